@@ -6,7 +6,9 @@ import (
 
 	"github.com/docker/oscalkit/cli/cmd/convert"
 	"github.com/docker/oscalkit/cli/cmd/generate"
+	"github.com/docker/oscalkit/cli/cmd/profile"
 	"github.com/docker/oscalkit/cli/version"
+	"github.com/docker/oscalkit/pkg/fetch"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
@@ -28,21 +30,67 @@ func Execute() error {
 			Name:  "debug, d",
 			Usage: "enable debug command output",
 		},
+		cli.StringFlag{
+			Name:  "cache-dir",
+			Usage: "directory caching remote documents such as profile imports",
+			Value: fetch.DefaultCacheDir(),
+		},
+		cli.BoolFlag{
+			Name:  "no-cache",
+			Usage: "fetch remote documents without caching them",
+		},
+		cli.BoolFlag{
+			Name:   "offline",
+			Usage:  "never fetch remote documents, use the cached copies only",
+			EnvVar: "OSCALKIT_OFFLINE",
+		},
+		cli.StringFlag{
+			Name:   "fetch-config",
+			Usage:  "YAML file with cache-dir, offline and rewrites mapping hrefs to local paths",
+			EnvVar: "OSCALKIT_FETCH_CONFIG",
+		},
 	}
 	app.Before = func(c *cli.Context) error {
 		if c.Bool("debug") {
 			logrus.SetLevel(logrus.DebugLevel)
 		}
 
-		return nil
+		return configureFetcher(c)
 	}
 	app.Commands = []cli.Command{
 		Info,
 		convert.Convert,
 		Validate,
+		Render,
+		profile.Profile,
 		Sign,
+		Verify,
+		Upgrade,
+		Diff,
+		Merge,
 		generate.Generate,
 	}
 
 	return app.Run(os.Args)
 }
+
+// configureFetcher sets up the fetcher of remote documents. Flags given on
+// the command line take precedence over the configuration file.
+func configureFetcher(c *cli.Context) error {
+	cfg := &fetch.Config{}
+	if path := c.String("fetch-config"); path != "" {
+		var err error
+		if cfg, err = fetch.LoadConfig(path); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+	}
+	if cfg.CacheDir == "" || c.IsSet("cache-dir") {
+		cfg.CacheDir = c.String("cache-dir")
+	}
+	if c.Bool("no-cache") {
+		cfg.CacheDir = ""
+	}
+	cfg.Offline = cfg.Offline || c.Bool("offline")
+	fetch.Default = fetch.New(*cfg)
+	return nil
+}
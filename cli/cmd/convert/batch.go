@@ -0,0 +1,206 @@
+package convert
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/oscal_source"
+)
+
+// extensions are the file extensions of the sources found in directories
+var extensions = map[string]bool{".xml": true, ".json": true, ".yaml": true, ".yml": true}
+
+// job converts single source file to the destination directory
+type job struct {
+	sourcePath string
+	destDir    string
+	destPath   string
+	err        error
+}
+
+// jobs returns conversion jobs of the arguments. Arguments are files, glob
+// patterns and, with --recursive, directories whose tree is mirrored under
+// the output path.
+func jobs(args []string) ([]*job, error) {
+	var jobs []*job
+	for _, arg := range args {
+		matches, err := filepath.Glob(arg)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid pattern %s: %v", arg, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("No files match %s", arg)
+		}
+		for _, match := range matches {
+			info, err := os.Stat(match)
+			if err != nil {
+				return nil, err
+			}
+			if !info.IsDir() {
+				jobs = append(jobs, &job{sourcePath: match, destDir: outputPath})
+				continue
+			}
+			if !recursive {
+				return nil, fmt.Errorf("%s is a directory, use --recursive to convert directories", match)
+			}
+			dirJobs, err := walk(match)
+			if err != nil {
+				return nil, err
+			}
+			jobs = append(jobs, dirJobs...)
+		}
+	}
+	return jobs, nil
+}
+
+// walk returns jobs of the XML, JSON and YAML files of the directory tree
+func walk(dir string) ([]*job, error) {
+	var jobs []*job
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !extensions[strings.ToLower(filepath.Ext(path))] {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, &job{sourcePath: path, destDir: filepath.Join(outputPath, rel)})
+		return nil
+	})
+	return jobs, err
+}
+
+// run converts the sources with the workers. Unless keepGoing is set, no
+// more jobs are started after the first failure and the jobs that were not
+// started are left without destination and error.
+func run(jobs []*job, workers int, keepGoing bool) {
+	if workers < 1 {
+		workers = 1
+	}
+	var (
+		mu      sync.Mutex
+		failed  bool
+		written = map[string]string{}
+		sources = map[string]bool{}
+	)
+	for _, j := range jobs {
+		sources[absPath(j.sourcePath)] = true
+	}
+	// claim reserves the destination so that no two sources overwrite it and
+	// no source is overwritten
+	claim := func(j *job, destPath string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		key := absPath(destPath)
+		if sources[key] {
+			return fmt.Errorf("%s would overwrite source %s", j.sourcePath, destPath)
+		}
+		if other, ok := written[key]; ok {
+			return fmt.Errorf("%s is already converted from %s", destPath, other)
+		}
+		written[key] = j.sourcePath
+		return nil
+	}
+	queue := make(chan *job)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range queue {
+				if j.err = j.convert(claim); j.err != nil {
+					mu.Lock()
+					failed = true
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, j := range jobs {
+		mu.Lock()
+		stop := failed && !keepGoing
+		mu.Unlock()
+		if stop {
+			break
+		}
+		queue <- j
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// absPath returns absolute path of the file, or the cleaned path when the
+// working directory is not known
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
+
+func (j *job) convert(claim func(j *job, destPath string) error) error {
+	source, err := oscal_source.Open(j.sourcePath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	destPath, outputFormat := createOutputPath(j.sourcePath, j.destDir, source.DocumentFormat())
+	j.destPath = destPath
+	if err := claim(j, destPath); err != nil {
+		return err
+	}
+	yamlPath := strings.TrimSuffix(destPath, filepath.Ext(destPath)) + ".yaml"
+	withYAML := yaml && source.DocumentFormat() != constants.YamlFormat
+	if withYAML {
+		if err := claim(j, yamlPath); err != nil {
+			return err
+		}
+	}
+	if j.destDir != "" {
+		if err := os.MkdirAll(j.destDir, 0755); err != nil {
+			return err
+		}
+	}
+	if err := checkDropped(source); err != nil {
+		return err
+	}
+	if err := convert(source, destPath, outputFormat); err != nil {
+		return err
+	}
+
+	if withYAML {
+		if err := convert(source, yamlPath, "yaml"); err != nil {
+			return fmt.Errorf("Error converting to YAML: %v", err)
+		}
+	}
+	return nil
+}
+
+// printSummary prints result of each job and returns number of failed jobs
+func printSummary(w io.Writer, jobs []*job) int {
+	converted, failed, skipped := 0, 0, 0
+	for _, j := range jobs {
+		switch {
+		case j.err != nil:
+			failed++
+			fmt.Fprintf(w, "FAIL %s: %s\n", j.sourcePath, j.err)
+		case j.destPath != "":
+			converted++
+			fmt.Fprintf(w, "OK   %s -> %s\n", j.sourcePath, j.destPath)
+		default:
+			skipped++
+			fmt.Fprintf(w, "SKIP %s\n", j.sourcePath)
+		}
+	}
+	fmt.Fprintf(w, "%d converted, %d failed, %d skipped\n", converted, failed, skipped)
+	return failed
+}
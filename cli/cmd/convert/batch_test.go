@@ -0,0 +1,195 @@
+package convert
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const xmlCatalog = `<catalog xmlns="http://csrc.nist.gov/ns/oscal/1.0" id="c"><metadata><title>Catalog</title></metadata></catalog>`
+
+// tree writes the files to temporary directory and returns the directory
+func tree(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "convert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// summary runs the jobs and returns the printed summary and number of failed
+// jobs
+func summary(batch []*job, keepGoing bool) (string, int) {
+	run(batch, 1, keepGoing)
+	var b bytes.Buffer
+	failed := printSummary(&b, batch)
+	return b.String(), failed
+}
+
+func TestJobs(t *testing.T) {
+	dir := tree(t, map[string]string{
+		"src/a.json":      validCatalog,
+		"src/sub/b.xml":   xmlCatalog,
+		"src/sub/c.txt":   "notes",
+		"src/sub/d/e.yml": "catalog:\n  id: c\n",
+	})
+	defer os.RemoveAll(dir)
+	defer func() { outputPath, recursive = "", false }()
+	src, out := filepath.Join(dir, "src"), filepath.Join(dir, "out")
+	outputPath = out
+
+	batch, err := jobs([]string{filepath.Join(src, "*.json")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batch) != 1 || batch[0].sourcePath != filepath.Join(src, "a.json") || batch[0].destDir != out {
+		t.Errorf("unexpected jobs of pattern %+v", batch)
+	}
+	if _, err := jobs([]string{filepath.Join(src, "missing*.xml")}); err == nil {
+		t.Error("pattern without matches should fail")
+	}
+	if _, err := jobs([]string{src}); err == nil {
+		t.Error("directory should fail without --recursive")
+	}
+
+	recursive = true
+	batch, err = jobs([]string{src})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []job{
+		{sourcePath: filepath.Join(src, "a.json"), destDir: out},
+		{sourcePath: filepath.Join(src, "sub", "b.xml"), destDir: filepath.Join(out, "sub")},
+		{sourcePath: filepath.Join(src, "sub", "d", "e.yml"), destDir: filepath.Join(out, "sub", "d")},
+	}
+	if len(batch) != len(expected) {
+		t.Fatalf("expected %d jobs, got %+v", len(expected), batch)
+	}
+	for i, j := range batch {
+		if j.sourcePath != expected[i].sourcePath || j.destDir != expected[i].destDir {
+			t.Errorf("unexpected job %+v, expected %+v", j, expected[i])
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := tree(t, map[string]string{
+		"src/a.json":    validCatalog,
+		"src/sub/b.xml": xmlCatalog,
+	})
+	defer os.RemoveAll(dir)
+	defer func() { outputPath, recursive = "", false }()
+	outputPath, recursive = filepath.Join(dir, "out"), true
+
+	batch, err := jobs([]string{filepath.Join(dir, "src")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, failed := summary(batch, false)
+	if failed != 0 || !strings.HasSuffix(s, "2 converted, 0 failed, 0 skipped\n") {
+		t.Errorf("unexpected summary:\n%s", s)
+	}
+	for _, name := range []string{"out/a.xml", "out/sub/b.json"} {
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name))); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestRunKeepGoing(t *testing.T) {
+	dir := tree(t, map[string]string{
+		"src/a.xml":  "<catalog",
+		"src/b.json": validCatalog,
+		"src/c.json": validCatalog,
+	})
+	defer os.RemoveAll(dir)
+	defer func() { outputPath = "" }()
+
+	for _, test := range []struct {
+		keepGoing bool
+		expected  string
+	}{
+		// the job started before the failure is known may still complete,
+		// the last one is never started
+		{false, "SKIP " + filepath.Join(dir, "src", "c.json") + "\n"},
+		{true, "2 converted, 1 failed, 0 skipped\n"},
+	} {
+		outputPath = filepath.Join(dir, fmt.Sprintf("out-%v", test.keepGoing))
+		batch, err := jobs([]string{filepath.Join(dir, "src", "*")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, failed := summary(batch, test.keepGoing)
+		if failed != 1 || !strings.HasPrefix(s, "FAIL "+filepath.Join(dir, "src", "a.xml")) || !strings.Contains(s, test.expected) {
+			t.Errorf("keep going %v: unexpected summary:\n%s", test.keepGoing, s)
+		}
+	}
+}
+
+func TestRunOverwrite(t *testing.T) {
+	dir := tree(t, map[string]string{
+		"x.xml":      xmlCatalog,
+		"x.json":     validCatalog,
+		"d1/y.json":  validCatalog,
+		"d2/y.json":  validCatalog,
+		"v1.2.json":  validCatalog,
+		"v1.2.1.xml": xmlCatalog,
+	})
+	defer os.RemoveAll(dir)
+	defer func() { outputPath = "" }()
+
+	// sources converted next to each other would overwrite each other
+	outputPath = dir
+	batch, err := jobs([]string{filepath.Join(dir, "x.*")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, failed := summary(batch, true)
+	if failed != 2 || strings.Count(s, "would overwrite source") != 2 {
+		t.Errorf("unexpected summary:\n%s", s)
+	}
+	for name, content := range map[string]string{"x.xml": xmlCatalog, "x.json": validCatalog} {
+		written, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil || string(written) != content {
+			t.Errorf("source %s should be left intact", name)
+		}
+	}
+
+	// sources of the same name in different directories
+	outputPath = filepath.Join(dir, "out")
+	batch, err = jobs([]string{filepath.Join(dir, "d1", "y.json"), filepath.Join(dir, "d2", "y.json")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, failed = summary(batch, true)
+	if failed != 1 || !strings.Contains(s, "is already converted from "+filepath.Join(dir, "d1", "y.json")) {
+		t.Errorf("unexpected summary:\n%s", s)
+	}
+
+	// only the extension is replaced in names with dots
+	batch, err = jobs([]string{filepath.Join(dir, "v1.*")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, failed = summary(batch, true); failed != 0 {
+		t.Errorf("unexpected summary:\n%s", s)
+	}
+	for _, name := range []string{"v1.2.xml", "v1.2.1.json"} {
+		if _, err := os.Stat(filepath.Join(outputPath, name)); err != nil {
+			t.Error(err)
+		}
+	}
+}
@@ -13,6 +13,7 @@ var Convert = cli.Command{
 	Subcommands: []cli.Command{
 		ConvertOSCAL,
 		ConvertHTML,
+		ConvertDocx,
 		ConvertOpenControl,
 	},
 }
@@ -0,0 +1,72 @@
+package convert
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/oscal_source"
+	"github.com/urfave/cli"
+)
+
+// ConvertDocx ...
+var ConvertDocx = cli.Command{
+	Name:  "docx",
+	Usage: "convert OSCAL system security plan to Word document",
+	Description: `The command accepts system security plan in any format and generates DOCX document laid out as the
+   FedRAMP SSP template: system characteristics, information types with their FIPS-199 impacts and the
+   implementation of the controls. The document is written to source file name with .docx extension
+   unless --output-path is given.`,
+	ArgsUsage: "[source-file]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:        "output-path, o",
+			Usage:       "Output path of the document. Defaults to the source file name with .docx extension in current working directory",
+			Destination: &outputPath,
+		},
+	},
+	Before: func(c *cli.Context) error {
+		if c.NArg() != 1 {
+			// Check for stdin
+			stat, _ := os.Stdin.Stat()
+			if c.NArg() == 0 && (stat.Mode()&os.ModeCharDevice) == 0 {
+				return nil
+			}
+
+			return cli.NewExitError("oscalkit convert docx requires one argument", 1)
+		}
+
+		return nil
+	},
+	Action: func(c *cli.Context) error {
+		sourcePath := c.Args().First()
+		if sourcePath == "" {
+			sourcePath = oscal_source.StdinPath
+		}
+		source, err := oscal_source.Open(sourcePath)
+		if err != nil {
+			return cli.NewExitError(fmt.Sprintf("could not load input file: %s", err), 1)
+		}
+		defer source.Close()
+
+		buffer, err := source.DOCX()
+		if err != nil {
+			return cli.NewExitError(fmt.Sprintf("could not convert to DOCX: %s", err), 1)
+		}
+		path := outputPath
+		if path == "" {
+			name := "ssp"
+			if sourcePath != oscal_source.StdinPath {
+				name = filepath.Base(sourcePath)
+				name = strings.TrimSuffix(name, filepath.Ext(name))
+			}
+			path = name + ".docx"
+		}
+		if err := ioutil.WriteFile(path, buffer.Bytes(), 0644); err != nil {
+			return cli.NewExitError(fmt.Sprintf("could not write to file: %s", err), 1)
+		}
+		return nil
+	},
+}
@@ -2,23 +2,34 @@ package convert
 
 import (
 	"fmt"
+	"os"
+
+	"github.com/docker/oscalkit/pkg/html"
 	"github.com/docker/oscalkit/pkg/oscal_source"
 	"github.com/urfave/cli"
-	"os"
 )
 
+var templateDir string
+
 // ConvertHTML ...
 var ConvertHTML = cli.Command{
-	Name:        "html",
-	Usage:       "convert OSCAL file to human readable HTML",
-	Description: `The command accepts source file and generates HTML representation of given file`,
-	ArgsUsage:   "[source-file]",
+	Name:  "html",
+	Usage: "convert OSCAL file to human readable HTML",
+	Description: `The command accepts source file and generates HTML representation of given file. Catalogs, profiles,
+   system security plans and component definitions in any format are rendered, profiles are resolved first.
+   The templates of --templates replace the built-in templates of the same name.`,
+	ArgsUsage: "[source-file]",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:        "output-path, o",
 			Usage:       "Output path for converted file(s). Defaults to current working directory",
 			Destination: &outputPath,
 		},
+		cli.StringFlag{
+			Name:        "templates, t",
+			Usage:       "directory of *.html templates replacing the built-in templates",
+			Destination: &templateDir,
+		},
 	},
 	Before: func(c *cli.Context) error {
 		if c.NArg() != 1 {
@@ -34,14 +45,22 @@ var ConvertHTML = cli.Command{
 		return nil
 	},
 	Action: func(c *cli.Context) error {
-		for _, sourcePath := range c.Args() {
+		renderer, err := html.New(templateDir)
+		if err != nil {
+			return cli.NewExitError(fmt.Sprintf("could not load templates: %s", err), 1)
+		}
+		sourcePaths := []string(c.Args())
+		if len(sourcePaths) == 0 {
+			sourcePaths = []string{oscal_source.StdinPath}
+		}
+		for _, sourcePath := range sourcePaths {
 			source, err := oscal_source.Open(sourcePath)
 			if err != nil {
 				return cli.NewExitError(fmt.Sprintf("could not load input file: %s", err), 1)
 			}
 			defer source.Close()
 
-			buffer, err := source.HTML()
+			buffer, err := source.HTML(renderer)
 			if err != nil {
 				return cli.NewExitError(fmt.Sprintf("could convert to HTML: %s", err), 1)
 			}
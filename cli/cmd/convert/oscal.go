@@ -1,17 +1,17 @@
 package convert
 
 import (
-	"encoding/json"
-	"encoding/xml"
-	"errors"
+	"bytes"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
-	"path"
 	"path/filepath"
+	"runtime"
 	"strings"
 
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/oscal_source"
 	"github.com/docker/oscalkit/types/oscal"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -19,15 +19,30 @@ import (
 
 var outputPath string
 var outputFile string
+var strict bool
+var validateOutput bool
+var recursive bool
+var workers int
+var keepGoing bool
+var canonical bool
 
 // ConvertOSCAL ...
 var ConvertOSCAL = cli.Command{
 	Name:  "oscal",
 	Usage: "convert between one or more OSCAL file formats",
-	Description: `Convert between OSCAL-formatted XML and JSON files. The command accepts
+	Description: `Convert between OSCAL-formatted XML, JSON and YAML files. The command accepts
    one or more source file paths and can also be used with source file contents
-	 piped/redirected from STDIN.`,
-	ArgsUsage: "[source-files...]",
+	 piped/redirected from STDIN. XML sources are converted to JSON, JSON and YAML
+	 sources are converted to XML. Elements, attributes and keys of the sources that the
+	 OSCAL model does not hold are dropped by the conversion and reported as warnings,
+	 with --strict such sources are not converted. With --validate the converted output
+	 is validated against the bundled schema of its format before it is written.
+	 With --recursive the XML, JSON and YAML files of directories are converted and the
+	 directory tree is mirrored under the output path. Files are converted concurrently
+	 and result of each file is reported. With --canonical the output depends only on the
+	 content of the source: prose whitespace, dates and namespaces are normalized, so that
+	 converting the same document again gives byte for byte equal output.`,
+	ArgsUsage: "[source-files|source-dirs...]",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:        "output-path, o",
@@ -44,6 +59,37 @@ var ConvertOSCAL = cli.Command{
 			Usage:       "If source file format is XML or JSON, also generate equivalent YAML output",
 			Destination: &yaml,
 		},
+		cli.BoolFlag{
+			Name:        "strict",
+			Usage:       "Fail instead of warning when the conversion would drop content of the source",
+			Destination: &strict,
+		},
+		cli.BoolFlag{
+			Name:        "validate",
+			Usage:       "Validate the converted output against the bundled schema before writing it",
+			Destination: &validateOutput,
+		},
+		cli.BoolFlag{
+			Name:        "recursive, r",
+			Usage:       "Convert files of the source directories and their subdirectories",
+			Destination: &recursive,
+		},
+		cli.IntFlag{
+			Name:        "jobs, j",
+			Usage:       "Number of files converted concurrently",
+			Value:       runtime.NumCPU(),
+			Destination: &workers,
+		},
+		cli.BoolFlag{
+			Name:        "keep-going",
+			Usage:       "Continue converting the remaining files after a file fails",
+			Destination: &keepGoing,
+		},
+		cli.BoolFlag{
+			Name:        "canonical",
+			Usage:       "Write the output in canonical form, with normalized prose, dates and namespaces",
+			Destination: &canonical,
+		},
 	},
 	Before: func(c *cli.Context) error {
 		if c.NArg() < 1 {
@@ -74,98 +120,65 @@ var ConvertOSCAL = cli.Command{
 	Action: func(c *cli.Context) error {
 		// Parse stdin via pipe or redirection
 		if c.NArg() <= 0 || c.Args().First() == "-" {
-			outputFormat, err := validateStdin(os.Stdin)
+			source, err := oscal_source.Open(oscal_source.StdinPath)
 			if err != nil {
 				return cli.NewExitError(fmt.Sprintf("Error parsing from STDIN: %s", err), 1)
 			}
+			defer source.Close()
 
-			destFile, err := os.Create(outputFile)
-			if err != nil {
-				return cli.NewExitError(fmt.Sprintf("Error opening output file %s: %s", outputFile, err), 1)
+			outputFormat := targetFormat(source.DocumentFormat())
+			if outputFile == "" {
+				outputFile = fmt.Sprintf("stdin.%s", outputFormat)
 			}
-			defer destFile.Close()
 
-			return convert(os.Stdin, destFile, outputFormat)
+			if err := checkDropped(source); err != nil {
+				return cli.NewExitError(fmt.Sprintf("Error converting from STDIN: %s", err), 1)
+			}
+			if err := convert(source, outputFile, outputFormat); err != nil {
+				return cli.NewExitError(fmt.Sprintf("Error converting from STDIN: %s", err), 1)
+			}
+			return nil
 		}
 
-		// Convert each source file
-		for _, sourcePath := range c.Args() {
-			// if isValidURL(sourcePath) {
-			// 	resp, err := http.Get(sourcePath)
-			// 	if err != nil {
-			// 		return cli.NewExitError(fmt.Sprintf("Error convert to OSCAL from URL %s: %s", sourcePath, err), 1)
-			// 	}
-			// 	defer resp.Body.Close()
-
-			// 	rawResp, _ := ioutil.ReadAll(resp.Body)
-			// 	logrus.Info(string(rawResp))
-			// }
-
-			matches, _ := filepath.Glob(sourcePath)
-
-			for _, match := range matches {
-				srcFile, err := os.Open(match)
-				if err != nil {
-					return err
-				}
-				defer srcFile.Close()
-
-				destPath, outputFormat := createOutputPath(sourcePath)
-
-				destFile, err := os.Create(destPath)
-				if err != nil {
-					return err
-				}
-				defer destFile.Close()
-
-				if err := convert(srcFile, destFile, outputFormat); err != nil {
-					return cli.NewExitError(fmt.Sprintf("Error converting to OSCAL from file %s: %s", match, err), 1)
-				}
-			}
+		batch, err := jobs(c.Args())
+		if err != nil {
+			return cli.NewExitError(err.Error(), 1)
+		}
+		run(batch, workers, keepGoing)
+		if failed := printSummary(os.Stdout, batch); failed > 0 {
+			return cli.NewExitError(fmt.Sprintf("Error converting %d of %d files", failed, len(batch)), 1)
 		}
 
 		return nil
 	},
 }
 
-func validateStdin(stdin *os.File) (string, error) {
-	rawSource, err := ioutil.ReadAll(os.Stdin)
-	if err != nil {
-		return "", err
+// targetFormat returns the format a source of given format is converted to
+func targetFormat(sourceFormat constants.DocumentFormat) string {
+	if sourceFormat == constants.XmlFormat {
+		return "json"
 	}
+	return "xml"
+}
 
-	var xmls string
-	var jsons string
-
-	if err = xml.Unmarshal(rawSource, &xmls); err == nil {
-		if outputFile == "" {
-			outputFile = "stdin.json"
-		}
-
-		return "json", nil
-	} else if err = json.Unmarshal(rawSource, &jsons); err == nil {
-		if outputFile == "" {
-			outputFile = "stdin.xml"
-		}
-
-		return "xml", nil
+// documentFormat returns format of the output format name
+func documentFormat(outputFormat string) constants.DocumentFormat {
+	switch outputFormat {
+	case "xml":
+		return constants.XmlFormat
+	case "json":
+		return constants.JsonFormat
+	case "yaml":
+		return constants.YamlFormat
 	}
-
-	return "", errors.New("File content from STDIN is neither XML nor JSON")
-
+	return constants.UnknownFormat
 }
 
-// Not yet parsing rawSource arg for STDIN
-func convert(src io.Reader, dest io.Writer, outputFormat string) error {
+func write(o *oscal.OSCAL, dest io.Writer, outputFormat string) error {
 	switch outputFormat {
 	case "json":
 		logrus.Debug("Converting to JSON")
 
-		o, err := oscal.New(src)
-		if err != nil {
-			return err
-		}
-
 		if err := o.JSON(dest, true); err != nil {
 			return err
 		}
@@ -177,11 +190,6 @@ func convert(src io.Reader, dest io.Writer, outputFormat string) error {
 	case "xml":
 		logrus.Debug("Converting to XML")
 
-		o, err := oscal.New(src)
-		if err != nil {
-			return err
-		}
-
 		if err := o.XML(dest, true); err != nil {
 			return err
 		}
@@ -193,11 +201,6 @@ func convert(src io.Reader, dest io.Writer, outputFormat string) error {
 	case "yaml":
 		logrus.Debug("Converting to YAML")
 
-		o, err := oscal.New(src)
-		if err != nil {
-			return err
-		}
-
 		if err := o.YAML(dest); err != nil {
 			return err
 		}
@@ -210,6 +213,65 @@ func convert(src io.Reader, dest io.Writer, outputFormat string) error {
 	return fmt.Errorf("Output format %s is not supported", outputFormat)
 }
 
+// checkDropped warns about content of the source the conversion drops. With
+// --strict the dropped content is an error.
+func checkDropped(source *oscal_source.OSCALSource) error {
+	result, err := source.DroppedContent()
+	if err != nil {
+		return err
+	}
+	if len(result.Findings) == 0 {
+		return nil
+	}
+	if strict {
+		var lines []string
+		for _, f := range result.Findings {
+			lines = append(lines, f.String())
+		}
+		return fmt.Errorf("conversion would drop content:\n%s", strings.Join(lines, "\n"))
+	}
+	for _, f := range result.Findings {
+		logrus.Warn(f)
+	}
+	return nil
+}
+
+// convert writes the source in the output format to destPath. The output is
+// written only when it is complete and, with --validate, valid.
+func convert(source *oscal_source.OSCALSource, destPath, outputFormat string) error {
+	var b bytes.Buffer
+	if canonical {
+		if err := source.OSCAL().Canonical(&b, documentFormat(outputFormat)); err != nil {
+			return err
+		}
+	} else if err := write(source.OSCAL(), &b, outputFormat); err != nil {
+		return err
+	}
+	if validateOutput {
+		if err := validate(destPath, b.Bytes()); err != nil {
+			return err
+		}
+	}
+	return ioutil.WriteFile(destPath, b.Bytes(), 0644)
+}
+
+// validate validates the converted output against the bundled schema
+func validate(destPath string, output []byte) error {
+	converted, err := oscal_source.OpenFromReader(destPath, bytes.NewReader(output))
+	if err != nil {
+		return err
+	}
+	defer converted.Close()
+	result, err := converted.ValidationResult()
+	if err != nil {
+		return err
+	}
+	if err := result.Err(); err != nil {
+		return fmt.Errorf("converted output is not valid:\n%s", err)
+	}
+	return nil
+}
+
 // func isValidURL(urlStr string) bool {
 // 	_, err := url.ParseRequestURI(urlStr)
 // 	if err != nil {
@@ -219,24 +281,20 @@ func convert(src io.Reader, dest io.Writer, outputFormat string) error {
 // 	return true
 // }
 
-func createOutputPath(srcPath string) (string, string) {
+// createOutputPath returns path of the converted srcPath in destDir, which
+// defaults to current working directory, and the output format
+func createOutputPath(srcPath, destDir string, sourceFormat constants.DocumentFormat) (string, string) {
 	if srcPath == "" {
 		return "", ""
 	}
 
-	sourceExt := filepath.Ext(srcPath)[1:]
-
-	var outputFormat string
-	if sourceExt == "xml" {
-		outputFormat = "json"
-	} else {
-		outputFormat = "xml"
-	}
+	outputFormat := targetFormat(sourceFormat)
 
-	filePath := fmt.Sprintf("%s.%s", strings.Split(path.Base(srcPath), ".")[0], outputFormat)
+	base := filepath.Base(srcPath)
+	filePath := fmt.Sprintf("%s.%s", strings.TrimSuffix(base, filepath.Ext(base)), outputFormat)
 
-	if outputPath != "" {
-		filePath = path.Join(outputPath, filePath)
+	if destDir != "" {
+		filePath = filepath.Join(destDir, filePath)
 	}
 
 	return filePath, outputFormat
@@ -0,0 +1,91 @@
+package convert
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/pkg/oscal_source"
+)
+
+const (
+	validCatalog   = `{"catalog": {"id": "c", "metadata": {"title": "Catalog", "lastModified": "2019-09-01T00:00:00Z", "version": "1.0", "oscalVersion": "1.0.0-milestone2"}, "controls": [{"id": "c-1", "title": "Control"}]}}`
+	invalidCatalog = `{"catalog": {"id": "c", "metadata": {"title": "Catalog", "version": "1.0", "oscalVersion": "1.0.0-milestone2"}}}`
+	droppedCatalog = `<catalog xmlns="http://csrc.nist.gov/ns/oscal/1.0" id="c"><metadata><title>Catalog</title><bogus/></metadata></catalog>`
+)
+
+// open writes the content to the directory and opens it as source
+func open(t *testing.T, dir, name, content string) *oscal_source.OSCALSource {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	source, err := oscal_source.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return source
+}
+
+func TestCheckDropped(t *testing.T) {
+	dir, err := ioutil.TempDir("", "convert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func() { strict = false }()
+
+	dropped := open(t, dir, "dropped.xml", droppedCatalog)
+	defer dropped.Close()
+	clean := open(t, dir, "clean.json", validCatalog)
+	defer clean.Close()
+
+	strict = false
+	if err := checkDropped(dropped); err != nil {
+		t.Errorf("dropped content should only be warned about, got %v", err)
+	}
+	strict = true
+	if err := checkDropped(dropped); err == nil || !strings.Contains(err.Error(), "bogus") {
+		t.Errorf("dropped content should fail with --strict, got %v", err)
+	}
+	if err := checkDropped(clean); err != nil {
+		t.Errorf("source without dropped content should pass with --strict, got %v", err)
+	}
+}
+
+func TestConvertValidate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "convert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func() { validateOutput = false }()
+	validateOutput = true
+
+	valid := open(t, dir, "valid.json", validCatalog)
+	defer valid.Close()
+	destPath := filepath.Join(dir, "valid.xml")
+	if err := convert(valid, destPath, "xml"); err != nil {
+		t.Fatalf("valid output should be written, got %v", err)
+	}
+	if _, err := os.Stat(destPath); err != nil {
+		t.Error(err)
+	}
+
+	invalid := open(t, dir, "invalid.json", invalidCatalog)
+	defer invalid.Close()
+	destPath = filepath.Join(dir, "invalid.xml")
+	if err := convert(invalid, destPath, "xml"); err == nil || !strings.Contains(err.Error(), "not valid") {
+		t.Errorf("invalid output should fail with --validate, got %v", err)
+	}
+	if _, err := os.Stat(destPath); !os.IsNotExist(err) {
+		t.Error("invalid output should not be written")
+	}
+
+	validateOutput = false
+	if err := convert(invalid, destPath, "xml"); err != nil {
+		t.Errorf("output should not be validated without --validate, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/diff"
+	"github.com/docker/oscalkit/pkg/oscal_source"
+	"github.com/urfave/cli"
+)
+
+var diffFormat string
+
+// Diff compares two OSCAL documents
+var Diff = cli.Command{
+	Name:  "diff",
+	Usage: "compare two OSCAL documents",
+	Description: `Compare two OSCAL documents of the same type, in any of XML, JSON and YAML formats. Controls, parts, parameters,
+   parameter settings, alterations, implemented requirements and metadata are matched by their ids and reported
+   as added, removed or changed. The changes are reported in text, JSON or Markdown format. Like diff(1) the command
+   exits with 0 when the documents are equivalent, 1 when they differ and 2 when they cannot be compared.`,
+	ArgsUsage: "old new",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:        "format, f",
+			Usage:       fmt.Sprintf("report format (%s)", strings.Join(diff.Formats, ", ")),
+			Value:       diff.FormatText,
+			Destination: &diffFormat,
+		},
+	},
+	Before: func(c *cli.Context) error {
+		if c.NArg() != 2 {
+			return cli.NewExitError("oscalkit diff requires two arguments", 2)
+		}
+		for _, f := range diff.Formats {
+			if f == diffFormat {
+				return nil
+			}
+		}
+		return cli.NewExitError(fmt.Sprintf("Unsupported report format %s, expected one of %s", diffFormat, strings.Join(diff.Formats, ", ")), 2)
+	},
+	Action: func(c *cli.Context) error {
+		oldPath, newPath := c.Args().Get(0), c.Args().Get(1)
+		old, err := oscal_source.Open(oldPath)
+		if err != nil {
+			return cli.NewExitError(fmt.Sprintf("Could not open %s: %v", oldPath, err), 2)
+		}
+		defer old.Close()
+		new, err := oscal_source.Open(newPath)
+		if err != nil {
+			return cli.NewExitError(fmt.Sprintf("Could not open %s: %v", newPath, err), 2)
+		}
+		defer new.Close()
+
+		d, err := diff.Compare(old.OSCAL(), new.OSCAL())
+		if err != nil {
+			return cli.NewExitError(err, 2)
+		}
+		d.Old, d.New = oldPath, newPath
+		if err := diff.Write(os.Stdout, diffFormat, d); err != nil {
+			return cli.NewExitError(err, 2)
+		}
+		if !d.Empty() {
+			return cli.NewExitError("", 1)
+		}
+		return nil
+	},
+}
@@ -10,10 +10,12 @@ import (
 
 	"github.com/docker/oscalkit/generator"
 	"github.com/docker/oscalkit/pkg/oscal_source"
+	"github.com/docker/oscalkit/pkg/resolver"
 	"github.com/urfave/cli"
 )
 
 var isJSON bool
+var resolve bool
 
 // Catalog generates json/xml catalogs
 var Catalog = cli.Command{
@@ -37,6 +39,11 @@ var Catalog = cli.Command{
 			Usage:       "flag for generating catalogs in json",
 			Destination: &isJSON,
 		},
+		cli.BoolFlag{
+			Name:        "resolve, r",
+			Usage:       "resolve profile into single catalog per the OSCAL profile resolution specification",
+			Destination: &resolve,
+		},
 	},
 	Before: func(c *cli.Context) error {
 		if profilePath == "" {
@@ -45,6 +52,14 @@ var Catalog = cli.Command{
 		return nil
 	},
 	Action: func(c *cli.Context) error {
+		if resolve {
+			resolved, err := resolver.ResolveFile(profilePath)
+			if err != nil {
+				return cli.NewExitError(fmt.Sprintf("cannot resolve profile, err: %v", err), 1)
+			}
+			return writeCatalogs(resolved)
+		}
+
 		os, err := oscal_source.Open(profilePath)
 		if err != nil {
 			return cli.NewExitError(err, 1)
@@ -66,23 +81,25 @@ var Catalog = cli.Command{
 			return cli.NewExitError(fmt.Sprintf("cannot create catalogs from profile, err: %v", err), 1)
 		}
 
-		var bytes []byte
-		if !isJSON {
-			bytes, err = xml.MarshalIndent(catalogs, "", "  ")
-			if err != nil {
-				return err
-			}
-			return ioutil.WriteFile(outputFileName+".xml", bytes, 0644)
-		}
-		bytes, err = json.MarshalIndent(catalogs, "", "  ")
-		if err != nil {
-			return err
-		}
-		return ioutil.WriteFile(outputFileName+".json", bytes, 0644)
-
+		return writeCatalogs(catalogs)
 	},
 	After: func(c *cli.Context) error {
 		logrus.Info("catalog file generated")
 		return nil
 	},
 }
+
+func writeCatalogs(catalogs interface{}) error {
+	if !isJSON {
+		bytes, err := xml.MarshalIndent(catalogs, "", "  ")
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(outputFileName+".xml", bytes, 0644)
+	}
+	bytes, err := json.MarshalIndent(catalogs, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(outputFileName+".json", bytes, 0644)
+}
@@ -13,7 +13,7 @@ import (
 var Info = cli.Command{
 	Name:      "info",
 	Usage:     "Provides information about particular OSCAL resource",
-	ArgsUsage: "[file]",
+	ArgsUsage: "[file|-]",
 	Action: func(c *cli.Context) error {
 		for _, filePath := range c.Args() {
 			os, err := oscal_source.Open(filePath)
@@ -22,6 +22,15 @@ var Info = cli.Command{
 			}
 			defer os.Close()
 
+			fmt.Println("Format:\t", os.DocumentFormat())
+			if schemaVersion, _, err := os.ValidationVersion(); err == nil {
+				claimed := os.ClaimedVersion()
+				if claimed == "" {
+					claimed = "none"
+				}
+				fmt.Println("OSCAL Version (claimed):\t", claimed)
+				fmt.Println("Schema Version (used):\t", schemaVersion)
+			}
 			o := os.OSCAL()
 			switch o.DocumentType() {
 			case constants.SSPDocument:
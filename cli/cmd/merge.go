@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/docker/oscalkit/pkg/merge"
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/oscal_source"
+	"github.com/urfave/cli"
+)
+
+var mergeOutput, mergeReport string
+
+// Merge merges concurrent edits of OSCAL documents
+var Merge = cli.Command{
+	Name:  "merge",
+	Usage: "three-way merge of OSCAL documents",
+	Description: `Merge changes made by ours and theirs to the base document. Controls, parts, parameters, implemented
+   requirements and the other objects are matched by their ids, so that edits of different objects merge cleanly
+   regardless of formatting and order. The documents may be in any of XML, JSON and YAML formats, the merged document
+   is written in the format of ours, in canonical form. Values changed differently by both sides keep our version and
+   are reported with conflict markers to the report file or STDERR. The command exits with 0 when the documents merge
+   cleanly, 1 on conflicts and 2 when they cannot be merged, so that it can be used as git merge driver:
+
+   git config merge.oscal.driver "oscalkit merge -o %A --report %P.conflicts %O %A %B"`,
+	ArgsUsage: "base ours theirs",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:        "output, o",
+			Usage:       "write the merged document to file instead of STDOUT",
+			Destination: &mergeOutput,
+		},
+		cli.StringFlag{
+			Name:        "report",
+			Usage:       "write the conflicts to file instead of STDERR",
+			Destination: &mergeReport,
+		},
+	},
+	Before: func(c *cli.Context) error {
+		if c.NArg() != 3 {
+			return cli.NewExitError("oscalkit merge requires three arguments", 2)
+		}
+		return nil
+	},
+	Action: func(c *cli.Context) error {
+		var sources []*oscal_source.OSCALSource
+		for _, path := range c.Args() {
+			source, err := oscal_source.Open(path)
+			if err != nil {
+				return cli.NewExitError(fmt.Sprintf("Could not open %s: %v", path, err), 2)
+			}
+			defer source.Close()
+			sources = append(sources, source)
+		}
+
+		result, err := merge.Merge(sources[0].OSCAL(), sources[1].OSCAL(), sources[2].OSCAL())
+		if err != nil {
+			return cli.NewExitError(err, 2)
+		}
+		format := sources[1].DocumentFormat()
+		if format == constants.UnknownFormat {
+			format = constants.XmlFormat
+		}
+		var b bytes.Buffer
+		if err := result.Document.Canonical(&b, format); err != nil {
+			return cli.NewExitError(fmt.Sprintf("Could not write merged document: %v", err), 2)
+		}
+		if err := writeOutput(mergeOutput, b.Bytes()); err != nil {
+			return cli.NewExitError(err, 2)
+		}
+
+		if len(result.Conflicts) == 0 {
+			return nil
+		}
+		b.Reset()
+		if err := merge.WriteConflicts(&b, result.Conflicts); err != nil {
+			return cli.NewExitError(err, 2)
+		}
+		if mergeReport == "" {
+			io.Copy(os.Stderr, &b)
+		} else if err := ioutil.WriteFile(mergeReport, b.Bytes(), 0644); err != nil {
+			return cli.NewExitError(fmt.Sprintf("Could not write report: %v", err), 2)
+		}
+		return cli.NewExitError("", 1)
+	},
+}
+
+// writeOutput writes content to the file or STDOUT
+func writeOutput(path string, content []byte) error {
+	if path == "" {
+		_, err := os.Stdout.Write(content)
+		return err
+	}
+	if err := ioutil.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("Could not write %s: %v", path, err)
+	}
+	return nil
+}
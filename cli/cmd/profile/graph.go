@@ -0,0 +1,65 @@
+package profile
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/docker/oscalkit/pkg/resolver"
+	"github.com/urfave/cli"
+)
+
+var graphFormat string
+var maxDepth int
+
+// Graph prints import graph of profile
+var Graph = cli.Command{
+	Name:  "graph",
+	Usage: "prints the import graph of a profile",
+	Description: `Prints the catalogs and profiles imported by the profile, directly or through other
+   profiles. Import cycles and import chains longer than the maximum depth are reported
+   with the full chain of hrefs.`,
+	ArgsUsage: "[profile]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:        "format, f",
+			Usage:       "output format, text or dot (Graphviz)",
+			Value:       "text",
+			Destination: &graphFormat,
+		},
+		cli.IntFlag{
+			Name:        "max-depth",
+			Usage:       "maximum length of import chains",
+			Value:       resolver.DefaultMaxDepth,
+			Destination: &maxDepth,
+		},
+	},
+	Before: func(c *cli.Context) error {
+		if c.NArg() != 1 {
+			return cli.NewExitError("oscalkit profile graph requires one argument", 1)
+		}
+		if graphFormat != "text" && graphFormat != "dot" {
+			return cli.NewExitError(fmt.Sprintf("unsupported graph format %s", graphFormat), 1)
+		}
+		return nil
+	},
+	Action: func(c *cli.Context) error {
+		r := resolver.New()
+		r.MaxDepth = maxDepth
+		g, err := r.Graph(c.Args().First())
+		if err != nil {
+			return cli.NewExitError(fmt.Sprintf("cannot build import graph: %v", err), 1)
+		}
+		if g.Nodes[g.Root].Type != resolver.ProfileNode {
+			return cli.NewExitError(fmt.Sprintf("%s is not an OSCAL profile", g.Root), 1)
+		}
+		if graphFormat == "dot" {
+			err = g.WriteDOT(os.Stdout)
+		} else {
+			err = g.WriteText(os.Stdout)
+		}
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	},
+}
@@ -0,0 +1,14 @@
+package profile
+
+import (
+	"github.com/urfave/cli"
+)
+
+// Profile Cli command to inspect OSCAL profiles
+var Profile = cli.Command{
+	Name:  "profile",
+	Usage: "inspects OSCAL profiles",
+	Subcommands: []cli.Command{
+		Graph,
+	},
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/docker/oscalkit/pkg/oscal_source"
+	"github.com/docker/oscalkit/pkg/resolver"
+	"github.com/docker/oscalkit/types/oscal/catalog"
+	"github.com/urfave/cli"
+)
+
+var renderControls cli.StringSlice
+
+// Render prints control statements with parameter values inserted
+var Render = cli.Command{
+	Name:  "render",
+	Usage: "Renders statements of controls with parameter values inserted",
+	Description: `Catalogs are rendered as they are. Profiles are resolved first, so that the parameter
+   values set by the profile and the profiles it imports are inserted. Parameters without value
+   are rendered as assignments of their label or selections of their choices.`,
+	ArgsUsage: "[file|-]",
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "control, c",
+			Usage: "id of control to render, may be repeated. Defaults to all controls",
+			Value: &renderControls,
+		},
+	},
+	Before: func(c *cli.Context) error {
+		if c.NArg() != 1 {
+			return cli.NewExitError("oscalkit render requires one argument", 1)
+		}
+		return nil
+	},
+	Action: func(c *cli.Context) error {
+		path := c.Args().First()
+		os, err := oscal_source.Open(path)
+		if err != nil {
+			return cli.NewExitError(fmt.Sprintf("Could not open oscal file: %v", err), 1)
+		}
+		defer os.Close()
+
+		o := os.OSCAL()
+		ctlg := o.Catalog
+		if o.Profile != nil {
+			if ctlg, err = resolver.New().Resolve(o.Profile, path); err != nil {
+				return cli.NewExitError(fmt.Sprintf("Could not resolve profile: %v", err), 1)
+			}
+		}
+		if ctlg == nil {
+			return cli.NewExitError("oscalkit render requires catalog or profile", 1)
+		}
+
+		var controls []*catalog.Control
+		if len(renderControls) == 0 {
+			ctlg.WalkControls(func(ctrl *catalog.Control) bool {
+				controls = append(controls, ctrl)
+				return true
+			})
+		}
+		for _, id := range renderControls {
+			ctrl := ctlg.FindControl(id)
+			if ctrl == nil {
+				return cli.NewExitError(fmt.Sprintf("Control %s not found", id), 1)
+			}
+			controls = append(controls, ctrl)
+		}
+
+		for i, ctrl := range controls {
+			if i > 0 {
+				fmt.Println()
+			}
+			rendered := ctlg.RenderControl(*ctrl)
+			fmt.Println(rendered.Id, rendered.Title)
+			if statement := rendered.Statement(); statement != nil {
+				fmt.Println(statement.Text())
+			}
+		}
+		return nil
+	},
+}
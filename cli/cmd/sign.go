@@ -1,125 +1,302 @@
 package cmd
 
 import (
+	"bytes"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
-	"path"
+	"os"
+	"path/filepath"
 	"strings"
 
-	"gopkg.in/square/go-jose.v2"
-
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/oscal_source"
+	"github.com/docker/oscalkit/pkg/signing"
 	"github.com/urfave/cli"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
-var privKey string
-var alg string
+var signKeys, signAlgs, signKeyIDs, signCerts cli.StringSlice
+var signMode, signPasswordFile string
+
+// Signing modes
+const (
+	signModeJWS        = "jws"
+	signModeDetached   = "detached"
+	signModeXMLDSig    = "xmldsig"
+	signModeBackMatter = "back-matter"
+)
+
+var signModes = []string{signModeJWS, signModeDetached, signModeXMLDSig, signModeBackMatter}
+
+// passwordEnv is the environment variable holding password of encrypted keys
+const passwordEnv = "OSCALKIT_KEY_PASSWORD"
 
 // Sign ...
 var Sign = cli.Command{
-	Name:      "sign",
-	Usage:     "sign OSCAL JSON artifacts",
+	Name:  "sign",
+	Usage: "sign OSCAL artifacts",
+	Description: `Sign OSCAL artifacts by JSON Web Signature or XML Signature. The --mode selects the form of the signature,
+   the signed files are written next to the source files:
+
+   jws          the signed file <name>-SIGNED.<ext> is JWS in full serialization holding the document
+   detached     detached JWS of the canonical JSON form of the document is written to <file>.jws
+   xmldsig      enveloped XML Signature is added to XML document, written to <name>-SIGNED.xml
+   back-matter  detached JWS is recorded as resource of the back-matter, written to <name>-SIGNED.<ext>
+
+   Detached and back-matter signatures keep signed XML documents valid OSCAL, the XML Signature element is not
+   allowed by the OSCAL schemas and is reported by validate. The documents are written in canonical form,
+   detached and back-matter signatures remain valid when the document is converted. As detached, XML and
+   back-matter signatures cover the content held by the OSCAL model only, documents with elements, attributes
+   or keys the model does not hold are not signed in these modes.
+
+   Repeat --key to sign by multiple signers, the JWS is then in general JSON serialization. The --alg, --kid and
+   --cert flags are given once per key, in order of the keys; single --alg applies to all keys. Passwords of
+   encrypted keys are read from --password-file, the ` + passwordEnv + ` environment variable or the terminal.`,
 	ArgsUsage: "[files...]",
 	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "key, k",
+			Usage: "private key file for signing, may be repeated. PEM or DER encoded PKCS#1, PKCS#8 or SEC 1 key, optionally encrypted and followed by its certificate chain, or JSON Web Key. HMAC secrets are JSON Web Keys or raw secret files used with HS algorithms",
+			Value: &signKeys,
+		},
+		cli.StringSliceFlag{
+			Name:  "alg, a",
+			Usage: "algorithm for signing. Supports RSASSA-PKCS#1v1.5, RSASSA-PSS, HMAC, ECDSA and Ed25519. Defaults to RS256, ES256/ES384/ES512, EdDSA or HS256 by the key",
+			Value: &signAlgs,
+		},
+		cli.StringSliceFlag{
+			Name:  "kid",
+			Usage: "key id written to the kid header of the signature",
+			Value: &signKeyIDs,
+		},
+		cli.StringSliceFlag{
+			Name:  "cert",
+			Usage: "PEM file of the certificate chain of the key, leaf first, written to the x5c header of the signature",
+			Value: &signCerts,
+		},
 		cli.StringFlag{
-			Name:        "key, k",
-			Usage:       "private key file for signing. Must be in PEM or DER formats. Supports RSA/EC keys and X.509 certificats with embedded RSA/EC keys",
-			Destination: &privKey,
+			Name:        "password-file",
+			Usage:       "file holding password of encrypted keys",
+			Destination: &signPasswordFile,
 		},
 		cli.StringFlag{
-			Name:        "alg, a",
-			Usage:       "algorithm for signing. Supports RSASSA-PKCS#1v1.5, RSASSA-PSS, HMAC, ECDSA and Ed25519",
-			Destination: &alg,
+			Name:        "mode, m",
+			Usage:       fmt.Sprintf("form of the signature (%s)", strings.Join(signModes, ", ")),
+			Value:       signModeJWS,
+			Destination: &signMode,
 		},
 	},
 	Before: func(c *cli.Context) error {
-		if privKey == "" {
+		if len(signKeys) == 0 {
 			return cli.NewExitError("oscalkit sign is missing the --key flag", 1)
 		}
 
-		if alg == "" {
-			return cli.NewExitError("oscalkit sign is missing the --alg flag", 1)
-		}
-
 		if c.NArg() < 1 {
 			return cli.NewExitError("oscalkit sign requires at least one argument", 1)
 		}
 
-		return nil
+		if len(signAlgs) > 1 && len(signAlgs) != len(signKeys) {
+			return cli.NewExitError("oscalkit sign requires single --alg or one per --key", 1)
+		}
+		if len(signKeyIDs) > 0 && len(signKeyIDs) != len(signKeys) {
+			return cli.NewExitError("oscalkit sign requires one --kid per --key", 1)
+		}
+		if len(signCerts) > 0 && len(signCerts) != len(signKeys) {
+			return cli.NewExitError("oscalkit sign requires one --cert per --key", 1)
+		}
+		if signMode == signModeXMLDSig && len(signKeys) > 1 {
+			return cli.NewExitError("XML Signature supports single --key only", 1)
+		}
+
+		for _, m := range signModes {
+			if m == signMode {
+				return nil
+			}
+		}
+		return cli.NewExitError(fmt.Sprintf("Unsupported signing mode %s, expected one of %s", signMode, strings.Join(signModes, ", ")), 1)
 	},
 	Action: func(c *cli.Context) error {
-		for _, srcFile := range c.Args() {
-			privKeyFile, err := ioutil.ReadFile(privKey)
+		var signers []*signing.Signer
+		for i := range signKeys {
+			s, err := loadSigner(i)
 			if err != nil {
-				return cli.NewExitError(fmt.Sprintf("Error loading private key file %s: %s", privKey, err), 1)
+				return cli.NewExitError(err, 1)
 			}
+			signers = append(signers, s)
+		}
 
+		for _, srcFile := range c.Args() {
 			srcFileData, err := ioutil.ReadFile(srcFile)
 			if err != nil {
 				return cli.NewExitError(fmt.Sprintf("Error reading source file %s: %s", srcFile, err), 1)
 			}
 
-			input := privKeyFile
-			block, _ := pem.Decode(privKeyFile)
-			if block != nil {
-				input = block.Bytes
+			msg, err := sign(signers, srcFile, srcFileData)
+			if err != nil {
+				return cli.NewExitError(fmt.Sprintf("Signing error: %s", err), 1)
 			}
 
-			var priv interface{}
-			var msg string
-			priv, err0 := x509.ParsePKCS1PrivateKey(input)
-			if err0 == nil {
-				msg, err = sign(priv, srcFileData)
-				if err != nil {
-					return cli.NewExitError(fmt.Sprintf("Signing error: %s", err), 1)
-				}
+			if err := ioutil.WriteFile(signedPath(srcFile), []byte(msg), 0644); err != nil {
+				return cli.NewExitError(fmt.Sprintf("Error writing signed file: %s", err), 1)
 			}
+		}
 
-			priv, err1 := x509.ParsePKCS8PrivateKey(input)
-			if err1 == nil {
-				msg, err = sign(priv, srcFileData)
-				if err != nil {
-					return cli.NewExitError(fmt.Sprintf("Signing error: %s", err), 1)
-				}
-			}
+		return nil
+	},
+}
 
-			priv, err2 := x509.ParseECPrivateKey(input)
-			if err2 == nil {
-				msg, err = sign(priv, srcFileData)
-				if err != nil {
-					return cli.NewExitError(fmt.Sprintf("Signing error: %s", err), 1)
-				}
-			}
+// signedPath returns path of the signed file, next to the source file:
+// <file>.jws for detached signatures, <name>-SIGNED<.ext> otherwise
+func signedPath(srcFile string) string {
+	if signMode == signModeDetached {
+		return srcFile + ".jws"
+	}
+	ext := filepath.Ext(srcFile)
+	return strings.TrimSuffix(srcFile, ext) + "-SIGNED" + ext
+}
 
-			if msg != "" {
-				splitPath := strings.Split(path.Base(srcFile), ".")
-				filePath := fmt.Sprintf("%s-SIGNED.%s", splitPath[0], splitPath[1])
-				if err := ioutil.WriteFile(filePath, []byte(msg), 0644); err != nil {
-					return cli.NewExitError(fmt.Sprintf("Error writing signed file: %s", err), 1)
-				}
+// loadSigner parses the i-th key with its algorithm, key id and certificates
+func loadSigner(i int) (*signing.Signer, error) {
+	keyFile := signKeys[i]
+	data, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("Error loading private key file %s: %s", keyFile, err)
+	}
+	alg := ""
+	if len(signAlgs) == 1 {
+		alg = signAlgs[0]
+	} else if len(signAlgs) > 1 {
+		alg = signAlgs[i]
+	}
 
-				continue
-			}
+	var s *signing.Signer
+	if strings.HasPrefix(alg, "HS") && !bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		// raw HMAC secret
+		s, err = signing.NewSigner(bytes.TrimRight(data, "\r\n"))
+	} else {
+		s, err = signing.ParseSigner(data, func() ([]byte, error) { return readPassword(keyFile) })
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing private key %s: %s", keyFile, err)
+	}
+	if alg != "" {
+		s.Algorithm = alg
+	}
+	if len(signKeyIDs) > 0 {
+		s.KeyID = signKeyIDs[i]
+	}
+	if len(signCerts) > 0 {
+		if s.Certificates, err = loadCertificates(signCerts[i]); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.Validate(); err != nil {
+		return nil, fmt.Errorf("Error using private key %s: %s", keyFile, err)
+	}
+	return s, nil
+}
 
-			return cli.NewExitError(fmt.Sprintf("Error parsing private key: %s, %s, %s", err0, err1, err2), 1)
+func loadCertificates(certFile string) ([]*x509.Certificate, error) {
+	data, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return nil, fmt.Errorf("Error loading certificate file %s: %s", certFile, err)
+	}
+	var certificates []*x509.Certificate
+	for {
+		var block *pem.Block
+		if block, data = pem.Decode(data); block == nil {
+			break
 		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing certificate file %s: %s", certFile, err)
+		}
+		certificates = append(certificates, cert)
+	}
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("No certificates found in %s", certFile)
+	}
+	return certificates, nil
+}
 
-		return nil
-	},
+// readPassword returns password of the encrypted key from the password file,
+// the environment or the terminal
+func readPassword(keyFile string) ([]byte, error) {
+	if signPasswordFile != "" {
+		password, err := ioutil.ReadFile(signPasswordFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading password file %s: %s", signPasswordFile, err)
+		}
+		return bytes.TrimRight(password, "\r\n"), nil
+	}
+	if password, ok := os.LookupEnv(passwordEnv); ok {
+		return []byte(password), nil
+	}
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		return nil, fmt.Errorf("private key is encrypted, give its password by --password-file or %s", passwordEnv)
+	}
+	fmt.Fprintf(os.Stderr, "Password for %s: ", keyFile)
+	password, err := terminal.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	return password, err
 }
 
-func sign(key interface{}, payload []byte) (string, error) {
-	sigAlg := jose.SignatureAlgorithm(alg)
-	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: sigAlg, Key: key}, nil)
-	if err != nil {
-		return "", err
+func sign(signers []*signing.Signer, srcFile string, payload []byte) (string, error) {
+	if signMode == signModeJWS {
+		obj, err := signing.Sign(payload, signers...)
+		if err != nil {
+			return "", err
+		}
+		return obj.FullSerialize(), nil
 	}
 
-	obj, err := signer.Sign(payload)
+	source, err := oscal_source.OpenFromReader(srcFile, bytes.NewReader(payload))
 	if err != nil {
 		return "", err
 	}
+	if err := checkCovered(source); err != nil {
+		return "", err
+	}
+	o := source.OSCAL()
+	switch signMode {
+	case signModeDetached:
+		return signing.SignDetached(o, signers...)
+	case signModeXMLDSig:
+		if source.DocumentFormat() != constants.XmlFormat {
+			return "", fmt.Errorf("XML Signature requires XML document, %s is %s", srcFile, source.DocumentFormat())
+		}
+		signed, err := signing.SignXML(o, signers[0])
+		return string(signed), err
+	}
+	if err := signing.SignBackMatter(o, signers...); err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := o.Canonical(&b, source.DocumentFormat()); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
 
-	return obj.FullSerialize(), nil
+// checkCovered fails when the document holds content the OSCAL model does
+// not, as such content is not covered by signatures of the model
+func checkCovered(source *oscal_source.OSCALSource) error {
+	result, err := source.DroppedContent()
+	if err != nil {
+		return err
+	}
+	if len(result.Findings) == 0 {
+		return nil
+	}
+	var lines []string
+	for _, f := range result.Findings {
+		lines = append(lines, f.String())
+	}
+	return fmt.Errorf("content of the document is not covered by the signature:\n%s", strings.Join(lines, "\n"))
 }
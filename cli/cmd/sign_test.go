@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/pkg/signing"
+)
+
+const (
+	signedCatalog = `<catalog xmlns="http://csrc.nist.gov/ns/oscal/1.0" id="c"><metadata><title>Catalog</title></metadata></catalog>`
+	// unmodeled is content the OSCAL model does not hold
+	unmodeled = `<title>Catalog</title><approved-by>nobody</approved-by>`
+)
+
+// testSigner returns signer and verifier of new ECDSA key
+func testSigner(t *testing.T) (*signing.Signer, *signing.Verifier) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := signing.NewSigner(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := signing.ParsePublicKeys(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer, &signing.Verifier{Keys: keys}
+}
+
+func TestSignUnmodeledContent(t *testing.T) {
+	signer, _ := testSigner(t)
+	defer func() { signMode = signModeJWS }()
+	document := []byte(strings.Replace(signedCatalog, "<title>Catalog</title>", unmodeled, 1))
+	for _, mode := range signModes {
+		signMode = mode
+		_, err := sign([]*signing.Signer{signer}, "catalog.xml", document)
+		if mode == signModeJWS {
+			// JWS holds the document as it is
+			if err != nil {
+				t.Errorf("%s: %v", mode, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), "not covered by the signature") {
+			t.Errorf("%s: signing content the signature does not cover should fail, got %v", mode, err)
+		}
+	}
+}
+
+func TestVerifyTampered(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sign")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	signer, verifier := testSigner(t)
+	defer func() { signMode, verifySignature = signModeJWS, "" }()
+
+	for _, mode := range []string{signModeDetached, signModeBackMatter, signModeXMLDSig} {
+		signMode = mode
+		srcFile := filepath.Join(dir, mode+".xml")
+		signed, err := sign([]*signing.Signer{signer}, srcFile, []byte(signedCatalog))
+		if err != nil {
+			t.Fatalf("%s: %v", mode, err)
+		}
+		document := signed
+		if mode == signModeDetached {
+			document = signedCatalog
+			if err := ioutil.WriteFile(srcFile+".jws", []byte(signed), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := verifyFile(verifier, srcFile, []byte(document)); err != nil {
+			t.Errorf("%s: %v", mode, err)
+		}
+
+		tampered := strings.Replace(document, "<title>Catalog</title>", unmodeled, 1)
+		if tampered == document {
+			t.Fatalf("%s: title not found in signed document:\n%s", mode, document)
+		}
+		// XML Signature covers the document, other signatures the model only
+		_, err = verifyFile(verifier, srcFile, []byte(tampered))
+		if err == nil || (mode != signModeXMLDSig && !strings.Contains(err.Error(), "not covered by the signature")) {
+			t.Errorf("%s: content added to the signed document should fail the verification, got %v", mode, err)
+		}
+	}
+}
+
+func TestSignedPath(t *testing.T) {
+	defer func() { signMode = signModeJWS }()
+	for _, test := range []struct {
+		mode, srcFile, expected string
+	}{
+		{signModeJWS, filepath.Join("dir", "ssp.json"), filepath.Join("dir", "ssp-SIGNED.json")},
+		{signModeXMLDSig, filepath.Join("dir.v1", "catalog.v2.xml"), filepath.Join("dir.v1", "catalog.v2-SIGNED.xml")},
+		{signModeBackMatter, filepath.Join("dir.v1", "ssp"), filepath.Join("dir.v1", "ssp-SIGNED")},
+		{signModeDetached, filepath.Join("dir", "ssp.json"), filepath.Join("dir", "ssp.json.jws")},
+	} {
+		signMode = test.mode
+		if signed := signedPath(test.srcFile); signed != test.expected {
+			t.Errorf("%s: %s signed to %s, expected %s", test.mode, test.srcFile, signed, test.expected)
+		}
+	}
+}
+
+func TestSignedValid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sign")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	signer, _ := testSigner(t)
+	defer func() { signMode = signModeJWS }()
+	srcFile := filepath.Join("..", "..", "pkg", "html", "testdata", "catalog.xml")
+	document, err := ioutil.ReadFile(srcFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, mode := range []string{signModeDetached, signModeBackMatter, signModeXMLDSig} {
+		signMode = mode
+		signed, err := sign([]*signing.Signer{signer}, srcFile, document)
+		if err != nil {
+			t.Fatalf("%s: %v", mode, err)
+		}
+		if mode == signModeDetached {
+			// the document is left as it is
+			signed = string(document)
+		}
+		signedFile := filepath.Join(dir, mode+".xml")
+		if err := ioutil.WriteFile(signedFile, []byte(signed), 0644); err != nil {
+			t.Fatal(err)
+		}
+		result, err := validateFile(signedFile)
+		if err != nil {
+			t.Fatalf("%s: %v", mode, err)
+		}
+		if mode != signModeXMLDSig {
+			if !result.Valid() {
+				t.Errorf("%s: signed document should be valid, got %v", mode, result.Findings)
+			}
+			continue
+		}
+		// the schemas do not allow the enveloped signature
+		if len(result.Findings) != 1 || !strings.Contains(result.Findings[0].Message, "Signature") {
+			t.Errorf("%s: only the signature should be reported, got %v", mode, result.Findings)
+		}
+	}
+}
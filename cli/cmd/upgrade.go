@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/fetch"
+	"github.com/docker/oscalkit/pkg/oscal_source"
+	"github.com/docker/oscalkit/pkg/upgrade"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+var upgradeTo string
+
+var upgradeOutput string
+
+var upgradeDryRun bool
+
+// Upgrade migrates documents to newer OSCAL version
+var Upgrade = cli.Command{
+	Name:  "upgrade",
+	Usage: "upgrade document to newer OSCAL version",
+	Description: `Migrate OSCAL-formatted XML, JSON or YAML document from the OSCAL version declared in its metadata
+   to newer version, step by step. The upgraded document is written to STDOUT or to the output file.
+   With --dry-run the changes are listed without writing the document. JSON and YAML documents are in the JSON
+   form oscalkit uses for all models, with camel case keys, which is not valid against the OSCAL JSON schemas.`,
+	ArgsUsage: "[file|-]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:        "to",
+			Usage:       fmt.Sprintf("target OSCAL version (%s)", strings.Join(upgrade.Versions(), ", ")),
+			Value:       upgrade.Latest(),
+			Destination: &upgradeTo,
+		},
+		cli.StringFlag{
+			Name:        "output, o",
+			Usage:       "file to write the upgraded document to. Defaults to STDOUT",
+			Destination: &upgradeOutput,
+		},
+		cli.BoolFlag{
+			Name:        "dry-run",
+			Usage:       "print summary of the changes instead of the upgraded document",
+			Destination: &upgradeDryRun,
+		},
+	},
+	Before: func(c *cli.Context) error {
+		if c.NArg() != 1 {
+			return cli.NewExitError("oscalkit upgrade requires one argument", 1)
+		}
+		return nil
+	},
+	Action: func(c *cli.Context) error {
+		path := c.Args().First()
+		data, err := readDocument(path)
+		if err != nil {
+			return cli.NewExitError(fmt.Sprintf("Could not read %s: %v", path, err), 1)
+		}
+		d, err := upgrade.Parse(data)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		from := d.Version()
+		changes, err := upgrade.Upgrade(d, upgradeTo)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+
+		if upgradeDryRun {
+			printChanges(os.Stdout, path, from, changes)
+			return nil
+		}
+		for _, change := range changes {
+			if change.Skipped {
+				logrus.Warnf("%s: %s", change.Step, change)
+			}
+		}
+		upgraded, err := d.Bytes()
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		if upgradeOutput == "" {
+			_, err = os.Stdout.Write(upgraded)
+		} else {
+			err = ioutil.WriteFile(upgradeOutput, upgraded, 0644)
+		}
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	},
+}
+
+// readDocument reads local or remote document or STDIN
+func readDocument(path string) ([]byte, error) {
+	switch {
+	case path == oscal_source.StdinPath:
+		return ioutil.ReadAll(os.Stdin)
+	case fetch.IsRemote(path):
+		rc, err := fetch.Default.Fetch(path)
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ioutil.ReadAll(rc)
+	}
+	return ioutil.ReadFile(path)
+}
+
+// printChanges prints the changes grouped by steps
+func printChanges(w io.Writer, path, from string, changes []upgrade.Change) {
+	fmt.Fprintf(w, "Upgrading %s from %s to %s\n", path, from, upgradeTo)
+	skipped := 0
+	step := ""
+	for _, change := range changes {
+		if change.Step != step {
+			step = change.Step
+			fmt.Fprintf(w, "%s\n", step)
+		}
+		if change.Skipped {
+			skipped++
+		}
+		fmt.Fprintf(w, "  %s\n", change)
+	}
+	fmt.Fprintf(w, "%d changes, %d skipped\n", len(changes)-skipped, skipped)
+}
@@ -2,37 +2,106 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"strings"
+
+	"github.com/docker/oscalkit/cli/version"
+	"github.com/docker/oscalkit/pkg/bundled"
 	"github.com/docker/oscalkit/pkg/oscal_source"
+	"github.com/docker/oscalkit/pkg/validation"
 	"github.com/urfave/cli"
 )
 
 var schemaFile string
 
+var validateFormat string
+
+var validateSemantic bool
+
+var validateOscalVersion string
+
 // Validate ...
 var Validate = cli.Command{
 	Name:        "validate",
 	Usage:       "validate files against OSCAL XML and JSON schemas",
-	Description: `Validate OSCAL-formatted files against a specific OSCAL schema`,
-	ArgsUsage:   "[files...]",
+	Description: `Validate OSCAL-formatted files against a specific OSCAL schema. The schemas are selected by the OSCAL version in metadata of the files unless --oscal-version is given. YAML files are validated against the JSON schema. With --semantic the files are also checked with the built-in OSCAL rules, such as references to parties, roles, parameters and controls. All files are validated and the findings are reported in text, JSON, JUnit XML or SARIF format`,
+	ArgsUsage:   "[files...|-]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:        "format, f",
+			Usage:       fmt.Sprintf("report format (%s)", strings.Join(validation.Formats, ", ")),
+			Value:       validation.FormatText,
+			Destination: &validateFormat,
+		},
+		cli.BoolFlag{
+			Name:        "semantic",
+			Usage:       "check also references and other constraints not expressed by the schemas",
+			Destination: &validateSemantic,
+		},
+		cli.StringFlag{
+			Name:        "oscal-version",
+			Usage:       fmt.Sprintf("validate against schemas of the OSCAL version (%s) instead of the version in metadata", strings.Join(bundled.Versions(), ", ")),
+			Destination: &validateOscalVersion,
+		},
+	},
 	Before: func(c *cli.Context) error {
 		if c.NArg() < 1 {
 			return cli.NewExitError("oscalkit validate requires at least one argument", 1)
 		}
-		return nil
+		if _, ok := bundled.Match(validateOscalVersion); validateOscalVersion != "" && !ok {
+			return cli.NewExitError(fmt.Sprintf("Unsupported OSCAL version %s, expected one of %s", validateOscalVersion, strings.Join(bundled.Versions(), ", ")), 1)
+		}
+		for _, f := range validation.Formats {
+			if f == validateFormat {
+				return nil
+			}
+		}
+		return cli.NewExitError(fmt.Sprintf("Unsupported report format %s, expected one of %s", validateFormat, strings.Join(validation.Formats, ", ")), 1)
 	},
 	Action: func(c *cli.Context) error {
+		result := &validation.ValidationResult{}
 		for _, filePath := range c.Args() {
-			os, err := oscal_source.Open(filePath)
-			if err != nil {
-				return cli.NewExitError(fmt.Sprintf("Could not open oscal file %s: %v", filePath, err), 1)
-			}
-			defer os.Close()
-
-			err = os.Validate()
+			fileResult, err := validateFile(filePath)
 			if err != nil {
 				return cli.NewExitError(err, 1)
 			}
+			result.Merge(fileResult)
+		}
+		if err := validation.Write(os.Stdout, validateFormat, result, version.Version); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		if !result.Valid() {
+			return cli.NewExitError("", 1)
 		}
 		return nil
 	},
 }
+
+// validateFile validates single file. Files that cannot be opened are
+// reported as findings so that the remaining files are validated.
+func validateFile(filePath string) (*validation.ValidationResult, error) {
+	source, err := oscal_source.Open(filePath)
+	if err != nil {
+		result := &validation.ValidationResult{}
+		result.Add(validation.Finding{
+			File:     filePath,
+			Severity: validation.SeverityError,
+			Rule:     validation.RuleOpen,
+			Message:  fmt.Sprintf("Could not open oscal file: %v", err),
+		})
+		return result, nil
+	}
+	defer source.Close()
+	source.SchemaVersion = validateOscalVersion
+
+	result, err := source.ValidationResult()
+	if err != nil || !validateSemantic {
+		return result, err
+	}
+	semantic, err := source.SemanticResult(nil)
+	if err != nil {
+		return nil, err
+	}
+	result.Merge(semantic)
+	return result, nil
+}
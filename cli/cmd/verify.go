@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/oscal_source"
+	"github.com/docker/oscalkit/pkg/signing"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+var verifyKeys, verifyAlgs cli.StringSlice
+var verifyTrust, verifyOutput, verifySignature string
+
+// Verify verifies signatures made by oscalkit sign
+var Verify = cli.Command{
+	Name:  "verify",
+	Usage: "verify signed OSCAL artifacts",
+	Description: `Verify signature of a file signed by oscalkit sign and write the signed content. The file is either JWS
+   holding the document, or OSCAL document signed by detached JWS given by --signature or found in <file>.jws or
+   <file>.sig, by enveloped XML Signature or by JWS recorded in its back-matter. The content of detached and
+   back-matter signatures is the canonical JSON form of the document, of XML Signatures the canonicalized XML.
+   The signature is verified by the keys given by --key, each a PEM or DER encoded public key, X.509 certificate
+   chain or a JSON Web Key Set. With --trust the certificates must chain to the certificate authorities of the
+   bundle, and signatures carrying their certificate in the x5c header are verified without --key.`,
+	ArgsUsage: "signed-file",
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "key, k",
+			Usage: "public key, X.509 certificate or JWKS file trusted to verify the signature, may be repeated",
+			Value: &verifyKeys,
+		},
+		cli.StringFlag{
+			Name:        "trust",
+			Usage:       "PEM bundle of trusted certificate authorities",
+			Destination: &verifyTrust,
+		},
+		cli.StringSliceFlag{
+			Name:  "alg, a",
+			Usage: fmt.Sprintf("allowed signature algorithm, may be repeated (default %s)", strings.Join(signing.DefaultAlgorithms, ", ")),
+			Value: &verifyAlgs,
+		},
+		cli.StringFlag{
+			Name:        "signature, s",
+			Usage:       "detached signature of the document",
+			Destination: &verifySignature,
+		},
+		cli.StringFlag{
+			Name:        "output, o",
+			Usage:       "write the verified document to file instead of STDOUT",
+			Destination: &verifyOutput,
+		},
+	},
+	Before: func(c *cli.Context) error {
+		if c.NArg() != 1 {
+			return cli.NewExitError("oscalkit verify requires one argument", 1)
+		}
+		if len(verifyKeys) == 0 && verifyTrust == "" {
+			return cli.NewExitError("oscalkit verify is missing the --key or --trust flag", 1)
+		}
+		return nil
+	},
+	Action: func(c *cli.Context) error {
+		v := &signing.Verifier{Algorithms: verifyAlgs}
+		for _, path := range verifyKeys {
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return cli.NewExitError(fmt.Sprintf("Error loading key file %s: %s", path, err), 1)
+			}
+			keys, err := signing.ParsePublicKeys(data)
+			if err != nil {
+				return cli.NewExitError(fmt.Sprintf("Error parsing key file %s: %s", path, err), 1)
+			}
+			v.Keys = append(v.Keys, keys...)
+		}
+		if verifyTrust != "" {
+			data, err := ioutil.ReadFile(verifyTrust)
+			if err != nil {
+				return cli.NewExitError(fmt.Sprintf("Error loading trust bundle %s: %s", verifyTrust, err), 1)
+			}
+			if v.Roots, err = signing.ParseTrustBundle(data); err != nil {
+				return cli.NewExitError(fmt.Sprintf("Error parsing trust bundle %s: %s", verifyTrust, err), 1)
+			}
+		}
+
+		srcFile := c.Args().First()
+		signed, err := ioutil.ReadFile(srcFile)
+		if err != nil {
+			return cli.NewExitError(fmt.Sprintf("Error reading signed file %s: %s", srcFile, err), 1)
+		}
+		verified, err := verifyFile(v, srcFile, signed)
+		if err != nil {
+			return cli.NewExitError(fmt.Sprintf("%s: %s", srcFile, err), 1)
+		}
+		logrus.Infof("%s: %s signature verified by %s", srcFile, verified.Algorithm, verified.Key)
+
+		if err := writeOutput(verifyOutput, verified.Payload); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	},
+}
+
+// verifyFile verifies the file signed in any of the forms made by oscalkit sign
+func verifyFile(v *signing.Verifier, srcFile string, data []byte) (*signing.Verified, error) {
+	if verifySignature == "" && signing.IsJWS(data) {
+		return v.Verify(data)
+	}
+	source, err := oscal_source.OpenFromReader(srcFile, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	signatureFile := verifySignature
+	if signatureFile == "" {
+		for _, ext := range []string{".jws", ".sig"} {
+			if _, err := os.Stat(srcFile + ext); err == nil {
+				signatureFile = srcFile + ext
+				break
+			}
+		}
+	}
+	if signatureFile != "" {
+		jws, err := ioutil.ReadFile(signatureFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading signature file %s: %s", signatureFile, err)
+		}
+		if err := checkCovered(source); err != nil {
+			return nil, err
+		}
+		payload, err := signing.Payload(source.OSCAL())
+		if err != nil {
+			return nil, err
+		}
+		return v.VerifyDetached(jws, payload)
+	}
+
+	if source.DocumentFormat() == constants.XmlFormat {
+		if verified, err := v.VerifyXML(data); err != signing.ErrNoSignature {
+			return verified, err
+		}
+	}
+	// enveloped XML Signature covers the whole document, detached and
+	// back-matter signatures the content of the OSCAL model only
+	if err := checkCovered(source); err != nil {
+		return nil, err
+	}
+	verified, err := v.VerifyDocument(source.OSCAL())
+	if err == signing.ErrNoSignature {
+		return nil, fmt.Errorf("No signature found in the document and no detached signature %s.jws", srcFile)
+	}
+	return verified, err
+}
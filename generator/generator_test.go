@@ -3,10 +3,12 @@ package generator
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
 	"net/url"
+	"path/filepath"
 	"testing"
 
-	"github.com/docker/oscalkit/impl"
+	"github.com/docker/oscalkit/pkg/fetch"
 	"github.com/docker/oscalkit/types/oscal/catalog"
 	"github.com/docker/oscalkit/types/oscal/profile"
 )
@@ -17,6 +19,20 @@ const (
 	temporaryFilePathForCatalogsGoFile = "/tmp/catalogs.go"
 )
 
+const catalogURL = "https://raw.githubusercontent.com/usnistgov/OSCAL/master/content/nist.gov/SP800-53/rev4/NIST_SP-800-53_rev4_catalog.xml"
+
+// fakeCatalog serves testdata/catalog.xml at catalogURL from the default
+// fetcher and returns function restoring the fetcher
+func fakeCatalog(t *testing.T) func() {
+	content, err := ioutil.ReadFile(filepath.Join("testdata", "catalog.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := fetch.Default
+	fetch.Default = fetch.NewFake(map[string]string{catalogURL: string(content)})
+	return func() { fetch.Default = previous }
+}
+
 func TestIsHttp(t *testing.T) {
 
 	httpRoute := "http://localhost:3000"
@@ -50,9 +66,8 @@ func TestReadCatalog(t *testing.T) {
 		fmt.Sprintf(`
 		{
 			"catalog": {
-				"title": "%s",
-				"declarations": {
-					"href": "NIST_SP-800-53_rev4_declarations.xml"
+				"metadata": {
+					"title": "%s"
 				},
 				"groups": [
 					{
@@ -87,7 +102,7 @@ func TestReadCatalog(t *testing.T) {
 		t.Error(err)
 	}
 
-	if c.Title != catalog.Title(catalogTitle) {
+	if c.Metadata == nil || c.Metadata.Title != catalog.Title(catalogTitle) {
 		t.Error("title not equal")
 	}
 
@@ -104,13 +119,11 @@ func TestReadInvalidCatalog(t *testing.T) {
 
 func TestCreateCatalogsFromProfile(t *testing.T) {
 
-	href, _ := url.Parse("https://raw.githubusercontent.com/usnistgov/OSCAL/master/content/nist.gov/SP800-53/rev4/NIST_SP-800-53_rev4_catalog.xml")
+	defer fakeCatalog(t)()
 	p := profile.Profile{
 		Imports: []profile.Import{
 			profile.Import{
-				Href: &catalog.Href{
-					URL: href,
-				},
+				Href: catalogURL,
 				Include: &profile.Include{
 					IdSelectors: []profile.Call{
 						profile.Call{
@@ -150,13 +163,11 @@ func TestCreateCatalogsFromProfile(t *testing.T) {
 
 func TestCreateCatalogsFromProfileWithBadHref(t *testing.T) {
 
-	href, _ := url.Parse("this is a bad url")
+	defer fakeCatalog(t)()
 	p := profile.Profile{
 		Imports: []profile.Import{
 			profile.Import{
-				Href: &catalog.Href{
-					URL: href,
-				},
+				Href: "this is a bad url",
 				Include: &profile.Include{
 					IdSelectors: []profile.Call{
 						profile.Call{
@@ -178,15 +189,11 @@ func TestCreateCatalogsFromProfileWithBadHref(t *testing.T) {
 
 func TestSubControlsMapping(t *testing.T) {
 
+	defer fakeCatalog(t)()
 	profile := profile.Profile{
 		Imports: []profile.Import{
 			profile.Import{
-				Href: &catalog.Href{
-					URL: func() *url.URL {
-						url, _ := url.Parse("https://raw.githubusercontent.com/usnistgov/OSCAL/master/content/nist.gov/SP800-53/rev4/NIST_SP-800-53_rev4_catalog.xml")
-						return url
-					}(),
-				},
+				Href: catalogURL,
 				Include: &profile.Include{
 					IdSelectors: []profile.Call{
 						profile.Call{
@@ -330,24 +337,16 @@ func TestProcessAdditionWithSameClass(t *testing.T) {
 		},
 	}
 
-	o := ProcessAlterations(alters, &c)
-	for _, g := range o.Groups {
-		for _, c := range g.Controls {
-			for i := range c.Parts {
-				expected := fmt.Sprintf("%s_%d", partID, i+1)
-				if c.Parts[i].Id != expected {
-					t.Errorf("%s and %s are not identical", c.Parts[i].Id, expected)
-					return
-				}
-			}
-			for i, sc := range c.Controls {
-				expected := fmt.Sprintf("%s_%d", partID, i+1)
-				if sc.Parts[i].Id != expected {
-					t.Errorf("%s and %s are not identical", sc.Parts[i].Id, expected)
-					return
-				}
-			}
-		}
+	o, err := ProcessAlterations(alters, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctrl := o.Groups[0].Controls[0]
+	if len(ctrl.Parts) != 2 || ctrl.Parts[1].Class != class {
+		t.Errorf("part with same class not added at the end of control: %v", ctrl.Parts)
+	}
+	if len(ctrl.Controls[0].Parts) != 2 || ctrl.Controls[0].Parts[1].Class != class {
+		t.Errorf("part with same class not added at the end of sub-control: %v", ctrl.Controls[0].Parts)
 	}
 }
 
@@ -413,7 +412,10 @@ func TestProcessAdditionWithDifferentPartClass(t *testing.T) {
 			},
 		},
 	}
-	o := ProcessAlterations(alters, &c)
+	o, err := ProcessAlterations(alters, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
 	if len(o.Groups[0].Controls[0].Parts) != 2 {
 		t.Error("parts for controls not getting added properly")
 	}
@@ -429,11 +431,11 @@ func TestProcessSetParam(t *testing.T) {
 	ctrl := "ac-1"
 	shouldChange := fmt.Sprintf(`this should change. <insert param-id="%s">`, parameterID)
 	afterChange := fmt.Sprintf(`this should change. %s`, parameterVal)
-	sp := []profile.SetParam{
-		profile.SetParam{
-			Id: parameterID,
-			Constraints: []catalog.Constraint{
-				catalog.Constraint{
+	sp := []profile.SetParameter{
+		profile.SetParameter{
+			ParamId: parameterID,
+			Constraints: []profile.Constraint{
+				profile.Constraint{
 					Value: parameterVal,
 				},
 			},
@@ -445,11 +447,7 @@ func TestProcessSetParam(t *testing.T) {
 			Parts: []catalog.Part{
 				catalog.Part{
 					Prose: &catalog.Prose{
-						P: []catalog.P{
-							catalog.P{
-								Raw: shouldChange,
-							},
-						},
+						Raw: shouldChange,
 					},
 				},
 			},
@@ -462,9 +460,8 @@ func TestProcessSetParam(t *testing.T) {
 			},
 		},
 	}
-	nc := impl.NISTCatalog{}
-	ctlg = ProcessSetParam(sp, ctlg, &nc)
-	if ctlg.Groups[0].Controls[0].Parts[0].Prose.P[0].Raw != afterChange {
+	ctlg = ProcessSetParam(sp, ctlg)
+	if ctlg.Groups[0].Controls[0].Parts[0].Prose.Raw != afterChange {
 		t.Error("failed to parse set param template")
 	}
 }
@@ -475,11 +472,11 @@ func TestProcessSetParamWithUnmatchParam(t *testing.T) {
 	ctrl := "ac-1"
 	shouldChange := fmt.Sprintf(`this should change. <insert param-id="%s">`, parameterID)
 	afterChange := fmt.Sprintf(`this should change. %s`, parameterVal)
-	sp := []profile.SetParam{
-		profile.SetParam{
-			Id: "ac-1_prm_2",
-			Constraints: []catalog.Constraint{
-				catalog.Constraint{
+	sp := []profile.SetParameter{
+		profile.SetParameter{
+			ParamId: "ac-1_prm_2",
+			Constraints: []profile.Constraint{
+				profile.Constraint{
 					Value: parameterVal,
 				},
 			},
@@ -491,11 +488,7 @@ func TestProcessSetParamWithUnmatchParam(t *testing.T) {
 			Parts: []catalog.Part{
 				catalog.Part{
 					Prose: &catalog.Prose{
-						P: []catalog.P{
-							catalog.P{
-								Raw: shouldChange,
-							},
-						},
+						Raw: shouldChange,
 					},
 				},
 			},
@@ -508,9 +501,8 @@ func TestProcessSetParamWithUnmatchParam(t *testing.T) {
 			},
 		},
 	}
-	nc := impl.NISTCatalog{}
-	ctlg = ProcessSetParam(sp, ctlg, &nc)
-	if ctlg.Groups[0].Controls[0].Parts[0].Prose.P[0].Raw == afterChange {
+	ctlg = ProcessSetParam(sp, ctlg)
+	if ctlg.Groups[0].Controls[0].Parts[0].Prose.Raw == afterChange {
 		t.Error("should not change parameter with mismatching parameter id")
 	}
 }
@@ -520,3 +512,42 @@ func failTest(err error, t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestCreateCatalogsFromProfileSetParam(t *testing.T) {
+	defer fakeCatalog(t)()
+	p := profile.Profile{
+		Imports: []profile.Import{
+			profile.Import{
+				Href: catalogURL,
+				Include: &profile.Include{
+					IdSelectors: []profile.Call{
+						profile.Call{
+							ControlId: "ac-1",
+						},
+					},
+				},
+			},
+		},
+		Modify: &profile.Modify{
+			ParameterSettings: []profile.SetParameter{
+				profile.SetParameter{
+					ParamId: "ac-1_prm_1",
+					Constraints: []profile.Constraint{
+						profile.Constraint{
+							Value: "the administrators",
+						},
+					},
+				},
+			},
+		},
+	}
+	x, err := CreateCatalogsFromProfile(&p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prose := x[0].Groups[0].Controls[0].Parts[0].Prose
+	expected := "The organization disseminates the policy to the administrators."
+	if prose == nil || prose.Text() != expected {
+		t.Errorf("prose of ac-1 is %v, expected %q", prose, expected)
+	}
+}
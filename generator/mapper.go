@@ -3,11 +3,14 @@ package generator
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/docker/oscalkit/impl"
+	"github.com/docker/oscalkit/pkg/fetch"
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/oscal_source"
+	"github.com/docker/oscalkit/pkg/resolver"
 	"github.com/docker/oscalkit/types/oscal"
 	"github.com/docker/oscalkit/types/oscal/catalog"
 	"github.com/docker/oscalkit/types/oscal/profile"
@@ -43,14 +46,17 @@ func CreateCatalogsFromProfile(profileArg *profile.Profile) ([]*catalog.Catalog,
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 			// ForEach Import's Href, Fetch the Catalog JSON file
-			getCatalogForImport(ctx, profileImport, c, e, profileImport.Href)
+			getCatalogForImport(ctx, profileImport, c, e, nil)
 			select {
 			case importedCatalog := <-c:
 				// Prepare a new catalog object to merge into the final List of OutputCatalogs
 				if profileArg.Modify != nil {
-					nc := impl.NISTCatalog{}
-					importedCatalog = ProcessAlterations(alterations, importedCatalog)
-					importedCatalog = ProcessSetParam(profileArg.Modify.ParameterSettings, importedCatalog, &nc)
+					var err error
+					if importedCatalog, err = ProcessAlterations(alterations, importedCatalog); err != nil {
+						errChan <- err
+						return
+					}
+					importedCatalog = ProcessSetParam(profileArg.Modify.ParameterSettings, importedCatalog)
 				}
 				newCatalog, err := GetMappedCatalogControlsFromImport(importedCatalog, profileImport, &catalogHelper)
 				if err != nil {
@@ -110,8 +116,9 @@ func GetMappedCatalogControlsFromImport(importedCatalog *catalog.Catalog, profil
 		}
 		for _, ctrl := range group.Controls {
 			for _, call := range profileImport.Include.IdSelectors {
-				if call.ControlId == "" {
-					if strings.ToLower(ctrl.Id) == strings.ToLower(catalogHelper.GetControl(call.ControlId)) {
+				// calls of child controls, such as ac-2.1, are mapped under their parent
+				if parent := catalogHelper.GetControl(call.ControlId); !strings.EqualFold(parent, call.ControlId) {
+					if strings.ToLower(ctrl.Id) == parent {
 						ctrlExistsInGroup := false
 						sc, err := getSubControl(call, group.Controls, &impl.NISTCatalog{})
 						if err != nil {
@@ -166,43 +173,71 @@ func GetMappedCatalogControlsFromImport(importedCatalog *catalog.Catalog, profil
 	return newCatalog, nil
 }
 
-func getCatalogForImport(ctx context.Context, i profile.Import, c chan *catalog.Catalog, e chan error, basePath string) {
+func getCatalogForImport(ctx context.Context, i profile.Import, c chan *catalog.Catalog, e chan error, chain []string) {
+	// sends give up once the caller received the first result
+	fail := func(err error) {
+		select {
+		case e <- err:
+		case <-ctx.Done():
+		}
+	}
 	go func(i profile.Import) {
 		err := i.ValidateHref()
 		if err != nil {
-			e <- fmt.Errorf("href cannot be nil")
+			fail(fmt.Errorf("href cannot be nil"))
 			return
 		}
-		path, err := GetFilePath(i.Href)
+		chain, err := resolver.CheckImport(chain, i.Href, resolver.DefaultMaxDepth)
 		if err != nil {
-			e <- err
+			fail(err)
 			return
 		}
-		f, err := os.Open(path)
+		f, err := fetch.Default.Fetch(i.Href)
 		if err != nil {
-			e <- err
+			fail(err)
 			return
 		}
 		defer f.Close()
-		o, err := oscal.New(f)
+		sniffed, r, err := oscal_source.Sniff(f)
 		if err != nil {
-			e <- err
-			return
-		}
-		if o.Catalog != nil {
-			c <- o.Catalog
+			fail(err)
 			return
 		}
-		newP, err := SetBasePath(o.Profile, basePath)
-		if err != nil {
-			e <- err
-			return
+		var importedCatalog *catalog.Catalog
+		if sniffed.DocumentType == constants.CatalogDocument && sniffed.Format != constants.YamlFormat {
+			// only the selected controls are kept in memory
+			keep, err := importFilter(i)
+			if err != nil {
+				fail(err)
+				return
+			}
+			if importedCatalog, err = oscal.FilterCatalog(r, keep); err != nil {
+				fail(err)
+				return
+			}
+		} else {
+			o, err := oscal.New(r)
+			if err != nil {
+				fail(err)
+				return
+			}
+			if o.Catalog == nil {
+				newP, err := SetBasePath(o.Profile, i.Href)
+				if err != nil {
+					fail(err)
+					return
+				}
+				o.Profile = newP
+				for _, p := range o.Profile.Imports {
+					getCatalogForImport(ctx, p, c, e, chain)
+				}
+				return
+			}
+			importedCatalog = o.Catalog
 		}
-		o.Profile = newP
-		for _, p := range o.Profile.Imports {
-			go func(p profile.Import) {
-				getCatalogForImport(ctx, p, c, e, basePath)
-			}(p)
+		select {
+		case c <- importedCatalog:
+		case <-ctx.Done():
 		}
 	}(i)
 }
@@ -3,26 +3,18 @@ package generator
 import (
 	"fmt"
 	"net/url"
-	"os"
 	"path"
 	"path/filepath"
-	"sync"
 
+	"github.com/docker/oscalkit/pkg/fetch"
+	"github.com/docker/oscalkit/pkg/resolver"
 	"github.com/docker/oscalkit/types/oscal"
 	"github.com/docker/oscalkit/types/oscal/profile"
 )
 
-// HTTPFilePath map of http resource against filepath to lessen downloads
-type HTTPFilePath struct {
-	sync.Mutex
-	m map[string]string
-}
-
-var pathmap = HTTPFilePath{
-	m: make(map[string]string),
-}
-
-func findAlter(p *profile.Profile, call profile.Call) (*profile.Alter, bool, error) {
+// findAlter looks up alteration of the called control in the profile and the
+// profiles it imports. Chain holds hrefs of the profiles visited so far.
+func findAlter(p *profile.Profile, call profile.Call, chain []string) (*profile.Alter, bool, error) {
 
 	if p.Modify == nil {
 		p.Modify = &profile.Modify{
@@ -40,21 +32,11 @@ func findAlter(p *profile.Profile, call profile.Call) (*profile.Alter, bool, err
 		if err != nil {
 			return nil, false, err
 		}
-		path := imp.Href
-		if imp.IsHttpResource() {
-			pathmap.Lock()
-			if v, ok := pathmap.m[imp.Href]; !ok {
-				path, err = GetFilePath(imp.Href)
-				if err != nil {
-					return nil, false, err
-				}
-				pathmap.m[imp.Href] = path
-			} else {
-				path = v
-			}
-			pathmap.Unlock()
+		chain, err := resolver.CheckImport(chain, imp.Href, resolver.DefaultMaxDepth)
+		if err != nil {
+			return nil, false, err
 		}
-		f, err := os.Open(path)
+		f, err := fetch.Default.Fetch(imp.Href)
 		if err != nil {
 			return nil, false, err
 		}
@@ -72,7 +54,7 @@ func findAlter(p *profile.Profile, call profile.Call) (*profile.Alter, bool, err
 			return nil, false, err
 		}
 		o.Profile = p
-		alt, found, err := findAlter(o.Profile, call)
+		alt, found, err := findAlter(o.Profile, call, chain)
 		if err != nil {
 			return nil, false, err
 		}
@@ -117,7 +99,7 @@ func GetAlters(p *profile.Profile) ([]profile.Alter, error) {
 				}
 			}
 			if !found {
-				alt, found, err := findAlter(p, call)
+				alt, found, err := findAlter(p, call, nil)
 				if err != nil {
 					return nil, err
 				}
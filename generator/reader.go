@@ -3,22 +3,14 @@ package generator
 import (
 	"fmt"
 	"io"
-	"io/ioutil"
-	"net/http"
 	"net/url"
-	"os"
 	"path/filepath"
 	"strings"
-	"time"
 
+	"github.com/docker/oscalkit/pkg/fetch"
 	"github.com/docker/oscalkit/types/oscal"
 	"github.com/docker/oscalkit/types/oscal/catalog"
 	"github.com/docker/oscalkit/types/oscal/profile"
-	"github.com/sirupsen/logrus"
-)
-
-const (
-	tempDir = "/tmp/"
 )
 
 // ReadCatalog ReadCatalog
@@ -45,36 +37,13 @@ func ReadProfile(o *oscal.OSCAL) (*profile.Profile, error) {
 	return o.Profile, nil
 }
 
-// GetFilePath GetFilePath
+// GetFilePath returns local path of the document referenced by URL. Remote
+// documents are fetched with the default fetcher.
 func GetFilePath(URL string) (string, error) {
-	t := time.Now()
-	uri, err := url.Parse(URL)
-	if err != nil {
+	if _, err := url.Parse(URL); err != nil {
 		return "", fmt.Errorf("invalid URL pattern %v", err)
 	}
-
-	if !isHTTPResource(uri) {
-		return GetAbsolutePath(URL)
-	}
-	logrus.Debugf("fetching from http resource %s", uri.String())
-	body, err := fetchFromHTTPResource(uri)
-	if err != nil {
-		return "", fmt.Errorf("cannot fetch from url %v", err)
-	}
-
-	fileName := tempDir + getName(uri)
-	f, err := os.Create(fileName)
-	if err != nil {
-		return "", fmt.Errorf("cannot create json file %v", err)
-	}
-	defer f.Close()
-	_, err = f.Write(body)
-	if err != nil {
-		return "", fmt.Errorf("cannot write on file %v", err)
-	}
-	logrus.Debugf("file downloaded in %f seconds.", time.Since(t).Seconds())
-	return fileName, nil
-
+	return fetch.Locate(fetch.Default, URL)
 }
 
 // GetAbsolutePath gets absolute file path
@@ -88,23 +57,3 @@ func GetAbsolutePath(path string) (string, error) {
 func isHTTPResource(url *url.URL) bool {
 	return strings.Contains(url.Scheme, "http")
 }
-
-func getName(url *url.URL) string {
-	fragments := strings.Split(url.Path, "/")
-	return (fragments[len(fragments)-1])
-}
-
-func fetchFromHTTPResource(uri *url.URL) ([]byte, error) {
-	c := http.Client{Timeout: 10 * time.Second}
-	resp, err := c.Get(uri.String())
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("cannot read response body %v", err)
-	}
-	return body, nil
-
-}
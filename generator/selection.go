@@ -0,0 +1,55 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/docker/oscalkit/types/oscal/catalog"
+	"github.com/docker/oscalkit/types/oscal/profile"
+)
+
+// importFilter returns predicate accepting top-level catalog controls that are,
+// or contain controls that are, selected by the profile import. It allows
+// catalogs to be streamed without keeping unselected controls in memory.
+func importFilter(i profile.Import) (func(c *catalog.Control) bool, error) {
+	all := func(c *catalog.Control) bool { return true }
+	if i.Include == nil || i.Include.All != nil {
+		return all, nil
+	}
+
+	ids := map[string]bool{}
+	for _, call := range i.Include.IdSelectors {
+		if call.ControlId == "" {
+			// unknown selection, do not filter
+			return all, nil
+		}
+		ids[call.ControlId] = true
+	}
+	var patterns []*regexp.Regexp
+	for _, match := range i.Include.PatternSelectors {
+		pattern, err := regexp.Compile(match.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid match pattern %s in import %s: %v", match.Pattern, i.Href, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+
+	var selected func(c *catalog.Control) bool
+	selected = func(c *catalog.Control) bool {
+		if ids[c.Id] {
+			return true
+		}
+		for _, pattern := range patterns {
+			if pattern.MatchString(c.Id) {
+				return true
+			}
+		}
+		for i := range c.Controls {
+			if selected(&c.Controls[i]) {
+				return true
+			}
+		}
+		return false
+	}
+	return selected, nil
+}
@@ -340,6 +340,97 @@ type Model struct {
 	Choice   []Choice   `xml:"choice"`
 	Prose    *struct{}  `xml:"prose"`
 	Any      *struct{}  `xml:"any"`
+
+	// order holds names of the assembly, field and choice elements in the
+	// order of the metaschema
+	order []string
+}
+
+// Member is an assembly or field of a model
+type Member interface {
+	GoComment() string
+	GoMemLayout() string
+	GoName() string
+	JsonName() string
+	XmlName() string
+}
+
+func (m *Model) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return decodeMembers(d, &m.order, func(el *xml.StartElement) error {
+		switch el.Name.Local {
+		case "assembly":
+			var a Assembly
+			if err := d.DecodeElement(&a, el); err != nil {
+				return err
+			}
+			m.Assembly = append(m.Assembly, a)
+		case "field":
+			var f Field
+			if err := d.DecodeElement(&f, el); err != nil {
+				return err
+			}
+			m.Field = append(m.Field, f)
+		case "choice":
+			var c Choice
+			if err := d.DecodeElement(&c, el); err != nil {
+				return err
+			}
+			m.Choice = append(m.Choice, c)
+		case "prose":
+			m.Prose = &struct{}{}
+			return d.Skip()
+		case "any":
+			m.Any = &struct{}{}
+			return d.Skip()
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// Members returns assemblies and fields of the model and its choices in the
+// order of the metaschema, which is the order of the XML elements
+func (m *Model) Members() []Member {
+	var members []Member
+	var a, f, c int
+	for _, name := range m.order {
+		switch name {
+		case "assembly":
+			members = append(members, &m.Assembly[a])
+			a++
+		case "field":
+			members = append(members, &m.Field[f])
+			f++
+		case "choice":
+			members = append(members, m.Choice[c].Members()...)
+			c++
+		}
+	}
+	return members
+}
+
+// decodeMembers decodes children of the element by decode and records names
+// of the assembly, field and choice children in order
+func decodeMembers(d *xml.Decoder, order *[]string, decode func(el *xml.StartElement) error) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if err := decode(&t); err != nil {
+				return err
+			}
+			switch t.Name.Local {
+			case "assembly", "field", "choice":
+				*order = append(*order, t.Name.Local)
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
 }
 
 type Assembly struct {
@@ -519,6 +610,50 @@ func (f *Flag) XmlName() string {
 type Choice struct {
 	Field    []Field    `xml:"field"`
 	Assembly []Assembly `xml:"assembly"`
+
+	// order holds names of the assembly and field elements in the order of
+	// the metaschema
+	order []string
+}
+
+func (c *Choice) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return decodeMembers(d, &c.order, func(el *xml.StartElement) error {
+		switch el.Name.Local {
+		case "assembly":
+			var a Assembly
+			if err := d.DecodeElement(&a, el); err != nil {
+				return err
+			}
+			c.Assembly = append(c.Assembly, a)
+		case "field":
+			var f Field
+			if err := d.DecodeElement(&f, el); err != nil {
+				return err
+			}
+			c.Field = append(c.Field, f)
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+}
+
+// Members returns assemblies and fields of the choice in the order of the
+// metaschema
+func (c *Choice) Members() []Member {
+	var members []Member
+	var a, f int
+	for _, name := range c.order {
+		switch name {
+		case "assembly":
+			members = append(members, &c.Assembly[a])
+			a++
+		case "field":
+			members = append(members, &c.Field[f])
+			f++
+		}
+	}
+	return members
 }
 
 type GroupAs struct {
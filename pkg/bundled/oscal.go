@@ -2,57 +2,137 @@ package bundled
 
 import (
 	"fmt"
-	"github.com/docker/oscalkit/pkg/oscal/constants"
-	"github.com/markbates/pkger"
-	"github.com/markbates/pkger/pkging"
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/markbates/pkger"
 )
 
-var schemaPaths = map[constants.DocumentFormat]map[constants.DocumentType]string{
+// schemaFiles are paths of the schemas relative to root of the schema set
+var schemaFiles = map[constants.DocumentFormat]map[constants.DocumentType]string{
 	constants.XmlFormat: {
-		constants.CatalogDocument:   "/OSCAL/xml/schema/oscal_catalog_schema.xsd",
-		constants.ProfileDocument:   "/OSCAL/xml/schema/oscal_profile_schema.xsd",
-		constants.SSPDocument:       "/OSCAL/xml/schema/oscal_ssp_schema.xsd",
-		constants.ComponentDocument: "/OSCAL/xml/schema/oscal_component_schema.xsd",
+		constants.CatalogDocument:   "xml/schema/oscal_catalog_schema.xsd",
+		constants.ProfileDocument:   "xml/schema/oscal_profile_schema.xsd",
+		constants.SSPDocument:       "xml/schema/oscal_ssp_schema.xsd",
+		constants.ComponentDocument: "xml/schema/oscal_component_schema.xsd",
 	},
 	constants.JsonFormat: {
-		constants.CatalogDocument:   "/OSCAL/json/schema/oscal_catalog_schema.json",
-		constants.ProfileDocument:   "/OSCAL/json/schema/oscal_profile_schema.json",
-		constants.SSPDocument:       "/OSCAL/json/schema/oscal_ssp_schema.json",
-		constants.ComponentDocument: "/OSCAL/json/schema/oscal_component_schema.json",
+		constants.CatalogDocument:   "json/schema/oscal_catalog_schema.json",
+		constants.ProfileDocument:   "json/schema/oscal_profile_schema.json",
+		constants.SSPDocument:       "json/schema/oscal_ssp_schema.json",
+		constants.ComponentDocument: "json/schema/oscal_component_schema.json",
 	},
 }
 
+// schemaSet is a set of OSCAL schemas of single OSCAL version, laid out as
+// in the OSCAL repository
+type schemaSet struct {
+	// root of the schemas bundled with pkger
+	root string
+	// dir of the schemas registered from the file system
+	dir string
+}
+
+func (s schemaSet) open(name string) (io.ReadCloser, error) {
+	if s.dir != "" {
+		return os.Open(filepath.Join(s.dir, filepath.FromSlash(name)))
+	}
+	return pkger.Open(s.root + "/" + name)
+}
+
+// schemaSets are indexed by OSCAL version. The latest version is bundled
+// from checkout of the OSCAL repository, the upgrade targets from schemas.
+// New version is bundled by adding its schemas here and to noop and
+// regenerating pkged.go
+var schemaSets = map[string]schemaSet{
+	constants.LatestOscalVersion: {root: "/OSCAL"},
+	"1.0.0-milestone3":           {root: "/pkg/bundled/schemas/1.0.0-milestone3"},
+	"1.0.0-rc1":                  {root: "/pkg/bundled/schemas/1.0.0-rc1"},
+}
+
 func noop() {
 	// Hint pkger tool to bundle these files
 	pkger.Include("/OSCAL/xml/schema/")
 	pkger.Include("/OSCAL/json/schema/")
+	pkger.Include("/pkg/bundled/schemas/")
+}
+
+// RegisterDir adds schema set of the OSCAL version from directory of the
+// file system. The directory has the layout of the OSCAL repository, such as
+// xml/schema/oscal_catalog_schema.xsd.
+func RegisterDir(version, dir string) error {
+	if _, err := os.Stat(filepath.Join(dir, "xml", "schema")); err != nil {
+		return fmt.Errorf("Cannot find schemas of OSCAL version %s: %v", version, err)
+	}
+	schemaSets[version] = schemaSet{dir: dir}
+	return nil
+}
+
+// Versions returns OSCAL versions of the available schema sets in ascending
+// order
+func Versions() []string {
+	var versions []string
+	for v := range schemaSets {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return compareVersions(versions[i], versions[j]) < 0 })
+	return versions
+}
+
+// Match returns version of the schema set matching OSCAL version claimed by
+// document. Pre-releases, such as 1.0.0-milestone2 or 1.0.0-rc1, match
+// exactly, releases match the latest release of the same minor version.
+func Match(claimed string) (string, bool) {
+	claimed = strings.TrimPrefix(strings.TrimSpace(claimed), "v")
+	if _, ok := schemaSets[claimed]; ok {
+		return claimed, true
+	}
+	c, ok := parseVersion(claimed)
+	if !ok || c.pre != "" {
+		return "", false
+	}
+	match := ""
+	for _, v := range Versions() {
+		if r, _ := parseVersion(v); r.pre == "" && r.major == c.major && r.minor == c.minor {
+			match = v
+		}
+	}
+	return match, match != ""
 }
 
 type BundledFile struct {
 	Path string
 }
 
+// Schema returns schema of the latest OSCAL version
 func Schema(fileFormat constants.DocumentFormat, oscalComponent constants.DocumentType) (*BundledFile, error) {
-	schemas, ok := schemaPaths[fileFormat]
+	return VersionSchema(constants.LatestOscalVersion, fileFormat, oscalComponent)
+}
+
+// VersionSchema returns schema of the OSCAL version
+func VersionSchema(version string, fileFormat constants.DocumentFormat, oscalComponent constants.DocumentType) (*BundledFile, error) {
+	set, ok := schemaSets[version]
 	if !ok {
-		fmt.Errorf("Cannot find schema for FileFormat %i", fileFormat)
+		return nil, fmt.Errorf("No schemas available for OSCAL version %s, expected one of %s", version, strings.Join(Versions(), ", "))
+	}
+	schemas, ok := schemaFiles[fileFormat]
+	if !ok {
+		return nil, fmt.Errorf("Cannot find schema for format %s", fileFormat)
 	}
 	schemaPath, ok := schemas[oscalComponent]
 	if !ok {
-		fmt.Errorf("Cannot find schema for document type %i", fileFormat)
+		return nil, fmt.Errorf("Cannot find schema for document type %s", oscalComponent)
 	}
-
-	return localBundledFile(pkger.Open(schemaPath))
-}
-
-func HtmlXslt() (*BundledFile, error) {
-	return localBundledFile(pkger.Open("/OSCAL/src/utils/util/publish/XSLT/oscal-browser-display.xsl"))
+	return localBundledFile(set.open(schemaPath))
 }
 
-func localBundledFile(in pkging.File, err error) (*BundledFile, error) {
+func localBundledFile(in io.ReadCloser, err error) (*BundledFile, error) {
 	if err != nil {
 		return nil, err
 	}
@@ -74,3 +154,59 @@ func localBundledFile(in pkging.File, err error) (*BundledFile, error) {
 func (f *BundledFile) Cleanup() {
 	os.Remove(f.Path)
 }
+
+type version struct {
+	major, minor, patch int
+	pre                 string
+}
+
+// parseVersion parses OSCAL versions such as 1.0.2 or 1.0.0-milestone2
+func parseVersion(s string) (version, bool) {
+	var v version
+	if i := strings.Index(s, "-"); i >= 0 {
+		s, v.pre = s[:i], s[i+1:]
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return v, false
+	}
+	numbers := []*int{&v.major, &v.minor, &v.patch}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return v, false
+		}
+		*numbers[i] = n
+	}
+	return v, true
+}
+
+// compareVersions orders versions, pre-releases precede the release.
+// Unparseable versions are ordered by name before the others.
+func compareVersions(a, b string) int {
+	va, okA := parseVersion(a)
+	vb, okB := parseVersion(b)
+	switch {
+	case !okA || !okB:
+		if okA != okB {
+			if okA {
+				return 1
+			}
+			return -1
+		}
+		return strings.Compare(a, b)
+	case va.major != vb.major:
+		return va.major - vb.major
+	case va.minor != vb.minor:
+		return va.minor - vb.minor
+	case va.patch != vb.patch:
+		return va.patch - vb.patch
+	case va.pre == vb.pre:
+		return 0
+	case va.pre == "":
+		return 1
+	case vb.pre == "":
+		return -1
+	}
+	return strings.Compare(va.pre, vb.pre)
+}
@@ -0,0 +1,104 @@
+package bundled
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+)
+
+// registerTestSet registers schema set of the versions from temporary
+// directory with catalog XML schema only
+func registerTestSet(t *testing.T, versions ...string) func() {
+	dir, err := ioutil.TempDir("", "bundled")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "xml", "schema"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "xml", "schema", "oscal_catalog_schema.xsd"), []byte("<xs:schema/>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range versions {
+		if err := RegisterDir(v, dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return func() {
+		for _, v := range versions {
+			delete(schemaSets, v)
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	cleanup := registerTestSet(t, "1.0.0", "1.0.2", "1.1.0")
+	defer cleanup()
+
+	expected := []string{"1.0.0-milestone2", "1.0.0-milestone3", "1.0.0-rc1", "1.0.0", "1.0.2", "1.1.0"}
+	if v := Versions(); !reflect.DeepEqual(v, expected) {
+		t.Errorf("unexpected versions %v", v)
+	}
+	cases := map[string]string{
+		"1.0.0-milestone2": "1.0.0-milestone2",
+		"1.0.0-milestone3": "1.0.0-milestone3",
+		"1.0.0-rc1":        "1.0.0-rc1",
+		"1.0.0-rc2":        "",
+		"1.0.0":            "1.0.0",
+		"v1.0.0":           "1.0.0",
+		"1.0.4":            "1.0.2",
+		"1.1.3":            "1.1.0",
+		"2.0.0":            "",
+		"":                 "",
+	}
+	for claimed, expected := range cases {
+		if v, ok := Match(claimed); v != expected || ok != (expected != "") {
+			t.Errorf("%q matched %q, expected %q", claimed, v, expected)
+		}
+	}
+}
+
+func TestVersionSchema(t *testing.T) {
+	cleanup := registerTestSet(t, "1.0.0")
+	defer cleanup()
+
+	schema, err := VersionSchema("1.0.0", constants.XmlFormat, constants.CatalogDocument)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer schema.Cleanup()
+	if content, err := ioutil.ReadFile(schema.Path); err != nil || string(content) != "<xs:schema/>" {
+		t.Errorf("unexpected schema %q: %v", content, err)
+	}
+
+	if _, err := VersionSchema("0.9.0", constants.XmlFormat, constants.CatalogDocument); err == nil {
+		t.Error("expected error for unknown version")
+	}
+	if err := RegisterDir("2.0.0", filepath.Join("testdata", "missing")); err == nil {
+		t.Error("expected error for missing directory")
+	}
+}
+
+func TestBundledSchemas(t *testing.T) {
+	for _, version := range []string{"1.0.0-milestone2", "1.0.0-milestone3", "1.0.0-rc1"} {
+		for format, schemas := range schemaFiles {
+			for document := range schemas {
+				schema, err := VersionSchema(version, format, document)
+				if err != nil {
+					t.Errorf("%s %s %s: %v", version, format, document, err)
+					continue
+				}
+				content, err := ioutil.ReadFile(schema.Path)
+				schema.Cleanup()
+				if err != nil || len(content) == 0 {
+					t.Errorf("%s %s %s: empty schema: %v", version, format, document, err)
+				}
+			}
+		}
+	}
+}
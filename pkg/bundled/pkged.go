@@ -5,4 +5,4 @@ import (
 	"github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecbd5973da5a1636fc57dee2f6759f68304970d57b61614b20837200a3a9abeb94b64424600bf48551749dfffed51e240468c498d87db848c580863daebd86673debbfb5f1ece77c517bf86f0dfd7b1affaa3dd4be78737ff4650157536b319efeeb27d7f8e2584bebcbaf91e359cb2fee78f9c55b812fcedc9e8e7e7d992f6c0b4ec7cbda5daded07f35fcb3fada5577ba8d5ee6a8ae58f6a0fb5c4154f739bfcf46afd72474bf2777f3ea77f75ada5edd51e662b08ef6a83a50547b5879f165c8ce8a7fec85acc67e45a692e8ee168115d4dde1d7f7c1a05f1dfafa3c5f2e86af4d5d11dddb9b342effb6f8d76c01d2fbd15f8c39efb295ded5ae359ed61f96b35ba7beba849f3eedc39fb015fdcf91ffedcc1cf5147bf16633c3eec1f2c5ffbfbefbfef6a3f49b7ff9bd39d872f3f06cdc7ce97c9623efbb2b0bd916fa11bd0ca40ff3ba3a53586f8193332a1f49abbda62bc1bd51eee99c6d7bb9a3f7746b5078ebdff76fffd9ead7fc3dffcb51ce33b388663fec570ff62bebfb2df1e98af0f2cf3c75796fff695ab73dcff65d80786a9ddd5c68bbf1c3496645817217ee7d3685d7bf87affed1b77576bcfe6b5870653e7be36bedfd514389e4d6b0f1c9ebb51ed81fdfabdc1dfd58663a7f6c0320c735793f67fea7ffd15580e537b60ee6a7d073d93b9ab0d12ed17e07471f0716e4f17b587ef77b5c7e5d8472d198cecda035bffceb2dfbe33dfd8bb9ab240df7ce5f9aff5ef6cfddbdf77b5eee9a5df1adf98f8d2a8cb7fdfd59ae52fd5fffa6b355b2d464eede1dfcc1d73c7fc074fad37fa75e94d9bbbe6e976f6d1d24fdbca294d70e75fc078967c08ddec6737978a887fe735f53fb1f0202be950762cd0a7ffe38c82d1cc19cdecf0e1fffc5acdf03a4d08957fd782a93b72fe70e7e869a9c2e5dfb5e8befffc9365cc5d2dc07df96fedcfa95b7209a5089cbfef6aa825d14805d6afd16cb97feafe5efcca4ac28cfcf6976d2d2d3877ff225ffe31c1ab214fcc65df164bbe6f8def5c24faeef9af25641e877635cb9fc83c7acc650bbdfb7ae3fb5ee8b191d0e3798eb93f47e891a667483df4778ad84b0aa86f4ce3db579effc697117bb4d765c4defed2f7137b29eb2f537b897fbfe92e9f50aee4ecfd58e2d49ae34777143e8ee59632b12475690cdaee8ff1e3d86af519fb69beee70c10e70f74b9b53e6a6c67a1dbf119a61636afba2ef34998d12367606e741a03d4fdae3cd4bbbf9e8b69beda9a5f5c6eda7b9dbf63dc6693d7eed848d89cdcb938e5f0f6cbeb732b906d7e1eabb0e87ee17179dddf3aadb64a367adac99b206e3f602b5afdd7c1ccb92b2061a0bc18c3e77da78ed3162b7fdacacc1ac1f026ee3f6389131b4edda0c0509f0f2d2d07b5fdbcfc1eb2b73ef0e69fbf76d9499912ec0f6f8f16bbb29af0d3f8006df1b771ee72efeddef43d3872b4bef0780abeff075f437345eb6248686c6e0b6d036baedd6c26dcffa81236da3e7f60c5df680a632a6dede3f9bbc7f6aeacac4f6e1c69120ea2b1ef7217e2e0b1d490ded50d8d87e83b3b43e6c4bcf2ed0d48925892b4b57966dc95cdb6381b1a4a16bb6e0cad0d8c00805cfe695c0e4ea4b53ab336da9e1b75ba6075a2a7c697583b60457e83906d758392d790d9af7476d4accdbb8bb3625d5b7b47a80db37ebaea33e1ff5237f1cc935a81f1b5397194b530fc6321e371f4e4fbea763ed489001d290ce3b9ca2be599a199c8e29592ba6a4ee0c5e0eec169abb7b721f5457962eb2e640084c495d3912f42d4d0dc918f5e7e6409800aece181a5c595a7dd6961a63cb57274e335e83597dde392d3900be3d6eb798e36bc706b7f56cbe7bb84ef03fe11b7a8ea5f71726dd733d7a6de93e4de1ca94e0c4406bd4b75d47f260bbd5afdbd2d0057cdb25ef5602d3a7d770f72ee00c729d84f78d87e617dd0b38f9ff333585d9ef8fc4bf197e2679e74c616c1faeccb0edfe1c1c5f8bd6b40081dfdf75b4c6aadc7cf6a12da92bb3d57551bfcaf75d5c01be3f37f5b66b27f60c5ae38edff885e7b0d59f5b3a7a6e1dbeb15f0b43932168e2793afc0daddd713f34352373df00491c9bda26757d1cf6ad7eba37e898195abdfeca1929ebe8d16d8b8bd3ef9a915c97a149654b3b6c4c4dcd0c800f194b6bacecb0c1da9c3843ebefb4cff85fca7764dd26d6bf67cf646f3448db27a48f96de87404fdb03c967b6a7b6affae499ed49873b94bf1d5edd199a03db63a191b246a8cc660330858ca9b1641c9f50bf04277d5f365636df0fd17e775a99fbd30333f8271dbf93b1d0c2acf129e80b87f61e5a6f6280cfb3d3f6e17f5973eab4e0269219862e87863e4ddb37744c12e391714d89758264239273485e30979a039357035312570637bcf2f83b2c3a538104579f62ed976b2f92534b4babcb8ea42eed108f59d86e31ae3e4899af19d10bb2e44dc17ae00c6dcba6b745de99da16eb00e9735ab4cf1508247ceea6ca614babffa27d3b95c3beb81a0d1b7efa5a1740eafa2f25bfe1ca4a3d173eda3ad906c05f64ca13f41ea0c1d520f1aeee5868b44506edd1a3352287a62eb2962e43f3e4dc1340bb2907e87bfd704cc686d69fd2f678401442c00bd09ef50393becff4c585cd0d0fc7d2df7a86af2e0ee7ed543f1a1e9cf3d13d87ef4fd7159e5d1b5dcfa973c06da7486730fd4648746ab8b33974ee0b744d6f5c4bbb774d09eed03da341351d393aeb7374e40c7d518ecfe2e4589fe84b50f06c5f5c9a7a1fda63e1d886389c77bf0f6d4e092d5dc0fb96be5f067e3f30389131f5ae6b68f5695b52ef515f2dadbea3baf0c6d1e50de015d896c495d91458346748773406c226dba6c91f1b47573c931bdeec870af683cdab4ba089e1689021f33275d8cafb79e768ecd2d0e57a7b2c3847f77aa6d427f3e88b8b614b5edb921a3a128444160bbea16d77e6e13b0f75ac66c1ba9e1eea44656ce5de40f0b19daf6db0bd6369f5f8b3a97b1bdb57d1de5e83b1b036c782476c9ef81df81e475277277334ee07748c4ae84287f227a99b65d85463fb64fc0ee602cb3d5347f79559cf877d4aed4fc9317c91d47b5353584712c7401a06582eb464886cc8c87634c83acfea3fd52daad88bc806dd64dac719fbe4f85963db57b78e0643533bd53bb410ef69b43717975adb447755913e028bceadfec1b525cf2bd40e6ee8da7cdf43fa1cf6e7b4fa7343efe1df1c1f4eb1fc1e08a1a951df988faec5ebdd276bdb83ed968ada4ed67f2b7976543bd7cc9c3e5c68cd2319bf3b3effcaea6874bc52d7c6593a49aa7e7c3ab7aa2f2e1c6de81ec9baeae7f04040e7dbd2d2959da5c90b7310e923642e8fce5d2cbbe2316d1ddb7f05e73069f3393a4aba6e7feaf77c25d791355dcaef89e40c87cf55a48b21593d071c9c9803c1035a037f075a7d68fb75b4c6393477957c979cba485b97175abb73c0dbe89c5ee59cd3c9f1793274796a69750f489049b70bd2c749e53c08c6c22e1e5fdf5e203d762fffb08fdcb57975623505cf0e8535f0893f10688dd04c7f57797fa6b6654c5d5e197a3f70fc145dec7dfd55134b6a0406d9e71fde6752d4d68af6d8993a98b7b634a5508ef5e2ebca9d4f5486b8d1bec2724d129780abcfda2d053abe1a229d82aec3f5debf79f87c59a27a7bba9f8cca80a3355b46bf98099e3d2b94533d43974ff67bb60d29a2f1a67d45f6a3b2063ada5feaca6926754c128fc2321add534dbfdca4b5e942722a38d5fd53c7453eb071d274bcf4334d053edca27581fd55e88cf521b253d1d8e058158e3d4922ea2f92e919f6de699fd1d98aedaa34dfa35ff78096e9433c58e7af48ffa9206f07513f6630116311587b2660fd8cd8cf70e548eaca94d46942dfc2fd4d9cd10bac7f51bb238a5d4473d939b6dd2bca65302b1763c2fdd7958d71bad7f3e655684b688cd59d2d89e84cde3a9ab8b034b31ec559cc81b0323416beb51fffb07859b23f9575ded80e4cf31be4ca82f7f597e277a7fa6ff1bf8fe32fe5912df078e6f97c329797b25f4ee7129d21d78e51f1f4fcfcf87a163d2f2f1393c2befe6cf9f34ef1a87cbffd071a6b1c7f3973ac2bfb6c0039ab2f136398a1eb8af5e021dd87256d56e2a391d4106890b14361e9685bc6d20564bf637bdde465cf96bc5da7292c2c5d61ec70e312ff9aba6b4b2a6384153138e9ed2b63bb2f4dbdef9992c818c53106d6f0b7d83741745d163a923835f4be574a578668accdc0c47d842bd36f84408b9e258e01af32719c41931796a6447e2b0ff83dd7d04dcfd2b69ee1c385a5f7ebd93ef6fedae0896fec44efc8d7b19363916d5bf8193efcd9e99a4bfe7e3c9ed563a0912e9e16b7257363735e8a1fbfec59a32239b7b390be9aaa4f233da8b7045a638ae32429fbb3a45c3b7d4e8acc45b622f1d39fa90725ee4f3997d736a778a0c972a62eefac1c5c5281ec4b794edafcc8d0f121febda37981cdf7d7f66c7ae5b37bdf864c599da5db1dac7f31b47db19eaae392b10d125883f46bcaac475fe52c5d21f2e332bad814700a0b346497b38179757c4f7f6d842c963f1f5e072e6e6b455c49be6c7c679d35579fff407a148e67df74d60fafb39eda627e6371fd752daf33ecfc8f36d621e032f496b3d67583fa61ae8e8b5d002ed2493f830cdfae635dfd52eb9cf8baafef3bc2be8ee96758ebd8ef30ba181e5386362facc14c090c7f0b3bbae0159ca1257053741f6970751a2f7adca65d5b645b9f8c3f590b0bf09cb516b2f761c57ec46d2c9241a6ae6cd0be207174b6789e72d600f01ba9389c9c3e1ce154527d1f048fa5b19eed2f33655b613fe3fb85c6c97e41eb495703db574f71716232de9392eba02b4c4763d72689ff4c53ae49d84065ecad0be1c97c716285ecd2d0fb187b5810db6b1a9af2ab2db19ed3ea43bb1c3652c07e7e29c62e10cc130799f81c227848b4c6d68e2e4f4c8cbf31a1edab2b83537715fd1063d4c68e96d1c60bf9226c5fdd015e0d51fbae7c8e26c6e9139ca3bc0c6daec1dabe72ae0e7321ec51d27e2ff057ee6d677720c19d2335c2d14010c1ac1f9e8c57c69a07630192eb8500609c988264078e7b626c257a2e896f87a66e06d8d732ebba8ef43d3536664a8d894371289676ef22fb1ec90273204cac96bc063ed2af9cc0e0c4455b6a84a6a486eda61774fce1aad31496805798764b860687e3ea1bec1fd01aac1d26de3f167c4b97774e737a2c83f2310507be91f7c16ebe5f4c2fe9b73bebcc20fe565d9967f9e28024ee9c26bb041c89dd9feb634c7b4eca19f8569f5cbe2fcea7b9c5ba09ed9912fba7abfb31539e738bb99e29632f1a73bde503bd5b3ed095f5555f9c9a145b6568dbde49be87647aa0a5c0a39816f5ede49f91af64becae97fa80fbe1a9a5a1de9760cc058546c5fe3b30afd0eb40683f5bebd6e5345e7a36d7e1f1c6abc1e8af2067caf4e9ea5de3bcda40e7b1c5b24efcec2c491bd108dd33e1e47f071c9cf6668680ef9bbe97906df670d6db3684bf5355a83510e07ea23d6732575fa5201ab9ada661a77a4d7b2b63f5da2f1317519822c1d6f9693e77d685f0c4c6deba5e65d9176a6c68cfb741c0d5f8d72ad487f708c538640227e1c9bf302bbb9e77f309bc2783420983232e6f7ae2a79811d0a1343bb770d7fe8a2ff2dad3e25f1d446323709af3999efba764b0d6da9b1334ff2da4ed7613ee68e85a604bd8ebeb7510ab1679a3ab5b46797bee32d63b634b57e601c8cddbe9fa6d673f7364ffa7a2eddcf591f8e5a59f1cfcbe5a7907d783226638bcad40b61203263c7851800b11f004ddd3992b8b4cbe5370c313789243218c3eff75c47824b13af61b83b900d2dc7b34321a99b56d4e90ffa55062b7cd47e736dfbac4773d4c6a4cd2c6369ec091eda9644c691d4305a9bfad89d77278f9b6e6b132a4f8f9b9f4fedfbee93f0fde5e9b9fef34906dd2653d707ee46dbf5ee757d0bba931ef7436441f7897e7eedb2ca0e3adaaecde98369f0326036dd3113fe18c0ef2f4d77de7d85dfbb70b1e9bc42e74525ff8f7672835e37ef8a8b2dfaeee79348dea56f426df7b8fc2132c18bbe99bf34dded8fe83b9509c8ffecb7ee8c093ab85dcf3be5d5bed7f5cdb6dbda6cbbe3e9f2e54900dd017a166a9fecd07bbe77d9c5a6ab967d6e8fc3f7bc3e47efc1cfd076cfa14efbf1f3a9bb7919c0e10b19a71d7edf93e844cffc31f6d0f76c74fdcbeb7c8e7e5354968eeb74ae8b9b15bdfed7cbebf7b936db8297907512edf99a6c9b7cac2317611d4ee57ed7d015a62d25fc63adca7be3d9d0fb516e2d5967488649b82d6ed49668ef55f42915f9ef3264df11aee7f0ec9ca2f676d07e3bcd1bda107f9c1cf56df4d274c32ed90b0cda0bdd898bf6c7e15a7e1280f2e4393fc545a83c19f7688ee9e76d7737e47fa0399e98cecb601a745e1fc3ce6bbb5eb8eed07589b5d68dd7195e5ff7687ffd6cbaf3c3bd09e9be59843f0774ede33de9393f9f9e373f9f9ec39741629fa1f64136da6b3b6df7b82dfb5ce5c9a8e37d36c8d963bbc7e045edcdc93829e87d9bee7ebd7f7d11d1f76af61e1978b6deacd3eb17c19fa167fd1417bf3ad133507b5e8364db8e314099789c14ee9e840cdfdbc665b0732ac65ec7f72470d6499d11fb952098553d0f32db5206275780174ad16b58e5a937dcb889712b2303fe1c328af0da149680eb43d014e2f755dcef29ed2db3df8ff259a5c6a4a31de7d0a7e4b2267c7b83831c8fb2fef5e4fc1ee4e2bbb6ae7a209259925c47f2f1f81ad2978ab9ab71dfdec7df17afa9149b2b1d1f29b286b625e316129ddee2203a0bd6e6584860970eb906de9a5b719893f3ee7c02417e0e51d4eecbc48622ff57814e1be9c9c798b6c23c3787838cd544362ece355ab4911ed8920363a632c8dec8f285efb9f63634178cd8b9262faf1dfdb1aa9e8b7d6737fe8c5b5e545e5f3e5f5ed4896c595a5afdd407f8f47c74c6b0de28c5f6559a477163adbe027cdf4be81f393a8db8027e83c67bcbe932bd688e79ca19d0123c0b9d55446644b9af1e680a70d412168656f7689c2ef689563cf33de0d7d78e14617edee55cc37d2a271348fe6585f516c990e43e39e61c0ab0eee763df57cc3f44f32f6339de690a24ff87f8bb180b734345b2e3ad3eae9b4cfcc83231e2b128d3173595f3a2944c8c75b4481748c8beb9a1cb9ec1f7a1edc39d23c1c95bfb64fbead2d0e5ec985631963ebeff0c9d8deef9cbe8647bfce67da14f2581b72c6947ec318f9da6c05b7a7fde96c49dcd35269626629d0c682a636848ee3fa3f9f29ce6de579e8c039815799093fdbae96255b843fa53ccc975e6da4ede9f129f84401303d064d15a0d4d5dd95d1da7cda94bca31f5e1736e90de61a57312eff76ff9d830636a5b684bde1af8c32598a94bc357c3eb6387f1fb3f0576d8921a97e37285eae0f5eaf9e16a083e05de24b79db7dca67758db97cc6d3ab0ddb2f5d67792e787b6e02718fb23bbf0965ff6f9f2cb7eabfe1feb4eef60032cad12b1d5bd1d200cb23149197c0c099ddedee3b628ef00595fc82eb0b4fba46d50cd079bc8b52a8a2964c55ae21ca712dc64a3c1e31cf0f2ccd0eac1c817f7dc632d740e296b303e5edf993eaaa3bc188cf90e302f95a406c89eb5c7c20eb5d7d1b69e3d7e9c235ba9e0ddd43fd85860ce760972266aafae2e1c096e8044b8316c0eed936a1c95d118ddecab0abe8378acafad7793f77e06bba7b0ad55cf465e9e9dcd2791358f371cf9b571e4077ad3b5c7fc383ef1e1c7bea45e7cc3f25f72ccffe7b1fc344f973de1284dad8131ebd7db6212ab5fca6f3c48e66a62de64a267b8745d401c771a0b097ea8ea35f29cd614ebba67608fd038ed4af8cb7bd1752575bfe8b98b76cb091cc98d7858270eea6f847f4df3bf1770d566b4e3327ada2cd233d9dc5864913f82b67119f1c9a5d52cb2a446e834dff49ea367a4e6a38566f8a677209d21af1f715f2dad7fed5cdfc4bb3f076f6499f65694ab6bdb773ce0c3af7b9bf7eaf380d7c8279983c2b656d5e37e5fec479c9ab8564af7338c7bd2cf7361ae9edfb2e6137c3d9f8577edd88f74a19a8a24aeb78c63a5d7e77ca071d8cf300705f1da8a63ef48eac2d48539e0eab0a3d559a0c9d0be76cc8227efff1cbe98fc786d45d9af0ea7dbebd7cff5b363e81f48dee7b6f30c9ef36cbdfd9d75ccdcd8e2475adbf97eb46a71673fe96fb93a175eb998e2071afb92feb48a73b00d408ebfe4bdf49adcd8e2071af3023f5a652e3ca0896176ccb998c729beff529c4cd8762fc28e3742931357961e54c23282b1706c37921a31923ac3b52cb53a8379d09bde0af0bda8561cb25d833df691e0f00dbeb7af83998a512cc036e278c6bbe0c90f7c1767710af1f2aca3d15a3f697113ce5bdbb3de9bde71fc8c9b8d7fb3f16f363e9da39b8d5f71bddc6cfcdf3f07371b1fffbbd9f8371bff66e35f766ddf6cfc9b8dfff6b1bfd9f8ef60e317d6964bda92e265f02b07f1e452f80d9c139e576b372337f59417c5f41b21c1b3507c63d3db399a827322318fca7363d063e0f3cb40606d2eaab1de87360767c027f99236a7cccdb4bcdc72189022cccb1b7020e53906d58adc8283982f118f09c9f3c5cfc05c2c87fc2b4d21b6a93a4d81eac0c2da1e0b6b8a9f2635f224749e3329dcf8956ac3e6d56abe1c474bf342dc8204db528cdd3af1915d72fd8b189f7ef4fc4baff7d89f715bebffd0b53e2bc73b3cdc739052ced6b21cc4c2dad2ea0ce6bb92be635e5753f730c79ea16d5c836b6c4683a8defc018f26c6f6015ea067d7c6b524716735051ff06dcc4f96e415acc89b7fe32dfec8bcc5ff143e5edff36ccebb7a5e04d9479fc1df85c6c73dd30f5059a6d27d7b210e37aabbc67a79390cb4fb4a62356564abe468db45bbe92d4ccdf14073ea92380f91a5602c04c0ef078e0fa78e262e2a72b31d6195abe7051ed824f97dfff3385e78c131388e6f558e1f467d3803ff9e83c33e955f47b1baf2f59d3416e9858483a829d07792cf44fe8b13db6fac461a530dfb9fd79e32ba909f882fe5cfbfb08fed94e331b4a5c6ce91c4455b8adf5131ef75dfb6dfa0f716e8b3295cfad9fdacaa9b6e00af304632de72f5fcb0fed41eb3e82cfd143eff52ed6d56e481e020aee177166f10bd372defc3c15cef676205b0ff31038b40f75b4753d6e6d975e005fc0ed0642780eb67e87dcadad1ea4c768df1227d2171ffc5b03268efb59748c73e1edb14bec5689fbaf4fa9236921200df59501d33daf3abb7c9b7b8cd67c86e252891d3ff84713ca564b64272f35bfdb543f14026a7ae4d5ef66cc923b5d35a3234692d348c1742363ab1edbdacf3217f0c481fce39b7d3f6d169de9ec260defb32fda7d776e21a28b81ecfda463a8a2f2e00e7ac1dbdfbfe63c2a7b7b91ccf438e0c48d167e939e3f6b8c6b4e43e88ef417dd5a6b087f41b531a527f18eb59da96f010efcfae4abeddf8ec4b6b53b97d912e9f52f606e0d595d392cbee8fe8b997eaeb24ebfda5f8d3233eb5fc3e8a143751666ee9b5e7eee508f7f0e6da0f30b5cd3381d42cf5a3fd7abf7a611e572fc7f33f8bb00af9babc9ac055949dff08ef18f1cd61cef8561f02498df8d52aadf5d47b2e54833531ee297a63eafccbc81635389131f5ee21273367b8145711f7fdadbca3f11866f9e032f640e5bac33ec5cc5caca611e177cb3f77d4c16bb93da70ea71b224f5a0e34c6c2cec2755a1a61fb19ae70dd1f5f65da2dcca5437d7155ce12dcd637d72772743938addfa0ee8c427cf2719f4bda8ec4ae75b1cfb5a57aa0291cdb6355f619bef71c194b6b6415cc35a9d755322f7eaf3bb6d07dd8b74b73e371fd05dec2b5d2841d92efb81e6e28047688fa8f641f1b009fd6aa48f0fa98bac79067aa2975d6f27d08a975c04a9db37260f8db0014ae017a1de784862ecc2dadebf6936da852279bf0be437b8c3913784b82135c23a1a5405a2f786c69f2c2d21a33db1737e8f736d2c5e8f942f8a414ea7753160eae7387ebda41525f495d44be644bef1ee3da5df49da3d5e9faa2f3f0dc78ed3162b76a1d6ed2ceefe7f836cef37526fce1053ac320b3ce644e0dcbb88ef5112732e5a4c7b13f1b5f87d779a2e6c8c67538a44bbb4877aeb72519125c4103f3b1db631c33398a0112ecc1be4efa7de59accfbbadbef125fca8b2f26c73a2f169d7b6e001f6e1d6de81ed658c17b008f35e5db406b0ed922196ba9b42c8ce4c2beedd5b92462fd2bc52f4265f459cfa5f7def853d3aeb9367f6aa216fbefc0ec4f01d7fd2c792a856dad88a19d587a7acd9158f694f05be6706c8deddf83570c3f0d67568c59b8900f5aef3386a6ccf139796dbe6d4ef66c6ec8299f22065dd8d64f8515c532e13218d14c9970aaa72b41567d9f2c3b2d212fb0be6173b82609d637ccb10081df73818f6b97531b05d7d949d627da392d9935f83e6b137dfac4563887132d29c7de29769853a3b9686dd07b6f3c9c67cad88bf270c676d879b1acbd0d7c069685ec890b615922bc50fe1e27589df2fccdd4ef348b6448840154c2912e3087fb14ce4c5ca7d881c0574343c775b4d60ef6933e27f158aea32bc46f3e10e6868e31e30b936b8496de9f838a7b9df6fb5d7ca886b69d015e0e1cc95b96c20a92f1750fef2b6dd32926ea3fd7738dd934b689f763846b9843db879c798205266ba0bc1d47ebc6bdb7ef357e4f0affade4ac6da4e349cca5ceba1070c7b6691a46b6b128e9d778a67a28c172b7e4359086041feb0f5d43577696e6aca25a9cc640a07ca295fc40b4cd371fc407f0415c8993138de5997a2547efbde90e1f4177b87178575abb370eef1b87770e8777611cf7a4f6899b3cdb2f9467036d5e58839982f47ad8d129ef7789bc1b5bc2f6ef18e908c3f45a22d97af63ea724d22576a6dec371f7e37a28768831493407b28e71a886de732d49e4ccc39a2b019020331a105c123a1b481c2cd667f65ce6385f02e945a82d5b8fd455c1711712b791c490e4a6e0181cb25fabc75b52c7f55df49e3daffbd56bd4917ee572af7c20dc6c923bfec6e370e3713863cc6f3c0ecd1b8fc33f89c7612f33fb17ab5997f421e5e0328654e72889c9a0f74a26d217a616d20b24b14e7df02b433703f3acbcdca84dd5f154862feecc578329d2a77af17525e21015713786ae4065f24eb9f4be190049cdf51f1cfa0bd1f5c3f45c3af2ce549df1759f3f87f533d38748771b9b9ab9b6fda16be93dd7e1c45d1bb5854363d0730dbdebc6e3daeaaf09ce066330a7a6a44edb125c1df969576d91e2a0223c28aeb33f8c6a9bf396b65d90e7883bc273a04ef1b30936cdb7b42d6cb79cb9a9ddd3fb497fd1f34c49c53926a7b5d0f3d6539a6fea14abd8d10feaf4a4e929347f98ddcf7be15c917c678a89ad90fb4df7a726c775fdc1411ef47e1eb19ce4d01866e293a80c2c9d4bc619da96cdc4015f8eeb207ecfa5ecb054199f86558b6dca523c1e09bf32c65d2e716c5d12c3d1405883f1b1bd93ca355168eb446d3f23af6562e87d0f488d59a18ce444c6d0b6eb53ec6e0e7e52da4283a77cbf9c41fcb32d790d5a55739714cf91c405e08ef9046ef5327331effb1ab067fa7f59e84822c140a6fa969d10f0eae6dafe309397d78efe29b06e33db6fb097b3bdb03f2404dcd53922f1fab4fd4636cfe807d2ff633973b171973d835b2c8126328e949d435230866343537e753496e4158dcfe6f8c9dfe797896dc6ebb600c343d7e3c6edd33d59d207499f8f7591786dd921c9f3436705d9e3c28ee4bdeed77d45dc7bd48f7789d79ff0e0659c9d8383786579bd5bd5ea81adabf0980b0c8d0fd16fe31c058c6fc8f0619ef6395f879b00a45f67c9f4e39c4672ed4538ba6cb416886eefd36b220cc8678cef6e0c5df6d0de3475f9eabe49fceed476e17f1f2766c40b9eed8bcb4b61586f71e9abc7a571fce3fa35d9c5d0f91c63edd9b38bc53bc87976756c36d1f33f83ee57641b54cd71d8eb2657c65b14ebb01f678d73b11e782d5eb6cbc6e1fd44fb8b381c623ba8646e31df0f1d4d611cdc97e35ad4b10ee3dabebab17d7547f4bcfa0cfb463975e2e872e0b4a6110763e0f8e26434d8ebcc9db1201a9a326fb71468f06a88f957a446d86e09a1a59b9ec14ff77e175a4bc98afca3cfb15f25f2914635c2c7a301e68d0c6cbe1f005f61f63af8c6b5b4face91c415f6d956f3e3c4e37cf3e394f7e318dcd63be14c4becf7433f1db9b6bcbf18ae4c0992ba37be7dc8c3c0b75df26e05d92e93b876d7fbda1fc9fe54ce298be73995b34a0c0d8d85c886ff1dfa8aa1319fc16755643b548c9fde7262af9d137b8039b876ed9e92b8cb0f34f6e5302515e700c7d6b265f63b8d7d3efef2038df945f3560946e27760ef3e45de37b6cf2fc75319c7f0aebcb68b6d8c0f34e6c5f192fc713f2b8737dbb7793e6e2831d6d9be35ac37ed63b4273654a1ad1bc7960f6dc10c8c36ea6ff21ea477d4ee6a81f56b345bd61efe5bfb73ead61e6ab5bb9a62f923f4d7df7fdfd5dcf1d25b813fecb9ffc599dbd3d1af2ff3856dc1e978f9f0e5c7a0f9d8f93259cc675f16b637f22df2db5ff6dc0fe6b3d16cf917f9fa0f74097ac378f6738efe77464b6b0c17e8cf197957de8d77b5c57837aa3ddc7fe779f6aee6cf1df481ff8afffc6b39c60fe0188ef917c3fd8bf9feca7e7b60be3eb0cc1f5f59eedb57aecef2ff97611f18a676571b2ffe72c6bf6a0f3f2db818ddd516216ec3d3685d7bf87affed1b77576bcfe6b58706735f6f7c6fdcd514389e4d6b0fec5dad8bdfcaf31c737f571b8e9dda03cb30cc5d4ddaffa9fff55760394ced81b9abf51df44ce6ae3648365d8053da15a6f1157d9cdbd345ed01fdfdb81cfba82d83915d7b60ebdf59f6dbb7c637e6aea62cd037df98c6b7af3cff8dfffbaed6cdbf34eef5df77b566f94bf5bffe5acd568b91537bf83773c7dc31fff91bcdbf37fa8587088d5aed8b37f7475f167035b516e3e9bf7e728d2f8eb5b4befc1a399eb5fce28e975fbc15385e28a7eba476576bfbc1fcd7f24f6be91dacb9f8f7a7b94d7e78b57eb9a325f9bb3f9fd3bfbad6d2f66a0fb3158477b5c1d282a37852f1a7fec8c26b0e5d2bcdc5311c2da2abc99be38f4fa320fefb75b4581e5d8dbe3abaa33b7756e87dffadd1e667ef11dcd2f1acf6b0fcb51addbd69147147ba73e7fc6970e77ff873073f471dfd5a8cf1f8b07fb03cdee801ee4b240572bb44e7aae2fefffbae865a597ba82129474e03656249ead2a0a78cd5ea33f6d37cdde1025c398a56e2f13ab88a50636afba2ef34998d12367606e741a03d2718e31219c8bec738adc7af9db031b17979d2f1eb81cdf75626d7e03af85444f78b8bceee79d56db2d1b3561666ea8fbd0e6399b0c24330a3cf9d1216b1f6335cdad216525412d1809f9535d08535f03173964418c97a5fdbcfc1eb2b73ef0e695ff6ed2df0def88727795292a3b1cb44a9a430c83a387b3e8e0c2e4dbdef11c686726cb28469b70fedf0c413e7197e837562e6301569f0b1c7c3d0ead3b62472860617c63ed3252b3299601bab585d203116efc424f16ed14e3c373a5a1be7325508ace16f039bf3607af5637961e80ad3d1d8b5493c6f99debe22ebe3f439a959ebd09e29b1a7ac7a7f12f7a75988bc3231b4cdd2f1d5d0e6ce67514a7b4e6a369d6e06e477766ee90a0378b97e6d66a57d1b3e41548397a1e3c3dd25bd0237b6b2cbb5f5962976d0bf0f982976cb52ba6296d21a48575fd7944de4e38f35c936bed8ba5e471506afcf04d0987c22cffa0270ca65331f6738e3f97744926806f92758eb940de0622c0cfb0cff853960b13739ef0c2d91554fd7181b00781a1deca6ad932214f3e91e236b41da66ae85cc7d58b51fd1bf421974c0dab02c314fd96bc0afaf8f99e28bfa70c4ac85f7573aebaaba3474399be5a4b89ff1fd6f40f6a444d4c49dd36497802348ea34247cc2062a656f5d063d94971d909a6d3ab1426169e87de6147d9289989f006ebb406b90b0ca63547cb06703123ce01f542175931555edb022a3256e239bd5c60bf922f6598757476324d9e93ffe391a2618f2cf3a474ffa7826e35dd27ecfaf5291b09d9fbdc0e6fb6b7b3675fbb45252a935afddbb26ad806469f794a5466409724b5cb4a56d60f384b5c6f655ced2958032d4acd3d158c9aac770d56ea136225930740dce0b015747fad5ccd2140f5702f7fbd01e3fcead411d76c61b17688d1da9c8ac20fb1d5717707c88ab74eddf7fef9a3e0c6dbe1754cc54498eedfb54e849cbfa8f6554218b6c14494cd3c90efc76679d19c4dfbab3a40c5f9cbff56cbeb7045a638a517be7fa18b994e7a49c816ff5c915f8e2d63687d618cb99babc8bfdd3d5fd9829cfb921b0ce94b1174560ddd03f5744ffbcafbe7a16c34ae4dbc9cf9e24f3f506d6366c5fe3b36a8c7e67d78e34cca9445f107b226d7e9f4cc9fd7a28ca289c23ddd226ac6f073a6c05a65801e906c05f44e39488c7e1b14a7ef66d5f9c91bf1fe786a6308e262e3a4d6105383640731ea1e90187f4dc3e349ba9fbe3bd194442f3b8fae25e9e1cb3032ed2d9e8493bcbb28624d8422296be359829734b7f44bacfdaf121617a994d291a1c8f79cce26270e28ab2b5ac70552c124f5d27197bc99a7376ed96c0da33616d9f66149caec37cf4f83f856985b2b9bf37a3765ab539b4863d22532f936d9e1d3b2ec6000c2d8d85b88a74c9ec73527d0d57b626717d34a784650857be4bc806de40ba7d4237ada8d31ff4ab0c3bcbd135b41a5c8405c16d5e3a124ca9be7f52ad74fef2d4de749f84efdd496fd36d6dc21f4f8f9b9f4d77fb43df84daee71f94365e65d71c1fc10d9efdaaecd2813cfd1768ff4f3f34e79b5ebbaba0815c8042f83e9b2fbd45e7627d3e067d39dbf3c3dd77f3ec9a0db64eafa0092fff545f8935ef7f22480ee007db7d99277b1dfbb70b1e9bc7acecb007e7f69baf3ee2ba4df41e74525ff8f7672e36580dbb5edee863c6a4bf755f8de7d6d079d81bbd176cff859daee39d4e93d3f9f14d07d12cb3e975126e89ee76df41efc0cf43ec8d27e6c76dde634509b2e1ea72e8bdef7b88ddef7f23a9fa3ef15955e3f987e7d11d16faa43c7357811b776276a4338fdf22206dfb570b1d413edf901936d3bb6438ab00ea772ff15fbc784847f0c9d89d5f6461f57b926be24b2ceb00cc36d21150b9399f915b12d45febb332a859ab8bd2ceee70973ab44fc7151dff4b13bef4e1ef15e50d05e786adfa3fd71b896d11aebddebfa1674273dee87c882ee13fdfcda65951d74b45d9bd307d3e065c06cba6326fc51bceed075fbb5f62446eb8cec4391095ef4cdfce5706f0674df7cebce9868eda33d798ff654b7b5d976c7d3c43e43ed939d78afb18b4d572dfbdc1e87ef797dced963ddcdcb000e5fc838edf0fb9ec478bdff187be87b36678fcc7571b3a2d7ff7a79fd3ed7665bf012b24ea23d5f936d938fe3d059789cd333e23921c313b67199aaecd86fb4bf679fd9973c17481554adce543c0f32db5286cdb0082f74aad7508c5f6b3f6e6564c02bab3cf586d8df3535b58dbb7f5fc5fd7edade33aabef6d746c89e549648a9fcbbf7ed3de7657766da57c9f93dccee6c89aca16d23998a3363c1f8f81adc978ad9b071df3e4a45ee3eb27dc8b8450c8ccb0057d5e20c37815d3aaac051855131cdb777385f59364e46b6ec199912b96c3c71bb2fc32c48fd5f053a6da4279f60da32d95669a59b96133812aeda8359403b4dac07229b8475a40376cc235f789c4db368b7702613b17325c2f45055cf25beb35b5599f26b3e9b39fb50aed53da0a93b5b124fab23e7cb33bc1e6c5ef0702c50573d20417fa4ed59970066757debde55906e58b22fd1b5a5e746067e3f20589bfa8cae632207f05a25cf437223c2e35c3813ff44b6000dae4e7d808fdba3336669e8dea9edfbd43b3adb8e3265f365847098a55a8e7d82cc7183b2a50a1b4383612433002fc4cca4a6ee6d8024aeb07f663675f73ed18a71e343b6f37739d7489f4ac9841fc6a95d90bbde221992dc27764b0d6d52251fdb459686753fecfb72740526d6dc662fc7372e6128c2fec6a89a78243bdeeae3bac9c48f2c138baadd1dc4c9b32ad615cbc458478b748184ecb330db84c298ba8c195c8c937d5cb54fbf97053fdaf397c1ebecf19bc53e953ddeb2a41d91c03c6e5c87838cd5143c9b57d606073dac934909565d5a4d27f6951f56c9a9684f24fb75d3c5cacb1d716ab66498cdb053c878b1bf3f2de743633d4bdb2cd15ac51840eeea386db4f782cfc124a62e0d0d9e9b357f6acb49eac2d48539e0eab0a3d559a0c9d0be36230a4fdeff39b0c3de1af8d998b88ad8617538bd3a53356bfb9f822122b79db7dca67758dbb72a58b72a586f1ffb7f6a7ed96fd5ff63dd29bcbc0d40aa2796b603dc6136262923a694d0e9f56e8cdba2f805babea28a487bdba0a20f36f1cc829842966f6156b2fa28c95b715fa4466872e2cad28398cd745f29f43e28cd907a54cd14c702f47ebdd314a0a5f7e7a6de766d0eae4c5e5d187adb7dc178a2827713ff605c81c6f187ee8bc4b2a0d50f6c64a7b4d01a51d03e3969677eb59d77ad24fabf695fcde2b1beb6de4ddefb29b8060adb5af16c6c84e6997858c0cb59f378c391df983c3ff2d8df983c6f58fe8b63f90faa5617c4f14925706190c0ea97f21b0f0f7235058fc4e4fbf5b644d685a9f5700e5c821faa6295c064a5f0ead823344e3657ec2f1fc6d795d4fde8f51dca4c1f318a1b3c8eb351fc6b9aff3d3f0e6967b4e35215dfa99eb9cc8d4516fada68df355ce5349db19cf3d6f6acf7b6f71c3d23351fcdb7dff40ea433e4f623ee2b9c5e9f797dffeecf60b7976a6fd5338c976786560f46fedee6bd3a77095e239f630e0adb5a71fc7f63ec079d79a18971371f7fdc937e9e4b73f5fc9635bff71b7d16deb5633fd285e681c4f53a5a142bbd3ee7038dc37e8639288ad756f4ddf62190d48d253556e6805d391a3bfe1d1509c9fb3f852f263f5edbac26fb5555ee5e7bad3bbafc292af5e6b6b3e238df2a3f5ebdf2e381bfe5ea15f2cac5143fced897f4a7559c03ec7fb9be5e931b5bfc40639eef47abcc85a7b19e9d13732ee4718aefbf182713b2dd8bfc527fdabee3011f7ead8465d4eedd63bb91c4d5b6d0e4c4459bc4a098d1e0718ed635ae58486dd797418c7da4387c05732e997e23045a3a46311fdb88e319ef83274ffa2ecec2ffa1b6b12b82354fd3bf9439e065e64defe08f9e71b3f16f36fecdc6a77374b3f12bae979b8dfffbe7e066e393b9bed9f8371bff66e35f766ddf6cfc9b8dffe6b1bfd9f8ef60e317f1adf907b6e4d9553bd3b01b6570ce117ec3d036ee208f27238397e284174532d7f618e35928bef1716ef3ea04e744869847e5cfe15491fbcda9ebe80aae226f0c84a9a92b81c9d549be24adad779a975b0e0352847979030ea43cc720acca2d9853959f338ef0bb1b776f536d5caa03e3eafd8027f86952234fc0e7f909ae9cacad2c0e849333f8040795bef7dfc8d1d2bb14b720c6b694c06e1dfbc82ebafe313efde8f9975eef7b7fc66dadff43d77a588a7718263848a54a1cc41bc0c115ae71d1eaaf09afab7a8f39f6304faeb2b65b533c67479cbb14dbd7d84467575bf23c9b735dd36f84989fcc4ff20a56e3cdbff1167f68dee27f0a1fefdcd095f9d5f32238bc8f3e83bf0b8fcfd5aae1d3b65c88c38deaae915e5e1203fd4c6235a5785767ea02341fe740526786b609da12be97c852eddeb5b4fad4d2cdc06ca91e38966305dc6c4758e5333818933649419ee471bcf0826370128bac1c3f8cfa7006fe3d07877d22bf8ee27de5eb3bb14ba41792776c5cfa4efa19cb7fcfe0e53598c16535ec7f6e7b4ae8427222be5450db6a1fdb29c96328ac313f715388df5131ef35d1b6ebebbd05fa6c1a977e763f2beaa6b6d4d839929888b75c3d3f6c6ab6da4b74967e0a9f7fb9f6568c792901aee177166e80de9b36b7d8e779365680416b2f238793ee377602b8feb975e03700bd43db2c0dae31cdd0fb268057574e2bbbc678c13b12f75f0c2b83f75e47433af6b0906f31962b12b9bea41cdfa1f303501d33daf3206fdf97906f519bcf90dd134b2fcee9ef71b02cf7ec13cdcd6700ef503c900301e784862ecc69edb4d0d41c5a0b0dfd8e6cf42df175649d0ff96340fb70c6b99db68f4ee7fad5c13cf2a5fa4fafdd443550703d1ec0b75d039daf5263067875778531d9a5b6b91ccf438e0c48d367c939d37e56d6e6893d9691cf18dd83d68718c8438c03eb43ea0fc33801c2cbba3fbbaaf976a3b32fb54da5f645ba7c4adb1b0d16ccfa61d9fd113ff7427d35b2de5f4a778ff8d4f2fbd8a7b889527a3af5b99db96e23dcc3db6b3fa4b779436a96cad17c8c3ae3e563677c1c1f9263ac42beaf2c89ab2839ff31de91f2cd8d31673c636a5b18f1ab555beb69f75caa06eb7edc53f4c6d4f91f205b14fbead4dd1127b3df6e515c45dcf7b7f24b47cfc9f4c165ec81ca75872966a67d295f2ce177cb3f77d4e1b4dc9e5355b94be5096f6a980f87a136a10c667d68fb75e834850dda8fd41757654fe2b6beb93ed14c0dad635d10ef916ed1383c1ff5b9a4ed48ecda76ab0f47ad3e8b79ac8fecb12afb0cdf9be747ce3c4f498dac823e0e49bdae92bcdd7bdd11d7f97234e25f47730d663dd7e1bcb5cd0d5d1bf365d6194bebba96de45fd47b20fd92f51ad8a04af8f7aef34f133d9943a6b053e84b43a60a5ced9b185eb757d2f1a9f26b9ae31b37d71634970d27e4eb6a134ff6eccfb6eea6dcc99e0705e60702ead1f4c6a59183e1c0309ae4d5ef66cc90b8c50084074be103ea909f5bb4d404b9d622e5e11cbba25d2634033f225c3dd09ae1d7dd75257747d45f3f0e7905184d766d53adca49de7f836cef37526fde1057a61669dc99c1a96711deb234e64ca494f627ff83abcce13354716ed968374e939d29d4703618c9ed16e096bccc7aeb771cce4280648b007893ae9d56b32c775b7df27be94135f4c8e756e2c3aefdcd0ea81adabb02d1dd458c17b80d477217c1b68cd215b24632d959785542e24da5e994b22d6bfd2b894898c3eebb9d1bd37fed4946baece9fbaafd7f43b30fb48ae7c963c95c2b6362be6a97030bde6482c7b4af82df338b666bf07af68fb9f86332bbc2837ab2f328e244e2c099d93fd6bcbafb1a12b50997c8abc97c2b67e2eac28960917c18866ca84533dfdc9d233eafb64625a12f202eb1b0aae4942f40dc335b5fab42dd571ed726aa3e03a3bc9fa4436df0f1d4d611c6cbf0be3135b413a8313ed408ebd4fec30af4673c1daa0f7de7838cfdceb97e5e18cedb0b3e6726f039f8165217be252bcd7142f94bfc7db04ab539abf39f23b453224c2004eec19b2470ef6696072eace690a3353ab43db171953437bdd8404279eb03f5bea8ef8cd9f5d4b127716920b92b3b6d1b84a4cc5bd4efafd2e3e545f5c985c23b4f4fe1c94ab4b46c6573ab8afb44df7aaa98c2535a66d49aeef6de2788c700d73539703079f1b6fb2e368ddb877f7bd46ef49c9a9dece008ff4686f79b17c08bf71629ba66064d7a064acab4ff5508ae50e01b785041f2bc3b624ee6c0ece30bf3bfeeed9a57ca2d56200a4cd371fc407f0415c8993138fe599e70dbdf7a63b7c08dde1c6e15d65ec6f1cde370eef3c0eef225de2b4f6493371b65f28cfc6d4950d9a63a4d79b03764378bf4be4ddf002b67f0d7f0bdb627a2d911c3d7b9f5312e9129ccae0b8fb713d9459176392a21c4882431599b6e4798e3f3ca8b96269dbc0694d692e8e006d12078bf5993d9739ce97407a11ba7f81640a7a96237d8fe2361ebacea19c4fc87e3d23de9236aeefa2f7ec79ddafee33a3fdcae55ef938b8d903eef81b8fc38dc7a1fa98df781c1e6f3c0eff241e87d95e665eac665dd2879483cb50a9ce51129341ef157ca42f9812dcd9d2d61bd15af7c017394b73cec9cb8dda74066655f66c6ec829cd027d6a1a5f57220ed1ae88bb1177e6ab71e2f3bf505cc0b7b42dccf51f1cfa0b25747d7a2e1d7967baceb8cf9f237e163340ba9be1ab3ee065d89620d36e399e1d0a48a74463306d4be2aefd1c8dabc08010e36c20d064684a7d683685001cf9693b6361487150111e94e4d9d1dae60e0717a049f03a368f790ed073d0b33136cdf4215a7fbc25a92b7a3f1e1ffc3ca90f718ec9692df4bcf594e69b4ac12ab261b24e4faadd48f2879789792f9a2b85e43b534c6cf9dcef0803398eebfa4b0779d08979c4754fd11866e393489f4be79239beb87032b9492fc77510bfe73276589a4ccb9117c7e35eceee3afadeb57975634b0db4bf57265dc3c89630b53a83f694a9558d4de4b7ab549e4b86cd9d6683467558cb710027cea926c95125711eb9de961ad17e45f60cc6eb613da02a4fcfbeede7e426af406bba24793e044f54885be4056870d0b7b4ae3b3cb8afcc7a38e018e02d094eaca6b0b32575626966608402c9cbd1281603ad8bc13ef7cbd47aae319b12bb9afa310887c3067f6f4b8d00cc221e14cc01917cf6d13dc977f508df32ae21fb4cf0bec856af8837055c7d311ab0c9e7be53cc2c45069ee9e74eaed91bb62fe59aab63fb6e75b9ff57ea729f8731802b836b84e88c2439f02c3a373680ab637952803d90912e6acf8435c606e85db7c73596b6d4589596d1b0dfec57e3c8088edeb93472de79211b20577729bb964efa7f864e76f28ccbe86629632a6e0c4d0e00ae1950a4ab1daf21e1c9d005cff0e1222d17f8fdd6c1419bdfc50f9dd6f6ea98dce3f3f41c3ce1c9332e842ddcefa58e7698d35384354cc80db79f5cfbe5b087c3def4583729ccb5cd6cebfbe841fb7aff67ea404b53ef7b24df2555ff09802e60ac4176dfead73eab4bb4e953c4aadfd48f8afeccc4199a1daf2a7136d1feb30180a7b2a99ba69314c9ff535f3589a94bdbcc987aa63fbb6a3ff6723d3ffe70709e67c7b0b27dd7f20af06938a9a3f33a071347e6359543c6b32591c461f3e26165ce1bb25690fe783a4f4fcf69d716c8f4d3b9237261bbc8940b999889aafdd8cbec827c94fdf8a5f3e944ffb2dbe4d7d7ce099e207f5d267d31917e9e8e1d162756c82e092f41fbccf35df60c6eb1049ac860fecdb3cef7e4597399b3ddd2d80de11653d64816021cff670fe464913f66409fd17e4e3e43783e7c4619dfcc766d68fd1df57fecf7bb74208f5d7326af018e6f34180b7399267dd915f5c512f2ff7df4062f967785b84db46675127b6c8b077c9da5319cbd8140ee8f7027fbdc5fd7913ccf690a746cd4dd212e2531a7adae6b68f569876b846de958e6e17840349eaea5d5697c00eef033a42d34783c9ed78803fc5ebe493fb90f8aeca4836b4be6e390f5897d91c97d42e3350627ae3076b92578987f30e6a0643780fa4ad1bd360f77a91ccb055c4e495fc0fbec8dbc3a6b27dc3efbfe578daf41ecfb4ef6676687c2ce921aa1d3dcb8564b5d1adafdd2f655a49f8f3127188ef9bd9513e540d656e0d53d90afc38af12901ed594752678097eb680d513f7882b35b60b1edcc0d0fb1ddf13bdfd8efd4f8f7beaf45f954d1bc6761addfc11773c33da53ef3aab8a71be6e69a989b14fc665edce566af66c644637c2e9086cb2867e8c3d936719c9145badb82c63d7f53ae03ce230a01b7592675954f94fb8071d3b6df587434b8b4a52d89f35e3817e237e3f3d338b88ffc5bbd086f9389e34fe1137927df759598c5a11fa224fe6e66fb0dd6265892b53916d6486fb2c7c2a1bf4f125766b3b146df27e220480e56cd79cf6be36fd0bf93e32bfc485f1bd9fe7dc26d967c86b36b4b1e0b3471d5d16568687d0fe75a102ef92ab6e34d4ffce8bacb4d4ffcac7a626e9cf5a62fa6fc2b584798a79097378097312f6b47fbd83ef194f626fd846570a819e7d37e2da6c432b669d716f9e84ec68d723e80e72c79901d1badd88fb88d8571c85cfcca878b77e5e8e9578f459fa7737f203c59ae0d969d6bf5a1706645fef5d4da45697ed9cb60970ad644215f3c9d0f414e998f323efae77d2cc55b3b61cce19b88691dc5505ad33d2ebdd53de4ba8ddad3aa6c2be4dad39f1c173c31b4fb25d054e834d9235c35bb33f5abe70417b4e753f0eb9ddd877cfdb1b28fe164fe2f1497f3c0985d9a9aca20db92c6da0987a9aec012b13a0f8c0505dd5f36d700c7e9f4aeeb7038c64ad6651457c57b731be518ec1c5dc071f428c7a038fe52848513571d8d85a6de5beee533ce0958e2f8eefbe062634ee932fe8361c415262678c3ce8f7127e3c024ff42bb775f59e5a937dcb806273286b65d9b21e69546b28ce4a9b5d0fa310333990f94e06a30291f023a4f484d4e8535f63a907b30ee95ea255cac46e7cdaff1916ced9b5fe3f3fa35f6fa1e348fcfc06cbbf69de22149dd559d1e9f599fc1b639c29c1c9f43ff235c019939bc277ac1a92d9d3fc717e31e28784f799b88c51ccc6653181cea87ef83e72f5a3fef5353e94837d4e0e9bc67f14325c7f4f9902f71786e4df156f2fcc7383b92bf9f7c57021306b87bd7d0ea511cea24e714d99514f3b7b3a4060ba49e6bf8c364ad14d792d4f0ed38a792e3363d18a70af82d7565e9226b0e84c094d0ba86bea5a921a9631561bb482d19c2439b8bb92e9d6b9ff4d79cda2f597930547f7ccf3521ed7552eb80ebf3adf3789aa37dc3b81fff2b3acbd3f2dc3f9a5ff796d3fd9174a75b4ef72da7fb6d39dd89b665e6b162997280dd39f4679cfac48ae331d9cf3a6c53ba5ead850539a7487ef5fedfffabddd502ebd768b6ac3dfcb7f6e7d4ad3dd46a7735c5f247e8afbfffbeabb9e3a5b7027fd873ff8b33b7a7a35f5fe60bdb82d3f1f2e1cb8f41f3b1f365b298cfbe2c6c6fe45be4b7bf825ff39f6338fa8b7cf907ba003d7f3cfb3947ff3ba3a535860bf4e78cbc29fbb6bbda62bc1bd51ebe323cc3dcd5fcb933aa3ddcf35ff19f7f2dc7f8768ee1987f31dcbf98efafecb707e6eb03cbfcf195e5bf7de5ea1cf77f19f681616a77b5f1e22f67fcabf6f0d3828bd15d6d11e2163c8dd6b587aff7dfbe7177b5f66c5e7b6830f7f54683b9ab29703c9bd61ed8bb5a17bf95e739e6feae361c3bb5079641ad91f67fea7ffd15580e537b60ee6a7d073d93b9ab0d924d17e09474e59e697c451fe7f674517b6039e6aef6b81cfba83183915d7b60ebdf59f6dbb7c637d48605fae61bcb7cfbcaf3dfb9bfef6addfc4be36eff7d576b96bf54ffebafd56cb51839b5877f3377cc1df39fbfd1e47ba35f788cd0b0d5be78737ff4650157536b319efeeb27d7f8e2584bebcbaf91e359cb2fee78f9c55b81e35572ba486a77b5b61fcc7f2dffb496dec1828b7f7f9adbe48757eb973b5a92bffbf339fdab6b2d6daff6305b4178571b2c2d388a67157fea8f2cbce4d0b5d25c1cc3d122ba9abc39fef8340ae2bf5f478be5d1d5e8aba33bba736785def7df1a6d7ef606c12d1dcf6a0fcb5fabd1dd9b461177a43b77ce9f0677fe873f77f073d4d1afc5188f0ffb07cbe35d1ee0be442220b74b74ae2a6dfebfef6aa88db5871a129844002a134b529706559ead569fb19fe6eb0e17ec0077bfb439656e6aacd7f11ba11936a6b62ffa4e93d928616367701e04daf3647fa0250c60df639cd6e3d74ed898d8bc3ce9f8f5c0e67b2b936b701da244ee0c4e5c7476cfab6e938d9eb5b2664a323039969121103958b081d578ed3162b78d0e0b8e90c8bd6a2a63e8b41faf737700953f5fc7c22b7de6de60c85784c74707e9f1c17290dc9e4f6c25b086bf0d0c2e2a34944c762c43161139f1d594829ee218f02a13915d39b8988b12150ac54489866e7a96b6dd1354641a9189c277d512a79263f12e4414c9f1acae7444066b6ad1013c3736e7a504ecca2ab3ea18487067496a3a09b1bff56cbeb7045a638a8de3cca216850ee1d3e7a412e6a9a1cd9d1f2c49de9f62e0ac6d4ef14093e54c5ddebdc1984a794ebac2e9f810ffded1bcc0e6fb6b7b36bdaeb39adfb7e13328fcb6af7296ae5c92e0610a3885a5c1f2c0bc7ab0a0bf36c24f42485edcd68a06d7ad28cab58ba2dc0a725cb320476371fd754d0a687d82b12605bb2eb6ae1b93df04645d004ef94404fbdb75acab5f6a9d4be2ef01961424677ea0b51e17aab994a33259d0a6a363d2fbbc33f406e0af56f8267b9e72d600f01ba9456773fa7044ea8df7d707044a4436e629c8c9d015a6a3b16b930471530a5fca091ba88cbd75a104d23cf2a7d3406fd3d0945f6d89f59c56ffb4c86236314792208782bee13ea102278f26c9e20f8a3aed2afa21c6a88d1d2da38d972ace14130c5d3dc09724d5fff8e7289f00be9e778e5e86f47796b4df0b08d8f7b6b33b90e0ce911ae168208860d60f4fc62b63cd83b100c9f551410a05c90e0c5a30b48d0bd0739b38a13a347533c0be9659775faceca8e8a82935260e2552b7b47b17d9f7481698036162b5e435f0917ee50406276210af29a961bbe9051d7fb8ea348525e015a6dd92a1c16190cc06fb07b4066b8789f78f05dfd2e59dd39c564bc438f08dbc4fa1f2772b967ae0b73bebcc20fe565d9967f9e28024ee9c26bb041c01ac9ceb634c7b4e5a51e437fae4f27d713ef5d1eb26b467ca1b0881539e7303649f29636f80ec4f0ac87e5f7df5bce442eadbc93f235fc97c95d3ff52c17cd8bea6092732045a83c17a5f56c1a07c9d8fb6f95dce9e79bc1e8a809ebe5727cf52ef9de641b24e85a258cf2ed90bd138ede37124a932f9d90c0dcd217f373dcfe0fbaca16d166da9be466b10eb197cdb457dc47aaea44e5f2a14624a6d338d3bd26b59db9f2e0f0abf66c46f403920e9c0d4b6e989a8a49d5905eef1381abe1a15f822fdc1314e1982a8a824e70536d67d1a9ca5e16261e3d18024449131bf7755c90bec509818da3d060d1362c9fa94c4531b49f02d5e7332df75ed961ada5263f776403129a8d5d1f7364a5182d92b2900e66610125519b3e54161b0a37e6242cd3da9ea5b8912e1a8955dd4ff62c5bcf03e3c4d5ab828196a4eecb8100320f603a0a93b47129776c904035c904e1291acc4717d47824b731011d1266403299c97d44d2beaf407fd2a53f8eaa8fd87897ea4cd2c639d12288d6d899046476b531fbbf3eee471d36d6d42e5e971f3f3a97ddf7d12bebf3c3dd77f3ec9a0db64eafac0dd68bbdebdae6f4177d2e37e882ce83ed1cfaf5d56d94147dbb5397d300d5e06cca63b66c21f03f8fda5e9cebbaff07b172e369d57e8bca8e4ffd14e6ed0ebe65d71b145dffd7c12c9bbf44da8ed1e973f442678d137f397a6bbfd117da73201f99ffdd69d314107b7eb79a7bcdaf7babed9765b9b6d773c5dbe3c09a03b40cf42ed931d7acff72ebbd874d5b2cfed71f89ed7e7e83df819daee39d4693f7e3e75372f03387c21e3b4c3ef7b129de8993fc61efa9e8dae7f799dcfd16f8acad2719dce7571b3a2d7ff7a79fd3ed7665bf012b24ea23d5f936d938f75e422acc3a9dcef1abac2b4a5847fac55796f3c1b7a1f525f125967381119b7c58dda12edbd8a3ea522ff5d9904d8e3b3738adadb41fbed84d457de107f9c1cf56df4d274c32ed90b0cda0bdd898bf6c7e15a7e1280f2e4393fc545a83c19f7688ee9e76d7737e47fa0399e98cecb601a745e1fc3ce6bbb5eb8eed07589b5d68dd7195e5ff7687ffd6cbaf3c3bd09e9be59843f0774ede33de9393f9f9e373f9f9ec39741629fa1f64136da6b3b6df7b82dfb5ce5c9a8e37d36c8d963bbc7e045edcdc93829e87d9bee7ebd7f7d11d1f76af61e1978b6deacd3eb17c19fa167fd1417bf3ad133507b5e8364db8e314099789c9422900919beb78dcb90f5a998e438be2741729cd419b15f098259d5f320b32d25128b8af042297a0d4d6a4f8c5b1919f0e7905184d7a6b0045c1f82a610bfafe27e4f696ff5a297a6d4989c26d79df6b59ff0ed0d0e129b4a13a627e6f73001cbd6550f44324b92eb483e1e5f43fa528d7c61dfb7f7f1f7e59186a7e32345d6d0b664dc28c981c5417416accdb190c02e1d26d39dac8bd26b9aea9287f3559c347694ac549138e43011f0344e44db7d99d850e4ff2ad069233db95cd1b3e9b34b8bec9282a04d64e362f2cb451be9812d3930662a2609c9f285ef131437b8b0add32476aec9cb6b477facaae762dfd9fb9005fd6f2688e610271fca705f5c9abab231b48ac99f783da83b5b1227e640d83a9ab8b034b38efb9028e0fdd6bd6b602c6db9bef4e8b5e5fd3870654a90606de20204b45036df76c9bb152437281ee7feb0284162bde7123096972de512527dd61ba5d8be4af3286eacd55780df93b8e5eb34e20af88d2c62ae2cb21b32c7bce981968a8b7d58e8ac2232634dc61a177d86a396b030b4ba47e374b14fb4e299ef01bfbede93a8bdcbb946c9a5cbc884aac5f4631992dc271b479737805722bb28c0ba9f8f7d5fec41218cd65e8e779ab86036f537d22244b1ecb825cdff2fcbc4a242fbc9bea8f4da336462aca345ba4042f6cd0d5df60cbe0f6d1fee1c094ededaa78f41287f199d6c8fdf2c2c4eda4de02d4bda117bcc63a729f096de9fb7257167738d89a5895827039aca181a92fbcf11c946ec2b3f2415ab58d83dd1af9b2e564187d1fa535c8ce3ccb59dbc3f253e09812606a0c9a2b51a9abab2bb3a4e9b53978606179f21e706e91d96b6b9546c9831b52db4256f0dfce112ccd4a5e1abe1f5b1c3f8fd9f023b6c498d550e26ae1a7618aa83d76be738f16a083e05de24b79db7dca67758db97cc6d3ab0ddae5e04e9d016fc04637f6417def2cb3e5f7ed96fd5ff63dde91d6c80a55522b6bab7038441362629dd0f932470b3f7b82dca3bb027e5b3b4fba46d5091c83d617714c414b2622d718e53fe58f448decae31cf0f2ccd0eac1c88ffad208db2d740e296b302e5d1ceb282f0663be03a7355db42535a004853bd45e47db7af6f8718e6ca5827753ff6063012475e2489033517b7575e1487003a4a18bd688cda17d72dcce7c1b2b1aa39b7d55c177108ff5b5f56ef2decf60f714b6b5ead9c8cbb3b3f924b2e6f18623bf368efc406fbaf6981fc7273efcd897d48b6f58fe4b8ef9ff3c969fe6e99e12f9a6c4f13d1cc3cf2b4e91ee371e247335dbd2b34bf58ca87004c471a7b190e087aae61ba6cfc3baee19d823344ebb12fef25e745d49dd2f7aeea2dd72024772092e4112270eea6f847f4df3bfe7c7213719edb810f977a467b2b9b1c8227f046de39216be4adb0f3b4b6a844ef34def397a466a3e5a68866f7a07d219f2fa11f7d5d2ae5e7429f1ee4f5160a9547b2bcad5b5ed3b1ef0e1d7bdcd7bf579c06be493cc41615babea71bf2ff6234ecd960c6d4214fcd1c73de9e7b93057cf6f59f309be9ecfc2bb76ec47bacc3cd0b8de328e955e9ff381c6613fc31c14c46b2b8ebd23a90b5317e680abc38e5667812643fbda310b9ebcff73f862f2e3b51565bf3a9c6eafbdd659dbcf8ea17f20799fdbce8ae37c2b1871f58211497fcbd5b9f0cac5143fd0d897f4a7dd8a765c70ccff378b7624f34491ed5e841d6f842627ae2c3da884650463e1d86ec4713520a93343dbb83806a5f7ebeda6b7027ccf35f6fe9c608f7d24387c83efb99656f7012f2fd3318a05d8461ccf78173cf981efe22c4e215e9e753482354f8d9b70deda9ef5def48ee367dc6cfc9b8d7fb3f1e91cdd6cfc8aebe566e3fffe39b8d9f8f8dfcdc6bfd9f8371bffb26bfb66e3df6cfcb78ffdcdc67f071bbf886fedc096142f835f39882797c26fe09c70398727232337f59417c5f41b21c1b3507c63d3db399a827322318fca7363d063e0f3cb40606d0eae4cc2d7086d0ece804ff22569adbcd3bcdc72189022cccb1b7020e53906d58adc8283982f118f09c9f3c5cfc05c2c87fc2b4d21b6a93a4d81eac0c2da1e0b51817752234f42e73993c28d9fc781707206e71534bf1c474bf342dc8204db528cdd3af1915d72fd8b189f7ef4fc4baff7d89f715bebffd0b53e2bc73b3cdc739052ced6b21cc4c2dad2ea0ce6bb92be635e5753f730c79ea16d5c836b6c4664cebc231e4d8ced03bc40cfae8d6b49e2ce6a0a3ee0db989f2cc92b589137ffc65bfc91798bff297cbcbee7d99c77f5bc08b28f3e83bf0b8d8f7ba61fa0b24ca5fbf6421c6e54778df5f2721868f795c46acac856c9d1b68b76d35b989ae381e6d425711e224bc1580880df0f1c1f4e1d4d5c54e4663bc22a57cf0b3cb049f2fbfee771bcf08263701cdfaa1c3f8cfa7006fe3d07877d2abf8e6275e5eb3b692cd20b09075153a0ef249f89fc1727b6df588d34a61af63faf3d6574213f115fca9f7f611fdb29c763684b8d9d23898bb614bfa362deebbe6dbf41ef2dd06753b8f4b3fb595537dd005e618c64bce5eaf961fda93d66d159fa297cfea5dadbacc803c1415cc3ef2cde207a6f5ade8783b9decfc40a60ff63061681eeb78ea6accdb3ebc00bf81da0c94e00d7cfd0fb94b5a3d599ec1ae345fa42e2fe8b6165d0de6b2f918e7d3cb6297c8bd13e75e9f5256d242500beb3a03a66b4e7576f936f719bcf90dd4a5022a7ff09e3784ac96c85e4e6b7fa6b87e2814c4e5d9bbcecd992476aa7b56468d25a68182f846c7462db7b59e743fe18903e9c736ea7eda3d3bc3d85c1bcf765fa4fafedc43550703d9eb58d74145f5c00ce593b7af7fdc7844f6f73399e871c1990a2cfd273c6ed718d69c97d10df83faaa4d610fe937a634a4fe30d6b3b42de121de9f5d957cbbf1d997d6a672fb225d3ea5ec0dc0ab2ba72597dd1fd1732fd5d749d6fb4bf1a7477c6af97d14296ea2ccdcd26bcfddcb11eee1cdb51f606a9b6702a959ea47fbf57ef5c23cae5e8ee77f166115f275793581ab283bff11de31e29bc39cf1ad3e04921af1ab555aeba9f75ca8066b62dc53f4c6d4f997912d6a702263eadd434e66ce7029ae22eefb5b7947e331ccf2c165ec81ca75877d8a99b9584d23c2ef967feea883d7727b4e1d4e37449eb41c688c859d85ebb434c2f6335ce1ba3fbecab45b984b87fae2aa9c25b8ad6fae4fe4e872705abf41dd1985f8e4e33e97b41d895deb629f6b4bf5405338b6c7aaec337cef393296d6c82a986b52afab645efc5e776ca1fbb06f97e6c6e3fa0bbc856ba5093b24df713ddc5008ec10f51fc93e36003ead5591e0f531758f21cf5453eaace5fb1052eb80953a67e5c0f0b701285c03f43ace090d5d985b5ad7ed27db50a54e36e17d87f6187326f0960427b846424b81b45ef0d8d2e485a53566b62f6ed0ef6da48bd1f385f04929d4efa62c1c5ce70ed7b583a4be92ba887cc996de3dc6b5bbe83b47abd3f545e7e1b9f1da63c46ed53adca49ddfcff16d9ce7eb4cf8c30b748641669dc99c1a96711deb234e64ca498f637f36be0eaff344cd918deb7048977691ee5c6f4b3224b88206e663b7c7386672140324d8837d9df4fbca3599f775b7df25be94175f4c8e755e2c3af7dc003edc3adad03dacb182f7001e6bcab781d61cb24532d652695918c9857ddbab7349c4fa578a5f84cae8b39e4befbdf1a7a65d736dfed4442df6df81d99f02aefb59f2540adb5a11433bb1f4f49a23b1ec29e1b7cce1d81adbbf07af187e1aceac18b370211fb4de670c4d99e373f2da7cdb9cecd9dc90533e450cbab0ad9f0a2b8a65c26530a29932e1544f5782acfa3e59765a425e607dc3e6704d12ac6f98630102bfe7021fd72ea7360aaeb393ac4fb4735a326bf07dd626faf489ad700e275a528ebd53ec30a74673d1daa0f7de7838cf94b117e5e18cedb0f362597b1bf80c2c0bd91317c2b24478a1fc3d4eb03ae5f99ba9df6916c9900803a884235d600ef7299c99b84eb10381af86868eeb68ad1dec277d4ee2b15c475788df7c20cc0d1d63c61726d7082dbd3f0715f73aedf7bbf8500d6d3b03bc1c3892b72c851524e3eb1ede57daa6534cd47faee71ab3696c13efc708d73087b60f39f3040b4cd640793b8ed68d7b6fdf6bfc9e14fe5bc959db48c793984b9d7521e08e6dd3348c6c6351d2aff14cf55082e56ec96b200d093ed61fba86aeec2ccd5945b5388d8140f9442bf981689b6f3e880fe083b81227271acb33f54a8ede7bd31d3e82ee70e3f0aeb4766f1cde370eef1c0eefc238ee49ed133779b65f28cf06dabcb0063305e9f5b0a353deef127937b684eddf31d21186e9b544b2f5ec7d4e49a44bec4cbd87e3eec7f550ec106392680e641de3500dbde75a92c899873557022041663420b8247436903858accfecb9cc71be04d28b505bb61ea9ab82e32e246e238921c94dc1313864bf568fb7a48eebbbe83d7b5ef7abd7a823fdcae55ef940b8d92477fc8dc7e1c6e370c698df781c9a371e877f128fc35e66f62f56b32ee943cac1650ca9ce51129341ef954ca42f4c2da41748629dfae057866e06e65979b9519baae3a90c5fdc99af0653a44ff5e2eb4ac4212ae26e0c5d81cae49d72e97d3300929aeb3f38f417a2eb87e9b974e49da93ae3eb3e7f0eeb67a60f91ee363635736dfb43d7d27baec389bb366a0b87c6a0e71a7ad78dc7b5d55f139c0dc6604e4d499db625b83af2d3aeda22c5414578505c677f18d536e72d6dbb20cf117784e7409de267136c9a6f695bd86e397353bba7f793fea2e799928a734c4e6ba1e7ada734dfd42956b1a31fd4e949d35368fe30bb9ff7c2b922f9ce14135b21f79bee4f4d8eebfa83833ce8fd3c6239c9a131ccc4275119583a978c33b42d9b8903be1cd741fc9e4bd961a9323e0dab16db94a5783c127e658cbb5ce2d8ba2486a381b006e3637b27956ba2d0d689da7e4e3d2643973d5098d722783692017a1fdae5f0933dfc5c4d6590ddd86ea1793003531a225b73696aca1cd99836c7502c32debfd311ceab8593fdfec6b81e6c973abeb870b4e1dc0ea7b8bea941b1a8c88eaccae743fbfc2e319c136ea48c336770e0c32e2f8b55346eba0a8ff961f018619917e35671cc2bc3aeadbaaf2700cd4996dfeb38cf855c7b11de167b2c907773d0a7d74471c1f7f3f9a3352da92bb3d55d9ed42acb98cfbea46e4cad3eb543e114639cd3f79e568fb0a69bfd9e69bb9656e700b7c473e8485e809febbf955b62eb19beba38d39fb6303419a673a21ef5edea7ca1eaced09c4f913bece88a677217e30b453210c976b4df99abc704b8c60aad3743832be733c46338241b919c130330bb980f6ae668706a50ddef9ae36ff26a604aeaa7c99b2fd5de6645be62a2179c699b47788134bb5f81b8e677564cbb90675f5d9073f7e6bfa93a766ff1df9c17e7b98c5f0548e2d82cce91ed25cff9e89eb2754e81244e4ccd5b0309e7aae0580bd2a92d8c0fa9cfda2db2a63ba4ce6bec87a9a82347677df53cad59741617c4b024313434163a921a12dec2aa797b70654a7062209b24143ce0f75c53f7505f03308bf24905d69e096b64ef195a7d4af2d528a6ac9563d3e48f0d6b73e2ecbdf268ff47ed879da3b14b4397ebef8f015358806cf4d9f46298e6a48e55e84b3cd4894ae61a129f12e59f8ceb1583a600472417774772091b3ec9434bbe03dfc3602c64352e8c035de8bd70cb365f6e3d1f8e5b6a7f4a8ee1e3dcd43d68f02a6368f2c21c4cb15c2075240e30a539fda7ba45057b11dba0efcff13935f53ee161b8ccdac6baab8979250ae301ade4b565cf2bdb27f9c5983f85e46945fe5cca7b42e477bb25c3c83f6646f13d124b20fe005d81d1fab7136747c5736d96d3874bd5f40e01af9c5dcb9b8c57eada382fd693a61f9fcead46fca9c291acab7e0eb75b02ce1bb77982bd88f4113a9747e72ec19644635a11cb1af980cfd051d275fb143e1f725df9bcf11e9233e45cadcf88acf6d69686f394978043df890bc2b32032966ec28a1c2e3b53dba6adcb0bc593bcb513b24b647f948b51289eedf703b41e53789ff2c649b72475d56ec5e3bb32c30dd26363f94772b58504c77b6345fd814bc0cb6f8d53780073bbd409f6e8ea75b69439e06046ac08fffb483e93a2b656b3c7cecd2b931a41092e81a7e8ba92e713952102dd5734b74c63d748f7b539953391ad16afc346ecdfac961f4665c0591c5b62582ca704cf3ed5e132cf6443c7ba575c87119dbb36da5f841f3ad6319d96bca63a29bea7225f6c5a9b2e24a7e0a9ee9f2a9f0e6c9cf23154a8ae2c5d44ebe280333ec1fb8c634f485725723ec3de3bed733e47515e5dc7c375fe238583374fdec63917236d1f63b15b6a6813fe176c3f634e76cc37d2dbeb5ba4bf89337ae3923579c0951fcde55bb948b371ed29fdb779c1abcc8b8e750f6563a0fdd8125943db06e60c4671960873f0d67efcb3e265ef972373c3775e1bdf7931fbe5742ed11972f55c217a7e7e783d8b9e97b73c958f9ea752d96713e5e65da68600baae580feed17d58d266c53a0092b34b4befefda12cb82563fb0d1f388bd4ef8cd5add455bda0636dfdf759a02f1afe95dd7d4fb93aa189cd4f695b2dd85107018d757660c0826b0941f43f50cce755f59e5a937dce07a2714e748f8f0921c6cd2b33bdcff8e6c58523fa59a7e1cf7e3a62357d091359531b4bee748cf67e6b4b2d09144c2eb96eacb85c8160aafcf7f43dffb19ceaae2b656cc6760a1ed1fc70cf7ebb540e7589aba3ccbc2dd02ae1f986773c7b36b13d94ce9cf1e1b9af2aba3b18427f85c0e2c5f9c5821bb34f43e83f6dfb9fc3d69639a58e797e1fcd0d80de0e5c218d820ba2e1b579c5df7ee395a5b09b99aac6ba77731f613f50b488d19e54fc772be736893867b5e499c5b3846b26acfc3f9384fd69c329bc406b6a50643e4a1b800ada90bb4c614cb46ad17443ca896de4d9c0f15f943e2317c975cc45b9db1649bcfaa33065706b765cfae69c125ee4fe32bd4bd0968a9d3736b7224efffddf5ac80a68666b12eaa10f9bcc76b9ff83ab262bacff45c90d445143b24ef547714a710026ebbc3b92444d62c9c73f859b9e8fc79973d39011c3b2698b7b3ceb7c4fda9f998bb8e06cfcd91f7ec900dec0c1f96a32b0ce09833f781c2da7c7f0d2e18cb34b8c6d2f0cbd45c51d640437281c6915b329ba26767f9c79fe2f14eac3bba0fa11d0abc25a98c85714b38b76d0e789be4d1c7ed13968eb6652c5d589883c739f1afab3826f8321038539777516e7de497b3399c6315ef91ca580edcdff7b225902ee1ad4bf2fb44e317f91e979467aac2b9e2ad9d90f8312de2e34cc80e5ccf8c05520ff32259680ea42d92776f3b2bb43a7b9c0793d4b11c5d811dcd0cec59062e25210fd371adf22f53533748f65eb00e51cade4fc1d6909cba72eb5fbb77ff7ff6deb43955aceb1ffe2a4fe56da7fb00c69c78aafe2f8246d4288913d3557775b1c1032a28f771c4bbfabb3fb507608380604c4e72b52f4e7754863daebd86dffaadbed25ba39c39791fc461bd44ad06dbac53fee456670b5a3dc4a36fcafb39cad16b898cb19066664b827a2bcad941b97edc9e25ebc2c6f96952b52d347d841189713342993e586ab2e3b75ba11e17ea7d867f670d15b82f6b704f6e40a51ff9b6a7bc0d16f3a8fdad1e8e732903c6709da989b00a25391ef198e4edaf29e06a2b4d6e26f3d2a76a453a98426d7d729e82eb04d3071569975abb24430f8beea564d602e14c0f08335319f8a62c32a6d209b0180e903b0e94c328d6e94abb00bb80f237a9793d9ebb926317f6ff5dced785e1d658e3c3ed753c475f213ea0553a5b53b9180f95a7d5d9205ebe353e3ad787136d20ecbe02dff64c95f7ab4be53918429341b8a1e9ef1c7bd6360571f915d63cb4fbd4cbd5093e4b5f0df7dda9ba6081ce270432a590af04db3d82689b02aa1777e8d6a10d4e9e21d4dc76dd0ed68aa3d5e731998e6ac771aa85dbc81f30ee83b2a94ae99fe9edbef24ae66186c5958a6a9e15c2d9916b8be33d027d0de784c46ac7fa6d013f2f562bff9dfdf6a99c2345e54ec801921aaf8ef0a41f9e778adffd15e421c64c3357deadaf8bcbb08dc587d73721b8bf2f30d6f9388f92f1287c9e7d347ee2949efe89745b6c735d6cbc9d795709b0e035ffe3f3d89bab745e03f4ef13c55df36d9fb2321cc71c3b9bdf39f6a7f4f44fb4e6d7aa3298e967aef90be1e0d3e3d9293117721dadf317f2ff8d3954ff93d6a9b0ef4c66517d957cbf50290c50d89777cac3a2f28cd883a60c902e9c83c93d9dbf1bcdf1f17c359ed2ae3d61371ecb1652076295799667eaab65fb11fc3ba5e38a8e5967696e9a3cf993dd2eb7ba4de552c8d9d7893ce0f47d847ce77b28c7ecdfc23dc3e17caeafa023e171ca9dbf77f789e8723535fe9d824b0be2e8e56558857754847f970eed96b9d451ee0ee5db407c84d1f3b545670b86240e47d58d794e893b3c23de43e407f080c26f8dc5e038f6942ff7420cca3bf94bb08e7056cc364b0fbafa7cdb579fefa7976f9fc1e78be7ff143e2e90813c5ad745645ae29e981e46cbaf005f8639f6295916fa890daf9c6f17c98477e13ac1b59ad9992e382badced2f8a042fede508e52b5bab57a0c2b50d1950125fb93638dfb9f8e6fc63cb234cf24ae89159c1bd2bc5ba7b1200e63f83ce9cbe352237c809a5c659e63d827a8978e2db876801fab0bfe465e5a6963ca69589d8017c539ce350fefad6e2f8b4b108fcfa094b807de2b45621d225ef3b1b9e854c9987706e5cedf59da7b2f87f5229ca47251fc7db836ade1b80446c609781502bffde34297fb8bb6a0ad54d9b0501ef3906754a5b3d014ca6e7bab1fffba673ffd9e3d85178fd6028bf6c9453894dd404fc83ffb46f88c8e9d5fea629e88bd15c2878e0817c6d1b39ea18e569156aad24e70ffcc2da223a0fd71bcdff2712c817ef15efe0a9447e016e39b1d87ba6109ae59546fc76481d0f4db827467b43a8e5111310f00ea9b1de5413f394f049fb805ee18c78be59a1fc7f186b87a2acefcc6fc9e0a6c7bbbd0188cc8b565f0eaf07b5d3637411d61c0a9962ed42a082345381b23aead04c6185e13cf0b0bfabf35e01a75a5f9511e5c4effcfc121ea9ce41859fe1cb779503969a321cc64d635682f3900f1b618d30cdf3092c15d595ceaf27ede95695c40a19cb2b8ed8e62e7d23c6e839b9e2958a7cea5740e3bce7640c8e185cf4e543720d091e5fe0ad50150064bc4a58c721a715fda757bae293cecbf03167d2f3ec70887688396b88d9d19ef3ca71324133230a5eec3f4a298948cfc83e33994eecef5bf48412d594a2e9bc28315bd9b770db7b62678032a4fe77119e60bd1f68ad071301681e4060952b236db091b26eccbbbe55a5ee357d7f8d527f74d7d86f8d54153fa6b9abfef94be18e3896d8a8ed91aa4c8d95caea3906bd7e02426e01a0bea2a639baeb920f5c3e179be039c8331e3503790ab07dcafb2758b8ee326ef237bfe5d38322dacb3c006f37ccae67c22e73de2902bc3290adc1ab613e39c851e58685b9d0bb8771cc24df746bd378d3bbab05c0dea3a64d57f8efaf6d1f2f154bd834f241f035ee70b615a687ed90fc76d25783c3fbfefbe602d89b2382e56970736cef5fdd0f18ff3ea7efeb55fa8bd25f5b3fc5a60a7e446c05b9b16170b6b2b9c57333fe0504d97c3ce26bbd6e37bc5d8f2f9883ed13ac9af2151cf8d6f5e2ae77155446fecd3989062fe441e4c791ef1c24e29ee8996b8d36411fb2591afb8b941f9e7614d43d6863632d227e53bda074173685610dfedb1ff08d9bea0a5a5f8924fe4d4112cc73be994815f655db41e413feaeb713c06bf37dd5780f779547fa06e7a5addb034776f6ba896166fb75b03db704dc7ac67e647e37fc5f9197d4d66918cf9703e3a575a838af625eaf59c6c6bfe7e4fc16985fbfdc3eb466b4236b7de2792afb9edfc08fc4234e727ec727c9d7de4b7cd88d5188234c31c63bd585e76688793f76a75e21b8c7110efde8a3525fbfdbdfc7f8833765d987bc7a1ebc5f2d3c9f07189ed6873837986d0f34ac435c8da43b5703b0e906b9c36dca15818f55c0b64d7fd2d6ca7aadcde362abd37f4959f95e6bbc5eb1dad95609d74ebf4b3eeacfe906700b776c094277eae32b1d9ac9c8470af9ef2310c29fe2434eea562f919f3a7873cbf847730e04340b1b9b7cd23f2e70ed9e2b5a3e9795448cc163d83c7cf40fe20d301aee4eb722f3f9e7bde5ce39ac5895ad4b80de681d4495ee5b7636769c3eaa20bef45b22caa3f514e9fb814566c303fadc7066766723d17c0bee2d89683fc0fa1beda0ceb6f13ce9a2046b34ae1512aa9930e8ee23217c2d5ec4045f4a87e9eda8f7deafa527145c30d74f9c19cf831379a62903826e242c1f814143be711fe005e8feb8d113d158d6fc4c3f12cc03175e650e737831a4f0b11d543d6957e128f97b7f6d2fae28085b4ced453eb9da04e7b162f8dad2d526aa085ed1019542f200bc382e39b73224753f53e5deebfe5bc28674f084d5fe324a62decb7293ee9689f1e7198943c1fde91f33c563bfbe3f33d6df015ea929ccabdae97c45a9fcacd794f9b303f4ff8338db90f2a17e391b1815bdd9aa4d6d787c7cce5ea065406817fe8f38f3dd7dc00b7c610dcd29527fed3f3c45feb1f94942d6fa87f70be3e4ebd2ffb0c08eafa65f1ce1790efd8e79bb01d326a99ca3e6e4b708f32e46b37b7379efe6bb258dffcf8bf9bd7b975f3e3e6e6f646d4dd09fceb9f7f6e6face9dade80bf8ca5fbcd5c1af3c9af6fcb95a13bf3e9fac7b79761fdb1fb6db65a2ebead0c7be2eaf8b7bf572bef6ffcc55ff047f8ece9e2e712fedf9cacf5a9b3827f2ef05bd26fb9bd594d0f939b1f35e681abdcdeb84b7372f3e3ae728ffefc7b3d45b7720cc7fcc9707f320f23f6fb0fe6fe07cbfc75cf56bedf73558efb83617f30cccdedcd74f5b739fd75f3e3a7eeac26b7372b1fbdbd31d9defcb8bffbfe9dbbbd692f96f04d77d55a8dbdbd119de9627ef383bdbde9a1b7562a1c73777b339e9a373f5886616e6f84e84fe5efbf3ddd646e7e30b73703133e93b9bd19d24de79d39eeca1d53bb871f97c67c75f3837db8bbbd795c4f5dd898e1c4b8f9c1561f58f6fbf7da77e6f6465cc16fbeb3ccf7fb4ae581fbe7f6a6977f69d8ed7f6e6feac52f55fefe7bb3d8ac26e6cd8fff30b7cc2df33fffc049b727bfd018c161bbf9662fddc9b795b399ebabe9fccf9f5ced9ba9aff56fbf26a6adafbf59d3f5377b0392abe37871dcdcdeb45d6ff96bfdaaafedd8420b7f6f2c0dfcc348ff654dd6f8efc17249feeae96bc3bef9b1d838ceedcd70ad3b937056d1a7c14447cb0d5e2b2c9b5367b20aaec66f0e3f36265ef8f768b25a27ae865f25eee82dcd0d7cdfffdd90e6676f0cd4d2e9e2e6c7fad76672fba651441de92dcdf3a7c15afee52e4df41c69f26b3545e3c3fec556d0eef6505f82ad9fdb2532578537fd3fb737b07d373f6ea050c3468238d30569ad1243486f0d18a3b1dc7639ef00b8bbb5c1894b4d66edae5bf335bf3637dca66bd6999de8d70e2a673b407e9a45c622e534706dc66c3dde77fddacca874665db7ea1995fe46e36a5c171db8f0fee6aa7b78daf4ea6cf0ac8dbe1069437eda11c42dc0c63571a0d5467da6d96b37c5aa51414ea991268bace13acc64c8f781d0dcb4ebf668cc3e7aed275c28d86c2cada123be8ea6fc88bc273208f313dca609014f1d08e8f05c6bcac0d68426a39e02d93a3cabba7b0a3c4593f617488c72a042ae79f0b7b6e06c02c273fcace61454242628e066ca50d90b0b8221b27b55d16c5ddedbaaebe0029e99e0ad88c8bd64a1177a2cde2789f0fd88c5d0dc189c9d42a65ef4e097a640700e3a022ca73999f7b651e9afa922036703c08e9e9396bce54a3e4e8439179411dd9fa2e06e0d4eb4419d0d09aecf748ea43c276d7e3a8ee93ae8f7ae6c7b064a8ece2af4f15e0470511bbe484097d315f19206e41c70228b49a2594ffb7012dec156f57112dba737704eb7f58c427dd9b2f19d890f731d419f28a09e4f16582fe724bc82c3de0d1c76ec90756bab8f5fd79d2d482fdcf2d9c6da075c86de72d6baaecdd4df03cc59014efc424e582aa874399297df137480ef6dcdbfc25a478ed3f4226cc1bf32e7260adc6ec142f45477ef741544ac9a7786160009917d243b9b1492b77ddab5a7c0af47e38fd7c20a3c65ad85ec7d58b21f611b4fc9204d1177705fe87267a50dd9d3f394b306805b4b0574e7f48106b864818c03b0a76db8eb738b2451f7a724c265387183f98802c5c7c117551199ae8c8b0ceaf251522b02d1473650117beb3285bd8e8a12e613b9d45559fcd516c24288c58a7b09e216088834204cc457398709cf215c5c892ee24715a9930e25fd10c9628defe28b8808973e9c149b1aa72f708e56e8c290bf31996041dbef2701af81ed6c0d05e7600a357f32e49b6031f08fc62b3bc1c0c1d7f31e06b68b507620801a2a80089f5b47e0365f53340ff95a16bd08601491e620a20e4da8cd4c0232220059240bb4213fd35b9d2d70a17e657a2ad744c0384d90fc76ddf6baee78d3adf36b501151914e9543c0a61df20fc83516272690f74f7957573a07b33e2f99004b8fedbb80c2e8f929efcb0afc86a96010da6f775e217ce46f55c465962f0e08cd8359673109aeeb9ced634c7b4e5a32d61b7d72f9be3897f8ed15cd311662e89f2eefc74c79ce15ac73a68cbd2858e70a14f938a0c8fbeaabe7113710dfce09722f3c5fc5f4bf560ce8490a0823fb1a83b831109e8913c494d2f9489baf85963f65a1e545c701c50a3b0d35799f9ec889db990a041e9071545d94ac491357b8686d05c043cef60c5c449cd311a91f956c80c6fcce9204db337c7ea6ca77285989247a92e40c38fe61dc14adb94ea567192dc93784dae1b840f8f13acc4f44611d4d70ecae12d928a7923147b234d7e5278bbce32d63b6d6e481a7c6c62eea678264f6ad806a67d2ca4e36cdb6fbcb911fe27d783426539dc8d48b9021e6c48e4f62009a030fc8d2c1149aeb23a2b774593a866b55139a0c222f73fb9629386b6d18909350b2a165da86cfd3ba69499d3ed6af6c1b3a2b61c125d80b22b3719b594647494889798c080ed1b5cad45af6668fbb5e6be78b8dc7ddcf46fbaed7e01f9e1b4fd59f8d0ee8d599aa32b476f2a17fa7287bd09bf5b997260b7a0df279d463c58363ca8736a70ce7def390d9f5a68cff32741e9eebd6b237721e7ace6ad71d39e6b384ff3f39746ae4ba65afb9dac3ef7e369af85dcace970f8feb9726e33d2bbbe573dddabf04df498c87ffcf7eef2d18af8bdaf5741047c69da2ecf6bdd66edf9bced7cf0d1ef486f059b07d1d93dcf3d06357bb9e54f4b97d0edd337a0ade839e211f9e7c85f4e367a3b77b1e3ae3673c4e07f4be46d30c9ef932b5e1f76c70fdf368b984bf89124bc675be549abb0db9fed7f3e861292ff6e0d9674daa3df774db3a491df914d6e158eef7544564da02e51f6b95de1b4faa3270882f09af3344ec88da62056d09f65e499fd229ff5d1122d8e4d93987ededc2fd7694001a119e916b27cf75cbefe1bdc0c0bdd09b59707fc4d772830762c3367f3657bed850efe01c93cffbde615c7981733cd3cce7e1dceb8e1efdeea85d3db9eee075d45aeb85eb0cadaf3bb8bf7ed6ad657c6f3a64dfacfc9f43b2f6d19eb4cd9f8da7ddcfc693ff3ca4f6196c9fc3067bed201f1ef7459f2b36d42ada67c39c3d7678f49ea5fe128f9308dfb7eb45ebfdfeb909bf97b2f7c8d036947a955cbff25e7d5bffd95cfdea06cf80ed197974db9218a04c3cce3171172dc323dbb81019234a4c0bef898816623a63902858f63cc86c4b8144d45378a114bd86151bfdf1cea2c6ad880c781d33223faaf36bc00d1c508f0af097dcef29ed2db2df13049c426d764c0e7edcd701e5db1b962747e6e3f3eb20526b9c80dfb60c45b24120b310917a7393bc06f7a55c0268d4b7f7f1f7856b2ac5e64ac747365955de07e42d38619873e059b0d5a63c855de23dba786c19c2d7f484c7d87c65d93845938b7d4d69b2bad249f111a2044f2fbff06dd0eecbc48602ffd7099d36d0939398b64cfb96103e5998601edab8880461d5867a60abe3a90b0915b5c8f285d3641138e905dbb9a4a862593d17f9cede8b2cb690df601edb8f2548f0a58dae34596d185fd3ef4dcc9853782b2ec31191a2b853653125269a27cfe07a900e86d09c69437e6fcacd952e6b558a98121111bc75ef12828b82a447f8da12440f1b4d7030d626205ac7c405b8c81e7a1e229b9ad104e569eb3d438ee6d96269b2a558313b97b52729b6af584f121ac4932af3759a7842634142573cc71552fca2c5db3a3cabb0ccd8e2b1aedaa0ce3b9316bf52e5aa4de274a14fb4e4991f4b527da773ad3809c7bc34314c2043e87db2331544a810d8451ed2fd5ce4fb620d8e22e36a4572bc5be7713160ecef22053402d9f176b295ab4cfcbc323124632cd01742e8768e4c0c753433490a27884b55e9d86a65e018ae73300567f6d63e19aeb456954e764cab00395a70ff193a1bd9f397d1c922fce6dd499f0a85b72c68474498c76e407e22340f06579be97213e964409618558672ff09ce976dd6235f391d07284b7248f7ebaa8b95d061e4c11c15bd39736dd3f7a7c4271d20373d5067e15af535453c7c384e9b93d6aaec64175afc44393750efd0e58b153d603479ef1882bd05ee780d16d25a75a50f2f7a40deff25b0c33a2667bf0c76d89186a38fce71aa48fe9728ee91dfce6b6ed33baced4be636c56cb78f2fa25d88dce6138d7dc22ebce6977dbdfcb2dfaaff87bad33bd800c7a4ddb976003fccc62465904c523abd11e1b608ef005e5fd02ed0e53bda3628e783a572ad4ec514b2622d618e53fe58f471decae312543a0b55ae7a1337240ef4db2d780e895b304daeef9c228bb1bc1884f9f6ccd67cd516240fdab3c6943fc0f69af2de36a68f4b682b9d7837f10fd656b8d095c369b0bd8ab43205670784b105d788c1c17d926c67be8d158cd1d5be2ae13b08c7faa3f56efcdeaf60f79c6c6bd9b3b1d2599ccd2791358f571cf995f4f1338ffd95f4f18ae5bf3c969fe4e9b25e016caa8d62f84d1aab5fc86f3ca4733551e11bac6758645d3828ee34e5297ea872be61f23ca4eb9e813d82e37428e02fef07d7152d3849ae5fc50a1c0bcd9909fb1be05fcb17b0d865b4e352c5c7899e995f10e0943f82b4714d0a4da4ed87832ed47cb3fea6f7249e919a8fe66bfe9bde017586bc7e847dd5e50f2fee47bdfb4b14b529d4de9272756bb8a60d5ce73eb2793f7c1ed01af9227370b2ad65f5b8df17fb69ce35547ca1f715c69df6f35c98abe7b7ac798aafe7abf0ae25fd4897990712d75b87b1d28fe77c2071d8af300727e2b525c7de14a495a6f04bc0559dae5c6581dc718c8f8e5954f0fbbf862f263f5e5b52f64be3f9fea3d73a6bb8d931f44f24ef73db59b66040aedefece3a666e6cf133aded7c3f5ac982d1b4bfe5e38b54168a297ea2b12fe84f2b39077b0fe4f84bde4bafc98d2d7ea2313fe1472bcd8507e4a69f1d733ecde314de7fb102cfd0763f851daff91ad7dce88a57b6c073d26e44713520480b55de592806a50caaedbabd0195bea546fe1c2fc23e621cbe5ae95bba5c7541a5b34ec7289ec036a278c6bbe0c963be8bb338852a9d4557c658f3f402ecf6d658f4dff48ee433ae36fed5c6bfdaf8648eae367ec9f572b5f17fff1c5c6d7cf4ef6ae35f6dfcab8d7fd9b57db5f1af36fedbc7fe6ae3bf838d7f8a6f2d664b362f835f89c5930be137504e78278727232337f5981745736b3ec6b3107c63dd3e98b2887222118fca536dd8679ca7e721cf1a9cb3d1305fa36370ce02b8385f92d4cf3bcecb2d860139857979030ea438c7a054925b7018f225a231c179bee819888b25cebf52e7439baa5be7890e8c0ae76f097e1ad7c813e079cea470e3e771201c9dc14738a8d4bdff568e96fa85b80531b6e53476ebc84776c9f5df44f8f4c4f32fbdde437fc675adff4bd7faa218eff038e220259cad453988f9ad2e5719c477253c205e574db111c79e2aef2c95abed2678ceec048f26c2f6810a4fceae9da50bcd835ee75d5069237e329a57b0246ffe95b7f833f316ff5bf8785ddb3638fbc3f322f03efa0afe2e383ed6a50ad69f92a964df5e88c38de8aea15e5e0c036d8d70aca6886c154c79bf6ad7ed95269b36a8cf2d1ce7c1b2144c790fb803cf749db929375725b9d91258e5f27981319b24bfefafc978e105c72019df2a1d3f0cfa7006fe3d07877d2cbf12b1bae2f59d6416ea859883a8ce9377e2cf58fe3767865bdb4c64a61cf63faf3d457421978a2fe5cf3f1fc5768af1181a42ed600acd555b08df5132ef356adb6fd07b4fe8b3295cfad9fd2cab9bee404564543adef2e1f96183b93165e159fa257cfe85da5b2fc903c139a886df59bc41e4deb4bc0f1371bd9f891540fec70c2c02d96f5d59dc6a67d781e7d13b409d9d016e90a1f7895b53ae32d935c64fe90bd4fd17c3cac0bdd75e431d3b39b6297c8bc13eb5c8f5056d24d103aeb9223a66b0e7376f936f619bcf90dda25720a7bf81703c8564b68873f35b83ad49f0401a276db54ac736041bd74e6b751c8dd44243782168a363dbdece3a1ff2c700f7e19c733b6d1f1de7ed890ce2bd2fd27f726d37ac8182eaf16c0da8a3b8cd15e0ccada9f4de7f4c2ae96d2ec6f390230352f45972ce587dae362fb80fc27b605fe5b9d387fa8d268c893f8cb575798f7988a3b3ab946f373cfbd2da546c5fa4cba794bd012ad2c66c758aee8fe0b997eaeb2cebfd85f8d3033eb5fc3e36096ea2c8dc926bcfddcb01eee1cdb51f9cd4362f785cb3d40df6ebdde69979dc3c27e77f116015f2757989c255149dff00ef18f0cd21cef8d6c0018214f0ab955aeba9f75ca8062b35ee297a63eafc77a02daa724d46537a714e664eb508ae22ecfb5b7947c331ccf2c165ec81d275875d8299b9584d23ccef967fee48c351b13d278de73b2c4f5aa6a34ef9838eeab4d4fcf693b341757f5c8969b710970ef1c595394b505bdf5c9fc8543ade71fd06e9a09ec42727fb5cd076c476ad857cae2dc906753e698f95d967e8de73642ca9917562ae71bdae8279f191eed882f721df2ec98d47f5172a3aaa95c61fa07c47f5707dde337cd87f28fb580fb8a45605c5eba32936839f29a5d459cbf721a4d6012b74ce763cd5dd7be0e41a20d771a6af2afc52977bd6806e43993ad998f7dd31a68833a1a20bce0cd54868890ea9173cd5e5ce4a976b0bc36deee0ef6da88b91f305f34989c4ef26ae4c54e70ed5b573707d256915f89275a597c4b55bf03b53ae92f545e6e1a936ea33cd5ed93adcb89d0fe7f836cef37552fef0133ac330b3ce644e0dcbb08e7582139970d2a3d89f81ae43eb9caa39b2b34c0eead216d49dab6da1e3605c410df1b11b53143349c40031f620aa937e57ba26735477fb5de24b79f1457aacf362d1b9e706709dbd298fad788d15b407d05813be0db8e6a02d92b1960acbc2402e446d2fcf2511ea5f297e1122a3cf7a2eb9f7ca9f9a76cd47f3a752b5d87f07667f0eb8de57c95339d9d69218da99aea4d71c09654f01bf650ec7d6d4f83d7845ffcb70668598850bf9a09501a3cae2129d931fcdb7cd756c831b73e29788419f6ceb97c28a229970198c68a64c38d6d3452fabbe4f969d46c90ba46f181caa4982f40d6dca3bc0ed5bc045b5cb898d82eaecd0f5890e66abc3aa95016b607dfac8563887138d9663ef143bcca9d17c6a6d907baf3c9c67cad88bf2708676d879b1acc8063e03cb82f7c485b02c015e287f8f63ac4e71fe66e2775a043224c0008afe44e199f83e75161aaa536c3ac0957c554175b4b626f2933ed1782ccb5444ec371ff24b554198f195c6d57c5d192c41c9bd4efafd2e3e5455de2f40a5e39982bd2e8415c4e36bc5ef2b6cd3891aec3fd7b7d4c53cb489a3314235cc1dc37538ed080b8cd740713b8ed48d7b6fdf6bf89e14fe5bc1dc1a50c713984b9d753ee092b6691a46b6b62ae8d778227a28c672b73a5b208c313ed61d5baa221e74d9dc04b538d5214ff8444bf981489baf3e884fe083f8204e4e389667ea951cb9f7aa3b7c06dde1cae15d6aed5e39bcaf1cde391cde27e3b847b54f2cfa6cbf509e8d6354f82d588850af77ba0ae1fd2e90776308c8fe9d421d619c5e4b245bcf8e724a025de2a0297d14774fd643317c84492239905584435595bea50b4d4e8bd75cf180e0309321c625c1b301c7c1427d26e23247f912502f826dd9dbb8ae0a8abbe0b88dd0f4716e0a8ac141fbb57cbc25755cdf45ef8978dd3fbc461dee572ef7ca27c2cdd2dcf1571e872b8fc319637ee571a85f791cfe4d3c0e91cc1c5cac661ded43cac1658c89ce51109341ee1534a82fcc75a81708cd2af1c16f5445f3b4b3f272833695c753a96ef3a08d54e6943ed50faf2b10872889bb5115d11167ef944bef6a1e10a45cff41dc5f08af1fa7e7d2e177a6ea8ca3287f0ee9679aeb40dd6daac9dad670c796aef42d936b1edab02d1c1c83bea52a3d2b1cd7d6608b7136088339d70469de169c4dc24fbb6937090e2ac083a23afbe3a0b6794597f72bfc9ce601f31c4873f46c8c4d737579efb45be65293efc8fdb8bff0799a20a11c93e35ae879eb29cd37758c55ec2ab13a3d697a0ac91f66a3793f395738df9960624be47e93fd2977c2bafe2096071dcd2392931c1cc34c7c1291818573c93855deb39938e0cb711d84efb90cb7478a4ccb911783c4b817b4bb12dff30753e177a002f7777541d630b4251cb0e85bc81e2f199b48ae8733f25c326cee541b34a8c35ae87ca0cf2992a38ae23cc6626e018eecd7166f43bbd4acf3480f288971a4da7e462e4bc539a0772aa2a356245f5706d5aec2af54f964adaad14411194d66ac3127cda03c305b736b2cec6d307d5c8e59b17fc497909df355352a70def983268bace1227b1a9ea39b6e9d8ff88e5a8325a8880ee67bc17b9dd4f57a194ae2b8dd14fbed86b5ebd599fd4b320e953f8607d297b511f5656da0bebc4fcceb884b2a63bd0d633effe2679724573d43919c249f0ee054724684385f1423ccf003949583547db5f3b05154fdde54ffbb2eb348767415de071c3ae7cef4f13b6b03c710a0be81cfd0d43848b036d9992e347d5516194de9784665e0a967ebc6279e99167708f6a90cdbbd77105629b407cf8ba1046b1e8da9807951f2706928ced4ea6c419df580c2af3422cbde8685cb7f661a964095c55f5d99c5794ae7bed76dce749f5dabca80d194f6d9f8a1631b356d5d1dc7c082b15739db36dce6cc14a0ec15195dbe5c8dee937b25254609141ee584c077607db7587e8dc1119d5408fa8f75ad112b36fae31de2d121fa338d7740b2079f81c19e300f4436f980db59c89641f97fe39279bf27e7e1ca71f459388e7e370e90c8c9cbc4364a9c17393a553f552e14cf9fd4e56ac09d91d68e480f08f7ddce322a12caf3317cde335b3dc21f03f7aee41b285f6a67a97275de6e45ba22deefa8dee84a5334071cd99927b855c2734d5caa4a07ae51c77011be7176a4635f48f7a2ced2f987c7f0a9777f059f62a1f6d6cbe1ada367a6702295d7a1b231208bf8355d455cc2f3f0adfa12de172cc1a064e7d92474f2d23acad1febee25152aeb9e2513ef5d85ff1285f1d8f32477c2d729335057b6bb8ce7d9a1e551057679bc278ad2a120365a7ae784e7abd970ee507620f9a5c3dc06798f833d133cee476a2fd3b9cb4313887d1150d7d0682c469726aad055a6788e739bfd9f68ed7f44aaf7f15b519da745097ebca7bc774a5d5b9efa7c617daddb65ae99fe8ffc0365bd9588353ef83f2d254d27d1be8bd4ac7d638694eafb3df146b47ebcb709b0b4deed36bf50bc95cb436a0ede76875d6369501d47b426cd3e5f2e69263c34e4145da6842d33f9bd3edc4335364c54653061550e9fceaca4d5fe56ca8f7cecc96e49f5d8fce951893abf9bacfda862b2ee19ce0dca9744e38ecef645d20d42ae7e656abcac0eecada0a54d2795c8c38f66d8de2e3793a5f115b098f1f9441c7d8ffc653dab527fc50c77b80e477ac32f769260eac6c3f827fa77d05e918b9ec3d91bd0f3b1b50493b0b73fa9088ffa5f3f3fe7e9f48c2463e69c3a5e3ef3339698a9ef125cee1dce71f9d7129b622d451d9b4be9e924917f61b9daeb5e084f1322aa7442c559bb4dd9236bad264b561bc3e00c1b1603c2a19eb44bc6d3a19c2df9d436edcae5c0c93d287de0b33128b83ad7379bb32f3768231e6433f5df95a0d543c8fe014a8bc9ea00e2335f69a0f3826cc5323f1621f54348fe04962f13d8c4789702265f8c30afa7333e272d9f1cc8cf8fbefccd74ef8720aefb717e46baadb886bbb607c7b8cb0430b6905222eea80473f6847496c58d2f7f586b83f96df6bc20556d83f8db1518fcb31becf2bcb3946b8c971fd8a6c3fb40da6506e77b660e1acbb28a6d464da58f6b0ba32b0e1197e8cbdc3182bac97f18c2a77568463f460caec5a553ad5b6b0f78c4a597c7d963ff18cf12f62879e980b4ae68ee2e727df4b3d3f73d627a8209b07ae510f04582f82d709e63cd14e0b7118ca2665abddade0bca07c5a8c3d837a7570660843891f75474fd59792f89e22635504f393b8c6010be938df18e5c0ecb7668a1e00e4da1cdaa9661a2ecdb53d8db3a6473e14ea5c8f8f6bf1333e715f91396d00aecaa8b2b3219c76b62eefe1b900f516a62da4ce331a337c26c13dd6f4a17c32851a94c72b74e670a263544447abe3b8a1d9ead86021ae75658038c8503e75f65ede19aec3a9b2584d72c575ebbc0ddcbe85ec7e6e4caf9b346c0fd73b3bc694ab335e2c2fe69a9b71cdcdf8cc637fcdcdf8e2b9196e427ea2da501f2e6b12e74780f1fefc7391f0b987b6e0397373467e6dc6dc5d0af395deb77cbe9ac4b9d84cc3de67e0bb03ee8905d2f7a0ceb1827abbead658b3ce232eeb24bf2bc1e3d3ba3c032a1da457c07341e550edfb7917d725640cb7b93138d6335b03caff10f927e21861ded715cd562bf3e47b5724278721dcbf14bfacb3693f553b63b66f8dd947eba5f1b8eb8ed45d491f46c67eb8728e7c02ce910fc363a4efbfdc1ce902fe2dfc6c203b9b149ffb3eedda533ec823998fe3e62bf0941537cf3e2b4af6236ce339e7cb291cd2eff1d55ff96f3f9aff16ca1f2df4e93657286f450eb088e763d1f39e9956df162c068e5689c7e6cf945f19cf4ab5773878ceaa21f74418df38139791f3bc33fcb4816e71464c6806b8aa1b9c39aabc4f8c45d1f13fc66c996ed3034273aa1f3df33df5ae2275b353752f2b37ef2723a60312b59edbad260bc7103e83d69b34cee134f92e3feed0920ed8ef13f9f346736724d5f9d1b8fe78d76b3caec5d963594c7e8aee3b7ed798cfbb73efa1775c660d95916929bcb25be06ac1fcdb8493a643f6b1354af793a6e78ea1fc30e9ce10704e405b205823548b2dc47bfb9a22b2a0857338b445670bc8fa32e52a853981f70f3c831357a022b2868bebdc24f64bc97594d6d74866bd0f17edf58cfff033de6d1ecec5f004f7a6630bf78e5a19a4e45817c610aea0dda6a5f3f940ddfb7f4d456470dd0d16fd1dafcf7e967e606b82c71a9501cae7e9cae86f5a769f755607e374997c72544b6991c05c9cce398bce706b189ccb4f01beb1484d41144b75262d9ecc6b908b82ecd5a01d8873c194f738ef3c88aba37885b4896352f935e0e2e7e2db6556fad85c65d555565d65d5ef9055056da8941add095be2f7c82ca853553a2c7926924faa2cce503d4994875bf3719d32f2bc78ac37c478257db3a14fb59cef33c7bebac658ff3b62aca8f6c799fe05726f7a6e43249fcecb03744060a7a4fb4ae6fa423a9802a99584fe8ee125cf93d927e5e639b62419a7cb60dc100757210eb0102ff4b8eca7d9a0759ed6d156b86e66527e16e36195087e91fc3645b811d48637bddba2304256287b88dd5ab666201eb72298ae849c97ab2c38d201106f93ab2bfc01639f5884f13f5ea79a67b47aebde685eedcaa846a29f529fda4a3c6ba9cbe6b23d4dea24d97a430ace28da3fc1f955b71b297eb815a9954a74588c01ea2b9aadcb7b5b751d345f653091d4deb79e9fd26cfa9d3594ab8c269b3e590bf0dc4a9ebfde45f3d1297974594c193dbfe9f832cd757686cfec5f46f3753ed68c7e5606eeaca4dc4babdf4fdd6f0d8fef2f32cf9d205e48cf35bd57097e8f70d5392ee19363e2ba0e928b69f7ad821aa22a1587c6f71ce99ca4fe6fc889b0d1b8b29c2227c7b47c7dd705c5bb15fafad2731cd3f66eb8ee9fc275ff16ec27ad83d2ba69be3e9aefef5be19aaff43861d90184dac1c035ac6c15610b7b8897fb4d767625773cdf874f82c2a7063aadae684e574e93696fcf5b2df28e1339b5c4b730f04c770c75c050c65e209f34e7d9a91c0dd47c913940bce86cd2a6385b3f2cfcfc149bf4cc5c9eac77a6c5b7cae0bc5fa9b1bd6fa79e9985796b6dc3359d686fa07d98d63e5a6e0658f01596c970afd736c16f090c7a9add59d195c1520f784a439cf0f978f0027be18cbaecd2c69499541f44ec8c46f99eb9fad91a7003285b99941ca1d8b374c159e8f576d2f6ca912db42e56e69ca0d6e36819d3af8ae6709842d3d73829ca41c77121aa3de9791d98cbab4ff15e8a0e5888705e39acbbe1e7c4e2a12dd33305db03899cb4b2f965c5e4d357d7f9d3fba82674f4931ca7949e35a2d6dccbb1ee5d9403965195ce4253c2fc4becaf4ab62b25f7b05be7913da6d5f92d8874c9581e4a8a6f1ed743179ccd1bf3ac0a9f1d5fda5688725f4fc991f41cd9acba6e818d1ff25a23bf851df2e1b682e745b8c273f777c02ff13ef114d4ce62fcd54e706d61ac25d2c1e13a231cc0c8f789d6b16b5801efc69bf33853e55474ae21bd86f043a869f9d1f87d2c807d98b273a43308b51df67f65e4e32fa40d94695d99b575b7e6631997632f45e3e419157e8bf6782a4e08f70954064bc4ddf919eb28856be632d8907c8e94935ccd3d8ad3c4ea1f739a14d31985c4f7e8bc6e6ec83c5889186a983748b021155d70667afd2877dc03aeedc073cbf0f9990aed4c7c1e78446ed8669d3fc07e1a9c568df97a5ae11ca03893e1df597db9ba85b2069e239ad25947678a8379455dd1315a7de46f508750df853a1aaeab8c796879280f38a8031bd3727ae9a939fa708c531c6746e78917e71b75b27802c21ce659906b887d02b95c2305b8d889ee9556ab23d4574ed67e266bff8ad54dbbe6c363e374bed9e2a3ebeb17e39ffb44635f2c9fb0e41ca0fcb34c2e99f71afb7c1eba4f34e6f9798425c73a56efed2cf975a2be9d9bcea3775e4e4b9341f60cedbffd841ccd9998f2053d5605b90b2f135f3dcd8b98c7ef7ca483f14f2976431e7604bd3f887f44b8ed8ea3729267e2bc36a8531df1bf95b4ad52fb7986bdbbc35cfb9d409f9c3cd72dbfd778dcfd6cb4995e837fe8cdac5dafb55bf646ce43cf59edba23c77c6ef0406cd8e6cfe6ca171bea9d22ad76e4f3be7718575e2416f4669af93c9c7bddd1a3df1db5abcfca6ef95cb7f62fcace970f8feb1789f1f0ffd9efbd0583af1b5a3bf9f004bf7be849f85d3f1b1dd0ab3377ca70eefdac5bcbe7c653957c5755860efebfb2f27f0ee76bd8aedea8c78a07dbfcd978dafd6c3cf9cf4366d96baef6e859b07d0e4beed91de4c3e3bee873c5860aefd9f782f70ce1ef7bd01b754cd28f87dee1d17b1edafacfe6ea575762a3678c3cfa79df3a49fc877ba497ae55b706ed3adb589cf445f3475c824f9dad2957e78853a5a0ff30f17dc045ee197e68332cd2d66d5b88de8562c12de29717f6d02e608d56583f22c87798eb727361b8cd75c46be1ac404b826b14ca50e297381a138b1a93b231c2dcf17d1f1b20af8e53e133f0c87777ad3b9a72cd95dbe2538ffd95dbe2ab735b0c3c55367d3543a74fda07b975aba0ceee1cdbfbbdb4769ed2018fc69bd84dc23ed36ecae41a2fdb8fe0df495ddeb1b54ac706795c1639ed817226cf8e3acde787eca1cfe78b3d3a1f2fe393a5c73b1f4b15ac69a668bc0cea2c3b5d706641dd7978a698ae33d7b0df92d3159155e59d65b8d2ce70a50355bbeb28ee8a6afc70b5b536e46da3c2431d03c7cc9492fc775c663fae7a4cf1f6fd4bf598eb19fa7167a868d3365779bc3e757f3a17795003f53c2c1b757f4a0ddc3373a79ba9dc2e2979d276c9fab553c3751c6d8a6253049332d8a2b817d6cb1d1207096dcd92fe95e8b967f088e672d5e7dbf22f016f7efbe98837fffc78601c6f01edf8909fbf2d24df03cf263ed0b9569321a90d4c6a51069c92e1391bc5033d20ec593826d4f8e7bca7641c2f674cafe7def5dcbbdaefef37f657fbfdabeb1e57fb3d7d0caff6fbe7b3dfb3eaf61c636207984b5e48c17c978d73386d61bfd5386706ed770d718d97c1ca63ae6c55167d50110f24c617d62381ebfd92b111c4c70ddb58e995f515d8a6f0b4d6d2b8d42f94779336eee56d9da3b575c50c65c8972b66e8d38efd1533f4c53143948d7be5894db4f194dd1b9c639f8f17f6f762a68ecec70bd74ed26536f4d514c4a187f8a761cabd45fc71632a0e92ccf95115923f0c75b4b81f0ae1bde9f66af2d9b57f3ca084bad4e9bcefb7e4155f6b7b5f6b7b7fe6b1bfd6f6feeab5bd598393fc6b0dd9e4bf5332473aa0bcb3ec39c86e0b942f6958ed447f73f889d3eb8e60fee0b521d4363817ea3aa7a5e69413b740e1b700e5607e3affdb41533a9c2e8b1faf0770926fbace2ca73ecee7918d15d131169aa7a673cd9d231f0f46e511730428889b7e8372e1736274ef566b6931700c57dc02b78af2fabf885fa254bbcbce4d6487543928f340a573367f4dfab33e9f4d672a708df72e65cba1f3ff241f44905bd244e79ed5e7f6b65139cab5cfe47e4072006103f0fd610c1fad8de6cc6cf5305f433d59779ae298119a1b5cd7b836d3e526e611736babb2b17d347eef64afbd5fce2aae331bd45a3f930729fe8c541fd4d59ffe8964e7d59f7ef5a75f60ecff9dfe747c36e4d65d7b27f9d2d90252d3e60b8cb90fb8fdc99a6aa5e44c2c279945359f7579ef68dc87e3cd6239cd5d05d585f480202dbe842dc3c5f26dd746abe399aeb3d26433bbc66ae93df2ae385e5f539aacae745272d3113f5ab8f6e0fd17e1f45f507b2f3f0e3184ef6e3fc53851acb12bb99ad271807bccff9c85f130e87b5a832d893ba0be193e8fecf6786d74a403978c3d44fd2a92779dd0f173f7413edf57ecda627cbf1147cca12d3c5928df84839fe91c75fab9fc5293f7f3b6d0a992b1433609aa092fd4369a8f713069fa7f125fa3cad57997abf9ed56c431a6c5b808e9f796b31bb2e5da3b6182ff0b6aa16a0b69836dfeeaa1abf08e81f14dd95c15efe4c38ada71b73604c9c7fc92193c17f5cf85cd2cddf6fc33e082b51c3516b822596f2c5ccb28167b417e0dd794ab3352fb35fefc7cd92e84f72d7ad638366645643a916b7207ca6fbb2d44fd6cb7e8358ce58a3ae417baa23960cac73954e2328ed45e740e810f44c53e949298baa82d89fd549e77dc8dfbbb4fd6168cae2d9c1b02db8ece3e2a2e11e433aa1c94e71d28936d63d127f56bee9231fa73f9206780637780ab3a60f15eb525e93161a367a6e19d33c7921fa7d4083f31aea26d0ad202f9278570ad22ae4872c6b1c682df42dd28c29bd2ef4c9e7b78ddfd9b6a745f7115575cc5a71efb2baee2abe32a423ee2337df5211f736a2e8622c2b3eda0cb9d95366489ad798df7c7ff9d8ec319157e0b16a2a7ba7ba7ab201bfbd3613a7eb38f86d6a3484df3632ef237d47b8ff6c985b0a9014ee2644c33c23514f26b2472736cd5adb1669db701cadb41352fe6a68c38cd030cc2c538e8034cc3953ff813f0075f73b53f957fe49aab7dcdd5bec0d8ff3b73b517e2c140beec1a0338719bac3717b6e91d6d6153a8cd00b7fb0ab1d2d8385d66fc435fcd99e71475ffe7d31d437deb32b13d78dd60ab72b5d549dd8e5a5745306ac3641e91dcd99a4a3f8c1985ef6d49a87e95293c447c6c43dea674be92ba1ddda777a993ed65c65ae23ef34e4c372bee8f9480ebec4d796c0535e334d7718c6954cf27accb897cd07c6ede62611fa49b8783cbab3b8c31706d01dd1ff947e939aedb4ed73536dd3a3f1e357b5e99ba3b1f5cc7626b2cfa6bc36d6e7e03b66287b0844ac7066e0e1ee1139d9b8650f3cd3aebab72350fff912bbfcbf35690757a19db3631e6276420ea2f3f24fd2d62e32aba20f98823b2c5af7459b455771ff2cbeb410daf27a76fcefa161e4f9e8c278ff92ac2fa3ea8461bb1afc37a568ee197acf3189fb3f7c9b1ac0c6c639155a7218983c6d716b67de7ce461350fd6107736c8c2dfc3e52db0b8fe1c694d9a9a6b42d5dbeb3d4218fe6b9dd0ae639659dc6fa8eeb2e66d9e4409156a6e0ec80200558b7d43c7080ec6f36a811b64b8de7cbd582be0269731493cc3f7fe7b8269874203e0107b85f738c4cd8a68588db5764ac9c015cdf07dccf378d1903cf0254376dd1b7f0595a26d69776e6a7d7410de59b33688cb37cb08ef43426f54c8fc6e94cf9672a500616f0edb524df106a878272efb84e211543a56bdf86bec5cbd424a5fa539ef7f1744ed2f1b80c53ef298689403a5cab6761fdf8ced2e1fa53344f6b4936a06abda5b5abe4b8a4e60c5d7d9fc5754e68b781cac0e92a2167c14695d97363f6be26b35b5318afc3759e9343728dad5f63eb9f7aecafb1f5af1e5b4fc6bf77ea8918c9956f32d3bf07f5ce8dc1395300e53bae5bf76f8b819fc51d59ec8c4dd14dc97ded276493f82a57db0054575c9ab79b21c7e30b7e56e15a70f8dd915e119cd947fad81b3095997ac01939090572b773f290e1d841db44a8f947b22b0b37d9121db34e7836179a031683adb198af917c83f6e66280b833c37a1b545d0d32a66b55aea2dabe6d817e3f7c9ec8a8727506ed87c8a718cc65499f4738b6397dbc90ef831efbae9c1d23795f5e83e45c7c85f38c9e1b16f927ff4b72b28ee7e372d89cd4b9cec79dc7f659078f73c1bc2cced968dcde690bac0d48ddf398ac111c64af5e04a393ba1eaef19ce2f19ce6c1e02406e7a116b1c7f1f589fcbd127ed99aaf71cd4d587bd56dae706e99c4b485784e60c05561f83c1b8dcd5d84ab5f0c9c49ab9f26f72d09d7b45cabcadc52ddb1a5a27359730c57da207c98f0409e9378a7223a5a9df7c014e1cb6ca382ce2ec6709b339d43e31efa5b480e0a67cafb8da67482daf9d5764b9c41db0c8d796bb005dc2e057f57305704efdf2b3eff339d41571fc2d5877081b1bffa10ae3e847f830f219157b8be7225be852bd1591bc2de0132d431a57957fed4dc89bfb9768993cc3b466b00eb74ac9712277f2fde6e9ab7dad1ea6c2c47f6444e75277e2fdfc8c9afcdc35f053e98e8ddaec444f5479abe36e413edc47a705b303d6863517550687bd2c2f664395f4b9e4c7827fb8dcdc67a2663b547fce896a4884ebb89f772f11824c606841c7c02bd779b197939a5ec826b9ec2354fe1538ffd354fe1abe72924733579fb0477e4b5564a962e9362f74cfe6df5e6ced39f1056c7706babae9c7686e6c7aefa5c6d63b63a5b50e73b27eb8714c0a9a5d53831b826a32b62c4bd85fc99f0cc477e4a1f70fb43791e95bc3ebf8b8ef4617ec6a4fe177121a1b9f9f858585c3f0f633568ccbf824f3269172aa16db4c63af5c7705625e7fef8ecce6fe7c5f8084fbca7b8cc60a72a8e090c136be21de44673d316106e9dd3e501abca3b1cf341ed789b7d15df5fefc5cbf4df89e98ce6802d1a036c04b2bb04cf65de3ae2db507eb992afc9d519b42901e2470bce965d2266f8469ea96b9efda7d2e7aff6ebd57ebdc0d8ff3bed57b7538df9c8b339c9af766bfaba994e86097ff5278b2f1dc5c1dcdaeaea9f283dcfa9dc669fafae2b6f1b6e73ad2903c798b2074d1930708d5ce388c97fa7eb759975e483b2812c319fb1a69e81ceddc82ebcce6fa9f9853ade29ffc57fa78ff1645c38b49b70bd820bf1df41bd36b0af4fc474478620cda04da8fafc88beaf18670a65ebf961ee3ff5fec181ca938dd989aad271da4273aeb53a8e51111dada45f81de935fdd0779c53a7e38d6f18ab3fb7d38bb2b576dea19f6dfc155ab2ee6575cdd9b7075093fc1bf4d673a4fe7c9b0194fe661d2f6ba3546f5e28ae6121ef9c1b7c01da35c0c20d77caafe13ae5dd4a2f72fc9cf10b4ad31e5c3ba263856f06451f55e2cc39556aa32f04c97ce1509f5a8b235a45265c8fbd42ebad6f7bcd6f7fcd4637fadeff9d5eb7be23c83b52e7f384e8360a6701cf6d3ebf3158495ab5eb2c6e767e46348ea9e05b015d1b54f916f2205775dc81f32c07d8467fe1254825a4c949e90ac8345e3b05b3d4b45fc62a40e5610eb6f95ad0f76a43fbe0b6fc0b54ef8a792ab573de2aa475c60ecffa57ac4356e7b8ddb5efd54897f5f2e6efb668cf0e5ea4ff5510eb42ab38e2948fe493f142731ed26bd2e795995f7ec59f5b631fe949e9780bfa4a2cbfb15aab72a681e10f6415e25a94b3bb6a08e0a841aae6fe03657a60cbf23fa69d9f81c2731284727ecd3fbe8a1a49de7e99f15387f927356ec7611de6b5ec27649edc771bcb6ccba18235e62c1f6554574da42cd6fb7021919e4daa27570e8ca21f7516cdda872754eeac6ae349abf3c8ee1b6e8586cc9dc122eb53f39723d1cb3ccb581f657c82d6770d2cc543a9ed99a435bcb4ec6710be81ab9cf8ab7297dcdc888639e70ba29a2a356245f5706d5aec2af5419c91deb67fffffdbf9bdb1b4fff3559ac6f7efcdfcdebdcbaf97173737b23eaee04fef5cf3fb737d6746d6fc05fc6d2fd662e8df9e4d7b7e5cad09df974fde3dbcbb0fed8fdb6fa657cdbaca7ce0afdf79bb701ce74657f5386dd11bef64ff06bb95b4d7efd694e579ea3fb7fed570e7cdf74f17309ff6f4ed6fad459c13f17f8cdd9b7dddeaca687c9cd0fb65a7ba8dddeb84b7372f3e3ae728ffefc7b3d45b7730c5bfb9365ffe4aa2396fd51adfda854ff626af735e67ba5cafec1b03f18e6e6f666bafadb9cfebaf9f153775693db9b958f5ad0986c6f7edcdf7dffcedddeb417cb9b1f35e6eefe8eb9bfbd119de9627ef383bdbde9a1b7562a1c73777b339e9a373f5886616e6f84e84fe5efbf3ddd646e7e30b73703133e93b9bd19d24de79d39eeca1d53bb871f97c67c75f3a3c2ddde3caea72e6ccb7062c0cb1f58f6fb4385656f6fc415fc86e31ebe3f70951af3cfed4d2f7ee9f7bbfbefb5eaf76a7069d0e97f6e6fea892b6b6cadca715c70e5fddd7dad76cf3dc04b95bfffde2c36ab8979f3e33fcc2d73cbfccf3f7029d8935f6884e0a0dd7cb397eee4dbcad9ccf5d574fee74faef6cdd4d7fab75f13d3d6d7dface9fa9bbd01c935737ac9dcdcdeb45d6ff96bfdaaafedd87224bf369606fe7aa4ffb2266bfcf760b9247ff5f4b561dffc586c1ce7f666b8d69d4938c3e8d360a2af960b7cadb06c4e9dc92ab81abf37fcd89878e1dfa3c96a9db81a7e95b8a3b73437f07dff77431a9fbd79504ba78b9b1feb5f9bc9ed9bc61475a4b734cf9f146bf997bb34d173a4c9afd5148d0ffb175b4112c0437d09c4436e97c84cbd4930fc737b03db7cf3e3e6b551bb03f2ce325dc93738670b66ccb437bcdbb5a7bc035c71ab09ce463b3053491a08dd91357d3ddc3dbfb6ec03687807b3e5ac34455c6ab2c4b45bf61a08d5c3cb02fec64cf5d680311acb6db762564cbf5ae9f9d5ade11adbde685e7d19d6d431b3db4ab8a0020a92b5ada5d56e698eb110a1d2566bcf9e36bd7afbb95de7ef80bcdf188793cfbcd35b833540f73c52f7795b8313ed589b38f160b8bd0d709d8359af2e4045c5408e8a3853e5dd16f51fb64790ee546ecf6ac878940ea6bc87ca8f0f0f9289201d5e871dfcec7afb0f7848c17f646ca081ba03429341e4a2ca60a6379869d7c7d7b5eb8f0fa8ad8dbbf0c07a156c4795fb7fd007e66b0b2b09af16a58834f67706b7bb278ac51a70aa6570d24a9345e675881d989a5c9dab72957999057df7b0622aadf6cab0bd8d3f6fb725c95389778bcc44464562e0a15d6b6332bcad5a110fedd9ddf36bfd69dd1df27d4de9ac756570680b926d70985010ae252034ab4899af33ebb4f6ab5c6d3719b25b6dca1fa032aa56fab5f67430332abd69f770472bfc0fdd0a6e0bf59c872e9718afc67e0ab841b52d882b5511e1dc7c07420dcee71a406575686d9fa74e0db69d7ef6a425ae5edce6ce10f6d52e72caf32b5cd4e5817af66eabbab5f96444ee6decb6d4fc3d742b8939c7d73d87cf5f44cf6d0bac6d0ae2325c3b0d6fa62a031b08b545bbb5b3c81a84e3b3028234238ee870dd9c6aef6bbd86d787202165c624f37a6a6d06ef0d12e982b17e152c9f1ad3c0c0a1c71dcfa7c2ef406bbe8ede2b1dc27984e3d56867b70fbe2b5ad7be2e3751e01ecddb8899066beed8c1500d9398a1520de4a6af71cee665d674c06865190232f436da6169f56469fde2f35350817ba47d8f8a1fc97796c1d556badcb754776fabdccafad90fd7d8c6a84854721eff1dce9de19a1e98b20ca83cdeb71b8f1b113f77871c3d7275f1327ddc6b32f3bd2d74b60654e0674b8b0025e7a0324024cd69ef8adef3f847bbbebcd75cc7372afdb5cad91e10faab76bd7a30e52851bdddb8b39e679eab2b9d83596767bae0acb4d436f20e18f2b570cc228375d91bee2cbdd15eb505ebd0adf34bb10e3f8fe1670e8ea7e6d636669d755599f5406b7edf6e893658f4a0c2ecebb24abf6b0afb0638e6f87d8275a09e75d015cf8163d49b3d42033b654e1fb66d68684d771652cc7151e6683ca7ec4a9335e6059196420347dc02c199b785ce4a95c55ff45c74e5bda32dfaf43cad55a5b3d0e5bb351024d76c2cad5efd8ed564869e8b6dd7e7e7baa26e5020b2cec7e612b8b58d965c5b42ecd9c76350afa2c02232fcd2fadc1283622896dee885466272ccc4c49851e3b3ecf981e1c3bb708d7415d19bb8e3fb76e389e9d5c754ff50dfa2f70446b5a06dc1a2bf3638e75e1b2daddea8b7ebc4e611ce49e2de02f3aa090e97bc37a58dfb781b2343cea8efd033bac4c147eff3ae32d81a780e19bce7d2d6e3e3a6e74aebd8b8a1feee3de08ed7ba20791a673369e38bda5e629f4deaa9fb2c5c4baaccc0be473248601d4d705830e40f80db7b5a9d5f18aee480d9d1b8def5eae3efed168f9c10c03570bfb878bf12ebcd06437e17938bd3c75d62ad5363520d9cc5b08d297ba88364aa294877669df5803b7000fcbecef839cfc40e7db84e04e700e5fd64b4b474197def74e5ce0a70f13d0bdbd825f25b45a4b8a60f2ad206939aedb7700faaf2de5339690a5a92f3923f9ec135517f944e30dfbbee0cf7295a4f89dfe8f5df7adc60a06f756ab8b55f9a7c87e612ca1cadd2d99a721593e50935ff658ac81eb6c01597baacb980bb8bcb10599aeb0a9cbb40760d6cc35d6f71c14738cf48d75a6b823403950e0ed8c1fba0bc19b28cde82ef3397f4dca3fdb1e85ba680e68fc30e4da89bb236109c0540e7109a3bb85ee9b5b43685da8eac8dd8f7aa5b634ca1b6ce5b375dd7d9a08280f59dd575c525e05061c0b4f32f9a13a139d30f09195abfdb27deb131e5aa6d54444fe3aa8e56cf7da6afc97df8bbad72eb85e1d658e022d2b715a8c0b39ef780bba6e531d293e1ba0cafc17380f7df3426bb3db0101d38b65de4904b935ff3b8acac5789038c92ab95411508e3fbb6e03068fd1ec2bd34d76411ce3d724cbd4c7916ee2d0325f1a6cbc4401622e0c7b10cae225941deabb9cdb52eefab2f53fea0cad54357111dc375dc97b00fa6a3cbe6129e85aa5b5b698d55cc619fb907b10ef31d3912f19aafe882c3c4ce463f38abe17e929c4923f69b1fbc179dc18a159b7b5dae1ee01a8e6419eb6929e7d44b234b8fa82280a6d6ead8267747cdd9517fc3f143f62c944f481f776619cf9d828a95b9c780eccc35610f654e4597078c0efb97387fba0b9155172283cfdd70fcd6ba3cd8a0b6091d68db9598436c1fbd4cf90d2a762cefc27b4d2e38df8e7fc363b8b25e1a633886d6f1d905e781dda9b2c8749501291abab24cce999b8205cfed1d5e6bb00f7bdb6889b00d708dac5405da75fd937d888d0b225569cea08c5251b09167d09c27ce3da2cb65ca5e3c86a6af29f3d372c84f3bb31f23dd2f79e6cea4c49911ea20ec397a3f7e4fd6fbf8f47709095b46a86ee3b2820fc68fd69ba379192db1dc139a3b446c13ddb7d287583782b60791596b14a41b2d2da3d26451821735672abc3eebbc82ba72d2f668ecb6d826d66cc375d09a42b6e6e3b2a8bd0ce5e50aca8400c812da998f941d19d99770fc1895b36a6db77650b9267c7e507c790db8819362d73e046d84fa4357aeb9504747f678839976a7c48712f6256a2ffcbe8cbd8dd6aff218d9db942da0727bdba8f46a6d17c9df5d7bca7b5a83994e2a587ee0000a3bd5646d6bb8e325e53bc0b66473b55786b5475deeaf3afedde25961a6b40fa580efe3a15b09db73dabf507f5a774778fc754eaa9262dcb5b63b70505fdc8884fe684ea8a449a3c23baa8f8b25bc0e3b8f2448bc25eb249ce3d2ef88824b55dad7124b3aa4bfa783bd64dc489ba01d3e5395feb21f158a5875fcdea2fb8413049e617ba7c4f795d966899082f5d7ea628e9345ebd11a7976d68fba3c30db539e35b871ad3de73dad7e3c0eb96b3d1a57caa783ecb119109a07e3c04c27dc7e0baf25459f96e9eb44dc1aad79d2df08d756cc5f47d6fc4c95f72b7a4d457d666d9d1baf937ea6986f9373183d0021900258afc38e6fca5017eb4de9bd18ce55abb3359aeb47b267acd7e1e302fbfd4cb33d8dfbf2487f6c20ecd6c9755cd8a715f5d5d3a63ca329683c021915f7d5247c8ed8260e7d6a0e706d1bb86200188d8f09f6c131aff308c8aac9d5252a4a4592715febb5a5d8b88b032d72fa903a7e315f174a76996b2366aacbd5196849732dc56f0cfb11f3c956c878cc8ec608151e9f0cd948162a3d7a1d05e3461551910e947cf6802bae4c79e0c4e792ec295985baf401f5c7ad31cf42b0df2990aed2f392f3a037fa7fd08459c66169bd0a738b9c7bb5b69ba9434d5fe7e8cc7de872f33f92e31fc8485d56e9756777878c15e853ed56a04f9928d88e0bb43faebb948f391cdf8c73869ca7e8b91a5cff2d3e007fcc3559b34d790fdfe3ab0a8aabd8a01e3ebfc8391b5fc364dc8b9e6b05d65378c624f643d26feffd4cf337a7c50f68f918975dc9bd1efa9681dcfc856ceaa45f3b5abbc88e08402fa11c5b7458006d5fbf9db68e117057664219fbbeb2a8e45ae973b603f550504171bbf85c4aeb4732578ba4eedf6119aa40520dda6f1e2ab0d414fb2f8dc75d77343eb45b702f41fb150152d4314381b77062dcdc709b1552bc684948e16db5d2b74c01fbfde13b86cd81388ad62b5ab3ed21b36e37794fe5d6709db3065eebf09c407ba83b64fe28ba3ea3b5598bc7c886fc433b941f22ab46e7e736192381e31fea3d43be6fcafb152946c6009f27cf2bb5e72252562ea6b3807eb83f4c741ec2b9f859e753f76a817b83333ba35df9673519df42e773d7bfdb506bfe416ff40aad6dfa199d56b4d70239622ca40d0238a59c079a5bf3bbb264ab9c95b637f3f67ada39b54d39e35c5069af35b939d31b748cab7791181cb60533e523892504ba8e188b6906fa17a8f0d5a4ddd2470926c7e3756afcbbd368dce9b55fee8c6f6fa8f31cf5a12b575920771c639a26b34fd825dcc033671789bfa2b6d0b21a8eb3a688070c9034cd9cfdb4d694c11270fd0589abbfe9bdb41d92d8df47d7e2a218a699b457cc4807ae753802c0141c0e9d3dc13a69ec512c26b42fb1eff83c9dff126ba8b127fe8a477a6d32b1983bc643f8097b81d1ced47be9f6e0fdc66e4c999d22c2d823fcc160fe96f79e781efcec273e232c43286feb353c3ea575c363fbd068753c504160c75a7bf6b4837b325dfe3c6c13f2286bfe4aadf79332a0b00f07253f820cfbafd6e1d019b6502466da16d8ad86302c689dafc1425aabaee453fb21137ba0cbd5a5a620e2c97957ae6edbb4bee936919d620a35ff65b6fc5ff9e4b9cb9af2a199274f92e773f1b629035f97d93502c553ebdcc4182b3427cff581075c1bea6688b419b80fab8effb8e8d68dc53365dfbdb61e93b2e178cc22b94f621d095d7c11f351d9da62e018539b31dce6c6e0d09c2e4fcedd70b768d78d55c737bc6ea2ed88d442661dad3e9fd27ab071194c0e5e9b6fd6a3529f6b83143f63e00b8dec8b816db8a603f71d257b7f69ca7cd9e18efc468bee13cfa84a67a1297d8fd6b7527407fafd65eda94bd92325c7ab16f97e157146eddb1dd5b7f86fd897ba5365ca8f1ac45d66d53e0588bf7fadd70e86d0dc1c9d7fd8aff707dd07f8b9bcfe74179741247617c4bf8bea64efb4cee9f10d80fbe1fa31ea919f304866a0c7c9a8a042b6e135d0ee372afde9ab837de72fb3dd165d732c37184db199d729d507f8b9b47ffaf1623ab72a33e199a70992ab2ad28ad6c3e9b148f91dce1d5a47d49a8476d9015e3308ae6f2c1fba15b42e8fe61cc5831b77745fe0e7227af696d21576e7e805947f066133b2f482c4181d540ad39a5c0b89df8bafed68bd945ddf788fb9ce06632552630078ff85b29d24fc4432253987c93df139fbe10e1ccd6db2a0d5a7634971f997724d52d63d67cbbadfbeb68fda3a4a6d6bac0d79722116b73af6df1cf9a5caf8978e749a0aefeb720d2508256dbba4af50759bf00cc6f834e2834be81a85e53b2210483f2ff16ff97e843236fd4a953b0e48f5bfbe41865b85ed014cea109eb31d164c598fc64e1f9d55810fa915e805a5656d4c6f3879ae9596c9477d0c75d07683b142fbbe1eb3ede9390e13c0e939d1e5eace54fa168eeb335315f983d75350b1a6d4bde873413fcf05ce9f13f3e9ee6dd5955639723a944747b2273d5722d89b697dc473365ba6cbabb7c9f6b27e206a4d13dc4234064bb1510d8bc15071a88bb749ab489e26407b17da65c7be002a5f8079754416ae91a0101aa8f30b5376e6286639da6df546ff727ed23c7f570ebe2368c3f119557dc57b0691f39dd04d1db7dd1a3806f205d07e89fef475d13b8e8736deb3efcd83c14907834376e1e939ca3853fbb4efb391b1fe4fd9fe6e617f692033de6ddd0644d1baa2417b7d4bd9f4c87f44b56d4bcd3b1abfb8afac7dbc0f23bbed44fbdaff7b2ed62894f7d3b7e5f73ca7f85f13e756fa5ccec958faf998a793727dbacc936365e4f49bd748963e74c6f99f77d6911cb9c7458065edf8bc67f8bcdd6e891e1a1b4e85ba265e9f726d43e2a376bb15238dc0f31260f40487317c3e88bd8505c9d07385046180d0dc68f5c725a8f001d9c56a329c5bea903cb35c6c32c0411faf1ba5e311bc61100bc484138a189245b4437993ee3fedca35bfabc075afdebf4c97db04060bf40567fe2a8bacb140b1dee5f3b0f648ecd1b52e0f4c19ad91fef259a27da738de3d6e75b6a6ebcc71cc19eb7aba326049ee0ca7cb4f16c22ec2e79182dc8868b7e538669d3f98d0ceab74108633b001f27d77d206ae295d36379a9c1657a1cf8dead6ac5b7395db6fa1fc0ec95786f3e92bc28243b9ee2c802bcd537025c15e88e66094a91f91f91b7cefb4e8f60dd2f4fe28be598fe7671ecd6b0253a30ef9a5e14aeeebb03d9b30bca7b598633cd379f142820167a740a8cd74ff188fa48e8ae676d2b82264671dc57cd2e7c94ef8c928ac40645b277146c57c0a67e2b6428291a47f5109884c1e32df918d9bc2f73e0fdb7fc84cf5b5dde4f9f1bc290ea441733ce5e5fe782f0d867c6b2869cd91c3a4e2be52f4e4640c3d8e13236b9b8add9fc679d58fd763469bf9312b76064cb53968a6b4b7803e5fd28f98efefcdb661a8755edc8e89c76313637edaff92779e163d1bb680f293a24220a1cf298e7f2c78ae6e4fe4561769d34aa7742c20cca936edbd33dab4d24717d13f6c6afeedc231774c6673064ee2e922f606297291ea0b4ffc96ecd7a918d31293393da4e17166ba50db1adc3829c3c8389b4b0de1d14299e003b9b9d215cfe92ae24e9545e7b93e3f3a7f0ae7fdc7e5554517a44dc659b63505db3116a697d2d6d3fbf7786e63321154064b4de95474457492671e59334bc0d50eda28264fed8bfb64706149605406d0763a7415d333056bd9176c5f9355847f87fa5701fd308855c662d53a27559feba61390f476e50e2237eef87becdbc1850cd6aaac319ad28ebf7748c781637a3dce413ddeef346e7b032afd65a715c45ce7a9b87455193086eb4ccd18468ed867b3282f14ed874330374d06b65b5d484c627e5230c2d93e2d95538ffc8d48df4ad5790acb106a6fda50e77632d6375afbb42e64b828e7ed5e1bb2b04d336d681deb3dc5fdfab1313139db01c7ba04d2e54748d6f070be2c75d1d9ea9cb439e218c96af77410aebb723221a603476b37f24d7ac05dad713e67f63e4eefd73e7f8fd78bf808d03e48eab0947ca831ba20f926e7c4640425db8ee52cad4fbf39de1cf37fa0fd08c777d23ab22553dab18fc6ee38cf221b679dc6f392317ea77128ed340c68f23c78381eeb2a18281d1fe71757b7ed3ac18df87c58686332e4a10d4af2e489fc8974d4b4732575ceb2cef7600ca838d71cbedb10a4795b70366d21b45b2cb3d5b1310f547315eea942befa589e45594ccce99c0f4a5f8e7c25a18c8cdf4fcb79e48b8eb5eda1cba5f83e2f990357c44680fa4e261613c9e8cbe3ff0bcda3c868197807a332985fda3f56707dd079dcd9b1ed052a4888720b40caf811dfbe423debfe1c5ccf85e2fbc93e3a34264c9319ca5691d6678cbb03f26d9542f93fe4cccbcc1b0c748b33da07d77cee5e2a96c3616f75597432fcb9503e7a2a373e23e7f502f16857f442f2f8acf62961e1f7df85ff8ae469be8c85f3f5beb9c3c57276e6f1fc9c271a4731a5b1f07acc3727ade3f9bed22ef1799a8c454404c9a1cd52282f2d23f7248c6194b72bd2f5c5745bb2bd39b2a3c998d3ef2894cf528feb0e65d6265e1b2146356f2f9d5aa747e3762cf7d72b80381859344fcf7527cd9f9d1edb9ade65f9cd32f755d00f9c232a1d744172ccc6dd45487ef7aef36d65d81357cf67ee25d70434bd98db16b3f472ecddf7bb873bb6fa3d41d6cb317f32dc9fccc388fdfe83b9ffc1327fdd73f7dfefb9fbcaf723b25e4c979acdd55be5be3f5443ae5e2ee0ea65ef1f6a9533b87a036ede54aade8774a65ee67b48aacb3277f7f75ca5523966ea85977eaf7d6742fedda0cbc754bd7997be1f556f9288b708f1abab4f17a914bd294db096dfc074413f8490f89edd5c42fdfb9fbca6fe4f480a8c57529c1378053ffd7fe6c49b2cccc9c2f07ffc7fbf360bb44e29b2e0ffdc78736b62fe652de1d3524983ff7313dcf73f57eee0b3b8832979131202e381baa028c33ffd6de86bdd595a7fe32fffdaafcc02ece469770552efa17a07a54d163979197957809c9cf95ebd1c3939697a86c463efd3651e2d9dbedfdd7fbfaf7c67d82f28f38e175f261b79f8fb958ffcebc994ec8dff76f6f1978538d30569ad0e4396efbb5c86f0d9e3ae37aca9a3f17ea472b603e4a769324a3311d81558f4eec1a83853389025465582b63cecbbb3c7ece7e27bd62ad7645479bfd50e673092d39a79c0b84e2cceaec2fbc8833fec2ccdd660f7327dd8aa15d157fdea465744a6eb9a5b738a4a5f10d6f2f4f68696cf93b6355cf6296012791d76f6a6dc5ce9b2e66972ffe83e5368fa1a273123845cc2d1b7b7b625b92ebab3c7359011eb3c03b8aad30b2c6768991cbc58c9b6b8b5da43568b2e575d90f092bf0accbdc1894b4d666dc280f4c788151bfdf1ceea47eccf8d90a51b8f8b6d36765b30f20e643d61ab7094f36c2564b7ff23b52fb3f8f3a2bea73dd3dea2ac4cf4ce2a19433664127fadd7d62f0b71092a28c316f52936b68dfdfa65d17180dcf4f54a2f2d62b97e593cfe2109b6d37eaa8dfa4cb34797d2ec076bd8e7117bbfaaf42d956b6e5019a296844b0d090f56508a4975c7963ac4cc809177017be183b22951f9ebfd0123d7785f93917539a74a631fb11f75eb3cecf3460b4a72878c5c035695f7de043391cc0127b2685d075e19fc8eb5a60c6c4d6832eaf0ce927069cf2da8e0729d88e9e444bf75a587e604792746d5f81c201936fea38be7769788ec3c7439e63e2c999ab62edddad66c249eb940ed4bb0c81caf6f64a9a6ef09b41f523c1ce9d9a1d9fbeae4de0afaa191c87cb016c714b31a1e27bca7022b3e2d0206465ebc3c6b2c8bb37368b7a0fcd33c4d406b6dadc9225c279ec131167c3f619b994f501b9c595b089075b8dc119a6fec55591afe1cb1d0a80461895855f01c26da90983332e606629cd59291403cee426d6612647cda33f098b59363347d9d2d2dfa3b034791385d1e386de1c902b234d3852694af6b8224c5e5bf5a0e1c534ff5f9804d1733dda1fd47905ead9e87a3414f5189dafa1df282bcb883ad5a8995cd3d5e7b7469d184f70531d0a15253f678a2f0c9b945636270d2de94a58d9a859e3878212a0095de9d855e41ea3cf5820c240bc8ce66c4893353e91c5e876dc27260bfaa9cc81a8b5ead3d7b3a8e9686ed0d3d57164632768ee4ebcb423aa8b289b22475b94a3d1765acd893a7da0cca3594352b57a7883d17cab12c345eb1fec1b578305b1d5b4765903ff4dd28838364a5c1f778601ebda787c6bc79178d7987052e6666d5120c8189b58119a347c7bf979b8fb06cdbc931e92599ec92fb33928bbe26aba147bf2b8bb62934578033eec3c8c7f9ef237b2bbbff85fbee92f2ae6f9c1322bb0e9ad26451b434a9cba0f650689608cd834ac111664c1fdeaf2b1d077d4732cce0b88ee6e20bbc3e0b25f2e2c64a041faf55ea3cca90afc9f3c2256c7d819e4497542c7aeee49d3f12709dbd298f2d2883c162e0692eae80a311d98b64a9d0f400d641826c3c74ee27ca5466f437fd6c4f1fbf1c397d3c8ed3d739dccf5506e9f34afba1cbb5ff7899f2e7f7e9d45991ec53c699419fa3666be0eb72874da219827540fd1e558b211183a872917d7899571bc82ec9425ce7eb3885759d3c9da78fdb85e73ef15bce3cad5f1265205fe7ce46139c191a5f3728478ffa6a814a9b444644386fb3309b21d065b16e0ccf0f746f901d85f742e23d196380e7b9b933a06deea69c29d1981ee9dc79fb4b9d551b38b2bcdbaab3bbfbf6d3bbf4b368bb827e66cba4706fa646d6a8f59216dd1b3886206db4566f8dcba17faeb53a10a49d2657e786cf6f01d67b13b2f4c4f312b2ad2f573192691a2f45aacb550e70eb607e3df43ec4aafa19e463384716e0ee02f9c80382f04a64697960a16d752e28c3ea1cdad0261de1f615d2f9b3edb5e49acc4694221d91dd0141ba93d0d91bb305433def841d18e8d11f6a07b6059ce1fd067b906fb7f8952a8b8e2ed4561a7a26c9a813580fca0ab4ceb08db722e837cfa8f02b55996f0ac8c07cd9575ce641594733105940684e357917aeb137f6e3543bf2651bee477c1d0df9b5aed88e8619c58e3397f03da8f28a91a3376bc807e6a0ca5d9acfa6665f5d701db306d75c1c31b7bcfb3a4eaca1f3d77307b8030fda609ad223659ba53b43d8c3732d2c096e2a9d1da8880ef167b10687334bd578d9e7d332f5842c2d2c43a1ec7462b231d1afa3f3dd317cde066edfd2147b07a06eb9e891f39e678d05bf857d42fd6f49076d8874d17896ececb49c3d215ff1bc73b5b521ec9d4993e819b273a7c9fd5a7b31f013cc57d1bac3151c72ec3389d3944e155503718d75c4b0942a3f52755a5419e093e90843da3eb8808e20c955cf5024a72dc4ec92afa2bb266d4be4af7b439f3e4a4f0dfc10693e3af25b1cc975e4338a106b5b80181fe1fe4d663d9fb0f75a27e605d94109193a8fbdaf985d956a4f3d59c17da89c3e6a43f059ba335a1d079db9c4be203ee1c80f874af0a30c938f95b147fd27ba83108c13bf457a69386ebc3369f1be8632bf49fc85f87b8dcac05765079e9dc86f8cb22cde438f48f3292d440fadbd318edb84ebec784f3a9316fc1dcf0562c922be25d87e94dd9fa257b7f37d57a8afed29cfa0753fec201f18c9344bcb122abca633da902f7bcaad7992f9584ef6e6c9e0fe900f9e633d0bd29d268b2c613ff38aec8512b2b89c4c3e5f36d332ba11dbb7e19e4dee9bc7a5a6d88e5a91082bf51ce925862b1d503c764af40f21b1ff8afabf92b2a0c01a3ae90fa39ea72936a3c9d543a6fd92b7df8236c5f52022f7c9f3033d9b2085937eea4067c18c5112d6758e624279e7d5491f6f9a6fb745c527de7416a02c306e6cc5ab420d96aad247bf99ae3347faf810da39e216c07ebb01921fee9f36d15d3374d5e9dd079f13268b7cb9707f8d02ddfb83faf979e287276dd371b3f33a66c6276268c7f19e3271940bdab6e9aca4ef60db4ad8cf10d7130a9e396967cc50aece7534eed201ea1f8620f94076a06c452c9bc4af8163f6e877dab686ba5654c5ed837d3632617d499e7948d7bf70bfae3e1cbcce8fb2d0decd9e70484630f1519cbbbec75047e2902db8d1102b575815d90632c229d900ea1e6ed5464c854aef837d33f17ea2f3a089ec0264cf433b14ea75247bce5251256bc352e53da3299d0d7cb6e94a870fb40f1c684303372b33be58cc3ecc167c2b6e82cc45e69993e7c7116a95aeccda60318ffb73c24a09bf333ed9f435c1d9a8f2de335bf3b7c7291dccce12602550f5f53ad4113a703e3dc30d740e915539cb52959e05b8aa836d70766b7c9a3890681bee00553ad315249bb16f07331210f984f6cd0ac97517ae0bc70f711b1569a6d7799bc4b650ec12c835ff8bc68632b26adfc5bf43b031e7cbe27ec87448cd137717ca348343be591bd4f983298bcbb650db91aac21fedcb09fb1ae8e781be10ed1f84595d030eb1183aa62bf9d04e27eb6b8bdb587d1fb9fc3575075c71e8fd6dcf3e7ccf1be396b6815851b17fc14055d4d91d9487013695c6a2127dd136161fec737482778671caf3dbfd796cc322f8c780b5f703b0a521f3f9853096a47a7876dffae3796d3418e63f3fa53da9fba3308632ac2a76623c17d246756b2c7007ce5135a292ef244c756f7d67e047cbd24b73703fce3ce16b7ee933ff3f7b6fdaa428b2fe0d7f17df9efa9f412cbb9b8e785e345689d025d36ac976c71d132c1e5013e56e57fcc77cf727726115105c286b8e2f26a6ba4a4932f3ca2baff5f7437fcb41a42a191f2c1b5bcebc67d3758097da7dd24657baf0cef5e239315c6f8e72d6216a318ad97304b5fde5747d7dda1e3a51075abade3eb221f8a3bc26f68fce9f535d361e5f9ce740ff0f99e417a10d7e87f288c6bf469d6420d7ed18db70ba5ec243317074cea3da09527b10c663de3a2cb625906f42eabf823dee9488e796bc8b2bdfc9091b325837743787f9e8891cd4a48d6db327f926c71c502cc2c54c371ad6efa87e3b26cf3184b29d8d7535badf833abea0eeb74cbd5139bbb373694d65fb50218f57a32c4b1821b1a41f13e9a24c7f063dd76cb18e4a8f6d53911c23b5c7189de69e64329a7fe0db18b11e51bed7457d7ada024475be784fef48b60216867bd39504a1e9f25e86b0d62ca62bd15cb1cec3e3401b017f26e8dfbcfffb3caabbc77af116f3fc84719c58df6b0df5913d696a70b13a7d59a25419aed3ebf9b95a10f47b4a8758cd4e1027991a2d890aef7c5958e1dc0cf253d1dda72a9aa3cb7b4775c10af71fe7ed73a4f36aef012d5c3724cf83a007d4f4a35a9f609ed087b13ae1dd9f7816ae27edd2aa0c56aa2c780607101a694e9f4fa4833f5f5f689eefbebf81efde54ddbd67d20eea7fbb79acc045ac276b4366e6012ec3919f7ee4d7c3795fd40b4a6b8a806bc88e7229afa5fb1f4bfbec64fc100f617abd7d0cf232a62bd1ba82ea9ad63a071023cda464aca7301753b23eac526d5866ee5200960bf09ebc3a9ed91a6ecdc5dc1eca6dca5c54cce5e4e9db7977c3439fa227b4835ef1e07c5a1c708c0ebbd21591f49547ef63fa2c65f8a7740aeb1953d6c7f161e887884bd3653606bddf6a3480fb6f1b2d61ae29bcfd939b6fb4d1f30ae5885ae86cccb0bca2ef7b464b3ac4c7e739cd331722f5b38a4d5cc12ee6cfb18d03fbf8e4be919ea3290bef0a7f3242ebe4f03d127b5a0c3db43650663b4877a33540e704d5d07fcbac338eee9f01c23930718d3af40b677a4fd81a2eb332680bda4028668b7a503a8ef7e68e376f1d766db444a41f541aeddd0e9d1f99699a7e6cfc29ebea8a70b03aa57a52b26ca0b2fdc08539e4a37d2ace279fa5a362fae32a71db942d91db935dc62eccf85cda463c6bce5086de6490591375431c02cc5a5bef98189dbdde317d83ded78d2f819054092271ad7179145feacdaf98f32863ab09c06cb15b63217aaabb0788416a316c1fe16c1dfd77bc27276bb92fddb390592ec46c2ab156fcfe443dfc69fddaa984b960e7e447479adc744c775d354e61e7e54b358ee41c5d783f91dad4c5d0817727f46d48fcd6315d6c5b589cb42ee3bb65e8ca52581355e3127ce0df85d84f416cac3bd73840d81a77d05e5a93fbd2274c11d897e3ba3e960b406932755ff76bbdf946fb440f53c6e752fd4ce15c0beaba8aeae2a3756a06d819815f11f3d7d7a87f806336a41e744d74de5aa7a5b699aefbc2cf0b6a56b6e6947d36e19ebe64df159a22ee0cba7dd051bf026290a426c77e1796531700831bd8931eeb589ccdf07316dd35f82eff7634678bf4b09dd71326382abd5a1b72974275aeb7afc5e8a8b2f81b2188f786a5fb127362edc778755c84ea1ff4acc27d355a928fe2f1aee46a8a806c4bd3afbbce1fadb51dac7550a77695797cae780f3c0f5b4b1166a5ea35cecff55faf777211f3036f7f4646311f8d873ebbd112dafc2bf6ed2fa963fa07f8a975f6139cda077c7ee5675bc3f180009330f007291df5a8ee3d93e055467e28c207d89e8a67a3380e8a95483eeab1a71ddfa0db5b681febb2887b48e17a4c7f2cf5511bbc4d77b62133076ccb8bd8bec6b196ada5f463e33fdb9a0b7cb335f0de6ed39b9f7d5f16f8c9e5fd8280ddfd7c6cb7d27e0f8ec91ff49cdea2abc752b9eec1ea34d7068deb5b6a89512f42dcdb723ecb653e6c307e418cfa16fb286ccdcf59cb9635669023f403bfade41a1662f695e85fb0abf430f079b9c6984f55b52f3a1bbb6a38d7d1dddb86fa10fa42c40612da3cc76c821ca3aa08f89e5286c0b8d35833891d8471e573fde82afe6e899c77793d7f2c57b5f575a6e67369acb51ebb926083debe57038d7359df75cce740b60bf76a13c62dd43363bad2da680d01ea1fa299a609d7ac5e7badafcbeddf31bf2ac63c394636ac3a62091b15aa9583fa736b71e3b86f75135f0adeab134e9aff1a0994b9908e30564bc665901d3851864bd4ab25830dea19cf892364d74961265ad4138ef3aaa4172a865f9471462d45f0de941853e707e21ae9f03c2b02a5290eb6338277ba4efd5ec0f48ae43beedf40198fff5b5b088e46e39f7f72dd99d5931ca3b3b30d9781ba2a90b19de9320833489307de9d60212da14c9a98553371ae6367e7d6eb701f75830b8c477e6738c823ccab50becfb948a68721c7837448d5d3a13a00238833d18e677622cc7aadc34e272352b38a6c59c4bfe0993e3b430c7feed886ffd7c3be7a265e6f8fe44768f56335cd7752f30aa23907f27e576b742738cd862ccd75f9758dcec62879468af09c6a3a23a2260f3d95ecd315ea6b5fc9bfa79a42b00db926d038e08498c621261cb4a5d600f19f0471ccf7fba8a57dc77b46f62ac85991fb46168019d4a0c73f17ab2944324c4bf3940df7f9b0ee92757eb5d7cf4a1c586b8a48a972737741bc768cf84130db2eca0158f0b9a300bf381627ec598ee9933a5a455c6af2a076bcdc540def58477d1043c7507e04feeca5f3f91478771976fb5c558600c9c4a8893890dee0bbc9d21784a7908b5f01909f8d38aeb808cf8bbf3696b422526f7273abe19eb92316f91be437fa2aaa438c8dd943eb71b0b8eedabc00bb68782c5b50b7ad92e305325a77fe0fd502dbb15a60fc8e919e20b648c539d496fbcb97c7e4b3a467f877923799aa987724a3361ae19a213df1a6589ec5d96bbc1ede368d391093eb838e189fa5178346bd71d46dee8f28267efbd84c5cef85e35ed077816ccb28be1ed99bf17ade59b07e75f74c0c63be63c4a117f4f1567ff7ba72570572975c8718be23cf090eeee74d6008e5e5b06bc17dcca8d7bfb97d14f035c6f7fb5c1d1f3c0bea512dce4d5abb3e27bc8b89338bec9c5f634a64df3becdaa087c0e844f2fc90d733704a833ae6dbdb25af311d730e175536764c329f9fc0190ffa83910dc4096d9eeb6ed29f897157c67cdd046fe587e8ee4c1c86d7d7544d4882a32ace4f6bab8b39898d243e83e6f849cf49619c232bc612c6568eb9d94ed6fb96ccd796d2d96574b844620ad7c2b28ef038a3def8583c2281e3f1d6611d8d1b226cf4b70e0b0cb9eb1949bc03e86339b17adb7bc6b91e93739cc225bdc93a54ce0f94ae3d3e3e437975bd47b268c9eda05f76adba928f644be9973b4f978d9f780fd395100645883b5701b33e53cef11db6813acb7449af33e170fd3512e6063d2fc2accf3fbb1570ecf3dfab624d42fe9aef7ecd5fed2107163af4e53aec2f823592bc13a63f96c35746f8f9be22d825007100a39cec02eab89d6dd280e46651ccdc31b8fdd66af5091fb000e0de688a86724818d35d6b1adcce565d303538b0d55a8263728ea7fa1803db74519c28cde35c6a9d2be3c367ae4d79ccf8cc7d0a62370b69ad2942a5ef93ef42dd00cfcfacec77537e75a9338ced3f69534a970476a254502f723a8717dba3587c21dbd6a929468071e06e8f7b9cc6d33e17c39070f0f650acc55609560dbc5322db04c5e272ea52439ef515c108247c2cb86ea17eec05616b72928fb17003bcc3dbcff1bfc12ec518459fc32e453c5b57b54bc91d16c73b4af39c7100c540516d5ac4af40703c42b90c6408630ab7864bcc2d8539a4aaf6bb95383fd9f251b1ef2d385fc1ba122eb8fab0da6ec8d3c2a7733c45775252ae9b868be2d96b4d6e4e354540f78679385d3372f1f8c9f748deef517cfd14de55b18cc86de81ff841fe92601b33bc8bea8b4f712de59fed3bb45b099727a9630acf3ee2d5d2b92e85edd421c9cf7cc3fd1c50bf225c7d8cc1837074b9eec1a4e3b91816d535e3f321b6cd16aa3346fd21468b8df58c22bc2b5ba5bb505f637e163296c6492ee2e3c1e7eb6072d20c615df959cf86674c9a13ee518cdfa1880783163d55416790606bc333f5a3fa9a55c0cd2aa3b32adc0b0939223659c2a72aebe361fb5300d897134bfbe8a5edd6d45a11bff6a4ae0a726945fdbaa538a53a27f80caaf052a69e57f73d7e095f65e2b9f5f356a664b59618d247f159a6e75aa2ceab946f675f113b34f9bcda3900cfc7142d92e39b608b1eed51c9be9e73efe57a31473f48562b63917eb0bc9e85511abef3bd6195866b53a3af744f18a6e9f997e9c738855b1e7b5ebce6ed23ebd612f3aa2137cc26d6f1a27c30d203db903f86eb7ae69445758121bf8d4bb88db9bd63b85ddc77de0bfb03ebaeed4ccf3de811c1b1b5561073631d5de16d8b06548cef26e0355ea972dbc1b88d6cd8b77aafbde731dc49dcab5aa68ff78eb026a33ed2abe04a9e8b7954c57e2e6f3b9f6737633c8708e78a60379cd93b5c2546558547f8742de6f19dd512b696f223bfbf1fb0a3f7a6d83dd1779e21df793d6727b096529f4bd7f9a4ce75eeb97c70653cb832fe7bb9321e3c44f7ce5bf04fe52182b6ce9df5fafd198b7b5c4197568a35dc43bf766afe24d7cdc5e228a3b0b6327e3f60fbbc021ec88d652be044bf37f9c2bce157c00f48708ce33d08f84d63bc0d8e63badd99c549beae88942e97f2e7ea90b3701d48ce77a3e19ec6b06f34f05963f1e4a5aa088eda1a02d305078b03b33ae5ed9a7d70316ce5dbf743704cd02b7a11268b113d6715d6bc247380311e9920bf293ac6949d6a097c14695e77fd4e720d029db6df0631b1b74e1013e91e4c9a99e9729782b65d0cb315caa06375a25efb389685f6f97850c2dade3a783ba02faccbbb9a31bdd925ee79ad1537bd69bab5cff3ca1cb165b86612f764cd63631cab5b8cf988c53d62718f58dcddc6e23cedfef093cee9dd8b3f2f9db39102dcf854af5a1e175b104fba93585a3a6e836cadf3e754173ed1357b9e235b73adcb833af016433b96ef56c7a8cbf22dc709cc77d6c1e3e23e5023e54fe07ed1e7b09e10c586a748b667063d04bfdc26b0b82eea15fbc87ed2980f608f3370e88ee61cf30f786e0834289b32c61e8ff9059f062b26a12f3f160726e0a4b83d5612e6d5b07f728cafd1dd8dae7841fd2cc209d414e160d0cfde65bd2e49ee201edf0728feafc900e73c7aa267b856d3e0ba3edf711c34be6b39860bbe847c9e2dde3639c9376966f333a8fb0db81a140df01d676dc97b4a8732300adebd7df859020fecaabd30e5d734c2464d7092205e000ff52c709247f222075d6e2f2c79ef98d31f4b15d5310b0b556e7b1337ac5bf1f91e0bcc85b835a673b45e2acdac0c4e9a591ca0b5d18fa5a1482b8b033b03d9a5782d4d7ffed97cf3600d6bf6ab185f2be9ef3cfcd587bffaf0571ffeeac35f7df8ab0f7ff573fbab21f75c1dbe2a20bc9a35f8a9645e9fca470d6ceb52fe698face5c337bdaa6f1ad8de359c05eccb5cc64b18bc6f9493c5ba698678d4c998317cf71a6519fb5f313fb0d2bbde63ad76814f43fcd266502f7a7b9e29dad99a8bc13ac04fb8b9df86fcc31ae747e4e70378c5b7a66ba2fbb0661f75aea15852193ee7abfaa7511d44fd6b4d70c5eae6187710ee6fbd797ac9af7d9e1fc04597b8276be68e7ff0e03d78f01e3c780f1ebc422c351ce7be7ddd652a7f7121977266bec8e0a4852aef1027b0ae0cdb7cc7d918ad81ad46b6b617d536623c06b585fa57b00e88d785d687659dcc1b8578be5d94e3c9c829ad34d942bd9d246f64ffe4da5bab837ce0201ff4cff3290aededebd7687e903fe195e21dbeaa7d329c9bae7428952bb9aa6d92ac0ba9d7c697d6aa0c5635dbf63b9d633657e0ddafb4ce9622d43e4fd36576358f99c424a8776cccd7788b31e35c89d82e2bc7135edc8358055fba34864736976298b7ab8a6f9279ef9f6b8b56c12fa9805d72166e098a05c6fc0f72d79f9babad9253ad80c5733ace7d2c5727397407e339f33e1c15ebf50cf9ceed1d2db081333e97b28753b65c66adca55f34ab1f8dded315306a41e0ada8957e3d330a6e9de5a847f18b33b7f2ccd9634d33bac8330c5a7ecaff15c14869db96d2922eaf154472c7caea7d16d07e3018b331deabb32fef035edee20df0075c96b66def3f6f3fd1cfc616530df0a787ed19949622196e11978706d3cb836ee816be333e2e347be64ed719d635d7a6e8fed311f13e2a6e032e3224d934672e5f01c7c0e58182eee59356971a9915efc5aebeb4fae4bf11d9315e37adc298f3be571a73cee940fb95316028e73dcde771985dcee84a7ffb27e14768bf0a9141e63cacbcf36e2ed5084c0d7de4d4601c75182071bc5cfe3f8f13ad73de81dd6355a3ce2be8ce388d68c0b99b546615d9141238e0c1f71ada0fb12c9901fc44dccde1ce188a8496ed70c1c4cc731699b704269c0746398fb9f2e7f80e672719cf982fae7257cb713d879997512faa819c30bf9401c32d769237e15284f9d3886c995b0a150bd024b197eea2c22bcbcf8bf355f952dfc73c771d4d6b0a9cabb15cfb5b7860cd05945fd633d618b30be38695ea62f2c2db7b7c02ed311df9e40411d846265b1badf108ffbc6eb702798546b4d1e7aeaa889f199cb63ccd784b9d7041a071c62635e41bec9bf6521c474326469aecbaf719b05ff1eee3f0ded7a019834d33471ddf33de0548978cf582a6e870d897daaba21f6d63afeb918061fbaab905fe64a07a325f948777cca3e81209e7b840d79dbfae8298beac22fb089384bdeaff88e43ea08e684bb00db40d01f355cc4b733b7e4eeaa66bb86c4ad9fed240716e69efcc9ed814677573f890e417285799b371a07685d46faef53fac07976468df1a24dba17eb46f6fdaf745ca30a8f5c963f3c5c482ba3f36349ea7f3c322f6ce366f44ed72cd3b9f32d27dbc7f54175d9ddff141efb542dcfcd63a289f12e90ebc108d792a2fa36f4ee3b9b3c3bd4d9e69475d496b03516605db35c1f748ef1ade0ee89fccfaaeffc697ab242bcd48fedc98ab00a6be0de08c6ba340663b6105f751483ab391e3f08eadc0e919f6572ccc1e2ba2b9e0b7b4deaec11ccaaeb4dcead6cfddeb4b942dc85f5d63dcd508d6d1dbd4fc8462bd39fc2ef2fad5d335a22857fc7cceba9fb14b796dca6ccc5fcbcf915d5afc4ce7d164721f63b33f225e5f324a5f323d7ca8bdc713ee4967ef73de63dcae53b92b6641136069225c2e3691bb2b4565dc94732a2f4abe6f82a71eaa3f3e34ac817535bc3fc7bbf2cc7708a3335e046ff351296065d8eabb8d49d5ef4dff979b6c4da982f6d96e79c2df4a7a25af18abcb617f2d99ec9637b2e7f6d312ff3c2da9aeeaa2c27f3455ccc57e16026dccb16cdf8ba9fb4bfcc8c78c5893d38579ecae746b3e426b91727f2cbe43e4c9ee7937d75157881311f797ebcbc48f7d485a98ff6f94dde7b865b436c721edacf28767da1cf8bb0ef0c12078fc5253fde77f051fc3aeeeb967ad747bca65abc46a5412d78980338ce25b24a635c4b8b635aa4376fa1c9ccc274bb3b9d03d0b78076ff02af33aaeb427d47987f283c3335f71b8a1ec60142f5672fd0877bebb094d1b230a624670183b67c556197d0c634905f66a13b10d7df351d1de91533b2070e0f19af1c93243eededf3462294874bf245ef70bf501caf276c2d17401f13e38dc273c4310ba3251dce959b5a3178f09a07b27fd3793dce43d57c2a8ec5bdc9086bac8e7c6a10937c419867e79f8f01891921d991e760c073680ef723f7419cf315bd5720ff67bdf743aeabda324cd3580cfd7aec1932d645364df0beb8ce11c58b709cc949e6026acb33bd182d6963f584766877977bc7475ea99acf18604fdd5c4e8798ffea82de10d2bfff6136341e3fac7fc2f3f97c7ab2809bec43eb5102aeb2dbe737c7f01c10fcb30be431c2923116386ecd73429b87e79d9330ee43cdbc041227ad3405af634c6f0658301b4b6e4e3585b7d5c5dcb6929ffd7c7ab3b8fe33abf614d7259ec6732f1fafac8697902b8f24cf53152fc1ceb14d05c31d921e85909b36aac94cf6d7c5f5e98ae7021d1be72ac7dc8f67e19795d0c1d97b7046fc1bf5770775bb98c7fca6eb500557be530d5bfee8bc482730cf2259c471ea29bbd6d05917906c998733735a55c64fbe07b4c7a02d3e23bd9a557a0033e5bc303fe3a25c5595e7d997e6692e96d7ecb543791b9d63d6da8825d841a1dedefcea30bb8a73b423dd7d694ea99afc1ead5360972ea4b5a60867e6c57273ac5bcdd5bc9c3e994f2803a806d4d55c307bc8412539581bb4e019dc3f450e5e6d4366a6babc077c8fd8c71cd4810230a715f3f1a9fd3b372f9fdac7aaf9f9942c56ced347df5dc0bb1ade6762b5da804a772a5e2b529f72cd7c7276af4a7a8f62fedb47c6b3109edcedfd32693cbf203e00a4d17bd0a74b4b53be277aa8eeadc5db23d40b2c6c3465609ba8bf2ad6bf581bf690347a7f2175a8dd33def573f4b7e4f4b27e6c3c16ed710d78b7c3a42c5d105b780debe751df6d4f728c0e9bf2f3ea8d2d1ccf0d6399f03d7169b4048aef0da1cfde4458bce9cf7e0ed9bd9fdeac055ccb3ab888d03817c969ac0606f5375b32c169c4e7d9b6a01cd363dba4a14f0dfdedbead2b7d282350cfad0917d174328af34aa15e73f8cca6498febeedd1ac379c4ea7d709d3dee670d655c0d64acc3b6748e396823f6a0c94dc7580c3dd5673dd387e775084cb7e919ae3427b1c1a5867a65dab8171c3d533adc0ac387e79ade8493e6bf4602652ea4a3d857490c4760b9923f51864b5d6e2fde64b0c17b9ed0e957ec4b143cd5dd7b460dfa7ae082a9c181add6121c9373e0bebd6ab2e6192eb818af3cc01a87b2634e59cf98423901083790ef8980c8f7549785951eab1f427dadf83d09e7ab48fa73c595250f81d679b625ce817bbbd5386915f459c33395c23447e70c635a68910cbe32ef03aadbafbd9eeef45ac77ac9da942a838da6f088cfcba21d4fa56d5b85674c19e01ecfe3e725b1cea7ec4c959fe19acc8c9e34d7e4c186efe23e75831e02a313f4de83c3f19a8303df43fd326e4c3ffd1a5322fbdea9f33e0bfb3b6e73d61611d640ad183f97d58afa311c1cc76c895b950604130de3f7104c60f839740745323a58f13d6ba9cbe212f52b8dd8297c461a2fc8680d97087b8eeb522ae95de43968a3138ea0ba79c4e2b809a1dd15db3bae7b306966a6cb18ffcea201a577087e30f121ac4e1cf767179e2943eeb6794e003c877ae33c786f9b53844392ea15617cf8199506814c7cbabe398b0394514f4f19928d5afae43e80df08caed07f064cd74a502a7d2c51cddc2ed30def3e7887c1171761b6e840757f6832bfbc1957de75cd93816e1c3efeb8a001efcd90ffeec7bc7c58ad98435f49d4576f885fe79f81cecab8b9ed91aa2da67e85f6a727bce73edadc58d6d128f720c7790c006355b43df9245ca42e734cb970f39a86bad9d0deda459847b11db23247b260d0ea4971cca2440737319a88f486caabbd13aec169f2b748f1cac9ed0545bc3a6896309384e13f649f489bff421fef12536179e6f1dfc621fc1454ae22467fa2235f111629cd6dbeb0e34ce65791957da99ae7488c97580ab3b331750bf24cebfa7d1d2c1eab00b4d6ea33c37f4cf8d960630a748cc87ef4907dc23f81a600e3b06676d4d17503a57caeebca60ee155455cc66272bea604f19461c84b88f193457fa2b054520f8085a68814eaa37251bd16d48f5b0bd5c8bec67120e3f8f64b3826cf75571acdf8ba325c96b249ef3077548c9b92816defc6e79c69039ef21b6ac7b71f1dcb6875bf2cf79c3581c5395b12778de2e6049b9ae48f7c5dd180f6528deb8b2f8ff59bd4b567d4cbfce2f89c754236a2a8c1fda607b81e3b8a719373000e88d7c205b42657b00b2bd88747b2fda8374dddaf39f5a640e40723caefcfb2e32e8573ccaf5d7b1fbdbeaefbb3319deba3e6cf3f594f37656955de37a16d3ea61cf6edbdff2ce673aa9d3bff66ff9dbfe6fc472389edbe8f07ebfebb9aefa7e7c87969ec8ff4f74ad46ca5f4462e1648ea73a5f08f4e6169c79e17ef89f8c89e31dfa0f7b5e48be03817f878417e117304f584ad817cb96fb6ea8e6d55110fba6c6d50be04fe0ec7c3026c881a733bcc2ac2851d02cd059bc00e34e1df4628173325b92e4fa3513e12e3c4f758c75c0cdbff983ccc114fe4c57998045e691df918526b56437e82f58dd68f9a399413f253f3d87bcf70578fbcc8232ff2c88b3cf2228fbcc8232f72f77911840bc0a158fbd4e0c66ba833addebc86ba5e01982d16e53454770ff86e68275e80e71de32d1a05f66758c798e2ad6c1f50bd50c099bce8db16e73856875daa8a0650dc337c3fb04298d2182b6da729023a57910d2cb91ad4c7ae08ed5eec7bf40460c07b003d6bb84debeda886b86e7ee9dc758fe2a8117f56e07784358d69ce0ad367833539a035ed0d97aa32b075ae4b6bb80e6e077fafcb826770800ab0514c4ef2b11d10fa3ed1de211e1ee457f89abc87324793fd4cddebb8867bd21b7cba3a2f72ced67a16b7fc092efa874dfeb0c91f36f9c3267fd8e4853639ba87490d6811b7257cfe9f03ea882fadf2b9bc88d7efe85e46b1c0cbf9fd00924d6293e0bb99d49ea37b1ff711a4c646764e051c955bdae8b9eb826cf531d2292406a9735d2a7616a1ade1997ec206819f83f6c775f9048a7993afd973e7595c2d71edb1ae0c5717f64050e819c4ded3387030b9bd3319911a25b74bebb2e57c449fa88463bbb13e05b2aed8be9feb505eb86e9bd4176d5445f3b4884ff9fa76e6885deb8a03b4176a6af584e611f709fecec27499a65914d75534602e00a5a39aa9a667b818673d6ecb5c31c712d681dfbe2606e1c2d1e2cbd9f2985d33749d3a940e5907224f25304ceea71e84ece1b0a3cb6dc75ccccfa80bd13c839382dcc5a57cd195efdcca776fa19cc1b98c2bf13e96d185ef118f29b281351740bd38d56438fed8d6e19d4c770f3c5c479a81fe87ad2a7d7b8030e5540af3a50b00617eb9d21cf5707060c373af683cf49df7b69fea5d9b21fce029eac584f7a017f2fbe2f721f7a5e519dc2e33df88ec040eaf89453bc048be3fee1f46b8c8d2bc4cbfd9d15ad558e332c4effc679c13f096fb12ab1f433d9da8f755e99335c53ca024ded4d2e5fd0a3fa77b3069b0407db051ccc8c5d83ad652939f137b426a8cd03e6b657a3ef3ecb632b66d194c9898bd91c5eb4578a5d784df2b111b2f5387f341d887696ee96b6120be927f4fb5502e12dcde28de867f0feff1358a2da6cef8dd9eb7f7388778ac76e042aef1ca1c65a5f97d8a6df8d4f362f769b69d5a133f7268fb4d798efa62baccd67aa1a61359aa8383f325b8db2fc620e0f620e0374375cad09ee9095ba3d7afbd2f2136a758afff7ea5110c2f8ccb89e2ced0a7ae9547272f06c2578a873681c57571bff485fd042aed6c7539330e6e57aa47c1b1a9abc468abad45b08fbbebc487896fa5738c7761bfc75495c5df6f7213dea3c03cb397f891cf7ee4b3ffdbf2d9812ea9a1c72fd01df6108f7951cf4e109f4fdd7f01beb3cb731803c9c4bd70087fc6749915e2bcac97a72b63de248e46def1e88e24bcaeda94c531ac5e3feb9eff54f9db1aeb4377aa22384619cee72bf6e47d441f20ca895f71cc877d52c13eb980af1bd530609f15731ccddb2f38b6f2711cdea3f85d37bb9ccb5b92db1ed41b294cfe988f1e62a991fe6ce1ff419fe14e78bad37612f26d2e98535db933bb38f62cae54453cdc5d2e778edfeb0af668113f84cf73781cb86f2add8dfcd31886a32a07bd1acc5477a59955a117b164bca8729c0861edd17b07f3239de4c1387b9ea57382a771c2ebe2470eedba3ae237c15897d55c8a94b99066564ff235797084ef18e80c14c70b70deb8a0e64f04f03ec3b595a816d3d715cd515bf3c836ec3cdb43b93bd33bec4193c5a6e9a21a42547368ba805665b11dd99a3b8c2bc781a026278ce704bdaa1af47164dccfaa8e50ae17cadcccea443635cf810de2607445503b066530ef9708172385e191c6900cfcb544df7f589b4a4bd03ef0acde1c61779b3dc1b3dcee6c328ae6fb3665bbaa2c2ecbed07dbd211ce2ac945bc867652908f88e3513a3c372498252215df275d6e1f2cae0bed8c87edffb0fd9377729978d715c746b98632b59b77eceb945ae3f00c5f3c6e11e6ca099bed93d4e31dc5d22eadc39336bad26d6a23d68bdbe29fc55e4bfb538423e2ec39d5659bf1c571ee99c1750fa67f7771ed17f45e87cbfdd691dc9eeb4807209b0bc57b835cab3965f1fc69b86f621c33e713c4ba431f0bf9b23799e7ddc7bf83782bd1ad410df7712dd9d2a0414efe27370eb5335de6a0bd7bcb2cccadc23b47617da325823f5ddb3fe60e2eb67d90ed418fbfe82ffde37c56c9f7cdc01129fbbecdf3df573df77d8f6be84b8e6965f1e1961b13da00e7add182f5b573e5613174500d777cbea1ec2762b5e4ec61f93ee13343f9bc5d7d6d281b845342e9dfaa9e76d99ffe43e671cc8bf129e7211e738e7fce79fc43ce87f8cf381fbb8cfbf96a9c38c1dd50732fc0ce74c7c739f7a43d037dce674d1e30fc62e85bc7be5d22675735df95eb1767f7bd05f7e05a57ec73fa4b83ef3bc77777e67d969c63cc2f1e16bf5f846dfb1ae43ab2eececbeb260c7a776aff2eae492bdabf3c3936b879a5baa62bcaf44abf8ede0cf2ad55632e7971c313fa4ba23545685b9ce319aeb9d6e536e6063b230e19ee017d6c67563823cd2c5b93d4c016f825317f7d5caceb22bcebfd0bf1e76fc4452465e465ae8c015dc8db91b7e77b2f4f0f658e7d4dde55ae3b4d7119df8deeb07ac7d88f35e90eca3c65ab64ca3c390fa38a7c2eb971aa0bd78f2b898370d6b38fefdddcfa94abca6b0686f71976ca09bba25e3ec2c570fee96dcaa0fe31a8e3cc192bbd7727ea956abe7b9bdea42847532c3307939366badc5d5d7257ab79f8f819776d9e2e24bfc7732d8a37e2f9a6f5dc897af3e019d5ecfbdcb81bb16f32effce3fc547e5c29257fb1e7569cdfed74788edc9c95630ee58566e619f1bcacbb2dcfde5c5f327e06ef76f9b1172265bacc46f32f79ff6c7ce8926b7fb0e40bbfaffcb8e4fb9e21e7f240e7dea3473a33b843f1193871d7bcdea79f7f729fc5adc68d2f596b605c744e8efdd80a634f2f3963666be81b747b71d933a40bdf41cac873e4c64aaed92389f6bd069b2f339674bfbe6ca83b3f618ce3849d75f22ce6f6cae5d4c6a76bdfa55ef1fb31b84ec3edae489debade2ae50273df6eff3ee9f771dbfedda36e7091d2583e7ba7c94bbd6a12e7f9fb6d023e7510efb18da3457397f77289b0bb1695e277f7d977a13fa3d67c49c33fbd754794fe5d4e0647ede6c09b31235ac8eb100d2783ebfd51a38f77977dc4dbc22db463a1eb3da9938fefed5ce47963d539433289099f3eed5a82e7609f7ee3a7a3715335c883b4d163d553ece37d71ccb3d1d9b92db074d113274c309bfb54adc314bb7c1ef2f327015cfd46f26d7f55599c258e33de94030abf7960c7c2dbf77f71af752d6d908f179435c91f43d7ffabbf936c2e9ef22d91e407be4384797dddf0ce5631aaec771bdf9f8785f139c6dd1b99aabca10481c586ba326eaa37a53869e214b5f0cba7d0eaeeb71cf14c6fb457f8bc6c23d5b04972ed877db827f53bcade15ead07ab88e330f63e9976c60eda889a22c4f8f6f8cdcfe660f3d377f4ff7457bfdf246af3d3cfbc4f93e3ce12f76916c75dd11e0143ee7ac6b1ed54d88390ddcfd0668d294b9ec73a1a3784ef79a861adf3f824d17b5ab4e3599cf46e725d78ef873c8c2acdac0caebb33e9e33829ee9f63311e911be3599c3eff1e749e7f5fa157e2783de66d807a3547ec5443b2c1db26d7a52c4ef28d6905bcc3f2f5f9a10c1eeb7401608c4231be5781be3fe60425b9a2b8ecc5ea48fc8cdaa2b3e48bef4aa3d1fb7530c852fc94b68a702e714f8c2ab7db9224089936e4c25aeaca108c5bac83384302d97011b69b63f6c4e3faa3dc332f98666fddff8fb426ffdfcf7f36776ba5d3b67ece969b9ffee5981219eb380aee2213f725cf74681b847a13ce83d920ae98293b1acefb76ff307f164b60bf955cff982c8567ec386f93c51f5ac0119ac1571bd5412882f7a6c4f972ae288f490e9915df139b883758e9c3b31ce77491c6f3dd75f09253e793e7106634b669dcee6622c1f7cabc775a3a07284d1277aa2c82981c22dc3f55610f99b650157d300bf6485c6a72d3f9652fffbfc653c3d37f4f16ebc6f7ff6dfc9adb8def8dc65343d4dd09fce9efbf9f1af674ed6c8c7f9b4bf70f6b69ce27bfff58ae4c1dcca7ebef7ffc39eafc78fb63ef823f56a6337175fca7bfcca5eb2d1793c5fa2ffceb7fef57167cfe74f19f25fcbf3559eb53b0823f2ef04805df7b6aaca68749e33bf3e5f94bfba9e12ead49e3fb73eb0bfaf1aff5147d9fa668ea7f28fa7fa86fefcdafdfa92fdf9bd4bfbfd05fbe7ea1bfb4befe8b6a7ea7a8c65363bafacb9afe6e7cff8f0e5693a7c6ca47aff032d936be7f79fefa957e6af08b65e33b433d7fa1be7e796a8860ba9837be379f1a7d346aab4553cf4f8df1d46a7c6f5214f5d4e0a21f95bffef2748b6a7ca79e1a430b3e937a6a8ce2afce82399eca33c57c81ff5c9af355e37b93a19f1a3fd65317becc686236be37dbdf9acdaf5f99afd453435cc1df7c7dfef2f54beb2bd5fcfba9d12ffe6838edbf9f1a9df21f55fefa6bb3d8ac2656e3fbffa19ea827eafffe0d77df99fc466b0497adf187b374277facc066aeafa6f3fff90fcdfc61e96bfd8fdf13cbd1d77fd8d3f51fcec6488bc99194349e1abceb2d7faf7fe96b272170e1df5f9626fec3bbfedb9eacf1cfc3e592fcd4d7d7a6d3f8bed800f0d418ad7530093715fd6b38d157cb05fe2cb7ec4ec164157c1a8f1cfef365e2853fbf4f56ebd4a7e1af52dfe82fad0d1cef7f1be4f5f30f087ad3e9a2f17dfd7b3379ba6811d144fa4bebfc5db097ff7697167a8e34f9bd9aa2f569febbd942a7dc4373095440e194c85e553afc7f3f35e03b36be377ebd30cf86bcb32d57f231702d35ed8f9ea1f30c1dd5adc6818d76a0a69234e4dedeede9afc3f34f7281a0cb501db1cf86bcdf9807efd93c40a76848992fcbed5bcb6a597ebbd5f7db5bd335b7fdd98f5d7fc4a8efe3fdbb4a3bc0905fa7e90b7bc23557c6a2ffc5788f3d87160fa6dbdf182e38589df6c268a95b63d1df12a77c6bc812a52ac1bb7cdbbfcd7ee43f370452800e33b341819191b0b47ac3dd9fd36f5bb525faaadfdee88a48bdb9d6d69a321bd36748a0aadcb3499080e15d87b27a3fbebcf9cccc6c09b337b7ed99adc146a319fa8d6e1fde6812043abc6efa1d3e6518b0611062e832be311e02cded368dde80e117dda62aef51c3b576fc3d4a558485a60c51a3b2890daf4bdf85d614e1802eb3110fd760d3ef34d7babc87171d7412fdc0f82b34ec8b1b9fbf19efde81c845d0ecfc6b4c89ec7b8715d0a5193728a6ec0b0a80d16d14501ce2067c0caa15ca6526604d629c48e69f33e7957a5eb40e19cf8c9335ff8a4016b686c26e0d57da9086fde4e78e9fe3872453d9403b3be2c8975e1b024e40f19ce84067501bb14d932640f25c04bc4d1af3bd8ce7a5802559cf98b20e0633404514ff4a9c27041a847f8f419a10d80c15823f06ebd1eb13704b16681dd6410676e299dd9d1a9003a6880b30f872344795ee6e08e0be67710e34c6c9b3a4f5afc510a8b4b3d1653120e90abe871bd665ea5f048832fa4ec2e814da89f7c6fb088cf7e70dfffa6a5b9c34d3b9b607f73bf1b90e22427052bfc3e40a0874081be6d8b10d08021cc774872d551100df71767a0f1cc87bc3f7a37545a02cb9bbfa899bd70f06ad5116ddf5b5115a93b8a3ec4f526ba463233bf93bec84a2796290a667047e143ea7b3b3cd9634d383fde9b1be418bc06cf557c1cf5a4ff2b5d10e830871cc4a97c5360147da9d9061dfa0192a060a71864c83439e1cbec984901eeb21b4d79810e31983872f7e6483b02e7efceb7dce8c87efcb8020e3280081f7efd5b668c6d7f179d8992eb340a4a9feb33d081c1a45843a7bc573474e0c9a03cf351d63318f01eb814d30373c4e103c1d1ef0984dcf6c891874afc5dbbadc0e12019436c273348f89d2d6d02921a47e694707fe6d6bb40627d70e83c81f070e52fa3c3f40959b2c8f018fdf1edc6c60c9c22a06f6b0d694a1a3715d4abd80d46adc13b6960be69ad23f023a5365a8e34272b5a6eaee6360758848cab1dcae6770dda92eef3120570ed062e4580fea06c02f5c370cfac2fa464bf3e0da1ccb71776ab4a490842df52c44fca52a9aa3cb7b2722d5cd038b89ced26701d98fe9ae1a40c2a273fce702cb9b493b39248da7c1e2abcecfe0ba07abd35c1bf430b051d3e3ee6f316e68cb1d8384dd64bc60bd6f316e8c4096d61511ea91b5ce8183c531fea40ce8db1d91c95a2e403633ffea78d0ae3617737b28b7297331bf16c12cbc2737560fda69846489248c2d0e40db6905fd39ac4fa2f731fd88383a05f413b37fd0ddeee3a62311eae3a5e9321b83de6f351ac0fdb78d9630d714defec9cd37dae879c573cdad89411767f87ca2ef7b464b3ac4c7e739cd331722f573548150b3531e3c893f0740a9f32324d72cde370cec8e92588b21b435b14fd02309eec5d0436b03651693aca33540e70411aa7f3b75bf215bd4c44dcb077807e83d616bb8cccaa02d626b33bec6493edf71bc3777bc79ebb06ba3850145551aeddd0e9d1f996962b24f32fe9475754538589d7915b2cf62dbe6780d4b156924f6a91884f2e80c1700fbc57c152f36efab800a26d7e2f43b171508647c2e552c70eebd0765de6f7aa5eebccb8114e3eb0dfd80dd3181ce89a2a94beff91b929917dd819860b7f6355e19b458272068627f318170ede3a6c88a9bc17be4ea94e0bfa3f72cd62d36dfb9781e51210f896595390ffde262bc3249dcc8be297137f29d6c82807140da5f116491cff10dcf2689bf439b80c410c2fb5fe3865e50b888ee72e2839928a6f11c118a937898c549eb323e5be27d4f27c8937b7f8a583e295779e4c9f1b33718cf99f7e1917f966a443ed6bdb940ab57b913cf05f288e6de0c08a062449c942ac3fd785da35816c76c4c1a4c0d6ebc46c0c4bdf95aa7a5b6992eb6087c641911f66dcd29fb6cc2bd7ac9f34b93e4ed6f0a219dcf2e067175595a699de899831e227b47858b19455fc1bcce2afe57ddee4cf79b6b5c8092d1c87103126b955e41d942054cd7274d04e48cb70f24de149185260a32a443eda0f668add960ad63845197cfe3b3c58562c4c017db713581d9c47d8bca4542457755cebd1cc442ec51180b61bbc8ffbc20763b909f6d0dfbb541fe2358370a816e707b0ffaf35006a3784cfb102362ce8d4fa27804f2f925dfa4c7b64a3bbe41b7b706c72cc2bc8e3b04e6f4c7521fb5c1db74671b3273c0b6a0886506c70cb696d28f8dff6c6b2ef0cdd6c0abfbcc9eda074c4ef10f88c9d446d65f0104ac30d60b6db13d28696b5fec07a118be222e736c9febc7b2ddbd63b6066b4366e6087cbfae711748cee0ded734cfa039a8e67117ac6fb4ae4366572d5f7033928d871ffaf0431f7ee8bdfaa1558168b19eb8b99df90ec72979e632cf59b2c81de53ad4118b6296a48e07eaa3adc58ded98cd5f33592f8a1747fe562f5ef08f6c3087e7f6db20cf02ff6ec80ca58d623ed7959a4bae08b893b857ca8012f2a59a5d0b1bbab373d1454d14d5ef938b084f75e8df2902a5290e95d8e7923ed309595fe972fb37ae257c4de4ef8c169ff8b7b6101c8dc63fffe4ba33ab27394667671b2e03efe4e06cec4c9741c4809a3cf0ee84247509f7da5c0cc1a4876d190dde91ae080292991ad6a174adce4d8955170230ee8fec7f441a87b6c615087d87e4dfaa0b7dc304e128f2550db2f626ed7826ca3133b42e0f036243e4bbe2bbea39a81f9ca9f2b3adba631bfe5f0f098998382116921fa1d5b7cd9ee49b1c73d0aad85eb7245402d19c0379bfab35aa8b8ca953dc0c6ec8d25c975fd7985c2a79467043587bf1816744d4e4a1a7927dba0201f12bf9f75453faa496a309340e3804342056c308efdd3548c46bdfef83bcee1def19d9ab207615d5c49b241665c43f378a08c2900ca35a7a019834d334f19d5097aebe222850a24ef0f6b1dc9e04edf95950238b9aff159152e5e6ee82fcc718ee89c675a10d876a4a2d0c72006dc043222edbb31cd367e331ac8faea71deba85f67e818ca8fc02ebf743ed7001328439e8b7b46c6a896abb07667d2837f17134de439f53b8ec549684dde14cbb3387b1d03a5c8cd77907e0644609d02bdb922b8db711cf2f6394234a61d1b9380745c7c665e5564dfa17c1a05d71bf7e924c70bead5ebce35bca35a6236564b8cdf31754e2acfa1aedc60813c26d74371e0df0f8906f5ec185031504c2ed907ee354224e25c146be1af9b0f0cfbe36ae8e3788de583a2bebc0bef8ea8963bbae3e3be9b1aac5fa7846d7ed5784d5cd7877981c43d51e9dd6bcb6de5cb5df259d233fc3b79ff4cc09a8fd5f9c73999dbeb7ce67d4075fbc9fd2e512f9f59234f9ec535b71a27ad62cfacdbee097afce26716f994ef4df1653046f9efb916ef472f03e8f390d794bc86f5cf37b7e587311d932603bf20869ec8f72748c47bf1fe77144f708c69ea33b19ed0c0b78bf50f7f9cee7e4d93c707f54e899a91f8676c53911c03cf6bce73421bda39e9cf60bff4739e93e29877560c12d95b99808527f3b125fb9e4ae9ecf47f99fd4fe85dabf739e5ea71b0d102bfd935495e338ab7e8f1f337ddd9aa3c9ce39ee49dadc94d4797719fb6d11a2ea1dca0fecd51940f2d1b93e1cbd9fa997b50254613e908fe5f52224673db75a81c4b2a9f1b3e3a43a77a8342595c481bec1f3481210bc044b2251d4a9ea78bc64fbe4701a016228f0049f28853ff0577d8425a6b8a10c44342f0418d63bcacb86989b37b3aa67afabdaae48a0ad7dc7c69b3fcebd0d36840a9f2ce7ea70594df49dd09f6cfd7e1afd168fe15e9b711c290d8919c13d4712bbe277a862b22bc0e84e920ef5746cb3af09c8673cb0adc1b89467570b46aab23d6b5e4fd8ae7044f75f79e415bbeaab04b5d46f58433a325cca1cca771034aad73b5feb9dcb529dd5797b94f411d411bbe835fe9fb45007a65be57e50c47b5e9657449163869deba45b9eb0220e858eced236304b83fae069c07388ea608d445b5c101086a0c28177d7fb4b3e3b689d6c9ad5b0d7173de3aa8b68a225833a4dea7665b14b0bed142b9ec762c777dfb39fe37d8a52efaffe7b04be7e85daf69970677581be539039c0f45d8192d3188cd7a283f80ea37514d4d9423ec457219c810c68e62289d93e0ffa7ba2bcd4ac5dd727475e95acc73ea1283f315ae2bf2eb84c0279dc8e89ca0b313e5ae714e4747d84ba876cfb61411c4f2ddbb50878d7636eabfc2e76a4b30aeb6aaeb01b5552ad773d99d9b015a5cc6d6b7e436eedf91a5b5ea4a3eba37947e1a50beccda561d3fa9170a008c8bea578a6544da40ffc07409c64d04108bea76f3f2fd25cef6fdd9addcde515d09d70272d1d9873e94c5390ec13aa3508c82d4409934d2af94ae6818d3a8c5db2a22b710a15f16e67e512d2f8dcec761a2889426531bdc3f129162f01c01f3cfc1b0d3e03d44ea4acc160b541ab8d0becd78363a635a8775c9bb43dd74306966a6cb5d84814640aae199cac22f3bb95ea5eb510af7f074ad4aa11c053659dca72af9fd22e0fc12dfaba423308604f66b4b604d1c83a9e7ad5b7e6d5b422f64dfe3204dd8f3e780c277fbfddde3e978e295ee73206d74a50b6dc2645c3517938bdccf2ff71d434ac7a7316ed9f973bd619ca8946fc717d72f6f4cbf7c1d5aea79e5efa0f27569e1dc32fcb5385eed15ed52e2cb2801883bc91d2c401437c4e7be4c1e2c6f8fcae2639d7b2f7f5367e9f509f206f0dda50321ecd85b727795b2c1912cdc38a6790d599d11d2bbd2b5c51f2caf98a4ef8cde2c3e27d6300a6b5e235f21a83734a72c5e1f64d788715cde2c7f3cf00beedc57c2365d1087b8c9fcabda6215ecae93b5c2b1e7c5eb7bb2c93d0a6ae8aed8439598570d3dfb83c43a5e1487c37aac857d2cbec73abac2db16f42fa04fdc0bfb1ac1a4c7ae54b9ed90bef4b0f7b3766c8bd4dc71de2a88ad3141cc6da7cac0e77ba8c6d18e484077b6a6383b83eb6e540563ef99413fa87fa7bde9f11e11dc9f59ae6f39ef7c55d1ebd5747a4e0f09c15daf561b6fe761b19cdb935ac57eae603b9f653713bc87b00f99603bcc350e84720af5520c731cea65ec87735d9fd433209ffeaeb0167388ac1335c8801dbd37c5ee893ef48cbef5bcdeb313984ce93b23a5d3537b9e8b3b53e40f205bea34416d91bf5bdacf2deddf5e2b3efd59e2d2b7ecd5bafff8f36dfb13abc56e6a92e50b62341f149bb965cfd547c5604a13ed15f8b1d78ab5d4d4f3777e4ca5be58ca2dfbb66b8b99dc58b688af7077f225e31aadcb7bae23df3fec270efca3988fef38a6db9d599ce4eb8a48e972ff5ee42c5c87546d62d83f4a724a715e8fa5aa088eda1a02d305078b03b33ae5ed8af53c712ce61ab05998206f78412fc4ab6d44cf5905beb7aa880783163d15d7ba44fc26a380b329cc3dc6b126e7f5f7c4c5d720d06908ab05f7b775823a9e645e3386ed1ae46cc39efb38a685566bbdce95b0efdcee5ceb09c0cc8a39dc825705fac43258d58ef3be6097b8cfafee7125dff8afc1d24fdc9b477c8f47ff1dc7b84ec6ff1f787a0f3cbd079ede034faf329e5ea718834687ef8bfb604ed7d8d5843f764e4f60d1b993e4b6672a1248f7c0e571e619a4cfe24e7c05211edf08729f17cce9f361cdb8315b5506f31a7a01fa918fc28e62d88ce7fb102086a387b83ed1b8b8bf3436bf24172ea953243cab50b6311f693bcd41f9417daad17bf3dd244e208ed1a5e7ccc67c3f76aec99a67b8018f6dc4e9f969f03692faf2c847ab334f4fb8366e8f310008ef46c7d99aaee5182ef812d6e5223e5fc9376966f3f3b21e9a147711e28cf3acde7c15e36b3ee8727b61c97bc79cfe5842bfd468090b556e7b93a0be1ae190b2c05c885b631ad413332b8393661607686df4636928d2cae2c0ce40f947fceea63fafbbc7a6f49a06350129ae15c447a02b28e704a02f8ffac069b0d15ad24a45b8f6a83edad7e8ee4657bcb01ec69cb23b4d110e06fdece13c55c4a361b963fb27d76c1abda107e5335ccb45bf0cbee4b5b0a28af8e22af986649ef5e395b784455d18fb0ffff78cfd79f8bf0ffff7e1ff3efcdf87fffbf07f8fe7f4f1feef02db8735f9be03cc135a83df4be6f5a97cdec0562fe7ef12ced587af7b5d5f17fb43759c05e2335c943b0ede37cab362dda4b6502d1d1e339ea3ae4f96b13f17ab35aff4ae9f2de7bb086201cda0aeb50e9feca0738c6f756a1d736bba2696e132bcd3b3d7cb79bd03b991513d65cdfe27e36b1fc3ddee68dcd0d714b1945f7765fef6644cfc644fdaf1fb95f0d36ff8fe8f7a88473d4428678f78d0231ef488073de2419f8b5fb0252c6af0417ea5724217f822b939a095265ba81796e4d9ec9f5c7b6b755827963f8bea5e71eff40cf12a93fc60ac5fb7bebad654be2fc4a7cbcb05727ba0217e42144fa226a31f4bb857087b28c8b18d76418f30e91f1111273759ef609ccf96fb2af207f637f10768676b2e06b5fa2070cf3fc6ff28a825be39177258dbbdd6e5c1a7b3b508b672edeb66720ee205aa7b5c4b113ec2e7f9109eee14764409394be988123eed0d7d27cccf798bbd7ae01e3c700f1eb8071f8a7b704d7c6a922340f76f0df577d81e8767e5881be412bf20994785cf89dbc57cc73958b2887ac4109fc82b331a50e0f527c2d54458010ec6f9040bc3c5fd63262d2e35d2175ba75f3026ef0ccf5c66eff8ebede7fb69727aa7f8170a6a27aaf2d69e755f55bfb3f2eeadf1193cb645f79660b843c21118f681479cc649f95af15ce82340ff13c7be13b820b8cff2ac1857a7661cb690a3066386dc741d1e786c4731a79aea40d271a7eb717521beee38df0ec656ceace1ee49078cdff16ac3e7e8b2b521fdd60795764049ec88abf2d19d5a97e23b263306f7b8531e77cae34e79dc291f72a798eede33dc550dbe4bc4798f39fe5717d655310887cd9c229e19c798b288b3c874493eb1c5b6b1bcbc26786d33304097aa026d7dc487456b8a10e71ba8b9262b6b8d0866478fdd22ec3585b72dee1bba2f910c2942105fd84d30fe8d93e27a3fc20ad5b9ee410f380d5cc98ff35d7fc2fc06debf4b637597d491f704a04d79848787f2fb4ab7a92b028a7b0477595e5d883e6ac6f0613e1077ce75dad0ffb538e919af77c5fadf13e715d755e0b39ae42f451c21b17f6bbe2a5bf8e78ee3a8ad615395772b9e6b6f0d1920f9457d713d618b30dd38694efa0d3f1cab4e477c350205cf258a99a56b845f6fbf0e778241b6d6e4a1a78e9af8de4e622c167166d584b1d8041a071cd2fb7105f926ff968510c3cb90a5b92ebf8698e4012e19da7fc44513e9fa3be9b510f19e91bd22f9f421b9fb5437c45a5bc73f17c35c443913e4b7b8d2c168493ed21d65edad127656a17d75831a73220bf5c575a72cc2202ce95364f9101ce2eeec38a4a6634ef0c3b15d604ca1ce1b7a960be696dc5dd5ec3b07f5f436ea230875a2d634b89dfd93d467fc243a04db6ec88fde681ca07519e9bf4fc90df8b1dceea83e7a93e6c5a82b6e14ecf5b9f1a2e1425a199d1f4b839316aabcf3c8bc30576d46cce8a3e341d5643b150bfa9c719e8fed074ad61add1c533231de653817a8e615e13c10fe54f2ec50679b53d6515bc2d6588075cd729de85b8971bf567de7ba7cc97c594bce3fce6b9ce0750ce38cb97e612d7cc7514fc9ed75351b8c7581bd81e212666be8181d367cf7fab953085fff21decbc61c2cae1b8f0f7ec6d8065ad33779ef7d44bd9a4a036a52570f19b2d5caccf10a78ac8b40669ba88fb8a6bebc99d19236564f689f395e70afe6c9563ecfc102ddaf1fe76f836bf9d921567684218f7d78640f26f38a3b5b0df482bfb349ad298a99182d8c9144f09a2bd794dd32868475ca1136f84de67bc558919df69b8aeacbb08ec43cae3cd704862c0013c9887428c9ab5e69bce4b8f02e8077bd4855ce5d66e99b3867ee94e0d0bf53539d63ca7155772af7725d2c67d96bf303daa44b8386fe49e2ceacc26b7c199ff1993cc6e7f2179fe0e56e192de177594eee8bb8b8afc3c18db9b77bd6d67457315df0fcedadf5a3c2bb54e7feccd88baab21cc434127b71aa9e18df87c9f35ca42faaf242133efadc586591eea92b5689f679da5ce972bb8ef84ee833e0fce405d830f3578c5148ef49ae338ae92464b7564cd350efa1387d3c6f53ea5d1fb1c98ab149d1ab05b7748ec6b94056458c3fda1b6e2d82cba9d1d2566b098ec9391eb4f591bf81d719e5d50db9eb19989bd649fad9b5c5705e7405e12be1d80df2e576b6c5312dd2f7b9d0646661badd9d0e6d4c7907fdb305b689502d04aaa5c1f577e1b978c87865fc2e11ae511dbaf91dcac32531c931f6c15798c750f30c548782eb165579bfd568a669fa67ca4dad753478cd03d9bfe9bc1ee7a12a9e5d32f652038e97d11229b89f03842577c1f908638a8ccf773d618cfac487e07ee43e8cc5be207cbc40fecf79ef875c57b565b696dca6cc452df64c30d625364df00c529bde7474146736239bf650731c9f669ac662e84f42bbbbdc3b3e72a6d57cc6a0effef6728aebb12fc82f75911dddf9301b1a8fff12c863505ffee9f46421fec207db031893edf676c0189e0f82fd7091de0c30318d85b4565dc9e739a1cdf786706e3b9d63365a897ad46bea4d8993569a82d731f2f7429c9e8d2537a79ac2dbea626e5bc9cf7ebefc7c717e2aab1615d7293efa871efd4377de3f74745e8a62d449596c1a2ed2f76b0d9d7501c9967928df4377f6f8c9f7807734b47166241f9669a757ce6dc96da89ffca0c68ef02333bceb6c73b8fa4f3eb36a8ee96af29abd76bb5ff3571be70c597c878c42bd5d350717cef3a25cdc99f27bb44e67e6e692eb9c9ba3db6aaee6e5f4cd7c42194035a1aee682d9430e2ac9c1daa005cfc8ae71f98472f06a1b3233d565c4bb85ed630eea400198d38af9dcd4fe5d5c27705e7e37258b95f2bcc9ef96cff7a6be57e54e3d91ff4d7dae54cdcb27ec0d467875b7f7cba4f17c7701c788347a0f7a596969caf7440ff566b6787b84fa65858da60c6c13f55bc5fa196b8bc94aa3f7207ed03de35d3f717cc15204ef83eba9918f54830cbf267db18bfa0248ed3d8bfa482db9bbc2b8203199af37067634b7a82602d7fea39ee19ee4181d769efeec23365b51e7a2feebdbf7b148709c978be414d71ce1fe4f30e9a1fe3c820f8dce604be7988336620f9adc748cc5d0537dd6337d28234360ba4dc289846267d0474798cba8771a3d533a6865e20e57d5d5701e8308732156eb1393f15920637ccf5a1a2d11f03d11187277637160c673e000cfabc549be21632e2b1c770b79c9e018f8998a782b6c1fdb90c1b3260f187e31f4ad631f199f8596b0b5941fc518bd8a06cc05a07454cb02f70cf722c463c657d4d7535d1656bafcad86dc84e0a9702eb4e5ab0abbd4e5be3de4245797dbde157438c10f071b4de1119799453b9e4adbb60acf8382f75f75c1d4e0405447e4a730d5a7ec4c457859ed99d193e69a3cd8f05ddc836dd043607482be722873499c7224873d847501e704b40e3b9d8cd85f634a64df3b75d7d59d5eebe04e21f8f25037f8882fad672d7584c508ef1b09eb06eef879297e2a5ba571efb04aef9b1a27cddfa6ec18f7e533734dde055c81501fa5d71cfe0ee396bad1f97d6f8a2f83f1ee437a886e74d67c4d11b79622cceae89b1cc731012ea9198d9e63ab8a783068d123987098b38cf086c0cfe13c5324a388d78c03331d6147cf6dd51d239e3e8306f0cef2f9de709b8efb226cba113bd39500cbfae33081627d98b1bd631db3256e551a381627cd03bc1f829b0c3f87ec8b1806d22a3a534d673262a7f019697ca154cf08eaebe439e8af10dea91a6dbb2c0cdf944f520e7f9d039451578f1992dd92d8de9f14e31deefdc7702e44725853ef9e7f435ea8a271917f2dce3279742ededb08038e60979799dfec352fc65a29965aba9f30cf7e4bf02e55e82bccbd5bcec66b2f8bdd6397c6efe99cdd1304fdf538463fe1c439936bab4adea00a4ee2e998d459becab82bfc1a53e313bae7585f649d9553b656c6e7d2765772cfcfe9db75916e6de2188500bfef992e9a431c4febcf0175845354f94c5e86a77506167dd15904d2468777fe88f5e2fcdf31aca9d0bfc275abcc1461a0bddc470f6f262670f7fc39d585e97645df22662fdfdeb718d080ba9c1f0dfa9ee173901f6ad2e060e11e1ee86703c385772643692396c4a9ba1b2d89257ab07a42536d0d9b268e6d1df9a9d89eaedbff8eeca538964bb44748f60eba225244f65c9e93e0dca0de0038be37b055b93de7396617f2b5f444ca5c4833ab27f9c84f3e8e67d4ce737e3d5f01c561ebe14beb095bb37e7ed830d676968f5213cf22c6aabdbd0e19c1712e8b87fb9a12f8dbe1b92278bca23f51582aa907c04253448ae72c60b8a8b609405d62a1b85732ce612922ae791f0558c5dd954633beae0c97a56af3aeaa4b1cc7a4ed182eb100827c65e89311dc65b525b4cdde30a10f74d902666b606bb4b4c17520504f3234aa4f4dc63562b8f8684c5b95f70ba3257816e794c2afb8c3dc52115e6726b6707cce99b6e0fdd5b5f247325ab2e7aacc397b97254ae71892378a62c304d39ae49704cf72a57945dfccaee49f752ec26d807e5ae63ae1387813589cb32531fb2066199e035d81fa43f2754503da19753d156b78cec459b918dfe5ecf193ef7125bc97e49ee7e2be8c29877d7be77d319fe738778eb975ae40e40723ca17dfd55c5f35fffce7d6ccbd8f5e5fd7fd834de572b19d3dfff1be3fbde6fc0561fc2a89c30eb5170bfcf51c394fd62497c79309f4fea9f844a43788cc5f1383241e17c8ba77483d5d549393dd6752532f8fb04dd560de8a4b028e7381af87737b01b790e9322be8d3193ece85982dd1d3689433c538ed3dd63117084ba1ceba9b9141efe338dc285f4aec401ffe0de7ab04c073dd03e60d92e6f877af284e89302f6aab1fcbe14de854cd650c3d8bdb6760f2bdde22ae9fc039ad2997806bd2ea196b67baccee0338b1e336e7a7e3a5be61ae27cabd93187d493928f41baad871a5fbb1b273f821c76d557b3e9b0beeccbc44955eb40a7d6867d5f1931c7d948bc27ef1b9bcc6556cf90a76fc695ff758ae16a6cb34cd62fd3218cf99f7e1a838179971be73e3ed05b648c6e75276496acfcfe2f281efdb930e242fbab764e0a33960dc4dec37ccdb2fef69bcf1ea67b2f479cc8c579dc1c758741625b9ed998a04d2bc85a44e2aa85d74021bc4a085ffa7c9227527dc25423ca712d84c17cca9b49d76927fbbd88ebfa6ed0dcc16bb3516a2a7ba7bf0a6609bb586daf791a6883b943f900568470f5445a0269761e3c7f9a730df59ac6e32cdf960faa83e897071b7114f8daa0c6c9debd21a8e2906efe7191ca02623dc836172928fcf158933c6399b108f0df20b7c4dde433d4c131f21550710d6f4d65d979fbbee617dbe2bb91abc3b5d11847e41584399e29e58f4ed604d4c1aad29a5735d8ae71cc772c7281e0ded3b9306535dde7b568f60b2f4586062ff2cf44da2bd433c47d05783df5f419983cf82fb99baffc2daefcf982b22be16e2803fbaeb4edc8d9f347794587bfe649ce7d8c62de113dcd03fc19c978f9aaa474dd5a3a6eabfaca60ac728d79fc5ce1fc7ee5d788f8ea14d52319f9669efa3fb17dfcd16bce33bd1598477bbaef413773efc1c7cb77be149c85b175c6b85ce5e102345b660686f409b18f5c624ed658c8558e17cde115f6150d77efbb83b8ecd5ec61744e2bb187b72ae431b96ebb6492dd54655344fa33f84cb6dac2bc3551cf39bac2bc11e1c7a668b5da1be115407d5762c172cc2bab31bc4d9a17d32e1a4f9af9140990be908c30a7fc7f28d96b42bb677245a5384b6c539d04687ba1af76ec6eda86b62d223dc2e95aac1f7ec90faf8b3734139d877d7c1b324eb10d80c9f096f2dd8c3810b36c8a6a98ebbe6ea505ef0fd790d1eecd2ba2de71dcbd9c579ba6fae79501754e1b32c730fbf47fcace8fed55c007df9a926c3f1c7b60eef2eba7be039b82ecc1cd5592a7d3b90ad280788b008e7c81ee3c006fafa703cf49df776545785df1de1fffe9a3edb12e9810c798bf1fb60dfbf677906b7c37d728a78d0650bf54386fe3b87d7c4a21d6024df1fd7e1c8e216f55e56b0192ada0e67db10f1733ac4effc679cebf096fb12abed43fdbcd0d682ffe135c5fca6c4266be9f27e9599e3c5fe858b3182aca5263f27f684d408a17dd63ab7c5ad2b856dd3298ebb13beec35e6cb8ef44559fcba0faa754b73665f0bcb31c0dc986aa15c2438cb6331ecbd67b86ba075d8f419bfdbf3f61ee7460fedad8b39d42be70d4ec6eed367e133d5e2b8ccc6e8cdd7985791fcad060e0a939366baac79aacf623e0afcae17d5eac4e422a8853cc4c63944e30c0f3cd7f5b55184171df4efe3dae26e53570412dfdca1df9b1ce3198b805f0fe71962cf4e7d273e16d4ff181703d53ad3ccc682fe74ddd80a40dc69b2e8692e98f15dcc7b816df4cc73f539d6ef1e6b96c2739594cfcf54bf314ccae49562bcaf764ad66db325ed4c8ef149efd46789f1e6e25c9d39bf2aef51e12e3aed031eeb92123102c08ede9b62f744de2823979197c72f8827647c2e1d5b389ec35de7c356badcfe7d933abb587e9fe466caf56b15fbebe5ece46a3672766d409437a96a1b67c60fcfadabaa620f57b085cfb28349fc3aaca5c37ae6ec5cd943cf5c41cf04b6795e4d40419c2b6ed390bcd27de59006a16d95b0c9ed71ac3ea4e2f9cc89edc7ea6746a4761ed7c7f806bdb313be0827b4a10c9b7eb21ffe4eeac9906f66bacc2a615377d8512c4699e881bcc2bc3f5fcd990b362acdf8d016826b64fa89bbb8060cbff4f8ec4b6cfc0b3085879d61092c896be60a46727b66b4849dd1423db107fe356e5f84794df45ef7581f15724b256de512f5507763ab9c85e17ba47b8f6b19933234650f96c2c27d8ef393dc7f8dfbfc088338c091386f7e8f3a980fc6168ae9974ed383369785b1bcc3980dfa5d2c0eff3e17518e24ebbc963eabe5cf69d6198debf7faec97709d503fb667f5fab67e5c7fffe1353183989dcd7763733951131cb7cfffe9f689aab08eea8295aed4521b2f18aeb83517ecd674bb94a6f4ed01dddda9411dfa0535f2d2abf05a737dccc9b93c6c94878df2b0511e36ca156d14c7e4ba41bfc9a7a9d94de8c5513a66702136e23cef0e4f8c79e7764ad1fa14c654e2f280f28fba323ca4fafb3e650d6fdc067b93093e8512dc4fd417d365b6d60b359dc8d2a106bb2561675f8b17e1036c96c279dcbbbd528851204b942a238cfa72f6cbc53d53606d62ac8cf87bacb5f89a4ecbe036a4e2fa257ac76e98b74bc42bebc64f4feaec4fb7765355167fbfc94da81b81392dd7e796c7359e752e4ed91f21ce18c6d50db167f3e53329bb3a2db5cd34f657704ee53d505bc3ad39659f4d78de5f72b1e863f18163ee28b49e2e000637b0273dd6b1389be1e7ac975aff083f0acee5c239c67ddc37790d264a3f93ffc7a4a19c8a94d1e2a377cb392709bff9080b14ad99abcbd24aeb44eb35e0c03cabc7c0227df967faf465f4d0edf142e526f21f1271fa0efbaac99a67b820d6bf72569f4cdfa0bb73f338fe348f3f9fe734dfa0295b95e1dc1c604ee3f89975e38206fbc29ebe735ff75b5586765b3af694b07b6c6d216c8d11eb40db0671b2c4ea3d6bec13cfc6098177e5b438667a41af434db8ed4edbe424c26d717d3f853c9760a8457b17f4e405dc5448ce7b22b0309f55ec5ca37ac48dd661a01e8eeb200fd7457fb3f5e01c726043fa123cd387cfd823dd1670d5dc83df338272a108b85628273e7b4f6bf61971e7ebcd3f2773b417e09d10bd2ecd53fa9e6070888e3145d8843b5511087e07e23743ba0bfb41d29cef896db3553236775d7cf9e35c35ae9b0cb8e7e2fb12f4b6206e3bd5253dc7588ee13c71ef1a9639f8ddc34411cbd63a5d9d7bed421b99423da5a3ebe1c83f62d88f18f623865d7b0cbbeab9ff20dccddbe1da14d65c27b118d60443e2d3e17e463d28cdf81de4dc716ceb8127fac0137de0893ef0442b9dc79c1af1280ef37a653eb7f9ab6de56071626c95641ce85efcf5e23509f29479bc6e5ac0991bf3e76ac4173a2197212ec7899abfa2defb8fa8f93b077f2317ff2ab137d681ef894b684fbf75d8a525371d63daf435b93b5731be26ba6bef5336d1990c6412f19cc7cf93d0eadb26ed6ccdc560c5734ed390bb9b374500aa3c7430f615fa7e955ea24b6bfcec0ab5a9085ba12cf7d147c8a444700c2e95c9c1889d211e309af12791bf378dfb779622ec8c960892bc91e1bb54e9abbd71ffccf1fa441cff5d4a93ad2db45d823550dd582f6d4ff24d8e39c4311fd2f7c3156393365f8163e84adc4667722a5d89cbe804878f4933aed5b31cd32dc4613a3ecb1530998ecf6c453bf9784ebb5ff3ee6632620f06ad5116ddf5b5d1ce365a2ce13e826728888fee10d6b1e602df50d03cbfbdb57e54e040aaceb975864ce69cd7249f5399ef15722bb98e63bac316f4894af1409d941dc9b75c30cbb465f39f57752d92733b731f22f9fb0175ef4153045a97a35c8b2177db7c8ff58d96e669dcd81e48ac60facfffbdb2b260b7060766936c5e9aaacff20d5a0466ab5f8acfeb0497d9ce749999260f9b50675de37906d7dd5ce33c682dc9d3b8ee294cbba375bb135dbab064e8f7ec3dc31d637c78570ae22af308fbf2bff53c88948ad641c88edd569415f85c5d0ee38d27732bb8662869139cc450a9c03d077df622dfb4c87e39bb6e365aa7a6e182bd258fb36b64956cbe8b2bd53bf9496e8526cabb66e8bdbcfa2e843dfc26dfa2fe2951035d478e3b3ede45fcea38ffc312ae15d5365ac325e2fd8dedabe9b38ee10eb6708fe2f591ba2c1dcaf8de57c56fcdea6f7abdce3ceeb1def8915b7ee4961fb9e5476ef99e72cb7a0a17e34d4ede7f9f2dc79c319f78fdf83de6984ff44f609c9c37395e8b76c7bc400f3cb5079eda034fed9ef1d43e0d1649a227e64372e6f1feb10af666495bb3b29d896b81133dba699ed2905ba5eadc1e79f30be533c8115f289700e71ce3cf165a7d5bef496b557e5e9baee4685c177132e1b5ba4fb90cea08627c51d5e6740f3de69da25859fcfc34bd1476cb3af5f7df9a020e99fc420b11da4e338b637cbee7ec5465b83cd7563feafd44fba8799abc9fc79efd23fdee97f64a26fb210b310bafd4135a8c8b587e1de298003789250618956bc2491097e57afa670846e6e878fc8b380334176c34a56feb1cd3caa893b0d371b9c9288a791cf756611f07e5feeaeeab295c1f54ef437a4ea503cf395bcb3fae97e0e1d94d61678418e6bd7e825b39ac81eef5efb5df2638d7a99855b91e9bc2dac9ba78568fe37057f1e5782ec54dd0139b668fdd9a213fc3e7f0e58e790a48ade599f37bd4405fa106ba53355e283ac6b4b9d66409e7ea70cdde1ae7eea06efdf05857112ed51531084faec3cdb10a067477c3bf3681a65cc6c313dca9168dfa370f1ac25e62dc08eb7a1ff0c7847769c01f631dd5dbd67e973ac69415e13e04798f61d44bddc27c772280f761ea1eb4516d54a46b80867140cfaa4b7ce4f51e79bddc777ce4f58e74dd23aff74fcdeb45fe8c260fd6516f14fa6ef5bc5e29fcaccbe613e85133c835f8e530afeec2e748f4145e83f7ebecfccabdf17ec5736a586f9edb47fad09b1f8b17bb08b0af3e392e1308e6c18e223caf3a7064a19fc0bc0fa86e9fe744c7e2ba2b833671dd6f4fd8120e64784fd1ba1cf7b9635846d0de8ff886b626b43964218ca1a530beeea55fe99df031dbe3889b39cd2f10d8547119c19c97f2b3fdde145f06e31dea6d52e5fd56f3d1dce15a118ee6a08f20d6e7147b3ec6c809d688f1a1bfa146f1593b896f7727f9878a18b7a7eefc1ae3cd6bc46f2f230e89f83b7c2acea088d7ab3955152443a304dfea27e00d2ac4b8a5dbabc9a819e757bbcb7aafd2fef5fdd6153dfcebbcb93dfceb7bb513edff76fffae18f3efcd1873f7acffe682adf836a3858846741b002fe1c50e8b31fe86b26df3155c7580fc75a2ff50ec44784f6b9413fa7f0ad63f66d07d72d103cd183ce314d831b204c51548bd493a02eb5754ef2efa60eadda7ae7fb9ca3a3b58861c8321be8cb077d69293fcb3669890a7adc4ddad95af27ece7302e039b051e97d139d33b8deee3d61c2dce1d9995fb9e617e46149c5b15eda942a830db22f3966aabbd2cc2ae177a674dd6df0adb36a7dbbe7cfa92efc69beb81e3ddee372873218af118badffb42edea930d697d8df3bc2c90ade4f48c85f357eccdc39de03463a9f5ffb9ac2d26ea6ee8ce6d670f7ed3725ce717e9d5ae0acd84dd9bad7385ffa15ea5e839aab604d82faaaa33a459d63bc1c6cc75c5fcfecc1f98fbfe82f19dc36c5b12ee88f1eb4776fd93fc67a28f66b15d6375a22f8d3b50f395c2c25de376f3f4ebfaf98878370fa7de973dfd7cce3d63935e6425a9d3ba641efce5da35d160e4dc9ef52186f30a3e6dd1597060d66296e042cdfa762ef2fafc7f1f5642cd73664f0acc903865f0c7debf84e2bf2e38277f70c14ef900e5967f62a398417fe1f328ffe3f641e19799b4f398ff13f641eea3f621ee6715d6cd2f619b16b5d7180f6424dad9ed03cc29b2baab70eef2389d614a16d718e67b8e65a97db2b5d6e839bcda9278063eece641c8de79ade8493e6bf4602652ea4a3fe56f21d7c0f8c2ac6b2737b11b3fb8cc37b5006cfe7c4c183efab19f642d67dc6e7f962e313efd76249dc77ff6270dd83e967de9d57a8e56656a7f62f07772a1b373c6b0d8b639f3972bcf78e6d9c02ccf22bcab4c16560509da16f54dad922ecc58a7992dc9c50b1fe02962bf91365b8d4e5f6e24d061b03faded9b886256d5be6d8ceac70462c39c3d6243e5f815f12abd9907ac5ba8ed905380f037aefa47117afc8c1d5344ee9eecb7bc00ab10e72f67ca5e7e9a15bf7802c08cef63dea8ec5d0ff28dd61f54edaf259321f9c87d3b9cea45cdc6afd4ae2ce9cf5ec65dede04faf246f2babc8e9d527c6f6b8a06cc05a0745413d72436e5f8567a89d23ebd4d49d633b44772c64aefdda9dc65bd77ef5a57ec82fa866299315b2c5069e018e76057857b94cb437d7cd7e6e9c2609ff05c8be28d68be693d77824b267846c55a95bcf912fb46c9bcf38feaf5f2e34a69f98b9e5b717eb7d3e1797273916d276e352e1b57ba644c0f18178d7f8c9d5161ec03ca5db8e625cf985e62db9a2de9d2ef67e355958d75cbcd45e57bf45867923b149f811377cdfe3efdfc93fb3c33e861360f40c9b5d664ea12393bf6632b8cad66636697cc138894e9321bcdbfe819cd0bdfa19995e7c88b955c97377978a5d8d419b1a4fbf56543ddf909631c27ecac9367d1a9a033edacda9d61f1fb6d710d56db315ec595aa88378b25439df4d8bfcfbb7f7a46aefe0cbfedda36e7093fb0e94deaf251ee5b874eefd3167ae43ccac582904d738df3778fb279b0941fd7b91bee516f42bfe78c987376fd6d7765e5d4e0e4f462fbaa7f1a275595db6d4912845bad817a9f77c7ddc42b726ca40cdba7d299b8ddf9e864d93357c1aa2e79afc6b89de1de4dafa277d331c383c949335dee1ee79beb8ee59e9435b0419c7fd5fdd60a71c72cddd63e688a405d4dbf2d58c774bb6b52e37dcc359b53f77d8d7b29eb6cc47afb48efc6307dcf9ffc6e818d70f2bb48b6e7d01ec9c8d165de0f0e948f703d8e3105c89d1fdfd7241759ac8fba4b69d2d033e4f1daa201a5779a942e37c1c46536dab13e3f8997775c9fdd461cc9f86fe158361e2bd18f34e7a12e92a52f06dd06d7c277335d0971c4aa2dcc2787b008c87d187b9f6c0e33ae4b599ce4c779e3dea6ebf1db74b594177be3a7dfb4dea62b2feb3e4d8d9bb84fb3b8dc0af7486e3ababc3b03b3e1b85f6a203fdbe479b62a0fe7f03dcdc3edd73a0fa31dcfdb5aeaca108c5bac837aae42be54716b707bc7ec89c771522c8f3b783e344588f149f29b9fd48fcdcf2c6ede8afcfe19b2fca7264b73d4a7e04a4836f81e0bcf1d30dde79bf46f853278bc17bea6201ee2597caf027d4ff03f1277e291ec45bd0b4dd33d87df2e43be46ac349ecf4ff7c39ee8813dc9c1ec763713491a8db26dc896ce014a93c49d8a386643aed19941ef57aac21e32ea8f72cfbcd263bfbef79a06f9bfa975d6e69bb4db28a3e597b7e9ea0afd48c7eb1462a52818335aa51d10d39b701e5b63caee501fe35c7be15ffaed3f5fd45b60d58467ec386f93c98799cf7d49304be2b219d90485985097ca63a2d7e8adc31e2c595cf25cf700cfb2e94a2bf879cb1ddb9224f4af84c59d3c9f5316e1c6109bc6311600be57e6bd63d18e6771d2bbc97567da28924395665606d7dd9974a62d54451f047b34d33969adbe3fff6c3c353cfdf764b16e7cffdfc6afb9ddf8de683c3544dd9dc09ffefefba9614fd7cec6f8b7b974ffb096e67cf2fb8fe5cad4c17cbafefec79fa3ce8fb73ff62ef863653a1357c77ffacbfbbdfccf144cfec2bffcf77e65c1a74f17ff59c2ff5b93b53e052bf8e3028f93fbada7c66a7a9834be372986f9f2eda9e12ead49e3fb73eb0bfaf1aff5147d9da668ea7f28fa7fa86fefcdafdfa92fdf9bd4bfbfd05fbe7ea1bfb4befe8b6a7ea7a8c65363bafacb9afe6e7cff8f0e5693a7c6ca476ff032d936be7f79fefa957e6af08b65e33b433d7fa1be7e7d6a8860ba9837be379f1a7d346aab4553cf4f8df1d482ef43514f0d2efa51f9eb2f4fb7a8c677eaa931b4e033a9a7c628f1ee2c98e3c93c53cc17f8cfa5395f35bed3cd2f4f8d1feba90bdf6634311bdf9bed6fcde6d7afcc57eaa921aee06fbe3e7ffdfaa5f5b549fdfdd4e8177f349cf7df4f8d4ef98f2a7ffdb5596c5613abf1fdff504fd413f57fff867bef4c7ea34582ebd6f8c359ba933f566033d757d3f9fffc8766feb0f4b5fec7ef89e5e8eb3fece9fa0f6763a485e448461a4f0ddef596bfd7bff4b59310b7f0ef2f4b13ffe15dff6d4fd6f8e7e172497eeaeb6bd3697c5f6c00786a8cd63a9884bb8afe359ce8abe5027f965b76a760b20a3e8d470efff932f1c29fdf27ab75ead3f057a96ff497d6068ef7bf0df2faf9c703bde974d1f8befebd993c5db4886822fda575fe2ed8cb7fbb4b0b3d479afc5e4dd1fa34ffdd6ca133eea1b9040aa0704a64af2a1cfdbf9f1af00d1bdf1bbf5e986743ded9962bf9260d951535ed8f9e77fc94850a7a8b80db0ed4549286dcdbbb3dfd7578fe490c64acb046ecb321ef37e6c17b860eb7de1b52e6cb72fbd6b25a96df6ef5fdf6d674cd6d7ff663d71f31eafb78ff8e2fd0d72350ab09d75c198bfe17e33df61c5a3c986e7f63b8e06075da0ba3a56e8d457f4b02475b4396285509dee5dbfe2d834c2f7c6e2cd88440fd2a8c83d6246d5cf49cb5c1b50f7fa68203bf46c2d2ea0d777f4ebf6dd596e8ab7e7ba32b22f5e65a5b6bca6c4c9f21c19fecf70d830fafa8e9f9559335c792f7d4af91b0b7e4ee0a35decbc7a06016d7f5355aa210c98f820dae4bdf252d176fb31f6b43062b78c141e7b01f04c98b0dfa4200f75f1cf5c5a4c52574868226ed0058701c01308a9a327482cf21508e5006334139be19ef1e31d65ed7d13c9ee13c37fd4e73adcb7b68346d0d77eca79e476b8a70c872ace133758ef1ada0113dbef7b4e6191c6e3a3769676b2e0699206268be21715f16c81df5c57c69b3083c113fd3d638e9a0cbd60613eb60a216dc948c0c1ac7703100a5e682851591aca7885c58d7749935cf311b6d84801ad7464b0011e8a57578ebb08e01ffeeb32d5d192e9151464bbe2e4b04f49ed98524ee8bbe6db4346022902169fd36655180c1e2989d213307abc3c6122b2000ecf935a644f6bd130389ec3ae27b2730ac5f6d5de9a335506966aebdb7c3cf61201614e4fd1769b4df1d05dfe8fcb5c5ebce6cad97d43317c2d6680d8e1da294bc1638de7989963594598deb521901f9ab131e8c7bd2d4e0c02c20ec47ba1111ebbf5e00f0180078c2fd071bf20ce20476a7460b83aa68237667c9c24a97452721938ae6e8f23e2264cb05c0881c86ba09cf8bd70d91b00c08f82b3c7b683cd36d86f3545da6697508d056ea59aadc9ef35c975665b08a912ec17319102940a7c6335c04901a393a9d124056651cf76b375b64246ef05dea51ba325c69a3728444671678a033fbe7826daaeede33692787e4f3cae3b97bc76c0dd686cccc83bbb71489c4998974b29ee11d740cfef57a94fcef9f9b38c3f74b681b85f7644962a98a807db4ae88ff3f7bffd69c38b2ad0bc3ffc5b7b3f69a4298eea6ef2c6c8454b6ba00a3d3175fcc504ade129002ade62876acfffe468e4c9d4080c441865e5c7474b9ca2065e6c8711ecf4364786e8978e388cdf06b575e6e1abccff1313d93372f20be833d19bb3dadc1d993f1a500fd1692a82e9c8edc009b49ed2fdc4f47c41e6a0933e2bf513d9abc8f1d02a8d8119d220408c0c44c6c4f1462f7a7b6df5c207ebd34794ccedf4575796cea92fb531c2fccfef34c126b4bbb0e776344e5153e1fa0baba493f5f12cdc09e28dccf5b04323d7a6e94680b919867d20bbffab04fe06341d171d20b606f88cc52723cd803b827930f00268b897e263dcfd21a00129ed89fae6b69cf640fe14e13db6741836593f85c01b98b92d80c4d510da59617bcfb83c57b8bf8660ae8078387b35bc1fdd19a353b4c3d7f28f8962e6f9cd6f8b6489c0f0f369ea4a352fae322c0a25bbec4410067a94a00d7d49a890cb1e2ecd5015c53b6a732d0d8d4336796d6f8bbe26786885fe3aad789f8c8472e60fb0e93b995bb3f623b743ae32a486f52be9a8cedbab044132530fc357ed705cf9ef41a4548edb6cfe4a09e6f0108fa79679602306520bdf704669e06d0bd08b1a629f682a821116c1b8ba588ed24b1cdbd106b0ee27c400478773268f2edd8d7038dbc4ccfdc26a1e6a943f6c93eed907ea7e2f539345188cd85cde321120773a6f34e26b84edb0a535756886f6c2c002aabad0c5de6bef681c2f91823b1eb7e7504cf11dda63416c0d65c87d05af60c7e36471a2d98ef36871d6a6e38aa77f2744dcbd094bf89be85868b82c4097bc812a011c08c1b0b80583388ee961dd23c929d22a8491790ab27a786bd76a3bd4e81159fbf8efbcaf7840949d3d97e4c458024a938f0fa77a49f8ad12412b3a3badc90de686c5fd046e7dae57f409c7a8c7ce030e1407192014a2c70f81c18d9c0b36bd27c00ec012587877890838664711dd8754a169fc4a18d0d80e51ec967d3da878c696e6de01abc1722beb14462736269c45f169636d98fe1cbd4ea37f0fb70e5b2fa49686a0af5af69ae65e9e81fa9e73fbba68f43bbde0d6e9584777f5c407ccb62b1d7b9b94d9693df587b88142e9e4b15db1ba7559b239e82525792a39e404eaf3801d379316cf4fc0339ea6b9ca3bcb48bece505636624e285558460a6fc33a31a6118c56d05f7f0500c51a4a1d62d525fdcf1dbb66b8da998ea22a459a792bddc60ae3921f8a179e553e3e832f16e01a0f5e27a7e57aef60ffdb27b728724361704c8a46457e59b64cb1239c2734eafe5ef2133406293d57e0044708eea3d2037b5f966cd8e09702af3d73e2cadf1f7111218c8a132a255a23f970e25168d62ababc452e7028130db591484f3e8d091d45137d961a9ee50eac07dcebda38e2e07ef7a9a88e8fcc18c42ba34c77e58e43eeb3267ea1e972147fa2c674ff6e414682d81c9773abe21329efed99cc89ec9d33fff14db23a7a37aa8b57291df24ba2a9231e267fd3789ed4dad1bdc0879ef94c8a43de9e1af4e3773af5377e7dafb70f1219b9348922632465b03d69f63e52fa233f7032114cecb16ca4de7e7e3d7de29c4a27932dd633f1bbeba61be10477512ed0340519e89f702bb15935563b3250cbffa349f4d7dd9675715bdc00e859141e25c7f0044ce96d618d3dead669aa408e447ae7fb876470d6db1b9b92542b168cd91bcdfd41e554554dd3a3cac8d34756c696f73b81bfdec1dd9370858e11d514cad1718ec9c2e40b41711fa0e4dfd83e5ba6ad814b1c7ec22e46fe8df135f6a4ece3ec9637ede0679d3273d33765651cd8ad91b4dc636ebf341e9df4bf514820cf3ea78cb87ab4a575f305f9be9f3abbc7f5615f1dcd415ced06aab33f2b5d0130d03ce7d5a0370c8f782bec19b4c9eb0e37876c8fa6875656a6addaa73a5ddad1ede81a5d5b05def79487f89e2d973d7738981be22c0ea74e87100b9eedd3a64f25df8ab43fe5dc90c4ae7f8ed6343ef6190897e0d7ae3dff564487e3f98381df2054236310bc27141409f99a12bdcbb565b9a94a46d5c417de3c3803ec4d4332968c0c611db73fbf5f49a606f57b6886e9b659f17c968d5f53fe8057653bdc0f41d133dc17c91926ba8acf6b75f1eb3dfa53e937f677593686036a7371a48eb404fbceb4ee088ee9cee47b0dc0635977687605f11dfe0b6f23617b41f494efcfab999b4de8b9f7bc6dc05f896497e3df137d3fdbca368ffaa9e99e8a562c764b62a223f2dffee55d5ae0ec85d761f748ffcfb6607ec000073711e606e76d83ecfee5c928066b75fffeafe513493973eef53757cf45d448f9ae9f9d3caf579f3b3cbb53fb27716fc9c684e6d8ef81e46ad449e1ff27a02186dd4c77c7dbfe42da56332e4b7e7f45c6cd5f333e4e5b6ae7a28f28144b92189edc5f6efa4e61953b16e6696f15b74772e79f5dbdb564f488660393d83ec1a9331cb8d647e07d678a7f7e4609e232fc712e75676411f8ff6fb16acd716d2d94574b8ca720a656bb6fbf4783fce89c1ac11e41f52f9882c71744bf04cb1179abab2796f091869ed80e595978ee811b921319697eab72d9a67dff21d0bcf7895cdbbc7cf31468d01bbc7e0ef5f791f4ad7070af71eefdea17d7dbd3bb2e8688d685e766ef86a08b2a57f14bb4fe73d3ff31ea7809d1d94736ac3164467d93e9b754e80abc688df010b2af49e45e7140fbf57c99e84fd7bbefa357e737b229e587acf432de197e107d8a86fd984e1cbb4f7d6947f7ece40bf4922dec00ca046fc25a2e356aecd63569b859cb987c4f5d2a97fb844f740df5b470e4cdd841a9239143c49346b485cb9868f8748c44bb32e7bb6e8054608fd6e4bdb873cd1f66c7fa17d2e9c773fb837c773f207cf29cadd4cd4798c9550f0f38700a40a7caed41da6fe9fba28a44bf240c4f6eddbfe1a5eea8c52f9857c5fa7a21c8110a27a0e18f6a5f30380a300f5dab37a7f233d247520d7e21a0c3890d89414e8e8787f5f2af8f921e25733e8399a74a1a7571269df42f5d80bf2d216d5d0e92473b855acf17f835f0affbf13bf14308b2eea97321b36c1d0e3ce7aec6af64458420f36e07260c881426f5a47dda46b68762297910c41cf04aaf7a6249e43bc0c7d1065e7dd0adc9f7cf92839f716ddaf685f695c17c7a40db827116e8b2eaf509dd8f6f6c26c0901e401a08f1500a1939a6927d161ef2de8c5e4e8bd6a7296a892ff0f2d5f1d15c22e39d3e6e680f416f1f56bc8877cf6dcd46a435397c16ed89be33d23673f3ffb1e8700296390ed12fe0fab0b35487c1046f5cb04bc17fa8b832d42a7c2df7b8b7e2b12db4393f52ca6eefe926251b539eaa7f6587de60f3acf41f42b808b520c1e7b288c00209c4fd76204e86ba6f74369d875e83386f99004587ce51a94a0c335f836d1d718f4077b9629aa3e1092d2fbc540ed4de2dfe67c37b963ea58124df6ee03d7d0950de295c0d0e10e52d051e8297929bf67afe5fa888fe9ac1276212347cc27cbc4544563bc0300b7053e57464724b3984574551e78f8be7ddbdbbb93d50bf9840be9be4ee2138c1baf9fd4b6df9c1ddfce275eca9eab6c3e763baf9af4f36467da22fb7ce339a4edfc34e492ce58ebf5f244c5623bf770df7263b3452801799b2376a9b40d2a85f3d4da57cf25efac6e00f8f9827e6997c5323603d88f6a075f5a9237a47e7bc9d99656b9f916e91cbb0c75b3ecfe44750394c21e953aed9aa1adb33e389585ebe6342f22ab11094f3e01caedc92b7ddf13b03fdc3db90619f9bdc0e0c9b9c4b142d44f1b4a227d1ef8357c9bdbaaf36fc5e32c2e78bded588991ae457988abacbf743f6889b92e763f1232bd03f352a99eb7efec5bcbacab82dab090d9c7b3eac1a007962cc6726db11dd8c308333721f29344f5d916d71ef2db74eebc13cf0756dddbb9bdf6684684e6d6ea51ce4df02c5d8ac82d1252b29680bf3ac2ccd01a1ec56d14e2b9d55b9d3d4fe14ed259d52273bc37843599cc915e0457f254cca332fe7371dff934bf99e2392438570cbbe1c4d9e13239aa12f9a902bd98bb366b3f3920edb3c442ffb3a6b48fcc9de7c8772524827befe50ec16b427404ff2f433ab72f775efc4c0ac9e59e586050ee1ee6dec10af3afb730a315ef1bf5a7a258371deb243353b457dad2a04e32865c1ad49b639f0a30294dc0dc5a819e72b23d134b56bfbe0af9d74973893ebe4159cee414ce9769ac2e2c72f7fbd91e974bc602d79cb5caed7f6b9fbea6c2b5cfe37efd517ffe906e25bece8dcdfafd95ca7b5c409796ca35dcc2bcf6d6fa59ad5b4ce551fa716f65da3e50ffbc041ec895658bd5236e4ebe344ace76feac75d2cb17cf11b335a7791b3ccff6db234754434b57384b2b14cf552167f13eb09aefc2a4338df1dc6814b3a6f2c95343973da3dec3b68f378e884755cadb25e7e052d8cad79f87109bd1ace859982c28f99e59dcf392ad01a67864a2faa6e2a1a1303433f828eab8eafe9dec1e443a6dbd8c7262efad2827d2ded87c7364696d8ef87629cc5622839ed34a66edd35816e6fdf1a0c4bdbd55f0764484c4d5627a0b533af35a296e7acdf62b5f27f40656faccac9dacf8d914c7ea1acf7ce4e21eb9b8472eee66737181797bf849a7cceea5bf6fbb66a346b8f15bb36afbb8d8a27cd28de4d2b6f336e06b9dbea6aaf0892e39f39cf89a734beb5681b718fbb152bb3c465d5e6c39c860be0b1e7d2e9d03455bf1049d177d8efb0921373c04d91e21be877ff935ec886d9815fbce79d2540ce00e7270e876d69c8a0f24b1874d229b1ac51e4fc50577831593d197df8b031371525c1f2b89f26ab83fc56668f2ed85a50731e7ac3d1456a62e6f10ff1c9c37eb92e50e92a83d80fcbfa9615af3e82801f29d1a12dba1d4f23c78beef78c8c7bfc57c9e75c9b54535b4f9e6e267d4f71b7135e826965adedcd1d69c4564a01fbd7b63f3b3001ed84567618aef69828d9ae124015e8000661644356075918da535268eb6f6ece1cbd4803e667962688de0cb8ffb5642a923607ba22cd1700cfb65f0cd19e317e6cdfecb14e9eacc11f10a815f4af7d20ec7f7169b477b58715cd50ccd82f1ce235e7dc4ab8f78f511af3ee2d547bcfa8857ef3b5e8db9e7aa885531e3d5ac204e65ebbaab1835f2ad0bc5a71db6978fd8f4a2b169e47b577017682c731e2f61f4be494d96eaa611f0a8b367a6f0dd2b94651a7fa5e2c052ef7a8bbdda07621a1697d6a27ed1ebf34cf1ded29e74e7117ec2d5e336880f2b5c1f939f6fe0155fdabe0df6b0e218756c422ea9089ff345e3d3a40fa2fabd66b86255738c7b80fb5b6d9d5e0d2b5fe73770d165ec64c5dcf10f1ebc070fde8307efc18377104b8de6b9afdf77b955bf38934b39b75e84447562682be004b6f45e436a790b54efba46e26b07496f23c56330ea30bf427540ba2fb43a2ceb6cdd28c6f36d438d27a7a634333507663b59ddc8fd2936964e0b62e0a81ef4cf8b290efadb97efd1fca6782228c43b7c51ffa437b67d7553a8567251df24db1752ad8fafce0d0dcf2af6ed5796d85c5c8077bfd43e3bba5cf93a6dbfb9aaf899594c826a9f4df91aaff1cc345722f5cb8af1841f9e412c832f5d18c3239f4b31aedb95c537c9b5fba7faa265f04b4a60979c845b02b9c054fcc16cfda9b5da3235d512583cc7f3dcbb72759443b73b18373f7bfdc37a3d47bef7ce8e1ef081737e6fcb1fdef2e5727b552e5a574ae5efae8f99d265fd50c44fbc189f061a6ecfd602fe61caef7c99da757564b5040f30c587c2afc158917badb1ebe80acc781a7d817c6f60f20d8fe2012b238be8bb22f1f025fdeea8de4074c95b6eddf3faebbd0ffeb022986f07787ee1ce64b1108bf00c3cb8361e5c1bb7c0b5718ff8f8492c59795e6757979e3a63bbcbc704dc14626e5ea466f320579e2492efc113e4d399559b57a6269bc5afb4bffee8be1cb6317939ae874d79d894874d79d8946fb1291399e639ae1fbbf4636e77c6d37fde3c8ab0047c2a5da298f2dab30bbc1dba1cc5daabaf7ec47194e1c186fc791a3fde12db1bab25f8a82e01f7651a47b4625cc8bc3d8afb8a100f1c192170ad80bd04190aa3bc89dd19038e8891e576cdc1c1f43c9b77192794896d3f85b97f77f50358cbd979e633fa9fa7e4dd8e60e7e5f64958fd5a0a2fe41b71c87caf01fc2a449e5a690c930b614341bf82c0a170eb2e025e5efa6733343487feb9e57946bd5733b4d54c121b4ba461b8ab303fd6919780f125aae3227361db727b0dec320bf8f6648ee820c895a5fa7e633cee2befc38d6052cd4dad1718fd1ac5672e8e315f11e65e0d9b22f6988f7901f9663f6b728ce98434756c696f699f85fe3d397f9ef8f532b6f966cda67dcfb78053a5d03313b8b41fd663fea9e1c7d85bf3f4efa530f8c056415ce6ab1b545743d01d77392710e57377b021afdb1f3d14a02ffc0c9f4874b4f54c6a79ac8f60ccb80ba80f44e251e403dfced8d1dab38afd1a96b77e76b31c58947bf2a7b8c626df9efd643a04e48af2362f4c11f39606faef2e63e07d7e4685f9a2c5f62cd695fcfb5fdb798d323c7279f1706fa2ce50eb65cafa7f02b62eeae3e6cc4e572cd37bd75b4cb677fb83aaf2bbff293cf65bbd3c57cf89669e77865c77fbb49714fadbe0dd572efbee5867db43c133eaf2124df0bc62b9de5862337422db93c49f65dff96e66b262bcd4ef9dc94ab00a2be0de889e756e0ec6ae035f759283ab381fdf8dfadc36499c658bcd8d23b6679218cf9a54392398d7d79b5d5bd1febd616d06dc85d5f63d8da0c7b68ad927f0d18acca748eb737bd7505de1c8dfc18c6c257d9fcad2d11a9c3d199fb6be43fd2ba97b9fc75148e3ce9c7a49f13a49e1fac8a5ea22375c0fb966dc7d8b758f62f58eac2f79081b036489f178ba4853e786af862023fa47d91a5f294e7db83fbe0ab19851efedb7fb453986b73853236ef45f7d798af8625cc5856cfaa1ff4eafb365f6c67e6d0892e82d493c95f48a97e4b53d93cff6441edb53f96b0ff3324f9ca5edcf8a72329fc5c57c110e66c6bdecf0cdd00ab3fe979d93af387206a7ca53f1da689edc64cfe2487d99d9c3ec7d3e3a5757821798f291efcf971fd23d5561eac339bf6beb00f915e426c7b1ff0cb9eb33635ec0be432c0f9eca4b7e7fec1042fe3a1deb167ad747bea65cbec6e0712578985df29c736495a7b8968ed8acb3d9bc89a93527b6df5e592226b105f1fb27749fa1af0be68e28ff507c672a9e3754028a0304fd67af24867b6f091caa3b1453527430e29dd0d08529f13111c4650ed840da7f57f32cd02b76e20f6c1e325e3a27c962daebd78d14220fe7d48b3ec979411eaf232f1d1f931893e28d927b243627a8ae6e4e959b4a3178e89e47b27fd5753dee43d97a2acdc5bd6b803556453d35ca49be02e6d9e9f7a3cb7246203bda18772511d6703b721fe539dfe0bd22f93fe9bd1f725dd69769d6d0a41756e3cfb0679de5d344ef4bfb1c215f44f34c5eb61650599de915d5d585d3911bb1df5dec1d1f75a5723163843d757539ed51feab336643d8fcfeb7f9d0f4f971ff135dcffde9c903dc64dfda8f1271955dbfbe3920f780e19f9d218f09960c9ad0bcb524ca0d89dc7751a5b80f15f312a8a23a3375ba8f29bd1961c12c1cad363475c9352663d7c9feeefde9cdc3fd9f79bda7b42ff1389e7bf17c6539bc84bdf2c8ea3c65f112dc3dbea98cfc1e9b5188b969939eccec7c5d5a9fce2431d2b169ae72cafd78127e59011d9c7f0627e4bf61be3beadba53ce657dd8732b8f2ad72d8f23bf7453d827996c822cd530f85b909775d06d9b23727d6b4ca3c3ffb1ec41f23bef888cd6a969901cc95f383f5191f6a5565becf3db74e73b6bce6ef1dd46d2cb13937fb02c30e8af5f6e257abb92ab94637d1dde7d694cac9efce3e457ee9449d9bba7c625d6c6f8d7569fa66b0674ee60e65007a407dd3c7a3871c94928339e265e2d3fe43e4e0cd455a7368696b2c75987f2c121d28637b58b21ebf757ea7d6e5b7ceb16c7d7e4b164bd7e993cf4e88ad26f64c29d71b50caa6d2bd62fd2997ac27e7cfaa6c9f512a7efbce7c16e0c95d3f2e5307e333f20358ed7f4673babc3a943a4a007d6f75c9edc32cb0bc30f5ae6bc37c556a7eb132ec21b5fff9cafa50db27bceb7dccb7ec9965fdde7c2c9c710578b7bdac2c9d915b788bfbe761eeb6a37aa8256cc579d5e61676d746b14ca48e32457599933a3d12b3d7008b77fb77ef43766f67366b42f6b20a2e2278ce59729aea8181f9664763388df43ebb0e91637ee0da3c89a949bcfde15afa079111a2e7e68c8b68f8d54ff34ac1ac39f9ce9acd0faa9edd1a9075a4fa7d689f3d9d678d65dc8864ac25d42db1b931fbc2c6d46a1e9af4022314023b24f7b5876dbf16205f1db3dce0d4845999069d0587ef5437d7c2f091c45af025aae35f7d99b327ea4eeeab208623767c35fcd27b534b6b4cde35bca0679ed1e9179c4b9403c35f07a8027dddf5f110897869d665cf163d726e6fa66606c8c767e3954758e34476eca110a02191130cb8815247c14cbe879626cfac54ff10ccb5d2f7649caf0a9bcf55668ed6c366ebd955458f9cedd214d55934674deed416a639dc338a69612632f8d6fcec72ed8fcafbe98eef756a96acc1191a5e98ba047c5e0eef0506efba06b9637a97ce78ee7e5f16eb7c288c0ced99ecc90875d4b1a97517529bcea923be87512b9abdc79bdd3dc71ba903f3327e4a3ffd1a708af0d9aad29ec5f31dd7b96b93046ba0528c9ff37a45c3140e8e67d795a5c163868946f17b182630f93db041898c766752c7995a9a328579a5be3024dfb18d1784eabd2960cf896dce60b38b92487c74c61154358f581a3721f6bb526727b63736df1c591ac5bf7378cc592d861fcc6208a795c6fd59c5770a69ed8624ca581261362e2076db1e020ec9d6ac483324bf63f0389289bb9b9b7344cca16a66ca40362a9993fb067e2322b7dfc09335b2f4129c4a677374cbd7c378dfbf46884594d175b8111e5cd90faeec0757f68d7365d35c44483e6fe9327ef0673ff8b36f1d172be51356307796f8e167c6e7f1f7d0585d09ec7a0f7a9f497c696a8db12436968e3870593eca437e37830d6ad77ba1a3299c03f7342f968f39a82bed9d8dfda451827b913a23903d9bc71b364b4e6412c3dafc26d1472c37d55e982d6149ef15d8918dd3916b46bd57b3692e81e669e239890f162f7d4b7c7c8ecf45d75b05bfd8777091b23cc989b148457c8414a7f5faba039e735e5dc65757b6af6e52721de1ea8eec09d12f99fb1f98bcba715ac2c4d41a50e726f139aa9b98728aa462f88ebaa133826f11e6b087446769fb98b3c4427ee725758864e8ca3495930b4d3dcaa7f4625e428a9fac845fbac065f5009e98bac2c11c950ffd5a443f2e1de8917d4be340a6f1eda7e49992d89e997c33b4f4deb4904f7a83b5a3c3b82939d8f67e7acdb93ee0b1b8a1727cfbfeae8c968fcbf6deb31a76446fc9f2ae49de9c6153b3fa5168e926365fcb717d49c5b17eb3baf6847e995fa2b4679fc047544c72de7c97f66327396e760ff006782d7ccc9b5a09bfb0847fb823db8f7ed32dfbbaa7df142b52b7cf851fa3fcbccbc135eeef5dfbecbfbdcd3f46037e6f8cba7ffdd97ebaa1c01bdaba467cf301e709ef9f1fcfca7e4eb553d75ffbf8942eb9fe7e5f15da9f83eefce3d3d81fa7ef91f3c2d81fdb9f2bd0b3b5a537f662816cfd5e21fca36358daa9ef4bcf447ce7cc5888f87525f522f29c3362bca8be4839823af212412cf7876bf803d7d0958da5390ba89790bfa3f9b0081ba2c2da4e7396e0c2f6b0e9e345e407dae4dffa508b19b25a5760f2508fa438f11dc1b327bdc63fa60eb3c31379761d2683575a453d86f59a55509f1042547fa9984339233f153f7b1d207ff6a88b3cea228fbac8a32ef2a88b3cea22375f17015c001172ed43240ee644673a9d71057dbd32b6eb02d4340c7f8da576ec279e81e79de22dea47fe67dcc7b8c55bd9d840bf50c4993cf9701dd1f39c963035741343de337e3f3c034c698a95b6327519ee55e203abbe49f4b1af10bf97c61e1d19236207e0bb7acb6dbd9df41057cd2fbd77df933c6ac29f15c51d714fe33667851d0ad19e6c604f3bbda9a1775d4b6cf326ed835b91bfb734394022e6226c145b5443ea07c4b14f7276c0c3037145686a6b22733c3bcf2dbb4e7bb8bf3addbbebf362f76c6ee571cb1fe1a27ff8e40f9ffce1933f7cf2874f7ed027073bcc7a400f715b92efffabcbedf0a595be9767f1faedd865c8059ecfef874136994f426d33eb3d07bb4fe708b69e0d7e4e091c956bfae87bf7057cf501e8149683b4c43697ba8bc4d708ec30e38390df23fec765f9040ef3265f72e62e70c44af2da034befcdce9c81e0e03b98bf678a78638b6befabcf7a94fc366f698ef71d73a22acdeda6e614d8be52ff7e6c117911db0dd65fb430743330133ee5cbfb997d616ee91e365fb9a1d3916b3bdc27f43313db6fd6ec43795dddc4f6047316f44cd502e4539cf5b42f73c11a4bdc077efd9e18c085e395d793e531bf67e8327d282db60f4c9e0a6098dc4e3f083bc35ecbd21a9e3d199fd01742644f8d6a17e7f24597b6b9a56def4139837b548af7b1882efc4c784cc10786ba4f5f189a1a79fec0b5884de6db1b89ec23df24f1876be81f6e1730e50c8ef2a5cb1830bf7c750c331c225e48e21b3c0f3ef3d908b766d746801f3c84594c62078398df97be0fb3974e80c4556ebd11fc0491ee89c37b1865df9fce0f032eb23a2e326fb6b35715f6b8f4e83bff95e604bce6b9a4fac760a613665ff50fb6a7940794e59bea96b69ed1ef696f6c1e4f600e36c919f9145bc7999ada73e64c588f119cb35964e6739fdf56c4b72d820993f237f278bd18aff49cf17b6572e345fa70be09fb709b5bfa5218886fece7a119cb4586db1bf26df4ef891d9f436e71eb8edfec7dfb4c7388a77a07cee41a2fcd515698dfe7b00fbff57d297b9aefa7568547ebd97e7b5e413cd03574d9439acad13ac9a9f847f47d017362f211f11b8c61be59ac6183f73ce4e30d62310395fd5e83bc83a57dc4f689f9a14456680f59dfdbfcec037e81c772c221e25755f7236fed11c5b24ffe4ede481d2144753330c5816b0017bd3235b44660f31cc5b6a7fa7efc057b8a47893d60f946a20f687fdbd40ec7803b60302c02120357c9e9b32f1f2395cbcd7a86afceaae8797174c533f92273d597cc3dc3cc0e67fbed004dae332fbbffd94e0dea971407e562f31c06ef2d2d2db7f651ee3ca81c9f3ee7de2ad76345dec9a81739837dfd5c513e73effa0baf1d6c5b915ac1e1333934f7e21e8ed9ee2427ba539f3f3717aa2e2ca2b7fa592ed2089fc64ce1bad0da6e7368f9eac829d1bf5fd0c72aed5b419f7eba1f6114e3f49dbca6c239b3e3389a477b840fe4e94788d8b9f0e67a655ee1bd36d7e501b687025d3f4fce4d49cf2ddd41ff8c323328a7c951fedf93d759554f4deb70ec6a8aeacad41a633bac01b6c78dc9ea9ba90b18f9bd8d24169e394e7fdf4e2f0d795fc094efa47cf6a110a089b9b4f8e87c313caf4c2c7a4dfdd88bcf4848cd15b7171156922db643b2170e60e5e385e337ff8e70f72dfdc32dc833b1adf3bebf2e14f9d1d5c6812e12db43f32cbe9c37d716d79ec1ab53c4afc78067e5530c27a461a22b889cb1186f05f14e5247aaba6f2a730f66862663f49accae9fb58e07ae22cb67a81b4373aaa86f66e478070bf66479c60b53c42383c84848eb9ca6eead10b16b938fa8ee5eb32702e08d1a5a634cb135d99c725ad7568ddf96d18d5bebdab1efea86d546f15787dc05bc88f27976470d6d8a350aeb7774857199bc65f4efbdd751a522fde0b7e5233c7abf33beeba3f71b3056eabd90dc5fa7027ebd6efa79a7d7f2ffd585fc307c0ef0e8634c596d05fa167adaf90cfe433a0fe7d2dcb2ba2912d75f145f766bfd91ef10ed13c5af8c715e6792a83e0336665dc9607820beb1713ab26781ed84bc31b68bc8e0edf41d9c8e433181b50e598d6c9b53f5e630283267de12385357b80bd61005e85982ef79999aba878dbaca19d0e7362e72176e9b2b6d9cbdb7d19dddb9372d0f7f89eac8d17b9ee1aff1cf3ef825c0b74ab1b3a8ff616cddbfd23166713e997b9c999f381ab189d4d7b97e4f57aa3e715e9fe1caf629b63df0a6331bc77a2bc8bff1e439369b3189fad2cd18570d7afe68ae6a8faf5a359672afae06a6a802a67f6a2ea79275de4efdf0686cda1d8c9b9fbd23b33239f59eefc10f9b500c910a625b8de619b27ec21973753147a5a97f0006057062806e857e6a96d7a0357b865191e488ea12d4f96d510d9dceb8e29c0ddbf31d9b97e5debcc8ba1e391c26e70af9bb5115f1c48027efa49c8bb3d98db0f2a279cea8c62b89ed39e2c9dfb567443f229fce73b29a5075b999ec3ac11e0c22cc7cc62f2f89ca14f1c0c5e821ad097f87485ce037e8fca4fe716f9897234b6c025fe6b97d1367cc104d51dd9e23adbdf82ad1af58557dd2d0e5b145ee8288234ca0b3ea942aedf165bd1280333b63bdac6b47c361dc73525701eb0eea2c3ee584435a33bc953a50976f87a6881786b60ea8cd017daf5ba2ba903a4c3fc1bd5911bd1ee335307f78e300f7469bd6b668ed728eeaf29dd68622bd50457e87f5c69c35c3cf6c76724e24768d74da0672b37e7b2675941a708330ace3aa75726aad917f1ef90bc9fde19f5d43ab2d916fbb36aff226f11f62f96ad2779c5c472fdfa7ef2078f6a40a4e3978ce9975cb76e8b079679ab783f52f911ff7a6a67b51a9bf483e5371ce71c09e99d4294f7fefdb890d8be01cb099be7be4b7a97256bbb83f0633b51573cd5c86df26cea3edf14b0ff4fd003e473ad73c6ebcd2b9a19c5c73f11c73e1dc722eaef1561fe0d97e9fd6086c9dc4c9999a586afe24e60964dc03f27f9378a404764331dc86f2980d7f18a39dba26d8e333d654958fe71eeed1a5bdb7d01f403146980f7e7bf278cabc647e9f2493eb098633c9ed971031e4c0e19e27bd13118f5c948f99517c035aeb8bfabfa2332e33ab78cd3825da376a9b63396d443d69665f5839babc4275c8452ccc9600dc7754bf43ff7622cf305b22607bd26bbcb728fe01b5efac8f2feafb2d332778e59e4a34f9285ec7ab5296756565148f63e2e7e6d7e7c8f7aa1b5b6c8fccbeb076b4f66ceb8ce17b6f4a2693f547b10dbca35d173c831fb8b6ae7a48c4fe9796f4f9d299e2db912d835f7b76fde3e6746597bed7f9b30c49af594a57b2b9d1bae4d2f52bc44718a53940eec09ec77df74c2f5e639df797c799c4f1c65012b9df6cbfb9745eb9e197a66eaae8978c628f33723b6d4353a611b733d82b3e33c79ee631f6a476f2ef493f53c5b1731c8744b2a87a06efba9f35e5b53b58b9719c126321a438acc5377790fc7b5283bf339c42a4a99ca10147581558e801d285a55df9ac640ddbbe5349ae0069cd31b1ff553ccbf0db232baccd896e3275e9b4d9c7b363e7db8f5f1ef174c6fede6b3c7dc19a099edbc0c970fd9a495fabad505de6b6702e4eae9fa0a120331deaa67404f9bdc0e41bac77ffcd35f8366768eba519a6edd82a132f6738d0282749664d96d618a18e0ab8383fb7f07be96c83b074a82c79485c3724b1b634a95c8d7ff6c1ced319b2c417a81ab321de2b3b87bffe1fb09777e56bc47b5004afe05c6e125f7d36f875cd3c956bff1cbb48ce7778982f20c737aad9fe789ec61affc6de832970a851eced0cfef965722680d91c618f27f2db825a7cfa67dff6db13fae797a9a1299c43746b4b5820be16209ff5d4c39d08b051ef61b355a0076dc7de5c61fec4f71a90aba4bcbd695cbc0497f2dafb7087332b48534393afa23f99c504712d74bd39abafe12dfabed49a602dc48624cf00fc38ea0bcc1c7a1e55db44c5d4e58999c2154a72316fee27ddffb43d9cc3efeb1f7436aa232f51e703665cc1e6f1eb1aede1b83bce6562f78696d6383b2ebc14668ea17fcc2dfda32acc9c91a32b1ce2b96b61e654c4dbac2c91265734d3103d2be28d946b8531bcf7f49974f9e6dcf0f1229d439244cac107b881bc8749fc650fe9bcad2536eb1497325eb78b7475e6887885c481fb93f67b60daff364ead59e04d5dde449c1e714d8d078ccc581754cd13cfee608417123a340719d5255ee33b9ad2ab512ed10e85ba25aaacfe05f5b329aadbd49e26fb3a77b435671139ebbf4ca98d52a117f86737f54e1d13db30a303bc3111b6ce06667a937e99ca745c7ecd38e224fecefa06ddd73817af91fd6f8e2fe0174ae4ec20dfcf7b948f3b656f6cde5b3ada7a4c71543d0ff9bd197012df82afb7ad1744764e715f96b774428a8f4367ce53b637143696d8ac21b10b18bd169163710d9cd217c46f72b7673b0f71cdd2984fc6b4075a616b028ceeb2f3987b3981496cf4ae99813d51b863dccca57161cbe9a15cf9b45f1baa0db13cc5e385f7647ad962b2ffde8f718543bbde4de4b525d42dbd3795c437d7e4316f6acf6e7fd0a5f8c0ba82cdd7996bd7d561e4c7107f1ff980b9109a7dc0a71f19ba3ca69cdb2f05d65a7ed6b184dc6ce9fd2c77f2a1dfff3ac4df1ce5e46ff3ec998ffc32cff8ee1d27704497d52815c07826e76ae8324eecb14a74e1aac8bc6f693ce702f665cf1967efe1510ce6bdfcd87f9b9ababad1337b87776b7119bbe1f098b35a195f47eeb5040ff0bf7975e5b456248e49f0f3224c3da2ab5f574b3b67f67dcf1e97e6222a8c5d9cbee3d9fb74d0d61de5232fc03f5e1956058b7daeefc3b7490c7e56ac8f86826087b5c00ed33e7b061b28cd55b3d7d613fbea680ae7406e10f08d01ff1b75546673dbc0b767ea63d031e9f5e57c96f8c1813d14e23c749cb7ae7f2ca4374cecd792c41444de927aceb36b688d46e25ff7286fa2989a97d23faace5108c067b04930dea4b7f6e65dc39b741c60a5f18ad2dc47199dad4c515d9d992de02f27f7a166d47b35c043ddcdc500fe3ff98eaf3ee88874fc94f3d9ded4d470287518667b5f20e7ef21b139b1c367b7af9358a549e4618122ff406c038e359a8c931c4be783f6baa47c7e3bac329f723ecf08e29b33536b2ff6e7d12bc13888731a15e4026a76bdb7447d81e24d91cf16c412dd8373f0cade3d9d7bf3295e18cc2fa7e46fc0f0e7225e0a8829425307fd41ee30d10969bdb3a35baac63ce8d6d58d2336e79248679288ad4dc7f955affd76665dd8fcdea158ac181e389df5bac4fc46ab5c1ed1d0d68530de2ffcdcb9a1f746d685e672583e32f72c0ecd175f129f85e2d65583d3c5e254facc73b0ba745a0b48f91a30531ccd3821de707fc6b39e50cb4edfcd25c35ea3ef41ec206fa4fdf76af124c6c95c1c7bffb856c7f448cabec36c718435b834872f539bcdf19b5a37c8d67c9a14af9be93e967fcdd45f6e471fddd8ec1de0d714a951dc3987329b8dbddc331f76e59eed8af4c01f7de08fde204efe63eee63177f3cfea05a67d965f15e0a77c46b30197e939fa34f56e06df8ff9d31ee0021e8981abee39427c2f3027e32ddf1e74e2b9ebb8ab1e589b57b9773dcd35201dad2fecf821476b2227e25214e951d2d605674d4ec7ec3a145327e711f322453ed19cc9d87c778f6b7f9b3adee462e4447876d03fe9ad0cbd37cdf7cf15ecb46a698cc03d78f166606aeb71eabb5e580c33b7b6f681adb3c67c8293ea26a99eea0a6619a2676563d753730783ba800de00b523752c7995a809b94ceddf796928817ac8fdb3527f212b19a7bbadffe674efefd27f0fce285b9cacabac5e6310af58e5c34b790ac234f07daa23aa2be22f47c0506ef66eb98e21fc90c415ff06dbf3967f5a58433b6ff328d664c2451f11cb13d43bc1d48a28c2dad31c9be97c066f17a857a4dee2f1fda9ea12231dc9e398372cf523c24ae2e12bb977b6ecd7344657a19cc9e5be8e58433ab528f91733b8b0f26bed3c0f19dcef99921e239e62fb3793331cb772cb5bc445f15c056bc643d66770f22cc5c65e6683d2c11dd91f5bdd23a27d11dadacfe4966a5ae83995bb8577202bd3a7383f7022476e7295dfa9d7c97591b0071be3aceeb318adfb7606efe909d65b89f19dc37e0ab4f66d266c03fafd359364bef6d2451995ac48f697963c63d47eeef565e1bfad53cd45152765a1ddf0ad6c9b6bf62107f40ebe6f507a5fb9fe93cec37ecd9cdf67b1ecaef64edfbde7ecf6d1c8b0377abeabeb194ce63e797771fb5e7cc0c67d2ebf9fc0feed16c2c6ff4cc28d66f6c57638cece8aece24b1b9409d71da671e59229e6de513689f56e64eaacfb6b80e0c1e73e4f324fe45ec7e3e7a01abe2adaac60fec92e79c53fb86f7ccda086332a6b6e00dbf553d4b437cd0b8a7e6f8bb559337dbc709cdeee0bb762f5ce5c93ef60705b18533dfb7cd551e715744b3462f134beb4e24d19c199aed422f625fe00c98bdec6efb83dfee5ba57a48e45e34e7d23e7d4d17f47dcee12a87b98d778dfa01ef5aca0ff8c6ba6aca3f89ea2edb3d6e6cde2435a776b67c3a53537b8ef8e8e03912f041a77cd6567afe0e737618d9d89769c4a74de2bead9e1fe8bd37b4f50685643fe8dacc5601bedf2d5b75a5baed49311a95ff6fd8b30bd685a524271e40ccade6e4f1b2324d627f127b4738fee99ee0433a3bab7bf6f99f138ced433366c57c838be8bcec1ebd6462cb1cbf92ddc7f6227df6a9fbb9f807cf082dd08d9e198be9a3dc159d314dddb37798c35b37d23507963fdacac503275bfa4e024fb2a5298103d83d8a87c435bb9f77191bb27c6f3627b0b78e7f485f5457c7a7b9f0eb63652ab43f2c73df875f7d2138a1ff29bfae0ffc22599923be3be046d5d599a14b5bdc9f6317696d0ef852f8e7c43fadacb6c5f63e93e7921b5b587249bdff12ebfbd6dcb24062606cfbcf37876f3d10db1c70995d00e7b0db174253736a486c873b5cc5701e5ed23bf486df585f7396332aebc77028a473588c43076a8fb710cfa4f72de652f1d589a3ad3d7bb8cd374be53d352f2af758ef3ae21b98f67bd796601fb66ab830f797f489df4c5f1faacb635397be31be6e86a67891be514112492c8327091e43d39744c060f0b678a7b27df8b4bfc8dbc62e8ece8dee917c43b14abc67d11c550abb305e1f60a2185bbc555b731674868372d0426d19e216e8bf90419ec9734cad5ba4df6acbb61f898f595f00f465886a9e1ecec8e1561ed335fcf6a6480f74769f8fccb7efeb216f1daf7318ba3232612eb53139e77b40b6787541e7db727bb6dc0afdb423f6f0516b7dd45a1fb5d647adf5e07f8f5aeba3d6faa8b5b66eabd66aea5e65fdc3bdf85997e91f56f7f4c9266bba7c9f6c953d7afbf62baa35e4f74bc7b8d797ef97be1d7cd7883bfc31637a1bd8051b53efced3333c557016a56631dc01af72cef9182ce9f90ed78ebe335c25b91be0206c4f0c1d7a5f39140a2bc4638ae1c31bc4066e4c5dfe8e19abbdfb91e6068ecfa7a360a7c338e0a3389f720c7a26df26f71dd66c8bcd806142f9c487b67935a4f7fa9666068af4caaf3dc3570bddf573310b1c5df14cbe08efc025398e881d5038db6f07a8727e25a706f3cc943bfc623cc997c2d5663e7655b8da2ccf56e40c4ec2d52eb57672265678f6991c9aff708fd54a6cbf3dbf9b3e2f9c9e1ba4bd4de7f3de6671eacc3e8bd553dcb6328fc77228f8486c4f483c25896fae4362255ee580eb367aa7d1ccb5fa0dfc3e14ea968847562b6bb34067f3eaadcc9c676c12ad4b467326bdc0207686e1ed4539d8a2bd63695bf6572804effe6011cf10763267486c34f14f6ea6d6f2c050786028dc0086c261bd4df65a541766e7638ef8e79b93d51eeb95b3436179915ab746de17781632fad4d21a3ce2e7d1f906f03c7f7023f5bff88c887e4c78cd7cc6099ed583019a984b8b8f389d31f1e917e66775bdb7178c373dd401ec84ebcf0800de41d41377466ca93dbbf4bba434c6e5263df76df09e877c07a7fa24e686d6c3343edbdb4b081c2179756bc6cfc3b3ba6da5f8bd703e514e3caa4f7fff1edc175688afce51dd2c84b3777cbebb70fce299e2f9f1e319d866dbb321b7151f3ce640527aee7ee7402e688b4253ab2d9d2af8a7b08c91d6e40ded0ccc02acac4c4da1b87329fcfa94be65eb21fa9562186cf5487f3796549fbe5f3bee1fc8e0c8a471ece3bc26dd3753eb463591adfae50e0efc37f33cc1ff810bf4e6f41f4e71d7022fedcbd480be486741716fe1fd2e114372a656a367189d5f9fce30a69ec762eadbd085833477ab2837a49647e33fbe81cdd6387e57163b965adf8dccc7012f8a1dd688cf727331e1cefe8bca8cece5057a4a654754e74c4746fa7e967ec6fb5010c8595abc1af707df4a6fd6817d01fdd9d77b18b13ed7c8e77c6fc14c033bef67b7db1738c4cf898fcd6a97979d3d3b279f6669dd3bd19517ca27e79f5710e754b52ee81147f4b00579d7dee646f267fd140f32bc633a279c7fc7125e1db315f1a003cf8ddceb7cdc8e0c9233e9d373bf6939d43f221c008c985ea7f30a0e46be1a5ada47169bf802b9b503fa056a1ff09da95a087d3767c3eccbece0fb0d57aed96f4cde87a0b74788efe15f0c9f9ecabcb234c5c1bfe03b606fe8cf50c7a777917ee6509fdf2de4face3cc3633e07cd49839ca672d4e4f7b909b993449edf292ff9c4d41ad8f6f1686bbe6b26898385193ebb8e8839c472e0d13edf615c077990abc774e3f6d814712a8e3a6d66309b43631c4ba9992343eb412fada535887e4df565ae76b9bcabe606d27a632b8abb72f0302fb19ebbea5579f06b5ca1472593d3adf8d9eb00f9b3bbe41339238fbb427525a0b309df390fdbdc24bae5029828ba1cd522c6662bf25fd43acb27c199b03997d0d421d7c6517b0b7d1f2ce709be6c928b6f794398db03dfa70b76d3d21a1b0be6243057041bb4a05e2ead9fd37a3abb97a0a7754b5443b22e140ac45e467e0476984ea6b5063ad701f3dca23a7268df24ec5dd4e302b9b9585fbd4c0d5f8dd74f7d1bbcb0793c36fb42e0b40a608b96f0fba5cbe29e40ef3ff2edd3714f7ce2eb35c3833340e5eed059679fdda7977f0d3a323678759c9a7f0ecc16e484e2f3fc07e39b78e664abf67c4367d3f39b332436ebff4bcf6643d6487bb66ff37cfa915edfe206663a6efcaecb98c4c1ac363693121e61bab67be5a46e1598798ee6a66f730e4e46136aa3b31cc32b66af590eb2d3f36cdfc14e6be5267cc4eac2bcd773ab6aa6adf5c8f16666dba2de5f71bd34f8148e05d5059e29f6986f7d2358147bf681f60344774298215e49635350ceb324febf2cbec475b0a722cecf28cee90ec6cdcfdeeefc64fe8c5a813e1c9b1ffc66bdeef2521488fd36e66730fd18eef6ef1fcd07d415fc97ef6e76f9bb8bbe6f4eec58f07d95dd1c46d1f7e54f7d5f3b67c6a1d03327eaecd467227e75ea1ead6c7f70ea673943936766768f736706d9dd639cc087e786897c1ec98fb948c3cfa6d66d4a935ee8ecea68badf8c2778cfbb070872276a562e2f38ff6cbd4aff90757cfc43d6919373bfcb75e4f483dde53a8c7fc43a7278d5b335a2be30b7740f9bafdcd0e9c8b59ddc4bd6f6efb1472a6fea72c31101176f6e698d99a535f0d5d6d491f1ee9c75d69f91c45af025aae35f7d99b327ea0e36568673ab5fb2cf782f2636e38cde670735fc7c6a8f32f9bc91e32f1c9c81dfce510f8ebc5f9de67b0d6d4de77dc25cdb7901ccd2e6ecd8f99d888f907ad6a13ef17d72bc0e767d9c28becb79f605651a89e38be89b6876b66cfd636f2dedb0fec28eaf865f7a0f3021df35bc60b3d0a7d5e6e00c9abb7e66893be26839be26ab8d1f884b52b1b7da39aceb9ab40ee1b7676c6e308fdbf21275f85a0e17c8a567300ecd42ef3bf399b54f0f5d9b276fd2f30c7f7d54f77f8bee98f4c2efd21d4ee7a82f9f27f3d17d283b237fadfd2b36bf73da774ff79d4d0ed6c025e5350797fb143fe5b0dd367513db13cc5990ffaf319f72702dbdc49977ef53b2fd8cfd913dcfda3ebb633810d5dadeb9a5bb077a250ecb8c4d67473c742a2f369c514eae6e9faddda70ba373a26b3d946f84f56eebb9bd7a3dfb1d25e708f7ad97f9377aaecddfe915d99f57da96bfe47b4baeef7a3a7c9fdc9ce5db410feaa9fd3de06fa2b39e3f3ee7d91b98d5f3ed73be63788e6f6bd7d5733f9f8ff15c34d7add576b19d8fd9d15d9dc96c28bd03476ccdfa36e3fca3e70cbdd5e7ecb5a971e7c8d96e1c5be2d9c66e1da5f81d032caee63eeceea2df513bf31d6a79758e7db9920bfa46f4dc2bf0f9727349b71bcbc6baf30e731c47fcaca377d12ba133ddbcf9d8dee1f75bd2da77c3436f74beed5a7957a2931ee777bfe767e5d4ea4f88db2eed731e89036bc1575531ca6debd0e16dfa428f9a47b15c10f83497b87fb7289b1b477fb98c6db845bd49e29e8b615cb467ce9e1e9c7c1c51253442c975689f7d68eaed9a055c4c92ebc079510e20436b34545596afb507c66dda8e9bc957ecf191727c9f5277e27af7a395e7cf1ca819b4f6cbcc697635d5e34ace6e7811bdbb9d3364186beddd7a73d5b9dca3b2c630c6cbc7ad25f28e79ba8de2b65f4cbf9d887b7c09bb947737e29e115f5d3b1a0e4dadb76de78f7ef6808f70f4b320db63e28fe4d4e872ed83071833d17eecf68e339b9f3ed7ecfc56a28bc43667aabd006983399dcdab719656c35f7e73710aefc9eefc476360890397fe5bfcac680ed0036ca9e8dc892ed2d4df10dfc04578a88acc251fe2c44bbd4fae9f6147bc97a9398cf7e17cf03e9c4db5c91afd0c6bcefb7016e4d9d3dc998003730007cf48ab7996b63a61de7eb74fbdab3dbbecfba0b71ce60e37d7df6bcae9b53bbf42d7ed4c2dbd870775c123763f99f55396485c7b7647d9cd9352795c91fb61ea726ace495afce45e163f2fc0339b23cb7f999a3a96c437d7f055ce6138b28ceff62afdf9b10cee9e4576ce7294d105799c91bb3c6cc93c72cdf6737a8b4e91afbea00ec60566508b60c41de282f3db8b2f55edf7f37dc8ba2562ce54959541ec7332033742fc7a66e8c226a7ff68ef9dd73bc2ef9f9d1a62ffb7cdd6dc7e57570bbd3ffded7d38bbc07ccdee3e0d225ba47f003fa9c17b38a537c93a966828ac80cf646cbe4aaf1f8dbf5e8d6b60fec4776cb76e93cbadb99f4f3387cf34f109d4d0ead7c21406d225e511fcdf08e7ebbd256c1ce0876a6fc85db67d75467edff107aeaaca1f17c2c23d3407eda10926ef956b771cde0b1c51fdb4c5f628e643006cfce60c89ed95cde7fa4265f4417446234b54e7c6e7f3cfa71f4f81f5f7d764fef4e7ff7bfa35769ffe7c7afaf1a458fe17f9d3fffccf8f277738f716e8bfeca9ff6f676a8fbffefef774665b783c9cfff9efbffaad97f77faf7dfcef99ed7df916fda7ffcc66c17fe85ffcd77ae6906f1e4efeef94fcdff99a5b433c237f9cd067e47ee2c7d36cb8f97afab3f63bcf37fef8f1e44f9dafa73f9febbfc11fff331fc247798ee7fe0fc7ff1fee8fcfdaef7f72bffd59e3feeb37fef7df7fe37f7bfeed5f5ced4f8e7bfaf1349cfdc719fefdf4e7ffb5f0ecebc7d32c84a7bf7e2d9ffefcedf9f7dff91f4fd264faf467937bfe8dfbfd8f1f4f0a1e4ec64f7fd67e3c7dc053eb759e7bfef134183a4f7fd6388efbf124267fd4fff39fc072b8a73fb91f4f3d877c27f7e3a99f7977018fe9629eb9e66fe4c7a93d9e3dfd597f7efef1f4321ffae46dfa5ff6d39fb5c61fb5daefbf377fe77e3c2933f237bf3ffff1fb6ff5df6bcdfff9f1f471f857e375ffcf8fa756f15fd5fff39fc56431fb729efefcff713fb81fdcffff7fc8997b5f7fc326917d7bfab737f5bffe3dc38bb1351b8effcfffe59bff76acb9f5efbfbf1ccf9affdb1dceffed2dd0b670ecc8c6d38f27c90fa67fcf7f59732f2366f1bfbf4e6dfa0f9fd6dfeed79cfeb9379db23f7d5873db7bfa73b2c0f8c7537f6ee1aff854e1a7de97359b4ee8ef8ad3f6107fcda2dfa64f8e7f7cfd0ae23f7f7ecde65bbf4dfe6aeb131f5367419ef7ff9ed8ebefbf16f0a6c3c9d39ff3bf175f3fceda4458c8c7d439fd14dce97ff95307be47fdfa7b3684fda9fd57ad0e773b80b54417ffe092d85915bcf2fff3e389bcddd39f4fbf5e9bcf485bb98eaf86364f141437fce83fafa4a14094f212c89c37dc50557be2fba73bfcb579fec99c62aaa4fac233d2d60b7b133c9320dbeaf438fb75ba7caf3b75276cd43fc2c6d2f6ede5c7e865f5d16f1a9f83f527359a6fc36de3f725d66668f2f11bfa4c7d0faf6c6cff63817cbc715a8d09aa1b4b34f958b264d112692a67e8d1bbfcb17e1fbdecffde28c1545756bffaf2d4e9f4567f0dff581a752534c2c6c2d215eedd7796ceb0b9b0c3264bca14fc4e9ab4224120e7745e7e7b0f9b23bb2e8fdefd4660d7bb0b936ff2ef7c63f3ceb3e4c1e66df1d192b69c8f0460a5e7374334e861d36fd750a7db9426ed9aa1ad011ccddcfd5c04d20264e23675d8cf7d17ded4e50d18d8be44f660f1d1aacd2d6d4d9c8b25f2073161dec1e1f6c344797fa0cf8039516f1149deaf01a7089f2de1f34b573853e3dc01af8e1c5d0e9cced81d886b0f0d5fa6839ad2fdd917441858d629a8b9cd2b531244e502e5bcaee789ccd692b58dc8da9f57ef5a2d40a2ba71806042ca7e1f34692a792480e43ba7a82e73d1333332d66aeefcfbeee7658cb47668d53ff2c1d7262fff52450f4b6fcdcf2ed7fe90de92217eb207924887bb9d963032b467001a73742522fa8b00788626032e84216ad83306e0d2c12b4b533c24ae1b522701da362968b6277594865d07d0be8da92935dbc7dc575f5821b1bdc8386c9dde14d5152c8944662991b6a53dbb9f63fca9b684cf41ebe5f9e3f565fef1e952d0b8490e29727abd1d7989eadd04d82f5a7f3b7e9fcfd4fb74c9fb482def73507b09527b12d8611a6431fa6c2db5961aac250dbcc84070b70147a84cf8eadcd0e5bfededc2a448feadb9745e1b1b26b7733b915b12dc790ca42e447c93cb715ab3c3fc070281bd832844176ae47773065d2f0eec2ed40c7f1d2483fe35ec88edb1a1f7bc334001bbb6dfe42dad87ed90ea0b3847eaa87b86dfac390cc0caeea8432426244586d6184b629b37343c3334394022c84532b01f13ea03994c1244b40a80111c0121280c3e00a00307f78d82c377e4a5e3e3b14909e533f7d5d0e4a513817474b6bfabbd305b82e7f8ed0089eda1a5ad4167ee23704a1229f74234a26cecd780b3f4de2c87b471bd53881aed3610e4dd9fad22ccc6aebffcc6e46b63d1a6e22b811ac27a6606f13bb41af1b57c4bcb7bdedb259f17dbbe2b3f27dacf6b3c2f21b2d0cd00fcd6616d4afc3754971b05cfeb10585a6142fd437a6147eef201e0794b07dfc2ed8b98f81fe1575f68131bfff5590e9445da03cc4293cb0dce9e8cc1261bc496d3c213070536714dfc42d01376fc3e8d4d0a0410409f0c0d2f20592c26ba2e22a2a0f103d1b35e88f8c61289cd09f8152d6169fb3d6c0f5fa6940472e522adb9a132a3d0fb079fc74b87921fc5fb61fa38b4ebdda00c28bd541c50d03d151c8b01d41f3c370a5edc5e4800022b37609fc437371a0a70444cf66646649682e4c01ec03d6180e247ec56446a02be29d1ed53db6f2e10bf5e9a3c263ea88beaf2d8d425f7a7385e98fde79924d696e49c6d5f1dd1c23b7c3e407575937ebe249a813d51b89ffd1280f9059397591d7cb050b9734e07070177eff0fe0683bab2227e77bcde4b91a9b78e0c0ee6edd59985fba2f68cc8382bec5e9f980bf6b732c05c58df8548838bdab610f1c5c08e2fb2977e0a50abbaf3f3ec49af902dbdccf3646cd785259a2881e1aff1bbce9e7f14ec6ef7fd8e02eb9dfbfe7171248edd8b11671f06732b088477309fb223cbbbfe03905179b63f2f4b32e3ee89d98418d8cd27be84b244cc96127f03720c3456f16c9fda2d4754e7376ad3596c1fdbef18e89ed853a2ab596c446c3d8949692c2ab643e60b91b8bf4cc1d92d05845820c6da95ab3d4db4e4ce61a1ff5953da4774648e4eada4192d17c0fb508351bce689bab0c8679342fadcd47b9e29b639a35f03906be2df5a0032565b19bacc7df56b7f9b3adee4374228e4ee8f888f2775bc95a1f7a63bf12200453717368f87481ccc894c389ddda1457a2666606aeb71eabb5e6cbf49de25d8b249ac20c8d67362f3b9a1297fbf6b35da10510501addf1e5921902e71a62e9d4efc377e736971551da500184796ceeef4e483e67d28115ecdf61520ce4b918e544df4d7227b0d645a64af6312938bace35ef23340b4e8e8f228274753d61fab089c24150bec34531e6a063a6a8bf3ed6f9cb310a43867f146e3c43372a7021a0a18e2cf7e9cbba7fb36e905100f9238b505b297e44d2694a0e4487e302228815c8ed91746160c05366788770272f692d80c4d510da59617bcfb83c57b4b98a3ba02be9ec183acac20b6d79a353b4c3d7f28f8962e6f9cd6b87a52cec3e7101340df7beee4124d84572692011bca48988af9d2a7c7359033b7796f8f6f73b91c3212db1ba7559b23be17d56daffabcb8863fbcf2bad87e56f53ca8ad17d9bbcbc4f6334b6bfc7d95b8f711373ee2c647dc98f37edf1b37968ca7a87eb8be9ff8419e738e3f98892118e11103e7a7e41d9450910302f5c8672f40dc744922bd4f112fac4d1227a51b822969d59b8bc426ab67488c8cb387d3b1d25562a3b380d0c08e1405d83c3a502475d44d7610aa9b1059679f59b3fdf13cb3877b87bf0beb8d42ba33477ea7241eb3273dfcd5e966cef502e4d602f1bb913fa3e48afd4c5d6c690f333ffbb6df9ec4245d9ac2395a7bf6de121688af05d4f690bb2084880fb05127ba6b7c1b0415bed7b045357444f599fa2a71137e446676fd7db811b262db5767b748a482225d73be4cbfc5bd7a403c4c7c1f36704663cb19ddfbc6c612f10acea96e06e07b8a7283c59a609bde87c2c012f14612150f0d85a1d917c8ff63b26da247939e20909f891d0a2b470702447c4324f06ccd3179df4ded51613b772641b094078e91ce6d6bbdc0e8d7e06e6cdd9143c47ed5dc91710d9b22f6d8399d4f6839663f6b32b6598f04d2d4b1a5bd51508554cf1fd4847975bce52bdc02a9bc42cf8c9d15cb35f598bd317c75c364759efebd149912c8b0a9752969705d0dc12654a5ab2f4a0890eab3bbbe4fdd7534e2bfc7844e030bfae57b1ed25f4eaf53603893b101be1bf460c2b036392b4bffc8e4511dbebd89fa36690eea9bfb4fdb30dcbd71c4f6dc4ec8afce5bcf25864c0b0183d3a1d96e44b6bdbf37e699fc3bcbf546037f5bfd310707eef7d525e830e52026d2bd12c1c04e1ef1ea35bc4f78a6907a269581b3efca989cf780d5bb28e800b1b328fbbca8afbbea9ac08701fd78a95edc4ecefd782bbd86aa6a77fbe531bb1ff8ab43fe5dc90002647b8ec81a55d08fef3a9021cf1d11cf4d3d586e03944bbb03adaf1191f275ea75f13ccef5e71cc6a97a4d277eeeb9b622e9894e6c7a3a568b89a88bf8e297ccc7f4d2ba3d9e09ccd88552ef5e55ede980dc65f741f7c8bf6f76800b00fc16e781df56abeb776b2bd7d7f535e5b53b5865cebb48df795eaf79f45d486b8ecdf45c6911d2cf4bfa3963362f96b9b3104346f385444f63949a737cc8eb09c0b2515ff1f57df7b7948e914d117ae8898d0ecfca9167ebf1635ad7370353975c5b573d14f901a2dc20bef0f6ef903b60f0cdb1f9d98862b9ed39c26fd1dd7dad077d1fc4774bf721657b3a52bf3314d68ed69ec1bab4ae6b4cc6aea13d6fff0eacf14eefc9c11c775ece91e61372011c8fd6594b90b4979a21daa7c3a94f587e5e689f1eef03b139c4c90b93e55952f995207dffde5b40ee1c9abab2796f091869ed00d1fcf3d2113d223730df98aa7316cdc16cf98e057250ad937232f1738c516390cec95c791f4ae78eca91a6ef02fb149145476b405c8034756ef86a08b2a57f14bb4fe73d3ff31ea700971d94736ac3164467d93ecb7f24205463c4ef00ff147acf2239d4e3ef55a2367478cf57bfc66f6e4fc4134bef79a825fc32fc001bf52d9b307c99f6de9af2cfcf19e83749c41b9807d388bf4474dccab579bc00e037d10bec50f090b85e3af50f97e81ee85bebc881a99bd0a7660e054f12cd1a1257aee1e32112f1d2accb9e2d7a811142bfdad2f661ce787baebed03e979a43dbbb37c5e7d372cf29ea1398a87353974b7dfe10185481cf95bac371cf78115d920708b66fdff249e9b7ce28956bcbf7752aca11d0f9b30a70104254873af759bdbb911e923a906b710d56df21362505203adedf571ae382cca0676ad2a5f55c91f6f154ed8b0e3af2126ad79d7152abae608dff1bfc52f8ff9df8a59ff0ce97f44b990d9b60e8518f7030ec89b0841e6ac001c1500f80ba6f47dda46b82762297910c415f11aaf7a6249e43bcfcdfa6a614cabbedd1d5857b2c4fe9378cee57b4af34ae8b63d206dc137a77e25a35abe104900780de3c00774eeadb9d4487bdb7049887a2f7aac959a24afe3fb47c75e414a9ed9c69737300778bf8fa35e4436d676e6ab5a1a9cb6037ec0df5153fc70ae06215f1154b3f3ffb1e87c0250ff5ab1c9611ad41e28330c66c8a8178a11f775f7dfff8ddbe41bf1589eda1c97afd52779fc4509c25b639eaa7f62047c17a9e36a05f01289462fed843610460df3c89cbe25a2ff4e8d2fb11e1403dc37c470212be720d4ab6e11a7c9be86b0cfa833dcb14551fc845e9fd6200f526f16f73be9bdc31750c5854110691ae6c10af04860e7790028802c6420e16d6d1fd2ade7f52446795b00b1939623e5926a62a1ae31d00ab2df0b9323a82ee158b6b0b6037ec0281efdbb7bdbd6c59bd904f9e90eee3243ec1b8f1fa496dfbcdd9f1ed7ce2a5ecb9aa35025b57f1765e751f6655649f6f3c87b49d9f865cd2196bbd5e9ea8586ce71eee536e6c4af49d65bfaf840d2adc87969c614e3d97bcb3ba0110e70bfaa55d16cb30eccdb876f0a5257943eab717a883ed3da3823853a7da65a89b65f727aa1bc0bbeb11703bc3224dfbe05416ae9bd3bc88ac46843a857b89bf595ee9fb9e3073e5eec935c8518f6b2a5688fa0b4349a4cf03bf26c6158cebfc5bf1388b0b5e6f3b5662046a511ee22aeb2fdddf5cdcef3ada1b9cfabe745f4f3e71c5819eb90bce4865d655416d58c8ece359f560d0034b1663b9b6d80eeca150b7f4de541213523e49549f01d3d56fd3b9f14e3cd35975cfdaf6dae9dc7a945bab473937c1b3742922aa4808c65a02feea0833436b780cc32e8ce63c6f75763c351342e72e8be07e8dde0e93bb95c94f14f721f36746a89c94eb856fedeba73c79d6b48cff5cdc773ecd6fa6780cc97c31c35ee88d2d3d9653a297983f233724b1b988f25c862ed37e061d62fa9bc22cdc43700c3dc783b6fc6bc00d8ecc95efcea1e7cdd11fd3df79b6624b9767cffa18c95e7e1c003ed4c179c563716e4932a8223ef1a5f2d2f7928fbee64cd6cde79daf3c87582a6753912c9f939bf9a69ccc3567abbe2bf752983cee40fc7aa91c4b45b37da7e7522acba15c733ebbba5cc975656bc262845b932fcc7ab3ce9fad8e63fe646e98ad3915db5b623b343485337539b0ebbdc0086f44ce927dd8ea498ce744592d29cd77e179b6df1e39a21a5abac2595a210cc14bc9dbe5fa78d2d8c71560ae209ed50bcf9881e8f68559fc3dc3554caa6bd795a5c1630f7a5c52bc1fe40c0dbd9baa39a6705bb46ee53370e93d88759ad88ce6d96671ff4eb69e99c2588d6ab5f16c7d0abb421ddf6a5e212f468bc97877730c17e51b89c884abc24bb7450fe6f8aa7a9ea3cbb37f2af67cc60e16c1c27be0d43d70eab6f7e88153f7c0a9cbde973cac9780bc2fcc9f7c1eef6dab08e7eb9459bc43f74d453e5e3bda607bf66c1f97db92cd37dc86af3ecee417a29ae3e96bba434c9794af38b7b46e0533faa918a19df29d0be624f27cf8410aafce0e058f3e97ce75a6d6073247e73f9fe3fe40ca9d99e29df4b7b911bf673ef433796f7790c5e3a3bc83db6b1653fbbac5eb99e29abc1b7c8b8cbedcd6f1d5d6c719e7c4f567fb079487c2fd293643936f2f2c3d88fa6197f6505899babc41fc73702ece689abb47a2f680fbeaaf5c53c33497df5102e43b3524b643a9e579f07cdff1908f7f8b6412f03d4535b4f9e6e267d4c7cbaf67a6a670966e62a9e5cd1d6dcd594406fad1bb37363f2bc62c2db1a7512d7e8b730470fa03a8f9886ac066433796d69838dadab3872f5303fa92e589a135822f3fee4309a58e80ed89b244c331ec578a578237fb2f53a4ab3347c42b047e1bdd4b3b2c80e378314ca603bc67c56237b6becae2a8a5eddbd7c69c7fc4a38f78f4118f6eebd1473cfa88471ff1e8231ebd36c628f5d7aa894531e3adac200e65ebbaab1834f29d0bc59f1db6978fd8f3a2b1278b4faab80bd4973faf961abd6f5277a4ba69041cd8ec99a99a6d85b24ce3ab549c57ea5defa5061ac5912ca770e5584999a2bacc55f22cbf199a2195d52271cbc72e0e70de3dcb8b8d22b9001b541927765d9e54caf9edb7c726e4828ac46797e2fdcee69aab7aeea35effa8d797d4018ffcc8233ff2c88ffcc3f22317f4cb210f7dfd5ae956cde21cdf7c5f8d425c631338e6a23ad0cb94c89224bea5ea3b715f249ba955800f97d5af52739c95f53dfedaaa47c5b865fb6a55485427e41e427e45ef35a496b740f5ae6b247140f0de8a6647e97c815127316c83ee77f49c7ba9cd1cf497df2ee92f6f2cb1193aad4a7c7372ef2af51d0ff4945ecdf748fa916b41211ee2cb3c9761e056f6bc9525025f4b55cfabd97e853140b53cc8d919feca6248e05abbca9e3ee6c61f73e38fb9f19cf7bbfedcf825717d597f08d8b10afaa7a8bf4aeec82973b6fbfce6adba9b0fbd3c89dfe8feec283583775d43ff001e86cf9afcda1f7403a9a36e28f6cc1be0235a9ab360f3371b83f7309b2bacd26feeb23e2c72d772676f2b58efddd4808ee1d61fa8b597e5f73cc94e95b6557bedd5097c9f07ed155e9822e3548b711612eed7ac7caddcc4c726f119e46a33b80a6c4eeda4dc8f54317e55c4edc13017aeba0f0f1cab9d9c4c453dec3bbda417e338425b3c250c9336b707d7d115902ba32f90ef094cbe41e7553bcac8227b5664f6feb23c5ec7f6e5b08dc9cb513d6ccac3a63c6ccac3a67c8f4d0981973cac207649b8c12917fae6bc3e1cc4038e5508fc1cc085075c2f615467b33b63901723cb079a839de87936ef321e2113db7e0aa7bdea1e9e9c3d8a300f6cb109d855f650e05008f61264c8f6595ea12e34003f447cdbe2c4dec1589c1aba32657697377539cd0f7c47f97f38b7b3737367f41b4f6d5ff5a521e08841bddbd6d5c0f621df11d9b0ed3e86e05d4ff5627e234e97053c6a3267ea1e97c1f8b80c7612ed2f80bb999547caa590fc6c4e64cfe4e99f7f8aed91d3513dd45ab9c86fce2d0de415e6986cbf091858a6d60d6e04db6b0abc1e931efeead01cf9760f6905fb701b984d7e0d9b22f6de75b0d3594cba43dc4255e12b6aead8d2ded86cc0f9f2dd633f1b7e8c793437b55e6024d8cd118e139cbfa9817c44bafd467af1e999b1b38aeacb6fccd60d636c2a31f37b298cba7580fc398953425357968e2e83ee286cef8efb5507fda92bf420cfd13676e295f3b8d21bfc7c3a7ffb98721cfe643d0e3f996da27e00f003135f9ab7b45ecdd056d5c6ca51bff550803ef34827f626ea0cb55ea6ac5f21603a84fa6a103737c6966e0626d17f05b0cc6f9043ed9b39dbe549d4affc0d79a2e8ac4fcd0f898eb69e492d6f666a8e875a6386b148393d737244df9dff2925dbdbb99fbbcceb7cefbc48b617e7fa187c99e79d87e50fbd9f4466229e49f6ddb1ce0e25b13db2fde6e24be3aa95ebec9c43c29159f69d2b8b1df7cb5af6bb52fcaf59febb38afb82f1eac84173635a3707d5d1d3feb0c7f03f2101b476ccf2431febeca3926225ef3f4ac13aa2b1cf48ec4f9c07bca6590bd94e688ec4d75fd6223e813bd6e3f239115aed09acec0ab8c64f25d8339d22bcf69294b476b70f6647cda73627bb947660ee0bd53aeffef8ba3d50bc5cf09667082a54dd7067e5ea63ef8de12bce8bebfb704d6c309b99025c3aaa1b8b5e57bc2ae991ba2ba620723f92aebbd5c0ea8b5130f1dea0f0359623c962ed2d4b9e1ab21c888fe518c57badcf3b23ad757c1861bf55ee91a648e7ec970864678dcbffaf214f1c5b87aa5b2b34ae7cb59eeded8afc4d7f49624eec8dac212bcae67f2b99ec8e37a2a7feb615ee289b3b4fd59514ee2b3b8882fc241ccb8871dbe195a614a178c564b3bc7cf3d7206a7ca53f1b9ab3cb9c99ec5917e60660fb3f7f980be28cd8b4bf9b8f7e7200fe99eaa729070ceefda3a407e15799bd877873ae3399820dd3ec58a4322ad59a6723519d9adb28eda8df51ee4dfd3f59842effac83996cb391a3cae043fb24b9e738eacf21407d2119b75868f3831b5e6c4f6db2b4bc4c4c727f1c584ee33d4c7e7868667b43f6b2b7eae2ce7a8041457077232af24667b6f091caa3b6cbed1c18877424317a6c4c7441087396003a1a741ac7916e8153bf107360f192f8ddb04fab702dd8c15220fe7e41a3f69ccbd023e37c7c70be82781fec38687c4e604d5d5cda97253693f0cddf348f6afbaaec77d288b6396cdb95480dfb4247710b58457c0103bfd7e74a35c21911d6d8cbb92086bb81db98f72ac6ff05e91fc9ff4de0fb92eebcb346b68d20babf167d8b3cef269a2f7a53de6902fa279262f9527af323fff8aeaeac2e9c88dd8ef2ef68e8f5a68b998319a67bfba9cf6685ff519752315fce8eff3a1e9f3e33a3deb13bf3b3d998f67f0cd7e00c502bbbefdef917b413115ced3971106e2c2d16a4353975c6332761d519d99ba30457c0357ac2f0764afd93ec6715e8243d3a8214dc6f650187ef5052ef3bb77586f3f5c97cae303a53da58ff99fc7fccf8dcfffecdc97631864b12c4ed405d5f3350c771d644bdd9498813bf9f9d9f720b699f8360a77c03f2f5bd3c268a2ce4d5d8e7ae6283fec2737b4c4663e57f9f1ef2c5b5bba98bce6efddcbbfba7d81d60a3bd486247abb64ed2d5ee77935b813e577679f4eacc965f7795f6dce6ffaa68ff3e760ee5106b46717f1a66f01d7f6430e8acb416d69f878f68f9183be3047bc1c50de23f0d55ca2030d5f0dcbd671b7ceefecfe8093eabadbb258a6bebbf5d9c275deedcf95b1a947eabe5bbf57a8d7e50e677b010feefa7199daff7c3d3d1e5355f983d5daeaa626b9368f3993f2adc930efea37b0d31256446e52f38995e562c9fb45798353def50ef30a35db1f7f735f34898daae889de8ac1cee21da43df452a747ee7f0db058b3b25e71ce6b676d490f04ede187d95f476bcf2471e7771fb9d872ba96ce515f7f1e65409e73de1c0af418b1394ef599ce1953dc63daf3ec4c519df8af0a465a7be18878248978436404f01534ca7d4373661e6658c230030ddfa92bb8ea7e1f95ace335c14a48f5f6a4643c9631d7e1bda5cd0f5c9b57e706f0517db896fe41ee2b67eaf29c711641be2de19f529f9d167c67cde6af84c9d317e696ee61f3951b3a1db9b6e30fd3cf4c6cbf59b3f760dc3abe1a7ee9bd29b913ef1a5eb09982e575f4b41c18fe3a4015e087747d3c44225e9a75d9b3452f3042e1cdd4cc00f9f87cddcdf0b0c9b9db43214043a16e897864b5048fdc0376ee434b936756aa5f681b235c12150f91cffbcaccd17ad86c3dbb2a9da15e9aa23a8be6c289ac6de16e83fc518c0ab226752c8972437a6b7e76b9f647e5fd73c7f73ab2250c2f1d2f4c5d023e2c87f702c04eec0b1b53a73ac1d8fdbe2cffd05018b1d9df11eaa86353eb2ea4369dab477c0fa356c40547f4d0f69ee38dd4019c513f756f7f0d3845f86c7dcb0cd075eeda44c636dfacd9be52c5dc633f3dd37f466f68820dd0173cbbae2c0d1e330c37ca49c5f82fc8ef511c8c041363466c91a529539847ea0b43f21d5247585a5a8323f2e6887f6ce779014b4e129520d23d9573ca65701ed81c65277576627b63f3cd91a5b5396247193e0fc53786df03bf228559b48aef14d2da0d499431e52ec8e0016dcd863443f23b068f2399a8d2a7cbc3dccdeee5e19931ced27bb36bcf8c81ac16c4dcbe138cf431e23faae50e48e4ebca3c6cf2f5f88a7267fc202ee695eb9c5d8cbd166185179cc13c349f56aa3e5564fe6f9f1f96e6012a3307b8d7469c8a8f5e1443a7405cbd2bf725677800ef248d894ff5fea9dc4f65f2d165f0098fe69276e5ea50acd11d8c9b9fbdfe61dd92a317f6d5920ff94a39bfb7e5376d9df529f3b5a03b3bea86f132ac1d0d87b086349ed5b8f1fab98d1354fe2e9e35877b0af6fba13ba86a8d80d86c49ccf033a7b09ee2f8086af288970183ec46666d733178cf585355986a178cc3137fb782d8e0d5d27be7f375f58551fc3d4388233796ae7074d6a6e94ba2ba305b0289c930cda9765d436b8c33d89d1d85b327eac8e9a821e4a476e34cf0872b8f9f13bf288da5929c11c89e12d8f51e933dc335616d446f0c5c966bf290df75515d887951ec7a2f743485737419e2dcdd7c44e53cd467fbfa9037bd2e7fd7caf69bab0a7dc7282776526c5111bf1fc580bdbeae90c873ceababc8388e8be3fb43716e8dbadcb03bbdcc7db73407dbf5ae6bf2ea82f61ca94467f0d0e799cd47d46c9ef6a04718c086b69ea0ba1c38a257a867ee923aa32fb637562a876dfb6a5447e4629e3f8a673cb227e47d32f73e307975e3b48489a935a03f83e843543731e443d2f98714debc459e49f48ce82c6d1f739658084fe2f66a3fe5b1e6d36bcef5f96eaedf74bc2ba32770d4eebb678aa9f7a68867759d2487cbb0a269fdc7f6316f6add72b157ab5cfc259d83a30075cfdc7d029ff05353394b6cd2dc7a9c5b8cef416087c2d8d4e5c0f1d5f109fd36e57a6b4ec43d391b6fe5f4e767dee352f82bd933df8bc3f2d97f7b9b7f8c246e2fbfeefe35eeed3f1d709ef0fe29d594e1de9874effaf7f6b26145eaf6b9cd5faff979b573d6af7cbe85175dff18777b83dadbfbe71b7f202ecf97f3ad5ee1c2f82e91de578fe41f527a83c9fc253141d2f17f8edd61399854afccb7ced8f8cd5925b8c4e43967d47b7ab4061771f684885f638a7d2eb3ba079e406d93e1a1db623ba4d80655e212cb4b94c6bba6754dea0776e0dfa0ae64f803d7d015e0e3813a0df93b9a87040c8aaafabaf6f112e4c94b5e8ede113187f230f176f276e7e4e733f8a157c6dfa3bd61d7ad370821aabf54c8c19cf22587d5f1305fada692aa59b39c78b1f33aecc797f1ab0acf2de5d6be130ed7b2fe756e0c7b6a1da0cccc568979ad93fadd596d3baefdd038f564dede32be7571bfba40ecb92b574e88eaea2a570f60a1ff5953da47ee5fce7ddd97df3ee013e4f91f5bfec1d6599fc259037ceb0e9dcb0cc9e72dc8254a8c1b83faef9f63e5af6d7cedd277b1f83dccbb83a7f00d1eba832af2f1dad106dbbc7cacaf28eaf16b47bec0d2f0036cd44bc4ba57e5eac8d43022dfe5f43515e5962ec02b7dd09fbea80fac6e6cb1b9b0793c44e260ce7cc70ae61b489c2340bedef0d7586a0b9e3de935cecd69261c5094cf2bd567b8c571d0d8403f4f87f1694c8097c57380c7cbc490db8bdf0fcf00d399ce28ac4c5d867b15e5fbd21c45662bf2cf658c88fe85efea2db7ebed49ef6bc5fdebfbf73dea3d0c4dcdc4c4661afc20f2cfe39ec36dae053b14a23dd9c09e767a5343efba96d8e64d9a175e410d4a930324622ec22ab14535a471521c23246707bc3e243623efb22632c7b3f3dcb27b718ff43dd56668acd3076ef36d1bb7be924f5a71ad26b3c795f9fb8c73f1d15bf4e82d7af416fd2fe92da276a2762ffe773f650f897deb135fe1027ef800ec22b5999c25b6b9d41d243637b0c38c2d26bf47ecf0ade0faefdb17da7304772eca21828f16fb014e670c331e5b7e2c60f795b99737c49b17f5695f3d2fadd21ce659bc352c0fcab0127b815d1766863e663d450dcff1f1e45b38c530d1e3eb344635db57ea7f9a22ded8e2dafbeab37e20bfcd5b9a13f75f5d210f4dfc926753eb36a5492f74b41dec252a0f7579e9e82ff9bdf5ba89ed09e62cc0f8ab05c8a7b8e6697fe98272083853caa85bc1bc30eb0b3f7dde3d1fabed3238622db60fcc4778b9277c307686bd96a535880f531e27cc370324aa9497ec1cfee556615db6e7dd8af9bffb745d0f70504af12816b1bb7fc5bca0d4defa96b6c69248f6a2199a7d21705a42ddd03f5cb22f8827f186e0d9a110c9545c1333b5dad0d47ac4ff82793fa30fcf83cffc4acda8d177077cda7fbd0f85019bdd8bf872e939b118dce1f10cb5e87c975d5702938739be388e36e99ed42d515d64df9ff6a5187c1366064bf808e57c85d37d86f4fd14e19ddf321c7b573c9774af1bcca112df8afc077bca7835990fe604485ce5d63c69afb40958360eefe128a7cfce84f6cc0006b43abe32be5a210c16e970fe9bf134d7284f73a2278ae2ac7d53efd73657f3a53007d9cf9a8c63b9487365f7935c32e42c78759cee25bcf1fba6a439b963ecd773b9bb8be6ce5bc56c6cde5db8abde943ade406e41573089c3819f42176686f63c9444ee37db6f2e9d576ef8a5a99b0afa8d3fbf74853335ce1df0ea88d87b12770dc4b587862fd3414de9fe3c93eb26fa7e3bf5fd367cffcab5eb2a6006d0bbd4dc987d611cf3a933bdfc39c69f6a4bf81cb45e9e3f5e5fe61f9f6ec5f186d2b0eb3d8cfac2a7a92935db875c771789ed85d4f23e07b597208d157395f5de0d2f670d3b629bce545f972793dcd5a53de9ceed8ebca4b6b540cefd9cd9e4e8de6acad4d065cfa8437fefc611f1c8decd4d5fe9d9346e48ebf62bef33e055d87e73f60dcf1e1a9af2f7bb5623fa1ddb45fa7c4ace871cf17dee25f7f8a8fda7f5f5a3f63f841ef5baccbdeb42887888452ac8f510fd0038695ddb6f425c7646cd5fb5f9a8ef35fe5e38a7cf9af2da1d504e23165385a61ee1a1a431b023bbed6c583d3944fc8af6dd53dec0aa79b86566b3dc41f4ee1186775bdd44f1637476cca766b83cc22adad3ec7c57c28f1bd908b9fe4165b9232f518be68c28a6d58df27b3f7a1f1fbd8f8fdec7fd36f2fb7a1fa7c41795287e1fc5d3d2d5c0f6d5f159f9e28a301f2c98f3623893e91ea40bf0b17759ae239acb486170b9b40695fc6c4e64cfe4e99f7f8aed11c58f5cb9c86f12dfd9635ce544bf039e82a975831ba9dd4ec919db0cab32dd0b978a77afbd0ff7872d3189fc8edac812dba141d6a2cb805d60d42bc0e84df215af39cf3fa3261ce37f4479c0bcf5257821b1ff95c97b044ee783e5d2890fa78636e44156b4aedc497262d4ef03dea199a99b1855de4f796c1fd3bc437811e5830ddef36cbf3d7244b20e85b3346593ca9146f9a199d4516a3033ac7fb896dedb30f999491da1664f84a5cd0f6692d85e982d6193ca3b0548173ca3de7591a8f2a6b6baa7fec868edf9986b477a97cecf9dec99b5baec5c5774f7e12ebeeb0ce3fa727992c836677dbddddc486efee9a09d2d58a329559fb91c8678e67df33169b3df4bee97dd21f1563a26bc7dbfb9b783811ee1e19cb6be47ffe219fd8b85effd63fef1f2bde6c0653d3774c0a0082d3dd8133f5d30cf9ed46ce6360f7d379ca59bf033b3b7c3a375e35dfb72b4afe50af6670b2ffacaf589d4be595a6d457ca5776d8d1d5f9d5d19ab6a43f494a3e7e48c2e69d77d584b68680e365b35cfd17bff1f7b6fd6a528f2ad0f7f97baed7e4f2ba667b5bdd6b948cd04a553abd49421ee18f2801a98fcca11df75befb7f45044300818222a9555cf4eaaaca548869c71e9efd3ce85e0f7b650667ebebe93393631fdda27fa3690af6ce087ba59e36be5dc018b2dbce611b9a7d736738eb8daa880b0de7770837db8deb4884d34e066bbd65dc9a7737d683f5a6901eacbbdc1f75deb5cebbd67957c6fbdd3eef7a0acb1d8e79256d35f4d9281f1bc6cfac1cc39bc2b63d6ff2067e28432626dde0d0bd3e6ae8ad81f5d1efdaa660256d67a2b7a4e9f79da435b4f15c3810eac238faae25e9997d9361dc26513c4586225d9b677341059aee333f8f30788deacb2ac797a08315aeb34bd7f1fd9ee4586d2f8c016591d2db81c728d716cb15ed7581af5ecfe1f43c0579e366463d1ef770c4f387718c94afa3e41ade29ac5595b5ce2fd4fa766258870dc1a0de1f469fe2df5e04fc6fe13ee14bd705a7e664e8e3b1d37b91da5f8ee1743621bfa1df5f67381d4ec39a448973d9376dc3a371f705fb5f7b55f33b32c6ce87639fd158df184fba9fcfd6186b17d923e0e95c23e4bf0cb469f41670b11f9ae0123739fe48f39a3da0be78aade9987b331c6df9a87c72f7e5ed11d8ccefec2e7230c6cc745ef92b04959fc899f667fb2378e9fbb370e40d0176d5d79de02aec31579c6a0685c707a1eaed5f20df784f142fa6a13770fee1ba1fb39d05d3371a4e56cc98f66bd5a8f3a5b8fda6e98fdeef1fbfcef9d264b0d306fef0cc7d8190e58bcb544473d8e0fc3c5208f1e7fea99457a47b2f7df05b693bd0ff73f96af582bc17424cfe0f8b5de5f46da09aff095b677fff292349d8d5d64f390ef069c480b72b6042fa397576ff8fbea9cd7bca85fd57be2d7422bc422108c74cf164cf9b0bea6a784e84382a62eec839ebc10c31b8eefcb621e3cafd6bfaff8fddc18cf418e77ae8a23f52aedeb386f36bb3ffb6bf6f3c6e0ec9db1aaa0af3dbdafdf8946f4f22ace05fffd833dc2888563581a5b9f776db525eef415dcbcf5fcde54920f686acac4c63c60248fb0d494d15e17f8f640805b82d5c2fa8f0d95f072a0df399a727343b87e0eaed1cad51f5c263e3aec45237a51cf9f33321f2e438b9bf4433372246f3d348ed127b973f9ed40e01786d3d97e60cc8dcf61a60cd13cbaa6c0cf591c177e3f30e963ea07f3d8f1d0bcea7293e4840578342bd54b2d2fcff0a55af52bbaef7184752a4c0172f8ef41cdb082be472a4ff68e6d9c32714df2f721c69b5dce59e1df511d0e28a28d6c7e52bb388c9ffbf1f10f84916d0ad28aae2dc6f773dc8744cf56a7dd4fbf264bc56c939d36253ef8f0fd793b5a3c7bdf7b9def5369347b5b0271c60fb7c3f7657bb0680b53a9fbfef6fedafe4eee64fbc7bc729c5d84f9e313f3f14af643fc1ebdfdbc4e96703cf31a87efefcb87d17749e000ce9eb1373911fb27eb21095f9865772ee5a489f9d7547d233a93cd63fc4c367d0c6833a1cd90cafb67e54a4ee559cfc4b6879dc9d2072988632f94e3ea3fff319e3eaf74a1d312bd6e622e023c6ccc1748e8a0e333be41671cf99506e3aea0d6a8547c75621e59b63a535f43973b4bf2ef290ea55cbe5c61fb13bd178eeb450eebcea37787bfd3bc6b025c69bdafd9e3e4d98695c05b05f8e6184e3aa1591fdd5dd3133994027992fc5c7199f98e53398decbc458e1a6702cf5565bd73ebffecda5a278d955f0265626bf201f9e873bd25350602bfd67bddc438499f11a945a0d886f7c0b47b34858e07e4f11ad71ab811345a23087a7bdc7362f6455b5f8d369a326950f5c2ac186a6f389053e5118e8be871bef97a92182fc5cde8bde7ea733c3e7b20d83ede28ca7f02a1e3be391d0f787f1f86bd276ef832787a6bb4c55973bc9d359fb7df5f9ef76fefeade5c88de703af823c9f7305a3c63ff4b7d7ffab2f86b1af7ab58bd0e0fbe7e5dec0b0ff8d178f062ed87bd0637ac3496bb9267a3c61bdf001b99d83f0ab6cf15e07d4faf5145faa68973ebf37ddd3e0e1713f622a847dfe88ee96cc1946da3e2b9a1c9ce70a4e51be9bb5f006574c4f60ae780269e2ab78fba0c1b442b556c9feaed32faa26b3afce223f5dca0e76df2a9b746908e4135f9e9b2d81e8ec66f0bdf9ef555cf58bcce7f2cf1dd63cd9acf5670f790f97dfda372cdd644bc4ef66b8053a4fa0b8509c45c1cfda1a5cae2cec41a056d98b4f13ebf5bc01d8c3141f5dd72eddd42f8754bc4edd73d6b75cf5addb3766f3d6b6c7f07f7e616f6797ae5fa3c83bc7d477d7167e4e9092bc71f5de85cdb093043aa7c88f53bddb8cfc9d55713085a6295cfb44d877775819f6be58c35c87f64f5919fc4b926b867bf8f1b290c43e1bb21f7bdc0e6bb4af08c15c0afb2eabf300b071e72072c02df96e85375e69a232dcc97fcf741cebba0782e0ffb7614966e11f25a5d3ca67bd0771864f76230e3a53739d3a66e344e6a1bc93a42a0592b1fa08a628e79f7c9406378c96fab53f122ded3c005f26139e8db7b55997cfe988acf616dacec3e8c13ef5601e75c3c1ef0f518927bf162fe5af92989113d9832bfc6679e8e1f04d3351d69cbc499e3f726bceec11ad05cef83be44624b2a6664e404abe7aa63c4e5744f07c5add8d5e7498c3cdf44f7239ab3a84f6566010e72203ea7694c79fef97888baec470e7dff131c5c414e618ee785e83a1e4c19a218f70e79d7cf9ec754ff47899a0def86202d127d1fcc7c418efd77efda0867cf67aad723e49a1ced813c4af478c4f3323e973d75a647c173c29e2b531991de971bf2bba7ce602eac2f9ed7a6eee0f7dd609d0745c4f383fcd23ce7f1aae7c7dfe3123cc2c9b5cf8bc32f5a872a843f2fde47963d962bf1ebd13a3dff31c6dcae63aa5f7b84fc2a3c47b17b7c358c71ad13bbc1af077cb7a93a07eaee0ef2775d59e70e4d5d9e59d3d9d29a3912a729c4ce0fdfb3ebfc27dfb7587ff5399b73597f4d1eecc099cf1a8e84f7b13fcf39ee8dfc18fbc45c91337d3ed715e018b3f227565e6d94a08642ddeb9561a418f9d3d3f987dbd76a5e18cfb7a6c1f323acdea5b51bdc9305147beff33f061810cc29188e13eb374b6b9ffbd8efddc4b16453a7f84dd0e781008f2a77d8192dc9f331f789b8ad62ace372b4d31d10dcabb6af9b2c069c7eef64bc815ffd35f3f130758a8a6be0e86eb9b5c684cfc7909137be8ca7268cd3f9a5b6928ea6809fbf217fa67dbb7cdc5af7518f898dc59a26c79233e68fbd1323fe9fca2197299acf05aef7c6384a7dbc3ad155738143f4d5cd5eec1c629f8ff539d2b301037e7a9a5bf53f26e6f5a0fc78aad741e56c5b774c788fb5a0b13cc1efeedf5dff26c711f59adfffdcd675a81b693f97aa75d16e00d924587739e00cbe3d3e724a3d77f01a721597d43b223d19c19908cf41c7a13891515cd9d4fba48e0a56e24ef7736ca6dca6f8e9d0e79b3b108fe1efc00f82c84f5ef9fd3795f93fbadc59c6cf3d9ed378febac23ecc2c4efedcfe42b5f54f626b6fcce7e8d7424ae5fa0c6c851abb9b9ae97b201f0fe1490da722bc24b9f95f18f627c73d5b0646450cfb9423df34c6b7149c490de3f3a1e3eb2c34426e186ccf70ef30eb73b827137d36d45f5a05b95bb769b4e2395adca71971cf6f01974bc3877167e5aaf95e929ff95bc55c82f8dd89fffd9a1a4760df1e616e6b0ec02fe0002c9817cac669dc3e27d44d3cfb9ef2414b4d197966803dc3773dbf5039e96878582b1bf9fc4d7476c8f7c56b2061ef7daa86146045abf699784e95e15a9545f7c679a3dbcf5b9d63aa734c758ea9ce31d539a63ac7f4003926df2edf3ca784ebd701ff48eff973cc75b620e478e5d738c7d5eb8a141698f424bd267d832b788860c07f1aeafe2f8318e3df5756fd6a6fd1b9b037a2e396f40bddbbd2f65a92f1f87ed355e38a7193f4715e2ee22ac6eb79d53ac6e2bad0770beccfa3708631f24877c83fc27eafa4df99e8edcfe0452bc4bf001cb83708dfcc46173aad12b4c40b693d1a2fedeea067aef596b14a73e70477649c2747939f923c39fbd3b6be540dedf85c32b1c499dcb68ea6748f8437a2b9d1b909fe9d32b44a2fe27889384f7eb7b9ffd464f3f38bf63a7e7636c74b92db056eef92dbe51406eb04ce2a934f918a0d2be0508c7c723f5734e8d92c8cd17af04ad78b482fef5801b6261f6cd581f83ebd9a53948e11ae798fe959bdce4a393da27a4a597e659463a2f3d637f50d1f8363312bcfb2499cdf2af9176358489a8331d8ebb5bff35bddb9b5bf53fb3bb5bf13f93bd9ba95d572d0326a3957e56f7644aff2b08ee387685cd1492cd119ecf03e85a5f1eff6b5def2f5cee335a3afc51bd11cb30cac16e98b7a90797b98da19c5412bfb7ddd02e4c0b4c9ea63a88073e22a5dddacb1d0bc0c8f3a86247ee06bc651512e9fb6f93a27fe07f9e89a02e09bcc8a5f2ae0d9a4f2bbefd4fbf87c8bc9737295be8029f01ee0a4e88ec3f686d993c2d41e5019fd7571ff2d8da71cf44dd7146c57ff6a8d8ec2f3ec7318f527b6e198d0ec051cd4183fcbb26134862ae282c3f112ba3770ff31fe59c20763e071bb2d4d997c22bf37be060f1b0b173873298eefea3446e27dbe17da8cafe02807aed1f77d75f95ef9ca1f24c6e815cc5f3c0467f9af39f777cc5b9ee42b47f7dfefc0579ecb4faea2b610c57bd47bbc7fc6f2d1d7e93633fd027a9cd5f8035f16479f9cd740afa9a12ae20a28e34d1c834af54eb1359cf09d087a943e7df29c31b0a726fa39b26df1397f18aea00b62cebbac2d5cf2de499b90c8f1e5e2532a908b5deb2d26ef4a05f735f2cf1efedeb08af9a4918ff245f96faaeef1bbcdbded02cefaa2bd8e9ffdf0751effe7f1b86d11c399b13867b2fa75689dab9deab898a3c874661b358125a8b806f1837a97ff4ed5feafc05764f901a9f156e30b548cbfc83fbf84073f2b4f97eaffa92447f780f8d2dce7eb0e31a897bf7b8ddb78a83b6550e3361e65ee6bdcc6bde136d0baf7a56315fe119a1753195e5177f3ff2e8b21dfaadeea867b4e95f756389e8817fdcbfc9568bcb8fef51af6b3849cba9d7d78a61c7e3de88fd07c370def6b35ed8b70a984fbc89136aa22fe34bc07ea4b8522d465ded35a39f7e4d9fdc92f8100c3bd88e6dadf27dec794f2b9914f8a73bd30efbeccbf47afb395c1de9d02b9691bcee618e61fe5c952c3b6a06debbd2ef2d1fcbb456c53f9d7bdaa88785f684ace1a6bc1fb28bef6677b1ee377754bdc99ca33b3977bc68b3f668dd9193dbc34a70dab777c70c69765deaf71bf36bed697e87cacb02d8103c22fd019201f56915c03dd6dd7dfbfb97a92b3ee009033fe0ddf2d93db0bad03d96fd13dd05e457634474e3fb9f7cef0085d90bf99ff80642de2daeb39debd443f6e50847fbb1cbeed3379b5c4dd978f53bf1837f3e5bcf096ef37cee83b98f4b607eb3659239b6e08fed81c03fbe8465fdc81beb488308619794ec6fa15eedfcfdfc36ee5cc85e65fbf15b689de9b3cc1f18adeea92fae5c3aca308894e0eba77e14a271a954b1c0b20bf2cd0a814882f4d9e2b2d4fc45d8cb92e60270a9ce3c4fa9f8ccdd2739f19a7616e2763dedca8b2bb43ffd7b918afd5396efdc23a1917d9d3f8bed8fb18ed504b4143beb5dc46f1f54ae7301f8f8dee74cd416b39fa54e5f60a4cf716c0b9d3c3ce209c24313d52e2e73c9d8ab11f7dad979a32daeb2d9c7788e3aab2cf5f518d82ab34097ef49f835e423f1f8b3549b7bad75deb5c7b05944132f6fb95d76b87e245e34ed769c6753618ef8fdfb17bc43edea91a1f635eefe8ee3b85e361fcde097f2af42923ffbc04ac00832b0ae70c3d553621e83529ceda8c7c76f9b91ddcff6138fc0ac8636bac480d4de8789ae216e277caa87725b46e716ec446efaf09923710e23d22d11deff33a72b6ab7296650a36d6340eef09b9fd09300e7568a91cbf0f6a419a2c1d03fd6a83e31b9a32723ee27dc81e50f8a686e25ce413f487dbc12b8f6cc227b249a620797a544f42cf5ce85c7b01946e83f81daf649c72e30f921fe86c7dce234fe7f6d87f341db80c7510e546e5dad2b86f4a116dc049cbc16b34bf09cecdb4262da9ad8573a146fb00825e34f77e7f4f4b13e0420b789f283baf3b3644738de64e55ba419d26d0a2b2cd5ef788dec5e0403bd6efdc0f635c7c3e0cefc91acbedc02e3580226ea2baa8cf35e58ca0d1275ae5ea94e435304686eb6ccdbeb8d3896e39a7c9c8377aa49ea1d2b5a7595c56686ef679b87ee379940bfb6ee276ab322e415d805b2d4f5eb31c6ee8bdaa888d8f69d365f0ac96ca5f08f03ddd8c739c97af55ee05b9c447e2849e05f9ec6339dc8417e74ff3e6af7af97358834be32e9ccba273e6e41e00c2c40df338c8a6fa778cb15a52d8895a9b3c87dfc7f2598bf3f551e7c5ec231f566c9a540f8e86de97e837120cc3b2fdf28e7e56423d3cd7de636a4014d7993d75de245f8797d691c53123e7f358463c93387717f42215a8c5e4abc35c50af56176d91d6800df3c6978fa9f4faf4ed631a640f669bb80f59410f69103fa4fd5e6b52a076915db3c0e34ac52386232d800c398cf55026473aae51a37c54a5f5ebe0dd52f1dcbcfb9aae675c35ae87e82920f715c60d55d747c9e2504dad47d3f7ad79af8a3e6bc67ee885cfbf0edbca8af513f1a1c9da4396ef77239f633d103afbc01fd1fb4bcb68497b3a873f1090cf357155d9f454b919c6fb9a7c589bb8e685d6d8e7174e8f959aebca79eb53e31e47e33e1d97fbfa15417e446c0dcf8c6d6fe9adae9f5b3dac3fa6dda3a974f7819d0e34db81006dd0126d3d8acd5d5d38348dd684f42810bf369d931288ef84fcdf7bc7b624d637edeba57cc3c1e18c3e79befa57fe5abb9591977b8de78c4a8a65d2e794ec8d96e8d7b01f24965926eed2a8d7eeb2f1d558976bb02e569d73fab29cd35293f995e1f09b9bcc6b88490a7307f9f432eec386d258b83274c02ece97dc9b76049d2323fd3617e31f6b6dac2fd0c60ac7bc92b61afaec94d2c14af86b6f32e5afc981efdcd8689cd436d8bd2f81fee0ce98779f0cb4a62f49db1a7e4fbad71aaf0570817c580efaf65e55269f3fa6e2b396b0a9949efdc1942f8ba7421fb682d869123cebba3809d9eabd26c04558ab0ceb86b88ec569caa889f1f08e84f527287f24d5e7a6b70696416af8b6d1ea42ccb1cca996a68cabce39bc06f710e58b5539d6aaf21059785ed67d1d9cc9844ffa4038fbb49f5dca3d9a8a73fba3a6d1efee8c15c1633eca3d3a899fefec383ee7f8eabaca1575955edefa7ab57a7615c7030b35d873f96ab6753df8dcf7d5f5e0ba1e7cd7f560e9e8f7f11d4c197ae00e6bc4a1ef3ced8a9397eb6bc463f9c9d2e8baf33c86db0b7322457c869cfe42f1de71ec27c4c7efc7dcdb643d38e04af3b5aefdcf94db4f148c93da4fb7a97571fc3e83fbb3fcba56f0acebf0aa73c38110ccbbd11dda9fec302e92c40c30d4062eb0bfcac477d2e30c7852d495e80259b5a2f9c69ce9e11c07f5b042fbe9022c24b29b1f82b4fc31151bc64a4a715c9dcdd13818bbdac61c2c8e81fc8bb526b7612c0753e2fed41da961721d4ff3904f33fa44f7b4da9a340d67767b6ec6651b9a7d7367386b6bac880b0de7d3460d5311e1557c8c19f543aacf666578dd2d50262dbd25fe1c08bca77236bac71766dfe7e62faf7678e23955d75f4fce7756ed28d5cb2fb68656b46fbac97d536afdfac4731e3ddfc1c4c2d6f98e3adf51c70dacdf7bac7c47ecace6c9759693f740bec2cfea6acb51fde316f35a73b3d4dc2c35374be61d704b6e9673b6198fd90cf1dc01e639f42fd1798df997bf5ecd956fa8d3a693e43ebc517feaabaa4cecc12b58eb2de32add9f44ac4161ef0f3bc04134cf16700e3bd32bc23d8fe2ecb1a5ca238f6897e1dec7108ba9c9d2116b9bf5fd332e1cdabe6fe4cf2bd6a49d7f503905c28fc6233bb6d6fb982b2ec06743839b91776c0d2bafefc6d7e1345e154cbb6b9d335d743f0f04fc9922fcb4962ab79703815f184ee768785dd2eb1be0651d080dafc438551941f28ee6b1c6b39ebcf36a3c6b8d67fd1dfc03abc6b3d678d63bb4a1359e95792fd478d65f18cfba447ee79b8c7dc84d54c36afe040a3c326b4ca1ae7fc7a362a1047f42881b65d7e31c0875616c7df4bbb629589dc1b2eb266c9a1f43faef9b83eb9ea581e1fbbb1b9ff79fbeababd0070b621811dfe7b13377791d7546613c93febe8afdf0bf2d0dc58efd34ff1b3d0f40ae1cc31af6cd4ed3eb11c43ce3905326a54b8d79c81bbad7fd44f18e99e64073a97986a0f7489c34750eb9747f6e251d8132c811c39534a7d8b677b6f87d2a1be3081a2be0aadcacb2bd8375f51471a173ed2d08743faa1baf7fde9baebe02e89cef8cd5329f8f59fbafb5ff5afbafbf9aff7acac7db98ca081af3eb7c59f21de5fab1978e87be63dee40dfc50864c0d6f8343367cd4d05b83e8dd1238763ccf5c1bd9f92fad77f8f35b1d7f0d8ffd026bcc1d6c236f0d35a3e661125dad1dc05a029217e18df01d69abadc991e89e59497f95d69bb3b16fdb1aed540eda18c7d41777ba30fb32ff5cc26bd27dd105fe18d9cc4ac67b8fb82b962fdbd094c91a4cf3e1062ef5b50ccede19ab31b9df4b7c56ee7ac7fdfa3a75bd236b6c75bda3ae77d4f58e604e3d9d3bc03719e6e26f2d695e69deed8dd1175dd3816b209b707096733ebdf66779e2eb9a4d1df3a6eeb63ae67d8498973a2f0dac59b9921a542c8879ae4da22d1572ebe37fc373457817df97a3efe8f7bf50bb4cc2b1c06bacd7ae1836352bbecae075f5b1461e900144e750e5883601d6b821b1cb02c72e77a3734662ce24976f18579531ce07ec61349cce1a103ef7dbc7fff859dd187ff080172170248f9ccbab7a1ba9359ae11a1cb927505c8bd60ce7e9032c22b6b364fd2aeed38238ce8eefc379774abd7b843dec537333c57537723fa0795486163af3a6e3f75c91b801e7041e54cf3df413ef50af3dfbdd527aecd2968cb109755984064473271dcf6813a69f9d8599bc44ef3dbe6699baefaa323a0265bcd15be6162883b3fa8945f4d42ed6527b39ec7f2cf985ca759a681fabce01fa3a29505f61dd9b2df0023e5cfcfeb8ceaecbfc96680e755d7ddeb5556eb4339c89ad3b2318e4c7f496b852e576c82386799a84ce5a934758cf056374fb5d4fe74610f4250f28c33c3a5ec535bc8ac4eb05b4bbcee876d9466b04cd5ed30628d670a08fc3ee78a774120b6b245eae8ff8b7f1d29e0265b4d7396437447477600e04559ea018cd355a13e8afbf8decbbd923fa4b785cfd5f6aad8e3a97aa337ce179f46ddb6a18f518d05a47b476f86a68a91cee0df6063dfbfdbd31744fe9d527e6ae903641feb8e78c8e7d019ba93b9dc51d9d9711909b73a088b4c63cd5a39eb92edf7fb575d1e4f682f0a78c3786231d0da1b335b8d97d9f21016e558ec41f582facd5dde92b745f4da04af200bf8a3dfb04f261ff26a378737de76b6243bdff8c7bab342f876ee4059a9145fabe8b684532ce12a5713ddae90e70412bfcd926f099482eb7b90632cfd463ff22dbc6f405426d99f858acf858b04ede0260ff706801aec30145dc128d655f77d7c76d92efe38f0627351ec61e06f190837be95cb5951dc366c518148e20dcab41decc8ff1627a391978df985edb9bd2f53405b8ba20ad4005fcb5332acf7f05c70c9d9fc09a98988b95437f8f342a505c108dadfb89711ac938bcd7b575a183fc53dc5b3910f82dd95b9d8526f3b8872fd9b389628e37aee30dfa91fe7c6cafc79e5b75ee827af67b903ba3f36598f3f66808d202fd5da5b576e81a0cb2a902e95f2463c2b9b9f540e0b17e72109fa9f2138ee9746ee4aa0aee234dd64e6ddd19ef306f6e18c385f121c99550cfbdd7de47568dce1460431718985256ff63911a5322c7fb483c97696e9632b817d27dd384d3a8e39942945b7800ee85141e23c86d5f38bebae67445cd29ef3907e83dc97dbd3104c923fb595a16af53bf965ba73ec5d95962fd2173fcb7e75d104cb9bd0862e119f5ec2b7c87e00ccec95df86c51e3b362e32377943d104cd774a46d4a2b3c765f12bfe2a2da5aa91c73a0a93b24ef6c78dd3150443ca7401e07358beac75f597f5176ad2171de9fd0cf0d8e6896a9a4761ccbfda7b0be713f9b554b2951bb127fc74ec7d8892a784b23bcf4b5bc8fc0815bcc7b41f7f9f8f8579543beb54870212ba29ba0620edd58bf5de8bf57cf6b1a9b87080b280357c7353c7a5d027e94918df6908f076c041af60381ecc5907331c4f83e52bf5de91af0353763cdcd587333d6dc8cbf415fed08d9f52683339169db2eee7d08eb2670ae0bb30dc10a0d4e9e53f45f6ace73c43257ae49addf51eb77d4fa1db7b2f5bf817e07ed9f5789a3c57a18c26b2ca6b9174d8fd373126014c3fe593786eb0ce37b3aaea910237b665fc6e65bc17b91b94fb3ee9efcbd55b972be8c5820160f92735b2c1ec8880546b629482bbd8579fac93e9545da4f6e1aabeecee066540d3cb186f780e766cf4f10572f70bd88eb781f51bfdd9ceeaf331571afb74690c2c9d0fdd845f2dff972df71bceca93ebb907f97d4b1a58dea481ece932bc37c38cf62cf8b3d375143b654873f067d0e0477da5e9dc204c4d7f4149e0d344c8ef798363f7b3c797db2abed1f7b4ccf7f8ce5767bd01fedc06ad242fe13e6be15fcdabc6344f925f4eff3aea329e2068ff3fd3c968061db2ec14ae4df9319e73517fe2abd36d9582c81f740dfb40d6796d9e75964ef0045e43479c4ecb7c9febe8273111fdba5eb10ae87f1d2eefa3d082ee94fc07d251b5559469ab1d36e77f60a8f797027bfea5ef1f1d7edccfa5c91efea8b3b95938e8697e91fa76d44165e6a45e1c0d9f9c8a2dfb756e5a732cec3ca94e152c53d220f684b05b389719ac8479a629be901d98feb9c88abefb73d0fad894d38c1d939b1827b057daf0b848cbe9a8c319dc495b17eff4c1fcb20036fc6f8f929ff2558a7a86ff37a2e23da07dcb0f35fd7711965e4d4f2721b51bdf469cdb820c6bca82e48f1d855c0cb193ceb0aee1ff43cfc39bf0f8a9feb2da9e1d7c7b640809c264f88f63aee93830b30cdd238a81a47478f1fc7d162926753753a4db387797a5cdd99b8c83f30657e1df321d8da02bf0287cfc579ec9a57a7e6d5a979756a5e9ddf805707cd6943e7463b9d896fbccd33c3bc59adbd5fd7ee4ed9e9ba76f78bd4eeeeab4e97e46fb956675fcaa8cbc56a1fab89adc96d9cdfd539f13f401e350ae0fff361ff8be719ff56e3bd4a5ee00b5d31a6f26a1ea18d99340c07ce4d65925783b060dcdaf5f4d664a7729d750598f17178e7be5cce55334d6a46c8c8ef1b87fd5de178fad211c863dc9748e5886d2a06ac9a9f36367ebfff8b1d87fbf754e4a3e09eb8e5a03fd9913102170833dc2f16e4f37f89feac4b79efd9d839cc3d6b38fc1694a02f70350fda7d719e256ddfb5b571c9e7d0b6b4383753a891af62ec3fefea847364a73a2e545be33ba98733f119978fe94e701a01eff20370f0e1e797b00fc37dfd2153fd797dc93384ce11f87aac98ef9b9c733c37148e01c7da40111b1fd33deeeb33492d7a87f555e7e11a17e961bca50f13cc1bf65d42ff6e05999814a29b0a710f0ece11a03b721aed67744f1a82e499fde53aec05690d2cbd35f9243e10f1758ac41539628a8b6b1105f5b4703eeb4d116ddd316115fe0e7ade8027cfbba27f47d104c9437bd7e877d79a3c223c5e7e4f9746b46be1e0158ecdc5d8c2bda9bdae877919082f6a4b13e042ebf96b1ce5f303ec05acbc170ee2bbd99a91bb39b0b7d58cb3a21e1fda77a7ea440ddc8b34ef36f0e7486fdb56e7dadf816ca267716086cfe72a8343ae1afc1c24ef59863dd69d89ab727c0304ebd727df4df2ca641fe82b82d342b6097346d06bfb7e1f78ce596b82ec215573ba7e5c55d9d0c169ff14ea4e2257e1b477fab20d01c7373405c029be2fcdb8ce49c236de3a773191db797b8b8bed477c17625f01df0f03a1ddc43c4673c27d18aca511daaa7bf057a52da06348012e887d23b60ff9360fb80f1b688e8d167ee72fb47d13342f473c4739f314b17d9bce572cd15d660a84fb184cbbf4387d4d9a3bc10527c69eb5bfccbe68ebab11392fc46e7c39e6f714f6f6547c139faf4ceccf6cdc7c3ec5b556687f5eb54ef139da076ba6cbf0e8f7cde31e78c3e9ac077c940fcbc17f675dc481d72b56db1d14e4c2cbb136d284bfd7b521bce913816fa8b2e9a972c3c2fab74267a173fbf37c78bdcb38f106c56a3956516e3ca20917e752ce3ce7ab11d1b59558f9c4caf2dc410c5c013e2b7cd615f8acb0b724b4b9540e83e233cb8567aa34c71dd4b8839c44d077ae3ae1fd47f58f505c637970663788dd0642d3fd10a425f63f5652ea8e38cba1e4481c50c4b629d8aeee181b4d6eaf35b90d5918c332387f98fab5b7cf6588fa6a020dcc7bd986fe1d7bd5fec679d3fed022359c274b437e92025cd0976cbd47e97e33c65b35ffc894f10ed4fe6ea8325c1b5ea80d98c41c861adf8c397c24bca107e4e6ce14669bb04f87acf9adeb38350742e91c08213fb2ab3a07f8a6746d633569d7fa5ecc77a9f5bd6ec04753eb7bdd07d7625dd73e79deeabaf657d4b5cfed6db24702ddee93bef19bc2beebaed41ff7e27cea4dcc31f77117bd48e89eec34c0b4196ab1eb49dce1cd34caa48dde9ac0c12bae6179681fe98eb4c03c7b7ce80717a9f5b3cee44c0b300e74ed3a78b6c078f697f52a056bd14dc64f10f4baa15e39c685069ce03cd11dc17634c22504be772a1e4af232568501bb4abb2ccec3903a33b1bcd10ce32de3d8dc3235fcc258066e4de433091defa38ab31270e1bf52cfbd4ec30feb77e99c8a7fae729d25786fbbfa0aa07db03356cb0dc6bb93672c746e027f601d30647f496e4655704cb4371ce918f083826977a3ca6d1b7012c18e847384e2d95103f74e2b312da3afea0b0cec8b488d99e2da0935aed27332bdf13c3c0aafa8438fab8963879be84ed7315d1dd3d5311de3fd6e1fd39588a763d8d1dbdf9b53b9cda177c31c5caf78bf5c91877eb50c0e6e017780c8a6e90ebf42fe94463f83ecc97ba9afd0779b35f5eff340aba284b1dc632ebae6a6aeb9a96b6eea9a9bbaaecb64fef705751976fc10d3567893637a1935f735cb9ed4fdf375fffcaf7a97fc06dcd7754f7df4bd0fdc536f9decdb717c4d5b5cafe936490e5b44318c6b38983b88eee709b5aabe8eff9abc6ff95cec1d0f70fc56535c3bb2a5feb3126beb73b4ba86d76d46f5c6277abde1477fccca975a9260a3cf617e57d5c17dee1e900144b699f0bf065ac389672a23087aa46f03fd67b470ceb76138fc42e3702d33c495e9ad01fa5ece940f5ba088b6eac035d6dfef237fa6bdf579af763ab7bf975ecfae3f1ee6197b6f892b556ebb1fa196eb1eebbf02659cacf3fafa58f048f3cfebb1daaff4e4e7ca497e2ea891ccbb330d7d0ef94353acad65633fd0913c9c679a761bba8f574d3c93f017e0fe9340c717f31c78aa3cfa49e63accdf13eefb3e68ea421b1a0e3fd7e4836bf697168e079cb61ffb75767a959cf897d7a4c3fccb9b8cf32f696e4c81f7ccfe72a37152db48f292079c0bf201aaad099a9b2703ed97979cb1434a9b127f1fda3b6bd08bbe6bdc1777e85db4448c46718da2715d34de848edc26a61f705d1d9ed408896f0641af497390a6391cb04d052ed69b8e38469fe3ef73839afce9b8a8829c695cc7af245dc1998fdbf6b5f5a231016512d6d150ac90d2dcf3fbd0000739203f45bae089bada57680d9e992be2cff8d87bdf46467bce911a04af8fec2bef61bb1adb9f7e4f61a0db19c3064779dddcfc8af75237ac7341bf772ea8aee7d6f5dcba9ecb78bf2fc7e8c638d91f25a772423bcb9a3992031491d40ecbd0744ac72f365b4f18c53d493f863fa6359353f5d67b891f5fa8bd604de3f1d9948aaf29cc542573731f399e9574fcda3c62e47706dc86923282036233aecfdd40c23d407a1e91bf4eaf174fd5a5bf7c9f8a94cf1c70fa4b0637b3887d8fe164cf8da7d621bb9d0e9967caede39b12e9793d98a6d48ce4a9e2f15e4c1f1fdbb5574b6c456314bd98d65d90e7f4735e69ac8d86eca000b7588b4638a0181073d099cac8e7a3cac10190b06f97ea0715e404489c8d5cdc00a9bd98ade1241d8132dcd0fa6639d7f0222e87f8debb90d321da837bdf9e26e3fbd8ded094a1a54e9f57d41857ccfbb3ef7f572a07d0fd549521da574753e0d73ab957b780f0c1fed65a6780f0002fd01e0a75cf7e59fbd3dde9025c7c4c8df8cffd1c9b5f0b49e5ce28bcd4d1df379068e5fce6b687cec7f6871b4aeb2e2fb7cb5d68c04964dd137e77ccbeb806f2d97be6de703a0ba08c8e45ef2fa325ed311f547fe8f3874a98b35afd95edcfefa7dd76aa8ef2f8f5aa823d61b8068abebfd774d3b1c8edeb35e3f0f9dd742c941363c2c296c4b878047b677af1faeea03f42cf38e29c8047b8f2a31a07e114ab9c2b7689b1029eceed2d468c1ce42a02ddaf3940fe92d06925b0ed9681b92c470eeef1125ee3752bfcfd87e3bd72e8e7d6654be1bfee26c75eebb265de35b52edb83eab225efcc905f9f7ca630def95032de39e8a366f5da94c7ad97c43628212e8efcdeedb9c942becb3759b431ce888fbfc315188782f74a5a3f35f225beecdedce0fc9f3cb66661cd07afeb8577e79931de236621dcab897ea207c2faa77ba40ad4037ad9fd8f89fd18c57b02a59f91aee7e6ad1358b96b05bd8b732b7fab8bb47f11f4445e38beba5e7d45bd7a9013c783f546aad3df8c9dd5eaf4540faeeeacabc346ad96317ce5d7e195707c9e077b949c971c7e53f9f386f1fd4ad736904fa14ca0511daeec88f6a4ff6e37d92721d74d80e7c9a3b37b2fbdd3b15eae32eebc8b314cf776e7d1b835122f5edabf57c78bd7c48ba7e22ceb745f114cf60e7d1f3708a7eed7f50e95dc3304a31e13ba6f85e236c3b9455586a4e746e8cc35475af8da1b5faefb93ecef23d893cbc774275a2b8b2866ced5d37607fb92ce039fc2ae5ddbe3f68a6d4f8031c49aabc22b15e3c6d7bc086ef796fb94ae19c4f6244f61f868ae988263bc07bdbf41fefa552217d79c7f4ce335adab6a578ed84ef4f0e4e5fd4ce171afebb58af75519f1fc4eba6ee774d660dafc09147864ea1cac46e88e5b9842c7a3faa3e27e32f33b6fc27b6ad1383ad23f9c2fe7f8a68ca0d9c39cacb62e4b0da00caeac55a6be2f776f19f22355dc0377e3b5c6b6d71fff753d75b17824f73863cfbf05afed08f9a5416ebd027ece2e543964ff86d68c1adb15b964da2e1f077df35393479f443770126051226eaeb83db60d6766a9f26489cf1f272dabee8b3b311f71ed59523b0eb438a9bd34416376b14ecadcc791fbf739e911e497a02f42a33582a0ce23b3fd953a8f5ce7917b751ef98bf3c85fdc07fa5879d54af2d175ff69dd7f5af79fdecc0e5fd17f4ac7127effe9ddf59a063ef63bf5aed5f599fa3e301d3f0d0482bf36bc781ee64efaf3287d593a262bd8435a6cdc55f5889ee3bd63e72362b16933a90de3e73c4ad59bc1df5935b74f3cc64fe6c0aecb2ba5fd849c638bf53b34dd845fe7d766fc7ce1653ce7ecb9bf3dae6d1a7f6e778a9e7b45fe81751677ba33c35c68badcf1c094e4e770fcae4c3e077ddaf7f4f9d18866eb1e28227e5f52df78b500baa3fc7e53c391d6681e4c87e66a0bcf7cd59ce959f318606c53dc723ad78603b45785e6ce706616ce712a7e6e06c504d1f7b9ba001b1fbe26af21481eb95f704c680f04d0d41d5fa3b52f421dbda70248be9daa13e13acc9d62cfef20defa2d703b614f0d9ba7b5c680d41810b66dab31208f8d015945bed92370f3cce2f53f6b86eb7f5d71f25202f731ce4be0f9a0f96313fe17a57f2f884dac79a28c8ef45d7b2f35f95373457198107fd2efe5d694a195a8b15a680dfc733b379591aba39f7b5dc794db0bd21774273ca845fb2c933e7f25bd2251eff30dfb2c1d4d6e233ffa536f053a2594ef9deef3a7fbd12d95d2f20b6b80fd61d57e73a1b922f9b909040e3a8fb13e12cabf4ef7a753df55f765d67d99755f66ed635ddb97b9379ccebeba383176562b8b1775016eb53c315c3931383b07768f5cb4cc1a6a1abf75ed3afdd69c9c094ce9b57e3f2b0717e60ccf724d06b9369f539baa2d608d87c86fa16b51f7c27518f3b11235a7ccfc64057373dff59614fef6d7adb92463a487c5b616d5654ff7e3dd1ef389620f9e7e6657267c7397c782095c64985744778129485ec8e1c6c1b5dec39a2a8e261fd644bbf5c952574bffbca3f37fd8617e039f83732084f356756cf88ee2d3193d0edee70565e3416f39ee73effd159ab52c1f8a70174ef3e9d696e86f90b33dc5b551cc3b4a6a9aa43f02ff1b1e33e1877d5f8ebea3cfb1e2d3dcb169feb89415938e295b644d67e3127ad0ba3b4d6e636d0e8c3fe8c7ce38ae75e23d47d7e37af877031c33a58dc4bbba43edc5bee9eac29ee85429a3a3269b5b20df8d7fc1984b8ccb18eb1c247873e16f346edafe6760d0f7c4f7e06c57e5aca46e1b043d9f9b73da6da1733c1030a6fb68707085e6eb5e7a880ad6fe83337bf3bb4722dc94976b99133edabdd6176d832335fd90ebd9b7b5c0816bbd3fdc843a6cf173807989831c992948c7307f17eba52aec479779ef84f731953fff8a315775e790fce88ce8e59dd26afee8a39f8fb2f9be537e6b9cb3724c74df62d8bf8bcf919f5b0a7cb9209f94cec7da3b4d66d7cab2efd2aea7b746f0bb631d327cf1ec5c405fdc19dcecbfb5972c1f3733e7b2379cce11bcbb9f432fa3d7edfcfb66619a72bcefece2f71dcd2f7cdf553a9f97f3994d3dab1ff2ec1c75d697ce91d117b362b3b39f35057eae0b33560cb3d0848e1bf60605310cd9df6778d1ac43ba9e158f730742d3fd10a4e58fa9d8305652cae73a89170ef686dc5e935a1303f7560abf9be5fd22e338fe22e360d4491f721ccd5f641cdc2f318ed533e37e8ed54b51dcfa04e47167b09a78669a0be2545d29b81ba0e948de078eb7daab37196e75e4134e6f35a6aec7f0dde3feccb4bbd1141b8297c6dcec8bcd548eddff8c7f0f14adb764f96b195aeec13dd8743f2eaad5049fcfcc1fc4efb344bc1ee13da4fee9f7ebec83fea23177b08dd690797796107fedf474af77d9b99a93bd3619fb78ad31380faae15b3db8e5d89bd1a7cec105deff45eaa49979a1d3f60b28001a2b9257005ed3d531be36edd716f033770c3fb3807f2b317ccd00db941d97d0758cc9695bb72339e0b6adbf8ed6aa323ab23811cac05199f2fe9cedbe1eeb72a2369ab5e6bab02c94c72cd16e34545cb3b94bdbd13018315925b6633539ebcb33f7bc7f1e8ad6cc6f357f39710c177db796c5a31dd8cb1bed57eddc3d97cf4f39e357481c50c436e6e0708c4de053decc2e09e347f72983f90cd629eb59c9b53b53a7a9f6ee45737c023b737acfac467b208f5c55de5f7357db05eeda2c5be8af1319eba97c231e6fd2ce9dc1e687df51ccbfcf1aafefdf48cc3b3fc539939d574aeebfe87b0b8eef86363c63dfa4639002fb65a17393743eaf973fa707e4c635cf77af79b6d19a783ac7c8b917f80e35ed231498fb51f3dacf1b57c59f70031879f233f768ca66faff4ecec039bcc87dc6f9e7d799eb2c19fc7905e65ada5cb3cf18716c8167a7eb2805ced811d7951de3baef90af7c078551e7c8ca9594d9fb42d6bd029f8f994bbadb5836b49d0f98e338e3679db70559392586cd1cb0303fb333efd7eafa3daf87175de08fb7cb894b9b7afd1e79fd96a5c46da5fb9ca76dd44653acaa6294bbb6a16a1a2b7b0fbe505df3c8970bc23e4d29e7ef0ef7a681ec5b2977c33dda4d1cf714cf39b371bbb6decfe25f6673ad19cef07c2f91c36f3f24693abd55cdd579bdcfbbe35ef215193e12c3f72974266e783e58feccb95e53f69eb9f05e8de6cc466b578edd4de40c0dc26f6d33eacd15e772cfe7a6f4d5081a2c6ef633716b81bc23cbb66d0d4ef218dceb97dab7cbfa124ab997186783e2900d345092f7fcd9cf9ef011ce7f16ed6dd045fe08830783357f9f687f84f3c1e8b39ba4d735a62b4ff199dba620cd34b909df14d335056b630a70031477a73b0cdcee29ee908c5e7bc2ed827f163e8bf0c15b964ae1ad410fd9a226fc703a5b90a7673e0f0f3ce650467e9ecfc52660bcb87f1f52efc3e42eec221f111ace9365128c7e67307ffa29f59e7efecbbb7fcbde7aa34c9f7efecbba4f13cf8ddda701b65ba2f7eca9359236aa0cd71770a4a471ea4b7e3b10fcef0b79f787b79febd508edcd2d60f72ab63401368034daabe8def7fb6b48bff461ad2add6396ce8821f00d53903c7deef7a0bc37e66ff3cdf3db7c5d825e517a3ede1d09ed537b208810ef8d79778fce1d50c4ed4dfa5ca23d98b6e98e74c4b6a145ad5502db1fbb13fba9bd17d56b1591812dba647fbd5a92248a25f5497840c1fbc6e73aeace55725790b95b4169b65c327d4893b35d5390de0d8147f773b83754aeb3d6057e6f7019b87ad6998787fd47e3d0919be4ff8a30fea9f49ac61b6cfce7fbfce96709fd72e97982e15de473e38c3e016d37d138b827cc3b39e04561ec3d1fbfbf5bdc2dfab7c33396e6dc89afcf22e67bc56c19ed7fc5f66674a7350d67b989695395b81fe3fca77bcb68490badd7b50ddc1348f173f2d2f43d87565b1ecea1c4f98cf5e6a872bb8ddf8b79ef989f9a3281b356d7c67dffc13e44beb770b08dfe88e90b15b107c11aa99c0d75f9f58f9ef53ffff3edcf6faef6f363b5f9f6cfffffedc7d2faf6cfb76f7f7e1b69ce07fad3fffddffffdf9cdfef8f9817efa32fff9ed9f6f7fd99fcec75f6bb85d6aebf9f2fffb5faef397a96db4bf7e7e98b6b6f9cb9a6ffeb2b7fa5fe6a7b1fcf8f9d7e7dad0e072bef9f6e7b781e37efedcfcd03676ec19d46fbc7c1ae447efda4feb6343fe3cf9fcf4ff34d43686fded9fd516c23fbf4d371afcf8f6cfff6a70fde1ff6df2a1ad3f57e477854f7e0e3fd6c16f9367877f7df970c33fbf7fac3789df46ff94f8c4f0d3dc423c0bfe00acf9c6deeaff657c3a8ca10eb5f9eadb3f9b9fdb8f3faf9d35e173f8695efc057f599fffe57c9af87ba48f9feb399e9fe67f355b6869ff1f000000ffff010000ffffb5b60a2e0c030f00`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffec7dd972e248b3f0ab9ce0da674612a6bbf19d852d21b1cc0046db892f26b460495609f437abf862defd8f2c954002ad6eccd8315c10dd0695aa322b332bf7fa6fc39dbf2e968d87ff366c77e5ac8ddfcc85ffbbb530bdd9cfdf174b53479ebb825f9fdc9f8d87c6ef2b3ff8dd9ecd1b770dc10f163f577fea2ba7f15034f6ae31d4fd59e3a191f8e66961361e1a8dbbc68bfed39eada2ff8f178b557a8a81be329dc6c3ff357e6bfce7ae3159e968d67858fd5ccfc81fe399be5ccc1b0f0d63ed22eb7f84a7fff1dda58f07dd35f805e7a2d912865b0bf3377b01ef8816bd6c3cccd708dd359e66c1e1ff2fb3e5ea30e6f8d5c988c1c25ac32afedba802f94077e7f18acf10c82f060b2bfe4a47abdffc8585bf96663f972e868bfe8d69fd4635fefefbefbbc66b044dd13e3dfcfec7a4f3d8fffd6db998ffbe349d99afc300d861f8d79aad7417e1bd9e475b429eb96b2cddfdacf1704fb5bfdd35fc85356b3c30f4fdf7fb1ff7f43d8dbff96be5e2110cc57cfb5f9afa5ffac70bcd3cd0df1f98f66f4dea9ea2a81f544b6bdc35dce55f16401a01bd0cf1744fb34de3e15b8b62eeef1ac27cd178687fa3bf7f6bb7ee1a43e4cebdc60373d718e069e96f3fbe7fbf6b4c5dabf140dd3578f2aff2d75f816e51f8ff630bde46dd35268945b3c84bc2c0a285e92d1b0f3fee1a8f2bd787354c6666e381fede669a4ceb478bb96b0c97f04dbbdd6a7f6fb5981f7fdf3506598fdeb7e3470f70fe7dd7e8547f54f9ebaff57cbd9c598d87ffa3eea83bea3f783f9dd9cf7f9caf0a5eff2f67b8bb468077e0bf8d3f3dbbe2466470dfdf770d4b5fe9313081fe73365f1ddf7a1c8ba7acc5d9d102fe32f5958e16f65fd184bfc103c53c9f3fec2006beb77f30b11cb867a80a02a0d9a6bf35e924ffbfea68592a00be1d04001d0b806693fef1a39e0088969b2301dadfb245409b6ec6ccca30dfab8b800852aa8a08383e5a47046450d189503852cde1f722c63f327c448484adc906a5f93ac9c6d1d3ff6e062e60b2036b373aeea33d0b1f5db13b7cd37969a54e04fb0ff7d1d5bb63ca7c5a6cfa4cb03798fb95c90c179a4c3b7dbf1d6a61db337dceb73ad47618b6f72ae320437e7e13dc6d4fe83cda4247f07479e40a4f0b5bf01dcaea3e7eeb87ed37b329bef5fd566036476b8d69337da6b5ef33edbdca70cbfefe793de8d0f1bbd6fa7cb8315c6109eb133a8faec80f37864c23634edeebb55f461437109e871b633e0e0d666b8f188e52e5dd460b59de688a2b55197d139e839717eade9e92f51fd7285233854582fbf84de8881bd50f90da1cb9fdc7858d7ff7c748f3d15a57c681c1b4f6f839f21be0cbe4b9509529bc16b2465be82e6d613e0e2c7e17bf77a42aa263c812a529c2f1ddf8237a9a327c337db4b57804b062bc4ff17b6964f1526886ecd6f4db8c2e8f91c03fdb862cbde93cb7d695e14ae0b58de9b294ce4f6dad8bd6aa4c076ac83a667318684c6ba5c92d4ae0dbbed0d51ca32ba15e7710083c5ac37b54a6bdb6bae2c6e8dc9fac29b16fee60a3f192afcbad00af6f3ed8c4309fc0518c47fc11018eada688942e4b295c1ef0e623efec7b826b8b4794c14fc9be230f60d3652d38c729feb81a2fedd5a618985dd8bbfb681c92d6bac2d1da840d345e5a5b3cf275590a231c8d17da847d339816a5ca68adcbadb9c0b75ddd97deacce8106139f14edecadae1818bee90a5deaf4595765768ed91ca4e9047fd8ef803b5d192f35c27323f26c65983cb4d678f4a6028dfaa66df10e12bae396c94f6da329d8d1dcc340f3c933ccbd6d306af41cf0d37cecc0fe1abe691b8cf8ff3479481df923f199e377621884f990327db4d642c17e9d9c3e0b34cd22c31feffb727b5d6d3fc7c8e4a5b5d61dd8005775d8b9b5d11c2f3445b0cd04cf008d5b7efb27dec3ee78a12bf0de16fa45b896aa2c22a383f729fd1bf0bc3b0e3559cde51b83e75c4dde66d2471ab6b49c893f8033556eb55e1835838e1e6d815b9e7f47c6895d116944b60861dbd3642d307c44e9727b6d866dda64b8b936c982197f32be63bf9fd0bf63ce456736c9e213fc7175658c0c258b07e20fbc53f04c5ff2a3770a6f7d262d7ffb4d69afca16125cb69d41234466d381e1214a93e9088f4f00176b65f3657b6d36c721f0bbd5cde54fc798a33f09fece7021878fbd77c1c200ef01bd71013ecfced7873f59df012d585db48d6586aa88a1aa786e3e4e12f8c879a6029d806c043907f282bad41e684d29d0786ead32d32be3dfa2e14c3578b4fe12b45f6dbd702ead74b9255abcb432438cb350e852b632c9d8af79a417e4c99b127a6054794767af45dc6bf20eeb00d97b5a026b73880c1e9fbb59ef5eea72eb27812dfd1bc8619f5bcfa66d3f9bd659e3fcbbaaf21badf5f02bd0c92e30fc65ae3c017a3464b49e24e61ab86c5be028fbf58c46c45053385a5744a49d9d7bac2174c400be57d238715579ec91f53806c786469345e67c1c68643ecde79626334de3d2df39aa2f2dd3fb76ae1f257563818fc76c7bf9e769ac2b3cdb263ccf480b83d979a033687e3b045dd890d1de64e0dc67094d6f6d5dbeb7351eed61cc6c524f478ecffa135c56d017c5c3599cc4f599be8458c7f4b995a68c91e9b2a736447adffd31329961a82b2c3eefc9fca2e18f0395e1284d19d8aadcf2045eba075875b9b527baf0d652c4add11c2281e7d65a87a561cf40775427ec36dfa629c68da50c1d8d99deec871af683d9945686cc85b313ba38c09dabc3d6e6e7bd25d32b55115b82cb5a27631d8d07bb166d0c9f5b4ebbe2c6e4a5d0e2118a6431ebabf26eafa565485ac7ea94d0b597d689aad8caa309eb633b5fde627b47975b87bf35c5d99abe04bcbd315c76a3b9ac23f0a939f0188b97f6677be48e03ad937bf69de84269f993d4cd726c2ad73cc35f6a2fb0dcd39421558d9ed33065c25311873d5ebad7e4216df19c6bf0d300cb85ae88c0868c6d4775c216c15fd7aef234056cd06dc6b9c77e2fe093d377b9a62fed2c19859a3c3a7b971ce2730178737929da8e745709f41154766e8d53cf6e7ba5b2c67bb6318d3053db6c8e1dd0e7c0e701b2595546f837cb471e96df1336d464e21bf3e159e00fe00b81f80324e0cb88febbc9b3a3deb9a615c070219a0719bfd726a7bf55d3d108be3269e35d3a49a67e7cbeb792cf2d2d796a9fc8bafae7f08485f36da52bc3bd2e8b4b6d12eb23d15e9e9cbb58761d70daf56aeda515adf9644c95332947b73ff77bbe44cf01ceef2bc9f229c81906fbe540170359bd3018f4a64d58c790dbf83ba33b46a6df021a6760efeac06c32d2328b2e2f44bb0ba369c239bd2e38a793f8795215d1d3e59663f0889a4daacb6b09fce32e4bf601fbfd96a0c71ee51ff691db66537ad33bac6386ecc6f0237fa021b7432d7bae0a7b1ff3d28ed214710dfe44cb97f67967d407f9abde74be1d607fc117f09994adb5a63df64e1dccd9e8f2b0548e8d0ecf553b9f880cb163be82fd11786e6530e0771d22cb974255dec674b839fa37d3ef1779e4e5dbb3b10c38a1d92afac59c754c122beab8b97c08f119aa2acce06706dd2b8215ecc7e1c65080bfa4b5d549ea98513c0a780e8fa9a75f6eb3d674213915cf5b269fc4948d939ea7e84c930c1fed802eb07f0dec7e1f819d0ab8c1b12a38272d9e037841a6e7d87be730c3d98a7dfa59be47bfe51872ae0f3145e72f603bd790b793188e394ac45858da9cb31bd01922fb19ad2d5e5a6bbce425f42d0cef614fbade12eb5fc4ee886317f15ef6dd5f93cbc67c501d7e65b855cf79bd685f5981071c4b7b93e7e04cde5932b7d465ad15c759b4090b3148d4776ff1b21af1b2243cb575de831d9881c76259f0b1fe523c77e70bf84b9b600b3c66e1afc2f97cb69797b25f4ed7e2e233e4da31aa26393f7370f389f42c725e669c0bef8849615f7ff3cab82ef1db7f225c830fe3e73b715ddb67634467f565620c73e0f5723d784af8300d5fb66e409eb541ce1a32a2cc905d59f28ed21516ec77a04b476b8a8ec93bfb7e875deaca9032c3ad1df9d7a4bdc04b941ad6f3c398d9ebab70be8a103370349ea3488e572fd7a788585af577d83701fb2ef01073e13c55193b95746504b8d6c0e7b507dd08622d861cbf8b738da644119d616bc9e2529787b1dfca31fc91ad2a9aa3cb10df414b5d19b7f27dece38dda8c7c637db7968e9dc445be6de1e7f8f0e7e734d771f37db41db7a63c68c6ba78069fcda3bd311927c38f5ff5ac915c83477b1df4d5ac397cd083462b436e7b38cf4a1ebd0f0e26e33de732ce055b31f2d3bf0f9ee4f80c1d6b633243c7e8d08ca688fb834efbb8a82bfb32de93b53f22b27c847fefcb4e6036c71b73ee5df73c691ed790b946784fd677a7f1249f0b4d9f6b65eab8f07c470c12b906d9cf54a1475f627405c718b2e8e33dba98673043da90c12ea703edeaf93de38d1ad2d8d6fef43a70f95a6be69514cbc60fc57b893eff89f428b015b6efd4a34e61bbe9ac1fa7b39ee1daf4dbcbebd3b5b8c9b1f33f1bae4383c9d15bde816b836fbfa919f9f75788332c0d26d649bf820cdf6d0ebafaa5e89ce7fe19df11ccdbf5be02ad837d07b1bb0b9d9b9067c1422e47a0fa3bd45758a7e40cad903745f84846ebf378d1e32eebd932dbfa0cffb0a73eb7349ef368219f0f6bc2117fcaf625d494e116f8228aa3d3e5fb943317c809c36f67e6e114c090b4bf63feca985b0ca19ecaf457b9b2ad14cec378b67dc62f7311998a1498be749e17c725e33d67eb5aaaca90eacbf4468b72dcbc8c67123650157beb42f9643ef7a68790a336c6f55425b1bd8e2a0f7f0a3ced58903770ea1fc88d730c37067fc85d88729e18441dcea1281f12686c632922c442424dd690e94b6b9591f635fd102eacb12fe7acf142be08c883339a5208ebbbf2399ac0532efff52af0d475ced126e4a0b469d31fbe5787b15f2fe2ab4cdaef25fecaa3ed6c4f78b4b7f876389bb01cc4a067936a346fb82cc438615c60e03cb121c80ef82e80183ef88a487c3bd4142dc0be96f9c0b6f81f99b1318d6fbf59240f0572a94d5fc2f202fc777a57dc187e7b693016f817971047d77829143a4ed0f7a7eb7e875d19cd21257445a4423e50076a20254697dbb41926e677595f57c4bdd5f1d6b5720a52be91f4b8a40ef10b31f6e4fef4eaea3f07bfe1b9fee3a6fd76ef3a33227fab325ce4f9e20c9edb5b1d7a653051ecfebd3ec6acf7bc4e8ace9177c1933c63cef9d587da646ed9573464ce897fe94c3e54d1af33de93b93f45b4f1c17af3bf33e67aab07fab07aa02bebab3ee76924b74a9577a3b37a0f1ef27187283d0e6247ad9f6567e44bb45f95ce423877345f0a35b905ba1d65e05c546c5fe3b30a7e37e436a54d923a602d9d8facf943ce9ec5811e32f6271993127ca715bd4bbab73a491d765a239fe6d9c6bec730c6d3311e877195fa5b0b55d98afedf711cb539a65579bb14f8d6067220e31a0e8011c7dc78c9eb65f247b6ad95b966127724cfd2a6efad003f9a2222234fc79b17d479a7ed8b8926ef621f51e57cb231c1a30ab9b3510c348207c7384564f0911fc7649cc0ec1cfb3f681dd69d4da27e0f11ceef6d8977023364df54f9de567dc845bbb775b9e545f1d476b23609d39cd81cd866570a4dbebdd7ceeadacee9b038e78e461a8f9cbe72b451d238c8e04359f274f9d92673fc0ace569a3c0ed414ee8e706af2c83eda3cd35f83733e46b36e5efc339b16df93eb10f1e1191db93a91a917ca81c88d1d97e60070e300f2ff2c9e5b999d4ab2740ab40af9063887df87da24b40299aaf3689f92155d0bf2d493ba694d9d3e1513cfb7a18fb97827ebd736a64f3ba446cd8dd64c53ba4c9ffa47c02f4a41fd694c9b8a6b2f066f8fdb41771b0e9f1eb7af4fc2fde089fdd17b7a6ebd3e89c6a043b59489bd95f7a37b45d91983b711f307471b8327f2f7cb801eee9125ef05469978416f426d072e15fe31413f7a1d7b3178413f0668b9edbf20ab2745ffcef6629b3cb71870cb1d7cf7fac4457329db50de3faefee0a8a0a76c17bd8ebdfb23fe4ea202fc9b447f1fcca9a08fd7f5bc1fbe98f78ab2dd0dbadbddc0f556bd27d6184ca856b43ed122637e0ce8e57620557def88c1635e9ee37956f0b7bc7f0e1502c7ebd360db9ba0692fc2d31ecff7c491f9a8e00fd781efe9f8f9decb6201bf0d259ae0d55b28dc764d9effd97bf9b190e73ba317d2f19a01ce6fc9b589a73a7259aec3b9dc1f807f4ce013feb16e6dde785695715c5b1bd119c8301eafc58ed712f35e4d9f5299ff2e47f6a59f897376c87a21f707f581dfceeb86b6913f4e8c619bf53a7638887881025e18bcd9c01f695a7e628de19363bd72cb70f8a4dec31e93bf7783fdb4f907ecf19b66f5265ed07f790cfb2f42ab94eee0b904ad0d0e7486e9eb1ef8ebb5632fd2bc8908df2cc3d709a17dcc938ef5faf4bc7d7d7a0e7b93049f71cbdd0011fa53b67b79ffb8abfadee1930afcb91b4c0a786cff18f4a4d122e2f921ccb71d1ce9fd5b8f83efa57c1e9938a6d26991e797c19fa1a3bf72cb9ffdf81dca327c7d09926b3bcd01cacdc7393b23bca40c3fdac627f49179a64be0373a8e49e45927de19f995c08f54f33cc85d4bde799de0af2cff75897d410f9f46d3ad9dc05b1519f0e7941ab22fe0ef62c6c8e8b087f96af27bc67aabf07b7acfc167d797915706eb38e1db3bd446e01a8f4a30b3e9fd4dd5e2dba622413d5c24b378b105f2f1f49908967a399347d83ec4e63ad2546a4d914d9c9d1fc9d1aabcc3fe3533047d686aebd08322f2b1267297d2bd06fa6e757b284dd344974cef579e8d73a97e02e93e0969bc26d67d99d8505c735028afd0414f3ecd692bad73b31844413d655407335d0aa00776c5409d4b94268f727de1c75e7b5b520b16d9b95a53dc58ca634db906eb7f2c9269b7fe1927fd336e75515fa12eea4cb640dec4b90ff0e9398d179f766619b6efb093960daadc825e81877c9e4219e1716b88f913384f7098ed9f18c57bdc243d03baaca32b422c33e2da57c7e8b068d66597aa0c35ec384e77f089d63cf31dc36f6d2ce22bfaa0730dc3544d26b49c73bba090de621992e493d39e4301d6fda00f6ba2ff10a9bf3cc8f17e07f74520fec636a5432cf1203b4ef58473fce4e84384be6e32f133cb447236578285f4e9788f4c3ce868b12e90907d0ba8f1519bd0970262efe8ed5761327d097a30e5c7b4cae28089f1efd0d908cf5f46273be66fde97fa5412f996699ce4d2d831e7b1df619bba325e083cb73799f69b2e735827835e29aa0c3d719e413773acced1579e8c036835fb2027e14a8fbbe96245ba18f8acc12fff5eda4e8e4ff9f0f09e415c8e0b8c0e0d7d3220ef681feb0357cbd366a415e93175c277e4f3896a6e40efd0b37b12bf27364c413f1a937736863f5d197369a5822fe4ca7179327f2e4c9f297758e7db97ebe58aa4c9cbd5ebc3a5d0f812f92685ebac87e7925ccb5b6dd3e56b9b52b6dbfcca3867d2b6e017c0fd895d78ab2ffb7af565ffa8fe7fd09d3ec00658c53a75353b809de4e72465fb61a6099dde3ce66d91be03117d815d0039c909dba09e0f36516b551653c8f12d907e2e5e192e706fb2d9e4716134c5b92ab782991fc3d20e852ee47bc01d395e25dc8c26ec495d0ccef90eacaeb7147829007bd674d93dacd792778ee93e2ec0562a999bf807db4bdcb31dfa32c27a156969f1686b40ff2bb8c7067a63865e2d1b2bc6d1096c37fbaac0be3ae2fada7a77346fae0cfc44764fe95aeb9e8d4d719e474b25e7c6c6ccdbc733d97ac5bd2471b47f591e794a6fba36ce4fe3139f1ef715f5e25b2eff2d97bf462e3fa9d3a5e3b8572ff75c863b302086cf2573f52bf98d133a25f4df223d5dbb9e4de802624d500397e80f75ff1ebd05ebbae97155ce7111f4967dac4714e986f1731575bff8bd4ba16b05166f83ceb6b678eecd0278e3fcd72cff7b711c729bb38ecbe869f358cfa40b639125b41aaf7115f793cbe087bdceb743abf34bf39cbc2343bfc07767fed21ca03314c171805597c7d7aef54dcc3dfa0a767ba5f5d694ab1bd3b71cc347df8e36efd5f701d3c817d983d2b5d6c4ff3f18fbe13c0dee30680e7261f944784ffa792e6307a5fc3c57a7f9e5d16f54d8cb2c676fff117deec48f74997d2071bdd521567afd9e0f240efb15f6a0245e5b13f7162f2d35855dc07db37db9451bb288cc6bc72c9ad1fcb9307d2a5f4c71bcb6a6ec97a6deeedab44e9b7e7e0cfd13c9fbc275d6c473b1defeb1f82e8e2d7e26da2ef6a3d58b3bfb497fcbd57be1558b297e22dc57f4a7d5dc03f0bfacae8dfbe2d8e227c279891fad1eaea1065fe6c2fc9873d91998187fa99e4cd8762ff14b79ed5063b8b5ae0431ed55f149b1703fe589dd88e36a062fcda12f02cef1833ee81d676d3447f15d7160bb06c7dcc7280f5f6d8e8ef76012ff6c1dff15c4fb4e68e552f9e429df45faf78a34d614e77d99dcf5737e96b926e36cccf9e897e6387dc7cdc6bfd9f8371bff66e3df6cfc9b8d7fb3f16f36fecdc6bfd9f8371bff66e3df6cfccf6de397de2d97b425b9cbe4afa4e2c995f237704d78d15dbbd9f9a5de795f14e8d714e5b390fcc68eb3b7e421ae89c47d549edb9311859e7b13a8a58eef58871c193437fca85ed264a02f308d6ad6a2a7e04eeff3a5f2408eb4de71cf75ca549d6fcdde829343bf448c13dc8b253abb712f9654fe6ebfc31e6caa7e87253a30bb81fb8d49fe34f4c972041ece73ea34af9cd07d5e0f84d367c518e7bd42de2feea35096ef8fe7b8486f41ec1fab90bb75e623bb24fdc3f767efbf34bd1ffc19375aff97d2fabc5adfe1e9b10729e9d95acd1f2c74d98d2eb7e08e0bdc5708ea3034c5c13df6c01fac32eded6c12df379fe8a74572fb8c264bceaeadadf3dc5eefb0bed114707fb2645fc15a752fcd18e6f4980bf9866f7d8b7fb56ff1bfa51fafef38d0e7d50c73e4c507e589477cf415fc5d801ffb9d7e80da3295f0ed656a0563ddf5a09757d2a1ef6ddca7665249b6f296bc5b0a1d67a9c99663743c3b8af344b2d47021177a1c583ef22c995bd6938fa7b9cadb5e4dfa4edb24c5b0ff791a2fbc200e4ee35bb5e387310ce971d9f2efc4862ac8c33e975f27b1ba2ab047f73bc934e89d788e7e872573463d89407f1478eecdf4dbeb994cd582bd703d5574213f115f2ade7f36d6cdaae9cb6017b6a13f31f42d8ce7a877fe27625fff80de5ba2cf1ecea1e3f9930f67f5fd206b87fb88d464bce5ca670fe8a8a64bc359fa257cfe95d65b33eea23208dfe19789f7b2f590b1197b0b3c4169ef7caf897b0ae6e422107eebcbc38d46f0df716b9ee54d16cf6174e837831967e3d21f6e2cb945e5df315ea62f24c65f2c5706784f5881cd738adb337dde3bf0a94d9eaf26c7bbc3c0f0ad25d131639e5fff9a7c1356996ba8222bfc6150a1a6ff4957aaf69ec5ef5b0addf1c622f9401a236db4a6e898bc13dd9d06b992e42e34b0efb08d0e380c8fb67b3d1ce0398be47beeb99dc547677b8d8650338a2ac14f9eed1fee40811a3fec7f7040a734186b6329838fc749337bcd55f4b94219704e1b2372ced823a6ed55e483c3188055f6d008f41b0deeddc0fe60c8138098866927ceae3abeae2d79ff4acd5a5335bec8964f19bc6134a5b5d515abf247fcde4bc1fa96377f95bd263919653072246fa2cade9267dfcbcb71de431558b6bd02ff03f1fd9dee3d1bdd59eac7fc7abfee518febdee9fecfe35c85625d5e4ae45554ddff38df31ee37877bc677c7c8e0a5b8bf5a9dfdcf1e73a13b5813784fc216d148f6fe8b608b82af4e5306e99ecc8c6a93bc8a03ec673eeccab29bd89e310e3374d75998473719b5df65f70efb2467e662771a45fddd8acf1d69f2528de7a4a9b78de449d7422af4c301d90f36e1335ae37b7f7c8982fec39a3224beb81a3cd9c46bcda7afa30c2dbc9fc852c4e04cfe808c0c4bf0e09dc25c85cf9e6d62d7daf88eaaae0431cb537bac0e9fe1b1a7735791b1e48eac92bd8eeeeb4a8ccbf5638e92ba6317c6e13be9486d3cbe7bb6a9e3bbd2d83dc877e897a4862cdcb9e669d01718ec179fdc5591e8eb03be7aac8f76a58c7bd68a7d0899f78065d3489a0f7c11ee8d0f8c521a20cf3156a82aec429707f638b906b7129f447e94e89e036482dfcc659b3a8fdef44e747f30b92fd8853bd875b93d377d6e0bbf0ba09f92f305d3203f247eb7e1d292a1d737bed70e45f72b49cbd897ac2b83d3bc761bbeb3e416a12fb20fcfed9711c50d6ac6f4c83a7f14e9be79be8df7f93a13fef0129d61927bcf64dede7413f7589ff444263de9719cdbc4cf613a4fdc39b2b52d067469db3664ae25f0228af20adab81fbbe9e2bb1e4f6280a06b3e27ee49bfaf677ba5eeddce970bbf105f8af73e392e0bd745b1e8229c4b868f76d01b3c7dc70ae6018c6bd26f03e420d86739b4740e738e2c8ce5c271ed8f8bba794707fd2b254712f2f29def2563b3d65bac277d70ceecbfb27f6ae22ef67f2267df3398c157a953295d6bcd1cda375d39e627bec7bf9890a7596b72cd7f265f312cc903fc3cb1d043cec2657809ee3954e5e1029f93d7eeb7cd888ec94c99e197884197aef54be58a62f975991cd15c9970aea70f83633e76353b2d212fb00d6332f84e12ac6f682e8b0c7f64438e39c4c9221b855b6be9fb89c04740abcd316d4676cd99adf09e9e684939961e5749bfae123b2cb8a3b98c36c8d80c7e2fd62b3f36e657525bf07978fdb27d380f76d8bbf69221f4fabefcc088272ed4f73ace172ae6f12857a7a2af22d414e2779ac73224ce011c863385a5d27c8ae61abea7d842862f851077015e871c40b0d512f6a76d29104b33a1ae7ca12a38677ca931ed10eaca8d9abc4ee0cef75ffc820f55957773a309b50cce2a8bdf72f06ba7c755b6e9861ac0cf8c6c75ee1d6ce2238ef01de670ef13a3c9bf6ac7917be3f260ca794f6ddfeb619e33be730cdeda98a0e3f1d4a5cebad06076a57effa9df5e56f46b3c133d14ce3347e88a1be82f8ef363fda9ad2ac3bd2e5bebf82e4ee89110f513ade507226bdef6f2f6f1e683b89a0fa22017acf2fb4fde91b17e4c6b83779e3764ec4d77f80cba43aa86f1da38af588ff879707febe17debe15dd4c3bb348e7b76f7899d3cdb2f546783cc26bb31e643d0eb515f217dbf4b750ab87bb4bd3619e4828e30cdbe4b245fcf3ed694c4bac45e534638ee7e7a1f8a19e29c245203d9c279a8aa3282ba1b464bdfb912183ce421467949a02b409cd9900ffaccb19739ae9700bd880d357907ba3ebc0be22e3896003dc671bd4f148303fbb57ebc2513afdb5e9e0ef00b7acfb1affbd5efa88be8a5b0f7ca27ca9b4df68ebf908c28f69d7cac9ff8d6c7e1d6c7e1d6c7e1d6c7e143fb381c65e6787f29df5dd287d47173edf429d139aaf82e58a14bc6f21ae80b9e0e7a010ff915d807bf56152dd0de55971baf29ffecceb1df5dd5e7f6da8b4a95e953a3c37315e21035f36e546588866fa73e880bc5057c2d3078a9d07f90f617c2f3d3ec5aba029df1e5583f87f533cd47a0bbb99a0cf7aa4c6d5d19d916c3ed05580be451cb235b5506f601afddf126cab3c139989ec643ec05ad4ffcb46b81237950713e288febece2bbcd9b3ad436e2f740ce0ff4f5903ca81f20b969be2eef90d0b5169a7c4fc647f0c23b355ec23526e777a117d1538a1e727315fb4aea9e9e0cb92392fa61fab8efa57b15d53b939cd8cc3567c7c9087f426f76a24f1ba93ae8e33ee238372379a97caa2c98abe4e7e18fc8a8f28e2678ec159e05a9f7d48e651ce6b9941d9629e3cf65e2e468536e7be57b91f42be3be062b1c5be7b910fa1b18eea9bd93d96ba2d4d689d75e2063f2ea5ae04e0bc7e0dbf35219c970942aef365a58f13c00db8ddf21b549fafd322ac00ff50f1ba35bb776097a7a404d8c5904e32fc851e49d7d9fc993a99e2cd579f21fba2f3371076c9aaeabea2e0c8d2c9e8b7220b3f43edf0a8da6b4bdb63f4c6b8a1b4bc9f7ab7ea29cabb9e9b7e9cbd95ed81f02319c6bebfe983e4dbf9ddf67f413e9ff07397331bc8b8eca2c57503304351279b82fc1a1abcac39f7d998eea8adc779d7ba06b16f3f965629b07ba4daee15c1ec6f4b8b5c784272bfa20c9fbb12e72a02d33dce23a50f043463cceee717e3c7fa4fb9a79ef311ce931178ad7c77902a9711967e72415afacae774b722b3015099df60203fc44faeda14601ce003bc7875957877b3320a73e4fa6a7f5a127f26c65988a7a74992e1bcdcd406c38ee85fd65e3bbe01776e00c06dff995e57534f7e40bc48c9aac63fadcea5239acb7b8f4d5e3d2406bd4d5710d7eb1af816bc79c5f2cde119d6757cfcd8ef4fc5c1ecddbc37f40f72bb30d6ae23ba19b5c97be2be8b09f87c699831ef84ebcd7d6832f1b873fd855db32ffcf534c0fd5fc3fcf36e81e963ca42cacd39fde457dd061a07fe0167ca1919ed79a63df2823bd412db4d5f5e21e8c81e5736f33e22f8075f45d96839a0da849559b12e4b4b6708faf2e1bea8ae6a84defe877217729e9b17ff4f9e057897da4f11de1ee6c0279b7e3c004ff8d3f8c63f18023a85385de3f903f50d78f73c0f3c97e2769f6e6c739f1e3a8ccce39eb9996e0f7981740171c91672bc3e4a1b5c623f003c2fce93e0c4dc18ee61e82ed12df8df3d1f6c781b733e56d89dc3cec73061e219606b112b0e1af2dcfa3b9a9afe0b32ab31deaf94e4aece80fd55b4af4dccf84f34bd6c4a6720eae7d774fc5bccb4f84fb6a392535f700c7d69ad7c67d71fee527c2f945eb5641b65ebd5eb5c4aebb00aeff3f7bdfd69c28d6fdfd5dbcce330d187bdadc05a378ee314610de7aab4bc01622a8d5a2a84fcd77ffd7da6c70039b932176f2b41753d349807d5e7b1d7eebb7ca9a6b90e74c59731de89257b741cfbae32798f3403fbf70dea3776fae1cde64dfe6e5b82162ae937d6b48f73ec76843fdcba11bc9e7d872d8164cc068437d1bf21d889555ee2a9bd9aff9caa93cfcb7f2cf725179a854ee2ac3993d877ffdfbef5d65613ac64efd4b5bdb5ff4b5b69cfffab2de6a336b693a0f5fbe8f1b8ffd2fafdbf5eacb5633e6f6ccfbdb0f6d6d6fd6abf9caf9e1fdfa2f78045a30573fd7f07f7deecc4c6b0bff5c796da5bd7857d99aa779e5e1fe5bb5cade55ecb50e3f700cfae70fc7441fe018eeeb7f58e63fecb717967b60ff7ee0ea7f55996afd2b5badd795ca5dc5dcfed0cd5f95879f336b3bbfab6c8fa8f9a7f9bef2f0b5c670f77795ce6a5d79a87f65fffe5aafdf558696b95a561ed8bbca003558adb2dfbedd5526a65e7960ee2a02fefff4c78fcd4c67d0bf9f75f81a73571993fde5ad25ee3f53ff0a3faeb5e5161abaab3c3aa60dbd18cfb5ca03fb779dab72b53a5bbdab0cb7f01beebe5affbb56e3befd7b5719501efd765ff71f0d86faef5da591ffd1e98f1fbbd56e3bd72b0fff8fb963ee98ffff2f2cba31ff05dd7a82f9aa7c71eccd97c57c155feeca5da5636fd6bf9c7f668e11da3ac1df9fd69af78797d9afc5dcf1fefdbc5ee37f0d668e66541e563bcbbaab8c9d99350f1608fdf43c9fc10e40cf0aeb9669cdb7fed35ecbc18f4ff34df0ef97f9d6893c0dbf8abc3158eb3b68efbf15dcfde4ad8e7a6aae2a0fceafddfc2e322fa86b83b5eeff6a66397fd96b1dfd5a9cffda9a6800ec5f5ced2fa602b3bb41cdf9e72db5553c9d054fdabf77157de6cc2a0f1590279edc1dbece04d191b13c9fb59f19ed69bdef731be0ca7770cd1ba38feaf5d4979addb2f506e30e8ff593cc19962a355fcff62991eb6b1b8cde7efcda3fd65fb56af7b56fd7365a75b453b83ad747f70fbcdfdaf64fcddda0c1fadfdacd56439253ccec824decf1d160fbdae3ebea342d47130e16c6ffa0bbb9d31ceed529bf576dc451257835ad475f3bcdcdcb0b73bf98e0b19cfb9be127b1c377262933e7c7489c38ddcf35d2519e7a10837394e9b3e17123e4c2ff409c99833c4ded18f37919b25d67f580a34b3455dfffc0dd2f64a9b6ec082d0eb83de5734e49520c90e0f52a96c74dce45f8bd927c51e47c3eae8bea0f810f246e83775db436d3e19aa60be4d45d59e011803a23f43ac3ddad3c1d327d0938ea20162b2e2f1c07e53b147d68a558da6a18f8a48a8f87783fae1b9a6a75f80afcf6c00da071412cba57d48743fb0e65ee8eca54d9787f67d7b3e99051abdd5ad2fcbd53fc97e8c327881f54bb966e5ba732edef1b2fd86fe3054b978def3bef7f684ed62d1fe88af9407b55b8fabec6bc1d1f7fae212649d75b2e986bfbb0f76bf95d3b5ea30a755f27fd0c327cab72beae5ed63ee78ddf14b3c1b9da9f60afe3bcfb92ee4d32977eab8c5957ceb843c97d961a7b055fba158fc30d68fb240b2f1c9b7fbc178443e25e483c8745c7e1ff97b52e617e0407e750a6e937f4b6e06e81d85023659f50c640e24dfdf345691b7cba8e3ced26f389648f3378ff0d189a58bf0083ab375847e53c8e251ae69cb08172d95be5e074d270f8142c84dd7a9d1d79e05367e2380f3a3e7984301c872d9c25b87730fe3ce0aad48ebc013c9324bf1c59bb543b16f343c8a88f6c521f4bf2459cf3fbae7d8f12f3f419eed123c1454f91d1d9f768a75d4afe45c87e4fcdc1b008dbb969005e6bafad968b675c9328d79e87facbb8d61060c33c3e9816eb61d15adb8e70005f28c2fd68b6c8cda6e06ba99d88dcdb08ee89ac2f6ced20bfd4931780e1378e2a57db43ce2160fc51cd6dfbd9d2ccc7f56c5cb3faa6bb50a5fac9ab7d0c5c9023c0bdc118513dac73fbf70bc5b68e5a75b429981342ce6df8bd92f242c8f5699845f51fdf6f48d17f5661bfdd457786e76f3dcd84045f9c0d78af91a34af525ca37b9d4c7c851bed366d2ee918bc643be1f3fafddbdc6c11e6339c8c108fcd38feba27281f21deafaa4ee8d77d69bff48acd30d6773459ccdfbeaab177199f8be9dd43b72e9ad57aebb90ce8f86ec6b745799f07776af0b1352072ca4f3e13ebfcbdd333bef87f8fa84637c6bd02d358f5f2da4c3d26d486a9c8f07dd40b5b7fe3c11f1383457e4cfb666b75628fed9785ccb8089975adb7e83dfa91cd499023dc3c3adab1cc4dc80cf997a3e126c2d5a9f71dcb11857c75191dc247912b22f26b6b8f57d44f9f1d6717e0e8297c3e7c303eebaf56cfa08bacf1eeab503ef2fe26946b86b34e7015f8accb576981765d711023cf79ee4c6f5f69c7eeab479565bf17b2d8edd2f8ad3fe53384d306f7ac2f8a87b9162072460997cbfb0cff11f9b13dbf0646a3979ddc9b1e36c0cc06426b116aad79c33cfdbab730631f68917d787359580d3da4035e608d95005be2052372da8d387c6956243077916916770dd352cb36dd46747172c4a9dfb585dd075efa9e30e9ef86f83d7913b68bbc7ef4f8feecfc6e2f07dea1ea5d3a3f35d64d683d696f9de62bf49a70e337c3574e9f4887f6e9e862f5a6d2a6e8f438bd9f4c64b67f0d47106afcbcdcfc662dd7b6ad67e3e75d54183a94dc796f7ffe9f6f8133fd77be2d5c198a94da7eec16b8bfd36b0b66effc5d07b63eb5bafb1580f5e2cfc3b4bef89f0374b9f9fbaf5de18f5eb30384daad097c10bff6df0d2d9f4c70b573a35d1b7a453f338c5effc7c1aaa83a756deef32c3574bfff9d43cf8ed40bb3f5bdbc3c062f138dcd3a0b1dc888d059aa7010bed3d1efcf67a2feb35fc7e28e2e7c7cbafbd16fc4dd4f1bc6e7aad83d6f7fb705c7ee9b536dfa4e3d6f1bf01e3fc8eff0effee8da3764816d6212ef75f907f8c27fc633c53f46c3ca37ad29e2fc9db6748fea3be7404bf2ffed92be653caf4dfd1655ff899c8dd09d81f65cca27146e4a9a9099e3fce1fdbd45cac07af8fe82c0ce12c3c75eee17c84f732ecb1d1fd747a5007af23ee7b8b55074ff8e797013b3c59ba74ea70d3f172d31b33eec0648edfb3f71d3c77de6b4f2d7f9fa1fdf5bdc56c7a5377dd0b9fcd0d3e377f0f568cbff7e14cdec3991ab4ddc3c05c12e70cfad7c5fb8ffd3660b7ee40ccfbdd1107676af0d24c396303b737b6263defcc9f507b4fad60bf7f370df83d9b7246d6d396bbc3cfffeabd7c5b4bab83da3bb27e9fa13f5fc9be75a371e8243c4efc8e6812329cb08ddd5ee69d6e21bfd1f99d730e1da93322bf12f8a10ade07897da1eb8e119b82e2bf4eb72f30c6af7d9eb73c32e0851d3e8d26c8dfb5542477716eafe0798ff737cf790fafb9fdbc978fac3fa72963257c7bcdb43c4afa9847e1f50de751b65bac2c1d7c998a72505533fa0c925d05f34e83b1bd8bcd45ec298a5f9d6a373deb526bebcd9bcf75e86ce02e001f2b815d8ad4bab82f600f85f6345ee3f07a25d938347de9b29c8454de9ba0dfa5c4865638d72f5d5e057af23c1f8e95efb4714d99b6bed18505d8b8886fb3df407a20d824ac2e88cb645f7890b7b2edb421a63ef2ec5cc1e35428aae742ffd3645a42fcf4cfaddf6227735487e51a708d42dcb815af439c2ecfd07ed0aabc81628153d15005cb9e4b677e2315f1a7bef5ec0e4137cc3916ffd9dc6b13d4e247ed7bfbd8b3ebd15ef5be0772c3c7e3949cf31e932d809b88fb001f0fe17901bcbc11b77d9f46e1ef8538cb33751a3e9c0f9a43975936f11ad7312f29efca920535f691cc50ab3cb6d3dd8532355c15b813a65e9ceeec132d76e74778c5dfe55ef3c6944b267c97e37641ea7ef36508794eb4b678d4bc7af4c82e9a49354641f5cf470b7d3af4ebd8c3de0c62def3b18bf853b1bfd1afdbedcb8ee8fae5d787304ee826133fb04c0ceabde5188be53f5b5c26063a9aaf0b10b26f86781d868c32ed42ec7d231fdf3aa6042ee2bcb1a937f2cdfb67be1cbcce19bf99ed5339e32dc37392b8c708cc23d4f44775ff0dad3adccb9c65209d4c20f86b71dd9ac0571eae4753d09e20c7e5f692d6f9a68b4575b1d65201bffca57b9b23de0ff9f0f0dd2cb1c64c721dd8ab803bd238ac0f5c0fa70d676ff33938bb440772eca8eb74416c5817c4ad32e5d72a57b3fa528d552156736dee91aad77ee2983e1476d8d8ab3695a7e812ecb038595e9d139ad5ec4fc1c590dacf82f39c8eb57cdff94ec7cd7ca4bd5d6a6e1369bbd5ae3de7b77a53b77a537f54bda940773a966f0378750a73db018b4932262921a644e8f4d34180dbc2f805bcbffcda4367dba0a00f96f866464c21c9b7b0ca59e713fc1aede5a227d48f0ad7dacda61bdc2e5993f37e936b6e96cd45242f0661be812fb4dfe02da8850fdcf21a67ed942ae09a3a8b9e00b65246db9e7f30a8f5a2db93454f6059b5fdbc01be8b4e1bf608f8c0079b4236569007e6f6926c8d9b7d15b1af56c15c5f5beff6da4dbe6f3e8edd93ddd7827723f0cc24eca5ac7ba3da4d5ac7986cbde25afe9138f290de74e3ccbc7166feaf73661258fc4b64d77b60f9cfb54e23ba69fc5e1ec928f6c38f09ac7e2ebf31a953422ea717937fae75044f0e2ad208e5c011fc50bb4bf4164fd70dbf97e31e37619e340eeb11a9baa1ff5c4edd0f7fb78f39e0319fbb01dcf333a986f1af34ff7b7a1c524be847497a9aaf673aa9b1c8acbdea8f5d82785602373867ecb5d5e86ded44be11d72fba7bcdd6ded406d4e74f1d4730566b79f55c5fa2ed4439f581ecf65cfd2d7a8755bb2b59aa6de6f6d9e6bdf63a787be473ac41665f0bceff6f8cfd40fe1ae48f9f3ec3bc937e9e72eca0b09fe7ea7bfeec37fa2cbc6b513f5249ebe0c5f5fa921f2bbd3ee703f0afcea44f111bca8ad7169bfbd5b3a50aa23b13ea3b65ccee7489357f47ed3faffdc4317d245f4c7abcb6a0ec17c5eee0da7b5d9f763f454ddcd47e169ce774bdfd7de73b23b6f891f676aa1fad60dc39e46fb9f69ce78c297e9cb9cfe94f2bb806c8ff72edb9cf882d7ea0394ff7a3159c6be05830b4949873c61d48bc5f1a2713d8ee597ea97f345b3754dbfaeaefbd3c3ea911f89b227623c4dd3ac2c15238e045403128663e7e5c031602d506c4b66b6f1c601f310e1f6af5593bc5ae1f55898e514cf55f71289e119eafb2f0e4a4ef22f2f77c7b0cfac6a27c0bea5db61aaed56a9779531bd5c8376e36fecdc6bfd9f8371bff66e3df6cfc9b8d7fb3f16f36fecdc6bfd9f8371bff66e37f6c1b3f8b6fcd0ed99217d7c7a46137f2e09c7dfc06e4848f4318d1c81ad2f1a57c8c17455000136c04785bf371ad55c5579413099ce526ffcf6439ec3e3796904b0d1c68808f068ccc46e16a5ebe24aeada78c2fc38064615ede800339eff5c7755ca70ce5f916e5164ca97fcfc911fcaebb38db54ee02ebc0a84ebe5af5f0d3c093258f79749ff7cd421c08d1674d7fce1b66ead94fe551c8c2fba336cae11604ff581eec56d44756eafe47f8f4c8f7cbdeef677fc66dafffa17bfde8dfd9e4fac5d787e020f57872f3f983c7bcab72d60e38f510c73ecac310efe167c05302764f6b2fd19a45387731b6afeefa775747300c8d5b2cc01f8cf8c96c9257b050de8b3fe6f03b25f9866fbcc56fe62dfe53f878d732f0bc4e0749f2e29d70e2e81c7d067f179a9f0bfd00c5652aee4b29b9827efe92e9ebe5f974e84ed38bd5e491adcf2b71ab361ed7aa20ae64c9dd7404f4ae274ba5fbc54caa2d675365a3b445436d14928f51ac72b29c4cdadf219b247dec2fd178618973108b45168e1ffa63707bd9f22f6243a5e0b063f22b12efcb3376afbe1370e3de630e22778171dbf86724ff0db9daddab2bcb2934f66a6a7f92f418629f7489f852fafa8f02dd2c9fbedc69f37bc44fdce08336fa66a1fd4df4edfa7a6f863e1bdc43c4fd933cce82ba297092e9428b88b75cfbee012c67c701fdeb53f8fcf3f5b760cc6bb84135fca8f39ed51ffc2e6d6d91cff362ac00f81fe9b18d957fded857957bb62ef471b96a75c840cd7199ab2fe973d97d55abe24e6f776b17b641bc5f1a56069dbdbe043af624cb1fc0077205d948b9e5f809ee0f15eb98fe9957cdb7c9b7be44ed430e59d17d855cfa4cb9cd59cc7c9c4b663fc1f7fa0d9e51abfa0e73c65a2aa71fe529bfc6b5d38e8aa4e35a688017021b1dfaaf11b67ba139c0634896ef89f736ed1cc5d7fa057835957ce3c7cfba7e0d14548f07fc0f32dcaf427da556c5d315e6e444ed731e7d2e5506c4f7c604df339de670af08f9ce41f00eec8fd6a63b419ce9cf16f687219c005a2fe17c7715f1759def3e6a9f729d0bba7ca29d8d3a0b5cc979cf47f0dd92c62a27b59f4b77f7f9d4d2c7f88c711379d6163f7be9bef5710f79c6925efb81de67d7ab59daf57db2f3bee93cf6cd687ca81b60155275798bc455e45cff00ef88f9e64cc419cf28d2c1f2f9d58aac3ffd9d90fef4861aace7790f8d0de92ff4f51f832d8aeaef88a70827b3dd69635c4530f6a80f3bb7ecc673ec7f87a2bbb6b749fbe692bac31833d329cb17ebf1bba5df3be26499efcc410e09969d5545427c380cb609bbea0aeafed42cbdc1bb708f605f5c913389fa9ab2bf02199a5a9f68251e67515d10c5490659f3d08c8c39cf39e33b6dcfaeedb4c1e7facc42cc326a8f153967e8dd632eb9141e23ae919531c68957afcbed65fb319ba4ee88ea7ce912dca588b31fd59ed5c13700bcee1cc877a89d3058cca683852288c0f90bf68b5fab82e0f511efc18fac983c4ba9b396e143a0d501a3ef91c839306750034bfa96b5071ade73f59566b7dc9960bd769a641fee739d9311c1fb0eb120e04cd0396323730b5c3fd8ab6521dbc03565ed956ad7d00463231ff98deadf2f70ef9afc2bf6bbbdaa6d11b8be515d3b658cea2b595073d1e34cb24e315cbb609d3a6d7187f797bf0eff4c9821ffd228b627fd7ea6e9be49be8dcb7c9da43f3c5d679824d6994c5c1ba28e7584131973d27bb13ff41cdae744cd916da7ad837db106dd793ee64d658c7832f6888f7dda41b51e233140908f065927bda0ed45d6dd4e960b6f892f49a3d8ef69739d1a8b4e997351aa6db4a9687584508d157406d05c63be0dd873608b24eca5fcb210cb05a2efbda2b8a340ff0ac911425e1e2ffbaeffee8d3ff523f0a79eeb35fd0ecc3ec895cf92a792d9d782185a99b3cef8c44bfc8bdc599e52fbb4fa3d7845cd4ec7017ea0586880d328e72cb5185d68bdce04b8279faf2dbf4c793ab486af9f22ef25b3af9f0b2b6a2dcbc28826ca84b89e0efe5fa3909d46e85f9e0d334435493c7d4306bed7654780da46508317d928a8ce0e599f087c043ad4e646f63b6fc66c05e1024eb4901c0bbf9747bfce133b4cabd19cb137f0bb94f39ea157be6bcc2f23b7e0039df5727938033beca2b53cdbc0176061bd335116ef35c60ba59ff18e87d5c9e7abd06cd1f73bf932c4c700be6a2bb00143e774a37022d4295e2952cdd2ec1603dc866a5581fa5846088fd5164fc82f28341733a17502ccb82ae87b0dee1e812978d6bd71a7f82f2ef7a1daadadc2d58f807154936c1edafc0aa1f772db742f92c8cc84fab223746b679b38982354c31cea3ee928c7203447587ee4b6e370ddb8c431257ca7b0efd56f277eeea4c34aad821e6d38a5e543d8f56db6dfbfbb5773c6ba9eb11e8ab1dc4795037e71c0c776ad8ed03a699cb5526d5c8b137124203ed1427e20dce7f0fcdc7c10bfc507918605cbfbfde83728fd477379e17d83dfbde90e1f4277b87178df38bc6f1cde65717867e912f1da270de26e2f29cf46990e5d9069a0d72b63d6f578bf33750a4babf2c8fe95ed83d569d16b89a4e8d9e79c125f97e04406d59f8dd643590d50fe9f9f03e9e1505b0ce4dd402d14b2e60ae090f4f612e7e2f096e6c5c1fcdcb4e399cb1c7418a41741cd962de8faf02d88bbe0b88d01cfe998f309ecd70be22db4797d17bde7cceb7e759f191e572af7cac7c1cd86b8e34b9211a9be9377f613df781c6e3c0e371e871b8fc37bf238e05a6a7e6cb39c3c34c287f4b84eb4d345ac7344f426aa6d3e027c107a97b7415f5004eba40907638e6bddab768b9b493adddf9f7e97fb7d4abebb93ec77bb6b68dc841b3632f4a965f05c8e3844a790bf45b65b27e5458ef9fc4b8a0bd833e960a5fa0fc2fe42019ea7e7d2a5e98ce7fc39a49f09ca067437d916a1ae0af863984e5b37b4230f3a25ccc112fc559da63faf3ca31e11cec6020ca602b5681afc468df869fb263fc138281f0feae5d9e1dae63a676dd506fa0e607e80d703be03df46d834c5b660ff556782b8c3efa3f941df139e2d946312af859eb69fc2fb2111abc812ba2dddd783f3871d62ddb3d66ae8e53b634c2cadcff438998f813483bafe42280f9a584788733b3087c9f8a4dcf83cbcafedd6564fe4264dfc4ef15886df4e3976184da6a5c88be8bcbbbdec33d48c624a175a557435a10ebaeb4ec17b186c09c0b2c09952a4a2b189f47e91322731cf25c1e68ecd8175aec31a962374dfed88bca7905dc73a304e790af90d75ffbc823d83f07a284faf284fcfb9ef17dc15f59dda5e3a90430a5809e85bd6fd38a9f296cc01dfc4603109bd97673fe0b5f23806aa10d39a35f8932688af80ad938f3ce4ca809f047ce5a70eec8bf139f70b625df26ae9d9d5d88fe17138b8e8f79a50df00ce0f6c6fcc01417e3bf20ed9d6c8e35b4635649b1edeb7ddddab05f1a62a57dbcec72cf9ddc8fb65c5cca27b3ef24c3e1d2db66723f228bb2fef6ce7a7fa913f10c6ac5c6cdfad2ef7ff4a5deecb3006d64ee6ea47b823210f593b42ae310b7e3d244f42fd8ceb4f5dd04535c851046cc074b0187175077c95b965b4f5dc786e14b2553691361d39a5cd926c00da7ddb2bba9762e3bf40278b7da31cdd8c32a72d5796ba1b55b0b27de5cbe81ee29fe4296fc8b6b5a5e502bfdf3e08f539fcad92fcd0b4be173ec7311df2029f45ec4e2e0b5b783e4b7d89ec7736d690901b0b1f6781cf4b786c347da2d19d8c96a342fa4f5a5fdf470f3ad7fb8ffd3ddfbde728d367c3cb77a1dd29dd8d3ae511774df2d86ad7beab73f4e953c4aadf348e82fe4ce20e4d8e57e5b89bf0f8d98d6ac565d320d66e0ef91f9b3f1c53170e8931f5c4f84dd171f8ff65dc93e4fa24ea88097b0b8f67a756e3f767aa3e19b259fd75a5b46d0f0d4d68797158aabe40f976d27d73d61fe3ebf4d4a43d9b21d3e36b07fb4c960edb44b9908899283a0effbfacbb8f983f3a9f8eff5f729f2036d6a08f37695f92be185f3fa7b46dca76eb757664a18e35e4295e78bf770d99db3a90630a7c9b97ddefe45d53cedd3e93581772b081870364a18ae2ff6c484e66f963c6f81b9d26f90dbe19fe460ebd7f7980da3227ecff389f7721248f17caaabb57517ca3cecc602e43beec82fa620ef9ff3e7a8311c8bb507f69f30bb274eae564755a21becef058d3fc8063de7bdfc79d9c737fc1276de80d1ecf8d780ae35288356d0f16b2545b42bdac8e10957928d6e0cf27f01de2f800c400867b55385832e48c70f7d1bd80f73e55ee5e1a07286c4395ca376993e720cb4e0a3d9b4727e6fdf3807c91e439c1f11a99035edfae87e759e17c79940bcfba2af695c2bb5ad53a513996d3cf4cc817f03e6723adce5af86c847c0b45e36b168a5591e3596947fe3483d840c35dccdac0cd70ef68b608fab909b1372fe6179509b9fdfc94fd518457977c8f9f148c4f411e97a10b22e0f16b1d21f083139cdd3c8b6c678e8c39926dbe71dca46df8b82eaaab07eb1e39db4932af0c5f4cf85c64fa85de170792e1e3fd38389072714f37cccd35313714fc26554efbf229af9df787d9ab243e5715268e9f3394b24ebfc7b609e28c2ce86e5b1cf7fc4db90e905bfc7c5439d72175954f94fb8070d390ebd5972c47130e5e9cb7e45c08125f1f9bff3cf7c8dbf0f9340eee887f6be4e36d627df7f599d83cbc9befba48cc22ec8788b49f6457ad34bbce6a1e9604f8bef680fdd14c9e980f8423d8298d3af019bd127110908305b126a97dfc0dfa3739bffc77fade48d44927801f0baf917eea0806ab4aad1dd4a690a56703e55a785cf2456cc79b9e78d3136f7ae2fbe889a971d6243dad909ef587e98b88a7b0da053f2ad8e8a7be1496f329ebf65bf4464a7fcf7e82366daff27fe7bb9fce7b317ec73f1e68cf66f9e81a266d6e5a5bb599240f9263a305c7e1ff97a53752ce13e1d74ad11b7f53bc2b454fbf7a2cfa329dfb719d3d1f57c293a5da60e6e7c09965f9d771ae444c8ebc137629634f90fb93ea53c6ebc17729eb11e91f55afc5b12f88a5187b1d63cc43675a88c450dacb332ebd3d0873ddfafd6917b61552ede9f01e29cb76a0ccc7e3bae8998ee9e9f133d0057e5f4795444b6fb0115c357b52a657cf09cee8cfa7e0d7bb780ce9fa63611f436cfd4b8acb19aac93a8a2432fd69106b4767a23f1d5a7a23db3fa09afc10de8f9ec3a45c03388f281f884331566f5ffa715574360f7e8ec1499ff2a0ff317e8e4176fc250b0bd7daf525d652a623e72c9f41968d1c8d8b8f21874e9b61d722591a704ae7f11f4c70dcbed33ac7f0df10e326e3c0884f1a38c05fd8e1d368e22e64aec5c8d261af1c512d36f08378796a6dd83fca4621f38108ae0605e5cd78f709aa97d31eb2f259075a84e6bd50bd04b7173fc717d5e8a4cd47afa81e12dbc337bfc6cdaff1a7f935cefa9ea544efc0f6b5e321a4ee2a2ea377d667b06d48bd539162f7d0ff085740620e6f4c2f88ad979dbec6a5710f64b493df26621107b3d2e0c7a1b3d1781f3c7fd6fe097fab241be6bcde01bf7dd2be0ae98756644e9b61bec409c9ff443b77f439e33b6df2fe47383b8f67956c8bc084418d3a59023e6614870acb30a4838e7ccc1f609d5855182d647b42d64a59cc04f1f8769c53ce795b86e689da664c3781fd6289bbd9b4c52a637ea308707e2027583c22dc62dbc77679b564501f8554cc75528e7c6acc3c6ebf24e5c160fdf13df7446053b88b5988ebf3adeb18cfd14ed4477263c3ff348c3b2dcf9d32d7f8bfdfe3d7a5ecb5e27769cc16a1dcd7e932f87dfd34e9dca08febecf9bc92ee9461ef14f3ffda878d6a3ba78ef97fec7d5973e2c812ee7fe1d967468be9691c711e2c6cc4aa1ec0d676e34687168c8424205aec27e6bfdfc852959084568c69fb0e0f1d8d414b55565656ae5f5e95d6b79aeedf5ad31d195b661d2bf757327727469b844d5362cd739f151f53a8c3c4f4048817e4d56320f935fcef7f6b77b5a5f66b325fd51efe57fbdb99d61e6ab5bb9aa07913f8f4cf3f77b5a9bdb2d6fa1fc6c2fbd35c18cee4d79f0bdfd05cc75e3dfcf963dc7cecff39f317f33f7dc39a785af0dbcfe5afc59bed4e7e065ffe0117c0f3edf9db02fe37272bcd767df8380fde947ddb5dcdb70f93dac3378aa5a8bb9ab73027b5877b26f8f87365a3db198af9f61f9afa0ffdfd85661ee8bf1e98c61f2c754f51d477aaaed6ee6ab6ffd3b47fd51ede34d79fdcd5fc3d7af9d364537bf856a798fbbb5a67bea83d34bed17ffd45dddfd504d79e3bb507faae36402f6459fafbf7bbdaab6dd61ea8bb1a8fff977ffe5c6a26853e8f4c781a75571b47c7cbb94e30fe7baaf10dfe5c188e5f7ba061028f2bdb83618c2746ed81feabc1b04cbd41b37735c1876f987aa3f157bdce7cffe7ae3648b9f4fb7d835c1acef59fbb5ab3fca5f2cf9febf9da9f98b587ff43dd5177d4fffd0756dc9afc82613d01c16a7faebce59fd3c9fc74ad6b77b58eb75cfc5afdadadac18df84bf3f2d8ce08717edd774b20a3e8f160bfc69a0ad0cabf6305fbbee5d6dbcd2dc49b842e8afd144031640d7f28b96ed4e7c7275f0e6f0cfa7c932fcfc32f15789abe1abc41d8385b986f7fdaf86879fcde768a4f6bcf6b0fab59edc25e88286365898e42bcd5dfde12d4cf4b538f9e5db6802f41f4cfd0faa06d45da2d791cd96fb564cce4adbec9fbb9aa9adb4da430d8e9b40d408338d17570a5653b5f688329e169b3eb33ce8ccfdca608405b4e0e97b8dbdba6f3886d7f2cc26b515f68d83c258ae2e3dcf8e4747c4d4f42cca6c3f7eebef1b3383edcefa5e7d69b0c3b5ca34983e886006ee6ff9fdc3f37ad0a4c9b3d6da5c888600ed2ea8dcc49501478bd3781952ad4107c43213c0b541bb0745c6f378594cc7aef0f78bcdbde0671e55f37c95d38e89d2586a2e12e1b132f2a4c88e9ba91cad78bb25b8c3416477f86859e1b6576c761177b998d23ab365ebac4811582913b54d1170e8144af686534556a19dfc110a22d35c8bb498ab56a214a545fcbe7c1a171c95e9f4ac7ebc13d3307214927ff3606d0cc672334dcb42b55104b8f48306708069eff07696c10e57bad47090c92d0dcf9b0793f29c1473d9f400c2fafcb044f4fe53f5acbb311868cf4f33aadc3dbcc36c49794edafa745dd373d1ef7dc98236581b63ee5cd72dcc1ec7f015546bc313194d16b2424ae7b87b1d9d11681c965eaa5777cb8f36cafe8b407f178fb5a269932f1b3f94ee0561b54f944671d1f623b7d617d76c7dd1f0afcfd7dd4d46aba2cf466b485749d75bcea0b5ce3766bf2965d4d719a2937e0519bedb84bafaa5f89c6f65bbcf3f3285a3a00cf213f13a695d72a17333de3aa62f7356c1195a2294f9af4c95cf6a3193bd4e19ef021ed0bd466a7bd79c3924e0b3d139f2095312888d79322e5f9105aa2fd11b350897a6b498ec466ca032f6d6854a35f360964e43aa4d45127e7578da32dba3d376865961d338140d4eaf26ad93ebb84c330acb1e6b9f74a8089562c318fb52c6182fe48b3842f95c3d9416a153e6feeb95d853d73947d9488ae979e7e83b4231db5e9a0fa128ede6f5683b4fc73cc0f534f69331d782b4fec9b81ccfeb3607a900701f6efd2080ec80ef96d09a1b7c456613952eef55595d225fcb7c706c0b9668efa9f28d998921cb35e91e526591bc00281daddddde85ec3d71913fc8b285d560588aea6b5ec7bafeb7e935be9ac4041fa8ac280fdce41eb6146931ab4b18fbcdfe63c4dee1ecca653ade421e61b89df77a1f4e6e8faf4aaea3fa1dff054ffb1e37ebbb3ce8cc0df2a0b8b2c5f9cceb70e66935ee94c901a72ae8f31ed396fe3bc73e4acf944cf98d3fdea611fbdacbac61cfb974ee44319fd3ae539a9eb93c71b1fac37df529f6fa9cf1f9bfafcb1faea79657c68fd8bcec89760bd4a9d85e96973c8be466715fcae4b0d4a1d4775c04a3a1f1ef3879c3d8b901f52d6271a93ea78563d7896786f36a33a6c05783ce7798a7c8f7b42a7633c0ed12af6b7ba572433f8dcb42c851dd18ab4f53b7c7d03f629d233a09d69bbbb41a974bce8f452f747baad953a661c77c4d7d286e7ac622d56d3ce0c48cd2997b23956a51df111a58d33ade4c61e613a2a9e485a6905f34131ceaeabe3125483b19606d27d1a0ca46aaa4dce9e402b3780184434bf9f8abcb534f61c94e4a1f45cf85f93ea8e2681efaa114d73453cd7650753a32d42db86c3fb537783d6557df968a3c46990b20f83565b53fc8ef7d06c156bc195982794c745e04bdf37cff9c89db4b3e29fe9bc9866071cd3d24f9f05e94cc13e3ce1a3cbc28ee6c48e0b73005aa3a52e89d0366865344bc95204dd03b0f52a6e3568f2ee0a646ad04a2e221b82167551ddb4a24e1f9b57b60d7d4c734d8c3f5e52178c99a6b453a822f08b227866c29bb23d5d0c668fdb417bbb179e1eb76f4f9dfbc113f7bdf7f45c7f7beaea83265597c7d3ad7418decbf24e1fcc86cc8f16ad0f9ef0df2f035a38b8a674e830f2d859f6c6d4766053fb1f63f77baf395d0c5edcef03d7dff65f5cb32706ff4f0edd06be6e3168f93bf8eeeda915bc4bdeeea5c3e3ea478b5af6e4eda2d79cee7e90ef446a897e13e9bf06736ad947e37a3e082fc6bd2c6f7783f67637b09d55ef89d30763aa1e8caf6be27bbe0f687f3b10cb3e77c8a07b5e9ec97b56f0b77478decb781e6f4f836d6fecbef6023a1dd0fb9e5af87dd4f2876dc1f734b9bef7b258c06f824863ba3a0bb9b55de3eb7ff55ebe2fa4f94eefed69326698e7b7e8d8ba491db928d7e154ee0fc03fd6e123feb176e5bdf10c2d4fb02f29e03390613c1acb948c85ecbd8a3ea522ff5d86ec8b5f437276f0781d186f1ff6db097c6e771bc09e77c9dc26bde6743f08f602057b61309bc2fe88f3f213a70b4f96f9d6f2f7c293720f6b8cffde0d0eafec0f58e3996af6c6ceb2fff2b8efbf74ea857c07d745786d10f219e2af7bd85f6fcde922be375dbc6ffcfddb18f33eda9396f9f6f4bc7d7b7adef7c6917dd6f2770317f39fbc3d4887c75dd9e70a4f0aeccfdd609cb3c70e8fcb9e385c047b5e80f76d07477effd66bc1f762f61e195b86dcace3ebfde5df7b4b7b6bf9bffae419b2bf7f7b5946c796cc01caccc73939239ca80c3fdac609fe483dd345285d3fde1381138e3c33f02b811fa9e279903996acf33ab2bf8af28552f41a5c3e1ea15b1919f0f72b25702fe0ef6246aedee4c2f755dcef29e32db3dfe36b0e3ebbd332b6d3b98e22bebd71ac84a8d49cb9f8fac64b9d0c59b47422b378683fd95a27af09e6725fa944f038b70fb1b98e3c151b5376eba78edba2156917d00dc309688c0b7105f0b1467297e2656b7dbbbc3d14e769ac4bc6d72bcbc649d397ce81e88897dcc5e91a19f7656243a41426575e1de1e292e52299f62d6e671bb4de6c828d8b6026fd0ee881edee52998b088e23cb177e2c05dc4e11c46f33b07355b6bb31e5c78a720dc6ff9827d3ce2f69fdffb4143307a2382ec3017e4a16b68a54b1cc12c18e0294730bca2c77a6d4f23549ad23191b6995fddebdab30a01b969bcb105f5b7a6d1c77adf26e906b1342fde396d46c671abc5b00b931c3d05091f33aceef197234cf164b932de54a3f3dda9aa4d8be42332e1b14a9bed6d9109e2b5f4638ad35c4fc3320b052759921596316a0c644808bb134b94364c626a0356aafec4eda9caf48750bc7e9429f68c533dfd201ba31842bfb90730dc33897910955dbd6873224ba4fb6a60c709102b18ba04db2ab7bc8f745c75a4eb48f72bcdfe4507e47e06fc4ed7e42d9915cbf53fa64e84398bf6e32f133cbc4b0d57c89b988f8da336462a8a3115d2022fb168adc055fb66b78107b7767ef9dd3e7806ebf8c4e76ccdf2c6c033a88e45bc66992c963c79cc77e93633579b4e8f0ad83c134669a042dd387531deaaa2490fbcf04ce22f495c7e1bbaad913d179c5efbbe96279ba18f8acc12f7f2e6f47ef8ff9f0804f9a10976b2df5267d6cc58ff581abe56933d00ecaf5bf42cd0de81d9ab44d5ba77362c3942aed5c83b736baf7bad2e7e24a015fc895e3f2f8fd9973fa4cb9c31ab464bb54eeb02b8e5fae5de3c48a7bfd4be49be48eb31a9d0b722d6fb54d97af6d8ad96ef32bd39c89db825f80f609bbf0565ff6f5eacb7eabfe1fea4e1f6003ac884e5dce0ee0c6d93949e9792251a834e398b78571078ef07790931cb10daaf96023b5564531850cdf821dd638e5d362087e8dc9f871a1b3ddb922d597138fcc05208621df03b0269c52b441d0c7b1ba1894f30db0f27e871797180af000e335a59d65d88f0bb0950ade8dfd830d5fe72167cb655418af2cfa26ef6e01db0278c460609f38956c2c42a3c4dc6ef6558e7d75a4f5b5f5eee0bd9932f013d93d8563ad7a36b2dd79162f159c1b1b236b1d4f64eb15d712c7d1fe6579e431bde9da344fc6273e3ded4beac5b75cfe5b2e7f855c7e5ca77b0a997b722ebba836ba9edb0622dd6f1cd129019bea798af50cd2a201624d500317c187ba3f476f41ba6efcbe32e77817f49603d123f27443725d49dd8f3cd7efb4cda5c94f41678376473313e64b5a4aa4f9dff3e390db8c715c464f9b133d93ce8d4516f02a19e30ab7984adb0fb8a5ffbbde9378468a7e8130e8def50ed019f2e611ce5593aedede28f2eee157b0db4b8db7a25cdd189e69e99efbed68f35e7d1d108f7c9135281c6b45faffc6d84fcb5101729f1d64cee513d13deae7b98c1d14f3f35c9de7fda3df2817cb2c636d7f8b3e97f0235d661d705c6f15c64aaf8ff980e3b05f610d0ae2b515696ff2a2afcadc0260b2fb529dd6a5ae6b5c3b66c106efcf9cd3a7f2c5e4c76b2bca7ef1d5d95d9bd769c3cb8ea17f22799f3bce8a74ced7db3f96def9b1c5cfc4dbf97eb46a71672fea6fb93a165eb998e227a27d497f5ac53500ffcbeadab4cf8f2d7e229a17f8d1be687b8cc87dc8762ff04b398dbdcab4d69abc24bc57c627c5e93697b41b515c4de7c539e022a01c3fc0416f5a6b9d1d42eb6762bb2e8fb98f411ebec2427cb3eee96c77859f55c97f05f1be04af5c2a9f3ce6bb88ff5e92c7d8eebc2f416e71063e12636d8cf9f05def483ee366e3df6cfc9b8d7fb3f16f36fecdc6bfd9f8371bff66e3df6cfc9b8d7fb3f16f36fee7b6f18bf0d662b664eb32f92bb17872a9fc0d5413de8de588c6d7303dbfd439c54501bca6209f05e73736ad832909a82612eaa93bcf8df190729f7b638e36006b04e547438e8c3bd7bda05e12f7ca73fbe7e58014e5bcbc230fe4c8eb4dfb54a78cd5f956c4161c8778898826419d2f7a06c2bb8ee5eff69b5c6853f59b1cd681b98d6173a4953ae064591d1ece732a99578ef93e0b0321796d97d0bc97bbf7f371148af2fdd13b2e822d88fc632572b74e7c6497e47fd81727cfbf34bf87fe8c1bafff4b797d5e0e77f8f588418a315bcbf9833b6d6ea34975e8718130f6a10e43952d84b107fe6085696c27c19ac53177716e9fce72f8ecda4e35be75d09a9ca7b31d844f16c515ac54f7c29239c7efb9906ff8865bfc5edce27f0b1eaf675980f36aec33e4c507e58907fbe82bf8bb803ed333fd0095652adeb797a91524ba6ba89797d2a1efa708a7665c4ab6f2a6b4f33b4dcb5725d3d29bce34881505b254b721177ab4343dd731a5965f4d3e267395b7bd8afc1db749f2e7fe77325e78411a24e35b95e387640ef1fbd2e55fc286cac9c33e955f89585d99b907fd9d241af44ef48e7e93c3ef0c3089407fecf0ad99e135d61389aa34f7dcf194d185bc487c297ffd39a29b95d397c12e6c003e31e0169277543bff23b1afdfa0f716e8b3e139743c7fb2e7597e3df0d8a11f91128db75cf9ec011dd5b069384bbf84cfbfd4782bc65d14c6453dfc52e95e341e7c6fcadac29ea0d4339f6b204cc18c5c04bcdffa92b05131fd9b76c5b39ce5d03bf4263dd399513a2d3d61634a752abbc77891be10b9ff62b932b0f73a2bb07992b43dd1e79d709f4ef1f5e5e4785b58ea9ee9631d93ecf9f5fbe45b67953a8632b2c21396256afa9f34b92cf62c7a9edf698f3626ce07521971a3b25dcbe0ada0771ae44ae25e6860df211b1d68b83fdaeed56880de9927df33cfedb47d74b2d6ae0035a36ea9f9e36bfb610f14a8f143fe070b744a9d3137a63cf8789ab0e9632ea3cfe5ca8053de18e273663a641a4ec97d10de0373951c7708fa8d0a7d37903f0cf20420a6614c236757155fd7163f7fa5a48da9dcbe48974f297b4367c5b5d9ee96dd1fe4b9979aeb2cebfd65d61ae76414cdb185f326caac2dbef6dcbd4cf21ecacc65dbcbf13f60df5f72edb9a067a947f6ebfdba473dae7bc9f59f935c857c5d5e8ce455945d7f92ef48f0e610667c7be4eabc48f0d5aaac7ffa3d17eac11aa17b746e018fa4af7f176c51f0d5a9f2208ec9cc28539c5711cefdc4875d5a7663db93d03045779decb3f826a5f6bba8efb08773662ed6d328c077cb3f77c4f14bb93d27be3adb409eb44d57b1b903f864904df8ecae51df1f4fa43a6d84a5837d7115f664809997cd5f47199adb9fc894bbcb13f90332725f40072739e732fbec798aedda29ea51d516216699b4c7aaec33746ff2dd65642cee9155b0d641bfaec87d997ecc6154776cc37da8271dae8d47bd67590df54ae30e20df012f49d973d073cd51e5916b80fde2e15e15115c1ff0d5237db42da6f459cbf721a4f6014be791f83ef0bad0377ea917f200be8e31f78acc2d3469301d45c76097da27811f25e8f90b714fc04c6035de9d69cda07f30ee176c6bd0d34b6acc0dafb585df3ba09fe2f305f1202f60bf9be09b12607da3be766ed05f49f4892f599307c9bcf6297c674a75cc5f781d9e1b2f43aa35a818d3c3e3fc9ea7fb66f936cef37546fce1053ac338b3cf64d6dab4237dac1398c818931ec5b90d741de2f348cf91edd46440979e4e75a955eff05d37c82b68203c76c346bd1e133140d0359f237dd2efabd95eb1bedbd972e11df125b2f6d1fbd2689d178bcea3b9a87bee0eb0c1e33d56d01e40b4c6781b2007c13ecbe0a5d33967c84222178e637f5c54cd3b0af5af981c89c8cb339f8bef4d1b6fbe9ef4c139b3ff4afcd4482ff6df91b3efe8cce0abd4a9148eb5620eed4c938ff989e7f81723f2346d4cb6f17bf215f70579809f27161ae62c5c662f419f43451216e89cbc36de36d3b50ce69511be440cba70ac5f2a5714c9afcbe48866ca84533d5d581ef3b1cbd9691179816c1883413d4990bea1da9c0b3d7821c71ce264818d82fa8f46fb13818f8056d8116d0476cd89ad700e265a548ec5ef2ba55f97891de6f4682ee20d7c6fca7ecfd72b3f36e657505bf079f6fa657138433becacb56430bf9e971f18ec890be15e937ca1fc3d1ee4ea94f455ec5519fb9de64486901c40613f91392abe4fddb98afa149baeee897b88bbc05e871c40b0d522f6e7d494219666405df9429151ceb8af328d3dd495eb15f73a9e77b6ffe21d3e5445dacd75166a19ac55da7ecba0ef347e5f699b4e5061fecc70aacc9dd0263ed208f53087be4f8c2abdd78ec37de3b2e694f19ccabed7f03d27fbced2797363808ec753973aebf63ab32bf4fbbf7a0dbfa45fe319eba1709e599d767703f8e2283fd67b9d2ab270d024734d7a7102464280275ac90f84c7bced65ade3cd0771351f444e2e58e9e7279e91327ec46b8333cf1b7cef4d77f80cba43ac86f1da342f598ff879687fc3f0be6178e7617817c6714f7a9f4ca367fb85ea6c5c83e536fa5c00bddeedcb18f7bb50a780dea38db5c1b836e808afe9bd44b2f5ec634d09d1250eaa3c4471f7643f14638f7292700d641de5a12af210ea6e1835de7365a9f3908718e42581ae0071665d0af599239639aa9700bd88dbabd20e747d7816c45d502c0130c6a1f613c7e0c07ead1e6f49a5ebb697a503bc43ef39e2ba5fbd475dc02fb9d82b9f286f368a1d7f211991ef3bf9583ff10dc7e186e370c371b8e1387c288ec351668e0e97f2dd457d484d3bd34e7fc53a4719df05d769e37b7915f4054703bd8087fc0ae4835f2bb2ba54cfaacb2563ca3ebb33ec775bf15a07f545a18af4a961785d893844c5bc1b45165c6196f4415c282ee0a94b9d1773fd07717f215cff9a5e4b97a333be1cebe7907ea67a2ee86eb62a415f95d7a9260fa726d33a74602c90472d0da78a3c9886746d8f36419e0dcac174541e622fee3ae1a75d775a380f8ae483f2a8ce8ef4366735a86d44cf819c1fc0f5101da81fc0b9699e26eddc4edb5ca8d23dbe3f982f3c53e545546372da0b3d8f9f62fc9099abd897637d7a52e44e17d70fd3c7752f5caba0de19e7c4a68e393d4e86f72760b3637d5a8fd5411fd711c5b919d189e553a5cdb94c7e1efad765146947633af672cf82d8732ac732c2f75cca0e4b95f1a732717cb429b7bde2b588fa9511aec10ac5d6f9d61ef00d743b69efa4624d14da3a64ec393226abae056c174b4facd7092f423f2a900190136a973a0fa0b7a7053d48c16e847c629d559750b7a1a05a7a610136a6c150381719ed5f6782ea6addd9717fa3bc1e64979a5ecb37a5d785b177507f5305e7a21a5ec3af980b49e61cbfe742311c123b8add9772e68c633eecf2b25804bac9a29bc487413442322fcc5b85f59e66d8b555f7f54c873ccb34bdec748f3ce16b4bcf290fb7c5b0b9e0dd0cc40b083eea07fbfc81a77971adb6072bb2978bd673c48b5b55aa3be02fd1edf2731f4a804b87f066b6c73dd3815c60466756680d4dde829c6cd44be27df3da598a27fa59e74f91feaf485d371d133531b7abe3858a074532ddaf609b98b260a9ccc5f0424106826c873c75eada7e69d87bc06f8ae4aecd6cffc227f24f836c04bda3b5d4e717f341cd4dc97514acfb5d93fe2a2b027edb97a99b2f35de8a398b582fc89037457283e40ba48c652e409ddd2c598f5376ae0623fac1b97bf3df5cd37f735e9ce7327e159d6fd96a718d6c4437ee4cc93d89f7a7eb0a630e7c2a3355b2363a60fde11a70d0a935941f529f77da014ff7a1ef69c40f53514726677d829625f4c539398ba3b43ed5972066057e0ba839ecf0491ba28c6de5ae55de859e7c60e75a90fba9ca16cc75a9cf493d29471b736e03f69e22d59da05e0de794b5736c9a7cdad006d39a9ff84f6ef6439efd7030257aa5c8dd446cf338ef2c1db6f27e9e0bb40e36fadcb9549e574cc72ae2eb615c272a632b73c867ead116c69f0cfb15eb4dce9d04b5b807b42fa056907f9ec6f52e642351aa7cb246f97e83842e94e33b38df3f09f5746c397e8ed32d753e2569f8b85065cb55589152a4aeaf8e1d2417c0cf6830b19cd29cf957b5ab462eb241b37485cc7d52d9ef0575b9010ec365781be9ae2ac295288c07b4a3d7963daf0c2fa82f865c7fc05e067f0ef6e762dc93407e830f92f8c75412df8335c576be290b1803076a358f6747c5736d9e33870bf13cf8ba04f74c1b1bd32b9537ce8bf5a4e9c7a76b2b05fe542e21ebaa9fc39d3687eac60d36c86921fa08927127e72e925d214d27e34a6b497cc0f17bca9c4919bafda9df135f57be6e7c087226f0cbd5e710df50186ba349c0bbad95cec0772d1ff81630c93559752b62b81c546997c69797e15dcfda987b7a05f64716ffc6e8e30896e18d96a023a7e03ee5d149d67871dd6987f45dabfb2de42185f20fe480d9e42218ef8d35f607ae74b6fbde3885a5236c973af81399345dec43fd558cb0d01977a68ebf84cfa468acd5ecb13375308d6f2c4b60093c91eb4a9e4f588670785fe1da32c0cff48ca9c1888c0ab65ac8878dd0bf59a566249401719e2da35fd870d615cb29c8031b969d33f899290dd7d141ae1e9cbb904f88f1a1431dd36c7737582745f754d22fd9d4315d484eb9cec9f7a9f22966e324de9373a6b9e25a935bc01731cc7882d541705040570de47c86bd573a0e87fd07797d1de37cfe230583374fde86351713e9186331dae21e30dcd471603fc3990f310c546342f4ad20b61eaec964bc45fa17b63b48ec82ace5fa9d72798de547a9f91b2c6765e16da7eb2acf011eb22c6c153893db2d5a91764b75ee92380bc93958dfe26515e265d1f954d779433b30858ef9b2e063fda5ffcefcce8bd92fc9b174d11962ecaf1b2321e767066d3e8f9e85cfcb9473e19c98d4ad4ee5c3ea542afb6c486dde45620ca81ea1580f1ee27d189f5fba6e80afe540ceae347974e8f034adb7474b039e17d8eb01be597be077f8ddd26047877e930bfc6b905b278f6615fd30dbd4f195395f413e3128afaf0c0d829cc0b8fccdd00d440b30c15e68e169f8ba45fd4e709e23e45ec431d8f8e7e9ebf177f01104fd53aae9c7e13c127c11dd0f371d39a9234be0d7853ac4e7f8fa97defbe0376c05b86e6967bbe7ae0ca8a9bd762d0f83dffb15ceaae2b156d3393cda353cd3cde2d7029d63a5cadd7986ceb1d299d1523d1b3b1e301a5d2f035bdf5624e1575fa2039ce0347e2871662a5e6ba681cf4d1e413cf8ac1a81d35e6d109388f1f945ce1e4da2b73adb2dcca71f93ebb2f38a336430f47124bc1591abd1be76f200fc8410f7b574be31c7f8e948cef7e336e9fe882b89301d6d751cc5e17c5c447b4ea9cdc00636f80605b25c935abede76a6bad47090ff401a2e090e2ae4931fcf878af821210db7bd2c5beb1db588b73e63efee33e6ae1566479fddd38289dc9f727ea9b235d3dba2a38e93eb574e5e44efffddfdac7449dcabc5baa810c8e763beb6b12fa597729d677c2e00763a8e1d06ef140fb83618f04c0ea896249035be790e3e2b43ce9f0fd993339da1ed20e7edacf32d727f72bd8176ad435f720f673edb32f6f4d248b5d9ba335316289da1cedc07026db0a38d9ecea767f11bf8a915b009127be754a71536ba04fdb7701cb9dda553f4ec2cfff85348ef08dfe17d08b16a56e3454a43794ba8b66da1b34650471f8e8f5b99d28ed264ce57c78f0b18b701f748f5796fcc315013416aeb895fce605aabe81ea9c6bf64be2773bc902d01ba84b5399529a9fb9dd08ff81e5718672a5d46a79e2b10870cfc985ae0e38cc80ed4cf8cd6f9e154f15e018760adf23bc03c7bdf5921d5e9641d0cf907fdb421ffa02fa94b637e22034ee461caf3612cbf5449047fa299a6e39d55b794baf753726b005b5c1e94e37fe97e3a94072bd071202686e3b0cb44af06a8c73bfa93dbdd8dde1e201c7d53da818dec76da0265ccc599d916416f45353b101bd7991d8df9c23290ef5a841ad1bd3a4e6233824c1f2d54c9dd77daa11e17ea7dc6fe7e3a968753956fc09e5cebecf0e8dbb6394b9f3bc7f1b70748b7007c53c3736d13720ff6d5f43625a049defeb275a6e1ab522b59976e2bac7830f9c6aa709dc875bcb9d759719bdabb24430f3bde1b91597394677a80781ee42f999240997297e462b8d0a702e430c43e0c4fdc92dc0558afe8ba9eae5d45da91b17dccf93a37bc066d5cdd5e0fd6e82bc40754b6bb31e58be1502dd5264de2e51be3dab53e8c60e9fc36732e69dffd26bced9902f5ca17aa7330f81685f286ecdf497bda327961f115781efa3b2997eb137c96be1aeebb7cfbe829d4f9b0dc2f27f3a1de1e6ae8a12612f58b039fbc45ce0e94abd0b408afb86ad389c974380fa16634182307f9555ecca6aaa47fa68ffb42f29dc4117a297cf57571253dc1074ccf52be1a875c5b7a4e616f2da443c47bc7ee3b7cf0bc58affc0ff6db47e7d3b42bca1d96ac730a1de7917cd26bcb4388d97aadd557908710075724ea4c79981c5b81cd78cbcbf888bc0cc07abb3aad2167e3abf077b97854095a7bc17976edfc89223d3d6b0d7f836e1bd85c17a3b7ebf465920bded85f9beed05757ff1271d77cdba7aa0c077f882a77d7bf93f6457afa27e27988d1ceb43379fe4279f0e9f1ec535d58c0d74575fe52febf5746a462353a73ec3b033fb857e417aa940314ce2531aecbf8aca37a21d8ccf208f9df33f93b7666e5eabc20d74ed7ebe939edda02bbf1b197c687e037c83ccb33f5d5aaf320ff8a745cc1359b74149b264ffe648f0b703b9be973cedad751fb29731f817f55da5150a78165ca756d0026a8e7fa0a3a9222ed8ad62fe7fcb88c4f04fadca7c5bf4f6dde308e5e5d86b19caba0fc77f10078801aaadd89f8360033903f3e5f9d7737fa18c7e1227d637a2971871ec23d4431a9a52e731b633e3a8d3de5cbbd300725b1de97f297043a42ea1e28e6e50c7ca79bcff7e6f3bdf97c4bf87ce1ec2e8af38d4319c821be2e23d312f7c4f4b0a8fc22397a10c38bc9b2d04f6c2cabf976914c88d3b38c8e5102eb24e8d54ccf34def5d5261d89f197f3f7867234d2ab5b6dc6720558e8f175f46b27699de35f77031cd928ce64d0138b9c1ba2d36f4673415ccad873782e8f0b15e301423cb5177906d4c803662e9c8dfa3ed6173c756c511976d48752f69127ae4d292d5787e0a2b8a7b5e6e49f57df5c362f01cbeb7c1be529d82bdb5ee15a38e87989b5e8d631cdbba36ae7ef2cedbd173a7bc3f1053d194af9f6096f4ec7afc30afc49701588dffe71ae49c37987577d4532a650dbab8c394a81dc3b3962b7bdd78f7fdbb39f7ecf16e58b1f798146fbe42218ca1ed113f2cfbe97e08c8e9d5fd07f301e7b4bcc393dc6f582b1304e9ed5031d8d157d45ee24b07f209f3b783fec8fd3fd969fc742f48bf83d97f357a03a02af1cdeec6ba81ba6f147ba0e31047c0dc9a475beb5eff0e2bd0139b3ac00fb07d3c53ad6413fbbcf383f117067910f48971afb688ea2b10ff3ea897eeaaaef942f3a0b63ef94a2c10bbeb6b47c709ea7f03df451c47dc110ee99c63758942385311b8f585b891c63fe3992f7cf2d23f3df18c0a39ee8f4edf2f33f270f516344d748a531ec93d64161003f09ecd3ac6bd05e72213e0c63c8f00d2319dc97848526ed9cbe14a143a9338dc8db68ec5c7462f46c9b4b938fc88166793e1619cbd5430c2f78de16f21ba7a16f411afa60f743de29606307358dc15c3a4dcb5165e8150df266b83c3e03d6b805fc0df82311ffc0c7afe904f910b7bd547bc5fb6e5fd23ec9aa3f385d43f1fe5cff8b487ac91eef43f9d1c777739ee1355638df2052a7f3b808eb85a2f60adf7591decbe3da20e81f51c98609e7921cff6564f72d7e758b5fdde25765e257d0677015c5ef8bcf2955cf395edb125cb33d4a91b3b9584721d6aec18814c11a237d95039bae35c7fdc3e13cdfea8cbb3621470e7403a97e08e655b56fd169dce46364cfbf2b8f8cd868c69e26eb5c64733ee3f31e721cd715e6cee95e23b013a3f150c0679dab1b8d21d83b2ec6a67bdfbc080e74bade5b2457495f87d8599f3ab76bcbc7a27e079f483e125ce7b3e4e329dda3f8b257cfdb4ae0787e7edf7dc95e1215d7c0a4356964e99ee05e99fe319cd92fc0fba5c65b513f23f8ace9f2a6486e10dcdad3b1d8c7de0ae96b5a3057ecab48c55ab251ef01f6da31b67c3ca24fc427f93d24f2e39bd3b78bf81949be45bede388ce68424edf7acf3dee638840b6b47b027dac2569584a02600f98a5b6b547f1ef634a42db091913e29dd477d1047fb37f039a7f98f90edabb7d5145f72414d1dcee588df73319d92f85556640c711e4ca1f771aea7f1983c5f41b0cf8ffd079ae6526d1a53d5db59d0cf0bf979da230beaf3cd489c20f53c2a831f457012241ac9986beb438627ae745675bfc2995c38d68af90c91fd7eb8f2796ca9fcf02bc8d7dc71e69fbf97f10f1ed73c5fbebe06d7597ab3946c7d3178710676a21aad2bc77e416487e3f7aa4dec1b8c61106fdf9b6b8af7fb47e12340dfcad75578c63f2e4e79296a87bbd17eb19c3d018c08d4e3c35c073843e8798971e4c53530efc199d4865ea60d461d6f512c2cf2dc00fbf69d76aac2ec2c831dbc63ae189f765ffe8cc0fc8e7885f067bf197dd6fd7438e6289d59415c00fbb9aac466b36a12c2bd5ae4631847f09310dd2bc5f233d64f0b717e31ee20c14340b1b9f7ad23f2e78ee9f2bda3a3eb28e3982d7a06173c03d5159aaeee897b4d1ae4c773cf5b6b949fa9277a5107f3300fb84fb29f3f8eed541dd7e77da8eb4471c463ff896afac465642d9c87457276189e99497e2e91fb1ae450404d6b445f6d85fdb731660d89d1f829384a1575d2d1495ce64279355b9d1596917916edc761e4fad2bc077145c8cd427481b581fd36e6d6aa6ce03826c24241b151442796033b12f12ae0a0877a2ac4a722381c3d1e68ea42bedf12e3a62ff5b980fa216bf2705981f7d2e6e2ea7331812d42fe21cc2fd2a73db196e45fd752e7293dd0c8bfb900394454660e0bc414919c42723455efd3a4e17bce8b6af60460c9400d0bbfdba4f8a48ffbd47ee7f910e5b7c745553d2fdce329b6492466d0bdb69e1cf603fff4b1c4a2daeb8ab649be0cfa609b30bf4ef833d17cafb317c391b174a809c2bdbeae4d7345aaaf757644fc439f9ff64c6b0df1289cb774c389fff438f1b7fe07d7eb7f70be3e1e795fb61edaf6f371e78bd620f4f9266c878c5ea6901316bd471e738dda5d6da9fd9acc57b587ffd5fe76a6b5875aedae2668de043efdf3cf5d6d6aafacb5fe87b1f0fe34178633f9f5e7c23734d7b1570f7ffe18371ffb7fcefcc5fc4fdfb0269e16fcf6d3f7973f832ffe801fe1d9f6fc6d01ff9b939566bb3e7c9c076f49bfe5aee6db8749eda1417d67d8bb9ab73027b5877b86421f7fae6c742b4331dffe4353ffa1bfbfd0cc03fdd703d3f883a5eea97b9afdc6a8b5bb9aedff34ed5fb58737cdf52777357f8f5efc34d9d41ebed529e6feaed6992f6a0f8d6ff45f7f51f5bb9ae0da73a7f640dfd506e8852c4b7fff7e577bb5cdda037557e3f1fff2cf9f4bcda4d0e791094fa3ee6ae3e87839d709c67f4f35bec19f0bc3f16b0ff4f7fbbbdae3caf66018e389517ba0ff6a302c536fd0ec5d4df0e11be6af7ae3af7a9df9fecf5d6d9072e9f7fb06b9349ceb3f77b566f94be59f3fd7f3b53f316b0fff87baa3eea8fffb0facb435f905c37a0282d5fe5c79cb3fa793f9e91ad7ee6a1d6fb9f8b5fa5b5b59317e097f7f5a18c10f2fdaafe964157c1e2d16f8d3405b1956ed61be76ddbbda78a5b9937085d05fa389062c80aee5172ddb9df8e4eae0cde19f4f9365f8f965e2af1257c357893b060b730deffb5f0d0f3f9bbfd148ed79ed61f56b3db94bd0050d6db030c9579abbfac35b98e86b71f2cbb7d104e83f98fa1f540da8bb44af239b2cf7ad989ca5b7d73f7735535b69b5871a889cc01d2ccc345e5c29d8e4d0da23ca785a6cfaccf2a033f72b831116aa445b7dafb157f70dc7f05a9ed9a4b6c2be7150200d567a9e1dcdb28879ee5994d97efcd6df376606db9df5bdfad260876b9569307d74b4c1fd2dbf7f785e0f9a3479d65a9b0b5193d9ee820b213063b1abaaf132a45a834e4ba81b2cb85eb817551268c373a9c9981bea7c6bdd695a2faff4e3b2f31cb435369f16d3b12bfcfd62732ff83d47d32bbf94cc4e88d288e88523a50b293916b48bc5f4eb659b951cad78bb489a52141e7fdb2b74d1b95006a142ab0b544e42a0c503f3b265eb2cc0262013736b4a5d3fd27a0bc1ca2bb26a6912a4e9b841abcccc34a923647abf9a8b3b4a8bf87df934c6d79c865f9b76b699deb4ab1eefc45c4e1ed5700c066b633096ab36e3eb58fe881521747fd0c0f592f60e0fdcd6c35504ceffbc793029cf3955596cd313f741bbe9f3e613bd3f4595dc185012dda44328e933dd1029cf495b9fae6b7a2efabd2f59d0fe6763cc9deba648b0c7317c91d029a3c9c2254d354767a0b53db820e9a57a75b8dbd146d907e5629fde94281e6bb534b28252e60fa57b81cbe51385aef361f92abae36e69581f968675426bc36bf8d7e7ebee46e75fbf02ada1c5c8c5526074be31537e4f0a8caf334427fd0a323c12beb9149ff3ad53d7ceb55c706de72bf03a847fa8f47667e79c9b5dd7603948835f2adeceedcb9c5570869648c7c1fb4872d7a76ebdc75ddab54569a627f48735f55abefe9cc50bd9fbb0e23cc8bfa2758134d12dec0b0d5a6e8ee9e275ca7817c83b0823e4f149ca1ca2a924647fa5bcbb0b695696e1ad32655be13cc3fb63aed95c7729598f6348f6645c502245f5a5a09d9f269d948fc2d8233650197beb322db44edaff45f9f134ada1092d073b7cd872b04cea1d874a9c79804a3896bc2b8c8b4349f5036e63146d97176907271e2afa21926d113fc417718436123379ed83ced1089d32f75fafc49ebace39ca465b30a6c8e8e273f43269fb111f4251cad3ebd1769e8e79175ac7ec2763ae0529e99371399ed721851f5dcfa176409db600b203be5ba25683f0dc264a23dbabb2ba44be96f9e098ca7384a701b9076d8666264ee7c1a9a8485e4099a3d6ee6e74af012d18c0bf8852d054281b6c5acbbef7baee37b995ce0aa81d26c03398a865a5c868528336f691f7db9ca7c9dd83d9742a969a46691bbfef42e957d1f5e955d57f42bfe1a9fe63c7fd76679d1981bf55161659be384811359b740037ebb967fb18d39ef336ce3b47ce9a4ff48c39ddaf1ef6dbcbaa6bccb17fe9443e94d1af539e93ba3e79bcf1c17af3bf332de6969271bd948c8fd557cf834840eb5f7446be04eb55ea2cecb4632995b8552fb2afd1598553cea938144b259d0f8ff943ce9e5b4be3f7b6349e775d3debcc8bdb176355da111f51e994db11a6a3e2898704448487788ba4f831d6d240ba4f8381b47c359ad68f687e3f15790bca22678a748fda7bc2ffc73288c63e1237453cd7650753a32dee0dbe71386dc57dca87f9251fb4abf2aed5978f364a9c0629fb50121d4d7a9ee277bc87662b551a2d9518ed8ef34cc0b9be6f9ef3913b69679775a6f1e2393083c13e3ce1235bc332f522b08339b1e313f990cc01688d96d01ed3e45b2ba3594a96be42c909e41b402a39c4f54dde85b6bd180624221bda26b4378deaa61575fad8bcb26de8acd2000fe75e60991d8c99a63428d549cae0239420ba56b6a78bc1ec713b686ff7c2d3e3f6eda9733f78e2bef79e9eeb6f4f5d7dd0a4eaf278ba950ec37b59dee983d990f9d1a2f5c113fefb65400b07d7940e1d461e3bcbde98da0e6c6aff63ec7eef35a78bc18bfb7de0fadbfe8b6bf6c4e0ffc9a1dbc0d72d062d7f07dfbd3db58277c9dbbd74785cfd6851cb9ebc5df49ad3dd0ff29d482dd16f22fdd7604e2dfb685ccf07e1c5b897e5ed6ed0deee06b6b3ea3d71fa604cd583f1754d7ccff701ed6f0762d9e70e1974cfcb3379cf0afe960ecf7b19cfe3ed69b0ed8dddd75e40a7037adf530bbf8f5afeb02df89e26d7f75e160bf84d10694c576721b7b66b7cfdafdecbf78534dfe9bd3d4dc60cf3fc161d5b37a92317e53a9ccafd01f8c73a7cc43fd6aebc379e014209fb92023e831c171e8d654ac642f65e459f5291ff2e43f66d7b3967a703e3edc37e3b29b53c428be16b27bde6743f08f602057b61309bc2fe88f3f213a70b4f96f9d6f2f7c293720f6b8cffde0d0eafec0f58e3996af6c6ceb2fff2b8efbf74ea857c07d745786d10f219e2af7bd85f6fcde922be375dbc6ffcfddb18f33eda9396f9f6f4bc7d7b7adef7c6917dd6f2770317f39fbc3d4887c75dd9e70a4f0aeccfdd609cb3c70e8fcb9e385c047b5e80f76d07477effd66bc1f762f61e195b86dcace3ebfde5df7b4b7b6bf9bffae419b2bf7f7b5946c796cc01caccc73939239ca80c3fdac609fe483dd345540216de73843488e98ca424afea79903996acf33ab2bfd2fcd705f6052d3c0d5fb7d308ddcac880bf5f29817b017f173372f5e6b1d57dc5fd9e32de32fb3dbee6e0b33b85e13e9deb28e2db1be795ada6cf998bafaf8be0a341d601f4a8218b964e6416822c6fad93d70473a9566a799cdb87d85c479e8a8d29f05fa5e747b66845da119814a4d32388b4c0c71ac95de296d136ad7dbbbc3d14e769ac4bc6d72bcbc649d397d2ca06f6aadca235b99be22344a594b1b127e81a19f7656243a47d64aebc72433d3909079269df6268a569d07e01607411dc80df013db0dd5d2a739152a561a62f3c0acb10949704762e6e5f5851aec1f81ff364da3b60195c278b87e3323fb61f13efc9a1a52bae35b945abe3384f67e7f666c4bb2af27c4e8babb80c87527959d82a92901213cd9367c00fe2c1e05b100bdc9952cbd724b51e81804425ff7dfb225012a5e632c4d7965e1bc70518ec20d786409a071001885783772358a759140a3c8ddf33e4689e2d96265bcab58df3686b9262fb0acdb86c48962fe6ca08275e3a58469719923566719b893667697287c88c4d40ebbaa5373977d2e67c45aa5b384e17fa442b9ef9b172d00f3ad7cac35d38952158880c89ee93ad2923e80262172d91eee721df170dbe8c90e7da4739de6f22d85aec6fc410a4a1ec48aedf297d32f421cc5f3799f8996562087b58622e183aed1c9918ea68441788c8be054000282cb43380d8bb3b7bef9c00b24791bbd931ada23860e4fe337436bce72fa3931df337ef0b7d2a917ccb384d3279ec98f3d82730237ceb60308d9926b5904e0650d28a0472ff19ce34cb6c1e7de5d138405538c1e8bce2f7dd74b13c5d0c7cd6e0973f97b7a3f7c77c7804da456a2df526404c8e20efe840f481abe56933e24a91dcec96869fa8e606f40e4dba587b010a60620ddedae8deeb0a207614f0855c392e8fdf9f39a7cf943bacf18db57aa9dc61571cbf5cbbc68915f75fa28d46fe386fb54d9fbcb62966bbcdaf4cf39230329f88f609bbf0565ff6f5eacb7eabfe1fea4e1f6003acb412b1d5a31dc04561424bf9615e233abd71ccdbc2b803017f815d0039c911dba09a0f36526b551453c8f02dd8618d533e2d86e0d700b85b9dedce15a9be9c78785f83afa30dfe0fc09f704ad106b5338cd5c5a09cefa5d976fc0e2f2ec19e356cee00e335a59d65d88f0bb0950ade8dfd830d3f6829e5322a8c57167d9377b73aff3a051e31a05dc5dea96463111a25e676b3af72ecab23adafad7707efcd94819fc8ee291c6bd5b391edceb378a9e0dcd81859eb78225bafb896388ef62fcb238fe94dd7a679323e718357bcc12b7e30bc622417ff2cd915b9ff62b9fcb84e972671af5ee6b9eca2dae87aa715cdd52fe5378ee89400f9fd3cc57ac614f305c49aa0062e820f757f8ede8274ddf87d65cef12ee82d07a247e4e986e4ba92ba1f79ae1f6b25ccb76626cc97e4bfa6f9dff3e390db8c715c464f9b133d331f7abf8057c91857b8a543da7e38687c636f36dff59ec43352f40b844bf7ae7780ce90378f70ae9a74f5367a91770fbf82dd5e6abc15e5eac6f04c4bf7dc6f479bf7eaeb8078e48bac41e1582bd2ff37c67e5a8e8ada1c0cbe02dda37e9ecbd841313fcfd579de3ffa8d72b1cc32d6f6b7e873093fd265d601c7f55661acf4fa980f380efb15d6a0205e5b91f626b43191b985ced4ddbe54a775a9eb1ad78e59b0c1fb33e7f4a97c31f9f1da8ab25f7c7576d7e675daf0b263e89f48dee78eb3229df3f5f68fa5777e6cf133f176be1fad5adcd98bfa5bae8e85572ea6f889685fd29f56710dc0ffb2ba36edf3638b9f88e6057eb46ab4861a7ca9b5cf8e39179d8191fb2f85c9846cf702bf94d3d8ab4c6badc94bc27b657c52d04a396937a2b89ace8b73c05f42397e8083deb4d63a3b9c2a477fcef298fb18e4e1438b354daa7b3adb5de16755f25f41bc2fc12b97ca278ff92ee2bf97e431b63bef4b905b9c818fc4581b633e7cd73b92cfb8d9f8371bff66e3df6cfc9b8d7fb3f16f36fecdc6bfd9f8371bff66e3df6cfc9b8dffb96dfc22bcb5982dd9ba4cfe4a2c9e5c2a7f03d584776339a2f1354ccf2f754e715100af29c867c1f98d4deb604ac2a2c3b7106679e7b9311e52ee736fccd106608da0fc68c89171e7ba17d44be2fe796effbc1c90a29c9777e4811c79bd699fea94b13adf8ad882e3102f11d124a8f345cf40582cb1fcdd7e930b6daa7e93c33a306a51bfc1f9d3809365757838cfa9645e39e6fb2c0c84e4b55d42f35eeedecfc75128caf747efb808b620f28f95c8dd3af1915d92ff615f9c3cffd2fc1efa336ebcfe2fe5f57939dce1d7230629c66c2de70feeb4b98d26d52990eb802b047518aa6c218c3df0072b4c633b09d62c8eb98b73fb7496c367d776aaf1ad83d6e43c9ded207cb228ae60a5ba1796cc397ecf857cc337dce2f7e216ff5bf0783dcb029c57639f212f3e284f3cd8475fc1df05f4999ee907a82c53f1bebd4cad20d15d43bdbc940e7d3f453835e352b29537a59ddf695abe2a9996de74a641ac2890a5ba0db9d0a3a5e9b98e29b5fc6af23199abbced55e4efb84d923ff7bf93f1c20bd22019dfaa1c3f247388df972eff1236544e1ef6a9fc4ac4eacacc3de8ef24d1a077a277f49b1c7e67804904fa63876fcd0cafb19e4854a5b9e78ea78c2ee445e24bf9ebcf11ddac9cbe0c766103f08901b790bca3daf91f897dfd06bdb7409f0dcfa1e3f9933dcff2eb81c70efd889468bce5ca670fe8a8864dc359fa257cfea5c65b31ee023dd82667c6f4c9bd296b0b7b8252cf7cae81fc8f19b90878bff52561a362fa37ed8a6739cba177e84d7aa633a3745a7ac2c694ea54768ff1227d2172ffc5726560ef755660f324697ba2cf3be13e9de2ebcbc9f1b6b0d43dd3c73a26d9f3ebf7c9b7ce2a750c656485272c4bd4f43f697259ec59f43cbfd31e6d4c9c0fa432e24665bb96c15b41ef34c895c4bdd0c0be43363ad0707fb4ddabd100bd334fbe679edb69fbe864ad5d01b054dc52f3c7d7f6c31e2850e387fc0f16e8943a636e4c79f0f13461d3c75c469fcb9501a7bc31c4e7cc74c8349c92fb20bc07e62a39ee10f41b15fa6e207f18e409404cc39846ceae2abeae2d7efe4a491b53b97d912e9f52f686ce8a6bb3dd2dbb3fc8732f35d759d6fbcbac35cec9289a630be74d94595b7cedb97b99e43d9499cbb697e37fc0bebfe4da7341cf528fecd7fb758f7a5cf792eb3f27b90af9babc18c9ab28bbfe24df91e0cd21ccf8f6c8d57991e0ab5559fff47b2ed4833542f7e8dc021e495fff2ed8a2e0ab53e5411c939951a638af229cfb890fbbb4ecc6b627a1618aee3ad967f14d4aed7751df610fe7cc5caca75180ef967fee88e397727b4e7c75b6813c699bae02783820fbc1267c76d7a8ef8f27529d36c2d2c1beb80a7b32c0a2cbe6afa30ccded4f64cadde589fc0119b92fa083939c73997df63cc576ed14f5a86a8b10b34cda6355f619ba37f9ee323216f7c82a58eba05f57e4be4c3fe630aa3bb6e1be118dfdebb0d6d07f81d550af34ee00f21df092943d073dd71c157081c17ef170af8a08ae0ff8ea913eda1653faace5fb1052fb80a5f3487c1f785de81bbfd40b79005fc7987b45e6169a34988ea263b04bed93c08f12f4fc750df09bd91cabf1ee4c6b06fd8371bf601b7ab06b52636e78ad2dfcde01fd149f2f88077901fbdd04df9400eb1bf5b57383fe4aa24f7cc99a3c48e6b54fe13b53aa63fec2ebf0dc781952ad41c5981e1ee7f73cdd37cbb7719eaf33e20f2fd019c6997d26b3d6a61de9639dc044c698f428ce6da0eb508fe548cf91edd46440979e4e75a955eff05d37c82b68203c76c3463193440c1074cde7489ff4fb6ab657acef76b65c78477c89ac7df4be345ae7c5a2f3682eea9ebb036cf0788f15b40710ad31de06c841b0cf3278e974ce19b290c885e3d81f1755f38e42fd2b264722f2f2cce7e27bd3c69baf277d70ceecbf123f35d28bfd77e4ec3b3a33f82a752a8563ad98433bd3e4637ee239fec5883c4d1b936dfc9e7cc57d411ee0e7898586390b97d94bd0e7509184053a27af8db7cd742d837965842f11832e1ceb97ca1545f2eb3239a29932e1544f1796c77cec72765a445e201bc660504f12a46fa836e7420f5ec83187385960a3a0fea3d1fe44e023a01576441b815d73622b9c8389169563f1fb4ae9d7656287393d9a8b7803df9bb2dff3f5ca8f8df915d4167c9ebd7e591cced00e3b6b2d19ccafe7e507067be242b8d7245f287f8f07b93a257d157b55c67ea7399121240750d84f648e8aef5377aea23ec5a6ab7be21ee22eb0d72107106cb588fd39356588a5195057be50649433eeab4c630f75e57ac5bd8ee79dedbf78870f559176739d855a066b95b6df32e83b8ddf57daa61354983f339c2a7327b4898f34720f289fd87319557aaf1d87fbc665cd29e339957dafe17b4ef69da5f3e6c6001d8fa72e75d6ed756657e8f77ff51a7e49bfc633d643e13cb33aedee06f0c5517eacf73a5564e1a049e69af4e2048c84004fb4921f088f79dbcb5ac79b0fe26a3e889c5cb0d2cf4f3c2365fc88d706679e37f8de9beef0197487580de3b5695eb21ef1f3d0fe86e17dc3f0cec3f02e8ce39ef43e9946cff60bd5d9b806cb6df4b9007abddb9731ee77a14e01bd471b6b83716dd0115ed37b8964ebd9c79a12a24b1c547988e2eec97e28c61ee524e11ac83aca4355e421d4dd306abce7ca52e7210f31c84b025d01e2ccba14ea33472c73542f017a11b757a51de8faf02c88bba05802608c43ed278ec181fd5a3dde924ad76d2f4b077887de73c475bf7a8fba805f72b1573e51de6c143bfe423222df77f2b17ee21b8ec30dc7e186e370c371f8501c87a3cc1c1d2ee5bb8bfa909a76a69dfe8a758e32be0baed3c6f7f22ae80b8e067a010ff915c807bf566475a99e55974bc6947d7667d8efb6e2b50eea8b4215e953c3f0ba1271888a79378a2cb8c22ce983b8505cc053973a2fe6fa0fe2fe42b8fe35bd962e47677c39d6cf21fd4cf55cd0dd6c5582be2aaf534d1e4e4d067038c435caa3968653451e4c43bab6479b20cf06e5603a2a0fb117779df0d3ae3b2d9c0745f241795467477a9bb31ad436a2e740ce0fe07a880ed40fe0dc344f93766ea76d2e54e91edf1fcc179ea9f222aa3139ed859ec74f317ec8cc55eccbb13e3d2972a78beb87e9e3ba17ae5550ef8c736253c79c1e27c3fb13b0d9b13eadc7eaa08feb88e2dc8ce8c4f2a9d2e65c263f0ffdeb328ab4a3311d7bb96741ec39956319e17b2e6287a5c9b41c79314ad0bda4dd95f89e3b9832b7d559d8dff539e661b0255c7d3e9ca25ae98ab109357f5c2572a5b36cee531abc1efbb0963a1fa2e714ae5185fe9c963177a610cf45fbb5cd598687f2f5901e5031c73132f66daf786fc7cf0a838558b2b8eacb82abb022c486ea7d99f315a9b057d5cb4416000f67faca8833c85d867e5baffcced2edc7c52b2d0c7be37234eab485bac1c2ba433eae4043bc6232e6e01c5d431d6f8877d41e2d7456703bfc71afe3be5e3fc6a2f0da6909c3ced3743b6852bb1fcd4a343ce0b9ac8ce35c56069a4be239178a79117e8edd97c26fe398cfbffcd9254af5a5218b6e124f077c1d88e78e79beb01fa6197e80aa7230d25f2d431616e9b0c7febd69b6b2ad4934921d7d99dbeb0c3ae7ced34f197765043104b067833334be37f0dc096fd2338d6fed154900bc96a5c18e96cad9ba71c13353e61dee5309c6bd7351ae12df4a91db65ec9affc7de97b5a9a9746dffa44f303e5ffa506c456935afad329d0924680bb6efa3b6e2af7faf5554415114636b0f0907b9f6ded90235aef15ef74a9e79b4a6b28ab85072e2c61b94671a2a6f564fd85bba7430b12ca3ce5de5fd2e7a27e78c6d0c6dfadfb12684754a75bfeb0f5e5681009873e0e3a9e5a3a4b9a2ba103fe59cabd41ca2b537c4f5daf607c02f13acf4696ba54d6fe6ef14de95b44c572c5d029e7417be11dabba564b86a8bd82695c9fc435b6b214c1f67cb33e2d1c1f6338d7740b207c9a348073857a42777cf81259e5de4cba0fabf6535ff492cdc875bc5411b8ea3f7721c7d360e10c77f6e93db2890edf9f72fb2a9665cb9707e2a3e1f915e27dc19bc71c4764074efceaedd56519d8f1d487b6738c11c3a7077d5006a9ecdf9d935b4ce76348c6dc5f0bea37ea30753373dab57cd768ff5daf4d5d01538a380bd017ce38b1ddcc7f6a274e9367da7ef9bd3a4bffd1d628aa5c65b116f1dbf93c38954face47f72c1b0312df45f49bb13e7d057df85e7b29bc1702c6a0706d149e4dfe5475ed53f79bb796b9fae9ce67b9c1a3347894068ff2b7e351a016d41b6b03c191d78025fd0fcf8e2a372ef0eb964743575b203b57fadee3f77b51a838907035b50ed4eb037f1ffc37b63332f6be600f13f11d51052c496ba59b28de63c9aa686adc5e0bb4cd90ac737ea72e616a9ff9dfa6c60c3e1dd89463ede239be7aa8fb7d6a7dc1975f1bed59c1fc9fd7ce50bdd6fd1ed4bc383a3fb681beab2b6b5354b7f439fba45c3b3a5fb63fd899da8c3eabdf48e6a2b301be9f67f684b50378f7b612619b6e65c7a5d746d800d78e290f82da9c6e05efe4c88a93a93fb7adb6f2dfb136080c710d31f41767a80666cd3158beda72a04e22809cc0f415721261ed149f132eac9d127c4b7e68d7bc1f2063d763cd3c586d9b7fff92d837f0b9f26dbe32be128ed9aeb44e1afbffd8e7fdb6200ed57de2dd3743bb1c32ef69260eacea3cc89f82bb918991cbbe13fc6f81be53a06f63ce5d2dcefff1f979bf00fe22d677db941fcef1e152e34fc5096be9f80a7a38f7fd291d975e6fc40727f0e65a24e76e1c372279aae8ffa5e2881ef92ddd6381f4d02b15af954643f5b4d20782394ff607c0381614bb256bcde4db36bfe710dbf5aeb979bb6a394cca1e4ae1416e841949e4c18e54ec97273b1331f1f41a4b519cae7aaf062a9f87710a545d0fe9c348adbd1958622baa53c3f9e2c06a9b7b8c2749e4f7423c4a8c13196f6e1ecfe5eaa7bc7c26ef5b9f5caf1d9d37cce95cf6befd8238d2a8b7467ce325f3db4bb84be64e3d58311735e1d127e3a896db6893f190faa7ecbc4661de3f94df47cc05563a3e1d62a3baafcbf0b97db9d874cc3916ae3bee5f911d87060ea9b5d156deac9d771cf7e0be0c5aa846733815a0e61eea07d2d8bb106315da6552cbd09403e618bd3a1ae4fc94ce48beecedf6ec5deb1ec7136bac7f193fb4602f2899bb48ea4f69c2d59f39e7d36a436dc400cee8de22582f8cd7217bce8cd3451c869a43f96a3f0ea3e154008e29c0c161bb9ae80c79ae4a8bf1a2dff95511df53c6674fbeaf8c7c533ce0584ce90a5403737973387680a53d6c4d5d811806f32e58dbf5de14dd4d2a8642e9f5e4ba96d7f1cc7365f6f4d1123b2d43f34e98d36ebdd22ed02f6c63b5d5d648e6ee335a333b20776c109873e9eac80f90373b209d234e3dbb3df5cc5e98377486c0e9343dae2087aee17aeaecbb7cb67d4f34b46987e58a839e44c06181fc7e71499f1b1eb6479cd4ce31e5da8cb7ca07e7db22f78d5d34b5194d6d46539bf1b7d766f88cfc44bda13e5cd630fa8360bcbffe5e3031f7c817acb33735ea6b33f6ee56982ffedc726d8d2da317073cec3ddf9f9d11ee891db2f7c0e638d881b436fc0701b86281cb9a1913c1e3d3b67ccb6a436c01b026ca9b21a2def7db710fd5e0b66c7f70b245610f78bf38fe10c7279218612958e9e6da686fd9ef1e704d4e0b73ffe2f38c6b32fa1d6529ccdca5d0757f3d76cfe38571ae88c3ceb80f77b131c8389e38f7a9e11c49738ef0ceee5355b951068fc1bf7fb935d225e25bc4fff04e9c98fb85f7dba21824336e1c231b1cac7e56de3c5b57549c07f9534bbf14e1903e27565fb4be77ad85cfc5b67c211ed67cdfa46aee517c38993e897d0e0e80451c6b048b58ef7e17bd9323571077b6d94ee6e66bcaaf8c7771d6cb1f88a0678d887b22ca6fd4cb158939efab11a725b6457adcacae49ad27f0ecfb44e718da85598bb2eb9ffadddaf1a15fd060b34abdf39e765799bed95cdbcbcdadfbe1eb6cc9627a3d8f860301d610de41db4da608dc763ff2f30ed0e3409b25e2798badb7507bd262d9ebfe983c768fd3976eb5f8a9989c2bee697bd79c4fd65dccb2496ad8f3db5b9da12a322d7d8ea66f966f92fd5f634e1a05df6377c18f93726dfab04f83fac3061c05ca3161ac11f46c88f1de50c32158c3b086c3dc296f163e5f8ed6a13027f0fcf3de16a707ab0df1ea25c29e33f7a5e239e2cd35965977394b8d8eff781d0fb53f8cef5a5a97e367393afb6a6a170fea0ccc5ebd77dba27a00bfcde4f3f940bfa3ff75f4690bd5e8f504f4efc9feecb5ec83b529ef05bbfd8cea79c61afa775a76d7d2d5648d6f8261f0512fa51d83b928ca6329940e77e7442ff709beb1445ed14335e4deefa184f755c2f81154f34fc6817a953bda25ac3b277975907543f544613ce0f9a32526f5e2fb65167f6d1a59d5c8aa46567d86ac2ae943a5e595c4f8129f23b3e4e7bddd5604fc4e645319daf405f5934475b80f01b2e1e7f87d89588a1461bc189916c754abc53e73fcabe45adc28fe992f03ef9bf7c8971bddd7e2b8d2b7ccb1a2de1f9cb52ca11344fc2c47fe24e4539d77b7a7d01734f453787be20fb6ab9d7a7564dc2b09fd7b022f594bae16cbcd3abe245ea7db60dc100757119f0de2aa8af042ddd719cf07ed49b48d06bdfb82519f959fdbf2be65d86713fcce0df0ba8772fd5ddf76298c901bc91e39b4212bca32bc6ed9722b8e17247f636a1dc14ad90088b7c95fe9d235c43e0908e39f3ea7e6de1e4e8e93c5b633d6508fc480d39fda65def5bad29c57883f307b9f6937a4e2061e757f88feeaad1f3971b8c3a84fdbb0210668a69b802d5a1bbe8762b0553091d4dd779ffa3c9ffeecceb54ecbd49c009f05d05bacfedddfb41e9d924739b6790d4c19bdbf7c7c99e97b673b685d7e2db6c77cac19fdae0cdc5945b9978e27259e77e7e9e7cbecb342f285f45ed37715e3f730579de7633eb956d2d6417291f7dc81f4108df2913bc2a197b23971ffdf8813e1648a5539450ad734b92699b924ea9bbbf8ecc6b13e7e8d23efee46e7be1f9dfbf7603ff17791cd48dba6f9f6687ebcef10f67ca5d729941d96fc70059e6de87563206c21ecddcff7f9d9eddcf5ccde9facdaa2025f1af6843a974762d3ae74d31b6b3c9956cfdea8fa0dbe2f49f0c2028e2d005e78795c51323625bbcad9c425dfcdb1cf12e71fef81ec89e65c607d8adaf661e9f7737cd25abe65f69c78f9ad227f7311afadf43fd4dafe67c4d599a5ee3df0d6ae6ddff1e2bb81ee216f7cb4dc24f50ac047ba068cce487e3891ffc760d0797e677ba53fbfaeb41f0c4eb8b28eae7217b2ef7c2c939935534f8ed64a9d372d607434aaf7ccb5cf208e08b2b565cef3f5fd4af676d0574c9f67d70924e74adb6255f404751e17af09fbaa54dc62db771de85320aa710d7a9817a2c6c3afeb4076be36a3782fa1e7d9147c2a118d0dbf27910f1d3a7b475e030e3c89f9aa66d397944fdfdde6e7cfd1606cf4229f90e6f35c5067ee57daf62ee5f3cde652cbd0959da947f59761bc8a1d17a7f670dc93903f063deea233c0d4a17062f3613f74d93b3172eb1db644beeef8d6be425cfb5a2447f835b27c1dd38f7cfc88d71ae52dd6242f3e1a92f7c5b8c2baf79bf04be4ecc33bf229689c99b844ce1a71ee70e63a211b1ce433e60046f525e81cfbb64b783752eb51fa9ce5c9a958af21bb06f34318299c00f9a30816ecf546d8229b417e3887f12fded8e05c418db41a0057e7ca7f807f327e40c6733ec4b6a5375bf4327042180fd97e7e45dc9dec79cee5742e6dd352cfd788e5edc839b80d36249f23a590ab7942719ab8b3543d7ba9fb2c8d64e6ef91be1e402d09ec83cbe45089cc25d890f64af6a0168cad1ddf5bfe1a38ff5a7620bd18e06786fa00fc2dc2817f8579daa2d949c47a86d11ea09cb81dfc70675ae70d6c0bc86f98ba728c758a17f28afa53cf1e02cff804fa3fbd58a270247d95431e5a09e48108d8287b53cd2e2ddaa3bbc8a63c8c132d97b624ae8aeadff932829ba7cae209886a985f48ad21b28fe40c3f3a475ea5ee5656af8ed23e1f39fb893b54ca9f6f72e377c88dd3f566bb0f5ef392fc735f68edcbd51356dc03d4f3a2fdd16b9fcf43f785d63cbf8eb0e25a27fabd71d7bb60dd8afadbf97c1ebd3a7145e04840fe0c1dbfdd7c3d3e22a20fd2e3a2d7aa2477e16df2abfc77e7db61117f46da0693fa1cbf214327a3781bfa3ec97f10bf097ada18a2ba77601f656453517b8b71d7d57c2bee3c736c9948af33bf39875cfb0ab1277f3ff5dc60f2d83dff791cb5268fd2cfc98b7b9e0ccfaf9385f773e21dcee385e73c3d4ad6f471edfc191c82e9a3f143570f67fcdf97c975d9fea50ad6e4c5749ee6dbfd78d10dc68b51e7493fbf3ef5dccb2ffd1c68d7eef197dada87ff14feff64d70a7f3777cfdab57ffca50a3f276af8ad3f8f8a35e9b57ee8f3edfe4fcf7d7d7aec77f0df75f4b907ffafa3eb87e0cf7c7b84714d1613617a5d3b7f1efbe73f8ffde069de7a9d0c0e17f4aec1e132f104fcccf9aa5dbb97b2ef9d3e1af0cc6542be3387ff7fb1260bc5c1f3f839b976f74ff3f5eacfe0f0dfb14abe7308fe2cf6f4fbfe9fc2e23ffc945d7a845a4dcb473d698aceafc49ea5515f7973b4ce1671aa9439bbdbbecbfc3de122dfdb4114a7d9f1ceed488ebf856a4387382e2f5fc02f10ec61d43f82d43b6c57da6067fb8363cc6be11daca10a671464288e4ba4d6c4a5d6a45acc48cc5fdffbf8001c7fadfb5a5507a662778c8c2e1e4b83bbba03eeaae1b668b82dfe256e0ba8fb730223c3a667fd039213e3ce116c762fedef4f78e32cb201d97112bf49be64fa4d995ce355e741fe14ec03f44d34dbcadae2e6fff19f9cf1809ce1cf35b3ce9b8ee1107fe8ebc56253faf13631597abde9fd4fc7dec8996e95cd9781cd72867829e93b0f986807ea9d01eb3497c4953e855e3e80c739435d0bd5bb2b9577855cac2d3e1ccdb9b4b6db502303395803fa9756b46d32e7d1d8318d1d5360c7343af4e374e8744dfb5c95633522f53c2b6bc3580de9819a9e739973453d9fe6d3a8278b0d7140cbd01c591ccfada42cded8503fbf41b9298c4981fa9ac82ef7701e24f2352be6aee3f726cf5806fe2439ef5caefa7c5ffe17e1cd1ff553bcf9c9f157c90726f116e0c747fcfc2399fdced9b5da12b1b90ebf816f5297a25e94845332d2b3713e706fc917c0f706d4fae77ca7621e2f674d1bffbdf1df1bffbdf1df1bffbdf1df1bfffdbbfbef597d7bd298d8e7904b5ee660beabe639bc917c793345ef05fc77d3077c6715ac7cc8956d68d3c06a4faf38c717f52381f37ecbdc08d436a031b627556305d0c3fd68fa1cfc6a42c6d5afbbe1ad7b755f2775b61acc5083196a30430d66e8433143948f9bb5de257ccd7f922736d263797bf139bcb09f8b994ae9c7dbe09e486c0771bc90584d242bf3634e11fe69ce79b64c3c6e49e541d89a1fe83983ea87c1464bc6a110de9b1eafa9d5eefd03b5d0c496227eea5dec9b7c1e9cfbf63a2e88813c95b8db1fd3ebb4c03eaad8eb38a1473f7acd4bc63ebeceda97b4939adede5fb6b7b7608b6a90b9d665e4581c1ffa877ac8aa57a8a1c9d983ecb1807ce9f1e79965cfd2b91f1277e37c1b38d18fb6fc7002fdd6ec69c53d15a76f960efd4a503de3578bbf5d4d5d1157dad4fb68b908f2c1f1bd1773fe0d64637bead93be060e372cdd5918f57bbdd05d9d6327505eadc4e10e3cdcbd1dd2997807a03d83ed42f762017f95de21295c65d756fb06d0ffb2382ccb3da4a8737de12e3cc78d7d7f3e91c1dcef8e456be1cd2ff45b1ef25a92d1920bde7cec4cbda6e4f4af96bc0fd80e400c20684cf47397c7436062fce7012f235f4921c00098e19797082fe4eb6f8f0b2d2a0efe8ccb5fd8743d5dc3e5abf3bf96b34cea4fab9207e32efcc857d6649aff57aef67de91be834d3cbd89a737f1f4269efe57c4d343dd90db77ed3eeb0dbdca714f9b6fb0e681255e0a7baa5559f7644db2807a3eafb48b678a2a77ac77b499a9fa41753bd6515fc8bd25abbb6fe1cb507584a6363bda4365eff8dec1d41cbe8d5063afee8ce30d4c7d20ac7485539b8ef8d1a2b3670737e2f4df51772f3f0f31876f8ffa094e1477e9abbea92b9ee5a7f99f79b6d168db776dfa19e0dd0ff30e686e50cf0a7e3bc1b1e25e23600357cc3dc4f362d6382b9640edb5927b0f72b1d25ee2b7c9f3c3c3ac26fb8d5e4772df45f5262270c6d035eaf47ba55753bb6c47b2d2c16b877c12d4131e6259418883e1d9ffcc9eb886d6d98e45e87b10738c99092e42fabbd5fc866cb976274c307d5ebbaf55655fe4bfa5e51cd74fab7cf753f781235fcc9d7a0a63159deb58077e31846fcae6aab8530c2b1ec78f23c425437ec90c9e8bac18df2761332b8f3d5f07548f37607990fa966f0a963fc5e74d805803e26cbe21bf86ef689d17dcfb35f9fe7cd92e47cfed26ee32b166c9b9a7ce30ecc116cb354df180836024c7f31c0de9331cca15632eed808fd5dad07c05ea969171b8f7a277253110238ca154d303623c16e63e65c733125805ea5b7e32de9dab07b689df96d103882b0eec1990d3745e82d4331a22c873c51b0d81bf6586fbd7fc6073f491cea85a536389c2d9123bc07d7f1ff99c583fd2eb3b2db7f2d7525a727a8417ac2b70a8ab3b14eb24f5371ac2f0121d27d83be04e5c527853fa9bacde2b5d6b44ced6b7d74b0daea2c15534b88a065771475c45c4479c1e5389b1b4239e65debba17712e8b6eb4a530ee65cc0be66c6be96c96dfc93180ec5038e61e09f37fc8b37d6918f9db7ff9f82e9f8e4180d6d47e19ee6a9358fec9b94ad90b26172eec96d7c8608275198d38c710dcc77d931e31eb5c9da9c35707a3910a3403d2e8067dfdb3ada00faa5130cc2cd38e809a681d9bb1bd9b1de36f5f75cbbb5e10f46fcc13cbbbcba1d9cb27539723e7fefee1b3bcfcf3d765f4bc8e88f898f14d8d3156b579b5aeda656fb1faad5de4daf368a653fb42c71fac6f69bbbb76f0077d791215673ceb4bb7a9b625bea8372a58975bacdfa47b19a9a7a8a7afeebd98e91bd759bdc1efceef9cd101f0e85b61d75ae9831f3624c9b395b47a4296f8e3e8b7246d1772176abc1dfff8cf9d8e6d29ab2f92ada76f49c92cf95e01628d3279bc4489f38b22b8ee325f37601f39d6cbba7a7a896ef5d1c0dfa883f433dd4def43dcfdec4fd7c505f0d7900b605e4f232ecd4f49c7363907e1e0e2eafef7088811bc9e8f9383e4aef716fed8d7dfb34ee49cbc560b21f6fbea81d0a31deddec68fb83d327602bceb08e631de27a3978842fa4376df921707a4260689d3cfc47aefc4eed6f21ef243ea7b7f16d99352f908168bed21ccf97f93ef72eeb2bc8950147e4503a40af56888b107ef915e9e1d5f766cecbcc0dd753c2eb897ab351fd7d966e5c3710f5b3f2eca05ace9dd9b3e41ccac4834a6076a1bf96bd9b65c992e49ae2df96f67db7dec99451ff61c825626c33bc03f7f642f3934e8e266c2027085c1fc65c42fb0c1886f06e71ce6962ee611fc82c9fdcd2d583237bc0a946b06edc3a70886dae3481f4083ba7ce3ae4f3b54ec958817a4ae524f3f5ef16ce9b23ab571c13f02cff7bae1170a758bb69b88765d6ca7b863b740de7f9ae356b812e809826e453435d5a25d7c759c38c3ea8917cf39e1f97dc3177a16f547f89fb998e86b7917f8eae9cad7689d8de500d6cf9e16ace6bf629a472a874efdb28b6789b9ea4d47cce4fc5fb93d0b19be29aa4f4baccb9cf9c9f8ae5d8b3877aab0f27a8776b98a7ef6c01df600ed5b545f57ae38dabe2ba706b8692e7b8897de6c53e21e66fb59fbdb11e71169c0c4df0b2e65a60eb01f7f61bf41b8ace39a943e17dbb2817d4e4d69bdc7a935b6f72ebf573eb6cfefb6c64c97822cb8b6329ff24df24f40d005c9e2d7a1bc097e3be7579fbf437e6c06b714796d3b11cdb143f37ea239f2430c4879305beabac6e4703f22ee957f8aef353b18d81ec8850bfc77605d1d9297bec1d98ca4c3b8059cf12764a99da6dcedae1efa2b503df447e087e97b3f12588d1426f6dc4b3b9332156f966efb647841d9a4bf0df01b21349bf0daaaf065ed3a3a175506fdf914c7f1fde376d195ae705fc8738a648f6b262cc235adb9c39de28f641affd58cbce91dc318ec8d98befa0cfe8bd11507cb2261effa3655d51ce26bd1fb7c3e670f79a1e03c7efa2d65952c2752e2113a12e4bf44e26d478c9c2dac27dcf13b24686fd68dd06a3c33d0fc9e79b7c4e5e3e07eabad45658875ac61f0f7fcfd4ef71c7c03f1f0f81290e4e51ef557f70086bcbd4d6484ebc33e2aab0034988d7067332433ff9ddb3f77b38e3c97d570d7b5a1e0d7deb1afe12fa3605a6667ab6af9e103e4cfe89dfc37c539f42ff4a882f01be6c6db791ee82f8decb0ace75dc27d3c53528a2a35d4ed07b1ef7ce877e982f80cf406b3e7c7eb3c4337ba6cbd78a64c7212bcba7d47ef06460ee3db8af4fd5c4109a184213436862084d0ce1ef8a21307585c7862bf13d5c89ded1962f9ea5818da96ec71a5d5f98294bf87890fb73277e72ef128fad3b8631619b4ed873f2e4699fe656fe57cc5bed993d2151239b38571c7f22f9acf4483f9bb4bb73f1572406137fdb575b71ff9101e04f98718676f04876f6e00bc6f675228ee3aef4eab1963c99c0ecd9adfc37211beb998c7571f8d15d559f7aa34178974bfb3a1e8a5dc51c7c327d7709d6fc5d7e4153a7d0d42934750a4d9dc21deb14d85a4d694ddbd6bd4dae9dd0f44a897ba570fd9edf7978d8bfd166ab673f01ff730b7805c71a4f87e6e7ae6680831f2a6f564f5228fb86c8a43239ac044e8dd7e3c41607ad953e8db9b7503cf3c147fc1fbb67a813bf56ccf915ccf92e36d287c51959fb2fe642427bf3d1b29db5cfa35c8da5e5fa554f25eedd07c568e833029c8b916f84fa39d7cc91b1f32ee4ac62f73ef54d3f7f9c37e3232cf84e7999216c8c30273067cec41de406f03221dcbab8d29e05e8516944e3789f7f95bc5fac1e2ba3ebfe5d4c67bc0742d91ce02391dd15782ef3ce913402f9e5ab81095887b9d4b2103f1ad12d672667c8b9778c8ec8cf0972c6d07dad2a5353baa4f15f1bffb5f15f1bfff543fd575fe92462e4d99ce48ddfcaf75b37bfe74cbc3aad23c99f4fc92fa5f260c03faf37fb5c719fd9f804f8acd9f5c5bd6eded8eed8d7555a43df74136a5a37c2d5d49f5b60c7d801e76c55cabffd6b1c6c806b42b9f7b5a5a9adafd8530fea831decc334fb5b797f2147417cd56c5dfa37c6180bf3c291df14f62bb84d8e17f14e12ffba20a7bbb065f5057c42239016f47365f2b973dad7038efeb0f69ffafef395aa934df88986ae782379b0358710d787b846b5b8027d27193bf0dbc520f3c77227ecd7ffb1f7654d8a2adbfe5fe586afbbeede8adaad15711f4a2d51bbb41d4aa61b273a043c8282fa6f676feceffe8f9564429232a443d9d5fbf840f4a00299b986df9af96cba6f1cbcf63be63a3ef2ec7e5d9edda357ed3fb857ad3a9f3df2eaaecaab63fc04d5475c96232e1b633326c75686617b7d32047b9d95adfc7ef0adee0e512d862e970fd4fc276f765183e65f5c9f216a5bc3aef8734d902d22be4ea8792f5083b8021fa9e9d2b5223e8e3aaf4629468630588fc39ee788c1a4d41e7eecfcbd64ac50b5d3e5dd1dfb49ee2e8c893ee67b3ee67bfe67cff7f47a15ac4772ffde310c9c33e5c56163f4f3370e9d7b2fdb0972e58ab79cf1f9c135ca17f56360b1679afeefd1df7d0d7c131179d75cfe90be67ef81ce5fe87903d7825238819d8345e76137da501b7ad4143c078bc4fa1bedf374fc297e0c9fdd8dfa0644e54554ed33e9f23127fc3127fc3127fc3127fc97cf097fc46d1f71db47dcf6378fdbb238fcec1ce15bc5df34a5876aa0a11f17f4f74ac3a143c8d1afd374599155799fe3f445d17d5e481f60fa5c48ff92fc48deafd0bc551166d2ee495d259e4b3b9c0046d5a1672ecc3770eb2be873a2c93847fadcf89c2065df147a4dcc5a6e8443f17b9e7cc6a5eff2707e9213e9874c8bddcefddf9ae17db9cc76895cc769bcf61cba1842af1e43b40e9037de14a187319191a4d616d1c1f14d7636517403b3d4f1dcd89546f72f0fe7704fe858ec99b52542e47a12e4babf67b1b451f94af7963304696a2aada5d99881ad65b1715c0eac9178aff03b45d38c8c7acce39e6e4ac751f3d201fcbf6f4a65a5ca0588fb4ffeddfb9fffc93c6596a39fe3f93af3fc7f99ee6c9279ce649e329d913b86bffdfdf7536662afad8dfea7b170ff3217c66cfcf3afc5ca1839337bfdfcd7f741f5e5edafbdebfcb532acb13b829bd8f37f2fe04f73bc1ed9ce0afe3af76e87bff39459d9c771e6b9902d7f79cab80b739c79167285af85522157c8a1fff9b1b6d12f84acf0e5bf73d9ffce95de73c273eeebb350fe339fcd7fcd095fcb5fb5cc53c65efd30ed9f99e7f5cfcdf829b33aa0c7d5c6dbccf3976256283c659af345e6b9fc25f7f54ba9fc94e938f67c9679169e326df4d8dc97d2d7af4f99a16d669eb34f1911ffa9fcf8b11c9959f4f7be0977cb3e6506d44b579c19bd868ab33066abcc73e929f3b2b65d7887c1d8c83ce7be9685bc502c15f34f99ce0afe279f2b7f2d1685d2df4f9976d4370b65ff9b64997f3f65aafc5f557efcd8cc37abb19979fedfec53f629fbafbfe110adf14f78ab1aec55e6afb5bbfc6b329e679e324d77b9f8b9ee8ed656e639e1a40392a0fea7b63080469e32efa39f93f1dafb7b7fb158871fd11ead0d2bf3fcbf49b7ffd75366b01e39637290e81ffdf168b598679e33fac676ccff6ad6fecbb5572ebadb53465cd46d67bc82fb9a0be3cfc922f32fb29a55e679be719ca74c6dbcf4fffe3e5eadfddf04ffc5fca2bd3037f016ff97e1d992f6c89e93373ed95971d15e98e4bf46cefa4f7761a2ff96c63f57365a57ee4fa1f8673603e7b3448f231cc87310a7bcf7f753c61cad47642d37646befa31fc6683d7216931f1e1fffb95f99c9fc1efb2b22014ac542394f444041c8f2f07ee16bb1942bd3bcffef91b34a63fe72d667fe1c61fe7c3e572a9dc5fcf87d63b83ff72586ffcbb980ff4bf9330480b7562e01e07ff51c01704a438c440868c6ff3c89eb036ef74810f3343ea03053d33cec7dfb3f9a7be359cc67eb4cb7562ee8f26e62bad2c1109cad3ecddaed41017c708eee76b69ae86cb463d696a4bef8f63eb1bbc7c2b76ec33a7e9f77a623515aab834a4197f71be3b82c18c7ac3d6af4b3466db17dcb9b79f350ccb70fc5ade11adbf6f465d71e94d5f7e1fe5d152c47975fed1056021c2ce656fabcfd457fa7ee23748e86dbdee8ae7334abc5b99e57112ed7f39da92aefb680f15485bc4b69ff367d89bfaff79bb52ad4b3aabcdf6a47fee7a03d992c42f76d36acb52e168fdfddf25115eaab3705b05cc7e90e5a0bb3d1df7db74b5b35df39a887e266a474b26faeb9356d84c5b686d0b1f46af4fbfab6caabb635dcdcab266b9629efb3dd416b0f737921cf51937b27bf33c5fa4113a4ec3bcae7eeec54f9fa7761e9e26dfab2d66567a5299d2cd42fb4ede61fe837b57dc1382e433ec46eb05fa571a3fd45552abb11cca73b1668fb76d215b35f0ca1b380de46b80ff31fefb94ead37dc4da85af39aaaf42d5d2ccf9baf787e716db7d5df97474c4f6b1dd6fd9e706f45738c79076ce43f22d7320ddf2f587bd43d2d34b3cc7b6611ef616e4adeb15b2dafbfcfbd196d644da1bdadedd7dfe750fb5a3f8cf2edf067fee72f7fa05e43afe5f75eb6dea6633b3d42c3870acab9501588c940bd6bcba167fb11df00eed939d585fdca9f97023d741a018e0ff231f6a87656ab560e9abc079b68167cb603fb7a817a44e3dcd837981d0db1209223e2f712859adbfd728cec9eca4c173ab950cdbdf78cb5a6f42d4dac6755afcf28c49ab67a9eea439ab26e98e70567a20ae599f65e0c9f019261c33fdebcb3dd513409fb5c7a13b25ffc185e145dbae5ad5963ee3947ef179c596db78da2ef6eb51cc713881f029f84d7f3bc3b6879fe8e01e6a96a2a5fa5f2165907ccfed1e5fa8ad0e290f2eba035e199e07ad5ebfdce3c03ee55d2df97e178e1b4d80bececd6d19b3da82d3511d1da5a933b40274b43c8d2b3876663f40eced4eb53ebcc7517e8089fb7e73f58188719998f15f46df0ce907907e6ccf09e1b0d9865ae6da3f64a13cb5393ee59c1f0377cb72b36d93db2bbd3c584fe3fe350817815d4963b4df175a2cbd27424422fdece1ae74479fea886037bba540f15e895b6d48422f2317875d69effe55ba3bd845a75b80f55a35e7acb5bc7ef6e7fabe64371dc53daa3635dd47a90ae7689efc31a82af3c6a4f0c017c21a8976f706f727934e8905832aa9d9e66ed537dba5ca972cb81d9bb103378173ae0673876074dc0136b55b1baaad0c919f376b9397db5dfa26815bdaf847b47f4206fcced0e5a27f2f5fb5c3aaa32cc4bab405e2575df17bb29e6acf16b790a720d308929176d3d2f6d34906376e99af5012d427ea105b308eefcecb92943ed3bd4ba0e618d4b7d163ca78df6bc5e08f6bc95d3ddd6d6948b33c00af17bdd59e8823365f5e6f9e7e1fb1153f7a43d68b27c492e562e421e7ab949f0159aab575fe982f1c510e09d3ad92b9e87792b7efddc6b7771bcf1ca33c1fae2087d31c087cdbe93f73ef5acd9682dd5b99425b332ba03af97baa920bc17ea8b8fe20def591bf6f57dd6f1667144c93befde74ccfa9456037a8d93aff405fac2d3d1f20e63b362a8973ba7de49d23f97f678477a9f899bc4ac375ab7b357b79122a7d9abb6b7bbe1be1da537a1f9c777bb72f99ad27405bba6189d41eb51b30131aa56ce144ff02bfbf9c43be3acad0a7bcb80987f03f9bfcbcdb975fc3e2bd6905d42639b2a37c6e1c63a4998a7e7bd9777f6cc67553bfa3e08873371896e300b15f2cc717e145a2bca9bf6d6df81739be23308b0ac878d417fa0df92ba2e8f1798e7c4ec8127b7ea3b036c733742a7047b7a82b993f84b9d166b3a60b2e36eab4e0b5f9aaf1fb24edef7c2f49c2093081d2b7d88add8a6d28fc436f4e7785e7619c5204569a335da6b94f7f5c968b52f4a3b4d2ece0cc8c7f7702f234b53eec7c8b69e0c7d422c9023a1d818cc11d18535395f98f100f9ff9f443efa6704b33a897cac003dc07ce76623146b861eba5b3c5302e6a01c9b6093beefb6dc98bf5a4e3b5b4293810ea67434a66dafb783281524a47b43b6a08ff352ec4082a383fd49a7bb547a8ba233da9e698a7b4b75a5d515f660a5d9805860c71989e5950667e4d94116e055901588ce3c1b6fe5d93bfda591afac5465b6e19081c9b28f5fe695d490edda9ce862ddd6e49d4f6357ae23ed3d92655b6d7f4a4783ca7aa4588e56cbda66a3953b914dde6fe6865bce1909b859433e3007f863a31d724b1d61d761088bde908e7386509f6b837bd331434397d3730b6677830da6296d9c47201520fe3e928b7e8e8a3ffbd8f367415f6ca4efd5701e42ba4c4d91a5dc321464a713928dccba4ef43be47a5bbadb9b688ab5d3015bce4fe747a3f5836f718066285921f93b4d97b39876e2cede3b779457be77c6758c3364a7a0c9bd7273de3f405e09736ee8375abeb535959704fb4c1234a55544f5fbae01f622e45d3a27f67802a64535fc9f0c2384fa16dc002348e0ab53a493194ebf0976656d4be4afbb624dbcef902ccbd3712afe7da48f0e7fe6fb20e8588b2f83034c0b6b81dc28e0df1ecd2ba5547baf91722eb5fda90c9d859ec7675745da53af13f23b94df85de81fc5b2a18a8e6b4e3db17d8271cf8e1e0378dbe63e47bf795b127ebc7d84124fb5401ec83fc24f8dfceb851811adfa31f7fc1fe5ec805853e9b3053c2b02b594de1a2c164daabed63f538b9702cd7ebd73b84f5f4033aa3be47686edc80cfbdb350ddfa91f896fc5c5686ae18fa8af25da1b536ed0aaa27eb0e5af0ce7e0d37b556f64aa5e9987748963de7d1fcc414a5a3593b4ff626c9e0de00c7b5e4dde49b281534b99333c53ad4752f7978e10c59ccd210dfbe9c2f9bc90598bb16e25b9f6759be7959688a0571c9ac8aeae0670897a0be7490a36063fc2132fcc7ebff626501070d615ea4b1c1e97df1fd34c5ca6a72f118b12fde3b26f11b79a7300ec2727fb70de1ec5ae15b949f9a60162d2f2d3551f2b00eb5ae6e23416604679ce4e38df2ed36a8f8c455bac0803a496118cac705ac0d316ef8cc749d19c2e368066567ab43acc8c5e76703ff3431768dc1aa76e1ce7ac2cc418c10fa706aef047bdf699d693c91c203378c1fa6daa6c37aab3bcc0e536268a7f19e73e22837b46d49cef787dbb612ae6108e1044e9d13a5630672713642fb8e672b88d241979d23e91f82fd1a0893799fd3b635602d3f67bdc8a16f92f50bbf3e01fd81eb064e741ec2fa375e57da7b9d8fbd7e4f1fce11681f6aeae8b5a7e9cd8bec09a703ef34253e8a4be97b08f682007819f98201277831de41c5d2e532fa3f1db0875b047927680a8e0931f7f9307dc0ac13e9833ab20b50bc0fec50c07523b1bc5491df25b745ff27efb39ad2dac0bd4d573a7e088d46db07500f02f9a0acec8fa29b58ba55056b3b923bd7e76ce0b388d539b53d8bd5023f8e58cebfc9394b9fcfc2fe9ca03e277c2f3eb9ce2ddf93e47c4fa81f20df1672e6ccc68c0f43256129c75c687261427225c03e835cbd66c3afefc198a3935385c94455da13c88344b250cc6d8d4f1307ea58860b31c83aea37e5fb7620efd3ae60f9049f1baba6f81a51d725413f4fcb607b5bfd9eb12122173e5e1ecf486ecce5b2b837a8609c449d9350f0659a2120df2cf84a8ea6dc5934c5f20ed75ede5726536b25f89ce085807fc067535feb02cca1ef38901f0c3365307d6dbd35178f0fece06307883567ef607b422c0ffb3e181ae68bf340dc127a0b2db16d057e3bba473fed6b41b9a8182f5ac6bc775f3a85b80eed6bbce6bd3f8f6dc893ffe8d79a7f7c6e29ee9773b31c4bf3a0e7a55dc2da7ac359f9bd3f48be7f447e61247ff0e231b07f4c9e5c5de8e3e89673ba0bf392af7b26e91d7ce533891f2d0e9796d8784b102371668caff97b2f8b3ea3f529b75e65e45abc7e4dd4b3a1d8d40d70df8573bd6ae9f9f58c8e4892d3fefef1e02b7241de3d1bd744fab87ef99aee85f1283a888a73a03f9b36ca0f28876bec3f1b3da2e75f4f87417e6b119d4974bec412c59c906c09722770ee81ef8f79aba25c0a2fd627e2fc2f72c6550e7f6e95d16931baf86c9d4c2ed0cdfebea178861f8f1ecb24276d38311ad2c180de24e08b707b13f05f419e1faad7f2fa5b107af67bd38e07bb8927ab516c90e4f191bc5f9e7c23967e13f0d76ecbca4e7ed95a3c32b49c14c7bb232d435fac3ab71d9362cfa0fb1af98a05be1943912c9d39638449079f892683f513db46a76a449b8d3aaad3d3e64e90e7ebe9cb4f445b9d95aa748e9f4f567aefc5697b24d9207eae19252bd15a3d99e73d073002e0463f7fe037d0e741debd27173f629df7d2f137b48da9ba57ca964ff73596d26445946f71d890a0176390a70f75f332ecd32b9f9e8fc499a4de533a52393bf8cceae0bfcde273db99726be5c56650ec12e93e55d1ac91bcb7823ef271e71cc8bcbbd78026ee1ba2e71ea901350e41ae0f5927d83066d5d7fda17ba17c4ab12ea8b203f9de4b5d74b2e3416c9d4f2083ab857f8aedbeff00db3da7bafba5215860ab7ebcafc005d9d65beb727946fa329cd8e927767d737f12d3386f3fc1677ac47d5a9958ca2b77fd23b7cd8e9feff743b06f778e242e63b8923052505ed31a6a374cb17c1873fa7a22d67696fe3f0b03c4e100a7e598aee39dc9abb534f2fdad319f4dfa303b667e662c274edeceea9b26d8148d5691d48a13fe3445077ce82be8ede1c9d1e07da0df9d7e48932995a56e430c12ea03c00ee92c0cb7bcd185fd56131c38ff899e87f969cdc93771b6d10685158a11e5112f4e413683bda629da52cf4b47faf9d09fcf9877b2dfcec1c467e0e2e0fc12e461d405365beab9e19a23e88733ef1fc603b44f16f8ff91ef69de5fa2bd019aad22d90d7bb8447c326fb3b34efc3ce340ff800d58803d443c0dba6f04fd2fdcf24a174cc083c8678b6a50aad6f2cd1d6edeaa95b59eef20f9a0429cbb0a7d082461249773c6817abe5d71474aeb6856b96a52a230503c96af26c42a28bcc45e1cf1e48bfc8a94fcb889df96c112b135d9ccf752f7017fcf97f111ebe75e33d0109e85705d3cfd3cdd437ab4dff399a44ff63d9f49fa3edf759dba4030f2d53ef2f3f807fc4b8dd94d78871b3bcdd9394e2896556c9eae83b94ecfa46ac77d175fd79e19f143043d9b38f6aab9a7639f1178235dbe06ef92669b27c547076456d9997e8a385f4585ee0d8c6a68b02d05980772d3b1ffd6325ca49f9690dfca63bb45c84a9e759fed97f0ed3bbff713f18dd1bdb2778097d6585f82ee07db15e128f0c7e2d927d053f773e9d7fbc61ba9f524da93d4f77cd9105e33c67b913667525e7cb04f39d23b83d81594bdbe3e998f40e62e0952d160f3bebcfb919c95ad61570a069c692d723fd9990178aed2c97e7974ea3a8e2ef626e346c532c549b939ab205de3e9f2d2c99a4d5cc386ef15bd8771f2ca6d59aab05a835f06e5b9523c912a832ecbc5a8aa72e727d8a066a3cf5d9718e36b3fed5727769684b748cd2a9cab9e970eaa807a7abb9ad2423161e3c0616fa4d818dc7605d81233b4d713b2d7244fed26ebf8bdfc3dc00f5b53694db5c1d5382629d69f2477cee3933965077e3c8f0c281bad0936bb9e6f159baf9e6dcfa9a323f5f23fc04e3df57b84d78ecff526f50469e780ea0a7a72017a5e668db9df9390d883d911aa59dd2f0ddcaf32b043517f806d9a3f1bf97190af047c6bc3892a58075d286e011f83bf5c877a50d80ffb65311a149d377b37d1e5329c21d43ea173819a40d375b6a6d29e5076f044739d8391ef2ddf3ea6363f6adf13ed647ebb80cc8ab8bcb71bb7dde3f9f78fa398daa25b3f0ffa2b99d5dc5a17bcfc96bbf8a8e77edf5b3e9bc52e5d23fbc9f3137cd41f718eadad51bd5ad7fc8a5cb6a418213d639a670f4fcf2d38bb38de66afd4f8626aac91b2a978b05f2a0ea4678a554196e63006023f78999ef982e4219a35fa497dcdd877e0fb952fb5a3cfb17753643b7da5cbf9eaf939e7b7aaeb64d673adaff53eb812f706a5ef9d1697ea3612f04d5cfc69869e73792c7ff61ab2391076819ec66219c77e9af0f95acff7a13fb76308e59c017b765fbcd6063f3065571d3459738037c06682b9f2eaa0827ca8b8f706c8cfad290e69db0abfcf6d6d29d0ab63519a7507adac31974e7aac72fa65100e1c2bfd05e044986705f9d2daa96e4cc893b2800f6d5413fe9eb5835a28aa7f51048fc26ca937859af11ba54bf8f508b70e89d21f23e067a595853e0aa1b9c3b7c9dff3620998be0319da43344eff5b9bb72c4df0fefe4dac4fcd8664e9d5dd4477cba08b098d413ce4ff816dafc9bd25073ff0f1c5f9fc412ee09305d0a4817af0f7427c4df1ce47ef03375fbfe5d36cecdd96a5776e7e007ffde7eb833cf0e62af0d73927d1741fff5b75a523934fe702ffe878ef0dc15a1ad5a067bd560d6626829c191dd0fc05e8013a5565e8ef03b5c785c9c8afab2fd3f9f6887e5af93695d37c06e6e2c45b67632d82b308bd617aff547b94a67fd83d8ad1435c7d696a7bf673da373e1bc9af6b329394e691a47e4e77e2918e26f7972a3ea733e35651beb157fc6f1b7aaa205c2de61c4d742cac17a99e7080a5d6508f10f831dff96357e44c52d67d76cc0ae255efde99e1b322312bac6fe4963fdb129fadf73d2aa710d1b020cd180c772f597d3b5c1dcef30bde211da3a46293489b96c9dd944407662c655539b7bbc25f8b669bc2ec1b520f62c27dd11c5be718f213364c0be68da03c5a05e619f5eeed2bed3139bcc311aa83e85bbaf242ecd96bd793f63ec9f4c7dfcf22beff16b912fadd45e0f619f450453431c8a119486ff06eb2f405f553b04b913c62080eb2b3d18c2b31e8e79566e3e2cf7c9ec0fb13e74382ba81ec9b9c83596ed01b7346cbf454599e2ec3a364771b9ed914a96702fe96a5a329d6d7c615bd8bfaa7b405b26d157e1ea1510e3c928243b8f107e08e19ca059e50b9c013332c27301639730d1f60af46c7fee2e991f13917e0731c37b1612e73a4df19f56493909c7853cca5294ed668bdca72cbf61ca0e8fa389211cd43afc02ce3b7b9a1fe087ce2f4fd3fc637139c5db3e13ff7725f8d83b065e05f0ff066203b919f14d516debd66a2ef7faf47cd0fc458a57efebba73d9bbcfbb5b1ab04ba235789edefd8145b96219cf410dac6f81ae3f50ee1e1a8f7c49ff9b21df3251bfff171fd69befe87e32332af913eef4b653cb917c8518d9e4d7a77798ee72e867816e5837787d94ee51de2db42dfd1ab013d3fe8f57c7af5f3983f1e97bc523286ed177e2986af84702dd3679cd407230c2442bcaabe617b9153b32b295b3734b7f297c8eec83e0caf615f2133a38a9e4f3b51e733ec1b097d07adf137e593f0fd195a8cf2b1f8be95d3d96cd1f7a2ee17b3eea82b5566f3c87009fb1478e4368f1c0ffa7106b5f1943f22d4c703fad269621ffc2dc7b76a0572ac977ab8df01d85816956f9b66fbb257b22d7cbddf9d5c602b7bb8f4a42fe987ecc3b9ef49f6215eb656637928ca7660af12c959c5f5b26bd5950e88b694361f3f5df77c72a1f7305c09f5a0f0fbce9dd1b33eeac23a6c0332cb70717d3a9ee1da1db466ba30a3ed099e2bd5e6e0b9b8710dcf55dbefbab3d7495f74e623a873ad56bab8d7485827d82f8bfe6bb9f5ed7d857b97386806308ac9ce5b8e56dd4d0cc1c1b159e433b77471bf35f36d3c0fb8e5c0d9688a06cf003bde6a8a5a4e177713d5851c6d67abe55b96215a303b17f2ddb6868bfc5eec1c679e0bd3fd957b4470cf85e785f9d5d1e7d21afa1e9ef37bfc5b9091c03f53dedfe2df9dc5c368afe6d2864b96109c2845e86076dfa2fdc2cc1951fe05667dcc5aa274fa0d7d04d0dfe0e5e3fd030edb4ffb421c8ae550b3817c2dd0f70fdd1f744a804da4597c5eaa3f677d857b04a29cdea6e8e52ddc1b8b0e610e8028418d06b1bbeeb2c6ff045c8afefc4d70299a1b7b535c8a7518ddef889d73263a1b904128372d98af80fb78f874496808e54ce8f9fe02ec3932438a875fd82b857fd88b9b9fd80bf88bec2b9e0577bf5e6de7eae133756eb7c1a993aa27b5635007b0d6e49cad292da4378c637acec8d5cf27576d7faadf03ff7a5abfabb80bc7858a601f1cf05e93dec6e5a68bea42d3662dfd56b815cff2c4794c3eef6fc1f73012ebd926c4221b7d1c9f41398347245f515f7daf070feaff2bd6612606158b81bcfcd64fa42b1b9da2914779c6a83e44cf57a89a518803b527aa00be9e968372f1f0b3345172d13c1e8fbf8e86284dc10fa41ea2ee0dfd10c16fa4e1771f4e54a573d485ce12e28d5a95f4d68618d1cbf97b564bd7753c578a3e4cbb08260bd9549cbfc7f8b30571e8c348eef0fe961fb7327b85eddaf8ef32b15d3a6e1bb76f947e3e5b8f9f359792b9dfbdf578c8763c33ef2d499fdf616e2543ab77f121fdaa7996ec5ad3fd447cb61d4547c1f72eec1d7ab10e4ab5cfaa1cf6da153d4593e8f8437a8b9e9c111f0f5fac97efdb73f417d1ead9bd487f31bd5ed4a334c9d7f04b7b9592eb9eb6d267ea61caae9f438f27f63465ee47e7bc85e98dc1361f9cb7165a176d77751b29bc5edba7d145546cb812dac76bfc709e9cd8faf363c4fad2b02b282fd09f6fe3e2d9c6e2ded2ddba5777def0eb0379e25be44ca365033ff6807815bb76522382d6fd96273eb78a35529a135370b2d4bc1b32d778a5ca304f0dfa3656fcbad5cf5a7b4ef0b3416a5579ea783f51afc9a08ef41467a7df8fa1abd9c53d8fd268949f5eab17d12e7d011dd37dae3c1abeb476f81c1f15968b3ce7cf918b495f88aeb47c6b6b2a2ff1f5fd4e65f09eebd453eace23e8bb94bc9e249eabc6e7f9307c1dcb97dd06a3931eb3321eb332fe6366653ce6103de610fd9a3944c6e1d3d5fa7da7fc1e3790a567f91a4e7f1ffdee64cf9379eb7cec02b885593f8e758b941f65e0e756d2fa01e9201e8c7d27dac2f1884f475fdedcf0337d75c9398d7e1d315e73101f5605cb32dcfa14fa2d426fb791cc65cfb1fbff1174e6ef038ef96e34afa6d1af1b25362be54f5ea84acb52f3302f1bfaf23bd37bd2db0dfd09746fe5604f6bfbd4f74bc34091390bd037c5c30c7cf22c4e8e05f759f9392fe11820354786c4373b16d46569a1fe28d2ecdef93be13d20326dbf253eb1b72af189d48f86509e8ee43af48ea067ac00b6b5cc6a506b4ff7b2d07ebf39287e6eef3de676802d3c927777eee95d597835afa7fd9c92fb3f95aed9d79ce1de7d9d379e11cbf1ccb09ebcf3b3a127c2ea439ef9f0c53d7c710f5fdca7f5c52db5cfd73f89cd8d38e5cbe4fb3118a82891bef14cad5adc2c36e24ffa24be34d66f83b0d6e56be2e5370e5e4bcc714aa2ed187a8ed31d144e5e434e1475fea9faa0db48918591beb200c736ebe7f7a88bb22d8714b6855a194f3f7875a0d4fa10cd79f5a2053f9f10f9866dc42b535de83b5d37e798621dd5ccfdca7a52ca0698d0eb233ae164cd947dd014fb8e06b429439d6968f6228f1f17d3f1afed15139297ac8cbf6f3c9dcca4f870db7708fef7c66cf20dfac10bf5cd485992fc59f0c9839ffea80b8525a7dc8e8eb137c2b383d05c3c343f7337d164c78b79343a4bdd3573ba58879ef460136f0dd7b474d7f942681272842197df10ca9b6f24ef97cc6a5034a759b5d6a6bccf8ec03e1c90772f1ebf71f403bb251f9db1a72476cfcc2441730196a866419496382e7204bf9c29ef2dc37e59808dade75b73552e2ec7a4b61162480da84dea6c757b8672aa55a1bcd24509f2d3056df0b2d01569658ace4e1787fe5e1a8759ba1e49d11f77b6cdc91eded9ae2a1f344e7be761af3eecd587bdfab0571ff6eac35e7dd8abbfb7bd8ae76ddec75675f05ccd3bd8a9785dbf958d4ab035bd2ea2034ed6dac07bf9b04d6f6a9b12ec7d075ef06c992bfad3f57c3b908ac9a2fda94f61468b8e9f49f577bf232d7bb6ac8f61ce7cd7b4679177bd67ae762c769913bb3447f2453f7ece94606d8d796f4dfa27f0e0feebec18b00fefb83e6cbfff82b9e25bc335903ebcaf9d589f69c897d4bef373033fe72fd86bdc578c837ec376c095b465a1bebf77dde7b974b8fb3a7fc12cba909eb41f73f01e73f01e73f01e73f03ed31c3cc0311f1f7b7a67e217d7c498e2e245ba28cdc1d7093a76a4f48bcdaab5d1f3bd891a60ed6590db88e28616f44e44eb001940c57113f9328517b9f90f786ec6c48dfc7ebef54d333aa6b4d26413e40c891b4dbe89c5ad59ad58543ce89f675324e2edd77f8a3db1d4aa1ccfba214650e5fe0c7ab11a87fb3e97cd0bb92fc697d6aaecaca264fa0762fbdd482c6fb4c17df7d9545a775fa7e19677777e664846ddf9d968dede873c93e8e8b98fcb38f8a4b98f8aa79c155309de25cef7c5135ba16bbe79747daadebf148b9ede2b7a7f387c7e17f9ffe80be58c50f607d6f597c66acf89a926fbb6e98bc3cf7dc10cddde70567eef0f92e57a047d6f93d7931a77c5dff3f54224968bcc5521382f0ae3e0cff8e34a94ff8ea291549e4ce7c328deebe17c28c08997c438e37037535b8bfa1f52b8f36561e4a5e9a85ab12026a3db95ee70d669f5ab33a80b47fda4d44105eebbd404e849023d5c3bd39128f1f5ceba25ee26f106b06b5f23e39e1fbfdeb4f82859efd57958f1b122063b45cd0fe3e9f9165f4f3f47b186702f449e390398ee636c8f847788a60d5edfd063d6c663d64668d6862f7302da8eb8efe7ea8f1fd892c19ad2f9e9267e9d53597afa9baacd95bf76328f09eed31423fd22399867a141ce8308f771e6d0bb0b6a560da1b3005d7cefdc85f47d49d631513eae874e79e894874e79e8945fa253e63063baf8f30eb6cbc09fed8ee7f45f63b3341b952dea4f05fd11c5129a7b87e676282d626bef201fda9b7114c84de23fa7fbc78fc4fa7154adb87abe89665fd27d4439f826994ff8f902fa8544ed919f57a40b6846c601cd9341fa12d1d081f84d8c06f84b54649bf8cff3e322741f4ccb3284099e09a539864bf5dcffede207682d57fb9909cfc5bd5342fef302fcd129bdf34edf7b2e1d46831cd52fe417f62173ad22f8314c512a9855ba87c98d7a43a17c854a563f30bc08b54ea17f6b075536bdbf572d4bcdf773aabc5b35c5e2167ceb2a9e3161345a5bd4e34b94663c7561cc1927c9adb3f020b900178ec0be545a599041cd4638efd7efc7fdc1fbc0fbde29fc4bcbb480def9f961adc9fda53ac8a1be914ccfbd246c781f5a9fe51c4d742c8c316f40dff8df72cbefe9a4cbd26c24bfd298c5fb7f387f01707dcb318472ce80d83f3f2e4bd633e7eb1b7281dee9786756c9d238ac8ff1a9eafabdb7f0d9fabdb7490f3ea4ab905de64a473d2f1d5461986eb3b0b829e1ecef572740fcb927bd2153e933952e93f2a3ed0aca0bbf021389a6bc5f35ab16ce2398793df93006027b147aa89bae3333e5faeaceb806fbad0b93f00c2c6ff6e43771ef68427df50dcb10445708dfa07e69c248467a209d9e52e8e857d8c06fc75f88ede9dc8a8fc7f75dd6af41cefaf4bb856f3cf6707f2eadf4eacb02e7ff2cf1ba3c8c1b513b7d679a8e5d2f1f6d33beae3be2ee04da3b770ee23626b72dbe4ee696b23a9ccbf3e13ed1d0f3aea0eb1e9ac9915b038fa07b410f65efdebecc065fa19a6f6df5b9b3be335d1f4762f96012dd13d89fe7be73da33c93bfff29a2cbf5f2a9bbb81e931491fe07546be27fecc97cb78ed6c8e0fc1cdbe1feb0eb2ba429e75ad0fc6c8a379d5febbdfdb1fdf23796e7ecec5ebc410cb47c8936a8a7eadc9e68ef41895d77b767e17cadfb37380ab9d3be73d4d518eed3d6a9f1046e3a94f69eeaf5c13f4f4cf7aff579edd27efb303bea6ac319f5db6bea4fc9560edb43de4eb45cfee8c8897a4eb456efd98a4272f898b44e9c94f120f49d6ade7ebd8cf1ef7489683d15832ca962257899ae30975db6bd5950e884694f6b9313e9ee7910b3d177403d8626abe1faff723744b0cdf8766a692d9e8dd416ba10bd1fd2ea2ae549d9e749d4f675157c9a8152b4dd1da823d15e48a9f39d7b6966eb3245d982679e451047d9e3dbf36792ef3dcdc1aeecae6b80f972f28e9e2ce67e398bd6c0ae5c3e840c982e96e6b50f894f30c2ea5273e59114737e1b348fe1dfe0dc3cff1bf61b07b145e63f6c09b471e718ef8f324d993e82fbfb58df02643afad3bf826673e7e46beeb6b7c39bd81d7fb4ec77e70acd320b7f2d7db0e07148fa66d5dae774d7b568a7cfb8ff3d7a802f48ba474fc07f9697af09c6b68157e3fd84d4cb19cc7b579734d2ecf0db7be1bc1fc0e7907f1e8399c27ceeb427547403394cd79e77ac30ed40c921ce71ad8706fd54a56cf9ba8deb0299a8e2e980755a92c0053ebc82e33e17e5e1e8008b30b40ae18011e383e68fc5c1a2736ed87cb66a703f480e387cc677cfe9b77382fe4c76bb4b6a6eb808d89e2ca3057146c583d2f1d2fa59b7bcaf1a1b7e784f63f745d0f7e38931fb02fee4d46fdb0efc017be4fb2a60b57f1470ffb8c10edc833a70739569a38fc3c744ffc9cafe8bd08fd5ff4de0fba3e8fae55a19cd3e7fdc37df00c7ed6559886bcaf97e788fc63c05794edc8e11323764e344ff2fb26c0f755839a59b3d12afab89bef1dd39e916c93fee7c595b06ff0e3e9b4efcdbfe2cba98acca5c2f5fbbf0c437bcf27f488d7f3fbc9c984d964bf341f85cc2afbf8f8e610e438ee7f76053d06bd64f4b9e7b76e8aad6213f85d94bcbe0f779e4b2089d24a53bc7da4e426e905033e515b539a13753e9b98e1effe7e7233620f52724fbdbcc498df71d11b7ba5d31f173dc27b7162511e6cda825c3e886f52b369839ccc707d1d2d4f574d91c8587a5639eaa37b59ff320e19cc5edc3299bd40463b246f17d5d97dec3ef0e6afb2fb9060d3c4f28b14a10bd8cba345cf4f6d57d61ae2f516f2471b473edeb9eaf9e4f2de03f018f815a7a6189fffc841dfe42a45c40482f88c8b6255b4fdc873a5da983cd7c5f49a10b7198965c899c2bd837cb9bde956cbbb33d748c9ee2bdff14cfa652fac631cd0959ad23afff7c931d6ade6425d56c8b7f61bd3401dfcb2aee63ad3071d9c45076b5d682df5b02fe937a683d7892e97ed91bc877e9b08ab3545888bb61cc33e331ecf9c1f0fa6e438c7243b918316c3e7c8f97beccb69399e3eebf0fe36ec03e2d2a9bb2d959f12ff5dc6668fc2bfecbe45d6aa30f7a3ed37667de1b544e1e91bfab3503f398a3e3ec82e9386b32bfc038e34782775ba8264371b9da50931da7c1372a7b29ad2da684a0ffcfe8e2150f58bf7f21fc0fbd5701e6afd82774d93dd2972f94ef52d31b5acbfd61f8bf20de8fcb1da3e4d765de6e30ad3d215be85573f7f1ed5dd362488413176de7d7d0ba76b433656a5d9e82cf47c2b0b7e8f7103d5624d18fbf0519b756e6dd61cf6f21eb388d073aea2532a0706d53743fd20eeb30ceb9c9840c7c2706208e05b805e38edc94869038d804c5ee35944f67840cf9542b5e69057933384e1bdfb2c0c611dbeffccab5f25f5ac3e8de3f7045f7a7e24968fdaa07284de97d09f593d5496c601faf5f41dc3cd2d75579a61df20f820a6c88f0ab5e0e89ed2f1a37af8406ef9589466e0fb32e6d289ef8bb387a3037d2fc64a7f01be8337d9d978671e92e9b7a37db705336497fa1de475cf756c5d74b65abe6519a2b5540f95574dd6600637553378517cb8427a8d037d1b505f6b57f2901f34423dbb3b0ea66f7b24438f95207fa8097bebbd279ef9da81dcb2a5ee7656a60cbd810b1309faef8bb9ad264a2b52670d3cc5f434477ce6f54ed6021a7c2dbff7b2f5f6ddf3e9d2f79af01be20f557636e0ab8659d3a6602d556102bd4b8e808b508de7e9fdc2bdceedca14fabe8ce4e2546f48d0d763d3ac235fe81a62ff90ff0f326b243ac7d33d778ecd86b4a166a5817cea0eb39dca7bf59efaccafeff8185e9b07bd0668d9f041f83ee85f734dacda9ba54cfae05846beb3550507f744f3faf7e09ec0f03dd8834940a33de8910f393d0bc84d1a0f2ab636a8586cbf203ddf5fc0df35b19e5571ed6253048c8e6704dd99778674df041f77516727d68f86509e8e64afff9d2938d95115f70fc6368459a5fbfeec7c9ed2e57ab129b69ca6886ae396a0b70d1bf563616a452026f03a813c4d4c13bf5ddd9c293a59fd3e35658836ee5227f70be61b013ffd823959507b8865fb1d6af448efb3fbae11d9229de9c7cc4620f6f06356f66356f66356f6279d95edf9220ef0fb91d2721ef3b31ff3b33ffbfc6c0a137ebccf6a16e0f02bed73ff3e9eadde591a5007ec1aa88790261767d05b10fcf2d81f65e96e2fd41b14e24fa6dcc99a884fa36c797f06759ace48d613e7f9af6a3e4ef2e78a85703ba23d43708eb8961c68d2416b73cbd0ab0ffba6ea1b0d6ac61bbe1e01df684ecdf773a87fa588fd347e9d441bdb4bbfc43e0eefd77998cb5bef3de68bfd02ac4efc2417da22244e13657fe1738e3c27fc19a71f02f769fd78d9819e7395bfdb70a51dd43151744dfaea4e8d39c89710ff2f35413a9ad5ca5c033f20ee4fafe735d00556c8866f48a8ae146c6cdc73d8d245a83b77b223910b77de52863455a5b3a07c72074d21fe147c1e36e99fdc398c954a36a8970239e0cc21ff0bd587b9285f0be4e316fcc8b03e23f067d0fded17f0cca6585f6950b7aef417fa5d65c9ed6247117b1ad074d4bc14975e7324060c9f6504964bf08dc7bc4732164af39d0f4e69f47cbb2c96cf728e295a5bec770dfce6b837358e1f1d468ae668b5745f1c7ba5f8e6d88bdb57c75ee0f78ede27e4c3eb6840e342cfcbc70e7cdc980fc02f0df12347d0e4337061958f2faecef7fc4fce37753acdde207b684fa3fd2e7157b7719af344e5aebd0f5e5fd7ede95088b551a3aedafe349fceae08aabccf81af6798b52a6fefed42271a475cb3fe5cfbbd79cbf50f0652a5fe3eecaddbef6abc9d1e43e7dcbd3fd8df61b99f94b3c5c88dd85e20ccf748ae4094ce2117e66bca1f10f10ef87e744d44787dcc5aa27418be47a4af9ddc9fe032fcae2cc6247e8a832eec43fe8754bfc385f12278ce15361e892f827d0d586b0bfd4520bea3bac389aa748e23d9dca07809fc9f37ab9df48648d42729fae3ccd84e7915f485ed3b9aeb6c080e34dcf20aec31fde0c5a98c3c9aff03580ae23310b7b28c79bf88df2709db113afbdc7198c1cde330a17ea517da4067ad0de79add213e5139e8f9970f8913c43e334c3f777e36f491583de2228fb8c8232ef2888b3ce2228fb8c8a78f8b804d628865c833b67571b88658b2d9987dbc9fd3693946be82621aaabb779a751f275e8aa52ba1b94503823ffd3c46666e65f1886a88c9cce4797b628a9665562b0b55d19c66837e3f6705fdc870af34989384f82ac0c092ab813c763b807b3ddba3d17274d003e85efd2d2bb7831c628e7ae5141ee0a67da0f9f87d0ffca8c1fc2c6277f8398deccc0ae350217b72d485229ab3a32abdc948ac0b1adc4be9ece0ff4732d4bc415f3aafa604e6d478fe3adff609ce0ecde14176c54193f74073023e4f46af434c0ce587ff76795e98cfd623b9c76163dc10a33e30f903933f30f90393fff33139d2c338073469b625c8fdefbdecc9bcb4b3f9929b27b9f010f2059ec79b517ce920dac498c4d3cd38f71ce97daf8e807936c2391cb88439c30fc1e8b1fb82e253432453b00f7224d6b3142f02d6801a271a83c0f7007ff0e00556e644e386f4b9c9f8f791fc8a3fe3cc4198f797a67817bff670a4f45757d64064d13d30ded344e768887b6b3cc0394a6e5d18c926e6a7fbfab325cfb74b68a8d26ce07d45745f998d805e44a83940f9451b55d1965a304f398d6e92e9250a670e2aeb9162395a2d6b43fed2c9ec13ef3773c32de78c24bfaea239c61ce2b780a9a1a60fb0cf308465f0fb47e25cfc994f77784d2ca625f2d5cf03a7d79e262b536564946c9ca1fe9942a776313d9ed0cb5b3ee13df8b103f8f0aa781f303dbda4eb7d222f62f6e98ef920f80cfbd5915cb460d64fc41a8373a5e49b2f935c6da98b12895d109d7ae9bc686e3a62af54baaaf2d019ac6578d6dc470edafbe31dcf9723fe23cd75400fd99a0c987c3819814e16eac726eca3508698c04455da931eea29a7824edb7af54fa887df0cd5a988ce067c0ff03c3413eabd18d49c79ef3e851ac2ae8d6a31410f2efdf9bedefb607d692e61ce6354bc11e104d1db1353b01c3dfcfe70bf9986fa224b339e5a4df64a91e9ecc52de3d90b647edfdb7332a316f93a3ef25ca8fc3154d309f94070797b8a7887f89bf223987b8bceb77e3404678eea60039f91ebf5d631179a5c089d09ce3142e7acf1d47cb2578a1c8a3aab487f64f5146f50dff3ed643c577a8de77b857ce33c7938dd46825eadc6bf0b277dc5612176b6f4a9dce0bb2fc31bc557fc6f5bf3e92234db1bf9dbbcf3063dbe46be4586c73f2dbfbdd333c4a9dc812b678df3ea65722563b1285ea0745cc47df1fd287dcaec079357f3b13d385c1ffbd94d31fbc570cb5bb396b5c7b274bc838d5023bafd2a9f3dd435887b07e744219a47be71c88569b479fc4378cf6e82e5e835117ff85417f62b4deee03a0c987f8cfcce6053df758e4e9c0f2438631e7f28e4add6bd7ae92beb0954c1da8ee4483f7894dd106fb778f1e29bf868cfdb0b728ebbdbf887b16d3512cbcb2beb3d6c55eefc7c937310ff730c9bcfafccbed315beb3473cfb11cffe2de3d944967cbc4fc2971d93bef74c3e5f6d948f76f63ac1efcdea3fd2dfd96d8a5e0f2488b76a36c4208a59c8bd340e1c7eda149ae3a635446351ebc67e34fc8e273a12cf75d5ec8ae7c36ab4a3f47c9a9e267a9edfaf1625ef7ebffc50f0655b3acfcce770ac667bcdfa7e451d20c406cd1b3ef3814fcec0275846c4d6abd6f6acfcf6652eca61f06c566f5ee3ac58f37c2bcd38ba4fea93c6f81c93f5a4ef7363e4ee80d675d3f37c79513e3c492e2ea1be94e9c94fd9e87e2f355c9fddfa7f60339c11df4eb663ceb767c8053e6a162721dbe68a35f1be43b2cce6b3bb137ccf9d15f82c3f5d2c77e6bdd70df068d27c884353f49e03e7a6e2f814e9b5477a38428f3ae45713cbf6c895a6e619b588f89cd3b0f9d97e22f0c7f684bde5cd184d9d8371f13a79df2bc53ea7e938b09528f971c3989a8febeee1bf21cfe2a4d328fa84deba59632e4dcd867400ff38dbdf91c80ce4c7237ddef04c34e83109facccbad44b998502f6ba9f959800dab85495fae436ded51933b39a84f857c22c83984ba5355ee14b19c429814f59583d888471bbe3f87d4aa42ed12bc27d4b3aa0314eb05de82d943c1de8bce06cd60743bcedd7b509275933edcafaf6c0f0fb68724b1d74275ff7e6eaa20018e82d9baa8cfb8d1682d4db73e1d63be81f37fb32b7515fa0d729d47250f35e023128b78f57112e9994bf7a3b4a0bf80d7b3a443f235d1398de422cc7e8438e003fbff87637fc0fe219d6cdff7d928d670b8ef336f6debf03c33e0e1ab9f9bd4732505b3fd26f97827be340edf4e928fc7913623f0df0f2a4b1a8bff2e788db5a7f08c888bd7c4ad13afc466141d44f9b9a73af4a53d7c3abf760dbdd7f17abb7520176730f71c632ee4ef25b15603fc88f01c0168b1e3e389dfc3d7eddb58c896fd90757e7aff37f1b762d94a72b84f73c916bae0c4c47f62fd503bc32d1fb5f7e522220698ac7314a831e938dfddc9e104ffa4601fe47714865f46b5f6890f8bf77d3bd58bdf3777f9fbaa97beefeed2679af2eed2676ef54bf7685e3968834b7fdbb7507eb95d8aa0fd90af16f39e47dfe4ff626c66a0cf645be09afc5af2ee2e9e29a184e912f375a4ed813ff3cf31751df63f641d877fc63a3ad57fc83a06ff9075fc33f863d74cc9f5be66260ed10d77ae05d8196ec8562b45e019b0390b9adc83d95107f3d4b60bc5ecce8d7731f7f2ef876377717a703d5226a13d39538f5aa7ba3b529fc5dac5fde4f70b7adbbe925847e906b8f1346f02628c29e777754e5ad2f9c5d1b12ece4ece2729afe98634bd1a0d6e226f48bcf55c9f4bf85edcf24b1234a55534450be29090df073322c2982b7c3f7f3f62cf4038c59967f0482e0a6bbee5d3ec12ca9e1f26cbbaa0dff5be86ed79fa79b7cbb79d4bab34d94d3df7b21ed089733be2ce7cbf3c39dfa478fe0de58629d66d6696f1a7911d66e3b4f7e39d6447d648c32ad4734fecf4c199f35ca61fb47f624c1ec94dee7daa7763f3536e4aaf93345ae5c22929b8e2bef308e7fd599a5cfaf49812ef2739a7b867e1dfa4cbd65fa27b73cbf1a9aee3a599a3214a301f6d758dae56237c3371ba364e16e2fff7d61ae2274c1f84eebcf506ef9a22d7c3f7380fdfc7ad97e09b489d7f1a9f8af72b31f447ddf7ccf57d9c0c8fa19bfd55f402b5a383049a0d745b0ccd4beb6b9e3fbae6d9f34ed670cb1bed70c53ddce8fed09c7b7f34e52b7fafbc5cf3fba52e1b67ebd113994974a8c70329bae6f526faf3e6767eea39439d7258579db9d78e7e159fccae79b67d0d8f41fe902e14e7d7dd43baf21da4883847acafe476d8089ffb2d6896e888737c499fd796f56567b29df2297d1c29382b95175ff9656664eebbd4487ebfb2971fe9d65738cf35f4bc8b31f2a99d0932e9717ebfeff92d6f63b7dd1a73a6c828d929dccb46f9d432d46d7e4e2c74819e0af6d95ffb3f3ee68130cd4df8ef53eaf79c51bd896ef8947213ec1efc9d38dee3ae5f53e57df694a6e3bf6fe45b538e1c564b9f3bd27036fba83db06e43bbb7d61da9f2e35efe8a688c543dc53e67f144358946aee30ffa4aa29388efb134c3ca133ebd1ae4c52ee0ec6e2377b13c0ce4d24e933b4b55defd6a5f6e2aad418d8aa6b4226443acbe3bdfef784aaf4b1d7e3fefdd4cbe41ad812a67bd5ee30de9887b56ef4dd93968f1b5bbb7d04b51bc41d619f41561f57cfa6fe33142fa6f116df7947e548c2e72ff46401f76731b5eefa9ce279fd3bd19b14c21cf86be3b8e243a6b6d904375546f90532c4b5f74a11892cfa979e67175c05ebf5ff459f02caf660bf7a523e73e31e13d94e556776f56839534e3905a3bc393de5a76aad2cf6a4a8b9ab7d7dc7ccbf536df0ed6e8dff5d5cf3729bbf97688d4a7e1e7e27d647a71856836e18c6056d1523fc54e893508d1f50cc58a0e73abd1fd2a9626f6e13d8f77d8ebb8799248e69882b53445e9dd10eb3027c39fc3a80ae5952ed6778670ea2745f438af007f38864bcd59b40b3f7bd5c2cf783c10b3862a072dcf8a0eaad5845e998836606e443d6b8ad241b7cfe877c89f9fefd3e0c95940ef70d4a3b0439f1591f7a733416bfb13da0bb0b974180d6e435fcdba3418bcefb6d19f17be31eb8b7b4629623ee54445fd47bd9a18552e1625a9d562cf199df1dc5c40cffb61be62a9c230a00dd017e2de321a9dd3fca3589e6f194663ddfeb7b4c67fee67df72bbb5522d9adfa68bcd37da5f59e5debfb47d1c105d647875c9d39128517213d6017dfe2bd03769d09fb527ede3acd0e1e8fdc6b9ff142df93c16ac13d32f733e98ce76db135986715eb771429bbe4e839ae037859e9773437a0ccf9059351b9d1c9a1bacb4276a78a68b349cedd2f938857fbb8d53fe6c8aa867b48769dcfa662cc17b31b4e3d10dcc6ece6a5267a7820f26a043d4f74f552ac7482c748e3cc07462089d05cc96e84e16ff9379ca2c473fc7f375e6f9ff32ddd924f39cc93c653a23770c7ffbfbefa7ccc45e5b1bfd4f63e1fe652e8cd9f8e75f8b95317266f6faf9afef83eacbdb5f7bd7f96b65586377e47df4c358b8cbc57c3c5ffff0fefbcffdca84fbdbf37f2fe04f73bc1ed9ce0afe3af79e94f0bba7ccca3e8e33cfe52f852fc5a78cbb30c799e78290457ffdb1b6d1ef85acf0e5bf73d9ffce95de73c273eeebb350fe339fcd170be572aeac659e32f6ea8769ffcc3cff7be4acc64f99d5013dbd36de669ebf14b342e129d39c2fe021b9af5fcab9a74cc7b1e7b3cc73ee29d3460fcce773a5d25366689b99e7ec5346c47f2a3f7e2c476616fdbd6fc2ddb24f9901fdbe1567e6bd7f215bfe02ff5c18b355e63957169e322f6bdb85d7188c8dcc73ee6b59c80bc5722eff94e9ace07ff2e572f96bb12894fe7ecab423be5a2a94fdaf92b5fefd94a9f27f55f9f16333dfacc666e6f97fb34fd9a7ecbffe8623b7c63fe1b56ab06199bfd6eef2afc9787e72d899a74cd35d2e7eaebba3b515a21bfff3dac2f03e781ffd9c8cd7dedffb8b05fe5b7bb436acccf37ce3384f99c17ae48cfd0342ffea8f47abc5dcfbaeb8a8dbce7845beed3dd9ff676dbcf4fffe3e5ead996fc37f31bf682fcc0d3cefff32f8f5e3e91cbda93dcf3caf7f6ec64fccb6a0576b2f4cf25f2367fda7bb30d17f4be39f2b1b2d20f7a750fc339b81cd5da2c711664b7c2adeceb3d8ecefa78c395a8f32cf996ead5cd0e5dd0452c0bc16b130b2b500a1143009b71aa4821db3b624f5c5b7f789dd3d16be61518dd48e3aa8147479bf318ecb02b85d468d7ed6a82db66f79336f1e8af9f6a1b8355c63db9ebeecda83b2fa3edcbfabd09e5b7eb543e2adfa32198bb9953e6f7fd1dfa9fb089da3e1b637baeb1ccd6a71aee755187fb6c5e6ef5697a5acaa907729eddfa62ff1f7c526b3679a963700c3a004c86cf477dfedd256cd770eeaa1b881361b6faeb935edf2c63894b14b88efded81c2f375d2b6b365ebebc1dca5370c1bdb9c5a591ef6d34a12cbc09c5e39b80dd2dc7d74dbbda645470c537f7fbd02a6a08ad40ea39bd0121df7a4e95f7a8559476fa3b18bd39d7943e6a75697810e7da778114ed23a8a9eea0097bb06957736b68f36de4fb608e1d08cc4a84d0c925c6d0aee588e98294157787d94ee5bd5a69214840ab6ebb5243ae26a10826fc04ed915ccfa2d6303e5d46b686093d27a0f942e4ba98fb05fb10714f7a2c7297b8d264e4e2dcea30a2dd6b65141a9f1c719f833fce29fc19f97c874d66eebdc16d00a09d8905e314b401b88271cb761197fdcb2d0797c02f23eec7b470ac2c75bb62796d0850bac21f217eaa96fdff476d72bcb62e59bfcd22d98f461bb791ac40fb15cb38b0f7acef5432868f1911d043a5fac11a55a1bec1aded97d0e6c5f0df4f5a77e77d4715ac0d9452ebd5d0efbc360972f60fdcf231f84d08deb58aa1f7f6ced1d1df0b9be6ebebc4843448b108ad9e56a1ef55d1c8018bf93f6f8c016aefe34160cf7d415af15b96e1f6f3309aab59b576a38673c4ef0def278c9456d694ebab6f5e4ba1a32e685953a81fb441015a3fd326e961ccec11b4bb51d9fff35c0d689d60ba429b1c95be4f753731f212b433f2cea75139e842c731f2ed15f9bb06ad94063baf5d8f585e8de44e11b721daa5d0f04117ca59aafdc20534ed1ce3e8f04dc6a3df3d788ece1a8d45782f80fb68fd7d8e53c7e8f7837bcd5ffe789f9587fdf705194541ef2b86eb707eaf1353281f461e3f4038700e268f71284c7ac474503a20b3574d91a627f43e680d10b2d0e733aa851db4fef0f8d57b0e7153f6815e8e10228191f5a8bd5dbe39815650d8e59ed560acb290fd425260c9e5add51f9f175e335a6f6babe77ba97be7b56b2f7c634d44469e63f3284207b06e6ce25ea05b7cb366529c2e49d72771ad2a7aa6dc5a516d15d69ad2b734b19e55af181f356cb4b6a6ebcc34a57dd2524c9521bced8f31cba9ee9e6a0b87463659a65b5fea62dd1ec97baff5554c4bc3c084edddbbd57ce2be79ed55206ca4416b95e3291dd76d3d2ff9e3ce987ba1115baaa25923796f05e36be3dab204bcf4bbb4b3a764d71dda71057cfc7deed19b215831e310d3dbb29fbb3e481330abb9b52ef40946659fbbff88e7fa58cebecff3c87e7fc47389dbcb702561a474408eac47a27334c5f2613cf8bdc6b69a2e6098f2a6f96a2dc15e30e6b3495f2e668df9ecbc3655fe3318993703ec2741bbbfa23fce0887664dd1b1f46a6505f69c274f82f7310ec18866a6a54e2013ab48b71fbcf21e48ffee2c200d5517f65b4d7080df267abe358336b4dfc4d9461b14564d31b735bcf68653e041c0499aa22df5bc74a49fdf14b5a531ef64bf0d0afc2d8ace6853149cdf09f648db633bfddc501b542f5c34ef03d6847d0257b4174a9ef721643ed18166aba87511ece112f1c9bc4d8deb8ad56f088b02fd83cd053a6004ed1cddf24a174c8cb5cb074d940ecdaab57c73879bb76a65ade7bdd69d2ab4edac42cb4d4918c9e59c71a09e6f57dc91d23a9ad5194f18314a9fc663122605202d1d825cdd4664183eee6243f1e1f4a690cdb9a4d6dd3c3715619bf01e71a903317b16198a8ff89e2f03afd37b60bf1d724bad7a93359fb3df6007ec9af699e949d7eaf90f1c1b9ea403a11dab36b8fb1eaf748160c7fb9faf37aaf7eecf65c602e7c87bc4ca14729dbce76491f8fd66f5ea7590d404df97c5c30fa1b658d5533b2f21bc1791be9bae1b7d5b8ec11f43321effcc768671b6e1c5e3d83f21260846f07bfa5f136114b1972288fc5cd806038c073e0e7f7437f68799a2b4e6b1d9e82bc57ea3af745b8eb7f423cc7bbde1acfcde1f24cbd208d9bbbd562726eac4b8f2489a1ee2f6c25b7b8e8c5a22f6068aa5c8701eaf6be4cb12cb1b4370a065c61ad2f2ccc66c3d12a462a8cc38b8df1164800a58d1ae1420f565548bd157f3f098f437058f7767f5a0775f68d3bad2aac13d7b8005c5e17ac4e8304c2b645da194035f3e611f569ccda5baf5e9e89003ff0da4f0d07654aaed946a2f45b6ee6d59aab002da42a942b71f4fe8601e2f1eb1bf2918bd1b4a7d908e6fd3f4f6bd317ebf94548bc2b7a836bd3db4d715b2d7be5cb9c53ad2de2345aedcdd2f448de0bd1ac7e1b545be5b52aa36feccc79c783fe2f57b605b047b93aeaf5375748c5e26be90c9c0f78554ead0a27ffccea7aba3f5736102320974168e7f907dcba2f616e21e62a5c8271bf8638a476ae471ac7f12f92390cd0f3e22f03358075d28427ad6dc8feb800fd77e598c0645e7cdde4d74b90c36f341933b40171303fdded99a4a7b42f983269aeb1c8c7c6f796f9e4d3b07e40b7efd07f8646e91e695ace393cb08ceb501e780c5f60e27d66669208acf933091e7c3573a8b18ec737b5fb60b2528bdb52e97673042e86ecf9d233a83b3bfd33af17e2b777eee1ce237b7191b778edefbc071160f3bf461873eecd0cf6a87e2cf78ed322c273e1c67bec3738e57e0c9902d3244b10e7550413e4b9cc703f2680b256f14e64fe7b314dee2e627e0a119f21707f656834ead4718cc6a8afb2d89b3807ed4e532945c0636d78dca382e694110d33220a45778daff05fb9654569a583a1df9ecc472852a37fd72d371123d8fa0a44f696535c5ca86ce99d3664aa17568d7fb13d942e26b287e07f960f4bfb579cbd204efefdfc43a8ce0b3f4ea6ea2bb65d0c924c70de2276814a926f7969f641ce902ceda98f79d71c3c3321ae8488879e1712e77d807def74e8f51fa72246871112d3783cf8352b096a37fbeb1fa035ca2b3d56f303ab78fffadbad29119ede902ffe878ef0dc15a1a28c65c1646902fe78d1044238b3c5d5520f9835315fc0aee70027f8efcd13f657af414e2a356be3d311ad2c110cb4792cfc7be5ff4fe25eb86b375048bb708bd617aff547b9466a7b37b1463afd3fa8a2aab0edfa7b6673f0ff0892ccd4690530ef27610e611afd4ab38ff853cd2d1e4fe52c5e7c4e9634ef235bfe27fdb9ad2c6b91c3947131d0b97e753398ca077d74ec85ffb9eeeb362cf2465dddc3e2c72812febdd3b337c56c47785f58d8cca2b3d7ea6bf37084671211a46b9f4302ab69c333c9d702f599d84dfa3317b5c0e6e384f307887748c7211c61e36245b179d295ef30495fd2b9dac2ae77657c43f86702690cbab0dbc9c52d43200c964c8d70e6444b3615ac601e7a17a3eac5f9d4f3b1ca17a9dbea52b2f04975fbb9eb4f749a63f8e1c269cb7e4d58c0c512ed7a97d18dccb1937602c632754ae1ded03eb5ba628a13d7953cca5294ed654fb87d87807ae67a8813f9e692f733b5e89f043d2cff99818217ae6847ae6c4bc0dcfbc429b0b1c4fcbc27e237d2b869f47f2d5ef1d6b7847b9c4152a97b8928de093b3d790f60e640d67d8ad91b1c1047a2417929f609b6972f1182a058ff60125b764b14b91fa8394dbf7509e6be06bb9713cd0af8fa3714e2abe49c7355178e6d5976b747de295ba23c8e50e747c40273d1c9382677260f3144cce8dc50183cf6859efc705427ae2ac77bf5b6c2b9eee985841013e3784f8d630bf56e69fc6643e5ee697df7bd97a3b7cdea7582afa5e0c8e21f712735b4d9456d43def8d7b488d1fcdb3c8a67ccf756abd218a7fcf34ba1edd7ed0ebf9f4eae73f7f3896ef5332861dbb7d2ab763eec9c8d1502d6b9519d7dda0ebdf913f01ea9ac3dfa16a42896d47d50fff3ad9fdca8e6927f94ed477c4d07726862259bab7ae5953847c8afa86fd0e5ae3e0f7e413e6fe0c2d46f92011de8a6c0d1873afe07e31eb8eba5265769543863be85dcfaf738a95e3ce462376b36be0b866e06f19d1fc67ef26aadc9f418cc938ec26908b3d829a9c7c73a2e7fb0ba02db095d541100f3dc545297b948cf5aff6d1900b6c0129e4a3f9d87d38f73d53ec05f60af150945dcd5e8816e7d206eedb14738e0ebe29445bd291939fae7a3e77eb2a34e2c6098f6948bb880e9b4b6b4d69117f88dfe64f13cba1b6f33c57aacdc173f1e31a9eab64d48a95e66b7fa9094e1672d4de85168a73313a61f2edb5df1d0c665f917c1ba01e123b1c73021907edc9965023063e71d4d341deaff4bc796c8a9a175b56e06c24019e01bca00e2aae29ef574db105b9d64b5d300faa52598c64944f38855c39a079b66f00cf85e9feda3dc27aebc2f3c23a4d938bf00e87b37e8f7f1bd9aa2eeafbecefcee1e120379d4796109c18a583d97d0b62d711ef80ef47fbdec2eb63d612a5d36fe8238078d9ee0efe811e3c07da215f951b4cda8dfaad155fbd78cf6037a1b189568dcd5bf5fbe6bc55518d4f16f79ac1f93e77f6233890e38762d9456277dd658dff09b8d445f8f4f7c0a533f4aeb7c4a5448715519c13e7129a4a6b07ade4b16f7689e203a8ce16e5d40431c2464097848650de9158ce8ea0c65b2cdb23579a72f9ddd82b857fd88b9b9fd80bd97a645f915dd72236e95846b200f14e10bbf6623a23d47b09e5d24e4ca58363e8defef9326cb08356c207d3e3ab2dee71b555dda5a3e6b9623d71ba235eee5663fdd9493a895c88ae4de85b2314207eba565de980f486d2665bb7f3ecedb9cfe76e159c94bf12776119b201fbc070718f9ba0152bcadb8d8bf773f036df99dc13b78ad09747423d17fc9e714a250b3694295a16d464a058b94cfacaa17a0f90afd991a2793d8df2cd892a405ca903b11c3ff68b6a4a05c41fc7b102f94ed98d573f128c9f688ab86d7e4c0f3be8f3a5e1bc12235f715401fc25eda87b231ed3aa1517bf3bc48d8e86508651b659c4835e3b68e0a9a8fe656957baaee3b952f461dae56332daa6e2fc7d528b7a8edf9d2523def2815dcbd16b22dca6d84ed8374a3f9fafc71d7634cef75e167df609f578c876bc9d3e77a4cd086ae0068c5f35b62717d6cfb5cfed4362fdd3c89754bf7cad1fe827e2b2ed283a8aca5fde1807fe3c34e67efc3a28dd3ee3b1d7e87eb537c4a5d8965148bb741c3b983b81dfd0c3ef3c71b0b833e2e3e1cbf532c4cd98fd2171037877a8d347a331f6d01b94c1e08816dea69f9e56a778bc1c776e3173cf7bd36b0dbdef05b55971be86819ff31ad80a24dfd080febbf03c846bfc9c313fcecfd8e3c42ef8e4b69287e9881fe243d67f2e163b0377a5e60a53f7a3f37b187a0b639ba81c3af24e51f946e4fec4cf86fd1ddd462856ece72887d675879afd5e681faff2c379722cefd958d0536604714fb02faa9560bc56b5e28c1b95952a172d5c97eed77ede3b778d5d3bc21aafc4b756263eb79d2a3b876603e5384e82719bbb89a6583b5dac6f54c5eb9968907ad0c327ad4d27f839e835cc514bdcdc37a789ba824fae9f27d3a3e4f980d009670e595a3ed9c535a969347a06bd5e44bb11fd1efc3a64dcdb61a6898e4fa7900789f10cf01bf853914e027f15ce6700bbfb5c1f15bf7fea925e8b64cc63523dbc5319bce73af5943af488baf5181c9824c723bec7ca74e6cc237bbba4602c24433946c126d9bbdc766eb7c1898d6fe59ffe5dfcd29c18eb6c6cf57bf89fd3f93add87732bdfcd9d68f90a1fcd2ff2cd70ea95b375caaff4c170d8b3843603d979866c3dc7d7d26da4f0c0f9b8e6b63e95fbf952c89e27f3d6f9be93bbfa4c3e98b6b0adf0e9e84b46ef75833e0281edefd71313fb88b2f161364e7d0a3d12a177d8486e7f163af3f781c94df4eb47f188d10057889d85aab42c350fa333a1879a33bd27bddd309f87eec51cec696d9ffa7e97f813dec532891bf2c9b3383916dc67456c6ff045e9426709e7a655a9f92628ae189a9f45f79a9cdddbaf10de0322d350af16b4beb72ac9e309c735a9deae2466ebd7dcd33d2db4ea27f52f24d96a6e7da635202f35c2e730f8805e69e08b919dd5ddfbbccf2b0be89b76ffe74a07fd3fa6977e486f9ecc7b3cb94e7d5cdf62bf8bafa4fe5d6fc7387dfee8a7f7e8a7f7e8a7f71fdd4f2fa5070df443c77530e93976fcfcc7cd7b517cc7dafbd7da0a925c5cc29c1cb6068ec2d6a199423aaeb3f824b682ef9303ff06897d5eb126de77206b8ab70993fd6e49b41d4dcf71f12197c2aab233a3edd754bbb5b64fc2d171766a3bb0512a03aa37e3e5368443f5d1831a9c01b28fbdf9b4d4fac2b370719e229eb30ab4edcd232d866629feba3ad5e0bd9bf5709f4064d7d2ff87d65ca16cbfca4c93b5a5ee9239b6c14c4f1ebdf029fa6d84e5e5f657c6e9f1ac0dda679deaabee36526456946fdac17337aad6d6704d4b779d2f9846f03c5fe82951de7cbbae8686995d54015f1ae404afa879cd47f06599f2de32ec9705d8a57abe3557e5e2724cf2ab21eed2801a81ce56b7493e7179a58bd2d4141d411bbc2c74455a99a2b3d321ce81dfdd38cceecb4767ec29c9096066ada079042305d5423860cb832e30208f392fad54346bb08ef64313ea9b91b2243c88fa46425f055d282ce1737a8e86e90e27dfc45c4e6ff497c047fe5ecedb3cfd2593f5077fafa8a4797167d986789d51f8ec63edab7c6bce69f76caf5de3c3fe7dd8bf0ffbf761ff3eecdf87fdfbb07fff21f6efdcc38777b27d7b504b3b1edcc1eec5ebfaad6c5e82d5f9ec5d3c73f561ebded6d6f5ecb47bf002b6192ea7ffd9ab6fbf057156b43f969a071a2f7afc47c7a8ef47cb9e6d4ce59a9ff5aebf5bcc778ed76be7485e2b0f16e7c1f94998e93812cb07b37ad7676e0dd7f0e439cfacede9ebd536944f37a0a3ee6e7f960fdaaf99dd6e69621f7c55c7fbaf39f0bd225c70ba0ee63a7dbfaa1df75d7c7de8fb3ff2211ef9108f7c88473ec4231fe2910f71c77c881bda21106fba830dd265624257d822b131a095269ba81616c7d926dfc4e2d6ac562c2a7e16e4bd7ab5e353a05b1dc707a97addfbe5b532f13ee21beac5c502c5bda3a1f984e0f771b26388ffb9e56c530cec9e6f831da911c6f52330731a7c5a481692e7a4f990307d7e9ad857923db0ff107b40b0b6c6bc77571b04cefcd7d81f09b9c41f1d5b0c72bbd7e0cb3e59077b9dbedfb7d8efe2eb23df1ff756befbbe19a285e602ddfbb9a6d2fa1536cf2f99d31df2fbd83c74c6c888c922f6bbc4a6bd128f27c94c345ff143cecaf7ebfbb8f1d1f7e0d1f7e0d1f7e08e7d0ff067917b813ff3f915ef7b9c2d4ff2a790febd43fe9de7c3065e39990d728d5d108ea3c27d685cdcac5a4753eea01a31e8cddb7c2d0f7a59e7f51beaab897a285910bb330467aebb5efd9821c09cc49c73ef7ab7217e67e889c3c6d2315f7ef87ad3de37c576bf5f4c2f6dfe4242eec4b9736b19bae7d357d43b70c8ee24bde5c597ced759717aaba5bb7d3c23b0383f99691ca6af5553f46d04b03f3ddf77a82f08d0c8eb653eae33fd5c17fbba7c7f179951837c5d1fbb0f6931c6b87d4890bb2c0f52b47d7a5f7c3f9a7f7e65ecdbb771a935a5f2533afff0f89d4e64e9e96f0adff8fcbe0566de8ed75b393287bb21a13c6fd045709f916c6e70bdf551152c87b37744727e127f5e12d4eea7ee4bb28e89f4c13d74ca43a73c74ca43a7fc129d62c08c27777587daa160e67d5344cfbcc26601dba48cfab019369aa5037dfed1cc22c3c5f1c47ca5e861b1d7d05cdb881ea00b5501db06cdc38239a3f4bc8174be49e1136ebec0b1c4d33dc23d3b1a952d9afd017d4cc512d2970897282de25fd8413f45cdae58ccacf7935ea123b17e1c919906ae74a0e75d73f81f883efd2cf10deffcaef5d525c50d53f4d608fc237613f5c343f17da59e1b292de4f720ba2cda372b1d46831cd51fe617f69d732d980f783045a960562bf43bf1f1690abf7a79151eaf86e797960fe17f6b0755363ddaad5a969aefe75479b76a8ac52df888553c8bd168b4b6a8a79b28cd70bd21a34f9365748aee3fdb0f08bd764670d64a2b0b7cd96c50f9c054edde47ef03ef7ba7e0c5d49e5014bd45f5205b6b727fa90e729ede0ef7584c9a99751f5a9fe51c4d742c5cfb7103fac6ff965b7e0f2f5d966623f9d5ef494efa92a1f347b3680259ff496a2d3ade99e1b3c2f1f43ed67daaebf75ac3674b61320fa3a19809f2fdb9d251cf4b07543bc08bb7881e4d38fb447c754b4c84fd809816683a4da5cf54ba4cf2ebda15d48390d3a688b2214434bbb36ae19c8e19ee1feee102987b0dfe1ad3756630fbe1ceb633c9a79fa03a025f266a395ddc4dbee1fc8c6f588620ba02df2bf0b7e8082319c9bf747a4aa123febcf3dbcd067c3b46d27192ad9d940b853ff3e916d3784c9cd1cb8fdeb07331ba8d040cc7672373f98dc8599f7e97cf5fd49f4b2bbdfab2d04569aecabb255e17ea8d18e533fad5fea0f3689bf105fd9e7e9eed2fb55fc3b946d43352f363537362a3f26043cfbbaecf05d8486ba019323f15dfdb97d9865db1d47c6babcf9df59de93a54b7e2e7d69dffce69cfbc952d194f6be4aaedd9b9c6a1b98ebe9f31d62e8cd707789d91ef79663c39a829f978595d21cfba026f549a8dcad6c8f7c1e7e2bffbbd63be3d32afdfcfbd40fea1a329d669ffe0efe8db407bfa26ef97bf225f4d15204ff63ef99b4043a6c8b3c61bf4639d139a8577e8dfab2e6f0a796266a355bcf07944afc6d15689ad47f731e01cd9b976dcfb27d9338c0c8ad62da9f6cdadec6cbf5776d043deb3e1111e0cc715771395c885c36e82734d514f253deff54842f5e483f373ca5264d7d9328c5c20cbbc7cf093dee01fb25e6e195b2da761a913bb294a3692abdb08e6b8423dbc0e7e131403928e1c3ea2b39f472eefb9a00b40d777b20998369e2ec955db9fceccb5f16c7cf0098be5e83e2251576dcfb3bf71d7d9741675751b2f804917ba9073189d79ce5ce374fb3ce94ab1dde3ae4be717a7cce5ceebf9d64f1a87255da9182de9e2c7e64997377bbb616e0d7745c902a8d57f39e35df8e542c2599c4bcb986ec26791f23bac0fc3fc9cf09bb3e742e379f4a7dfc19f27c99e7bf92ad139db39f0bd3af7b419bcf824a74e8fd4e530f3ddd968c21ec73a039f4e8876ef197f9df9720ff9e9033dccf9ae0fdfe499bec9cef22e7d4b67e83957d02afafdaad9e86f4ddc975313a4ad966f59866841aeca0ad91bde3ea3b8ba2ed79788860e815d7c671f4e6da4f48bbeef06d972bb892996f3b8ee73aec9e5b9e1d67723d181bc7ea8e799c3fd702e04caa5413e91000f3c68fc5c1a9f77608fee219bdf811eaef1b50fd1790d57de1c43e8590579281097ae6f5479bfd52037e57021dddc358fc6db7342fb1fbaae073f9cc90f8cefe5e3f9a2a7e73b5938cf1eea9f7e057ff83ec5f2a1595fb686906726f49dcf43f7646f2b355da0e8ff92f77ed0f57974ed76a076366bccef8267c8b3aec134e41e38371dfc63806d8d00d31eefecc717ca397dde3f8c7ddccdf78e69cf48b149ffe362a6d83778073af5fa7c5d115faa231c5dfd6518da7b7e8dd023c92fffede46464cc85d0c12fc603de8cba8fc70143f0a9e0de0f57c94dd21313fc8daa2b1d9a62abd884b8b228ed465017cd918f7a4bb92989d24a53bc7d243abf17f4e9019f9a0df10e753e9b98e1efa6f1023ecbabe3a1b78bcf47ec414a2eaa97a718f33b2e7a63af74fae3a2c747fdd0a37e88ae1f3ae197241f35b93c5acce92e92f76b0df17a0be158e3c8c73b573d9f5cde7b808e068c3335c590fe09e1740efa66e2eb45904f07bcd7643e72b9e95adb9859fd49572ad6e1b92ea6d7a8abb6df7567af132f6658f174c8c097dbe7c6e018d97de53b9e49bfec75696c8ec1347131baade66acb98ba99df9006507db00bf5670f3a388b0ed6bad05aead1392ebf211dbc4e74b96c8f6434770b61b5a60832b0e518f699f15ce6fc783025c7399e1bdf6568f1ac382f632373c77b99df9da35353e2bfccf7886d16857fd97d8b8c0733f7a3fd07ccfaeeea2740fdea28faf820bb4c1ace7697db638e347827b5ac8264371b9da509f951f9e66480ea655b1b4de94d0cf06b0a543de3dd7cb2d2e09df80fea17bc6b9aec4e91cbbfd2bf602aad657c9d6dfcf330af44da95f833df8f80d712974f8d6cf03bd0f06bd816bb3cc6d01b5470ee7d05d591429d56536468febe3eb093b50539115ebd02aa196e489003ced8a4e93a27459ffcc7f966d15e563fbe8e4582e7d4aea253881991fa4ff0dbe4701f022f8e03f36ec4f2511b548ee06784bc5cf550591a87ca4c53a04f430ecf4442be33a81f453d9781e6bd7b4a478dc7ef705359dd77c68d5ed07381caf5a1687c4a68acd930177abee3341b1de8e5097da5a74dd13902bf9aa27480bc79a887f5fc6efe5c327886774fa5f351bd7d2067bfa0c9bd7273de3f98a73632fa8d966f6d4de525312f5f5334c7983bd911c891039c19da9390cff886f2da1ec9add5482ed1ef9b8ad1bb8d0459e7e306c6c7366b2d55e87d22980755a92c46727bd217251766c6dd4086e3fee1ce06fcae30cbcc14204f680273c18ea0e3e1fc5517e6f73a411ed181e9a96e57a6906336928b53bd21415fde4db38efc596b88efea5554d70b677364fb94233a6ca0beb4b026e899658f0795ee30dba9bc57efcc571c7b4d740aee2f0fb2e1006b021e1ba15e8ca06f244f3688a7f763e6534d54016c6967a30afb1cf4e67db32b43547f2f97671ae4c503ff89d6d238dd73f8bf9cee06b3e8807fdf739d5a6fb8fb2535441fc46be0abdb9a4a6b7a8fbac921dd13e0e2d8cb2bdd5b60a22a9da32e7496b8279c37b30ccf0d81efc11ed03d35d05c33d1998eaa50bf349ba8ee10f871a70b0ee8ac03e4f7b17e5fc0e6eaa0321d2958f6d8bfae27901fdb1954a8b3ab5846beb35505c7821e20a4df0fee9b0cdf03394ef7405a053c95b3c6838aad0d2a16db5f88a91941b5a84d11ec153c77ea63f416b38ffbd81ebe8c4dc2556306731ff47bd59821da6ddfe759bfa8c73bd0d4af99b910d0e185b574517231a976eff08173a1929e8be2279d69ef43ce96d81c06e95dceb3bee92b8b25b971da59982d0dbf85e62e71e89354dd7271bff6d37b45ef0f8d0dd2d7cd6fa3d317d8eb748f7e3c13e7c2595be7c40d88cee3387f0e9fd445b6cab0deea0eb3c314d9732a2fa278250d6b457ccf975d31fe83f3eb765d245b735e7d620b7ebf34c0660ef7d3fadecb9ef4293a9b27b9f931d2960ac5d4cfe3c7285e74a4cd08faf60f2a4b7afe37d56bcab7af505c5b2cdba8075a2d3def8639b3243f9fbf7f491887bd201f27b22770fdf235f1be430adea2e54f902743d17312bdc7d0789c6d41e1e58fb72d7a824364131fed45ca7fb03dfdfb203f9621384713e4f401d9d98eee82ce2c439f3be49b85fa012ddc4b14721c72d0ab0ff169849deae1e93bdb10b3002f513604754688f68e2305626a88f6dca6286db46a05e406d49c239f952a17674db1bcf3e7b5343a59632e4dcd06f439ec45f933ee3ee7fc76b602f2c3de675e5aa3b535aa1cd8ebc6d892f8da2eb25192faa512da8d3a2ffc19af3fdceb55fbf1326400cfb9ce1f7ed014626ffb7c85fbf1760e63a5920dcb01670eb9524dd174c0d7857d185b13f9bdc27e0e53e940de14f82a70afe2fa4a13ca07f07770e5e6dd54965896214c021f39c45571bc929c07e9bbace65b45a3d10fc983916c3a46be37d10469e3e581809c2c0be06b50c37e0daa2f3e7ae64495f7733ddf5a9aa2b5bea74cb9616ce9744f299aa672037cfaa7d71c8905a9f5c660baf85867cc7ba460a2b45868f38446396b1279f8ec5d96b223b18ce346816f18f7b4c6f1a5d6d274a5d999b6d979f6d9e5361ab1d322f70961c5d79c638ad616fbec89cfd2e7839102f2433a8c14cdd16ae97cc05e297cc15ea1fc80381be803fabb5cfcfc0fe9f742aedafe348f8deafb32cc5a95b7f7e6a113ad9fe3aee43c57a7d3ec0db287cebb1a6bab465dddc669ae159533f73e787d5db78f936ca43feeaaf50ff76dfb96eb6fb586af52a75fcdee3b09f67a0c9d877392a7e9bf65ea5fd2fc1381dcc0349ff05dfe5c345f67077e8188fb627945e5e430eb63d612a5c3f03d22fd31e4fe0497610cb70fd982412d4f6bcbe460a6fa1f52fd0ed1be3f78ce15b69e17db23b3850cb7bc029b4e3f78b11023df596a028a997afdea61fee4bc5fe4d027c9fa835f5f807e18e8c2deafe125f1528c030ff09917af6a41bfa6a3377b0fe23ff07fd0ffb07e403d2fee963f166107b03283d04b622ca3bf34c57d444fbed78ff0eb87fa9c46f9063fe099c83f75a767ed0cb7bcbb7f7c2614fbfeede6527f60ac2788bd631f3d271d9c9e47702696ee16b7a6588f9325dc76719a8d4ccfb83d17cf47dacc97c625d2ecdd483d9abe6e6e3b3822461fc4a2500ddac5738dcfc1f267e0f8745b97be3cba9a1b6e396724cb97de70567eef0f92639111fc1dc52b695824e27b0c2e61ce3c562725d8db30c717e51e426f71177eef806fd3c67d37bd5cd159b1f6cef61b67eecd7d261cfc18e9af62620ce7f063142f4a727109f129a63e92e44991dc458b60105d68fd3f4dee64cfb0b193b1d1f918895c80955a744c8560a62bd6c48dd3def2293e96641c9f44efd1341e8fbd1d235fd9eaf30ec41c9c37c5c3ac77c87d1f684a0774e711fcc9daa0d2539556767c451faade20347fca9b7746e54db2331f8c03ea6786677117d19c1a55e94d46625dd0e05ec1fb414fb6ec78e0d56018a274f0f80afb19e9994d688e0db20b0e9abc075c2c601b81c903f0737aef9d971fbbef7e7ebe2bb99ad2423167df2ef0732899d913f3f684ec8901b12fb4ce7a167c4ea63b04bc7434c432f4df81ba34e887e7f58d69541cc3b3cf7cdb24383bb047904d08bf5f01cdc1bde03c19fde7e77eff8eb1226c6bad4751ba6ed0fc50acfa8b6247a1bd6f9ede8bb94e316ed58efa1e757da87d0239ec2b8eb8d7f967f5c8a97ae4543d72aa3e714ed51ce9a3f5ef82f38794de053d3a045fe5fb0df03ed2bf682f00df5b6635e045d0ed1063a2753e7c0fe4c167999310b72f08fb783532c4478ab0a08f37e6fda2571b13c6cb5e0fc733f8938337c9fa293b33b80ff97d144ec19ff1e64890bcf68ff7bb7bbed92bfab9559a0decdf45fb5d998d00c38af522cea5daa88ab6d48259c4697a2059f69f87a78723a51fd0d0ebeb04ef2bee3dd85f1af9ca0ad58da03ca8a265bace1cd3473adda4d04b94fc067c3216a519f4b032e6d2490f2bef37e641cf4bbb64bc23c15ceba2295a80d1018b79b59b76e9f4fd6e408f5edf2e357b07dbb38af3e32f8e05bd1da37936e63db86515c8a81ede078219f0bd93e891d0f3b5b563f1b5cae44ae9b746ceb0e73a1b846922d698d277cd85fe25782edf2de66073cbb698778c9775550ed937d3a0bf8c3fdff11cfd9ba487dff1dc3c321f58731db0e56d4d06f93a9c8c407709f523e463aad0bf18ea8995f684d056100344bd0867088f41dda4f88af43efacd7b31c8abf2de1df5ffedda8589846b20fdb9c5defb6447621dcd8e87f995a84e4ee91c47b2b9813c4fdf7e17bd3d3105cbd1c3ef0ff79c6972670bef730e66e0e4c3ab3104b9804ffbde9e93d9bb28defa91e742e5f6a17a5ec05a70797b8a702ac164f911ccf38d8af17a758e88c7a09e4f930ba133c13942e89cb5ea99760787be8a3aab489c533d95f1d4f77c9d81e765af919ca0e4056fffba6e23416e56e3df8593bee2f40f3b339b0717f1e024d273c3d67cba08cd2ca77cd8e0cb5843ae02cbe39f96dfdee9d9e83edeba7a867a9a6e65af645d1bc50b943d1971dfcf958be396377a63b6867ef026f98c7a9f547eb90c8fbd1ba23485388b7aa878f328bc77bd189f31b3f5492ee4917ace31784effd804bffc20e8174deaf7216706fcbd23f087037d5477e8ff0db1bc845a1df05d933803756fe637f4b340fe7b7d3140a643bd88d9686df57bf756703a3b4dee401c70daac7b732f3c9b3192af7e8ffdfb005beaea9c259fafc2f4f93be56ff4c334c9c793a9fcf93a61687d62e4a59d01795580b70fbf8d8f37b6cfd585eb3be73dced045e936e0453e02a73278cf75ea2971a3885846cc5924f91322bec7fa16e8eb378887813fe5278f2c383b8e45e4cedc8fcd70c4ad9afb38ac9caaf723e4132f268cc003742dfab9d838d27f78695ed53978f80c2c7c110ec6fe6b3f97ced3d317c7ca1e72e6067206ef636c4e40829f8bc634ebd1278c21f57c6c15c2e49321951f72267f46f266287f668073e7bdbcb1832eec26215b04eab744276b1cc2f5f067e0023e4c703e1e002c806c33a80b0861ea6a6540f928fd5ccf1bad9bf73dd37938d95ebda18d09fdd5ca07c042b047c621a48b3f3e1e35639f5fa951cfbfdce674fad53e472f89143dc1ad1b401f0ca0c75fbeb5d3f3a826f6d87ca5f105d61175efbdd2ee4bdeeb9ef951c48fc760658e7ca84f83554ef0ffe9770adf12ee19e9dfef0d989eb876e5682a1538677a3ec9e7cf719f313c82fde417afef1c7f748abd4f5f1cb6ff230f06f2605c4abe54734bb0ed4c6f568befb3d1aa613ffcfbac83622451fccacdabfc7c1ac5a3b47cbf1f7ef1f709d5632fcd06f41b3dc9bf67ee9778ee69eb3fdbaec0f3137d9cddac536ba1721aa27282697cfe4fc727aa52b154d759c16c6c9a8e53e9379d6ea3e8b5a5bb9dad31af6ca1fe1a62393da1be53491e3a671c394aaf48afad570eac9c8c91f9b13160e2d4b53c30ca03a33c30ca03a3dc10a358865827f526bf4dce6e482e0e589f01874d9a649bcee27478e8999f1ca724ed4fa24f85a607147f1c29fd2353dfc7cfb71c3c9b98db427e1fa5a762fc8d713a89c6606f32ee4f81ebe79b62f68be196b7662d6b8f65e97807dc12c2d96caffedf08b324aee3b3e3952459afcb525695e13d5ff9f00b4fac29c96fefc2bca6f2c10cbfc71acf9758e25e9f276b62af13bffe6411fb5d523bf681713b7a2d1c71b5dbd6ee8565f66fb777b62a777ebec939a8a3770c9b23e61ad12b13f355245fa4e10fbfcf18fc3688c724d0679876478254340ecd683e95f78e9aef6f0dbb5230002bd4e2f882da93d33df5f4a3eb38bad89b8c1b15cb1427e5e6acb264f61f6324bc962bd748dbb86ff2da192b6d3beabd0c01e8b49385ba5fffdd62f8246437b3b2c4db3377244b2bad1aec574f74c2316e2c674d5c978fdf09cb7b6e9b9e470e51cf4cc57ca9582f0ae30d20872fdfca86fcf4d5caab266b4bdd7588fdc1e3938af245b575a13e334efd4f33fafe4d513be84276a2cab036cb316cba7f26877d86cf236eeddc7618f20f13fd5049d7b9affbad2af78fa7bea710ee996850ab37a858806dd04c162adff38e75e234f6f3f1da0874a59dec33bda2d681bbc6a1db48c04aa9f133ab083d10503eede1f6760abe2feea1169c1da9c9c33ceb203a6f741cd39b6745f135d008d48595b7865da165d0127c6990af39227c28c20c9f1299e934d5853d926d6456cd67b07b06e0e357a0e772f1679c7ff633ed19ef1a53f88c92f154fe04a79ec79ff97a00df97c529517af10ef1674a365fd73b1acb7569c6c87bdc83a363c1ac55eca3c2fd3bd07c33647722ac06f1a746a768e4397d733795fde17d20b44c6855abd2f4d923b52d68b61dd416414e13a66358a757bbe6f913a0aee138563abcb94e84ceaead9f8bf3559d8b91b3a8a67470bb3ef244ff3067cb670346f02e970fa17aaa1353e463926fe1e1c37ef8b07f371ff66fd277f3e3fada249d19d327628d7b48fc767d3f831a941cad83708feb4fe99f79f4137df4137df4137df413bdba9f682877e89589a7ddc01760c6f4e2041f03eb077afb8cf954277b42e294be6f36942fe5f51362fd7767e0310e2c46d64ed11d375dfa7d395272fe926aefb9ed86da9ee78ce2ec85903ff192fe1b51ba4222fd5ffdb3318fcd4667017e91b76a6561ca394bb7c14f579fa95e7f4da46b3f276d22ff1ca14934e79ce6a756be3d31046b6bc0ec67d1cae9727df3a6b41c881f7abdafd0efcfa925c2749c70f6847ea373fcd2f23ea873c9a1de0abcb38f7e054d4ab88fc1b5340933f9204f5113ca877160efd9b47d672a5023d071c27323fd7739a7ae969c61b2ef983fde1e1b7727fb43fc42aa003548e6166af6c81ea82e554bdb90a0a6e448f77c60f5032fcf70621aee1943379a6dc4fdbc0f99659432c3c710caaed9302dc34decc37451bce2663899bd6afb5d7756df8c0795a32e685953803e10bb899eafe0d947c579e01f85ff07ece11c7405adb3f4967fe17f5e8d9ffea22e4e9a8ce1d7f03c279edf25ce56722dcb70fb79c87d8cf43f9c4d3bd2c1749d692496adc6cbe133f7e262dd1975751b2fe03f3c6a4a4b18c941ac4597ebc5660366eb6b4b4d1c4e7a52a5651c0affb9b432af6c75d1998ea3e7d29c7baf832e741c23df8ef4dbc4f871e2669981bf6daac93077777893fbe9627d730b7ed0f2d25213eb7171decf2e4be7a60c760ff8158748661aae44fc2a337cefff607ee8646126b2aab4a27db767d20af0d948c6fb913e3f0fe70c853141c26fce9e3d07367b24ede0cf93f04ba26d8a7f1feb3ff2f629a7bbcede94039b35840195e8791737ca77a26746acb4410e7c86d908b91797df857a0fbfc91f91ff14ca81a6d7992a37ba8d14bc1f1de3a69fc7e70b8af10179f19f0a9eb5a24ef47c7f013e20fa5c21af41777b5b38233a3f72244b471edb3bc5e6e6c60b2779ea94fd728b75a4bd478a9cfb907c63c2678c5dfa882d3f62cb8fd8f223b6fc4b62cb23a62fc69b1cd67f91b4465fa7effb2df6bbf8baf37a82b3ad7eca1873122d4e499f9c3799ce45c3f614c7bbb134cc719ed7d1f8a39fdaa39fdaa39fda67eea7f6dbf422a172ab8bbf26664ed927e7e04d4eac7936ce441833a49f2703a6fe98d85067afed11373f3b6e1ea64f1223be922e1d2fe648df1be2cba386b456e5c2da70254b13ebb64ef6ec93d225c923a0e6459db7a67bd1639a3c8ef595d1fc935b32bd5bd6cce73f35c509d595fbfb3cef00769a9a62f9d06c583b55e92f2ec5ea2c7646f777b5a526ef67d4bd5fd87767702af663e2bc1b8e5a49fa5cdfe4d39e40b7af094d7cc619fb10dcf3837c89a447e51acf24a069f91ebe45bf47e6e0f4f97cb22a4a46cdbc3a420de6aa88e53cf65fd0673061fd72e341e0f338adadf26c1ce3c021cb5264d8d9753589fb8372d070cda9746c8ad6d6c4391434ed34817799de197e0ff3463b345bd9cf816eb479b030a6b7bbd6db10be0edb1f365f8d4dc4fdb9e8fb2c5a4fa57b865e6f64cb35c5f07d9b8d4ece6854b6863f9fe1f7b0e54ee714e05ccb0bd777ce7ba4f8ddff7373a0ab117a24d15f08358bb9b5264bd937c5cfd943bf7b533ace27f07591786894ec49dab3e87d8ad7b169fb10ec736d9f467ba939ded1b9ddf54df335e7684aef263ad51450fde65143bd97ca6ed0eb7a4fe6c7f8ba94cc8f314ff26defae4ba1b6b403f448e21eb837019e8b03f3ee3a0ef4f664f4e004e54605b2066a52a1bfd64579898fb8de23aef788eb3de27a8fb85e60cf68726f1dd446a1df9e1fd7e3ea9f75dd7a881c3548ace110e557ffa43647a8a6900303a4e2818be32ba7f78ade1f0a6b71acfbfc3a0552ab40c7d4bc7c884beb481f72f31672330997077c1355373427bdaf92fdf39fbe2f9343d6511904fdbceed14716ec84f27b2f5b6f43af5553acaf74c140be3298ab86672043fe893092699b9bea6524f702ec5cad6c0dc825925bbe0f8de9f1758e3cf8c87aa5773c8f19660a1ca9bffbfb43612a9a46bc99977261f29eebd47a439083f5ac2aefb7da01ad1df60acf68267504549d13757faf470ed923f0b57772aa1bf8ffc2fdedce90351c72e6e2f803fe8c8d01c7e9cd349d4f9d512a9fa5f257145f05fed4359a6f2ff726c3f03bf0f1d527991914ccf5cad9aad201bb7440e45d6806ecefdae35628aec6831c3d5fed7c5c58bd1f2e4cb5afab0ffbfa615f3feceb877d7d1bfbfa618f3eecd1873dfa99ed5126de8372382aa89f05ee15f0bd9745df8de2496e7ee4e6c5183ea4df91c963bccf8cb506f30ed84684d811d41f87fb5b53f8b6eae52de07ea2c79158ce419f75e8298a72911a12c8d2c948940e5cfdb0595e48c11467e309dcdbeb8cfd8eb73907277b41f59085de10f50da94b63ecac8921485952e30e7d614cc83b125b9067ba51857d0ef1996839863b3c271f9ac8c678cceaef6bc02bdcbce47e42de9985ceee3c3d166943c6f592a27bbd14b3aaec6c50fc462cdb23579a9a1c762723ebd26c85b37337206f2332d7b77ef99a78df21c56e4ded3f9d928feefb653ea5fc0e7c1ae15c6bfb1efec257dad7173adfb7e9e7a0496af6792b447fe7cdc78c5de3bd68340dcbc4e4be32bdb4738ccec86d75775f7c53e819e7b7c9058ef2ddf0e6bdd2f3d26f90f74a72aec89e90fc2aea9ee87bd391585ec6f4768cb5f5c02f6e08c32fa35ac46c1bc65e63b02fd8a347ed7db9689ff67a48b66b15f025779cefeee478629f72bf6fefe2f7ed0c2e7e5fe1d2f735aa173e732ead2e7da62eec2edda35d541f1acedf66bd7e83a1f562faee2c74c1f1fdaa582678f49de67bafbdda29be5c88651734b9576ecefb07f354a725d971e4dd973af27748c7289e8df41de3cfb86308b5e63f641ded7fc83a7aff90750cff21eb50ff11eb304ef362c3d86750598f14cbd16a59db6cb47227fde692f2ad7d7d24099ad22ac2fc1bdd35c02f0cb3799c0f5b53a315aa578df2a341cc682c4ab3eea09535e6d2497d2bfe8da7070667fab2997ba5d519fb7a50760aa13d2117a71e5523f042943e63eca100070f53de2f5fc17edf7d4d17eb47e310a93ba3fc95f833ffacf0b932ebf27d10e555daf945f1526cdff0a83d4cf67dc6d0f17e79723e493dcb6f48d3ba18d183ea0279a30ad616f55e3c334ec2dc8b577e39a62b1dc64a7f013e07a80984b95b0ce63a0f67426ecb153c62ca1158b35a4eb34ba89c0da9912cebca3bd2e7a127ec2db6ef62927d893ff3d780f788dd17625fe5f434d91dc5eff1fef5a86725d579c49df96a142787a29f7d3bb931c77db63fa3ec98f70fbf4a76988d542ccfbc57c84e4f8f7586e9e2a3f68f2f467cd9bd17716743e4e507d1ebe2363825596f6b8ae61873273b02d97bc8614c39fc28b994d5d2e4d2a7c794783fc939c53d8b3dbb78d9fa2b74ef7aa44c42677c0ecd18f98aa30a8ea59ffa83a2ce3ee68c227c75d5322d5b4eceea44169273f2d68aff2f441f84eed07aa9774d96eb611a3b0fdfc7c91117e31b2552e79fe4ebc5fb9558fa0bee7be6fa3e4e86c7d1cd55d8aeb3d5c46102cd06ba2d86e61dfdaae7cfae79f611c52e5ce39a7bd8277ae89cbdcf4bd7fe7e77cdef47726e7ef2fb343d7a2a33b10ef57880fc5f8caed9df467fdedace4f3de7a92ef4c3baeaccbdd6e4ec3574b6bce6d9aa7d058fa1f919e58d76b8ea1eb92bdf211715e788f395dc101b79e77e139a4dd6df91bea4cf6bcbfab2137f2fce4ef98c3e8e149c95ca8bd619323358235597d54f7ebfad978355b4f4d7ce4a553a1fe64b0699f438bfdff7fc46839bd86db7c69cf36419955b8eef65a37c6e196a7f4e2c74819e0af6d95ffb3f3ee6e1619a5bf0dfe7d4efcacb6d74c327d47bc8ee49e1bdb4b504b67e7d654660d3d8efcf3b07f590de2755958b45496ab53e6a0fd4c14d68f7d6ba23557edccb5f11839122b0cf593c91485357f1077d25d149c4f7589a61e5099f5e0d7aac8ee0ecec9bc85d82257cb96488d27424d757bfdc979b4a6bcec610a443946c88d377e7fb1d4fe9752417615e5ff666f26d0e7d11eb6b9ce37d3a6b3626effb167a298a37c83a83da8d3eabe7537f9b8011527f8b687b56cf46c6e8a2f6cfb5803e7c9983d77baaf3e9730dcf22f39fad41bdb7d45feaf2706d0a4e7654cd654772ce19bbe54d483ed7f6a939d5d1f9d9453423d9fbcc7fd6c47b56a81e69d6045d2d4b508beedcaabf9be14ae05758aa7990738509ea4580f521f53e2c4fa23383bec3a6281de8b9716ff67af866af16f27caf7f3be4cc377b15f6a361f9c73c37a44fa366b9259e919cb346f2ee943612ea1de2eaa57a726102358f2398d320f767f09ec6f1e3f73aae473b7acfb9b9803aca61be6241acd99ff907b25ddc5b46a373ea27f5e811f2e2b39ad2a2e6493637dfb22f9b6f51b379d36938a5fea1f85d93a15eeb75a2ba12a20de8f16a36fa8ee1163ea47ecba7c1d3b380b979201ba6f459e1cf8eb8ff4748279ed05e50bb9033dcd96de86b509186b3195edfe9e7553bbcbeb867a4ce6076eb9bb1240d06d118323f8219235267a7827e0e668d4e7561bf5295ca3122ff2896e79546e5eb7b23a7e33f0dadba36dea4dd46192cbebcd92b7adfb8f72f6d1ffd5e298ad7335a152c87929bb00ee881b043758c33add6acb58bdf6b6afabe57f9f69fa2259fc74ee33691f330494c87966534fe0ad1668009127b425d4b8fa15aa3b72af449ea2c9a62fd08bc6cb8d20af6dd748713496ab539f838997f6bfb53feb42ba86f0cc634963e77e0bd58da41eb34050bfa29bf1b621df24e7d3a5485f24a17eb3b4388c442e7c8037246d39128add5f7c2b7cc536639fa399eaf33cfff97e9ce2699e74ce629d319b963f8dbdf7f3f6526f6dadae87f1a0bf72f7361ccc63fff5aac8c9133b3d7cf7f7d1f545fdefedabbce5f2bc31abb23efa31fcb9f8b7fdbcef887f79f7fee5726dcdd9eff7b017f9ae3f5c87656f0d7b9f79cd85f3d6556f6719c79ce65cbe52fa5a78cbb30c799e78290457ffdb1b6d1cf85acf0e5bf73d9ffce95de73c273eeebb350fe339fcd7fcd095fcb5fb5cc53c65efd30ed9f99e77f8f9cd5f829b33aa087d7c6dbccf3976256283c659af345e6b9fc25f7f54b5978ca741c7b3ecb3ce79e326df4c07c3e572a3d6586b69979ce3e6544fca7f2e3c7726466d1dffb26dc2dfb9419845eb8e2ccbc1514b2e52f4f998ab33066abccb390fbf2947959db2ebcc7606c649e735fcb425e289673f9a74c6705ff53c815cb5f8b45a1f4f753a61df1d552a14cbeea2ff6efa74c95ffabca8f1f9bf966353633cfff9b7dca3e65fff5371cb835fe09af55831dcbfcb576977f4dc6f393a3ce3c659aee72f173dd1dadad10d5f89fd71686f7c1fbe8e764bcf6fede5f2cf0dfdaa3b561659ee71bc779ca0cd62367ec9f10fa577f3c5a2de6de77c545dd76c62bf26defc9fe3f6be3a5fff7f7f16acd7c1bfe8bf9457b616ee079ff97c1af1f4fe5e84ded79e679fd73337e62b605bd5a7b6192ff1a39eb3fdd8589fe5b1aff5cd96801b93f85e29fd90c6cee123d8eb05ae253f1769ec1647f3f65ccd17a9479ce746be5822eef2690826b08a062a1f570015c29200ab7a845da316b4b525f7c7b9fd8dd63e11b86a29e6818540ababcdf18c765c1388219decf1ab5c5f62d6fe6cd4331df3e14b7866b6cdbd3975d7b5056df87fb774f55bd9eb48f1a8bb9953e6f7fd1dfa9fb089da3e1b637baeb1ccd6a71aee7d5ad3e6f6fb18b66abcb525655b0981a94f66fd397f8fb526e1dd43eef8ce7a03d098be249b361ad75b178fcce98e1dd416b6136fabbef7669abe6c1f556dc8c944ef6cd35b7a65dde18873276b344bfaf6fe6bfa2f26218296199f23e0ba90ca60c63a9a14cf3b4fd16b457d104298bc6e9281eb4b9f65d58ba789bbeac75d959812a0133ac4ddcd1c9d0199baad12d1ca01db42174166076907268d2c26f48ce6a50e9684adf22df43ad627c1ac4e5d7e1f38196171816bdae83751460cf37ed6a6e0dad008d7c7fabbbc303733f48e53b326bf0ef3912cb0793947cd3672f684b5df4cabba1958331ef45b6eb42eb256d8298bdf03faf152bcd867fcf09b4ca18c9e6064a83c948146f1410820e96ee7aad1e35d7999bc1387366644ac535dcf21adad46803d41271ade75b4ed05ed23cbe552b960e9f431b76a5bf40f047900e302a19b50910cb3b7f5cfabc3dd1f31a94b76761dfdeec0a32e54db1bcd3e5f2d1ac5602d7aae890d638dd61b65379af52ed18eb56e7bd4a20eceb64a4b4510b335528cfb4f7a2ff3d744648260dffc025ed24f5875cdedec7ec2ddcd370cb5bb3c6dc73dedaeaf9dea9e9c1b41cc150298ac659f71c319bd640b3e04688707dc7f14a2abf90b5b02d04860dc9d645678ae1e604c94634c2fe95afe540641b0cd22a13ce1f5a22a0676273ab0ee98e597cae3b536ead467287d09e47938a668de4bd3ff229bed54460e2f0b42b48695310639e47ed633365dfd0b8931e6eb30ae30950ab0e68fb40d609632dcc2a6e69c5dc4b958bb3a6581754d95951e38d802fc9c80270032c7568b173a804264595a365548a89c6eb828d4d0f0d68353144e2e9d26576a4f457d0ee9767f40f6d9e908b752f478424901cf83eafe45477bf34042b669ce68d9fe742894e6fadcbe519d1bd5ce31aecd235fbe9eba0d3365baf2761f6f6a5212a4f67f9d8c8d793f6edce91b8290d5712464a07f8623d129d238cb11b0ff8ce2f626d5c667f44583fbdb54b5c7b17a7e598aee39dc9abb504ec60cc6793be5ccc1af3d9796d5de2e4edacbe69425b9306b493733658ffa2f474538454fbca0af09b274783f7310ea87d578a4ca92c75484b47a51e903ad559409a25b88a34c181f39fe8f9d60c5c46dfc4d9461b14564d31b735f28837a620839a0df4fba59e978ef4f39ba2b634e69decb7cfd83234f5dc908cafe860f3ccfb309218f609612c686b026d94d0de00cd7a63e8d01e203e99b7510b307fa4cebc6f416b6868c71de89fde642417600f114f83ee1ba154c6f24a17cc25848ba1cdb406eee2aab57c73879bb76a65ade73b483ea8020a2fefe0f723b99c330ed4f3ed8a3b525a47b33a3bc7bd9ceea2aa9e86dda3426951574a68f4621945c90ffbdc906a949c62b0c449e945ccf722438a11dff365fc35721968088741cf4bb5ba4ef7dcad3d2bf54c2897fa79e7671e7461efdc7b9dba403072f3dc1496ab743cb45b85d1afb7e01d6eec0461fb7c65abcf3b4b28bb7d532a9631ef1779c6c7b16752b5e3be8baf6bcfcc0f35f8ed707fa7b6e1f4e89453ec927e3fc6de2a5634b1bf24a97f48b7615b0a300fd897bfcb08cba1ef0f20ade52e6e4ffc79f46b42ca2c9633a4b55a226f47d0f736793d89f624f53d5f3684d78cf15ea4cd99964216335e9bb2d76174d5d110cb1b43706044f81acbbc8b474953fb09e13368a9771ca196603968259f1dc78489a1ed26b4bf1d43f85f9c949b33d0e97be7634647b72c5558adc12f03a900b43c4a9541e972274ad65455b9f31374244a6de01c511033960042d52bcd0fe1838fb1b324bc05be4ef02319d428183a547bff31d068af2764afa9b6c0d7afe3f7f2f71c82714857e318126e8eb21992e4ce797c32a7ecc08fe7910165a335c166d7f3ad62f3d5b3ed397574a45efe07d8a9a77e8ff0daf1b9c6b4e1e56fe70fe3a4d2cec16beb8dd2de901f07d9ea905283edc12c4afd15f74bf083c038dac00e2d1e51ebdd147f36f2e3205f09f8d6861355b00eba50dcea62790efe72349a0bf6c37e598c0645e7cdde4d70fce4a0c91da0eb89817eef6c4da54d3dbf30d15ce760e47bcb5ba543c6eade60df13ed647ebb00b0259fed75ad6f13fbe48fa3989105b77e9e2ed68f6635b7d605affdf65d7cd473e4d3e3f48d5ced9b26cf4ff0517fc439b6b646f56a5d73d633a1a5c6e8f021cf2431c203b1db38f7f0f4dc82b38be36df64a8d2fa6c61a299b8a07fba5e2c04bc7aa7c425f73304ac7f32b5f6a479f63efa6c8f688d29404397f4e792de693df705ccced70251e2b45df3b2d2ed56d24e09bb8f8d30c3de7f2587eccd8005d2ce3d84f133e5febf9be03b2df10ca39c31f35c3dc2b58ebadf15a1bfcc029e356900f158f3405f9b9855230cab6c2ef735b5beada961b5877f2b6bb4c2def6936a463b82ca967371b889f2379d4545acb37851ef9c3f843ced323dc3a244a7f8cc0c7a5b4b29a6265436388decfd327313e052f9680e93b90a13d44e3f4bfb579cbd204efefdfc4fad46c48965edd4d74b70c7886d018c443fe1ff82834b9b7e4e0073ebe389f3fc8057cb2009a34e67d67dce885f89ae29d8fde076ebe7ecba7d9d8bb2d4befdcfc306f393a53cafc3eeb7c077cc5f22897bdcff04282dd9f6cffcb7b2c57aea7e93efeb70a6dbe3d2c844a20491e808ef7de10aca5e1e5d6c1586818436b8f079e3fdbc3b28589245a4be35099aa60e7ba43343279241767608b424e0695a385e8a7956f4f8c867430c4f2f1338dee226b26f4fea9f6284dffb07b14a387a2cb37197d5fdbb39fd3bef1d9487e5da332ff419847e24aeeeec8231d4dee2f557c4e67c6ada27c636474aead296decebca399ae858582f22ff8df7ff80a5d6c01f811ff39d3f7645ce2465dd67c7ac205ef5ee9d193e2b12b3c2fa466e410ea2c7cff4f7a89c4244c382346330dcbd64f5ed707538cf2f7887748c928a4d226d5a267753129d35e4b9ab726e7785bf16e544430eb036f0621926dc17c964e718f213364ccb38e03c5a05f2dc7bf7f695f6981cde21947ac308285d7921f6ecb5eb497b9f64fae38895e2fc23afbc70887cdd01ad554ff6c6193760545e2754921c81db67907b8f68629043b9f16f4a508e7ee24f634ab9d1e83331dcee22c9c6c59ff93c81f727ce8704ad6bb26f720eea75601c5aa8d552aa2c4f97e151b2bb0dcf6c8ad433c1ff224b30227e6dd4f8304f34d639a12d906dabf0f3088d72e091141cc28d3fc0bf3343b9c0132a17786286e504c62267aee103ecd5e8d85f3c3d323ee7027c6e08a1d2d453bfb3dbb74c514272e24d3197a63859a3f52acb2ddb3e3ca2dcb40635008cdfe686fa23f089d3f7ff18df4c7076cd86ffdccb7d350ec296817f3dc09b81ec447ed262f657d44cf4fdeff5a81a318c55eae7bf7bdab3c9bb5f1bbb4aa03b72793256b1605f8f276d05506b5a27aa352df135c6eb1dc2c351ef893ff3653be64b36fee3e3fad37cfd0fc747a4268f3eef4b653cb917c8518dae3fbdbb3c2fbff7b2f576986791df9ed4a9411ccfd1ab013d3fe8f57c7af5f3983f1e97bc5232263466f60a0c5f09e15a313c26dc50244b27184884ba8dfa86fd0e55cf48d9baa15ac65f22bb23c744bf867d85a3f02863ba0679a2ce67d83712fa0e5ae36fca27e1fb33b418e563f17d2ba7ed15a3ef45dd2f66dd5157aacce691e112f629f0c86d1e393ef07d62b07760d30e697f44784473b56269621ffc2d50670c39d64bec57de824fc6b02b60635954be6d9aedcb5ec9b6f0f57e777281adece1528cf73f781fce7d4fb20ff1b2b51acb4351b6037b9548ce2aae975dabae7440b4a5b4f9f8e9bae75fdd562ce9c23a6c0332cb703ddea45a44cd74e1a42d4fda956a73f05cdcb886e7aaed773046be2f3af391d287f84e5775978e9a677482fdb2e8bf965bdfde57a87d6c137c3c0d1c939d430bd9ddc4101c1c9b453e734b17f75b33df9e80ec011fa4d9682d35458367804cb09aa296d3c5dd44752147dbd96af9966588d65285ba36b9b3355ce4f7626bfb792e4cf757ee11c13d179e17e657479f4b6bbf5702e7ef935a3571fcee2c1e467b3597365cb224aa5d57dcbe45fb859933a2fc0bccfa98b544e9f41bfa08a0bf4144dbe95bfb071c780e8ad75e95fb4be450b3817c2dd0fa0dfd1e744a804da4597c5e2ac2f9075dd8ad50ced1bc87727a9ba297b7706f2c0afd5d0c513a988da00ef71e6bfc4fc0a5e8cfdf0497a29e4537c5a55887cd1d18c74772ec72c6bc022dbd715f0e67033208e5a6412e0d15433302ba2434847226f47c7f01369f2eb4501e040fbfb0570affb017373fb117f017d957cfaef36dd222e213d2b74569eda05539d8ad5ab5b2447e0094c78a5a2f0731d34620c3deaa281733ebf15519faff1c9a62d91eb9d294ab77499ceee0d4b9dd06a74eaa9ed48e411dc05a9373b6a6b490de308ee93923573fbfcaddfad16f679d421711b9c545b00f0e78afa936b928bf78c98c4efaad71ab2ed66d0de72c52bcbf05dfc348ac43bf29c893c1f11994337844f215b5f1f47af018d01b0bda780b742c06f2f25b3f3dfee8148d7cdfd1078509b4450e5a7843cd28c481da1355005f4fcb41b978f8599a28b968f4a7c75fb87dbc06f836e2dec063d03258c3ef3e9ca84ae7a80b9d25c41bb52a6eef097937793cda326a5faac97c7589fc88925967e805fa22982c645371fe3ea9952cc7efce9111412d268fac22b1343a6e1bb76f947e3e5b8f8fe8bc4eb0ad67c51a92eb71b500bf508f876c47cef8008f3e97707d2ceb570df279c2356d443f7f721f12eb9f46bea42bd6fa717e223edb8ea2a3e07bbeade5168fcce806e4b749d14b67eba054fbacca61afcde09da5236ab17c435cdac3b68c815bd993d8c1580efc861e6ee78883c59e111f0f5fac9751dc2cbc3f246ea053bd479b8d7a4e95f7610ceed1c2e6f3d32a1977133d6ae4f3d1abf7be9cbe051e5f434b77fbd09b2a4bd90a249ff6d014bde7816cf2be138af333f638b60b6a9fdb56c2e3cd881fe243d67feefb9d81bb087f0463eb226414be1f9df316a63706db7c70de5a685db4ddd56da4f07a6d9f461751b1e14a681faff1c37972628b6dac8921d697864d7ae60623f39aa25430c4bda5bb75afeebce1d707f2c4b7c89946cb067eec01f12a76eda44604adfb2d4f7c6e156ba434c9f89d60fc57b5e28c1b95952a1751fefb7850f1eb563f6bed39c1cf7e3f609e3ade4fd46b32a8233dc5d9e9f763e86a7671cfa3341ae5a7d7ea45b44b5f40c7749f2b8f862fad1d3ec74785e522cff973e4629e337ed8cbb3742a83f75ca79e52771e41dfa5e4f524f15c353ecf87e1eb58beec36189d84e54d77d0427f9e33de2d620fb979929b1f23b1157afe797c18c98377f4bff2da0e840e52ecfeb36d05df4618fa788ad8bab4ad13d44c79b5042319c549a037198e37fb980a7801d58c8e073bd0b707339c33b1c5f1eb736c0b22af1270c66ecbda08cc7d126c08e713d272c8a7703d4d3bd26604bc3f08e7b8dcd216e0b401cec6fe101f61fd55c88ead5fbe26de7748c1435c78bedb603ea764ab71f874b57edf29bfc70d64e959be86d3df47bf3bd9f364de3a1fbb006e61d68f63dd22e54719f8b995b47e4067ca83b1ef445b381ef1e9e84b46ef75a6af2e39a7d1af23c66b0ee2c3aa60c188da29f45b84de6e23b9fd59e8ccdf071cf3dd685e4da35f374a6c56ca9fbc509596a5e6614c25f4e577a6f7a4b71bfa13e8decac19ed6f6a9ef978681227316a06f8a272ff9e4599c1c0beeb3f2735ec231406a8e0c896f762ca8cbd242fd51a4d9bdf377c27b4064da7e4b7c626f55e213a91f0da10c63b4a177043d6305fcc296590d6aede95e16daef3707c5cfedbdc7dc0e3caaf7ce3dbd2b0b98fdc5d3afecec1edcf1fb9a33dcbbaf13e506def599613d79dff5e23e561ff1cc872feee18b7bf8e23ead2f6ea97dbefe496c6ec4295f26df8fc1404589f48d676ad5e266b1117fd227f1a5b17e1b84b52e5f132fbf71f05a628e53126dc7d0739ceea070f21a72a2a8f34fd507dd468a2c8cf4950538b6593fbf475d946d39a4b02dd4ca78fac1ab03a5d68768ceab172df8f9842837d746bc32859ae4ae9b734cb18e6ae67e653d2965034ce8f5119d70b266ca3e688a7d4703da94a1ce34347b91c78f8be9f8d7f68a09c94b56c6df379e4e66527cb8ed3b04ff7b6336f90673cb84fa66a42c49feecd6b02be0a73fea4261c929b7a363ec8df0ec2034174f84dcdddd44931d2fe7bcd159eaae99d3c53acc4e039b786bb8a6a5bbce17429390230cb9fc8650de7c2379bf645683a239cdaab586f9ca23b00f07e4dd8bc76f1cfdc06ec94767ec2989dd333349d05c8025aa5910a5258e8b1cc12f67ca7bcbb05f166063ebf9d65c958bcb31a96d847c9606c4953a5bdd9ea13a075528aff07c61411bbc2c74455a99a2b38359c4642f8dc32c5d8fa4e88f3bdbe6640fef6c57950f1aa7bdf3b0571ff6eac35e7dd8ab0f7bf561af3eecd5dfdb5ef567cfddc35675f05ccd3bd8a9785dbf958d4ab035bd2ea2034ed6dac07bf9b04d6f6a9b12ec7d075ef06c992bfad3f57c3b908ac9a2fda94f61468b8e9f49f577bf232d7bf6978f61ce7cd7b4679177bd67ae762c769913bb3447f2453f7ece94606d8d796f4dfa27f0e0feebec18b00fefb83e6cbfff82b9e25bc33590eff6be76627da6215f52fbdef6a9efe7fc057b8dfb8a71d06fd80eb892b62cd4f7f7aefb3c970e775fe72f984517d293f6630ede630ede630ede630ede679a830738e6e3634fef4cfce29a18535cbc4817a539f83a41c78e947eb159b5367abe375103acbd0c721b51dcd082de89681d2003a8386e225fa6f02237ff01cfcd98b891dfcfb7be6946c794569a6c829c2171a3c937b1b835ab158b8a07fdf36c8a44bcfdfa4fb127965a95e35937c408aadc9fc10c46e370dfe7b27921f7c5f8d25a959d55944cff406cbf1b89e58d36b8ef3e9b4aebeeeb849abf3b3f3324a3eefc6c346fef439e4974f4dcc7651c7cd2dc47c553ce8aa904ef12e7fbe289add035df3cba3e55ef5f8a454fef15bd3f1c3ebf8bfc7ff485724628fb03ebfa4b63b5e7c454937ddbf4c5e1e7be60866e6f382bbff707c9723d82beb7c9eb498dbbe2eff97a2112cb45e6aa109c178571f067fc7125ca7f47d1482a4fa6f36114eff5703e14e0c44b629c71b89ba9ad45fd0f29dcf9b230f2d27454ad581093d1ed4a7738ebb4fad519d485a37e52eaa002f75d6a02f424811eae9de94894f87a67dd1277937803d8b5af9171cf8f5f6f5a7c94acf7ea3cacf85811839da2e687f1f47c8bafa79fa35843b81722cf9c014cf731b647c23b44d306af6fe8316be3316b23346bc39739016d47dcf773f5c70f6cc9604de9fc7413bfcea92c3dfd4dd5e6ca5f3b99c704f7698a917e911cccb3d020e74184fb3873e8dd0535ab86d059802ebe77ee42fabe24eb98281fd743a73c74ca43a73c74ca2fd1297398315dfc7907db65e0cf76c773faafb1599a8dca16f5a782fe886209cdbd43733b9416b1b577900fedcd380ae426f19fd3fde34762fd38aa565c3ddf44b32fe93ea21c7c93cc27fc7c01fd42a2f6c8cf2bd2053423e38066ad207d8968e840fc264603fc252ab24dfce7f97111ba0fa66519c204cf84d21cc3a57aeeff76f103b496abfdcc84e7e2de2921ff7901fee894de79a7ef3d970ea3418eea17f20bfb90b95611fc18a62815cc2addc3e446bda150be4225ab1f185e845aa7d0bfb5832a9bdedfab96a5e6fb3955dead9a62710b310c15cf9800df13eaf1254a339eba30e68c93e4d65978905c800b47605f2aad2cc8a066239cf7ebf7e3fee07de07def14fea5655a40effcfcb0d6e4fe521de4bcfecce19e7b49d8f03eb43ecb399ae8581863de80bef1bfe596dfd34997a5d9487ea5318bf7ff70fe02e0fa966308e59c01b17f7e5c96ac67ced737e402bdd3f1ceac92a571581fe353d5f57b6fe1b3f57b6f931e7c485721bbcc958e7a5e3aa8c230dd66617153c2d9dfaf4e80f8734f7a43a6d2672a5d26e547db1594177e0526124d79bf6a562d9c4730f37af2610c04f628f450375d6766caf5d59d710df65b1726e11958deecc96fe2ded184faea1b962188ae10be41fdd284918cf4403a3da5d0d1afb081df8ebf10dbd3b9151f8fefbbac5f839cf5e9770bdf78ece1fe5c5ae9d59705ceff59e275791837a276face341dbb5e3eda667c5d77c4dd09b477ee1cc46d4c6e5b7c9dcc2d65753897e7c37da2a1e75d41d73d349322b7061e41f7821ecadebd7d990dbe4235dfdaea73677d67ba3e8ec4f2c124ba27b03fcf7de7b4679277fee535597ebf54367703d363923ec0eb8c7c4ffc992f97f1dad91c1f829b7d3fd61d6475853ceb5a1f8c9147f3aafd77bfb73fbe47f2dcfc9c8bd709f4303745f073f8b5269b3bd263545eefd9f95d287fcfce01ae76ee9cf7344539b6f7a87d42188da73ea5b9bf724d3053350bff87fa38dd25efb303bea6ac319f5db6bea4fc9560edb43de4eb45cfee8c8897a4eb456efd98a4272f898b44e9c94f120f49d6ade7ebd8cf1ef7489683d15832ca962257899ae30975db6bd5950e884694f6b9313e9ee7910b3d177403d8626abe1faff723744b0cdf8766a692d9e8e003d685e87e175157aa4e4fbacea7b3a8ab64d48a95a6686dc19e0a72c5cf9c6b5b4bb759922e4c933cf228823ecf9e5f9b3c97796e6e0d776573dc87cb17947471e7b371cc5e3685f26174a064c174b735287cca790697d2139fac88a39bf05924ff0eff86e1e7f8df30d83d0aaf317be0cd238f3847fc7992ec49f497dfda467893a1d7d61d7c93331f3f23dff535be9cdec0eb7da7633f38d669905bf9eb6d87038a47d3b62ed7bba63d2b45befdc7f96b5401fa45523afe83fc343d78ce35b40abf1fec26a658cee3dabcb92697e7865bdf8d607e87bc03dc3f87f3c4795da8ee086886b239ef5c6fd8819a4192e35c031beead5ac9ea7913d51b3645d3d105f3a02a9505606a1dd96526dccfcb0310617601c81523c003c7078d9f4be3c4a6fd70d9ec74801e70fc90f98ccf7ff30ee785fc788dd6d6741db031515c19e68aea6279aee7a5e3a574734f393ef4f69cd0fe87aeebc10f67f203f6c5bdc9a81ff61df8c2f749d674e12afee8619f11a21d79e6f420c74a13879f87ee899ff315bd17a1ff8bdefb41d7e7d1b52a9473fabc7fb80f9ec1cfba0ad390f7f5f21c917f0cf88ab21d397c62c4ce89e6497edf04f8be6a50336b365a451f77f3bd63da33926dd2ffbcb812f60d7e3c9df6bdf9577c395591b954b87eff976168eff9841ef17a7e3f3999309bec97e6a39059651f1fdf1c821cc7fdcfaea0c7a0978c3ef7fcd64db1556c02bf8b92d7f7e1ce730924515a698ab78f94dc24bd60c0276a6b4a73a2ce671333fcdddf4f6e46ec414aeea9979718f33b2e7a63af74fae3a247782f4e2cca834d5b90cbe7d528f8b369839ccc707d1d2d4f574d91c8587a5639eaa37b59ff320e19cc5edc3299bd40463b246f17d5d97dec3ef0e6afb2fb9060d3c4f28b14a10bd8cba345cf4f6d57d61ae2f516f2471b473edeb9eaf9e4f2de03f018f815a7a6189fffc841dfe42a45c40482f88c8b6255e7dc8fcbc6e4b92ea6d784b8cd482c43ce14ee1de4cbed4db75ade9db9464a765ff98e67d22f7b611de380aed494d6f9bf4f8eb16e3517eab242beb5df9806500ea8abb9cef4410767d1c15a175a8069ff31b24097cb36f42f6b36303e16212eda720cfbcc783c737e3c9892e31c93ec440e5a0c9f23e7efb12fa7e578faacc3fbdbb00f884ba7eeb6547e4afc77199b3d0affb2fb1659abc2dc8fb6df98f585d71285a76fe8cf42fde428faf820bb4c1aceaef00f38d2e09dd4e90a92dd6c749626c468f34dc89dca6a4a6ba3293df0fb3b8640d52fdecb7f00ef57c379a8f50bde354d76a7c8e53bd5b7c4d4b2fe5a7f2cca37a0f3c76afb34d975998f2b4c4b57f8165efdfc795477db902006c5d879f7f52d9cae0dd9589566a3b3d0f3ad2cf83dc60d548b3561ecc3476dd6b9b55973d8cb7bcc2242cfb98a4ea91c1854df0cf583b8cf32ac7362021d0bc38921806f017ae1b42723a50d340232798d6711d9e3013d570ad59a435e4dce1086f7eeb3308475f8fe33af7e95d4b3fa348edf137ce9f991583e6a83ca117a5f427f66f550591a07e8d7d3770c37b7d45d69867d83e08398223f2ad482a37b4ac78feae103b9e563519a81efcb984b27be2fce1e8e0ef4bd182bfd05f80ede6467e39d7948a6df8ef6dd16cc905dea7790d73dd7b175d1d96af9966588d6523d545e35598319dc54cde045f1e10ae9350ef46d407dad5dc9437ed008f5ecee3898beed910c3d5682fca126ecadf79e78e66b0772cb96badb599932f4602e4c24e8bf2fe6b69a28ad489d35f014d3d31cf199d73b590b68f0b5fcdecbd6db77cfa74bdf6bc26f883f54d9d980af1a664d9b82b5548509f42e39022e42359ea7f70bf73ab72b53e8fb32928b53bd21415f8f4db38e7ca16b88fd43fe3fc8ac91e81c4ff7dc39361bd2869a9506f2a93bcc762aefd57bea33bfbee363786d1ef41aa065c307e1fba07fcd35b16a6f9632e9836319f9ce56151cdc13cdebdf837b02c3f7600f26018df6a0473ee4f42c2037693ca8d8daa062b1fd82f47c7f017fd7c47a56c5b58b4d11303a9e117467de19d27d137cdc459d9d583f1a42793a92bdfe77a6e0644755dc3f18db106695eefbb3f3794a97ebc5a6d8729a22aa8d5b82de366cd48f85a9158198c0eb04f234314dfc767573a6e864f5fbd49421dab84b9ddc2f986f04fcf40be66441ed2196ed77a8d123bdcfeebb46648b74a61f331b81d8c38f59d98f59d98f59d99f7456b6e78b38c0ef474acb79cccf7ecccffeecf3b3294cf8f13eab5980c3afb4cffdfb78b67a6769401db06ba01e429a5c9c416f41f0cb637f94a5bbbd506f50883f9972276b223e8db2e5fd19d4693a23594f9ce7bfaaf938c99f2b16c2ed88f60cc139e25a72a04907adcd2d43af3eec9baa6f34a8196ff87a047ca33935dfcfa1fe9522f6d3f875126d6c2ffd12fb38bc5fe7612e6fbdf7982ff60bb03af1935c688b90384d94fd85cf39f29cf0679c7e08dca7f5e365077ace55fe6ec3957650c744d135e9ab3b35e6205f42fcbfd404e968562b730dfc80b83fbd9ed7401758211bbe21a1ba52b0b171cf614b17a1eedcc98e442edc794b19d25495ce82f2c91d3485f853f079d8a47f72e730562ad9a05e0ae4803387fc2f541fe6a27c2d908f5bf023c3fa8cc09f41f7b75fc0339b627da541ddbad25fe8779525b78b1d45ec6940d351f3525c7acd9118307c9611582ec1371ef31ec95828cd773e38a5d1f3edb2583ecb39a6686db1df35f09be3ded4387e7418299aa3d5d27d71ec95e29b632f6e5f1d7b81df3b7a9f900fafa3018d0b3d2f1f3bf071633e00bf34c48f1c4193cfc085553ebeb83adff33f39dfd4e9347b83eca13d8df6bbc45dddc669ce1395bbf63e787d5db7a74321d6468dba6afbd37c3abb22a8f23e07be9e61d6aabcbdb70b9d681c71cdfa73edf7e62dd73f184895fafbb0b76ebfabf1767a0c9d73f7fe607f87e57e52ce162337627b8130df23b902513a875c98af297f40c43be0fbd13511e1f5316b89d261f81e91be76727f82cbf0bbb21893f8290ebab00ff91f52fd0e17c68be03957d87824be08f63560ad2df41781f88eea0e27aad2398e647383e225f07fdeac76d21b22519fa4e88f33633be555d017b6ef68aeb32138d070cb2bb0c7f48317a732f268fe0f602988cf40dcca32e6fd227e9f246c47e8ec73c76106378fc384fa955e68039db5369c6b7687f844e5a0e75f3e244e10fbcc30fddcf9d9d04762f5888b3ce2228fb8c8232ef2888b3ce2229f3e2e023689219621cfd8d6c5e11a62c96663f6f17e4ea7e518f90a8a69a8eede69d67d9c782996ae84e6160d08fef4f31899b995c523aa21263393e7ed89295a9659ad2c5445739a0dfafd9c15f423c3bdd2604e12e2ab00034bae06f2d8ed00eef56c8f46cbd1410fa07bf5b7acdc0e728839ea955378809bf681e6e3f73df0a306f3b388dde1e734b2332b8c4385ecc951178a68ce8eaaf42623b12e68702fa5b383ff1fc950f3067de9bc9a129853e3f9eb7cdb27383b348707d915074dde03cd09f83c19bd0e3131941ffedbe579613e5b8fe41e878d71438cfac0e4bf19260f7d875fe672c8df07267f60f27f3026477a18e78026cdb604b9ffbd973d9997c68d7f9877e1a1cd487e0ceb65e40b3c8f37a3f8d241b4893189a79b71ee39d2fb5e1d01f36c847338700973861f82d163f705c5a78648a6601fe448ac67295e04ac01354e340681ef01fee0c10baccc89b7cd92e726e3df47f22bfe8c330761de5f9ae25dfcdac391d25f5d59039145f7c0784f139da321eeadf100e728b97561249b989feeebcf963cdf2ea1a14ab381f715d17d6536027a11a1e600e5176d54455b6ac13ce534ba49a697289c39a8ac478ae568b5ac0df94b27b34fbcdfcc0db79c3392fcba8ae6187388df02a6869a3ec03ec3105ec4ef1f8973f1673edde135b19896c8573f0f9c5e7b9aac4c959151b27186fa670a9ddac5f478422f6ff984f7e0c70ee0c3abe27dc0f4f492aef789bc88d9a73be683e033ec574772d182593f116b0cce95926fbe4c72a1c64322b10ba2532f9d17cd4d47ec954a57551e3a83b50ccf9afbc8417b7fbce3f972c47f846a45a0ae4b8698e17032029d2cd48f4d11f204cb101398a84a7bd2433de554d0695baffe09f5f09ba13a15d1d980ef019e877ef35e0c6acebc779f420d61d746b598a00797fe7c5fef7db0be349730e7312ade887082e8ed8929588e1e7e7fb8df4c437d91a5194fad267ba5c874f6e296f1ec0532bfefed3999518b7c1d1f792e54fe18aae9847c20b8bc3d45bc43fc4df911ccbd45e75b3f1a82334775b081cf08f10fd4046a7221742638c7089db3c653f3c95e297228eaac22fd91d553bc417dcff735e2b9d26b3cdf2be41be7c9c3e93612f46a35fe5d38e92b0e0bb1b3a54fe506df7d19de28bee27fdb9a4f17a1d9dec8dfe69d37e8f135f22d323cfe69f9ed9d9e214ee50e5c396b9c572f932b198b45f102a5e322ee8bef47e953663f98bc9a8fee476b196e7d7d077ba0a72a2d4b97a5aca634f96cd2485bd47b5f4de93bc6bc4de61bcc206fa429e61c55b0c03f7fd4b1cde0d17ebf08ef0039bb443f611c0af1182f876c601dbf0d50ff020bfb840fbab0e3f12de1fdbe090e64f608d9a7d4ffb58e50efade7b5a50631a401f4bfec2c54b9b83484acd7dbde93f7b331da53671ae803ec6f0479e0e5b72d8cc30cf51d50712f02c8c3b9e74c9f387f4c40831cbe68776fa9aeb4ba47ce8ba9742c4d187e881f387e7d109fe8640db7bed4e7bd3b3fdbcca1f825f8a278e68586fd7cb17bad0ad6762447c63ece3b0f8f8e2faf73af46c8c5841c2ba01135dfbbfc79443fc4af9f7bed48b71dae3e93a4ba97149bed37f1899ec4e7aff5854a9b11c8ad41781629e94f43fa1e415f178445c5b23d72a5a97946fe3e27c63a1b5b810d33a0f31148dd5cfdf235f1be438acea0f568e08767f442b7c17c1ef8e9a73af406397cba5c991a7aafe3797e8028fb3f692eae6157bcf50b706e1dba6ee937c89fe9ac0093117bfa43d6c96bd372d8b2a9366c6dcf7eeedbae9a28eda0ded638e4506f8f4f46abaf9a527174b70f392d9b336dd4c85c1a785fd45bbf416176e8f135d7b623819c2fd4fff0cd9060cfe023e463df3fa30a55575cdf905e49107b867332615da2b331ddf24fd2771f7c299c73265899177fb6c936e5edec4082a3a9fd49a5bb747a8ba233dac699e862ddd6ae9a9703fd9cf6962a480b5dd8cf503f2bb083c457c046201381ceb08db743f64e1047ba77de54880f56aadc72f41aa1b12bd79126e35264db3fa6afe25c3aaab2e9dc9b8e191aba829e9d8d06f35541561ebc38a7a658104b5ce2fc42a7d9a8e48c7905f51b55a17f03eaad89eb946959cbe14f4f91a5dc321464e730241b99759de87798ab8962a3ceb8515901b624fe3ca3211d0cafd7285a3fd409232c2abe86e42f879cc5b4f339e3a8299876f9c8fd7ee47e7ff6dc6fc0dc107701fe35e9fafdda3ed5debba8972dfdbccb63f9d05f93fc0ef5cef77bcaca3b246f21f68663dda4ff03ed8743bf3145e9c863d7df52c6b2eb27d881ec13ca470ffabcc28ca702eaaf9fef847a78403eb5d96859d00f14fda6d1770c1e1a4ca1bddfa20f05f4fe043f5e03f9a6d9f9f0c15a636467024dc7bc43b2ec398be6ab952cf41c3953f626c9e00aaa494032fc65a12996a3e6a5ac8af2dc663cbcc02f8bab0c0da5c8e48b6533b9908c0ef32de1d913bea95ace5894a6a6d2b7207ff1db00e112c887c073253dfc119277781d3cbccbca020e1ac2bc48618328d9f6b96ae6e7a60cfe560feb50eb4af5f5a6fa78237dbb547ce2ba3c43c83142bdede14c888ec3b915f09900cf31708d09c94bd7f0f9e19a1ea4dbe3b0eabdfb90f7f312f8a7a1b76b3023f34eeb4c7bcf141eb861fc30d536ed0d67e5f77e4aad4c44bce79c38ca0d6d5baf87ca1d6c5bd9f333847102a7ce499cd509792aea00d57aad4748b6829e217e0d2f668f7b54043ea27c13c5f9a1decb6cccd2f54d8a7ee1d627a03f1cbce7273a2f3c7bf326eb7af870b00fa703ff37bd873d3114c0afd4213e8a4be9bb477ae5917a4e12e36d8af5b52ec0ffd557a00774a879841cebe37df501b34ea40f86a4673e9e2fdf143b0b5d40b3182d5d2ea3ffd3c12e708b706f4153da1f42a31fd8f3723a12cb685ee6b57913f82c62754eb7c160b5c08fb3d0f3c65a97eb9bf119f98aa972bd1ae68b04f99e24e76baad29a41de36aaab3d335f390a4b495e8e2fce95407d66573897756fcace01f3ffc4c84ba8d71dd4feeaae37134e97cb87cf1207ea09f583263a1b55de2f916cf67c3bca48943630d706c927c4373bb0a7fd7e0d800b4de8218b666f40de6f798363976b3ddffa4d6343442e7cbc3cee91dc98cb657185f45aa3ce096c5722d38ec837ebd657cd462707f37648afe37bcb646aad049f13bc10f00fcc8597735bdd3560b698003385759fbecade9ae7ed0776f0b143c532e677a82772d073ae8c5bd60f26ae77463e38c0980ac8433f3715fb5a502e2a92916053dedbe738c4cf243e936bde3bedb9e4bd3fde36e4c87f24b59277c82dbd79cfec7bd66af3e33154537be75933b7996f8369371697d6f66cbcc58f91a0fe1cb4af7956ac797543943ead72eb556efd9aa467d93cc0ab711fe4a02b602787626254fd893f2710cf1e68fd3fb047cee8dd80f540829c0ef68f075f910bea2fd9b826d2c757ace95e188fa283e0735f17bae84f1be507787d9a3006ff7cf47849bd64141dfa743d77d09944e64b40de9688f93cc89d2073e4883f66e5f5378059184d7f561e39e3736a155374f1d93a995ca09bc9be79bad9a7d322c949839e22a6d2dae979e48b807c0b34fbced31f287f3ba067545b02bdacfac5b72af255c1ecd64353c4797c24eff79c3ac114dc991aab4e91adfabccd1fc7bb272d2b9d9dca6fc724db33889fa1c75a1d7c337b53aeaf983346f7fd543419ac9fd836e81d8d7cc55285e1c450244b171d772c0779be88df079f87b654616f19f9f6a793953defbdf86c8f441bc4cf35a36425ae1bcd3727defa3b90bf32c567f0bbe8733fef1ecbc58f58e7bd74fc2d6d63626fd84d31fbc570cb5bb396b5c7b274a46dfb6e23ed7d2f8a29f588ed71856fa7ae82df03cf7646b59042a88e9d9e636c35ebc1e7be0d766fdbd9b743082d4a16f89cde739d5a6fb89bf8768adf0b819a612dbe4efcdf0f2afe7972d05e32cd45e53d7e609f428877a932e88e578e3e85cdfd95f61eccd6d81a77af95845917e65d7c05ba5c9e81febfc7b354b73e1d1d726bc8a3d294e665b58f57dbce9fdf7e79d8d3ff087bfa76bad685fcc6f2e11e31ec01cc5fc9b7b24c9f8b4b756c45b72b2d2c4303bd59adc0f7602e07cedd8718773dabcafbad76a0f5d82e642f533d6cc84c92d09a467271aa3724c8b3997c63faf7225bbd51d942fea7293a962eee8b4d31b7d53c3fcdecdb00cdcd825e65470a0b6c38782099f6f9691eb0a6bf57d45a49bcf09fb097697b91cc7377c61afe1e0cee309bc4950aaab0cf69e2f0fe7a11ced74e9e1710818d72863b5bd3bdc69bd3b8f527c850e6fd12ec80247b6001313ec3ebbd1dea7fce29bb126598d7ef68457a8f07f40bb35eedd0bf5dc3adcfbdbfbf2c5498730bf674b5b2d105a8dbc139f58827a09e18fc661c3968cc19a7e18ab3739c2137cdb58ac857e9cdeda5fbe2f9f2e7c3f7e15ebe1af2fba8d81ffecc970f38b799e563c23bba2c1d3461f8f131e219b609fc58e8fe788d5e6ebe92fb516b426b918ef43350ff380f0bac4cef3ceead133b9ad29a93be11d05728f0c5bc4eded13b533679b50233fde6288712f2d3618659a38d6a75914e15f639742636876f3085ceee9d7fa60b391b7c12d7da6a093d63fceff0e8475569af474afb327d75c1fa4da593d585ece5cf23e719bdfefbe45dc33de4d69d6a1ac8b370ce7da395e3eee11d9367d213ca6b156222940f0966aec20c3ee039e8cb09f617cc6a867adb9158ce7b7d29fd754f74455a99a2b3035fda3790674ac5f17a74cca83557203fe948667af83135a1bea665c19d65510df320e9177230e53d1d97209f87e42af1251a874a7e244a38fe85ea95219fd3c315c1beae4d799f1d019d0d5e165e7e8a9485f8ccb71ef54e0da809ee90b931a4b7ce11ce3d90dfbbbbc938da060e689dcc24fe75be1442af78dd13f01f8ec4f2ec06b8b0096787e21a8285fcf9b4be31046b6bcafb99d78f15fa08f6576826f167c07aac5c10f139f97959d6d63c787d80bc9a734af71e2a301f32a78b3db01961aece4613f768a634b72fbb5ae639fb12efac59241b619602ca81eee035a11edde7d663c6ce04865aac37595b1af34e36cace8990d5e9364ff522391475958c5a5132902d0fbe2ffc9e582e8f30edbf0dfcbec207238f673e01bd42cf5fa5bf80dc6a4d80d9d985c960d843711058b7565b418eb54d700ce07ddd059c583f6803d49f7e0ab9df88bef32f1c6be5a795284ccf213368bd1c9a9d9cf4fdc4f9cdc427ff39cf1e63e4977508bb37cca5294e708cb203f8039d2bcc8509f4b104b270c7f1ac745d524d906309d826e28cc37c98f4fde4f9d83f3559da7dd2337b43ef56cd86ec145370b2a36a08ebb4fa50db00b2569076667507fe8da07f1ee9a907b2bab6db1a542d6eca1e9ffbeef8fc39f789e883303f457f1f7f37751e39537f1ce9ffbb93dd4f6c9f8fc7f050f7e15c65ebeb76a5621c721013a6307ba83710eebd1ff4678cd2f550ab6e824f4b69415c1cfa1b434dfb566f4858e7d6d1bc3d4d99211943af2fe2b7808397865df1fdd0bedf3adfde345f9d6c53ec6fc1a6007ac372cd023b5e958bc5005fa31e7380a7837a29a57d6f1f4505cd33f0fd12f54df3b57e7c939d63f09ebdc988ee5784e71252b12a2cb33b0b3d2fadb42a9a5f7e84be416abe9f43fd504f7d31682e03dc03e6d818211f7ed46ffb0b4d760ecd06eed93ea8408e8aa58be5b971284c064a6fa28965a8d9dee8041f406f19b0e5e6b3c0c7d268a358028df98dc33dfd29f1fd0718bd10d7efc3d685f24a93eb9b133f0123633eb8c781efd3f87839d2c919f9fe561f54bcb93d40f39cbd44a362ca60ebe277a77d6fe0ff5ee3fa658afe86b8ff1c994b816c8a83a6a0799c90f702f284963b27b285271ff196b936bdbc7434c5f2ba297a3549607fd376febdd79ef6ee29d8f886b52eb87e2fe2f3e03b3cf12e5ceb756d2d05b9587f5b821f5185f926f77f2ee4bd4c4737aacb79cbc79f05a685c8b3bca1ecc27deb289b3adda773514e422fb053bd675ed3ab0be6dec8051a6ba09a62328f12f247bef9b59e28964df3e616f75ef3de03f4a0a0d2f83dddbf93e2d7e1f6e7f8fd88c0d6db91f7f76375588e50fa1dd516935e835bcd7e5918b88e5f937bcb1006f1f2f45d22fbb0ff35d887e36792479facf60ef5afc9de375ff057cc50c6b5b1b77be643affcce7a25a56fc5a3ffe8a3ffe8c7f41fa5e880fafc5177f3a8bbf967d6ddb85eceea98a6dd0fc2ddefa43680c28657f821df35a517eaef87f134d4236ed26ce03bfbf33aba0075bf3306dba3be3dd7ae23ed3d9269edce39b0862065df147ad640f38476d8eb04874c16b1dfc5becab3710aeffbabf29eb3d62419a726619f249b3a380f7f2e12c1446b4c63ebd33dcefdd414275447479fabd78708f227ad9daaf417d1f8bce398d51cdd23f0744d4027aeb6d4204f21b8d78bc7a3d9f588d907bcce1cc60417c54da89cea0f975f03ff59144f5ee1f31ce62b8e8ae6224947987f3b82fa41da6e46794ece06e7714fb4790bfcad0827d1f9f6df22fcef507fa041fdf22e4ceb235c8fc1953be2eba05bf816827544c9404394a65ecd0dcaf95a421d22f53d94d3149c35f846cb6b1c5f0ae6420fc007e1cd526d8a3083a9bed20563093933e06f0dbf5705d7e2f5b9724d30addec1ff702bbbb5bed2ab97d7199cf7ac8ea58bbb9bf80bce7b6ece32c5cee22636f2a7c8e54467764f3906e77645fe26c5d3e003a76597a81d209e01b1c4a688ebcdc4f0bce366d50ae415476fc55bc6634ef780f4ccedac4cb90ffd48567a187bd13227901df4cc6a945f89fdc0878fe9991b7dfe818de0d3d21ce5eaac55c825147b6b4a96dacc3d82fb24d8cc8c2f25d93ef3e985c1f5ac0e4076be340bd10dce31f2df97d3379fa46771dfcf50df37c845f3f589dc5b81fed514af2e70a4400e406731021c53b56678f61cf02fe3d746f96a96dee8507a5a9af1c4163969fa6cda8ea1717f4626d43252b440f2837c3a2731c95fb167dcfc5b2da7c9457fcf48dc3dcaaee1cef74cf2ef90abb63fcd33a3f23dd93e1609bc15ef13a8dec417425f90eb47c93c7c7e51fc2817e8b30f68a85af807e76816b79ff4ccbc5ebfbe5ef57b64e373db415de0466fcc82331b54a623d15931fe042f4f2bc49352c110f74b5570b2f07bb07f75cc9f8f5cc03be5020af7c1813d78ce35b16ff87d35ac23d4f9ccd305afceeb9d7d6c358897f93935e9ef9676efdbf8cda2e3573e0fbec9bfcbacf2601f07c31e9fcd90643bf8b32bc81cef97f948eecd9ba2b65265e8af5180fc82ac0a79e80aa56f387a24b1fcfa11d88aca2169f5499d4bfdf235f1be03873e24741bd01d3f5da2ba8d37d9c3016f3239f37d2476e93612f883be6a7b9e73207e7e461685f00989bbb0396eb8de84bc2fa74d9b489fe602ea56f03c3af41cc89b0e61d62a5d7fe7648d03d1b12f0b324f1bec3e26e707e5deabf2fea8833f1faf4dab72ccfb2517a69724797f967f2ec94fc769a3a1fae9fa2fd8b31bc685fd3d835965e0cb9122fc78e4f2681a6c7fb0bd491f7f3a27384966f3e1cfb9e3184935667cd8e026328fbeba8d973f06c9b812f3637d439f3dc59f9b7f708dd046ffa467866d7ae2bb42b16b9a57df501ddebe189c19f8ea91ff28e075a829f266b2d13c89e6248fe40ef4a487f82ef4f071b4dfd736c4fedeb04f20e2bbc42f1c2f2f082d7e7c1cdff385537b96aa9fd3f572943eee78f961217eb7c783caf282fca7e8b83e9a2f42e1a3461b6177d4832b2fad54a5c9ccfe9c419c210b3123c0583e3e4dd2a5293af4bcd816de7b5a478aad22d34b2e88f7df627d69723145065ee75bae40ee9463b8854fd7df7a08755b30cbec067d0e7b303b493673ba583f9ccc2a46e76105b943afce2bce6b0ecf8c0ae398ac7ea8d8d40c1d47fb24f60cbd6ffe2c15579a9bf2de326c76deac47ef54bd68ab8f73d775a10836dd017ae121fd40d138ee0b37a1f2c4d3e9b8ca47cfa9fe198a06a9cf7d9f8b9e6fcd20afe3d7d9d7e5832672cbcd24f95981fe2986e0cc837e0c30f315f560b098b953e13c7cb047608672d063cfabd9c7e7e6ed51eb13d92afe9e913a2aaa77a1bf3ed4134565e6563175165e0d87378316e5a323bb05e55f406dbbf71c4deef1e45b910bd3768a7d8cf302505e862845c961729522fc9813d5ad1f7972a039b1ab57df1e9743ce11e75095ce146c38241baeb80fa22d01e2f5e0db8eccd90af69889dd7c004e4bd1878f58eb23d6fa88b53e62ad8f58eb23d6fa88b5fe4eb1564db1f09951f226dd56bd28dedaf79f759bfc6129264f3658d3edf364d3746d8a8ee5d6ada053e3f68bc41aa2f3a5a5c287e54b7f9efeae6476f8e9e7d5081fe1a3c634b2c634a9ce027fe6af053fb714922d41ef82a3a6f4d6740d0fadcb52f5566d9fc433c4ce676444b147d5624c86829445bae90a79d21b54e8fa0ef0d378f73cec7c1f00d4091b627d0e7d9eb42af267ed74c1f17af8082ae41b1d35a5f52b6aac62f7c3f767d1e7d3e83826acdb3556be9d0f7e8f7cc5d2843af8a6d09a0db1bcc43da15cc0d086003dcd41eeedb669b22c054fddb76781bbb754575adda36781a9742ce8617e8b7a00fef5811ee8640db7bed4ef3e5fc9cca17a66a0b7c16d645a422dc2f9e7e1c5932fef737d9e6cc77eb6dee5cf4baec5386bed30cf7d74b8fa4c92ea3f527c4390bb505fff36795e0e5d37e8e536f1e89324bd3260fad469036cab53b36d5b82336b1d2aae2ed6e7604f35c5d7890979a982946d36a8779aae26a341d179b3218fc4998eaa619d8564b6207d969af3904ef2e292a4cea4bf84fe841aeeb7d76c9c973b46638def87caf2cd1d6efc1a427abf6ca4a3019fa4e35796ff12682dd13e0bf62b32d6f2e8a1f0e8a1f0097a2824cb6d901da2b4d11aedb52e143e1dadf671ae1cc49c6f12eb96e17dd19c85903c1dc9454117d6e47ca1e72bc4b73f49fccf3f23c88b20b2b502f480f20bc37270a9cfb5ed4820339d212fb1bcd1ded3713c2bf312ce3631f7f686f6a6a543fe854dd15f3adda5d25ba44c44fd0eb08f9d93cea2e9ab30f1eed5a47b5c1ee9ba6f5580b906a643e549ac55a84b42f6596c2e21d48b3b51716b3c9f47c071db34f9992c37f9e525e8fd0a3a1fe21327f1e95fbf07696b4896a9b57d2cfd32f9b37138f92cec6eb8d25acf6bce4dfc53fcf68ba589d7db8f497ea7941c7cb636e45107f2a803b9791dc80d75d14193735bf31ef3a79c96a3cb654195afe859e074769a8c30a943f7afa7e42d5e0fe818af87019323fdab7b490dbcf7abfbf903a13e32741f7bdfafe9ed1bf834714c84895f9ef4814fb71509aebb32d6118dbdd19f6816e8e7f38f50b36bd15cda97858af222cd8dd7f716bddff57271e6643539e79d2139bf81575f493d0fdbd49f43160ee9d9ad90ff5cb5bc1e7a4211e692faef8a6dc7b3d6772fb948d147b46e861e8287dc4193d54f67139eecbfd85901addc20a7b4658ad21acb4822ef57f433de609e6ba3bf1d09929f1ffc36fda47419ec0b929f03a5efe838cf9560ceb72af076059f7701724bb3bab0068c8d6397b7ad3dbbc69f36927bbf89acbc913f39fabc96be4f15fa53cc67e0af7046c8efda3f72e868563e24af995f6733badb9f838cde91f60947f398ef63839a573c071dcdb9819cfbcf43837026034fb67c6a3a041bdbeb03e0e858ae437e7753341ddd950e23b91dee4d7c03df5a827c41b10fc403542c6404df1f9847ac5f5689ef67ef26daa0387fb391dc9eea42dfe9e2fef41ecd77b69a38fc03dd03f1a8f76f14c7f76c28ef370974fb297c7d579e611ae6005981b11be5a386ef67e7c09340cf501f69d895b926171da8f965eabb564d71b8d10e8589293a591dfbc0c93edf0bbfdcd0ae437e10ead9a9bc9acaa351bc39abcf34d1a1ec28e6f3c982079f84fa47aa64c6125573a4ca7d88f3437e0af8d57c7bce38ec4e677973e09614bcc28d53009ff4e4fe0ccfd48cec87798bf5a4bd4f0add3de66bfceef335c23edd3b3f7bbfd4ddd56f394fe40a3fee4ecf433d3cd426fc429b502c1f03d972fa79d58ebe57b4ef0c6a505a241631d3f05c51dd95f2d89f84ce04d7b9808f0c7c6d3007728bf33eb0cf13726ca8d99155cb46757b08fbf450ff7dc823041c063d42787a8332e7152797cf96cfe402391dde4b84d9a10f1bf44e039d02fa92e008c7c43219ad0fd775804c063d657a799308eb911c1790e581bc7a59a8aee4af1f6137947be840eef8d2ac72f4166531d9fdfa9ea0fa44dd35a2f03e239fe36a4100eb950f91be94cb78e8aab3a72fe87d326cb41c55906654fdf352ab229f909fb3f40fee6f62697326f6fc89cea6ef9657ba58ceff879ecd11d6e8e56c7fcef31910b94ee64c63dc8a65dcec4d69399aedc7c6563087177fd75bdbef3a933ad8f3d8ba45bf6e3a653fbb8d33def1727f157d797d62e79e8ea6f2b7207e84f535f64136fa96e19a0ecc150fe6114b1bed314b3c7996789a7fed3fccc72b6159dd14f75b55a0fa5878b2c0d2c43ec6d685cfe79fa2f601f99dea84272a2b5de810bef7fd7d6a60ffdfb6bf84bfde20c64add07ff3e32468b3f8bec3d45667e123ba7379c95dffb83e6499e50648d5a6d1ff24145e5e118c2f0cba8763a97a2db08bd5394ed77d4de978bb67d9abf9fea0fc8779cefeee478923bc4fdbebd8bdfb733b8f87d854bdfd7a85ef8ccb9b4baf499bab0bb748f76863bbcf4b759556eadb4f01e13da0fd50c62dec333810bdfa29ee1cbe3daab9de21f839a938226f7cacd79ff609eca68f41b322738e6dd973af29d4861ba247c7d83fae751adf90f5947fb1fb28ede3f641dc37fc83ad47fc43a22e6aa87634483ca7aa4588e56cbda66a3953bf1bd84757f8c3e92044d69154d11f5c503dffd6a24179d0f5b53a3e5345372cec0e73c16a55977d0ca1a7329f47dea379e1e189c9967ccdc2bb81f9e191da70765a710da137271ea5135022f44e93306df073eea61cafbe53d7faf2aefbd99a98748dd792e6e0cd6e5e7f9955769e717c54b1cfd11a867259c9f1d47c7fbe5c9f960da8c7cf60d695a1767379137a476f6dcf807732f5ef9e598ae74182bfd05f82fde6467836ba1b7b1f74bc399e013ba82474c39026b56cb697609657b4b8d645957f6e2c26e7d85eb0643cfbb611c3ea7a7c9ee287e8faf558e7a56522d74dc99af46717228fad9b7931bf3bea5bafb54d94f3dfb7eb263de3ffc2ad9613652b13cf35e213bfddc1af98fda3fbefa9dcbeebd883b1b222f3f885e1769b4ca875392f5b6a6688e3177b22390bd871cc694c38f924b592d4d2e7d7a4c89f7939c53dcb3d8b38b97adbf42f7ae47ca2474c6e7d08ce1d58e58faa93f28eaec63ce28c257572dd3b2e5e4ac4e642139276fadf8ff42f441e80ead977ad764b91ea6b1f3f07d9c1c7131be51da51bc75922b12ef5762e92fb8ef99ebfb38191e47375761bbce561387f6dbb1f0ad1949b3816e8ba17947bfeaf9b36b9e0df90d5bc86fb8e21ef6891e3a67eff3d2b5bfdf5df3fb919c3beded9ca6474f6526d6a11e0f90ff8bd135fbdbe8cf5bdbf9a9e78c72abafd96b4dce5e4367cb6b9eadda57f0189a05518eebddcd7b8fdc95ef908b8a73c4f94a6e888dbc73bf09cd5ee04bfabcb6ac2f3bf1f7e2ec94cfe8e348c159a9bc689d2133833552f5b1fde4f7db7ab1efa2a5bf7af56da1e75d8c914fed4c90498ff3fb7dcf6f34b889dd766bcc394f9651b9e5f85e36cae796a1f6e7c44217e8a9609ffdb5ffe3631e1ea6b905ff7d4efdaebcdc46377c42bd87ec9e14de4b5b4b60ebd7576604368dfdfebc73500fcd89e9e5d91fc0c73042b3989a13139d57d6c6f97e45496ab53e6a0fd4c14d68f7d6ba23557edccb5f11839122b0cf593c91485357f1077d25d149c4f7589a61e5099f5ea5725ce1ecec9bc85d82257cb904753723b9befae5bedc545ac33dc62364439cbe3bdfef784aafa46ffbcde4db857d8f6fa197a27883acd370a5bd293b074deeb37a3ef5b7091821f5b788b667f56c648c2e6aff5c0be8c3973978bda73a9f3ed770fd96ff6c4dac6735a9bf849e4a5e6d5e2e3b9273ced82d6f42f239bd2624a6fea338845a36ef33ff59a40ed06a36a873075d2d4b5f604e24cf1c2ab2ee98774a9d8947bd0fcb93de6fc9dc4baa0ee3cd5e0fdfecd5429eeff56f879cf966afc27e342cff98e786f469541d40e219c9396b24ef4e6923217f3f2e4f1d7a6de2fb4d54b93f83f7348e1fbfd7de4cafd3fa15f49e7373017d2d86f98a05b1e6a0d6afb3d5c5bd65343ae1985e408f3be0674d6951754ecdcdb7eccbe6db0de6cc9eee47f1bb2643efb7d789eac24c0daf8f2c9e77fb21f9f93e0d9e9e45b8ce127f8e3f8b9a19593aa1bda01e3967b8b3dbd0d7a0220d671c35a8787d71cf489d05e7d63763491a0ca23124f47fcf6a5267a7827e0e6ae0a6bab05fa94ae518917f14cbf34aa3f2f5bd91d3f19f86565d1b6fd26ea30c165fdeec15bd6fdcfb97b68f43a28ba0c7c3a07254a1876b2037611d501fb683592cc399566bd6dac5ef35357ddfab7cfb4fd192cf63a7719bf0f9e0cf494c87966534fe0ad1668009a4c368903b503d906e498f1383eaf3f556ad1c4d341faa7e045e365c981bde5f9aee702249ad36071f27f36f7a1db4a5cf1d782f9676d03a4dc15a9aa2f46e8875c83bf5e95015a07eb5be3384482c748e3c2067341d89d25a7d2f7ccb3c6596a39fe3f93af3fc7f99ee6c9279ce649e329d913b86bffdfdf7536662afad8dfea7b170ff3217c66cfcf3afc5ca1839337bfdfcd7f741f5e5edafbdebfcb532acb13bf23efab15a2d7f78fff1e77e65c29dedf9bf17f0a7395e8f6c67057f9d7bcf88fcc55366651fc799e7dc574128969e32eec21c679e0b4216fdf5c7da463f15b2c297ffce65ff3b577acf09cfb9afcf42f9cf7c36fff56be96baeac659e32f6ea8769ffcc3cff7be4acc64f99d5013db836de669ebf14b342e129d39c2f32cfe52fb9af5fcaf9a74cc7b1e7b3cc73ee29d3460fcce773a5d25366689b99e7ec5346c47f2a3f7e2c476616fdbd6fc2ddb24f9941e8852bcecc5b41215bfef294a9380b63b6ca3ce70b85a7cccbda76e13d066303565816f242b19c83c7afe07f0af95cf96bb12894fe7ecab423be5a2a94c957fdc5fefd94a9f27f55f9f16333dfacc666e6f97fb34fd9a7ecbffe8683b6c63fe1b56ab06399bfd6eef2afc9787e72c499a74cd35d2e7eaebba3b515a216fff3dac2f03e781ffd9c8cd7dedffb8b05fe5b7bb436acccf37ce3384f99c17ae48cfd1342ffea8f47abc5dcfbaeb8a8dbce7845beed3dd9ff676dbcf4fffe3e5ead996fc37f31bf682fcc0d3cefff32f8f5e3a91bbda93dcf3caf7f6ec64fccb6a0576b2f4cf25f2367fda7bb30d17f4be39f2b1b2d20f7a750fc339b81cd5da2c711164b7c2ade4e4ee6fafb29638ed6a3cc73a65b2b1774793781b45b4300b59ab5db8302b84f40fc6dd1d8e463d696a4bef8f63eb1bbc7c2370c3f3d7130a8147479bf318ecb827104d3bb9f356a8bed5bdecc9b8762be7d286e0dd7d8b6a72fbbf6a0acbe0ff7ef9e7a7ab559353316732b7ddefea2bf53f7113a47c36d6f74d7399ad5e25ccfab5b7ddede62b7cc16468cc1a868ef5d4afbb7e94bfc7d892b27dfd97507ad85d9e8efbedba5ad9a0797587133523ad937d7dc9a7679631ccad8fdc1794fcf3d04e656d66cbc7c793b94a746be357d738b4b23dfdb68425978138ac737019be9c7d74dbbda0ca9a526d5caa40f2d39877d4773eb39bd01ee9c7a4e95f7a80d9976fa3bd20e058ded363c687cedbb400adb11a9b24113f660d3aee6d63022cbc8f76174833f9aaedb48556f716d54a00517862bafb8bcb7d81d663b95f76ae57dac74a06d1a8c6003d7c8d26ccc2643716fe9f6cb6298ebf4be0d2a226aff08236984ec1743e82cc05c896c490325c73ecde682b54de11c0abb3739b7d445e968a2510ecdf0fd503a6427bcaee09e0b18eb489e19a2b16af9e4f3d3df43fbb8fa61946f87efed7ffef2870465f2afe5f75eb6de6ebe06e5f2b0074dd16b7366562b5318f70b2dbdc0b58b47ea915637d0fe1f9508a37265b467b8554ac3d98de48ea58bfb62b311b4b4d6aa64847fa768e4a18d60e5a8c99d9ce13ad9f1a0b2d3c5fa26048d1afd05b8389b22d0ac37b21ada87bdcf9c77a95a791f565f0aeddacbbafd3e01d307ad2b71bd10decff782167a64fd75ff7ddea9f7e9c1fb34abd6fb30f7b2a4f664691ce87686e4b7396a2d39b416bac5216e37cbb6f6f068c295d6aad2fa6950908a949e1b6e796bd68a474cb76b23a05b30a32cdc0eeea00be56c043c0c97cd2740ee38d71b9285329847af17c0e133dbed39959ceaee9741493d8c90aacf600cea15edf77a865b16a05d9171f0dae1c13d3024b660ac9a895b45190dc9d6c9c82070d5c8c55953ac0baaecac54b9b5d44544174169bc3fba1e529a2b015caf7294fda794fbc7991311fb68a7ec1b6a233c6cb4b6a6ebcc34a57dc2afaa0c6e48dc0ea3c1deabbed1aa15cb84d18562dd1ec97ba0bdd8514981cbe27719e9d1391ab56576a4f45711e311f727219fe969a83e8a7f9870c7d1c8bf7cc1f4751c79e9bb1fd43e10ad07428dd937390758cb1dc951cf7bbde5f37cddf7c1cf21fbf911cf23aea883a6684b845beddc02f09b9e6f1539cfeb943fcf73fbf9ef95d60624b1ad9e2b092305618bc94074007f1cc6834a1d74fcf8fdbcf627fe331859e6b9718b59035add024618544888278b4259e21e7021921386ff3e45dcde3668afa4cace06b965c540d691910f9efd3084b143075d286e611436c215d0ea16da65db2f0b6fdce26ea2cbe5a347331da4bf0cf47b676b2a6deaf98589e63a0723df5b9ed3fefd8cd67d09aedbb43d6ea69e1b6ad7f20aa3a6a0dd2ab48a7736c87d84d3ef4dd1b1f46a650534ebb5a3417b80f804b7ee4ed15b647c08c2a620db17865bde80ab4f131cb073267abe350397df3771b6d106855553cc6de19c0d579aa2b36ba0df2ff5bc74a49fdf14b5a531ef64bf0dce684dcfe9263c2324485fc9a50bec9516cacf7776dfe7d47aed335397d8f042c41ea4beeb8d42e4bcfa0c743d0ea15ebf56aefdbd5b6b5aa4af6f349e374ad744e9b6832eec9dbbeda54bb5aebadff959c6bc5fbcdff35a8e91afc0e8ed2594e4be29f8f9f132015fa7ef57b5e3be8baf6bdfdf0f43f8b63b079d3743a52711f65734ae8ec1271cba8dd8580c7e40639f2cc35d9f3bce25ce66abf82dd45cc0129dad8e7529e00dd08fd856b120540336398ca7f8a43a1ddbf6befef65bca833e85b4376c1b014683300dd2d13042196321f0579d13da4db7abaaa7296f493616773a2df09c5319bce73af514191921534b1faad3f0fe9da421a5a5f2f86b9e4b9b11fc360859c3e8560b523854081b2a9d9d2e40fb5068e7958331b5e007faa929ceb1398db6597568b70cad451b16843817acfe817406432c436b655b17876ba009b3715a1e88eee76a4b4ddecfa87bbd40cb7d6d905b323a0987def07af03af11ef8f2087f3fce46b255b9f3f34dce59e6bd46bdbaf5e9e8003405e9164dbe76e191a3cb5e01ffaebcbdf75b1d4e470ae6e979dbf3fb405a9050ce19302eac41b54f54dad8afc7dc77b288e2bf68ff177fbb5318cb5385bd060c80f6da1f17729375a4bdc767f1cf007f6d4da535d50657e331127a8e7a27bcde481b027fe66346ec538dd7e3be2d40f17faa5e4ed7c5d1fad7f759549abecfe2d5b313aff09d567488d981df60e0fbeebd7d9bf797a0bfc09f655611ed057e93b937da24c53f484681205f0ef80346e09f8716d98209be4c345a488351d9556bf9e60e2136b0d6f31d84f55401e9fd1db2ede572ce385428bf4dc51d29ada3599ddd9b57d3cec11fb5fcbbfb4ed2f010962d717a202acd3c6adf23f5f539361c1e77c487a52fb6333c9fb9215831d8e6763e6448ed35abb9b52ef4714ced639fe7c7f0ed0f5e17decf7b3d0f955655afd625bcfa0b5ab5fcfc10bbf761373eecc687ddf8e9ec46fc99cfa778bfe3ec294f3e7c3c4e6cc373aec183211b028f16c26df0115ec3a30b612c7180d9394624a5f014371f81cfe51dfcb63edfbc86526f1186125f27ba58c6f18c261e7bd977685b89836fceb78df8ca0f634acd51fc93b795656ae94eb3211dc32547bd606474f899909abf0eed61d4bef3ea0d7e9d1145bf0bb0c78c79df19377aa1733d537f44d3b6b85feaee0ad92eea201417db1a76e8dfaee1d6e7fe382cb99335c15751ad6c74015adb115ea81c7461e9a879b043679f6314846b150d513a98a25430ab153add9d8c0dfbf87de08dff71c4fe4e4bcf22ed08fa733fb7c970a5555ca95f04eda4e63c31bc106d4fa6e640b51c9dc89aeb69fa15ffdbd6d0486af099e3d22e2f2e8fca0ec0b61c89ce0ed16b5e5b22ec09e3d3bd71264837bdd995e148748e4db163e9760572ffe04f7fac358cd9097282101fc168da9da9a05183ce39182b45179cad13185cb552c363f23ed51e71ebb964fb9ad64f416b6d0abb50741a7c4efbb6e5fe521de450192cc3234923f4eec323b39ca3898e85cfe9fad19133fc6fb9e5409e04c45f74599a8de457af7d0195f38762c28214f2ab72f898d833495e37bfcf895ce07bea786786cf0afb9afa58dfc0c8464cabf86cbdef51638b100d438e2d1a379c970eaa30bc9fac4ec2eb31183d2e6735946747bd432a46b90c53f74cb9b502df1af6c30fa17cd9c8f72d5d79e1a3cd289a74d099400ea78373305159349cd5486987fca8a600b9d2b40fea17e79fd6a1a419f2deeb6b23183375dd7ad2e83085fe78db0590f2d41e196bcdd883c1bda4028cbd368450695dc8c79452da1e1797f0ca1687fec8da0fe191083fe287c7f0ded1332bd4332bd99bf0ca0cf4e410c7bbd07e233dab879f47f2baef1d1368432e6e53a472711b11fcf17af61ad2de81ac81df3e8dc20749f4482eef5eceb801f4da0995de877c3c6edf324509c9c737058d1d5e9ba2b3d694e5966d051e513a5a23238b3f265ee7d7e3d0b82615cfa4e29848fc12c8b166c37feeb5ba22c8890e747a401fd560e4330f164fc1e067f963fab46c27fe7d12c7ae9fffee69cf4ec1c6dcb1a704ba2317ba97a658b0afc7931601a8cdac13d566f6beb2fe34b6f2f1b23ed7a9f586740d285fde7954ae39b9972e97671a5d57ca335e93ece32d70ce0cd78b8578168d5a27f585a0db1c9daa737cd0ebf9f4eae7157f3c767fa5644c4b03bce7d5cb1c387d2f317e444abe8bce0cfe1cc9da12f2e60d45b2748203c07f003586cc77800754a13cd3de8bc49663eb087f89ec1ec870e6088784f290827b417d26f51dbbb207bf285abbdc9ba8f3d904ea4999efa035fea67c12be3f438b513e47cf9f10d92a31fa5ed4fd62d61d75a5ca6c1e19eee5419e5f2f1427c707688438b293a1ee04f95328ffca92e6bfb72a1aa30c31a4e35bb502b1ed25ca5db12b5bf031823f1eea42a938671aa667af648c7fbd4f865c60030c699fcc07efc3b9ef996c27b0579887a2ec68f642b468427da85098e8b2b4565de980684b69f3f1d375cfbfba4558d28575d8066496e162ff47d0ee69a60b272d76d22e6e1f6ad2c58d6b78aeda7ed79dbd4efaa2331f297da831ebaa2ec4b5189d60bf2cfaafe5d6b7f715926f4df08537708c690e326e37310407624de0035f1a870af408d89af9f604640ff854c1b7ac291a3c037cae5653d472bab89ba8ae03b5d95b2ddfb20cd15aaa90ef2777b6868bea8cd9ba7a9e0bd3fd957b4470cf85e785f9d5d1e7d25a535a67fd3ea9ed12c7efcee2613f679c479644b5de8adb372a461d715f7c4694af8d591fb396289d7e431f01c4c7225a48dfda3fe0c073509cfbaadc5dd276aed940be1688e5a2df834e09b089348bcf2bf5fb82ac50adcdbce7c57345affee3de58147a18a0d8756346ecaebbacf13f0197a23f7f135cfa0eef7a535c8a75d8dc81b8268e015772c6bc02fd97701f1007c503506c1c7267a898a011d025a1219457a4e7fb0bb0f974a1f5ff34b993e5e117f64ae11ff6e2e627f602fe22fb8ae235afbe4d5a447ce2f913fd58358ee12c911f00e5e6a136ca417cbb11c8b0b76a05d543797c55ce8e20a75f2cdb23579a9a3cb19d38ddc1a973bb0d4e9d442e8fae73ba8b623b6b4dced99ad2427a037274618fdf671dd4178b072b9efdfc2a771bc7a47c95b80be72016c13e38e0bda65adea27cdcb8f8fe6f895b75b16e6b38d78fe27dc865ca8ec43ae4ca425f8a25c4be71ced311c957d492d3ebf963d89529c4620cc171a9582fcad1f5f883f4812a4ca03746d08e1b6a3cd108e6892a80afa7e5a0dc3bfc2c4d945c34c6d3e32fdc0a5e037c1b716fe031c8cdd1f0bb0f27aad239ea4267097162ad8a5b75a21e0b11bdb0d2ae5abaaee3b952f461da453059c8a6e2fc7d525b588edf9d2323765bcaae8dff2efe1e892b47c5d9d87da3f4f3d97a7c44e771826d3d2bd6905c8feb4df30bf578c876e48c0ff0e873492e2ea1468df5abc6f5ac22faf993fb9058ff34f2255db1d68ff313f1d976141d05dff36d2db7783c23efec621d946a9f5539ecb519bc33d46ad7a7b7c4a53d6ccbe0de9b7eec602c077e430fb773c4c162cf888f872fd6cb286e16de1f123740efae9016e9b817298dc13d5ad87c7e5a25a36bb873897f31bd7aefcbe95be0f135b4488e2b652b90fcc24353f49e07b2c9cf110be2fc8c3d8eed82dae7b695f0a832e287f890f59ffb7e67e0aed4dc60ea7e745e4f98de186c13953387ef11996744ee4ffc6c293552a175d17657b791c2ebb57d1a5d44c5862ba17dbcc60fe7c9892db6b1a0466f69d895fc48e92f9a6230feae294a05d4d3d5ad7b75e30dbfa69327be45ce345a36f0630f8857b16b475883e8a3b73cf1b955ac91d224232182515ed58a336e5456aa5cb4700fbb03a9f3bca73f2daa668f89db9ce41ef8bd7879fa7e4d433d712f96eb67c9f42879eef874c2974396964f36bbb8d6348d46f9e9b57a11edd217d0315d5fecd1b0dc9f8d149f4e412e613c03b91810bb43ba0bfc55882e602c90fec97a16c68c124639c7c37aab3bcc0e53eaca4febd0a3eae8d3e477c4f75859cedad7717d6092ec00f833b95e31cdcee5b76f4bbc98f8567ee9dfc51fcd89adcec654bf85df99839f537d37b53dfbf9653e9b3bd1f235be995fe493e1d327e7eb925fe97b216b4ac0b6bb2d2b3b99fb94d8cf2ff4b170631a6e3c73635fcadd7c28441ea4f0d6d93e93fbfa4a3e96b6e6d846f86cf4e5e0dcacf37c1f893e8fa0b61aaf99b2ed4762fda0ca1d185fb734f2fda57af8247416ec039393e8d789e25852802b54c182b1b9531807093de64632570fc15bd1dbedf278e8dec7148d751b69ef77911fa1ad0b385ec8a94763e4d8cabf8fbd2336b765e43b5b5570e0dc66f4dc0f384355e9513147aa6f8bdcbbb73f21b407be4c831e2c1e1dacfcfc9d703c93eab14a62b57e6d3dd5bb429a7d56bf42948de68fbd3d7cecbc113c66f76efdd20dd142757cf77a9ea9b4561fd2532daa3fd59d7bcf87f4a0fde853f7e853f7e853f7e85377599fba945e2fd01fdceb73f29e9edbc6cd77fc3c17c56facbd7d2d569774d7d943ec85a93d8b9be5b6c5f50d9fc4260cf91748ccf1f235ddcbef9544db31f41c1797a1b0e21a729ca8f34fb51bbb8d041c1beb87a06c843a859ddf2fc7f043aa5f1dd4be78bd2cbcf990d4fa10cd79f59f053f3f10f93c6d6aeea41b9af1e7df874747a4e8056e5d807cb7c17b4fe8f511bbf264cd22b5afcc5c4f6ad6248f3ef814fd2d42f29295f1f78d8fe3991394cfb8b64fb277fc7b25c9ac28dff0d09b4331f906fdd985fa66a42c314e4579b23be8e5a00b8525a7dc8ec447cd4678760f9a7986e66fee269aec7839e48dce5277cd9c2ed6a1473cd8855bc3352ddd75be109a84b807c4420ca1bcf946f27885fd0a7ccb2345739a556b6dcafbec08686040debd78fcf67e5f3e3a634f492c9e993982faf4c36cce55539496b836f408311453de5bd0ef1d6aaff57c6baecac5e598d42a427e4a03e2259dad6ecf50dd02355742d0062f0b5d9156a6e8ec7484dbbcbd340e1c7d1c53f4077f4fa684b967b57d8847626c37bcbebbd951309f9ccfbeb14b97aee9618f3eecd1873dfab0471ff6e8c31e7dd8a377b647f12cb6fbd8a20e9e5b79073b14afebb7b2410976e6b23f1b782f1fb6e74d6d4f6c9fdc83173c2c7f5d2c95d85341dc11ed4f7d0ab34475fc4c2a667b475af6ec2bcace3beb5dd39ef55962a0c48ec43e053eec1d8559b86ca5ce42cfb7b27779965b3e6807cfd7c163b7b44ffb0047f159946d44e802e9a07bd981e03fb8ebcc6fb73ed3902fa87dbf35063ecdbbeeed235eff88d73fe2f58f78fd235eff9f1dafbf212e477ee80fc7e4332666710d368f8b51887b47433381491ce86501b4d4145fa9f88e9f17896b6aa1ef3eac03c5afa83acebbe53d76997814f19554e26255ba28cd810fe16c474abfd8ac5a1b3ddf9ba8811db07cab92da5164375ad08711c5ef60bfc9737e97d84c225e7ebd255e3e8ec4f2c1acc63debe596cf02bebb2b764cc829fd30ec11e423e7965af56ecfc53d70eff6bcdd482c6fb4c1dd9e9733dc3bda00f79d831cf23b7c086e8d9431681edd87eca9ef0f26b8ea5137fea81b7fd48ddfa36e1cef6de41ee0cf7c3ec5319e381b97e4bf203d169c4d6d9fe42bbd227fcaf37d02cebba4ce360e3733713717e5f204b871f2add1c9a902d4d8b4d11c86f75cab3618f696503f0e794280a5e1be23d9dce0fa9ba32a580eae2b4ce3d164be3c0f37f7701e16f01a1b7bc5fcf8e1eb4d7bdf649bf68e31a0b4bef509b1f673e77b5ea4a7a8774897d989faea82799f89facad968229ea9e61a27b35fc3f4b59b04181bec33e4ab0df555c0756a17f97ecef4ff5cec03227e2032db03f75cf8d07d38f73dc93e24c85d960703da4ed03114ff84e9e5beb152df36a4d694ca4fa9fcc3e397b14f64e9e96f260b2e7f28cc7ea6ef857bd246e6e09a0ae4e91ae0c380fb2c3501fa4241df92ce74244a7cb5f729f92cdc792c287f25755f92754c948feaa1531e3ae5a1531e3ae5d7e894039a4b7eb883ed12cc069fa0671eafb05906959d2ea03e5607349f03cdc243b35e0e24ce663420bea622dde1cb4ddfff4df74eb42c4398e039429a63b8549f760ebe49e1136ebec031b6933d223d0f0cb18c662640ff761de61fd9d02bb1ef182ef62be42be04f703ddb843caf17d56371a12a9d05d6bb306f929e0f9cee7720faf497fbffd1b95ded9b4b8aa7a5e8ab05f8459b36ea2386ea010c455a1aae34a37458c857622aade59b42e562fec23e5d2390194a2b0b33619a8df3f34353f8d3abbf40bc19a647b07be87f6bf396a509dedfbf416e5a43b2f4ea6ea2bb65f019597866ddce70cba8079626f7707d18a33f936572b2ae3fdfdf07be86059cb131ef3be386e723677348efb00fbcef9d8c0f69be8eeea143d15bf0b9ef0773738e263ad69b82ecec704fbaa4d94277a2f577599a8de4575c1b703d7df7f1bf55d7ef79b4d6e4fe520d7a37933e4e880f603e8811c8f64f928bef9d193e2b125f7ec5bacef67b5389a1ef513dea2036b2063b05ea09b7a6d29a6aeffcf80ad36ed2d927e2a91b6220e2f75be35956c1bea5d3672a5d26f9719bafe8df7c3644a4cde0cd38fc86731cbe61dde4e100c044c84f258ce47e4e9577f7b59549beb55d4179e64426f6e7d24aafbe2c70bec212cb104457e06b1d413f31455b6aa0076ae9f4944247bf6286da362616116f5b27e506913d20748bed80b878a297677b323fe05e7e2272d6a7dfe5f30f89a6bc5f35abd64a934d4bafce708f45d44b2eca47745f9a8e5f2f176db3be1f0efdfdf9fc3a7629727fef64af86737168399096275adb27d969841e195c500c3def1abf27cefd049a217326f1bd7d997d688af5a9e1963763397b5fba0ed739f8b96667bff3dd6cc7785a63f22d82f9afe1f977be5f31ce1e4cd007789d91ef795efc98aa51f87859ed3feb0abc81fc10475304bf897fbf7bc7782b64ae3991bb50eba4e73b5955a6fd81bf932f03f6b2b906bfd81df3c5a6284ff463f3198156b25c6bbaa25f25a1c93719f544fae03aad0ef892b2c67c76d97330cdc5d24c6dcfd621fbd80ed9afbfd06724ddc87e0e7a0607bdb4bdb5219c178a0fc23c66c2ef6fd50acee144be902dee5583ea882fc8094b9649e7cb2672818cf274d7498fe40f592fb7ec7ccba760a4537b284ae691ab44cdb1843ae8b5ea4a0744234a3bddf773fef3c8859e0b321e74b89aef9f1d838c902fa199a1a41f37f8787521ba7f44d4958a4193aef3e92cea2a1935c09ad6166ceab02e3c63ae2b87dd9d74a5d8e471d7a5f35b93e712cfcdade1ae6c8efb7061afa48b1b7373cc1e3685f26174a064c174b73522706eca195c4a4f7cb2228e6ec26791fc3bfc1b869fe37fc3d89b51362fb307de3cee8873c49f27c99e7bf920d139bfc9e0531dded3164071465e9d1ea5cb7b830aca5f811e6fe85e81af2644bba73671e15b94ccb8451cb5e7cb3d147ff1f530efbba63d2b45befdc7f91c5501eaea281dff417ef31e3ce71a5a85df0f7613532ce7912fd1aecc35b93c37dcfa6e043927f20eec8b399c278e8faf55d9011a9a53f6ee9d7d8e1de8cd487c3235b0d9deaa95ac9e37717da3e9e882795095ca0230b58eec3013eee7e53488906f0a72c508f0c0f141e3e7d2b881f0c01d64b3d3017ab8c687fe0ee7a50d76689e9be93a90638172a260aea62e96e77a5e3a5e4a37f794e3436fcf09ed7fe8ba1efc70263f303e978fe78bca167850af566aba70157ff488af1078429e393df0736be2f0f3d03ddedbe62b7a2f42ff17bdf783aecfa36b5528e7f479ff701f3c839f7515a621efebd531217f11e070ca76e4f089113b279a27f97d13e0fbaa41ddadd968157ddccdf78e69cf48b649fff362a1d837f8f174daf7f2aaaf881b490847ff3a0ced3d9fd0235ecfef2727c3b11572febf545e925e601faffffbc0175e4f85ebe425e981087e461b66c9aaf3d9c414a595a65416ba5074ee2c2f87a09bf03e125d5f0bfad0802fad053544f67850c986befb1bc6db23f620a099a8dcd239d2e571bfe3a237f64aa5bf2a173d3eea7f1ef53f74fdcfa4597d298d1bed2f06e697280cc25e8816e7d2067435cce144bc8e684b3a72f24e2cbff23c9f5cde7b806e86d859271bd23b617c9e4edfe422f1f4b9b4d69416c999f3e6c3c2fc72b11c3dab3ce94ac7383cd7c5f41a75751b2fd01bd88b15363c1d12c8ed33636fd5b0ecbef61d53704bda75694c8e5ca5887850109b73cb2ed41efd636800723c050deac71e7470161de4b6aaebacfe41b260ad0bad25c49930569be8732f3fe2dc382e737e3c9892e31ccf8cebb2b4183e47bedf9f1be7657f778e4e4d89fb32df23365914fe65f72d320eccdc8ff61b84d7775fff00ea07f7f176993478af5d6e8f4952ab8d636d794d6e4e0cc1819e005bc3aeb4c0cf67b845c7ac42dd9b74a4ea13efe68b85f7237e834bde35ed592972f957f81572863b8bad934d780ee691c87c59fc99ef3fc0be86b8bc68f055de23279ab1f12f8f29549a0d2f87bed9807a50546f356168fdce3eaf93b50539105edd01aafd3565c8e53ef96e9aae49d623ff79be58b497749e60b791b03fc1bacead4719c273ae887d559a22c474491da754f0ea8c2b53c2cfcd86b9d0f31da7d9e8807f11f270a74dd139028da0fe0ab237fbc6f399417da8178f811a68744fa5e3dc3bdf4712a582590b7a2550b93d148dfb343631056b6b08c38921805f18e623b72723a50dfc9ad594d61acf2c42feb660fe945430abe89e50efff313c32a8ac478ae568b5ac6d365ab9133cecfd666eb8e59c119d6fef98ae74182bfd05e4acbcc9cec6db8be6f6f4bd6e21a75b4bd5dd2f75da27f04131889eebd8bae86cb57ccb32446ba91e2aaf9aacc14cb0eb6537ee870de76ed8302fb992877ca05115e63e751c7ceef6486ead4654be10db23bc297620ef6da9bb9d9529436d46612281ff4acc6d35515a91ba70a035a6ef36a23faf272fac499a35c556b1f95a7eef65ebedbbe7cfa5ef35d125b85fbab3013ffa482e006f2d51efc441e5a8299e4c504fef179e3f6457a6b8f677aa3724e8d3b169d6bdba7a88f543fe3bf0f208c92176cf9d63b3216da85963c0b7dd61b65379affe921aa08fe135e82f22947386dbb947dde380aee93ffdbcf08db94774ac6540f50618542c23dfd9aa82837bb87933a9f0fc0bf81eec01dd1303e656410ecf027291c6838aad0d801f2bdb915ccc02bd996289f5f382dd6035c5ce92c89eb75fd7c387c4722acd06757662fd6808e5e948ae6735d9efcfe3f53746df43f55a815ea9ee7c9ed2e57ab129b69ca678d20f88a90d81fcadd709e465629ab827a68beab94b2e163f45e9b0ec48e9af3eba660c61f3c3c73ee3ce3dd267bad0beefec8080be2eab85ab46c8bba89abb79ebe3e615453dcf45710fa1f33167476c8603e915ce598379ba163ebc7516f64ac361f41c201ebd90aa232eed8f9e664b574f6d419e7573dbd8f405f676a827be27f72f9dfdc4ab6f68ddc571fee9bea4ea59b6466f382bbff707c9b225422e44f1481a568af89e2f9bc26bb8a2be16c9ce86847c0b860b78ce39c08c0cdcfbc8abb998156bef6c9fa0f379919b0fa378f092deef493c28c9c525e8eca6189acf4cf57af2ed2388434f74a1857ab171e4c9306796e09f0bf62f09a3b017e4cf44f6e0bd624dbcef908c97427227c86ba1ee9344efd1341e671bb801debd836d0035285826f1d15ea4dc1f54a6fe7d6ce47f3a8e14c83b28ce919d2c4a1bad5a819c1ec8ff403df654b9380bf5ee6c74b2c65c829e81c0a7517626c2c377b79f035c14d800944de2d15e6769400c0cf1933ad1d0da40860f27d8d764e92ef409acf873512037c3943b59536921ffd4a93fe2ee73a8afc6fae09ffbe0f95dd03b727747ec487c6217d91649fd48f16791e7823ff3df0def551cf6f47ac07ebcac68c273aef2cd814d4cec629f7f403ebc4ed47cab6834fa217e1fc9a663e47b134d90365ece11f8d2ca02c87e35ec8fc8198297274f7a00abf27eaee75b4b53b4b872e66e29330662fd38a27cd8862b913822390fd2cf786acc617f427cbfd404e968562b730d7c98aee75bd0f31af804ac90ff81ea373f8267829c11a146dec98ec4ec3d65c7ed623f117b4ad17454ef5e7acd91988f394bfa4a8d45c6bc4732f6498d559ed228275ff1f0594753fa0b5dc0719dc0878b7b4583df15e2db8ea0c9bdf36caf33edaf8b6d303fee19b94f0813becb527624963ddfbaef5bf4f960691c2a33e8876dbad28c830fd82b992fd82b1cb78fb3756edf6fe5f2e77f48ff15c6df14d787e57df0faba6e4f9bd948bd1c7761f911977f3acc5a95b7f766ae6347628bb8ab14910315e4b2399d666f903d7eaf45fbd5ae597fe7fdf570d3f5cf9c5e7f987b7d7b7f1512ec72f6c2f525e15c618edf86eb52a414ff03253730cdc77f9789f547e91c7261bea6edffd3fbe2fbd1b932ccfac26b89d261f81e91fe16727f82cb704e42b711b2f9821a1bb71cce8d4cf733a4fa17a27d7be5d535f19ebe178323337b0ebab007db6debc52c20eee1cc516c13f74387d9e05e6f83147d92a23fb8f505e807a7b5d5839a5a12d7f47060037d86e24aaa3b9ca84a07cde3012c85fecff343a21e1469d88ed0d9b5795d717309787df430f35a8fea8937b8a57f3ed43ff46363013837ec439f31af1cf4fccbfde2283496b43f24e610657b7f5c4c858a59639f381f4d24e3f8737055929d9a66b3d2335ccfc5d79136eca57180d37b45ef0f872d7a915d1a11dbf6633f5eaed5c5737bcfc1d6fcb89ac3f6a42f8f37cd839e97769172c0a90cde739d7a0aff45f06b048fa4618288efb1f88039eb58dd9060f7c29cda89e9d5651ee0f723e44b6ce2d9185effc4f759e73bdb5f9bb937ef5970d163040fb23efd73f8308a0725dd75f6a63c64ea07495e11c9f1ab132cb055dda5a3e6cfb0755330cad958855c805966a11806c12e97af292d278a5d5382af23114f27d17b0c8dc76360e9688865a82db67571b8c6d8f1e373c41db0732ac85fafba7ba759af58c6bc5fe4a4c9487d109ab73440f93b749e2133e3a078447dbe1a789ec61ccd65b14c34c74b739a0dfafd9c15f407c3350a30d704e936e2efa36714c1dc160f9fb71c1de42fba577fcbc6db83dcd73be7afc7ef3bc93d3c6832cc6b433977049ffb3987ecac05e350217b72845813ac53557ae0fb1134b897d2d9c1ff8f64a8db823e715e3f1543940e9e9de4db08c1d9a1b93e609b41dc610f3407f782f364f49e9f23fd3bc5663c5b67905b46e8b8fd0761d23bc76a427b7c37bc8f672e46e086abf7f4915bf4c82d7ae4167dc2dc22cf8797fb5df0f780d287a0df0680156e80c387482fa2bd00dc5dcf523c083a176230b42e86ef811ede7c925ca3b87d419804d57af83e4484d17c1c603666a8c683c1b1a877df397cc9c19364fd81fd47c94efcfb48fc803ff37526be6f9c8e2179dac1fed6f6a9ef76895f5af27c9857f41f7b9d603f28ee95d85f1af9ca4a5566d03b14f26e2cd375e6989fee8c73c13714d0500f6aced0be7af853139da321eeadf100e703b97561249b983ed2e926855ea2e436e0928226f7a0f7d2c10ccf91f37fa3e55b5b537989c4379aa239c61cf2090033e7963ae4c3b8c3105eba211da23e539d69efe36dc119ce0bbfbcde7d1bc3abd1efc12fa3403655f13e608c40ee9d4087848eafae7d8aafb16570715c7f307c86fd2accba85d93a116b0cce35aa4f98ab2d755142b2e9aaf9cb556e5916f36ef1b28d47d6f5511f94b3e628f2e8ddef785e1b994beb8ee4bd03713978be06fdb1aa95bc0a35b072710a35775ab56219870aa1293f26a641cf3cb90ff80bd5fba903a4e7d16fba548d9af7eea87fed1f6f7665886bf7c8bc5cef9cb00d6e0ace4aaf7af55d46beb3d40454c7e7dbd19ab727f991286dc2ef0ff7ec3baa5086f7390723f0f1dff598815cc09f22daf3d7d08cbd0f3c173ad70dd5a102b6820bed299eab893198b98439b251314f149b1081b7865087e6e876e84ce07e330df5809666e7da171cfa29eaac22714d846c8ff27fe339cd396f4e73202778fbaca5ea959877e1a3af58bdc3ce6ae6c041551e5c84ff0dfda9085dd0b3b2710dfd08cfcb1809d28cce25fce4fcd6a16772137c75f5ec6e5edf79954fc746f142a42f9db9dfa7c94dc93b4714af543a0ec4c9d17c0aa5b252e582dd14b35f0cb7bc356b597b2c4b47da9e4db563d3edd728bbf57dacc06cfcec642848e03380d91993a1b8b774fb6531cc757adfaeab673e92fb1bd4fd0d747fc8cf9750cf004fc6968fdaa042f4b04de4f2fbcc7997aa95f761f5a5d0aebdacdbef933bdb1b9da291ef3bfaa0f2aec99d1ce4fd8e07959e2ed637cdaaf53eccbd2c295be463d67b375c1ae3a709e82bc53f9c734cb18e6a4838fda89174cae1f7863cb2ad31efada166d8d3ad1c3ef76b6a9309dfca9d85aab42c358ff27b8fd0efc538dcebd99edd40cbf60fde67a833ca822fee173cdb56e5cecf373907fe41c7e0c9f389d8e347ecff11fbff4f8bfda31cf57c2bfba640cc1ad92277f0f5807c407dd27a865b467619272d46d2a02190bc57ffbe086fbfe73ab5ded09bd5846daa83a6907e28740f6ca2b7cd238e271f74016cb6fa5143735f863cf66f32fee6c7db60cfb6b0ce9a0cc9bb931ede75e87fe8d98fe4ec30a6c67d79a047a2b7a7e1faae603e2ed111ad7cdbcb6301bf7ad59be10eb68836f8a4f3bd1fb98f8fdcc747eee367cc7d5c40aca2e9f5ef43fc6928d2d2807a846bfcc5b53db7ff8a0723c4f98547709e0aee3349e72071dab32976ad970b8eeb3202fe801ad226dd936ba2cd5b9626787fff06735450ffc8dd4477cb906762e159e520df518f084dee2dcff01325eba7f3f514b9405f2dc0bf63e05e95742e1c65ef7ef43ef0be773adf137efae8de1273823b72d391583fa8b016a5857a17a8f93bf4e875c8f32bb588e75f1113f6fb7f103f60d4fa827e213efe0af93d9666a3ede578a0b994d2c1407e909d17576e043e310ff7a1b9432b888fea77cfa74cdb477aee90b321fe6055b02cc3ad4fa1f7abd79fa37344fd39bc3d23fea155b3d1c9a1be8fd0db52e91f31fdac9a8d4ace9857a0dfeaaa29d6a1a7c791f23b81dfc352f3bd892e4a8226ef7ea7fc48b2f6e89e6b29b94bd7fb4e626aad06b7ac832234e3f1e29b827b5cdfce4f42747318ebd97cfea788fb73c9a1b364529a7cbaac87782a6e667b72037f190db0b7689bf0f3e3e67e58be113973f1face798f73628b5cd8f477cf5f7cd43fdea7fe316a4f5da0d5e15a55500f8ac34859c6d84fa772ee625d11c46cd6e05f310407ea3cd0bfb1beb54fd6c25e2c0d0c9adf62bf8baf0fd03f4cbf683efd708b7d1bc9b91d60a537790fbdd5571fdcab0ae63e674da5fdb17add456b39a8b2e968d59c6542ef977ccbaf95699ebe3b739df24cd58efb2ebe3e84a7ea3953b4b6865f2b555863b900fea50fdec3a26336a06fd20afc42d311f2ef78bdd97864ca153482e2916fb2b6d2f306878cbe628df3700dd69be2d5be7d4afa78f85d1f7ed787dff597f85df167b17be0cf3885df06fe58df7e8ef231bc29d1b2e74d5e3b6325e477f2e9cb1040af77b2e0b31c372a96294e58d9c9d496e470ddc9892c46f78398aa2ef6827bcd20feb877de64272c93f0de99b8e72cbe5734168f955b01d6d1aa949f39ddbf9c9a771ce54f1e623f42f335882fab42fd1c1b36da6e6df8e7bca4e3f8b8263914dbf36d40b945cddb718e81af2de42bda418e128f8c4a914bdcb208ecd7947d227ee35c4c3c1ed57084fd87e11c293c4709f2681372ad0abf532e7f5a1e6d89f55bfb3ce0067bac29cd35ca4bf98439fa54ffed293e87894f27f55be7e8d27bd2c6f9d8a7b448d1976bb8e5b53f3b05d7d7196e5918a199440c5f364cc8dfa3f2eecfac7fa5698f735fcfce8389c987f1d75ef7d7eef945305f86faa4637ff628e2ec0279a41d7421ebf7bfc418e9a0e735d8b319db4bdc14ea47baafd907e7bf9ff0681ad6e0982f1eb27bc1e66d4e93ef17dcf38c3e7ee4f2f8157430f0fed41443b2e3a2776164525cffc485d9e8ef8ce362fb26688ed66859baf2b2d184b270ce33ceb60be2aef3e3974957c9a815870c6d83ee417523743d07e89abe2bcd86b37a67587dcca38e9f476d65cd46e5f8dd2e6d47b294d5ece2d6708dade16ad3b77ccb558fbd7d7bda8cd36f71d75971899bcbcea8abb6df7567af685602f8830ca1bed21bb36076c2abf34acbbb6f75491a0c7b4b907980dd34379805399c69b54eedf5d0fecf9d73fee88b7aefbea8586f637c45f3632aafa5c6fd927311bcbabdaa259af27e754d4d09ea19206a39a8bfc335797e0eafbfbe5f66f3a07d9d7c7b45efe7d785f0be73da33537895bb476ac24ceaf4d9d7e1bed9db4f44cf6b03e6d4ce7bbf82aedf21466556677c764ca4ddf23ac1ef4f6824c2160ee5d2c0ec544bcdb7b6fadc59bf55717f10a81b6e747223a56f811f08fb116623f0e388300fd1d9406f333cff31ab22bf0eeaf57534e51cf80e8b30f3d8c873d50763f91fb37ffcb80df2cdfc5a346f5ed4cb62e8edc732621637922f513e92b76ae568a23ef5a073eb9ba6589f1a6e7933463937b88719f415c94b305fc68eea7181eb8151dca0d920fb083de5a0ae32e7f98445e768567f4f3fc3db31521626c905bccec8f7c49ff9fc99e6b7a3ea1e2167bf037e770172befc98e1c9fe24d80fb57d921c8f8b1fd0b57cefa82e54e92f4defdf6d946f56bb5647c10c959605329f9d5d4c68b5d908af1fe6219ba234a7638b617a0e6348d08feaa0b2c03159ca66eb6f47030f83b7df5f369de9cbe17bb5fc7d2075866f33ad35acb737edf759b1392d8a03a9f2fef6fe5afceee964ab6bdf3dcfcea787669dd98f578f1ec27af4e3f7b53f737ac34376fffd7d76cffcba789d49ae84f92ee138643a8fbdc961cc7e120fc1679c247792e218f8339ffff17e9dfa63a8f846c093b963982773380734c7cc6638f1fbc7f94a92fcac29b6ed7e6b46cd07e1f7a572634972751b2f7ff4062f735d2ce75b870ab31715bc17212cc0cc41473cbe061e075c6944e80aea8cb8f473228d53f4c2ec23f39de4f91aba5c9e79ff7fd243890bcb9d2d7f18bbbe25a0b9f34003ce7fd2be8f44673ea2636877a471efd9c684c9b722f9cda13c6966667da0bb06093e14b23f1cef86cf2569bd69fe8e249f46bcdfa2b60fc94a0a2b61dc7f92cf15ac35fd8cae8d776ef067d7c63ae95cf999a6402dc11e30baade7a56c53ac434f23669d5e9d91975b5f87ef408f9fa3299661e618e4ca1f74a1e31879e8cfbc43352726f8aae79df548e967893e4fb0a17630a34b953bc82ea2d7f986e749a27c296148d3de52b751ddb4d5142d9c6f14f83f35b1bc7c73cb07ed50dab7ab05a15d6b16deb2c5d630d7db0c732f9befb597dddbbbba33a7ad03cca866fb3d74a62f087fa9ef855f667f0dc2b82aaad6e1373fbf0ac2c2cd7aa7d7ac4d76ed6a5668573f697d6c54cecb23dff803f28d19fa51509c8e270fefca7cdfe433ba348f087fe6af199f01bbef047b337c8bfb7d9dd0f6cdedf016232f483cfa83744c79037936cc33bdb592fef6c837d4df429deb9b57773fd5940ed4e7af3c1f50ffa0cac5a32e3b596f566aab9854db65345a4bd3ad4fc727cf25356f904bdc71681b14fc4f17d9f64ea7f736c5f2aca11e0ca8f99921dd3319e65e2644f7787ae5f58f7be706317a65e2d12bc953a4ea0bc53ef4115aea8df64495a16f6a6fa20b458795f1b8bf1be91d8c72821ebae55adde2f5d7bd61de3e9131613ae3ac4988b8bfff8cb8bd62af6e2301235539fcfd8f9ab547cdda3fad66cd65649a8777506d6eec9ec4619eea6d310f2f2e851e4046f56e7874aa0b4597e40ca9f23e54ef74437919f5ec25f4a4d4f2ad7b3ed332ddfa5217ebf6e8366b25fe8fb83af2c43c57a6f7ecf75ef62487e16cddc0ad1722754228b7f1bcfcd5a8f8af139707eef70e98126cebcda72adb23579a9a357e7dc0a90bced6031eb6a372e9a618d3d52f5f13ef3b70c87f2c2fa91e379c3654422d0683e53d7be94d8e95a9eb9120158d4333321f03785fcdf721065f304087d5f865f589bd88685a5b6af27ed66c583b55e92fba83d68b1f1bb34b2798f3aa3a8c8477a3ce2195275379319a0743cf6ee2790c2c2d5e1ac7edc9053647746fcaf515e279da7e10cda5e94a9bc83c73f4ded0ef7bf8073903bad77bb3817abf856cc6089fe0bd7d81917679f335b2b76245b719fe6ed473a01f61ff833a95e144131c41930bc939e5fcfb91b69ee4d8c99de2b23cf3fdbb0d463e513151ec53b0119d40ce930bf69d03fed328ddc7cd6f31ef10cf7fd59bf0a37fde24be70812d15674fbd1ba23465ea3e22fd051cf4c7c36fec95cc7fecc5cf8f17f1e749ad87df6bb2b3d3e40e53e311f6cbe05ef6144f77c873fc9a2be88babf1f835d80beb1c5edaec3662626611dfa5f615eacb605fd668fe86d242fb631cf9f8f1aae757afce4748ba4a11b1dec83cfc73e3505c6b627cbd1c3677d27591bf21ee4239132073e51e55af0d79301e4d533cef18f376a8d7ba2737eaab66bd9253dd3da5bb89ffae22ebc23e07b9bd83e16c32742561a474d07ddbeff171fea48b437ff15cc93a2eedc2bcc8d013dfeff16f61d603d25b79cedf327945517a3766af3c9e4ea00f268f31ce7f926a1330f7a3f57a787d1f982345d9e6040b24fb1fa87de9365268e2b2584d2de2f99301797e90abc7a3cfa3f437aac9d2146b87fb3f921c10d453d05f27f43fce4b2bdcfb18d76e225b32a753fd4dc0bf0fb3e05461bf35f2d201e7dc3376db9d731d679dadee6a44af5a786e728bf4f47bf7d64b70f5afd98f470c3c3a060ebae5a3674ce07e0c317ee3cbfad4f8767a7d369a4b47534436fddafb3b8ded38fca89f261e135acb64c0ae85d3e64fb3ff07b2dfcb14f6137a7a323d4a71be3ad45c88802dbcf9ea6635c48708f345fdceabd970487f7abab7eaff33954e18c753b50ed0ff43774de733c6827a721fbd3bd65ddfd875905ceedf616fcfd9030e3fe47f561c0aeb8948394f7448d41ee0cf7cfc83b1545c0dd8482e6635d944fce8cda8819ec1943df341f99103eab9cd57f2dc5bd58e480583f084cf076597ea890c76654e8778ab6b406fedad8e7d6ca65ca4fad3c1ef21a79996f39f0107398093e7867b5ffc03f9e561be2fbbac8f8887ef53789dbb0e332aa7eb2cbc70dff8a7a7c73e36e648622137edf54964851ad24db9533dc0916b18eb2b49973367c99c34f9c3a16779ecb0349bace5d72907d834d46f89f024cc6a073983e72c64fdde30283e82e64047fd0ed564c26ffdf94b73e2bb5de68c7cd8470b7b80791b7acf6f34816b864f84cee2c09e97f967c1375bd1bc77f7f0f7ebc93a887cfb1df6f69c3de0f74571c45efe937b00e2cf7c1b0eef779c5f283e4fe3e37d4215e6d99fc91f0435ef0713cb044fd7d7a7aa201da19e443fa09a77986d83ef178e81f8b5f7273124922b7a6fcc541754d959a9726bf9c17ea38fdfb7878fe9e1637af8981e3ea6878fe9e163fa0d7c4c582e53f7fe189f520f3d07fb90aa2f0be8b9a0f93d5eeb2be4e3aa565a542e30f8850ecd57161b5cd187c821fd4ffdb9ffd05300d918df5ea3e257bb09ed0b7bf3e6b8b1b87019e61fdf8f856beaef8da53c7b14e3a6abd645648d847b9f62396903eef2e8e6aa730cd9753e7623f2e78ebeaaab7a8685650992a79fb0ff48f47bd17bd938aded8fe98b7656ff05cd757686d76f660d3d3fe867327886d7b63ec76f01fd502bcdaa09f331e6a7bd73888e0cf7c981fc19a64fce2e59d6f3f0e66e9b86f1fc73a5f792fd4e726f5b77a4548ea8e7d120b7d60598e35fcfde6256e925fd2fa89e27ff697bbf18c9e6e217d13a7a767c8f17b6b78bb3f994bd5d9272b018f919928d313887b60da9f74db5bb526dad28fbcaa13039f61535ab56548ed1aaf94ac78bbc5ade9ea2416db7a5ba0ec24557f7146d50ef73cd7b0c52e775a6f9ab095d44ef273fcd832ffa3d88a7dc0a57063e268a5e3e161ba6c9b014d9c5ef2f8ac71a8c7c8beab118e7675933fc7be2bb61f2166fd97f31940be9fb69e601ad3ff0ce03ef3cf0ce03effc87e21d625bfb31b35fd4833622967395ff660bf3095479bf0a78aaec86f38a1273895272877727b93458b7aff47ce7181133fab5f946748fd9885c2daf56f937d9b734f99622d7ee173b0bf6fc4dc675dda22368835c541dc31d7a4e70c6b2ce5b0bdd97e1775d039b3ff06bd671275f3e2df375a1f5ffc07e19299af32647d92f1446fa209fff30789fc93bf53eb8df22cb2797eb85d9ebc484fe8b8214cc6646b1fda87547cf1e5023eaebc2f8ed349fb2d9309730afcf9f338aeb6c79e28a29b1446e0c0a79e9e7ef33ee61d4e85b866b3a6695f4a046f9b351328ccea10a7ac121ff30e80dd80ba463580c16918f5bc98f94fe6224fbfbfc0b72276e6a0b9fc173273dbee3fac8c4d7e55f2a2fc275be17ca8c93f7bf438f726d69343056973f6bbff2dfc4c608f68acf7f11d8b3ec99f97b19c78b17e1d98b7a96ff33f7fe13f72d67fb9543cfe1ff847ee55c38997e7e1a7f741b09bef084d802798fe62bf51eef8b903ffa8adcd4385c40aff33e78e097d9d189fb4ae6356555a535d794de9af005ce19f5bf4be745911e2c6f550f4768556a3e3dcb6711b9a7267c2e3a1b66cf7f9b5e4117d89c9f32b670c97bd3ef13e1e3e3eaa7d46d9ccaba185f2ce43afc227d0df8ecb7d71bfe5ef161d200a3b06716ec65026eb8deff4dc53dfed3f6de5a6ac2e417d13a7af66f1fe7c19f87ed36fc39235f43b233a65e87ca0fc86d557709f58e4bd31dae55269780e695541ea9ed937c253c31882ef52e5f4e62ff57e457c4e18093f5de070b9cfac6268b08daba55fe05fffea238c46b9c9f8ef9fe9d7c7469722d459efd8afc526efe3ac97d88f549c7fbc26e9c837af9bb3ff2361e791bbf69dec6bdf579a04fb9f6fe91b7f1d9f23680e71ad2f144e67d003e029d662aed2be26ef8df72cbefb7aae72b3ecda9f26ee2af27e88bfecbf04ab05e14ff7ac57e2f3be8a95bdef93ce5d6a14f33ec77ce38fcda99f6e7f452f1e9c89560def94fe3f01bd5a53a2d4797eb87519e932653e9b33ed344c7a745d86b4c2787f180f2bf41ec18f97a1d5ebae4a7d1ea45f44a5f40bb034dce5986bb3efafe47b93f8338942e142dbd0ab3067258b7b48a94ff75a72a2d245f460a678c95be38f411b93862aff4e5e9ea3cccbf7a89ace51ed65bdd617698320fefb4a70deb23647831d1cf99806b9998c205733ee64896408f21e82f506e028655a425cc62bb81fe4d92fda93a40e3b47f93ec60546720c23978f416e881e23c90a31c3e7d96f612724e827de2a4e7c0d70fefe3105ee27ef71be2b813bf7a52ffeddbf4db4ef1ab31ba2fe27d2fa2cfebe51fb900370e691decf54f24e7d65f814c3744bc36d740181de628690d09ef59829f33e2fc92747b828ce1b15528bd11c6e9179fdf1cc9c4c39bdc47f68a9eaf201a8d922d9ff31c5b0ee2373457cd99432cffad5a99a1fc0ab1bcf36754a21a1aec7714a559821f3562afcf901367f03173fe89b65904768bb3d3504f3bc3cead5579b9853f7521d4d72a89d77d7ae17df78be5297d816fdc09617cd4df0bcd42535a735d807a9a8a053a7d04f163b1b350e5e21c728735e43bdd6f0daf2749681ea987730a4936f6ef7ed6d09768a7e73bcb93bcaa6abccee7943317fb47e80b7243a4903f16cd24dde887ca4a178a734d69527a13e1ed7ff2796d2177d1f8a4e73414ca6b94ef8fdeb172041ad192627c11fb7aeef33f50f725e5f1447c2f014f119aa1f039759f4b73057c5bc1c7fa2e8a6d1c54d974b46a8eea598be988dacb54fd9b8eefa3703daaff30dcfa1c729a7b8a941d89e5c34859e2b3bc2ade15ce17b2916fc482f71f89d2a129866b44021d8ffb3a0ad612e64743deb471a0f4845c5c68280f15fa65d67724163492a523995f6d403d8ed271c7e13ae403f8474660e7cad2c66cb437cdd73ac88405c82453940e7a104f029935d585e254532a60ef1f9be2ab17d793b37f78fe01a803457db50fbab043f8d1843e35640ea29cbdfb6c69d4cb4569591ae456bd06fb8be985f4dc3c9d490b3e3731d80b35a0038839fa7b8feb7bf223d1998e48df274aceebaee5409c0ff64e855a2e2f4e436651c17cea23bc8b2168c550bd73c3b771117f1887c2a42717895cca6a4a6b1de448e15e536ec7311a3d841bd481e7d7009c8b7aa8365a5bdd9b5b2e404ebb61ff47cf9e8eea65057bb38bf28b24fb514a97ad292cb7eed64b50179dcde870b7ded0e0438398e552ab7e6cff420de9695a5f48b31bd20b89c31e882ff177ea093d24feece379beaa583d76a9ff94d77f75860f2ba08f7331f0de0efbcc515ca3a289fda5efc781fe7d58c718f319953bf1984d7ed3d9e449357e14bf980de837d1ca99540d0eccba6d36d0be78390cb362ed1d3ebb413c9c8bf622f8ed9239b349fc26e139bcf41c59c024902b057800632ee833897c77a416e98c580c5f1ce67cdf15c45f5ac1bc48e9e0fb8d2f5f13ef3b903525d807f7b269401e0cd7610c49d1673a5d5e56434aec8753dc3be99f11bb88a4497446685d27f688e14a534d8699be8045fbc7a678f2fcbbc7afc9bb9dd87376e5f5349e71d5bad2de2b992eef545300f4e461a613d91ef60544dd9fe8e628bd843ff3b113ce33898bb5ab27e791c3d8ba7eb8479d75043d54fde7bfdfdcd667ec43338a862618775bc61cf5dbda113ca2376613232fed681f7e53849835d4279b0755cef9f6fe48deaf60f607f887343c03e874af2bd45e73e03372ee317bcc8dc3208e383b5d772f5877b25d8ee75710ff482bdf4e591bf486ab60dfea1e625d4753a9ec889c2633db35d1b1b47ccbd203db7ca98b7b988901713107e3da539f94e861275569153f7b6e0b73bea758ef041b36f77171b0b3e25fb57d924f87c72ff71af619f1f1e6057cead146be8563d8bf892d33637429d66717afef9cb8428a9f88be387c46ff845c97281fc2c3e7741f9fd36c24d7e7865b5f7fc8be124c3ff77d071cfbf86964289d0bc753e39c5aef7ca9bf242d0610c16b3cebbe28fe87fac0503e32afdee6e2fc475ebb24dd16a02f0ebbe03f793696bfe6b9b419012e0a6a674ef0da9b4ce1359960e7ec7a24484523baf685cc1fdc1a76a560c099d658d9eadfe7444ea2f7827e30f27ed66c583b55e92fba83d6cb8891a9787d30cf7e6f929cdd98fd8ac77718c3dec176ea93675d672781acde41fccc8f55fa71c3f6441d548491d2c9010f1aaeb483fa6f0a8f60f942ec29c0f9cd89e1c5f02d235f71508f65a803547af7f639bc123d447c0b775e6bdabbdeca0f1197cf1bc8e3d3384a58f6ff56f39f4e70f64df4e8899ddbe8e48c46656bccbd3e92bf8b1eed87f93bde8ee75cdf39ef916257ffe7c555a2f0eb27986777677b60aa129ae3b0071ef1e0473cf8110ffedde3c1d211d7f1ed4dd939689f3046ec63e741a5d5af9dc787d13c589890bea328ee6c87f2f67c9fc8399881132f9c8d153c9c105e3fb6b9376c3c98f44ac3b3aef16fcef04f72f826c93a297a0aee437e1fc573f8335edb0c72334f72d4d3e92d95cea2e8ab479ec5997f104953838a6db88e037d6d7d1ddae86f9ba26f339098c859f4954257dcf4047444af93f44951e7ada526ab9360bfd17c627f8f493cec2c7a4aa1a328bf36c8cd31d4d90c5a59632e39ac0f30d547e3a2dcd522f0b0ee1a805d56d0832ae483b9217deaae943585f26174004cd359809e56f3fd9ce1867a98a6da5ba93656a45d5574cc86b935dcd5a4a7b4a623e44feb644da5e570da587c76d5692dfd1c6a3134a59fd7f3ad9f4db17e50050bec8fa9d9c0bdf96f173b4c780e071e22671d230bb8710f8abf26ee37f157c4c4b083fa3388bd06745361e9e6a6f1eb84e7fceefe8ec85cd888fb73f1df59bc98ca970f7fc7c3dff1f0775ce3ef08f1aa7d37bf0760859f1f12038dc42a41fce323f695601483f82e0ebf91cf38e45738c5eae9f77bf46679f466f965bd59d26433e225d3cfe72639cf3ebe5cab0cbefce7c55ceb59759073d99995dd4602de0cd699844f22f3e054a56f355f35e877c8274f2265c8eb84b135a8dcfbfd56131cd8e789e6425fea737acf572cdded4d54b973f06697a1da474cdbf503d4a4a299700dcce3e2be88b111de57f0f557ec31e553f0faa3d5a1e674a53750ff6f929fed18c2d07bc77c9b47be25cbb433e3bbe17348ce57d50695952e9830176fd514d16fcee94f3b51e5e20c66c0196ef9681c2a5ead2fc997057fdfe18676aad271bc77348f3c98340587a663cfda3e36e7230d0f30347c8a3d069f3617eb91cffac8677de4b33ef2591ff9ac8f7cd6473eeb239f95379f7506b8f34d067c66ac7d3c37c8fdd41427d4fb38b80799eb5f3e50b65048a605f73991531e0dba8ea38bbdc9b851b14c71526ece2a4b46a6611b12bf2f5e075ea32f6ff0f7e3fce404efae71df7f5a57d3be9c54ff4db79180f363fd34be0d0373b7575a88e72e8fa30ea91c4f16efc36c19532c4d46603b32981f62def43e68f2dd7358dfc9bb0c4ecf83d83c3dbfa7ccc95c6a345f1062af0bb077183b116a8697d43e3b5a9503a7a560b3fbf5a479f8906fee439e4b474d6972d87037da5324dbcb1bf43e775b63c731e630e77d7837da4173f59416f4eeda6864eec7fdd68bf91de6bc69c0e75b633ee3587b73ffc0af0ffcfac0afff38fc9a84f1d6a6d2710cfb3a2cebdde3b638f6d2f5d03ae64d5e3b63a56d47bd9721800cef642167d37fb7b02ef6ec04a1087e895f1aefc0fb1bd0526d9fe4fff5d7786e0ea3dfbfa68e70c1a427ec2d23dfbe2ae661828de5cdd2cd19827408f28d908eb4d47cffe8cd3d9bb078959e3767e936d45c75b6aae058a80f07f4571487bf0c9f4be84c2a355dac1f03997997f5a6bd6fb24ce590a3dd466c6cf21c2c9b1d29fd9536e0c0ce57f42134046b6bcc7b9e7fea86cfe28e77541ff18e47bce311ef78c43b7edb78c74117f6ce9bec70f56fbdd1befabda834b9b7361a2d98a7b7d264d3699eae81b94ecfbe6ac77d175f8f98cd2366f388d9fc96311b8a5fb266a3b554e75296b20521263201fb08d506e3defae8ffd05e656db041df679def603b46f123372ff2f361140f4ac816780dd5daf1d95569f6554c5f579c6b74d064cd01df932a78b30920c601fb65d89529b25d0e6760334e5c763626033ce6783627dbcbd7b7ab6eb14e5e8cc681cfee55c368b8e595e6f573ff78fb1f3dab12ea1fdcacb71ccd9540de4dafe95fdb1bd06704bd5e4b584f805d0bb489fcf4241711c9598f4e39e833852eb9e911d121bccf304c87766540bd7b907bd8a0f666806a1ed17ba333837e998274305d5c73e5d90dc80772cf1cbf1bce73f771e2279cd71eff6ed3087f22fc3fccee8259c00eec9d744c994d78faec28ba6f9ccefee19af74eaedafe74ee1335f75d553a474de9adf5bcb981b81df38e17d75b9cc523ec0573d466f5a92a947340c7aabb77f09c14e8810c736f608e1aee878bde1fcd4bd1e5fac69b39545982af4b153a5bc3ed5be06f22fe313ddf9aab72d1ef23067108432caf467207cd734139ba8dca41173a8e06359d4a9b678e17bf1e60e899673fcf99dd85657edcdc2ef0773a66356769606bb80ecec32e1fa270ccd978e67cff277bc18ccb8106b3e004901b2dd01da807822af7c1465b825f139fbf05f2ddac22bfa8b7aec63feaac8eba701267f885fc8865dbbc1dd418d0b38e446a7eea1c6658419c4e3a34abd6fb7bb6bd4c9a57cfecdd397483cf92076befb611f3432f9299ba5b9e7e227ee96872ced694163d639eaa518f3d97efffb47319c9c529e430e9f3de1afaee1962796308a1be079f8f874467a30a9e0d077e2d235fd9ea73d0577d4795b3ff2479b6d0e4fd0ee6f31ac2ea939f89e5e88d17545b353a70cc8daca563ef987d4db2b798f30def6bdc7713672483cf1fe2cb79ffb335c14c88afabb99526d723e7b1ff22d9168905fcd932e1b54cc26b4173f2a61ac2b7ed892694a10fcb06f54b27737771dea677bffad110a4ec6f230ff1f740ce211f563ee27be43b3136065e07e411f8b48aff8fd878746c88f5fb47f4e695666f4ae53052b4a52e4a736d7007ff02e5e7e7f44547f99f69ff049a89897ab10a30ef289851d16cd06bab803c3bb5c3ab154b17cb609fa0da4ae8b3e4d156793a92eba8868ff13ba0bac037017261b07c75eb615a0f3df7debe0bead9efc47746fbcb50cfdba3214a53f8b74acfdaa1cea6295a286707ea1711bf35906f6ed514eb687e32b1cf5419f2a93b475de82ca19651ab9ec44ea136750b36019185aaecdb87c826a49ffb596b1fa36274a6e8647571c8913b7b665e25e3e3fd9dfa5c9ef66639fd4ed58ebf679c8f9be5416f264ff9608a816f212226737aaf74991f9d2374bd8e84dca1937c0ce2dbbe707de7bcc723e6c4c49c78f95c83f7f4f4f5da10a58347cfd2ecfc38f5eb6de3d4787d917515497b14b32f71f187d8f5d332390d67a6c7cea26266a22917a7c4161e52cfbe023b101eb43d5df832a1d63709adcff3615a4dd15c9aaeb4a1e786012e08ebcb56f1e2d8da2df5ff4ccbe9ae576f601c2a3d4d69a13dd5e41e8959dc7ffd69f66e8a7dcb91ff544a8b3530fc5e80588421207f04ca31657dff6cde0e83b3a36229789d91ef893ff3790bf3619cfe47f7d8ea609bdf21e6d7a3f2a5afe02bd4f751035f2ff467a4ee49f25f5501b0750bf0b165407ea5a022fcc8d4dbf9f89d275f049f4b9c5ce1ce0b81fa07661f825c40595bea2886479f0be98fd281bc641be70366c90cfba6e8ac433d17fd1cdfdfa9deeee633e0890d1a3ed3476fc6476fc6476fc6476fc67f526fc67907704ece38f0c9b64bf732889b38b62e0ed728ef64d03cb9177b9decf96411fb5d62cb5c79268ff91d8ff91d8ff91d8ff91d17cfefa0f179d8a7fdb179b4e00f801843a8aef40cecc589bbcec65caccd72ba272447d1af9f5d5271934360dfd3760d877dc2d05c12f64ecc914da1cbd07e2bc88f1f49a711fbc96d1370db0391b640c81ef4f8f63c7b20c616e8407deb5ccfb78a3e6e965b344ece19f3cad61086540c9c39435e3dc1a923ced60fa01ba2f787d8d553142f12ca505741f6c0a6ebeb4ca5b5d3f31d87ca93a1ebb1cff17ff3f9bec3f9b27172d8a72fe8bf0b3e435d96d6aa2b1d503eaad2e6cbf33cef79e42a45c49027aa5b3f923a07e8298af63086de23f0655cfec75117b4ac29d40f91323feae2d70337917f5157b7f1f2474f2e169b8dce569bf7f3d0b31af5be15716cde3502ff12fcbf5d71474a6b8dd6f99e9e4b1021db78e32f97d1640cbfa6e61bb0576d7f9a1744e76241ecac615a307726ca1639977634a5258ce44e64bd4d821c3e6f2fae8bc7b117e4b256700dc2521586a4ae64ad2ab36066eca05219be3a479ebc937f2aade0fceb62a46d7ceebd1aad2dd4251987587c1c6153c6e44bcda93cf0687fe4b9f75ba972e116fc30376567a6a21a91df50968a660ee56982cf748064e64193b15de7927bff07f3431e6a16fa96e146fbc4cea415e08da586f72395c778f2caa2be1f9363167146a17cb388cf93f00b39a7a06e937a5652fd4f42ef1f1a03aea3fd5fd7f5328af1a9b1f224aeb7915f87143a3bbcefc4c6c4f78ab6e959ff22890b527deca87749b575526d9c28dbc6f19fc5675b47dad4f03c88a5635a16ebe057cde2f8d846131d6124f7bd39f3a84ece811aba9819071c364d8a2dc36dc380ed125a3fb2a35b6c9f4df07f9a903f68835ddf8758f8cc94ebab1086889e2dc063bb24cbb85fdfc3e7623f36b1ef99b3fc9dfa29bc866df61bf9842fed3bc36beb73f0c845fcf2e8abf3e8abf3e8ab13d55707f634ab0b9dad5ebddf337dbfd963f6fe63f6fe63f6fe7fc0ecfdcf15a763fbb79cc37351fc26c5c4e542b18f79df827a738891e842ebff415fd733f2fff1f9a4c481cef73396d470add28160a12bd6c4fb0e644df198d89731fdace13ab6a9f469da4da2ed687a8eb55ba16f7f7fab0ae5158ddf53317b3a4e8fc2e63d5fe7d6f8682f8ae606eccc0819e64bf688fc0bd603f14819febf44fb882dca06e4a94349a63f7eba037a0bad1fd77f45dbe1584ff9dfafa27eb5b366a3bf85da393daf2d35e8d931a8f8fe7c1e599f22dfd365fa47d76745d99597e25888c3223f547da3dd60be00debbd8b5771b8c3f2df08b2d3f61cf3356f65d1b1b97700fedc928dc9bc99f91afa2dcff3af44a81bab0adea2e1d35dfe3c75e9cb8eb6ccc05786b1692f504735dbea64f92a741fa2eff063df8103fdc800e7dba1ecb547d5e433a1862f9a8e179aca8dfb7c7e7a82686ca6300bb10fa7c64c703e867543f405f1ca063d02f86ed9f318feee0d321e7eb1272814e21fb86fc383ebe9b3b913929dedc5407d5e0a01eb1a02307013d839e843a2bb3315bf9b520f9e644cfc31cd4a68f75ceb12b5274ce55b108fc99af07f07de36c64e4cf7a535a96ee9a215a4fa5f174da8ea2e91e3caf59f79ec749db5134ad8ca0f66b5081e7437f2daf8f17aee9827e5d409bcd57a7674e7b13549b5aad1c505f8601ea579f1f89ce7454c5671cf8f349ee8573f75a3807e9e6c9d0d3cd44dede679d69b2f946353e34760ff036e49eb7b24dbb9245394c5e6ddb46178adf35d9846709da10f1e73ca687dc7df2e71cef3d6f218f21fea20af5ac46ceafe1dd1be542a13a93de04e2b790a705b209f2394367fbfe39f2398779981be4d76ade645df792a1143d44e1534777195f855bdceab3a2a309f5ec48d19c01d2976678ce09231b13de8de18b64fc16e7bbe8cbc5d987d023d285082b20fdd0142107a105380ff53e246789ed884f8257a58d46db90a28372360d8c7734b938fb0de9300b7b0c7dbb8cf92fcc1d76fac09f90bb703039fd1429fe8a19e86c13f5d14518935e279e4953f81c74c5ac3d8ebea0d7a53eef78fce2d522fcf29cdfa4dcdb24fb86f15bc4e5fe0c7bb997289abc883eaf3a27fa82be6bf8cc74d939e2ba7954030fb931cd7ae00fe3e87f479dcd99ef43689273ed9856c2795b979f8dd4af7fd6b341bd99267db19e5565f3a0cad9099a7f2b96a7bab04bef8747ed2d8f7c8ed8e324591d71eee13d8ef93e9e0917eea51cf15dfcbd8e37d7568af227eeb62c56a6ee734b3f37b181693a49a589543d12a93ffc675d919f85ff2db77c994bf930a87e665cf94c697e0abc97b7f171931837f14990ba73d5958ea7f52354af319e3cb30fb0dd20b7692c4a33843fe6d2898e48eda1e44ad00fb1688a16f85c20f60ef5cc4ec86f4dde2bcab78e3ff3fd153857b7db08d13715578c985ffbf1be8c963e877ed19dadee161dac63afa26fe4376d400fe402ee35529c417f3cad2141ec9ed0ef246abdf7ee3f328878078abeb3aaecac8c833f1b90cd39c4f45b5946ece1ef946f0839265b137279499d8e77e61f1dc779f440b8790f04bf3ff2127c896f0af87afbc5c77cafc77cafc77cafc77cafb3e67b3de2da8fb8f6ade3da69b45ddbd373bb13b1f19b12adebae9c3f7e08f753cf414e6976fc296a91404f96b3da2047303499bfccee5fa21f378d26a37cf420d3f57c1f627410c33a40ce87ee424d97346bd67d1c7c4eac3f8a27872392e340c7aec9b3c58867d336641a5fa6f023371ffa79bc701615d67e82596afebc7294174a7a82d7918d8cf683ca4b20d8fbc41e62fb32a6e9e314bf10770e5842bfcdf4d965e13e0c273c13f21b0d3b5bd83b9feed3e4438cbe8bb3a7035bc6d9402f59a83f1cdf8357f0739bafd473af9be187e677419e2af0a62a9467da7b71a9cf3590f15b633e5ba37c77ef19535de83b5d34070c7c789e6f067a811aaeb4035f3ae90faa0d2a6b552e5a9a2079b923fe1e813ddbc9a2da69859af9f1cb788d3cb7d2a2d64c745e859a7175ba27830fde870ff05b45d1606c9e7a35425f446154975e570ed510f0f4187dd8740f9bee61d3fd1e361dfe2c720ff0673e9fe2fd66fd57befd772a473f5e6f0ee4a200b4827a70bd227ab9c20ffd3a310467a30930f72467e96e7d0e786a443fc39b23fe59e22bb46e9b0cb03e27b32a6eb096b47749e6b58ff145137a0bcb3a9bafee3de2fe01ed25d8a711fa25fafcaa1c31c1476fea476fea476fea476fead4ded4bf515c26eafdddf06c853799ee33f9e87dfde87dfde87dfde87dfdcfea7dfda8a9ff47d4d45374107ceefba95c3cd316c56ba0161be8af0536ccd280f943e17a1e7f5655d47bf2da1ddc3647a4bde1bdefed7bb1970f9a50df8c94a53f5f95ccfb65cf16f7685d1a878a1fa7f373faa07fc9bcef8c1bbd287fe944122df81deaefaabaa8cefda0c99a03b2591586d4ac61e6994a07e22a4b9c87651979e4f3857cfbe9086c636aa62ac4f6d4414530e5fd067a47aaaeb342f3f71be0df296e70dfabad2eec786c7c960e6fcd5b60fb57f07a2279ec3ddf9aab727139c6b9bbc8df866636f7d8382f9e8fe51ce9fef3c41707713353940ad857eec5d6488cc4ae0c47a27344786880666b59c82fe64a07e4671a54b23ace57659e9933b0df3c98e32b41edc041953b3fbdbd26e78fea6dad6643cbe96211f2bded91bc5f9a8dd904f582748bd8f62b6ff57bf6c427bf3f3f26edfb5fde64e47f39ed8d29d60f6663b61e0952d1383423e36d8059d57c1ff6a66000bdd4386d0716cb7bef09b4b3d2aac1bd7a8dd6167a6a8e181b0def0959d745eb65e6c8ad43f303ae8bc37bb9e5c86e04cc9fa37b909ed89a9e4cd59668de74d063f425fc3e1f10934fb68bd83d4dc22ca958251aa384e7f8d1b329387d5151fea721cedbc6b3f58235694adf8fa381ad7032730fd7a16982236872c1972f6c5c8dd75f95e2a3e2f64b410c2365af90ac9570ee3d969101cdb952d6ab9182f983d09fbfc2d027ae2924733b039947c72403bbea77891b3e7c41ffd9bea0473cf711cf7dc4737f493c37254797c643ebdfc5a792303b6b32742557535a5eecf03c7e8de4d508fbc58a9e27ec1c4f714cfd783a33f924defa59ecc71a450b9390dfcaae0c28fb3a88bfdd676f78d7922e17aef1f1cca5e3afa4791a77e2b54d24e84de5c98ceb7d370ef2dd233f9587d7e9f3c2ebfa1c74daa23033c67815c91086132f5f07eba23ad77a6e475b17f424f887cf213b9872f1f8a604f3bc78ee49e9abe4bd8ebbcea7a9a80b664a21fb75c4d87bb42fc0936baf93563e5863eb50a1752af173629fd7897c9b8c400e8ace06cda211f76003a21e74d0770ef5dde2e901c0c8b773b11cb9303ff0c8b208dee0ea0d106153c5cd70928e9ad25ed3f3cd38eee9df37cee64dbb2e8a29b297d777631161df8768037ab3a9839739b5c679a4fe6ce07b9df8002a0b157a9fc9c5a329d657baa757379ad70ff63f7ad69906feaf7c6b0a34e4cf3dfbc7ca9fca56179de97860843fc73e361c0b39f19d51f952474c370ef8c70cf73f5cf6d0fed8467b4dcdbae3db0f7e4c78337b9cbd60061cf24386708f3463e40bc4d1ac66d584bea6534de91ccfd55f465edaa15e588d36ee1f2aa19ed5ea3f59fee0df30382afe37bfffecb6a438caef1faf8af1afb0be6612c3423150b87f35b73cb545a8f5a6cb81549b30c6ff819f5f39b58538734ca2724b42bd78446b6b1ec2f1dd6603fad43947e41300b9118a71783dc5eede2b166655cffb075dd84d226c64e2ab2073bf6c0df09258ce33b9ed1303f5b2ec001d219d1a8a5ba1fbef8f3cf95e98bee2ce3c3dafabb64ff4e525d125b3e7a73ef6c1a7f5b13fe6b23de6b2fdd3e6b2b13ad3efaf8fb0cdf9f9cefb1be73b93fe7b51b536984622cf127f76616e83e2e7c5a1bda5e54faacc49973351b2c5ef77f926b72c9467540fbf03679c202a3e70a65e399d9f1a60895fa637d7c8ff27f726433fe683cef542dd99b2c6cf98b3e0d36a58cefe4eb9fea73552a7dfa9daf1f78ccee77c9d30f418d87b22353fe3349ecb1b27e08f155cee5b29a9019ffaf882d4445eb8be73de83acef11afc6f1ea280c1ba5ffc1ef6244bedf597a8e575f87789587f7cfce83893a2377bfd4ddd5fd72a3e6b3507ee5afcb5742f9a43cb947ecbe7c8bfd2ebe3e62dfc01e4439b38029a097fefdf2cac0b79fc5eff6217442ec4783e4f3f0ccd9fd2cb5d3a15aae5be8bc8b73983e9bcea3f3d63c7bf1d2fabd87bd788dbd98646725e79cb80e5b3bf4bd9745741dc57bdc7c775ded5028dfea3c9e8be23727a831a1eb56a8de66c8b7a8cace06e52588657b043de36afcf990f87cd2fc5367c75dbc7c702a4f8ae0cafae56be27d07b2a604fb22310e40d1414c5e20b199b96ada3e015dfaf66c4aeeda95f43a7b9d18548e21d48081bf38b071c367fe36fd1c744ac70c423459a772f8e85e3167aef15e749b269339e3578c2f2e678f07e198d655b12bb755646a78d8b38cebfb197e0706bf45c6ae82f722ffefc70398dc817590d39b8b8edbb9e59536c8fdd414e7d89c46f7e5d145e899593e50f551619c1c794feef59fd3f7d4bf0ff97f5e9fe39b02712bd493d5d26529ab29cd2b639527f7e3ae2d031ca9cad98f3f6b247ba5f50d6aea42f608f73a43cfff801aba39cc0dec637ea6ce31dd0f77510c7698af38aa0032b43d19526bbbc2974ccbe563b3612e467267d16c50eb6ab483de5c61796c1950072df76788a70569c6a37752f40db79e01fd92b01fe1d9b3a0930ffe2c4e8a96a026d05ca2392936ce23c7fa1cf22a9b627da635c0870035dc0f3ff2c38ffcf0233ffcc89fd28ffc8beb407f2fbfea5dfcd18ffad347fde9a3fef433d69fd2b6c47af4396b4d09c67ea7def57e75a618031b14a66e8a5efeb57108fb61cec04b7c58e9e23a52325f96b6c9ceac213d6fddbcef99cebb8427287f3c751ffcfb58bb21da1f11b24d73ec6c18ecf3b8e9bc1974cf13beffe0de3ef459bdc9ac0fec3abfd2294ee05c5ba8de21b764701d8ecd607f21965b78dd3e7ec0df67b11f9161d17b4fad33152f5c96d736083fb7328033e79445bc3268abbbc309d09d2e97a15f0d5a1bb2df95fea2d9a0b127ee8fe6cd6cdd694a0bbdaf17df789d68a0a370bda9e14a2bd807d3a57bb5f93ccf63f725cb2f7eb905f22a6e1f498eed496f39f01137c5f2a129e6b6e0770159a729d8370331fce07e4b5d74b2633c93d710a583674b239bd06a8a5a4e77f18cd646cbd1e13d15cdf3b7537122b8ff67cd3dff04f65668bdffd4bc1dbfa646ee7dc8331f39208f1c90470ec827cc019907d8ec77e8cd330cc7ff2643d08d834aab5f3bafa627aa9e07d5b37a5895d4a542fd2a83bfa8f9f7622b87669e289d23ad6bcff16570fa31cef66160ff45ec5e513d4c10e622b5dcd0338089b14ee00c30dfdaa6d259eaf0f9a1e29a72718ad6669fc1bf1cbc7b711f54fc19eb4788d3452798ffe33175a807129d3341e50f58e7d4a845d1715f94dc915c8438d842cf93392514f63eadf30fe2d950674dcdf2f363808df6bd71f3597b85e8f9b5ef6830d3315c4742e1ebd3fa74ea5e8fbacc475de6a32ef35197796d5d26f4bad845bd5ff27a22f039979d18e2d50fb1dda2ce48179dcde870371b3cda07767a2fe63a3d83aa9df4fd0f9a4b1491bf75ed39615a8dd517093191dfbf272793537a8ece88d117273e38df6798da6b92f8da704fedc0cf88fc9a540e151d8b3ac7964fc654e763ab588cc5c49c88aef9157bc38d13dff22978e523e22d27f9b7ffdc980b6b23fdb6b9ad980e786dc3887a3c76af92645eaaac8bf46d80ed51a79f5991bd7e737c322e4ab6317991be5f117481294a07bf879be0acf42a9aa9e28ee4fd0a7a51c2bc1c753ec3fc0e71d5fd16f537c03d389ba2b43a439eddd2367cd794de6448afa3eebd13915b775c77da7b27cb288e1c8e6e23d1b7c78ba1bcde8503beb9b537c41b608758fa00c54651df51e8dd4aea23d0ffa1357bfd61df679deff0bb28fbb4db48d8c7084cc2619746d9a43d4a164d06c3de793eff287fbf53d98ee422e41540ccd36a36423c8e629d88e6e8785c15e52a903c666a36521dfc6f012d36cca52eeebc39554ae73892cd8d267f1a7c11b197282fa3a70b0ef235417c56f56c55ff7bd139e83b0f7b08d6521526ecdc36e87d8ae9bb92073e6e8a28a7fb6808ce1cf68bc787c3d07aa27c7fcb33f44ef14392bd893ff3ed057c5f967f09ef109efd70dd2379bd29f9683d92c6917f78376a400f1c2fa6eff77ac6b256739d95de68affd396c613e407d89898fcc14a523f66330b5542758318dce6fa9777c7d4cf9cf7fc59ad3def9563ac7eb313544364d5023533dd93f67dc007ddb89eff77d825bc33d2b912f5c0c70d9557c847d4b04cb117f12bd3ff03d55b0b623393a5616af4b61d650c7f9ee4ef631583cde1700fd4684e197512d0ee3fab882f58b814fe9a8bd2f17ed43737be1fb662f7fdfe1c5efdbb12f7cdff9cba5cfcce9d50b9fe9965797ee91d1683997fed614eb109f0bad97d0fe482c2f5581b1613cfac6ffc73cc3b75127211f7f948f167c5063519a7507adac31974e305762be307ef7915c847a04c708d365925d8d3ff3cf316d1d877fc83a8eff907564ff21ebc8fd43d621fc23d6317fb153e2a560b71634b9576ecefb07f3b41744525c89e806c774a5c318d95bc5f99bec6c208ea10d3e6a4d954304760fe39941653d522c47ab656db3d10a637dea37580f9c1b6f09df2b75963bd183b9e5f8a2580df97dacff20accfaa619b26c8f7901ac9ef57de91faa29eb0b78c7c3b52779e8d1b4fedafad5e4d393f7cdf2b7c3549e71747c7ab51986603da8c7ef6ed68dadd2f6f236f3a0b5d70200ffcbc3829732f5ef9a5299a63cc3dbf8276c82d75d417ef14d706f7f3f723ee0cb61138939f47e65204d6dc6dd3ec123a8ed14f96755bcf075cb4f4d7ce4a851c35fa79184b3232368c33397de5a6bc4b93ddd1b181185f62e4b31262d87167ae8bb393f34df263e2cf22f9fb4cb99155519cfa53ca8eac61ff22d931efa76279eab92776fab931f38fda3fce3c868bee3d3abd37d97f4f5e7e10bd8eaa37c12929b8421234a555443d385c634d30e587c925b19726973e3ba624fb49ce29ee59d4fe25cbd65fa17b618f43677c0ecdcc3b3b4dee2c5579778daeb64e9e1faf6be364213e276fade4ff68fa207487d6cbcab968d97a7a8ff3f07ddc7a31be912275fe49cf19fcfff81d92e82fb8ef99ebfb40191e4337a736c819f432d585be9340b3816e8ba1794dce5ef3fce535cf86b88b2e14e7d7dc433dc50867ec7d2777edef8dea15bf779db516f653f0e8d1139989ffdfe381145d630c6ea23f6f6de7a79fb3509e31baeaccbd96d6d7d0d9e8aa6737afe1b1238a2bbbc675f790af7c072522ce512dd3b47022bf6f818df0b9df82662ff1257d5a5bd6979d2976ca67f471a4e0ac745930e0979991393fc394f7cb5770cdebbea68bf5e3c7f9c4a5f5e3fc7ee7f39bddc46ebb39e64c9651eb9132b9978df2a965a86adf44afdc1a0b5da2a782b3276bffe7c73c10a6b909ff7d42da84be99b7d10d9f516e22bb2799f7f8f3762dbd11ceab4ff9fed170dbe9b5446e7d3396a4c160f0517bf07a1bdabdb5ee48931ff7f257c460a408ec73164f24d2c875fc415f497412f13d8666aaac3ce1d3ab016d597076b791bb581e067209f5b7b6f4eaaff6e5a6d2da52075f5f546ff6387d77bedf31825e9d8d214807b37a33f976595dc24df452046f9075523350583d9ffadb048c90fe5ba06dad023918346d24ecdf02e8c3df0fb217a73a1f7fbe3f992b1f3c1bf5601c8ee49cf3a6408ff6c9da149db5a62ca13f1c2d5353fb3ec5d5da7bbd5dd067feb3bc7ef093894ae55b6b506b21e79cb15bde683c35f32975f2e87de04c511e34eec526a21c79ac0f83b5b33ce9fdb60218d131dcc2c4f472f4cb4dbbf053aa167e7eab2f4bf261b55606859fdfa2f429f35cfc39896f78b9dd124db3496724ad55d95945d0467c3d4e5c6efdacbe698af87e7edffdf6c7eff5bc03b4b90961a4e03da1d626ab499d9d0a7a1fd7d778f5d2fb95aa548e117e52448f50d7083565ba8d6b50deb3f69bbd7e79b357b178206e0d3cb4fcee4a50ef62418d0ba20d1bea67fa594d696d3ea4ce25a0c193b3805e414836e4a9b36272fb433a91ae2b90301ec33ad0545a11b94597d0d7eb44925a2dbcbed3cf8335f2d449406d2fd00dee7554b1554f57c067963e77a4e16c6647d1852958d0dbfadd10eb90e7e4d3862a9457ba58df19c269fe512ccf3bfbdd38bb2fcb39ef4f45ecfd54aa39e3cdc9febfef76e127bd6fdcfb97b68f8eaf8b706f9cce42a3e526ac03fa6da2facb96d83bbc1cbfbf4f048e7de7db7f9ace088fd1f4512d9f9e0ffe1c7f169265f8f9a513da0c745ace7067ebd06caa1bd263b8ffe90e661d436d9f65a09a40aa3f675d1abcd7d2f938857f4b11fc19aacd51e56211f884a51d4437737301bdf986f98aa50ac3800e017b8b7bcb687422b1d039f2809c912a588e2ebffe519dfccfff649e32cbd1cff17c9d79febf4c7736c93c67324f99cec81dc3dffefefb2933b1d7d646ffd358b87f990b6336fef9d762658c9c99bd7efe6b399bfca56fe6a63336ff5a19d6d81dade03ef6fcdf0bf8d31caf47b683fe6beedd917ce929b3b28fe3cc73215bfef2947117e638f32ce40a5f0ba542ae9043fff3636da39f0859e1cb7fe7b2ff9d2bbde784e7dcd7e7bcf067e96baefcb558144a5ae62963af7e98f6cfccf3fae766fc94591dd0f36ae36de6f94b312b149e32cdf922f35cfe92fbfa35977dca741c7b3ecb3c179e326df4d8dc97d2d7af4f99a16d669eb34f1911ffa9fcf8b11c9959f4f7be0977cb3e6506d44b579c19bd868ab33066abcc73e929f3b2b65d7887c1d8c83ce7be9685bc502c15bf3c653a2bf89f6211bffcdf4f9976e45705f2557f9d7f3f65aafc5f557efcd8cc37abb19979fedfec53f629fbafbfe124adf14f78ad1a6c56e6afb5bbfc6b329e679e324d77b9f8b9ee8ed656e639e1b803baa0fea7b63080509e32efa39f93f1dafb7b7fb158871fd11ead0d2bf3fcbf49b7ffd75366b01e39637292e81ffdf168b598679e33fac676ccff6ad6fecbb5572ebadb53465cd46d67bc82fb9a0be3cfc922f32fb29a55e679be719ca74c6dbcf4fffe3e5eadfddf04ffc5fca2bd3037f016ff97e1d992f6c89e93373ed95971d15e98e4bf46cefa4f7761a2ff96c63f57365a57ee4fa1f8673603e7b3448f236cc87310910cf8f753c61cad476439b765efbf727f66ffccfeb70bfbbe5eccc7f9647e3ff9f6af62fcdc6760fc2f5f1f8cff60fc8f62fc53d6bcaf24f86b8a2475923840dff8552240f04540fed78980afe58708788880bb89008f277f811cc09f268b03fc9d5f2510bef80241f87502a15c7c08848740b8af4020ccf9ebe48247473f8cd17ae42c263fbcfffc331d40c4ffcc17225ff3c522912205219b2c3e72395a68fc7be4acd2a48610c0881c911af97cae543a4f6a786f192336ca5fa2e5463997271c5e2ca700897c8e0089ecc7c98bf3498e11300189f99f2709914078780c8b45043eb7b08ca04582f7ed8730481006091ce98b89cc7807decee60c7ba6ade6743169ba56d66cbc7c793b94ff9f2194376f4a673a8268d3a0b8355c63ab355a9636efaddb3563ebffeed05c214fa5dd8768f09766b5b5301bfddd77bbb455f39d837a286e464a27fbe69a5bd32e6f8c431947c54bfbb7e9cb9adce7cd5d1ea10bf3db8bf75efe6417ef9edd61b65379af56820976af1dcb14eb2b5d30267dafeb56f6bb5df936cc96bf37ebe4bdf1bbcd51b4c4fe6ebfd8bad0fa7fd03db469efc01b0c1de0a1e3bf377573deb6bfdb95afd8336be32e99f4ff4d9ad55676a4f4a14215de8beed286dfddfb2dd34d123d9beeba681c2a90c1258c64c892789de8b2341d8975d8ab359ed4853ad7690d073cec4bf550b18c7c67a909451cdd2fbb24d2f6add15e364fbabe91bdf4dea7253a3374c6767bcbac794bd6497fdf44de7db4f75bd55d3a6abe17fa1c656889d2c1141de834f2a5d958d19e701b3a8b86f70ddf5774b23a3e8b50a749bbb962bf1bb58792df51d699c13e8c646d09dd067541653bdb2d75d79830e74dddbf9545515ad89320ba497da752a6d60bdf9fe20c1b76ad2c4d904c1caef50ce4e26c24772c7a1ab5b7f715a001ef99689a3dfa0e44d1b69acd763544bf8d3a27efa2e8dfef2a4647941ad9f0bb42573851da688df6dadbf7e473ec8bd24e938b3303a6d2719e634f86c88b8523b04107c3915c1474618dce0eaa5dd13ddde10dd6b4b754575a010f78b28ffacceea3ec24584bf3509e69b2067b8a2250c6a1bc52e596a3571369233c55202c47c86543d4e85d50e1339df96c724a53986f21f265031f36a76f8237a5034f893abee5a5a32a9b11dddcd9f783ebe47d4ef651555a0755999dee35ba5a10915d47d33fb99a338800c6c91953e9589a103d01fbdf03963fd16543d796014c6150604f5f0e27bf6d64c3bfab67bfc5ef0364241421aa07f2211bc3cb963e77ba68cfeba7eb3ca11d8e7543274ee03b55763666c434efa8b5473f8792596efd60b8f5e2a95cc3f2d7dfb398734fa32d013a17825cac2ff579ef579ed9dc949d990ad58583fb9c979697608aad5761ff9bf056f23b47c84372b950e10153f27247a0ab76c47ac3bfab98553b96066c1ced8e947f89f496c75d79278bf87726d368ab51fb94bc3fa46b7ce2fd5185f6fa18a92320fb7a5846fb2fef38cf3f45af8ce4e2cfdf85bea0dbd7e8d08cf86dd4fbc2d5822e152dffb9b508b9d70bff4ee92d22654488c6a82ccfd3b368810de27f57a17fe7d29323f73d9834a329adec4896d0796b6e7d6508c36f0c5dd8ba58b73579c76b034cc8f7a93d89c63f83ca4e87ec26d9daea22ca4443d3f15454e103dda8a1fbafc7136fd5ca52b72bb3114c7211ebc5b710c66ace3cac9d885da8ef27e05e6add4457f3ae9b5d63dafa03dc2b1d9b627da3552bceb85159818ef4bbce37a48321968fdac0ebf60b1dd0bd0963afe18ef4b40ef4edd004bc447f3fde36c49f43c574c531e6fda516a54f5d6716292fe6fda529ee1df8ac390bd92af4dee07b84bb30a3df3878f2f5203c511dad9f9a400cfb8568452cdb2317c946eaac4f6c39c03e80aded24196ee4a5b52ec344d5287991403f581ef8f70ee809e88eca143ed1d5f4336fcabf0cf60aaf27744661bc9346bf3d34391a75bf47ddabd1444eaf1bfe4453ac1d647319826fafe1c9f9fefdd16f80f6cd2a372f87b0eb4d6978de778c7cbabdde0bed6525ab29cc73a26c21c065d55605f934d064e09785a6588e9a9770a7af19cf7e9df2ccd9f65fdf41b672f57e34ad89b0466715bc57c5a4e94aa527fed64fcec855e5fd51eb2d181ef5f0b02603564994cf0dfa7b1cf40cfe2890b574c77432cdd5f755918c4be29fd370f624edb38a93d367e8ad79dcbb5feb97c2955317e053389f0e8b4fbf45cad00b655534760e9da98c2735c4cb02a6a33fd63f1b4d74a6aa42ceafe2186e6e09fe44d5ddfb19fbf80cd9291be087da91358c07dc6748263050df4fe0336a1f7cac1ed6ab215934c4df017a495dbf8326ff432630e83ea85a2195561355ce6dd1ffc97b540500d9de267469e2c614be4d12bd4e374a2fb7762c4d84f1430ba6cf820edec4e9608a266aaad29a8d60e284e830e713cfef12640edb1532650af66585f8d5af3ec5956c41a635541421bda5cbe543f85c4f78930b679cecf97dec2e72f6a177636890fa3f76ef4fd66a19f39615b1eff8fd03dbfa646dd7beefe99edecc0753b5a3f6a46569226026af526ec8ca5a11ec958e53b519bbd0afb44cc45fe43bf4f3a2b10491570dcc7be8b38aa5cb65f0831f51c75ab7be6a363a39987843aa648d03b7ecf2f79cd62df1f44dad755eb18c792f498683bdc821b33ddd69623dd214cb07667220859382c980e83755ee75ee4edee5610f9dd843a8d2385d0ee32a572e3cdc226b18cb41dc87b577c1470d71154da66c5f3ca138d0c73b7ce6285e44622a041b857523ab9b62b06ef83c5a1be3c0b776c3ab78e13bcfd9ab57bda690aac17a4e95f74b6dee90d80fa9a0dc3c627767c4ee283e3be1c1643d43f621691f5370cb87e86bf4ccd09a23df2de1fe77f49322fb2222c6f3817e52e6fcb19d72fef963fbaff92dfe9d5b485f1887fbc461b02efbc68379a2ef7fc77859ded3fb51bffd288cc69c0dc4ab7edeeb6ce2e2019ff26c04f081acee7a36553b8a3f93fd451867dcd4ff19edef08e9bc1ee6fb34dc8dbf5781e7ae474affd8147339bdd15f42652af619cc0db7be1b35daaba6b85f1af9fef1ad5af1fc7c4a7ba229fde9193ea05dc47bc5eb7d6acd10935065a8de7f4d5cb729b756946e067f8705fe2c956f2f883f0c4d4a35dc1cb98f059df0cdaa8fe96cddf3bd006e98a87271d614eb02f80555b9b5f46cf6585c1054449f91cf45af3fc67eb9c87716f85fe83de5e0bf3cc62489b8a59253ddfdd2102ccf977a22c3527485b7cfc7114c3a497a8e0b9d617a6b5d2ecf503c46ee9dbf1ee1f41e553b56a6d8d079de8b059cbf2eeab749b29f741a8229014782b1cfd4ffa7f7483caf9663ba0efade9b6c01af6f8df9ec4e794fc1b343eff89971a12b0923054df9bcab1e0aafa745c993dc526bb4ef735e427fab1e72a1d87cd2ba7f3d5e887fdffbe2f8043fcb87f0153cf3e5943ed9774bb8ff1dfda23176e1fd729d1006cedfe96ce230ece73c9b98dca6fb9d8de19657f7e39bd6167051d5e658ebaf3f9b832e30582ce97d3f846fca180f17efe5435ae902c1f91172f953eaa0fd5615706cfd179e158e4138f7f22ba0e73566bf0b2f217f189db3747f9cd0728c7c057265502cff4da958499821c5060c68c861f1c7cbfef47bc93e89883db55579bf8a95bdb134c311973a87c620d7482c6f0cc181e9e1eb589a8b910db13208649b5b66f31692e83332fe14cfabd1f4c1f230fbbb139e76a5b5aab4627c85a97b477efb2df27967f8bb883f214c572de8629e7d93735bcd8bf1cd9a76ac3d1de605bf33344c2c656cadfa2de2d82d4b15569097813aea25e5a7f4dcfa74748029897daedac01e8a5fee839c16886b8a9d25e94e07795790030b79857a5e3aa8c2704277db330efcfe2115bd5b2ef2ddaef51105ef77b7f810e4f66c4da5350dc72e2269ff73e8f67ccb318472ce70997cb26a140fdd50b7f71651bae9d23cc483a6684bcc87613aa063fd0ee5ab79f5fd44933ed45fcd67e97c211726903b0dbf19c905c8dd225dbdb323b98efdcc3d54ff19f8588ac766a3bf8dce0b08bac2a29ad906bc1fc813f0bd5a075d286e75b13c07bfb05ead6c0d885ddb2f8bd1a0e8bcd9bb892e978f5e27fa8e971f857eef6c4da54d3dbf30d15ce760e47bcb33fcddf47e52bfb93a972cf0799f29eb893cabdab1fc6487fcae51fc9e829f900f5ee92cd27ca850076b56736b5df0ea852ff11147dc83fa7d882760dfaff1a5f2f95023f45514664bb61312745ee47925caef8fc1f43131f1cf299b637c60f7f3d13ee2b89f288ecb9c0de4c55a86bb3e5e201382df4e1691cffff70de2bdf83ce3f5f1ccf323a6ea5de8ec2f431e259a763b31c512e85ee447417ad186cf735b531c4e02cc37e4c6a0f83d297978b59e5b103a3f39177afdae55f4e49f5430aba135d2ef9e9cb786debd92d521973e8427ca87f0bfb5832a9bde6755cb52f3fd9c2aef564db1b885dc0f523b04981aad579466df4e7d1591f6e2e9bbe21834e8c56a4437ee243d3447358251f2868e970f34794f7c6827ef1845437dfc7f2ae4767b58cc3b63b06d48d76ed09782b534a81a13ad5ab1c7032f1eeed149612279bd20a62a60407738813f477efe5d99ce0345f2b3956f53f99421dbe8c49fc09557e7e61c4d74ac3785fcee448685ecbf77599a8de4579c0f78f17ead35b9bf5443fb16ac11f2426378efb235cefbceb8111d0f8fa2c1aa1de59308c9ccd034ab139f0f7e5ef8bd311fdfb6662a8433cfc80941d34e8c7cdfd29517fa3d23cf0f4d4a1121ef623851e5e2acd9e82f757948fa9850b1d74a5e057b81c2cee7e4c5d06ba1df29ea8c42ef8cfb2481ec6cbae83dbd69312199d3f22762a0ef3985c5b75a73d7ae554aed696fd76eec0edf6b2fbb7f5727fbefcaee201f5fd6dfa5eca25d5f65bfd77325f9d8cc76a696291f5ff0bf5f8f9d77a3a848ab43c7c92ebf0d66eb76adb96e4f67cb7f57278b6fb5d7e2bf6b2dbd5dcd169581e3fda9ac0effc6dffb56abe8ed41b6a828bbbdf7ac5ca9edac766fef96f96de094be55278bf6bb83ffcf31bf49f099638e8fadf2b7017aaf7dfb38ccc3bbb4df2ba5f67b73f93698ece4e32bba977c7c3d28f837ffae75f476adce7bdf6c67ea98ffaebdeec973e0b9ffaeaff66d2787d7b13bb6abb3a5549da07d6ae7e0792f7bf2bc6fef8b05fc7f47c2df1fccbe7cabc3679289f775f9adbe37dec83b1c667ff9936ba8f7f98e3ff7d64cec288a0f53735e423cd006ff5b53a4fc6f40e3b20475816ba39a9a1bf50a7566b8a61b4d0442b25944ef3021ef40621b67d07db25f304ad6d19f87f5e30cdef10de4881cc62cc1441ab417e36fd5c9a10df45e6b663d1e98000f8469a356d13b35cbfc777d75e8d4d4029c2ffeb7477b70be53cdfc36982ddfde5f0e6fefcde23765b7f816e6a125a6efafed79d6fb1e45a76d7cde98570aca209d7f10efbcb7739da30574bafb77edf59044ab883639efdba9a940dffb36790ee2d1bdde7e6f61dacd95dac797e537a9b7f078b303fbb36b27d0fa77db82cf723e7f0c2c43a916f1f757cbeec11afdbbbefaf946ee01eff3bea4dfcdcffda2647f9c1d1fd2d77d7f5a486f12ed5f8ea4f5216094e0fb411e5f307da48773facecbe18b7907befc473c2590ba47823d507eef65ebed6623f01fa4e9b9f75ca7d61b225fd84c9377d4faf9d717f18e67af13f6f84d7666bce71adf6f2ef26c2b612c1faa599a188a04f5969e1c125b459077ec773c7dccbf27fe7a6e598fe5ff9ef9ff98b31d06eb2218f427e06c4f86c7eec72d6a74c2755de1338d97f13cb6745acf18fab9e175104c75fb9cec6a024f06b1d7b02f25c61ec575e3135370b2301509d74dad9a22357d106ac8c452648fc4a06724a925dba13a5f3c5d72c35f0b0deffd1287492f8a27fd87d7de2db56aeada5b09f41bb70792ee3a7ba099514826c6e6a1479d1d2f4f527216b0684cce4f482701fd428cbeced403c7cbad1e9e44866b107df94c9fb1474b85ebd730c7f5c769eb70f0f7f8cea4a5bb7d848b35a5edf1a9df97c1a73de8117b50e50ed4cd432c6da986e3be17aea7e540ef9db85cc6647f79f05bfa7721f90b763b9eaa1a757f5a5ffbd357b97c717e5f8ba066b4812723422f2d74af0ec48bfcfa8b8fe8135bb5a3f695abd68739077cdeb7f57dd0b96f615912e2392adf8c43e704397c85953fd550e91c75a1b304db4aabd2f534c07ff56c53ec58ba5db1b5906ff92cdf07bd16ea3757fb8eff8172d69f0e7b3e4f0bc16f93781ae2192379b786fc01f0431af7cbf90099b30cf7e4fdcc712b3cb177b238fd2d7b6eb7caf560ceca14a595a65416ba5074dee4624e975b8e71b73ec5de739b116b787be1a4877bd6ea8816f4ba09d17eccbe7e549c511acef6f7e2a59ce1ee4ed71a459731677fc77cdbe877bddfb9a4e89147ff835fdeffe0d1c3f733f5f00d9fcd47d819bd45d41a92e38e6e04beb9497e7080a1d3fc92ef017e9e0ce9387d1af676a849d84adbcfc9c0f14a4c9fd20ce5368ad077b10eb10eeb328c8d6a20a371366b0b05676d937cfaf01987b034ea81351ebc2cf47c6baecac5e5d8c5b607f4b96a40dd4167abdb215e88b3fb21ff7707386b047d06061584b3cdc66cd514a525f6c9a07ecaa6bcb70cfb6501b90329cfc5f6234c9b87bc0c47d0e05d1569658ace4e879c1711fcd6b05f336eff2ed9975bfac74627b1bcdf7ece0ae8d7e31d6bc3f0f3c279939f1803c5bfeffd7010cc833a5f860be543dad93ff0d5035f3df0d5e7c75797e661797a3f77ea6f0bf9cd510d63b159a7b04e489e46fada06944ff1487a4f9b8dd9049f93a3552bcb709f9fc2b9ba7b3de2c8b93ac18573822b12d74cbe93b6ce4a80537624e6876b5c206fc7d9903caf135f6e72ec2eeaf9d7fa5589ae5ac7c7f152e41dc6596fd0df584ea94511acad31ef5dfeacf0efa9df46eabf8b9f03d8936b3dfeda9dd9bdea0108ae45f6cbefa21313df39424e7e908f95b529ee796ef0ecdfe9cce2dff77ee7f50be216e057007bf5d88c78f7cf784efe1efd4a5cc3f855eec653818f06e9fdaacd41a7bfde3e64fd37bff0dcbcb8d79b4cfc6d1de75e67876393bfcb99c5c633ef7656f33ef4d2df8dc4f2461be436a69cb301bbdd492ee2e746ac61b0e3a4873bc67363e399f7d35d92d46adf8b974ca51511bb8ea2cb5fafafa2dff57ee792e2affd90f389f30931eff65978e7515ffda8affe7debabf3ad79625c8f8d2ba5c5f3665037503e6802ccfe5f62dc86f289579a6c5a7ad58f674dbe89c5ad59c5f3bb90af6616e4de79796453b3caf6a3e68efb219f3e751e57c7a6683f47044da6d022c40a7228a797394f6a3da05b3a1043cc5efc9c7cf8f7553b96e61ff6fdc3be7fd8f70ffbfe61df3fecfb877dffb0ef1ff6fdc3be7fd8f70ffbfe61df7f46fb3eb9e69b8edfdfb6b69b8e4527f80986f87bd003ecdcbe04bacdce40f5e77663bfc0cb829adb0c3577dde1acd3ea57671313fa9342cdf7a002b9254b4d285a26cc4c6a74a623515a6b67e5c4506ba56996d207b7ee5940efa174466fada4599d1ab39f6fd58a6fc7bd81ff0561b7508d37caa96e8ae037ce867d3c97e5bcc6d61fa6dda76a47e9bfe4dad390ad7393bc77e4ab49cee99a9df8b8e2eb2963fd64e15c64c8736a8a91feb39c21c05e412f04b89733d7dd3aea816008d0372be79c910f8f644542ded745be31fff7c935a74374fea7f495583f0eb9ee27fdf6c279dcd01b02db6c30ab0fd72742eebd58ce8ea0b739ea63f63a41b1b2b04cb8b01ef5441ef2dee712f91eb28b6f22d7515dd12aa9a66110f421f7faf3a5d334e4289697fa1c7a4c7bfd2475bbe28c1b7dc7708be0fb9deaf94ad13bbfd7504f219cf7b7f572f6d17717aad259605d003d8fe8ba777e998ed7794b5a1f114c924ceb0ba055c3eb0918ea6dcf49ff15e805aebb2bd2ab94eeb707b29bfeb70bb32abdbf433d48276bcaf5d55bb5b2d185dc5287d9f1c00b8dca411760bd50cf3ee3e381d37775f57c6bad2a9e7c3195d6f24d09e5b526e905dcff31e7eb9308fea164acd7a312f740387ddf481ac4ff07f5c0a44f46a8776550ab8ef29b05c00a54fffaeb751fa25b4db9532db26059863039c5c09fd36f84f6a63989f8ed47d9bbbd45d439a5d4d359680d37c5147ece348e017160e9e6ab17c3a1df3b0a0bf6e7d24aafbe2c74519aabf26e89fb25a119027e5f59455b6a0dc9d2abdc3881cd75a6f7209e2782734c889785e464d7704d4b779d2f58b64fde4f9f17251f4553deaf9a550bc71567b81f0ee26fb011b6cc7db97506fdde317a2321373e297e175af77124960f6615d3032d7722e5da2bc444a1e7e5c6ef87d5f09ee59f37d4f8c91d98f950d407dce71cff1e095826c02ead20469440d3bd202e9376ae500fb0453d6a69bb851fdf06ef73271b2ed93e938ea7d8d5c7aab7b0b5767abe9355e9be2577f215a1e7d939c08611bee5cfe96f4d7ee70fd44334668758ae0073b2a3d691f2fedeefbec5ef73eb688af5ec25751cc07366da7c14b10cf75fbdc99dad16c30f8978254f783b37d5857ef80cd8bd773b5b13cfd539fb3942f05b1e3c7061fd1792356f32e0f444595ff16512c2f4e93abd37f06abc758c5329bd76b14c7c934f9fcda5d3dcce32a5febd3652787a3ba2fbac60969109bdcba027a3206db57ccb32440b6cfd1560710df55eadb87abe394179c4a2e7fbf0f790b7064c40cf3b7fbdf34e167a7e25e9b321e695b473c4df5b21db2b0f7e18d47b11f9795479bfd5606ed6a132d7e4f2dc70ebbb11f47f9377602fced1be79f62ff267003d50ba831bcf1811efcab70f0caf26ed07960bcd574f2ea4ee0bf93ed8a5f5656b08be019007b7582f23a3ce5e77acdc09d3bc9e973666a3c543f7e47ed8c707b9fcc08bc684d2d9bcbc3c8d7c2ea54313b01a89db27f835b1ef2c754dc4c776fef9905c87b473a13f2735a0e89e384f23bcfe8a65223f93475f8a5df8d9ab167e46ec0189ddc7ef8143e716a4e2d55a50ab8afb90d9debc0f4dde3ba4f716efde447dff5a7f95fffbf0ffa7fa5d83be9db80725cca4f3de6fa2cfa5b5ea4a87b00e8a3f4bfa3bc159603b11df2bd2af9040d718337c8bbc6fb23f15e78b346f6ce34b8744de72a4c17b35959ea4e16ce7c98986e9a876e5083e6ba4335e1de8bb7ed05d290bfa44533aa7fec4c49a68f47e69763cfdf989af2f829fd01a9274659f91ff69ba01611f649356b29a62215f6653646410ef9a05f85d583671e980c8d93121f93f84774b9793af347ea3d683facd60dfb7b585798d8600720de241edc94869439d3ff4c55de35a7f7f8610eebf5f803ecd9a5dc919c2907f3ff227336af8f6c36dc13ce6a57e48a0ef19fe8e601e54a5b28075f469f966a7d27e05ef0bf4f2861802f437c80326427dc01b1d07afd986de48230a339df409f07ab302de5a996806139ab9e4787340a415e9410cfb3c928b98ce110da2bd37e522d6bbb81ffeab3757e00ccc8ddfb174962cbfd00ea167c626c89f183f770cdd52b398d85eb8f991d25f60bfc60ebe07714cba9ffe5bd53fb7b5aaccbc195783ca4e173c190636001b9b03bcaf0e2ad391d2c771cf73e23c9173a3aed69dffc07e54810c3bd33f40d64caf2fa0416aad87f3ef8d7f97f4de8f5e4a9fbe9712c5ff77cc7bf7e78cfc26e714ffbe117bfa81fe471217b9c0b7e6cf716f4e12e8617edfdc4512ebafda1c7b1a7d76f78c851ee2722defc7aff5ac29d6a72311e609f5ef25536d55e9389de96f539f12ffbea77cf54fcf33bd791e52bc1c0afbda024cc8654304f209d9131d345bc4c3512accdf9fc1cc5a13669d797373d18c1c3d5fc1efbf9b800fcd943b5913725baa51f606bccfeb197628f54ef46fae8f4bc6ce384d94e1d80eacdab1b49c868d1fb501bfbe36c0b731cf3e7fc1b7e743f7afda51b23839ffd6f345dc562e8cc4fa7194e8a7f6f29e387c49074dc1beb2399121c8876035c5ce61ac54b2b4bf42139db986e6859a8eee4a076cef6e61ae5f530cdbc454ee39ce55acaf34a17c00db583f23ef1caf95deef6bfb145aba686e0dd0016236dd7e45cfaf847e43bd7b828f00fa715b5bec9b0afc34223a1be2c33a8c14cd09f7dd3ed1e18cdf2992876c3c172e7a3d69f7ea2da27828390f8cccc50acb0f5b95f7733d0fb908d6fac6b38d0ebab04f8eb3b9e515474ce215630014376d365a5b8823a33c52f0c3289de348363764869e3aa8e03ea6dcbe2def3d6fa9c7e2f3a9299e0ff94af868d4913623a59ed306e19a087a069917bf713628662646f1db85f4ea9fdb99b2398fe55c18affdb23e110f9fce7fb24fe7d11ffb3fbc3f36ea8f9d9c2fcdd4cb51b2efb6f1d59663e42bc89e53ddbdf3a6e0beda61daa4e4ee8b3d849c53b1bc3104c7061d388c9859118b1bfdfedb58770e2a474de9a1bc6ab6778e7140f5743867ba88723e55a537198975410bcff458ea22e4f079f94db06e2f6e85ebbb1a542d05d477217d0fefb207ec0af782ba1a1407841edea00f71ac00ec397e7b341fb397f4efafd5e973ef9ef7ec8142f7350fbdcbe7f52fd13de423de25c4871f284b52cef263e6e845dbcf9ff49ca271c3fd7cf648dfe4ef743671b5f59ff36c6270c3fdcec6809c34a51513eb489159c16fbf453eff0c5f4c20fffac71bdba6d17163da3e73f0df691d11e937789d601d8df321fb4b235f59a13c01cf376b99ae33277e615e3f0ad1fbf4f7e36d364aaeba2dcb10864227d1dfe47f27c5f66e72bfafead68fdabb9abda9ce75b5a52e4a513566116b82ef0e23eaade27191f7dda08652731dc027b6266b5bc31d4e464a6f620af56313de41007cdf9ba84a7bd2236b45791f2d07d568bbd24c13c96cb7906f6dd3ace3dc1c9283886b9c4cd1b24cc82d81da2f749ffad1ab87873acefe16dbf4ee48de3bcd86b9d0e402febdb756ec9342b9c65a35445b27fbefe71b3e6a60cfad811554799f8bce3149bfd725728ff8066f6d6f44db57a1d8d4c08fafa5ca3dda17bec3f57ce093aa1fc6830ad43c30b83ea21f470aa627ef7bbe0c845ab5fa4a178ca4b5d6a04f882e96e7e96b7da5e70d129f3b9aeb63b8e5957128f0cb49a19e45b512e1dcd46b7de4b13d4a7edf19e9c17ccc48b9928ca1a8395289bc3d37dc72ce68dc0b879a073d2ffd36fe3a2ddfda9aca2f8d09523c761f9b1bd133c41822ecd7a835473fe38e715b01f94b0ebaf04b676fdbaadcf9f926e7bcba95c87e2229fbeed6a7a3430ef296b3a15a861bfaf17459caaa32c44d5f6fab5b5dccd709bebb9e4f5795069239a9d81b723ff07d21be239273de1fdfbc9e29a08388fc427e3c9f761b6d7e3d4b6412fdfdab71fb3f2ffea50a7bcb38c14e51e7ec7d8f6b3d3367a389b80f906b84eb93207f1cddab43f70afaed73aac1a6077b0ef2e6ef25d3bd6766ed0819b7e2d5eb77f4ff800fd5d2437657dc593dfca7ffa9fe53a069f35eb8350fb18fdf2b06f12bcfc6c3cd957bc599e7184f9cecfbe7c4ab186ffcc2f3a170d27df82709a37f4e1ef2edaebbf2516f11a5e792713ec194b78d0f04ebe7c2f41c58bed1c91a73696a36606676efa44ed3c76fe0bb844b40f816c7d13b8e9a972017b3487a1f8e14cd52f3b3894fcbd5c2a42fd72167f1a8c99d1ce44b821f0ee2f886eb08aadc290636049e4d0dbe6acfc71cf8c244e708f5c9c0b3f09eea7c06b58eb391d2c98ee422cc300af6467436a8a786db39a7676bf0fb87cdf1b0391e36c7c3e678d81cbf85cdf1c8d9f8c4391b0f7bf0d3da830f7be31f646f44c4154e30c8153185aaedd34f42cd626beae3f5f09ea7d1851f178f7c5ef4ba6d2a66fd0dede13cfb2df394598e7e8ee7ebccf3ff65bab349e6399379ca7446ee18fef6f7df4f9989bdb636fa9fc6c2fdcb5c18b3f1cfbf162b63e4ccecf5f35fcbd9e42f7d33379db1f9d7cab0c6ee68f557eecfec9fd9ff766d67bc5a2fe6e3fc5fd3d5628e3ff57efac358b8cbc57c3c5ffff0fefb4ff80abc803dfff702fe34c7eb91edace0af73ef55927ef89459d9c771e6b950ca15734f197761c23f842cfaeb8fb58d6e2064852fff9dcbfe77aef49e139e735f9f73392df394b1573f4cfb67e6f9df2367357ecaac0ee8a1b5f136f3fca598150a4f99e67c91792e7fc97dfd2ae49f321dc79ecf32cfb9a74c1b3d269fcf954a4f99a16d669eb34f1911ffa9fcf8b11c9959f4f7be0977cb3e6506f45b569c197eeb6cf90bfc7361cc56f0a0a7cccbda76e12d066323f39cfb5a16f242b19c8387afe07fbee48ae5afc5a250fafb29d38ef86a299f235fcdfefd94a9467da52890af94bee6fcbb293f7e6ce69bd5d8cc3cff6ff629fb94fdd7df4001d6f827bc4e0df629f3d7da5dfe3519cfcf3efbcc53a6e92e173fd7ddd1da0a9199ff796d61781fbc8f7e4ec66befeffdc502ffad3d5a1b56e679be719ca7cc603d72c6feb9a17ff5c7232007f45d715187b720dff69eecffb3365efa7f7f1fafd6ccb7e1bf985fb417e6069ef77f19fcfaf16c81ded49e679ed73f37e32766dbd0abb51726f9af91b3fed35d98e8bfa5f1cf958d1690fb5328fe99cdc0e62fd1e3086f263e156fe76db9f2efa78c395a8f32cf194f25346778bc8b05a2bae95a59b3f1f2e5ed50fe7f684c91e28d3852074518e9b2d51a2d4b9bf7d6ed9ab1f57f475266fc70666b6136fabbef7669abe63b07f550dc809be2cd35b7a68dc7c3081d4baf96f66fd39735b9cf9bbb3c06a998cd999fbae4ddb33bcc762aefd54a4b572a2b18474395dad67421b7d385226a75d6c72dbabedb956fc36cf97bb34ed680df33dea56133e6220d0b4261ea305ca0cce46aab67a27269326eb442a7bca4b9a37a865b1620a5c738b023a92b96ea96736615a76f35245b27ee02a180535aeb02b4cb5483f28fb874206aac077f8a14bd7efa37d7b6bf226a3abca7b72a61ace4a004dd102c47ab9eefc6c0fb7c1c410a69d2735c0809f7a075fe0c85cee5def9eb114eef41ff1ec301ff5e502e6e089876ce5c17f5db6ff12e8516e6d31c8cc6391257d509944c8690a7f7483caf9663ba0efade9b6c414b8dad319fdd079ee7836787def113a78219ae248c940ec88010adc4d0cc47a51951f224b7d4ee664ef5b7ea21baa4296add2774cbba443f3cc416ffbe0f77dec39df770e7fd06ee3c2895bd1bdfa0961abfcbd9400a64188b25bdef87f04d19e3e1e2bd523c56ba40707e845cfe943a688f4a1da231cefdce0aa54945db031f16f683311d559b63bd9fa65cfb57e284c8d2fd58cc9062030634e4b0f8e3657ffabde4765e117b0aed9256b1b2379666127d01e7d358b80dc43a96e6626443ac0c02d9e696b389f4c491c610cfabd1f4c1f230fbbbcf5a9e4cfc0961ba6aad54f041c9d09a1da5a79f860b029b38cc0b6ef9086383de14cd31e68cad7593328596a50aab35b49587f46be69c29ffc88bdd43e51815bf1c83a335dd5417f62ba06be02794924fb5ae44a3cedd1e5d161b1a237b56091d532a42ffee5a1f51f07ed2bd743bddeefe1b0ffffe72dd9ea7ca6befa9db7b8b28dd74698adf4153b425e6c3301dd029630ee5ab79f5fd44933e1ea994ca1772015ab4c2772723b980dbeed473f07f23343a63bf34f25e1b9ec0c7523c52e5df4c7a59d0961cc644c08831cfc706a598168ccbdc42492b8c6bd4a14c0852c0ec97c5685074deecdd4497cb47b30a2d78a085646f62a0df3b5b536953cf2f4c34d73918f9def28cb43e7a3fa9dfd074795169ebc7a686d17ed7287e4fc14fc8070f2392537ca8ba583f9ad5dc5a17bc12da4b7cc411f7a07e1fe209d8f76b7ca97c3ed4087d1585d992ed84049d17795e89f2fbd1c6f7d7b7f1bd6b9bfcb8b6759ff26ce27c60ffc4d6eb17b64ac6e719af8f67de1ea6eadde89677c88f82f4a20d9fe7b6a638a431293706c5ef49c9c3abf5dc82d0f9c9b9d0eb77ada227ffa04d75688df4bb27a47f7b23bc4607d47acf0ae389f221fc6feda0caa6f7f7aa65a9f97e4e95772b68370fe5e3d0f603b5fd6bb4b668bda234fb76eaab88b4174fdf15c7a0412f565b39c39dad69fb20510fcd5bcec9a83a163b545b03dc7690c127f1344446bda96ec4d8eb46cbd1896d23584ba30a65165e4c9d1e6fe6ed351ad7b5340e95298cb047e3a3c8287b19fc7a65ba9c00c9cf56be3d311ad2c110cb472ddc7efbc49fc0db4e43131deb4d21bf3b916121fbef3dd462e7e2fdc2ed81e87d0bd608e52531bc77d91a13c60744d1208f6d91527ac48e9f0bf8f8b6e548219c79464ec87024e71c54a2a3bcd0ef19797ede4839c8bb00fa2c420baa256e53b53442b91c953cb4c1a2b1f3197642682df43b459d51e89d71be0dc8c8a68bde736d8a0ed3eeabb5f37c162d5cbe53587cab3577ed5aa5d49ef676edc6eef0bdf6b2fb7775b2ffaeec0ef2f165fd5dca2edaf555f67b3d57928fcd6c676a99f2f105fffbf5d879378a8ab43a749cecf2db60b66ed79aebf674b6fc7775b2f8567b2dfebbd6d2dbd56c511938de9fcaeaf06ffcbd6fb58ade1e648b8ab2db7bcfca95dace6af7f66e99df064ee95b75b268bf3bf8ff1cf39b049f39e6f8d82a7f1ba0f7dab78fc33cbc4bfbbd526abf37976f83c94e3ebea27bc9c7d783827ff3ef5a476fd7eabcf7cd76a68ef9efdaeb9e3c079efbeffa6adf7672781dbb63bb3a5b4ad509daa7760e9ef7b227cffbf6be58c0ff7724fcfdc1eccbb73a7c2699785f97dfea7be38dbcc361f6d7b7fab2241f566b720f58e777fc39fcfddb80d851141fa6e6bc8478a00dfeb7a648f9df101d4b3046796d545373a35e614c028cb4d620070a5acf215f167a8709790712db3883ee93fd8251b28efe3cac1f67f08e6f2047e430663144cfb787d732fe569d1cda40efb566d6e38109f04098366a15bd53b3cc7fd757874e4d2dc0d9e07f7bb407e73bd5cc6f83d9f2edfde5f0f6de2c7e53768b6f611e5a62fafeda9e67bdef5174dac6e78d79a5a00cd2f907f1ce7b3bd7395a40a7bb7fd75e0f49b48a6893f3be9d9a0af4bd6f93e7201eddebedf716a6dd5ca97d7c597e937a0b8f373bb03fbb7602ad7fb72df82ce7f3c7c032946a117f7fb5ec1eacd1bfebab9f6fe41ef03eef4bfaddfcdc2f4af6c7d9f1217ddd17cb5313e52af626d1fee5485a1f024609be1fe4f169d4fdf088d6b372f862de21be2c30d0e5b69eefc0d8f835758f047bc01b65da6c04fe83343df79eebd47a43e40b9b69f28e5a3ffffa22def1ec75faa3f338cf357e3467e4d956c2583e54fa3a3114c9d2891c125b459077ec773c7dccbf27fe7a62e45a64dc2b2d4fc8ff3df3ff31673b0cd64530e8cf11ca592dbb09fb116e97947686d1b675b83c387ca6f1329ec7964e1b63463f37bc0e82a96e3ace08fbc3e2793288bd867d2931f6286e77e995a4a3913ba83deaaa09baacd15aaa73294bc6bb4495210725f03bd46ed2acee5029336e5fc7df0a0bfbce6230e945f1241db5984db54dbf434ba4d07bc6f3b5dfc6712ca3968b244f1bdb8a5e9c6d2417b3301a0eed9bd2a16d2fdf0f311e90fd42fe902dec2dfc89d7791b9ef8c7b5a58cc9f909e924a05f88d1d7a7bcb6736f50416d8e8d7cc542b1512cc7e833f668a970fd1ac828a8b475389123a362e95277fb08176ba4cd7103ff3ea0bde90895c57760143cc4d2966a38ee7be17ae25adb72f864e34687fc07b562a8da51fb9a9cfb80e51a730ef8bc6febfba073dfc2b224c47354be1987ce0972f80aab66c35c9ae2841d0b4fd5d300ffd5b3780cbfad857ccb67f93ee8b550bfb9da77fc0f94b3f599066d702ee16921f86d124f432dd848dead217f00fc90c6fd723e40e62c7f9f16b3d21aeabd42e715776eb7caf560ceca14a595a65416507bf72617733ab4830fd7cd7d607eb4f7dcd3f5b37827811eee59ab235a5bdd0de73dc4eceb47c519a5e16c7f2f5eca19ee6fd3e620fa5def772e297ae443ce27a6ce857db74fc23b8f11858f11859f7e446104beb9497e7080a1d3fc92ef017e9e0ce9387d1af676a8d1324adbcfc9c0f14a4c9f648c4e3002e2328c8d6a20a371366b0b05678d5b29b1b5c0212cdd8316b3e3c1cb821d9d88db333ac6bcb3d5ed102fc4d9fdf43845183f8470b6d998ad9aa2b4c43e9923d8f4a6bcb70cfb6501390429cfc5f6a33fb244d0e05d1569658ace4e077f91087e6bd8af19b77f97eccb2dfd63a39358de29adc5fbbde3f755928b4b43911cc6cf7b8f5686c1d8ccfbc870fcbc70dee427c640f1ef7b3f1c043d46ce97e142f99076f60f7cf5c0570f7cf5f9f1d5a57958d478db785fa483c70fd74363f4a8b546fada06944ff1d8145f2758df4ef039814f7219eef353385777af471c395727b8704e7045e29ac977d2d65909700a6e438d5b4aaba07f456743f2bc4e7cb9c9b1bba8e75feb5725ba6a1d1fc74b91771867bdc910134aa94511acad31ef5dfeacf0efa9df46eabf8b9f03d8936b3dfedaef39323b78e66fa31313df39424e7e908f95b529ee796ef0ec7b9fd9356716ffbef73baf5f10b700bf02d8abc766c4bb7fc673f2f7e857e21ac6af72379e0a7c3448ef576d0e3afdf5f621ebbff985e7e6c5bdde64e26feb38f73a3b1c9bfc5dce2c369e79b7b39af71d5d947623b1bcd106b98d29e76cc06e77928bf8b9116b18ec38e9e18ef1dcd878e6fd749724b5daf7e225536945c4aea3e8f2d7ebabe877bddfb9a4f86b3fe47ce27c42ccbb7d16de79d4573feaab7fdffaea7c6b9e18d763e34a69f1bc19d40d940f9a50df8c9425c66d289f78a5c9a6a557fd78d6e49b58dc9a90df1dc4a982dc3b2f8f0cc68b31fda8b9e37ec8a74f9dc7d5b129dacf11419329b408b1821ccae965ce935a0fe8960ec410b3173f271ffe7dd58ea5f9877dffb0ef1ff6fdc3be7fd8f70ffbfe61df3fecfb877dffb0ef1ff6fdc3be7fd8f79fd1be4faef9a6e3f7b7adeda663d1097e8221fe1ef4003bb72f816eb3a3b435f017507e81978591976094b985fa06db95ee70d669f5abb38909fd495d03f28621b764a90945cb84de9d0d6ff69676564e0cb5569a66297d70eb9e05f41e4a67f4d61af8bdc2827a60cfbf817a7684f6f3ad5af1edb837f0bf20ec16aaf186fe31565304bf7136ece3b92ce735b6fe30ed3e553b4aff25d79e866c9d9be4bd235f4d724ed7ecc4c7155f4f19eb270be722439e53538cf49fe50c01f60a7a21c0bd9cb9eed6510f043cc3ee9c71fb485624e47d5de41bf37f9f5c733a44e77f4a5f5172c1af1f875cf7937e7be13c6ee80d816db636e4c9233b0ef6b12996b323e86d2e427fb0d7098a958565c285f5a827f290f73e97c8f7905d7c13b98eea8a5649350d83a00fb9d79f2f9da62147b1bcd4e7d063daeb27a9db1567dce83b865b04dfef54cf578adef9bd867a0ae1bcbfad97b38fbebb5095ce02eb02e87944d7bdf3cb74bcce5bd2fa886092645a5f00ad1a5e0fc2506f7b4efaaf3445f4eea45729dd6f0f6437fd6fd770eb736f6625d48374b2a65c5fbd552b1b5dc82d7517fa8aa37a90832ec07aa127e58c8f074edfd5d5f3adb5aa787e7753692ddf94505e6b925ec0fd1f73be3e89e01f4ac67a3d2a710f84d3f78da441fc7f500f4cfa64847a5706b5ea28bf5900ac40f5afbf5ef721bad5943bd5220b96650893530cfc39fd46686f9a9388df7e94bddb5b449d534a3d9d85d670534ce1e74ce3181007966ebe7a311cfabda3b0607f2eadf4eacb4217a5b92aef96b85f12aa6df2fbca2ada526b48965ee5c6096cae33bd07f13c119c6342bc2c2427bb866b5abaeb7cc1b27df27efabc28f9289af27ed5ac5a38ae38c3fd70107f435f8e2d735f6e9d41bf778cde48c88d4f8adf85d67d1c89e58359c5f440cb9d48b9f60a3151e879b9f1fb6135bc67f9e70d357e7207663e14f501f739c7bf47029609b04b2b881125d0742f88cba49d2bd4036c518f5ada6ee1c7b7c1fbdcc9864bb6cfa4e32976f5b1ea2d6cad9d9eef6455ba6fc99d7c45e879760eb061846ff973fa5b93dff903f5108dd921962b3830672d621d29efeffdee5bfc3eb7a0af6df6923a0ee039336d3e8a5886fbafdee4ce568be18744bc9227bc9d9bea423f7c06ecdebb9dad89e7ea9cfd1c21f82d0f1eb8b0fe0bc99a3719707aa2acaff8320961fa749dde1b7835de3ac6a9945ebb5826bec9a7cfe6d2696e679952ff5e1b293cbd1dd17d5630cbc884de65d0935190b65abe6519a205bde25680c535d47bb5e2eaf9e604e511430dd821d005dc3560027adef9eb9d77b2d04f39499f0d31afa49d23fede0ad95e79c84f42bd17919f4795f75b0de6661d2a734d2ecf0db7be1b41ff377907f6e21ced9b67ff227f06d003a53bb8f18c11f1ae7cfbc0f06ad27e60b9d07cf5e442eabe90ef838fa6be6c0dc13700f2e016eb6564d4d9eb8e953b619ad7f3d2c66cb478e89edc0ffbf820971f78d198503a9b9797a791cfa5746802562371fb04bf26f69da5ae89f8d8ce3f1f92eb90762ef4e7a40614dd13e76984d75fb14ce467f2e84bb10b3f7bd5c2cf883d20b1fbf83d70e8dc8254bc5a0b6a55711f32db9bf7a1c97b87f4dee2dd9ba8ef5febaff27f1ffeff54bf6bd0b713f7a014d4097ebf893e97d6aa2b1dc23a28fe2ce9ef046781ed447caf48bf42025d63ccf02df2bec9fe549c2fd2bcb18d2f1d1279cb9106efd5547a9286b39d27271aa6a342af17d04520275f9d0deae5ef4a59d0279ad239f52726d644a3f74bb3e3e9cf4f7c7d11fc84d690a42bfb8cfc4fd30d08fb209bb492d5140bf9329b22238378d72cc0efc2b2894b0744ce8e09c9ff21bc5bba9c7ca5f11bb51ed4b31afbbead2dcc6b3404906b100f6a4f464a1beafca12fee1ad7fafb338470fffd02f469d6ec4ace1086fcfb91f7d675f67eb8ada50abef443027dcff07704f3a02a9505aca34fcb373b95f62b785fa00f0fc410a0bf411e30d108e589771cbc667b04336328cc74d227c0ebcd0a786b65cae0ef4633971c6f0e88b422fdaf619f477211d339a241b4f7a65ca47a4fb58acd576faec019981bbf63e92c597ea11d42cf8c4d903f317eee18baa56631b1bd70f323a5bfc07e8d1d7c0fe298743ffdb7aa7f6e6b55997933ae06959d2e78320c6c00363607785f859ed04a1fc73dcf89f344ce8dba5a77fe03fb510532ec4cff005933bdbe8006a9b51ecebf37fe5dd27b3f7a297dfa5e4a14ffdf31efdd9f33f29b9c53fcfb46ece907fa1f495ce402df9a3fc7bd3949a087f97d731749acbf6a73ec69f4d9dd33167a88cbb5bc1fbfd6b3a6589f8e4498ebd4bf974cb555a5e374a6bf4d7d4afcfb9ef2d53f3dcff4e67948f17228ec6b0b3021970d11c827644f74d06c110f47a9307f7f06336b4d9875e6cdcd453372f47c05bfff6e023e3453ee644dc86da946d91bf03eaf67d8a1d43bd1bfb93e2e193be3345186633bb06ac7d2721a367ed406fcfada00dfc63cfbfc05df9e0fddbf6a47c9e2e4fc5bcf17715bb93012ebc751a29fdacb7be2f0251d3405fbcae64486201f82d5143b87b152c9d2fe0a4d74e61a9a176a3aba2b1db0bdbb85b97e4d316c1353b9e73857b1bed284f2016c63fd8cbc73bc567abfafed5368e9a2b935400788d974fb153dbf12fa0df5ee093e02e8c76d6db16f2af0d388e86c880feb30523427dc77fb4487337ea7481eb2f15cb8e8f5a4ddabb788e2a1e43c3032172b2c3f6c55decff53ce42258eb1bcf363ae8c23e39cee696571c3189578c0150dcb4d9686d218e8cf248c10fa3748e23d9dc90197aeaa082fb9872fbb6bcf7bca51e8bcfa7a6783ee42be1a35147da8c947a4e1bb03526c10c322f7ee36c50cc4c8ce2b70be9d53fb73365731ecbb9305efb657d221e3e9dff649fcea33ff67f787f6cd41f3b395f9aa997a364df6de3ab2dc7c857903da7ba7be74dc17db5c3b449c9dd177b0839a7627963088e0d3a701831b3221637fafdb7b1ee1c548e9ad24379d56cef1ce380eae970ce7411e57caa4a6f3212eb82169ee9b1d445c8e1f3f29b60dd5edc0ad77735a85a0aa8ef42fa1ede650fd815ee057535280e083dbc411fe25801d873fcf6683e662fe9df5fabd3e7de3defd90385ee6b1e7a97cfeb5fa27bc847bc4b880f3f5096a49ce5c7ccd18bb69f3fe93945e386fbf9ec91bec9dfe96ce26aeb3fe7d9c4e086fb9d8d0139694a2b26d69122b382df7e8b7cfe19be9840fef58f37b64da3e3c6b47de6e0bfd33a22d26ff03ac13a1ae743f69746beb24279029e6fd6325d674efcc2bc7e14a2f7e9efc7db6c945c755b96210c854ea2bfc9ff4e8aeddde47e5fd5ad1fb577357b539deb6a4b5d94a26acc22d604df1d46d45bc5e322efbb410da5e63a804f6c4dd6b6863b9c8c94dec414eac726bc8300f8be375195f6a447d68af23e5a0eaad176a5992692d96e21dfdaa659c7b939240711d73899a26599905b02b55fe83ef5a3570f0f759cfd2db6e9dd91bc779a0d73a1c905fc7b6fadd82785728db56a88b64ef6dfcf377cd4c09e5b032ba8f23e179d63927eaf4be41ef10dded4de88d8db383be3821c474676548ea652d9413d1fd5ef00b021ca118ce85b90285bb484f7e19289b1b665282e37f0638b34cd44d2241d07d8e15a46f0c7d50fe34105d6cfd83411bd4852ec19f2be67afd52d6ff4c66c0db50e609b239b2ae1ac87f98aa30ae0476c4f86f46f52f700ff9fc797c45f7e3444690a3e4df55081ba05c0425e3c1ef66210d4bd20593a9f412f009f17bc9affdd449dcf48ef05f07dfbf62a756fe637f4b370ff052c87517e29f88cabfcf4a60bc5d57890a3ef79d33cbc531e62f45e2a9e65ce83c67b0f5be8610b3d6ca1dfd116ba50af3b804d0f865846f15de300f56d3998b98be45682be6be96e676bcc2b5b03fa2f01ae15ca6b03ea8978e4bfd3aff6abdc31b625f3acb51af7acab6d86d3773da1bd649a63ed209eb34bc473acbcbf2dae3b3d7f55a958aaebac501d6b82ee1fc8c5a99e6f014e833e73c7e66bc732c4ba0df57c3c7e65e9b5f5ca8d698494f77cd0c01534d0d9ea4a65abbb809f72a1b5249d7f88df5fe9f32adcf8ec13deefa6e71ecc54bfe0cca9d9a3e1f3a6f602ce68096b815996b40c7b93c36bbe538c91e35d0af6c97d4374fb6974fd656b39d5d91f959f46e9d598fce234fa0d621d27b963ed9335a4c882a8f372eb2bfd358ebe62cf982347eb1c7c49f37af3dbe9efd97d4d7db78d9e8fcc098d7eff483f4cc299e3773ab9778816d8df9dd206a5cba26553badd88cf30b7d41d56cebdec4fbf979c8b18c18390ebb58ab52562735079e99a0f13ab427da7fa71e3ff18fab055b9f3f34dce7975fdf6f9b684ead6a7a3430eea3ab3a15aef8bf31c285d79dbd80a971c4fb44bbcdf4faec127ef62d9d21a6df01bd1f6d0440bd5f0565cc32dafa17f899eef2fa06631e4b7e7c6370ebc27f41da39fb50e3f8b96a769f236352776416825d9df6e154167a058eba142cd60e7f5bd7bf5dea383d7ff34e457c73921788de003a46b9aa97d40bebf8d562d434e092b27916f6f24e790af18ea9ee1dfa6682d8d03e432ee57206be07d787b339cae0bcfdb4267d7ca19ee6c1dea5f3a5944f2f0197524de59dc9687683a4ae2951afdbd349e407e7abf0e9cd6d310ffaa67719db98d7df559dcdf0d7a3baf0d71eff95a21c6d5e8148dfc793e7c5a168474c7d5983f3e6734ded639e98718cf030ec9abf5f7796e1c484f87dd64d4803e0705a029b01d505e96d7bb31b437276b8de9ff11a671973e23de185be85ccfe9510dfd0ac00f00f9e045bf4705e86ae48387de29951cf21709c3706db7ffbc1bac995acbc93a93ed07c217497af874dd67ea6196afe8777fd41cfd7635478f79249f791e499067bad206b99d1a8b8738f4c87f82ed1d97073b599cde8b95bb897407380ae69bc6ac3de20c22657d35ca6649a00f36cf93fd1dbb06d097f3fe4117766b8429712c5bc5fb73279f1caac131dcf2ea4da67113ae973dd90f96ee139e7bc77a80206e9e037b6e85e3f8a46e39e29e51ebf888dcdeb85c0e3efe20bfadda51cf3fc77e8ec29021dcbec6f64934be09cba613bc7ee3f84028ae93644f50f65958b7c4d8875abeb535950aeeeba34e881d4de37db00d75b7b7851a01daff03b6ed193da8a6b1ef9626fb6f6643d0beabcaf7931ee3f1d87a08f94061df97796c8a564e97eb1b9839a1ca7d0b6a17706e60d8f7c0e5c37a60dd07d67d60dddf14eb46e44704b2e2817913306f5a5e4114fe643145221dfe2ed8f7741f1ef14a9e7865d2bef5ce9009f1b24777cb6c0e2e7b45fab2a3ef17118b8aa4a9705e1bfb3b660d7622de9fdf2b3731c97e0be38f481e4878ee1df318ceb4fffe91b98c89b950a7339822ec8b9bc75312ce247cd66cdc00f345a5156107d36b4ee85d2201d6df9ab87e25140b815ed86228ef6e62e4a51dc823b3d10ed569fb3cda689f119b4ce6297a6f53f213d2307e44cce9546624f37f1c0d44f0880bb1aadc5a93a5ec9be2c7e790bfe74de93866e36e322bf93d7e17fbe482759ce2d89bf97cbe45ee55721c96e5bf9bf6f05285fae64dce399ad25b077e219005bdb521488935363da1be69bea2df86f8e9945f50ed1589a7e7514c1662ea8d36cbff13a8a56a363a3903e68bcdfb6c4f755fbe9ce55f910b50c7e498d59c2fbf919cace68eecbb5f2b2bfc3c82685c1ac4331d7f6e2b3dc3f5a25c8650dc1fd56ec2b978bdd0bdd8677da50b069ae503ef8feb4121174318c9a416c972d8be16feb978b34c8fa61ce89ba648f359212cb7e3fd3ad49945cc0c994b87d12047f7f40861a37f0f927d8b5172f1667239cdd7f6113238ceb7c2be5bc2fdef880f1ff5468f7aa3d87a231aa36932ab6bfaf78a61d136a0a39de883087dfe29310d8df5a5198b6bfec36d31648bf97ad84ed619611ddb4aa68f46fbc6f1ab94734cc45e4437e750ef6bad5a1984deb59a6ac30d7bb31e779e5b32ff86ef7335860adf7bcd33df6318fecd243cefe356b8ea75c2bc1bc9bb059c0a79b716f8c44668760a73968077493e229a092cad00db6a037afe4d6501cf0b635b3e3fdaa9bcfdc7cd44a17d1d1c7d487dffc087d102858f437d4b6f717ea7b5fa0f5f77bcaf3ba237c4193af0644f7fabf8c829bd9eac271913c5fa171ebd601fbd601fbd607fcf5eb067c5137a8be05de3fd1c367fdd53f23924dd8760e4aaedebc4b87cb5c4f781df2bbd65b93af99fffc93c6596a39fe3f93af3fc7f99ee6c9279ce649e329d913b86bffdfdf7536662afad8dfea7b170ff3217c66cfcf3afc5ca1839337bfdfcd77236f94bdfcc4d676cfeb532acb13b5afd95fb33fb67f6bf5ddb19afd68bf938ffd774b598e34fbd9ffe58fe5cfcdb76c63fbcfffc13be008fb7e7ff5ec09fe6783db29d15fc75eebd48fccf9e322bfb38ce3c7fc966bf7c79cab80b739c792e0859f4d71f6b1bfd5cc80a5ffe3b97fdef5ce93d273ce7be3ee7725ae62963af7e98f6cfccf3bf47ce6afc94591dd0236be36de6f94b312b149e32cdf922f35cfe92fbfa15fed571ecf92cf39c7bcab4d163f2f95ca9f49419da66e639fb9411f19fca8f1fcb9199457fef9b70b7ec536640bf65c599796f5dc896d13f17c66c9579cec16bbfac6d175e63303632cfb9af65212f14cbb9fc53a6b382fff992cf95bf168b42e9efa74c3be2aba57c8e7c35fbf753a61af595a240be52fa1adc4df9f16333dfacc666e6f97fb34fd9a7ecbffe86e3b7c63fe1756ab05199bfd6eef2afc9787ef6c1679e324d77b9f8b9ee8ed65688c6fccf6b0bc3fbe07df473325e7b7fef2f16f86fedd1dab032cff38de33c6506eb9133f60f0efdab3f1e013da0ef8a8b3abc05f9b6f764ff9fb5f1d2fffbfb78b566be0dffc5fca2bd3037f0bcffcbe0d78fe709f4a6f63cf3bcfeb9193f31db865eadbd30c97f8d9cf59feec244ff2d8d7fae6cb480dc9f42f1cf6c06367f891e471833f1a9783b6fc9927f3f65ccd17a9479ce78dab2393384ce023aa621e9e55a59b3f1f2e5ed50fe7f8650debc299de908ba220e8a5bc335b65aa36569f3deba5d33b6feef8897db8f5eb61666a3bffb6e97b66abe73500fc5cd48e964df5c736bda488bc26f2dbd5adabf4d5fd6e43e6feef21878289a33bf1b9b77cfee30dba9bc572b10e9f13a29bea2cad7a32a588e2ebf7e69be2edfdfb385c990bc97e70949b0ce5b8c76a7356d8bee2211d6c0a1a8462507136b540147a045aa1a97b64aa2ac2c541dae8125753c9d145bb7f53c4c5446556b3b134dd4e9e06835cac29da88a668de47d9021153b3d829a80c81f45a2d74ffde6ea8ad7204b99de530e2d4a2cf9242bd26848b62e3ac71154374621c214e48df65901fae9253e4717eb47b39a5beb82e74dd0aae7af27e21edfe2115a4b802eee175a3cc16f270968cd2d1f55a1be7a5334c7987722500e97657a728fa47d345c491829e859eb91e81c4db17c180fee93d94a3d3b74d69f38aa71d0146d6908acd51a47331f84ea1f13557f9b89aa299ee74736ff239bff91cd1f9fcd8f268add8d6fd05495df856fd0f4b65fca37bad0b97356f37eebe3fc08b9fc1975902e96a7b159d7f73bab9d7a670f3a3c2faa2bd5e7e4a5f3abbb3f208b819e48b546133be231438a0d18d0d04944adf67af2bd14cf6dc49eb62c5ddcc7cade589a49f4059c4d63915d0dfe833aa10513bca3783a6defc86f278bc8e79d912d4cfc0961ba72f79691efad75b93c43117bb967c7dad361beb6f57c67aacabb354cd735840fc840663ac025547157a1db5c53f4bbcda565b840e79fad2eee1d15268b0b059c6d4ca699177107afca81cac0a5b24fcfaae2663be1d1ef76ad8f28783fe54eba3d4f75448b90a56f2f9c7ae98eba9dea707757dd5eb5a374d3851961f39663ba4e945f8bcd7622be9ac9c0f71355ea90f1321ea4f3856e572023067eb304ff2974b883ac1ec80682c91fe033c41def021fcb1ca6fc9422b37cc0b6377146d4482e40b60cf2b14187b11164f6bbe5952e984bf08535c5f241830ca9aab57c73879bb76a65ade73b59c8985205f06f5776f0fb915cce19870ae5e3a9b823a57534ab33fe2aa13cb59ff46fd2b266d2b2bd299ff799b29ec8b36ff1fcd40af95da3f83d053f211fbc2158693ed4950a710839b7d5bccca54b7cc4a7f708eb308a1681beaff0a5e6f97ca811fa2a42a6a4d809093a2feabc92e5f7232be69115f3c88af9edb2627cb902b1c6e2cf247dfceef91153f56ea8c26a80bbcf8ac88f82f4227caecbe5ac36a031293706f5def3967aceb5309d9f9e0bbdfe11d8914a2bab295636bc46beace2dea0e2bd3bceaca5f10454add1ffd6e62d4b13bcbf7f13eb53b321597a7537d1dd3274c1b370b51a606ab45e4dee2d436b8eb7176f5a9d66b8d22a52de84e2e52d4727d5daa7ef184543aff8ff6c8d4c5df4a60e22db069e496c9b91e8ec9a0d3fa63e6b8aad228e87a3bd7eb32b43883152dd71a14bee5277fb80d3b27a9eceea06f9b9820eb13b5381c92a9d9b64a9e38987a4a358840c0bd97f1d6f2a63257b46f7d7d3fd1243d31a9935ae9db03d78fd1a4d512a44671147d2208f6d9158f9829fb78ae2e39b56afcc433833bcbea49c907a7fa9cbd2d114eb6b236c07479d1fea30a6c1c4ba8197e7618ace1a26ac7bb41bc45e9b0dd342f41960e733ec84d05ae8774a9113e129a3de7be6b223b683dabce2f9055c4fde29f664d19ebeecda8ddda1537bd9fdbbd62cb46b95d2b7da6bf1dfb596deae668bca60b2938fbd82a2ecf5f6b4277cafe7f4760dfffbbd9deb1c1d533e360565305b7e1b64776d3b7bf83e704adfaa9345fbdd29b59dd5eeeddd31bf49de9fe363ab8cbfb768d7577bf8bf7fd7eadeb394dd413ebeacbfd7b3cb6fca6ef1ad3ad97f27ff276597e83329f7b53dcf2edfd07bbd1e3bef46415176fb7663b76fdbb3f5b75a456f0fb245effd5a26fe4da99d5bedda12ef7d7b02facdfb2b79ce1afe2d1f5f0f0a5ec7bf6bedddb78133fce6edd3113daf56c7cfcb2ebfdb16fc7f8e7cffdbfb62019f75a41cded7d942a9ef36f8fb3fbfbd9716f27caf7f3be4c83bc33abfd0efd622d835e0c3f49c179a0766c8ff36a1fc6f305d79ad299dac2ae776a9b95133a86c187a9397a1724df63a557bef5021ef40621bfc742f24fa0523651dfd79483fbae81dd7685d611fc4ce9beed0c2795e85c5b75a7307f4de9ef6100f7c071e08d306d068f67b1dcebe99ed4c2d381bfc6f447b45455a1d3a4e76f96d305bb76bcd757b3a5bfebb3a598479c8c1f4bd3afc1b7f2fa0d3dd1e9f31e615cbfc96ce3ff05efbf67198877769bf574aedf76602ad76f476adce7bdf6c670a3cf9ba27cf01fafd373ccfc1b4abec8eedea6c295527689fda39d89f977d02ad7ff95687cf249f3fbed5f7c61b7987c3ecaf6ff565493eacd6e41e2027bee3cfe1efdf063dfb54f6c7d9f1217dfdaa0b9d1c9e641aed5f8ecc03441825f87e90c737a3ee8772facecce18b7e070a23b31dce025ddec2399ab9e01e49f640ae53eb0d7713ca7f90a6e7483e27f0b2a353fb75c6fa4edff1fc75ce74a11d5171197bae2dbaf22df56c67616c3f0a57e6ed4db9be22f211a6e5aa7281fd0ed2c767ec89bf9e18b9765185aafffbf0ffc79d6ddf5f978f41d74bc03220c3e3f783ad4e4e3ec3539c086710aad264ce345ec6f3d8d231551991cf0daf0363aadb76c2027b6f97c4933eaee59800d91b54f074f44a7ea4f41750f9eb75f7da4d409781fd652aa86237ab07ddafa01b1af84637daa11254ab56bda9236f9e5d3a37dc72ce38636a33f69d4563d2cbe2491bd4e1f7e4ff59cc72c63491997fde457a323ab115719c0d265f3bba8bf62d179ab6d1f0cfb948f60bf943445c4d2d9275de8627d2d79e44bf317be0489b9152079a095503c7e7a1479d1d274fb276744cce4f482701fd2a9d9d2a9f744588935b15af6bb37434c43a742722f2993e63444b6ff6f56b30ddfacae4e8562de1ef719dc9ccd96822c2c5d0790af1297e0e65f77716aad2b2d47cdf315c88a539d35bace7233a6184d7dc9a02fe8fbe7f485fd7f0f7b87c7103b938c3b51520c7bc3d43fa0ec92fef9968b2be5f7f0193b1a264607c4e493acf7e8bdcd7e4dc874d44e7729fae6eeafb70a9dcb7049df34ee59b71e89c2087cfde79d3feab15cbc877b6aae0c004a359a89e064f2a830e6e4db1c576ef3ac7060cd642ffe66adff13f4fce42fc00f20c2ee169eab7493c0d5320967a3507f903e0873cde2de74380895fce2ac4879f386e05f9562379173aaf9873bb59ae47782dadac26ef1d43b4b6ba3b5ceb7369ad82bd77affc68efb9a7eb67f14e023ddcb1566737824aedc12f8c333ad2e0fd5edd53f3d2418f887747d165f47ede33df36fa5def762e697ae4d159f1d159f1d15931b6b3e247d819553b6a0dc971c7287c738bfc60aa6623cd2fd90ef0736c97af48ec3da4ba7b19414e06ae3df7e425f82421b75197a52c4c3532c5d78b3036aa818cc1d9acff2238eb16c9a70f9f318da51d5c3751b5b6866b5abaeb7cc1fbe675fe15c1175dde7c0bf1428cdddf084fdc6b7afe8cec78b09b68b2833bdc7596ba6be674b10eb99c903b90f25c6c3f92e9c78ae634abd6da94f7d911745d1c54c06775049df46dc0eddf25fb724bffd8f22496774a6bf17eeff87d9574d7d983ef81f1697f84fd4cad17e958c79877ee571b86f47987c99bfccc1828fe7def8683dcf241bb4086ebf956dad93ff0d5035f3df0d5e7c75797f922e79ede8fe88a1af2450ea186b1319bd05827244f237d6d944f5141535bb0bea527ee3ba13e3f6ff6b9ba3bb7e4c8b93ac185045724af197f276d9dd0e1157ff7ad4a627ea02ffb969a07dc57c4795ea7bedcc4d85dd4f3aff5ab125d6ee7e2e37829f2cec35985359e58f42d5ed7b58e2398d853bdf859e1dfd3bc18a9ff2e7e0ef4e3e2590fc69885f548be5bd779ea99e13e4a9f58ee26bff3a99cfc281f2b6b53dcf3dc104dfd466716ffbef73baf8f8d5b44e299fa4c43f66adb8e78f7cf784efe1efd4a5c13f6abdc8da7a81827d2fbdf78e8f497db87acffe6179e1b8e7bad7d7f9b20ddebec706cf27739b3b878e6fdceca14a595a654163075f54d2ee674b9e518f7f3c1a0e79eae9f95d909f470c7786e6c3cf37eba4b1acef6f7e2a59ce146c4aea3e8f2d7ebabe877bddfb9a4f86b3fe47c627c42ecbb7d12de79d4573feaab7fdffa6ab05b92e27a5dc6064c8de7f5204e976fcd55b9b81cbb38b70de513ef1d0df52021f1ac97054c6c6a8a81ffe7dbc0cfbdc379649dec493f6aeeb81ff2e953e771756c8af67344d0640a2de65bf337a891a6f3214fe5b86d08d6d698f72e7e0ef3fb6ff134ffb0ef1ff6fdc3be7fd8f70ffbfe61df3fecfb877dffb0ef1ff6fdc3be7fd8f70ffbfe33daf7c935df54fcfec6b5dd742c3ac14fd0c3f9a3967e765f02a8a10bd5a24dc0dea7fd02cdaa7534e5cea229d651dfe0e66b79d0cb3aafdf06959c2140ef26c81b861c1a67aebb30b5b737c1b3bc9c737262e8b5d27b47e9835bf72ca0f77078466fad16e91546d5037bfe0da8790eefe7aa29fa761cf85fbcd84ca8c61be5544f102e1c84726c4e78faca3aefb4fb7c8bd47fc9b5a7215be72679efc857939cd3f5cefab85e437bcee5276372915dc81557a3fc670de988f61cf5e783bf9b1ba8abd6aa153c736e78a65f2c21efeb32df98fffbc49a53c7d30d27f4955c3f0ebd8f4efaed85f76e37f16db603e4c9233b0ee5c0ebf9fe02c914343fae827cca619970593deaa93ce4bccf45f2dd99dd58ae1fb0ee4ca869a0fa90a3fe7cab749a1e5476bae06c4c9029a89f24c876a900ff861a6fc8eb331a33747e6ab8a710cefb2bef480e6653b42c439878baa001f52474dd3bb74c27ebbc25ad2f082649a475d72a82fef27a025642bd2bf9e8ff75e2bd3be9554af5db43b29bfeb7765065d3fb7bd5827e0a3955dead9a62710bf6828a7901b02cf27b8bd2ecdb808f074edf55db1a6ece427d15abad9ce1ced6a109fd61791e7e86ebf57f7c53883e89e01f5ac67a3d2a710f84d3f78da2c13efe3fd5958e582fae43bd2b835a7514cb425881ea5ffff672b6ce62d608746b39f7aa451e89f5e328c23ef99c7e23d89b891df5db8fb277ab76d43925d7d38dbcb8dc4d3105c1b22406948ea50b93772f0644bf771416144d79bf6a56ad95269b965e9de17e49688680df57d6749d9929d757dc38c1afcbc17605fdbb049e08ce31215e46cbc959f9a009f5cd485962d95ef97ef2bc48f9a8e5747137f986e38adf703f1cd48b026c0491bd2fb7cea0df3b5a6f24e5c627c5efe875cf3b0b3ddfca127ae0d0ad535d8019ad057cbebb097e967fde865db1d47c6babcf9d35ff39c7bf47943e38edc712c4881268bae2c76552cf15ecbe32f4a8a5ed9633f06df03e77b2e112edb3a85ed10156bd81ad35af6c8d7cdfa2fb96dcc957849ef726ef9791bee5cfe96f4d7ee70fd443e1736e4dd19ceca87524bfbff7bb447ae8c08ca54bea3880e7b269351c302f06667ca8427916cd0fc97885f0f69bdcd96a2933b455a19cc37375ce7e0ef55b1e3c70612f2a9035cd35e0f444593ff365d2047f374da757708df70ae354ecd701bd76a94c6cae239ecda5d354c149ae7f9f75965cbd1dd17d7613532ce751cdba5d996b72796eb8f5dd487460ee0bcc2f9a837c698a1ad4ac235f159281c1bab96bc0f07b9fbd5e90a7a6384cd267ef9857d2ce117f6f3701fd62bace4685de8bc8cf53b474b13cd7f3d2b1299a8e2e980755a92cc0c6d5a10fbe6cc2be615f28f83340ce1a94eee0c633c78877e5db07865713f6a387e5c2a4e7c985b47df1bf0f76a93c737ae01bd0c4e12dd6cbcaa8b3d71d2b77c234bf35e562d698f3d03db99fe7e343b17e9003874aa0b3b97939fab97c580dfb7ec3ba216473f7716fafb435e1ef5d723e24d721ed5ce27bfd47bca321d6b3c8cfe4d1d7f8cd5ebfbcd9abd33d98e3d87dc21e48546e413a5e0d6a55491f323cefc3d14589f4dee2dd9b88ef5fedaff27f1ffeff54bfab63303d2835bb82dfafb231e59cad29cdb00e8a3f4bea3bd459e0de91f85e8cbc4da3eb6bfca9385fe4c636bea9b412794b925aed547a825e69554f4e988264030e30c1e79d6f4e067211f5f2d7941ee813c7104e7bec24e943f47e69767ce8fd4e7c7da7fc846cb844dbef9591ff69ba01b00fb2498187c60de4cb9cb0328877cde8776159c2a5032267c7d0f2df8177eba5ca493c5f89f81dfdf578fd66b0ef5b2c1fb541e508710b8869a887cad23854661af4ce851a36887b053384506e349af784ee291db501ff7e9ccea8e1db0fd575a057c63689be7bf83b5abe6519a2b5540f95575abea5d2feec95eac30372d4d9341b266022d407dc10a42cda47b105bd919601666ab37d02706f56c05bfb1ccc60f2662e0dd11c100d7087d78318f679a9139f36ec918df63ea7bb41ef29e8878fe70a6cf8f5347ec7c359b2fcc23e1454ac26e17c86d17eee38baa5663131bd701be6d21427d8af81e6a5a19902543ffd55706e396b3cf0665c351b95ed08c9b0269a67cac4e600ef5b4d11e41dce073d23ce13bceb59fbfd1fd88f2a906167fa07c89aa9f5513448adf5827b7bbf4b7cef93d8e63df23c1fbd94cee8a544f1ff3df3defd3923559b63ddbffe9ce2dff7744f3fd2ff48e22217f8d6c81cf7e479c0c67d731749acff1bcf9e469fdd1d63a1793cdbf417f22bcc5351e5ce02cd13ba577f66a16519c250e8fc3631ebf8f73de5ab7f7a9ee9cdf390e2e550d8d7e663423e1bc2974f084719029a2d82709406f3f761fe938b66fd7a7373bd19395bcf6e4339ac30732f07793486677b9dd81b1e66e5b7bb689949ffe6eab864ec8cd364198eedc06ff1b49ce6577ad406fcfada00dfc63cfbfc7d7b7eb288c41067e4df7a3c745bb9b05095ce22c9961d78794f1cbea496437c65be0c413e84d7899a6f158d469ff657cc46b2e9c0bc504d90369ad2c2b35fca02d8a96ad826a672cf515ed34495f7733d0f7465adcfc83bf7d64acbb56bfb14baf59526940f9053a9b3318c53fbb5899e2f867e43bd7bbc8fe05d96b223b18c7d53819fc6cb49233eacd6d204196aef4ede21d6ef14c9432d3c172e7a3d69f7aada513c949c0746e61731ef61e9a2b93500778ad9dbce369ab7b67a729c6da00b7b8edc288c0170ceb8e196218e0c79a436f6e52c35a14866e859cd06ee5fceebdb821c237178533d169b4f4df37cc857c247a3925c5c1a8ae4b03526d40c3214bf01b90ef1d5487ee3a4316abde1733b5336133947afef17f68978f874fe837d3a8ffed8ffe1fdb1bdfed8c9f9d2e17a395af6dd34be8a7226c5f2c61000bb0ed786d7579ba14d4aee565b034de9ec80b646720b74602f6266452c6ef4fb6f0f889eec386615e55533bd738a473433b58173a6e728e7d332ab95850ab33642333d9c15cae1f3f29b60dd286e45eabbe85a0aad5a39207ddf68393a605774affe16c701a11f388abbe05862c1acf2dba3717b49fffe5a9d8eef794f5f30ddd79c7e97cfeb5fca533de47fa52c493ecb8f99a317633f7fd2738ac60df7f3d9237ff1bdcee6b7f2a5c7e186fb9dcd0172378cb85847b2cc0a7e3b59443eff0c5f4c20ff1aed1bdba69171e3907d26e1bf537b136993f50615aca3bd3c274d748e86b8873c010bcde677ebc24836895f98d78f42f43ebdd6789b8dc215aa5b3f6aef6a36696d3df29d34db9bff7d6d55e9389d69efa63a57739d95866b7922f829b4a63e7c7710516f158f8bbefb35561e067147f2de8119c890a70c7381219f4955da13c04d3ae83a98a37ca854f15a51de87ea0ea146dbd6e4fe8ccc760bfbd60a1309e7e6901c445ce3941d89f56cb3612ea1f60beea32a1d540f0ff7817b7b36bdb6d4216f116ae3713e05da9701f649a17c66c8096aae4ef62722df90daffb8bcb8470d2c5d033b07192245e798a4dfeb12b9477c83b7b637a2ed2b3a36e504f1b554b947fbc2ab308f2f876ab655a5556c8ae50d8beb23fa71a4617af2be67cb40c0eaaa9c4db2a5c076b26006234f7f0b987904b20272b28d4305fc1802f46c001b4797a5e948849acfce1ad779783cda805af51ce40d121f25a977779b0d8fa6be35da4bc8eb83fb20bba7d1daeae7d83d789db794b9ff40dfe55417eb31b3d6433451c3dfe35acf402ece700f878dc6e496a3f994702f81eef370877c38d0e1a2b4d11a6da8ad4d3dc7be28ed34b938330e95adce798e3db9b885f332ecca8ee221c8591574614dfa9b806e4273c5af5fd3de525d697501165da972cbd1ab89b411c60577ebb9281d55d90cebd64f1c1b36958ea509611f7ea45efb389b04f2c401c35890737b2fbb11f8146854959d4d38bf207eedd1cfb9a7fd087214e4627da9cf7f695ededc949d998a7c8ef7392f2d2f2d3551021cf8dbf056f23b47c8c30ff29991f87094fc4b9665181f4f16f1ef3cef40fdd8f492dc1e4390d09ce7c4fb3ffa4a7ea6be92df2269ecde7defc4baadc93b5e1b6042be4fed4934fe81be60627daac9d65617fb41afbb41653d42f63bf430f478e2ad5a59ea76653682b88d582f9e914743b00bf5fd04dc4bad9be86ade75b36b4c5b7f807ba1d61be51a3ae34665053a92f8378c867480de081af681994a07d7fc433f94c096e2cfd1c07889fe3ec54397e515c5f6750cece159c856a1f726de67e6489b9152cf81ff28544b13f829f03c1092d31255cb7362cb45d78bd27c02766f5e5aeb723da6c74402fd6079e0dffb8c7829f5cc9bf22f83bde27d16b330de49a3df1ef2d3b6d6a45edfafb583ba4cc5daa158a2e0db6b50c345df1fd5ae02ed9b556e5e0e61d79bd2f0bc0ff97da9765e2fb49795aca674b29c3ed20af269b8394bafbe2c34c572d4bc945551fc79c6b35fa73c73b6fdd77790ad5cbd1f4d6ba2940dcf04b8491e28c2c31aeed793209f1bf4f738e819fc51a8e6167242a14f30f8db70ccdef7551928e6df7254a1bc861a09cdcf1f0d7c567172fa0cbd358f7bf76bfd52e873e1127c0ae773e2c7fe1629432f9455d1d83974a6b2e7d3adc4cb82f4fe0590db886aa8c19fa8ba904b81b137f0a88d731f21b600dff77ad2f879efe301f71912ff33f5fd043ea3f6c1c7ea61bd1a924543fc1daefa0787d4e3f641f701c69a8ec4f25215861355ce6dd1ffc97bd44700ea114c881170630adf26b9658ded42cf1ba08337713a98a2899aaab46623886f896c6f9f787e97bc38d05193f78e9a47fbb2c2b936b8d6c5e37f232f4d475ebc0afc70486fe932c4b5aec719277b7e1fbb8b9c7de8dd181aa4fe8fddfbbbe7d4c5bfefe99edecc0753b5a3f6e4c238b9db59e88273eabf09e32ff21dfa79d15882c8ab06e63df459c5d2e532f8d08f9a02bd91ebab66a3935385c944cf57f05e72cb2e7fcf69dd124fdfd45ae790bbd44b92e1602f72c86c4f779a588fa05c3ac0444a65abbba847068593fa075dc0fd8c21bfaccabdceddc9bb3ceca1137b283e9f3c74aee7e40cf8fd11c6b2b3d162ec5dd0ed1057d164caf6c5f1d0401feff099233d4d622a041b857523ab9b62b06ef83c5a1be3c0b776235fb12263e531f48df655e9ec54b9e3341bf59c2aef97dadc097adb8b286763f388dd9d11bba3f8ec840793f50cd987a47d4cc12d8ffac75f5eff38c776caf9e78fedbfe6b7f8776e217d611cee1387f1e45af31b0fe689beff1de365794fef47fdf6a330da2317f6f3e6c256ed28fe4cf617b17567b7f07f46fb3b423aaf87f93e0d77e3ef5540feaf474affd8147339bdd15f1aa06f3c9f81d7b7b6d15e35c5fdd2c8f78f6fd58ae7e783fc47a53f3dc307b48b78af78bdcfacd9cb6d647881c6a00ed491a09c485a1744eaf9be5c071bb83bcc762aef5594b785733c9d63530cf7445307955ef039f828901dcd9f8b15bcfb2dfd09ffbc9e5d2ef814eb3355e95b17e83af06d59e0bb5493f1ce52572a5b637eb75a1dfcbc083df2397d13f1effb81b29539a3b5a6b4e6d1f6598a0e93a5039b8fc4bcb30dbd1147b276495ffab52ef497da7c96787fd5ad4f47871cf468ccc6f57b4dd66d2d4b1556e02b44bd7e199ef916f9dcf4187a3cafdd3696e1d34f827e6ae1efc4e56347caec9e5c980ce4dc0ef54c0de47068561c8a65881083aeaf74c158351bbe4e08cf026c50bd24d10caf9643f7d16c562d6a9615f4c641f1ec9d9ef7f25b5579bf1a0f2a1e3db88ea355674857e0fc465f9f9ca11bfd7da3f781e2fb8b622378c64f9a9e58800e36e6a8d76ba83693536754001be8eecaab7b08cf3c03bb99feb76bb8f539c499cceacb42953b5953aeafdeaa958d2e400f58989f866a4c0fba00b120a8ad98f1e996d3770df5d33695d6f24da1eb5d53f484db599990cb7dbe9c087e1be65d8607a582d7b77578818c087e5bb5a3ecca73fa5d01adabb7ada372738ee19a4e52bce7dd93d5417e7b83a3b66050e978baa1027e629c37ef3dcb38a0185a16fa80a07eb7368a116df586746e9f55a27f6e89d7a00ec246b97ce7d353f0db447aaa1fdf64e778c1fd2de3905bb235f2d459a0f737954e5617b217d06a2767e4fb5b9dc165553b6a1d17c723b6badc4a8b9fd7f03e12dfddc19479faccbcc2bc87b50a78374477d84e80d94782e5603f2daaed1b89e5bca757fcf79ae88ab432456707355bdfc0c7af541caf466036315d989b86304c50f7d0e880af94aaffe0a6df805ee8b55d1d07007c6e6d39ec0e769f019b2f7421a4bb12f6dbda9ac0cbd05f09ed4f88af8f23b19cd3c51eaa8b83fa1a0d66a3b1f826262670fa7c6963cad9539988ae56ce10866bcd750e46947d83702f9261919f8d04c939f11b32b8f7b63c10c9ff2199db53da9391c253c35598f494f67a0436bebc77b0ee5e32f3bea0f7436013028d36da685e9b29efa15f16d070d6984b53b3013d2620fe51007c71d4857d0ed306c4a10193159b62fda00dd89e84d01bbdbfd064e7d06cf83dc920ef01cd95310e85c940e94d34b10c79101b3d4ffa44d421bfd2d2e7b3e0fd1b6d144f035c6eb88e6d22bcc85f53a57afb1187cf6c5d28af34b9be694e4e30718cec0cf30cfece44cbb7b6a65261e9354e1f06bf0bce0930d61a66b2a9a1fd247367d0fc0ec86701de3a688a9f4b0175acb7d2a1fe9a6f8967f1dedccb769f1b6e3917e5fb0fce36b84edee704377cb44fd43ce879e997f6511ac9bd35c9f5d1f3ad7b9dd314ecb1d37553b231edfe778c27a9427d15ae3d4b7adf0f8927596603666e147ec559815f64fafbccf2cd59a6d859dc95a77a8b287ab838b714cbb078bff92cc837f1e4eb4bbade011c21a3f9235955de6fb53cc4075eb1ee7a4118f69b9fcb0373b8437a658bfdc99e7c05bd2ca83486e0d6c951ef7bad8e79d439ffc675ce145d9fc88e64b944f621691fa9bae6bbc94bef9983df445e42ccdcadafef2a2fc3fb90c6df8f1e5fbfbec7d723e7e4f3e69c109d7c9f7e07b1360bfb5e9f03bb13bcf10bcf67a9557324c77f6bdcab2f85d0b1747177baee28991e73ff3bc6b063ecc13bea207f4eebaf38ab389be573f214c4b9556172579eaada51f470a9df35b7d520ef3b29376946be43db4134fd47c655dfd10c54aade0edb4e80413769be3aee7a27c17f7ffa7dae8d77d13d80c0cf904576423e9a07526cb680471dd6ee7dd99f7e2f39af2e42a6d8202f627930161770c454cee003d8a33785aed33bc52cd13c1c2b27e01cc0271eb5e7d495664f26f015cb4f91fcc6fe8e5d43cbd2913faa8ee5f77d70a157abd6fc5d749ab747f65dfd86df22e5f08579ab23e895d9906689399c339283338cf50745f92286f98aa3a2ba0ce918cc68217ead3df487dc06f93dc38906334806b84627c8419a7c8b88317d83b9ae5e4d0fced1e93b1a7f2c36c805a1e5c2d5b158e4438ee093549a0fe32296d65d67f6a610bf5df970375e8cf18947e9ffe8fbdfd1468bc3c21f8857c2fc0d351b7df0416d7ec559c5e2b5cf293763e30dbf8bdc443c9b10d31ff872b582e8324d5632dfa7e56c482e8e70ae25d33777f22dc8af5cf2634cc4dfd4195f8d2ff330c3eb4dee2c46f27ef62653b2fee4bb293a06e5b24bb3b0bef0667ffb7b137ab7d41afc500d2fe450f8ba4ff66686433e0cf8febd9a0b6f0dcdaa35d31498db08b185de32f43e5e6e04e4da517aec9adece89792f452d9ce7402e5b774b76a21ddc5b44f1d6a5b1ab691aedf74ee92aca8eaaa1fb847ae956ecf1a0b2341bedc960d8e38e37a9a7cfbb968e7d1b0ecdc648a5dd80ae98f78ecfa772482f10526bfc321fc9bd7953d456aa0c7126d4973cab42cea3d24ba4f928daa2bf139277705682931d5573d391e8acb46a8eb2575363512d1f37057131363f05c9008ad738f7c35c6810bba46cf1a6e81c437c55ade44722ccfd930e4dd1c9426ea9b7869785867be3420c8f896b5a06f46a97f747fd50f1719f16eedb7c82ff12f2971d7d2eada363492ff658667a9a90cb2d86e75ddea60f9fbfcef00c979bd40c101d1da68910dd639f564826b68a4c2c3555f7911e3361d9da2a7af9fe664e17eb87a62815d02ccd7c077eb76c8ae4d9e54df3d579e5d779f877b7c4fd41ee46147e0dc9c6a1ef83e4d35d3d984d10bd07130ff359411dffabf30abe28cd667bc884f881cc62243e50ee3c4dfa3b211a051d946fcde2ea6ae8f5bfe3ef71c984d92b5d4702e78e7212501e2ff4d0c17483fb76b861faf1fab407757e9525b5f6ad0132c8fd305dfd5393a55d8886c8e5d68f2acc7705bfdb694cdfd795d08fe224ae7e2a33c2bf9f237cb456056ba98bbdb5af331aedd473a1f0a7dfdf439329bdd3807c6ad01b012d9dae2f52cf2b2351627ae6a3b3a064f18eced546fa1faf61f24dc43dd245c8759d85de0766153445c0e7251acbf1f6b160f7ded6e4624e1f44db64cd39f237b3bf817b6ff44333d14eacda51e775a95f3bae3e25a4a7eb6331a4a73dd94cef4b8c0e8ef6d148855fe0a371fc35dc52563f7c290f5fcac397f2a97c2911319678cce9d0dfabbc6b4a3f7b82d363b044a89771a3e39880415d63e5d79a425d6abe6269504b81673a196279a9435d3af4bf129d8d2148873367831d35a5b70e3dfba6f2acb382395491f413c2eaf87b7c3abb456a4ad0de7abdb0c8fca64353f4ee05f85e15a8b9f61fdc1f8cd878c621b7d5591a39d581afd86f0367b7e15c774577492d7288ce96fa5cdb8e04925309766979a30dae5f5374df731e198de7ac4c16f1f289e1837bc9cfd8f9239f537ee60ca13e0fe7987eb8fc0cafc5a57b30dfad0746b8b7ed64c1b1f698e7dc312f256dc6cbfdcecccc8de4be05b392ef745ea15ecd27bfff9cbc95fccea7f2f0a3f024e9911c417729b20cf78aaedab1ef6c133f44e43e25ef0ff6c1f412efff9883ff89e6e0f716513476a98f95e48fc4e3dd597d057815fbbf527da9a846d8cba1a16b4f8f86284d01afa1fa53c1b274d774287fd85a855c08948f51dfd03ef7c017b023334d2daa7f0bd42d93da61e1c4f792127bfc002c4cde75cd532fd40bfc51ad7e95cf1f2b79f220a80faa9a4bad6a4c34776f41cd8cb73f7d0b7a5f98c9beb2289f54920c835ee15be85d7f2f1c67b8d25acf6be1fe169f181bc4bfef07ca039f974fe4c0f14eb8c0d2c488da84a8f5fe7a791dfdaea7fb78331c50b5a3f6e342dfeb1ce6ed9685c45c39c7fb8e2aefcecb8ff37b67f5b1cf15fc11f879728ff85de91c8615c869dc336b6546e887a498b9cf2b3795bfa88ff49af0e8098d30fb14f47c82b8ffcb829aa7bdc43da9e9f544dab43877c4a34bd837b267831deafd45dd7382fb7eb2b47db6cdae0a7bcbc8b72f5c27aec13cf0e91b4cfb9e2f0a9fd95b95be4f610233ed75610d7326b02f9033b63d59c4cb0be427c73831d9cf32087ab20dd19e73e740c49cdbc8af6105fcd12afabd46507cf3faf343b986831ccc7b76ce3a3fc04ef3199e8d5dd9e079f487a6683aba2b1d46729be9cf79f519d3f3e7fd3a5deff926ec5bd1f07be8c5bdc36ea20d8af337f82d3ab360cecc2598a46a47d1cba5b1acfaeca46f11bdf7b3c09f1c7ad7285a723a3b4deea0be7fa4e718e420501817de117255c09fbb0de4e8fef856bda64e03ad81de8b6b738f767abeb3f47d92c9bcd7a3becb456b900b07f930406b8055f04c878da61838ef0ee2ac388707744bbe02f629923fa81e9e605be8c748f57bfc26821e7220df7189fb0b2ef579670931c391d20be724c6d3da79315917fa0646cec881fb5bdabc1fc583f0d9d184fad3f8183ce4fb9dc89134ec87faac5ca613f8ed0fc83914a46c53dc6f237cef011fda37d001d43b9fac2719e7111aa5f687e2fb935aa447ddfea36eff51b77f61ddfe631ec1279a47f0e8a9f0797b2a54ed28d9791e76addafefb25c8c65630bb20acd7d2f684f855edc8e745f70ba6e7307c43f27c9efd9679ca2c473fc7f375e6f9ff32ddd924f39cc93c653a23770c7ffbfbefa7ccc45e5b1bfd4f63e1fe652e8cd9f8e75f8b95317266f6faf9afe56cf297be999bced8fc6b65586377b4fa2bf767f6cfec7fbbb6335ead17f371feafe96a31c79f7a3ffdb15a2d7f78fff1277c088fb6e7ff5ec09fe6783db29d15fc75eebd44f44f9e322bfb38ce3c97be96725f9f32eec21c679e0b4216fdf5c7da463f15b2c297ffce65ff3b577acf09cfb9afcfb99c9679cad8ab1fa6fd33f3fcef91b31a3f655607f4b8da789b79fe52cc0a85a74c73bec83c97bfe4be7e158a4f998e63cf6799e7dc53a68d1e93cfe74aa5a7ccd03633cfd9a78c88ff547efc588ecc2cfa7bdf84bb659f3203fa2d2bcecc7beb42b6fc05feb93066abcc73eeeb97a7cccbda76e135066303fea72ce4856239977fca7456f03f5f0a5fcb5f8b45a1f4f753a61df1d5523e47be9afdfb29538dfa4a51205f297dcdf977537efcd8cc37abb19979fedfec53f629fbafbfe1d8adf14f789d1a6c54e6afb5bbfc6b329e9f7de099a74cd35d2e7eaebba3b515a22dfff3dac2f03e781ffd9c8cd7dedffb8b05fe5b7bb436acccf37ce3384f99c17ae48cfd8343ffea8f47400fe8bbe2a20e6f41beed3dd9ff676dbcf4fffe3e5ead996fc37f31bf682fcc0d3cefff32f8f5e37901bda93dcf3caf7f6ec64fccb6a1576b2f4cf25f2367fda7bb30d17f4be39f2b1b2d20f7a750fc339b81cd5fa2c711864c7c2adece5bb1e2df4f1973b41e659e339ee46fce0ca1b3d0e41c9aead174adacd978f9f27628ff3f432843d6dd74244a6b7550dc1aaeb1d51a2d4b9bf7d6ed9ab1f57f472c27df3a6e2dcc467ff7dd2e6dd57ce7a01e8a9b91d2c9beb9e6d6b411fa86df5a7ab5b47f9bbeacc97ddedce531f05c366764e2319a225e6d916930ef63a593d5e4ec64284853e81a6f366693a1b8b774fb6531cc757adf0615117796ffd27c5dbebf670b932179570f6124743468319642489ad3534ca8ff67adba4a4e75f754461435cd21d57b01285283898ba8e205ac5ac8ee07aba529d66d3d2f65b185b733e5d68aeade863a5bab8a668d64c808725623a55f8ccfc6ea6fd5bcd729fb8d3f838d5e3fad39aff574049e0c7a4f39900e89e205cff2b524f9b76d34245b179de3083c1527cf4eb714d13e2b403fbdc4e78017d6ace6a8a913e7af27e21e49a852d094d691d0ce99eb0a7e3b59c45b936ef90855756f30e175dec1de8713a4978c6684d37b24eda3e14ac24841df5b8f44e7688ae5c3787027e41c3c3bf48e9f389276d0146d89bad3dfb7fb44783d2e254f646716969b1f775e9a589e862bde92d61d63a1dc31321dffbe0f4fcec393f3f0e4fc069e1c8814dd8f6fdc32e33dffc467936f6d592c96f4be1fc137ba80f1f0ddbaf951d19708b9fc1975902e96a17b5234c6b9df59edd4587be0c3223ed9f1ef14f199f78bbf1427b8d2d110cb1b43706c5d1ca2caa504cc906203063474e259adbd9e7c2f258b36624f5b962eee63656f2ccd24fa02cea631c81cd8812c1cc104de412e96e6a265c36fdf291032532dc35d47f374dade91df4e1691cffbf780bb1b06f12784e9ca856cadde1a4f185d823d1f6b4f87f9dad6f39da92aefd6fe2430fbb653a393a79286323daaaadcf989ba5e34d8ac86e84a3694e122a22eb77ee7045570b22a9635787a26d02ea9dca6a76b1ecff00fd9f06e6f72e4bb5deb230ade4fb9936ecf5353482364e9db0ba75ebaa36ea72af1efaadbab76946eba301b6cde724cd789f26b85b2f68781af6632f0fd44953a64fe8f07e97ca1db15c8b285df40d697d56c74a0c30e9e20bb9b80cf10329834bb12f858e6ed20038a990802b6bd89b3a0f02435e46303dfeb083a55b8e5952e98e01746997a1a543d54ade59b3bdcbc552b6b3ddfc942162f740531d1d4734918c9e59c71a09e6f57dc91d23a9ad5197f665a9eda4ffa37d766f97e705610ed778de2f714fc847cf08660a5f950a18239fb267b5d9647f2453ee2d37b847518458b40df57f852f37c3ed4087d15215352ec84049d17755ec9f2fb63303d3c33b4d79f5936c7f8c0eee7a305dfc9fa5e67135391f739cf26ce0776b7b3411525f5435c7567b24c087e5bb5a39e7f930a45ef3c13f4f1bbb787a97ab7d90865bd4217320b67b1e2e9e2b09e72561b5402cc37e0c6a0de7bde52cfe109dc51e742af7f0476a4d2ca6a8a950daf912fa3b737a878ef0e5510e26b084f400737fadfdabc656902c9b8aec304574bafee26ba5b86b88ea557fdec7eb45e544943af39de5e3c7d571c8346bc33970ea3418eb20f5262afaec476083dc10ea8524ac43eb4d3778ca2a157fc7f369e74ebe00a0864db18aeb422b6cd487476a84ad58ba907552478afdfecca10628c4db163e9b6571da0dbc1245dd877bfc2d39ba23f370e959da9a0ec7c276c1b9df813b8aa5074599a8de4d7b5ffbb131916b2ff3a9adc5faaa40bcba5fb25e61c4d742c7adf8235ae9db03d78fd1a4d512a98d5e8b545d0208f6d71d0947a6ea4b400dfb13e1ff2bc55141fdf7492fe3c8433c3eb4bca09a9f797ba2c41f5c1da08dbc151e73784ea1f4dac834c44791ea6e8402510ee4013c45e9b0dd342f41960e733ec84d05ae8774a91132d17e7db2019ebbd672e3b927361fa0c75c77cb1157bb2684f5f76edc6eed0a9bdecfe5d6b16dab54ae95bedb5f8ef5a4b6f57b3456530d9c9c75e4151f67a7bda13bed7737abb86fffddece758e8e291f9b8232982dbf0db2bbb69d3d7c1f38a56fd5c9a2fdee94dace6af7f6ee98df24efcff1b155c6df5bb4ebab3dfcdfbf6b75ef59caee201f5fd6dfebd9e53765b7f8569decbf93ff93b24bf49994fbda9e67976fe8bd5e8f9d77a3a028bb7dbbb1dbb7edd9fa5bada2b707d9a2f77e2d13ffa6d4cead766d89f7be3d01fde6fd953c670dff968faf0705afe3dfb5f6eedbc0197ef3f6e9889e57abe3e76597df6d0bfe3f47beffed7db180cf3a520eefeb6ca1d4771bfcfd9fdfde4b0b79bed7bf1d72e49d619d5fe8776b11ec1af0617ace0bcd0333e47f9b50fe378f8e954e569573bbd4dca859df32619ab95c9c35a1120657df7bef5021ef40621bfc742f24fa0523651dfd79483fbae81dd7685d611f84dfc90e7dcf292cbed59a3ba0f7f6b48778e03bf04098368046b3dfeb70f6cd6c676ac1d9e07f23da2b2ad2ead071b2cb6f83d9ba5d6baedbd3d9f2dfd5c922cc430ea6efd5e1dff87b019deef6f88c31af58e6b774fe81f7dab78fc33cbc4bfbbd526abf371368b5a3b76b75defb663b53e0c9d73d790ed0efbfe1790ea65d65776c57674ba93a41fbd4cec1febcec1368fdcbb73a7c26f9fcf1adbe37dec83b1c667f7dab2f4bf261b526f70039f11d7f0e7fff36e8d9a7b23fce8e0fe9eb575de8e470f78d68ff72641e20cad90bbe1fe4f1cda8fbf9d58d67f868a3df81c2c86c4558a0cb5b386f3317dc23c91ec8756abde16e42f90fd2f41cc9f1045e76746abfce58dfe93b9ebfce992eb4233a87c49e6b4b13a58de9f97e0ea9673b0b63fb70956e656fcaf515918f5045adca05a692d7c37e67ec89bf9e18b91615f74acb130a7e1ffeffb8b3edfbebf231e87a095806b067fc7edca07ad10d77210f9f69bc8ce7b1a5632a31229f1b5e07c654b7edd40ef6de2e89277d5cdb08f952e2ec51dc2d8b74f27fc515e8bb09e832b0bf4c053af9a2caeaa8c9d17ed79bb76a05c542df3cbbd49b625a2d704f76c0beb3684c7a593c89afea7f7656f70972de45aa5ba66f2be2381b549d435531ec5bce1028dbabe19f7391ec17f28788b813a5df41f3363c91bef624fa8dd90347da8c943ad0cc32241363f3d0a3ce8e9327593b3a26e727a493807e95ce4e953bbcb673c5eb4002b91075888d12f94c9f31a2a537fbfa35902e7369ebc0dda7f8ce64e6409779c0c5a8d317f0297e0e65f777165011ade6a11b3ec4d29ce92dd6035d2c54a51593cb98e22f0f7e4bfdee3f6a7afeb7c87d4dce7d40b4d8b4a3e9eaa6be0f97ca7d4bd039ef54be1987ce0972f86ce83487260d5846beb35505077782a1ea691afd858aa6e240b7ba9613f2bb9ed5858e5a0bfd9bab7dc7ff3c390bf103c833b884a7a9df26f134c433967a3507f903e0873cde2de74390d6aaec84bb297fe2b815e45b8de45fdaa13f0bdd250dd1daeaee700d139154b0f7ee951fed3df774fd2cde49a0873bd6eaec4662791395cf7db738a3230dde1b77e2a5bc74f86d265dc4bcebddce254d8f7cc8f9c4d4b9b0eff64978273ad7e37ef9d08feecb9fa8fbf21dec8caa1db586e4b86314beb9457e3055b391e6976c07f8b94277644cc5de43577235a5e540277b23c8c9c0b5e71e7d824f12721b7559caaa32c43a5e2fc2d8a806320667b3b650c0872d924f1f3e631a4b3bb86ea26a6d0dd7b474d7f982f76d02b17cb06ba0afc1b7102fc4d8fd5ed73b98c8b054ddbdd3f47076763cd84d34d9c1d3413a4bddc553f9aa16e410a43c17db8fc2de9b58a7684eb36aad4d799f1d2915e8ca083eab23c8806f036eff2ed9975bfac79627b1bc535a8bf77bc7efaba4bbce1e7c0f8c4ffb23ec676abd48c73ac6bc73bfda30a4cf3b4cdee467c640f1ef7b371ce4960fda05325ccfb7d2cefe81af1ef8ea81af3e3fbebacc1739f7f47ec494ee902f7208358cd02f88c23a21791ae96ba37c8ad0f97950c1fab682cf093ae03aa13e3f6ff6b9ba3bb7e4c8b93ac185045724af197f276d9d68329ef7dd37d2f917d7b840e7df915cc4795e6776988f7afeb57e55a2cbed84eedd29f2cec3598535ee06ff2d5ed7b58e23b17c30ab173f2bfc7b9a1723f5dfc5cf811e5d3cebc118b3b01ec9779b32463d33dc47e913cbdde4773e95931fe563656d8a7b9e1ba2a9dfe8cce2dff77ee7f50be216f59986ecd5b61df1ee9ff19cfc3dfa95b826ec57b91b4f51314ea4f7bff1d0e92fb70f59ffcd2f3c371cf75afbfe3641bad7d9e1d8e4ef726671f1ccfb9d95294a2b4da92c74a1e8bcc11407b9e518f7f3c1a0e79eae9f95d909f470c7786e6c3cf37eba4b1acef6f7e2a59ce146c4aea3e8f2d7ebabe877bddfb9a4f86b1ff5d58ffaea477df56f5c5f0d764b525cafcbd880a9f1bc1ec4e9f2adb92a17976317e7b6a17ce2bda3a11e24249ef5b28069f74d31f0ff7c1bf8b977388fac933de947cd1df7433e7dea3cae8e4dd17e8e089a4ca1c57c6bfe0635d2a9d39cadad31ef5dfc1ce6f7dfe269fe61df3fecfb877dffb0ef1ff6fdc3be7fd8f70ffbfe61df3fecfb877dffb0ef1ff6fd67b4ef936bbea9f8fd8d6bbbe95874829fa087f3472dfdecbe04504317aa459b80bd4ffb059a55eb68ca9d4553ac1fa19eb9f95a1ef4b2ceebb741256708a8ded16a8a9043e3cc75b79e857c613cdfeb9c7e05a1b83bbd77943eb875cf027a0f8767f4d66a915e61543db0e7df809ae7f07eae9aa26fc781ffc58bcd846abc514e359e2c1fcab139e1e92bebbcd3eef32d52ff25d79e866c9d9be4bd235f4d724ed73bebe37a0ded39979f8cc9457621575c8df29f35a423da73d49f0ffe6e6ea0ae5aab568eaa6039ba3c3cd32f9690f775996fccff7d62cda9e3e98613fa4aae1f87de4727fdf6c27bb79bf836db01f2e4911d8772e0f57c7f81640a9a1f5741b1b2b04cb8ac1ef5541e72dee722f9eecc6e2cd70f587726d434507dc8bd1e84e9343da8ec74c18129fe07af9f24c876a900ff861a6fe8096f3466e8fcd4704f219cf757de911ccca668598630f1744143730c97ae7be796e9649db7a4f505c12489b4ee5a45d05f5e4fc0ca21a6262681fe5fa117f8667420bd4aa97e7b4876d3ffd60eaa6ca2b98acdaa65a9f97e4e9577aba658dc82bda0625e002c8bfcdea234fb36e0e381d377d5b6869bb3505fc56a2b67b8b3b541e7b586e579f819aed7fff14d21fa24827f6819ebf5a8c43d104edf378a06fbf8ff54573a62bdb80ef5ae0c6ad5517e33c20a54fffab797b37516b346a05bcbb9572df248ac1f4711f6c9e7f41bc1de4ceca8df7e94bd5bb5a3ce29b99e6ee4e576dc1453102c4b6240e958ba3079f76240f47b476141d194f7ab66d55a69b269e9d519ee97847a83f87d654dd79999727dc58d13fcba1c6c57d0bf4be089e01c13e265b49c9c950f9a50df8c942596ed95ef27cf8b948f5a4e1777936f38aef80df7c341bd28c04610d9fb72eb0cfabda3f546526e7c38fe16bfee7967a1e75b59420f1cba75aa0b30a3b580cf7737c1cff2cfdbb02b960af3d0e6ce9aff9ce3df234a1f9cf66309624409345df1e332a9e70a765f197ad4d276cb19f836789f3bd97089f65954afe800abdec0d69a57b646be6fd17d4beee42b42cf7b93f7cb48dff2e7f4b726bff307eaa1f039b7a6684e76d43a92dfdffb5d223d7460c6d225751cc073d9b41a0e981703333e54a13c8be68764bc4278fb4dee6cb59419daaa50cee1b93a673f87fa2d0f1eb8b01715c89ae61a707aa2ac9ff9326982bf9ba6d32bb8c67b85712af6eb805ebb542636d711cfe6d26930b72bb1fe7dd65972f57644f7d94d4cb19c4735eb7665aec9e5b9e1d67723d181b92f13c395e6205f9aa20635ebc857856460b06eee1a30fcde67af17e4a9290e93f4d93be695b473c4dfdb4d40bf98aeb351a1f722f2f3142d5d2ccff5bc746c8aa6a30be641552a0bb07175e8832f9bb06fd8170afe0c90b306a53bb8f1cc31e25df9f681e1d584fde861b930e97972216d5ffcef838f469e393df00d68e2f016eb6565d4d9eb8e953b619adf9a72316bcc79e89edccff3f1a1583fc8814325d0d9dcbc1cfd5c3eac867dbf61dd10b2b9fbb8b757da9af0f72e391f92eb90762ef1bdfe23ded110eb59e467f2e86bfc66af5fdeecd5e91ecc71ec3e610f242ab7201daf06b5aaa40f199ef7e1e8a2447a6ff1ee4dc4f7aff657f9bf0fff7faadfd531981e949a5dc1ef57d99872ced694665807c59f25f51dea2c70ef487c2f46dea6d1f535fe549c2f72631bdf545a89bc2549ad762a3d41afb4aa27274c41b2010798e0f3ce372703b9887af96b4a0ff4896308a73d7692f4217abf343b3ef47e27bebe537e42365ca2edf7cac8ff34dd00d807d9a4c043e306f2654e5819c4bb66f4bbb02ce1d20191b36368f9efc0bbf552e5249eaf44fc8efe7abc7eadd8f72d968fdaa07284b805c434d44365691c2a330d7ae7420d1bc4bd82194228371acd7b42f7948eda807f3f4e67d4f0ed87eac23c66679b44df3dfc1d2ddfb20cd15aaa87ca2b2ddf52697ff64af5e10139ea6c9a0d1330d102c58305298bf6516c416fa4658099da6c9f00dc9b15f0d63e073398bc994b4334074403dce1f520867d5eeac4a78d6810ed7d4e7783de53d00f1fcf15d8f0eb69fc8e87b364f9857d28a8584dc2f90ca3fddc71744bcd62627ae136cca5294eb05f03cd4b433305a87efaabe0dc72d67850b1618650b351d98e900c6ba279a64c6c0ef0bed51441dee17cd033e23cc1bb9eb5dfff81fda8021976a67f80ac995a1f4583d45a2fb8b7f7bbc4f73e896dde23cff3d14be98c5e4a14ffdf33efdd9f3352b539d6fdebcf29fe7d4ff7f423fd8f242e72816f8dcc714f9e076cdc377791c4fabff1ec69f4d9dd31169a6fc5e45ade8f5f619e8a2a7716689ed0bdfa330b2dcb108642e7b78959c7bfef295ffdd3f34c6f9e87142f87c2be361f13f2d910be7c4238ca10d06c1184a33498bf0ff39f5c34ebd79b9bebcdc8d97a761bca6185997b39c8a3313cdbebc4def0302bbfdd45cb4cfa3757c72563679c26cb706c077e8ba7e534bfd2a336e0d7d706f836e6d9e7efdbf3934524863823ffd6e3a1dbca8585aa741649b6ecc0cb7be2f025b51ce22bf36508f221bc4ed47cab6834fab4bf6236924d07e6856a82b4d194169efd5216c04e55c33631957b8ef29a26aabc9feb79a02b6b7d46deb9b7565aae5ddba7d0adaf34a17c809c4a9d8d619cdaaf4df47c31f41beadde37d04efb2941d8965ec9b0afc345e4e1af161b59626c8507b77f20eb17ea7481e6ae1b970d1eb49bb57d58ee2a1e43c3032bf88790f4b17cdad01b853ccde76b6d1bcb5d593e36c035dd873e446610c8073c60db7bcc233ef6deccb596a4291ccd0b39a0ddcbf9cd7b7053946e2f0a67a2c369f9ae6f990af848f4625b9b83414c9616b4ca81964287e03721de2ab91fcc64963d47ac3e776a66c26728e5edf2fec13f1f0e9fc07fb741efdb1ffc3fb637bfdb193f3a5c3f572b4ecbb697c15e54c8ae58d2100761dae0dafaf36439b94dcadb6069ad2d9016d8de416e8c05ec4cc8a58dce8f7df1e103dd971cc2acaab667ae7148f68666a03e74ccf51cea765562b0b15666d84667a382b94c3e7e537c1ba51dc8ad477d1b5145ab57240fabed17274c0aee85efd2d8e03423f701477c1b1c48259e5b747e3f692fefdb53a1ddff39ebe60baaf39fd2e9fd7bf94a77ac8ff4a59927c961f33472fc67efea4e7148d1beee7b347fee27b9dcd6fe54b8fc30df73b9b03e46e1871b18e649915fc76b2887cfe19be9840fe35da37b64d23e3c621fb4cc27fa7f626d226eb0d2a58477b794e9ae81c0d710f7902169acdefd685916c12bf30af1f85e87d7aadf1361b852b54b77ed4ded56cd2da7ae43b69b637fffbdaaad2713ad3de4d75aee63a2b0dd7f244f053684d7df8ee20a2de2a1e177df76bac3c0ce28e64985106b34aca07980b0cf94caad29e006ed241d7552b9671a854f15a51de87ea0ea146dbd6e4fe8ccc760bfbd60a1309e7e6901c445ce3941d89f56cb3612ea1f60beea32a1d540f0ff7817b7b36bdb6d4216f116ae3713e05da9701f649a17c66c8096aae4ef62722df90daffb8bcb8470d2c5d033b07192245e798a4dfeb12b9477c8337b5374ef736d6ce383fc751646447a393331a95ad31a7fb1d542ca81937ab517d0b1265cb2ce17db86462ac6d49c7e59c20b6982af3e93840156611e650bdbaaab48a4db1bc616d9a885e2469f60c79dff3d79a87f8a0b47e533a8e9a97c06f5e7c532a2b554e3cf3f7b1d2c96a72763214a4a9a9b4a006663214f7966ebf2c86b94eefdb80830e1a9da29187b542fe6727077ee531f45110eb9bb76a25e87bd3e82ff47cc7f1e946069f7565a9db95ef03a9336cd63bbd666db26b57b3fbef556e3a39e235ac8d600d6b03ad21661f1f79745e1e9d1bccddba00f3ad35a56f7931e46612ae5cea0ac884de1af4bea7c3a2f63119cb8fe4dc0ef2a9df14f02720dd18c2ca21394bf383dc59a84a0bfa4940ff0d88a14f8dc3f9cf4fb95fd2fa7dfa446b10252c33a2e431879d9027bc96837d5d417f28f0fd637a4a7a0f94730d719437d901fe7074998a279e7ffec9f74ba4bb96a50aab35f4c3867a970b68cf56e5cecf373967998d7ea806e286febf28ba0daf63ee9fc57424d60faadc81da86a591ef2fd5fc8d6d1717ce0ccd520339e561d6f0be8530d700f34bb31ef04b9a7e93940e89a791b5830fd069be96df7bd97abbd9f0ef05586b07fe37a065aa7f17a1f5b971a8f8f4d11421ff48029c764e2fb394fd7ff4c0f9653d703e4d2e9785e4d34df92c85a779b0544fb02cc3ad4f41b68d944e7624f3f48cf063b8c4de897a7e10f3f531d76e62e4a5e9c8b3519766a38ded4ca8d7950e50abae0da0b75871d66c04d8b029823ca94fcd6a65a5299aa39fe3774fd685b7e4514a77decdff4e3df3b7c9c74e7ee7537cf4513e44ff3d2e8ee9fbb4c5316722fcdd35ce07b80653a1fe3b6f0aaedd4bc430a73c7c7276c967c6da0249fb9ac2278f3cc65f9fc7f8e871fc697b1cbb601f0dd7aa8272fd0e2365e944dbf2c9eb005b571be42c137212f3ade518fc789384e7ce695f4cc7d1e75017e808e8dff2de315d89adb5e6397bcac7923b6a72f108b6a1e9fd1bebf2e6b7f83da6756a782ef5157293a93f4d7e3ef5fe80f12d35df5beba22468f2ee927708f6582698866f1fc0f63595f625cf84dcfc5c8add8fdec170eb734deed1b467617aba573e12d019f4d674b42a4dbb1176faa796219200b9cc9a20cddee47ace14adade1e7ae846bb862f0c247619e933d7d935bd0df6486fcc2e7f377f2fd92e48d5b74cc06e4f5aec08f3c1d89802b3b5953693917bcc74653fa793ddffaf926d70faa60c1194ecd8674a0631411fb81fc896fb2b6d2f3c6f9bc2580ac7e5d6bee7e6b26f3d7219c1795dba949f8282d1f3dd03b27b195f6091da5d8965134eed657fa6b1c0688e56d0e1bea8cbdcdd3395c7be74dc139d327322082b6f075b2afe4728bdbe81a9ee83388ec0b98a0dff13b9ddc3ba4f7d9df7d56bf45e06340390e71fa19db50e135b0be83300ddba1188820419e21e4dca19808d1b1bc78e01cbd1da75bcf9295b7adbfa1d6d5e3f2db50b9f964f659aacfb437a8e462e237282f24ecaba9109bcf263d3970ff0ef2ae13eafc265e0ceb12bf8c13ae5549937d6939ec6ea8ee60edfb354e783d2e16467a9e57fcbd96cec80508d54ce05c8ad1e9594dc8fac18faa0b59c89d58aa42d0e343cf6b4be8a3c2d65198029ac971f4666fdc34a7222c2bfe59fdf75939951063f7e9dfcbbda95aa8df33476c19f5bbd1e6d24aaf52bd91c3b1860d7fcd0f798f8bfb41336bce1dc19e34ab67adfdddfbcd8c47b6e0fb93b5a37c6b44ff3eafd3f175d433ba3e35dcf2662c6757e0475615c84368838f18ea8f6dddef4fd85f1af9ca4a5566247709e1b666a36f013640b1894627a7435edf7c063d3f8f67e566c7f8e6e8dfc7d33945d7e7dbd0a1385828af21ac0f27efa7ba2bf21c2490e1a87eb26541ce16a32703b9d308bf5f5304b927cd43fa2e745ee1fe4cfd99d31b1eb2fbefefb373683a7d7fd2e89cfe1c7275e46c84fc40783c2c17dddc5613a503f4d90bdd03649be8cce99efe4a2f9e9758dcc1a7abc3bf493bc31ec9a198b7a10f5b167a481a878aa5bae59c59ad449e2ba24fc5af83b00c773831f27dc8d399bd552be0939d423f704dee79b3881afd832a178fbaec401f47badf1959ebaad9e8e44027417ea1d1682d4db73e1d0fd8fe5d245e13e4cb103a015e7d9f39ef52b5f23eacbe14dab5977567fa72890c647d36b78cdb3cfcd50f7ff5c35f7db1bf3a3c2fde9b3573373e62e442ce9305bfc9d931329cd82af73c4bfffb67e4fe46eeb971b86d2e70ccdec463d759980e9bf593d96797e8daadee0ea3752dc98d179d63b3d1c9ea79c8d5823c86d65615d02c6bd0bb08031b6e7d6308b925e47f69d58a3d1e24e0e246e53052344bcdcfd8e7ae70df1cf0cd43ff52cc0bc846dc345f8bad61ae3719e65e26df6b2fbbb777757756fe52141fd17afa067679a44c089f216deb9ef25d542e8a236d464a3d07b510a17cd6c0f642b95daa4cfa33c4fa25d36d599aae43d8fd5cff1c5ef32449ae9eaef564ef9265236ba37e8b9727adc8f307df4cb47ce3f2f3a2d8c589bfb9f67af2bd149b9edd5bdc13641f5b7f172bdbd3eb7dced9dfd83d3bd53f11e78baf9333fdad7cd4a967f5e827f18bfb4940adbae6fb44ebab70ec398abe92314bcafd9265ccbcef68d0afa93143f9e590837c815c8bbecf6411bfb76e5d001b5a955b4b5d0cc504ce97dd42fcbdaa7614df9ce1f724ba90d6ab11b885d957d06f2ef131abf23ebc2fccbd503f68d96472109adcfb75d3fcd548db619816036985e56ec59be37cfd3ce0bd29d757c8cf1ec43b9ca6682e4d570acdccf6e640430d95ef2fcd61bc17e0b80fc0631f112b89e4bf34bd42e98d73ec865bcf8d3e4bb685706667abbb1ac18b9647e39516a6f1337dad52c1805c03e443c33e4cc09e41ce32c4fe733afcce35261af41cc3f8df948b548c117edf5f1a4267a5e7217649e62e876c007edf9910b5c6808fe9b55d5b03fde865f1e97b59a0daf70b74adf7bb24dd3aefc0dc1d8ffecfbfff5193f70ed4a7d1b221e2fd2d4d5ce68c7c1fd5a3bdc9e8efa1d8f7057a7c369a4b4753c4fdd3d1df4333af43ef53b5a3d69f5ca785fa1c0c6e2bf362f47782ac43f3c1e786fb59641cc461427176a81d65f41d7fec2e1dcf3ce203fffcf800d4ec5de087f17aa387e432238f21476905b80ae3f5f3ee9fa76463920c75536551c4d9249f892af7ff9fa974b2a86eb69a437f0f61d7f0fb7c8b5c7f329e6374c34df2342cd385597d757bc4da310971f39ea2c1fc42cbeff5f6eacba536ceade6cbdd50ac1d3eeb09ce15c3180cbf03ea2b27ad706f73d770cb6bec639c8d94cec124be3a84ffea53e4073ea05e76e007ce194224a69bf8b95722e3c3f6ed0b7e79a8327bf190890f99f89089bfb54c8cc2ee61f987702691752f8b5e945d5cadb428bf999703f2cafa79667c78b05139847243d1f3af7a6e2897d497b7186b9ee137f1f68ab6e3a27c195c3944dad268b4d7edf719e42645e413d19fc7e71669aeb333bc3ca9354f9e11650fc5637a87e2a1578cc7ab562dc20fb86abed2f87f87740dab2f79731b29be9f7c7b8df22fec26031a8f7bf1cf0aa3d39737abeda6e4508c3fc38fe3d19f6b7231a70f4eed35faac74b19c4f3a4b34d710cd2b607b2bb4dc915239bebdbf16bf0f728b916c2e687970ea033d53ce856cbbd06f2783d3dfa69d6b8bf4eda1cf96e6458283202f13ec36dca337ebe72d23dc827446d4ef505e27fcd6ef0be4f5ea759ae2890defe57a06fd04369a303cc3df95ba8fd1f827a94f55b01e1f879e9bc73920b28cf029bd9ec878365affd6ab83dbafc2f6366d8727dade39dd0deacee04ca1e7a32aecb7461e6aa276682e27adfb30af82eff118815f2fb6cf13f730464e5fe4cba6736f658c694547d006b9a8f8c845b8e59cfbd3f78ec04ffe9e90b58e140d62c794ac3e1fdf70def75b3c160dd5cd605f30d4eb0ed7ac5dc1d03f0f7ee7bf370f0ebbd07f16bf4751fa2c41f63a013d345f297a785f4ca270502adfcf5e27a6583f688214f007e2c3a8f78aaad781fe9cf523f03aca5102197b92e35d764f6d4c982d6b2d759bc949e2c75cf1e79ab60fbf1d368b5e27ed83e4d50bef14ed7db74318391583433f5f5569cd35a5b70efb29a8f788aebf40f81866aff9e7cdd41944f9274cf85c74364c7ef86579ff61d9b98c5bf76f85e1e2750febcf4a8a6bbf07f455e952f4f5e5d45e4ac57515a88d315cd309fa0ba0390aecfbd0982bc8fb47784e9a517d4299de0d513efb4a7ea4f41790f31f964b976185b06c4e5c7f1496a33f77f4b9b48eb0d5e93346f5df6f2fb19fafa1b7b2e1424f8d2459632d356112e8fe7af654ffe4fb96d9908e8972827c275567e0ff935b8edfb719723071fd0ef460f39f775d2d1ae921118da12fabbb4075b72739bd117c81bf47dd3b5e3622ff84d8c919503f3def51fb017dffc83a4234c9a78b126909c76cc37b7ffa0c906b72710675a91ab28ff65bc3f3f9277e5797ebff4fcf37d72c663f7d3fa047542bb7350427e13be5ec087a59d2388bd2790ccd7eb67a7438c35bfbd892fb9f24dae074cf924a258875469c510cee63e818c50854b9b805390a33fa43f1535fae92f8039e0388ed6ca2eb01e7c1198f94fe116afb0c22f351ef4e9cbbde40b6f1519bcf42b67bb0ff409bfde39b5da9e88466a087a99bf5f506b1ed5541da41df6ca8534333dee4dc56b7a9be9a414df3e10c6c99762eb79447ffbc9ec9149d9e69ab913553eb0bf1e82306f45bc4801e7dde3e6d9fb73935872aea7c2e9abbc5be7b0beae41dba07d545313b21b227ddc7cce7b9ed7c1e22c7e2f73e2cc3ede4be7937c11a1c67125d47de3bd17f95d713bf4614c670900d879e7b82131a2d471524c871863ab2ac71a0f106f60df3ebebc8b59d63d71bd0e74e940e58778ebf55278776ed65f7ef5a33dbae554aede964d76eec16ed77a7d47656bbb777c7fc56abe89d9a65febbbe3a746a6a4191563bfcef7dfb38cc7f97727a7baa99df06b3e5dbfbcbe1edbd59fca6ec16dfaa93fd776577908f2febef5276e9fd99fbda9e67bdef0d263bf9f8bafe2ee54a6dc97bd6bf6b2dbd5dcd1694c16cf9efea64f1adf65ac4ff5754060e7c565494d5e1df83d91adeabfddece758e96f9efdaebeedfb5d7c3b74176d1aeaff6e85ef5d5beede4f06f7647f9f8b2e7bd6fa7a6c26ff66df29c017cbed7dbef2d13afa3d43ebe2cbf0dacd1bfebab9f6f1279ceeaf0eff7257dbfbf5a84970319659f62ad9cade7a58d26d60f897ed15970f6c47fd473cb39dded436f8ed05947d96fbd4185c1500427a13a4b8c7d4de81f72f21c35780ef84e77a41e41074c9b977641df7fdcef40047fbd7950a1d686f41f91f72bc80bf06c7d8ccf4ef98eda0b7eff46ca9ede12cb9ee2d0133d99ac1fd9b3099ecdc8dfc72cc3c72cc3c72cc36b6619ce46727d6eb8f5183c9b260bfe236a6dfd3d6a4e227ecf62eb44da7af47f3cabff238b276f8c85fd734df087f745c7d2f22d4b0febc8531d87e6bc990755a92c46b2df0b0974a500f179c0b6cd86b634f212cc443b680aca9d009f0cd1eb6cbc0c6268475d807e66f523cabdf0e6512fcfe92fa645bfff43c73f74fc43c7ff67e8f8a94ad9e367fa43a6ea89ecfdb5fae242591fb7072159df13ea449e71c8fad661244b9023636119e6cdbb958b1b1de29e50638be281c4ce3b23e629f8f74cf55f9ce403f0f7360fadfddd95b2a6503e8c0e958ae1761650770a73b50c77c8931312633707faac053399826758cc336e6a33273ce78c3348d847fa1e145623790367e50e46f8cca833e2e151963e83678779e7113779c44d1e71936be2262e257fa2ce274d1604eff4cf9d9d20903dca9e817962680bb0905b8eeed3537de1d08b2f29fd8ba26920c8b38bfe1db386f8f9d93cf226f8edb7c8e7856830b9e63fd6a6ba49bf9cd0bc91041f3cfadea4efb2b9b171388ad17f41aed84a17cca52ad4574d11cd4839273f1470d80cf7f83e92798fbe8f1e72200e508f54c1f952fbd57850399a4ac59f3b4c7a4dfbf673d0df78a98b7ba81f39909816d8f1de3b9ac733f24b42f35f62705e94ef2acd1e8ec06867627281399349824c7cf461fbf47dd850be41fe4e671367ef7eceb389c13ff73b1b4abe449e4f8a2c08dec961f9f0657ffabde4ba95883db44136c6d247ac7f85170bf1e9487f8f06cd88dfc760bf68da023db4d1a37bef45e321b646af9ae28b89a401d647c3fe8e5dc3a7c96189b52d6fd14fc8f77547cdc54ff0550cf1ef9aaf11bfa3692cc2b66e3a7e2ee8f1a41e097ae735fadba6686dcd43d87f817a2d8bf4f3a4d919ba9ee01254cb493006c63021dd7eadee7ff8ca1fbef287affc625f39f4d23cc49c0d8f8dfc4f8f85a3fd39072ffdd67170b7b3d595ca56875ad046e4b9fc87e3330f9fa942796d88e50de8b4ff18da98771c630ef550d2bd7cb5474d69092399eef11327c7129e71477f2dc80ad375a6779e2bc29ed3d1a87ab5be06f0b35b4435e2717e91dbdbc3a8ffc101ea9474d01927319a78fdf6ebf531dfbbdff12c83995c730d7267b6c67c16798e89ebca47dfa76ac79ec7a7f1f19a0ac89df66d7dbb73e9a8294dae3a00099e6f576aba583f1a3cfedd069237c8778bdeddf667d778b4257720ef1fd56f9ff870a99e12aa5c98a84ae7a80b9d25f85db56ae5a00b7be70c1bccc32e34afa4e9c034df26e5f73ee1d564594ef6f65b3c0fb5f04c4b3c83f3fc67847f3f49e0ab470cfa11837ec4a07f8f1834f4f017a1ef4bff5ee7e3c9d9c8d9429ff38ca0e65b3ba94f4c7ae78fe0a1a04648937b6b6f86a9b3d264f36e7e39aae6c7d1aab99de13ac248de3b1ad36335f2fd13f0ee1de333a15ac83705cda75beaa234ffb536c527c98fa765c16d73e3039e4ff2c3233eab84fb12d45b0eeed338d5063cf890fe3eeaf7e7f9dde1ded05302d99038ffcfc38708c79d81fb82b5c4603fd67f40ed4312fdc5e74038a1efa5ed01bd7fc7a6f83a31f2154715a07f245d2b4bdfb3b2d0e4fde99ea0f9cc65e8e3e0e1696fcef9d110cad3915c875ea513263700e549bc0930c31c7a5179768316eaf1463ff7a27ac313d943dfe3da7847408fe7f2213eaf49029fdf1717bba65c9ce2fd5bc39abdd89434bb939e0f9e6fe7009fa33c1b903b27f7fa9cba82fffd433c7edb7842d58edaa3149b1ed330373ddc56d6c7ee5b823f40a4de6d32a4df8d96b1e97dc3aca6a8e574b783fb41420f2bd4d7cdd1aa9e0c52079539f461d34f7a11d17289ccb5853a6de4cb98a8c2de32f2ed33f2bd83f77853e8f7a0e935212f20386f3be40f0fd33325afd0acfde07ba9fbf63ac13216f666aa0bb99d2e14a17f17c94b9b221f09ccde17eb0713f7dcd361be8d5259e17e4af05be25f39636fa83848dc7e44c6c4d26cfd16bd8ee839cba7b457a3d72e79bfa19e19970387ea14a0dfb3a309e5c338a859b091eef7f4e8ce545a3b3ddf71a83c44fa1dc37b964613d1723e58cbc93a93f13ca19daa1d2bc71eb97f8fdcbf47eedf6f92fb17d38f93e7dd51ff4a5369db89f777a523e81743706c5d1caeb14d15490b2938fc3f228e1db95fe7f8191e3987b7c8398cc204abc8b88b9dacfb98f59df2cc4d6267240f80b5414398e5dd8fdda7e2bc939c445bcf4b59cf9677361af45397fb39d45fd78fafdfa4ff3189d5d37b722dbe7bf4b53cafafe5e95acfc4882c0f24ea8764dfca2357f4912bfac8158dcf153d1af91788eb6c4db13cd585dd7d3036a2c9fe5615caab909cfaccf1386a8f7ee17905b6fdf9b23bf8ede477880b611d755b3f21e8faac2e74b63aabd3299dd87428fabc14eb605f4cf03c14df98a1da0cbf97f770a20e2a7e4ee419f1207a1dd46f1e58e704ebb8454b97a3632bb1f30f1a1df0d1669b22faad97f785e3483e5d542de7cd35366fd5caf0bdde5e86cf8eafa69b9119945ff55cbe6379258aaf2b5b63de5b1b6e7da3dd2fdf7707fbf8a6b42cdd35c3eff79965bd97737650e5e2bd63f4df226923d9d66472e36e232743e796e0ff1fa2bdaa0cf05e5134172907149881a00d2a96d1a8ac60b6a0eaeefd9ecad05719d97eaf4ecf9cf62686776f7c0e68e6527e243ad35115c7d703db11d900e017370e67d88be173bea51c053bfd60a6cbd277fcbd158f6c1ac8c519ec99294a47ac5fbce7400de0a1b2c3322ba7435c0a622636f4eb437c3ff1f87e98284ff1fc2bc7889065ba82e676436f010faf47d40301d619c9396f3668ede55b3cbfb41cdd8df4258568ab0ffd0ff8f44b4b87be26423dab111a12a12ff06fb72fd0036a83e6cfccd3f7476a40ad5dc73bf74bf7a9d107fa3f625e4374cfabcb4ef62c6ede1cba5ac35eeee5f437d5175b1af67bcdc90572f1d29add867430c4f2511b2462c09c31af6c8d306d44f2651fff9feafa7c19606d7a8edc2d7d5dc11aa2e516eb7fa5d68fe85981b865710335ed04cbc6c9f841d4f7537171df4178aed1f673df4720bf146da93550ff503f473eea7dcec0c2917531f43e52b47b517ecee8248f206a8f685ccb27cf25b9b83414c9698a64c6a3b684b8bf8f37610ed0bc6f81ce82d9b4f1b18184738f9633e0ebde9a101fc0b9596f723c4e4eb7f9ca596d9023e7b9d6ddfa3ac54798a23f1ff9f38ffcf947fe7c42febc0318419f7796809fdf948a4561faaf09bafb1117257151c0cdcc9ce9488c9146778ffe6e6c7fb70b6390b17a2401a391df545a0c06801c31bfdeefddd347f43b4661e321d894de3303bf14d44ceaf9bed314d93a526976116ec3f77b53c86f716d1b4dd714ddb33eaee05c5bfe3e8de4a200f841cfb792e727933cec576763025617cb8771fabe549a8d8e8373f1e85ad335aacd1d54e0dfd047d8ef676bb8d20ef210e09d301e5eab72d1d2c0ff29d2cfae2c7598472917a760b7057eb60bf6d5c731b16bbbd6a63f5dbbd2be4fbc44a0d79443feaddf4557d2b4f926432d4df657c64f3e4b7e48242ddd34a7c38da09904d930a065c8ab774e69766f6f5039826cd2416ecaf50d9ee14aaf6d8265c4d5f66e141dd1bf7dd87827369e65e4416ee778623a15efbb4c1dd4e93b44e987aee19a96eecf112b826d8f9f5d5986ee47fa9d83ef29d817d2f714fc11594db1b254de32d1719b66dd9bbba7cbf562536c39a8c6c8955c4d015f6207fc585bfc1be699a0877a93911cd4df6b4a6f62365a962aac018751fdd1ca07b8af399756ba2b1d8279e995a32ad437383695d585f28ad74756b563655c04bd9c697b3ff29c1f79ce8f3ce7fbe43947d98fd1ba84036b06d8e6449f47c40f5262789fb5cf1acc86821cd8e3486eadb4410ecfdc6d7e3bb9cf89ee4aa4bbdf251799a959ca5139c1317d0fb8fc1dfff4defb4c7f523954b7f59f423b9fc64e09d70e221a391a82948d9efd1cc63337b66792e822de47340bd353f335f4bb34dfc710c7ed3c4c2a06bfd594beefef50612e11d35717fc45802535c11134b940614cda2eab205bfeac5857ac4c09db3bd7da43d0e72a4646c5ec2dce7fab4b476d50192279c0673fa03df6de17fb988235e2b9820c0d5e80b51f79e28f3cf1479ef8c579e291582eda4ffac03108c7e4236374c5736c98dfbbb7ecfdf2c82fcc7144fe26a8273db7777f0feab21aadad5eadb4927555a4be7bc5ff6743cc632496f38caf6b6208f5ec48e904bd7468fc31ef432fa07366f424af93e6cb34be4df32145e4019d895dd9fd4a92492c16f2fb93e8727cafebdbfb51e83d95666f8a8fa7d7d1bda43f6b9c2698e9807abdf9b51940db9fbfff0c6b7b9c4b2b1f6aabb034c1255b72b6aa74208e3d08bd6bf5d6f2e5b42657f5dfe19cf84c32fddcd236f907c66a68193d4bb7bb881ed84dc2ebac0c3459730c57daa85139cd91799a117114f195a281700c27ac772eb3c54ef7fe4c7cf0887b3ce21e8fb8c75de21eea7cb6a67b8145638cfff0788717efb0c783b03f2eea99ccfea6bedf6f925bf8e8d1f3e8d1c3dba3077c47962e4b594d69ae219680ead7f20f7a89a51788b780ff087253a1c6d16e46dce71f492b50df98c5765bf419fd87fb1a3d5f63270bb5afd83efc4fa18dcf12230ddb6eb7adc3a6e89f3d8f701db1214a53b057d543e59dfe0dfd0e51366fc87e849edb5e7d7598ef1ae6d2142daf9ed8f741ee70dcb33ed31ae0eb065f05bfbf00f50dc4fe8210bffe5e3ec8471ddba38eed51c776b33a36980511c3476972e13f42cf47ed57c41c14567fa5d1dc6f62673e7c110f5f04872fe2d3d73946f17002b61b84732f2a03980513927ddc7efdf20672ac0cbbb2d6f32da7d9c0f216e1bbc984f64b8ce4fdd26ccc26d0c7959a69003c0f3dcba8790b958326ef214f4ba067df5c12438af18bd07b7e6d0ddf03af3df0da03af5d8cd7509f8122e4fede2b4f0fd7e9af47bfcf0c1f5ce7f08b67f77dfefcab138c92a00343f352aecebf4232403a36456b6bfab3da025dc9cea6a1731bd0bc9fa01edef7fd9cd3778ec5b1215d9e66e73cfc1f0fffc7c3fff1f07f3cfc1fff71fe0fc042d02b0666cebf291dc784ba71d77cd04b2cbd809eced1f1e67f2eadf41651b22bb9c73a8b156e1cbbd294de9aa6d9a4bcd721e4b8d7e918774556e57d8ea36f13ddb3c2efbf499d39992d981fc9fb95d73b02662eee21b716625a78eee27002f37b75e8a929f726a65b5f41bf724dc679bce7f8314ee3f5f41a52e83b0d53b504bc2f117c97aceff09aecabe9660e79cfd2ad7b4e47af8bce7b75f07369391681779b0eaaa7d88d1a2dcb10861398c1e9d7c0e09a4dcd75567aa3bd0e7ad28468cfd2dd1ee4c0a2dc7eaadf72386f3c1427edf1cfa2cc47ac8392d7febbe2fd45761fc10ef1f8df2635a6a01bd4bc741829fde29b5259a9329b639b4c2749f721feccaaed9f5d1cad24be0ffc5ee92dcbd5c9fffc4fe629b31cfd1ccfd799e7ffcb746793cc7326f394e98cdc31fcedefbf9f32137b6d6df43f8d85fb97b93066e39f7f2d56c6c899d9ebe7bf96b3c95ffa666e3a63f3af95618dddd1eaafdc9fd93fb3ffeddace78b55eccc7f9bff6ae0337b7e7ff5ec09fe6783db29d15fc75ee3d06bef09459d9c771e6b9902d7f79cab80b739c79167285af85522157c8a1fff9b1b6d1d785acf0e5bf73d9ffce95de73c273eeeb735ef8b3f43557fe5a2c0a252df394b1573f4cfb67e679fd73337ecaac0ee859b5f136f3fca598150a4f99e67c91792e7fc97dfd2a7c79ca741c7b3ecb3ce79f326df4d8dc97d2d7af4f99a16d669eb34f1911ffa9fcf8b11c9959f4f7be0977cb3e6506d44b579c19bd868ab33066abcc73e929f3b2b65d7887c1d8c83ce7be9685bc502c15e1d12bf89f2f39fcf27f3f65da915f15c857fd75fefd94a9f27f55f9f16333dfacc666e6f97fb34fd9a7ecbffe86a3b5c63fe1b56ab05999bfd6eef2afc9789e79ca34dde5e2e7ba3b5a5b99e784f30f0885fa9fdac200ca79cabc8f7e4ec66befeffdc5621d7e447bb436acccf3ff26ddfe5f4f99c17ae48cc949a27ff4c7a3d5629e79cee81bdb31ffab59fb2fd75eb9e86e4f19715107ba83fb9a0be3cfc922f32fb29a55e679be719ca74c6dbcf4fffe3e5eadfddf04ffc5fca2bd3037f016ff97e1d992f6c89e93373ed95971d15e98e4bf46cefa4f7761a2ff96c63f57365a57ee4fa1f8673603e7b3448f237cc97310fc1cf9f753c61cad47648d771002f8c3645980bff3abc4c1575f1c08bf4e1c08f98738788883bb8a03c29bbf4c2a7854f4c318ad47ce62f2c3fbc59ffb95992c2e627f450448492814be12095210b2c9a22397a305c6bf47ce2a5562947c89912312239fcf954a67490cfc96312223f7254668947379c2de5fcac564a191cf91af663f4e589c4d6f8c7009e8cbff3c49800482c363562c1ef0b985e5032d0ebc6f3f0441bc208867475f4264bab57241977713d3950e86e06cf569d66e0f0abba65d81b4872d1a0173ccda92d417dfde2776f758f8d66d58c7eff3ce74244a6b755029e8f27e631c9705e398b5478d7ed6a82db66f79336f1e8af9f6a1b8355c63db9ebeecda83b2fa3edcbfab82e5e8f26bc88c06b36a2ce656fabcfd457fa7ee23748e86db86d69147b35a9ceb7915b908f47c67aacabb2db8285485bc4b69ff367d89bfaff79bb52ad4b3aabcdf6a47fee7a03df1cd757c35acb52e168fdfdd32b4b75cbd29605a769ceea0b5301bfddd77bbb455f39d837a286e464a27fbe69a5bd34621cead21742cbd1afdbebecbe415a58cbc6ab26699f23edb1db4f6a65c5f419ab21611ce8551469a206551bb6ea5b353e5ebdf85a58bb7e9cb5a979d95a674b210de6bdbcd3fd06f6afb82715c5aba5bdc92d608dd60bf4ae346fb8baa547623b9e8eac74260b6575f265d31fbc5103a0b4847c76334fe78cf756abde18e0e55d654a56fe96279def4f6c5326bbbadfebe3c627af2c666bc27dc5bd11c63de8156a17f44ae651abe5fb0f6a87b5a30160a3fb388f7303725efd8ad96d7dfe7de183cb2a6d0ded6f6ebef418839fc99fff9cb1f689cde6bf9bd97adb79baf41ea788fd0f0a1e2eaf9d61ab5d112a0141dd292bc96ada6589a909475d545a312a7bab05ff92368a0254623702dd0ed3160d4b45625a949d22cf86c076ebe056a398ed3dbdfaa153817181585da14e3ef3ae30694c3ef976354da5099e94227176a9de13d035c7c9626d6b3eaa03041eb85b4aa7c2f281b4f5937b4b585335185f24c7b2f86cf00c9b0e11f6fded9ee289a847d2ebd09d92f7eda5a145dbae5ad5963ee3947ef179c596db78da2ef6eb51cc713881f02d76805b5b0ef0e7069d700f3543595af52798bac038fd85c115a1c526e65b426d7e329bdeab5d3679e01f72ae9efcbb0fb785aec056ee2d69119cbb9d6e40ed0c9d210b2380d0eb9006763f40eceb429d68f86e0cc7517e8089fb7e7625d188799d77218b7358654367c86cc3b306786f7dc685496baab6da3f64a13cb53934e7960f81bbedb159bec1ed9dde96242ff9f71a880ab1946b1a376caba2c4d47621d64fd9a1e5ba5351cd8d3a57a406d9d979a50f4ca3610ff79aecc6f8df692b4bca352ff4a6f79ebf8dded6fd5bc973ea122b770e194f6e4e246cf9fae07e96a18eb00ad5e140bb5958bda1343807613a8ed41706f727934e890148e26848ca659fb549f2e57aadc72f46a7302e9c1ef42676a2aad6377d0043cb15615abab0a9d9c316f979bd357fb2d8a56d1fb4a382505c6cb496e77d03a91afdfe7d211daa5c37d477291baef8bdd1473d6f8b53c05b90698c4948b305276a3415b41bb74cdfa8016211c0523a5b2777ef6dc94a1ad06b4321bc21a97fa2c784e1bed79bd10ec792ba7bbadad2917678015e2f7bab3d00567caeacdf3cf83b8e4d3f7a43d68b27c492e562e42aa44b949f0151add585fe982f1c510e09d3ad92b9e87792b7efddc6b472922ababcf04eb8b2384482094c6be93f73ef52ca485ab7329ab0d7cbd01a972136839d91d84dafc4c50dade7bd6867d7d9f75bec3f79b51f2cebbf70652d44f640c7dd5f649f295be405f783a5ade119c146a8fcfa97792f4cfa5639191de67caf962d61baddbd9abdb4891d3ec55dbdbdd59a84d4ee94d68fef1ddae5cbea6345dc1ae294667d07ad46c4028ad9533c513fcca7e3ef1ce386babc2de32f26dbbd94021b972736e1dbfcf8a356497d0d8a6ca8d71b8b14e12e6e979efe59d3df359d58ebe0fc2e14c88b40b23cb45077411a425e0f0275a2b0a957aebefc0b94df119a4b640f27881794ecc1e7872abbe33c0367723744ab0a727983b89bfd469b1a603263beeb6eab4f0a5f9fa21ebe47d2f4ccf093289d0b1d287f269db54fa4e34360e3ec723c9cb4d38531871db68afa194f7b3d16a5f94769a5c9c1987ca56f7702f234b53eec7c8b69e0cef6b811ca1d37926301646c7633ca044193dcf1d7e12f9e89f118c4025f2b102f43082749870ca018ccdd98e04646b6647a2736c824dfabedb7263fe6a39ed6c094d063a98d2d198b6bdd467512a4848f7866c411fe7a5d881044707fb934e77a9f4164567b43dd314f796ea4aab2becc14ab301690e1d672496571a9c91670759808d4056802cc069342bcfdee92f8d7c65a52ab30d870c4c967dfc32afa4866cd7e64417ebb626ef7c1abb721d69ef912cdb6afb533a1a54d623c572b45ad6361baddc896cf27e3337dc72ce48c0cd1af28139c01f1bed905bea08bb0e4358f486749c3384fa5c1bdc9b8e191aba9c9e43e3be55b9386b8a52c110f7a0bf234655237f560ea54c092af82e68599b2e53536429b70c05d9e9846423b3ae13fdee405906a46c698ab5d3015bcec978737f8cb7b7fe066a4f0f58d40ac9df69ba9cc5b41377f6deb9e394b1711de30cd9296872afdc9cf70f907ac79c1bfa8d966f6d4de525c13e93044d691541bfe9ae01f629a4d93b27f67802a685f2a9cf861142ad476e80112e6ddff949b02b6b5b227fdd156be27d8764599e8e53f1ef237d74f833df0741c75a7c191c605a580bb49902feedd1bc524ab5f71a29e752db9fcad059e8797c7655a43df53a21bff346c8399be0df52c1f0dae3f8f605f609077e38f84da3ef18f9de7d65ecc9fa317610c93ea15271e427c1ff76c68d0a8c0238faf117ecef8594555576c0e705bfc96a0a170d26d35e6d1fabc7c98563b95e2bf021aca71fd019f53d4273e3067c8e626813d5ad1f896fc94fb965e88aa1af28df155a6bd3aea0b4eeeea005efeca7fc536b65af549a8e798764d9731ecd4f20b5d9ac9d277b9364706f80e35af26ef24d940a9adcc999621dda1c2e7978e10c59ccd210dfbe9c2f9bc90598bb16e25b9f6759be7959688a0571c9ac8ac665cc102e41ed0d2047c1f385e1565a14fff1fabf5859c041439817696c707a5f7c3f18c1a9c9c563c4be787b9dc46fe49dc23808cbfddd3684b36b856f517e6a8259b4bc04ada73dac43adabdb489019c11927f978a37cbb0d2a3e71952e40edcb85e124d4e20c971dc067a6ebcc101e1f809dd3d9ea102b72f1f9d9c03f4d8c5d63b0aa5db8b39e30731023849139da3bc1de775a671a4fa4f0c00de387a9b6e9b0deea0eb3c39418da69bce79c38ca0d6d5b526ef3e1b6ad844ba942388153e744e998815c9c8dd0bee3f128a274d065e788dbc610bf06c264dee7b46d0d58cb2fa12972e89b64fdc2af4f407fe092b6139d87b0fe8dd795f65ee763afdfd3877304da87565af4dad3f4e645f684d381779a121fc5a5f43d047b4100bc8c7cc18013bc18efa062e97219fd9f0ed8c32d82bc133405c78498fb7c983e60d689f4411dd90528de875b444d476279a922bf4b6e8bfe4fde6735a5b5817b9bae74fc101a8db60fa07d2be483b2b23f8a6e62e95615aced48ee3857e74de0b388d539b53d8bd5023f8e58cebfc9394b9fcfc2fe9ca0056ff85e7c729d5bbe27c9f99e503f40be2de4cc998d191f864ac2528eb940a33371ae04d86790abd76cf8ed7831e6e8e45461325195f604f220912c14735be3d3c4813a96e1420cb2be823c45dfb703799f7605cb27f8dc58e1b1f6b89c12e76de42568c96be1d8168ad1ea72f9f09bc686885cf878793c23b93197cbe2dea0827112754e42c1976986807cb3e02b399a7267d114cb3b5c467b5f994cad95e073821748ae11ce595deb029441771cc80f86714f98beb6de9a8bc70776f0b103c43fb277b03d2196877d1f0c0df3c579206e09add996d8b6021f5ca88515e56b41edaa305eb48c79efbe740a711ddad778cd7b7f1edb9027ff91b433bb436e29d8012f37ccb1340f7a5eda25acad379c95dffb83e4fb47bc4f247ff0e231b07f4c9e5cddb9b451dd724e7761f4df75cfc42da3ae7d26f1a3c5e1d2121b6f096224ce8cf1357fef65d167b43ee5d6ab8c5c8bd7af897a36149bba01ee933623c8211d8447cfa1788317b346f200fc0880099b226ea3524bcfaf677444929cf6f78f075f910bf2eed9b826d2c7f5cbd7742f8c47d141549c03fdd9b4517e40393412e3d3d123e287ebe930c86f2d92d63111f9124b1473427c1ee44ee0dc03df1ff356ad785802f49788f3bfc8195739fcb95546a7c5e8e2b37532b94037fbfb86e2197e3c7a2c939cb4e1c4684807035a24812fc285d69830ee13e90f94bf4dd1b3dfc6683cd84d3c598de289248f8fe4fdf2e41bb1f49b80bf765b5676f2cbd6e2f18c38de1d6919da62d7b9ed98147b06ddd7c8572cf0cd188a64e9cc19234c3af84c3419ac9fd8363a5523da6cd4519d9e3677823c5f4f5f7e22daeaac54a573fc705979367d79efc5697b24d9207eae19252bd15a91ad217acf018c00b8d1cf1ff80df4799077efc9c58f58e7bd74fc0d6d63aaee95b2e5d37d8da5345911e55b1c362418131be4e943ddbc0cfbf4caa7e7237126a9f7948e54ce0e3eb33ad4dc65f1b9ed4cb9b5f26233287689749faa68d648869c6307f9f5e2cf39907977af014ddc3744cf3d52036a1c825c1fb24eb09bccaaaffb43f742f994625d506507f2bd97bae864c783d83a9f4006570bff14db7dff01b67b4e75f74b43b0c06efc785f810bb2adb7d6e5f28cf46538b1d34fecfae6fe24a671de7e82cf14e5979cc6525eb9eb1fb96d76fc7cbf1f827dbb73247119c395849182f29ad62311da27960f634e5f4fc4dacec2976761cc381ce0b41cd375bc3379b59646bebf35e6b3495f2e668df999b19c38793bab6f9a6053345a45522b4ef8d3141df0a1afa0b787274783f7310ecc589948995259ea36c420a13e006cb3cec270cb1b5dd86f35c181f39fe879681fdb9c7c13671b6d5058a118511ef1e2146433d86b9aa22df5bc74a49f0fed628d7927fbed1c4c7c062e0ece2f411e465d60b3a59e1bae39827e38f3fe613c40fb84461820dfd3bcbf447b0334eb8d34803d5c223e4139f4a5c83ce340ff800d58803d443c0dba6f04fd2fdcf24a174cc083c8678b6a50aad6f2cd1d6edeaa307ea883e4830a71ee2af4219084915cce1907eaf976c51d29ada359e5aa4989c240f158be9a10aba0f0127b71c4932ff22b52f2e3267e5b064bc4d66433df4bdd07fc3d5fc647ac9f7bcd4043304ae6ea78fa79ba07f925eefc4cc861fc79e7671ebc3161f75da72e108c7cb58ffc3cfe01ff52637613dee1c64e27e31b502cab18b4c58ebb4ecfa46ac77d175fd79e19f143506381d2f7aab9a7639f1178235dbe06ef92669b27c54707645cf3997e8a385f45851e6780f2b0b12d05980772d3b1ffd6325ca49fd0a8111edb2d4256f2acfb6cbf846fdff9bd9f886f8c1e71bf83da8c35d697a0fbc176f56c39e8b586e802c65d653f977ebd6fbc915a4fa23d497dcf970de13563bc17697326e5c507fb9423bd33885d41d9ebeb93f18864349a20150d36efcbbb1fc959d91a76a560c099d622f7f3101e3199c323cc4ef6cba353d77174b13719372a96294ecacd5905e91a4f97974ed66ce21a367cafe83d8c93576ecb5285d51afc3228cf95e289541974592e4655953b3f41479a8d3e775d628caffdb45f9dd85912de2235ab70ae7a5e3aa842780c9971e0b037526c0c6ebb026c8919daeb09d96b92a7769375fc5efe1ee087ada9b4a6dae06a1c9314eb4f923be7f1c99cb2033f9e4706948dd6049b5dcfb78acd57cfb6e7d4d1917af91f60a79efa3dc26bc7e77a937a82b4734075053db9003d2fb3c6dcef4948ecc1ec08d5acee9706ee5719d8a1a83fc036cd9f8dfc380da03df0ad817fc63ae842710bf818fce53ae415c27ed82f8bd1a0e8bcd9bb892e97e10ca1f6099d0bd4049aaeb33595f684b283279aeb1c8c7c6ff9f631b5f951fb9e6827f3db057864cd15bdddb8ed1ecfbf7f1cc5d416ddfa79d05fc9ace6d6bae0e5b7dcc5473df7fbde72d87757fba6c9f3137cd41f718eadadc1b3979f2f972d29467820761be71e9e9e5b707671bccd5ea9f1c5d458236553f160bf541c488fc3f246cb620c047ef0f206cb58c0dc481e8e94bea37f525f33f61df87ee54bede873ecdd14d94e5fe972be7a7ecef9adea3a99f55ceb6bbd0faec4bd41e97ba7c5a5ba8d047c13177f9aa1e75c1ecb9fbd866c0e845da0a7b158c6b19f267cbed6f37d0764bf21947306ecd97df15a1bfcc0945d75d064cd01de009b09fa9dab830af2a1e2de1b203fb7a638a46d2bfc3eb7b5a540af8e4569d61db4b2c65c3ae9b1cae997413870acf4178013df646703f9d2daa96e4cc893b2800f6d5413fe9eb5835a28aa7f51048f9a4a6bf9a6b41ccd95c0af353da92f394f8f70eb9028fd31027e565a59e8a3d06c50ef749bfc3d2f9680e93b90a13d44e3f4bfb579cbd204efefdfc4fad46c48965edd4d74b70c7886d018c443500f4f4dee2d39f8818f2fcee70f72019f2c80268d79df19377a21bea678e7a3f7819bafdff26936f66ecbd23b373f80bffef3f5411e68f21ecb95eb69ba8fffadbad291c9a773817f74bcf786602d8d6ad0b35eab06a35b41ce8c0e68fec2d23854a6aa5c98a09911726132f2ebeacb74be3da29f56be4de5349f81b938f1d6d9588be02c426f98de3fd51ea5e91f768f62f410575f9ada9efd9cf68dcf46f2eb1a8f5a0ef148523fa73bf1484793fb4b159fd39971ab28dfd82bfeb70d3d5510ae16738e263a16d68b544f38c0526ba84708fc98effcb12b722629eb3e3b6605f1aa77efccf059919815d637720bfa38a2f8143e5bef7b544e21a261419a3118ee5eb2fa76b83a9ce717bc433a4649c52691362d93bb2989ce1afa96a9726e7785bf76086705b36f483d8809f745e3b4a17f7120239a0dd3827923288f56817946bd7bfb4a7b4c0eef7084ea20fa96aebc107bf6daf5a4bd4f32fdf1f7b388efbf45ae847e7711b87d063d54114d0c726806d21bbc9b2c7d41fd14ec52248f188283ec6c34e34a0cfa79a5d9b8f8339f27f0fec4f990a06e20fb26e760961bf4c69cd1323d5596a7cbf028d9dd86673645ea9980bf6509c67daf8d2b7a17f54f690b64db2afc3c42a31c7824058770e30fc01d33940b3ca172812766584e602c72e61a3ec05e8d8efdc5d323e3732ec0e7386e62ab4ac789f43ba39e6c1292136f8ab934c5c91aad57596ed99e03145d1f4732a279e8159865fc3637d41f814f9cbeffc7f86682b36b36fce75eeeabf1c6f307fef5006f06b213f949516de1dd6b26fafef77ad4fc408c55eae7bf7bdab3c9bb5f1bbb4aa03b7295d8fe8e4db16519c2490fa16d8caf315eef101e8e7a4ffc992fdb315fb2f11f1fd79fe6eb7f383e22f31ae9f3be54c6937b811cd5e8d9a47797e778ee628867513e787798ed54de21be2df41dbd1ad0f3835ecfa7573f8ff9e371c92b2563d87ee19762f84a08d7327dc6497d30c24022c4abea1bb6173935bb92b27543732b7f89ec8eecc3f01af6153233aae8f9b413753ec3be91d077d01a7f533e09df9fa1c5281f8bef5b399dcd167d2fea7e31eb8eba5265368f0c97b04f81476ef3c8f1a01f67501b4ff923427d3ca02f9d26f6c1df727cab5620c77aa987fb1d808d6551f9b669b62f7b25dbc2d7fbddc905b6b2874b4ffa927ec83e9cfb9e641fe2656b359687a26c07f62a919c555c2fbb565de980684b69f3f1d375cf27177a0fc395502f79bfefdc193deba32eacc33620b30c17d7a737bcd997dd416ba60b33da9ee0b9526d0e9e8b1bd7f05cb5fdae3b7b9df445673e823ad76aa58b7b8d847582fdb2e8bf965bdfde57b87789836600a398ec1ce64fee2686e0e0d82cf2995bbab8df9af9369e07dc72e06c34458367801d6f35452da78bbb89ea428eb6b3d5f22dcb102d989d0bf96e5bc3457e2f768e33cf85e9feca3d22b8e7c2f3c2fceae873690d7d0fcff93dfe2dc848e09f29ef6ff1efcee261b4577369c3254b084e94227430bb6fd17e61e68c28ff02b33e662d513afd863e02e86ff0f2f1fe0187eda77d210ec572a8d940be16e8fb87ee0f3a25c026d22c3e2fd59fb3bec23d02514e6f53f4f216ee8d458730074094a04683d85d7759e367cc952578d29f55cf9377f8896ae383bcb753dc997e3f06e7cd2eaed13a07336259c1b1eecbb022e044ba2e0f61c58b731dcfd16d67e8300edff1f9f3d89ccae03dd7a9a7e4c946d07729793d493c578df74b307c1dcb97dd06d31788a7b75fc4be761b9cf8bbb64f92a569be0e94e3c2a94f92f4ca3dfbd8f1f2271f6f9ecf97c09364dfb0ed86f12edd1b8d9d89283a1bc02b288f3598c582f7c9d76144dfa0fc2a3ddf5f80ef87cc9be3d13b0c9dc6e99fc8984e542c945cdd4680f59a62ced1218fc001bd251d397a529efd3c7279cf6d410cf930f26be328bf4bd28c45f6aaed4f71b58d7b8f43cc16f48fc0697306f74bdbe3b88b1be7245dddc60bca57f46601231f00c59bd07fda9b153442f92c504b82fabca09a2f98d106b32d705fad98be6aa8261cd1b591879e3bd2fa0de62b431fe906a583c4fa91ce77025b0f3f6ba6c91af40fc77cd1d9697207ea5aa651f74639a9720fdb7e087f5a46beb3550507e2843382ed80170c4636275d981fced5bfac2ce1f3879ce892223cf3c0f53bfc1bc62711ff1bf27d1e1ef6f600ea9f936446920d98d8f72e45ff2db5cf976379897f3f490f4aa4b69cf167c7f56b237a2d3d66c59c779a1ff76c5f0f8a6585620ec49eba7c4dbc7893036b62daa4ec3706bbd5f6ece7be3da4cfdb415e7b9a6f915fa673fbd32363a1546fd51bd05da529523d5c077e8c85eea98ce899c73e67f73c85b7ced66360b733eb27f9e6fc7d733f096da902e4f3b43fdddce7a09feae967553bfa3ed176b43fff9bc2b6381736df9c78ebf7f4393e032a47365c274e70ec27c1f035a8b3358e2773ce6fb94edef74ac717848ee37da325f6f3c04789fd61e97de57f7d2f6e07c7496ed82bd9cfe5267ec1c0ee9c8ed07cbf0ecc4c83be7e4b95a71fc8c9997c802e0ef681890ffab509646612a5872d98b53f851e2ed02f6224b73777a4c1dbf9d4ddfdd6b73f281a4bc584b57d9abe8ac2806dbf9fda15f9483d5c5388ee63ef26a6e0644755c67611618e64dd8b5163bb4c1520d7a3e5846ad8ee9fb71dda03bfff3bd4757a74b0f27de94ae7a80b9d25e4326bd50ad59f1afca9f56c53ec5890efa985ea2ea5d967f599c7fa1fddfa4c83fa877cfb1ebd7a710cff43fa04c4d65c1ba285726cefdbcf4f3adc7d9d8fbe0f8fbe0f8fbe0f8fbe0fb7e9fb908cb51fb3c31eb3c33e687658126dc7d0739ceea0b06a6e798fdac8f7004b4e86e15e033c765e947d37a0f0259a514fe54807ebf330fd5417fa4ed726b108c0e2cec6f3777839d4e1991de43e855f87c3ed0ab53e7f5ed8c99a756a5f355172477211623c4bdda6b13987bf8ce8b85f5b1f169297ac8c67e2131f9dd7e5f599fe78fb13cda51d0f5e167abe3557e5e2724c724621e7a801395f9dad6e73ce8788f4e1bd4e98beb6a85721c4fddeaa15076a5121ce0c79985a5e5aa9687e431d3d5f13ea9b91b22434b935ec0ac4e98fba5058c2e7281f4cf466369bee70f24dcce5f4467f09369affeef3364fcf935bf2d1197b4a72c6c27dc6d10c0d346b6737d164c79b25d8e82c75d7cce9621dfa57829dbb355cd3d25de70ba607e42b8458be219437df0664d62eeeebaa684eb36aad4d799f1d017d0ec85e168fdf38e68ca7e88ffbdac773b28777b6abf2ad39a7bdf3e815f8e815f8e815f8e815f8197a053e6cc687cdf8bbdb8c785ec17decc521e4803666f7b015bd75bdff567622c6b77c36229edbf3b00f6f6b1f12fc7b075ef0ec892be8bf12d83c7e6c12cba64e969dcb7a6f5ac6f6a48f61ce7cd7b4679177bdb61f549c5e8f9299b1d885d886f86cefd16bfd3812cb0713d30b27eedf5eb346f063dc717dc41fb11ec93d8ee7dd3096e9960fda01e9c3bbda89aadc9f217fcee1becfa57c8dbf60afa535cc40e6a1dfb01d701d6d8dc4f2461bdc779f4da5b57acc6bbc625ea3afa37dfb9883561f73f81e73f81e73f83e7c0e1ffecc978318ffb37ac0cfdd077f2f451ba9bc78590ca6cbf8f1af88b5c4c64d569a6c82bf11ecfd25d863dfc4e2d6ac56ac00efce823c3b143fab4f811f752c03a87866225fa6f02237ff01cfbd33f1133f575f2e4c22632be2ded1d09c2f123f7959c059797628898bfcf3707d22e61dfc5330fddde748fb7d8eeefcdc207fc0be3fbe07fd3f92775132fde3f0f5bcb2f0fa10df759f73867bf775a27e2d777d269eeff311cf7cf4fc78f4fc78f4fcf8753d3ff0679138037fe6cb41bc3fb1f115da8f45ed652a4fa6f26124ef61cc0971e2d70b627db1d837742faf8e9fc27ecdaa7534e5cea229d68fa8cfe96b79d0cb3aafdf06509b06bd172a5653045e74e6ba5b8739411343e82c34cedad55b625fe27707591219ff7bfdf8f5a6bd2f59efb53941093193fbf53fe59c2dc3d07dbc2d18f30e31b4c1eb9f217d3b4f798aef7eb1758a54ad763077284c5faba6e8d725818de5f94491dd55f672d444a091d7cb725668999910a3ba3a77c5cf5f41f448e23e1fbb0fe7e8c1737561b5ccd2f6e97df1fd68fe89c91fb953ff776ccf516b4ae5a7dbf8564e64e9e96f0adff8f2b84efa46bb907fa946f9261ad211f1179a63077f3737b886f1a80a96a3cbc37bc73d53f72559c744fa991e3ae5a1531e3ae5a1537e894e31ee35c7d809e6d235c51bcc3416cb4b985d017307a0379a6e579c71a3ef182eb6b5f315c80b76d919a8d8874df7415ba80a607d0df2fe61de07dd2f249d6f52f8849b2f706ef0e91ef9f9355bd48b51697af390e5c204e112a545fc263bf09778bdb683e791d8849eaff83e9691583f8eaa9ede85b92e74ef384cc349fa90e8d34fe1c3f7d672b5af97f05cdc3b25e4013fe6323fe6323fe632fb73995d6ff6ea9b82683fe081346c78275a67e7ac7ec08ce6353ddf96ca2b47b933c85744cface7c565297ae66c7d432ed03bb3d0bc5ca273ae9eab9b66b3900bd36ed2d9df2d5f9ef87375b6175c3a7da6d265529e70f315fdfb0a4ce4cdd7f88663f9df705f3e447fe0b305ac067d63e57e4e957777c6352457a212eac1dc9f4b2bbdfab2d04569aecabb25962188aefcd9db8ab6d4602e7d2d9d9e52e8e857d8c0db5f89ede9fc860fc7f7b313bf0639ebd3ef4e163cf6b068cafb55b36ae11c9c19ee23e961dc883adefbd274fc7ab9689bf57571e8ef5be1ee78da2357c28ce4904fc62e45ee6f52bdc80d6575389fe6e37da2a1e75d41d7159ccf093443669ce07bfb32fbd014eb53c32d6fc672f6be743def40fd7d16cb8dc0fe3cf79dd3fc2ce49d7f7d6d92df3f93cdddc0f498a40ff03a23df137fe6cb652cc3993c1b1f37fb7eac3bc86aff59d7fa6060ee37ed83bbb73fbe4272cd88dced0d2a3b3d0ff3fb7793a0e6a270477a8cc8ad65e42a478e15caa17b93c10734fc901ca4f8bca70ee4b9dea306086827cb55a7317dbd6e4d30d3230ff3ee72a856f41eb997aa50cec1ccadf185eb4bca5f09d64ed94318ef77072dcf573e8ddbaf04bdc8af1f93f4e4257191cf1b0f49d1ad67ebd8cf1ef7489183915832713ee03e985f01fdade49cad292d4423c691c19311cf39fb79e4f268383c533146ef27d025c3f7a1992013d3adaf4ca4bbad6d4cdf87a82b55a7275d67d359c21cd09158067bcacfd7fec7ce67e19823640ae5c3e8d04cbf0f9f2f28e9e2ce67e3982194d7f3ad9f942c3877862abf5c48388b736919d34df82c527e877f13e6e784df30d83d0aaf313e99d899a8619f4d94ec21fb10ed2fbfb18dd05ceb809befe09becf9f819c52eaef1e554701fb615f683639d56df7c06dbc18bb5fa7a98f75dd39e952cdffef3fc35d3917297de8c3578ce15b48a7eff56ad64f5bc89ebe34c4717cc83aa541630f75e8738876cc2fbe2bc2ea8fd011a32029bf378dfbce79ee0a07e385efe19b2e156106b34717f434d908299db50eb077699e70b417900a8973dc8954380071e347e368d139bf6c365f31061471c3f643ee3f2df381de039f0e3411f016104bd250728ae6c41cdb92e985b53695f4a37f794e3ef68cf09edd73f745d0f7e388f1f882f6e8d7a33df832f884ff215f588be823f88cf08d1ce6820bd408ed54c1b7c1abaef113f670fdecba7ff8bdefb41d7e7d1f5146a69cd46eb2e78863ceb1a4ce3bfaf97e788fc45485f0698f6be71a659076aa0b3c6dcefe7ccf78e69f66a8a4dfa9f1757c2bec18fa7d33a922d35be9caac85c2aaf86fe976168fc7c428fde7aaabf9f9c4c9855f54bf351c8ec2a7abfd2fc47dd4682bef4753723139d3ec871af0fd8fbe5f4d80bfab96c4ce4b76e4ed4f96c628ad24a53bcde0b77d6f943b039f03e0672d3efc7023ed116ccf9b5c7834a36f4dddf506e46ec41403351b9a773e49b8bfb1d17bdb1572afd55b9e8b168f062d12a07364d9aef2aa84c7d1d1d0787b90848c6219f809ef76648e0598017f510e390c1ecc52d93d90beabb259cb79b3afff506fb70ee7b927d48b06962f9254a17b017a245eca76e8a3907f13aa22de9c8c93b573d9f5cde7b80cf167c2e9d6c42fe633a7d93abb63f8d0950f1192f5645d98f3c576d7fce79dc9c5e13e2360b5dc8414f7fa44302b90d792a2fe7ad9192ddd7bee399f4cb5e58c748a02b1dc33dfff7c931d6b20b7559ff181a801c5041734772e7410767d1416eabbaceea1f240bd6bad05ac27c278cd5261017555de9706e3c9e393f1e4cc9718e0976220f2d86cf91eff7f8b78059c08656f39cbf25bf3b47a77ab49ed3dde473646cf628fccbee5b64ad0a733fda7e0baf8f594b149ebea13f0bf574fb78bb4c1abc5fe11f90a4561bfb86f29a8c669f65b16fbf0576b2e1161d13c533a42355bf7837ff01bc1fc943bde45dd39e952297ef53df1253cbfa8bfdb1e88cefe08b7d0dd3d215be85819f3f8fea6e4d19faaf3276de7d7d0b276b2333e50dc1da1af31ef83d0a5e2d6a85e1a9476dd6b9b559682fef30934782e75c217371dd32a963057d95c3fd063cff4eb5921f89e5a336a81cc1b7007d7dd44365691c804640cee5f04c9e5611e7b4a21926703ede3da5a3c6938f7bcbd88383eab489ffac82f37f703dab4fe3534263cd86b9d0f380d33bd09b1e7a244f9ba273047e3545e900f9eb50d7ebf9062d07d5f7e05a70744fa5f3513d7c20b7bca0c93df07d1dccd31c54be1e8eae047d2f8aa66881ef007ac942dcc509c9f41bd2beeac23c53677b07795d1dc9add5482ecf0db7be1bc1b9bdf61dcd753623ba66f092f8f0ec95f4fb06fa3e402f6ca09311ea93083253f2e85b6c2d55e8b1e2e70fb527a84703f022b66d5401f2e09c8d2aec73d09ff7cdae0ca1e7a92e97671af8a88086440b782adc57dc467ce6cd0971031a7ccf756abde1eedefa8363af09bf21feb080c60c1bcdb5cac3d98c505ff50e6038a8f1b44feec7f41b6f8a1d98e7bad4ddceca94a1af476122812f54cc6d35515a91dafb9172bae7f07f5ebd0c9919d62a365fcbefbd6cbdfd41bc1ab5b7417dc7e063788dea3570077c1ff4afb922565d693682fb400f4e43284f47b2d76713f7efc9027f18e87b080706345add4d4c01727a2650af546c8a2da7299ef40bda829c36ecca0cea9ebc1c3fe06787c89e7bf3ce80ee9be0e3aee0ec26aad239ea426789fbdfe561fe33d6a7c486c8d27d7fde905e463cb55695d944758713156ae30407f4f6017203d95a118809a8830ae46962f9f2dbd5cd65474a7f75979a32441bed7bd4c9fd8a393f335d68ff8279519d25e1937bd4e891de67f75d23b24584cef53dab92ece1c7cce8c7cce8c7cce8cf39333ae7f9225af0fba5e14a8f39d28f39d29f7d8e3485093fde67d50b70f895f6b97f1f64ab1b827334811f0ea88790a3bb6027952186807cb0d09f590bf706857c8a9c9aefe7109f46d8f2fe2ce67bfaaf66014ea2fa5e506704b3468ac79102b91988f6dca6286db46a05e406e40a21df942a17674db1bcf3f548a39335e6d2d46c40ffc7de84f869fc3a8983672ffd12fb98a2ebb33197e78bbbc78caf5f81d5b19fa474992d82e932d2fe22341b754ef8335e3f04eed3fae1b26300cfb9cedf7dd0940ad41907746d93beba9dc358a964c3fcefcca126b9299a0ef801b17dbe85bebae053a0fc2f1353811a5803ec70dc73b8bed2a0ee5ce92fb870e74d65886519c224f0c9812f85f853f07990fec96abe55341afd901c18c9a663e47b501fb6f1f2b5403e9605c8275407541fc8d0cc14f4cc892aefe77abeb534456b7d4f5972c3d8d1e99ea6f4b6a7d71c8901a9f5c660b978df78cc7ba460a134df79f38446cfec979ac467efb2941d89651c170afce6b837358e1fb596a62bcd387c71ec95ec9b632f7e5f1d7b81ef2e729f3c1f5ece31456bab55513e76e0e3c67c003e684d940e234573b45a3a1fb0570a5f5c9deff99f9c6f3acc5a95b7f7e6a113ed7789bb4a11394f41ee9ad369f606d943e75d8db551a3ae6ee3349faed9d02cbd2101367f1fbcbeaedbc7493612475cb5fee1be6ddf72fdadd6f055eaf4abd97d27c14e8fa173eede1fccef7872b618db3eb61708f3bd849e20273a3bf00744dc17cb2baa2682591fb396281d86ef11e96b27f727b80c63380663fa7514adad2ede255e04cfb9c2c6c3f1453c23c870cbd0b303e23b36f43532f29da52614a12f35fc1fcc6cb68c79bfc8a14f92f5c799b11d5dd8077d6145c91dc94582030ff099178b69e158973347f148dc271ee65e9a8d197e9f046c47e8ec73c761f6378fc384fb95c6da408936d0796b43fea8bbc4271aadadf1317182d867426df8e8f021cf7cc4261eb189476ce2119b78c4261eb1895bc626c02ed8217fb7dc5a69831cc8ccec98d61be9bae2225fe3106a49c532d430db50bf332458ed623cfb1a9a1d44660505b984cccc81791bd5af92b9c5c60166a5d7b34dd1b24c773831a8f783fe646663e6f52b6b541cc3e32b1f876ab2e6182ef87f017b22fc0fbf5f01ce847bc1cc31466efb79bc779ff11cbbef812f3398ab45b0bf9f57c8cc8d281e615f9b0d3ca364de9e98b07f682e9ce6c0bd8c7c650b3d16a0ee0c7ac3e1ba8e9da6b4100e20334ae9b3d3aa04dbb71c1d680eddabbf65f53acea32e98d5df2ed70af3596ea9553970fe0d31ea03173f70f103173f70f15d70f11ce9422f173269c623cc7d9a156befecdcb0f3f9929b27b93009f8c4ce8cdb44f125caabc7b800eb472f07dbd3bd289641e30d7836bcdb39fd445230c2d958211133e07d41719a3ad2c9c417b750955ec08b73d0f7ce318c03f68e87013874362b7312eca3c4f9c1e4f751fc8a3fe38dc59ba293bd8f7fb7bf34c5fd95b500de3d30e69a8d00eb8bf522ced5d9a8301f2e985d7b8aa9c3cfc1e77513bfee10e59afbb1bed709de57dc13b8bf34f29515cabd47793645cb749d39a68f74ba49a19728ac07becfb128cd60068831974e668078bf310f7a5eda25e03a077217c64a7f01b8f64d7636e013d4c2be4cfcfe9158137fc6196b08f2a1a9b5a7caca541919251b7ba88fa49abd981e59bf3139a398f7e0c60e801766781f6a989e6ae97a9fc88b987dba5f5e0439c3578805d70f21db3b420746e54768d0d383f8f0894ebd746e32f3bc043aba58f726d1591fd632f0e701f2d05a3aed4d8bdff19c35e2c3714732c845b075cb070dfa56552b7915720ae4229a73a4552b9671a854a12765670a3aad84ea8034e86527f7a15e6332920b137580681dfda63b676bb8501fdd3fbc9a44a87975c89c5bb4165f5f0ace4aaf46c6dd104e40e78b7220a44df8fde19e7d07f5a8957b3c329cbd92653a7bf1cb78f682589e88f6fc35341bef03cf85cea342b58d90170317da533c0fd3f3f934cc25cc7f85f3855ab1916ca27a50df6f236aa8c78c29588e6e87ce04ee37d36438676976aebdc12187a2ce2ad227188137a2e65be1f9ca39e49766fcd33cf928dc7e6ae65df8e82b0e0b9dcc583e951b7cf765786386ff0d7da3085dd033ae07c8e7e5fd3ff830046946d7577e727eebd0b3b4a918fa7533b779313babe739682611c333f7a3f5698cbd7a975e143ef603ffd6fbf2a00be56c77d02a6a4afbe36d8499afdbaff29bab4279a58b1299f305348ffcd39013621c38fc4337c572f49ab04f1afc3de2dec1bdac504d3ff2fd824dddb8eb3c99381f887fc65cfe5059caaa32e886d76bf3eaa723b1bc8cf4455723ec8678bb05fba66ee2a33d6b2ffc73bc914f1adb560b5d70aeab7b705b962aacd6ba5ccfa29a6f3ebff23646163d62ca8f98f27f4a4c99c892e0dcd36dc9cb7c12beeca834d033afab5dc1efcdea3fdce75850275e2fa00ac43c5ddc8705fc9ec77bc770a3d64dfc68a45f11a323c97c53b7297a3e2ce310a1e73fc0aff68131d43be64942dcbebee6d101e1584de99af5fd8a7a38880d666ff8cc073e39039f6019115bb7d96d30f23bb0eb511e818964b637d3ed7dd6f17c2b513284b726aab64f92596935502d5ad79de9cb8bf2e149baebec4d79c8f4a60f6cf4a0a718d4b354b6aabb74d47c8f3fbe9d62c79c6dcf900bd9352c4e42b6cde56be2b5c353643697dd5ddbb39f53f6efde32f2ed4f17cbed79ef753d1e4d9a17013d9fd0733a706e537c06a4e71ce96568919a055d68fd3f4deee0180af39c983d78cb7360f3f3fd44e08fade9d01fec48b0c387ac93f7bd92edf3106f06b612759f1bc6d408aedbddc37f439ec547a791f4f93a81da3e53ee644d88ed544ffa1c129901f8c6ef77e6e7dd0912e83398798ae8d96840dd687d3ac6360960e037bb5257a13763a3e380fef7f2f850de1fd45f5a6a7e16604ddc5f0d622388365e7d5d4a6a36a186c7c1759d9617eb059a833a707f3fa0e720cc22845a9a7bc7a66b64ddc467da1bb0bd2cd85e8ad85eab86eadf497ee851933b39c375b263afdff6ce701d41953b456abd9be6ab6441df3d9ef368364ca8ff5e9258448fe024d23b96eecb28be4e34d1ebdda1e671cea4774e4b7d0e331021cff381fdffc3b13f607fe4efffcded0d9e67067c74ed73314fc4d274026efa5d72e2587f168f7f25c9cf22c9c525f8429b62080fff2e9889b569505dea156be2d64b57e2238a0ea27ccd9d15c4bd3f9f6fd97baf1bd88e2dddedc3ec76afa793e77325f1ce4353f49e03e7a6e27cb8dfc5df1cd839c89efc907572fb26abe53499f4313e68e2f3c4b295e4519fe673595b981945fd7fba2f48a91ca07ffd7777721a874bd139a8d642187e19d59a271824057f80efefa8bd2f17ed88590b9cef9bbdfc7d8717bf6fc7bef07de72f973e33a7572f7c26c20097ed11f4cfbef4b7a65887daabd07a09ed87fca584f73cfa4eb15b27fb660a1ebf26c795bc3b996f6084e912f37524fec79ff9e798b68ec33f641dc77fc83ab2ff9075e4fe21eb10fe11eb98bfd829f9d6d7cc6721bae1bef9f8f3ca2164abd5f6a7786650598f14cbd16a591b7c4a27b65d386e766ecc297cafe07e24b61da30773cb714cbc8a4f8f9efa5822f51963bb0676b1d4487ebfa0cf2ac6bb91baf36cdc789abbb0d5e93daced63ef7b455e58d2f9c5d1f16a745e6ed1ed68dadd2f6f236f70cc7370a6cf85b917affcd214cd31e64e7604fc7e80395a30afe014d706f7f3f723ee0cb61138939f47e65204d6dc6dd3ec12da5eef27cbbaa0f7f22bb1e7a9e7dd30e7d5947769b2fbea7ec4493324e2ce5c176727e79b1453c79f45f2f7997223aba2fe199f5276640dfb17c98e793f15cb53cf3db1d3cf9d2df251fb1797cb718b7b8f4eef4df6ff2447e496f43aaaa6d02a1f4e49c115f79d8d678abd34b9f4d93125d94f724e71cfa2f62f59b6fe0add0b7b1c3ae3736866ded9697267a9cabb6b74b575f2fc785d1b270bf139796b25ff47d307a13bb45e56ce45cbd6d37b9c87efe3d68bf18d14a9f34fe253f8fff13b24d15f70df33d7f781323c866e5eafa117545b9840b3816e8ba1794dce5ef3fce535cf86fc0f5d28ceafb9877a8a11ced8fb4eeedadf1bd52b7eef3a6bed70b61e3d9199f8ff3d1e48d135c6e026faf3d6767efa3943adf0e09abd96d6d7d0d9e8aa6737afe131c8e1d942bff5abee215ff90e4a449ca35aa669e1447edf021be173bf05cd26ebef685fd2a7b5657dd99962a77c461f470ace4a9705037e9919997f3e4c79bfbc97dbaaca7b2fd7f4a37cc9aeb47e9cdfef7c7eb39bd86d37c79cc9326a3d5226f7b2513eb50c55ed9be8955b63a14bf45470f664edfffc98c7d1949bb7e1bf4f489b06f4ebba896ef88c7213d93dc9bcc75f4366e98dd3f9cc09df3f1a6e3b3d87d5ad6fc69234180c3e6a0f5e3fa7ee48931ff7f257c460a408ec73164f24d2c875fc415f497412f13d8666aaac3ce1d3ab016d597076b791bb581e0672a9e2a88263e9d55fedcb4da535a813718c79846c88d377e7fb1d23e8d5d918827430ab37936f50db6ce9b8e7b6a97470fffb7a6e84eaa662ea676fa297227883ac93eaedc1eaf9d4df266084f4df026d6b15c8c1a0692361ff16401fbecc217b71aaf3f1e7a1fe8858a69067430eb1341cc939e74d815aa6c9da149db5a62cb710a33fbfeeeeb46ec9ebb98b3ef39fe5d54d4d706f3872eea0ab73ced82d6f6e560795346f8f7e9fa8b5cc2b80111dc3a566bfd9859f52b5f0f35b7d59920fabb53228fcfc16a54f99e7e2cfe3e7ad259e91b456656715411b093508fbe87a86597dd314f1fdc4fa4c43fd80db1fbfd771b30dbd75c34cc9ac2675762ae8fd6026e05417f62b55a91c23fca448961ba2d713889ef9f766af5fdeec552c1e885b030f2dbfbb12d44b5ad0af12d1860d3310fa594d696dcee939c89d9f1fd0e0c95940fd28920d79eaacc86711f329bb8d13daf3b139d49dde86be5e2792d46ae1f59d7e1eac31b98ec6a38bf0ac44bb62430f505c1363e973471ace6676145d9882057de7df0db10eb37b7ddaf0faabd57786709a7f14cbf3ce7e37ceeecb72cefb53117b3f956ace7873b2ffefbb5df849ef1bf7fea5eda3e3eba2a3571bdc5968b4dc847508053433a5596f89bdc3cbf1fbfb44e0d877befda7e98cf0184d1f09b32cf1672159869f5f3aa1cd40a7e50c77b60ecd8db9213d8667a9ec26465e42336c0d344f919a6d529706efb5743e4ee1df52047f4e54d4b7d9c334aa5c2c029fb0b483e8666e2e464adf19e62b962a0c033a04ec2dee2da3d189c442e7c80372462af451955fffa84efee77f324f99e5e8e778bece3cff5fa63b9b649e3399a74c67e48ee16f7ffffd9499d86b6ba3ff692cdcbfcc85311bfffc6bb13246cecc5e3fffb59c4dfed23773d3199b7fad0c6bec8e567fe5feccfe99fd6fd776c6abf5623eceffb5771dfca1f7cb1fc6c25d2ee6e3f9fa87f7df7fee57263cde9eff7b017f9ae3f5c87656f0d7b9f72209bf7bcaacece338f35cce0b39e129e32ecc71e6b92064d15f7fac6df47b212b7cf9ef5cf6bf73a5f79cf09cfbfa9ccb6999a78cbdfa61da3f33cfff1e39abf153667540cfac8db799e72fc5ac5078ca34e78bcc73f94beeebd7bcf094e938f67c9679ce3d65dae831f97cae547aca0c6d33f39c7dca88f84fe5c78fe5c8cca2bff74db85bf62933a0dfb2e2ccbcb72e64cb5fe09f0b63b6ca3ce74a85a7cccbda76e135066323f39cfb5a16f242b19ccb3f653a2bf89fafb95cf96bb12894fe7ecab423be5acae7c857b37f3f65aa515f290ae42ba5afc1dd941f3f36f3cd6a6c669eff37fb947dcafeeb6f387f6bfc135ea7061b95f96bed2eff9a8ce7e79e7ce629d374978b9febee686d8568ccffbcb630bc0fde473f27e3b5f7f7fe6281ffd61ead0d2bf33cdf38ce5366b01e3963ffdcd0bffae3d16a31f7be2b2eeaf012e4dbde93fd7fd6c64bffefefe3d59af936fc17f38bf6c2dcc0f3fe2f835f3f9e27d09bdaf3ccf3fae766fcc4ec1a7ab5f6c224ff3572d67fba0b13fdb734feb9b2d102727f0ac53fb319d8fb257a1c61ccc4a7e2edbc254bfefd943147eb51e639d3ad950bbabc9b40369437e117268416c0330c19285b0db2a28e595b92fae2dbfbc4ee1e0bdf30f2988e00790e2a055dde6f8ce3b2601cb3f6a8d1cf1ab5c5f62d6fe6cd4331df3e14b7866b6cdbd3975d7b5056df87fb772ca5ec90c4afbe4cc6626ea5cfdb5ff477ea3e4207bc611bdd758e66b538d7f32a4cc3da62cfc71675fc54c8bb94f66fd397f8fb626f8917f52d6f000982f56536fabbef7669abe63b07f550dc8c944ef6cd35b7a60dd3cbcad86ae7bb37f6c4949bae95351b2f5fde0ee5a9916f4ddfdce2d2c8f7369a5016de84e2f14dc05eabe3eba65d6d86b446b35af1adf63e742e19c244e17a4e6ff4cacd793da7ca7be88ae368a7bf838944734de9a30e5586a771ae7d17c83444ddf5a10bc4dbf465d3aee6d6d071dcc8f7c1aaf4addc44ad5edb2755ea42572aa2bd4897abee30dba9bc572b2d847069cd6d576a280a2214c18b39e9e38e21a89ad9a7cbc86ae6d073029a2f44ae8bb95fb00f11f71c89e583492aa8894752469ee8ad0e9393bc0e8ae1ef9ddec79fb6c53c837cbeeb3aa8729a7b6f483795a6d8b1741b75c2cf190299e48cabfee596832b959711f763ba1956a03b8ae555954377f6e11f217ea2baf37b9d11e94981d47e34daa4eb23e93ccfdcb3be53e5d652179decd89b148432c9ba7661d24315d5c11a5501ac6098542d41f716c7f0df4f5a77e7d0c5dfda8ce40e99dc457ee7750692b37fbc552bdbd06f429662ab187a6fef1c1dfdbdb081ae90a6284d476211aabe57a1ef5551c7188bf93f3c0911a60b7808ce43f55ee71b989068b8fd3c4ce16b56addda8e11cf17bc3fb0923a59535e5faea9bd7ede6a80b5ad614ea07e8c2df1b5468b47c18337b041d8254f6ff3c0f125a27d5dd3fb84f9545d6303db0031e8615f9bbd6900e80c0518723b1bc1ac99d2258ce885693691875f4a6aae42fa069e71847876f329ec24e26db0859f02eceb4f782d7f5688ebd69f4fbc1bde62f7fbccfcac3fefb824c36a0f7157b42e0fc5e27a6503e8c3c7e80e8db1c10bb71284c7ade5e833505327bd514697a42ef83a7f1e42c7d3ea3ba59b0dd8f8807b90ff47284e83b740a439d2ef24de8e4443cb6590d4f9b23d975e4f2d6ea4f7d0baf19adb7b5d5f3bdd4bd33dcf2d6ac9d5a4c8c3c8fb74263bdd139c714eb33e80a74818518a74fe23a27f64cb905344ae86aad297d4b13eb59f5fdf20eb7c3466b6bbace4c53da7026de3df039ab324427fcc95d39d5dd531d22d0b428cb74eb4b5dacfbd350037a087737092c58ae8924c9dd5ae22cec887d54a7c9fb86badcd5219350832eccc7533aaedb7a5eca623edf31f7b274b7375115cd1ac97b2b98661ad73d23e0251e0f1d8f651f1975a8f2458e38a23db4ccfd42a69c5dd99d3ee023261248f3f1f7b9476f86603911d35077b7eae845af0fa2f26635b7d6853ec1a81fd6492cb4af04cbd9f7791ed9ef8f78ae1fc9742561a474408eac47a8d35c19a658719d63041f9fe5313fabc3505c9721a7e5982e6098f2a6f96a4137c2ad319f4dfa72316bcc67e77510f59fc1c83c2f02021d126122e206e34d141d35458802575660cf79f224781fe3104c81623ac10632113af9d9d079102a5e21fbadb330dcf24617f65b4d7080df267abe35838e54dfc41944b9564d31b735bc8e9053e041c0499aa22df5bc74a49fdf14b5a531ef64bf7dd229b1c9e7863a225574f011ccfb8035619fd0346b547139ef4395114cf639e2e9d6b0874bc427f33635fd2956bfa149538657e576041d304219e6e0253731d62e1f34f09657ade59b3bdcbc552b6b3ddf41f2501510d6868e9cc2482ee78c03f57cbbe28e94d6d1acce78a69b44e9d3784c52e58fa6d3574ad5cf795930219b7349adbb796ef6df36e13d48e433ed9d6f936572aede039a3f444e00bf64cde7ecf71dbb4bd2eb850afc957d6e86d5b53a103ad86a83bbeff14a170876bcfff94257cdf1e0eecf055b1230e47184aae173e43d62650ab94ede73b248fc7eb37af53a1e93e31f93e37ff7c9f134eff586b3f27b7f902c4b2364eff64375625c26204d0f717be1ad3d472624107b839a9eb636c293c9d778a2fa7a244845233aebe708b24c058c6f570a90c933aac5e8ab79786af99b82279ab37ad0bb2f74845d69d5e09ebd4630fd9ed6619856c8ba4211735f3e611f569ccda5baf5e9e89003ff4d565322b2f7136ca7547b29b2132b9a863621d3d0383bb24675628d99e6e7601e2f1eb1bf899ade0a3a47730c172ad8a5fb4f5b427b5d217bedcb955bac23ed3d52e4caddfd42d424d0ab711c5e5be4bbe1cf226d13fc998f39f17ec4ebf7c0b608f6265d5fa7eae818bd4c7c219381ef0ba9d4a1cbfff89d4f5747ebe7024c40ce827d8be31f64dfb223b9be6a8a7b8895225e32fc7728c284d76d9a7f12f92390cd0f3e22f03358075d286ea1fbbd1fd781cedbf6cb6234283a6ff66ea2cb65b0990f9adc01ba802905e033d89a4a9b7a7e61a2b9cec1c8f796f7e6d9b47340bee0d77f804fe61619b41f3eb581d6a9fda529ee1d4eaccdd240149f276122cf87af741631d8e7f6be6c1732f87b6b5d2ecfa09bf8dd9e3b477406677fa775e2fd56eefcdc39c46f5eaed63de7ea3d34fdfbf021f6f4c30e7dd8a10f3bf4b3daa1f8335ebb0ccb890fc799eff09ce3157832648b0c51ac431d5490cf12e7f1803cda9ae2303cfd3f8dcf52788b9b9f808766c85f1cd85ba16c7a84c1aca6b8df92380be8475d2e43c55f6073bda7f3cf053616574531a9f84cd22bbc5d6ad3abf2122785473e1baa6fde147a4f23740abf3ee1d62551f43c02fb4e696535c5ca86ce99d3664aa1756fb215d842681a1aa1851e9ab046ff5b9bb72c4df0fefe4dac4fcd8664e9d5dd4477cba093498e1bc44fd054224dee2d3fc964a2059cb531ef3be3466f4257b45053ec3e7a1f78df3b3d4619515d1a2d3783cffdcad679cbd13fdf34dc8126efb1ecb99ea6fbf8df2a74660c4ff971fdca22b9bc31046b6954d139093035174f5d4453033d5d5520f9835315fc0aee70027f8ee4e20c6c7f934c0df79e81e8a7956f4f8c867430c4f291e4f3b1ef17bd7fc9bae16c1dc1e22d426f98de3fd51ea5d9e9ec1ec5d8ebb4be0a3ac9505826b2d34cc8773e1b414e39f0c620cc23d0090df9867e1d8f7434b9bf54f139dd60ead72bfeb70d398f5eae62ced144c7c21d4aa81c46d0bb6b27e4af7d4ff759b16792b26e6e1f16b9c097f5ee9d193e2be2bbc2fa466e3906f645e1b3f5be37082648231a46b9f42dc710ca3903f220ee27ab93f07b34668fcbc10de70906ef908e512ec2d8c38664eb6422b20032003a14c0c4d4dcee8af8c710ce047252b58197538a3a1f0ce0ac205f3b9011cd866919870aedc3fad5f9b4a86b8191ef5bbaf24270f9b5eb497b9f64fae3ed08422ad0872897ebd43e0ceee58c1b30f1b213aa9e8df6812577b1888b77e07a063465988a5fdc965722fc90f4733e2646889e39a19e39316fc333af2ac277289e86ba75207d2b869f47f2d5ef1d6b7847b9c4152a97b8928de093b3d790f60e640d67d8ad91b1c1047a2417929f609b6972f118eab211ed03823a0907c9cb412e3f52fa8b37e05b59fa0276ef890f98a916efa13cd7c0d772e378a05f1f77873a8e575faed1f58957ea8e20973bd0f1019de009df906b5ce5c0e629989c1b8b03069fd1b2de8f0b84f4c459ef7eb7d8563cdd31b182027c6e08275d4150c785a8ced2f795f9a731998f97f9e5f75eb6de0e9ff729968abe178363c8bdc4dc5613a51575cf7be31e52e347f32cb229df739d5a6f88e2df338dae47b71ff47a3ebdfaf9cf1f8ee5fb948cb964a2798c5f31a0a76a65494f066f36e8fa77e44f80bae6f077a89a5062db51f5c3bf4e76bf86a69813f95d096179667abba14896eead6bd614219fa2be61bf83d638f83df984b93f438b513e4884b722bba3c6dc2bb85fccbaa3ae54995de590e10e7ad7f3eb9c62e5b8b3d188ddec1a38ae19f85b4634ffd9bb892af7671063320ebb09f41518414d4ebe39d1f3fd05d00dd8caea2088879ee2a2943d4ac6fa57fb68c805b68014f2d17cec3e9cfb9e29f6027b857828caae662f448b736903f7053f9b0ebe29445bd291939fae7afed55d01932ea2c3e6d25a535ac41fe27778d3c472a8931dcf956a73f05cfcb886e72a19b562a5f9da5f6a8293851cb577a185e23b8c4e987c7bed770783d95724df06a887c40ec79c40c6ad9a0da8ff87ae8afd05eae920ef577ade3c3645cd8b2d2b70369200cf005e500715d794f7aba6d8825ceba52e980755a92c4632ca279c42ae1cd03cdb3780e7c2747fed1e61bd75e179619da6c9457887c359bfc7bf8decb416f57df677e7f070909bce234b084e8cd2c1ecbe05b1eb8877c0f7a37d6fe1f5316b89d2e937f41140bc6c7707ff400f9ea3418dd435b9c1a4d3e4a0827c2d90c78a70ec6037a1b189568dcd5bf5fbe6bc55a1b3682b8b7bcde07c9f3bfb111cc8f143b1ec22b1bbeeb2c6cf984bebe3493f9f8c23f7b1b98fd34567e99c749a4ea3ef01c969e4f479a5f9bf2ecea13bbd57f4fe50b28267dd1761459c9feee74de25cf499263ab82bfe0e7c786bacaba07e00b0068ad9437d13b6bfb29a9cfd5cb5e131d38e423113a73278cf75ea2979b31179b631b60de1c9243f37f53d5fd6333822a9162525ff0ad95091f9571174c0cd7ffcbc17c57728d7e54c9e8be2b716f1578c6524433d1af4f31abc78df08f5e54279d6a8ab3e950bb1f3f5db60876817fa2c1876658bfb9f6d5577e9a879aeb8264bc3c936eff9b818fc7e64df90afcff713cd1dca7eabe48c7905a6584c54b988faa1010e4113163c2cecfbb5518f45513a988d19f421b38c792f64cfe9185b9f63cb71da70dcb61387cdf6bd97453a28c966e37e1eb78d362bd6de8196e3f2dca374668c6d86f4a67086bd57dbf3ecf14d75027b751b2f80eb56aa0cb96c886728de845cd7fe4255bc7e79a6e810dc837a9b40ecda141d01f7a73a34456fc2121d5f845a1bc35d23ba36f2501f25addfbc5e29c164a66a650a7d92e8bc27e06ffcac99266b4bdd1d62bef0a6366bae338dba37b213e59ed74fcecb25b38c7c670b1350505f41dc7dff5c1bef4cfd17274bd2e571a42e39c3963bd78623dfe7e1e1149b8dc3564bb5d112f4df327672caafd37fbede1ac9d2e1067a502235e8619f5d20eb195b83e8b574bb8939ef38fbe96c3b8a5cc8b71fe9cfbf7c4d697612bba6047f36a6cdcb7219e7edf0a4b124bf1ebf4c4f8d2f25c59990ce52bc892237a0bb8a174f908e78a2c41efaaa8e64ad88f2343d398af0e739360ed9f314de3a5b8f815dc3ac9fd8ee1baacfb31f2ba231a567cb73d8e077a22d154f530ad1571226b9137de1294fe7c56ace8dd1e49b136ffd9e3ec76710dbef93e0d84f82e1bd8972478e18cce5ebe47daf747c41e898f2d331f729b19f073e210f33a5d4fea4c55db8638edd06c799f9f4c6c83dc77bd71bc8c496eef6519e9c9fd38db12365774e4762fda0425d8ad2827e8e4bf5c0e1c33c39930fd0c5c13e601b93d410f8350ac4cf49e961e8b75d9fc2c429e8473092db9b3bd220fe7da45ff3cc7c0f54ab97bd53ee5ddbefbf765d8e07d5c76d373105273baa32b68b48f586c67699dfeb9dae65bb7ffe76680f3c3fe3eb4417cb44d6adfcc94e4ae7a80b9d25e46d6a55aa6f32cae30ef5e5a77bd8cc787c179856afce538df3cd0534c457cb1fc4d96fd70b37b1c6d3cb69b87bcf0243b4603ec4dd9f6b2aadd5dd7b4b3efa423cfa423cfa423cfa42dcb22f448a8d38927b9fce3ebc24b73589ef24b9b884dec96c2ee76f620bb27e4084b9af581337f67acba761dafb63ee37d999dd1577db9501d56384cf6711e5ab70280c0db9640364177a7396a8f585673a797375c8bc20a06d6fae4e313413e4d7e55b07efddac53eb23b9a3f4ffa135d3760889bd90794c81fd714f3c9e5023905e37169697dbe829c409359be42ca2de3326d6cfe23a22c771cf58da3792ea13e936526456a40f04f78fad5a5bc3352ddd75be601ac173a9a036aabcf9765d2e18d3831bcd3e58a218743077ec08fe6353de5b86fdb280d89e9e6fcd55b9b81cbb243e083146c8c1ea6c757b867d6530c55882a9bb82367859e88ab4324567a743ac1bbfbb7198dd978fced85362ff323d83515f4d8895be552b0ee496812e30205e9c97562a9a9901b67cf9a009f5cd4859121edc1a7605721b8eba5058c2e7743f58d31d4ebe89b99cdee82f818ffcbd9cb779faa424eb0ffe9ae7a4b907acbd9184ddc83aef6f5fe55b734ebb677bed1aff6933117cf9466cb1c3ef359327b0933870431a86985d9c5f777aafe8fda1f9367ddde7e3598269e99c4a84672fb68d79636db4cce6387f8e3cd88b7aa50debadee303b4ce1c753fe7d3b26dac4a9398ef87b3e3fc7609538595b62717e80cd1d364e93946bc5cd7fdcbc17c577b3904d741eef456277693302bfed80a97d8c9d9b57b647ae34356b9f239ecddad30843d42f5f13ef3ba460f4d49c9d24da8ea1e738ff239ee1729f3adda187c527c370ffba533a8db645d95821657f4a68162a65077aeb0ad9808c5d45d5e75233274e67b426eb87649dc0af0740f6f7d03b0f58bb1ae5f39cac15db35134d94dc915c841cc1656826268f0eb859cd437cdd0e835fe26a717dd9c8ca7326bfed636bd6e7c426ba072f78b6cb15f45f096c383f7689655327cbce3fbd372d639bc2f7839ff9ae69cffaac369467cff3cce6ba41ecd110acad31efddf599e04bc0347caf9ef0986e7ec92cbdade11a68c6cf47d87017c6b13f7acd942f12edf9c93ad8ebe4fd268bd8efde68be5bd2fb430c6ea9df9f567623a0d9c1dd9f9b33dc5f907f00f52ff7df63d4a3f643fc383e06f07d137cf22d02df077b19a39fd8abb6e7b17192e232b4df80074fa4628b472de6a316f3deb598f8b3c8bdc09ff9fc8af73dceae05bd4dfb5952fd2ba97e95487f0a1337b82617312e4e23ee1d0dcd40009a73b26388cdb8e52cd8bb7efc637005fe3fa5d95be0ff2e13aff1ed0054831611cbd145690efc48e235cdaab5d1f33d888d126cbd7cab92fe005eeeb69a87f86fd1db6ff29ccf58e79fc4a76ef9a01d72a4ce98f16fbe7e442ce43812cb07b31af7cc978f78e603473f70f403473f70f403473f70f40347ff3e38daf76b8eeed163dec1be3ee095933ea5a73ef4e87b32187776daab14ee43e3e766d53a9a7267d114eb683e75f3b53ce8659dd76f03a8bd4375ac16e41c1a8233d7dd3acc439a1802cc1de5abcd4de14f6e9e44f62c7e67c0ca91f1cbd78f5f6fdafb92f5fef21cc4a49af194fcef7367e83074cfa7afa877e090dd497acbb353ced759a97598542d7a305f294c5faba6589f690de8f7d2063b75adcacecacb992b6747a274688a4023af97d56dd0323321c676518e217ba11a0ed22f17c55d3f761fd26cd5b87d4890bb2c0f52b47d7a5f7c3f9a7f62f25fee92abebc596ee92c7cefa274e64e9e96ff8fd384ccf0d17e5d746f9771a12cad5055d04f719c9e606d7681e55c1727479f8abfd3667ea98c85cda874e79e894874e79e8945fa2530c9c83fdf1b64b307faf2992d9c697da2c609b9497501763d8a81f1bcc9740fd930d17e701e72b501bebb2b35e711c80eef3b65015b06d506f6e987942f74349e79b143ee1e60b5c1f7bba477e7ed016f59a549adebc6ab93041b8446911ffc20ee2739a5db198b973a8c7819eaff8be8891583f8eaa9ede859944746f3c0eff03d1a7d1f2f6feb945def95debabbba61616fc237613f5ff4435eb4a3d37525a0edd1b2ed27732970ea3418e9e454efb10b8f9919b2f93f8d3b56056c1c114a58217abf0df898f4f53f815cde13c90b9e5147d22594dff5b3ba8b2e9d16ed5b2d47c3fa7cabb55532c6e2116abe2b910d05f14e5158bd20cd78c31fa345946a7e8feb3fd80e07b489ae31df41ff9d87de07def14bc989a634dd15bf039ee75d61db4d6de2cdb9ca7b73f5b3fa659680eee0de8fbea79b4a7f73cdd1bfa4c92d7cd6fd3900b6c1b76fe70dc1c697cb61426a3663f23df9f2b1df5bc7440b283176f113d9a70f689f8ea969808fb01312dd0749a4a9fa97499e4d7b52be7f4ae8eb221443447a46aad34d9b4f4ea0cf71644f407186909fe1ad37566d04bf117e53c4f509f445f266a395ddc4dbee13c8e6f588620ba02df2bd039f4f39591fc4ba7a7143aba63cebe2fe7de8e91749c646be37546be27fecca75b4ce33171463a7fe2641f126ce61bf98dc8599f7e97cf5fd49f4b2bbdfab2c0f92f4bbc2ed4a730ca67f4abfd41e7d136e30bfa3dfd3cdb5f6abf8673fc3fbc2e25f4bceb7a15808db4069a21b35cf0bd7d996dd8154bcdb7b6fadc59df99ae4339507eadedf9ef9cf6cc5bd992f1b446ae84f9e6213f63ac5d18af0ff03a23dff3cc78b2a5897de86171bc83acae90675d8137a0c66a8b667d572bfebbdf3be6db23396c7eee05f20f1d4db14efb077f47df06dad33779bffc15bd0c5501f269ef53370534648a77ab99c2340befd0bf578ee714ea2dcc46ab78e1f3885e8da3adf85a7b3c8f35eefd93ec19460645eb9654fbe65676f6c7ce144d93599cb2eb6c19f6d967a8a660ce48bb294a369e317727c94774f6f3b8e7eea4c52ee92b65eece482c47f7428cbad2b16ad275369d25ccdc59e842ce6174e6ee9c77393bbe563dc55a3cf228823ec367c1f33bd0d5209b613e839f73807b940f5a793ddffa49e3b0a42b15a3255dfcd83ce9f2e6d736ccade1ae2859007d0e5ece78177eb9907016e7d232a69bf059a4fc0eebc3303f27fc86e84fcf5690226c63860e4db9187d8ef8f324d9732f5f253a673b07be57e79e3683179fe4d4e991bafc7582f25e843d8e75063e9d10edde33fe3af3e51ef2d3077a98f35d1fbec9337d939de55d7a4fced073aea055f4fb55b3d1df9ab81e4f13a4ad966f59866841aeca0ad91bde3ea3b83aaab187773c0476f19d7d38b59142cd1046b6dc6e628ae53ceedf38d7e4f2dc70ebbb11cc88917750873387fbe15c08944b837c22011e78d0f8b9343eefc01edd4336bf033d5ce36b1fa2f31ac2bcce839e875e5090870271e9fa4695f75b0d72530e17d2cd5df368bc3d27b4ffa1eb7af0c399fcc0f85e3e9e2f7a7abe9385f3eca1be6557f087ef532c1f9af5656b886631f49dcf43f7be2fb6a60b14fd5ff2de0fba3e8faedd0ed4bc668df95df00c79d6359886dc03e7a683bf08b0ad1160dae39dfdf84239a7cffb87b18fbbf9de31ed192936e97f5ccc14fb06ef40a712922d57c497ea0847577f1986f69e5f23f448f2cb7f3b391919732174f08bf1c04217eee2bb18824f05cf05bb4a6e92de2ae06f545de9d0145bc526c49545c9ebcdc0918f7a4bb92989d24a53bc7d243adfa393fa1462cba69cb3c1ffafce671333fcdd345ec06779753cf476f1f9883d48c945f5f214637ec7456fec954e7f5cf4f8a81f7ad40fd1f54327fc92e4a32697478bdefc7be86f8c78bd8570ac71e4e39dab9e4f2eef3d404703c6999a6248ff84703a077d33f1f522c8a703deeb89e9d65726c2dcd636a65f7cd2958a7578ae8be935eaaaed7750d3e4c50c2bb8bf8f2fb7cf8dc131b2fbca773c937ed9ebd2d81c8369e262745bcd85daa57f0a0da0fa6017eacf1e7470161dac75a1b5d4a3735c7e433a789de872d91ec9687612c26a4d116460cb31ec33e3b9ccf9f1604a8e733c37becbd0e259715ec646e68ef732bf3b47a7a6c47f99ef11db2c0affb2fb16190f66ee47fb0f98f5ddd54f80661953f4f1417699349ced2eb7c71c69f04e6a5905c96e363a4b549b996fc27c799881bfd194dec400bfa640d533decd272b0dde89ffa07ec1bba6c9ee14b9fc2bfd0ba6d25ac6d7d9c63f0ff34aa45d893ff3fd08782d71f9d4c806bf030dbf866db1cb630cbd4105e7de57b22007a04eab2932347f5f1fd8c9da829c08af5e01d50c3724a8a5676cd2749d93a24ffee37cb3682fef306b4882e7d4aea253881991fa4ff0dbe4701f022f8e03bd88c5f2511b548ee067843c55f550591a87ca4c53a04f430ecf1a6a15e9b95140f3de3da5a3c6e377b8a9acee3be3462fe8b940e5fa50343e2534d66c980b3ddf719a8d0ef4f284fed3d3a6e81c815f4d513a40de3cd4c37a7e377fb6323cc3bba7d2f9a8de3e90b35fd0e41ee47e1fcc531b997bee9ca6688e3177b2239023073833b427219ff10de5b53d925bab915ca2df3715a3771b09b2cec70d8c8f6dd65aaad0fb44300faa52598ce436d43ac3aca8e50d64389edde36cc0ef0a33984d01f28426d0affb083a1ece5f756106ab13e4111d3c9d03ef89fd2753c8311bc9c5a9de90a09feea65947bcb286f8ae5e2575e5ce91ed678ee8b0216da8f957f67850e90eb39dca7bf5ce7cc5b1d744a778f2a30eb2e1006b021e1ba15e8ca06f244f3688a7f763fa9c4f54016c6967a30afb1cccb878b32b43547f2f97671af49000fe13ada571bae7f07f39dd0d666803ffbee73ab5de70f74b6a883e88d7c057b73595d6f41e759343ba27c0c5b19757bab7c044553a475de82c714f38aff73d9ef709df833da07b6aa0fef8a2331da1bee0b389ea0e811f77bae080ce3a407e1febf7056cae0e2ad39182658ffdeb7a02f9b19d41853abb8a65e43b5b55702ce80142fafde0bec9f03d90e3740fa455c053396b3ca8d8daa062b1fd85989a11548bda14c15ec1f3f43f466f31fbb88fede1cbd8245c3566301f42bf578d19a2ddf67d9e3587bcb697bbd707024da17e94d7f65e3a7b6f033abc53edde01f2c147f79f9180e2279de9c7cc85203687417a973f66643f66643f66647fb619d939af3eb105bf5f1aaef4989bfd989bfdd9e7665378f9e36d8b9ee010d9c4477b91f21f6c4fff3e20ff21d7f868023f1c909dede82ee8cc32f4b9c37eaafa460bf712851c871cf4ea437c1a61a7fab3a7ef6a7f077889b221a83342beb7e34881981aa23db7294ab036901b0ee4b681cf4a958bb3a658def9f35a1a9dac3197a66603fa1cf6a2fc193355e95b1fe4e3fa605b01f961593cb48fc2436fc7eb9ef58b661cf9beb68b6c94a47ea98476a3ce0b7fc6eb0ff77ad57ebc0c19c073aef3871f3485d8db3e5fe17ebc9dc358a964c372c09943ae5453341df075611fc616faf136c5b09fc3543a903705be0adcabb8bed284f201fc1d5cb97937952596650893c0470e71551caf24e741fa2eabf956d168f443f260249b8e91ef4d3441da7879202027cb02f81ad4b05f83ea8b8f9e3951e5fd5ccfb796a668adef29536e185b3add538aa6a9dc009ffee9354762416abd31982e3ed619f31e2998282d16da3ca151ce9a441e3e7b97a5ec482ce3b851e01bc63dad717ca9b5345d6976a66d769e7d76b98d46ecb4c87df2fce039c714ad2df6d9139fa5cf072305e4877418299aa3d5d2f980bd52f882bd42f9017136d007f477b9f8f91fd2ef855cb5fd691e1bd5f76598b52a6fefcd43275a3fc75dc979ae4ea7d91b640f9d7735d6568dbaba8dd35c2b2a67ee7df0faba6e1f27d9487fdc55eb1feedbf62dd7df6a0d5fa54ebf9add7712ecf5183a0fe7244fd37fcbd4bfa4f92702b981693ee1bbfcb968bece0efc0211f7c5f28acac961d6c7ac254a87e17b44fa63c8fd092ec3186e1fb205835a9ed696c9c14cf53fa4fa1da27d7ff09c2b6c3d2fb647660b196e7905369d7ef0622146beb3d4041433f5fad5c3fcc979bfc8a14f92f507bfbe00fd30d085bd5fc34be2a518071ee0332f5ed5827e4d476ff61ec47fe0ffa0ff61fd807a5edc2d7f2cc20e606506a19724dffebcbf34c5fd3f79d633f24fdde9593bc32defee1f9f811c91d52366f188593c62168f98c52366f18859fc0e310bb013802f8fe017d50639902dd0ff38a083da3ef55d2ff13d426e14e4721802e4030e274382dd786832522f00a6955c0d64a3dbc13891ce9f63e60eccdba85e82cc3df166d8d4b3e06f30dde1c4a0de0ffa6f980ddc8fa351710c8faf7c5c1acc8d022c8aec01f8fd0a6c3ab817e4163133ddfdbcdf7bf70d8fdff7c0b7e9cf701ae0994d8d8e637a7997dbf0ec87e211f6b5d9c0b35ce6ed8909fb87e6cb690edccbc857b6fabcb3545d6705bdd970ff1d98218e7c63813d129c9d562558bfe5e84073e85efd2dabff70de75c1ac72e8c04f87f93dfb2a3a97e835453796ae7df62fc99dfac07ca2409e614c15851fd83d6dd3722ec2279c20f3227041bcfce390851eae3f9eef338e8ccb5c8a23d3622af495125fb928d61291071ad80e9e7cd2c4fed28fc58a9dad8e651ef44b42feb3b09c3dbbef372d6f39ce3f3d9e425f1e5dcd0db79c33926dd8de70567eef0f92f931827fa37885c213a9581e7fcfe7e7f05af099c7cac084988ee76fca2db56af2cc48d8cff759e73b3bbb89c1bfe9f19dda3e4967a6c57306615c54217ea9534c9d7c5f46af17513e3ef1bd21bc11f062763c40b5420c2643b593e7d8af7cb6ebf976ab67b346ef0bb25f25c47b1efec138c0b7c5013f410c87c655f03d9085e7f027076fde0b3b67474a7f758f3a02c9cbe1be0a1be33c70dc53aebf34f29595aacc707e4bd1325d678ef9e9beb17507e4794043bd4105ef2ba2fb89263a4743dc43cebee5e522d585916c62fa48a79b147af947d59191bc69ea7d53fd0aa9fe84483f028a270a9dcb7347b6313c1bfd1efcb20a645415ef03c60ce4de09f47833db20bebe9bc1cb51f5e4b0469cfbdeaf8ee4a2c5f4d58c902351be286da98b12f1ed13ff53247d42adfa9b42db7b3178984fb6c5bc63bcace3917d7dd7015970ce8c501e3dfcdd9f49e8e95f17f51d11612fca076d50599ad54a5e859c03a84314c00ea958c6a14268cb9b47ee0e27a8f794dc07fb6832920b1375806ae5d16fba7376de6d67ab89c33fbcba3c54bf48e6cba2b5c0df5505cd6b5fe9d5c8b81cd2a79ab727f991286dc2ef0ff7ec3b2af4fc957be7fabcd3f9f07a0c412ee05311edf92b3a6782ef3ff05ce83cabc244c27590feec62905d3e26339730c312d5ca299de348363770bebe1f47041e1b4e4cc172743b742670cf9926c3394bb373ed0e0e7d15755691382742c647f509bc746635b77e8979173efafa8859d68938e96e33add933bc07bffdda59d7553e5d1bc50b943d797a5f7c3f3a0f3126ae73975e16ba505c8d0739e83d9ec59f7d3c1e733a3b4dee401c63daac7b7300905fec8adcde305de09cc306f59c06f59c467b023ed9a0af6487d4de5b883fbc7818a947b6c77efd31e4a1f93e66eadee1df849e856b9eb14c477e74b0a7efee47cf571c55705cc86bf6662d608c1ec957bfc7fea5ad3f453771e7169f53af40f88aa14f0edf75731fe53f3acb87749e1f89604d86df4e7b09f1f0641a7ff6064cdf21bb723495ca4ecfb7e81eb09fdfc73b63f425e69f8bd7778e3e3a4717a5db8017f9083e692e44949fe19173f5c8b97ae45c3d72ae3e47ce159e31e6619adcf213c6906a045b853079b53208f245ceb45d2379333aef05e546345a5bbd5aa16d11d4d769a4f48f4c4f787e5cc08909cec603080ba05e71075dd8853075b34ef928fd7aa11bad9b978f397838d15ebda18d897aa3e55b80f5608f8e215d7c8fba51e6f9cd571a0b5c6e734aafad570e9d91ac27f87503e88396ee76b6c6bcb2855a214d694f7a14be203a02bf57da7dc97bddac071a0726237e3c062bf3d57047dc3fa0090e5d15d02887ce8ec32aa7f8fff43bd1ef99e4dfafb0fd639b8d4ece6854b6c69c9e2b7f9a2792e64388e2771efdc0eb5ba02ff033f4c33c42fce417afef9cf748b1f7e92bddf67fe4c1a03c18da7e79937f97bc71ea4cee875ffc7d4275cfa293350ee19cec33ec0b7c9e29ebe7d71de4021d52a370f66448adc5b7314239c2901b53c2beb7e077ff707c6219629de451d3749c4abfa9741b49afd003b57c30c432d4153bc6a15253958a45729039754c945e19f666bd74ac9c8291b9b131c2c4a96b79609407467960940746b92146a9ef5419660bc1fcfcdf2667372417599f018f4d9a649bc6e9f0d0333f394e49da9f649f0a4d0f28feb88498e588a99fe3e55b0e9e4dcc6dc1bf8fd45331fec6389d4463b035ee1bbff4f5d3fbf2a00be56c77d02a6a4afb0eb82584b35f3519e6f63be7e89c4f825912d7f1d9f14a92ac5f6b4adff2fa19f2e1179e585392df1ef5e1cab7b2a1f7a8e666f49e364fd7c45ca77efdaa1df75d7c7d68dc8e5e0b475cedb63d00a72139f8dbed5dcb5285d51a665ea0fefd97f5d4488abba4e18f129913672852108f71e3e993a1dd9f9ae21c9bd3683ed545696aa29a526ba72afd451c5fd07b72b2a7de7e82ee5d69d54ac100b952cbda3dd109ef3f9679642d57ae91b671d723412a1a876674fe8bbc77d47c7f6bd8c1bbc5f049c86e666509da33575b6af27e46edd7cb88897163390bf372f726f1cfe0b5f3dbf41c72883ad354cc978ef5a2305e0b9e0df3b7683f7df315f736a2eb57d27c5291bea8fd5695fbc753ff53e8fe136ddedaeaa81e05cfcda0724079ec337c1e716be7b6c3c03f3cc0e7d27c4dd7b9ef62d9d21aed13df5318f7545cc32daf9b627d43e66150f99ef79b05139dafbc005d99e233bdbcd6a1b64fc3a5dc71b3c4f819ac5981994fc59f1f60a778f7c5d89d3a3b52938779563a86fb2350f403342217676f02cc04a76490e8802f0df23597840f617e8a579700b5079dad2e22d90667bef924764f13e8c2f07285e2fcb39f69cf78d798cc67214c1fe44f50f749d2f3f8335f0f609dc1e09448bd48f1d507c59f9918eda5765185c875a8ed08c97b5c87e3cdd36a793eaa399e5f866693a1dc3a84d5e0b746de39f2fae66e29fb997d20b44c687516a2cf2aae6d113b16cc30c5394d988e619d5eed1ac20280531b9da291e7cc75227416630ff3d7cfc5f8aaaa11b477df7e7a44ff3c7cd80f1ff6c3877d2f1ff66f3213ec17cdc98aebdd154963f475f29e9345ec77890fe403d711d4a0c8b40ec2bd9b39de8df57fc4f203b9aef5cf10dc33f7f3ac7fa77a143a07fa263ae0d29e3c9f4d07d07d98bc78c8c579efe7d829e938beca6f3bd39787bbcc839e97768972d3a90cde739d7a8abc8a906f313884e0c1343d85bfc7faa098338f9d6f93e027f86d6296941e99b0fd284fbf5ff81673ef385f00f1f9856375b6d75b85f1036d3e633e55648fced7d749e09b0de54be1fe3661ffdd39788c038b91b50774c74f97a42f475ace5f52ed3db7ddd06d709c51acbd40e9e8ea45fd37a27405ea7f459f4d2bdf9e1882057e915553b47230531e7a92a872df52dd3df1bd7f4ada7c473c8c69b24e7ac3fa9fcf8d4305f75adf4d460d69adca85b5e14a103f443d4f114f9f534b44e46ac2d927e6f8a5e67d503ca3a0fc3fbbd9908eb80e6e6fca0ecc3cfb2c34e9d914d7d364057c9ca628c19ca5a26fefc92ddabecba11a01614866a83b61dfca1975b5e40c537cc7dcf1f6f8b83bd91fe2179a42fea02694a1668fec814dd7d29a0ae46c76e89e0fac7ee0e5193e4c53dbd3733ae26c449fbe40e6039ed46569adbad201d5b62a6dfeb936fccf23177a2ef8d0411e631fed4475eb478221bc393bc5791cb68fb029e366d31c7541cb9a42fd90d887a97acacb1c7e839be164f6ea365efee8c9c562b3d1d96af37e1eeab1df603ea48867dab846e01f85ffb72bee4869add13adf775b83c17c49d719f41775f1d1640cbf32737a387e973c336804b5b20dd332987e7097d28ea6b48491dc89c4b20972f8bcbdb85c77465d25a356ac341b1dc7986b4b2ad6b286be84301fd2749d9936a85486afcef16dfa9f4b2b86585e8de44e31d6de3ae75e8dd65615a4a37188f4dbc4f87162665481bf4de8381acc428df6a39f7bbf952a176ec10f735376666a5a4fbbcf2a4b453387ec35c0480324330fd0530cf9555c72efff607ec8f72ddded5b861bedbb3d93568037961ade8f541ec36b623041fc6fc8f73966f9e133029b3d9276f0e749f825d136c5bf8ff51ff9330cc1df12d8ac34065c33fd7bd7b8b7ef6df29de6f4bc80bdf3a6783df24fce242ebf0beaddc5e1fa23f29fc239d0d43a3f2cc64d3d8f332f24c607e4c57f8299de5bf0c71a368ded21e709e67a97e18ce8fcc8a5a6b4796cef649b9b1f2f9ce4a953f6cb2dd691f61ec9728e3777293aaf92d1818fd8f223b6fc882d3f62cb9f32b6bc64fa62ac19fd17496bf475f2be9345ec77ef10638e584f70b6bf65fd84d727678db120ed23e47937968639cef33a1a7ff4537bf4537bf453fbc4fdd47e9b1996546e7563f64b62e6b47d720edee4c49a67e34cc098217b71506931f5c7c4863a7b6dbcefc28133137d13145dfcee71f3107d9218f1b57489662bd175058d19c49717a69cb3743b77d0e4fa4c4579809e3df949e992e41104f3a2ce5cd3bde8314d1ec7f9ca42fc23b3bd5b72cce76b671cae2bf7f7d910003b75b290a7336e542c539c5c8ad5d9f344f7877c1a5dec05f79e31bc2f5f5d2b499feb3ab967e16d6a42939fc1bf0fd43d3fc897487a54e6a02668a5d1b46cdfc3b7e8f7c86c9d3c9f538746c9a8be574708f54e0b3d6f44e4495458bf5c91f2799cd656211b677fe491652932ecdcba9ac4fd41f93eb8e6147a468ec4723e225f02cd170ef362d0c3dc08cfd5f573a08d030716c6f476d77a1b9fafc3f607678dcde9fdf9e8fb2c5a4fa3fb533fdce977aa76fc3da36db9d709c30713232fed40e693f90cbf892df7aa299da9e13abb309d5fbcbe736cca64bffb7f700e74941e49b24ba166f14dce399ad25b07397be8776b340b3d9ebff0756ab356eda4ef9fe9ebc2eb8d943d497b16b34ff13a36651f4ee46502edd5f649ba85c42f189d59aca97261f22e4b8e59bb894ecda3fa4dd0198d36ca43f47104991f13e852323f26cbe6dbde5f97d637cd57740e24ee817b1378738560de1d9c877160f520c43d8694ac9166a80fe86579898fb8de23aef788eb3de27a8fb85e60cf385a35e7d74621acc815076264d76411fb5d8227ae5c0f91a307126b88f2ab7f569b83ae29e4c1006978e0e2f80a6f7d028dc338d67d769d02a95508c5d4bc7c884beb481f72f30672330997a7d40d91de5729fef94fdf976948d6510ffa79dda58f2cd809b94ead378458703dabcafbad7640be3298abe6cd406e40fe89b6d4689b9bea65a45503ecdc44bed24e4e7583793ae11e5f67c8838fac5772c83ce6ca2098cdccce17c098aa41d38837f352b72bdd61b65379af824fb16fe96279eead1df2d2bd19cda48e80aa73a2f61ff7c8c17b04fe3a232f51fed94ab8c7df19b28643ce5c1c7fc09ff9f806df374e6fa6e9fce08cd2f92c95bf92fdcd3934df5e831912f43bfc5e3383fcb95e6f72cb320469d6acfbf22e3403f637ed71bbd5dd7df14da167967dca7c2f42df69f6f5ee615f3feceb877dfdb0af6f645f3fecd1873dfab0473fb33dcac47b2087a3d940f6bbd72b6056aca1bcac5f696b86df319cc768df67c61ab34fc44684d811d40c87fb5b53f816e72d64713c6aa1e7511d9ead0d205ed459993260b8ca029e770eaee0c41467e309dcdbeb8cfd8eb7394ff682ea210b3e2a552e92ba34c6ceaa1c35a5476adca12f4c0ef28e547708fd80a77a43023e9b403ff1cfd413e633f2cead737ea5985e52a15e2ff3be05fe13a8cbd285d6ff83dcbc33723892edcff3ed5072813d1a99eb7bc59a78df21d96ea56933baff744a3ebaef97597f461aa473c4e8fdbf8bbf7040fbfa42e7bbf9243419cc3e7f0dd3df79f33163d7782f1a4dc33231b9afe15eda6f4a5867bcc9e58dde98ad0d6ac6f98d7281a37c37bc79aff4bcf41be4bde29c2bb22724bfea344f71a10b4e4c6fc7585b0ffce247ed7db9889a6dc3d86b61ecab801fbde37c772787d3dca464bb167cc98630fc32aab54fec53def7ed542f7edfdce5efab5efabebb4b9f69cabb4b9fb9d52fdda37925aa0f0de76f71bf417abd443608d6167a2be1fb6299e0d137f9bf181fc12264f747f9720795f548b11cad96b5cd462b77a2d392ec38f2eeaeb3813a61985117c1b391be63fc997f8ea9ebb0ff21eb38fc33d6d1a9fe43d631f887ace39fc11fbb08fd1cc23e90973016a55977d0ca1a73e9a4df5c52be35d10d9aa239c6dc81f9371bed905bea6836cff0c3d6043df6f077e2fc68e0272e6872afdc9cf70fe6697d2bfe8da707cef56533f74aab33267a703d5226a13d39538f5aa7ba3b529f31f6508083fbc9efb7f56c94a2a5bf7656aad23946ebce087f25fe2c1a3706eb223e085dd8a59d5f74dfa998bee191cf4a38bf383ad6c588997c093dcb6f48d3abd1e026f2663a12cba8f7e2997192f0bdb8e59724684aab688a16c4e920660273b7c2982b7c3f7f3f62cf4038c59967f0482e0a6bbee5d3ec122a6763982cebf47c05f779d8d774b1cef65d4cb22ff167fe1a30ef30fb42ecabb9b44a93ddd4737dda8af5af479d41629d47dc99ef9727e79be4dbbfa1dc30bd3edb9f5276988de6af921d59230dab50cf3db1d307e9b1ce105d4c3f68ff44be18f165f73ed5bb64ffb1bcfc207a9ddc04a7a4e00ac774a5c358e92f2027ee4dc69872f05172a93f4b934b9f1e53e2fd24e714f72cfc9b74d9fa4b746f6e393ed575bc34733444693a92ebab6b74b51ae19b89d3b571b210ffbfb7d6103f61fa2074e7ad3778d714b91ebec779f83e6ebd04df44eafcd37cbd78bf12437fd47dcf5cdfc7c9f018bad95f452f427916d3579ad56d31342fadaf79fee89a67a37904e58d76b8e21eee29463863ef8fa67ce5ef95976b7ebfd465e3e4f7697af44466121deaf1408aae79bd89febcb99d9f7ace9dad268675d5997bede857f1c9ec9a67dbd7f018cccfd085e2fcba7b4857be831411e788f595dc0e1be173bf05cd121d718e2fe9f3dab2beec4cb6533ea58f230567a5f2e22bbfcc8cccdd911ac9ef57de919cc69eb0b78cfc87f99241263dceeff73dbfe56decb65b63ce1419253b857bd9289f5a86bacdcf89852ed053c13efb6bffc7c73c10a6b909ff7d4afd9e33aa37d10d9f526e82dd83bf13c77bdcf9b7aabccf9ed274fcf78d7c6bcad127d5d2e78e349ccd3e6a0facdbd0eead7547aafcb897bf221a23554fb1cf593c514da291ebf883be92e824e27b2ccdb0f2844faf063d56177076b791bb581e067269a7c99da52aef7eb52f3795d64672f1a829ad08d910abefcef73b9ed2eb5287dfcf7b37936fd0375095b338c7fb74d66c4cdef72df452146f9075fab51b1aabe7d37f1b8f11d27f8b68bba7f4a3627491fb3702fab09bdbf07a4f753ef9fc641659c05733a8f7964467ad0d72f991d25fbc41beb42c7d81de82b44ced36d272aaf7d1f9d90eeabf803e0b9e5541cf0ad723419d8cb3d694e5567787b7eaef76d014f02b38533c4f0ef522c0fa905a3bc393de6fa1ef7056535ad4dcb8e6e65baeb7f976b046ffaeaf7ebe49d9cdb743a43e0d3f17ef63c22cb7a433821ac5a57e8a9d92ea1d62eaa58a15ddaee0fb552c4decc37b1eefb0d7713ddad17b9a82057594ef8658873e1ffecc3f5528af74b1be3384533f29a2c77905f8c3315c6a9ea45df8d9ab167ec6e3819835543968795674804ed541c5d6106d34278658cf9aa274d0ed0fa9dff269f0e42ca01f3e9a43dca1cf8ac87bafff8744ebc4d339c80136970ea3c16de8ab59970683f7dd36faf3c237667d71cf489dc1accac5a224b55aec39a3339e9b0be88135cc572c551806b401fa42dc5b46a3739a7f14cbf32dc368acdbff96d6f8cffdec5b6eb756aa45f3db74b1f946fb2babdcfb97b68f7eaf14dc337a3a12254a6ec23aa0ef5705d531f667ed49fb382b74cea8434ed97f8a967c1e0bd699340f937c46cb328cf3ba8d13daf4755a624fa86be9315c6bb482fee3aa3099a84a1be65f1c34790fdf17b441451ace76e97c9cc2bfddc6297f3645e81b83318d5bdf8c256930a0d718ac333f129dac2675762af860023a9ceac27ea52a956324163a471e603a3184ce02faeb75278bffc93c6596a39fe3f93af3fc7f99ee6c9279ce649e329d913b86bffdfdf7536662afad8dfea7b170ff3217c66cfcf3afc5ca1839337bfdfcd77236f94bdfcc4d676cfeb532acb13b5afd95fb33fb67f6bf5ddb19afd68bf938ffd7de75f087de2f7f2c7f2efe6d3be31fde7ffeb95f99f0707bfeef05fc698ed723db59c15fe7de6bc4feea29b3b28fe3cc732efba52c084f1977618e33cf05218bfefa636da39f0b59e1cb7fe7b2ff9d2bbde784e7dcd7e75c4ecb3c65ecd50fd3fe9979fef7c8598d9f32ab037a646dbccd3c7f296685c253a6395f649ecb5f725fbfe6bf3e653a8e3d9f659e734f99367a4c3e9f2b959e3243dbcc3c679f3222fe53f9f1633932b3e8ef7d13ee967dca0c42af597166de7b17b2e52f4f998ab33066abccb390fbf2947959db2ebcc7606c649e735fcb425e289673f9a74c6705fff3359f2b7f2d1685d2df4f9976c4574bf91cf96af6efa74c35ea2b45817ca5f435b89bf2e3c766be598dcdccf3ff669fb24fd97ffd0da76f8d7fc2ebd460a7327faddde55f93f1fcdc73cf3c659aee72f173dd1dadad1085f99fd71686f7c1fbe8e764bcf6fede5f2cf0dfdaa3b561659ee71bc779ca0cd62367ec1f1cfa577f3c5a2de6de77c5451d5e827cdb7bb2ffcfda78e9fffd7dbc5a33df86ff627ed15e981b78deff65f0ebc773047a537b9e795effdc8c9f985d43afd65e98e4bf46cefa4f7761a2ff96c63f57365a40ee4fa1f86736037bbf448f236c99f854bc9db763c8bf9f32e6683dca3c67bab57241977713c8243304d0e6d071b3001119b02cb7a84bdd316b4b525f7c7b9fd8dd63e11b96f8487ba9834a4197f71be3b82c80f766d4e8678dda62fb9637f3e6a1986f1f8a5bc335b6ede9cbae3d28abefc3fdbb2a588e2ebf9e74231f8bb9953e6f7fd1dfa9fb089da3e1b637baeb1ccd6a71aee7d5ad3e6f6fb115bdd56529ab2ae45d4afbb7e94bfc7d29cb5b739d9576c673d09e84b5d3a4d9b0d6ba583c7e673c3050656536fabbef7669abe63b07f550dc8c944ef6cd35b7a65dde188732f65645bfaf6fadbfa2e975d065db32e57db63b68ed4db9be42d5b372efe47730754e13a42cb2ac154fd35cfb2e2c5dbc4d5fd6baecacc02a006bb24dacdb646d8ebdf3d15d02a05a9a682d52954dba280ec9590d2a1d4de95bbe7683ea689f06233b6c4147ad23a6b575b08e02ecf9a65dcdada11ba391ef83657a60ee07d9ed47660dfe3d47d0c59c74f5a2cfdeeb54883ae21882b535e6bdc84a71b45ebf7b4ff833fff35ab142773f846af2916c6ec0ba251347bc693a0eeef0d183eef01bcd75e66630699dedc4ee1a6e790ddd65f134fab59e6fa1c94da6585fe982797cab56a0fb2274a60cac79413a8c64e9e84d7329effc49eef3f644cf6b8056a1bbc8facdae0ca14bbd299677ba5c3e9ad54ae0fd121dd24188747a0c3a62d6adce7bb542ce6a3252daa8521f7961df8bfef7d019219934fc03772321de1072797b1fb3b7704fc32d6fcd1a73cf796babe77ba74890e9289080aee3bc6c6ba0599848af5e807c933a1a4475321836245b179d2946b613241b65b0d25ef93acf44759c7148b752387f9858829e89d16f1daa06b2f85c77a6dc5a8de40ea13d8f2615cd1ac9fb604a10d090dff9bf9855656703d9cb4d31b0b0793acda4749889b11ca2f6b199b26fa8834ccf70cbc24846bc87bae4c15451b24ef0029b55dcc995b9972a17674db12ea8b2b3a2a607015f92890ee08958ead079e65009bc13550ecf038fc512ed4d2557b2479cd38bede9d26576a4f457da806f2a02ebf9e18cec2039f07d5ec9a9ee7e690856cc24971b3fcf85284a6fadcbe519d1bd2751f493ae5bcd8b2350783f7d1dc4399989e58128b9741a51f574968f8d7c3d69dfee1c8937c0702561a474802fd623d1399a62f9301ef09d5fc4dafcf5c5d17f8ca730b94b4a52974fa7e598aee39dc9abb504ec001dcdfb72316bcc67e775f78a93b7b3faa6095d681ad005ded960fd8bf8d3141de8ccb702fc86747123781fe380ba5aa5c894ca52b72b07afea01b2623b0bc8ced385fd56131c38ff899e6fcda0bbcb3771b6d106855553cc6d8d3ce28da947afe8f74b3d2f1de9e737456d69cc3bd96f9fb42360f2b92119ef79d1e7fdc318ba9fd91ec682ce4bd0a10aed0dd0ac37e50ded01e293791b75b989eac414e89fde642417600f114fc374b91174a576cb80b99610bd856ed71a7468ab5acb3777b879ab56d67abe83e4ad2aa088fc0e7e3f92cb39e3403ddfaeb823a57534abb373bae9045e247ecf796a94985cdd46647432eee2965194fcb0cfcd628a92530c9688adb860be776db4fcac35030d79133ccfcc9cb84ef7003ededdf9995041fcf3cecf84a994cebdd7a90b042337cfcd32b84ac74384de6ccc6ec23bdcd80979b02b5b7dde5942579e37a56219f37e91a7ab387b26553beebbf8baf6ccfcc899df419663af9afba8a8e45991c9e05dd23a66264da91b902ec6b7ea3e7e69d7dc34db2d4256f2ac9bdba68be8188afd016412667da6890ece9084491de535d697a0fbc176fd6d27615272e6f7eb064cb0605c06526d4f77aef3a38c94bd0e93328f8658de1882031d03d658e6ad4782540c555906f73b82bf55052c6f570a10f91fd522f713b22aa02bf971842699e7a013f63953b991aee19cc61dbd8771f2ca6d59aab05a835f06b2166879942a832e9b885955e5ce4fd0cb288a7cc5a4e91ef203edd18474e0473c317a49780b7c9de0478273d5f3d24115c2d3728c0387bd91626370db15604bccd05e4fc85e539d2faf5fc7efe5ef3904937eaec63171198d6972e73c3e995376e0c7f3c880b2d19a60b3ebf956b1f9ead9f69c3a3a522fff03ecd453bf4778edc91d6de332b922f64f9da69e03eab2dc930b13e07dc036b007eaa042ecc12cea9620ee97e00781aecb811d5a3ca20eec29fe6ce4c741be1af0ad0d27aa601d74a108d93e73f097ebd5cad680fdb05f16a341d179b377131c3f39687207e87a62a0df3b5b536953cf2f4c34d73918f9de92675a4142fcc2970db1ba37d8f7443b99df2e006cc9677b5debdbc43ef9e308d9011fff3ca8b831abb9b52e789dcdefe2a39e239f1ea76fe46adf34797e828ffa23ceb1b535aa57eb9a33cff2317de6317de6317d2672fa0cc527c37aab3bcc0eed641fcc297dbf1d13d773adaff53eb812753d5dd159f0a971a96e2301dfc4c59f66e83997c7f263a6c7e86219c77e9af0f95acff79d6623989c7a67bcd6063f70ca4401e44345b8096231726e0b9548946d85dfe7b6b6145fa563aa5f86a76b5b4043544573406f16e8abe42aab081efd4c137a47c0cf4a2bab2956b6d9b8f9a40d2f9680e93b90a13d44e3f4bfb579cbd204efefdfc4fad46c48965edd4d74b70cb28ad018c443d0a4184dee2d39f8818f2fcee70f72c1648e05d0a431ef3be3462fc4d714ef7cf43e70f3f55b3ecdc6de6d597ae7e68779cbd1abcd890934852b49de679def80af581ee5b2f7195e48b0fb93ed7faf0261abdf60b21199a0a9ba10774758280be74ff20074bcf786602d0d2fb70ee52bc134aff1c0cb4d82ee90a343612289d6d23854a62ad8b9ee70027f8ee4e20c6c51c8c9a072b410fdb4f2ed89d1900e86583e6ae7602e4ebc7536d622388bd01ba6f74fb54769fa87dda3183d44611cba6a347c9fda9efd9cf68dcf46f2eb1af1c620cc235e254b71fe0b79a4a3c9fda58acfe9ccb855946fec15ffdbd69436f675e51c4d742cac17a9a95980a5d630dd2ef063bef3c7aec899a4acfbec9815c4abdebd33c36745625658dfc82dc841f4f899fe1e9553886818a6598731dcbd64f5ed707538cf2f7887748c928a4d226d5a2677135535c3042639b7bbc25f8b72a22107581b78b10c54118d64b2730cf9091ba6651c701ead0279eebd7bfb4a7b4c0eef7024e7a002cdd2951762cf5ebb9eb4f749a63f8e5829ce3ff2aae386c8d71dd05af5646f9c7103a6107642d5a811b83db9aade2e45f208a9984453e6c4a06b459a8d8b3ff37902ef4f9c0f09ba1364dfe41cd4ebc0f4c050e79454599e2ec3a364771b9ed914a96702569125983eb6366a7c98271aeb9cd016c8b655f879844639f0480a0ee1c61f803b6628177842e5024fccb09cc058e4cc357c80bd1a1dfb8ba747c6e75c80cf0d2154f57fea7776d1e43b2427de1473698a9335d5f1e1c4bfc3744b40d30519bfcd0df547e013a7efff31be99e0ec9a0dffb997fb6abc0e1b817f3dc09b81ec4453ba8bd95f5133d1f7bfd7a36ac43056a99fffee69cf26ef7e6dec2a81eec8e5c958c5827d3d9e5479a36ed04e543768e26b8cd73b8487a3de137fe6cb76cc976cfcc7c7f5a7f9fa1f8e8f484d1e7dde97ca78722f90a31a5d7f7a77795e7eef65ebed30cf22bf3da95383389ea357037a7ed0ebf9f4eae7317f3c2e79a5640c3b51f9520c5f09e15a6612b3a148964e309008f1aafa8699744bd73352b66ea896f197c86e76023792dfaf615fe188fe8e5da16b9027ea7c867d23a1efa035fea67c12be3f438b513e16dfb7a274c21dc7aa31f7a2ee17b3eea82b5566f3c87009fb1478e4368f1c1ff83e31d83bb06987b43f6249f3df1bd59deaadea77acda1a76854cda071bcba2f26dd36c5ff64ab685aff7bb930b6c650f9762bcffc1fb70ee7b927d8897add5581e8ab21dd8cb9f968deb65d7aa2b1d106d296d3e7ebaeef9dc1da5927c9c7117d6611b905986ebf126d5b167a60b33da9ee0b9526d0e9e8b1bd7f05cb5fdae3b7b9df445673e52fa10dfe9aaeed251f38c4eb05f16fdd772ebdbfb6a622a5033e81c510d20745e9a838cdb4d0cc1c1b159e433b77471bf35f3ed09c81ef0bb4397384dd1e0192013aca6a8e5747137515dc8d176b65abe6519a2b554a1ae4dee6c0d17f9bdd8da7e9e0bd3fd957b4470cf85e785f9d5d1e7d2daef95c0f9fba44e831cbf3b8b87d15ecda50d972c61bb4dd909fb16ed1766ce88f22f30eb63d612a5d36fe82380fe062f1fef1f407d1450bcf6aadc5f22879a0de46b814e67e8f7a053026c22cde2f35211ce3fe8c26e85728ee63d94d3db14bdbc857b6351e8ef6288d2c16c0475b8f758e367cc952578d2ef5fc29377f8896ae383bcb753dc997e3f06e7cd2eaed13a07336259c1b1eecbb022e044ba2e0f61c58b731dcfd16d67e8300edff139d31f3cbfb05319bce73af5943cd908fa2e25af2789e7aaf17e0986af63f9b2db60ba2e63d9d31db4d09f911df923f6b5dbe0c4dfb57d922c4df375a01c174e7d92a4577c3fc9580eec97209fc18bf38d64645b43dd20c682be5f17ce1de5738d073b54376986ed992dc696e7f0271f6f9ecf97c09364dfb0ed86f1eedc816ec6241f3767cc2bd0a11df7f07136805750cd39e4dd51f17623d06144dfa0fc2a3ddf5f80ef47175a28678a47ef30741aa77f22633a51b15072751b01d66b8a3947873c0207f49674c4dd9cbff7b24856db497bc7fb3ceeaea94127f8e8f3a5afdafe1457db416769a47f044e9b33b85fda1ec75ddc3827e9ea365e50bea22a430e1be2198a37c17eea2f54057400e4b3402d09eaf3827a9340cc1a3adce2be5a87a6e875b8079fa32a60bb5544b50588ae8d3cf4dc417ddaa0d749d0dd1ee24868a27990ef04fc8d9f35d3646da9bb43cc17de6405cd75a651f74639a9720fdb7e48365846beb3550507e2843382ed8017c8d4579e0bf3c3b9fa9795257cfe90135d5284671eb87e877fc3f824e27f43becfc3c3de1e40fd7392cc48b2014fa70af0ebbfa5f6f9722c2ff1ef27e94189d49633feecb87e6d44afa5c7ac98f34ef3e39eedeb41b1ac50cc81d85397af89176f72604d4c9b94fdc660b7da9efddcb787f4793bc86b4ff32df2cb746e7f7a642c94eeb17a3ddd55bc5c66e988a72590184b11d9b35edc1fdd97c73e67f73c85b7ced66360b7877acc12bb06bfa3813bf493b8188d29d1997ea2fc58154f488a994ef3cbe80b4f6e3acf968eb4a39d8d46721a7d6c8b7361f3cd89b7fe0ec8043fef31c8910dd789131cfb49307c0dea6c839e2e1fb24edef74ac717848ee37da325f6f3c04789fd61e9d3e0e226b204f74fe0234677279f994f6f8cdc73709ce47a99d8d2dd3ef4c6cbfab9dcc42f18e4134d4768ea5407a67b403fc6a5cad30fe4e44c3e401707fbc0c407fdda04cf6ea1ec4855b02cc3ad4fa1870bf48b18c9edcd1d69f0763e7577bff5ed0f8ac65231616d9fa6afa23060dbefa776453e520fd714a2fbd8bb892938d95195b15dc49c638a752f468ded3255805c8f9613aa61bb7fde76680f480e07aaebf4e860e5fbd295ce51173a4bc865d6aa15aa3f35e8e77a167a8143bea716aabb94669fd5671eeb7f74eb330dea1ff2ed7bf4eac531fc0fe913105b736d8816f4aebf733f3fe970f7753efa3e3cfa3e3cfa3e3cfa3edca6ef4332d6761d165327f9c5ef83a94ffc3a57da848eb41941fc6b10ce4ba37175389e5eb647ae34356b9fc3f71099b35abf7c4dbcefc0c16b89fed524da8ea1e738dd4161d5dcf21eb591ef01969c0cc3bd0678ecbc28fb6e40e1cb23f4b9a772a483f579987e0a39f85d9bc42224148ff368dbcba10ecfec20f729fc3a1c6e57a8f50d894e38593385d1279a28b923b908311ee83f4f61730e7f19d171bfb63e2c242f5919cfc4273e3aafcbeb33fdf1f667cfeb03fbb2d0f3adb92a17976392330a79550dc8f9ea6c757bc627b7237d78af13a6af2dea550871bfb76ac581394d1067863c4c2d2fad5434bfa18e9eaf09f5cd4859129adc1a7605e2f4475d282ce173e4fb1251ffdba5e90e27dfc45c4e6ff49760a3f9ef3e6ff3f43cb9251f9db1a724672cdc671ccdd040b37676134d76603ed5a1d9e82c75d7cce9621de62c582806ea9a96ee3a5f303da0b83dc4f20da1bcf9e6cdc80afaba2a9ad3ac5a6b98c53602fa1c90bd2c1ebfbdff66f6f19cece19dedaa7c6bce69ef3c7a053e7a053e7a053e7a057e865e810f9bf16133feee36239e57701f7b710839a08dd93d6c456f5defbf959d88f12d9f8d8867583cecc3dbda8704ffde81173c7be20afaaf04368f1f9bc4b2a99365e7b2de9b96b13de9639833df35ed59e45dafed0715a7d7a364662c7621b6213edb7bf45a0fcd9be6c4fddb6bd6087e8c3bae8ff823d623b9c7f1bc1bc632ddf2413b207d78573b5195fb33e4cf39dcf7b994aff117ecb5b48619c83cf41bb603aea3ad11cc321fdc779f4da5b57acc6bbc625ea3afa37dfb9883561f73f81e73f81e73f83e7c0e1ffecc978318ffb37ac0cfdd077f2f451ba9bc78590ca6cbf8f1af88b5c4c64d569a6c82bf11ecfd25d863dfc4e2d6ac56ac00efce823c3b641fd5a7c08f3a9601543c33912f5378919bff80e7de99f809c94785795791b11571ef68681e35899fbc2ce0ac3c3b94c445fe79b83e11f30efe2998feee73a4fd3e47777e6e903f60df1fdf83fe1fc9bb2899fe71f87a5e59e842d1b933bece19eeddd789fab5dcf59978becf473cf3d1f3e3d1f3e3d1f3e3d7f5fcc09f45e20cfc992f07f1fec4c657683f16b597a93c99ca8791bc873127c4895f2f88f5c562dfd0bdbc3a7e0afb35abd6d1943b8ba6583fa23ea7afe5412febbc7e1b406d1aaaf3b49a22f0a233d7dd3acc099a184267a171d6aede12fb12bf3bc892c8f8dfebc7af37ed7dc97aafcd094a8899dcafff29e76c1986eee36dc1987788a10d5eff0ce9db79ca537cf78bad53a46ab583b94361fa5a3545bf2e096c2ccf270a396d62d9cb511381465e2fcb59a16566428ceaeadc153f7f05d12389fb7cec3e9ca307cfd585d5324bdba7f7c5f7a3f927267fe44efddfb13d47ad29959f6ee35b3991a5a7bf297ce3cbe33ae91bed42fea51ae59b684847c45f688e1dfcdddce01ac6a32a588e2e0fef1df74cdd97641d13e9677ae894874e79e894874ef9253ac5b8d71c6327984bd7146f30d3582c2f617605cc1d80de68ba5d4133bd0c17dbdaf90ae405bbec0c54ecc3a6fba02d5405b0be0679ff30ef83ee1792ce37297cc2cd173837f8748ffcfc9a2deac5a834bd79c87261827089d2227e931df84bbc5edbc1f3486c42cf577c1fcb48ac1f47554fefc25c17ba771ca6e1247d48f4e9a7f0e17b6bb9dad74b782eee9d12f2803fd95ce61459fd98cbfc98cbfca173995d6ff6ea9b8268fff3f57063e6ac7ec08ce6353ddf96ca2b47b933c85744cface7c565297ae66c7d432ed03bb3d0bc5ca273ae9eab9b66b3902bc576b96bbe3cf1e7ea6c2fb874fa4ca5cba43ce1e62bfaf71598c89baff10dc7f2bfe1be7c88fec0670b580dfac6cafd9c2aefee8c6b48ae4425d483b93f97567af565a18bd25c95774b2c43105df9b3b7156da9c15cfa5a3a3da5d0d1afb081b7bf12dbd3f90d1f8eef67277e0d72d6a7df9d2c78ec61d194f7ab66d5c2393833dc47d2c3b81175bcf7a5e9f8f572d136ebebe2d0dfb7c2ddf1b447ae8419c9219f8c5d8adcdfa47a911bcaea703ecdc7fb4443cfbb82ae2b389f136886cc38c1f7f665f6a129d6a7865bde8ce5ec7de97ade81fafb2c961b81fd79ee3ba7f959c83bfffada24bf7f269bbb81e931491fe07546be27fecc97cb58863379363e6ef6fd587790d5feb3aef5c1c0dc6fda07776f7f7c85e49a11b9db1b54767a1ee6f7ef2641cd45e18ef418915bcbc8558e1c2b9443f726830f68f8213948f1794f1dc873bd470d10d04e96ab4e63fa7add9a60a6471ee6dde550ade83d722f55a19c837954e30bd79794bf12ac9db28730deef0e5a9eaf7c1ab75f097a915f3f26e9c94be2229f371e92a25bcfd6b19f3dee91220723b164e27cc07d30bf02ea97e59cad292d4423c691c19311cf39fb79e4f268383c533146ef27d025c3f7a1992013d3adaf4ca4bbad6d4cdf87a82b55a7275d67d359c21cd09158067bcacfd7fec7ce67e19823640ae5c3e8d04cbf0f9f2f28e9e2ce67e3982194d7f3ad9f942c3877862abf5c48388b736919d34df82c527e877f13e6e784df30d83d0aaf313e99d899a8619f4d94ec21fb10ed2fbfb18dd05ceb809befe09becf9f819c52eaef1e554701fb615f683639d56df7c06dbc18bb5fa7a98f75dd39e952cdffef3fc35d3917297de8c3578ce15b48a7eff56ad64f5bc89ebe34c4717cc83aa541630ef5d8738876cc2fbe2bc2ea8fd011a32029bf378dfbce79ee0a07e385efe19b2e156106b34717f434d908299db50eb077699e70b417900a8973dc8954380071e347e368d139bf6c365f31061471c3f643ee3f2df381de039f0e3819d2c8ca0b7e400c5952da839d705736b2aed4be9e69e72fc1ded39a1fdfa87aeebc10fe7f103f1c5ad516fe67bf005f149bea21ed157f007f11921da190da417a8ef9e69834f43f73de2e7ecc17bf9f47fd17b3fe8fa3cba9e42cdb2d968dd05cf90675d8369fcf7f5f21c91bf08e9cb00d3de37ce34eb400d74d698fbfd9cf9de31cd5e4db149fff3e24ad837f8f1745a47b2a5c6975315994be5d5d0ff320c8d9f4fe8d15b4ff5f7939309b3aa7e693e0a995d45ef579affa8db48d097beee6664a2d30739eef5017bbf9c1e7b413f978d89fcd6cd893a9f4d4c515a698ad77be1ce3a7f083607dec7406efafd58c027da8239bff67850c986befb1bcacd883d0868262af7748e7c7371bfe3a237f64aa5bf2a173d160d5e2c5ae5c0a6b384b9a782cad4d7d17170988b80641cf209e8796f86049e0578510f310e19cc5edc3299bda0be5bc279bba9f35f6fb00fe7be27d987049b26965fa274017b215ac47eeaa6987310af23da928e9cbc73d5f3c9e5bd07f86cc1e7d2c926e43fa6d337b96afbd39800159ff1625594fdc873d5f6e79cc7cde935216eb3d0851cf4f4473a2490db90a7f272de1a29d97ded3b9e49bfec85758c04bad231dcf37f9f1c632dbb5097f58fa101c80115347724771e7470161de4b62ae40ffd7364c15a175a50cb36c1586d027151d5950ee7c6e399f3e3c1941ce7986027f2d062f81cf97e8f7f0b98056c6835cff95bf2bb7374aa47eb39dd4d3e47c6668fc2bfecbe45d6aa30f7a3edb7f0fa98b544e1e91bfab3504fb78fb7cba4c1fb15fe01496ab5b16f28afc968f65916fbf65b60271b6ed131513c433a52f58b77f31fc0fb913cd44bde35ed592972f93ef52d11b98e50cbfa8bfdb1e88cefe08b7d0dd3d215be85819f3f8fea6e4d19faaf3276de7d7d0b276b2333e50dc1da1af31ef83d0a5e2d6a85e1a9476dd6b9b559682fef30934782e75c217371dd32a963057d95c3fd063cff4eb5921f89e5a336a81cc1b7007d7dd44365691c804640cee5f04c9e5611e7b44ed1d92956d6bba774d478f2716f197b70509d36f19f5570fe0fae67f5697c4a68acd930177a1e707a077ad3438fe46953748ec0afa6281d207f1dea7a3ddfa0e5a0fa1e5c0b8eeea9743eaa870fe4961734b907beaf83799a83cad7c3d195a0ef45d1142df01d402f5988bb3821997e43da575d9867ea6cef20afab23b9b51ac9e5b9e1d6772338b7d7bea3b9ce6644d70c5e121f9ebd927edf40df07e8850d7432427d1241664a1e7d8bada50a3d56fcfca1f604cdd4025ec4b68d2a401e9cb351857d0efa20bfd99521f43cd5e5f24c031f15d09068014f85fb8adb88cfbcf9156e4083efb94ead37dcdd5b7f70ec35e137c41f16d09861a3b95679389b11eaabde010c07359ef6c9fd987ee34db103f35c97badb599932f4f5284c24548f9edb6aa2b422b5f723e574cfe1ffbc7a193233ac556cbe96df7bd97afb8378356a6f83fa8ec1c7f01ad56be00ef83ee85f7345acbad26c04f7811e9c86509e8e64afcf26eedf9305fe30d0f7100e0c68b4ba9b9802e4f44ca05ea9d8145b4e533ce917b405396dd89599aaf42d2fc70ff8d921b2e7debc33a0fb26f8b82b38bb89aa748ebad059e2fe777998ff8cf529b121b274df9fb7aacf536b55994d54773851a1364e70406f1f203790ad158198803aa8409e26962fbf5ddd5c76a4f45777a92943b4d1be479ddcaf98f333d385f62f9817d559123eb9478d1ee97d76df35225b44e85cdfb32ac91e7ecc8c7ecc8c7ecc8cfe9c33a3739e2fa205bf5f1aaef49823fd9823fdd9e7485398f0e37d56bd00875f699ffbf741b6ba21384713f8e1807a0839ba0b7652196208d81f55df68e1dea0904f9153f3fd1ce2d3085bde9fc57c4fffd52cc04954df0bea8c60d648f13852203703d19edb1425581bc80dc7f3e3f526aa5c9c35c5f2ced7238d4ed6984b53b301fd1f7b13e2a7f1eb240e9ebdf44bec638aaecfc65c9e2fee1e33be7e0556c77e92d265b608a6cb48fb8bd06cd439e1cf78fd10b84feb87cb8e013ce73a7ff741532a50671cd0b54dfaea760e63a5920df3bf33879ae4a6683ae007c4f6f916faea824f81f2bf4c4c056a600db0c371cfe1fa4a83ba73a5bfe0c29d37952196650893c02707be14e24fc1e741fa27abf956d168f4437260249b8e91ef417dd8c6cbd702f95816209f501d507d20433353d03327aabc9febf9d6d214adf53d65c90d6347a77b9ad2db9e5e732406a4d61b83e5e27de331ef918285d27ce7cd131a3db35f6a129fbdcb52762496715c28f09be3ded4387ed45a9aae34e3f0c5b157b26f8ebdf87d75ec05bebbc87df27c7839c714adad5645f9d8818f1bf301f8a035513a8c14cdd16ae97cc05e297c7175bee77f72bee9306b55dede9b874eb4df25ee2a45e43c05b96b4ea7d91b640f9d7735d6468dbaba8dd37cba6643b3f48604d8fc7df0faba6e1f27d9481c71d5fa87fbb67dcbf5b75ac357a9d3af66f79d043b3d86ceb97b7f30bfe3c9d9626cfbd85e20ccf7127a829ce8ecc01f10715f2cafa89a08667dcc5aa27418be47a4af9ddc9fe0328ce1188ce9d751b4b6ba789778113ce70a1b0fc717f18c20c32d43cf0e88efd8d0d7c8c877969a5084bed4f07f30b3d932e6fd22873e49d61f67c67674611ff4851525772417090e3cc0675e2ca685635dce1cc523719f78987b693666f87d12b01da1b3cf1d87d9df3c0e13ee577a990d74deda903fea2ef189466b6b7c4c9c20f699501b3e3a7cc8331fb189476ce2119b78c4261eb189476ce296b109b00b76c8df2db756da200732333ba6f546baaeb8c8d738845a52b10c35cc36d4ef0c0956bb18cfbe866607915941412e21337360de46f5ab646eb1718059e9f56c53b42cd31d4e0ceafda03f99d99879fdca1a15c7f0f8cac7a19aac39860bfe5fc09e08ffc3ef578033e15e30738c91db7e1eefdd673cc7ee7be0cb0ce66a11ecefe7153273238a47d8d76603cf2899b72726ec1f9a0ba739702f235fd9428f05a83b83de70b8ae63a7292d8403c88c52faecb42ac1f62d47079a43f7ea6f59bd8ef3a80b66f5b7cbb5c27c965b6a550e9c7f438cfac0c50f5cfcc0c50f5c7c175c3c47bad0cb854c9af108739f66c5da3b3b37ec7cbee4e6492e4c023eb133e336517c89f2ea312ec0fad1cbc1f6742f8a65d078039e0def764e3f91148c70365648c40c785f509ca68e640af1c52d54a517f0e21cf4bd730ce380bde361000e9dcdca9c04fb28717e30f97d14bfe2cf7863f1a6e864efe3df0579b7bfb216c0bb07c65cb311607db15ec4b93a1b15e6c305b36b4f3175f839f8bc6ee2d71da25c733fd6f73ac1fb8a7b02f79746beb242b9f728cfa66899ae33c7f4914e3729f41285f5c0f73916a519cc0031e6d2c90c10ef37e641cf4bbb045ce740eec258e92f00d7bec9ce06b08f16f665e2f78fc49af833ce5843900f4dad3d5556a6cac828d9d8437d24d5ecc5f4c8fa8dc919c5bc073776003fda0cef430dd3532d5def137911b34ff7cb8b2067f80ab1e0fa21647b47e8c0a8fc08447bc4874f74eaa5739399e725d0d1c5ba3789cefab096813f0f9087d6d2696f5afc8ee7ac111f0eaa9980b8113c5f83be55d54a5e859c02b988e61c69d58a651c2a55e849d999824e2ba13a200d7ad9c97da8d7988ce4c2441d205a47bfe9ced91a2ed447f70faf26116a5e1d32e716adc5d79782b3d2ab9171378413d0f9a21c0869137e7fb867df413d6ae51e8f0c67af6499ce5efc329ebd209627a23d7f0dcdc6fbc073a1f3a8506d23e4c5c085f614cfc3f47c3e0d7309f35fe17ca1566c249ba81ed4f7db88089f436d9ca3dba13381fbcd3419ce599a9d6b6f70c8a1a8b38af40946e08da8f95678be720ef9a519ff344f3e0ab79f9a79173efa8ac3422733964fe506df7d19de98e17f43df284217f48ceb01f27979ff0fb13d419ad1f5959f9cdf3af42c6d2a867eddcc6d5eccceea790e9a49c4f0ccfd687d1a63afdea92f6bfda0cad93bd803e0dba8af35a58f62153c3669942d0a7e7690b950c76a1c489f7fb0d95f81f6a723117ce49d35b61910ed6b0d07de017257897ec238147a5078b954df1aed25d4e1c37d905f16ecdd2a876fe9963890d923dcd33df8bf797b0258dd741de8456f415e9e2a58e0cf3eeab8c7bb27effb45d853c85126fa00fb1b411e78795e03ebf86d80eaef2d5c937fd085dd3d67dbc4f9637c1ae4f3cdd66d4ddedd23f7236708f5b936f8103f70ecfa801621f751959d8d7967bfb79697208688fb81a4fba5c37ebed8bd9e8ec4f23232fe70de79603abebcde3b4a2e26e41ac13b4dcdeae5cfc3bc15bf7efeb5c399fcbcfa4cb0ec8a8d0925d86cbf8b4f948d915fed0b958b4b905bcc4c4ed2a785f4ffb1489e9f2eb4fe9f2677b267602d3e8c753eb6021ba645e704104c75c59a78df21596784f468e08767ee53623f0ffc489d15e8b9cf97afe2bdd7997e8073e7e11e9aa2f71ca04515fb4379f23d4e9f938c6b3fc237df13f696916ff3ccc1bd789dbcefc561cb123a0e6cd3286c136dbbce34a5e2e86efff806effde968b5ef185027da684fb86b6f136dd3fa86f4563728cc0e18db74cb3fc9f942ec887396027b061f211f5fc91941bc99c8c79e5c243d8320efc1d265290b327f2417055d5893fef3e04be19bb7c0cabc84b34db4296f6807121c4ded4f2adda5d25b149dd1368e5d59a972cbd1afabd98498d05493adad2ea27bbab897d17a846405d09967e3bd5591bde3c791ee9ebb14e283bda5bad2caa7b12bd791f61e29b2ed1fd35fd0543a96260cef4dc70c0d5d4ecf03b9388339a3a6281d719cd31937804f1c9ce307bdcfa483e1f5ddb4a08f81a974f09cdad790ace590a9c9b2945f8682ececd1b29159d7897ed794368e8d4a0543dc03b624febc9da9a0d9810e5e3fe425232caa0e42f2375dce12bafa9c71d4144ceb3cf2af1ff9d79f3dff1ad6027117e0df2ccd2bdd469abd77514fd71afd3c4ebb2aca9eaa20ff30a26dd497ddefadaa7bf216626fc7501f84901f0efd260b35a97796b1ecfa097620fb8472c2fd7ea7f26ea22916ea1189676d915e1690d39c35dcfa127c5ef01bd0373c3498427bbf453f06b4d66ad38b91b1b345a9b5c6c8ce789a8e798714d97316cd43ff1e23df3b4ff626c9e0d9eb84dca759b59c31f83895be05796edf061cbc70862c666988735fce96cde402ccdd0bf32de1d913bef9264a054deee44cb10e7505cba62815508fd9bc375f11e38f90bcc3ebe0e15d5616a4d310e6451a1b44dcf773d58ebb660e627118eb04ebaaed936406978f3732d7868a4f5ca50b1a9503eef10e6742741ccead80cf3478ce11d77990bcf4193e3f5c57837c557158f5def9890d5306ff34f438f57355eeb5ceb4f74ce6815bc60fd36c53a73278cf75ea2931b48878cf1971945bdab65e2f913bd8b69ebf84c1097c3a2752c7f8b31a214f057a31a0d910108b073d43fc1a38660f9fd3b635602d3833a8b9ca8eef3dd7c7dbf3139dc7cca0bcc9bad2deeb02ecf55bfa700c01e2b19d7bd813ef1083c733c62ea76fc7ef19476a2a498c77a2cab92dfa3f799fd594d6067486e94a3826c4dce7e3f401b34ea40f7aa4773ca96154056b8b66128af5b52ec0ffd557e047d2618e9fa239c6f14368f4e37a3fba9d852ea0b991d7e64d5c5e43e45a5bf3905bab72b17846bee29dfc391dcb70617e6e7d3552486f1ce63ba7ef9684a5142fc7b703d8107a356db4c30ee7b2d67323e86701b40abd55d1ac943ad4df6ef06cb4b59e6f7d9638504d555ab311c800d141b219c9fbbab9d0e4c284c827e09b37e8e9d6f07b26e0bc8d4e0e6650a838b6e5c52e735be3378d0df972e1e3e5710dcbcd2bb0c62be939469d5379e3cbb44607f966618e959197a6a3a0e7efbd6532b55682cf315e08f807fab259ba5c861e9a470d6430f455c1f4a5e73dbd611c1fd8c1c70e509378877a22c843c57e688686f9e23c3dcf6ecae2f948c86fe7adbfbcf17353b1afc5cb45453212fde6ce74dac3cf243e936bde3bedb9e4bd3fde36e4c87f24b592bfe19c97bbd66a73e3b139d4d4de7baecc6de6bc60da8dc5a5581f46e5fd2c35c6d7fc3eeb787543947c66ee13af57ab61b996a05f93f42c9b07782dee9374d7d99bf27032a263625eef6c14b30ee6e541afd6ca5675978e4afcd88c6c8c5eef6e9b26a783fde3c057e482bcfb93b826d2c797afe95e188fa203ea735f17a23f074de83d8e7b257918fc13d2e325f5925174e8d3f518e672c6e44b40ac52f3f83cc89dc0b907be3f66b043b91438d647f2bfc8199f53ab98ac8bcfd7c9e4020c49f60de9663f1e3d77484e1af4dacb19f3ca16fcd25ebe059a01e7c977e86941d588813e827e526663b6423e381c1b24797c24eff79c3ac114dc49ef4f203bf965ebc638f0c7f1ee49cb46be6271db3129f60cbaafd2d9a9e09b69d473aabc0f9fb1e76bf8543419ac1fdb36e81da10f471de602ec4db9be1ac95a91caf34567fa89686baac3fcc9c3a7939535f45e67f65c4ace350b6425a91b35ec8ab77e016a053aa447f86fa2cffdbc7b2c173f609dbfa11fc7b737a086ed7d79d08572b63b681535a57d8798926f7b5ce1db912cf07be019c793603d78e6383dcf577c9d0c83cf7d1b8c434626cb467e9908b2b047ec10428b7db90e3ea7ee30dba9bc572bbe9d427a21d0b39cd541c5ff7d53f4cf339df652682e2aef91dd83c4bab0f36c5b887759deac2c8e5e81d3d72bed3d9831513edcbb5612661e40def11d7c056b5d008c37bbc7b36c55eefc7c9373209b1cc3beacf6f16adbf9f3db2f0f7bfa9f604fdf50d7a27cab7ceb1e31ec96ae54b6c69ce97371b18ead6f9aaf4486067a137493379f02e7ee0f2a539807a88be5f91ba5c7dec2f6323d0b0c7af1da5a8f5e133dbfff65c1f4d045b6ba219651beda48aeaff4c66ca2cbe519d4396a726f89f43cae21f365164f3fd014dae7a6798fd6c95e056bf5fbc2fd03f6f2f7c21afe1edc634687a65853bd21cdb4c1ddf522e295949efda7d8682e1d46831cd5ef7b68c7ad3f498632ef176f0724d903ae5544be26512a9855ba07f98d7c26d0efe880fb7f8b01fd7ab178fadfda41954d8faeaa96a5e6fb3955dead9a62710b674a72ea812750ddb428cdbe71e4a031679c862bcece7186dce6116020a585fab087fa9efbf2e7e3f7e15ebe1afcfbc8d81ffecc970ff8be2c1f13de819967f37be427bf7b3641100b6db4afd0cbaf13723f6a4dde5a9436fd0cd43f0e72bb74619f43e761df5b27c2ac3a93f48d80debabe2fa637a874d03b533639f43182ef1b07af5701ccf2825e773ad6797a43427bc0e31b4ca1b37be79f6d751972bcaeb60be37bc604dfe1d18f9671c82d8dc365faea82f5e78c7c7fab5fa81fa9f38c5e3fa6d948ec70432c0f7919aa7b9f9a861e7e16e6af893197b87b78c7e499d4742167a39c9bc08734d1bc597490b79c1f89308f553ae07adb859e37bcbe94feba2b6b53de674780e1062f0bd80f037e2317e7df680cdad0608e0699ab41626a4790bb812cd8dd5916111ec4fd421aad9c2e527189579f4769b94a7c89c766c35c688a17fff2e26790cf89f4e934d8d75c4e6ff49790bbd4ac5af0ff3b0ddec93596f43b41de19aac39bb783de3a8d0e9c7b20bf6b779371b40decd3ba4e66f352cf63f0de47fb5208bd125f3cf80f17bad0e3d39d893a149d1d8a6b8c00b386f5cd71249673ba08b3ef8713e823a8897b349bf733603d562e9073227a752096f3b83f0eaa39a7756fb3017312a515cc22d6069505d0b10e35eee9f9582c364b3efbda9eae358bb2f5c8856814300ac27a5e5efa44453dbacfadc78c9d8d0b354a6bcd750e46be1765e75c64f35c2487a2aeda7ed77550fc9df4e385f72472d99ba167bfacb10c75474aeb48e62e013e6a36cca5294e20b77a0e33a475bbd2ea57511c246708d2ecfb0172ac5b04c7405ede06f91a149835da5f82ae36c0ef5ddb6d0dacff92ae3368e57cba61e43e334338e1fbc9738c894ffe539e3dc1c8d56c08bb9b82931d91f91a02e4efa073b50c7718e86319e28f2fe9cfe2d02509671da75f62ce38cc8749df4f9c13edae1d4d79f9a46786de6df23608e98d3cccc7c77cea619d57e7b529d6a1fff74f4da964dfc0bfa104fdf3704f3d88512fbad5f22e65add41e9ff9eef8fc79f7099f7b989f62be8fbf9b3a979ba93f8ef4ffddc9ee27b64fb067b57d1a9d5f86e19536ccc4b9c2d607bf71fdf8263b471ab38fe8de40f4ac9a785d0fb5ea39f0e520df20f437865a15a1bc3231df418d0ad0e3186666bb436a7d51bfed2f34d939341bbe1fdaf75b1b87c264a0f4269a58069b62a3e7c97cbbfa12d530cc6701be6e78b30be97a29e3706f1f05d41e39815f422e4c7a4a7b0dfe6f8a769674bf227af6112db30dc1da9af27e06f3189a8d0ef40d9a9a0df0c5f5227c31682e03d85b30c7e610f2e147fc16ce66043df470cff6a608392af5952e98c737bbd232ab95992e40afa8e296e003e87500b6dc98f2b1406f3aa8ad0d30bf74bca73f25a1ff005fbf0fb7b585194daa5c38f1133032e6837b1cf83e8d8f972379e9688ae57553f4e6f6001ee68c2d47c5942bcd57f2eeb4ef0dfcbe395cbf4cd11fee274ee652c0b3c1c78c7a620880ff40265072e754b6f0e423de32d7a6662a9dac2e64275e3d33d860066de7df7bed69ef9e8c8d6f58eb42eaf7223ef7bfc315ef42fbfa72752d0563bff1f8112d5ddcfd82e7e62c53ec2c6e5397b3db269c45527df10d6517ee5bf7f1390935df4ec5b2eb1ab985630114d64035c5a4c669abd92f0b52ebe9c5b269de843817d4d279ef017a50b329fc7e4cf7efa4f875b8fd39a41f11ae8bf3dedfef2581f0c826a4dfa1b618bf375a43d53ae23a7e47abce421804f014f428c2b2cff3bf52fbf099e4d167abbd433d2507f7cd17fc15738cbd7a96db3df3a1577e6bbd129c2365873dfa8f3efa8f7e74ff518a0ea2729b1e75378fba9b7f54dd0dceb32cd2b4fb41b8bb836b03686c78b91fd2e9386695eeefd7c278ba0e7b9f6603dfd99f97db6a50f73b08637b4f265eb98e349a4ba1b53be7c01e35a5b70ecddc38a51de63ac521553beebbf83a1ba770bfbfa5f3d69a24e3d44b6d6aff3c4c7f2e12c14484c672277bfc26af9d71b88e8e3e57d487086cb471a36299e2241a9f0b52f64da17b049eae09e804f43de429f8f79a61da1ee496cc3ee03dc09800eb292cf7fcb3c0df8f8b9b0439d51f2fbf5afeb3289ebcc277f06e88d2d4c38b6d987fbb84fa41da6e863ca7915cc479dc15d770cb24e64ee5dbbf2c4efdef06d41f3880a1c2b45e81b99d5b63dee7ca1d213ae816be8541b08e2819b8d3e48e577303314bd1813ac4e07b5eae7470d6a276005f2ac41fb03cf4e20f558bd4984c202ea2cafbad76984d5457027f6bf8bd445cfbc0976b8279f2e3fd0fb7b25b5579bfbaa2cee0bc6709f5957e1bdbfdace7ea723dab0a939bd8c89f229713ceecae72acbeba66be11c5d3e0030fc92e6dde827806c2cba4de8c99773cf946c9ab3bc7634ef680f4cc55857d4e038c0f6711c25e94cca16407237f7c3f304ffd418aad5a8aa52be6fc291bc1a7252f5727371d89ce4aabe628594ac50699fb24d8cc8c4f26d93ef3e985c1f5ac0e003b1f6822a4f3d07b07b29f53bf26e959afef67b8efdbd2a0f48956dd81fe75702ddbd26c40cd82b5041cf34dc4b3e7a08696f16b7bf96af59521507a5afe34fd77281af767643a1ab5b77e7e904fe73b1293fc157bc6cdbf6ff914b9781adb8fb26bb8f33d93fc3be4ea364ef3cca87c4fb68fc527ca1ba3641e3ebf889c3f4bb7e9b30f68e86dfa0fced1744b9ff3cc1cafd72fd1ab418f6c7c6ed5dd44178aabf12038b3a6d8598c501e10e1f5bd9fa745f324cce4d1017f2b7df8fd147024e6cfab720113d786cf8d771fffe1b980d33be1c01a3ce70afb15fd9ed11136d01ce8cfc190c3ef7b531f1b8a9711fd95fe6e77f29b45c7af080feed6bfcbac722a37a0d5e7b419926c0732bbc2af35aa9a4bad6a4c34776f412f2bc8456c36fa16d45ed2b5059f045b053924afce2b89295cb126de7748d7873e4f0574c74d977354b7b1c6386eedf34e2312bb70ebbe6e83e31c7c3f3f238b42f884c45d981c37526f42def716f429588eeecfa383e7ec206f9ac6ac2bbafe6ea4f48fbe8ead5a649e36c45a989c1f947b6fe98dce16fcf9dedaa419e0a853191bb39fc97eb9b3fd73497e3a4e1b0dc5d47ec59ef1ae31c5d6667ce260dfef1d5a87b317a2e979cbf16ac13ad9939ce024994dae14fc3996a56392cdc1850daa379179f455326ac55632aec4fc2817e8b30f68a85af807d70815b79ff4ccb04d4f7c57a8c694e6d515d4e141ef1dffccd0ac20f01f05bceed507d4b3619e44739297aae064e1f7e08bd6317ffe96b621fe2ee31338fd2ef95e82bcb85f1cdff385537b96aa9f53f572a43ec6f96134bf43af2bf182fca798b83e9acf13a6397b3c407da3e6a6bcb70c3b3cfbf3db007204fb103302f949f069a22e4dd1a167c5b6debd38444847428fcc51640ed46dd697f67e2932f02adfb221d6b310bbd7ed4fd7dfba07b8166699ddc06f5c01dfbe969756aad26467157bf446e50e0d867d9cd71c9e1915d68bfd2dc465831954d2ec93d833f4be915e38074d3673ba583f9ccc9b45f46e05f5a2afce2bce5ddf42af0ac825d0e5f2411b84681c62b8a8eecfcf13e7a0634e7a4ef5cf50341895d7b7857a73c3fe75f6f57bbe35bb49de28cc4c16a5e34836837e0c823a19a11e0cafccdca9701e3e606b345f95e4bb0c2a4beadcbc3d723f8fad12ec19aea3a27a1706eb437d35ac80e7fc5c3e9a36511d289a41ebc59691ad07f917d06f4347cf81d9141cf956e4c2b49d661fe3bc00c8cb586952841c26576d7feac7b42bb6aa747872a039b12baa218fcd21e788735886d001bb1764c335f741ba1bf2df903e89cad9ba274e4bd1878f58eb23d6fa88b53e62ad8f58eb23d6fa88b5fe56b156672ce233a3deb3db48e183da3e0dd346d9a675ff59145d70eadc185d1b99271bace9f679b269ba3659c7f2eb56e89910b75f24d610992f1df4bdbe7dbe749a8d8269ef5a9f0b477f573c3b3ce2f3281fe1a3c634b2c634a9ce027fe6af05fb64f721d912c44da1862747d5f034695d96aab7ba8d049ef1ed7c464638742d46e55d53fad9ab7bb03442f73c927bbe557d1f00f4bdd8a9b2097d9e66c89fd5a86c47b8878f06396250d332ff053556f1fb41fc59f4f9801f244b66c0133b1fea7c0cb13e571584bb60cd3b5d70bc9e50e043918b4798bf88b0543ac64ac65377ee59a08b755b9377f7e859903384fa5c1bdca41e807b7d80c7c0ff0c76eebde72b697969a989129e1d7e0b9976d3beda5e3cf98a3ed767c9f639f6b3552f7f5e622dc6796b8733f979f59924d57fa4f886bc5ac7ec6f93e735a46b33bddc261e7d92a4575a4c9f3a87d8ea41ddffcb7c24f7e64d515ba9b231413e814125ab422f7ea537a16b19bf1f2acb3777b8813c9291dc59303a0b6436d43fa6eb1ee66cd3f6eb6c3bf25427b5fa549d89263a502b02f56e287e649e973b46ebb2af4d71b6d10605bf8690de2f4f47779c470f85470f85470f05aa87428adcd6446907f107e390dbeaf6a7a3d5579c2b0731e79bc4bae17dd19c85b03c5dea736d3b12c8f942cfd7f206cf7c60ee71ba17f4197c44fcafef9f5165ab93fc8ad7fa86cc040fc941d1d9986ef92799e90cf13d5d283a1c389e9579f1679b9c7b7b3b7b1374059c15b53fa974974e6f51745641fd0eb08f9d93cea2e9cbae78f7b2a91e978d50ddf714cd3510a4204f42cc59e02b47f6596c2e21d48b4b51716b88956ef586e6c56dd3e4678adce49697a0f767def9e0ef9038cfafdf8334d99a2253bb8d58fa65f26763707240873cd8fda0c9b9ade90e6fe29fe2f74dd567daf5f66392df293907ffa436e45107f2a803b9791dc8ed74d1bce5e8725908d1643a2da6d260a46dea4a6b3daf59d7f42c18e62b8e8afa7a4954fffa7e206f1b783d728ff4306072a47f712f29c77b3f55f6f307427d647c7f158e8720bfa6b76fe0d3f462224cfcf2a40ffcfd7aaf47626f749e6816a8faf9fc23d4ec5a3497b66a599017a90990ef31c36bb85e2e0e94bea3e31c34727e6f5e0d23f53c6c537f0e5938a0e6a4a2fce76f5e0fbd39d46b7d23f63fb11dcf5bdfbde422451f91ba19f5107c535a8ef6f96cc293fd5785bd65e4397bec25e6943a594dce793292c8c7c18e7ec6a6f9fa3a31c5f24fc895c0b5089bcf4a97c1be20f9d93245698d63e70473ae106f8b9ebfe30d7af437fadb9100768617bbbc71edd935feb4a556fd4d64e58dfcc9d1e715f8546196dc18f51e9296c8efda68a7ebe813f990bc666e9dcde86e7f0ef200bd23ed138ee4316aaece8ccc4147736e20e7fef8796810ce049ffba7a643afd6a682de17cb7534ef4713a48da6b496ea21dc9bf8e3e8159f25de43aa07327ab756be8d7da9bbc4f77bab569c37d7d878b5009dad260effc0fde911cdab4279a6bd17d03d8047bd7f17511cdf5b9bf79b78bafd1cbebe2bcf300d73a0180cc26983c0473d82ef0fcc23a667a88f3c3445d3d15de93092dbe1fa2e7b37d106c5f91bf40856fa2bec0327fb7c2ffc723bbbcef38304cf4ee7d5541e8de2cd1eccb0a1ed28e6f3aacd814f9cb00f8dcc58c23c073547962622bdb0d4e75083457c2cfbe35bf5749637076e49c62bfc3805f0494513fb4b627745f4c3bcc57ad2de2799ee1ef3357efbf91abae86c4687fb3ef346333d2ef7a5ce2b5b036a73a0b62842bf741b09341f81532eb4cb7a7abee3f3f7e9e7856f31f78af40b408f0d98b7eac544fa33822134c5c0315594ab84e77eb61c03fc5df91ed279c81749fc8e90bf47cd6ffc26824fcb81febc4bb38ae2b790cbb784f8cb48e92d3f892d17da4b4f56423f11a8a905b90e3a8be872298fe5225a1faead00b908ba228bbeefed1dce3301daa3e23255cbd6147ffd083f8dd0ec3ff00738599efe9ee4c2f2f77ebd47e628ff7ea345d59fb13232a61e437525f02f44fa332ee2a1ebce9ebea0ff48cf70a5a926f7a999b5ceccf3cbf87943ffe01e2375978dff7ea2b3117561bfd2f3e67fe6d9cc3bb0c66c5c1f844f703e2d22d7c3f3797758c6f5d6101b0de253bb893fcbb7e1aded77ad4f0af63cb676d0af5dfe94b568336783757490438562389ebec67ec08909bd79605e43b5e2cf04865ca6475d59725d599a8feb3fcccf3ac4b27aa28be529a68120a62dd6679ae861ebb7e9e7f31151fb807c3f12e689a6b8dfaa02d51fc2c380966f83dbb7edf140d64bc53983fb90df47c549f167d1fd9fc8dc4d6ce73895c17bae53a7f54d529d18472ecc517b5f2e2266439442ef1465fbe53bce77777238cda14fb7c90d61f865546b9fe4eff0be6fa77af1fbe62e7f5ff5d2f7dd5dfa4c53de5dfaccad7ee91ecd2b07ed527a98f72dd5dd3ba1f546d7ed61dec37379278ba86710790cf499eca31a54d623c572b45ad6361baddc898cf67e4366f546bfbbeb6cc077023d05e83dc77c1de913c39ff9e798ba0efb1fb28ec33f631d9dea3f641d837fc83afe19fc1131db3c1ca781dacc31d4d90f5a30efffc4f712d2fd31ba415334c7983bd911ace5905beaee7ea9bbc30f5b93e10eed94bc2ff0391734b9576ecefb07f3b43f15fe8d57bf7d6eae2f732fff7edeacf197383db81e2993d09e9ca947ad53dd1da9cf187c1ff8a8fbc9efb7f5f2c08a96feeae5a044ebce737163b02e3fd74ed8a59ddf853d0aa867259c5f1cc6d1c5d9c9f960da8c7cf60d697a351adc44de90fad573e31fe17b71cb2f49d09456d114515f46a841c6f5c8a5b8fba5e24c5d38c59967f0482e0a6bbee5d3ec12caf61e26cb3a3defc52154795fc33585f4f36e170b9f4bab34d94d3df7b23a88c47ae4b833df2f4fce17f37fe4b36f28374cb16eeb62aaecff25b2c36c347f95ecc81a768aeca09e7b62a70fceac539f7ed0fe897c353497ddfb54efc6d6fbdf945e2769b4ca855352708563bad261acf417e0ff7f9331a61c7c945ceacfd2e4d2a7c794783fc939c53d0bff265db6fe12dd9b5b8e4f751d2fcd1cbdfa8dfaea1a5dad46f866e2746d9c2cc4ffefad35c44f983e08dd79eb0dde3545ae87ef711ebe8f5b2fc137913aff345724deafc4d01f75df33d7f771323c866e2ece7d82fd4379a083049a0d745b0ccd4beb6b9e3fbae6d928bfa10cf90d57bcff29463863ef8fa67ce5ef95976b7ebfd4e5d3feca697af44466121deaf1408aae79bd89febcb99d9f7ace28bff99abd76f4abf86476cdb3ed6b780cfa61e942717edd3da42bdf418a8873c4fa4a6e878df0b9df8266898e38c797f4796d595f7626db299fd2c79182b35279f1955f6646d6a84a8de4f72b7b354a6e7dd5f36acc42cfbb18239fda9920931ee7f7fb9edff23676dbad31678a8c929dc2bd6c944f2d43dde64df4cacdb1d0057a2ad8677fedfff89807c23437e1bf4fa9df7346f526bae153ca4db07bf077e2788fbbcf842aefb3a7341dff7d23df9a36ed4ace9bf1d9021fc3d270a15f68258bce6bd042bfd5e78e349ccd3e6a0facdbd0eead7547aafcb897bf221a23554fb1cf593c514da291ebf883be92e824e27b2ccdb0f2844faf0639ae3063d1bd8ddcc5f230904b5077b354e5ddaff6e5a6d21ae9f37d42aff1faee7cbfe329bde2dee9bd9bc9b70b7b0fdf422f45f10659e701fcce23a80761f57cfa6fe33142fa6f116df7947e548c2e72ff46401f76731b5eefa9ce279f9fd46f057c3583be1492e8acb5410ed5e6bd413eb52c7d811e94b44c4dad0989abff70501e33fa2c78169939f53aa1ce7d62c27b284b98d578abdae0706e7e782e1db5768627bddfeec8ecc9a00ea3b9f996eb6dbe1dacd1bfebab9f6f5276f3ed10a94f236b0212ea0092cec8d1e5fa523fc54e49f9fb3179ea45e8f588ef8772cbe13d8f77d86b34072fa27e05bd27f4873645e9dd10eb536d40d5fa09e5952ed67786108ee9f9f438af007f38864bd539d9859fbd6ae1673c1e885943958396674507cdaa1b546c9841817bb9e299b31f929fefd3e0c959307596f87322ef4fe736d6f627b4176073e9301adc86be9a756930e0a841c5eb8b7b46ea3c36552e1625a9d562cf199df1dc5c408f8c61be62a982dfc31cf95e75716f198dce69fe512ccfb70ca3b16eff5b5ae33ff7b36fb9dd5aa916cd6fd3c5e61bedafac72ef5fda3e0e882e320ed093b1331d891225373b5b5d84fab00af46c1df467ed49fb382b743ea2f753c063c13a31fd32e783e96cb73d916518e7751b27b4e9eb3453692ddf14ba0fd10de911cd0cf67b6dad9a8d4e0ecd6852da13157db6877d12b441451ace76e97c9cc2bfa975d06e7d3396e0bd18daf1e8263f129dac267576aadcf16712a0fef4c27ea52a956324163a471e603a3184ce4293735677b2f89fcc536639fa399eaf33cfff97e9ce2699e74ce629d319b963f8dbdf7f3f6526f6dadae87f1a0bf72f7361ccc63fff5aac8c9133b3d7cf7f2d6793bff4cddc74c6e65f2bc31abba3d55fb93fb37f66ffdbb59df16abd988ff37fed5d077fe8fdf2c76ab5fce1fdc79ffb95090fb6e7ff5ec09fe6783db29d15fc75eebd42e42f9e322bfb38ce3ce7be7c2d678b4f1977618e33cf05218bfefa636da39f0a59e1cb7fe7b2ff9d2bbde784e7dcd7e75c4ecb3c65ecd50fd3fe9979fef7c8598d9f32ab037a5c6dbccd3c7f296685c253a6395f649ecb5f725fbfe64b4f998e63cf6799e7dc53a68d1e93cfe74aa5a7ccd03633cfd9a78c88ff547efc588ecc2cfa7bdf84bb659f3283d06b569c99f7de856cf9cb53a6e22c8cd92af39c174a4f9997b5edc27b0cc646e639f7b52ce4856239977fca7456f03f5f0b5fcb5f8b45a1f4f753a61df1d5523e47be9afdfb29538dfa4a51205f297dcdf977537efcd8cc37abb19979fedfec53f629fbafbfe1d4adf14f789d1aec54e6afb5bbfc6b329e9f7bde99a74cd35d2e7eaebba3b515a22cfff3dac2f03e781ffd9c8cd7dedffb8b05fe5b7bb436acccf37ce3384f99c17ae48cfd8343ffea8f47abc5dcfbaeb8a8c34b906f7b4ff6ff591b2ffdbfbf8f576be6dbf05fcc2fda0b7303cffbbf0c7efd784e406f6acf33cfeb9f9bf113b36be8d5da0b93fcd7c859ffe92e4cf4dfd2f8e7ca460bc8fd2914ffcc6660ef97e871841d139f8ab7f3368cf8f753c61cad4799e74cb7562ee8f26e02d96368c2fc346bb7070588c28035b9d5209bff98b525a92fbebd4feceeb1f00d4b79a4b1d441a5a0cbfb8d715c16c063336af4b3466db17dcb9b79f350ccb70fc5ade11adbf6f465d71e94d5f7e1fe5d152c47975fed9064acbe4cc6626ea5cfdb5ff477ea3e42e768b8ed8dee3a47b35a9ceb7975abcfdb5b6c396f7559caaa0a7997d2fe6dfa127f5f626de73b3ba87e321bfddd77bbb455f39d837a286e464a27fbe69a5bd32e6f8c43197b9138efe959f0e5a66b65cdc6cb97b743790a5ebb37b7b834f2bd8d26948537a1787c13b087e6f8ba69579b8cd60eba80f4a11bd5b0ef686e3da737c09b5fcfa9f21e756bd74e7f87bb1bf4512750c34345d7be0b64751f41b3c164aeb7e9cba65dcdada153b991ef8305e777814844ddb57d52d52d74593c627a205d6bbac36ca7f25eadbc8f954e5693b393a12041747069366693a1b8b774fb6531cc757adf06151175b4c1537788b68bece0055dea7c9acd056b9bc23914766f726ea98bd2d14453269ae1fba1ccc04e785dc13d17309d953c334463d5f2c9e7a7bf87aea6f5c328df0edfdbfffce50f09aa9e5fcbefbd6cbddd7c0dba26c01e3445afd21aba79ab7201755d36950e9922472aa36d52b98faabcd19ee1ee110d67075d1e75715fa4270268553299be5334f2d08dae72d4e44ece709dec7850d9e9627df356ad1c4d988424d6a153dd023c8e4de80ee67a13bfa1bbf3fbcc7997aa95f761f5a5d0aebdacdbef13b0fcd1ba12d70bdd05f2bda0ea9bacbfeebfcf3bf53e3d789f66d57a1fe65e96d49e2c8d03aa309fea42dfe9cec96f73d45a72682d7447425c554ebc2be4f268c295d6aad2fa69b0d6aa98fd62b8e5ad592b1e31ddae8d806ec1eab77017a6832e9469af6974d7a204041a175d42b25006641c913115836429fe3d770a644e75f7cba0a21da69dd4c1e36271760088aafcef196e5980ee86de14727c8ef0995db1c0c34aba4a180dc98609c6848e55b9386b8a7541959d952ab796ba88e8226eea4f60f9df7b4247f2bea18e01c3466b6bbace4c53da27fcaaca1069255d51d87bd5371a74de70eb4b98ba3892f7407b54e7047f423c54ea4c026b8a6b323ba1d324399f183de836223dd549111526ead7391ab525ee7279d2dd6dcf3de52f396a7434f22f5f307d1d4750edc031c52fa26301ef7ac09b9c7d937380b5603a40c4f35e6ff93c5ff77df073c87e7ec4f388e7f4a029da12e1563bb700fca6e75b45cef33ae54f3e4f4444a641b45ce0eca0228c14842d2603d101fc71180f2a75d0f1e3f7f3baf6c575eeebc98509604a633e433a591d544846791665ff8b7bc085484e18fefb14714768e4dd479d4055d9d9407549530c641d994ae1d90f305dcd3ae84211bc5773842ba0a31f7462b75f16a341d179b377135d2e1f3d9ae978fc877eef6c4da54d3dbf30d15ce760e47bcb73babc9cd1e925c1ab94b6c7cdd4732313c19a224cc4808eee8e372509471c4dd1b1f46a650534eb7583417b80f804775b4dd15ba4db2ac2a620db17905da90bfbad263860e74cf47c6b061eec6fde94c65553cc6de19cbd0e76902d857ebfd4f3d2917e7e53d496c6bc93fd3638c3e3cde1358bc804888aee455dc9d15af64a8bb6e63bbbef736abdf6999908ac6739620f52df3529ba1df13d36d27d461604d267a0ebdf64677693b572ed2f6420bcd8e766985daaafefd76516e936e878ecdc6d2f5daa73d4fdcecf32e6fde2fd9e873ac66ea1432074ec7953f0f3e36502be4edfaf6ac77d175fd7bebf1f55f36d770e3a6feed94816637f45e3ea187cc2a1db888dc5e087e2007c2486bb3e9ed9a92dce660bba88bb80253a5b1deb52c01ba01fb1ad624174066c72e89eff49753ab6ed7dfded779a037d0ad930d836028c063629d2d190ed82b110f8ab786c29fa4ab6abaaa7594969195a5c99d5c073a47b5bb28c8c90a9a50fd56978ff08460d6591a565a9a135cfa5cd087e1b6458c054594b13eb5915a67b299d9d2e4047e5d64a1be4a0133bf8817e6a8a736c4ea36d5634591ea630342c88202f58fd039152432c43974ce84eb0069a301be1cc11ff7eaeb6d4e4fd8cbad70b4ce1d306b9a5568d8afce1f5e075e23df0e511fe7e9c8d64ab72e7e79b9cf3a2bcf6f951d724fb26caa6e9b9f5e9e800348532282e9fae3043d3e256dedefb539ca72305f3f4bcedf97d1ad041bc9c3360325a838a302bed7b4f54a8c25e83ad89f69a740a7fbdc93ad2dee3b3f86780bfb6a6d29a6a83abf118896e47bd135eefd91982917adcb70528fe4fd5cbe9ba385afffa3e8b4ad3f759bc7a76e215be532ff30bfc0603df77efeddbbc0fd9af13f06741877dcdae047e93793be8d01fef1f24931a912f07fc0123f0cfbbe5952e98e0cb44136a34e80c5eb5966fee1062036b3ddf41584f1590dedf21db5e2ee78c03f57cbbe28e94d6d1acceeecdab69e7807cb1ff04df491a1ec2b2254e0ff0660247eaeb736cb80f9fbe029867eef9cc0dc18ac136b7f32143b58d59cdad75a18f636a1ffb3c3f866f7ff0baf07edeeb79f79b5a836c7be85ef6f343ecde87ddf8b01b1f76e3a7b31bf1673e9fe2fd8eb3a73cf9f0f138b10dcfb9060f866c88018a2958b80b3dc26b78da2754610598fd3d9dbf52788a9b8fc0e7f20e7e5b9f6f50b548d0491fe24fe2ab370500f06bbe89a727f61dda56e2e09bf36d23be4ad598ae03488ff074648bac56f6b3f65d0b4f7d48a8a00a3f3367b8b375680fa3f69d576ff0eb8c28fa5d803d66ccfbceb8d10b9deb99fa239ab6c5fd527757c8765107a1b8184c24a1ffed1a6e7dee7df6b250e54ed6043f44b5b2d105e8f24678a172d085a5a3e6013bcc3ec7d45ed72a1aa2743045a960564319ee6402ffc7ef036ffc8f23f647e40655f1162927a32ae20c575a31d5cedf7b59c4277604ed704fc9e93612ecc9d41ca896a31359733d4dbfe27fdb1a9a140b3e735c41e1c5e5579e0fa2781c89ce0ed16b5e5b6af474e64105e9a637bb321c89ceb129762cddae40ee1ffcb9840943104782694b414e10e2a3b971a8ec4ca5b5831cbe7330568a2e385b2730b80aafb940e8fd53ed11b79e4bb6afa32b3c29ec42d169f039eddb96fb4b7590439d00181e89abcabb1f8fcc728e263a163e273e5f70a24f18ff5b4695530847ebb2341bc9af786a7590f38762c28214f2ab72f898d833495e37bfcf895ce07beac004417580cf0afb9afa58dfa82ee46320df113e5bef7bd4d42044c390636bb8d251cf4b075518de4f5627e1f5188c1e97b31acab3a3de2115a35c86a97ba6dc5a816f0dfbe18723942fdfb774e5e5f2388557010d399c0ecec1ccc27de1ac464a3be4473505c895a67d50bf38ffb48eaac18fa6585f1b358cc3eb57ae278d0e53e88f233728544ddd23d367197b30b897543045696308a1aae5908f0962839a047b315c7b135b73e80cc76e3912b387aaee86feb4d70fe191083fe287c7f0ded1332bd4332bd99bf0ca0cf4e410c7bbd07e233dab879f47f2baef1d1368432e6e53a472711b11fcf17af61ad2de81ac81df3e8dc20749f4482eef5eceb801f4da09555d877c3c6edf324509c9c737c55c9ae2644d756f38f1e5329d0f6a6472fcc7c4ebfc7a1c1ad7a4e299541c13895f0239d66cf8cfbd56570439d1814e0fe8a31a4cdee7c1e22918fc2c7f4c9f96edc4bfef4fbc3effddd39e9d828db9634f0974472e742f4db1b21a9aa61d3bb9fad7cafad3d8cac7cbfa5ca7d61bd235a07c79e751b9e6e45eba5c9e69745da97d679c33c3f562219e453624a92f04dde6e8549de3835ecfa7573faff8e3b1fb2b25635a1ae03daf5ee6c0e97b89f12352f25d7466f0e748d69690376f2892a5131c00fe03a83164be033c803adabf17892dc7d611fe12d93d90e1cc110e09e52105f782fa4cea3b76650f7e51b476e880339f4da09e94f90e5ae36fca27e1fb33b418e573f4fc094d544f1bea1e568db91775bf9875475da9329b47867b7990e7d70bc5c9f1815c9c613b19ea4e909f85f2af2c69fe7ba33a4dbd55fdee53e07fde828f11fcf150174ac539d3303d7b2563fceb7d32e4021b6048fb643e781fce7dcf643b81bdc23c146547b317a24513f2bf84c24497a5b5ea4a07445b4a9b8f9fae7b3e777728aff34e711e15df8abb5226f0cf746146db133c17b70f35e9e2c6353c179ad6ff3ae98bce7ca4f4a1c6acabba10d7627482fdb2e8bf965bdfde5748be35c117dec031a639c8b8ddc4101c8835810f7c095daa7471bf35f3ed09c81ef0a9826f5953347806f85cada6a8e5747137515d076ab3b75abe6519a2b55421df4fee6c0d17d519b375f53c17a6fb2bf788e09e0bcf0bf3aba3cfa5b5a6b4cefa7d52d7408edf9dc5c37ece388f2c613b47d909fb46c5a823ee8bcf88f2b531eb63d612a5d36fe82380f8d8cbc7fb071c780e8a735f95bb4be450b3817c2d10cb45bf079d12601369169f57eaf70559a15a9b79cf8be78a5efdc7bdb128f43040b1ebc68cd85d7759e3bd7069548e11a3674e6c25bf77084f9de234d4c3e3629d934ad3a9f4ede72af2f9bcd2fc5fb38b73e3cec18c585670acfb32ac083891ce874458b127f7672384c98aa013c15f8b7515d88e8035104d43bd11a20be860a97fb21aeb988943284632acb7bac3ec30250ff6346f362aef97e2c544bf36f53d5fc6c7f83be2ea564aaceef275848bfe8cccaf62ee7116df71f35c14bfcdd03b9dc76b917ce6fb298a487662da23f90b38aeb744be2194af89a60b04390f8d40afbd5511cd663d595bce8ea0ce432cdb23579a72f5cf616937c5d63d1b0f237f1fd937a46b7cffd0580eec36a3211d0cb17c44f9302ef83f904f0126d2620cecfbb36132c80e3ab18f073b94a76b86edb82dc6d4e7d8707cb61bbfcd946eab059dd593f68ef779dcb6d9fbac83fad0f1d866293619caab3ec7ceeb3638f6b87a535dc05e25a35684bc3c4b859c35c433016feaf926f017ee4d0631518c77bcde2010a3ce8e14cdebdb946f4e5434b5a143c713a13ee03012105d935e631bafd74830eda129e22ef5417e53ce10c8b3708f36dc13cec8571c55001f463beadea49f9c8bdf1d721c8e86509e8ee47a16f18ed77df95cdbee3cbd17274b38e471a42e39c3863bd776c3dfe7e2e1145b2ddd464bb7cd12f49f33fb743995b3907ffd7a3de8f8b5e0215f1d25eb191b03ebb55ababdc49c779cdd74b6fd442eb0a322fdf8f5cbd7c4fb0e2976d2b5398b1be3d0f4f2f538fc79dc32bdb6e73987b8f812dd3bf506f8ebd5b3fb14d2e51bf76f9d3b908f89e528e2b5736c1bb2e7c9bc75be1e037b86593fc92f877784de066872038911d198129d298fed7d27da9ae2296721fa4ac22477a2af1a7aafe379319a736333865df1d68ff4b99fe718d70793e0d84f82e13dccc2137bb9789dbc9883035f103a0e7c578c8ca8edd9cf83d8a28799926b7bd2e22db57d1a1f311820f9cc7c7a63e49ee4bdeb0d64a2b3d1bc9ead7eee36de072ad7beb3509596a5e6fb8e013dbf4567cae3bb64cfe4237471b00f4836b648ad4050af81fd9b941e1ea149581d9838b234f2fda57a28dc8f06c9efa3fc9967e679a01a3c8f57686c988a09bb8d147d158901837e67d7e57604f779ab92895461db85ea994cec32bf8f365db376f73cedf01e78fec54165a50b44d6ed265e9e7205ecc3ad2a3890af39a3fb0903165495de4415c0366c857bcac8bf55cf5f3fae7ec31eb251cfc131fbbbf51ad88da0dffce06ecfcb19eeee6e3d191f7d1b1e7d1b1e7d1b1e7d1baee9db9062eb41bfbccf66e75d929b9ac46f92ee3a7bc0c84c2ee66f62d3b1fe3c849d2f5fd3ef8d9dd723b97757fcdcac53988f333f34cae730a4b030e48279cff5e6a550eb4334e7e54317269a28b923b98867c9507358dcd0cc8b5f962ffd1ebcf7845e1fc1db276ba6ec0976ce0d6547dc1357c7e7f8335826aade2b242f59198f693ca9c612af33f23d6362f62c8e23721cf760a57c1ce9be8d549f46942f6308b1dcc66cf20dfa150af5cd4859fabe23c3ae40ccf7a80b8525a7dc8ec447cd46b897359a0180e6d1ec269aec78f1f34667a9bb664e17ebd0331162745bc3352ddd75be109a84781be450194279f38df8bc84fd4a8309908ae634abd6da94f7d911d0c080bc7bf1f8edfdbe7c74c69e123b96e9c15b816740cc73d514a5258eb11fc1c76cca7b0bfa1f824daee75b73552e2ec7244e0cb1d506e4de75b6ba4de2a57e9f55411bbc2c74455a99a2b3d3c5a1bf97c681a3af498afee0af514e980350db87782412b3cdc9faee6647c1bc3e3efbc62e5dbaa6fbcd06f8d8d900447e1d888df59bcd9ef1ed1f1e5c9086112ece7fe3c5a9345f72acfb6cbc4a306b28e711e1d58b6d5e5e394acb648ef34fcf53ad9ed5abac379c95dffb83643f50844cd826dbb8a9b987f87b3e660eaf216d964a6dcfe2771f738fd8384a522e143fdf71f35c14bfb1b1fc73782e8adf24b9b804bf2a5b9348c55242fd9c75a1f5ff003b7c9278336b27236c70c59a78df21197ba7e7d424d176343dc7fa132187bd5fbc53fd6ccfc3d89541b88fdc299d46db980cbd38945d89a6acd37336bd75d1b61d6b2f5175b3c16c060fb77ab33aef5d2feee079338cbd8cfc82f4ffa1b556b0bd529969b2b6d45d69867a7953b1171ed97fab5a84847a1abe1ad940366e6372abef524b4ef0f93d7801db2497d3ffecd5b72782d8a2279ba0d68e99db796f5af66c06aaeefbac77fded6c23cf3ee7c4de3cb83e0a231d4762f96056eff22cb0c13cffdce0837bab13bab8e76c38b77cd00e68d6cd47d86467c68f3f6a8d81aff0be7b2bad6106f2dd9e37af2c74a1e8dcef79d2e19ef179a803b9dbda5cd48bf543fc22be8e7dd4203e6a101f3588f7ac41c47b1bb907f8334e7b11e94dca7f51db27d90f019fc660ca383fc53be367bf26161217d7d045690e3916b0be91d22f36abd646cff7906d45e2059f0e57cf98f8868fafeb9b6674ec63a5c926f021896f4cbe89c5ad5985d95c04bbce567e3dbc17f39e42ce8c8ef79b3ce733d6b547f127c468301d9deab1c12d63089d859e6f65639f55bde1b31e78f581571f78f581571f78f581571f78951faf06feb9e53d7a960fb12f0b70e525b1b6e898f6490f4c54074fe1d4c9b74627a70a93890ab101b93079cfb56a83616f09b55d90c3d2145f2117ee3892cd0df4d4d7aa95a32a580e67ed67325ff2f322f01ff67ba3f87c64fced0eeb4d7b5fb2de5f9e1b97d66330212ff9dc592c17e929ea1dd26576a2beba60364ba2bef2ebfc825a676a4e4f98be761355eecf608e8971007b30678df0ac373ddf5f40ec176633a883cbea082859981423ba28f78dbda0a680f4614571c3d78fdd8773df33c5468c9239016d27e8188a7f226def3be590a298c97df2ab4f6c7d56969efe86df5fc2f474d0c08f10991f6a2a90436a80cf04eeb3d484a25703d8e84c47a08b7972ab3ed23f629fa963a2723c1f3ae5a1531e3ae5a1537e8d4e39e0dce18fb75d82396e131dcfc4bdd866195476bae0c0be1cbcde62e09f467d790f247fd580fe6282cace0cc5fe76ba8f986519c204f77c86bc31aadf0607dfa4f009375fe07acd933d0af25bcaa89721cc288039c7ba5d413464b8d8af90af803fc1f56c13f23c985f866ae8b7dedea1ef2e54a5b3c07a176683d0bdd7d2fd0e449fc6c8dbbbe5c6b8e8dcaef6cd5d519bb980f840d3467d2551edb4a1484bc395e89e63215f89a9b4966f0a956bf70b7375472033945616e64a341be7d7d0a5f0279adf48fafed1f408789ffeb7366f599ae0fdfd1bc4861a92a5577713dd2d83cfc8c2f305c0ef8b727b35b9876b9718fd992c939375fdf9fe3ef03524cd7ff6fb597cf03ef0be77323ea4f93a3a2798a2b7e073df0fe67a3350df1464fb7dbafe3eeff4fcd41bd0f7d5734cd36c969333495e37b70d432e64cbb0736b63e60f8ba1ef5179ea90cbb3063b25345b9f5bdfa5e3aa443c75430c44fc7e241f31d8b774fa4ca5cb243f6ef3f58c5ec8913683378fe29bb87734a1befa867513a23f6f961360696124f773aabcfb3539ba7605f52b2032b13f97567af56581f32396588620ba025feb08e6d128da52033d504ba7a7143aba5f8e7920e7b631b18878db9aec7bd47b923d20748bed80b878229d9ff00bfc44e4ac4fbfcbe71f124d79bf6a562d9c5732f3e2a403d4f72eca47f4abfd3f67d136ebfbe1d0df9fcfaf639722f7f74ef66a3847fde3eb2842cfbbc6ef896b2a8166c84c107c6f5f661f9a627d6ab8e5cd58cede97aec3b9467e4de8d9ef7c37db319ed6987c8ba8b9d821bf629c3d98a00ff03a23dff3bcf8b15b9f69a89742fb0eb2da7fd6157803f9216056f4aa29faf7bb778cb74272c788dc45fea07c27abcab43ff077f265c05e36d7e017bb63afbc29ca4bfdd83a1fa095ecc7d7f894e139ab3719d5627e70ae64077c4959633ebbec3998e6626926a1e61bcfeb8c7bef243b85db5e49b25ba41bd9cf497d8f6f3073324d16f1c9a4f365d3679fb1998c25a3eda12899c73f9f25c9f773fef3b8e7b3f85895d19b5157ca7c96852e44f7da8bba523168d2753e9d25cc66b1b660538775e1cb19ef926e77275d293679dc85e9337c163cbf43ef2a1761df0e78dfc9cc9672736e6e0d776573dc870b7b255ddc989b63bea929940fa303250ba6bbad11817353cee0527ae29315ec85751b7316c9bf23fa30cccff1bf61ec4dda968cd9839cee469f23fe3c49f6dccb0789cef94d069feaf09eb6008a33f2eaf4285dde1b5450fe0af41f43f70a7c3521da3db5890bdfa264c62de2a83d5fee21ffbbaf8779df35ed5929f2ed3fcee7a80ad0fbefe3fde63d78ce35b40abf1fec26a658cee3fab5b92697e7865bdf8d20e744de411c6f0eef8be3e3a876076886b277efec73ec405d1df1c9d4c0667babc29c35738367c33aba601e54a5b2004cad233bcc84fb79390d22e459825c31023c707cd0f8b9346e203c7007d9ec74801eaef1a1bfc3796930d7b1d1da9aae37e3dccb3f2c5aba589eeb79e97829dddc538e0fbd3d27b4ffa1eb7af0c399fcc0f85c3e9e2f2a5be041bd5aa9e9c255fcd123be42e00979e6f4c0cfad89c3cf43f7786f9bafe8bd08fd5ff4de0fba3e8fae55a19c8319ecf7c133f85957611af2be5e1d13f28f010ea76c470e9f18b173a27992df3701beaf1af489311bada28fbbf9de31ed19c936e97f5e2c14fb063f9e4efb5e5ef51571235c03ffcb30b4f77c428f783dbf9f9c0cc756c8f9ff52796988d6f62e3e0ba70f7ce1cd93ba4e5e921e24e067b4a1ee4c9dcf26a628ad34c5eb9d7067793904dd84f791e87a441faadc8158714e975be0f7b7c7834a36f4dddf30de1eb10701cd44e596ce912e8ffb1d17bdb1572afd55b9e8f151fff3a8ffa1eb7f4ef8250a83b057b711cc4787d98688d7116d49474edeb9eaf9e4f2dea3e578b1b34e36a477c2f83c9dbec955db9fcea2b72b822aef7380b5476239ba5f79d2958e7178ae8be935eaea365efee80d4077e49c6603f7dff1e5f699b1b76a58765ffb8e67d22f7b5d1a93235729221e14c4e6dcb20bb547ff181a801c4f4183fab1071d9c4507b9adea3aab7f902c58eb426b0971268cd526fadccb8f38378ecb9c1f0fa6e438c733e3ba2c2d86cf91eff7e7c679d9df9da35353e2beccf7884d16857fd97d8b8c0333f7a3fd06e1f5ddd73f8066e17ebc5d260dde6b97db6392d46ae3585b5e939b134370b278fe7d0bfc7c865b74cc2ad4bd4947aa3ef16ebe58783fe237b8e45dd39e9522977f855f2167b8b3d83ad984e7601e89cc97c59ff9fe03ec6b88cb8b06dbfb1e39d18c0d76794ca1d26c7839f4cd461ff81feaad260caddfd9e775b2b62007c2ab3b40b5bfa60c35f027df4dd335c97ae43fcf178bf6f20ef5284378ce15b1af4a5384982ea9e3940a5e9d31cc32f3f8b9d930177abee3341b1df02f425eeab4293a47a011d45f41f666dc783e337f5e11aa8146f7543acebdf37d24512a98b5a0570295db43d1b84f631353b0b686309c1802f8856106537b3252dac0af594d69adf14c57e46f0b66f54a05b38aee09f5fe1fc32383ca7aa4588e56cbda66a3953bc1c3e973ce1cd3950e63a5bf809c9537d9d9787bd1dc9ebed72de4746ba9428f54da27f04131889eebd8bae86cb57ccb32446ba91e2aafde6c22e77ad98dfb6fc3b91b7665a9db953ce4038d501feb8e83cfdd1ec9add588ca17829948f83db1bfa403796f4bddedac4c196a330a1309fc57626eab89d28ad48503ad317dbe11fd9972919ab7d42a365fcbefbd6cbd7d6f7ee2d86ba24b90dc504126284d34f7ca14ac25ea9d38a81c35a587e48c7a7abf70ff6fbb32c5b5bf53bd21419f8e4db38eeae7d710eb877c7fe0e5119243ec9e3bc76643da50b398816fbbc36ca7f25efd2535401fc36bd05f4428e70cb7738fbac7015dd37ffa79e11b738fe858cb80ea0d30a85846beb3550507f770f37ac2e3b992f03dd803ba2706f48d871c9e05e4228d07155b1b003f56b623b99885b88d2996583f2fd80d5653ec2c89ec79fb753d7c482ca7d26c506727d68f86509e8ee47a5693fdfe3ca826c440df43f55a815ea9ee7c9ed2e57ab129b69ca678d20f88a90d81fcadd709e465629ab827a68beab94b2e163f45e9b0ec48e9af3eba660c61f3c3c73ec368b4b6c6fd7aa3cf74a18de6ef5fdb1389777d147d7d6ccddd1cf46ef1e7fdfabea3bc24a1f33167f798a9fc98a9fc98a9fc69672a4bc8b760b880e79c83f698b3fc98b3fcd9e72cbb01debd836d0035285826f1d15ea4dc1f54a6fe7d6ce47f3a8e14c83b28ce919d2c4a1bad5a819c1ec8ff40fe25552ece42bd3b1b9dac3197a06720f069949de9cf2abea70dd00b70516003503689477b9da501313034b75c9d68686d20378613ec6bb27417fa0456fcb928909b61ca9daca9b4907fead41f01eff5fa5b617df0cf9de29fd728fcb3bdf019779e19e4fbc42eb22d92fa91e2cf22cf057fe6bf1bdeab38ece9f580fd7859d184e75ce59b039b98d8c53eff807c789da8f956d168f443fc3e924dc7c8f7269a206dbc9c23985d5e1640f6ab617f44ce10bc3c79d2035895f7733ddf5a9aa2c59533774b993110ebc711e5c3365c89c411c979907ec653630efb13e2fba5264847b35a996be0c3743ddf829ed7c0276085fc0f54bff9113c13e48c0835f24e762466ef293b6e17fb89d8538aa6a37af7d26b8ec47ccc59d2576a2c32e63d92b14f6aacf2944639f98a87cf3a9ad25fe8028eeb043e5cdc2b1afcae10df76044dee9d677b9d697f5d6c83f971cfc87d42feea7759ca8ec4b2e75bf77d8b3e1f2c8d436506fdb04d579a71f0017b25f3057b85e3f671b6ceedfbad5cfefc0fe9bfc2f89be2fab0bc0f5e5fd7ed69331ba997e32e2c3fe2f24f8759abf2f6decc75ec589b34ea2a45e44005b96c4ea7d91b648fdf6bd17eb56bd6df797f3ddc74fd33a7d71fe65edfde5f8504bb9cbd707d49385798e3b7e1ba1429c5ff40c90d4cf3f1df6562fd513a875c98af69fbfff4bef87e74ae0cb3bef05aa27418be47a4bf85dc9fe0329c93d06d846cbea0c6c62d877323d3fd0ca9fe8568df5e79754dbca7efc5e0c8cc9e832eecc176db7a310b887b387314dbc4fdd00db17ef07a1ba4e89314fdc1ad2f403f38adad1ed4d492b8a687031be833145752dde144553a681e0f6029f47f9e1f12f5a048c37684ceaecdeb8a9b4bc0eba3374527ab8bffa459c5e06f6a7fec33e695839e7fb95b1c05cf6079c41a1eb18647ace1116b78c41a1eb186cf1b6b803ca47c05f9af5577efbc2915cb98f78bb4add76da4bdeb45be44c849daa13881dc5a69834a8f60311e9a8cd407e00f9461e617cadb2218cfcf5b63fbf51b0794eb8fe785148f90cba72a3df01f081adc2b783fe86f9125fd2e0c513a7858dbc799c1bc25193001e07b78973dc85fb857563fb033cffd3cdb0d072f24f3003fed03cdc7ee3be5abf4671ff9d85e90b25e1c879999306f4fc89e1802dad3ec48ac67c1076bba43c8a3391a62196ac86de83f65366678365bc5313c9f986f5f046707368587dd0d575a01cdc1bde03c19bd0771269413cca3fb3e0b86c77652746ecfe06330e99d73973e2ebf87925b183345e104660f9bfb38df55aa6cab9e25e7d2651ec6ed17f87a23e32997e2c4d37b45ef0fcd371cebe68e9144e459fab6812787ea334d74fc5829f025966dd0770862cc8c3c3dbb7f362d57d3cf9f230e425f1e5d99073d2fed226d52a73278cf75ea29fc17c1af113c42e385348c8ebfc7faaa98b38e9571093118e41f7a939d59ca0c45d8c7efbdecc94ca3b3e331dd46824e4c8bbf3861dcd3ac133fd229664ebe2fa3b71d94e74e7c65084ff83c08b80a72de1bccb351bde4197629a74d7ab63d8a6dd1e87d41f3e48688e790ff12f04c3d4bd9d8808f20e642e326f81ef4083b872fd379f26ed81864ebfe1ef9f94394237d1df6c579d61e46d544e768887b6b3cc079276e5d18c926e6a7fbc6c225cf67e9fb369a0dbcaf88ee2bb311d08b08b9f0759423a4c2bc3801d3473add24d3cb3fa32e8be42fb3eb4af217a4fa09a2fc033d14f753b39cf8e41493d8a5185e8d7e0f6e1905b26986f781e455e07b27d1e1ad307f429d747a3d36f87ec819beb696ba5b0ff7a3ac9eca8f281f93063d35884f9ef895c27409b5deeb90fd16c193dcb22ce6dd12641b8fac1347f2de396b56268fde9d05b3f990be1535d4af437525783ef872b2cd866919870ad8b550bf37831856f3159f4b15d5ddd8da0066d2b41c4d04fc856a072d4fcfa3dead7fb0735f55a13cd3de0ba8be0d6afe46fe9c55ef7d4cb083614e39cc418c8a9b79fad3457bd230179a5c08bd3fae639d42dd9c563dd377cdc17f5763067221fef4f61ce553fbfe840f3c172a0feacdae0c713d2199e18b64968fc10467a557d17da06e6da909a8b6d0f7cb20de1a409da844e206de99e05a6974ce72ef1c1cc3e8010e3e4bc63511b23df8ded5b39b191e4fd02b31efc2475f1f31d3390917dd6db6f3c919de83df7eedcc674e1d1bc50b81fd18856fab65364f30bc1f4c7ec807f782388e1598cf9e5d1b82047638cc6f80e75abadd9ce8efcb832e94b3dd41aba82977e871e2748a46beefe883cabb26777290e7391e547a3acc87ae5aefc3dccbf262ece6f53d21f73f52f7077cbe79ab568e26cab1ab23198bfa4a905e23728bc8e5ef03a9336cd63bbd666db26b57b3fbefb5fbda1bc3bc73043f57b3de7120d68fe622d62b2ba849ff56efbc0fabfefc8d0f5b6fdafba6f0ccdd7cd1ba2c655519deef95cf8f7a690e860bfdbea04f4d0ef2f73dddfab175023edfaa8265196e7d0afd4ebc9a94cef15ecfc676032ddb3f789f515ce9a00bbb5ff0ec96a50aab35f47280bde6f1ff47ec31c9c58bab4549c03ebf89ef71168a2bdfc0e7286d46200706e1d99314b6f1fb7ae07a2c7be44a5393432eb3f22ac5f6e396d3e40279cde62420ff7ffdf235f1be438a0c4ec52b37c4189077ba35e6bd35c4e934b677663a26bfc8d73300f9906f659bf5ca4117905dc6478bd1347824799523725fcf8ec2bd5b2a81dc0fd5000533540dacb75bf9b6d7a309f237ab5edf71c8a9d37872b752f03737de46f62cd159951e79773f4744e910fb919c9d67b7e31e3fcd86bfa7a1da1f44abdebe101d31370e1e2d43de8037434582f8a9f35963dac41f6590981d676d1c43b367cbdcb3e46e9cec0de5da71d07a1addcf2e8e539ede2b7a7f6839c4a36fcef54f047921416c1ae5415d9c5f99d6a3a91ac1afdc3a37d29f1e7521bad2f2adada9bc4462df61bdd51d6687293909a718262a07234d1f447c8fd50d37c03f1660d1e43cc40bfcc5dd06afff8adb3f1ce5175ec0791ab8f720fd4e9cf66cb25d8bfa24ae484e58c01fde2c6efadfaee1d6e78887ab2f0b98d961822e84da752107be7be8fb06f94b075d583a6a1efc91337e3f518a7e3a5b4f910bf4956bc19c8103ae37a773e1027bf7a3f78197f739f83eb1b687fc3e4ab7e1cfd81c87583d8671c79bdc59a84acb8233355cf83f676a1c3e1e87f9fe8ad788e71faf8809038612eb4b7d4efc8051eb23bdf802fc15f27b884ed6387838f5ad5ad9994a6b077e9f37dccf80f28921dc07fcd214f7101f5dbd4d7f91df27661f915df1eae7ee127ff07424d60ff0de502b0a3d1cd47c3bf091fafe21e8d720a11e92c6a1b2341b6d2ff71bcdaf940e86583ec28c4e15fa3e3428bf930818b23e35aba0b33447ff353d0c837b938bc79e9f93b5e79611b948fb94dca5ab7d27defe723cf79a9e6e84e63d5e5c1bd04b76debb9d9fc4d7cd61acc7e97f3abd3f9f1c3a4b26a5c9a77e58aedc0837bfb2fdb481bf76c8dea26dc2cf8f9b5fc3f28dc8998bd777ce7b9c115bfccfc85ffc8fcb878e3a1b17f4e470ad2aa83fc261a42c1d8d67add7c8513a36264890ef9f1d291afab72e4a8226efec93b5b0172b7307cd6fb1dfc5d7cd7cd794ae0bf732fe60fd43ed1bc14f6fb2879f3875c4c57a167c9ea6d2e6a841b8625f5db496832a9b8e56cd5926f425c9b7fc1a9ce6e9bb33d729cf54edb8efe2eb4378aa9e33450b7acc7cc172768de502d4647ef01e161db3013d7d56e06f9b8e902ef4fa86f1c8942b68c4ebb7266b2b3d6fdc0e1345ad31ae0eed33d2c7a306e55183f2a841f9253528f8b3d83df0e76fc26f83da94c45c8037255af6bcc96b671ccee9f1e90b6234d05f1472edc78d8a658a135676327510395c2371228bd1fd20f6a98bbde05eb30a554f43ed37de3b13d728e37b611ae2cc270fdbf577f46f05716f35c8efe79141d13edd465c8c18d515847c5a4cde0e9e0503b53809f93f1c322a452e5de8bf8adc2732a303e6e0ed4d79c8cccef5ea3ec23eab0a79779b9af103353a841f42fe3b2fa7ed9efea9f8fc3d0643df7e0eb51becb1a634d728ef34cedfc2c71b31cfe6f3bb70f46b98e27398f8745227799fa7bfabdac9cf8af6c9d07b427cc6a7b448d1976bb8e5b53fd703c7030db72c006d6a55862fbdfc7b2afffab3e7b347acbdeeafddf3bb62be0ce5b060dfce28e2ec0279a41d7421ebf766c418e9a0e735d8b319db7bc214ea47ba7680273780bd88ace2a4e1108fa6610d8ed9d721bb176206cd69f2fd827b9ed1638e5c1ebf820e06de9f9a6248765cf42e8c4c8aebedb7301bfd9d715c6cdf04cdd11a2d4b575e369a5016ce79c6d97641f5a67ecdb8ab64d48a4386b641f7e455a51deaaf06baa6ef4ab3e1acde19561fb392e367255b59b351397eb74bdb912c6535bbb8355c636bb8daf42ddf72d5636fdf9e36e3f45bdc75564ecdcd6567d455dbefbab357d45b07fc4186505fe98d59d06be7d579a5e5ddb7ba240d86bd25c83cc06e9a1bcc291cceb45aa7f67a68ffe7cee07ef4ecbc77cf4eacb731bea2f93195d7ba8d941854649e9a8f33be43ecbd59b54453deafbe5d5123eecd2ed472bab8c3b1eb88d8ff2fb379d0be4ebebda2f75b125cc5fbce69cf4ce155ee3cc9abeb80831abced27a2673feefd0be8fa1d62546675c667c7c4c492f1fb131a89b08543f91b30d7d352f3adad3e77d66f55dccb026a3d1b9ddc48e95baa1bd59fc0d9402f2c3c9b30ab22bf0eca1f3b9a720e7c8745a8a933f23d9e383596ff31fbc78fdb2006fd4eec79547b3b78590cbdfd5846cc8946f225ca4712cefba96f9a627d6ab8e5cd58ceae481d2dd4231b7909669fd890db0c7627cc2b30f29595aaccd0cc5075504171836683ec63f9d06c403f9e9ce713169da359fd3dfd0c6fc7485998d41f00af33f23df1673e7fa6f9edfcbca237c8899fc30c1a47803c233f6678b23f09f6436d9f24c7e3e20774dde13b9271a83f1bf29bb5518e53ed5a1d05f33d5a16c87c76ae2ea1d56623bcfea6d881d920733ab618a6e73086045f8b3aa82c704c96b2d9fadbd1c0c3e0edf7974d67fa72f85e2da31abfb799d61ad6db9bf6fbacd89c16c58154797f7b7f2d7ef774b2d5b5ef5c9fe050796675663f5e3d7a08ebd18fdfd7fecce90d0fd9fdf7f7194f1c04d3ff19bd5b3e60f648380e99ce636f7218b39fc443f01927c99da43806fecce77fbc5fa7fe182abe11f064ee18e6c91cce3bcc3173034efcfe71be92243f6b8a6dbbdf9a51bd06f87da9dc58925cddc6cb1fbdc1cb5c17cbf9d6a1c2ec4505ef45080b3033ba118faf81c701571a11ba823a232efd9c48e314bd30fbc87c2779f6832e9761d609e4fdb067c485e5ce963f8c5ddf12d04c74a001e73f69df47a2331fd131b43bd2b8f76c63c2e45b4d70bed50a30179addeef590a1e7a907ba6b90e04321fbc3f16ef85c92d69be6ef48f269c4fb2d6afb90aca4b0525c3e57b0d6f433ba36de199a6d7f45acb3466a5d11df2890bfbe877880ade7a12f6f1d7adbb033f851bfc5a01f6ffda00d2a47532c433dd00ac51a848e63e4a18e71877af89ae0ab9e77d623a59f25fa3cc186dac1fc2855ee14d919fea43600e54b09439af696ba8dd66735450be71b05fe4f4d2c2fdfdcf2413b94f6ed6a4168d79a85b76cb135ccf536c3dccbe67bed65f7f6aeeecc69eb00b9d1ef33e75daa56de87d59742bbf6b2ee4c5f10fe52df0bbfccfe1a847115a9bbf9079ddf49bf0ba1fd5bd534fc63668144e5eb3134944334747e0eddeb6d73e8f0d9479edd0d7d5a8c4d731ad3ff2079cff0fc443aa387555add9626427f1667053e2ba00fb35ad9eaeef0c47ef3d64a6405f26365a1bf1ac876c8755705e96808d2eccdabf3cb1a6e7d6308b9a5d9e853f93031bea046e53052344bcdcfd8e7ae70cf4ec8333f6a031ad3391bcffff73a19613e1c35fa59a3d1fef27628cf7461b6817efc6fc7d7dddb54ddb5a793ad27cf8befc36a01cbf38ad09e43efeee2a9cca9fd229b7b169695410e068f9c2f6fb441b49c0ffbd7fa5be85b02f576865b9e6a4a077a2be07acbfe41958b475d76b2a688b173b89f4008f7198dd6d274ebd3f1c97349ad5ad0d788d8f1e0c38bd0adf7b4e7e36a9f79e520ee857bbbdc7d2263183ae3cbfb8ec0cbc13312ec13fa4af7d5a5f9ed4eea9478e453aaac1a5498feaf95a3a954505f11aad7c5e79fc13463b062e037bf6c7d6978286a7ddcf679224efa27f440e0e57346a6797807e5ccc6ef490ce6f9457525cc6c3a72ef8fc2a3d05747f3731feb2bcfaf097e4aa851be9dbc8c7af6488698a979b8e7335545035bcb525de7266b4dca4d0fde89ea1715f86821d71af737f5e6eabecf3a5e6c3f82d7b97503bf5e88d209ecfc2b1eff44929f428ac94df663f62ac1b680350e95adea420f8d33665073ea82b3f50093efe9f5654398eef235f1ea000ef99f98339364430534e2e78d27db4bb958997a653d44bcac3eb117114d034daeb46aa56000ceac65ed9eefc7686e4ffd86b81600f3638c7e61758acf9bb1ef469d432a4fa6f362240f869f5d41be8fe6eb053d02237323ea1b3697d850244bf7789eb61fe6238813d974ce7607bf13f2d9a11ee45de257f5629edeffa19e8bd22c6433be22bfdd64987b9910bfddbdfb8144dae5834ae4ccbf9e5c60f97b0f7d7fd09ed1be34d15c9aaeb449c98be7de8fb4f590fdf844794ba77447d13e259f7c9bc98f2d47f5e68c383b6e7e8b798704febb093f9ed40270fa7a78eca961bee2a802f09edfaf3cda5fc0437f1cfcc65e29fcc75edcfc78197f12f95321fe75a293df0d519a32752a61bf0cee759e5883017dd23ebaa7ff290fa6d96cfebee29cdb892e4b6bd5950e687f94361f3f5ef77c72a1f7807ef808cfe6fba1bc09f4198ad515e7497c1c83db39ea271272719c4eef6d8a7d810df560c4f7978cbbb863e249d745fe86b80bc5d241e6825fcfefaf087dc9bd3da2781e669602cdfb7ac5931b3007bb67caad15a5bb89ff6e22b9e515ec79f3d56935eb2dc7741d9457ddacbd3ef2e81f79f477c9a34ff13f7c78aca617f1fce62b79feadf217a502e827cf3f8ff332a11777d00b0e72ad723afcc63526dabcb5d571fcc5948b548f14f87d7f69089d959e87fc2e6f760763b7dd3bd60db16c97e85515cff41d90de7f91798777df8fb4f524ebeffbc529a027f68ea787cb4d7c8dde0c32be98c5a5cf9877e0f3a919e337beb0370db1d32d4d5ce68c7c1f3dff4d467fa7b11d871ff5d3c4632af45a9aaf276be1b3f953ed7f87f4cb3fc27e7a7d5a43bd0e706e5e05fa02cc46b2e6f5029ef7427c8830df20ea773bd253c0efc7efd73fcfa52383e383ba0cb1b350e5e29caba73b7d71faff2ec666c80f589f79ef0e36796f3260d7416614bcfe067bfb88435d138722d8284acee3bd8ddc03fc998f7f30568ac955749d0de4d87b679f031c66a9f93bd462cde8e7565af8b993772f7ff4729fa33703d919372a8427081f80cf9cac0fe8e068ca7b2f8e4afa21806c68489b70efc2ca5a17c236fc27c0412d7dde77b47cebcef807f2ac437cefed69c847c4c1f729bcfe4fcdc7f364edc7c61c492c04de930b8ff0602d5f568474d3fa540f70e41a7e96591c1c7a96c70e4bb3c906b2dfcb3bc0a6a11edd8427d1accfa5e66a0eee5587fbdb207986ea61a27ee7d58fc26f4f7a8bff3f53e9847db454dd25cc25d35dd339d74f7b868ff622ff2cf8667b721fbd3bc6dfdfd87510f9f63beced397b70862f2a3df6523d6ba6786f382bbff707c9722f429ec49d7f123e89f89e2fd3c26bb853ee6e429ec6c7fb8498677f267f109ae33f9748ee19e87a4b4579ff10f7287979ff20973cff523806e2e58b46c59048aee8bd3153c574eb4b5dacdba30ff61bdd61dfd2d6fdf0313d7c4c0f1fd3c3c7f4f0313d7c4c9fc0c7e4f9fee97ba79dc3653e25f41ce2439a7c83794bae4672ca20ef2c3b1eec26033a17d8ab4962b1c13573cc518f42a3d10af5fc453646d58a8a5fad9aafb42fccab876571e1b730fff87e2c5c6f7d6f2c5545ebc1b8e9aa75bd63595327fd5b3d0cab427e169a473abcea1cc3769d8fddb0fcb9a7af2abe1f07e3df89ea031492259e1fe9a4a7c63686b76f1f5b27f7f4720f023b2afabdeed0eb435b1a0ddcbf41feac7d3fd8be13cee677ed3ba1b9cecef0faecac7f8fde1fffccbdffc4fd3fd8be1f50bbff9fd0f723b00da9f74db5bbd26dad28fbea9dc2e45eee50f565119563f456add0f122f0631c9aaf75016abb55b9b5f470d1153d101dd646b8ea3d3ed75ccf209e722b5c19f8981a14bd7c2c364c9361b7f217c5630d46bef1f442237bf3397b9e115a7fe09d07de79e09d07def9cfc43bc4b60e6266a4e723754e1f143b1b52382122967395ff46cf43ac18f536233c05f12f3aaf28399728397778759a4be3f97d74b17c340ea731a35f9c6f44f7dd8dc8d542b5cabfcbbea5ad3b59aedd2f764661ba1caeeb06393f5c47d531dc635625672cebacb5d07d197ed735b0f903bf681df7f1e587647ed91eb980d11d411be4a611f60b8d913ec6e74ff7a17ea5dee77d3189b241afd00b15e8296fb8a613e83824a7a3d63d013b9ac4ce70dc7ed514a3eaeb42f82d229fb2921f29fd05f4c5c2b94eb8ce9623ae98124bbcb8df37df3e931e46595569cd35a5b7265809f267236518954345ea64df70ec4483de73e43316839de6e34e4cf85c74fc7dfe05b91337b585cfe0b93583b74f6288780f92eaf22f9517a13adf0b65c6c9fbd3ef75694d70b7718a8ba9ba5f77a4548e1e56cfa179d3d433b9e5d7d97c7562db812dffdbdb18fe5ef1f92f027b963db3602f6378b17a119e2517cc93ab34ab26e4f70366cd6a83ffb4bdb7969a30a175f41d691d3d7b7e3af381e4da85e73b80fe63e63bec22de9bda238e77c26793b44e7c2661ff04f51dfc79d8c7873f67e46c4886c6e47ff1e1e4bbc416c87b54bad47b7c09f9a3afe9331b830b42ebbc0f1ef8557674e2bea29c88d7d78909bdb70529ebcfd8c139a3fe7763e64d219d28f7a819f82c9f45e49e36cc25d4fce9ec9eff2ebd822eb0393ff93c15fef7666502e3e3e3eaa754db9fcaba383fb858ced3cfe49647e7f30dabaf019ffdf67a23d82b2e4c4a6114e6cc82bd4cc20d57fbbfa9b8c77fdade2f46b2b9f845b48e9efddbc779f0e78cdd86edc6b07c0dc9ce987a1d2a3f60ad0badff07f58ed03ff04d667309285eb9430ce29d7a97efa7b1ffcbf32b667138805def7db0c0a96facf02d82b66e957f71c6fea238449c9fce62be7f1f1f5d9a5c4b9167bf22bf949fbf3e5f0eea15effec8db78e46d3cf2361e791bff09791b70ee3983963f1f858f809f1ad2f18ab81b991d6207fd56cb3b9fe6dc3acc9cc1eba96cb55f8d5782f5a2f8571ffbbd54d7efa9bb3544c25345e8997904ec6d2a6de4b7fa75f8e18c5e2a3e1db51c5dae1f46f9f6ef54973ad0e49c65b86b5e9a4ca3cf8a26f6973e2d8a9dad8ee904cd1bf73177c5c2bd2796bc7479068d5e44aff48568d795608efc4fe348fc8ff599263a4816a8202b85f21aeb9603c4aa7ddb007c9448be38bc31d608d9c771fe1cb157faf268df3ce879691759cbed5406efb94e3d651e5e444f1bc647586578310acb467c8fc5b5cc595f32e703c912e83104fd05ba83d601ee31526016dbf5fa3749f6a7ea0071c867ff26d9c15e9d019c03a6375f0fc0ec0b2247797cfa2cedc5e79c04fbc44bcfbeaf1fde470bd737f2bc3befb3f8f826222e1ff3de37e9b79de25763745f044d5e469f57cb3f7241beaf14d2c1a87fa27f6e3a60c77c05afad3807bf8937cfb69fc37b96e4e78c38bf336c090e7dcf5e989ec238fdf2f34332d1b07333e47f14cb3b24bfa264cbe73c475b9321ee81e6c62d350162f93bc0ff8226ef8136c98c4a0f4b7bcf75b45abcdd15b1d7e7c8097e3e66ce3fd1368bc26e31761aea69a734d7ba5cff7f7a1efe2ccf427dad52f6fb2cec76b93ca52f88030d698c8ffa7b35507fe2832694c12f33510795b52a2fe12ca72398132a0c57904f00be533d5f41f650781ea98773926cecdffdac515f22b17c1c9de655455ddc3e91abfc23f405be1227e48f45334975b704f9dd1b4d900e94de4478fb9f7c5e7abebfd094e6273d27b0738613fc8e13033d7bf8dbea3efcddb0cf37e2bbf87b49788ad04c80cf29fc7e69ae4044ae10f2191a6e7daec9bd35d5b316d311a5933fc8b783ea619596a541bce4b59e4333f75de70b3ecbabe25d4cbe10f28da832d82916ccc70ad788043a1ef775341723987dd5e82f407e507a623312511e2af4cbb40c120b129d60d67d03d5e31cb579b80ed970c13fe21c302638bed9950ac804b85fb3d1770c37ebc793e09990dfac0ad20eec7d989fedd54d4beb2ee4d4ca39d0c1a8afb6e196bd79fa79d4a706cf4194d6f79f2d8de28a075536c10f5a09f617d34b90cf1df659e0be80fe5e88011d40cc31d87b5cdf23584b559890be4f819c978b0b0ded35ec5d7d47e234641615cca7360093281d771cae77f66d5cc41f8df6a6f95adf10b9648ad2410fe2a2a4d7d454532ae0af3836c557ecd780de7b283e75d0056f6eb90939ed4a93c7264bd613f7ab19baf9ece9c81ec38dd6d688f48b24fa51b617ae6935928b3fefd643705eb18c39e4a93b11fdb85e6d8e3a1cde75cd20e7f94d0ef1cbed7a173e7cb40f1fedc347fb197db48f59cc8f59ccf79fc51c43cf7139761af8ae07b930cea6de25952ed3e9318a0ec9accd49efc49ea9bc82bee4a4cd289a443e236f5db8af7b60371c34b9e3409e9006b2bfd19e9cda5377afb724ef7682c59baf249e12d4dd5fb5ae34fa4ca1cb3be5a8013d21cc7422dbf1fb25dd1ff34da45ec29ff9d809bf7b9cdd4df184671bbdc9de6c105569dda33627821e82e75fc11f95a618b6c3515e286343b7f2513454c1b8bb7ef0fa9756301ed9afc0563395ca8eec2d9967ad898ea5e55b96eee574586027ebe21e7a44437cc8c1b912277bdd1483b5f2e0337cb6717bcc8dc3203e1e2193aafebaa99a1d464690bc0edade9d1b8794b5816fba81e364e2bed86c747246838a49542bf6780073bdea73c3adaf7dff85e8acf406cab3061981f365021b03d3e1046327c0bf9fd5be25fc163e5f9bcfb68db8bfff8c3839c65edd06476c38a937c18cf16bf1f1e6f97cead1c6d6987b331c7e175ba61fe613a2cf2e5edf39ef41d6f798b58267ad44f9103ec1cc2c5d7436a343947d97680f6e39e44ba44f86c8d30ff1373d7c320f9fccc327f34b7c32f8b3d83d40ba7e2e6d46f0dbc0579388f5df64e859621e5439bb1e095231944f1dec3be9150ab9080503ceb4c6eca7ebdfe7444ea2f7825eb3f27ed66c583b55e92fba83d6cb8891a9787d39eca3b8d0e6c798fc0e75737d82ffafeb2100d87737129da91f23f2e3366dc80111464a2707b9ab862beda0fe90b26fb17c21b632d82dcd892194d760771879981f0a3573ea64a4f478720a937309f9735e2077f095e821aa8fd23dd79af6ae9fd91ed83dec81873df0b0071ef6c00dec81a94a688ec31ee08c0513397620789af3ded13496ae9fcfd2d5697a9bc6ba3c7a3b5587cbfdd928a885029d817dd0ad22d52771a7e2b9622385b3f761046ee459f7d9f122df5fefdb74bedcbad4be79c8ad2be456d2cc588a5fa8f8998f7f0153371b12aa7708f2bde0ffd05e79bd7366c5da3b7c3f8a1ff979919b0f13b1f3a0d2ea73ce874de6c1021bd3a4f3a57c1ff3399881132f9c8d153cbf6178fdd8e6de34c550dc36948f17d8361cf12e869692f0e1bde2b1901387f12e75ff547a4ba5b328faea916771ce408fa4a941c5365cc781feb8be0e6df4b74dd1b719488ce72cfa4aa12b6e7a023aa2d7496237eabcb5d464e84f8b3fb32b59fd50f1f758c3332dcfa2a7143a8a8a9f82dc1c8bd2ac3b68658db974921b92eaa371516e6e11f22c75d700ec0279744ec8077343fad45d296b0ae5c3e800b5b59d05e86935df879eedb4ed956a6fa5da58917655d1311be6d67057939ed29a8e903fad933595d635f902b1f150aa3e01e2781b4de9e7f57ceb6753ac1f54c102fb636a36a48376db5868c273ee9c9b90bcdf4979c259ba6e0762c901dd5458ba8139553b829fa0eecec84b3b90a3485ec2cc24113022f19de5fc3ce191bc5fc17c7ec30df835e1398ff8e723fef9887f3ee29f8ff8e723fef9887f3ee29f8ff86710fff431e79bcc62ce5c60b7c96b67acb4ede89c4fb0653b59f0278c1b15cb1427093287d91fefdc20277da55583d8694f74c2f206ef8b2917978622115e8c96f77132c905fbfe75adb94c5fbe0fb2b1fb623dab0e2a22cc50e49427e7da282b5d30a18e7fd51435e8997c4e5ff4892a17674db13e355c348fcccb89263983e0233adcd0b68159f2e81dcd238f7c4b9169dc720c7c00cc39a4da2eba589ec3cc36bd5a71e137e7f44e05df8756ad586abeb585b96e5e1daa9f4fb0d494f60d6d1ee9a841dda358ce1b878ff15144ed7bacdfb59ac87b611ae6abbb3bbd3f1fbf9ec5bbe97ccc62f8d3ef54edf87bf2d63f7bb66ff9807b1dc5e9cfb41c05fac234c161df5dd6af03e53184f938f0b35db8be073eb8021ff0da1cffe8fa5e2ad7ea236c38e20f3508263efc46f929a118e62de4d8c576c0679363b4ede761844be3d6697a38829f79ce9f433fd317a22b2ddfda9aca4ba40c18d65bdd617698c283a73c1bc5233cf607f33d9f879935905eb7517e54bcb7b17b50a26d8468dcff0fb369e624df2f47f733c3b54b1f6fe30c09ee7df5eaf2423c77b9cdd333dcb200f16ae370d287df823a28fd505980bd13ce79447d58c27dddaabfaca6b375721e041bd5fd5e30c713bb02e6aba018aab5350fac6de1c0f7a97d96663c3e61cc6f71679feefbaded637d0e713af23f0e73cca523f4f13ac11b83ebf146d45e829e35a05f1ad806775b63c731e6da5215861f82aba2d68966c5292de8e7b3d1704ff43bae17f3726ea9cf35e0e3ad319f71acfdd1bfe5d1bfe5d1bfe533f76f49c3aa31be72e23b5de3de80341e58433f6ec3cefdd414e7d89c46e706e890cf21960f549d4f48de79f738d17948476baee3e8622fc0a3b3ca92d18f183be3f7be723db48eb9122b83edb4d38522e8b35f5aff84f7f77eb8b88e70c1a427ec2d83d7e68ef1179ae0b3f26653e50ce893eafb85918eb4d47cffe8f9a5272c2ea6e7a95a0843e73b5b55702c13f040a3b5d5c5218f4d9e6c87f3dbde606ffb7d60247426959a2ed68f81ccbccb7ad3de3759a67e4c4ed0393dff22fd4c2c7ff1622d43b0b6c6bce7c5c26ff82cc27b8c0df63b619dd730be382f1fdb97118c0d1a1183f27207f32dafeffcef5217719a03161763e35bdfc37f768dff8c57a6ec0cb7bcbb9b2de5ee97babbba9bdd083d77b5416ea9553972296fe36fa0eac8a5d99b52398c146da98bd25c1b344fd6c05e27b276b288fd2e7cbf59bd7a0d8f1ab5478ddaa346ed51a376718d1ac6edb7ee2b5a69367c3b7549df9be07fc0ae1af0a1dbc13ddda11fa26767a99e9dc563cb30e798125b3c3fae08368e84ec96d7c85ea3b75a27ef7b25db3221f9d487f908b609bd38270be6f7917c11cd0bf1fe4eb037d7508b49df3f0dc7751b29e7141def86674d06e1dac781266b0ef811556178954d4e9f118adb787ae2a00b7b38b3a3a6b4049227e7d9a8884eef6d6b0fe07d183a9c0ca977a730fb81da9b892996f07b83cd0c7dfa510c80f42644f129cfa7c281db097dc59c31073e2fa5f500653062fa1c6c1f279ecc8adec6c4a5ef37e73afedda867ee894f0cd6b8d6e49cad292da4b38c63f24cb7886707f7ad5e3d279bc19d71f3b2eb4703cdbc2fe775573ac4d9fb67dbfce7f1087bc1fca98a2a74003f6455b9b5d2f07c094d2e1e61f6b85fa3033e2b4182fcbc23c4a0746f56cb64241726aadc99eaf9d64c8598e9a0921f29fd45532c1f34a1bef1fb8b02266b54b6bae84cd11c0c2f7f146cb3a92643ed9a74e4997f74861e60e54bfa7e9e33f388c8fc987947aad2396a4a6fadca7db035963847781bf2c9562fc63357c56ec84cb9a12b1dc1ef6d088e0dba03744353accfc0461b81cf129fbf0a334a14af7f94b7aefe3feaac0ca13cfd44fcd8c1b2ed48d7e8523362e8b99330fb07cedd31ec97c5305bae7d4b9af3cdeedd197483cf920b6bbfe54fe72ec67d37596616b72adb87ee17f2cbbb2cad55573ad0b3b9a95adcd873799ffdd3cec5d9a88287b1de949663e42b5b7dde39a9ebff643cb4d4dd8e677f807dad74204e77849a6a4daeafff49f26c244a2ba30a734d3b3f3ff9992c3479bff37a13ac78e6eda563ef987d4db2b798f30def6bdc779367cb4e75a1e88e6433473e7b93096602beeead7551b222e758ff22d91689053c1bef642d01fec37ee9466bab0a12e0c3635334b7307b0dcf0dc1f34aa52365335a46bee398d5df461e627b08e41cd8179da8ef856d26d6c6c0eb80fc579f5699390f745e46b711f231f8761235abc0d1aab99de13ac248de3b9a7087be240ee5e7bfa2cf69c83f01b3041b9d9d2677c05f31a5e2daf4da2620cf4eedf0dd4495f75b649f40dd5f3522fe7d524f083647796bd8152c5f8b1643ebf473efedbbe851cf263e6ddae788e6fba15eae82e38e646a1664838ec12099ead5d679fc8b7228de607f208ff240ecb33aecc711b0ef48aea3fe2dcc59a1bac93701f606cb42b7eedb87d8974e3df793d6e545c5e8e6907fb777386bf3a2fce7a5908f83bfbf49646e41c4fdb9f8f82c9e4ee3ef477f93477f937f5a7f1357cbe9aea7afdfc04fe8d133c4becf8d53ef9b9345e2f7cf8c535f5afb13bd2f71f187f8f507fb998e335363675131b33ee41ae4b12d5ca79e5dbb1c3b101e545df01fbe4e9a62b0be66835e9fe7c354079539cc0bd34f662c87f425c61517c5d66ea9ff45532e7a7ee7797b3214a583b7a7d28cc42c7ec1fad3de3fd9bee5cf1b8c8f35900bd3f8b801f8b183e73ed58fda3be3fb67f376ee3b4f2d94ff4befd38760f219c24a5b1d74cb157c85f2f3646da9c35c4491ba6795cce1ea00b6b6715e4816d9ef627dc3d66805f89d235f8460c118b9c29d1702b920cc3e5075f92ef489d4aa15fa5c48ef8ea90ab10bd7ab4730ab78f6b7cdd6d5f97d44fe0979b491fdf57872cf880dca9ce9a9ee1f3c725b1fb9ad8fdcd6476eeb0d725b81161ad2f186767ad41efab180118a7fe7504e0b0fde67f7bc6a277dff6572f5993cea281f75948f3aca5f5247c93507dfcb153a401de64869399f70367e8dc6c1215ffc997828060be562e6ca137b94c6e13cf6354babf1f651b037bcf44feceec43d21b644d06b259c5b08363ad8e2a11ad233fc7b1cbe3db2f680eef8e992deefb557671849a7a7fb59dba7bd17b77f28c94fd4a3f6ade9f12d5f9e6a4abeaa2a404cc3843e067e7f36d5a5fab135a48321968fb8ee12fd1f7b86bc7a8253479cad1fa0c63e7a7f88efb90375ad733d8f7ac77b7b2053397b8d4ace9857b686302473bb9db07d7f064ee7c4e8dd46421c94beaec8e7e4785ee4776f94e719812f63f264e69dad36efe761de53d21a2278395d0f546f22ffa22e9413aacf673053d1351ba645e617e09c08e021e213811e9f87a6a82d0d3787d6c991c31121db38edc4ea653419c3af5c791e11364b5ccec742555a3353a81fb4685be45cdaf17bd070eee759f1c29bf80dd8abb6dfa15a01413a98ae03b9c0d87f98b3c683ca8ef4ba6abed67b03a5cd93eff34fa5959d2eec976a7e16eb4738e75e28875ae91c63f171944d19933b06bdab54419ac1fc939bdc4fdc5b113506e7f3836be64672df42f5271cfb7f16a6be832cd5f212e41e7a738b4066365a0eb1eb3472efff5c7e389a902706f66c7cec989f56e69aa58bce0cff7f2a8fe135853141c29af0f78fb8974c920f12c779a54d0ced24e78dd1e7747ead9abf4f81ed42e2c23406cc45fabfaeec8313ed5363e5495cffc8465037479fdd2dfae204bae9e363e043f2acabf2e5c23dee5598d9aef42610bb1ac9c519c471b58604b5dca8066f24779cb89eed3c364d8a2dc36dc380ed42afdfb3a34f7a40da7a1e6a8a502c71a38990ffd6f7e67e93dfe27a22a8330af7bee1b05d5264dc23dfec916ff6c8377be49bdd2cdfec9f3d4f0becb7ac2e74b67af57ecf3495d64ecf771c9e18677bf0e807f3e807f3e807f37bf783f95cbd5f6e1d239362e24821fffcbc6f41cd38e8735d68fd3fb0b53e49ac8ced81837284ae5813ef3b9035c5fb722eb793a3e93936f702747c7fab0ae515edd34ff535d5f66998312a5edbf3756eedf63624967fc17aa0e78edc43bd50c00f68bace0ceaf5702f14f045f3c46c93e9efec9aa860fd893624d653fef7aba897eaccab3b247308861083f6ed641e599f22dfef3653c0149dac2e0e6fd7933d1abfa2bea8865bdf6837e87d7f753ec5e7ca9d6065df39f66a94ad2ac5e44ad0f168e08f25aea5ddaaeed251f33d7eecc589bbcec65c285f3424eb09e6ba7c4dbcbeec149f0ecdb314c68894c5511884f404fe0d7279103fdc800e7dba1ecb91f91196eee25ed49ebd8af247a81a7eb00b779ad2ca8e073b3f971ee818f48b61fb67cca33bf874c8f9ba845ca053c8be21ece2e3bbb9139937e1cd0e7550cd08cad36c48a19c11d093d0f7c36ccca0bf9165401fe87c73a2e7611668d3c73ae7d815293a87de9f407652b2352907197fe6eb017cdf381b19e579bf292d4b77cd10ada7d2783a6d47d1740f9ed7ac7bcfe3a4ed289a5646503f35a8c0f35730e35475f70ed0eb488159a56def9c5f9d9e39ed4dd0acc66ae5a0cac539e403e976253f823e55557cc6812f9af478718c03870d9c227fb9e52ec85b077c7cbdc9d0d3cd44dede679d77f2b3d3d8dd8f55cd3bd09b1666ff64913deaf562dbe842f1bb269bf02c411b22fe9cc35ca228fa48d3170c4d27cb9c387fb6e3bde72de4b1eef661d6715623e7d7f0ee8d7adba1dae2de04e2edd08b086413e41f86cef69ddf9f8de5419a0e3adb8f0d3eec611e7c2d3d42ab3759d7bd6428450f51f8d4d15dc657e116b7faace868423d0b35970398e7249be1191c8c6c4c7837862f92f15b9cefa22f17671f428f481722ac80f4435384fcb716e0bc25d49a92b3c476c427c1abd246a36d48d199c2cc6e03e31d0d62a9bf1f1dc28cec0df4df32c2f5a677967d7dd817e82578e0ad434df157cc40679b22ea1b04763dbd4e3c2fa5f039e88a597b1c7d998d96a5cf3b1ebf78fdadcfa9d72034926c53d4f6dc7929b01fd07313c9b63cf60bb9f5238f7dc3f82de2727a86bddc4b144d5e449f579d137d418e223e335d768eb8676e4d178a5998a5d0ac07fe3dce7c2c7c3667be0fa149ceb59f9b7795723652bffe59cf06f58d9ef4c57a16cfb69e0cc1172996a7bab04bef1946ed2d8f7c8ed8e324591d71eee13d4ece034bec21f6d1f960e7fab9890d4cd3492a4da4ea9148fde13feb8a1ee5f8df72cb314e7d1854cf2adf97edf50d03fd8e7bac5275fe697e0abc97b7f171931877649f0fec63f1e3e0a2b635ec137bedae3952e0171e8bd20ce18fb974a22352ebff5c49d0945611e5a8b906c4f5bdbc58bb74fa5e51be75fc196fce1f9ea3169eadfaf1be8c16eacb0b7be7161d354ff2782ea76fe40b6eb4516d6b54fe1fa6df49d47aef9dfb378878078abeb3aaecac8c833fb76e09b621c49b4cb9be0ae8b7b28cd8431edc948c97eed78703660e6d4d71b8f673303d99f6d1719c7f721e129b5b0cbd27b2e3c7eca9c7eca9c7eca9c7eca9b3664f3d728d1eb94677cd354aa8c941318c832a9437ba2be1bae45c8caebb6ef6b611eef5bd4631dbc68c7b5e7530af27c4dfd876c5b3be30ade27df7f10afe7e929e8cc44b1f6f8342fe68197cab2c6e871e70fe0c672423aea8e791400f78671ed06cc37f368b77d9fe73697c99cc8fe7d9a5037c161316cb43afe8213e9fe62b5a0bd183c31191b1748cdc95d67a1e627a2c8d4bb38fb655d9fd49eb05c9e0dea8b95aa11e0171394fd8078bfc8a14dda7c987687d17eba3217b975bea730df003f4bbb883bf863cb7d2a29e7b856d8b6ac6bdbe92e8f3ce5613877f8ce4a2007b07fd2dde640778003d4315ca33edbd3081cf0cd7c1fe9e3ab2850cb804d2bb72087865a3ca26f011bd476023a39a53985b40e5b2fc2a5ef3e5cb805a33d1793d6afe52c49e7cf43ea4ad23595fdecfd6a6d7b5f6ea1438fa5f3e6cba874df7b0e97e0f9b8ed041d41ee0cfcef009b372f4e3f5e6ccd998402b6219faf8b5c01fc8999f1195975169363a4bdd356126c75a958b962640ffe6d033104d7e967a705ab7355f893ec775ad37584bdabba4f0da87e4f2137a6364dd295f0d3e6ddfce47dfe447dfe447dfe4dfa96f728c7f279286e8ebf4fdbec57e175f1ff1fed87646fba9c9bd35dd97f1d197f9d197f9d197f9d197f91fd697998d759cc3878fdac2dfa4b690cc84f7fa1c4828d7d670a14f8273d0d878e41cf5a7dd6851f3af6bfb243be6227f5194afa8e7bdefcdfb84bfe75b73552e2ec7386712c952fc2ce66c71ff50349bca8b2b0d2abeed07710453940a78764fd81e44b108e7887b8fc2ec220bc93c57829ef9903397d5719e20f3cc9c816dcf604626aa2f3fa872e7a75783e3fbbf2117cf6a36b49c2e162107da1ec9fba5d980deb91d381f5c5358deead5c227e12dbc9ed7481eeb1aae69e9aef3c59f8f5a25f37a2b4b5a4681bf5c55a01f4c7b12c432fd5c4ac83dcb6a8a958df22937eb5e1d9e2ed78b4db1e5a0fa25577235b085a0bf67302b9879a6d79f007ac141fe1be627945baf0a6bb4d7e4fcbdbec4af13732ead74573aa8d0175b44f3f3c10ed8601991d585f2ea1c9f5d8a0f81d6df813ea2f823cdce41fa86c450293d76eadbfefd63c367ae9799cb950bf5b61f0952d13834a3fd35f21e62a9c0ab7e8fc9b07d169e63f626d3fd314ffc9d25442baee3e8622fe87f390bcd36f890de9589761175dea998251dab446294f0fe57e8b90997c7fe1c242ffcf816b526c76cb4c9ac36e8413e61d6efc98160c621912f13dab74b61f07bc6f352f62a8895fb714faa2fbfa6f48f44be42dfad93397bb8264d131c41930bbece8bf2977e56bf68a4df026ae923ed8bab6376513e20c839ff79371fd0bce5409d1eccae845af437a56219f37ef1e1cf78f8331efe8c873f83d39f71823152edc84f90d71a9a6113b63b26430af7dfc0ef5189b0f1ace899b6cef154afd68f7e0f17c02272e1522cc1d27e7c9cf83c6cc137b7caae0c345973401ea902352fe83e7bc3bb9674b940f82dbad691a2cbe0f3407749c75f49f3030aabe2b54d24e89b74ab19571e6e463d513cfc489f175ed7e7a0531a0b93f92092210c279e7cc7baa8ceb59edbd1d605f5f2e0e78073d465aff70a7a7fa59deeaf3bff79e44aadd3e79991c560f0b89af08329178f6fca6f3bb30ae51eb33662c8464672ed75d2ca076b6c1d22678363bfe0897c9b8c400e8acec6c8f72d5ddc433e25ea8f063106d4138aa73e9d916fe7623972617ee0916511bcc155b71e6153c5cd5c918e9ad25e8f60868b3733cce6b8a77f5f9e1c869bf840a32eaf2704c81f76f67b8836c067a90e5ee6d41ae791fa13fb2e291f16ceefa82c54e8cbe5e5dcae744faf6e34af57e9116c0683c18a1ce77f29eff0c9c8389a0dcfdee1f8ddfeb47f0535274a035c996f4d81860cb10cbdcc8aff5cf953d9eaa2331d0f8cf0e7d8e783ecc9c8dc377fd6c311d38d03b6a2e1fe87cb1eda7fdb68aff10cb3e3893f38eee2c78437b3c7d9abdb78c1b54134ee91668c7c591a80d9aba63ff3ed5cfd65e4a51dea55d468e3de96129ac9a3fe93e50ffe0d83a3e27f43be8feb8492fc10780fc0af1f79e6f8f324ccf731f1a28458494c8ce7ba184a9c7f93f5a5fc82995f284e0cf7afe696a7b608b5de7439906a13c6f83ff0f32ba7b610673c3d2a8e1eea13235a5b93f4bd21f18006f450738e28ae0d72a381e2b8902f3125fdaeeedec77486ea730fbab09b44d8c8c45741728e6d0df09258ce3331e48981fa2c76808e10a60fc551e67d983777fcacb10f4297cc9e3f72c21f39e18f9cf05f9713ceea4c3f5f10611bae5ce8501c61cf13db3b23ae89732822eb24318d449e25fecc973f5887c6c4fcd85c08a505cf5b1a2efe1e454ba932275dce44c916bf17e39bdcb2502e563dfc0e9c7182a8f8c0997ae5742e6580257e99de5c23ff1fd4e0fb319f1c9d5770db35a6c99e1479c35f931fd3c3a59a44ab6139fb3bd5e1f6c374735e3c202e26307b9d30f418d87b2235dbe1349ecb1b27e08f155cee5b29a9019ffaf802c5085e2f5edf39ef41d6f78857e378751486fd8fcbdf71a1df02ed8bb9db3ad93c4fb06d9c4f993714b56f73b039516eaaa5cb52166634dcad0e0ee923fc6e1f41278f3ca9479ed4234fea33e6493de6c73de6c7fd92f97181ed94fb5d6a0f83d907bf2a370ffb83c3675ef824741abcdf553976716bbc17dda6c9e4da9e8eb3f8f4ccc4b3d7419e29c25d6b753e0bd5e7bfc96b67acb4ed181b7f05fd03a117bf5f3713c6baf678108a5571d71d85dfc109e337bc7652a7c6d18b33d107f6a6b0b12e1cbbba69fc0cddf3fe7557893ed01cd4e9a07e1220b3a097e395e77d72bf134c111b2f847cfbfafae3cf1a66aff5c9faafabad0bd923bceb0c3d9feb7cb11cf0ed14fc7dd6d624670eb94859cccf1fdf03cbe9ec34b903f26fdaac536bbb6abe3525530ffe4c436a5dfd23d5372a248f2107b029d6671af44bcc771cedde7edd84fdc0b3a4289ddc9e9882b55485c984a625e310ccff5071ed2dd46136c11e19542c4dec830f017cc63c3609d64931b883c3f6e83662e314717e0f428b61cc6073f858a6afacece1a6dfb368398dae4f637ea7df897ecf64cc2486ef4be580d1f36a3fbd4d7deaefc678e9c2f59df31e29317efa4a8ff7ff137a2c46f9b21e73447e2fbfea7dfcd18f5eb78f5eb78f5eb7bfa2d76d8a9f89c67f9e0d149a8ffdbd973d999b70362ee2c644917828f0790ca9776dd629ff08e71c85449e1c540e941f66628aa530060ed94f151bf54568b4c37e9833f01227563a1b27417d369527fa4ed964ac1f8ee492dd64ddbcefc9c1bb98d6297f3c7596f8f7e7e631d3b6e969deb2e7f3b86dbf1b74cf13be8febf98230c3d53e88397d5639d607769d5fe91427f0ae2dfc0e0caebb455e76ccde53fb988a175231420c2ea09f3b19c2995f31d3258a17c17fe9f5a0ca6da1770d5a1bcc4f8799a3d50a8d3d495f2a34a3d410a583f7be289fc56a8a5a4e77f1cc4a98130367ac68748f2c9fe779ecbe14f9c52db7709c34721f49ae8e41c92df4bee0231e548056d77abe05736fb63a9af10dbe99c984a607d2470df6a2d9a83886a75f904da80e2aae2917f18c24d8fb3dbca780fded419ce833e7b0fde7d95ba43fd952ab7ec8331fb6cac35679d82a9fd156096253bf433c7c10f60b550680f19aafceeb0d62e0a84701c20203aad74a231cfba3677cab73e908b3b18d7c7ba2814c0c6620f3e6ece2734db1e3cecfd5853cdda4bd42760aaa69053d2c0e496d2ad4b032be376a3ebbd8ca1982b38178058d7dcee15f0edebdd856c19ff97a1cdf374e179dc4b3a9f748b5c353edef38bbdbc7fa153ab7838a73d6cf895144d1f1ab266b4b9dad3ba4f0de49ef042a8fe053d522f2ef15995dee227e3c54167a9ec427289fc669cd7f702fa8b90ef7baf4306aa3fdbbc7e5f68fb8dc232ef788cb3de272fff171b90fa877b8220ffaf7ef17798778813f373cb50f22f1c9994be8b34ce711017e0d7c4e15da6f8d754e589ec6eddb5b9e43dfdda05f246f4ce10e7bc3bb9664fc93de2f324d46c7e407b2fef393befb380670551e64b4cc6365d02d7aa704f721ffefdbe927b9bcca892cbb32c7f5543672e7b7a21cd1ecc7c42104298bfa71c3bf95be63d8d4fad2655eaaac8bf4f741bf923afdcc8aecf542e393711c79907e4d929f5b4bfa8b09ce4aafa29928ee48deaffc5efaf319e677e0fffd16d5dee3fe904d515a9d21cf92e518bffc02b9f5ae29bdc9905e47dd7b2722b7eeb8eeb4f74e96511f63a3456128afaf1ecffcebe9eb2df186877b7e1f5f5f8f924593c190738e45520dbe53d98e3c1f8757dfd208f178649eee5b15c5ad48de3253e744d162c35ceae26ea2bac389aa748e23d9dc6832173fb2f4f911f822622f518e674f171ce4fbc0390ab4fc0759941de179160146d879b80ce73afb1864deb7c0f7a25571dfc841250f7ceccd1082b946ce5c77a5d96fea2b243cfbe1ba47f2fa26f2d17a248da35e5cbb5103fab30c27806bfc3ec458d66aaeb3d21bed35c8d7c057e0f301ea994bec7913c580d13d7d5981f7e65c1c7d4bbde3eb63aa3ff5af5873da3bdf4ae778b53f43c46789be897103f46d27be17b55d8a891d79f602ca7312035c76151fe17e5404cb911e54f4fec0f754c1da8ee4137d9aa24b2b073ddf71bebb937d0c168ff705c02c1b61f865548bc3b8b13e17e8ed79d4de978bf6a1b9bdf07db397bfeff0e2f7edd817beeffce5d267e6f4ea85cf043fff857b64345acea5bf35c5bacdd6f511da1f89e5a52a30368c47dff8ff9867f836ea64df4cc957819e1b63519a7507adac31974e3057628f00fcee23b90833931c234c97497635fecc3fc7b4751cfe21eb38fe43d691fd87ac23f70f5987f08f58c7fc25423f87623b60b71634b9576ecefb07f3348f3da91725d10d8ee94a8731b2b78af337d9d9e88009071fb5a6ca2102bb87f1cca0b21e2996a3d5b2b6d96885b13ef51bac07ce8db784ef951a07237a30b71c5f14ab21bf8ff51f84f559356cd30479425223f9fdca3b92ebd513f696916f47eaceb371e3a9fdb5d5ab29e787ef7b85af26e9fce2e878350ad36c409bd1cfbe1d4dbbfbe56de44d67a10b0ef4fc48cdc70cc5e0987bf1ca2f4dd11c63eef915b4436ea9bbfba5ee9ee2dae07efe7ec49dc1360267f2f3c85c8ac09abb6d9a5d42c731fac9b26eebf9808b96feda59816f28f43c8c2519191bc6999cbe7253dea5c9eee8d8408c2f31f25909b1d1b833d7c5d9597e4cfc59247f9f2937b22a8ad97c4ad99135ec5f243be6fd542c4f3df7c44e3f3766fe51fbc799c770d1bd47a7f726fbefc9cb0fa2d751f526382505574882a6b48aa8e7866bac09a6fc30b924f6d2e4d267c794643fc939c53d8bdabf64d9fa2b742fec71e88ccfa199b9d70b459577d7e86aebe4f9f1ba364e16e273f2d64afe8fa60f427768bdac9c8b96ada7f7380fdfc7ad17e31b2952e79fe4ebe3ffc7ef90447fc17dcf5cdf07caf018ba39b541cea097a92ef49d049a0d745b0ccd6b72f69ae72faf7936c45d74a138bfe61eea29463863ef3bb96b7f6f54aff8bdebacb5b09f82478f9ec84cfcff1e0fa4e81a637013fd796b3b3ffd9c85f28cd15567eeb5b4be86ce46573dbb790d8fc1fcd4adee1ad7dd43bef21d94883847b54cd3c289fcbe0536c2e77e0b9abdc497f4696d595f76a6d8299fd1c79182b3d265c1805f6646e6fc0c53de2f5fc1fdb2f7355dac1f3fce272ead1fe7f73b9fdfec2676dbcd3167b28c5a8f94c9bd6c944f2d4355fb267ae5d658e8123d159c3d59fb3f3fe68130cd4df8ef13d226cc06ba8d6ef88c7213d93dc9bcc79fb76be98d705e7dcaf78f86db4eaf2572eb9bb1240d06838fda83d7dbd0eead75479afcb897bf22062345609fb378229146aee30ffa4aa29388ef31345365e5099f5e0d68cb82b3bb8ddcc5f230904b1547151c4baffe6a5f6e2aad2d75f0f545f5e18fd377e7fb1d23e8d5d918827430ab37936f97d525dc442f45f00659e7dcef353f63f57cea6f133042fa6f81b6b50ae460d0b491b07f0ba00f7f3fc85e9cea7cfcf9fe64e679f06cd4037f389273ce9b82fa7ead4dd1596bca720b31faf3fb639cf67af07a8ea0cffc6779fddf271395cab7d6a0d642ce3963b7bcd178e6ec9275c7bc137a1f385394078d7bb68928471eebc360ed2c4f7abfad0046740cb73031bd1cfd72d32efc94aa859fdfeacb927c58ad9541e1e7b7287dca3c177f4ee21b5e6eb744d36cd219496b55765611b4115f8f13975b3fab6f9a22be9fdf67bffdf17b3def006d6e421829784fa8b5c96a5267a782dec7f5355ebdf47ea52a9563849f14c97298cb003565ba8d6b50deb3f69bbd7e79b357b178206e0d3cb4fcee4a8e5685bada968368c3ae401fcbaca6b43ea6274e4083276701fdf9906cc85367453ec3b9fd219d48d71548188f611d682aad88dca24be8eb752249ad165edfe9e7c11a79ea24a00e17e886f416b4554f57c067963e77a4e16c6647d105cc653045e9dd10eb90e7e4d3862a9457ba58df19c269fe512ccf3bfbdd38bb2fcb39ef4f45ecfd54aa39e3cdc9febfef76e127bd6fdcfb97b68f8eaf8bf03cfdce42a3e526ac4328a0fe94cd7a4bec1d5e8edfdf2702c7bef3ed3f4d6784c768faa8964fcf077f8e3f0bc932fcfcd2096d063a2d67b8b375a847e50de9313c076a077378a1b6cff27a1f517d3cebd2e0bd96cec729fc5b8ae0cf506d8e2a178bc0272ced20ba999b8b91d27786f98aa50ac3800e017b8b7bcb687422b1d039f2809c912a588e2ebffe519dfccfff649e32cbd1cff17c9d79febf4c7736c93c67324f99cec81dc3dffefefb2933b1d7d646ffd358b87f990b6336fef9d762658c9c99bd7efe6b399bfca56fe6a63336ff5a19d6d81dadfecafd99fd33fbdf3f8d1cdcd19eff7b017f9ae3f5c87656f0d7b977efe06b4f99957d1c679e0bd9f297a78cbb30c799672157f85a281572851cfa9f1f6b1bfd48c80a5ffe3b97fdef5ce93d273ce7be3ee7853f4b5f73e5afc5a250d2324f197bf5c3b47f669ed73f37e3a7ccea809e581b6f33cf5f8a59a1f09469ce1799e7f297dcd7aff9f253a6e3d8f359e6b9f09469a3c7e6be94be7e7dca0c6d33f39c7dca88f84fe5c78fe5c8cca2bff74db85bf62933a05ebae2cce835549c85315b659e4b4f9997b5edc23b0cc646e639f7b52ce48562a9f8e529d359c1ff7cc997bd97fffb29d38efcaa40beeaaff3efa74c95ffabca8f1f9bf966353633cfff9b7dca3e65fff5379caa35fe09af5583cdcafcb576977f4dc6f3cc53a6e92e173fd7ddd1daca3c271c7d4023d4ffd4160610cd53e67df473325e7b7fef2f16ebf023daa3b561659eff37e9f6ff7aca0cd623674c4e12fda33f1ead16f3cc7346dfd88ef95fcdda7fb9f6ca45777bca888bbaed8c57705f7361fc395964fe4556b3ca3ccf378ef394a98d97fedfdfc7abb5ff9be0bf985fb417e606dee2ff323c5bd21ed973f2c6273b2b2eda0b93fcd7c859ffe92e4cf4dfd2f8e7ca46ebcafd2914ffcc66e07c96e8718425790e228519ff7eca98a3f5882ceca398feaf293aa224ce47dff8454c5fc8fa4c9fff754c5ffc4f60fa07d3ff72a6f798f19e9c8fff3b5900e0effc2a1190f34580f0eb44c0d787087888807b8900c295bf4012782ffcc318ad47ce62f2c3fbee9fe92021fe67bed8f89a2f1689dc2808d9648191cbd162e2df2367952a27045f4ee4889cc8e773a5d27972c27bcb184151fe122d29cab9bccfd3b962b2a4c8e788a4c87e9c843883c81859121095ff7992bc08e484c788581ae0030b8b039afbbd6f3ff83e8aef1378d0970899f10e1c19cd19763a59cde962d274adacd978f9f27628ff3f43286fde94ce74048ee441716bb8c6566bb42c6dde5bb76bc6d6ffdda1b9424e08bb0f819e2fcd6a6b6136fabbef7669abe63b07f550dc8c944ef6cd35b7a65dde1887320e7895f66fd39735b9cf9bbb3cea42c17e7bf1de0b82cac8b9e5ddb33bcc762aefa186d11dcb14eb2b5d30267dafa14ef6bb5df936cc96bf37ebe4bdf1bbcd9123d4fe6ebfd8bad0fa7fd018b069efc0d1636b5e73696fb8c1bc6d7fb72b5fb1d3c5f61a9a65e9ff9b34abadec48e943f119bc17dd8009bfbbf75ba6511c7a36dd50cd385420394380a158e0fcd365693a12ebb0576bba19bdd670c079b6540f15cbc877969a50c481bbb24b9ce8df1aed65f3a4a113d94bef7d5aa23343676cb7b7cc9ab7649df4f7d1c07c6f8d64e07fe873947c214a075374a089c0976663453bb96c681a18de377c5fd1c9eaf82c424de4ece68afd6ed41e4a7eb3c8f0e07c5d50d9a655a8693273ded4fd5b68781bda932070417da752a6d60bdf9fe2e039bb56962648909d6b3d03b9381bc940cba899276a44e6ed3d1adae33d53705cfc1d70906f359b6d58867e1b754ede45d1bfdf3088761637b2e17785864fa2b4d11aedb5b7efc9e7d817a59d261767c6a1028dcab9d6dd93c1a96ae1e04ad09c6c2417055d589386d730b8000529af5fd3de525d6905e7edc93eea33bb8f120fe05ecd4379e635da779073d9389457aadc72f46a226d841b8c87e508b96c7008bf0b2a7ca6339f4d4e690af32d38b56de0c3e6f44de83b9aeb6cf09099e35b5e3aaab219d1a8997d3fb84edee7641f55a5755095d929fda0ab851a8e47d33fb99a3370eec7c91953e9589a308c6c12fdef01cb9fe8b2a121c30006e528b0a72f8793df36b2e1dfd5b3dfe2f701828d4570d8837cc8c6f0b2a5cf9d2edaf3fae93a4f688763ddd0640f6854951d1806f78d67edd1cfa164965b3f186ebd782ad7b0fcf5f72ce6dcd3684b80a6642017eb4b1852ff0bcf6c6ecace4c85c2a1c17dce4bcb4b4b4d94bce2d9df84b792df39421e920b06bccd1c687e7704ba6a47ac37fcbb8a59b56369c0c681ac48f997486f79dc7073b2887f67325cb21ab54fc9fb431a4227de1f155fae8f913a02122b8765b4fff28ef3fc53f4ca482efefc5de80b1af98c0ecd88df46bd2f5c2d28406ff9cfad45c8bd5ef8774a6f11292342344625709d9e450b6c10ffbb0afd3bb73ed344872442f60cb7bcd3945676244be8bc35b7be3284e137862e6c5dacdb9abce3b50126e4fbd49e44e39f4165a743e2826c6d7518fc617b03995494bc0f8d66a1b1a7c7136fd5ca52b72bb311342c17ebc5b710c66ace3cac9d885da8ef27e05e6add4457f3ae9b5d63dafa03dc0b038dea1bad5a71c68dca0a74a4df50ba211d0cb17cd4065e234f1864d614cb2ed84ba166d3b40ef4edd004bc447f3fde36c49f433164c53160806f943e759d59a4bc98f797a6b877e0b3e62c64abd07b83ef116eb08a7ee3f883794117c1200468427f40eba78690c27e215a11cbf6c895a666e8ac4f6c39c03e80aded24196ee4a5b52ed70fe3687d1b4f3f581ef8f70ee8097e4725019ee86afa9937e55f067b85d7133aa330de49a3df1e1a1e8b1a5ba3c6b4683096d7e87aa229d60e12350cc1b7d7aca618ba3ffa0dd0be59e5e6e51076bd290dcffb8e914fb7d77ba1bdac643585794e942d04b8acdaaa209f061a00f5b2d014cb51f3126ee233e3d9af539e39dbfeeb3bc856aede8fa63511d6e8ac82f7aa98345da9f4c0d0fac919b9aabc3f6abd05c3a31e1ed664c02a89f2b9417f8f839ec11f05b2966e864c0608fabe2a924c45fc731a4e8ca27d567172fa0cbd358f7bf76bfd52b828e2027c0ae7d361f1e9b748197aa1ac8ac6cea133957113f67859c034ebc6fa67a389ce5455c8f9c170e7dc12fc8968f825c619f80cd906fae087da91358c07dc67489aab53df4fe0336a1f7cac1ed6ab215934c4df017a495dbf83867f43921f19664d8a2826aa9c8326051355dea3045f48e434a1010b37a6f06d92e875ba517ab9b56369228c1f5a30e81074f0264e075334515395d66c04cde4d15073fa39f1fc2e4152a05d210364605f56885ffdc2325ca4122451821f0ee92d5d2e1fc2e77ac29b5c38e364cfef637791b30fbd1b4383d4ffb17b7fb256cb98b7ac887dc7ef1fd8d6276bbbf67d4ff7f4663e98aa1db5272d8b1e043d6465ad0872a4e3546dc62ef48ba812f117f90efdbc682c41e45503f31efaac62e97219fce047d48c120655373a3955984c48019c71e0965dfe9ed3ba259ebea9b5ce2b9631ef25c970b0173964b6a73b4dac479a62f9c00c05a3705230f40bfda6cabdceddc9bb3ceca1137b081511a6cb615cc0c685875b640d633988fbb0f62ef8a821aea2c994ed8b87c807fa7887cf1cc58b484c8560a3b06e6475530cd60d9f476b631cf8d66e78c9ec7ce7397bf50a5314521054cfa9f27ea9cd9d60d8a957c0b679c4eece88dd517c76c283c97a86ec43d23ea6e0960fd1d7e899a13547be5bc2fdefe82745f645448ce703fda4ccf9633be5fcf3c7f65ff35bfc3bb790be300ef789c3605df68d07f344dfff8ef1b2bca7f7a37efb51188d391b8857fdbcd7d9c4c5033ee5d908e00359ddf56caa76147f26fb8b30ceb8a9ff33dadf11d2793dccf769b81b7faf02cf5d8f94feb129e6727aa3bf84a233ec33981b6e7d376ab4574d71bf34f2fd230cda437e3ea53dd194fef40c1fd02ee2bde2f53eb5668849a83214ffbe26aedb945b2b4a3783bfc3027f96cab717c41f8686201a6e8edcc78226d766d5c774b6eef95e00374c54b9386b8a7501fc82aadc5a7a367b2c2e088a1dcfc8e7a2d71f63bf5ce43b0bfc2ff49e72f05f1e639244dc52c9a9ee7e690896e74b3d916129bac2dbe7e3080689273dc785a60fbdb52e9767281e23f7ce5f8f707a8faa1d2b536c682aedc502ce5f17f5db24d94f9a884003f023c1d867eaffd37b249e57cb315d077def4db680d7b7c67c76a7bca7e0d9a177fcccb8d09584918206f8dd550f85d7d3a2e4496ea935daf7392fa1bf550fb9506c3e69ddbf1e2fc4bfef7d717c829fe543f80a9ef9724a9fecbb25dcff8e7ed118bbf07eb94e0803e7ef74367118f6739e4d4c6ed3fdcec670cbabfbf14d6b0bb8a86a73acf5d79fcd4117182c96f4be1fc237658c878bf7f221ad7481e0fc08b9fc2975d07eab0a38b6fe0bcf0ac7209c7bf915d0f31ab3df8597903f8cce59ba3f4e683946be02b9322896ffa654ac24cc9062030634e4b0f8e3657ffabd649f44c49edaaabc5fc5cade589ae1884b9d4363906b24963786e0c060e0752ccdc5c886581904b2cd2db3790b49f419197f8ae7d568fa607998fddd094fbbd25a555a31bec2d4bd23bffd16f9bc33fc5dc49f10a6ab163428cebec9b9ade6c5f8664d3bd69e0ef382dff415861132b656fd1671ec96a50a2bc8cb40cdb292f2537a6e7d3a3ac000b43e576d600fc52ff7414e0bc435c5ce92349e82bc2bc88185bc423d2f1d546138a11b6919077eff908ade2d17f96ed7fa8882f7bb5b7c08727bb6a6d29a86631791b4ff39747bbee518423967b84c3e59358a876ea8db7b8b28dd74691ee24153b425e6c3301dd0b17e87f2d5bcfa7ea2491feaafe6b374be900b13c89d86df8ce402e46e9186bdd9915cc77ee61eaaff0c7c2cc563b3d1df46e705040d1f51cd6c03de0fe409f85ead832e14b7ba589e835f58af56b606c4aeed97c5685074deecdd4497cb47afc974c7cb8f42bf77b6a6d2a69e5f9868ae7330f2bde519fe6e7a3fa9df5c9d4b16f8bccf94f5449e55ed587eb2437ed7287e4fc14fc807af7416693e54a88335abb9b52e78f5c297f88823ee41fd3ec413b0efd7f852f97ca811fa2a0ab325db09093a2ff2bc12e5f7c760fa9898f8e794cd313eb0fbf9681f71dc4f14c765ce06f2622dc35d1f2f9009c16f278bc8e7fffb06f15e7c9ef1fa78e6f91153f52e34ed96218f120db29c986209742ff2a320bd68c3e7b9ad290e2701e61b726350fc9e943cbc5acf2d089d9f9c0bbd7ed72a7af24f2a98d5d01ae9774fce5b43ef5ec9ea904b1fc213e543f8dfda41954defb3aa65a9f97e4e9577aba658dc42ee07a91d024c8dd62b4ab36fa7be8a487bf1f45d710c1af46235a2d16e921e9aa31ac1287943c7cb079abc273eb493778ca2a13efe3f15f2e93d2ce69d31d836a4212fe84bc15a1a548d8956add8e381170ff7e8a43091bc5e10531530a03b9cc09f233fffae4ce78122f9d9cab7a97cca906d74e24fe0caab73738e263ad69b427e7722c342f6dfbb2ccd46f22bce07bc78bfd69adc5faaa17d0bd60879a131bc77d91ae77d67dc888e8747d160d58ef2498464666850cd89cf073f2ffcde988f6f5b3315c29967e484a0410646be6fe9ca0bfd9e91e787862088907701f4599c35e1337948fa9850b1d74a5e057b81c2cee7e4c5d06ba1df29ea8c42ef8cfb2481ec6cbae83dbd41102199d3f29bdda3ef3985c5b75a73d7ae554aed696fd76eec0edf6b2fbb7f5727fbefcaee201f5fd6dfa5eca25d5f65bfd77325f9d8cc76a696291f5ff0bf5f8f9d77a3a848ab43c7c92ebf0d66eb76adb96e4f67cb7f57278b6fb5d7e2bf6b2dbd5dcd169581e3fda9ac0effc6dffb56abe8ed41b6a828bbbdf7ac5ca9edac766fef96f96de094be55278bf6bb83ffcf31bf49f099638e8fadf2b7017aaf7dfb38ccc3bbb4df2ba5f67b73f93698ece4e32bba977c7c3d28f837ffae75f476adce7bdf6c67ea98ffaebdeec973e0b9ffaeaff66d2787d7b13bb6abb3a5549da07d6ae7e0792f7bf2bc6fef8b05fc7f47c2df1fccbe7cabc3679289f775f9adbe37dec83b1c667ff94329a8f7f98e3ff7d64cec288a0f53735e423cd006ff5b53a4fc6f888e25a80b5c1bd5d4dca857a833c335dd68d80792cd227a8709790712db3883ee93fd8251b28efe3cac1f67f08e6f2047e4306609864da0bd187fab4e0e6da0f75a33ebf1c00478204c1bb58adea959e6bfebab43a7a616e07cf1bf3dda83f39d6ae6b7c16cf9f6fe72787b6f16bf29bbc5b7300f2d317d7f6dcfb3def7283a6de3f3c6bc525006e9fc8378e7bd9deb1c2da0d3ddbf6baf87245a45b4c979df4e4d05fadeb7c973108feef5f67b0bd36eaed43ebe2cbf49bd85c79b1dd89f5d3b81d6bfdb167c96f3f96360194ab588bfbf5a760fd6e8dff5d5cf37720f789ff725fd6e7eee1725fbe3ecf890beeefb83007a9368ff7224ad0f01a304df0ff2f882c1023d9cd3775e0e5fcc3bf0e53fe20160d43d12ec81f27b2f5b6f371b81ff204dcfbde73ab5de10f9c2669abca3d6cfbfbe88773c7b9db0c76fb233e33dd7f87e7391675b0963f950cdd2c45024a8b7f4e490d82a82bc63bfe3e963fe3df1d773cb7a2cfff7ccffc79ced305817c1a03f01677b323c763f6e51a313aeeb0a9f69bc8ce7b1a5d37ac6d0cf0daf8360aadbe7645713783288bd867d2931f628ae1b9f988293858127b86e6ad514a9c16250432696227b24063d23492dd90ed5f9e2c1711bfe5a6878ef97384c7a513ce93fbcf66ea95553d7de4aa0dfb83d9074d7d903cd8c423231360f3deaec78799292b3804563727e423a09e81762f475a61e385e6ef5f090215c83e8cb67fa8c3d5a2a5cbf8639ae3f4e5b8783bfc777262ddded235cac296d8f4ffdbe0c3eed418fd8832a77a05701c4d2966a38ee7be17a5a0ef4de89cb654cf69707bfa57f1792bf60b7e3818951f7a7f5b53f5891cb17e7f7b5086a461b78e859be3951d1bd3a102ff2eb2f3ea24f6cd58eda57ae5a1fe61cf079dfd6f741e7be85654988e7a87c330e9d13e4f01556fec032a573d485ce126c2bad4ad7d300ffd5b34db163e976c5d642bee5b37c1ff45aa8df5ced3bfe07ca597ff0e3f93c2d04bf4de26988678ce4dd1af207c00f69dc2fe70364ce32dc93f733c7adf030cec9e2f4b7ecb9dd2ad783392b5394569a5259e842d179938b395d6e39c6ddfa147bcf6d46ace1ed85931eee59ab235ad0eb2644fb31fbfa5171466938dbdf8b977286bb3b5d6b145dc69cfd1df36da3dff57ee792a2471efd0f7e79ff83470fdfcfd4c3377c361f6167f416516b488e3bba11f8e626f9c101864ef34bbe07f87932a4e3f469d8dba186dc2a6d3f2703c72b317d4a3394db2842dfc53ac43aaccb3036aa818cc6d9ac2d149cb54df2e9c3671cc2d2a807d678f0b2d0f3adb92a17976317db1ed0e7aa0175079dad6e877821ceee87fcdf1de0ac11f419185410ce361bb355539496d82783fa299bf2de32ec9705e40ea43c17db8f30481af2321c41837755a495293a3b1d725e44f05bc37ecdb8fdbb645f6ee91f1b9dc4f27efb392ba05f8f77ac0dc3cf0be74d7e620c14ffbef7c341300fea7c192e940f6967ffc0570f7cf5c0579f1f5f5d9a87e5e9fddca9bf2de43747358cc5669d1ee84fcbd3485fdb80f2291e49ef69b3319be07372b46a6519eef353385777af471c395727b8704e7045e29ac977d2d6590970ca8ec4fc708d0be4ed381b92e775e2cb4d8edd453dff5abf2ad155ebf8385e8abcc338eb0dfa1bcb29b52882b535e6bdcb9f15fe3df5db48fd77f173007b72adc75fbb33bb573d00c1b5c87ef95d7462e23b47c8c90ff2b1b236c53dcf0d9efd3b9d59fcfbdeefbc7e41dc02fc0a60af1e9b11effe19cfc9dfa35f896b18bfcadd782af0d120bd5fb539e8f4d7db87acffe6179e9b17f77a9389bfade3dcebec706cf27739b3d878e6ddce6ade875efabb9158de6883dcc694733660b73bc945fcdc88350c769cf470c7786e6c3cf37eba4b925aed7bf192a9b42262d75174f9ebf555f4bbdeef5c52fcb51f723e713e21e6dd3e0bef3ceaab1ff5d5bf6f7d75be354f8cebb171a5b478de0cea06ca074d80d9ff4b8cdb503ef14a934d4baffaf1acc937b1b835ab787e17f2d5cc82dc3b2f8f6c6a56d97ed4dc713fe4d3a7cee3ead814ede788a0c9145a8458410ee5f432e749ad07744b076288d98b9f930fffbe6ac7d2fcc3be7fd8f70ffbfe61df3fecfb877dffb0ef1ff6fdc3be7fd8f70ffbfe61df3fecfbcf68df27d77cd3f1fbdbd676d3b1e8043fc1107f0f7a809ddb9740b7d919a8fedc6eec17785950739ba1e6ae3b9c755afdea6c62427f52a8f91e5420b764a90945cb8499498dce74244a6bedac9c186aad34cd52fae0d63d0be83d94cee8ad9534ab5363f6f3ad5af1edb837f0bf20ec16aaf14639d54d11fcc6d9b08fe7b29cd7d8fac3b4fb54ed28fd975c7b1ab2756e92f78e7c35c9395db3131f577c3d65ac9f2c9c8b0c794e4d31d27f963304d82be88500f772e6ba5b473d100c01fa66e59c33f2e191ac48c8fbbac837e6ff3eb9e67488ceff94be12ebc721d7fda4df5e388f1b7a43609b0d66f5e1fa44c8bd17cbd911f436477dcc5e27285616960917d6a39ec843defb5c22df4376f14de43aaa2b5a25d5340c823ee45e7fbe749a861cc5f2529f438f69af9fa46e579c71a3ef186e117cbf533d5f297ae7f71aea2984f3feb65ece3efaee42553a0bac0ba0e7115df7ce2fd3f13a6f49eb23824992697d01b46a783d0143bded39e9bf02bdc07577457a95d2fdf64076d3ff766156a5f777a807e9644db9be7aab5636ba905bea303b1e78a15139e802ac17ead9677c3c70faaeae9e6fad55c5932fa6d25abe29a1bcd624bd80fb3fe67c7d12c13f948cf57a54e21e08a7ef1b4983f8ffa01e98f4c908f5ae0c6ad5517eb3005881ea5f7fbdee4374ab2977aa45162ccb1026a718f873fa8dd0de342711bffd287bb7b7883aa7947a3a0bade1a698c2cf99c631200e2cdd7cf56238f47b4761c1fe5c5ae9d597852e4a7355de2d71bf243443c0ef2bab684bad21597a951b27b0b9cef41ec4f344708e09f1b2909cec1aae69e9aef305cbf6c9fbe9f3a2e4a368cafb55b36ae1b8e20cf7c341fc0d36c296b92fb7cea0df3b466f24e4c627c5ef42eb3e8ec4f2c1ac627aa0e54ea45c7b859828f4bcdcf8fdb01adeb3fcf3861a3fb903331f8afa80fb9ce3df2301cb04d8a515c4881268ba17c465d2ce15ea01b6a8472d6db7f0e3dbe07dee64c325db67d2f114bbfa58f516b6d64ecf77b22addb7e44ebe22f43c3b07d830c2b7fc39fdadc9effc817a88c6ec10cb15604e76d43a52dedffbddb7f87d6e1d4db19ebda48e0378ce4c9b8f2296e1feab37b9b3d562f82111afe4096fe7a6bad00f9f01bbf76e676be2b93a673f47087ecb83072eacff42b2e64d069c9e28eb2bbe4c42983e5da7f7065e8db78e712aa5d72e96896ff2e9b3b9749adb59a6d4bfd7460a4f6f47749f15cc3232a17719f46414a4ad966f59866881adbf022caea1deab1557cf3727288f58f47c1ffe1ef2d68009e879e7af77dec942cfaf247d36c4bc92768ef87b2b647be5c10f837a2f223f8f2aefb71acccd3a54e69a5c9e1b6e7d3782fe6ff20eecc539da37cffe45fe0ca0074a7770e31923e25df9f681e1d5a4fdc072a1f9eac985d47d21df07bbb4be6c0dc13700f2e016eb6564d4d9eb8e953b619ad7f3d2c66cb478e89edc0ffbf820971f78d198503a9b9797a791cfa5746802562371fb04bf26f69da5ae89f8d8ce3f1f92eb90762ef4e7a40614dd13e76984d75fb14ce467f2e84bb10b3f7bd5c2cf883d20b1fbf83d70e8dc8254bc5a0b6a55711f32db9bf7a1c97b87f4dee2dd9ba8ef5febaff27f1ffeff54bf6bd0b713f7a0849974defb4df4b9b4565de910d641f167497f27380b6c27e27b45fa1512e81a63866f91f74df6a7e27c91e68d6d7ce990c85b8e3478afa6d293349ced3c39d1301dd5ae1cc1678d74c6ab037dd70fba2b65419f684ae7d49f9858138dde2fcd8ea73f3ff1f545f0135a4392aeec33f23f4d3720ec836cd24a56532ce4cb6c8a8c0ce25db300bf0bcb262e1d10393b2624ff87f06ee972f295c66fd47a50bf19ecfbb6b630afd11040ae413ca83d19296da8f387beb86b5cebefcf10c2fdf70bd0a759b32b394318f2ef47fe64460ddf7eb82d98c7bcd40f09f43dc3df11cc83aa5416b08e3e2ddfec54daafe07d815ede104380fe0679c044a80f78a3e3e035dbd01b694461a6933e015e6f56c05b2b13cd604233971c6f0e88b4223d88619f477211d339a241b4f7a65cc47a17f7c37ff5e60a9c81b9f13b96ce92e517da21f4ccd804f913e3e78ea15b6a1613db0b373f52fa0becd7d8c1f7208e49f7d37fabfae7b656959937e36a50d9e98227c3c00660637380f7d541653a52fa38ee794e9c27726ed4d5baf31fd88f2a906167fa07c89ae9f5053448adf570febdf1ef92defbd14be9d3f752a2f8ff8e79effe9c91dfe49ce2df37624f3fd0ff48e22217f8d6fc39eecd49023dccef9bbb4862fd559b634fa3cfee9eb1d0435caee5fdf8b59e35c5fa7424c23ca1febd64aaad2a1da733fd6dea53e2dff794affee979a637cf438a9743615f5b8009b96c88403e217ba283668b78384a85f9fb3398596bc2ac336f6e2e9a91a3e72bf8fd7713f0a19972276b426e4b35cade80f7793dc30ea5de89fecdf571c9d819a789321cdb81553b9696d3b0f1a336e0d7d706f836e6d9e72ff8f67ce8fe553b4a1627e7df7abe88dbca8591583f8e12fdd45ede13872fe9a029d85736273204f910aca6d8398c954a96f65768a233d7d0bc50d3d15de980eddd2dccf56b8a619b98ca3dc7b98af59526940f601beb67e49de3b5d2fb7d6d9f424b17cdad013a40cca6dbafe8f995d06fa8774ff011403f6e6b8b7d53819f464467437c588791a239e1bedb273a9cf13b45f2908de7c245af27ed5ebd45140f25e78191b95861f961abf27eaee72117c15adf78b6d14117f6c97136b7bce28849bc620c80e2a6cd466b0b716494470a7e18a5731cc9e686ccd0530715dcc794dbb7e5bde72df5587c3e35c5f3215f091f8d3ad266a4d473da205c1341cf20f3e237ce06c5ccc4287ebb905efd733b5336e7b19c0be3b55fd627e2e1d3f94ff6e93cfa63ff87f7c746fdb193f3a5997a394af6dd36beda728c7c05d973aabb77de14dc573b4c9b94dc7db18790732a963786e0d8a0038711332b6271a3df7f1bebce41e5a8293d9457cdf6ce310ea89e0ee74c1751cea7aaf42623b12e68e1991e4b5d841c3e2fbf09d6edc5ad707d5783aaa580fa2ea4efe15df6805de15e505783e280d0c31bf4218e15803dc76f8fe663f692fefdb53a7deeddf39e3d50e8bee6a177f9bcfe25ba877cc4bb84f8f0036549ca597ecc1cbd68fbf9939e53346eb89fcf1ee99bfc9dce26aeb6fe739e4d0c6eb8dfd9189093a6b462621d29322bf8edb7c8e79fe18b09e45fff7863db343a6e4cdb670efe3bad2322fd06af13aca3713e647f69e42b2b9427e0f9662dd375e6c42fcceb47217a9ffe7ebccd46c955b76519c250e824fa9bfcefa4d8de4deef755ddfa517b57b337d5b9aeb6d44529aac62c624df0dd6144bd553c2ef2be1bd4506aae03f8c4d6646d6bb8c3c948e94d4ca17e6cc23b0880ef7b1355694f7a64ad28efa3e5a01a6d579a692299ed16f2ad6d9a759c9b437210718d93295a9609b92550fb85ee533f7af5f050c7d9df629bde1dc97ba7d930179a5cc0bff7d68a7d5228d758ab8668eb64fffd7cc3470decb935b0822aef73d13926e9f7ba44ee11dfe0aded8d68fb2a149b1af8f1b554b947fbc277b89e0f7c52f5c37850819a0706d747f4e348c1f4e47dcf978150ab565fe98291b4d61af409d1c5f23c7dadaff4bc41e27347737d0cb7bc320e057e3929d4b3a856229c9b7aad8f3cb647c9ef3b233d988f19295792311435472a91b7e7865bce198d7be150f3a0e7a5dfc65fa7e55b5b53f9a531418ac7ee6373237a86184384fd1ab5e6e867dc316e2b207fc941177ee9ec6d5b953b3fdfe49c57b712d94f2465dfddfa7474c841de723654cb70433f9e2e4b595586b8e9eb6d75ab8bf93ac177d7f3e9aad2403227157b43ee07be2fc4774472cefbe39bd733057410915fc88fe7d36ea3cdaf67894ca2bf7f356effe7c5bf54616f1927d829ea9cbdef71ad67e66c3411f701728d707d12e48fa37b75e85e41bf7d4e35d8f460cf41defcbd64baf7ccac1d21e356bc7afd8efe1ff0a15a7ac8ee8a3bab87fff43fd57f0a346dde0bb7e621f6f17bc5207ee5d978b8b972af38f31ce389937dff9c7815e38d5f783e144eba0fff2461f4cfc943bedd75573eea2da2f45c32ce2798f2b6f18160fd5c989e03cb373a59632e4dcd06ccccee9dd469faf80d7c97700908dfe2387ac751f312e4621649efc391a2596a7e36f169b95a98f4e53ae42c1e35b993837c49f0c3411cdf701d41953bc5c086c0b3a9c157edf998035f98e81ca13e197816de539dcfa0d67136523ad9915c841946c1de88ce06f5d4703be7f46c0d7effb0391e36c7c3e678d81c0f9be3b7b0391e391b9f3867e3610f7e5a7bf0616ffc83ec8d88b8c20906b922a650b57dfa49a8596c4d7dbc1edef348baa0e8c28f8b473e2f7add3615b3fe86f6709efd9679ca2c473fc7f375e6f9ff32ddd924f39cc93c653a23770c7ffbfbefa7ccc45e5b1bfd4f63e1fe652e8cd9f8e75f8b95317266f6faf9afe56cf297be999bced8fc6b65586377b4fa2bf767f6cfec7fff34727f4d578b39fe6fef373f8c85bb5cccc7f3f50fefbfff84afc093edf9bf17f0a7395e8f6c67057f9d7bef90f4c3a7ccca3e8e33cf8552ae987bcab80b13fe2164d15f7fac6d7403212b7cf9ef5cf6bf73a5f79cf09cfbfa9ccb6999a78cbdfa61da3f33cfff1e39abf1536675400fad8db799e72fc5ac5078ca34e78bcc73f94beeebd742fe29d371ecf92cf39c7bcab4d163f2f95ca9f49419da66e639fb9411f19fca8f1fcb9199457fef9b70b7ec536640bf65c599e1b7ce96bfc03f17c66c050f7acabcac6d17de62303632cfb9af65212f14cb3978f80afea794cf95bf168b42e9efa74c3be2aba57c8e7c35fbf753a61af595a2e0dfed6b7037e5c78fcd7cb31a9b99e7ffcd3e659fb2fffa1b8ede1aff84d7a9c13e65fe5abbcbbf26e339ffa1679e324d77b9f8b9ee8ed65688b0fccf6b0bc3fbe07df473325e7b7fef2f16f86fedd1dab032cff38de33c6506eb9133f60f0cfdab3f1e011da0ef8a8bbaed8c57e4dbde93fd7fd6c64bffefefe3d59af936fc17f38bf6c2dcc0f3fe2f835f3f9e11d09bdaf3ccf3fae766fcc4ec177ab5f6c224ff3572d67fba0b13fdb734feb9b2d102727f0ac53fb319d8f5257a1ce1c6c4a7e2edbc111ffefd943147eb51e639e349ffe60c4f72b1402a375d2b6b365ebebc1dcaff0f4d2452bc6946eaa008d35bb65aa36569f3deba5d33b6feef48768c1fb96c2dcc467ff7dd2e6dd57ce7a01e8a1bf048bcb9e6d6b4f12418a163e9d5d2fe6dfab226f7797397c720ebb239f3b394bc7b7687d94ee5bd5a69e94a65059367a8aada9a2ee476ba50445dcdfab81bd777bbf26d982d7f6fd6c91af07bc67b2f6cc632a4114028221d460694455c6df54c54194d268b56e8ec9634cf53cf70cb0264ef180776fa74c552dd72ceace24cad8664ebc433201470f66a5d80ce986a50e91197f9434df0e0cf86a2d74fffe6da4e57442387f7f456d58a951c549b1b82e568d5f33d16789f8f23c8164d7a8e0bd1df1e74c99fa128b9dc3b7f3dc2e93de8df63cdefdf0b2ac30d01d3ce99eba27efb2dde7bd0c27c9a83293847e2953a418dc968f1f41e89e7d5724cd741df7b932de89eb135e6b3fb20f17cf0ecd03b7ee2ac2fc3958491d2011910a295189af9a88c224a9ee496dadd2ca7fe563d44572f45adfb846e59efe78747d3e2dff7e1b97b78ee1e9ebbdfc0730755b177e31bd43de377391bc8760c63b1a4f7fd10be29633c5cbc5736c74a1708ce8f90cb9f5207ed51554334c6b9df59a18ca8687be0c3227c3091a36a73acf7d35466ff4a9c1059a51f8b19526cc080861c167fbcec4fbf97dcb92b624fa133d22a56f6c6d24ca22fe07c1a0b777c58c7d25c8c6c88954120dbdc7236919e383216e279359a3e581e667ff7592b91893f214c57ad950a3e2819bab0a34cf4d3c84060138779c12d1f6142d09ba239c69cb1b56e5291d0b25461b5860ef29069cd9c33e51f79b17ba8f2a2e2575e7074a19beac27e05740dfc84b2efa92e9568aab9dba32b60431363cfaa9663aa42e8df5deb230ade4fba976ea73bdb7fe3e1df5faedbf35425ed3d757b6f11a59b2ecde63b688ab6c47c18a6033a3bcca17c35afbe9f68d2c7d39352f9422e403756f8ee64241770879d7a0efe6f84a664ec9746deebb813f8588a47aad29bc9240b3a90c344089826e6f9d8a0ead282c9985ba85e85c98c3a540441b697fdb2180d8ace9bbd9be872f96856a1db0e748bec4d0cf47b676b2a6deaf98589e63a0723df5b9e91c147ef27f51b9a2e2faa62fdd82c30daef1ac5ef29f809f9e0611a728a0f5517eb47b39a5beb82572d7b898f38e21ed4ef433c01fb7e8d2f95cf871aa1afa2305bb29d90a0f322cf2b517e3f3af6fefa8ebd77ed881fd7a1ee539e4d9c0fec9fd865fdc2aec8f83ce3f5f1ccdbc354bd1bdddd0ef951905eb4e1f3dcd614873426e5c6a0f83d297978b59e5b103a3f39177afdae55f4e41f74a40ead917ef7844c6f6f5ad7e880baec59613c513e84ffad1d54d9f4fe5eb52c35dfcfa9f26e059de5a1521c3a7ca00e7f8dd616ad579466df4e7d1591f6e2e9bbe21834e8c56a2b67b8b3356d1f24eaa179cb39994ac762876a6b803b0c32f8249e86c85437d58d9870dd68393ab16d046b6954a1a2c28ba9d393ccbcbd4693b996c6a1328569f5685214995a2f835faf4c570e20f9d9cab72746433a1862f9a8853b6d9ff813783b6768a263bd29e47727322c64ffbd87bae95cbc5fb81310bd6fc11aa1922486f72e5b63c2a480281ae4b12d52aa8cd84973011fdfb6f2288433cfc809198ee49c83aa719417fa3d23cfcf9b1e077917409f45e836b5c41da996462897a392878e5734763ec34e08ad857ea7a8330abd33ceb70119d974d17bae4dd1613a7bb5769ecfa2852b750a8b6fb5e6ae5dab94dad3deaeddd81dbed75e76ffae4ef6df95dd413ebeacbf4bd945bbbeca7eafe74af2b199ed4c2d533ebee07fbf1e3bef46519156878e935d7e1bccd6ed5a73dd9ece96ffae4e16df6aafc57fd75a7abb9a2d2a03c7fb53591dfe8dbff7ad56d1db836c5151767bef59b952db59eddede2df3dbc0297dab4e16ed7707ff9f637e93e033c71c1f5be56f03f45efbf67198877769bf574aedf7e6f26d30d9c9c757742ff9f87a50f06ffe5debe8ed5a9df7bed9ced431ff5d7bdd93e7c073ff5d5feddb4e0eaf63776c57674ba93a41fbd4cec1f35ef6e479dfde170bf8ff8e84bf3f987df95687cf2413efebf25b7d6fbc917738ccfefa565f96e4c36a4dee01ebfc8e3f87bf7f1b103b8ae2c3d49c97100fb4c1ffd61429ff1ba263092626af8d6a6a6ed42b4c4480e9d51ae440419739e4cb42ef3021ef40621b67d07db25f304ad6d19f87f5e30cdef10de4881cc62c86e8f9f6f05ac6dfaa93431be8bdd6cc7a3c30011e08d346ada2776a96f9effaead0a9a905381bfc6f8ff6e07ca79af96d305bbebdbf1cdede9bc56fca6ef12dcc434b4cdf5fdbf3acf73d8a4edbf8bc31af1494413aff20de796fe73a470be874f7efdaeb218956116d72deb7535381bef76df21cc4a37bbdfddec2b49b2bb58f2fcb6f526fe1f16607f667d74ea0f5efb6059fe57cfe185886522de2efaf96dd8335fa777df5f38ddc03dee77d49bf9b9ffb45c9fe383b3ea4affb62796aa25cc5de24dabf1c49eb43c028c1f7833c3e8dba1f9ec67a560e5fcc3bc4570006badcd6f31d9810bfa6ee91600f78534b9b8dc07f90a6e7de739d5a6f887c61334dde51ebe75f5fc43b9ebd4e7f4a1ee7b9c64fe18c3cdb4a18cb87aa5c278622593a914362ab08f28efd8ea78ff9f7c45f4f8c5c8b8c7ba5e509f9bf67fe3fe66c87c1ba0806fd394239ab6537613fc29d91d2ce30dab60e570287cf345ec6f3d8d26913cbe8e786d74130d54d2717617f583c4f06b1d7b02f25c61ec59d2dbdea73345d0775425d354197355a4b752e65c92497a88ae3a0da7d873a4b9ad51daa5ac69deaf8bb5e61df590c26bd289ea4a36eb2a9b6e977e87e147acf78bef63b368e65d45d91e469635bd18bb38de46216a6c0a17d533ab4ede5fb21c603b25fc81fb285bd853ff13a6fc313ffb80e9431393f219d04f40b31fafa94d776ee0d2aa8a3b191af5828368ae5187dc61e2d15ae5f0399fa94b60e27723a542c5dea6e1fe1628d74346ee0df07b4371da10af80e4c7d8758da520dc77d2f5c4f5c175b0e9f6cdc9490ffa0ae0b553b6a5f93731fb05c63ce019ff76d7d1f74ee5b589684788eca37e3d039410e5f61d56c984b539cb013e0a97a1ae0bf7a164fdcb7b5906ff92cdf07bd16ea3757fb8eff8172b63ed3a0e3cd253c2d04bf4de269a8051bc9bb35e40f801fd2b85fce07c89ce5efd34d565a43bd57e8bce2ceed56b91ecc5999a2b4d294ca026aefdee4624e87ceefe1bab90fcc8ff69e7bba7e16ef24d0c33d6b75446babbbe1bc87987dfda838a3349cedefc54b39c3fd6d3a1a44bfebfdce25458f7cc8f9c4d4b9b0eff64978e7318df0318df0d34f238cc03737c90f0e30749a5ff23dc0cf93211da74fc3de0e35454669fb3919385e89e9934ccc09a63d5c86b1510d6434ce666da1e0ac71d724b6163884a57bd04d763c7859b05312712746c79877b6ba1de28538bb9f9e9c08938610ce361bb355539496d82773049bde94f79661bf2c208720e5b9d87ef4a793081abcab22ad4cd1d9e9e02f12c16f0dfb35e3f6ef927db9a57f6c7412cb3ba5b578bf77fcbe4a7271692892c3f879efd1b5309890791f198e9f17ce9bfcc41828fe7def8783a0c7c8f9325c281fd2cefe81af1ef8ea81af3e3fbeba340f8b9a641bef8b74f0a4e17a68621eb5d6485fdb80f2291e9be2eb04ebdb093e27f0492ec37d7e0ae7eaeef58823e7ea0417ce09ae485c33f94eda3a2b014ec11da771f76815f4afe86c489ed7892f37397617f5fc6bfdaa4457ade3e37829f20ee3ac3719624229b52882b535e6bdcb9f15fe3df5db48fd77f173007b72adc75ffb3da76307cffc6d7462e23b47c8c90ff2b1b236c53dcf0d9efd3b9d59fcfbdeefbc7e41dc02fc0a60af1e9b11effe19cfc9dfa35f896b18bfcadd782af0d120bd5fb539e8f4d7db87acffe6179e9b17f77a9389bfade3dcebec706cf27739b3d878e6ddce6ade777451da8dc4f2461be436a69cb301bbdd492ee2e746ac61b0e3a4873bc67363e399f7d35d92d46adf8b974ca51511bb8ea2cb5fafafa2dff57ee792e2affd90f389f30931eff65978e7515ffda8affe7debabf3ad79625c8f8d2ba5c5f3665037503e68427d33529618b7a17ce295269b965ef5e359936f62716b427e7710a70a72efbc3c329824c6f4a3e68efb219f3e751e57c7a6683f47044da6d022c40a7228a797394f6a3da05b3a1043cc5efc9c7cf8f7553b96e61ff6fdc3be7fd8f70ffbfe61df3fecfb877dffb0ef1ff6fdc3be7fd8f70ffbfe61df7f46fb3eb9e69b8edfdfb6b69b8e4527f80986f87bd003ecdcbe04bacd4ecdd6c05f40f9055e16465e82a9e516ea1b6c57bac359a7d5afce2626f427750dc81b86dc92a526142d137a7736bcd95bda593931d45a699aa5f4c1ad7b16d07b289dd15b6be0f70a0bea813dff06ead911dacfb76ac5b7e3dec0ff82b05ba8c61bfac7584d11fcc6d9b08fe7b29cd7d8fac3b4fb54ed28fd975c7b1ab2756e92f78e7c35c9395db3131f577c3d65ac9f2c9c8b0c794e4d31d27f963304d82be88500f772e6ba5b473d10f00cbb7326eb23599190f775916fccff7d72cde9109dff297d45c905bf7e1c72dd4ffaed85f3b8a13704b6d9da90278fec38d8c7a658ce8ea0b7b908fdc15e27285616960917d6a39ec843defb5c22df4376f14de43aaa2b5a25d5340c823ee45e7fbe749a861cc5f2529f438f69af9fa46e579c71a3ef186e117cbf533d5f297ae7f71aea2984f3feb65ece3efaee42553a0bac0ba0e7115df7ce2fd3f13a6f49eb23824992697d01b46a783d0843bded39e9bfd214d1bb935ea574bf3d90ddf4bf5dc3adcfbd9995500fd2c99a727df556ad6c7421b7d45de82b8eea410eba00eb859e94333e1e387d5757cfb7d6aae2f9dd4da5b57c534279ad497a01f77fccf9fa24827f2819ebf5a8c43d104edf379206f1ff413d30e99311ea5d19d4aaa3fc6601b002d5bffe7add87e85653ee548b2c5896214c4e31f0e7f41ba1bd694e227efb51f66e6f11754e29f574165ac34d31859f338d63401c58baf9eac570e8f78ec282fdb9b4d2ab2f0b5d94e6aabc5be27e49a8b6c9ef2bab684bad21597a951b27b0b9cef41ec4f344708e09f1b2909cec1aae69e9aef305cbf6c9fbe9f3a2e4a368cafb55b36ae1b8e20cf7c341fc0d7d39b6cc7db97506fdde317a2321373e297e175af77124960f6615d3032d7722e5da2bc444a1e7e5c6ef87d5f09ee59f37d4f8c91d98f950d407dce71cff1e095826c02ead20469440d3bd202e9376ae500fb0453d6a69bb851fdf06ef73271b2ed93e938ea7d8d5c7aab7b0b5767abe9355e9be2577f215a1e7d939c08611bee5cfe96f4d7ee70fd44334668758aee0c09cb58875a4bcbff7bb6ff1fbdc82beb6d94bea3880e7ccb4f9286219eebf7a933b5b2d861f12f14a9ef0766eaa0bfdf019b07bef76b6269eab73f67384e0b73c78e0c2fa2f246bde64c0e989b2bee2cb2484e9d3757a6fe0d578eb18a7527aed6299f8269f3e9b4ba7b99d654afd7b6da4f0f47644f759c12c23137a97414f4641da6af996658816f48a5b0116d750efd58aabe79b1394470c356087401770d78009e879e7af77dec9423fe5247d36c4bc92768ef87b2b647be5213f09f55e447e1e55de6f35989b75a8cc35b93c37dcfa6e04fddfe41dd88b73b46f9efd8bfc19400f94eee0c63346c4bbf2ed03c3ab49fb81e542f3d5930ba9fb42be0f3e9afab23504df00c8835bac97915167af3b56ee84695ecf4b1bb3d1e2a17b723fece3835c7ee0456342e96c5e5e9e463e97d2a109588dc4ed13fc9ad87796ba26e2633bff7c48ae43dab9d09f931a50744f9ca7115e7fc532919fc9a32fc52efcec550b3f23f680c4eee3f7c0a1730b52f16a2da855c57dc86c6fde8726ef1dd27b8b776fa2be7fadbfcaff7df8ff53fdae41df4edc83525027f8fd26fa5c5aabae7408eba0f8b3a4bf139c05b613f1bd22fd0a09748d31c3b7c8fb26fb5371be48f3c636be7448e42d471abc5753e9491ace769e9c68988e0abd5e4017819c7c7536a897bf2b65419f684ae7d49f9858138dde2fcd8ea73f3ff1f545f0135a4392aeec33f23f4d3720ec836cd24a56532ce4cb6c8a8c0ce25db300bf0bcb262e1d10393b2624ff87f06ee972f295c66fd47a50cf6aecfbb6b630afd11040ae413ca83d19296da8f387beb86b5cebefcf10c2fdf70bd0a759b32b394318f2ef47de5bd7d9fbe1b6962af8d20f09f43dc3df11cc83aa5416b08e3e2ddfec54daafe07d813e3c104380fe0679c044239427de71f09aed11cc8ca130d3499f00af372be0ad952983bf1bcd5c72bc3920d28af4bf867d1ec9454ce78806d1de9b7291ea3dd52a365fbdb90267606efc8ea5b364f98576083d333641fec4f8b963e8969ac5c4f6c2cd8f94fe02fb3576f03d8863d2fdf4dfaafeb9ad5565e6cdb81a5476bae0c930b001d8d81ce07d157a422b7d1cf73c27ce133937ea6addf90fec4715c8b033fd0364cdf4fa021aa4d67a38ffdef87749effde8a5f4e97b2951fc7fc7bc777fcec86f724ef1ef1bb1a71fe87f2471910b7c6bfe1cf7e624811ee6f7cd5d24b1feaacdb1a7d16777cf58e8212ed7f27efc5acf9a627d3a1261ae53ff5e32d556958ed399fe36f529f1ef7bca57fff43cd39be721c5cba1b0af2dc0845c3644209f903dd141b3453c1ca5c2fcfd19ccac3561d699373717cdc8d1f315fcfebb09f8d04cb9933521b7a51a656fc0fbbc9e618752ef44ffe6fab864ec8cd344198eedc0aa1d4bcb69d8f8511bf0eb6b037c1bf3ecf3177c7b3e74ffaa1d258b93f36f3d5fc46de5c248ac1f47897e6a2fef89c39774d014ec2b9b1319827c085653ec1cc64a254bfb2b34d1996b685ea8e9e8ae74c0f6ee16e6fa35c5b04d4ce59ee35cc5fa4a13ca07b08df533f2cef15ae9fdbeb64fa1a58be6d6001d2066d3ed57f4fc4ae837d4bb27f808a01fb7b5c5bea9c04f23a2b3213eacc348d19c70dfed131dcef89d2279c8c673e1a2d79376afde228a8792f3c0c85cacb0fcb055793fd7f3908b60ad6f3cdbe8a00bfbe4389b5b5e71c4245e31064071d366a3b5853832ca23053f8cd2398e64734366e8a9830aee63caeddbf2def3967a2c3e9f9ae2f990af848f461d693352ea396dc0d6980433c8bcf88db3413133318adf2ea457ffdcce94cd792ce7c278ed97f58978f874fe937d3a8ffed8ffe1fdb1517fece47c69a65e8e927db78dafb61c235f41f69ceaee9d3705f7d50ed32625775fec21e49c8ae58d213836e8c061c4cc8a58dce8f7dfc6ba7350396a4a0fe555b3bd738c03aaa7c339d34594f3a92abdc948ac0b5a78a6c752172187cfcb6f82757b712b5cdfd5a06a29a0be0be97b78973d6057b817d4d5a03820f4f0067d88630560cff1dba3f998bda47f7fad4e9f7bf7bc670f14baaf79e85d3eaf7f89ee211ff12e213efc40599272961f33472fda7efea4e7148d1beee7b347fa267fa7b389abadff9c6713831bee773606e4a429ad9858478acc0a7efb2df2f967f86202f9d73fded8368d8e1bd3f69983ff4eeb8848bfc1eb04eb689c0fd95f1af9ca0ae50978be59cb749d39f10bf3fa5188dea7bf1f6fb35172d56d598630143a89fe26ff3b29b67793fb7d55b77ed4ded5ec4d75aeab2d75518aaa318b58137c7718516f158f8bbcef0635949aeb003eb13559db1aee7032527a1353a81f9bf00e02e0fbde4455da931e592bcafb6839a846db95669a4866bb857c6b9b661de7e6901c445ce3648a9665426e09d47ea1fbd48f5e3d3cd471f6b7d8a67747f2de6936cc852617f0efbdb5629f14ca35d6aa21da3ad97f3fdff051037b6e0daca0cafb5c748e49fabd2e917bc43778537b23626fe3ec8c0b721c19d951399a4a6507f57c54bf03c086284730a26f41a26cd112de874b26c6da96a1b8dcc08f2dd234134993741c60876b19c11f573f8c0715583f63d344f42249b167c8fb9ebd56b7bcd11bb335d43a806d8e6caa84b31ee62b8e2a801fb13d19d2bf49dd03fc7f1e5f127ff9d110a529f834d54305ea16000b79f178d88b4150f78264e97c06bd007c5ef06afe7713753e23bd17c0f7eddbabd4bd99dfd0cfc2fd17b01c46f9a5e033aef2d39b2e1457e3418ebee74df3f04e7988d17ba97896390f1aef3d6ca1872df4b0857e475be842bdee00363d186219c5778d03d4b7e560e62e925b09faaea5bb9dad31af6c0de8bf04b85628af0da827e291ff4ebfdaaf72c7d896ccb3d66adcb3aeb6194edff584f692698eb58378ce2e11cfb1f2feb6b8eef4fc55a562a9aeb34275ac09ba7f2017a77abe05380dfacc1d9baf1dcb10eb36d4f3f1f895a5d7d62b37a61152def3410357d04067ab2b95adee027eca85d69274fe217e7fa5cfab70e3b34f78bf9b9e7b3053fd8233a7668f86cf9bda0b38a325ac056659d232ec4d0eaff94e31468e7729d827f70dd1eda7d1f597ade554677f547e1aa55763f28bd3e83788759ce48eb54fd690220ba2cecbadaff4d738fa8a3d638e1cad73f025cdebcd6fa7bf67f735f5dd367a3e322734fafd23fd3009678edfe9e4de215a607f774a1b942e8b964de976233ec3dc52775839f7b23ffd5e722e62040f42aed72ad69688cd41e5a56b3e4cac0af59deac78dff63e8c356e5cecf3739e7d5f5dbe7db12aa5b9f8e0e39a8ebcc866abd2fce73a074e56d632b5c723cd12ef17e3fb9069fbc8b654b6bb4c16f44db43132d54c35b710db7bc86fe257abebf809ac590df9e1bdf38f09ed0778c7ed63afc2c5a9ea6c9dbd49cd805a195647fbb55049d8162ad870a35839dd7f7eed57b8f0e5effd3905f1de784e035820f90ae69a6f601f9fe365ab50c3925ac9c44bebd919c43be62a87b867f9ba2b5340e90cbb85f81ac81f7e1edcd70ba2e3c6f0b9d5d2b67b8b375a87fe96411c9c367d4917867715b1ea2e92889576af4f7d27802f9e9fd3a705a4f43fcab9ec575e636f6d567717f37e8edbc36c4bde76b851857a35334f2e7f9f0695910d21d5763fef89cd1785be7a41f623c0f3824afd6dfe7b971203d1d76935103fa1c1480a6c0764079595eefc6d0de9cac35a6ff4798c65dfa8c78636ca1733da74735f42b003f00e48317fd1e15a0ab910f1e7aa75472c85f240cc3b5ddfef36eb0666a2d27eb4cb61f085f24e9e1d3759fa98759bea2dffd5173f4dbd51c3de6917ce67924419ee94a1be4766a2c1ee2d023ff09b6775c1eec64717a2f56ee26d21de028986f1ab3f688338894f5d5289b25813ed83c4ff677ec1a405fcefb075dd8ad11a6c4b16c15efcf9d7c72a806c770cbab3799c64db85ef6643f58ba4f78ee1deb0182b8790eecb9158ee393bae5887b46ade323727be37239f8f883fcb66a473dff1cfb390a438670fb1adb27d1f8262c9b4ef0fa8de303a1b84e923d41d96761dd12631f6af9d6d6542ab8af8f3a2176348df7c136d4ddde166a0468ff0fd8b667f4a09ac6be5b9aecbf990d41fbae2adf4f7a8cc763eb21e403857d5fe6b1295a395dae6f60e6842af72da85dc0b98161df03970feb81751f58f781757f53ac1b911f11c88a07e64dc0bc69790551f893c5148974f8bb60dfd37d78c42b79e29549fbd63b4326c4cb1edd2db339b8ec15e9cb8ebe5f442c2a92a6c2796decef9835d889787f7eafdcc424fb2d8c3f227920e1b977cc6338d3fefb47e63226e6429dce608ab02f6e1e4f493893f059b37103cc179556841d4caf39a1778904587f6be2fa95502c047a618ba1bcbb89919776208fcc463b54a7edf368a37d466c3299a7e8bd4dc94f48c3f81131a7539991ccff713410c1232ec4aa726b4d96b26f8a1f9f43fe9e37a5e3988dbbc9ace4f7f85dec930bd6718a636fe6f3f916b957c9715896ff6edac34b15ea9b3739e7684a6f1df8854016f4d6862025d6d8f484faa6f98a7e1be2a7537e41b557249e9e47315988a937da2cff4fa096aad9e8e40c982f36efb33dd57df972967f452e401d93635673befc4672b29a3bb2ef7eadacf0f308a2716910cf74fcb9adf40cd78b721942717f54bb09e7e2f542f7629ff5952e1868960fbc3fae07855c0c6124935a24cb61fb5af8e7e2cd323d9a72a06f9a22cd6785b0dc8ef7eb5067163133642e1d46831cddd323848dfe3d48f62d46c9c59bc9e5345fdb47c8e038df0afb6e09f7bf233e7cd41b3dea8d62eb8d688ca6c9acaee9df2b8645db808e76a20f22f4f9a7c43434d697662caef90fb7c5902de6eb613b596784756c2b993e1aed1bc7af52ce31117b11dd9c43bdafb56a65107ad76aaa0d37eccd7adc796ec9fc1bbecfd5182a7cef35cf7c8f61f83793f0bc8f5be1aad709f36e24ef16702ae4dd5ae0131ba1d929cc5902de25f9886826b0b4026cab0de8f93795053c2f8c6df9fc68a7f2f61f371385f67570f421f5fd031f460b143e0ef52dbdc5f99dd6ea3f7cddf1beee88de1067e8c0933dfdade223a7f47ab29e644c14eb5f78f4827df4827df482fd3d7bc19e154fe82d82778df773d8fc754fc9e790741f8291abb6af13e3f2d512df077eaff496e5eae47ffe27f394598e7e8ee7ebccf3ff65bab349e6399379ca7446ee18fef6f7df4f9989bdb636fa9fc6c2fdcb5c18b3f1cfbf162b63e4ccecf5f35fcbd9e42f7d33379db1f9d7cab0c6ee68f557eecfec9fd9fffe69e4fe9aae1673fcdfde6f7e2c7f2efe6d3be31fde7ffe095f80e7daf37f2fe04f73bc1ed9ce0afe3af7de20fe674f99957d1c679ebf64bf1484a78cbb30c799e782907dcab80bf3c7da463f17b2c297ffce65ff3b577acf09cfb9afcfb99c9679cad8ab1fa6fd33f3fcef91b31a3f655607f4c8da789b79fe52cc0a85a74c73bec83c97bfe4be7e2d149e321dc79ecf32cfb9a74c1b3d269fcf954a4f99a16d669eb34f1911ffa9fcf8b11c9959f4f7be0977cb3e6506f45b569c99f7d6856cf90bfc7361cc5699e71cbcf6cbda76e135066323f39cfb5a16f242b19ccb3f653a2bf89f52e16bf96bb12894fe7ecab423be5acae7c857b37f3f65aa515f290afeddbee6fcbb293f7e6ce69bd5d8cc3cff6ff629fb94fdd7df70eed6f827bc4e0d362af3d7da5dfe3519cff94f3cf39469bacbc5cf7577b4b64254e57f5e5b18de07efa39f93f1dafb7b7fb1c07f6b8fd68695799e6f1ce72933588f9cb17f62e85ffdf10808017d575cd46d67bc22dff69eecffb3365efa7f7f1fafd6ccb7e1bf985fb417e6069ef77f19fcfaf15c80ded49e679ed73f37e32766bfd0abb51726f9af91b3fed35d98e8bfa5f1cf958d1690fb5328fe99cdc0ae2fd1e3082b263e156fe74d98f0efa78c395a8f32cf194f31366786d05940733424a85c2b6b365ebebc1dcaffcf10ca9b37a5331d4103c441716bb8c6566bb42c6dde5bb76bc6d6ff1d7168fb81cad6c26cf477dfedd256cd770eeaa1b819299dec9b6b6e4d1b294cf8ada5574bfbb7e9cb9adce7cd5d1e03674473e6375ef3eed91d663b95f76a05823a5ed3c45754e47a5405cbd1e5d72fcdd7e5fb7bb6301992f7f29c1e0986788b51e4b4526dd10d23c2ca3614c0a8e460388d2ae060b34815ded206489441850ac135309a8ea74361ebb69e87e1c9a8406d67a2e1391d1c984609b71355d1ac91bc0f92a162074550c30ef90346f4faa9df5c5ddc1a2424d37bcaa13089d19e64301a0d091ce0c71114324681bf14908df65901fae9253e4717eb47b39a5beb82e738d0aae7af27e21edfe2c1584b8086ed171a37c16f2709c0cc2d1f55a1be7a5334c7987722000d97117a728fa47d345c491829e859eb91e81c4db17c180fee93c44a3d3b74d69f388071d0146d6908ac811a47331f04e01fc3537f9be1a9294ee647e2fe2371ff91b81f9fb88f8687dd8d6fd00095df856fd0a0b65fca37bad0b97302f37eebe3fc08b9fc1975902e96a7b109d6f73bab9d7a6767393c2faa01d5e7e4a5f30bb93f2061811e3eb546c339e231438a0d18d0d049f0acf67af2bd14276dc49eb62c5ddcc7cade589a49f4059c4d63910d0cfe839a9e05c3baa3783a6defc86f278bc8e79d91184cfc0961ba72f79691efad75b93c43c179b967c7dad361beb6f57c67aacabb350cd235840f4836669abd25146c57a1b15c53f41bcba525b340939fad2eee1d1586880b059c584c06971771b3aeca814ab6a5124dcf2ad8669bded1ef76ad8f28783fe54eba3d4f353f8b90a56f2f9c7ae98eba9d6a667757dd5eb5a374d385c95ff39663ba4e945f8b4d6c22be9ac9c0f71355ea90dc321ea4f3856e5720f9057eb304ff2934b383041e48fc81211fe033c4cded021fcb1c06fa9422137ac0b63771f2d3482e40620cf2b14133b11124f1bbe5952e984bf08535c5f2418364a8aab57c73879bb76a65ade73b59488e5205f06f5776f0fb915cce19870ae5e3a9b823a57534ab33fe82a03cb59ff46fd21264d212bb299ff799b29ec8b36ff1fcd40af95da3f83d053f211fbc2158693ed4950a710839b7d5bc24a54b7cc4a7f708eb308a1681beaff0a5e6f97ca811fa2a42a6a4d809093a2feabc92e5f72301e69100f34880f9ed12607cb902b1c6e2cf247dfceef91153f56ea8986a801bcd8ac88f82f4227caecbe5ac36a031293706f5def3967aceb5309d9f9e0bbdfe11d8914a2bab295636bc46be04e2dea0e2bd3b4ea2a5f10414a8d1ffd6e62d4b13bcbf7f13eb53b321597a7537d1dd3234bcb370611a606ab45e4dee2d436b8eb7176f5a8866b8d22a52de84e2e52d472785d9a7ef184543aff8ff6c8d0c58f4060c22db069e496c9b91e8ec9a0d3fa63e6b8aad228e87a3bd7eb32b438831528d70a121ee5277fb80d3b27a9e4ee006f9b98266b03b5381212a9d9b24a4e3e186a47958840c0bd97f1d6f0063257b46a3d7d3fd124383199935ae9db03d78fd1a4d512a44375489a4411edb22b1c8053f6f15c5c7372d5499877066787d493921f5fe5297a5a329d6d746d80e8e3a3fd44c4c83e174032fcfc3149d350c53f7683788bd361ba685e833c0ce67d809a1b5d0ef942227c20345bdf7cc65476cb3b479c5f30bb89ebc53ecc9a23d7dd9b51bbb43a7f6b2fb77ad5968d72aa56fb5d7e2bf6b2dbd5dcd1695c164271f7b0545d9ebed694ff85ecfe9ed1afef77b3bd7393aa67c6c0aca60b6fc36c8eeda76f6f07de094be55278bf6bb536a3babdddbbb637e93bc3fc7c756197f6fd1aeaff6f07fffaed5bd6729bb837c7c597faf6797df94dde25b75b2ff4efe4fca2ed16752ee6b7b9e5dbea1f77a3d76de8d82a2ecf6edc66edfb667eb6fb58ade1e648bdefbb54cfc9b523bb7dab525defbf604f49bf757f29c35fc5b3ebe1e14bc8e7fd7dabb6f0367f8cddba7237a5ead8e9f975d7eb72df8ff1cf9feb7f7c5023eeb4839bcafb38552df6df0f77f7e7b2f2de4f95eff76c8917786757ea1dfad45b06bc087e9392f340fcc90ff6d42f9df6090f25a533a5955ceed5273a36650c430f4862c43919aec35a5f6dea142de81c436f8e95e48f40b46ca3afaf3907e74d13baed1bac23e889d37c8a185f3bc0a8b6fb5e60ee8bd3ded211ef80e3c10a60da0d1ecf73a9c7d33db995a7036f8df88f68a8ab43a749cecf2db60b66ed79aebf674b6fc7775b208f39083e97b75f837fe5e40a7bb3d3e63cc2b96f92d9d7fe0bdf6ede3300fefd27eaf94daefcd045aede8ed5a9df7bed9ce1478f2754f9e03f4fb6f789e836957d91ddbd5d952aa4ed03eb573b03f2ffb045afff2ad0e9f493e7f7cabef8d37f20e87d95fdfeacb927c58adc93d404e7cc79fc3dfbf0d7af6a9ec8fb3e343fafa55173a393cb434dabf1c990788304af0fd208f6f46dd0fe5f49d99c317fd0e1446669b9905babc85733473c13d92ec815ca7d61bee2694ff204dcf917c4ee06547a7f6eb8cf59dbee3f9eb9ce9423ba2b832f65c5b74915bead9cec2d87e142ec2db9b727d45e4230cc655e502fb1da48fcfd8137f3d3172eda26254fff7e1ff8f3bdbbebf2e1f83ae9780654086c7ef075b889c7c86a73811ce205490c99c69bc8ce7b1a5630a30229f1b5e07c654b76d7a05f6de2e89277d5ccb31ecb137a8e041e895fc48e92fa0c8d76be4b59b802e03fbcb5450716e560f1a5d41e333f08d6eb44325284cad7a0346de3cbb746eb8e59c71c68066ec3b8bc6a497c59336a899efc9ffb398e58cc12133ffbc8bf41074622be2381b0cb9767417ed5b2e3458a3e19f7391ec17f28788b8705a24ebbc0d4fa4af3d897e63f6c0913623a50e34132afc8dcf438f3a3b4e9e64ede8989c9f904e02fa553a3b553e69801027b72a5e8366e9688875684444e4337dc68896deecebd760baf595c9d1985ac2dfe33a9399b3d144848ba1c914e253fc1ccaeeef2c54a565a9f9be63b8104b73a6b758cf4734bd08afb93505fc1f7dff90beaee1ef71f9e2067271866b2b408e797b86f41d925fde33d1107dbffe02866045c9c0f89c92749efd16b9afc9b90f9b8826e53e5dddd4f7e152b96f093ae79dca37e3d039410e9fbdf306fb572b9691ef6c55c1816145b3503d0d1e4a06cdda9a628b6dd4758e0d18ac85fecdd5bee37f9e9c85f801e4195cc2d3d46f93781a063e2cf56a0ef207c00f79bc5bce8700c3bd9c55880f3f71dc0af2ad46f22e745e31e776b35c8ff05a5a594dde3b86686d7577b8d6e7d25a057bef5ef9d1de734fd7cfe29d047ab863adce6e0445d9835f186774a4c1fbbd1aa5e6a5831e11ef8ea2cbe8fdbc67be6df4bbdeed5cd2f4c8a389e2a389e2a389626c13c58fb033aa76d41a92e38e51f8e616f9c154cd469a5fb21de0e7d8865e91d87b4835f232829c0c5c7beec94bf049426ea32e4b591860648aaf17616c54031983b359ff4570d62d924f1f3e631a4b3bb86ea26a6d0dd7b474d7f982f7cd6bf22b822fbabcf916e28518bbbf111eaed7f4fc19d9f16037d1640737b3eb2c75d7cce9621d7239217720e5b9d87e24838e15cd6956adb529efb32368b038a880cfea083ae9db80dbbf4bf6e596feb1e5492cef94d6e2fddef1fb2ae9aeb307df03e3d3fe08fb995a2fd2b18e31efdcaf360ce9f30e9337f9993150fcfbde0d07b9e58376810cd7f3adb4b37fe0ab07be7ae0abcf8faf2ef345ce3dbd1fd10035e48b1c420d636336a1b14e489e46fada289fa28206b4607d4b0fd777427d7edeec7375776ec9917375820b09ae485e33fe4eda3aa1992bfeee5b95c4fc405ff62d350fb8af88f3bc4e7db989b1bba8e75feb5725badccec5c7f152e49d87b30a6b3c9ce85bbcae6be1e1ff173f2bfc7b9a1723f5dfc5cf817e5c3cebc118b3b01ec9776b304f3d33dc47e913cbdde4773e95931fe563656d8a7b9e1ba2a9dfe8cce2dff77ee7f50be216f59986ecd5b61df1ee9ff19cfc3dfa95b826ec57b91b4f51314ea4f7bff1d0e92fb70f59ffcd2f3c371cf75afbfe3641bad7d9e1d8e4ef726671f1ccfb9d95294a2b4da92c60c0ea9b5ccce972cb31eee78341cf3d5d3f2bb313e8e18ef1dcd878e6fd7497349cedefc54b39c38d885d47d1e5afd757d1ef7abf7349f1d77ec8f9c4f884d877fb24bcf3a8af7ed457ffbef5d560b724c5f5ba8c0d981acfeb419c2edf9aab72713976716e1bca27de3b1aea4142e2592f0b18ced41403ffcfb7819f7b87f3c83ad9937ed4dc713fe4d3a7cee3ead814ede788a0c9145accb7e66f50234de7439eca71db10acad31ef5dfc1ce6f7dfe269fe61df3fecfb877dffb0ef1ff6fdc3be7fd8f70ffbfe61df3fecfb877dffb0ef1ff6fd67b4ef936bbea9f8fd8d6bbbe95874829fa087f3472dfdecbe04504317aa459b80bd4ffb059a55eb68ca9d4553aca3bec1cdd7f2a097755ebf0d2a394380de4d90370c3934ce5c7761406f6f82677939e7e4c4d06ba5f78ed207b7ee5940efe1f08cde5a2dd22b8caa07f6fc1b50f31cdecf5553f4ed38f0bf78b199508d37caa99e205c3808e5d89cf0f49575de69f7f916a9ff926b4f43b6ce4df2de91af2639a7eb9df571bd86f69ccb4fc6e422bb902bae46f9cf1ad211ed39eacf077f37375057ad552b78e6dcf04cbf5842ded765be31fff78935a78ea71b4ee82bb97e1c7a1f9df4db0befdd6ee2db6c07c89347761cca81d7f3fd059229687e5c05f994c332e1b27ad45379c8799f8be4bb33bbb15c3f60dd9950d340f52147fdf956e9343da8ec74c1d9982053503f4990ed5201fe0d35de90d7673466e8fcd4704f219cf757de911ccca668598630f1744103ea49e8ba776e994ed6794b5a5f104c9248ebae5504fde5f504ac847a57f2d1ffebc47b77d2ab94eab7876437fd6feda0caa6f7f7aa05fd1472aabc5b35c5e216ec0515f3026059e4f716a5d9b7011f0f9cbeabb635dc9c85fa2a565b39c39dad43c3f8c3f23cfc0cd7ebfff8a6107d12c13fb48cf57a54e21e08a7ef1b45837dfc7faa2b1db15e5c877a5706b5ea289685b002d5bffeede56c9dc5ac11e8d672ee558b3c12ebc751847df239fd46b037133beab71f65ef56eda8734aaea71b7971b99b620a8265490c281d4b1726ef5e0c887eef282c289af27ed5ac5a2b4d362dbd3ac3fd92d00c01bfaface93a3353aeafb871825f9783ed0afa77093c119c6342bc8c9693b3f24113ea9b91b2c4b2bdf2fde47991f251cbe9e26ef20dc715bfe17e38a81705d808227b5f6e9d41bf77b4de48ca8d4f8adfd1eb9e77167abe9525f4c0a15ba7ba00335a0bf87c7713fc2cffbc0dbb62a9f9d6569f3b6bfe738e7f8f287d70da8f25881125d074c58fcba49e2bd87d65e8514bdb2d67e0dbe07dee64c325da6751bda203ac7a035b6b5ed91af9be45f72db993af083def4dde2f237dcb9fd3df9afcce1fa887c2e7dc9aa239d951eb487e7fef7789f4d081194b97d47100cf65d36a38605e0cccf85085f22c9a1f92f10ae1ed37b9b3d5526668ab423987e7ea9cfd1ceab73c78e0c25e54206b9a6bc0e989b27ee6cba409fe6e9a4eafe01aef15c6a9d8af037aed5299d85c473c9b4ba7a982935cff3eeb2cb97a3ba2fbec26a658cea39a75bb32d7e4f2dc70ebbb91e8c0dc17985f3407f9d21435a85947be2a2403837573d780e1f73e7bbd204f4d7198a4cfde31afa49d23fede6e02fac5749d8d0abd17919fa768e96279aee7a56353341d5d300faa5259808dab431f7cd9847dc3be50f067809c3528ddc18d678e11efcab70f0caf26ec470fcb8549cf930b69fbe27f1fec5279e6f4c037a089c35bac97955167af3b56ee84697e6bcac5ac31e7a17b723fcfc78762fd20070e95406773f372f473f9b01af6fd867543c8e6eee3de5e696bc2dfbbe47c48ae43dab9c4f7fa8f784743ac67919fc9a3aff19bbd7e79b357a77b30c7b1fb843d90a8dc8274bc1ad4aa923e6478de87a38b12e9bdc5bb3711dfbfda5fe5ff3efcffa97e57c7607a506a7605bf5f6563ca395b539a611d147f96d477a8b3c0bd23f1bd18799b46d7d7f85371bec88d6d7c536925f29624b5daa9f404bdd2aa9e9c3005c9061c6082cf3bdf9c0ce422eae5af293dd0278e219cf6d849d287e8fdd2ecf8d0fb9df8fa4ef909d97089b6df2b23ffd37403601f6493020f8d1bc89739616510ef9ad1efc2b2844b0744ce8ea1e5bf03efd64b959378be12f13bfaebf1facd60dfb7583e6a83ca11e21610d3500f95a571a8cc34e89d0b356c10f70a6608a1dc6834ef09dd533a6a03fefd389d51c3b71faaeb40af8c6d127df7f077b47ccb32446ba91e2aafb47c4ba5fdd92bd58707e4a8b369364cc044a80fb8214859b48f620b7a232d03ccd466fb04e0deac80b7f63998c1e4cd5c1aa239201ae00eaf0731ecf352273e6dd8231bed7d4e7783de53d00f1fcf15d8f0eb69fc8e87b364f9857d28a8584dc2f90ca3fddc71744bcd62627ae136cca5294eb05f03cd4b433305a87efaabe0dc72d678e0cdb86a362adb1192614d34cf9489cd01deb79a22c83b9c0f7a469c2778d7b3f6fb3fb01f5520c3cef40f903553eba368905aeb05f7f67e97f8de27b1cd7be4793e7a299dd14b89e2ff7be6bdfb7346aa36c7ba7ffd39c5bfefe99e7ea4ff91c4452ef0ad9139eec9f3808dfbe62e9258ff379e3d8d3ebb3bc642f378b6e92fe45798a7a2ca9d059a2774affecc42cb3284a1d0f96d62d6f1ef7bca57fff43cd39be721c5cba1b0afcdc7847c36842f9f108e3204345b04e1280de6efc3fc2717cdfaf5e6e67a3372b69edd86725861e65e0ef2680ccff63ab1373cccca6f77d13293fecdd571c9d819a7c9321cdb81dfe26939cdaff4a80df8f5b501be8d79f6f9fbf6fc64118921cec8bff578e8b67261a12a9d45922d3bf0f29e387c492d87f8ca7c19827c08af1335df2a1a8d3eedaf988d64d38179a19a206d34a58567bf9405b053d5b04d4ce59ea3bca6892aefe77a1ee8ca5a9f9177eead95966bd7f62974eb2b4d281f20a752676318a7f66b133d5f0cfd867af7781fc1bb2c65476219fba6023f8d9793467c58ada50932d4de9dbc43acdf2992875a782e5cf47ad2ee55b5a37828390f8ccc2f62dec3d245736b00ee14b3b79d6d346f6df5e438db4017f61cb9511803e09c71c32d431c19f2486deccb596a4291ccd0b39a0ddcbf9cd7b7053946e2f0a67a2c369f9ae6f990af848f4625b9b83414c9616b4ca81964287e03721de2ab91fcc64963d47ac3e776a66c26728e5edf2fec13f1f0e9fc07fb741efdb1ffc3fb637bfdb193f3a5c3f572b4ecbb697c15e54c8ae58d2100761dae0dafaf36439b94dcadb6069ad2d9016d8de416e8c05ec4cc8a58dce8f7df1e103dd971cc2acaab667ae7148f68666a03e74ccf51cea765562b0b15666d84667a382b94c3e7e537c1ba51dc8ad477d1b5145ab57240fabed17274c0aee85efd2d8e03423f701477c1b1c48259e5b747e3f692fefdb53a1ddff39ebe60baaf39fd2e9fd7bf94a77ac8ff4a59927c961f33472fc67efea4e7148d1beee7b347fee27b9dcd6fe54b8fc30df73b9b03e46e1871b18e649915fc76b2887cfe19be9840fe35da37b64d23e3c621fb4cc27fa7f626d226eb0d2a58477b794e9ae81c0d710f7902169acdefd685916c12bf30af1f85e87d7aadf1361b852b54b77ed4ded56cd2da7ae43b69b637fffbdaaad2713ad3de4d75aee63a2b0dd7f244f053684d7df8ee20a2de2a1e177df76bac3c0ce28ee4bd033390214f19e602433e93aab427809b74d0753047f950a9e2b5a2bc0fd51d428db6adc9fd1999ed16f6ad152612cecd213988b8c6293b12ebd966c35c42ed17dc47553aa81e1eee03f7f66c7a6da943de22d4c6e37c0ab42f03ec9342f9cc9013d45c9dec4f44be21b5ff7179718f1a58ba06760e32448ace3149bfd725728ff8066f6d6f44db57746cca09e26ba9728ff68557611e5f0ed56cab4aabd814cb1b16d747f4e348c3f4e47dcf968180d555399b644b81ed64c10c469efe1630f3086405e4641b870af83104e8d900368e2e4bd39108359f9d35aef3f078b401b5ea39c81b243e4a52efee361b1e4d7d6bb49790d707f741764fa3b5d5cfb17bf03a6f2973ff81becba92ed66366ad8768a286bfc7b59e815c9ce11e0e1b8dc92d47f329e15e02dde7e10ef970a0c34569a335da505b9b7a8e7d51da697271661c2a5b9df31c7b72710be765d8951dc54390b32ae8c29af43701dd84e68a5fbfa6bda5bad2ea022cba52e596a3571369238c0beed673513aaab219d6ad9f38366c2a1d4b13c23efc48bdf6713609e4890386b120e7f65e7623f029d0a82a3b9b707e41fcdaa39f734ffb11e428c8c5fa529fffd2bcbcb9293b3315f91cef735e5a5e5a6aa20438f0b7e1ade4778e90871fe43323f1e128f9972ccb303e9e2ce2df79de81fab1e925b93d8620a139cf89f77ff495fc4c7d25bf45d2d8bdfbde89755b9377bc36c0847c9fda9368fc037dc1c4fa5493adad2ef6835e7783ca7a84ec77e861e8f1c45bb5b2d4edca6c04711bb15e3c238f866017eafb09b8975a37d1d5bceb66d798b6fe00f742ad37ca3574c68dca0a7424f16f180de900bd1134ec0333950eaef9877e28812dc59fa381f112fd7d8a872ecb2b8aedeb18d8c3b390ad42ef4dbccfcc913623a59e03ff51a89626f053e0792024a725aa96e7c4968bae17a5f904ecdebcb4d6e57a4c8f8904fac1f2c0bff719f152ea9937e55f067bc5fb2c6661bc9346bf3de4a76dad49bdbe5f6b0775998ab543b144c1b7d7a0868bbe3faa5d05da37abdcbc1cc2ae37a5e1791ff2fb52edbc5e682f2b594de964397da415e4d37073965e7d59688ae5a87929aba2f8f38c67bf4e79e66cfbafef205bb97a3f9ad644291b9e0970933c50848735dcaf27413e37e8ef71d033f8a350cd2de484429f60f0b7e198bdefab3250ccbfe5a842790d35129a9f3f1af8ace2e4f4197a6b1ef7eed7faa5d0e7c225f814cee7c48ffd2d52865e28aba2b173e84c65cfa75b899705e9fd0b20b711d550833f5175219702636fe0511be73e426c01beeff5a4f1f3dec703ee3324fe67eafb097c46ed838fd5c37a35248b86f83b5cf50f0ea9c7ed83ee038c351d89e5a52a0c27aa9cdba2ff93f7a88f00d423981023e0c614be4d72cb1adb859e3740076fe274304513355569cd4610df12d9de3ef1fc2e7971a0a326ef1d358ff66585736d70ad8bc7ff465e9a8ebc7815f8e190ded265886b5d8f334ef6fc3e761739fbd0bb313448fd1fbbf777cfa98b7fdfd33dbd990fa66a47edc9857172b7b3d005e7d47f13c65fe43bf4f3a2b10491570dcc7be8b38aa5cb65f0a11f35057a23d757cd4627a70a93899eafe0bde4965dfe9ed3ba259ebea9b5ce2177a99724c3c15ee490d99eee34b11e41b974808994ca5677518f0c0a27f50fba80fb19437e59957b9dbb937779d84327f6507c3e79e85ccfc919f0fb238c6567a3c5d8bba0db21aea2c994ed8be3a1813edee133477a9ac45408360aeb465637c560ddf079b436c6816fed46be6245c6ca63e81bedabd2d9a972c76936ea3955de2fb5b913f4b61751cec6e611bb3b237647f1d9090f26eb19b20f49fb98825b1ef58fbfbcfe718eed94f3cf1fdb7fcd6ff1efdc42fac238dc270ee3c9b5e6371ecc137dff3bc6cbf29ede8ffaed4761b4472eece7cd85adda51fc99ec2f62ebce6ee1ff8cf67784745e0ff37d1aeec6dfab80fc5f8f94feb129e6727aa3bf3440df783e03af6f6da3bd6a8afba591ef1fdfaa15cfcf07f98f4a7f7a860f6817f15ef17a9f59b397dbc8f0028d411da823413991b42e88d4f37db90e36707798ed54deab286f0be7783ac7a618ee89a60e2abde073f051203b9a3f172b78f75bfa13fe793dbb5cf029d667aad2b72ed075e0dbb2c077a926e39da5ae54b6c6fc6eb53af879117ae473fa26e2dff703652b73466b4d69cda3edb3141d264b07361f8979671b7a238e64ed92bef46b5de82fb5f92cf1feaa5b9f8e0e39e8d1988debf79aacdb5a962aacc057887afd323cf32df2b9e931f4785ebb6d2cc3a79f04fdd4c2df89cbc78e94d93db93019c8b91dea991ac8e1d0ac3814cb1021065d5fe982b16a367c9d109e05d8a07a49a2195e2d87eea3d9ac5ad42c2be88d83e2d93b3defe5b7aaf27e351e543c7a701d47abce90aec0f98dbe3e394337fafb46ef03c5f717c546f08c9f343db1001d6ccc51afd7506d26a7cea80036d0dd9557f7109e79067633fd6fd770eb73883399d597852a77b2a65c5fbd552b1b5d801eb0303f0dd5981e74016241505b31e3d32da7ef1aeaa76d2aade59b42d7bba6e809b7b3322197fb7c3911fc36ccbb0c0f4a05af6febf0021911fcb66a47d995e7f4bb025a576f5b47e5e61cc3359da478cfbb27ab83fcf606476dc1a0d2f1744305fcc4386fde7b96714031b42cf40141fd6e6d1423daea0de9dc3eab44ffdc12af411d848d72f9cea7a7e0b789f4543fbec9cef182fb5bc621b7646be4a9b340ef6f2a9dac2e642fa0d54ecec8f7b73a83cbaa76d43a2e8e476c75b995163fafe17d24bebb8329f3f4997985790f6b15f06e88eeb09d00b38f04cbc17e5a54db3712cb794faff8ef35d11569658ace0e6ab6be818f5fa9385e8dc06c62ba30370d6198a0eea1d1015f2955ffc14dbf01bdd06bbb3a0e00f8dcda72d81dec3e03365fe842487725ecb7b5358197a1bf12da9f105f1f476239a78b3d541707f5351acc4663f14d4c4ce0f4f9d2c694b3a732115dad9c210cd79aeb1c8c28fb06e15e24c3223f1b099273e2376470ef6d792092ff4332b7a7b4272385a786ab30e929edf5086c7c79ef60ddbd64e67d41ef87c026041a6db4d1bc3653de43bf2ca0e1ac3197a666037a4c40fca300f8e2a80bfb1ca60d884303262b36c5fa411bb03d09a1377a7fa1c9cea1d9f07b9241de039a2b631c0a9381d29b686219f220367a9ef489a8437ea5a5cf67c1fb37da289e06b8dc701ddb447891bfa64af5f6230e9fd9ba505e69727dd39c9c60e218d919e619fc9d89966f6d4da5c2d26b9c3e0c7e179c1360ac35cc645343fb49e6cea0f91d90cf02bc75d0143f9702ea586fa543fd35df12cfe2bdb997ed3e37dc722ecaf71f9c6d709dbccf096ef8689fa879d0f3d22feda334927b6b92eba3e75bf73aa729d863a7eba66463dafdef184f5285fa2a5c7b96f4be1f124fb2cc06ccdc28fc8ab302bfc8f4f799e59bb34cb1b3b82b4ff51651f470716e299661f17ef359906fe2c9d79774bd03384246f347b2aabcdf6a79880fbc62ddf58230ec373f9707e67087f4ca16fb933df90a7a5950690cc1ad93a3def75a1df3a873fe8deb9c29ba3e911dc97289ec43d23e5275cd779397de3307bf89bc8498b95b5fdf555e86f7218dbf1f3dbe7e7d8faf47cec9e7cd39213af93efd0e626d16f6bd3e07762778e3179ecf52abe6488effd6b8575f0aa163e9e2ee74dd51323de6fe778c61c7d88377d441fe9cd65f71567136cbe7e4298873abc2e4ae3c55b5a3e8e152bf6b6eab41de77526ed28c7c87b68368fa8f8cabbea319a854bd1db69d00836ed27c75dcf54e82fffef4fb64c74ac5694e1797c6bbe81e40e067c8223b211fcd0329365bc0a30e6bf7beec4fbf979c571721536c9017b13c188b0b38622a67f001ecd19b42d7e99d6296681e8e9513700ee0138fdaf3687c13694f26f015cb4f91fcc6fe8e5d43cbd2913faa8ee5f77d70a157abd6fc5d749ab747f65dfd86df22e5f08579ab23e895d9906689399c339283338cf50745f92286f98aa3a2ba0ce918cc68217ead3df487dc06f93dc38906334806b84627c8419a7c8b88317d83b9ae5e4d0fced1e93b1a7f2c36c805a1e5c2d5b158e4438ee093549a0fe32296d6e768e6ed6d7b99536b8df4e1c4d2f03db009afecfb301e8bdc7f55b096bad85bd33c90ee9324fc15d4cd41cc95bac7c4149cec88c20d9cf95dca4894985e94153c53dacb91d2aa3b3a07626936da13bc86c93711f71e845ce4ea2cb8077c4781bcbbfaca104a135f3ec8dc356ee1bd035fb55ccce983681dd19c231cc7fe06e4e4463f3413f162f8eca166a60f3ec0cd9b427cdce5c3bdf4562c5efe9c7a2b36def3813c7553bd85646678fd717c876ce4345dc57c3fc40fb45e1ae15c57a66ff1e45b90dfbae4c7f8485f50677c35becfc30cb537b9b318c9fbd99b4ce9da93efa6e878544b20cdc2fa1ae9a1606faa11bc1edf032154430d392c946c4133db211f09622f5ecd8bb78666d59a690acccd84d84e6f197a1f2f3705721d291c714d6fedc4bca3a216ce332197adbb253bd10fd15b44f1d6a5b1c3691aedf74ee92a4a7fd4d07dc2fac31e0f3c3d3118f6b8e37deae9f3aea5635f17a2d924a9b41bd015f3def1f96c0ee9c5426abd5fe623b9376f8ada4a9521ce87fac26755c83955287d5de5a32dfa3b217907df47fa3e371d89ce4aabe6fcb572c4025b3e6e0de2926c7e10920114af71ee87b9d020764cf9429aa2730cf155b5921f893077513a3445270bb9bdde1a5e161aee4d0c315426ae6c19d02b5fde1ff5103609e5789d60481f939e620e479f4beb68acfb628f65a6a70cb9dc6278dee86dfa20faeb0ccfd0b949cd06d1d1619a08d13df629866462abc8c4b253751fe9f11396adada2576f61e674b17e688a5201cd32cd77e077cba6489e5dde345f9d577e9d877f477fff5abb2bc89d49c5e343df07cca7bb7a301b227a0f261ee6b3823e0aafce2bf802359bede113e207320b93f8a0b9f364e9ef8468147450be358bab6ba2d7ff8ebfc7251366af741d0f9c3bca094179d4d0c308d30dee9be286e9c7eb931fd4595696d4dab706c820f7c374f54f4d9676211a22975b3faa305f578cb4557c5d09fd40a26c6246663cecc4dfc04eacda51e775695c21ae3e28a4a7eb6331a4a73dd94cef4b8c0e8ef69149855fe02373fc35dc54563f7c590f5fd6c397f5f0653d7c59c4971511638cc7fc0efdbdcabba6f4b32776520c960bf5f26e741c136c00d758f9b5d650979daf581ad412e1996686585eead09701fabf89cec610a4c399b3f18e9ad25b879e4dbfebb5fac4edac600e5b24fd846c25fc3d3e79d4223555686fbd5e70647ed9a1297af702fbcafb4e79738ffe78c4c6360eb9adced2c8290679c57e3338bb0de7ba2bba4b6af14374b6d4e7da7624909c62f00b9437dae0fa3545f7fde7c9a7c17386268b78f9c4f0c1bde467ecfc9dcf293f7386509f8773ac3f5c7e86d7e2d23dc86f880b93d71deeedfc69f0e275338eee7766666e24f72d98157ea7f30af52a3ff9fde7e4ade4773e958737cba57b7b899c5119417729b20cf74aafdab1ef6c133f50e43e25ef0ff681f512ef8f7ae0e5ef95a719d3c3ee73d257cc6ca30fcca9ed2da268ec521f37c99f8ac7bbb3fa0af02af63fa6fab2518dbc974346d75e1f0d519a025e43f5d78265e9aee950fec8b50ab940281fa9bea1631e812f664766fa5a54ff22a8db27b5f3c2895d9912fbfd002c4cde75cd532fd70b6ced56bfcae70f973c7910d4c755cda55635269abbb7a066ccdb9fbe05bd5fcc643f408a5fe4448641affc2dcc6eb8178e335c69ade7b5707f974f8c0de2dff703e581cfcb2772e078275c606962446d4ed47a7fbdbc8e7ed7d37dbc190ea8da51fb71a1ef7b0ef3a6cb4262aea8e37d479577e7e587fabde3fad8e70dfe08fc3cb947fcde740ec90ae434ee19b73223f44352ce82cf2b3795bfa88ffa9af0e8098d30fb14f43cabd8e3c1cb829a27bfc43dd9e9f544dab43877c7a34bd837b267831dea7d47dd7382fbdeb2b47db6cdae0a7bcbc8b72f5c27ae413ef0e91b4cfb9e2f0a9fd95b95be4f61d21b54b2bab0861c23ec0be4cc2d982ce2e585ebcc505fba743fcb20e84938447bce9d8312736e23bf861bf047abe8f7da41f1e5ebcf0ff55f18e460deb973d6f901769acff06cf88af77be40f331ddd950e23b9cdf4a7bdfa8c512eb847b7419dbaf77c13f6ad68f83d24e3de6137d106c5f91bf4c9456716cc59ba049354ed287ab93496589f9df4eda2f77e16f89343ef1a454b4e67a7c91dd4f792f4dc831c100ae3c23b42ae10f873b7811cdd1fdfaad7d429a135d07b716deed74ecf7796be4f3299f77ad477b9680de631413e12d01a6015ecb3dd688a81f31e21868473a840b7e42b609f22f903b1271fdb423f52aadfe93711f49003f1fb25eeafb9d4e79d25c46c474a2f9c131a4f6be7c59b5ce89b1939230aee6f69f37e140fc2674713eaafe3732020dff2448ea4613fd467e8329dc06f7f40cea720659be27e1be17b0ff8d0be810ea0def9643dc9388fd028b53f14df9fd4e23dfa563cfa563cfa565cd8b7e2318fe313cde378f414f9bc3d45aa7694ec3c0fbb566dfffd1264632b98dd11d66b697b42fcaa76e4f3a2fb65d37348be21793ecf7ecb3c6596a39fe3f93af3fc7f99ee6c9279ce649e329d913b86bffdfdf7536662afad8dfea7b170ff3217c66cfcf3afc5ca1839337bfdfcd77236f94bdfcc4d676cfeb532acb13b5afd95fb33fb67f6bf7f1ab9bfa6abc51cffb7f79b1fabd5f287f71f7fc287f04c7bfeef05fc698ed723db59c15fe7ded3a37ff29459d9c771e6b9f4b594fbfa947117e638f35c10b2e8af3fd636faa99015befc772efbdfb9d27b4e78ce7d7dcee5b4cc53c65efd30ed9f99e77f8f9cd5f829b33aa0c7d5c6dbccf3976256283c659af345e6b9fc25f7f56ba1f494e938f67c9679ce3d65dae831f97cae547aca0c6d33f39c7dca88f84fe5c78fe5c8cca2bff74db85bf62933a0dfb2e2ccbcb72e64cb5fe09f0b63b6ca3ce7be7e79cabcac6d175e633036e07fca425e289673f9a74c6705ff53faf2b5fcb558144a7f3f65da115f2de573e4abd9bf9f32d5a8af1405ff6e5f73fedd941f3f36f3cd6a6c669eff37fb947dcafeeb6f386f6bfc135ea7061b95f96bed2eff9a8ce7fc279d79ca34dde5e2e7ba3b5a5b216af23faf2d0cef83f7d1cfc978edfdbdbf58e0bfb5476bc3ca3ccf378ef39419ac47ced83f31f4affe78048480be2b2eeab6335e916f7b4ff6ff591b2ffdbfbf8f576be6dbf05fcc2fda0b7303cffbbf0c7efd78ea476f6acf33cfeb9f9bf113b35fe8d5da0b93fcd7c859ffe92e4cf4dfd2f8e7ca460bc8fd2914ffcc6660d797e8718405139f8ab7f36ae6fbfb29638ed6a3cc73c613f2cd992174169a9c43036c9aae95351b2f5fde0ee5ff67086528169d8e4469ad0e8a5bc335b65aa36569f3deba5d33b6feef8891e41bc2ad85d9e8efbedba5ad9aef1cd4437133523ad937d7dc9a3602daf05b4baf96f66fd39735b9cf9bbb3c064ecae68c0cf74603f3ab2d32f8e87dac74b29a9c9d0c05690a0312ccc66c3214f7966ebf2c86b94eefdba022e2210a5f9aafcbf7f76c613224efea818984e61d2dc62808096e7a600ff5ffac0157c9a9ee9e4a7ea20697a43a2a00306a305c14151781010b098760a034c5baade7a52c36e676a6dc5a518d0a51137755d1ac910cc93fce6aa4f48bf18957fdad9af79ac2bff127abd1eba795e4b54e8dc06941ef2907a82101bbe059be4224ffb68d8664eba2731c8153e2e4d9e94621da6705e8a797f81c70b89ad51c3560e5fcf544dc2309400a9ad23a12da39735dc16f278b78c3d12d1fa180f10d8619cf3bd8d17002ea92818b707a8fa47d345c491829e87b6b48a633c5f2613cb813480e9e1d7ac74f1c343b688ab6448318eedb6825bc1e979227b2330bcbcd8f3b2f4d2c4fc3c58549eb8e3146ee18848e7fdf87d3e6e1b479386d7e03a70d0485eec7376e9971947fe2b3c9b7b62c164b7adf8fe01b5dc078f86e8d2ba9404b845cfe8c3a4817cbd0282c1ae3dcefac766aac3df061c19decf8770aeeccfbc55f8a135ce96888e58d2138b62e0e519152026648b101031a3a71a2d65e4fbe9792301bb1a72d4b17f7b1b2379666127d0167d3182409ec40168e60d8f420174b73d1b2e1b76f8a0949a896e1aea3793a6defc86f278bc8e79dd17884f813c274e54262566f8d87e92ec19e8fb5a7c37c6debf9ce5495776b7fe89d7ddb01e9c9037843491d5555eefc440d461a6c024374d11a4a6611514367bf49852a385915cb1a3c2816689714c9d383648f67f8876c78b73739f2ddaef51105efa7dc49b7e7a981bb11b2f4ed85532fdd51b7534d0feeaadbab76946eba30f16bde724cd789f26b8512f48781af6632f0fd44953a24f98f07e97ca1db1548a885df408297d56c74a099111e96bc9b80cf10929534bb12f858e6ed20d989197e03b6bd89139ef0d040e46303dfeb089a82b8e5952e98e0174649791a143854ade59b3bdcbc552b6b3ddfc942c22e346031d1807f4918c9e59c71a09e6f57dc91d23a9ad5197f125a9eda4ffa37d726f47e700210ed778de2f714fc847cf08660a5f950a15839fb267b0dc547f2453ee2d37b847518458b40df57f852f37c3ed4087d15215352ec84049d17755ec9f2fb63303d3c33b4d79f5936c7f8c0eee7a305dfc9fa5e6713537cf739cf26ce0776b7b341c523f5c328dfbe402604bfadda51cfbf4931a2779e09faf8dddbc354bddb6c84125ca1e19b851356f1207d584f39ab0d2a01e61b706350ef3d6fa9e7f0b0f9a873a1d73f023b52696535c5ca86d7c897bcdb1b54bc77878207f1358427a0591efd6f6ddeb234812457d76158b1a5577713dd2d435cc7d2ab7e223f5a2f2a9aa1d71c6f2f9ebe2b8e4123de994b87d12047d90729b15757629bb19e6007541425621fdae93b46d1d02bfe3f1b0f757670b103b26d0c575a11db66243a3b5490eac5d4838211bcd76f76650831c6a6d8b174db2b04d0ed606834ecbb5fcc2982fc5ccd8d4365672a2811df09db4627fe04ae82135d966623f975edffee448685ecbf8e26f7972a69b872e97e893947131d8bdeb7608d6b276c0f5ebf4653940a66357a6d1134c8635b1c34a59e1b411152f5c4e7439eb78ae263ffffeab7b055423833bcbea49c907a7fa9cb12141aac8db01d1c757e4328ecd1c43ac84494e7618ace1a64a747bb41ecb5d9302d449f01763ec34e08ad857ea71439d17271be0d92b1de7be6b2233917a6cf5023d2175bb1278bf6f465d76eec0e9ddacbeedfb566a15dab94bed55e8bffaeb5f476355b5406939d7cec151465afb7a73de17b3da7b76bf8dfefed5ce7e898f2b1292883d9f2db20bb6bdbd9c3f78153fa569d2cdaef4ea9edac766fef8ef94df2fe1c1f5b65fcbd45bbbedac3fffdbb56f79ea5ec0ef2f165fdbd9e5d7e53768b6fd5c9fe3bf93f29bb449f49b9afed7976f986deebf5d879370a8ab2dbb71bbb7ddb9eadbfd52a7a7b902d7aefd732f16f4aeddc6ad79678efdb13d06fde5fc973d6f06ff9f87a50f03afe5d6befbe0d9ce1376f9f8ee879b53a7e5e76f9ddb6e0ff73e4fbdfde170bf8ac23e5f0bece164a7db7c1dffff9edbdb490e77bfddb2147de19d6f9857eb716c1ae011fa6e7bcd03c3043feb709e57ff3e858e9645539b74bcd8d9af52d1306f7cbc559138a5e70a1bdf70e15f20e24b6c14ff742a25f3052d6d19f87f4a38bde718dd615f641f84debd0f79cc2e25badb9037a6f4f7b8807be030f8469036834fbbd0e67dfcc76a6169c0dfe37a2bda222ad0e1d27bbfc3698addbb5e6ba3d9d2dff5d9d2cc23ce460fa5e1dfe8dbf17d0e96e8fcf18f38a657e4be71f78af7dfb38ccc3bbb4df2ba5f67b3381563b7abb56e7bd6fb633059e7cdd93e700fdfe1b9ee760da5576c77675b694aa13b44fed1ceccfcb3e81d6bf7cabc36792cf1fdfea7be38dbcc361f6d7b7fab2241f566b720f9013dff1e7f0f76f839e7d2afbe3ecf890be7ed5854e0e37da88f62f47e601a29cbde0fb411edf8cba9f5fc878868f36fa1d288ccc167f05babc85f33673c13d92ec815ca7d61bee2694ff204dcf911c4fe06547a7f6eb8cf59dbee3f9eb9ce9423ba24948ecb9b650734dcff773483ddb5918db870b722b7b53aeaf887c848269552e3045bb1ef63b634ffcf5c4c8b5a8b8575a9e50f0fbf0ffc79d6ddf5f978f41d74bc032803de3f7e306858a6eb8e17bf84ce3653c8f2d1d537411f9dcf03a30a6ba6d537cb0f776493ce9e3da46c89712678fe2c6586468c22b6e92b09b802e03fbcb54a0f92c2aa28e1a92ee37b879ab56502cf4cdb34bbd81bdd502f7100dec3b8bc6a497c593f80afc6767359a20e75da41a63fab6228eb34181391410c3bee50c81b2bd1afe3917c97e217f88889b4efacd326fc313e96b4fa2df983d70a4cd48a903cd2c433231360f3deaec387992b5a363727e423a09e857e9ec54b9c36b3b57bc6623900b5187d82891cff419235a7ab3af5f03692897b60edc688aef4c660e34f4075c8c9a7a019fe2e750767f6701c5cf6a1e060f402ccd99de623dd0b042555a31b98c29fef2e0b7d4ef42f217ce8c344c89d243b4beae9dd558c56f32429aba0e27deda91fcf29e899af3f8f5177e030e4606c6e794a4f3ecb7c87d4dce7d40b4d8b4a3e9eaa6be0f97ca7d4bd039ef54be1987ce0972f86c682a871aa65b46beb3550507377da1ea691afd858a06104163ba9613f2bb9ed5708e5a0bfd9bab7dc7ff3c390bf103c833b884a7a9df26f134c433967a3507f903e0873cde2de74390d6aaec841b277fe2b815e45b8de48821f7a7e776b35c8ff05a5a5968246988d65677876b183ea582bd77affc68efb9a7eb67f14e023ddcb156673712cb9ba87ceebbc5191d69f0deb8132fe5a5831e11ef8ea2cbe8fdbc67be6df4bbdeed5cd2f4c8879c4f4c9d0bfb6e9f8477a2733dee970ffd68b4fc891a2ddfc1cea8da516b488e3b46e19b5be40753351b697ec976809f2b74f3c554ec3d742557535a0e34ad37829c0c5c7beed127f82421b75197a5ac2a43ace3f5228c8d6a20637036ebbf08f8b045f2e9c3674c636907d74d54adade19a96ee3a5ff0be4d20960f760df435f816e28518bbdf6b7007c31796aabb779a1ecece8e07bb89263b78104867a9bb780062d5821c8294e762fb51d87bc30115cd6956adb529efb323a5020d18c167750419f06dc0eddf25fb724bffd8f22496774a6bf17eeff87d9574d7d983ef81f1697f84fd4cad17e958c79877ee571b86f47987c99bfccc1828fe7def8683dcf241bb4086ebf956dad93ff0d5035f3df0d5e7c75797f922e79ede8f18881ef2450ea18611fa05515827244f237d6d944f119a3c0f2a58df56f03941b35b27d4e7e7cd3e5777e7961c395727b890e08ae435e3efa4ad130dc1f3befb469afce21a1768f23b928b38cfebcc66f251cfbfd6af4a74b99dd0a83b45de7938abb0c68ddfbfc5ebbad67124960f66f5e267857f4ff362a4febbf839d0a38b673d1863166e3b6836f9dda86786fb287d62b99bfccea772f2a37cacac4d71cf734334f51b9d59fcfbdeefbc7e41dca23ed390bddab623defd339e93bf47bf12d784fd2a77e3292ac689f4fe371e3afde5f621ebbff985e786e35e6bdfdf2648f73a3b1c9bfc5dce2c2e9e79bfb3324569a52995852e149d3718d820b71ce37e3e18f4dcd3f5b3323b811eee18cf8d8d67de4f7749c3d9fe5ebc9433dc88d875145dfe7a7d15fdaef73b97147feda3befa515ffda8affe8debabc16e498aeb75191b30359ed783385dbe3557e5e272ece2dc36944fbc7734d48384c4b35e1630d8be2906fe9f6f033ff70ee79175b227fda8b9e37ec8a74f9dc7d5b129dacf11419329b4986fcddfa0463a7570b3b535e6bd8b9fc3fcfe5b3ccd3fecfb877dffb0ef1ff6fdc3be7fd8f70ffbfe61df3fecfb877dffb0ef1ff6fdc3beff8cf67d72cd3715bfbf716d371d8b4ef013f470fea8a59fdd97006ae842b56813b0f769bf40b36a1d4db9b3688af523d433375fcb835ed679fd36a8e40c01d53b5a4d1172689cb9eed6b3902f8ce77b9dd3af201477a7f78ed207b7ee5940efe1f08cde5a2dd22b8caa07f6fc1b50f31cdecf5553f4ed38f0bf78b199508d37caa9c643dc433936273c7d659d77da7dbe45eabfe4dad390ad7393bc77e4ab49cee97a677d5cafa13de7f29331b9c82ee48aab51feb38674447b8efaf3c1dfcd0dd4556bd5ca51152c47978767fac512f2be2ef38df9bf4fac39753cdd70425fc9f5e3d0fbe8a4df5e78ef7613df663b409e3cb2e3500ebc9eef2f904c41f3e32a285616960997d5a39eca43cefb5c24df9dd98de5fa01ebce849a06aa0fb9d783309da607959d2e3830b0ffe0f59304d92e15e0df50e30d3de18dc60c9d9f1aee2984f3feca3b9283d9142dcb10269e2e68688ee1d275efdc329dacf396b4be20982491d65dab08facbeb095839c4d4c424d0ff2bf402df8c0ea45729d56f0fc96efadfda41954d3457b159b52c35dfcfa9f26ed5148b5bb01754cc0b806591df5b9466df067c3c70faaedad6707316eaab586de50c77b636e8bcd6b03c0f3fc3f5fa3fbe29449f44f00f2d63bd1e95b807c2e9fb46d1601fff9fea4a47ac17d7a1de9541ad3aca6f465881ea5ffff672b6ce62d608746b39f7aa451e89f5e328c23ef99c7e23d89b891df5db8fb277ab76d43925d7d38dbcdc8e9b620a8265490c281d4b1726ef5e0c887eef282c289af27ed5ac5a2b4d362dbd3ac3fd92d00c01bfaface93a3353aeafb871825f9783ed0afa77093c119c6342bc8c9693b3f24113ea9b91b2c4b2bdf2fde47991f251cbe9e26ef20dc715bfe17e38a81705d808227b5f6e9d41bf77b4de48ca8d0fc7dfe2d73def2cf47c2b4be88143b74e750166b416f0f9ee26f859fe791b76c552611edadc59f39f73fc7b44e983d37e2c418c2881a62b7e5c26f55cc1ee2b438f5ada6e3903df06ef73271b2ed13e8bea151d60d51bd85af3cad6c8f72dba6fc99d7c45e8796ff27e19e95bfe9cfed6e477fe403d143ee7d614cdc98e5a47f2fb7bbf4ba4870ecc58baa48e03782e9b56c301f36260c6872a9467d1fc908c57086fbfc99dad9632435b15ca393c57e7ece750bfe5c10317f6a20259d35c034e4f94f5335f264df077d3747a05d778af304ec57e1dd06b97cac4e63ae2d95c3a0de67625d6bfcf3a4baede8ee83ebb892996f3a866ddaecc35b93c37dcfa6e243a30f76562b8d21ce44b53d4a0661df9aa900c0cd6cd5d0386dffbecf5823c35c561923e7bc7bc92768ef87bbb09e817d375362af45e447e9ea2a58be5b99e978e4dd17474c13ca84a650136ae0e7df06513f60dfb42c19f0172d6a07407379e3946bc2bdf3e30bc9ab01f3d2c17263d4f2ea4ed8bff7df0d1c833a707be014d1cde62bdac8c3a7bddb172274cf35b532e668d390fdd93fb793e3e14eb073970a8043a9b9b97a39fcb87d5b0ef37ac1b4236771ff7f64a5b13fede25e743721dd2ce25bed77fc43b1a623d8bfc4c1e7d8ddfecf5cb9bbd3add83398edd27ec8144e516a4e3d5a05695f421c3f33e1c5d9448ef2ddebd89f8fed5fe2afff7e1ff4ff5bb3a06d38352b32bf8fd2a1b53ced99ad20ceba0f8b3a4be439d05ee1d89efc5c8db34babec69f8af3456e6ce39b4a2b91b724a9d54ea527e89556f5e484294836e000137cdef9e6642017512f7f4de9813e710ce1b4c74e923e44ef9766c787deefc4d777ca4fc8864bb4fd5e19f99fa61b00fb209b147868dc40becc092b8378d78c7e1796255c3a2072760c2dff1d78b75eaa9cc4f39588dfd15f8fd7af15fbbec5f2511b548e10b78098867aa82c8d4365a641ef5ca86183b857304308e546a3794fe89ed2511bf0efc7e98c1abefd505d98c7ec6c93e8bb87bfa3e55b96215a4bf55079a5e55b2aedcf5ea93e3c20479d4db36102265aa078b02065d13e8a2de88db40c30539bed13807bb302dedae760069337736988e68068803bbc1ec4b0cf4b9df8b4110da2bdcfe96ed07b0afae1e3b9021b7e3d8ddff170962cbfb00f0515ab49389f61b49f3b8e6ea9594c4c2fdc86b934c509f66ba0796968a600d54f7f159c5bce1a0f2a36cc106a362adb1192614d34cf9489cd01deb79a22c83b9c0f7a469c2778d7b3f6fb3fb01f5520c3cef40f903553eba368905aeb05f7f67e97f8de27b1cd7be4793e7a299dd14b89e2ff7be6bdfb7346aa36c7ba7ffd39c5bfefe99e7ea4ff91c4452ef0ad9139eec9f3808dfbe62e9258ff379e3d8d3ebb3bc642f3ad985ccbfbf12bcc5351e5ce02cd13ba577f66a16519c250e8fc3631ebf8f73de5ab7f7a9ee9cdf390e2e550d8d7e663423e1bc2974f084719029a2d82709406f3f761fe938b66fd7a7373bd19395bcf6e4339ac30732f07793486677b9dd81b1e66e5b7bb689949ffe6eab864ec8cd364198eedc06ff1b49ce6577ad406fcfada00dfc63cfbfc7d7b7eb288c41067e4df7a3c745bb9b05095ce22c9961d78794f1cbea496437c65be0c413e84d7899a6f158d469ff657cc46b2e9c0bc504d90369ad2c2b35fca02d8a96ad826a672cf515ed34495f7733d0f7465adcfc83bf7d64acbb56bfb14baf59526940f9053a9b3318c53fbb5899e2f867e43bd7bbc8fe05d96b223b18c7d53819fc6cb49233eacd6d204196aef4ede21d6ef14c9432d3c172e7a3d69f7aada513c949c0746e61731ef61e9a2b93500778ad9dbce369ab7b67a729c6da00b7b8edc288c0170ceb8e1965778e6bd8d7d394b4d2892197a56b381fb97f3fab620c7481cde548fc5e653d33c1ff295f0d1a82417978622396c8d0935830cc56f40ae437c3592df38698c5a6ff8dcce94cd44ced1ebfb857d221e3e9dff609fcea33ff67f787f6caf3f7672be74b85e8e967d378dafa29c49b1bc3104c0aec3b5e1f5d566689392bbd5d640533a3ba0ad91dc021dd88b9859118b1bfdfedb03a2273b8e594579d54cef9ce211cd4c6de09ce939caf9b4cc6a65a1c2ac8dd04c0f678572f8bcfc2658378a5b91fa2eba9642ab560e48df375a8e0ed815ddabbfc57140e8078ee22e38965830abfcf668dc5ed2bfbf56a7e37bded3174cf735a7dfe5f3fa97f2540ff95f294b92cff263e6e8c5d8cf9ff49ca271c3fd7cf6c85f7cafb3f9ad7ce971b8e17e677380dc0d232ed6912cb382df4e1691cf3fc31713c8bf46fbc6b66964dc38649f49f8efd4de44da64bd4105eb682fcf49139da321ee214fc042b3f9ddba30924de217e6f5a310bd4faf35de66a37085ead68fdabb9a4d5a5b8f7c27cdf6e67f5f5b553a4e67dabba9ced55c67a5e15a9e087e0aada90fdf1d44d45bc5e3a2ef7e8d958741dc910c33ca605649f9007381219f4955da13c04d3ae8ba6ac5320e952a5e2bcafb50dd21d468db9adc9f91d96e61df5a6122e1dc1c9283886b9cb223b19e6d36cc25d47ec17d54a583eae1e13e706fcfa6d7963ae42d426d3ccea740fb32c03e2994cf0c3941cdd5c9fe44e41b52fb1f9717f7a881a56b60e72043a4e81c93f47b5d22f7886ff0a6f6c6e9dec6da19e7e7388a8cec68747246a3b235e674bf838a0535e36635aa6f41a26c9925bc0f974c8cb52de9b89c13c41653653e1d07a8c22cc21caa57579556b1299637ac4d13d18b24cd9e21ef7bfe5af3101f94d66f4ac751f312f8cd8b6f4a65a5ca8967fe3e563a594dce4e868234359516d4c04c86e2ded2ed97c530d7e97d1b70d041a35334f2b056c8ffece4c0af3c863e0a627df356ad047d6f1afd859eef383eddc8e0b3ae2c75bbf27d207586cd7aa7d7ac4d76ed6a76ffbdca4d2747bc86b511ac616da035c4ece3238fcecba37383b95b1760beb5a6f42d2f86dc4cc2954b5d0199d05b83def77458d43e2663f9919cdb413ef59b02fe04a41b4358392467697e903b0b5569413f09e8bf0131f4a97138fff929f74b5abf4f9f680da2846546943ce6b013f284d772b0af2be80f05be7f4c4f49ef8172ae218ef2263bc01f8e2e53f1c4f3cf3ff97e8974d7b25461b5867ed850ef7201edd9aadcf9f926e72cb3d10fd540dcd0ff1745b7e175ccfdb3988ec4fa41953b50dbb034f2fda59abfb1ede2c299a1596a20a73ccc1adeb710e61a607e69d6037e49d36f92d221f134b276f0013acdd7f27b2f5b6f371bfebd006bedc0ff06b44cf5ef22b43e370e159f3e9a22e41f4980d3cee96596b2ff8f1e38bfac07cea7c9e5b2907cba299fa5f0340f96ea099665b8f529c8b691d2c98e649e9e117e0c97d83b51cf0f62be3ee6da4d8cbc341d7936ead26cb4b19d09f5bad2016ad5b501f4162bce9a8d001b36459027f5a959adac344573f46ac17ee3c5a9c9baf0963c4ae9cebbf9dfa967fe36f9d8c9ef7c8a8f3eca87e8bfc7c5317d9fb638e64c84bfbbc6f900d7602ad47fe74dc1b57b8918e694874fce2ef9cc585b20695f53f8e491c7f8ebf3181f3d8e3f6d8f6317eca3e15a5550aedf61a42c9d685b3e791d60eb6a839c65424e62beb51c831f6f92f0dc39ed8be938fa1cea021d01fd5bde3ba62bb1b5d63c674ff95872474d2e1ec13634bd7faf344573c23318e26534536f7a8ddc64ea4f939f4fbd3f607c4bcdf7d6ba28099abcbbe41d823d9609a6e1db07b07d4da57dc93321373f9762f7a37730dcfa5c937b34ed59989eee958f047406bd351dad4ad36e849dfea9658824402eb32648b337b99e33456b6bf8b92be11aae18bcf05198e7644fdfe416f4379921bff0f9fc9d7cbf2479e3161db30179bd2bf0234f4722e0ca4ed6545ace05efb1d1947e5ecfb77ebec9f5832a58708653b3211de81845c47e207fe29bacadf4bc713e6f0920ab5fd79abbdf9ac9fc7508e745e5766a123e4acb470ff4ce496ca57d424729b665148dbbf595fe1a870162799bc3863a636ff3740ed7de795370cef4890c88a02d7c9dec2bb9dce236ba8627fa0c22fb0226e877fc4e27f70ee97df6779fd56f11f818508e439c7ec63654780dacef204cc376280622489067083977282642742c2f1e38476fc7e9d6b364e56deb6fa875f5b8fc36546e3e997d96ea33ed0d2ab998f80dca0b09fb6a2ac4e6b3494f0edcbf83bceb843abf8917c3bac42fe3846b55d2645f5a0ebb1baa3b58fb7e8d135e8f8b85919ee7157fafa5337201423513389762747a5613b27ef0a3ea4216722796aa10f4f8d0f3da12faa8b07514a68066721cbdd91b37cda908cb8a7f56ff7d564e25c4d87dfaf7726faa16eaf7cc115b46fd6eb4b9b4d2ab546fe470ac61c35ff343dee3e27ed0cc9a7347b027cdea596b7ff77e33e3912df8fe64ed28df1ad1bfcfeb747c1df58cae4f0db7bc19cbd915f8915505f210dae02386fa635bf7fb13f69746beb2529519c95d42b8add9e85b800d506ca2d1c9e990d7379f41cfcfe359b9d931be39faf7f1744ed1f5f93674280e16ca6b08ebc3c9fba9ee8a3c07096438aa9f6c5990b3c5e8c940ee34c2efd71441ee49f390be0b9d57b83f537fe6f48687ecfefbfbec1c9a4edf9f343aa73f875c1d391b213f101e0fcb4537b7d544e9007df642f700d9263a73baa7bfd28be7251677f0e9eaf06fd2ceb0477228e66de8c396851e92c6a162a96e3967562b91e78ae853f1eb202cc31d4e8c7c1ff274666fd50af864a7d00f5c937bde2ca246ffa0cac5a32e3bd0c791ee7746d6ba6a363a39d049905f68345a4bd3ad4fc703b67f1789d704f932844e8057df67cebb54adbc0fab2f8576ed65dd99be5c2203599fcd2de3360f7ff5c35ffdf0575fecaf0ecf8bf766cddc8d8f18b990f364c16f72768c0c27b6ca3dcfd2fffe19b9bf917b6e1c6e9b0b1cb337f1d87516a6c366fd64f6d925ba76abbbc3685d4b72e345e7d86c74b27a1e72b5208fa1b5550534cb1af42ec2c0865bdf18426e09f95f5ab5628f0709b8b851398c14cd52f333f6b92bdc37077cf3d0bf14f302b21137cdd7626b98eb4d86b997c9f7dacbeeed5ddd9d95bf14c547b49ebe815d1e2913c26748dbbaa77c17958be2489b9152cf412d44289f35b0bd506e972a93fe0cb17ec9745b96a6eb10763fd73f87d73c4992aba76b3dd9bb64d9c8daa8dfe2e5492bf2fcc137132ddfb8fcbc287671e26faebd9e7c2fc5a667f716f704d9c7d6dfc5caf6f47a9f73f63776cf4ef54fc4f9e2ebe44c7f2b1f75ea593dfa49fce27e1250abaef93ed1fa2a1c7b8ea2af64cc9272bf641933ef3b1af46b6acc507e39e4205f20d7a2ef3359c4efad5b17c08656e5d65217433181f365b7107fafaa1dc53767f83d892ea4f56a046e61f615f49b4b7cccaabc0fef0b732fd40f5a36991c8426f77edd347f35d27618a6c5405a61b95bf1e6385f3f0f786fcaf515f2b307f10ea7299a4bd3954233b3bd39d05043e5fb4b7318ef0538ee03f0d847c44a22f92f4daf507ae31cbbe1d673a3cf926d219cd9d9eaae46f0a2e5d178a58569fc4c5fab543020d700f9d0b00f13b06790b30cb1ff9c0ebf738d89063dc730fe37e522156384dff79786d059e979885d92b9cb211b80df772644ad31e0637a6dd7d6403f7a597cfa5e16a8f6fd025debfd2e49b7ce3b3077c7a3fff3ef7fd4e4bd03f569b46c88787f4b13973923df47f5686f32fa7b28f67d811e9f8de6d2d11471ff74f4f7d0ccebd0fb54eda8f527d769a13e0783dbcabc18fd9d20ebd07cf0b9e17e1619077198509c1d6a47197dc71fbb4bc7338ff8c03f3f3e00357b17f861bcdee821b9ccc863c8515a01aec278fdbcfbe729d9982443dd5459147136c967a2cafdff672a9d2caa9bade6d0df43d835fc3edf22d79f8ce718dd70933c0dcb7461565fdd1eb1764c42dcbca76830bfd0f27bbdbdfa72a98d73abf97237146b87cf7a8273c53006c3ef80faca492bdcdbdc35dcf21afb186723a5733089af0ee1bffa14f9810fa8971df88173861089e9267eee95c8f8b07dfb825f1eaacc5e3c64e243263e64e26f2d13a3b07b58fe219c4964ddcba2176517572b2dca6fe6e580bcb27e9e191f1e6c540ea1dc50f4fcab9e1bca25f5e52dc69a67f84dbcbda2edb8285f06570e91b6341aed75fb7d06b94911f944f4e7f1b9459aebec0c2f4f6acd936744d943f198dea178e815e3f1aa558bf003ae9aaf34fedf215dc3ea4bdedc468aef27df5ea3fc0bbbc980c6e35efcb3c2e8f4e5cd6abb293914e3cff0e378f4e79a5ccce983537b8d3e2b5d2ce793ce12cd3544f30ad8de0a2d77a4548e6fefafc5ef83dc62249b0b5a1e9cfa40cf947321db2ef4dbc9e0f4b769e7da227d7be8b3a57991e020c8cb04bb0df7e8cdfa79cb08b7209d11f53b94d709bff5fb0279bd7a9da67862c37bb99e413f818d260ccff077a5ee6334fe49ea5315acc7c7a1e7e6710e882c237c4aaf27329e8dd6bff5eae0f6abb0bd4ddbe189b6774e7783ba333853e8f9a80afbad91879aa81d9acb49eb3eccabe07b3c46e0d78bedf3c43d8c91d317f9b2e9dc5b19635ad111b4412e2a3e72116e39e7fef4bd23f093bf2764ad234583d83125abcfc7379cf7fd168f45437533d8170cf5bac3356b5730f4cf83dff9efcd83c32ef49fc5ef51943e4b90bd4e400fcd578a1ede1793281c94caf7b3d78929d60f9a2005fc81f830eabda2ea75a03f67fd08bc8e729440c69ee47897dd531b1366cb5a4bdd667292f83157fcb9a6edc36f87cda2d749fb2079f5c23b457bdfed10464ec5e0d0cf57555a734de9adc37e0aea3da2eb2f103e86d96bfe7933750651fe09133e179d0d931f7e59de7f58762ee3d6fd5b61b878ddc3fab392e2daef017d55ba147d7d39b59752715d056a630cd77482fe02688e02fb3e34e60af2fe119e9366549f50a6774394cfbe921f29fd05e4fc87e5d26558212c9b13d71f85e5e8cf1d7d2ead236c75fa8c51fdf7db4bece76be8ad6cb8d0532349d6584b4d9804babf9e3dd53ff9be6536a463a29c20df49d519f8ffe496e3f76d861c4c5cbf033dd8fce75d578b467a484463e8cbea2e50dded494e6f045fe0ef51f78e978dc83f21767206d44fcf7bd47e40df3fb28e104df2e9a2445ac231dbf0de9f3e03e49a5c9c415daa86eca3fdd6f07cfe89dfd5e5faffd3f3cd358bd94fdf0fe811d5ca6d0dc149f84e393b825e9634cea2741e43b39fad1e1dcef0d63eb6e4fe27893638ddb3a45209629d11671483fb183a463102552e6e418ec28cfe50fcd497ab24fe80e700623b9be87ac07970c623a57f84da3e83c87cd4bb13e7ae37906d7cd4e6b390ed1eec3fd066fff866572a3aa119e861ea667dbd416c7b559076d0371bead4d08c3739b7d56daaaf6650d37c38035ba69dcb2de5d13faf673245a767da6a64cdd4fa423cfa8801fd1631a0479fb74fdbe76d4ecda18a3a9f8be66eb1efde823a7987ee417551cc4e88ec49f73bcce721722c7eefc332dc4eee9b7713acc17126d175e4bd13fd57793df16b44610c07d970e8b92738a1d1725441821c67a823cb1a071a6f60df30bfbe8e5cdb3976bd017dee44e98075e7f85b757268d75e76ffae35b3ed5aa5d49e4e76edc66ed17e774a6d67b57b7b77cc6fb58adea959e6bfebab43a7a6161469b5c3ffdeb78fc3fc7729a7b7a79af96d305bbebdbf1cdede9bc56fca6ef1ad3ad97f577607f9f8b2fe2e6597de9fb9afed79d6fbde60b2938fafebef52aed496bc67fdbbd6d2dbd56c4119cc96ffae4e16df6aaf45fc7f4565e0c0674545591dfe3d98ade1bddaefed5ce76899ffaebdeefe5d7b3d7c1b6417edfa6a8fee555feddb4e0eff6677948f2f7bdefb766a2afc66df26cf19c0e77bbdfdde32f13a4aede3cbf2dbc01afdbbbefaf92691e7ac0eff7e5fd2f7fbab4578399051f629d6cad97a5eda6862fd90e8179d05674ffc473db79cd3dd3ef4e6089d7594fdd61b54180c457012aab3c4d8d784fe2127cf5183e780ef7447ea1174c0b4796917f4fdc7fd0e44f0d79b07156a6d48ff1179bf82bc00cfd6c7f8ec94efa8bde0f76fa4ece92db1ec290e3dd193c9fa913d9be0d98cfc7dcc327ccc327ccc32bc6696e16c24d7e7865b8fc1b369b2e03fa2d6d6dfa3e624e2f72cb64ea4ad47ffc7b3fa3fb278f2c658d83fd7047f785f742c2ddfb2f4b08e3cd57168ce9b795095ca6224fbbd9040570a109f076cdb6c684b232fc14cb483a6a0dc09f0c910bdcec6cb208676d405e867563fa2dc0b6f1ef5f29cfe625af4fb3f74fc43c73f74fc7f868e9faa943d7ea63f64aa9ec8de5fab2f2e94f5717b1092f53da14ee41987ac6f1d46b2043932169661debc5bb9b8d121ee0935b6281e48ecbc33629e827fcf54ffc5493e007f6ff3d0dadf5d296b0ae5c3e850a9186e670175a73057cb70873c3921317673a0cf5a3093297886c53ce3a63673c273ce3883847da4ef41613592377056ee6084cf8c3a231e1e65e933787698771e719347dce41137b9266ee252f227ea7cd26441f04effdcd90902d9a3ec19982786b6000bb9e5e83e3dd5170ebdf892d2bf289a06823cbbe8df316b889f9fcd236f82df7e8b7c5e8806936bfe636daa9bf4cb09cd1b49f0c1a3ef4dfa2e9b1b1b87a318fd17e48aad74c15caa427dd514d18c9473f2430187cd708fef2399f7e8fbe82107e200f548159c2fb55f8d0795a3a954fcb9c3a4d7b46f3f07fd8d97bab887fa91038969811defbda3793c23bf2434ff2506e745f9aed2ece1088c7626261798339924c8c4471fb64fdf870de51be4ef743671f6eee73c9b18fc73bfb3a1e44be4f9a4c882e09d1c960f5ff6a7df4bae5b89d8431b64632c7dc4fa5778b1109f8ef4f768d08cf87d0cf68ba62dd0431b3dbaf75e341e626bf4aa29be98481a607d34ecef3e6d0e4bac6d798b7e42beaf3b6a2e7e82af62887fd77c8df81d4d6311b675d3f173418f27f548d03bafd1df36456b6b1ec2fe0bd46b59a49f27cdced0f50497a05a4e8231308609e9f66b75ffc357fef0953f7ce517fbcaa197e621e66c786ce47f7a2c1cedcf3978e9b78e83bb9dadae54b63ad4823622cfe53f1c9f79f84c15ca6b432c6f40a7fdc7d0c6bce31873a88792eee5ab3d6a4a4b18c9748f9f383996f08c3bfa6b415698ae33bdf35c11f69c8e46d5abf535809fdd22aa118ff38bdcde1e46fd0f0e50a7a483ce3889d1c4ebb75faf8ff9defd8e6719cce49a6b903bb335e6b3c8734c5c573efa3e553bf63c3e8d8fd75440eeb46febdb9d4b474d6972d50148f07cbb52d3c5fad1e0f1ef3690bc41be5bf4eeb63fbbc6a32db90379ffa87efbc4874bf59450e5c244553a475de82cc1efaa552b075dd83b67d8601e76a179254d07a6f93629bff709af26cb72b2b7dfe279a885675ae2199ce73f23fcfb49025f3d62d08f18f42306fd7bc4a0a187bf087d5ffaf73a1f4fce46ce16fa9c670435dfda497d62d23b7f040f0535429adc5b7b334c9d95269b77f3cb51353f8e56cded0cd71146f2ded1981eab91ef9f8077ef189f09d542be29683edd5217a5f9afb5293e497e3c2d0b6e9b1b1ff07c921f1ef15925dc97a0de72709fc6a936e0c187f4f751bf3fcfef0ef7869e12c886c4f97f1e3e4438ee0cdc17ac2506fbb1fe036a1f92e82f3e07c2097d2f6d0fe8fd3b36c5d78991af38aa00fd23e95a59fa9e958526ef4ff704cd672e431f070f4f7b73ce8f86509e8ee43af42a9d30b901284fe24d8019e6d08bcab31bb4508f37fab917d51b9ec81efa1ed7c63b027a3c970ff1794d12f8fcbeb8d835e5e214efdf1ad6ecc5a6a4d99df47cf07c3b07f81ce5d980dc39b9d7e7d415fcef1fe2f1dbc613aa76d41ea5d8f49886b9e9e1b6b23e76df12fc0122f56e9321fd6eb48c4def1b6635452da7bb1ddc0f127a58a1be6e8e56f564903aa8cca10f9b7ed28b88964b64ae2dd469235fc64415f696916f9f91ef1dbcc79b42bf074daf097901c179db217f78989e29798566ed07df4bddb7d70996b1b037535dc8ed74a108fdbb485eda14f94860f6be583f98b8e79e0ef36d94ca0af75382df12ffca197b43c541e2f62332269666ebb7e87544cf593ea5bd1abd76c9fb0df5ccb81c3854a700fd9e1d4d281fc641cd828d74bfa74777a6d2dae9f98e43e521d2ef18deb3349a8896f3c15a4ed6998ce709ed54ed5839f6c8fd7be4fe3d72ff7e93dcbf987e9c3cef8efa579a4adb4ebcbf2b1d41bf188263ebe2708d6daa485a48c1e1ff1171ecc8fd3ac7cff0c839bc45ce6114265845c65dec64ddc7acef94676e123b237900ac0d1ac22cef7eec3e15e79de424da7a5eca7ab6bcb3d1a09fbadccfa1feba7e7cfd26fd8f49ac9ede936bf1dda3afe5797d2d4fd77a2646647920513f24fb561eb9a28f5cd147ae687caee8d1c8bf405c676b8ae5a92eecee83b1114df6b7aa505e85e4d4678ec7517bf40bcf2bb0edcf97ddc16f27bf435c08eba8dbfa0941d76775a1b3d5599d4ee9c4a643d1e7a55807fb6282e7a1f8c60cd566f8bdbc87137550f17322cf8807d1eba07ef3c03a2758c72d5aba1c1d5b899d7fd0e8808f36db14d16fbdbc2f1c47f2e9a26a396faeb179ab5686eff5f6327c767c35dd8ccca0fcaae7f21dcb2b517c5dd91af3deda70eb1bed7ef9be3bd8c737a565e9ae197ebfcf2cebbd9cb3832a17ef1da3ff16491bc9b626931b771b39193ab704ffff10ed556580f78aa2b94839a0c00c046d50b18c466505b3055577eff75486becac8f67b757ae6b43731bc7be373403397f223d1998eaa38be1ed88ec80600bfb87138c35e0c9ff32de528d8e907335d96bee3efad7864d3402ece60cf4c513a62fde23d076a000f951d9659391de2521033b1a15f1fe2fb89c7f7c344798ae75f3946842cd31534b71b7a0b78783da21e08b0ce48ce79b3416b2fdfe2f9a5e5e86ea42f29445b7de87fc0a75f5a3af43511ea598dd090087d817fbb7d811e501b347f669ebe3f52036aed3adeb95fba4f8d3ed0ff11f31aa27b5e5d76b26771f3e6d0d51af6722fa7bfa9bed8d2b0df6b4e2e908b97d6ec36a48321968fda201103e68c79656b846923922ffbf8ff54d7e7cb006bd373e46ee9eb0ad6102db758ff2bb57e44cf0ac42d8b1ba8692758364ec60fa2be9f8a8bfb0ec2738db69ffb3e02f9a5684bad81fa87fa39f251ef7306168eac8ba1f791a2dd8bf27346277904517b44e35a3e792ec9c5a5a1484e5324331eb525c4fd7dbc097380e67d0b7416cca68d8f0d249c7bb49c015ff7d684f800cecd7a93e37172bacd57ce6a831c39cfb5eed6d7293ec214fdf9c89f7fe4cf3ff2e713f2e71dc008fabcb304fcfca6542c0ad37f4dd0dd8fb828898b026e66e64c47628c34ba7bf47763fbbb5d18838cd52309188dfca6d2623000e488f9f57eef9e3ea2df310a1b0fc1a6f49e19f8a5a06652cff79da6c8d6914ab38b701bbedf9b427e8b6bdb68baa6e89ef57105e7daf2f769241705c00f7abe953c3f99e461bf3a1b13b0ba583e8cd3f7a5d26c741c9c8b47d79aae516deea002ff863ec27e3f5bc39576908700ef84f1f05a958b9606fe4f917e7665a9c33c4ab93805bb2df0b35db0af3e8e895ddbb536fde9da95f67de22502bda61cf26ffd2eba92a6cd37196a69b2bf327ef259f2432269e9a6391d6e04cd24c886012d435ebd734ab37b7b83ca1164930e7253ae6ff00c577a6d132c23aeb677a3e888feedc3c63bb1f12c230f723bc713d3a978df65eaa04edf214a3f740dd7b4747f8e58116c7bfcecca32743fd2ef1c7c4fc1be90bea7e08fc86a8a95a5f296898edb34ebdedc3d5dae179b62cb413546aee46a0af8123be0c7dae2df30cf043dd49b8ce4a0fe5e537a13b3d1b254610d388cea8f563ec07dcdb9b4d25de910cc4baf1c55a1bec1b1a9ac2e9457bc3eb2aa1d2be322e8e54cdbfb91e7fcc8737ee439df27cf39ca7e8cd6251c5833c03627fa3c227e9012c3fbac7dd6603614e4c01e47726ba50d7278e66ef3dbc97d4e745722ddfd2eb9c84ccd528eca098ee97bc0e5eff8a7f7de67fa93caa1baadff14daf934764ab87610d1c8d110a46cf4ece7309eb9b13d934417f13ea259989e9aafa1dfa5f93e86386ee7615231f8ada6f47d7f870a738998bebae02f022ca9098ea0c9050a63d2765905d9f267c5ba62654ad8deb9d61e823e5731322a666f71fe5b5d3a6a83ca10c9033efb01edb1f7bed8c714ac11cf156468f002acfdc8137fe4893ff2c42fce138fc472d17ed2078e4138261f19a32b9e63c3fcdebd65ef97477e618e23f237413de9b9bdfb7b5097d5686df56aa595acab22f5dd2bfe3f1b621e23b19c677c5d1343a867474a27e8a543e38f791f7a019d33a327799d345fa6f16d9a0f29220fe84ceccaee57924c62b190df9f4497e37b5ddfde8f42efa9347b537c3cbd8eee25fd59e334c14c07d4ebcdafcd00dafefcfd6758dbe35c5af9505b85a5092ed992b355a50371ec41e85dabb7962fa735b9aaff0ee7c46792e9e796b6c93f305643cbe859badd45f4c06e125e6765a0c99a63b8d2468dca698eccd38c88a388af140d84633861bd73992d76baf767e28347dce311f778c43dee12f750e7b335dd0b2c1a63fc87c73bbc78873d1e84fd7151cf64f637f5fd7e93dcc2478f9e478f1ede1e3de03bb27459ca6a4a730db10454bf967fd04b2cbd40bc05fc47909b0a358e7633e23eff485a81fac62cb6dba2cfe83fdcd7e8f91a3b59a87dc5f6e17f0a6d7c961869d876bb6d1d3645ffec7984eb880d519a82bdaa1e2aeff46fe87788b27943f623f4dcf6eaabc37cd73097a66879f5c4be0f7287e39ef599d6005f37f82af8fd05a86f20f61784f8f5f7f2413eead81e756c8f3ab69bd5b1c12c88183e4a930bff117a3e6abf22e6a0b0fa2b8de67e133bf3e18b78f822387c119fbece318a8713b0dd209c7b5119c02c9890ece3f6eb9737906365d895b59e6f39cd0696b708df4d26b45f6224ef97666336813eaed44c03e079e85946cd5ba81c34790f795a023dfbe69218528c5f84def36b6bf81e78ed81d71e78ed62bc86fa0c1421f7f75e797ab84e7f3dfa7d66f8e03a875f3cbbeff3e75f9d6094041d189a977275fe159201d2b1295a5bd39fd516e84a76360d9ddb80e6fd04f5f0beefe79cbe732c8e0de9f2343be746fe8f87ffe3e1ff78f83f1efe8f87ffe3f7f17f0016825e313073fe4de93826d48dbbe6835e62e905f4748e8e37ff7369a5b788925dc93dd659ac70e3d895a6f4d634cd26e5bd0e21c7bd4ec7b82bb22aef731c7d9be89e157eff4deaccc96cc1fc48deafbcde113073710fb9b510d3c27317871398dfab434f4db93731ddfa0afa956b32cee33dc78f711aafa7d79042df6998aa25e07d89e0bb647d87d7645f4d3773c87b966edd733a7a5d74deab839f4bcbb108bcdb74503dc56ed4685986309cc00c4ebf0606d76c6aaeb3d21bed75d09326447b96eef6200716e5f653fd96c379e3a138698f7f16653e621d94bcf6df15ef2fd21b043bc4e37f9bd498826e50f3d261a4f48b6f4a65a5ca6c8e6d329d24dd87f833abb67f7671b492f83ef07ba5b72c5727fff33f99a7cc72f4733c5f679eff2fd39d4d32cf99cc53a63372c7f0b7bfff7eca4cecb5b5d1ff3416ee5fe6c2988d7ffeb55819236766af9fff5ace267fe99bb9e98ccdbf5686357647abbf727f66ffccfef74f23f7d7de75e0aef6fcdf0bf8d31caf47b6b382bfcebdfbc3179e322bfb38ce3c17b2e52f4f1977618e33cf42aef0b5502ae40a39f43f3fd636faba9015befc772efbdfb9d27b4e78ce7d7dce0b7f96bee6ca5f8b45a1a4659e32f6ea8769ffcc3caf7f6ec64f99d5013dab36de669ebf14b342e129d39c2f32cfe52fb9af5f8b85a74cc7b1e7b3cc73fe29d3468fcd7d297dfdfa9419da66e639fb9411f19fca8f1fcb9199457fef9b70b7ec536640bd74c599d16ba8380b63b6ca3c979e322f6bdb8577188c8dcc73ee6b59c80bc552f1cb53a6b382fff9522e7a2ffff753a61df955817cd55fe7df4f992aff57951f3f36f3cd6a6c669eff37fb947dcafeeb6f38536bfc135eab069b95f96bed2eff9a8ce799a74cd35d2e7eaebba3b595794e38f88042a8ffa92d0c2099a7ccfbe8e764bcf6fede5f2cd6e147b4476bc3ca3cff6fd2edfff59419ac47ce989c24fa477f3c5a2de699e78cbeb11df3bf9ab5ff72ed958beef694111775db19afe0bee6c2f873b2c8fc8bac6695799e6f1ce729531b2ffdbfbf8f576bff37c17f31bf682fcc0dbcc5ff6578b6a43db2e7e48d4f76565cb41726f9af91b3fed35d98e8bfa5f1cf958dd695fb5328fe99cdc0f92cd1e30843f21c04072bfefd943147eb1159dc47b23dfedf64eec7dff95502a0e80b00e1970980afb987007808803b0900c294f79703deebfe3046eb91b398fcf0fef3cffdca4c1610b1bf2222a324140a5f89cc2808d9646191cbd122e2df2367952a23bef832224764443e9f2b95ce9211f82d638444ee4b8c9828e7f284a1cb6962229f235fcd7e9c78e0a730468e0414e57f9e242b0219e131219604f8c0c2a280e67cefdb0f9e8fe0f97806f48541a65b2b17747937315de96008ce569f66edf6a0b06bda15c862d8a2892ec7ac2d497df1ed7d62778f856fdd8675fc3eef4c47a2b4560795822eef37c67159308e597bd4e8678dda62fb9637f3e6a1986f1f8a5bc335b6ede9cbae3d28abefc3fdbb2a588e2ebf86ac62b092c6626ea5cfdb5ff477ea3e42e768b86de8047934abc5b99e5791c5afe73b5355de6dc1e3a02ae45d4afbb7e94bfc7dbddfac55a19e55e5fd563bf23f07ed896f7de3ab61ad75b178fcee96a15be5ea4d014bb1e37407ad85d9e8efbedba5ad9aef1cd4437133523ad937d7dc9a36f2da6f0da163e9d5e8f7f53d20af2803e4559335cb94f7d9eea0b537e5fa0ab28eb588e82c4c26d204298bba6f2b9d9d2a5fff2e2c5dbc4d5fd6baecac34a59385685ddb6efe817e53db178ce3d2d2dde296743ae806fb551a37da5f54a5b21bc945573f16022bbcfa32e98ad92f86d0594076399e8af1c77bae53eb0d7774e4b1a62a7d4b17cbf3a6b72f9659db6df5f7e511d3933705e33de1de8ae618f30e74fefc23722dd3f0fd82b547ddd382294ff89945bc87b92979c76eb5bcfe3ef7a6da913585f6b6b65f7f0f22c6e1cffccf5ffe40d3f15ecbefbd6cbddd7c0d32c17b84860f1557cfb7d6a82b960095e59065e4756035c5d28464a0ab2e9a7c38d585fdca9f28031d2e1a81a780ee760193a3b52ac9349266c1673bf0da2d5007719cadfe56adc0b9c0e427d475187fd71937a0ba7dbf1ca34a85ca4c173ab950270cef19e0b1b334b19e550785095a2f6449e57b411578cabaa14b2d9c892a9467da7b317c0648860dff78f3ce7647d124ec73e94dc87ef1b3d0a2e8d22d6fcd1a73cf397abfe0cc6abb6d147d77abe5389e40fc10783a2ba8237d77802bb50698a7aaa97c95ca5b641d7862e68ad0e290f212a335b91e4fe955af3b3ef30cb857497f5f86bdc1d3622ff0fab68ecc94cdb52677804e968690c5596dc8a3371ba37770a64db17e340467aebb4047f8bc3d8fe9c238ccbc0ec2b84b3164a6e13364de813933bce746a3b2d45d6d1bb5579a589e9a740603c3dff0ddaed864f7c8ee4e1713faff8c43053cc730591d7547d665693a12eb20ebd7f4142aade1c09e2ed503ead2bcd484a2578581f8cff34c7e6bb497a4831d95c9577acb5bc7ef6e7fabe6bd6c081579790ba7b42717377afe743d4857c39406e8dca258a84b5cd49e1802748f405d0c827b93cba34187646434210234cddaa7fa74b952e596a3579b13c8f67d173a5353691dbb8326e089b5aa585d55e8e48c79bbdc9cbeda6f51b48ade57c21926302d4e72bb83d6897cfd3e978ed0fd1cee3b928bd47d5feca698b3c6afe529c835c024a65c8409b11b0dba04daa56bd607b408d125981095bdf3b3e7a60c5d3220bb60086b5ceab3e0396db4e7f542b0e7ad9ceeb6b6a65c9c015688dfebce42179c29ab37cf3f0fe2614fdf93f6a0c9f225b958b9089984e526c1576812637da50bc617438077ea64af781ee6adf8f573af1d6550aeae3e13ac2f8e10f180c818fb4edefbd4b3100957e752561bf87a0332df26d041b23b0875ed99a008fa7bd6867d7d9f75bec3f79b51f2cebbf706a2f2273286be6afb24f94a5fa02f3c1d2def084e0a75bbe7d43b49fae7d229c748ef33d57931eb8dd6edecd56da4c869f6aaededee2cd4f5a6f42634fff86e572e5f539aae60d714a333683d6a362032d6ca99e2097e653f9f78679cb555616f19f9b6dd6ca0085bb939b78edf67c51ab24b686c53e5c638dc582709f3f4bcf7f2ce9ef9ac6a47df07e17026e2d98509e4a203ba08b20c703413ad15453ebdf577e0dca6f80c523b1a79bcc03c27660f3cb955df19609bbb113a25d8d313cc9dc45feab458d301931d775b755af8d27cfd9075f2be17a6e7049944e858e94335b46d2a7d271a1b079fe309e3e5269c294cac6db4d75099fbd968b52f4a3b4d2ece8c4365ab7bb89791a529f763645b4f86f7b5408ed0d9391398f2a2e3a91c50718c9ee70e3f897cf4cf08269a12f958017a1841764b388300a6e06c4702b235b323d13936c1267ddf6db9317fb59c76b68426031d4ce9684cdb5e26b3281524a47b43b6a08ff352ec4082a383fd49a7bb547a8ba233da9e698a7b4b75a5d515f660a5d980ac858e3312cb2b0dcec8b3832cc046202b4016e0ac989567eff49746beb25295d986430626cb3e7e99575243b66b73a28b755b93773e8d5db98eb4f748966db5fd291d0d2aeb9162395a2d6b9b8d56ee443679bf991b6e396724e0660df9c01ce08f8d76c82d75845d87212c7a433ace19427dae0dee4dc70c0d5d4ecfa1e9ddaa5c9c3545a960887bd0df1193a7913f2b8732a004157c17b4ac4d97a929b2945b8682ec7442b29159d7897e77a0ca0232b034c5dae9802de7645ab93f95db5b7f03759b072c6a85e4ef345dce62da893b7befdc7106d8b88e7186ec1434b9576ecefb07c8a463ce0dfd46cbb7b6a6f292609f4982a6b48aa0df74d700fb14aad09c137b3c01d34235d467c308a14e2237c0089776e3fc24d895b52d91bfee8a35f1be43b22c4fc7a9f8f7913e3afc99ef83a0632dbe0c0e302dac05ba4601fff6685e29a5da7b8d9473a9ed4f65e82cf43c3ebb2ad29e7a9d90df7913e19c4df06fa96078dd6e7cfb02fb84033f1cfca6d1778c7cefbe32f664fd183b88649f50e537f293e07f3be346053afb1ffdf80bf6f74206aa2a3be0f382df6435858b069369afb68fd5e3e4c2b15cafb3f710d6d30fe88cfa1ea1b971033e4731b489ead68fc4b7e467d03274c5d05794ef0aadb5695750967677d08277f633f8a9b5b2572a4dc7bc43b2ec398fe62790a96cd6ce93bd4932b837c0712d7937f9264a054deee44cb10e5d0b973cbc70862c6669886f5fce97cde402cc5d0bf1adcfb32cdfbc2c34c582b8645645d32f660897a06e0590a3e0f9c270672c8aff78fd5fac2ce0a021cc8b343638bd2fbe1f4cd4d4e4e231625fbcbd4ee237f24e611c84e5fe6e1bc2d9b5c2b7283f35c12c5a5e824ed21ed6a1d6d56d24c88ce08c937cbc51bedd06159fb84a17a00a4e613809752cc35504f099e93a3384c70760e774b63ac48a5c7c7e36f04f1363d718ac6a17eeac27cc1cc408a1fa517b27d8fb4eeb4ce389141eb861fc30d5361dd65bdd61769812433b8df79c1347b9a16d4baa673edcb695706554082770ea9c281d33908bb311da773ced44940ebaec1c711718e2d74098ccfb9cb6ad016bf91531450e7d93ac5ff8f509e80f5ca176a2f310d6bff1bad2deeb7cecf57bfa708e40fbd0198b5e7b9adebcc89e703af04e53e2a3b894be87602f088097912f18708217e31d542c5d2ea3ffd3017bb845907782a6e09810739f0fd307cc3a913ea823bb00c5fb70c7a7e9482c2f55e477c96dd1ffc9fbaca6b436706fd3958e1f42a3d1f6017463857c5056f647d14d2cddaa82b51dc91de7eabc097c16b13aa7b667b15ae0c711cbf9373967e9f359d89f1374d40ddf8b4fae73cbf72439df13ea07c8b7859c39b331e3c3504958ca3117681226ce9500fb0c72f59a0dbfbb2ec61c9d9c2a4c26aad29e401e249285626e6b7c9a3850c7325c8841d65790a7e8fb7620efd3ae60f9049f1b2b3ca51e5747e2bc8dbc041d762d1cdb42315a5d2e1f7ed3d810910b1f2f8f672437e67259dc1b54304ea2ce4928f832cd10906f167c254753ee2c9a627987ab62ef2b93a9b5127c4ef002c935c239ab6b5d80aae68e03f9c130bd09d3d7d65b73f1f8c00e3e7680f847f60eb627c4f2b0ef83a161be380fc42da1d3da12db56e0830b75a4a27c2da8fb14c68b9631efdd974e21ae43fb1aaf79efcf631bf2e43f92ee6477c82d053be0e5863996e641cf4bbb84b5f586b3f27b7f907cff88f789e40f5e3c06f68fc993ab3b9736aa5bcee92e4cf2bbee99b803d4b5cf247eb4385c5a62e32d418cc49931bee6efbd2cfa8cd6a7dc7a95916bf1fa3551cf86625337c07dd2660439a483f02439146ff062d6481e801f01306153c45d516ae9f9f58c8e4892d3fefef1e02b7241de3d1bd744fab87ef99aee85f1283a888a73a03f9b36ca0f2887265c7c3a7a44fc703d1d06f9ad45d20926225f6289624e88cf83dc099c7be0fb63deaa150f4b80fe1271fe1739e32a873fb7cae8b4185d7cb64e2617e8667fdf503cc38f478f659293369c180de96040c723f045b8d0e912a67722fd81f2b7297af6bb128d07bb8927ab513c91e4f191bc5f9e7c23967e13f0d76ecbca4e7ed95a3c9e11c7bb232d4397eb3ab71d9362cfa0fb1af98a05be1943912c9d39638449079f892683f513db46a76a449b8d3aaad3d3e64e90e7ebe9cb4f445b9d95aa748e9f4f567aefc5697b24d9207eae19252bd15a91ad217acf018c00b8d1cf1ff80df4799077efc9c58f58e7bd74fc0d6d63aaee95b2e5d37d8da5345911e55b1c362498fa1ae4e943ddbc0cfbf4caa7e7237126a9f7948e54ce0e3eb33ad4dc65f1b9ed4cb9b5f26233287689749faa68d648869c6307f9f5e2cf39907977af014ddc3744cf3d52036a1c825c1fb24eb09bccaaaffb43f742f994625d506507f2bd97bae864c783d83a9f4006570bff14db7dff01b67b4e75f74b43b0c06efc785f810bb2adb7d6e5f28cf46538b1d34fecfae6fe24a671de7e82cf14e5979cc6525eb9eb1fb96d76fc7cbf1f827dbb73247119c395849182f29ad62311ba21960f634e5f4fc4daced2ff676180381ce0b41cd375bc3379b59646bebf35e6b3495f2e668df999b19c38793bab6f9a6053345a45522b4ef8d3141df0a1afa0b787274783f7310ecc949848995259ea36c420a13e006cb3cec270cb1b5dd86f35c181f39fe879e806db9c7c13671b6d5058a118511ef1e2146433d86b9aa22df5bc74a49f0fdd5f8d7927fbed1c4c7c062e0ece2f411e465d60b3a59e1bae39827e38f3fe613c40fb84261220dfd3bcbf447b0334eb4d28803d5c223e4139f4a5c83ce340ff800d58803d443c0dba6f04fd2fdcf24a174cc083c8678b6a50aad6f2cd1d6edeaa304da883e4830a71ee2af4219084915cce1907eaf976c51d29ada359e5aa4989c240f158be9a10aba0f0127b71c4932ff22b52f2e3267e5b064bc4d66433df4bdd07fc3d5fc647ac9f7bcd40433019e6ea78fa79ba07f925eefc4cc861fc79e7671ebca95ff75da72e108c7cb58ffc3cfe01ff52637613dee1c64e27d318502cab1874b98ebb4ecfa46ac77d175fd79e19f14350537ed2f7aab9a7639f1178235dbe06ef92669b27c5470764faf2997e8a385f45859e4e80f2b0b12d05980772d3b1ffd6325ca49fd0242e1edb2d4256f2acfb6cbf846fdff9bd9f886f8c9e58bf83da8c35d697a0fbc176f56c39e8b586e802a657653f977ebd6fbc915a4fa23d497dcf970de13563bc17697326e5c507fb9423bd33885d41d9ebeb93698764d29920150d36efcbbb1fc959d91a76a560c099d622f7f3109e1899c313c94ef6cba353d77174b13719372a96294ecacd5905e91a4f97974ed66ce21a367cafe83d8c93576ecb5285d51afc3228cf95e289541974592e4655953b3f41479a8d3e775d628caffdb45f9dd85912de2235ab70ae7a5e3aa84278aa9871e0b037526c0c6ebb026c8919daeb09d96b92a7769375fc5efe1ee087ada9b4a6dae06a1c9314eb4f923be7f1c99cb2033f9e4706948dd6049b5dcfb78acd57cfb6e7d4d1917af91f60a79efa3dc26bc7e77a937a82b4734075053db9003d2fb3c6dcef4948ecc1ec08d5acee9706ee5719d8a1a83fc036cd9f8dfc380da03df0ad817fc63ae842710bf818fce53ae415c27ed82f8bd1a0e8bcd9bb892e97e10ca1f6099d0bd4049aaeb33595f684b283279aeb1c8c7c6ff9f631b5f951fb9e6827f3db057802cd15bdddb8ed1ecfbf7f1cc5d416ddfa79d05fc9ace6d6bae0e5b7dcc5473df7fbde72d87757fba6c9f3137cd41f718e68eae3b5bae657e4b225c5080fc46ee3dcc3d3730bce2e8eb7d92b35be981a6ba46c2a1eec978a03e9e956dea4588c81c00f5ede60190b981bc9c391d277f44fea6bc6be03dfaf7ca91d7d8ebd9b22dbe92b5dce57cfcf39bf555d27b39e6b7dadf7c195b837287defb4b854b791806fe2e24f33f49ccb63f9b3d790cd81b00bf43416cb38f6d384cfd77abeef80ec378472ce803dbb2f5e6b831f98b2ab0e9aac39c01b603341bf737550413e54dc7b03e4e7d61487b46d85dfe7b6b614e8d5b128cdba8356d6984b273d5639fd3208078e95fe0270e29bec6c205f5a3bd58d09795216f0a18d6ac2dfb376500b45f52f8ae05153692ddf9496a3b912f8b5a627f525e7e9116e1d12a53f46c0cf4a2b0b7d149a0dea9d6e93bfe7c512307d0732b487689cfeb7366f599ae0fdfd9b589f9a0dc9d2abbb89ee9601cf101a837808eae1a9c9bd25073ff0f1c5f9fc412ee09305d0a431ef3be3462fc4d714ef7cf43e70f3f55b3ecdc6de6d597ae7e607f0d77fbe3ec8034dde63b9723d4df7f1bf55573a32f9742ef08f8ef7de10aca5510d7ad66bd560122bc899d101cd5f581a87ca54950b133433422e4c467e5d7d99ceb747f4d3cab7a99ce633301727de3a1b6b119c45e80dd3fba7daa334fdc3ee518c1ee2ea4b53dbb39fd3bef1d9487e5de3c9c9211e49eae774271ee968727fa9e2733a336e15e51b7bc5ffb6a1a70ac2d562ced144c7c27a91ea0907586a0df508811ff39d3f7645ce2465dd67c7ac205ef5ee9d193e2b12b3c2fa466e411f47149fc267eb7d8fca2944342c483306c3dd4b56df0e5787f3fc827748c728a9d824d2a665723725d15943df3255ceedaef0d70ee1ac60f60da90731e1be683a36f42f0e6444b3615a306f04e5d12a30cfa8776f5f698fc9e11d8e501d44dfd2951762cf5ebb9eb4f749a63ffe7e16f1fdb7c895d0ef2e02b7cfa0872aa289410ecd407a837793a52fa89f825d8ae4114370909d8d665c89413faf341b177fe6f304de9f381f12d40d64dfe41ccc7283de98335aa6a7caf274191e25bbdbf0cca6483d13f0b72cc1f4eeb57145efa2fe296d816c5b859f476894038fa4e0106efc01b86386728127542ef0c40ccb098c45ce5cc307d8abd1b1bf787a647cce05f81cc74d6c55e938917e67d4934d4272e24d3197a63859a3f52acb2ddb7380a2ebe34846340fbd02b38cdfe686fa23f089d3f7ff18df4c7076cd86ffdccb7d35deb4fdc0bf1ee0cd4076223f29aa2dbc7bcd44dfff5e8f9a1f88b14afdfc774f7b3679f76b6357097447ae12dbdfb129b62c4338e921b48df135c6eb1dc2c351ef893ff3653be64b36fee3e3fad37cfd0fc747645e237dde97ca78722f90a31a3d9bf4eef21ccf5d0cf12cca07ef0eb39dca3bc4b785bea357037a7ed0ebf9f4eae7317f3c2e79a5640cdb2ffc520c5f09e15aa6cf38a90f4618488478557dc3f622a7665752b66e686ee52f91dd917d185ec3be426646153d9f76a2ce67d83712fa0e5ae36fca27e1fb33b418e563f17d2ba7b3d9a2ef45dd2f66dd5157aacce691e112f629f0c86d1e391ef4e30c6ae3297f44a88f07f4a5d3c43ef85b8e6fd50ae4582ff570bf03b0b12c2adf36cdf665af645bf87abf3bb9c056f670e9495fd20fd98773df93ec43bc6cadc6f25094edc05e2592b38aeb65d7aa2b1d106d296d3e7ebaeef9e442ef61b812ea25eff79d3ba3677dd48575d8066496e1e2faf48637fbb23b68cd746146db133c57aacdc17371e31a9eabb6df7567af93bee8cc4750e75aad7471af91b04eb05f16fdd772ebdbfb0af72e71d00c6014939dc3fcc9ddc4101c1c9b453e734b17f75b33dfc6f3805b0e9c8da668f00cb0e3ada6a8e5747137515dc8d176b65abe6519a205b37321df6d6bb8c8efc5ce71e6b930dd5fb94704f75c785e985f1d7d2eada1efe139bfc7bf051909fc33e5fd2dfedd593c8cf66a2e6db86409c18952840e66f72dda2fcc9c11e55f60d6c7ac254aa7dfd04700fd0d5e3ede3fe0b0fdb42fc4a1580e351bc8d7027dffd0fd41a704d8449ac5e7a5fa73d657b84720cae96d8a5edec2bdb1e810e6008812d46810bbeb2e6bfc8cb9b2044ffab3ea79f20e3f516d7c90f7768a3bd3efc7e0bcd9c5355ae760462c2b38d67d1956049c48d7e521ac7871aee339baed0c1dc6e13b3e7f1e9b5319bce73af5943cd908fa2e25af2789e7aaf17e0986af63f9b2db60fa02f1f4f68bd8d76e83137fd7f649b234cdd781725c38f549925eb9671f3b5efee4e3cdf3f9127892ec1bb6dd30dea57ba3b133114567037805e5b106b358f03ef93a8ce81b945fa5e7fb0bf0fd9079733c7a87a1d338fd1319d3898a8592abdb08b05e53cc393ae41138a0b7a423479fb5b39f472eefb92d88211f467e6d1ce577499ab1c85eb5fd29aeb671ef7188d982fe11386dcee07e697b1c7771e39ca4abdb7841f98ade2c60e403a07813fa4f7bb38246289f056a49509f1754f30533da60b605eeab15d3570dd58423ba36f2d073475abfc17c65e823dda07490583fd2f94e60ebe167cd345983fee1982f3a3b4dee405dcb34eade282755ee61db0fe14fcbc877b6aae0409c7046b01df082c1c8e6a40bf3c3b9fa9795257cfe90135d5284671eb87e877fc3f824e27f43becfc3c3de1e40fd7392cc48b20113fbdea5e8bfa5f6f9722c2ff1ef27e94189d49633feecb87e6d44afa5c7ac98f34ef3e39eedeb41b1ac50cc81d85397af89176f72604d4c9b94fdc660b7da9efddcb787f4793bc86b4ff32df2cb746e7f7a642c94eaad7a03baab3445aa87ebc08fb1d03d95113df3d8e7ec9ea7f0d6d97a0cec7666fd24df9cbf6fee27a12d55807c9ef6a79bfb1cf4533dfdac6a47df27da8ef6e77f53d816e7c2e69b136ffd9e3ec76740e5c886ebc4098efd2418be0675b6c6f164cef92dd7c9fb5ee9f882d071bc6fb4c47e1ef828b13f2cbdaffcafefc5ede038c90d7b25fbb9dcc42f18d89dd3119aefd7819969d0d76fa9f2f4033939930fd0c5c13e30f141bf3681cc4ca2f4b005b3f6a7d0c305fa458ce4f6e68e34783b9fbabbdffaf6074563a998b0b64fd3575118b0edf753bb221fa9876b0ad17decddc4149ceca8cad82e22cc91ac7b316a6c97a902e47ab49c500ddbfdf3b6437be0f77f87ba4e8f0e56be2f5de91c75a1b3845c66ad5aa1fa53833fb59e6d8a1d0bf23db550dda534fbac3ef358ffa35b9f6950ff906fdfa3572f8ee17f489f80d89a6b43b4508eed7dfbf94987bbaff3d1f7e1d1f7e1d1f7e1d1f7e1367d1f92b1f66376d86376d807cd0e4ba2ed187a8ed31d1456cd2def511bf91e60c9c930dc6b80c7ce8bb2ef0614be4433eaa91ce9607d1ea69fea42dfe9da24160158dcd978fe0e2f873a3cb383dca7f0eb70b85da1d6e7cf0b3b59b34eedab264aee482e428c67a9db3436e7f097111df76bebc342f29295f14c7ce2a3f3babc3ed31f6f7fa2b9b4e3c1cb42cfb7e6aa5c5c8e49ce28e41c3520e7abb3d56dcef910913ebcd709d3d716f52a84b8df5bb5e2402d2ac499210f53cb4b2b15cd6fa8a3e76b427d3352968426b7865d8138fd51170a4bf81ce58389decc66d31d4ebe89b99cdee82fc146f3df7ddee6e979724b3e3a634f49ce58b8cf389aa18166edec269aec78b3041b9da5ee9a395dac43ff4ab073b7866b5abaeb7cc1f4807c8510cb3784f2e6db80ccdac57d5d15cd6956adb529efb323a0cf01d9cbe2f11bc79cf114fd715ffb784ef6f0ce7655be35e7b4771ebd021fbd021fbd021fbd023f43afc087cdf8b0197f779b11cf2bb88fbd38841cd0c6ec1eb6a2b7aef7dfca4ec4f896cf46c4737b1ef6e16ded43827fefc00b9e3d7105fd57029bc78f4d62d9d4c9b27359ef4dcbd89ef431cc99ef9af62cf2aed7f6838ad3eb51323316bb10db109fed3d7aad1f4762f960627ae1c4fddb6bd6087e8c3bae8ff823d623b9c7f1bc1bc632ddf2413b207d78573b5195fb33e4cf39dcf7b994aff117ecb5b48619c83cf41bb603aea3ad9158de6883fbeeb3a9b4568f798d57cc6bf475b46f1f73d0ea630edf630edf630edf87cfe1c39ff97210e37f560ff8b9fbe0efa5682395172f8bc174193ffe15b196d8b8c94a934df03782bdbf047bec9b58dc9ad58a15e0dd59906787e267f529f0a38e650015cf4ce4cb145ee4e63fe0b977267ee2e7eacb8549646c45dc3b1a9af345e2272f0b382bcf0e2571917f1eae4fc4bc837f0aa6bffb1c69bfcfd19d9f1be40fd8f7c7f7a0ff47f22e4aa67f1cbe9e57165e1fe2bbee73ce70efbe4ed4afe5aecfc4f37d3ee2998f9e1f8f9e1f8f9e1fbfaee707fe2c1267e0cf7c3988f72736be42fbb1a8bd4ce5c9543e8ce43d8c39214efc7a41ac2f16fb86eee5d5f153d8af59b58ea6dc5934c5fa11f5397d2d0f7a59e7f5db006ad3a0f742c56a8ac08bce5c77eb30276862089d85c659bb7a4bec4bfcee204b22e37faf1fbfdeb4f725ebbd362728216672bffea79cb36518ba8fb70563de21863678fd33a46fe7294ff1dd2fb64e91aad50ee60e85e96bd514fdba24b0b13c9f28b2bbca5e8e9a0834f27a59ce0a2d3313625457e7aef8f92b881e49dce763f7e11c3d78ae2eac9659da3ebd2fbe1fcd3f31f92377eaff8eed396a4da9fc741bdfca892c3dfd4de11b5f1ed749df6817f22fd528df44433a22fe4273ece0efe606d7301e55c172747978efb867eabe24eb98483fd343a73c74ca43a73c74ca2fd129c6bde6183bc15cbaa6788399c6627909b32b60ee00f446d3ed8a336ef41dc3c5b676be0279c12e3b0315fbb0e93e680b5501acaf41de3fccfba0fb85a4f34d0a9f70f305ce0d3edd233fbf668b7a312a4d6f1eb25c98205ca2b488df6407fe12afd776f03c129bd0f315dfc73212ebc751d5d3bb30d785ee1d876938491f127dfa297cf8de5aaef6f5129e8b7ba7843ce0c75ce6c75ce6c75c667f2eb3ebcd5e7d5310ed073c90860def44ebec9cd50f98d1bca6e7db5279e5287706f98ae899f5bcb82c45cf9cad6fc8057a67169a974b74ced57375d36c167261da4d3afbbbe5cb137faecef6824ba7cf54ba4cca136ebea27f5f8189bcf91adf702cff1beecb87e80f7cb680d5a06facdccfa9f2eeceb886e44a54423d98fb7369a5575f16ba28cd5579b7c43204d1953f7b5bd1961acca5afa5d3530a1dfd0a1b78fb2bb13d9ddff0e1f87e76e2d720677dfaddc982c71e164d79bf6a562d9c8333c37d243d8c1b51c77b5f9a8e5f2f176db3be2e0efd7d2bdc1d4f7be44a98911cf2c9d8a5c8fd4daa17b9a1ac0ee7d37cbc4f34f4bc6eb5bcfeee6a5be37cbaaee07c4ea01932e304dfdb97d987a6589f1a6e793396b3f7a5eb7907eaefb3586e04f6e7b9ef9ce66721effceb6b93fcfe996cee06a6c7247d80d719f99ef8335f2e6319cee4d9f8b8d9f763dd4156fbcfbad6070373bf691fdcbdfdf115926b46e46e6f50d9e97998dfbf9b043517853bd263446e2d23573972ac500edd9b0c3ea0e187e420c5e73d7520cff51e3540403b59ae3a8de9eb756b82991e7998779743b5a2f7c8bd5485720e666e8d2f5c5f52fe4ab076ca1ec278bf3b6879bef269dc7e25e8457efd98a4272f898b7cde78488a6e3d5bc77ef6b8478a1c8cc49289f301f7c1fc0ae86f25e76c4d69211a318e0c9e8c78ced9cf239747c3e1998a317a3f812e19be0fcd0499986e7d6522dd6d6d63fa3e445da93a3de93a9bce12e6808ec432d8537ebef63f763e0bc71c2153281f468766fa7df87c414917773e1bc70ca1bc9e6ffda464c1b93354f9e542c2599c4bcb986ec26791f23bfc9b303f27fc86c1ee51788df1c9c4ce440dfb6ca2640fd987687ff98d6d84e65a07dc7c07df64cfc7cf2876719dcdebf5615b613f38d669f5cd67b01dbc58abaf8779df35ed59c9f2ed3fcf5f331d2977e9cd5883e75c41abe8f76fd54a56cf9bb83ece7474c13ca84a650173ef758873c826bc2fceeb82da1fa02123b0398ff7cd7bee090eea87e3e59f211b6e05b14613f737d4042998b90db57e609779be109407807ad9835c390478e041e367d338b1693f5c360f1176c4f143e6332eff8dd3019e033f1ef4111046d05b7280e2ca16d49ceb82b93595f6a574734f39fe8ef69cd07efd43d7f5e087f3f881f8e2d6a837f33df882f8245f518fe82bf883f88c10ed8c06d20be458cdb4c1a7a1fb1ef173f6e0bd7cfabfe8bd1f747d1e5d4fa196d66cb4ee8267c8b3aec134fefb7a798ec85f84f4658069ef1b679a75a0063a6bccfd7ece7cef9866afa6d8a4ff797125ec1bfc783aad23d952e3cba98acca5f26ae87f1986c6cf27f4e8ada7fafbc9c9845955bf341f85ccaea2f72bcd7fd46d24e84b5f773332d1e9831cf7fa80bd5f4e8fbda09fcbc6447eebe6449dcf26a628ad34c5ebbd70679d3f049b03ef632037fd7e2ce0136dc19c5f7b3ca86443dffd0de566c41e043413957b3a47beb9b8df71d11b7ba5d25f958b1e8b062f16ad7260d3a4f9ae82cad4d7d17170988b80641cf209e8796f86049e0578510f310e19cc5edc3299bda0be5bc279bba9f35f6fb00fe7be27d987049b26965fa274017b215ac47eeaa6987310af23da928e9cbc73d5f3c9e5bd07f86cc1e7d2c926e43fa6d337b96afbd39800159ff1625594fdc873d5f6e79cc7cde935216eb3d0851cf4f4473a2490db90a7f272de1a29d97ded3b9e49bfec85758c04bad231dcf37f9f1c632dbb5097f58fa101c80115347724771e7470161de4b6aaebacfe41b260ad0bad25cc77c2586d027151d5950ee7c6e399f3e3c1941ce7986027f2d062f81cf97e8f7f0b98056c6835cff95bf2bb7374aa47eb39dd4d3e47c6668fc2bfecbe45d6aa30f7a3edb7f0fa98b544e1e91bfab3504fb78fb7cba4c1fb15fe01496ab5b16f28afc968f65916fbf65b60271b6ed131513c433a52f58b77f31fc0fb913cd44bde35ed592972f93ef52d31b5acbfd81f8bcef80ebed8d7302d5de15b18f8f9f3a8eed694a1ff2a63e7ddd7b770b2363253de10acad31ef81dfa3e0d5a256189e7ad4669d5b9b85f6f20e33792478ce153217d72d933a56d05739dc6fc0f3ef542bf991583e6a83ca117c0bd0d7473d5496c6016804e45c0ecfe46915714e2b9a6102e7e3dd533a6a3cf9b8b78c3d38a84e9bf8cf2a38ff07d7b3fa343e2534d66c980b3d0f38bd03bde9a147f2b4293a47e05753940e90bf0e75bd9e6fd072507d0fae0547f7543a1fd5c30772cb0b9adc03dfd7c13ccd41e5ebe1e84ad0f7a2688a16f80ea0972cc45d9c904cbf21edab2ecc3375b67790d7d591dc5a8de4f2dc70ebbb119cdb6bdfd15c6733a26b062f890fcf5e49bf6fa0ef03f4c2063a19a13e892033258fbec5d652851e2b7efe507b827a34002f62db4615200fced9a8c23e07fd79dfecca107a9eea7279a6818f0a6848b480a7c27dc56dc467de9c1037a0c1f75ca7d61beeeead3f38f69af01be20f0b68ccb0d15cab3c9ccd08f555ef0086831a4ffbe47e4cbff1a6d88179ae4bddedac4c19fa7a142612f842c5dc5613a515a9bd1f29a77b0effe7d5cb909961ad62f3b5fcdecbd6db1fc4ab517b1bd4770c3e86d7a85e0377c0f741ff9a2b62d5956623b80ff4e03484f274247b7d3671ff9e2cf08781be87706040a3d5ddc41420a76702f54ac5a6d8729ae249bfa02dc869c3aecca0eec9cbf1037e7688ecb937ef0ce8be093eee0ace6ea22a9da32e7496b8ff5d1ee63f637d4a6c882cddf7e70de965c4536b55994d54773851a1364e70406f1f203790ad158198803aa8409e26962fbf5ddd5c76a4f45777a92943b4d1be479ddcaf98f333d385f62f9817d559123eb9478d1ee97d76df35225b44e85cdfb32ac91e7ecc8c7ecc8c7ecc8cfe9c33a3739e2fa205bf5f1aaef49823fd9823fdd9e7485398f0e37d56bd00875f699ffbf741b6ba21384713f8e1807a0839ba0b7652196208c8070bfd99b5706f50c8a7c8a9f97e0ef169842defcf62bea7ff6a16e024aaef057546306ba4781c29909b8168cf6d8ad246ab56406e40ae10f24da97271d614cb3b5f8f343a59632e4dcd06f47fec4d889fc6af933878f6d22fb18f29ba3e1b7379beb87bccf8fa15581dfb494a97d922982e23ed2f42b351e7843fe3f543e03ead1f2e3b06f09cebfcdd074da9409d7140d736e9abdb398c954a36ccffce1c6a929ba2e9801f10dbe75be8ab0b3e05caff323115a88135c00ec73d87eb2b0deace95fe820b77de54865896214c029f1cf852883f059f07e99face65b45a3d10fc981916c3a46be07f5611b2f5f0be46359807c427540f5810ccd4c41cf9ca8f27eaee75b4b53b4d6f79425378c1d9dee694a6f7b7acd9118905a6f0c968bf78dc7bc470a164af39d374f68f4cc7ea9497cf62e4bd99158c671a1c06f8e7b53e3f8516b69bad28cc317c75ec9be39f6e2f7d5b117f8ee22f7c9f3e1e51c53b4b65a15e563073e6ecc07e083d644e930523447aba5f3017ba5f0c5d5f99effc9f9a6c3ac55797b6f1e3ad17e97b8ab1491f314e4ae399d666f903d74ded5581b35eaea364ef3e99a0dcdd21b1260f3f7c1ebebba7d9c642371c455eb1feedbf62dd7df6a0d5fa54ebf9add7712ecf4183ae7eefdc1fc8e27678bb1ed637b8130df4be80972a2b3037f40c47db1bca26a2298f5316b89d261f81e91be76727f82cb30866330a65f47d1daeae25de245f09c2b6c3c1c5fc433820cb70c3d3b20be63435f2323df596a4211fa52c3ffc1cc66cb98f78b1cfa24597f9c19dbd1857dd0175694dc915c2438f0009f79b198168e753973148fc47de261eea5d998e1f749c07684ce3e771c667ff3384cb85fe96536d0796b43fea8bbc4271aadadf1317182d867426df8e8f021cf7cc4261eb189476ce2119b78c4261eb1895bc626c02ed8217fb7dc5a69831cc8ccec98d61be9bae2225fe3106a49c532d430db50bf332458ed623cfb1a9a1d44660505b984cccc81791bd5af92b9c5c60166a5d7b34dd1b24c773831a8f783fe646663e6f52b6b541cc3e32b1f876ab2e6182ef87f017b22fc0fbf5f01ce847bc1cc31466efb79bc779ff11cbbef812f3398ab45b0bf9f57c8cc8d281e615f9b0d3ca364de9e98b07f682e9ce6c0bd8c7c650b3d16a0ee0c7ac3e1ba8e9da6b4100e20334ae9b3d3aa04dbb71c1d680eddabbf65f53acea32e98d5df2ed70af3596ea9553970fe0d31ea03173f70f103173f70f15d70f11ce9422f173269c623cc7d9a156befecdcb0f3f9929b27b93009f8c4ce8cdb44f125caabc7b800eb472f07dbd3bd289641e30d7836bcdb39fd445230c2d958211133e07d41719a3ad2c9c417b750955ec08b73d0f7ce318c03f68e87013874362b7312eca3c4f9c1e4f751fc8a3fe38dc59ba293bd8f7fb7bf34c5fd95b500de3d30e69a8d00eb8bf522ced5d9a8301f2e985d7b8aa9c3cfc1e77513bfee10e59afbb1bed709de57dc13b8bf34f29515cabd47793645cb749d39a68f74ba49a19728ac07becfb128cd60068831974e668078bf310f7a5eda25e03a077217c64a7f01b8f64d7636e013d4c2be4cfcfe9158137fc6196b08f2a1a9b5a7caca541919251b7ba88fa49abd981e59bf3139a398f7e0c60e801766781f6a989e6ae97a9fc88b987dba5f5e0439c3578805d70f21db3b420746e54768d0d383f8f0894ebd746e32f3bc043aba58f726d1591fd632f0e701f2d05a3aed4d8bdff19c35e2c3714732c845b075cb070dfa56552b7915720ae4229a73a4552b9671a854a12765670a3aad84ea8034e86527f7a15e6332920b137580681dfda63b676bb8501fdd3fbc9a44a87975c89c5bb4165f5f0ace4aaf46c6dd104e40e78b7220a44df8fde19e7d07f5a8957b3c329cbd92653a7bf1cb78f682589e88f6fc35341bef03cf85cea342b58d90170317da533c0fd3f3f934cc25cc7f85f3855ab1916ca27a50df6f236aa8c78c29588e6e87ce04ee37d36438676976aebdc12187a2ce2ad227188137a2e65be1f9ca39e49766fcd33cf928dc7e6ae65df8e82b0e0b9dcc583e951b7cf765786386ff0d7da3085dd033ae07c8e7e5fd3ff830046946d7577e727eebd0b3b4a918fa7533b779313babe739682611c333f7a3f5698cbd7a975e143ef603ffd6fbf2a00be56c77d02a6a4afbe36d8499afdbaff29bab4279a58b1299f305348ffcd39013621c38fc4337c572f49ab04f1afc3de2dec1bdac504d3ff2fd824dddb8eb3c99381f887fc65cfe5059caaa32e886d76bf3eaa723b1bc8cf4455723ec8678bb05fba66ee2a33d6b2ffc73bc914f1adb560b5d70aeab7b705b962aacd6ba5ccfa29a6f3ebff23646163d62ca8f98f27f4a4c99c892e0dcd36dc9cb7c12beeca834d033afab5dc1efcdea3fdce75850275e2fa00ac43c5ddc8705fc9ec77bc770a3d64dfc68a45f11a323c97c53b7297a3e2ce310a1e73fc0aff68131d43be64942dcbebee6d101e1584de99af5fd8a7a38880d666ff8cc073e39039f6019115bb7d96d30f23bb0eb511e818964b637d3ed7dd6f17c2b513284b726aab64f92596935502d5ad79de9cb8bf2e149baebec4d79c8f4a60f6cf4a0a718d4b354b6aabb74d47c8f3fbe9d62c79c6dcf900bd9352c4e42b6cde56be2b5c353643697dd5ddbb39f53f6efde32f2ed4f17cbed79ef753d1e4d9a17013d9fd0733a706e537c06a4e71ce96568919a055d68fd3f4deee0180af39c983d78cb7360f3f3fd44e08fade9d01fec48b0c387ac93f7bd92edf3106f06b612759f1bc6d408aedbddc37f439ec547a791f4f93a81da3e53ee644d88ed544ffa1c129901f8c6ef77e6e7dd0912e83398798ae8d96840dd687d3ac6360960e037bb5257a13763a3e380fef7f2f850de1fd45f5a6a7e16604ddc5f0d622388365e7d5d4a6a36a186c7c1759d9617eb059a833a707f3fa0e720cc22845a9a7bc7a66b64ddc467da1bb0bd2cd85e8ad85eab86eadf497ee851933b39c375b263afdff6ce701d41953b456abd9be6ab6441df3d9ef368364ca8ff5e9258448fe024d23b96eecb28be4e34d1ebdda1e671cea4774e4b7d0e331021cff381fdffc3b13f607fe4efffcded0d9e67067c74ed73314fc4d274026efa5d72e2587f168f7f25c9cf22c9c525f8429b62080fff2e9889b569505dea156be2d64b57e2238a0ea27ccd9d15c4bd3f9f6fd97baf1bd88e2dddedc3ec76afa793e77325f1ce4353f49e03e7a6e27cb8dfc5df1cd839c89efc907572fb26abe53499f4313e68e2f3c4b295e4519fe673595b981945fd7fba2f48a91ca07ffd7777721a874bd139a8d642187e19d59a271824057f80efefa8bd2f17ed88590b9cef9bbdfc7d8717bf6fc7bef07de72f973e33a7572f7c26c20097ed11f4cfbef4b7a65887daabd07a09ed87fca584f73cfa4eb15b27fb660a1ebf26c795bc3b996f6084e912f37524fec79ff9e798b68ec33f641dc77fc83ab2ff9075e4fe21eb10fe11eb98bfd829f9d6d7cc6721bae1bef9f8f3ca2164abd5f6a7786650598f14cbd16a591b7c4a27b65d386e766ecc297cafe07e24b61da30773cb714cbc8a4f8f9efa5822f51963bb0676b1d4487ebfa0cf2ac6bb91baf36cdc789abbb0d5e93daced63ef7b455e58d2f9c5d1f16a745e6ed1ed68dadd2f6f236f70cc7370a6cf85b917affcd214cd31e64e7604fc7e80395a30afe014d706f7f3f723ee0cb61138939f47e65204d6dc6dd3ec12da5eef27cbbaa0f7f22bb1e7a9e7dd30e7d5947769b2fbea7ec4493324e2ce5c176727e79b1453c79f45f2f7997223aba2fe199f5276640dfb17c98e793f15cb53cf3db1d3cf9d2df251fb1797cb718b7b8f4eef4df6ff2447e496f43aaaa6d02a1f4e49c115f79d8d678abd34b9f4d93125d94f724e71cfa2f62f59b6fe0add0b7b1c3ae3736866ded9697267a9cabb6b74b575f2fc785d1b270bf139796b25ff47d307a13bb45e56ce45cbd6d37b9c87efe3d68bf18d14a9f34fe253f8fff13b24d15f70df33d7f781323c866e5eafa117545b9840b3816e8ba1794dce5ef3fce535cf86fc0f5d28ceafb9877a8a11ced8fb4eeedadf1bd52b7eef3a6bed70b61e3d9199f8ff3d1e48d135c6e026faf3d6767efa3943adf0e09abd96d6d7d0d9e8aa6737afe131c8e1d942bff5abee215ff90e4a449ca35aa669e1447edf021be173bf05cd26ebef685fd2a7b5657dd99962a77c461f470ace4a9705037e9919997f3e4c79bfbc97dbaaca7b2fd7f4a37cc9aeb47e9cdfef7c7eb39bd86d37c79cc9326a3d5226f7b2513eb50c55ed9be8955b63a14bf45470f664edfffc98c7d1949bb7e1bf4f489b06f4ebba896ef88c7213d93dc9bcc75f4366e98dd3f9cc09df3f1a6e3b3d87d5ad6fc69234180c3e6a0f5e3fa7ee48931ff7f257c460a408ec73164f24d2c875fc415f497412f13d8666aaac3ce1d3ab016d597076b791bb581e0672a9e2a88263e9d55fedcb4da535a813718c79846c88d377e7fb1d23e8d5d918827430ab37936f50db6ce9b8e7b6a97470fffb7a6e84eaa662ea676fa297227883ac93eaedc1eaf9d4df266084f4df026d6b15c8c1a0692361ff16401fbecc217b71aaf3f1e7a1fe8858a69067430eb1341cc939e74d815aa6c9da149db5a62cb710a33fbfeeeeb46ec9ebb98b3ef39fe5d54d4d706f3872eea0ab73ced82d6f6e560795346f8f7e9fa8b5cc2b80111dc3a566bfd9859f52b5f0f35b7d59920fabb53228fcfc16a54f99e7e2cfe3e7ad259e91b456656715411b093508fbe87a86597dd314f1fdc4fa4c43fd80db1fbfd771b30dbd75c34cc9ac2675762ae8fd6026e05417f62b55a91c23fca448961ba2d713889ef9f766af5fdeec552c1e885b030f2dbfbb12d44b5ad0af12d1860d3310fa594d696dcee939c89d9f1fd0e0c95940fd28920d79eaacc86711f329bb8d13daf3b139d49dde86be5e2792d46ae1f59d7e1eac31b98ec6a38bf0ac44bb62430f505c1363e973471ace6676145d9882057de7df0db10eb37b7ddaf0faabd57786709a7f14cbf3ce7e37ceeecb72cefb53117b3f956ace7873b2ffefbb5df849ef1bf7fea5eda3e3eba2a3571bdc5968b4dc847508053433a5596f89bdc3cbf1fbfb44e0d877befda7e98cf0184d1f09b32cf1672159869f5f3aa1cd40a7e50c77b60ecd8db9213d8667a9ec26465e42336c0d344f919a6d529706efb5743e4ee1df52047f4e54d4b7d9c334aa5c2c029fb0b483e8666e2e464adf19e62b962a0c033a04ec2dee2da3d189c442e7c80372462af451955fffa84efee77f324f99e5e8e778bece3cff5fa63b9b649e3399a74c67e48ee16f7ffffd9499d86b6ba3ff692cdcbfcc85311bfffc6bb13246cecc5e3fffb59c4dfed23773d3199b7fad0c6bec8e567fe5feccfe99fdef9f46eeafbdebe0fff57ef2c358b8cbc57c3c5ffff0fefbcffdca84e7daf37f2fe04f73bc1ed9ce0afe3af7de20e1774f99957d1c679ecb7921273c65dc8539ce3c17842cfaeb8fb58d7e2f64852fff9dcbfe77aef49e139e735f9f73392df394b1573f4cfb67e6f9df2367357ecaac0ee899b5f136f3fca598150a4f99e67c91792e7fc97dfd5afcfa94e938f67c9679ce3d65dae831f97cae547aca0c6d33f39c7dca88f84fe5c78fe5c8cca2bff74db85bf62933a0dfb2e2ccbcb72e64cb5fe09f0b63b6ca3ce74a85a7cccbda76e135066323f39cfb5a16f242b19ccb3f653a2bf89f72be58fe5a2c0aa5bf9f32ed88af96f239f2d5ecdf4f996ad4578a02f94ae96bcebf9bf2e3c766be598dcdccf3ff669fb24fd97ffd0d076f8d7fc2ebd460a3327faddde55f93f19cfbc8334f99a6bb5cfc5c77476b2b4455fee7b585e17df03efa3919afbdbff7170bfcb7f6686d5899e7f9c6719e3283f5c819fb0786fed51f8f568bb9f75d7151b79df18a7cdb7bb2ffcfda78e9fffd7dbc5a33df86ff627ed15e981b78deff65f0ebc773017a537b9e795effdc8c9f98ed42afd65e98e4bf46cefa4f7761a2ff96c63f57365a40ee4fa1f86736039bbe448f23ac98f854bc9d3761c2bf9f32e6683dca3c67bab57241977713487cf286f9c230d002388121d964ab4102d4316b4b525f7c7b9fd8dd63e11b0619d31180cc41a5a0cbfb8d715c168c63d61e35fa59a3b6d8bee5cdbc7928e6db87e2d6708d6d7bfab26b0fcaeafb70ff8e05921d12eed597c958ccadf479fb8bfe4edd47e880e36ba3bbced1ac16e77a5e85c1575bece4d8a2e69e0a7997d2fe6dfa127f5fec18f102bce50d803e30b4cc467ff7dd2e6dd57ce7a01e8a9b91d2c9beb9e6d6b4615059191be87cf7c64e9772d3b5b266e3e5cbdba13c35f2ade99b5b5c1af9de4613cac29b503cbe09d841757cddb4abcd908268562bbe81de87262543181e5ccfe98d5eb939afe754790f0d701cedf477307c68ae297dd48ccaf094cbb5ef024985a8913e347c789bbe6cdad5dc1a9a8b1bf93e1890be419ba8c06bfba4a25c68404514156968d51d663b95f76aa585c02cada4ed4a0d053c8422382c277ddc1c04152efb741959b81c7a4e40f385c87531f70bf621e29e23b17c3049b134713ecac8e9bcd5614892d72c31fcbdd3fbf883b5986790cf775d07154973ef0d699cd2143b966ea3a6f7394320439b7181bfdc727051f232e27e4ce3c20a3442b1bc027268c43efc23c44f54237eaf09223d1490da8f469b3478244de6997bd677aadc5aeaa2931d7b438150d258d72e4c7aa8783a58a32a80c10b43a92568d4e218fefb49ebee1c1af65b9b91dc2143bac8efbc264072f68fb76a651bfa4dc8286c1543efed9da3a3bf1736d000d214a5e9482c4281f72af4bd2a6a0e6331ff87871ec220010fac7900de6b7203c3100db79f87817bcdaab51b359c237e6f783f61a4b4b2a65c5f7df31adb1c7541cb9a42fd000df77b830a0d8c0f63668fa01990cafe9fe72c42eba41af907f7a9b2201a060576c099b0227fd71ad201c0366a6624965723b953042319d16a320da3e6dd5441fc0534ed1ce3e8f04dc603d7c9101b210b8ec499f65ef01a1ccdb1e38c7e3fb8d7fce58ff75979d87f5f902106f4be62a7079cdfebc414ca8791c70f10689b0338370e8549cfdb6b309c4066af9a224d4fe87df0e09d9ca5cf6754e30ab6d1117116f7815e8e106887a660a8a945be094d9b887336abe1c17224918e5cde5afd016fe135a3f5b6b67abe97ba77865bde9ab553e38891e7f10667ace339e798627d060d802e3006e3f4495c93c49e29b78046095dad35a56f69623dabbe5fdecc76d8686d4dd799694a1bcec4bb073e67558640843fa42ba7ba7baa19041a0c65996e7da98b757ff069400fe1462681b1ca357c24b9314b9c311db18fea3479df5043bb3a240d6ad070f9784ac7755bcf4b59cce73be65e96eef626aaa25923796f05834be31a6504bcc4e38ce331e223030c55be201147608796b95fc840b32b1bd1077cc404fd683efe3ef7e8cd102c2762f0e9ee56cdbbe8f54100deace6d6bad02718f5c39a8685f6956039fb3ecf23fbfd11cff58396ae248c940ec891f50835952bc3c02aae738ce0e3b39ce36735138a6b28e4b41cd3050c53de345f2d683cb835e6b3495f2e668df9ecbc66a1fe331899e7053ba019220c3fdc60bc8902a1a60801dfca0aec394f9e04ef631c82814f4cd3d7402642d33e1b9a0c42712b24ba7516865bdee8c27eab090ef0db44cfb766d07cea9b388380d6aa29e6b686d7fc710a3c08384953b4a59e978ef4f39ba2b634e69decb74f3a1036f9dc50f3a38a0e3e82791fb026ec131a5c8d8a2be77d282882213e473cc81af67089f864dea6063dc5ea373454caf00ada8ea0034628991c1ce226c6dae583068ef1aab57c73879bb76a65ade73b481eaa02c2dad07c5318c9e59c71a09e6f57dc91d23a9ad519cf2093287d1a8f49aafc8173fa4a29f0392fe12564732ea97537cf4df4db26bc070972a6bdf36d124aced57b40f387c861df97acf99cfdbe6323497abd506cbfb2cf4da6ba560742b35a6d70f73d5ee902c18ef73f5f68a0391edcfdb9604b02863c8e50e17b8ebc47ac4c21d7c97b4e1689df6f56af5ec76348fc6348fcef3e249ee6bdde70567eef0f92656984ecdd7ea84e8c4bfaa3e9216e2fbcb5e7c83004626f5083d2d6467808f91a0f4f5f8f04a9684427f81c4196a980f1ed4a01927646b5187d350f0f287f53f0f072560f7af785e6af2bad1adcb3d70806ddd33a0cd30a59572838eecb27ecc38ab3b954b73e1d1d72e0bfc96a4a44a27e82ed946a2f45365d4583cf2664f01967f3d5a8a6ab3183fb1ccce3c523f63751835a41e7688ee142b1ba74ffc14a68af2b64af7db9728b75a4bd478a5cb9bb5f881afa79358ec36b8b7c37fc59a46d823ff33127de8f78fd1ed816c1dea4ebeb541d1da397892f6432f07d21953a34f41fbff3e9ea68fd5c8061c759b06f71fc83ec5b7624d7574d710fb152c44b86ff0e4518e6ba4df34f227f04b2f9c147047e06eba00bc52d34baf7e33ad064db7e598c0645e7cdde4d74b90c36f341933b40173090007c065b536953cf2f4c34d73918f9def2de3c9b760ec817fcfa0ff0c9dc2259f6c30734d03ab5bf34c5bdc389b5591a88e2f3244ce4f9f095ce2206fbdcde97ed42b27e6fadcbe519340ebfdb73e788cee0ecefb44ebcdfca9d9f3b87f8cdcbd5bae75cbd87067d1f3ec49e7ed8a10f3bf461877e563b147fc66b976139f1e138f31d9e73bc024f866c91218a75a8830af259e23c1e90475b531c8607fda7f1590a6f71f313f0d00cf98b037b2b94388f3098d514f75b126701fda8cb6528ee0b6caef774feb9c0c6e22a1e26c59d497a85b7216d7a015ee250f0c86743a1cd9b42ef69844ee1d727dcba248a9e4760df29adaca658d9d03973da4c29b4ee0db1025b080d3e23b4d043c3d4e87f6bf396a509dedfbf89f5a9d9902cbdba9be86e197432c97183f8091a40a4c9bde5271942b480b336e67d67dce84de8e2156a60dd47ef03ef7ba7c728230a49a3e566f0b95fc43a6f39fae71b7c3bd0e43d963dd7d3741fff5b85268ce1813eae5f4424973786602d8d2a3a270106e4e2018b6840a0a7ab0a247f70aa825fc11d4ee0cf915c9c81ed6f9201e1de3310fdb4f2ed89d1900e86583e927c3ef6fda2f72f59379cad2358bc45e80dd3fba7daa3343b9ddda3187b9dd65741d3180acb44369509f9ce6723c82907de188479049a9e21dfd0afe3918e26f7972a3ea71b0cf87ac5ffb621e7d1cb55cc399ae858b8190995c3087a77ed84fcb5efe93e2bf64c52d6cdedc32217f8b2debd33c367457c5758dfc82dc7c0be287cb6def706c1b06844c32897bee518423967401ec4fd6475127e8fc6ec7139b8e13cc1e01dd231ca45187bd8906c9d0c3f164006403302188e9adb5d11ff18c299404eaa36f0724a519383019c15e46b0732a2d9302de350a17d58bf3a9f16352830f27d4b575e082ebf763decfbb0ef934c7fbccd3f48b1f910e5729dda87c1bd9c7103865b764285b2d13eb0e4861571f10e5ccf80060a53f18bdbf24a841f927ecec7c408d13327d43327e66d78e65545f80ec5d350630ea46fc5f0f348befabd630def2897b842e51257b2117c72f61ad2de81ace10cbb35323698408fe442f2136c334d2e1e430d35a27d405027e1207939c8e5474a7ff1067c2b4b5fc0ee3df1013385e13d94e71af85a6e1c0ff4ebe3ee50c7f1eacb59ba3ef14add11e472073a3ea0133ccc1b728dab1cd83c0593736371c0e0335ad6fb7181909e38ebddef16db8aa73b26565080cf0de1a401086aae10d544fabe32ff3426f3f132bffcdecbd6dbe1f33ec552d1f762700cb99798db6aa2b4a2ee796fdc436afc689e4536e57bae53eb0d51fc7ba6d1f5e8f6835ecfa7573ffff9c3b17c9f9231970c2f8ff12b06f454ad2ce921e0cd065dff8efc0950d71cfe0e55134a6c3baa7ef8d7c9eed7d0c07222bf2b212ccf0c6a3714c9d2bd75cd9a22e453d437ec77d01a07bf279f30f7676831ca0789f0566423d4987b05f78b5977d4952ab3ab1c32dc41ef7a7e9d53ac1c77361ab19b5d03c735037fcb88e63f7b3751e5fe0c624cc6613781be0223a8c9c937277abebf00ba015b591d04f1d0535c94b247c958ff6a1f0db9c01690423e9a8fdd8773df33c55e60af100f45d9d5ec8568712e6de0bee067d3c13785684b3a72f2d355cfbfba0160d24574d85c5a6b4a8bf843fc666e9a580e35ade3b9526d0e9e8b1fd7f05c25a356ac345ffb4b4d70b290a3f62eb4507c87d109936faffdee6030fb8ae4db00f590d8e19813c8b855b301f5ffd040b1bf403d1de4fd4acf9bc7a6a879b16505ce4612e019c00beaa0e29af27ed5145b906bbdd405f3a02a95c54846f98453c895039a67fb06f05c98eeafdd23acb72e3c2facd334b908ef7038ebf7f8b7914dd5a2becffeee1c1e0e72d3796409c189513a98ddb720761df10ef87eb4ef2dbc3e662d513afd863e028897edeee01fe8c17334a891ba26379834951c5490af05f258118e1dec263436d1aab179ab7edf9cb72a34116d6571af199cef73673f8203397e28965d2476d75dd6f81973697d3ce9e79371e43e36f771bae82c9d934ed369f43d20398d9c3eaf34ffd7c53974a7f78ade1f4a56f0acfb22ac88f3d3fdbc499c8b3ed3440737c0df810f6f8d7515d40f00d640317ba86fc2f6575693b39fab363c66b0512866e25406efb94e3d256f3622cf36c6b6213c99e4e7a6bee7cb7a064724d5a2a4e45f211b2a32ff2a820eb8f98f9ff7a2f80ee5ba9cc97351fcd622fe8ab18c64a847837e5e8317ef1ba1be5c28cf1a35d0a7722176be7e1bec10ed429f05c3ae6c71ffb3adea2e1d35cf15d7646938d9e63d1f1783df8fec1bf2f5f97ea2b943d96f959c31afc0c08a892a17513f34c02168988287857dbf36eab1284a07b331833e649631ef85ec391d63eb736c394e1b8edb76e2b0d9bef7b2480725d96cdccfe3b6d166c5da3bd0725c9e7b94ce8cb1cd90de14ceb0f76a7b9e3dbea94e60af6ee30570dd4a9521970df10cc59b90ebda5fa88ad72fcf141d827b506f13885d9ba223e0fe5487a6e80dfea7e38b506b63b86b44d7461eeaa3a4f59bd72b2518c254ad4ca14f129df704fc8d9f35d3646da9bb43cc17de8066cd75a651f74676a2dcf3fac979b9649691ef6c61d809ea2b881bed9f6be39da9ffe26449ba3c8ed42567d872e7da70e4fb3c3c9c62b371d86aa9365a82fe5bc60e49f975facfd75b23593adc400f4aa4063decb30b643d636b10bd966e3731e71d673f9d6d47910bf9f623fdf997af29cd4e62d794e0cfc6b479592ee3bc1d1e2a96e4d7e397e9a9f1a5a43813d2598a373ce4067457f1e209d2110f8fd8435fd591ac15519ea6274711fe3cc7c6217b9ec25b67eb31b06b98f513db7d43f579f6634534a6f46c790e1bfc4eb4a5e2c14921fa4ac22477a22f3cd0e9bc58cdb9319a7c73e2addfd3e7f80c62fb7d121cfb4930bc373ceec81183b97c9dbcef958e2f081d537e3ae63e25f6f3c027e461a694da9fb4b80b77ccb1dbe038339fde18b9e778ef7a0399d8d2dd3eca93f373ba3176a4eccee948ac1f54a84b515ad0cf71a91e387c982767f201ba38d8076c63921a02bf4681f839293d0cfdb6eb53182e05fd0846727b73471ac4bf8ff46b9e99ef816af5b277cabd6bfbfdd7aecbf1a0fab8ed26a6e0644755c67611a9ded0d82ef37bbdd3b56cf7cfdf0eed81e7677c9de86299c8ba953fc449e91c75a1b384bc4dad4af54d4679dca1befc740f9b198fef02d3ead579aa71beb98086f86af98338fbed7ae126d6787a390d77ef59608816cc87b8fb734da5b5ba7b6fc9475f88475f88475f88475f885bf68548b1114772efd3d98797e4b626f19d241797d03b99cde5fc4d6c41d60f8830f7156be2c65e6ff9344c7b7fccfd263bb3bbe26ebb32a07a8cf0f92ca27c150e85a121976c80ec426fce12b5bef04c276fae0e991704b4edcdd529866682fcba7cebe0bd9b756a7d247794fe3fb466da0e21b117328f29b03fee89c7136a04d2ebc6c2f2721b3d7038a166939c45d47bc6c4fa595c47e438ee194bfb46527d22dd468acc8af481e0feb1556b6bb8a6a5bbce174c23782e15d4469537dfaecb05637a70a3d9074b14830ee68e1dc17f6cca7bcbb05f1610dbd3f3adb92a17976397c40721c60839589dad6ecfb0af0c06164b306057d0062f0b5d9156a6e8ec748875e377370eb3fbf2d1197b4aec5fa66730eaab09b1d2b76ac581dc32d00506c48bf3d24a453333c0962f1f34a1be19294bc2835bc3ae406ec351170a4bf89cee076bbac3c9373197d31bfd25f091bf97f3364f9f9464fdc15ff39c34f780b53792b01b59e7fdedab7c6bce69f76caf5de33f6d26822fdf882d76f8bd66f2047612076e48c310b38bf3eb4eef15bd3f34dfa6affb7c3c4b302d9d5389f0ecc5b6316fac8d96d91ce7cf91077b51afb461bdd51d668729fc78cabf6fc7449b3835c7117fcfe7e718ac12276b4b2cce0fb0b9c3c6699272adb8f98f9bf7a2f86e16b289cee3bd48ec2e6d46e0b71d30b58fb173f3caf6c895a666ed73c4b3597b1a6188fae56be27d87148c9e9ab39344db31f41ce77fc4335cee53a73bf4b0f86418ee5f774aa7d1b6281b2ba4ec4f09cd42a5ec406f5d211b90b1aba8fa5c6ae6c4e98cd664fd90ac13f8f500c8fe1e7ae7016b57a37c9e93b562bb66a289923b928b9023b80ccdc4e4d10137ab7988afdb61f04b5c2dae2f1b5979cee4b77d6ccdfa9cd844f7e005cf76b982fe2b810de7c72eb16cea64d9f9a7f7a6656c53f87ef033df35ed599fd586f2ec799ed95c37883d1a82b535e6bdbb3e137c099886efd5131ed3cd2f99a5b7355c03cdf8f9081beec238f647af99f245a23d3f59077b9dbcdf6411fbdd1bcd774b7a7f88c12df5fbd3ca6e04343bb8fb737386fb0bf20fa0fee5fe7b8c7ad47e881fc7c700be6f824fbe45e0fb602f63f4137bd5f63c364e525c86f61bf0e089546cf1a8c57cd462debb16137f16b917f8339f5ff1bec7d9b5a0b7693f4baa7f25d5af12e94f61e206d7e422c6c569c4bda3a1190840734e760cb119b79c057bd78f7f0c3e1dfeef32f11adf0e40356811b11c5d94e6c08f245ed3ac5a1b3ddf83d828c1d6cbb72ae90fe0e56eab7988ff16bdfd26cff98c75fe497cea960fda2147ea8c19ffe6eb47c4428e23b17c30ab71cf7cf988673e70f403473f70f403473f70f403473f70f4ef83a37dbfe6e81e3de61deceb035e39e9537aea438fbe27837167a7bd4ae13e347e6e56ada32977164db18ee653375fcb835ed679fd3680da3b54c76a41cea1213873ddadc33ca48921c0dc51bedadc14fee4e64964cfe27706ac1c19bf7cfdf8f5a6bd2f59ef2fcf414caa194fc9ff3e77860e43f77cfa8a7a070ed99da4b73c3be57c9d955a8749d5a207f395c2f4b56a8af599d6807e2f6db053d7aaecacbc9cb97276244a87a60834f27a59dd062d3313626c17e518b217aae120fd7251dcf563f721cd568ddb8704b9cbf22045dba7f7c5f7a3f92726ffe52eb9ba5e6ce92e79ecac7fe244969efe86df8fc3f4dc70517e6d947fa721a15c5dd045709f916c6e708de651152c479787bfda6f73a68e89cca57de894874e79e894874ef9253ac5c039d81f6fbb04f3f79a22996d7ca9cd02b64979097531868dfab1c17c09d43fd970711e70be02b5b12e3beb15c701e83e6f0b5501db06f5e6869927743f9474be49e1136ebec0f5b1a77be4e7076d51af49a5e9cdab960b13844b9416f12fec203ea7d9158b993b877a1ce8f98aef8b1889f5e3a8eae95d984944f7c6e3f03f107d1a2d6fef9f5be49ddfb5beba6b6a61c13f623751ff4f54b3aed47323a5e5d0bde1227d2773e9301ae4e859e4b40f819b1fb9f932893f5d0b66151c4c512a78b10aff9df8f834855fd11cce03995b4ed12792d5f4bfb5832a9b1eed562d4bcdf773aabc5b35c5e21662b12a9e0b01fd45515eb128cd70cd18a34f9365748aee3fdb0f08be87a439de41ff918fdd07def74ec18ba939d614bd059fe35e67dd416bedcdb2cd797afbb3f5639a85e6e0de80beaf9e477b7acfd3bda1cf2479ddfc360db9c0b661e70fc7cd91c6674b61326af633f2fdb9d251cf4b07243b78f116d1a309679f88af6e8989b01f10d3024da7a9f4994a97497e5dbb724eefea281b42447344aad64a934d4bafce706f41447f809196e0af315d6706bd147f51cef304f549f465a296d3c5dde41bcee3f8866508a22bf0bd029d433f5f19c9bf747a4aa1a33be6ecfb72eeed1849c749b6365e67e47be2cf7cbac5341e1367a4f3274ef621c166be91df889cf5e977f9fc45fdb9b4d2ab2f0b9cffb2c4eb427d0aa37c46bfda1f741e6d33bea0dfd3cfb3fda5f66b38c7ffc3eb5242cfbbae5701d8486ba01932cb05dfdb97d9865db1d47c6babcf9df59de93a9403e5d7da9effce69cfbc952d194f6be44a986f1ef233c6da85f1fa00af33f23dcf8c275b9ad8871e16c73bc8ea0a79d61578036aacb668d677b5e2bffbbd63be3d92c3e6e75e20ffd0d114ebb47ff077f46da03d7d93f7cb5fd1cb5015209ff63e75534043a678b79a294cb3f00efd7be5784ea1dec26cb48a173e8fe8d538da8aafb5c7f358e3de3fc99e616450b46e49b56f6e65677fec4cd13499c529bbce96619f7d866a0ae68cb49ba264e3197377927c44673f8f7bee4e5aec92be52e6ee8cc472742fc4a82b1dab265d67d359c2cc9d852ee41c4667eece7997b3e36bd553acc5238f22e8337c163cbf035d0db219e633f83907b847f9a095d7f3ad9f340e4bba52315ad2c58fcd932e6f7e6dc3dc1aee8a9205d0e7e0e58c77e1970b0967712e2d63ba099f45caefb03e0cf373c26f88fef46c0529c23666e8d0948bd1e7883f4f923df7f255a273b673e07b75ee693378f1494e9d1ea9cb5f2728ef45d8e35867e0d309d1ee3de3af335fee213f7da08739dff5e19b3cd337d959dea5f7e40c3de70a5a45bf5f351bfdad89ebf13441da6af996658816e4aaac90bde1ed338aaba31a7b78c7436017dfd987531b29d40c6164cbed26a658cee3fe8d734d2ecf0db7be1bc18c187907753873b81fce8540b934c82712e081078d9f4be3f30eecd13d64f33bd0c335bef6213aaf21cceb3ce879e8050579281097ae6f5479bfd52037e57021dddc358fc6db7342fb1fbaae073f9cc90f8cefe5e3f9a2a7e73b5938cf1eea5b76057ff83ec5f2a1595fb686681643dff93c74effb626bba40d1ff25effda0ebf3e8daed40cd6bd698df05cf90675d8369c83d706e3af88b00db1a01a63dded98f2f9473fabc7f18fbb89bef1dd39e916293fec7c54cb16ff00e742a21d972457ca98e7074f5976168eff935428f24bffcb793939131174207bf180f2c74e12ebe8b21f854f05cb0abe426e9ad02fe46d5950e4db1556c425c5994bcde0c1cf9a8b7949b9228ad34c5db47a2f33d3aa94f21b66cca391bfcffea7c3631c3df4de3057c9657c7436f179f8fd883945c542f4f31e6775cf4c65ee9f4c7458f8ffaa147fd105d3f74c22f493e6a7279b4e8cdbf87fec688d75b08c71a473edeb9eaf9e4f2de037434609ca92986f44f08a773d037135f2f827c3ae0bd9e986e7d6522cc6d6d63fac5275da95887e7ba985ea3aeda7e07354d5eccb082fbfbf872fbdc181c23bbaf7cc733e997bd2e8dcd3198262e46b7d55ca85dfaa7d000aa0f76a1feec410767d1c15a175a4b3d3ac7e537a483d7892e97ed918c662721acd6144106b61cc33e339ecb9c1f0fa6e438c773e3bb0c2d9e15e7656c64ee782ff3bb73746a4afc97f91eb1cda2f02fbb6f91f160e67eb4ff8059df5dfd04689631451f1f649749c3d9ee727bcc9106efa4965590ec66a3b344b599f926cc978719f81b4de94d0cf06b0a543de3dd7cb2d2e09df80fea17bc6b9aec4e91cbbfd2bf602aad657c9d6dfcf330af44da95f833df8f80d712974f8d6cf03bd0f06bd816bb3cc6d01b5470ee7d250b7200eab49a2243f3f7f5819dac2dc889f0ea1550cd7043825a7ac6264dd73929fae43fce378bf6f20eb38624784eed2a3a859811a9ff04bf4d0ef721f0e238d08b582c1fb541e5087e46c853550f95a571a8cc3405fa34e4f0aca156919e1b0534efdd533a6a3c7e879bcaeabe336ef4829e0b54ae0f45e3534263cd86b9d0f31da7d9e8402f4fe83f3d6d8ace11f8d514a503e4cd433dace777f3672bc333bc7b2a9d8feaed0339fb054dee41eef7c13cb591b9e7ce698ae61873273b0239728033437b12f219df505edb23b9b51ac925fa7d53317ab79120eb7cdcc0f8d866ada50abd4f04f3a02a95c5486e43ad33cc8a5ade4086e33ee3ce06fcae3083d914204f6802fdba8fa0e3e1fc551766b03a411ed1c1d339f09ed87f32851cb3915c9cea0d09fae96e9a75c42b6b88efea555257ee1cd97ee6880e1bd2869a7f658f0795ee30dba9bc57efcc571c7b4d748a273fea201b0eb026e0b111eac508fa46f26483787a3fa6cff94415c0967636aab0cfc18c8b37bb3244f5f77279a6410f09e03fd15a1aa77b0eff97d3dd608636f0ef7bae53eb0d77bfa486e883780d7c755b53694def513739a47b025c1c7b79a57b0b4c54a573d485ce12f784f37adfe3799ff03dd803baa706ea8f2f3ad311ea0b3e9ba8ee10f871a70b0ee8ac03e4f7b17e5fc0e6eaa0321d2958f6d8bfae27901fdb1954a8b3ab5846beb35505c7821e20a4df0fee9b0cdf03394ef7405a053c95b3c6838aad0d2a16db5f88a91941b5a84d11ec153c4fff63f416b38ffbd81ebe8c4dc2556306f321f47bd59821da6ddfe75973c86b7bb97b7d20d014ea47796defa5b3f736a0c33bd5ee1d201f7c74ff1909287ed2997ecc5c08627318a477f96346f66346f66346f6679b919df3ea135bf0fba5e14a8fb9d98fb9d99f7d6e3685973fdeb6e8090e914d7cb41729ffc1f6f4ef03f21f728d8f26f0c301d9d98eee82ce2c439f3beca7aa6fb4702f51c871c841af3ec4a71176aa3f7bfaaef6778097281b823a23e47b3b8e1488a921da739ba2046b03b9e1406e1bf8ac54b9386b8ae59d3fafa5d1c91a73696a36a0cf612fca9f315395bef5413eae0fb615901f96c543fb283cf476bcee59bf68c691ef6bbbc84649ea974a6837eabcf067bcfe70af57edc7cb90013ce73a7ff8415388bdedf315eec7db398c954a362c079c39e44a3545d3015f17f6616ca11f6f530cfb394ca5037953e0abc0bd8aeb2b4d281fc0dfc1959b77535962598630097ce41057c5f14a721ea4efb29a6f158d463f240f46b2e918f9de4413a48d97070272b22c80af410dfb35a8bef8e8991355decff57c6b698ad6fa9e32e586b1a5d33da5689aca0df0e99f5e732416a4d61b83e9e2639d31ef918289d262a1cd131ae5ac49e4e1b37759ca8ec4328e1b05be61dcd31ac7975a4bd3956667da66e7d96797db68c44e8bdc27cf0f9e734cd1da629f3df159fa7c3052407e488791a2395a2d9d0fd82b852fd82b941f1067037d407f978b9fff21fd5ec855db9fe6b1517d5f8659abf2f6de3c74a2f573dc959ce7ea749abd41f6d07957636dd5a8abdb38cdb5a272e6de07afafebf671928df4c75db5fee1be6ddf72fdadd6f055eaf4abd97d27c15e8fa1f3704ef234fdb74cfd4b9a7f22901b98e613becb9f8be6ebecc02f10715f2cafa89c1c667dcc5aa27418be47a43f86dc9fe0328ce1f6215b30a8e5696d991ccc54ff43aadf21daf707cfb9c2d6f3627b64b690e1965760d3e9072f1662e43b4b4d403153af5f3dcc9f9cf78b1cfa24597ff0eb0bd00f035dd8fb35bc245e8a71e0013ef3e2552de8d774f466ef41fc07fe0ffa1fd60fa8e7c5ddf2c722ec005666107a49f2edcffb4b53dcff93673d23ffd49d9eb533dcf2eefef119c811593d62168f98c52366f188593c62168f98c5ef10b3003b01f8f2087e516d9003d902fd8f033aa8ed53dff512df23e446412e8721403ee0703224d88d872623f502605ac9d54036ba1d8c13e9fc3966eec0bc8dea25c8dc136f864d3d0bfe06d31d4e0ceafda0ff86d9c0fd381a15c7f0f8cac7a5c1dc28c0a2c81e80dfafc0a6837b416e1133d3ddcffbbd77dff0f87d0f7c9bfe0ca7019ed9d4e838a69777b90dcf7e281e615f9b0d3ccb65de9e98b07f68be9ce6c0bd8c7c65abcf3b4bd57556d09b0df7df8119e2c83716d823c1d9695582f55b8e0e3487eed5dfb2fa0fe75d17cc2a870efc7498dfb3afa273895e537463e9da67ff92dca90fcc270ae419c65451f881ddd3362de7227cc209322f0217c4cb3f0e59e8e1fae3f93ee3c8b8cca538322da6425f29f1958b622d1179a081ede0c9274dec2ffd58acd8d9ea58e641bf24e43f0bcbd9b3fb7ed3f296e3fcd3e329f4e5d1d5dc70cb3923d986ed0d67e5f7fe20991f23f8378a57283c918ae5f1f77c7e0eaf059f79ac0c4c88e978fea6dc52ab26cf8c84fd7c9f75beb3b39b18fc9b1edfa9ed9374665a3c6710c64515e2973ac5d4c9f765f47a11e5e313df1bc21b012f66c703542bc46032543b798efdca67bb9e6fb77a366bf4be20fb5542bce7e11f8c037c5b1cf013c470685c05df0359780e7f72f0e6bdb07376a4f457f7a82390bc1ceeabb031ce03c73de5fa4b235f59a9ca0ce7b7142dd375e6989fee1b5b77409e0734d41b54f0be22ba9f68a27334c43de4ec5b5e2e525d18c926a68f74ba49a1977f541d19c99ba6de37d5af90ea4f88f423a078a2d0b93c77641bc3b3d1efc12fab404655f13e60cc40ee9d408f37b30de2ebbb19bc1c554f0e6bc4b9effdea482e5a4c5fcd083912e58bd296ba2811df3ef13f45d227d4aabf29b4bd178387f9645bcc3bc6cb3a1ed9d7771d9005e7cc08e5d1c3dffd99849efe7551df1111f6a27cd00695a559ade455c839803a4401ec908a651c2a84b6bc79e4ee70827a4fc97db08f2623b9305107a8561efda63b67e7dd76b69a38fcc3abcb43f58b64be2c5a0bfc5d55d0bcf6955e8d8ccb217daa797b921f89d226fcfe70cfbea342cf5fb977aecf3b9d0fafc710e4023e15d19ebfa27326f8fe03cf85ceb32a4c245c07e9cf2e06d9e563327309332c51ad9cd2398e647303e7ebfb7144e0b1e1c4142c47b7436702f79c69329cb3343bd7eee0d057516715897322647c549fc04b675673eb979877e1a3af8f98659d8893ee36d39a3dc37bf0dbaf9d755de5d3b551bc40d993a7f7c5f7a3f31063e23a77e965a10bc5d5789083dee359fcd9c7e331a7b3d3e40ec431a6cdba370700f9c5aec8ed0dd305ce396c50cf6950cf69b427e0930dfa4a7648edbd85f8c38b87917a647becd71f431e9aef63a6ee1dfe4de859b8e619cb74e447077bfaee7ef47cc55105c785bc666fd602c6e8917cf57bec5fdafa537413776ef139f50a84af18fae4f05d37f751fea3b37c48e7f99108d664f8edb497100f4fa6f1676fc0f41db22b4753a9ecf47c8bee01fbf97dbc33465f62feb9787de7e8a3737451ba0d78918fe093e64244f9191e39578f9cab47ced523e7ea73e45ce119631ea6c92d3f610ca946b0550893572b83205fe44cdb359237a3f35e506e44a3b5d5ab15da16417d9d464affc8f484e7c7059c98e06c3c80b000ea1577d0855d085337eb948fd2af17bad1ba79f998838713edd51bda98a8375abe05580ff6e818d2c5f7a81b659edf7ca5b1c0b93667406fd26beb95436724eb097edd00faa0a5bb9dad31af6ca1564853da931e852f888ec0ef95765ff25e37eb81c681c9881f8fc1ca7c35dc11f70f688243570534caa1b3e3b0ca29fe3ffd4ef47b26f9f72b6cffd866a393331a95ad31a7e7ca9fe689a4f910a2f89d473ff0fa16e80bfc0cfd308f103ff9c5eb3be73d52ec7dfa4ab7fd1f7930280f86b65fdee4df256f9c3a93fbe1177f9f50ddb3e8648d433827fb0cfb029f67cafaf97507b94087d4289c3d19526bf16d8c508e30e4c694b0ef2df8dd3f1c9f5886582779d4341da7d26f2add46d22bf4402d1f0cb10c75c58e71a8d454a562911c644e1d13a55786bd592f1d2ba760646e6c8c3071ea5a1e18e581511e18e581516e8851ea3b5586d942303fffb7c9d90dc945d667c0639326d9a6713a3cf4cc4f8e5392f627d9a742d3038a3f2e21663962eae778f996836713735bf0ef23f5548cbf314e27d1186c8dfbc62f7dfdf4be3ce84239db1db48a9ad2be036e09e1ec574d86b9fdce393ae7936096c4757c76bc9224ebd79ad2b7bc7e867cf88527d694e4b7477db8f2ad6ce83daab919bda7cdd33531d7a95fbf6ac77d175f1f1ab7a3d7c21157bb6d0fc069480efe767bd7b25461b5869917a87fff653d3592e22e69f8a344e6c4198a14c463dc78fa6468f7a7a638c7e6349a4f75519a9aa8a6d4daa94a7f11c717f49e9ceca9b79fa07b575ab5523040aed4b2764f74c2fb8f651e59cb956ba46ddcf548908ac6a1199dff22ef1d35dfdf1a76f06e317c12b29b595982f6ccd5969abc9f51fbf5326262dc58cec2bcdcbd49fc3378edfc363d871ca2ce3415f3a563bd288cd78267c3fc2dda4fdf7cc5bd8de8fa95349f54a42f6abf55e5fef1d4ff14baff449bb7b63aaa47c17333a81c501efb0c9f47dcdab9ed30f00f0ff0b9345fd375eebb58b6b446fbc4f714c63d15d770cbeba658df90791854bee7fd66c144e72b2f4057a6f84c2faf75a8edd3702977dc2c317e066b5660e653f1e707d829de7d3176a7ce8ed4e4619e958ee1fe0814fd008dc8c5d99b0033c12919243ae04b837ccd25e143989fe2d52540ed4167ab8b48b6c1996f3e89ddd304ba30bc5ca138ffec67da33de3526f35908d307f913d47d92f43cfeccd7035867303825522f527cf541f16726467ba95d5421721d6a3b42f21ed7e178f3b45a9e8f6a8ee797a1d96428b70e6135f8ad91778ebcbeb95bca7e661f082d135a9d85e8b38a6b5bc48e05334c714e13a66358a757bb86b000e0d446a768e439739d089dc5d8c3fcf57331beaa6a04edddb79f1ed13f0f1ff6c387fdf061dfcb87fd9bcc04fb4573b2e27a7745d2187d9dbce76411fb5de203f9c07504352832ad8370ef668e7763fd1fb1fc40ae6bfd3304f7ccfd3cebdfa91e85ce81be890eb8b427cf67d301741f262f1e7271defb39764a3a8eaff2dbcef4e5e12ef3a0e7a55da2dc742a83f75ca79e22af22e45b0c0e2178304d4fe1efb13e28e6cc63e7db24f8097e9b9825a547266c3fcad3ef17bec5dc3bce17407c7ee1589dedf55661fc409bcf984f15d9a3f3f57512f86643f952b8bf4dd87f770e1ee3c06264ed01ddf1d325e9cb9196f397547bcf6d37741b1c67146b2f503aba7a51ff8d285d81fa5fd167d3cab7278660815f64d514ad1ccc94879e24aadcb754774f7cef9f9236df110f639aac93deb0fee773e350c1bdd6779351435aab72616db812c40f51cf53c4d3e7d41211b99a70f689397ea9791f14cf2828ffcf6e36a423ae83db9bb20333cf3e0b4d7a36c5f53459011fa7294a3067a9e8db7b728bb6ef72a84640189219ea4ed8b772465d2d39c314df3177bc3d3eee4ef687f885a6903fa80965a8d9237b60d3b5b4a602399b1dbae703ab1f7879860fd3d4f6f49c8e381bd1a72f90f9802775595aabae7440b5ad4a9b7fae0dfff3c8859e0b3e7490c7d8473b51ddfa9160086fce4e711e87ed236ccab8d934475dd0b2a6503f24f661aa9ef23287dfe0663899bdba8d973f7a72b1d86c74b6dabc9f877aec37980f29e29936ae11f847e1ffed8a3b525a6bb4cef7ddd660305fd27506fd455d7c3419c3afcc9c1e8edf25cf0c1a41ad6cc3b40ca61fdca5b4a3292d61247722b16c821c3e6f2f2ed79d5157c9a8152bcd46c731e6da928ab5aca12f21cc87345d67a60d2a95e1ab737c9bfee7d28a21965723b9538cb5b7ceb957a3b55505e9681c22fd36317e9c981955e06f133a8e06b350a3fde8e7de6fa5ca855bf0c3dc949d999ad6d3eeb3ca52d1cc217b0d30d200c9cc03f414437e1597dcfb3f981ff27d4b77fb96e146fb6ecfa415e08da586f72395c7f09a184c10ff1bf27d8e597ef88cc0668fa41dfc79127e49b44df1ef63fd47fe0c43f0b704362b8d01d74cffde35eeed7b9b7ca7393d2f60efbc295e8ffc933389cbef827a7771b8fe88fca7700e34b5ce0f8b7153cfe3cc0b89f10179f19f60a6f716fcb1864d637bc87982b9de6538233a3f72a9296d1edb3bd9e6e6c70b2779ea94fd728b75a4bd47b29ce3cd5d8aceab6474e023b6fc882d3f62cb8fd8f2a78c2d2f99be186b46ff45d21a7d9dbcef6411fbdd3bc49823d6139ced6f593fe1f5c959632c48fb0879de8da5618ef3bc8ec61ffdd41efdd41efdd43e713fb5df668625955bdd98fd9298396d9f9c833739b1e6d938133066c85e1c545a4cfd31b1a1ce5e1befbb70e0cc44df044517bf7bdc3c449f24467c2d5da2d94a745d416306f1e58529e72cddce1d34b93e53511ea0674f7e52ba247904c1bca833d7742f7a4c93c771beb210ffc86cef961cf3f9da1987ebcafd7d3604c04e9d2ce4e98c1b15cb1427976275f63cd1fd219f46177bc1bd670cefcb57d74ad2e7ba4eee59789b9ad0e467f0ef0375cf0ff225921e9539a8095a69342ddbf7f02dfa3d325b27cfe7d4a15132aaefd51142bdd342cf1b11791215d62f57a47c1ea7b555c8c6d91f7964598a0c3bb7ae26717f50be0fae39859e9123b19c8fc89740f385c3bc18f43037c27375fd1c68e3c0818531bdddb5dec6e7ebb0fdc15963737a7f3efa3e8bd6d3e8fed40f77fa9daa1d7fcf685bee75c2f0c1c4c84b3b90f9643ec36f62cb9dcc2920b99617aeef9cf748f6bbff07e74047e99124bb146a16dfe49ca329bd7590b3877eb746b3d07fb5af0baf3752f624ed59cc3ec5ebd8947d38919709b457db27e91612bf607466b1a6ca85c9bb2c3966ed263a358fea37416734da280fd1c711647e4ca04bc9fc982c9b6f7b7f5d5adf345fd13990b807ee4de0cd15827977701ec681d58310f71852b2469aa13ea097e5253ee27a8fb8de23aef788eb3de27a813de368d59c5f1b85b022571c88915d9345ec77099eb8723d448e1e48ac21caaffe596d0ebaa6900703a4e1818be32bbcf509340ee358f7d9750aa456211453f3f2212ead237dc8cd1bc8cd245c9e5237447a5fa5f8e73f7d5fa62159473de8e775973eb26027e43ab5de1062c1f5ac2aefb7da01f9ca60ae9a3703b901f927da52a36d6eaa9791560db07313f94a3b39d50de6e9847b7c9d210f3eb25ec921f3982b836036333b5f0063aa064d23decc4bddae7487d94ee5bd0a3ec5bea58be5b9b776c84bf76634933a02aace89da7fdc2307ef11f8eb8cbc44f9672be11e7f67c81a0e397371fc017fe6e31b7cdf38bd99a6f383334ae7b354fe4af637e7d07c7b0d6648d0eff07bcd0cf2e77abdc92dcb10a459b3eecbbbd00cd8dfb4c7ed5677f7c537859e59f629f3bd087da7d9d7bb877dfdb0af1ff6f5c3bebe917dfdb0471ff6e8c31efdccf62813ef811c8e6603d9ef5eaf8059b186f2b27ea5ad197ec7701ea37d9f196bcc3e111b11624750331cee6f4de15b9cb790c5f1a8859e477578b6368078516765ca80e12a0b78de39b88213539c8d27706faf33f63bdee63cd90baa872cf8a854b948ead2183bab72d4941ea97187be3039c83b52dd21f4039eea0d09f86c02fdc43f534f98cfc83bb7cef995627a49857abdccfb16f84fa02e4b175aff0f72f3cec8e148b63fcfb743c905f66864aeef156be27d8764bb95a6cde8fed329f9e8be5f66fd196990ce11a3f7ff2efec201edeb0b9defe693d06430fbfc354c7fe7cdc78c5de3bd68340dcbc4e4be867b69bf29619df12697377a63b636a819e737ca058ef2ddf0e6bdd2f3d26f90f78a73aec89e90fcaad33cc5852e3831bd1d636d3df08b1fb5f7e5226ab60d63af85b1af027ef48ef3dd9d1c4e739392ed5af0251bc2f0cba8d63eb14f79dfb753bdf87d7397bfaf7ae9fbee2e7da629ef2e7de656bf748fe695a83e349cbfc5fd06e9f512d920585be8ad84ef8b658247dfe4ff627c048b90dd1fe5cb1d54d623c572b45ad6361baddc894e4bb2e3c8bbbbce06ea8461465d04cf46fa8ef167fe39a6aec3fe87ace3f0cf5847a7fa0f59c7e01fb28e7f067fec22f47308fb405ec2589466dd412b6bcca5937e7349f9d64437688ae6187307e6df6cb4436ea9a3d93cc30f5b13f4d8c3df89f3a3819fb8a0c9bd7273de3f98a7f5adf8379e1e38d797cddc2badce98e8c1f5489984f6e44c3d6a9deaee487dc6d843010eee27bfdfd6b3518a96feda59a94ae718ad3b23fc95f8b368dc18ac8bf820746197767ed17da762fa86473e2be1fce2e858172366f225f42cbf214daf46839bc89be9482ca3de8b67c649c2f7e2965f92a029ada2295a10a7839809ccdd0a63aef0fdfcfd883d03e114679ec123b928acf9964fb34ba89c8d61b2acd3f315dce7615fd3c53adb7731c9bec49ff96bc0bcc3ec0bb1afe6d22a4d7653cff5692bd6bf1e750689751e7167be5f9e9c6f926fff8672c3f4fa6c7f4ad961369abf4a76648d34ac423df7c44e1fa4c73a437431fda0fd13f962c497ddfb54ef92fdc7f2f283e87572139c92822b1cd3950e63a5bf809cb8371963cac147c9a5fe2c4d2e7d7a4c89f7939c53dcb3f06fd265eb2fd1bdb9e5f854d7f1d2ccd110a5e948aeafaed1d56a846f264ed7c6c942fcffde5a43fc84e983d09db7dee05d53e47af81ee7e1fbb8f5127c13a9f34ff3f5e2fd4a0cfd51f73d737d1f27c363e8667f15bd08e5594c5f6956b7c5d0bcb4bee6f9a36b9e8de6119437dae18a7bb8a718e18cbd3f9af295bf575eaef9fd52978d93dfa7e9d113994974a8c70329bae6f526faf3e6767eea3977b69a18d65567eeb5a35fc527b36b9e6d5fc363303f43178af3ebee215df90e52449c23d657723b6c84cffd16344b74c439bea4cf6bcbfab233d94ef9943e8e149c95ca8baffc32333277476a24bf5f7947721a7bc2de32f21fe64b0699f438bfdff7fc96b7b1db6e8d39536494ec14ee65a37c6a19ea363f2716ba404f05fbecaffd1f1ff34098e626fcf729f57bcea8de44377c4ab909760ffe4e1cef71e7dfaaf23e7b4ad3f1df37f2ad29479f544b9f3bd27036fba83db06e43bbb7d61da9f2e35efe8a688c543dc53e67f144358946aee30ffa4aa29388efb134c3ca133ebd1af4585dc0d9dd46ee627918c8a59d267796aabcfbd5bedc545a1bc9c5a3a6b4226443acbe3bdfef784aaf4b1d7e3fefdd4cbe41df4055cee21cefd359b33179dfb7d04b51bc41d6e9d76e68ac9e4fff6d3c4648ff2da2ed9ed28f8ad145eedf08e8c36e6ec3eb3dd5f9e4f3935964015fcda0de5b129db536c8e5474a7ff106f9d2b2f4057a0bd232b5db48cba9de47e7673ba8ff02fa2c7856053d2b5c8f047532ce5a53965bdd1ddeaabfdb4153c0afe04cf13c39d48b00eb436aed0c4f7abf85bec3594d695173e39a9b6fb9dee6dbc11afdbbbefaf9266537df0e91fa34fc5cbc8f09b3dc92ce086a1497fa29764aaa7788a9972a5674bb82ef57b134b10fef79bcc35ec7f56847ef690a16d451be1b621dfa7cf833ff54a1bcd2c5face104efda4881ee715e00fc770a9799276e167af5af8198f0762d650e5a0e559d1013a5507155b43b4d19c18623d6b8ad241b73fa47ecba7c193b3807ef8680e71873e2b22efbdfe1f12ad134fe72007d85c3a8c06b7a1af665d1a0cde77dbe8cf0bdf98f5c53d237506b32a178b92d46ab1e78cce786e2ea007d6305fb1546118d006e80b716f198dce69fe512ccfb70ca3b16eff5b5ae33ff7b36fb9dd5aa916cd6fd3c5e61bedafac72ef5fda3efabd5270cfe8e9489428b909eb80be5f1554c7d89fb527ede3acd039a30e3965ff295af2792c5867d23c4cf2192dcb30ceeb364e68d3d769893da1aea5c770add10afa8fabc264a22a6d987f71d0e43d7c5fd006156938dba5f3710aff761ba7fcd914a16f0cc6346e7d3396a4c1805e63b0cefc4874b29ad4d9a9e08309e870aa0bfb95aa548e9158e81c7980e9c4103a0be8afd79d2cfe27f394598e7e8ee7ebccf3ff65bab349e6399379ca7446ee18fef6f7df4f9989bdb636fa9fc6c2fdcb5c18b3f1cfbf162b63e4ccecf5f35fcbd9e42f7d33379db1f9d7cab0c6ee68f557eecfec9fd9fffe69e4fedabb0efe5fef273f963f17ffb69df10fef3fffdcaf4c78aa3dfff702fe34c7eb91edace0af73eff9b1bf7acaacece338f39ccb65f342e129e32ecc71e6b92064d15f7fac6df473212b7cf9ef5cf6bf73a5f79cf09cfbfa9ccb6999a78cbdfa61da3f33cfff1e39abf1536675408fac8db799e72fc52cdcae395f649ecb5f725fbf164b4f998e63cf6799e7dc53a68d1e93cfe74aa5a7ccd03633cfd9a78c88ff547efc588ecc2cfa7bdf84bb659f3283d06b569c99f7de856cf9cb53a6e22c8cd92af32ce4be3c655ed6b60bef31181b99e7dcd7b290178ae55cfe29d359c1ff948bb9f2d7625128fdfd9469477cb594cf91af66ff7eca54a3be5214c8574a5f83bb293f7e6ce69bd5d8cc3cff6ff629fb94fdd7df70ecd6f827bc4e0d762af3d7da5dfe3519cfb90f3cf39469bacbc5cf7577b4b64234e57f5e5b18de07efa39f93f1dafb7b7fb1c07f6b8fd68695799e6f1ce72933588f9cb17f62e85ffdf168b5987bdf151775db19afc8b7bd27fbffac8d97fedfdfc7ab35f36df82fe617ed85b981e7fd5f06bf7e3c0fa037b5e799e7f5cfcdf889d92ef46aed8549fe6be4acff741726fa6f69fc7365a305e4fe148a7f6633b0e94bf438c288894fc5db790316fcfb29638ed6a3cc73a65b2b1774793781a4314300c50dcd350b107c0123728b1ad21db3b624f5c5b7f789dd3d16be61e18e14953aa8147479bf318ecb02386a468d7ed6a82db66f79336f1e8af9f6a1b8355c63db9ebeecda83b2fa3edcbfab82e5e8f2eb49e3f1b1985be9f3f617fd9dba8fd0391a6e7ba3bbced1ac16e77a5eddeaf3f6161bcc5b5d96b2aa42dea5b47f9bbec4df9732b235d75969673c07ed4958114d9a0d6bad8bc5e377c6d902055566a3bffb6e97b66abe73500fc5cd48e964df5c736bdae58d712863c754f4fbfa86f92b1a54070db52d53de67bb83d6de94eb2b54282bf74e7e0703e63441ca22235af194cab5efc2d2c5dbf465adcbce0a0c00301cdbc4904d56dcd8111fdd10000aa389822205d8a461e2909cd5a0d2d194bee52b322884f66930b2991634cf3a625a5b07eb28c09e6fdad5dc1a1a2f1af93e18a107e67e90c87e64d6e0df73040dcb49032ffaecbda684a8f98d21585b63de8b2c0a47ebf51bf5843ff33faf152b74a343281c1fc9e6060c59325cc41b9ce3e0661e3d6804bfd15c676e0643d5d9a6ebaee196d7d048160f9e5febf9161ad2648af5952e98c7b76a051a2d4213cac07017a4c348968edee096f2ce1fda3e6f4ff4bc06c0141a89acdfecca101ad29b6279a7cbe5a359ad048e2ed121cd824853c7a0f965ddeabc572be4ac2623a58d8af291c3f5bde87f0f9d119249c33f70e311e2f82097b7f7317b0bf734dcf2d6ac31f79cb7b67abe770afa98e60109403acea1b6069a85e1f3ea052037a9794154d3826143b275d19962103b41b2510683ec95afc94c54731987342685f387e124e89918e8d6a140208bcf7567caadd548ee10daf36852d1ac91bc0f0602010df94dfe8b5955763690a8dc1403639aa7a94c4a33991823216a1f9b29fb869ac5f40cb72c8c64c47ba8211e0c1025eb0487af59c54d5b997ba97271d614eb822a3b2b6a5010f02519de004e87a50e4d660e95c01151e57032f01827d18e5372253bbf391dd69e2e5d66474a7fa50df80620b04e1ece200e9203dfe7959ceaee978660c50c6db9f1f35c0898f4d6ba5c9e11dd7b12303f69b0d5bc38d884f7d3d7419c4398581e88924ba7c1534f67f9d8c8d793f6edce9118fe862b0923a5037cb11e89ced114cb87f180effc22d6e6af2f8efe639c82c90d51921a7a3a2dc7741def4c5ead256007685ede978b59633e3baf91579cbc9dd5374d6838d38086efce06eb5fc49fa6e84013be15e037a48b1bc1fb1807d4c02a45a65496ba5d3978050e9000db5940229e2eecb79ae0c0f94ff47c6b068d5cbe89b38d3628ac9a626e6be4116f4c3d7a45bf5fea79e9483fbf296a4b63dec97efba4cdff92cf0dc978cf613eef1fc6d0e8ccf630163459826654686f8066bd816e680f109fccdba8a14d54d3a540fff42623b9007b88781a06c98da001b55b06ccb584402d34b6d6a0195bd55abeb9c3cd5bb5b2d6f31d246f550105df77f0fb915cce1907eaf976c51d29ada3599d9dd338277018f13bc95303c2e4ea362203917117b78ca2e4877d6ec252949c62b0446c7105f3bd6b03e367ad1968c81bd6796692c475ba07f0f1eececf8462e19f777e260ca074eebd4e5d2018b9796e42c1553a1e82f166637613dee1c64ec8595dd9eaf3ce121af0bc2915cb98f78b3c0dc4d933a9da71dfc5d7b567e607c9fc66b11c7bd5dc470520cf0a4206ef92d61c336920dd80342cbe55a3f14b1be4a6d96e11b29267dddc365d447350ec0f20432feb334d747032240ce528afb1be04dd0fb6eb6f3bf4929233bf5fe35f8205e3928d6a7bba499d1f50a4ec75188a7934c4f2c6101c680eb0c6326f3d12a462a8a032b8df11fcad2a6079bb528020ffa816b99f9040010dc88f2334b43c074dafcf19c08d740de7e0ede83d8c93576ecb5285d51afc3290a040cba3541974d9f0cbaa2a777e825e4601e32b864af7901f688f86a1033fe2e1d04bc25be0eb043f129cab9e970eaa101e8c631c38ec8d141b83dbae005b6286f67a42f69a6a7279fd3a7e2f7fcf2118ea73358e894b5e4c933be7f1c99cb2033f9e4706948dd6049b5dcfb78acd57cfb6e7d4d1917af91f60a79efa3dc26b4f6e5e1b97b415b17fea34f51c5043e59e5c9800ef03b6813d500715620f6651630471bf043f0834580eecd0e211355b4ff167233f0ef2d5806f6d385105eba00b4548ec9983bf5caf56b606ec87fdb2180d8ace9bbd9be0f8c941933b40d71303fdded99a4a9b7a7e61a2b9cec1c8f7963c830912e217be6c88d5bdc1be27dac9fc7601604b3edbeb5adf26f6c91f47c80ef8f8e741718d59cdad75c16b627e171ff51cf9f4387d2357fba6c9f3137cd41f718eadad51bd5ad79c79968f41338f41338f4133918366283e19d65bdd61766827fb604ee9fbed98b89e6b7dadf7c195a8c1e98a4e784f8d4b751b09f8262efe3443cfb93c961f33284617cb38f6d384cfd77abeef341bc190d43be3b536f881538607201f2ac24d108b91735b283aa26c2bfc3eb7b5a5f88a1a53fd323c0dda021aa28a97037ab3405f25175445f0e8671ac63b027e565a594db1b2cdc6cd876a78b1044cdf810ced211aa7ffadcd5b9626787fff26d6a76643b2f4ea6ea2bb65905584c6201e8286c268726fc9c10f7c7c713e7f900b86702c80268d79df19377a21bea678e7a3f7819bafdff26936f66ecbd23b373fcc5b8e5e6d4e4ca0295c34f23eeb7c077cc5f22897bdcff04282dd9f6cff7bc5065bfd06438cc8b04cd585b83bc24259387f9207a0e3bd37046b6978b975285f0906778d075e6e1234821c1d0a1349b496c6a13255c1ce758713f873241767608b424e0695a385e8a7956f4f8c867430c4f2513b077371e2adb3b116c15984de30bd7faa3d4ad33fec1ec5e8210ae3d005a2e1fbd4f6ece7b46f7c36925fd7883706611ef18a568af35fc8231d4dee2f557c4e67c6ada27c63aff8dfb6a6b4b1af2be768a26361bd480dc8022cb5864176811ff39d3f7645ce2465dd67c7ac205ef5ee9d193e2b12b3c2fa466e410ea2c7cff4f7a89c4244c330b83a8ce1ee25ab6f87abc3797ec13ba46394546c1269d332b99ba88019862dc9b9dd15fe5a94130d39c0dac08b65a0e26724939d63c84fd8302de380f36815c873efdddb57da63727887233907c56696aebc107bf6daf5a4bd4f32fd71c44a71fe9157083744beee80d6aa277be38c1b3070b0132a3c8dc0edc905f4762992474871241a2827060d2ad26c5cfc99cf13787fe27c48d08820fb26e7a05e070605869aa4a4caf274191e25bbdbf0cca6483d13b08a2cc1a0b1b571c5d0fffe296d816c5b859f476894038fa4e0106efc01b86386728127542ef0c40ccb098c45ce5cc307d8abd1b1bf787a647cce05f8dc104205fea77e67170db94372e24d3197a6385953cd1d4efc3b4c6304344890f1dbdc507f043e71fafe1fe39b09ceaed9f09f7bb9afc66ba611f8d703bc19c84e3490bb98fd1535137dff7b3daa460c6395faf9ef9ef66cf2eed7c6ae12e88e5c9e8c552cd8d7e34941376afcec44357e26bec678bd437838ea3df167be6cc77cc9c67f7c5c7f9aafffe1f888d4e4d1e77da98c27f70239aad1f5a77797e7e5f75eb6de0ef32cf2db933a3588e3397a35a0e707bd9e4faf7e1ef3c7e392574ac6b0c3932fc5f09510ae65862e1b8a64e904038910afaa6f98a1b6743d2365eb866a197f89ec66876d23f9fd1af6158ee8efd815ba0679a2ce67d83712fa0e5ae36fca27e1fb33b418e563f17d2b4a27dc5cac1a732fea7e31eb8eba5265368f0c97b04f81476ef3c8f181ef1383bd039b7648fb239634ffbd518da8deaa7e73aaad6157c8507db0b12c2adf36cdf665af645bf87abf3bb9c056f67029c6fb1fbc0fe7be27d98778d95a8de5a128db81bdfcc1d8b85e76adbad201d196d2e6e3a7eb9ecfdd3c2ac9c71977611db6019965b81e6f52cd7966ba30a3ed099e2bd5e6e0b9b8710dcf55dbefbab3d7495f74e623a50ff19daeea2e1d35cfe804fb65d17f2db7bebdaf26a6023583ce11d5004293a539c8b8ddc4101c1c9b453e734b17f75b33df9e80ec01bf3b3484d3140d9e0132c16a8a5a4e177713d5851c6d67abe55b96215a4b15eadae4ced67091df8badede7b930dd5fb94704f75c785e985f1d7d2eadfd5e099cbf4f6a2ac8f1bbb37818edd55cda70c912b6b1949db06fd17e61e68c28ff02b33e662d513afd863e02e86ff0f2f1fe01d44701c56bafcafd2572a8d940be16686a867e0f3a25c026d22c3e2f15e1fc832eec5628e768de4339bd4dd1cb5bb8371685fe2e86281dcc4650877b8f357ec65c598227fdfe253c79879fa8363ec87b3bc59de9f76370deece21aad73302396151cebbe0c2b024ea4ebf21056bc38d7f11cdd76860ee3f01d9f33e8c1f30b3b95c17bae534fc9938da0ef52f27a9278ae1aef9760f83a962fbb0da6c132963ddd410bfd19d97c3f625fbb0d4efc5ddb27c9d2345f07ca71e1d427497ac5f7938ce5c07e09f219bc58cc4846b635d40d622ce8fb75e1dc513ed778b043759366d89ed9626c790e7ff2f1e6f97c093c49f60ddb6e18efce1d685c4cf27173c6bc02cdd8710f1f67037805d59c43de1d156f37021d46f40dcaafd2f3fd05f87e74a18572a678f40e43a771fa2732a613150b2557b71160bda6987374c82370406f4947dcb8f97b2f8b64b59db477bccfe36e901a347d8f3e5ffaaaed4f71b51d349146fa47e0b43983fba5ed71dcc58d7392ae6ee305e52baa32e4b0219ea17813eca7fe42554007403e0bd492a03e2fa83709c4aca1992deeab75688a5e337bf039aa02b65b45545b80e8dac843cf1dd4a70d7a9d048dec218e84869707f94ec0dff859334dd696ba3bc47ce10d51d05c671a756f94932af7b0ed87648365e43b5b5570204e3823d80e78810c78e5b9303f9cab7f5959c2e70f39d1254578e681eb77f8378c4f22fe37e4fb3c3ceced01d43f27c98c241bf0748000bffe5b6a9f2fc7f212ff7e921e94486d39e3cf8eebd746f45a7acc8a39ef343feed9be1e14cb0ac51c883d75f99a78f12607d6c4b449d96f0c76abedd9cf7d7b489fb783bcf634df22bf4ce7f6a747c642e91eabd7d35dc5cb65968e78300289b114913debc5fdd17d79ec7376cf5378eb6c3d06767ba8c72cb16bf03b1ab8193f898bd198129de927ca8f55f130a4984134bf8cbef090a6f36ce9483bdad96824a7d1c7b6381736df9c78ebef804cf0f31e831cd9709d38c1b19f04c3d7a0ce36e8e9f221ebe47daf747c41e838de375a623f0f7c94d81f963ef82d6ef84a70ff043e627477f299f9f4c6c83d07c749ae97892ddded436fbcac9fcb4dfc82413ed17484064c75609007f4635caa3cfd404ecee4037471b00f4c7cd0af4df0ec16ca8e5405cb32dcfa147ab840bf8891dcdedc91066fe75377f75bdffea0682c1513d6f669fa2a0a03b6fd7e6a57e423f5704d21ba8fbd9b9882931d5519db45cc39a658f762d4d82e5305c8f56839a11ab6fbe76d87f680e470a0ba4e8f0e56be2f5de91c75a1b3845c66ad5aa1fa53837eae67a11738e47b6aa1ba4b69f6597de6b1fe47b73ed3a0fe21dfbe47af5e1cc3ff903e01b135d7866841effa3bf7f3930e775fe7a3efc3a3efc3a3efc3a3efc36dfa3e24636dd7613175925ffc3e98fac4af73a54de8489b11c4bf06e1bc341a5787e3e9657be44a53b3f6397c0f9139abf5cbd7c4fb0e1cbc96e85f4da2ed187a8ed31d1456cd2def511bf91e60c9c930dc6b80c7ce8bb2ef0614be3c429f7b2a473a589f87e9a79083dfb5492c4242f1388fb6bd1ceaf0cc0e729fc2afc3e176855adf90e884933553187da289923b928b10e381fef31436e7f097111df76bebc342f29295f14c7ce2a3f3babc3ed31f6f7ff6bc3eb02f0b3ddf9aab7271392639a39057d5809cafce56b7677c723bd287f73a61fadaa25e8510f77bab561c98d3047166c8c3d4f2d24a45f31beae8f99a50df8c9425a1c9ad6157204e7fd485c2123e47be2f11f5bf5d9aee70f24dcce5f4467f09369affeef3364fcf935bf2d1197b4a72c6c27dc6d10c0d346b6737d16407e6531d9a8dce5277cd9c2ed661ce828562a0ae69e9aef305d3038adb432cdf10ca9b6fde8caca0afaba239cdaab586596c23a0cf01d9cbe2f1dbfb6f661fcfc91eded9aecab7e69cf6cea357e0a357e0a357e0a357e067e815f8b0191f36e3ef6e33e27905f7b117879003da98ddc356f4d6f5fe5bd98918dff2d9887886c5c33ebcad7d48f0ef1d78c1b327aea0ff4a60f3f8b1492c9b3a59762eebbd6919db933e8639f35dd39e45def5da7e50717a3d4a66c66217621be2b3bd47aff5d0bc694edcbfbd668de0c7b8e3fa883f623d927b1ccfbb612cd32d1fb403d28777b51355b93f43fe9cc37d9f4bf91a7fc15e4b6b9881cc43bf613be03ada1ac12cf3c17df7d9545aabc7bcc62be635fa3adab78f3968f53187ef3187ef3187efc3e7f0e1cf7c3988f13fab07fcdc7df0f752b491ca8b97c560ba8c1fff8a584b6cdc64a5c926f81bc1de5f823df64d2c6ecd6ac50af0ee2cc8b343f6517d0afca8631940c53313f9328517b9f90f78ee9d899f907c54987715195b11f78e86e65193f8c9cb02cecab343495ce49f87eb1331efe09f82e9ef3e47daef7374e7e706f903f6fdf13de8ff91bc8b92e91f87afe795852e149d3be3eb9ce1de7d9da85fcb5d9f89e7fb7cc4331f3d3f1e3d3f1e3d3f7e5dcf0ffc5924cec09ff97210ef4f6c7c85f663517b99ca93a97c18c97b1873429cf8f582585f2cf60dddcbabe3a7b05fb36a1d4db9b3688af523ea73fa5a1ef4b2ceebb701d4a6a13a4fab29022f3a73ddadc39ca0892174161a67edea2db12ff1bb832c898cffbd7efc7ad3de97acf7da9ca08498c9fdfa9f72ce9661e83ede168c798718dae0f5cf90be9da73cc577bfd83a45aa563b983b14a6af5553f4eb92c0c6f27ca290d32696bd1c351168e4f5b29c155a6626c4a8aece5df1f357103d92b8cfc7eec3397af05c5d582db3b47d7a5f7c3f9a7f62f247eed4ff1ddb73d49a52f9e936be9513597afa9bc237be3cae93bed12ee45faa51be89867444fc85e6d8c1dfcd0dae613caa82e5e8f2f0de71cfd47d49d631917ea6874e79e894874e79e8945fa2538c7bcd317682b9744df106338dc5f2126657c0dc01e88da6db1534d3cb70b1ad9daf405eb0cbce40c53e6cba0fda425500eb6b90f70ff33ee87e21e97c93c227dc7c8173834ff7c8cfafd9a25e8c4ad39b872c17260897282de237d981bfc4ebb51d3c8fc426f47cc5f7b18cc4fa7154f5f42ecc75a17bc7611a4ed287449f7e0a1fbeb796ab7dbd84e7e2de29210ff83197f93197f93197d99fcbec7ab357df1444fb010fa461c33bd13a3b67f5036634afe9f9b6545e39ca9d41be227a663d2f2e4bd13367eb1b7281de9985e6e5129d73f55cdd349b855c987693cefe6ef9f2c49fabb3bde0d2e933952e93f2849bafe8df5760226fbec6371ccbff86fbf221fa039f2d6035e81b2bf773aabcbb33ae21b91295500fe6fe5c5ae9d597852e4a7355de2db10c4174e5cfde56b4a50673e96be9f4944247bfc206defe4a6c4fe7377c38be9f9df835c8599f7e77b2e0b1874553deaf9a550be7e0cc701f490fe346d4f1de97a6e3d7cb45dbacaf8b437fdf0a77c7d31eb9126624877c327629727f93ea456e28abc3f9341fef130d3def0abaaee07c4ea01932e304dfdb97d987a6589f1a6e793396b3f7a5eb7907eaefb3586e04f6e7b9ef9ce66721effceb6b93fcfe996cee06a6c7247d80d719f99ef8335f2e6319cee4d9f8b8d9f763dd4156fbcfbad6070373bf691fdcbdfdf115926b46e46e6f50d9e97998dfbf9b043517853bd263446e2d23573972ac500edd9b0c3ea0e187e420c5e73d7520cff51e3540403b59ae3a8de9eb756b82991e7998779743b5a2f7c8bd5485720ee6518d2f5c5f52fe4ab076ca1ec278bf3b6879bef269dc7e25e8457efd98a4272f898b7cde78488a6e3d5bc77ef6b8478a1c8cc49289f301f7c1fc0aa85f9673b6a6b4108d1847064f463ce7ece791cba3e1f04cc518bd9f40970cdf8766824c4cb7be3291eeb6b6317d1fa2ae549d9e749d4d67097340476219ec293f5ffb1f3b9f85638e9029940fa34333fd3e7cbea0a48b3b9f8d6386505ecfb77e52b2e0dc19aafc7221e12ccea5654c37e1b348f91dfe4d989f137ec360f728bcc6f8646267a2867d3651b287ec43b4bffcc6364273ad036ebe836fb2e7e36714bbb8c69753c17dd856d80f8e755a7df3196c072fd6eaeb61de774d7b56b27cfbcff3d74c47ca5d7a33d6e03957d02afafd5bb592d5f326ae8f331d5d300faa5259c0bc771de21cb209ef8bf3baa0f60768c8086ccee37df39e7b8283fae178f967c8865b41acd1c4fd0d35410a666e43ad1fd8659e2f04e501a05ef620570e011e78d0f8d9344e6cda0f97cd43841d71fc90f98ccb7fe37480e7c08f0776b23082de92031457b6a0e65c17ccada9b42fa59b7bcaf177b4e784f6eb1fbaae073f9cc70fc417b746bd99efc117c427f98a7a445fc11fc46784686734905ea0be7ba60d3e0dddf7889fb307efe5d3ff45effda0ebf3e87a0a35cb66a375173c439e750da6f1dfd7cb7344fe22a42f034c7bdf38d3ac0335d05963eef773e67bc7347b35c526fdcf8b2b61dfe0c7d3691dc9961a5f4e55642e955743ffcb30347e3ea1476f3dd5df4f4e26ccaafaa5f928647615bd5f69fea36e23415ffaba9b91894e1fe4b8d707ecfd727aec05fd5c3626f25b3727ea7c36314569a5295eef853bebfc21d81c781f03b9e9f763019f680be6fcdae341251bfaee6f283723f620a099a8dcd339f2cdc5fd8e8bded82b95feaa5cf4583478b16895039bce12e69e0a2a535f47c7c1612e029271c827a0e7bd19127816e0453dc43864307b71cb64f682fa6e09e7eda6ce7fbdc13e9cfb9e641f126c9a587e89d205ec856811fba99b62ce41bc8e684b3a72f2ce55cf2797f71ee0b3059f4b279b90ff984edfe4aaed4f6302547cc68b5551f623cf55db9f731e37a7d784b8cd421772d0d31fe990406e439ecacb796ba464f7b5ef7826fdb217d63112e84ac770cfff7d728cb5ec425dd63f8606200754d0dc91dc79d0c1597490dbaa903ff4cf91056b5d68412ddb0463b509c44555573a9c1b8f67ce8f0753729c63829dc8438be173e4fb3dfe2d6016b0a1d53ce76fc9efced1a91eade77437f91c199b3d0affb2fb1659abc2dc8fb6dfc2eb63d61285a76fe8cf423ddd3ede2e9306ef57f80724a9d5c6bea1bc26a3d96759ecdb6f819d6cb845c744f10ce948d52fdecd7f00ef47f2502f79d7b467a5c8e5fbd4b7c4d4b2fe627f2c3ae33bf8625fc3b474856f61e0e7cfa3ba5b5386feab8c9d775fdfc2c9dac84c7943b0b6c6bc077e8f82578b5a6178ea519b756e6d16dacb3bcce491e03957c85c5cb74cea58415fe570bf01cfbf53ade44762f9a80d2a47f02d405f1ff550591a07a0119073393c93a755c439ad5374768a95f5ee291d359e7cdc5bc61e1c54a74dfc67159cff83eb597d1a9f121a6b36cc859e079cde81def4d02379da149d23f0ab294a07c85f87ba5ecf376839a8be07d782a37b2a9d8feae103b9e5054dee81efeb609ee6a0f2f5707425e87b5134450b7c07d04b16e22e4e48a6df90f65517e6993adb3bc8ebea486ead4672796eb8f5dd08ceedb5ef68aeb319d1358397c48767afa4df37d0f7017a61039d8c509f4490999247df626ba9428f153f7fa83d413d1a8017b16da30a9007e76c54619f833ec86f7665083d4f75b93cd3c0470534245ac053e1bee236e2336f7e851bd0e07bae53eb0d77f7d61f1c7b4df80df187053466d868ae551ece6684faaa7700c3418da77d723fa6df7853ecc03cd7a5ee7656a60c7d3d0a1309d5a3e7b69a28ad48edfd4839dd73f83faf5e86cc0c6b159bafe5f75eb6defe205e8ddadba0be63f031bc46f51ab803be0ffad75c11abae341bc17da007a72194a723d9ebb389fbf764813f0cf43d8403031aadee26a600393d13a8572a36c596d3144ffa056d414e1b7665a62a7dcbcbf1037e7688ecb937ef0ce8be093eee0ace6ea22a9da32e7496b8ff5d1ee63f637d4a6c882cddf7e7adeaf3d45a556613d51d4e54a88d131cd0db07c80d646b452026a00e2a90a789e5cb6f5737971d29fdd55d6aca106db4ef5127f72be6fccc74a1fd0be6457596844fee51a3477a9fdd778dc816113ad7f7ac4ab2871f33a31f33a31f33a33fe7cce89ce78b68c1ef97862b3de6483fe6487ff639d21426fc789f552fc0e157dae7fe7d90ad6e08ced1047e38a01e428eee829d54861802f647d5375ab83728e453e4d47c3f87f834c296f76731dfd37f350b7012d5f7823a239835523c8e14c8cd40b4e7364509d60672c3f1fc78bd892a17674db1bcf3f548a39335e6d2d46c40ffc7de84f869fc3a8983672ffd12fb98a2ebb33197e78bbbc78caf5f81d5b19fa474992d82e932d2fe22341b754ef8335e3f04eed3fae1b26300cfb9cedf7dd0940ad41907746d93beba9dc358a964c3fcefcca126b9299a0ef801b17dbe85bebae053a0fc2f1353811a5803ec70dc73b8bed2a0ee5ce92fb870e74d65886519c224f0c9812f85f853f07990fec96abe55341afd901c18c9a663e47b501fb6f1f2b5403e9605c8275407541fc8d0cc14f4cc892aefe77abeb534456b7d4f5972c3d8d1e99ea6f4b6a7d71c8901a9f5c660b978df78cc7ba460a134df79f38446cfec979ac467efb2941d89651c170afce6b837358e1fb596a62bcd387c71ec95ec9b632f7e5f1d7b81ef2e729f3c1f5ece31456bab55513e76e0e3c67c003e684d940e234573b45a3a1fb0570a5f5c9deff99f9c6f3acc5a95b7f7e6a113ed7789bb4a11394f41ee9ad369f606d943e75d8db551a3ae6ee3349faed9d02cbd2101367f1fbcbeaedbc7493612475cb5fee1be6ddf72fdadd6f055eaf4abd97d27c14e8fa173eede1fccef7872b618db3eb61708f3bd849e20273a3bf00744dc17cb2baa2682591fb396281d86ef11e96b27f727b80c63380663fa7514adad2ede255e04cfb9c2c6c3f1453c23c870cbd0b303e23b36f43532f29da52614a12f35fc1fcc6cb68c79bfc8a14f92f5c799b11d5dd8077d6145c91dc94582030ff099178b69e158973347f148dc271ee65e9a8d197e9f046c47e8ec73c761f6378fc384fb955e66039db736e48fba4b7ca2d1da1a1f1327887d26d4868f0e1ff2cc476ce2119b78c4261eb189476ce2119bb8656c02ec821df277cbad9536c881cccc8e69bd91ae2b2ef2350ea196542c430db30df53b4382d52ec6b3afa1d941645650904bc8cc1c98b751fd2a995b6c1c60567a3ddb142dcb748713837a3fe84f6636665ebfb246c5313cbef271a8266b8ee182ff17b027c2fff0fb15e04cb817cc1c63e4b69fc77bf719cfb1fb1ef83283b95a04fbfb7985ccdc88e211f6b5d9c0334ae6ed8909fb87e6c2690edccbc857b6d06301eacea0371caeebd8694a0be10032a3943e3bad4ab07dcbd181e6d0bdfa5b56afe33cea8259fded72ad309fe5965a9503e7df10a33e70f103173f70f10317df0517cf912ef4722193663cc2dca759b1f6cece0d3b9f2fb979920b93804fecccb84d145fa2bc7a8c0bb07ef472b03ddd8b621934de8067c3bb9dd34f2405239c8d15123103de1714a7a92399427c710b55e905bc38077def1cc33860ef7818804367b33227c13e4a9c1f4c7e1fc5aff833de58bc293ad9fbf87741deedafac05f0ee8131d76c04585fac1771aece4685f970c1ecda534c1d7e0e3eaf9bf8758728d7dc8ff5bd4ef0bee29ec0fda591afac50ee3dcab3295aa6ebcc317da4d34d0abd44613df07d8e45690633408cb9743203c4fb8d79d0f3d22e01d73990bb3056fa0bc0b56fb2b301eca3857d99f8fd23b126fe8c33d610e443536b4f9595a932324a36f6501f49357b313db27e63724631efc18d1dc08f36c3fb50c3f4544bd7fb445ec4ecd3fdf222c819be422cb87e08d9de113a302a3f02d11ef1e1139d7ae9dc64e679097474b1ee4da2b33eac65e0cf03e4a1b574da9b16bfe3396bc487836a26206e04cfd7a06f55b5925721a7402ea239475ab56219874a157a5276a6a0d34aa80e48835e76721fea352623b930510788d6d16fba73b6860bf5d1fdc3ab49849a5787ccb9456bf1f5a5e0acf46a64dc0de10474be280742da84df1feed977508f5ab9c723c3d92b59a6b317bf8c672f88e58968cf5f43b3f13ef05ce83c2a54db08793170a13dc5f3303d9f4fc35cc2fc57385fa8151bc926aa07f5fd3622c2e7501be7e876e84ce07e334d86739666e7da1b1c7228eaac227d821178236abe159eaf9c437e69c63fcd938fc2eda766de858fbee2b0d0c98ce553b9c1775f863766f8dfd0378ad0053de37a807c5edeff436c4f9066747de527e7b70e3d4b9b8aa15f37739b17b3b37a9e836612313c733f5a9fc6d8ab77eacb5a3fa872f60ef600f836ea6b4de9a358058f4d1a658b829f1d642ed4b11a07d2e71f6cf657a0fde948041f79678d6d0644fb5ac3817780dc55a29f300e851e145e2ed5b7467b0975f8701fe497057bb7cae15bba250e64f608f7740ffe6fde9e0056375d077ad15b9097a70a16f8b38f3aeef1eec9fb7e11f6147294893ec0fe4690075e9ed7c03a7e1ba0fa7b0bd7e41f746177cfd93671fe189f06f97cb3755b9377f7c8fdc819427dae0d3ec40f1cbb3ea045c87d54656763ded9efade5258821e27e20e97ee9b09f2f76afa723b1bc8c8c3f9c771e988e2faff78e928b09b946f04e53b37af9f3306fc5af9f7fed70263faf3e132cbb6263420936dbefe2136563e457fb42e5e212e416339393f46921fd7f2c92e7a70badffa7c99dec19588b0f639d8fadc08669d1390104535db126de7748d619213d1af8e199fb94d8cf033f5267057aeef3e5ab78ef75a61fe0dc79b887a6e83d076851c5fe509e7c8fd3e724e3da8ff0cdf784bd65e4db3c73702f5e27ef7b71d8b2848e03db340adb44dbae334da938badb3fbec17b7f3a5aed3b06d48936da13eedadb44dbb4be21bdd50d0ab303c636ddf24f72be103be29ca5c09ec147c8c7577246106f26f2b1271749cf20c87bb07459ca82cc1fc9454117d6a4ff3cf852f8e62db0322fe16c136dca1bda81044753fb934a77a9f4164567b48d635756aadc72f4eb6a36212634d5646bab8be89e2eee65b41e21590174e6d9786f5564eff871a4bbe72e85f8606fa9aeb4f269ecca75a4bd478a6cfbc7f41734958ea509c37bd331434397d3f3402ece60cea8294a471ce774c60de01307e7f841ef33e960787d372de863602a1d3ca7f635246b39646ab22ce597a1203b7bb46c64d675a2df35a58d63a352c110f7802d893f6f672a6876a083d70f79c9088baa8390fc4d97b384ae3e671c3505d33a8ffceb47fef567cfbf86b540dc05f8374bf34ab79166ef5dd4d3b5463f8fd3ae8ab2a72ac83f8c681bf565f77babea9ebc85d8db31d40721e48743bfc9424dea9d652cbb7e821dc83ea19c70bfdfa9bc9b688a857a44e2595ba49705e434670db7be049f17fc06f40d0f0da6d0de6fd18f01adb5daf46264ec6c516aad31b2339ea663de2145f69c45f3d0bfc7c8f7ce93bd493278f63a21f769562d670c3e4ea56f419edbb701072f9c218b591ae2dc97b36533b90073f7c27c4b78f6846fbe895241933b3953ac435dc1b2294a05d46336efcd57c4f82324eff03a7878979505e934847991c60611f7fd5cb5e3ae9983581cc63ac1ba6afb2499c1e5e38dccb5a1e21357e98246e5807bbcc399101d87732be0330d9e73c4751e242f7d86cf0fd7d5205f551c56bd777e62c394c13f0d3d4efd5c957bad33ed3d9379e096f1c334dbd4a90cde739d7a4a0c2d22de73461ce596b6add74be40eb6ade72f6170029fce89d431feac46c853815e0c683604c4e241cf10bf068ed9c3e7b46d0d580bce0c6aaeb2e37bcff5f1f6fc44e73133286fb2aeb4f7ba007bfd963e1c4380786ce71ef6c43bc4e0f18cb1cbe9dbf17bc6919a4a12e39da8726e8bfe4fde6735a5b5019d61ba128e0931f7f9387dc0ac13e9831ee91d4f6a1855c1daa29984627dad0bf07ff515f8917498e3a7688e71fc101afdb8de8f6e67a10b686ee4b5791397d710b9d6d63ce4d6aa5c2c9e91af78277f4ec7325c989f5b5f8d14d21b87f9cee9bb256129c5cbf1ed0036845e4d1bedb0c3b9acf5dc08fa5900ad426f55342ba50ef5b71b3c1b6dade75b9f250e545395d66c04324074906c46f2be6e2e34b93021f209f8e60d7aba35fc9e09386fa3938319142a8e6d79b1cbdcd6f84d6343be5cf878795cc372f30aacf14a7a8e51e754def832add141be59986365e4a5e928e8f97b6f994cad95e0738c1702fe81be6c962e97a187e65103190c7d55307de9794f6f18c74f831d7e3d76809ac43bd413411e2af6433334cc17e7e979765316cf47427e3b6fffcb1b3f3715fb5abc5c542423d16fee4ca73dfc4ce233b9e6bdd39e4bdefbe36d438efc47522bf91bce79b96bad36371e9b434dedbde7cadc66ce0ba6dd585c8af56154decf52637ccdefb38e5737348d5b57825ead86e55a827e4dd2b36c1ee0b5b84fd25d676fcac3c9888e8979bdb351cc3a989707bd5a2b5bd55d3a2af16333b2317abdbb6d9a9c0ef68f035f910bf2ee4fe29a481f5fbea67b613c8a0ea8cf7d5d88fe1c34a1f738ee95e461f04f488f97d44b46d1a14fd76398cb19932f01b14acde3f3207702e71ef8fe98c10ee552e0581fc9ff22677c4ead62b22e3e5f27930b3024d937a49bfd78f4dc213969d06b2f67cc2b5bf04b7bf91668069c27dfa1a705552306fa08fa49998dd90af9e0706c90e4f191bcdf73ea04537027bd3f81ece497ad1be3c01fc7bb272d1bf98ac56dc7a4d833e8be4a67a7826fa651cfa9f23e7cc69eafe153d164b07e6cdba077843e1c75980bb037e5fa6a246b452acf179de927a2ada90ef3270f9f4e56d6d07b9dd9732939d72c9095a46ed4b02bdefa05a815e8901ee1bf893ef7f3eeb15cfc8075fe867e1cdfde801ab6f7e54117cad9eea055d494f61d624abeed71856f47b2c0ef81671c4f82f5e099e3f43c5ff175320c3ef76d300e19992c1bf96522c8c21eb143082df6e53af89cbac36ca7f25eadf8760ae98540cf72560715fff74dd13fcf74da4ba1b9a8bc47760f12ebc2ceb36d21de6579b3b2387a054e5fafb4f760c644f970ef5a4998790079c777f015ac750130deec1ecfb255b9f3f34dce816c720cfbb2dac7ab6de7cf6fbf3cece97f823d7d435d8bf2adf2ad7bc4b05bba52d91a73a6cfc5c53ab6be69be12191ae84dd04dde7c0a9cbb3fa84c611ea02e96e76f941e7b0bdbcbf42c30e8c56b6b3d7a4df4fcfe9705d34317d9ea865846f96a23b9bed21bb3892e976750e7a8c9bd25d2f3b886cc97593cfd4053689f9be63d5a277b15acd5ef0bf70fd8cbdf0b6bf87b708f191d9a624df58634d30677d78b8857527af69f62a3b974180d7254bfefa11db7fe2419cabc5fbc1d90640fb85611f99a44a96056e91ee437f29940bfa303eeff2d06f4ebc5e2e97f6b0755363dbaaa5a969aefe75479b76a8ac52d9c29c9a9079e4075d3a234fbc69183c69c711aae383bc719729b4780819416eac31eea7beecb9f8fdf877bf96af0ef23637ff8335f3ee0fbb27c4c7807669ecdef919ffceed904412cb4d1be422fbf4ec8fda835796b51daf43350ff38c8edd2857d0e9d877d6f9d08b3ea4cd237027aebfabe98dea0d241ef4cd9e4d0c708be6f1cbc5e0530cb0b7adde958e7e90d09ed018f6f3085ceee9d7fb6d565c8f1bada2e8cef19137c87473f5ac621b7340e97e9ab0bd69f33f2fdad7ea17ea4ce337afd986623b1c30db13ce465a8ee7d6a1a7af85998bf26c65ce2eee11d936752d3859c8d7a38053ea489e6cda283bce5fc488479acd201d7db2ef4bce1f5a5f4d75d599bf23e3b020c377859c07e18f01bb938ff4663d086067334c85c0d12533b82dc0d64c1eeceb288f020ee17d268e574918a4bbcfa3c4acb55e24b3c361be64253bcf897173f837c4ea44fa7c1bee6727aa3bf84dca966d582ffdf69f04eaeb1a4df09f2ce501ddebc1df4d66974e0dc03f95dbb9b8ca36d609fd675329b977a1e83f73eda9742e895f8e2c17fb8d0851e9fee4cd4a1e8ec505c63049835ac6f8e23b19cd345987d3f9c401f414ddca3d9bc9f01ebb172819c13d1ab03b19cc7fd7150cd39ad7b9b0d989328ad6016b136a82c808e75a8714fcfc762b159f2d9d7f674ad5994ad472e44a3805110d6f3f2d2272aead17d6e3d66ec6c5ca8515a6bae7330f2bd283be7229be722391475d5f6bbae83e2efa41f2fbc2791cbde0c3dfb658d65a83b525a47327709f051b3612e4d7102b9d5739821addb9556bf8ae22039439066df0f9063dd223806f2f236c8d7a0c0acd1fe1274b5017eefda6e6b60fd97749d412be7d30d23f79919c209df4f9e634c7cf29ff2ec0946ae6643d8dd149cec88ccd710207f079dab65b8c3401fcb107f7c497f16872e4938eb38fd1273c6613e4cfa7ee29c6877ed68cacb273d33f46e93b741486fe4613e3ee6530febbc3aaf4db10efdbf7f6a4a25fb06fe0d25e89f877bea418c7ad1ad9677296ba5f6f8cc77c7e7cfbb4ff8dcc3fc14f37dfcddd4b9dc4cfd71a4ffef4e763fb17d823dabedd3e8fc320cafb46126ce15b63ef88debc737d939d2987d44f706a267d5c4eb7aa855cf812f07f906a1bf31d4aa08e59589f90e6a54801ec73033db1d52eb8bfa6d7fa1c9cea1d9f0fdd0bedfda38142603a537d1c432d8141b3d4fe6dbd597a886613e0bf075c39b5d48d74b19877bfb28a0f6c809fc127261d253da6bf07f53b4b3a4fb15d1b38f68996d08d6d694f73398c7d06c74a06fd0d46c802fae17e18b417319c0de82393687900f3fe2b7703623e8a1877bb63745c851a9af74c13cbed9959659adcc74017a4515b7041f40af03b0e5c6948f057ad3416d6d80f9a5e33dfd2909fd07f8fa7db8ad2dcc6852e5c2899f8091311fdce3c0f7697cbc1cc94b47532caf9ba237b707f030676c392aa65c69be9277a77d6fe0f7cde1fa658afe703f713297029e0d3e66d4134300fc073281923ba7b285271ff196b9363553e96475213bf1ea99c10633683bffde6b4f7bf7646c7cc35a1752bf17f1b9ff1dae7817dad797ab6b2918fb8dc78f68e9e2ee173c3767996267719bba9cdd36e12c92ea8b6f28bb70dfba8fcf49a8f9762a965dd7c82d1c0ba0b006aa2926354e5bcd7e59905a4f2f964df326c4b9a096ce7b0fd0839a4de1f763ba7f27c5afc3edcf21fd88705d9cf7fe7e2f0984473621fd0eb5c5f8bdd11aaad611d7f13b5a7516c22080a7a04711967d9eff95da87cf248f3e5bed1dea2939b86fbee0af98638c6b636ff6cc875ef9adf54a708e941df6e83ffae83ffad1fd47293a88ca6d7ad4dd3cea6efe51753738cfb248d3ee07e1ee0eae0da0b1e1e57e48a7e39855babf5f0be3e93aec7d9a0d7c677f5e6eab41ddef208ced3d9978e53ad2682e85d6ee9c037bd494de3a3473e3947698eb148754edb8efe2eb6c9cc2fdfe96ce5b6b928c532fb5a9fdf330fdb9480413111acb9decf19bbc76c6e13a3afa5c511f22b0d1c68d8a658a93687c2e48d93785ee1178ba26a013d0f790a7e0df6b86697b905b32fb80f7006302aca7b0dcf3cf027f3f2e6e12e4547fbcfc6af9cfa278f20adfc1bb214a530f2fb661feed12ea0769bb19f29c467211e771575cc32d93983b956fffb238f5bf1b507fe000860ad37a05e6766e8d799f2b7784e8a05bf81606c13aa264e04e933b5ecd0dc42c4507ea1083ef79b9d2c1598bda017ca9107fc0f2d08b3f542d52633281b8882aefb7da6136515d09fcade1f71271ed035fae09e6c98ff73fdcca6e55e5fdea8a3a83b39ee5e50520febe85fd7ed6b375b99e5585c94dece48bf33929991295b786f7673a129d9556cd51744dc569aadcf11ac63e4ec6ca3ece673016cb8f6073414c30247fd07b077cc829eb92649ed78331dc836b6950bcad5577200b1d5c57b4341b903f6e2d41a77c13f11c30a867647c8c5eee507d650894cc3c6f6e7b72bce7fcb80fb920fec3ea0ec8b770346a6ffd5c0d5fb7ec487ce857ec19ef1a93e562749c350a6372e7de25d9da8caf2b2ef78eed29f08972789c8d2aec731ab2313d9a8fc8bfb2749b3efb8086dea6ffe07c39b7f439cfccf1faae929adaa05f313eb7ea6ea20bc5d578109c5953ec2c46282723c091246786e649988fa2031652faf0fb29e874cc9f8fbcac3be565810f9e7a762a8d751b293a24da771cf0bdbc5f5d6153507aa6bed2c3fa85b61f023b80ae0d46f5133e6ebeb38fe4640f889f8ee01488b387e4a1366f41ce04f2c911fe030c43d59a4fbe51361187dc4b9671bc383fda778c7231df648ff7df648af7a37820cd8665f062822d7b8e4dcb894b387c7689be3b4f66e2993538160ff9848406a4d95b95aefd71b2507fe7e1e79785264a3bf08d81afe61b750fc81342b9c8f2fe48db8b5a95c3dfc749d367d3761c8dfb3e64265f87e4ce133aaf85fb7bdf75cf78f507a7cec03630c89e952645e4e391abb63fd51376c556950e4fbc8fb1216372b9e78e13697f45f016872d76915f24eaea365e42be12effca2f8b1bea1cf9ee2cfdf161f70d4cd6cf44f7a6692603908977b7ad59fb541ce0dfa65e8eebed8145330be97c74bf324cc395b8de4ced214e1f79017b0c7fcf95bd65960bf6fcbf1eaa83a71dfc5df4b90178416a91c01ea3e497e74f2be9cb9699d3be140f49ccb6362deef19bd0afde6902e68f5efecf3ed09f595afbf5ed3df2dedde2934598a8d8932b221ca47e7f320f663fe067d89833cc657e7f506be3919d9da54cfdd96e0cc5a878aab8b7598058b7c5026d405085296d2379f045bf9ef33190ca11e17f9ce2e5fd30db10fa15b8aeeb8e9f2e13b7ef88e1fbee387eff8e13b7ef88e1fbee3dfca778cfd7d4ea85f492a364cc7845158b0a32a7dc899f031a171a8d8e341e592deaf51ba97cc683c32ba049e81e28d1ee64ac18129f88f9b9f10dec379eaaf141f40cfcdd7c8feae7cef9f46f329f49deadf3ac3aff5763ca1c9289a3ed38ec57b763f7aa4318a3d66f2beafa7c7b08e83bee4df1a2d47cb4b2b55692219087800f535b2a1a728ca1599002ef865f44acb6da05731895eaf5cdf2de8393e0f31d09b94ffc5a7b539aae7760cb760371b8817bc5ae93992df1bed17da2f43b19e85dc537d7a7dbe7c0f72f16433a78bf5c3c95c7e949b64057d355e9d575ce3b7859e5e9073a9cbe5833608f109e4baa1fe0858667d9a3e4ff4be911e9e862bcd4d796f1936f253826cc3f5f11ebd5333545a7da805145476ee6888c621e7117a5c513593e9745ce5a3e76b6d713ddf9a694a330a4b72e3c76e83c34e8cf50f960f9ac82d3793e46785eef3ec9d17d4a1a21e6416352397d43dd0f4897a66a079fd5e0e1ef413f1cfcddba3d6278ab9f97b467a7cc25e2c35c1efe106eb437d05d580e780be10add2b409fd32d441d0031bc5df1afd2de4da42ce3e3ce73fc006fea9c9d22e328f9e5c29f698aa74a6108b44b2e18afb20da12c03f01fdf29adb5f1c6f48d18728aebbc6b6f99aa2ab5f284f2e8b475fab3371accc0a9eb3433284f223ade8de9623a57ff4eddeaa35d3948a0335c7fa9cada7477dad2cbdd1d9d2b1d1b7e9e7d09f17fa4d91dcfa157bc6bb46b26729b80dd79b40defddea1ed6af6ea364ef935d46f6756acbd839d41f7b961af14d93196a5c8bc9b08de4a96cdd7fb78e9ab64d48aad645f0fe647b9409f7d4043bf6f5c38e0abb89c0db7b8fda46786fdeca4a604f451885757103f83b916a3e4dc8eec48ac67c33c29150c71bf5405270bbf873a0f1df3e76fe9afc5df6530cae977c9f712e4452296bf618d2c354b83ba7faaad9a6aa346d9a67dff59145d70d675c7e8dac8bab2604dbf775d59dc7e919cb991ccf85c105e970a04f7d039a4a1bcd1883e82df06155b833a995de87d7dff3d97ad4ae8f24a9f0b473d6e741d13ab1f898f30bee635f063dfa227c879cf5e7b7eeddbf4a2c1f9400b5d704ee7d954cb89b315ba0d2666f0c8eb7de4f53ef27a1f79bd8fbcde475eef23aff7f7cceb9d43ef951cd57b25e4db4ff5c1a5fadea27c6e0edd43a3f2ae29fdecd5bd731ba17b1ec93ddfaa7e2c097cd63b5536a13ff70c61e046653bc2bd9735a06fe84532ff05bd71e2f783f8ebe9f399188294f5eceb9d1f7380fe2c86589fab0a603fb4e69d2e385e2f6f41053fc9515320bfa6cce39b4fb67d6bfbd8fc10461fa6f67aa07246e2fb1b88755b9377f7e835993384fa5c1bdc066bf3ae0ffc56e0e7035fe8bde7626b7909f21156904f7913dbe6b67697e7abbc623e59944f23b6efe31cc7fcaa973f0ff356fcfaf9d70e67f2f3ea33b9a26787d7a32abb1ec93dbbd99050ff01c3059bdb81fef7e19c86247f343f6e49f51725f98d86744faddbd403b498f9020ec16b41bfc697f948eecd9ba2b6526503e5d5a8834a5685198a4a6f42f7a0fa7ea82cdfdce1066214c82713d65920b3a16fd5e7b0e7c23a09d5cd207fd2ab03b146f007419f22544f80ed8e60665ad55c6a5563a2b97b4b3b54c0beb19a8dbe053324c37dde9a5f9be26ca30d0a7eef277abf3c1ddd711ebd2f1fbd2f1fbd2fa9de9729729bf8438c436eabdb9f8e565f711c167a556e6e20a32bf0bea8de3b2c4f97fa5cdb8e0472be50275edee0599dcc3d4ef7823e8394759e6d5f239fbd7f46956d904b56073b0bcdb20cc941d1d9986ef927c923839837e48f71e07856e6c59fedbdec4dd0157056d4fea4d25d3abd45d15905f5a924f63a1f9d45d3975df1ee6553b3491aa17e7d53348f5290a85ca89c05b115649fc5c6a951af02547fe7e3be01aa4d02ffe0566f687cfedd14b9c92d2f41efcfbcf3c1df213584bf7e0fd2646b8a4cbd614c8907bb1f3439b735b9e64aa7f7aee7b65fdcfaec06fd07896f2ace762fb17222e06d1263dcad479fd03ea0667b903a704666a4dc8fe141e93cdcebfb4b3f494d255b3b8d7c4d57ace9f7d345f396a3cb65214493e9b4984a8391b6a92badf5bc66f1e60e46d1db305f7154d48f5da2e60ef60379dbc0eb917b24a6cfe4dffce21ee08ef77eaaecd7e686fafffafe2a3a07dedb37f0697a391aa1daa5dde9fcbefbcdcc8bc4dee83c0739f0657e3eff882bb990970ebd7751df89aa65419d8626402df50cafe17ab93850fa8e8ef3e1c9f9bd797566d4f3b04dfd3964e1409335c77091def76a8cbcd90773c805fe46ec7f623b9eb7be7bc9458a3e2275339afdf0a6b41cedf3d98427fbaf0a7bcbc873ce4648cac79839594dce793292c8c7c18e7ec6066a784cb1fc13ea9071fdd8e6b3d265b02f487eb64c515ae37a298239714f19cfdff106b3151bfded48801e085e3dd28df39aaff1a72db5ea6f222b6fe44f8e3eafc0a7aa797204f25c96c8efda68a7ebe813f990bc666e9dcde86ecd85da9e22e403c23bd23ee1481ea3e621c3ecdda23f9f186a008f9f8706e14cf0b97f6a3a24f5c3f0be58aea339cd1ad4ff28ada57ab8a8b6f8027ac56789f7909a5d85dead956f635fea2ef1fddeaa15e7cd35365e1fb0ce5613877fe0b98288e655a13cd3de0be81ec0a3debf8b288eefadcdfb4d3cdd7e0e5fdf9567988639500c06e134aac7d308be3f308f989e21f7fed0144d4777a5c348869a196a3699bd9b6883e2fc0d7eabf457d8074ef6f95ef8e576769de707099e9dceaba93c1ac59b3d983d4cdb51cce7559b039f38611f1a998d8d790e6aa02d4d447a61a9cf216f9bf858f6c7b72a9abb8afd6412e5e74cc42dc978851fa7003ea968627f49ecae883926b7584fdafb24d3dd632eea6f3f1755179dcde8f05bce62bddc973aaf6c0de87b0739a211faa5db48a0f9089c72a15dd6d3f31d9fbf4f3f2f7c8bb957a45f00ea37a1a7ab1713e9cf0886d01403c754d1997839c030d308fc5df91ed279c81749fc8e90bfe7fbbfa0471bf8b41cc8295e9a5514bf855cbe25c45f464a6ff9496cb9d05efa79ef30eb0070b3083a8be872298fe5225a1fae8705b908ba22ebd9b2086fe13c13a03d2a2e53b56c54dfefad1fe127c8ff039d0fbd46bfbddf147bdfb6ae35a9370a2b2363ea5a555702ff42a43fe3221ebaeeece90b6a5b7b862b4d35b91ff444119d99e797f1f386fec1f5ab75978dff7ea2b3117561bfd2f3e67fe6d9cc3bb046947bfc49cfa745e43ad2073e76dc6119d75b436c34884fed26d013107db7e1aded1f5c3f02fd20806eed94f571f7d5b8ca6714d19b13ebe820870ac5703c7d8dfd807e1fe2b76a056a45d0772197e9773d37fcdd70bd57c47713fadb316b7bf85939fcac432cab27ba589e621a0862da80b5440f5b832ffcf4f78967f9e13e226a1f90ef47f27b73efb7aa40f5aaf2f0b3e5dbe0f66deb1ac97aa93867709f8b7b0b98073d2fed7c3bc7a90cde739d3aad6f3c3a8ea9514ecf85396aefcb45c44ccf52e89da26cbf7cc7f9ee4e0ea739f4e936b9210cbf8c6aed93fc1ddef7ed542f7edfdce5efab5efabebb4b9f69cabb4b9fb9d52fdda379e5a05d4a0ff3bea5ba7b27b45e9ff643736a31ef79f44dfe8f790691c7409fc93eaa41653d522c47ab656db3d1ca9dc868ef3773c32de78cb877779d0df84ea05f37bde798af237d62f833ff1c53d761ff43d671f867aca353fd87ac63f00f59c73f833f7611fa3914a781dacc31cc111fb4b2c65c3af1bd84747f8c6ed014cd31e64e76046b39e496babb5feaeef0c3d664b8433b25ef0b7cce054dee959bf3fec13cedc9807fe3e98173737d997bf9f7d3f2adada9bcc4e9c1f5489984f6e44c3d6a9deaee487dc6e0fbc047dd4f7ebfad970756b4f4572f07255a779e8b1b8375f9b976c22eedfc9875ee13fbdb443e2be1fce2308e2ece4ece27a9b7ce0d697a351adc44de90fad573e31fe17b71cb2f49d09456d114519f68e8af84eb914b71f74bc599ba708a33cfe0915c14d67ccba7d92594ed3d4c96757ade8b43a8f2be866b0ae9e7dd2e163e975669b29b7aee65751089f5c87167be5f9e9c2fe6ffc867df506e9862ddd6c554d9ff4b6487d968fe2ad99135d2b00af5dc133b7d70669dfaf483f64fe4aba1b9ecdea77a37b6deffa6f43ab9094e49c1158ee94a87b1d25f80ffff4dc69872f05172a93f4b934b9f1e53e2fd24e714f72cfc9b74d9fa4b746f6e393ed575bc3473f4ea37eaab6b74b51ae19b89d3b571b210ffbfb7d6103f61fa2074e7ad3778d714b91ebec779f83e6ebd04df44eafcd35c9178bf12437fd47dcf5cdfc7c9f018bab938f709f60fe5810e126836d06d31342fadaf79fee89a67a3fc86725caf7ecef73fc50867ecfdd194affcbdf272cdef97ba6c9cfc3e4d8f9ec84ca2433d1e48d135af37d19f37b7f353cf19e5375fb3d78e7e159fccae79b67d0d8f413f2c5d28ceafbb8774e53b44ccf888f795dc0e1be173bf05cd121d718e2fe9f3dab2beec4cb6533ea58f230567a5f2e22bbfcc8cac51951ac9ef57f66a3edcfaaae7d598859e7731463eb53341263dceeff73dbfe56decb65b63ce1419253b857bd9289f5a86bacd9be8959b63a10bf454b0cffedafff1310f84696ec27f9f52bfe78cea4d74c3a7949b60f7e0efc4f11e779f0955de674f693afefb46be356dda959c9767df021fc3d280fefc76258bce6bd042bfd5e78e349ccd3e6a0facdbd0eead7547aafcb897bf221a23554fb1cf593c514da291ebf883be92e824e27b2ccdb0f2844faf0639ae0b38bbdbc85d2c0f03b90475374b55defd6a5f6e2aad913edf27f41aafefcef73b9ed22bee9ddebb997cbbb0f7f02df452146f90751ec0ef3c827a1056cfa7ff361e23a4ff16d1764fe947c5e822f76f04f46137b7e1f59eea7cf2f949fd56c05733e84b2189ce5a1be4506dde1be453cbd217e84149cbd4d49a90b8fa0f07e531a3cf8267915920af13eadc2726bc87b284d9d1b7aa0d4e9ac542ad9de149efb73b9883aa292daa0ea3b9f996eb6dbe1dacd1bfebab9f6f5276f3ed10a94f236b0212ea0092cec8d1e5fa523fc54e49f9fb3179ea45e8f588ef8772cbe13d8f77d8eb23d06644fd0a7a4fe80f6d8ad2bb21d6a1ff4850eb279457ba58df194238a6e7d36330833da873b20b3f7bd5c2cf783c10b3862a072dcf8a0ed0a90a73d2106d34a107b8376bfd63f2f37d1a3c398be83924f1b3476afb13da0bb0b974180d6e435fcdba341870d4a0e2f5c53d2375ceb32a178b92d46ab1e78cce786e2ea047c6305fb154c1ef618e7cafbab8b78c46e734ff2896e75b86d158b7ff2dadf19ffbd9b7dc6ead548be6b7e962f38df65756b9f72f6d1f0744171907e8c9d8998e4489929b9dad2e427d58057ab60efab3f6a47d9c153a1fd1fb29e0b1609d987e99f3c174b6db9ec8328cf3ba8d13daf4759aa9b4966f0add87e886f438a81ca85e5bab66a3934333239536cc3a3a68f21ef649d006156938dba5f3710affa6d641bbf5cd5882f76268c7a39bfc4874b29ad4d9a972c79f4980fad30bfb95aa548e9158e81c7980e9c4103a0b4dce59ddc9e27f324f99e5e8e778bece3cff5fa63b9b649e3399a74c67e48ee16f7ffffd9499d86b6ba3ff692cdcbfcc85311bfffc6bb13246cecc5e3fffb59c4dfed23773d3199b7fad0c6bec8e567fe5feccfe99fdef9f46eeafbdebe0fff57ef263b55afef0fee3cffdca8427daf37f2fe04f73bc1ed9ce0afe3af79e1df98ba7ccca3e8e33cfb92f5fcbd9e253c65d98e3cc7341c8a2bffe58dbe8a74256f8f2dfb9ec7fe74aef39e139f7f53997d3324f197bf5c3b47f669eff3d7256e3a7ccea801e571b6f33cf5f8a59a1f09469ce1799e7f297dcd7afc5f253a6e3d8f359e639f79469a3c7e4f3b952e92933b4cdcc73f62923e23f951f3f9623338bfede37e16ed9a7cc20f49a1567e6bd77215bfef294a9380b63b6ca3ce785d253e6656dbbf01e83b19179ce7d2d0b79a158cee59f329d15fc4ff96baefcb558144a7f3f65da115f2de573e4abd9bf9f32d5a8af1405f295127537e5c78fcd7cb31a9b99e7ffcd3e659fb2fffa1b8edb1aff84d7a9c14e65fe5abbcbbf26e339f741679e324d77b9f8b9ee8ed6568896fccf6b0bc3fbe07df473325e7b7fef2f16f86fedd1dab032cff38de33c6506eb9133f64f0cfdab3f1ead1673efbbe2a26e3be315f9b6f764ff9fb5f1d2fffbfb78b566be0dffc5fca2bd3037f0bcffcbe0d78fa77df4a6f63cf3bcfeb9193f31db855eadbd30c97f8d9cf59feec244ff2d8d7fae6cb480dc9f42f1cf6c06367d891e471830f1a9783baf64bdbf9f32e6683dca3c67bab5724197771348143304109e59bb3d2840c0050cc7ad0689fbc7ac2d497df1ed7d62778f856f58a023e5a40e2a055dde6f8ce3b200ce9951a39f356a8bed5bdecc9b8762be7d286e0dd7d8b6a72fbbf6a0acbe0ff7ef2a0c9f935fed9010acbe4cc6626ea5cfdb5ff477ea3e42e768b8ed8dee3a47b35a9ceb7975abcfdb5b6c246f61e888aa907729eddfa62ff1f7258675beb3830221b3d1df7db74b5b35df39a887e266a474b26faeb935edf2c63894b1c388f39e9eb15e6eba56d66cbc7c793b94a7e0a07b738b4b23dfdb68425978138ac737013b638eaf9b76b5c928e8a0e1471f1a4f0dfb8ee6d6737a031cf7f59c2aef516376edf477b891411f35fd343c0074edbb4002f71194180ce17a9bbe6cdad5dc1a9a921bf93e186b7ec38744805ddb2715d84283ba23a607d2a0a63bcc762aefd5cafb58e96435393b190a12040297666336198a7b4bb75f16c35ca7f76d501151f31a3c608728b6c8665dd090cea7d95cb0b6299c4361f726e796ba281d4d3450a219be1f4a02ec84d715dc73a1e75b59f2cc108d55cb279f9ffe1e1a98d60fa37c3b7c6ffff3973f2428707e2dbff7b2f576f3356890007bd014bda26a68dcadca05d460d9543a64601c2982b649913e2ae8467b861b45349c1d3474d4615823d5fc5ff31a7701082d1a79683c57396a722767b84e763ca8ec74b1be79ab568e260c3d12ebd0946e01cec526340283662f720f35727e9f39ef52b5f23eacbe14dab59775fb7d02463e5a57e27aa19140be17147893f5d7fdf779a7dea707efd3ac5aefc3dccb92da93a57140c5e4535de83bdd39f96d8e5a4b0ead856e3e880bc88923855c1e4db8d25a555a3f0dd63015b35f0cb7bc356bc523a6dbb511d02d18f8166eb874d08532ed208d6e50940036e302494816ca00822392a362402bc5bfe70e7ccca9ee7e1914afc360933a38572cce62ffa822ff9ee19605686a64a0e1bef81ce133bb628133953490301a92ad7b43ab101dab7271d614eb822a3b2b556e2d7511d145dc809fc0c8bff7308ee47d43cd01868dd6d6749d99a6b44ff8559521a84a1aa0b0f7aa6f3468b2e1d697ba58b747f21e688f6a92e00fcd86a29c496038f57e93a67c9da3515be28696278ddcf6dc03fd9203444723fff205d3d77104850d1c03fb229a13f0ae071cc7d9373907580b9aa8463cef35fe79e73fcfd77d1ffc1cb29f1ff13ce2243d688ab644b8d5ce2d00bfe9f95691f3bc4ef993cfe9109154102d17389ba5082305618bc94074007f1cc6834a1d74fcf8fdbc067d714dfa7a72610298d298cf904e560715923c9e4589fee21e7021921386ff3e45dcfc1939f251d34f5576365048d214035947065078f6030c52b30eba500447d51ce10a68de074dd7ed97c5685074deecdd4497cb478f663a1effa1df3b5b536953cf2f4c34d73918f9def29c862e673475497020a5ed7133f5dcc8f0af2634476eb48a689fc4d709092e9aa263e9d5ca0a68d66bfc82f600f1096eac9aa2b7486355844d41b62f20915217f65b4d70c0ce99e8f9d64c539a936fde40c65553cc6de19cbd66759018857ebfd4f3d2917e7e53d496c6bc93fd3638c3b9cde1208b08fa4705f2a2aee4c02c7ba50556f39dddf739b55efbcca403d6891cb107a9ef9a14c88ef81e1bd43e23e101e933d0f56fb233bbc95ab9f617920d5eec7393c92ed5d7f76b288b741b343776eeb6972ed524ea7ee76719f37ef17ecf43cd61b7d00c109af3bc29f8f9f132015fa7ef57b5e3be8baf6bdfdf0fa0f9b63b079d37f76cd08ab1bfa271750c3ee1d06dc4c662f04371003e12c35d1fcf6cca1667b3050dc35dc0129dad8e7529e00dd08fd856b1201003363934caffa43a1ddbf6befef69bca813e85c4176c1b0146039b14e968486cc15808fc553cb6147d25db55d5d304a4b4642cae246ae039d2a82d594646c8d4d287ea34bc7f04a38612c6d212d2d09ae7d26604bf0d92296080aca589f5ac0a83bc94ce4e17a079726ba50d72d0741dfc403f35c53936a7d1362b1a220f03171a16048b17acfe81a0a821963786e040238235d084d9082789f8f773b5a526ef67d4bd5e60e09e36c82db56a54900faf07af13ef812f8ff0f7e36c245b953b3fdfe49c17d0b5cf0fb026d93751364dcfad4f4707a029942c71f92085191a0cb7f2f6de1fd83c1d2998a7e76dcfef038dc58572ce80468b0d2a98acb4ef3d3ca10a7b0d1800ed35690afe7a9375a4bdc767f1cf007f6d4da535d50657e33112c88e7a27bcdeb3930123f5b86f0b50fc9faa97d37571b4fef57d1695a6efb378f5ecc42b7ca75e9217f80d06beefdedbb7791f125d27e0cf8266fa9a5d09fc26f376d08c3fde3f488632225f0ef80346e09f77cb2b5d30c1978986d168d004bc6a2ddfdc21c406d67abe83b09e2a20bdbf43b6bd5cce1907eaf976c51d29ada3599ddd9b57d3ce01f962ff09be93343c84654b9c1ee04dfa8dd4d7e7d8701f3e680530cfdcf3991b8215836d6ee74386c21ab39a5beb421fc7d43ef6797e0cdffee075e1fdbcd7f3ee37a006d9f6d0a8ece787d8bd0fbbf161373eecc64f6737e2cf7c3ec5fb1d674f79f2e1e371621b9e730d1e0cd910031453b070c37984d7f0604f28b80a30fb7b3a7fa5f014371f81cfe51dfcb63edfa0c290a0693ec49fc457afe13fe0d77c130f4aec3bb4adc4c137e7db467c45a9310d06901ee169be165998ec27e8bb161ef090502c157e66ce7067ebd01e46ed3bafdee0d71951f4bb007bcc98f79d71a3173ad733f547346d8bfba5eeae90eda20e427131183e42ffdb35dcfadcfbec65a1ca9dac097e886a65a30bd0d08df0020c8a5b3a6a1eb0c3ec730ce875ada2214a0753940a663594cc4e86ed7ffc3ef0c6ff38627f446e50c56d917232aaf8cd70a51553d8fcbd97457c6247d00ef7409c6e23c19e4ccd816a393a9135d7d3f42bfeb7ada1a1b0e033c7c5125e5c7ee5f9208ac791e8ec10bde6b5a5460f621e54906e7ab32bc391e81c9b62c782822c1838aadb20abfb60ab6761b052901384f8686e1c2a3b5369ed2087ef1c8c95a20bced6090caec26b2e107aff547bc4ade792edebe8624e0abb50741a7c4efbb6e5fe521de450d13fc323710578f7e39159ced144c7c2e7c4e70b4ef409e37fcba8480ae1685d966623f9150fa80e72fe504c5890427e550e1f137b26c9ebe6f739910b7c4f1d1816a80ef059615f531feb1bd5857c0ce43bc267eb7d8f1a108468188a120d573aea79e9a00ac3fbc9ea24bc1e83d1e37256437976d43ba46294cb3075cf945b2bf0ad613ffc7084f2e5fb96aebc5c1ea7f08a9d2187d3c1399859b82f9cd5486987fca8a600b9d2b40fea17e79fd651e1f7d114eb6ba3867178fdcaf5a4d1610afd71e406850aa77b64d02c630f06f7920aa6286d0c2154a01cf231416c5093602f866b6f386b0e9de1d82d4762f65081ddd01fecfa213c12e147fcf018de3b7a66857a66257b135e99819e1ce27817da6fa467f5f0f3485ef7bd63026dc8c56d8a542e6e23823f5ecf5e43da3b9035f0dba751f820891ec9e5ddcb1937805e3ba102eb908fc7ed5ba62821f9f8a6984b539caca9460d27be5ca6c9418d0c89ff98789d5f8f43e39a543c938a6322f14b20c79a0dffb9d7ea8a20273ad0e9017d548321fb3c583c05839fe58fe9d3b29df8f7fde1d6e7bf7bdab353b03177ec2981eec885eea52956564383b3638754ff5a597f1a5bf978599febd47a43ba06942fef3c2ad79cdc4b97cb338dae2bb5ef8c7366b85e2cc4b3c88624f585a0db1c9daa737cd0ebf9f4eae7157f3c767fa5644c4b03bce7d5cb1c387d2f317e444abe8bce0cfe1cc9da12f2e60d45b2748203c07f003586cc77800750f3faf722b1e5d83ac25f22bb07329c39c221a13ca4e05e509f497dc7aeecc12f8ad60ecd6ee6b309d49332df416bfc4df9247c7f8616a37c8e9e3fa189ea69438dc2aa31f7a2ee17b3eea82b5566f3c8702f0ff2fc7aa138393e908b336c2743dd09f2b350fe9525cd7f6f5453a9b7aadf680afccf5bf031823f1eea42a938671aa667af648c7fbd4f865c60030c699fcc07efc3b9ef996c27b0579887a2ec68f642b46842fe975098e8b2b4565de980684b69f3f1d375cfe76e04e535d929cea3e25b7157cab0fd992ecc687b82e7e2f6a1265ddcb886e74283f95f277dd1998f943ed498755517e25a8c4eb05f16fdd772ebdbfb0ac9b726f8c21b38c6340719b79b188203b126f0812fa121952eeeb766be3d01d9033e55f02d6b8a06cf009fabd514b59c2eee26aa0b39d0ce56cbb72c43b4962ae4fbc99dade1a23a63b6ae9ee7c2747fe51e11dc73e179617e75f4b9b4d694d659bf4f6a10c8f1bbb378d8cf19e791256c93283b61dfa81875c47df11951be36667dcc5aa274fa0d7d04101f7bf978ff8003cf4171eeab7277891c6a3690af0562b9e8f7a053026c22cde2f34afdbe202b546b33ef79f15cd1abffb83716851e062876dd9811bbeb2e6bbc172e8dca3162f4cc89ade4f70ee1a9539c867a785cac7352693a95befd5c453e9f579aff6b76716edc399811cb0a8e755f86150127d2f990082bf6e4fe6c843059117422f86bb1ae02db11b006a269a837427401cd2af54f56631d335c08c54886f55677981da6e4c19ee6cd46e5fd52bc98e8d7a6bee7cbf8187f475cdd4a89d55dbe8e70d19f91f955cc3dcee23b6e9e8be2b7197aa7f3782d92cf7c3f4511c94e4c7b247f01c7f596c83784f235d1208120e7a111e8b5b72aa2d9ac276bcbd911d47988657be44a53aefe392ceda6d8ba67e361e4ef23fb86748def1f1acb81dd6634a48321968f281fc605ff07f229c0f0598c817d7f360c01d941d3f5f16087f274cdb01db7c598fa1c1b8ecf76e3b799d26db5a0897ad2def13e8fdb367b9f75501f3a1edb2cc5264379d5e7d879dd06c71e576faa0bd8ab64d48a909767a990b3867826e04d3ddf04fec2bdc920268af18ed71b0462d4d991a2797d9bf2cd898a063474e87822d4071c4602a26bd26b6ce3f51a09063b3445dc903ec86fca19027916eed1867bc219f98aa30ae0c36847dd9bf49373f1bb438ec3d110cad3915ccf22def19a709f6bdb9da7f7e26409873c8ed42567d870e7da6ef8fb5c3c9c62aba5db68e9b65982fe73669f2ea77216f2af5faf071dbf163ce4aba3643d636360bd564bb79798f38eb39bceb69fc8057654a41fbf7ef99a78df21c54eba366771631c9a5ebe1e873f8f5ba6d7f63ce710175fa27ba7de007fbd7a769f421a7ae3fead7307f231b11c45bc768e6d43f63c99b7ced76360cf30eb27f9e5f08ed0db000d692031221a53a233e5b1bdef445b533cd02c445f4998e44ef45543ef753c2f46736e6cc6b02bdefa913ef7f31ce3fa60121cfb4930bc875978622f17af93177370e00b42c781ef8a9111b53dfb79105bf43053726d4f5abca5b64fe3230603249f994f6f8cdc93bc77bd814c74369ad7b3d5cfddc6fb40e5da7716aad2b2d47cdf31a0e7b7e84c797c97ec997c842e0ef601c9c616a91508ea35b07f93d2c32334f4aa03c3459646bebf540f85fbd120f97d943ff3cc3c0f5483e7f10a8d0d533161b791a2af223160d0efecbadc8ee03e6f55327c2a6cbb503d93895de6f7d1a66bd6ee9ea71dde03cfbf38a8ac7481c8baddc4cb53ae807db8550507f23567743f61c082aad29ba802d886ad704f19f9b7eaf9ebc7d56fd84336ea3938667fb75e03bb11f49b1fdced7939c3ddddad27e3a36fc3a36fc3a36fc3a36fc3357d1b526c3de897f7d9ecbc4b725393f84dd25d670f1899c9c5fc4d6c3ad69f87b0f3e56bfabdb1f37a24f7ee8a9f9b750af371e68746f91c861416865c30efb9debc146a7d88e6bc7ce8c24413257724172176b0d46d6a0e8b1b9a79f1cbf2a5df83f79ed0eb2378fb64cd943dc1ceb9a1ec887be2eaf81c7f06cb44d57b85e4252be3318d27d558e27546be674ccc9ec571448ee31eac948f23ddb791ead388f2650c2196db984dbe41bf42a1be19294bdf7764d81588f91e75a1b0e494db91f8a8d908f7b2463300d03c9add44931d2f7edee82c75d7cce9621d7a26428c6e6bb8a6a5bbce174293106f831c2a43286fbe119f97b05f6930ec51d19c66d55a9bf23e3b021a1890772f1ebfbddf978fced85362c7323d782bf00c8879ae9aa2b4c431f623f8984d796f41ff43b0c9f57c6baecac5e598c48921b6da80dcbbce56b749bcd4efb32a68839785ae482b537476ba38f4f7d23870f43549d11ffc35ca0973006afb108f4462b63959dfddec2898d7c767dfd8a54bd7f44f990d40e4d781d858bfd9ec19dffee1c1056918e1e2fc375e9c4af325c7bacfc6ab04b386721e115ebdd8e6e595a3b44ce638fff43cd5ea59bdca7ac359f9bd3f48e6bf087edd26dbb8a9b987f87b3eff86d790364ba5b667f1bb8fb9476c1c2529178a9fefb8792e8adfd858fe393c17c56f925c5c825f95ad49a46229a17ecebad0fa7f801d3e49bc99b5931136b8624dbcef908cbdd3736a92683b9a9e63fd8990c3de2fdea97eb6e761ecca20dc47ee944ea36d4c865e1ccaae4403d5e9399bdeba68db8eb597a8bad9603683875bbd599df7ae1777f0bc19c65e467e41faffd05a2bd85ea9cc34595beaae3443bdbca9d80b8fecbf552d42423d0d5f8d6c201bb731b9d577a92527f8fc1ebc806d92cbe97ff6eadb13416cd1934d506bc7ccedbc372d7b360355f77dd6bbfe76b691679f73626f1e5c1f85918e23b17c30ab777916d8609e7f6ef0c1bdd5095ddc73369c5b3e680734ebe6236cb233e3c71fb5c6c05778dfbd95d63003f96ecf9b5716ba5074eef73ce970cff83cd481dc6d6d2eeac5fa217e115fc73e6a101f35888f1ac47bd620e2bd8ddc03fc19a7bd88f426e5bfa8ed93ec87804f6330659c9fe29df1b35f130b898b6be8a234871c0b58df48e9179b556ba3e77bc8b622f1824f87ab674c7cc3c7d7f54d333af6b1d26413f890c43726dfc4e2d6acc26c2e825d672bbf1ede8b794f216746c7fb4d9ef319ebdaa3f8136234988e4ef5d8e0963184ce42cfb7b2b1cfaadef0590fbcfac0ab0fbcfac0ab0fbcfac0ab0fbcca8f5703ffdcf21e3dcb87d89705b8f292585b744cfba40726aa83a770eae45ba3935385c94485d8805c98bce75ab5c1b0b784da2ec861698aaf900b771cc9e6067aea6bd5ca51152c87b3f633992ff97911f80ffbbd517c3e32fe7687f5a6bd2f59ef2fcf8d4beb319890977cee2c968bf414f50ee9323b515f5d309b25515ff9757e41ad3335a7274c5fbb892af76730c7c438803d98b34678d69b9eef2f20f60bb319d4c1657504942c4c8a115d94fbc65e505340fab0a2b8e1ebc7eec3b9ef99622346c99c80b613740cc53f91b6f79d724851cce43ef9d527b63e2b4b4f7fc3ef2f617a3a68e04788cc0f3515c82135c06702f7596a42d1ab016c74a623d0c53cb9551fe91fb1cfd43151399e0f9df2d0290f9df2d029bf46a71c70eef0c7db2ec11cb7898e67e25e6cb30c2a3b5d70605f0e5e6f31f04fa3bebc0792bf6a407f3141656786627f3bdd47ccb20c61827b3e43de18d56f83836f52f8849b2f70bde6c91e05f92d65d4cb106614c09c63ddae201a325cec57c857c09fe07ab609791ecc2f4335f45b6fefd07717aad25960bd0bb341e8de6be97e07a24f63e4eddd72635c746e57fbe6aea8cd5c407ca069a3be92a876da50a4a5e14a74cfb190afc4545acb3785cab5fb85b9ba2390194a2b0b73259a8df36be852f813cd6f247dff687a04bc4fff5b9bb72c4df0fefe0d62430dc9d2abbb89ee96c16764e1f902e0f745b9bd9adcc3b54b8cfe4c96c9c9bafe7c7f1ff81a92e63ffbfd2c3e781f78df3b191fd27c1d9d134cd15bf0b9ef0773bd19a86f0ab2fd3e5d7f9f777a7eea0de8fbea39a66936cbc99924af9bdb862117b265d8b9b531f387c5d0f7a83c75c8e559839d129aadcfadefd27155229eba2106227e3f928f18ec5b3a7da6d265921fb7f97a462fe4489bc19b47f14ddc3b9a505f7dc3ba09d19f37cb09b0b43092fb3955defd9a1c5dbb82fa151099d89f4b2bbdfab2c0f9114b2c43105d81af7504f368146da9811ea8a5d3530a1ddd2fc73c9073db985844bc6d4df63dea3dc91e10bac576405c3c91ce4ff8057e2272d6a7dfe5f30f89a6bc5f35ab16ce2b997971d201ea7b17e523fad5fe9fb3689bf5fd70e8efcfe7d7b14b91fb7b277b359ca3fef17514a1e75de3f7c435954033642608beb72fb30f4db13e35dcf2662c67ef4bd7e15c23bf26f4ec77be9bed184f6b4cbe45d45cec905f31ce1e4cd007789d91ef795efcd8adcf34d44ba17d0759ed3feb0abc81fc10302b7ad514fdfbdd3bc65b21b96344ee227f50be935565da1ff83bf932602f9b6bf08bddb157de14e5a57e6c9d0fd04af6e36b7ccaf09cd59b8c6a313f3857b203bea4ac319f5df61c4c73b1349350f38de775c6bd77929dc26daf24d92dd28dece7a4bec737983999268bf864d2f9b2e9b3cfd84cc692d1f65094cce39fcf92e4fb39ff79dcf3597caccae8cda82b653ecb4217a27bed455da91834e93a9fce1266b3585bb0a9c3baf0e58c7749b7bb93ae149b3ceec2f4193e0b9edfa177958bb06f07bcef64664bb93937b786bbb239eec385bd922e6ecccd31dfd414ca87d1819205d3ddd688c0b9296770293df1c90af6c2ba8d398be4df117d18e6e7f8df30f6266d4bc6ec414e77a3cf117f9e247beee58344e7fc26834f75784f5b00c51979757a942eef0d2a287f05fa8fa17b05be9a10ed9edac4856f5132e31671d49e2ff790ffddd7c3bcef9af6ac14f9f61fe7735405e8fdf7f17ef31e3ce71a5a85df0f7613532ce771fdda5c93cb73c3adef46907322ef208e3787f7c5f17154bb033443d9bb77f63976a0ae8ef8646a60b3bd5561ce9ab9c1b3611d5d300faa525900a6d6911d66c2fdbc9c0611f22c41ae18011e383e68fc5c1a37101eb8836c763a400fd7f8d0dfe1bc3498ebd8686d4dd79b71eee51f162d5d2ccff5bc74bc946eee29c787de9e13daffd0753df8e14c7e607c2e1fcf17952df0a05eadd474e12afee8115f21f0843c737ae0e7d6c4e1e7a17bbcb7cd57f45e84fe2f7aef075d9f47d7aa50cec10cf6fbe019fcacab300d795faf8e09f9c7008753b623874f8cd839d13cc9ef9b00df570dfac4988d56d1c7dd7cef98f68c649bf43f2f168a7d831f4fa77d2faffa8ab811ae81ff6518da7b3ea147bc9edf4f4e86632be4fc7fa9bc34446b7b179f85d307bef0e6495d272f490f12f033da5077a6ce67135394569ae2f54eb8b3bc1c826ec2fb48743da20f55ee40ac38a7cb2df0fbdbe341251bfaee6f186f8fd8838066a2724be74897c7fd8e8bded82b95feaa5cf4f8a8ff79d4ffd0f53f27fc128541d8abdb08e6a3c36c43c4eb88b6a42327ef5cf57c7279efd172bcd859271bd23b617c9e4edfe4aaed4f67d1db154195f739c0da23b11cddaf3ce94ac7383cd7c5f41a75751b2f7ff406a03b724eb381fbeff872fbccd85b352cbbaf7dc733e997bd2e8dc991ab14110f0a62736ed985daa37f0c0d408ea7a041fdd8830ecea283dc56759dd53f4816ac75a1b5843813c66a137deee5479c1bc765ce8f0753729ce399715d9616c3e7c8f7fb73e3bcecefced1a929715fe67bc4268bc2bfecbe45c68199fbd17e83f0faeeeb1f40b3703fde2e9306efb5cbed31496ab571ac2dafc9cd892138593cffbe057e3ec32d3a6615eadea423559f78375f2cbc1ff11b5cf2ae69cf4a91cbbfc2af9033dc596c9d6cc273308f44e6cbe2cf7cff01f635c4e54583ed7d8f9c68c606bb3ca6506936bc1cfa66a30ffc0ff5561386d6efecf33a595b9003e1d51da0da5f53861af893efa6e99a643df29fe78b457b79877a94213ce78ad857a529424c97d4714a05afce18669979fcdc6c980b3ddf719a8d0ef817212f75da149d23d008eaaf207b336e3c9f993faf08d540a37b2a1de7def93e922815cc5ad02b81caeda168dca7b18929585b43184e0c01fcc23083a93d19296de0d7aca6b4d678a62bf2b705b37aa5825945f7847aff8fe19141653d522c47ab656db3d1ca9de0e1f439678ee94a87b1d25f40ceca9bec6cbcbd686e4fdfeb1672bab554a1472aed13f8a01844cf756c5d74b65abe6519a2b5540f95576f369173bdecc6fdb7e1dc0dbbb2d4ed4a1ef28146a88f75c7c1e76e8fe4d66a44e50bc14c24fc9ed85fd281bcb7a5ee7656a60cb519858904fe2b31b7d5446945eac281d6983edf88fe4cb948cd5b6a159bafe5f75eb6debe373f71ec35d125486ea8201394269a7b650ad612f54e1c548e9ad24372463dbd5fb8ffb75d99e2dadfa9de90a04fc7a65947f5f36b88f543be3ff0f208c92176cf9d63b3216da859ccc0b7dd61b65379affe921aa08fe135e82f22947386dbb947dde380aee93ffdbcf08db94774ac6540f50618542c23dfd9aa82837bb8793de1f15c49f81eec01dd1303fac6430ecf027291c6838aad0d801f2bdb915ccc42dcc6144bac9f17ec06ab29769644f6bcfdba1e3e2496536936a8b313eb4743284f47723dabc97e7f1e541362a0efa17aad40af54773e4fe972bdd8145b4e533ce907c4d48640fed6eb04f232314ddc13d345f5dc25178b9fa2745876a4f4571f5d3386b0f9e1639f61345a5be37ebdd167bad046f3f7afed89c4bb3e8abe3eb6e66e0e7ab7f8f37e7ddf515e92d0f998b37bcc547ecc547ecc54feb4339525e45b305cc073ce417bcc597ecc59feec7396dd00efdec136801a142c93f8682f52ee0f2a53ff3e36f23f1d470ae41d14e7c84e16a58d56ad404e0fe47f20ff922a1767a1de9d8d4ed6984bd03310f834cacef46715dfd306e805b828b001289bc4a3bdced28018189a5bae4e34b436901bc309f63559ba0b7d022bfe5c14c8cd30e54ed6545ac83f75ea8f80f77afdadb03ef8e74ef1cf6b14fed95ef88c3bcf0cf27d6217d91649fd48f16791e7823ff3df0def551cf6f47ac07ebcac68c273aef2cd814d4cec629f7f403ebc4ed47cab6834fa217e1fc9a663e47b134d90365ece11cc2e2f0b20fbd5b03f2267085e9e3ce901accafbb99e6f2d4dd1e2ca99bba5cc1888f5e388f2611bae44e288e43c483fe3a93187fd09f1fd5213a4a359adcc35f061ba9e6f41cf6be013b042fe07aadffc089e097246841a79273b12b3f7941db78bfd44ec2945d351bd7be93547623ee62ce92b351619f31ec9d8273556794aa39c7cc5c3671d4de92f7401c775021f2eee150d7e57886f3b8226f7ceb3bdceb4bf2eb6c1fcb867e43e217ff5bb2c654762d9f3adfbbe459f0f96c6a132837ed8a62bcd38f880bd92f982bdc271fb385be7f6fd562e7ffe87f45f61fc4d717d58de07afafebf6b4998dd4cb7117961f71f9a7c3ac55797b6fe63a76ac4d1a75952272a0825c36a7d3ec0db2c7efb568bfda35ebefbcbf1e6ebafe99d3eb0f73af6fefaf42825dce5eb8be249c2bccf1db705d8a94e27fa0e406a6f9f8ef32b1fe289d432eccd7b4fd7f7a5f7c3f3a5786595f782d513a0cdf23d2df42ee4f7019ce49e83642365f5063e396c3b991e97e8654ff42b46fafbcba26ded3f762706466cf4117f660bb6dbd9805c43d9c398a6de27ee886583f78bd0d52f4498afee0d617a01f9cd6560f6a6a495cd3c3810df4198a2ba9ee70a22a1d348f07b014fa3fcf0f897a50a4613b4267d7e675c5cd25e0f5d19ba293d5c57fd2ac62f037b53ff619f3ca41cfbfdc2d8e8267b03c620d8f58c323d6f088353c620d8f58c3e78d35401e52be82fcd7aabb77de948a65ccfb45dad6eb36d2de95df9718d019ca49daa13881dc5a69834a8f60311e9a8cd407e00f9461e617cadb2218cfcf5b63fbf51b0794eb8fe785148f90cba72a3df01f081adc2b783fe86f9125fd2e0c513a7858dbc799c1bc25193001e07b78973dc85fb857563fb033cffd3cdb0d072f24f3003fed03cdc7ee3be5abf4671ff9d85e90b25e1c879999306f4fc89e1802dad3ec48ac67c1076bba43c8a3391a62196ac86de83f65366678365bc5313c9f986f5f046707368587dd0d575a01cdc1bde03c19bd0771269413cca3fb3e0b86c77652746ecfe06330e99d73973e2ebf87925b183345e104660f9bfb38df55aa6cab9e25e7d2e2270382db2ff0f546c6532ec589a7f78ade1f9a6f38d6cd1d2389c8b3f46d034f0ed5679ae8f8b152e04b2cdba0ef10c49819797a76ff6c5aaea69f3f471c84be3cba320f7a5eda45daa44e65f09eebd453f82f825f237884c60b69181d7f8ff55531671d2be3126230c83ff4263bb394198ab08fdf7bd993994667c763ba8d049d98167f71c2b8a759277ea453cc9c7c5f466f3b28cf9df8ca109ef079107015e4bc379867a37ac933ec524e9bf46c7b14dba2d1fb82e6c90d11cf21ff25e0997a96b2b1011f41cc85c64df03de811760e5fa6f3e4ddb031c8d6fd3df2f3872847fa3aec8bf3ac3d8caa89ced110f7d67880f34edcba30924dcc4ff78d854b9ecfd2f76d341b785f11dd576623a0171172e1eb28474885797102a68f74ba49a6977f465d16c95f66d795e42f48f51344f9077a28eea76639f1c92926b14b31bc1afd1edc320a64d30cef03c9abc0f74ea2c35b61fe843ae9f47a6cf0fd90337c6d2d75b71eee47593d951f513e260d7a6a109f3cf12b85e9126abdd721fb2d8227b96559ccbb25c8361e59278ee4bd73d6ac4c1ebd3b0b66f3217d2b6aa85f87ea4af07cf0e5649b0dd3320e15b06ba17e6f0631ace62b3e972aaabbb1b501cca469399a08f80bd50e5a9e9e47bd5bff60e7beaa4279a6bd17507d1bd4fc8dfc39abdefb986007c39c729883181537f3f4a78bf6a4612e34b9107a7f5cc73a85ba39ad7aa6ef9a83ffaec60ce442fce9ed39caa7f6fd091f782e541ed49b5d19e27a4232c317c92c1f8309ce4aafa2fb40ddda5213506da1ef9741bc35803a5189c40dbc33c1b5d2e89ce5de393886d1031c7c968c6b22647bf0bdab6737333c9ea05762de858fbe3e62a673122ebadb6ce79333bc07bffdda99cf9c3a368a1702fb310adf56cb6c9e60783f98fc900fee05711c2b309f3dbb360409ec7098df00cfb574bb39d1df97075d2867bb83565153eed0e3c4e9148d7cdfd10795774deee420cf733ca8f474980f5db5de87b997e5c5d8cdeb7b42ee7fa4ee0ff87cf356ad1c4d946357473216f59520bd46e41691cbdf075267d8ac777acdda64d7ae66f7df6bf7b5378679e7087eae66bde340ac1fcd45ac57565093feadde791f56fdf91b1fb6deb4f74de199bbf9a27559caaa32bcdf2b9f1ff5d21c0c17fa7d419f9a1ce4ef7bbaf563eb047cbe5505cb32dcfa14fa9d7835299de3bd9e8ded065ab67ff03ea3b8d2411776bfe0d92d4b15566be8e5007bcde3ff8fd863928b17578b92807d7e13dfe32c1457be81cf51da8c400e0cc2b327296ce3f7f5c0f558f6c895a626875c66e5558aedc72da7c905f29acd4940fefffae56be27d8714199c8a576e883120ef746bcc7b6b88d3696cefcc744c7e91af6700f221dfca36eb95832e20bb8c8f16a369f048f22a47e4be9e1d857bb75402b91faa010a66a81a586fb7f26daf4713e46f56bdbee39053a7f1e46ea5e06f6ebc8dec59a2b32a3df2ee7e8e88d221f623393bcf6ec73d7e9a0d7f4f43b53f8856bd7d213a626e1c3c5a86bc016f868a04f153e7b3c6b4893fca20313bceda388666cf96b967c9dd38d91bcab5e3a0f534ba9f5d1ca73cbd57f4fed0728847df9ceb9f08f24282d834ca83ba38bf32ad475335825fb9756ea43f3dea4274a5e55b5b537989c4bec37aab3bcc0e5372124e314c540e469a3e88f81eab1b6e807f2cc0a2c9798817f88bbb0d5eff15b77f38ca2fbc80f33470ef41fa9d38edd964bb16f5495c919cb0803fbc59dcf4bf5dc3adcf110f575f1630b3c3045d08b5eb420e7cf7d0f70df2970ebab074d43cf82367fc7ea214fd74b69e2217e82bd7823903075c6f4ee7c205f6ee47ef032fef73f07d626d0ff97d946ec39fb1390eb17a0ce38e37b9b350959605676ab8f07fced4387c3c0ef3fd15af11cf3f5e1113060c25d697fa9cf801a3d6477af105f82be4f7109dac71f070ea5bb5b23395d60efc3e6fb89f01e51343b80ff8a529ee213eba7a9bfe22bf4fcc3e22bbe2d5cfdd25fee0e948ac1fe0bda156147a38a8f976e023f5fd43d0af41423d248d43656936da5eee379a5f291d0cb17c84199d2af47d68507e271130647d6a564167698efe6b7a1806f726178f3d3f276bcf2d237291f629b94b57fb4ebcfde578ee353ddd08cd7bbcb836a097ecbc773b3f89af9bc3588fd3ff747a7f3e3974964c4a934ffdb05cb9116e7e65fb69037fed90bd45db849f1f37bf86e51b913317afef9cf73823b6f89f91bff81f970f1d75362ee8c9e15a55507f84c348593a1acf5aaf91a3746c4c9020df3f3b5234f46f5d94044dded9276b612f56e60e9adf62bf8baf9bf9ae295d17ee65fcc1fa87da37829fde640f3f71ea888bf52cf83c4da5cd518370c5beba682d0755361dad9ab34ce84b926ff93538cdd37767ae539ea9da71dfc5d787f0543d678a16f498f982e5ec1acb05a8c9fce03d2c3a66037afaacc0df361d215de8f50de3912957d088d76f4dd6567adeb81d268a5a635c1dda67a48f470dcaa306e55183f24b6a50f067b17be0cfdf84df06b52989b9006f4ab4ec7993d7ce389cd3e3d317c468a0bf28e4da8f1b15cb1427acec64ea2072b846e24416a3fb41ec53177bc1bd6615aa9e86da6fbc7726ae51c6f7c234c4994f1eb6ebefe8df0ae2de6a90dfcf2383a27dba8db81831aa2b08f9b498bc1d3c0b066a7112f27f3864548a5cbad07f15b94f644607ccc1db9bf290999debd57d847d5615f2ee3635e3076a74083f84fc775e4edb3dfd53f1f97b0c86befd1c6a37d8634d69ae51de699cbf858f37629ecde777e1e8d730c5e730f1e9a44ef23e4f7f57b5939f15ed93a1f784f88c4f6991a22fd770cb6b7fae078e071a6e5900dad4aa0c5f7af9f754fef567cf678f587bdd5fbbe777c57c19ca61c1be9d51c4d905f2483be842d6efcd8831d241cf6bb06733b6f78429d48f74ed004f6e007b1159c549c3211e4dc31a1cb3af43762fc40c9ad3e4fb05f73ca3c71cb93c7e051d0cbc3f35c590ecb8e85d189914d7db6f6136fa3be3b8d8be099aa3355a96aebc6c34a12c9cf38cb3ed82ea4dfd9a7157c9a815870c6d83eec9ab4a3bd45f0d744ddf9566c359bd33ac3e6625c7cf4ab6b266a372fc6e97b62359ca6a76716bb8c6d670b5e95bbee5aac7debe3d6dc6e9b7b8ebac9c9a9bcbcea8abb6df7567afa8b70ef8830ca1bed21bb3a0d7ceabf34acbbb6f75491a0c7b4b907980dd34379853389c69b54eedf5d0fecf9dc1fde8d979ef9e9d586f637c45f3632aaf751b2931a8c83c351f677c87d87bb36a89a6bc5f7dbba246dc9b5da8e574718763d711b1ff5f66f3a07d9d7c7b45efb724b88af79dd39e99c2abdc799257d701073578db4f44cf7edcfb17d0f53bc4a8ccea8ccf8e898925e3f7273412610b87f23760aea7a5e65b5b7deeacdfaab89705d47a363ab991d2b75437aa3f81b3815e5878366156457e1d943f7634e51cf80e8b505367e47b3c716a2cff63f68f1fb7410cfa9dd8f3a8f676f0b2187afbb18c98138de44b948f249cf753df34c5fad470cb9bb19c5d913a5aa84736f212cc3eb121b719ec4e985760e42b2b5599a199a1eaa082e206cd06d9c7f2a1d9807e3c39cf272c3a47b3fa7bfa19de8e91b230a93f005e67e47be2cf7cfe4cf3dbf979456f90133f8719348e0079467eccf0647f12ec87da3e498ec7c50fe8bac37724e3507f36e4376ba31ca7dab53a0ae67bb42c90f9ec5c5d42abcd4678fd4db103b341e6746c314ccf610c09be16755059e0982c65b3f5b7a38187c1dbef2f9bcef4e5f0bd5a46357e6f33ad35acb737edf759b1392d8a03a9f2fef6fe5afceee964ab6bdfb93ec1a1f2cceacc7ebc7af410d6a31fbfaffd99d31b1eb2fbefef339e3808a6ff337ab77cc0ec91701c329dc7dee430663f8987e0334e923b49710cfc99cfff78bf4efd31547c23e0c9dc31cc93399c779863e6069cf8fde37c25497ed614db76bf35a37a0df0fb52b9b124b9ba8d973f7a8397b92e96f3ad4385d98b0ade8b1016606674231e5f038f03ae3422740575465cfa3991c6297a61f691f94ef2ec075d2ec3ac13c8fb61cf880bcb9d2d7f18bbbe25a099e84003ce7fd2be8f44673ea2636877a471efd9c684c9b79ae07cab15602e34bbddeb2143cf530f74d720c18742f687e3ddf0b924ad37cddf91e4d388f75bd4f621594961a5b87cae60ade967746dbc3334dbfe8a58678dd4ba22be51207f7d0ff1005bcf435fde3af4b66167f0a37e8b413fdefa411b548ea658867aa0158a35081dc7c8431de30ef5f035c1573defac474a3f4bf479820db583f951aadc29b233fc496d00ca97128634ed2d751badcf6a8a16ce370afc9f9a585ebeb9e5837628eddbd582d0ae350b6fd9626b98eb6d86b997cdf7dacbeeed5ddd99d3d60172a3df67cebb54adbc0fab2f8576ed65dd99be20fca5be177e99fd3508e32a5277f30f3abf937e1742fbb7aa69f8c7cc0289cad76368288768e8fc1cbad7dbe6d0e1b38f3cbb1bfab4189be634a6ff41f29ee1f98974460fabb4ba2d4d84fe2cce0a7c56401f66b5b2d5dde189fde6ad95c80ae4c7ca427f3590ed90ebae0ad2d110a4d99b57e79735dcfac610724bb3d1a7f261627c418dca61a468969a9fb1cf5de19e9d90677ed40634a673369effef7532c27c386af4b346a3fde5ed509ee9c26c03fdf8df8eafbbb7a9ba6b4f275b4f9e17df87d50296e715a13d87deddc5539953fb4536f72c2c2b831c0c1e395fde688368391ff6aff5b7d0b704eaed0cb73cd5940ef456c0f596fd832a178fbaec644d1163e7703f8110ee331aada5e9d6a7e393e7925ab5a0af11b1e3c18717a15bef69cfc7d53ef3ca41dc0bf776b9fb44c63074c697f71d8197836724d827f495eeab4bf3db9dd429f1c8a7545935a830fd5f2b4753a9a0be2254af8bcf3f8369c660c5c06f7ed9fad2f050d4fab8edf3449cf44fe881c0cbe78c4cf3f00eca998ddf9318ccf38bea4aee3c9b0efaea687eee637de5f935c14f0935cab7939751cf1ec91033350ff77ca6aa68606b59aaebdc64ad49b9e9c13b51fda2021f2de45ae3fea6de5cddf759c78bed47f03ab76ee0d70b513a819d7fc5e39f48f2534831b9c97ecc5e25d816b0c6a1b2555de8a171c60c6a4e5d70b61e60f23dbdbe6c08d35dbe265e1dc021ff137366926ca88046fcbcf1647b29172b53afac878897d527f622a269a0c99556ad140cc099b5acddf3fd18cdeda9df10d702607e8cd12fac4ef17933f6dda87348e5c9745e8ce4c1f0b32bc8f7d17cbda04760646e447dc3e6121b8a64e91ecfd3f6c37c0471229bced9eee077423e3bd483bc4bfcaa5eccd3fb3fd473519a856cc657e4b79b0c732f13e2b7bb773f9048bb7c50899cf9d7930b2c7fefa1ef0fda33da97269a4bd395362979f1dcfb91b61eb21f9f286fe994ee28daa7e4936f33f9b1e5a8de9c1167c7cd6f31ef90c07f37e1c7935a004e5f0f8f3d35cc571c5500def3fb9547fb0b78e88f83dfd82b85ffd88b9b1f2fe34f227f2ac4bf4e74f2bb214a53a64e25ec97c1bdce136b30a04fda47f7f43fe5c1349bcddf579c733bd16569adbad201ed8fd2e6e3c7eb9e4f2ef41ed00f1fe1d97c3f9437813e43b1bae23c898f63703b47fd44422e8ed3e9bd4db12fb0a11e8cf8fe927117774c3ce9bac8df1077a1583ac85cf0ebf9fd15a12fb9b74714cfc3cc52a0795faf787203e660f74cb9b5a27437f1df4d24b7bc823d6fbe3aad66bde598ae83f2aa9bb5d7471efd238ffe2e79f429fe870f8fd5f4229edf7c25cfbf55fea25400fde4f9e7715e26f4e20e7ac141ae554e87dfb8c6449bb7b63a8ebf987291ea9102bfef2f0da1b3d2f390dfe5cdee60ecb67bc7ba2196ed12bdaae299be03d2fb2f32eff0eefb91b69e64fd7dbf3805f4c4def1f470b989afd19b41c617b3b8f419f30e7c3e3563fcc617f6a62176baa589cb9c91efa3e7bfc9e8ef34b6e3f0a37e9a784c855e4bf3f5642d7c367faafdef907ef947d84faf4f6ba8d701cecdab405f80d948d6bc5ec0f35e880f11e61b44fd6e477a0af8fdf8fdfae7b97464707c5097217616aa5c9c73f574a72f4effdfc5d80cf901eb33efddc126ef4d06ec3ac88c82d7df606f1f71a86be250041b45c979bcb7917b803ff3f10fc64a31b98aaeb3811c7befec7380c32c357f875aac19fddc4a0b3f77f2eee58f5eee73f466203be34685f004e103f09993f5011d1c4d79efc551493f04900d0d6913ee5d5859eb42d886ff0438a8a5cffb8e966fdd19ff409e7588efbd3d0df98838f83e85d7ffa9f9789eacfdd898238985c07b72e1111eace5cb8a906e5a9fea018e5cc3cf328b8343cff2d8616936d940f67b7907d834d4a39bf0249af5b9d45ccdc1bdea707f1b24cf503d4cd4efbcfa51f8ed496ff1ff672a9db08f96aabb84b964ba6b3ae7fa69cff0d15ee49f05df6c4feea377c7f8fb1bbb0e22df7e87bd3d670fcef045a5c75eaa67cd14ef0d67e5f7fe2059ee45c893b8f34fc22711dff3655a780d77cadd4dc8d3f8789f10f3eccfe40f4273fce712c93d035d6fa928ef1fe21e252fef1fe492e75f0ac740bc7cd1a81812c915bd3766aa986e7da98b757bf4c17ea33bec5bdaba1f3ea6878fe9e1637af8981e3ea6878fe913f8983cdf3f7defb473b8cca7849e437c48936f306fc9d5484e19e49d65c783dd6440e7027b35492c36b8668e39ea5168345aa19ebfc8c6a85a51f1ab55f395f68579f5b02c2efc16e61fdf8f85ebadef8da5aa683d18375db5ae772c6beaa47fab876155c8cf42f34887579d63d8aef3b11b963ff7f455c5f7e360fc3b517d8042b2c4f3239df4d4d8c6f0f6ed63ebe49e5eee41604745bfd71d7a7d684ba381fb37c89fb5ef07db77c2d9fcae7d2734d7d9195e9f9df5efd1fbe39fb9f79fb8ff07dbf7036af7ff13fa7e04b621f5bea97657baad15655fbd5398dccb1daabe2ca2728cdeaa153a5e047e8c43f3b52e406db72ab7961e2ebaa207a2c3da0857bdc7e79aeb19c4536e852b031f5383a2978fc5866932ec56fea278acc1c8379e5e68646f3e67cf3342eb0fbcf3c03b0fbcf3c03bff997887d8d641cc8cf47ca4cee9836267430a2744c472aef2dfe8798815a3de6684a720fe45e71525e71225e70eaf4e73693cbf8f2e968fc6e13466f48bf38de8bebb11b95aa856f977d9b7b47527cbb5fbc5ce284c97c375dd20e787eba83a867bccaae48c659db516ba2fc3efba06367fe017ade33ebefc90cc2fdb231730ba236883dc34c27ea131d2c7f8fce93ed4afd4fbbc2f265136e8157aa1023de50dd774021d87e474d4ba27604793d8198edbaf9a62547d5d08bf45e45356f223a5bf80be5838d709d7d972c41553628917f7fbe6db67d2c328ab2aadb9a6f4d6042b41fe6ca40ca372a8489dec1b8e9d68d07b8e7cc662b0d37cdc89099f8b8ebfcfbf2077e2a6b6f0193cb766f0f6490c11ef41525dfea5f22254e77ba1cc38797ffabd2ead09ee364e713155f7eb8e94cad1c3ea39346f9a7a26b7fc3a9baf4e6c3bb0e57f7b1bc3df2b3eff4560cfb26716ec650c2f562fc2b3e48279729566d584fc7ec0ac596df09fb6f7d6521326b48ebe23ada367cf4f673e905cbbf07c07d07fcc7c875dc47b537bc4f14ef86c92d689cf24ec9fa0be833f0ffbf8f0e78c9c0dc9d098fc2f3e9c7c97d802798f4a977a8f2f217ff4357d66637041689df7c103bfca8e4edc579413f1fa3a31a1f7b62065fd193b3867d4ff6eccbc29a413e51e35039fe5b388dcd386b9849a3f9dddf3dfa557d00536e7279fa7c2ffdeac4c607c7c5cfd946afb535917e70717cb79fa99dcf2e87cbe61f535e0b3df5e6f047bc58549298cc29c59b09749b8e16aff3715f7f84fdbfbc5483617bf88d6d1b37ffb380ffe9cb1dbb0dd1896af21d91953af43e507ac75a1f5ffa0de11fa07bec96c2e01c52b778841bc53eff2fd34f67f797ec52c0e07b0ebbd0f1638f58d15be45d0d6adf22fced85f148788f3d359ccf7efe3a34b936b29f2ec57e497f2f3d7e7cb41bde2dd1f791b8fbc8d47dec6236fe33f216f03ce3d67d0f2e7a3f011f053433a5e117723b343eca0df6a79e7d39c5b879933783d95adf6abf14ab05e14ffea63bf97eafa3d75b7864878aa083d338f80bd4da58dfc56bf0e3f9cd14bc5a7a396a3cbf5c328dffe9dea52079a9cb30c77cd4b9369f459d1c4fed2a745b1b3d5319da079e33ee6ae58b8f7c492972ecfa0d18be895be10edba12cc91ff691c89ffb13ed34407c9021564a5505e63dd728058b56f1b808f12c9178737c61a21fb38ce9f23f64a5f1eed9b073d2fed226bb99dcae03dd7a9a7ccc38be869c3f808ab0c2f4661d988efb1b89639eb4be67c2059023d86a0bf4077d03ac03d460acc62bb5eff26c9fe541d200ef9ecdf243bd8ab338073c0f4e6eb01987d41e4288f4f9fa5bdf89c93609f78e9d9f7f5c3fb68e1fa469e77e77d161fdf44c4e563defb26fdb653fc6a8cee8ba0c9cbe8f36af9472ec8f795423a18f54ff4cf4d07ec98afe0b515e7e037f1e6d9f67378cf92fc9c11e777862dc1a1efd90bd35318a75f7e7e48261a766e86fc8f627987e457946cf99ce7686b32c43dd0dcb8a526402c7f07f85fd0e43dd0269951e96169efb98e568bb7bb22f6fa1c39c1cfc7ccf927da6651d82dc64e433ded94e65a97ebff4fcfc39fe559a8af55ca7e9f85dd2e97a7f40571a0218df1517faf06ea4f7cd08432f86526eaa0b256e5259ce57404734285e10af209c077aae72bc81e0acf23f5704e928dfdbb9f35ea4b24968fa3d3bcaaa88bdb2772957f84bec057e284fcb16826a9ee9620bf7ba309d281d29b086fff93cf4bcff7179ad2fca4e70476ce7082df7162a0670f7f5bdd87bf1bf6f9467c177f2f094f119a09f03985df2fcd1588c815423e43c3adcf35b9b7a67ad6623aa274f207f976503dacd2b2348897bcd67368e6beeb7cc1677955bc8bc91742be1155063bc582f958e11a9140c7e3be8ee66204b3af1afd05c80f4a4f6c4622ca43857e9996416241a213ccba6fa07a9ca3360fd7211b2ef8479c03c604c737bb52019900f76b36fa8ee166fd78123c13f29b5541da81bd0ff3b3bdba6969dd859c5a39073a18f5d536dcb2374f3f8ffad4e03988d2fafeb3a5515cf1a0ca26f8412bc1fe627a09f2b9c33e0bdc17d0df0b31a0038839067b8feb7b046ba90a13d2f72990f37271a1a1bd86bdabef489c86cca282f9d4066012a5e38ec3f5cebe8d8bf8a3d1de345feb1b22974c513ae8415c94f49a9a6a4a05fc15c7a6f88afd1ad07b0fc5a70ebae0cd2d3721a75d69f2d864c97ae27e3543379f3d1dd963b8d1da1a917e91443fcaf6c235ad4672f1e7dd7a08ce2b9631873c7527a21fd7abcd5187c3bbae19e43cbfc9217eb95defc2878ff6e1a37df8683fa38ff6318bf9318bf9feb39863e8392ec74e03dff52017c6d9d4bba4d2653a3d46d12199b539e99dd8339557d0979cb4194593c867e4ad0bf7750fec868326771cc813d240f637da93537beaeef596e4dd4eb078f395c45382bafbabd695469f297479a71c35a02784994e643b7ebfa4fb63be89d44bf8331f3be1778fb3bb299ef06ca337d99b0da22aad7bd4e644d043f0fc2bf8a3d214c37638ca0b656ce8563e8a862a1877d70f5effd20ac623fb15d86aa652d991bd25f3ac35d1b1b47ccbd2bd9c0e0bec645ddc438f68880f393857e264af9b62b0561e7c86cf366e8fb97118c4c7236452d55f3755b3c3c80892d741dbbb73e390b236f04d37709c4cdc179b8d4ece685031896ac51e0f60ae577d6eb8f5b5efbf109d95de4079d6202370be4c6063603a9c60ec04f8f7b3dab784dfc2e76bf3d9b611f7f79f1127c7d8abdbe0880d27f52698317e2d3ede3c9f4f3ddad81a736f86c3ef62cbf4c37c42f4d9c5eb3be73dc8fa1eb356f0ac95281fc2279899a58bce667488b2ef12edc12d877c89f4c91079fa21fea6874fe6e19379f8647e894f067f16bb0748d7cfa5cd087e1bf86a12b1fe9b0c3d4bcc832a67d723412a86f2a9837d27bd422117a160c099d698fd74fdfb9cc849f45ed06b56decf9a0d6ba72afd4577d07a19313215af2f877d1417dafc1893dfa16eae4ff0ff753d0400fbee46a233f563447edca60d3920c248e9e42077d570a51dd41f52f62d962fc45606bba5393184f21aec0e230ff343a1664e9d8c941e4f4e61722e217fce0be40ebe123d44f551bae75ad3def533db03bb873df0b0071ef6c0c31eb8813d305509cd71d8039cb16022c70e044f73de3b9ac6d2f5f359ba3a4d6fd35897476fa7ea70b93f1b05b550a033b00fba55a4fa24ee543c576ca470f63e8cc08d3ceb3e3b5ee4fbeb7d9bce975b97da370fb97585dc8a9f194baf8b8e9ff9f8173075b321a17a8720df0bfe0fed95d73b6756acbdc3f7a3f8919f17b9f930113b0f2aad3ee77cd8641e2cb0314d3a5fcaf7319f831938f1c2d958c1f31b86d78f6dee4d530cc56d43f978816dc311ef626829091fde2b1e0b397118ef52f74fa5b7543a8ba2af1e7916e70cf4489a1a546cc3751ce88febebd0467fdb147d9b81c478cea2af14bae2a627a0237a9d2476a3ce5b4b4d86feb4f833bb92d50f157f8f353cd3f22c7a4aa1a3a8f829c8cdb128cdba8356d6984b27b921a93e1a17e5e61621cf52770dc02e9047e7847c3037a44fdd95b2a6503e8c0e505bdb59809e56f37de8d94edb5ea9f656aa8d156957151db3616e0d7735e929ade908f9d33a5953695d932f101b0fa5ea13208eb7d1947e5ecfb77e36c5fa41152cb03fa666433a68b78d85263ce7ceb909c9fb9d94279ca5eb7620961cd04d85a51b9853b523f809eaee8cbcb403398ae425cc4c12012312df59cecf131ec9fb15cce737dc805f139ef3887f3ee29f8ff8e723fef9887f3ee29f8ff8e723fef9887f06f14f1f73bec92ce6cc05769bbc76c64adb8ecef9045bb693057fc2b851b14c7192207398fdf1ce0d72d2575a35889df644272c6ff0be987271692812e1c568791f27935cb0ef5fd79acbf4e5fb201bbb2fd6b3eaa022c20c454e7972ae8db2d20513eaf8574d51839ec9e7f4459fa87271d614eb53c345f3c8bc9c689233083ea2c30d6d1b98258fded13cf2c8b71499c62dc7c007c09c43aaeda28be539cc6cd3ab15177e734eef54f07d68d58aa5e65b5b98ebe6d5a1faf9044b4d69dfd0e6918e1ad43d8ae5bc71f8181fc5cdfa88317175cebabbd3fbf3f1eb59bc9bcec72c863ffd4ed58ebf276ffdb367fb960fb8d7519cfe4ccb51a02f4c131cf6dd65fd3a501e43988f033fdb85eb7be0832bf001afcdf18faeefa572ad3ec28623fe508360e2c36f949f128a61de428e5d6c077c363946db7e1e46b8346e9da68723f899e7fc39f4337d21bad2f2adada9bc44ca8061bdd51d6687293c78cab3513cc2637f30dff379985903e9751be547c57b1bbb0725da4688c6fdff309b664ef2fd72743f335cbbf4f136ce90e0de57af2e2fc47397db3c3dc32d0b10af360e277df82da883d20f9505d83be19c47d48725dcd7adfacb6a3a5b27e741b051ddef05733cb12b60be0a8aa15a5bf3c0da160e7c9fda6769c6e313c6fc1677f6e9bedfda3ed6e710a723ffe330c75c3a421faf13bc31b81e6f44ed25e85903faa5816d70b735761c63ae2d5561f821b82a6a9d68569cd2827e3e1b0df744bfe37a312fe796fa5c033ede1af319c7da1ffd5b1efd5b1efd5b3e73ff9634ac1ae32b27bed335ee0d48e38135f4e336ecdc4f4d718ecd69746e800ef91c62f940d5f984e49d778f139d8774b4e63a8e2ef6023c3aab2c19fd88b1337eef2bd743eb982bb132d84e3b5d28823efba5f54f787fef878beb08174c7ac2de32786dee187fa1093e2b6f3655ce803ea9be5f18e9484bcdf78f9e5f7ac2e2627a9eaa853074beb35505c732010f345a5b5d1cf2d8e4c97638bfed0df6b6df0746426752a9e962fd18c8ccbbac37ed7d9365eac7e4049dd3f32fd2cfc4f2172fd632046b6bcc7b5e2cfc86cf22bcc7d860bf13d6790de38bf3f2b17d19c1d8a01131282f7730dff2faceff2e7511a73960713136bef53dfc67d7f8cf7865cace70cbbbbbd952ee7ea9bbabbbd98dd073571be4965a952397f236fe06aa8e5c9abd2995c348d196ba28cdb541f3640dec75226b278bd8efc2f79bd5abd7f0a8517bd4a83d6ad41e356a17d7a861dc7eebbea29566c3b75397f4bd09fe07ecaa011fba1ddcd31dfa217a7696ead9593cb60c738e29b1c5f3e38a60e348c86e798dec357aab75f2be57b22d13924f7d988f609bd08b73b2607e1fc917d1bc10efef047b730db598f4fdd3705cb791724ed1f16e78d66410ae7d1c68b2e6801f51158657d9e4f419a1b88da7270ebab087333b6a4a4b2079729e8d8ae8f4deb6f600de87a1c3c9907a770ab31fa8bd99986209bf37d8ccd0a71fc500486f42149ff27c2a1cb89dd057cc1973e0f3525a0f500623a6cfc1f671e2c9ace86d4c5cfa7e73aee3df8d7ae69ef8c4608d6b4dced99ad2423acb3826cf748b787670dfead573b219dc19372fbb7e34d0ccfb725e77a5439cbd7fb6cd7f1e8fb017cc9faaa84207f04356955b2b0dcf97d0e4e211668ffb353ae0b31224c8cf3b420c4af766b54c467261a2ca9da99e6fcd5488990e2af991d25f34c5f24113ea1bbfbf2860b24665ab8bce14cdc1f0f247c1369b6a32d4ae49479ef94767e80156bea4efe739338f88cc8f9977a42a9da3a6f4d6aadc075b63897384b7219f6cf5623c7355ec86cc941bbad211fcde86e0d8a03b403734c5fa0c6cb411f82cf1f9ab30a344f1fa4779ebeaffa3ceca10cad34fc48f1d2cdb8e748d2e3523869e3b09b37fe0dc1dc37e590cb3e5dab7a439dfecde9d4137f82cb9b0f65bfe74ee62dc7793656671abb27de87e21bfbccbd25a75a5033d9b9baac58d3d97f7d93fed5c9c8d2a7818eb4d693946beb2d5e79d93bafe4fc6434bdded78f607d8d74a07e27447a8a9d6e4fafa9f24cf46a2b432aa30d7b4f3f3939fc94293f73baf37c18a67de5e3af68ed9d7247b8b39dff0bec67d3779b6ec54178aee483673e4b337996026e0ebde5a17252b728ef52f926d9158c0b3f14ed612e03fec976eb4b6aa20013e3c3645730bb3d7f0dc103caf543a5236a365e43b8e59fd6de421b68740ce817dd189fa5ed866626d0cbc0ec87ff5699599f340e765741b211f836f2751b30a1cad9adb19ae238ce4bda30977e84be2507efe2bfa9c86fc13304bb0d1d9697207fc15532aae4daf6d02f2ecd40edf4d5479bf45f609d4fd5523e2df27f584607394b7865dc1f2b56831b44e3ff7debe8b1ef56ce2d3a67d8e68be1feae52a38ee48a6664136e8180c92a95e6d9dc7bf2887e20df607f2280fc43eabc37e1c01fb8ee43aeadfc29c15aa9b7c13606fb02c74ebbe7d887de9d4733f695d5e548c6e0ef9777b87b3362fca7f5e0af938f8fb9b44e61644dc9f8b8fcfe2e934fe7ef43779f437f9a7f53771b59cee7afafa0dfc841e3d43ecfbdc38f5be3959247effcc38f5a5b53fd1fb12177f885f7fb09fe938333576161533eb43ae411edbc275ead9b5cbb103e141d505ffe1eba42906eb6b36e8f5793e4c755099c3bc30fd64c672485f625c71516ced96fa5f34e5a2e7779eb72743513a787b2acd48cce217ac3fedfd93ed5bfebcc1f85803b9308d8f1b801f3b78ee53fda8bd33be7f366fe7bef3d442f9bff43e7d08269f21acb4d541b75cc157283f4fd6963acc4514a97b56c91cae0e606b1be7856491fd2ed6376c8d5680df39f24508168c912bdc7921900bc2ec035597ef429f48ad5aa1cf85f4ee98aa10bb70bd7a04b38a677fdb6c5d9ddf47e49f90471bd95f8f27f78cd8a0cc999eeafec123b7f591dbfac86d7de4b6de20b71568a1211d6f68a747eda11f0b18a1f8770ee5b4f0e07d76cfab76d2f75f26579fc9a38ef25147f9a8a3fc2575945c73f0bd5ca103d4618e9496f30967e3d7681c1cf2c59f898762b0502e66ae3cb147691cce635fb3b41a6f1f057bc34bffc4ee4edc13624b04bd56c2b98560a3832d1eaa213dc3bfc7e1db236b0fe88e9f2ee9fd5e7b758691747aba9fb57dda7b71fb8792fc443d6adf9a1edff2e5a9a6e4abaa02c4344ce863e0f767535daa1f5b433a1862f988eb2ed1ffb167c8ab273875c4d9fa016aeca3f787f89e3b50d73ad7f3a877bcb7073295b3d7a8e48c79656b084332b7db09dbf767e0744e8cde6d24c441e9eb8a7cce8b9e77bb3ccf087c19932733ef6cb5793f0ff39e92d610c1cbe97aa07a13f91775a19c507d3e83998aaed9302d32bf00e744000f119f08f4f83c34456d69b839b44e8e1c8e08d9c66927562fa3c9187ee5caf388b059e2723e16aad29a9942fda045db22e7d28edf8386733fcf8a17dec46fc05eb5fd0ed50a08d2c1741dc805c6fec39c351e5476a4d755f3b5de1b286d9e7c9f7f2aadec7461bf54f3b3583fc239f74239d44ae7188b8fa36cca98dc31e85da50ad20ce69fdce47ee2de8aa831389f1f5c333792fb16aa3fe1d8ffb330f51d64a9969720f7d09b5b0432b3d172885da7917bffe7f2c3d1843c31b067e363c7fcb432d72c5d7466f8ff53790caf298c0912d684bf7fc4bd64927c9038ce2b6d626887c481e3f10b39a7f36bd5fc7d0a6c171217a631602ed2ff75651f9c689f1a2b4fe2fa473682ba39faec6ed11727d04d1f1f031f92675d952f17ee71afc2cc76a53781d8d5482ece208eab3524a8e546357823b9e3c4f56ce7b169526c196e1b066c177afd9e1d7dd203d2d6f350538462891b4d84fcb7be37f79bfc16d713419d51b8f70d87ed9222e31ef9668f7cb347bed923dfec66f966ffec795a60bf6575a1b3d5abf77ba6a9b4767abee3f0c438db83473f98473f98473f98dfbb1fcce7eafd72eb18991413470af9e7e77d0b6ac6419feb42ebff81adf54962656c0f1c942374c59a78df81ac29de9773b99d1c4dcfb1b917a0e3fb5b5528af689f7eaaafa9b64fc38c51f1da9eaf736bb7b721b1fc0bd6033d77e41eea85027e40d3756650af877ba1802f9a27669b4c7f67d74405eb4fb421b19ef2bf5f45bd54675edd219943308418b46f27f3c8fa14f97eb79902a6e864757178bb9eecd1f815f54535dcfa46bb41effbabf3293e57ee042bfbceb157a36c55292657828e47037f2c712ded5675978e9aeff1632f4edc7536e642f9a221594f30d7e56be2f565a7f874689ea53046a42c8ec220a427f06f90cb83f8e10674e8d3f5588ecc8fb07417f7a2f6ec55943f42d5f0835db8d39456763cd8f9b9f440c7a05f0cdb3f631eddc1a743ced725e4029d42f60d61171fdfcd9dc8bc096f76a8836a46509e66430ae58c809e84be1f666306fd8d2c03fa40e79b133d0fb3409b3ed639c7ae48d139f4fe04b29392ad4939c8f8335f0fe0fbc6d9c828cffb4d6959ba6b86683d95c6d3693b8aa67bf0bc66dd7b1e276d47d1b43282faa941059ebf8219a7aabb77805e470acc2a6d7be7fceaf4cc696f826635562b07552ece211f48b72bf911f4a9aae2330e7cd1a4c78b631c386ce014f9cb2d7741de3ae0e3eb4d869e6e26f2f63eebbc939f9dc6ee7eac6ade81deb430fb278bec512f4767a30bc5ef9a6cc2b3046d88f8730e7389a2e8234d5f30349d2c73e2fcd98ef79eb790c7badb8759c7598d9c5fc3bb37ea6d876a8b7b1388b7432f22904d907f183adb777e7f369607693ae86c3f36f8b08779f0b5f408adde645df792a1143d44e1534777195f855bdceab3a2a309f52cd45c0e609e936c86677030b231e1dd18be48c66f71be8bbe5c9c7d083d225d88b002d20f4d11f2df5a80f396506b4ace12db119f04af4a1b8db62145670a33bb0d8c773488a5fe7e740833b237d07fcb08d79bde59f6f5615fa097e081b70e35c55f31039d6d8aa86f10d8f5f43af1bc94c2e7a02b66ed71f465365a963eef78fce2f5b73ea75e83d048b24d51db73e7a5c07e40cf4d24dbf2d82fe4d68f3cf60de3b788cbe919f6722f513479117d5e754ef405398af8cc74d939e29eb9355d2866619642b31ef8f738f3b1f0d99cf93e842639d77e6ede55cad948fdfa673d1bd4377ad217eb593cdb7a32045fa4589eeac22ebd6718b5b73cf239628f936475c4b987f738390f2cb187d847e7839debe72636304d27a93491aa4722f587ffac2b7a94e37fcb2dc738f561503dab7c5fb6d7370cf43beeb14ad5f9a7f929f05edec6c74d62dc917d3eb08fc58f838bdad6b04fecb5bbe648815f782c4a33843fe6d2898e48adff732541535a4594a3e61a10d7f7f262edd2e97b45f9d6f167bc397f788e5a78b6eac7fb325aa82f2fec9d5b74d43cc9e3b99cbe912fb8d146b5ad51f97f987e2751ebbd77eedf20e21d28faceaab2b3320efedcba25d886106f32e5fa2aa0dfca32620f797053325eba5f1f0e9839b435c5e1dacfc1f464da47c771fec979486c6e31f49ec88e1fb3a71eb3a71eb3a71eb3a7ce9a3df5c8357ae41add35d728a12607c5300eaa50dee8ae84eb927331baeebad9db46b8d7f71ac56c1b33ee79d5c1bc9e107f63db15cffac2b48af7ddc72bf8fb497a32122f7dbc0d0af9a365f0adb2b81d7ac0f9339c918cb8a29e47023de09d7940b30dffd92cde65fbcfa5f165323f9e67970ef0594c582c0fbda287f87c9aaf682d440f0e4744c6d23172575aeb7988e9b1342ecd3eda5665f727ad1724837ba3e66a857a04c4e53c611f2cf22b52749f261fa2f55dac8f86ec5d6ea9cf35c00fd0efe20efe1af2dc4a8b7aee15b62daa19f7fa4aa2cf3b5b4d1cfe31928b02ec1df4b778931de001f40c5528cfb4f7c2043e335c07fb7beac81632e01248efca21e0958d2a9bc047f41e818d8c6a4e616e0195cbf2ab78cd972f036acd44e7f5a8f94b117bf2d1fb90b68e647d793f5b9b5ed7daab53e0e87ff9b0e91e36ddc3a6fb3d6c3a4207517b803f3bc327cccad18fd79b33676302ad8865e8e3d7027f20677e46545e46a5d9e82c75d784991c6b552e5a9a00fd9b43cf4034f959eac169ddd67c25fa1cd7b5de602d69ef92c26b1f92cb4fe88d9175a77c35f8b47d3b1f7d931f7d931f7d937fa7bec931fe9d481aa2afd3f7fb16fb5d7c7dc4fb63db19eda726f7d6745fc6475fe6475fe6475fe6475fe67f585f6636d6710e1f3e6a0b7f93da423213deeb7320a15c5bc3853e09ce4163e391f30ef8f4365ad4fcebda3ec98eb9c85f14e52bea79ef7bf33ee1eff9d65c958bcb31ce9944b2143f8b395bdc3f14cda6f2e24a838a6ffb411cc114a5029edd13b607512cc239e2dea330bbc84232cf95a0673ee4cc65759c27c83c336760db33989189eacb0faadcf9e9d5e0f8fe6fc8c5b39a0d2da78b45c881b647f27e6936a0776e07ce07d71496b77ab5f049780bafe73592c7ba866b5abaeb7cf1e7a356c9bcdeca929651e02f5715e807d39e04b14c3f971272cfb29a6265a37ccacdba5787a7cbf562536c39a87ec9955c0d6c21e8ef19cc0a669ee9f527805e7090ff86f909e5d6abc21aed35397faf2ff1ebc49c4b2bdd950e2af4c516d1fc7cb0033658466475a1bc3ac76797e243a0f577a08f28fe48b37390be2131544a8f9dfab67fffd8f099eb65e672e542bded478254340ecd687f8dbc87582af0aadf63326c9f85e798bdc9747fcc137f6709d18aeb38bad80bfa5fce42b30d3ea47765a25d449d772a6649c72a911825bcff157a6ec2e5b13f07c90b3fbe45adc9311b6d32ab0d7a904f98f57b7220987148e4cb84f6ed5218fc9ef1bc94bd0a62e57edc93eacbaf29fd2391afd077eb64ce1eae49d30447d0e482aff3a2fca59fd52f1ae9b7805afa48fbe2ea985d940f0872ce7fdecd07346f3950a707b32ba116fd4da958c6bc5f7cf8331efe8c873fe3e1cfe0f4679c608c543bf213e4b58666d884ed8ec990c2fd37f07b54226c3c2b7aa6ad733cd5abf5a3dfc305b0885cb8144bb0b41f1f273e0f5bf0cdadb22b034dd61c9047aa40cd0bbacfdef0ae255d2e107e8bae75a4e832f83cd05dd2f157d2fc80c2aa786d1309fa26dd6ac695879b514f140f3fd2e785d7f539e894c6c2643e886408c38927dfb12eaa73ade776b47541bd3cf839e01c75d9ebbd82de5f69a7fbebce7f1eb952ebf479666431183cae26fc60cac5e39bf2dbceac42b9c7ac8d18b291915c7b9db4f2c11a5b87c8d9e0d82f7822df26239083a2b331f27d4b17f7904f89faa3418c01f584e2a94f67e4dbb9588e5c981f786459046f70d5ad47d854713357a4a3a6b4d72398e1e2cd0cb339eee9df972787e1263ed0a8cbeb0901f2879dfd1ea20df059aa839739b5c679a4fec4be4bca8785f33b2a0b15fa727939b72bddd3ab1bcdeb557a049bc160b022c7f95fca3b7c32328e66c3b377387eb73fed5f41cd89d20057e65b53a021432c432fb3e23f57fe54b6bae84cc70323fc39f6f9207b3232f7cd9ff570c474e380ad68b8ffe1b287f6df36da6b3cc3ec78e20f8ebbf831e1cdec71f6ea365e706d108d7ba419235f960660f6aae9cf7c3b577f197969877a1535dab8b7a58466f2a8ff64f9837fc3e0a8f8df90efe33aa1243f04de03f0eb479e39fe3c09f37d4cbc2821561213e3b92e8612e7df647d29bf60e6178a13c3fdabb9e5a92d42ad375d0ea4da8431fe0ffcfccaa92dc4194f8f8aa387fac488d6d6247d6f483ca0013dd49c238a6b83dc68a0382ee44b4c49bfabbbf7319da1fadc832eec26113632f155909c635b03bc2496f34c0c7962a03e8b1da02384e9437194791fe6cd1d3f6bec83d025b3e78f9cf0474ef82327fcd7e584b33ad3cf1744d8862b173a1447d8f3c4f6ce886be21c8ac83a494c239167893ff3e50fd6a131313f36174269c1f396868bbf47d152aacc49973351b2c5efc5f826b72c948b550fbf03679c202a3e70a65e399d4b1960895fa637d7c8ff0735f87ecc2747e715dc768d69b22745def0d7e4c7f470a926d16a58cefe4e75b8fd30dd9c170f888b09cc5e270c3d06f69e48cd76388de7f2c609f8630597fb564a6ac0a73ebe403182d78bd777ce7b90f53de2d5385e1d8561ffe3f2775ce8b740fb62eeb64e36cf136c1be753e60d45eddb1c6c4e949b6ae9b29485190d77ab8343fa08bfdb47d0c9234fea9127f5c893fa8c79528ff9718ff971bf647e5c603be57e97dac360f6c1afcacdc3fee0f099173e099d06ef77558e5ddc1aef45b76932b9b6a7e32c3e3d33f1ec7590678a70d75a9dcf42f5f96ff2da192b6d3bc6c65f41ff40e8c5efd7cd84b1ae3d1e846255dc7547e17770c2f80daf9dd4a971f4e24cf481bd296cac0bc7ae6e1a3f43f7bc7fdd55a20f3407753aa89f04c82ce8e578e5799fdcef0453c4c60b21dfbebefef8b386d96b7db2feeb6aeb42f608ef3a43cfe73a5f2c077c3b057f9fb535c999432e5216f3f3c7f7c0723a3b4dee80fc9b36ebd4daae9a6f4dc9d4833fd3905a57ff48f58d0ac963c8016c8af59906fd12f31d47bbb75f37613ff02c294a27b727a6602d556132a169c93804f33f545c7b0b75984db04706154b13fbe043009f318f4d8275520ceee0b03dba8dd838459cdf83d0621833d81c3e96e92b2b7bb8e9f72c5a4ea3ebd398dfe977a2df33193389e1fb523960f4bcda4f6f539ffabb315eba707de7bc474a8c9fbed2e3fd9fa2c762623c85fa9eef1f3b5dc3638e083b47e4f7f2abdec71ffde875fbe875fbe875fb2b7adda6f89968fce7d940a1f9d8df7bd993b90967e3226e4c148987029fc7907ad7669df28f70ce5148e4c941e540f96126a6580a63e090fd54b1515f84463bec8739032f7162a5b37112d4675379a2ef944dc6fae1482ed94dd6cdfb9e1cbc8b699df2c75367897f7f6e1e336d9b9ee62d7b3e8fdbf6bb41f73ce1fbb89e2f08335ced8398d36795637d60d7f9954e7102efdac2efc0e0ba5be465c7ec3db58fa978211523c4e002fab993219cf915335da27811fc975e0faadc167ad7a0b5c1fc7498395aadd0d893f4a542334a0d513a78ef8bf259aca6a8e57417cfac84393170c68a46f7c8f2799ec7ee4b915fdc720bc74923f791e4ea1894dc42ef0b3ee241056875ade75b30f766aba319dfe09b994c687a207dd4602f9a8d8a6378fa05d984eaa0e29a7211cf4882bddfc37b0ad8df1ec4893e730edb7f9ebd45fa932db5ea873cf361ab3c6c9587adf2196d952036f53bc4c30761bf50650018aff9eabcde20068e7a14202c30a07aad34c2b13f7ac6b73a978e301bdbc8b7271ac8c46006326fce2e3ed7143beefc5c5dc8d34dda2b64a7a09a56d0c3e290d4a6420d2be37ba3e6b38bad9c21381b8857d0d8e71cfee5e0dd8b6d15fc99afc7f17de374d1493c9b7a8f543b3cd5fe8eb3bb7dac5fa1733ba83867fd9c1845141dbf6ab2b6d4d9ba430aef9df44ea0f2083e552d22ff5e91d9e52ee2c74365a1e7497c82f2699cd6fc07f7829aeb70af4b0fa336dabf7b5c6eff88cb3de2728fb8dc232ef71f1f97fb807a872bf2a07fff7e91778817f873c353fb20129f9cb9843ecb741e11e0d7c0e754a1fdd658e784e569dcbebde539f4dd0dfa45f2c614eeb037bc6b49c63fe9fd22d364744c7e20eb3f3fe9bb8f630057e54146cb3c5606dda2774a701ff2ffbe9d7e92cbab9cc8b22b735c4f6523777e2bca11cd7e4c1c4290b2a81f37fc5be93b864dad2f5de6a5caba487f1ff42ba9d3cfacc85e2f343e19c79107e9d724f9b9b5a4bf98e0acf42a9a89e28ee4fdcaefa53f9f617e07fedf6f51ed3dee0fd914a5d519f22c598ef1cb2f905bef9ad29b0ce975d4bd772272eb8eeb4e7bef6419f531365a1486f2faeaf1ccbf9ebede126f78b8e7f7f1f5f5285934190c39e75824d5e03b95edc8f37178f52d8d108f47e6e9be5551dc8ae42d33754e142d36cca52eee26aa3b9ca84ae73892cd8d2673f1234b9f1f812f22f612e578f674c141be0f9ca340cb7f9045d9119e671160849d87cb70aeb38f41e67d0b7c2f5a15f78d1c54f2c0c7de0c21986be4cc75579afda6be42c2b31fae7b24af6f221fad47d238eac5b51b35a03fcb7002b8c6ef438c65ade63a2bbdd15e837c0d7c053e1fa09eb9c49e37510c18ddd39715786fcec5d1b7d43bbe3ea6fa53ff8a35a7bdf3ad748e57fb33447c96e89b183740df76e27b51dba598d891672fa03c2731c06557f111ee4745b01ce94145ef0f7c4f15aced483ed1a729bab472d0f31de7bb3bd9c760f1785f00ccb211865f46b5388c1beb7381de9e47ed7db9681f9adb0bdf377bf9fb0e2f7edf8e7de1fbce5f2e7d664eaf5ef84cf0f35fb84746a3e55cfa5b53acdb6c5d1fa1fd91585eaa0263c378f48dff8f79866fa34ef6cd947c15e8b93116a55977d0ca1a73e9047325f608c0ef3e928b3033c931c274996457e3cffc734c5bc7e11fb28ee33f641dd97fc83a72ff907508ff8875cc5f22f47328b603766b41937be5e6bc7f304ff3d8937a5112dde098ae7418237bab387f939d8d0e9870f0516baa1c22b07b18cf0c2aeb9162395a2d6b9b8d5618eb53bfc17ae0dc784bf85ea97130a20773cbf145b11af2fb58ff41589f55c3364d9027243592dfafbc23b95e3d616f19f976a4ee3c1b379eda5f5bbd9a727ef8be57f86a92ce2f8e8e57a330cd06b419fdecdbd1b4bb5fde46de7416bae040cf8fd47ccc500c8eb917affcd214cd31e69e5f413be496babb5feaee29ae0deee7ef47dc196c2370263f8fcca508acb9dba6d925741ca39f2cebb69e0fb868e9af9d15f88642cfc3589291b1619cc9e92b37e55d9aec8e8e0dc4f812239f95101b8d3b735d9c9de5c7c49f45f2f7997223aba298cda7941d59c3fe45b263de4fc5f2d4734fecf47363e61fb57f9c790c17dd7b747a6fb2ff9ebcfc207a1d556f825352708524684aab887a6eb8c69a60ca0f934b622f4d2e7d764c49f6939c53dcb3a8fd4b96adbf42f7c21e87cef81c9a997bbd505479778daeb64e9e1faf6be364213e276fade4ff68fa207487d6cbcab968d97a7a8ff3f07ddc7a31be912275fe49be3efe7ffc0e49f417dcf7ccf57da00c8fa19b531be40c7a99ea42df49a0d940b7c5d0bc2667af79fef29a6743dc45178af36beea19e628433f6be93bbf6f746f58adfbbce5a0bfb2978f4e889ccc4ffeff1408aae310637d19fb7b6f3d3cf5928cf185d75e65e4beb6be86c74d5b39bd7f018cc4fddeaae71dd3de42bdf4189887354cb342d9cc8ef5b60237ceeb7a0d94b7c499fd696f565678a9df2197d1c29382b5d160cf8656664cecf30e5fdf215dc2f7b5fd3c5faf1e37ce2d2fa717ebff3f9cd6e62b7dd1c7326cba8f54899dccb46f9d43254b56fa2576e8d852ed153c1d993b5fff3631e08d3dc84ff3e216dc26ca0dbe886cf283791dd93cc7bfc79bb96de08e7d5a77cff68b8edf45a22b7be194bd26030f8a83d78bd0dedde5a77a4c98f7bf92b62305204f6398b271269e43afea0af243a89f81e433355569ef0e9d580b62c38bbdbc85d2c0f03b9547154c1b1f4eaaff6e5a6d2da52075f5f541ffe387d77bedf31825e9d8d214807b37a33f976595dc24df452046f9075cefd5ef33356cfa7fe360123a4ff16685bab400e064d1b09fbb700faf0f783ecc5a9cec79fef4f669e07cf463df0872339e7bc29a8efd7da149db5a62cb710a33fbf3fc669af07afe708facc7f96d7ff7d3251a97c6b0d6a2de49c3376cb1b8d67ce2e5977cc3ba1f781334579d0b8679b8872e4b13e0cd6cef2a4f7db0a6044c7700b13d3cbd12f37edc24fa95af8f9adbe2cc987d55a19147e7e8bd2a7cc73f1e724bee1e5764b34cd269d91b456656715411bf1f53871b9f5b3faa629e2fbf97df6db1fbfd7f30ed0e626849182f7845a9bac2675762ae87d5c5fe3d54bef57aa523946f849912c87b90c5053a6dbb806e53d6bbfd9eb97377b158b07e2d6c043cbefaee46855a8ab6d398836ec0af4b1cc6a4aeb637ae20434787216d09f0fc9863c7556e4339cdb1fd289745d8184f118d681a6d28ac82dba84be5e2792d46ae1f59d7e1eac91a74e02ea70816e486f415bf574057c66e973471ace6676145dc05c065394de0db10e794e3e6da84279a58bf59d219ce61fc5f2bcb3df8db3fbb29cf3fe54c4de4fa59a33de9cecfffb6e177ed2fbc6bd7f69fbe8f8ba08cfd3ef2c345a6ec23a8402ea4fd9acb7c4dee1e5f8fd7d2270ec3bdffed37446788ca68f6af9f47cf0e7f8b3902cc3cf2f9dd066a0d372863b5b877a54de901ec373a0763087176afb2caff711d5c7b32e0dde6be97c9cc2bfa508fe0cd5e6a872b1087cc2d20ea29bb9b918297d6798af58aa300ce810b0b7b8b78c4627120b9d230fc819a982e5e8f2eb1fd5c9fffc4fe629b31cfd1ccfd799e7ffcb746793cc7326f394e98cdc31fcedefbfff7eca58e39f63f8b466ffcc3c67fe5abbcbbf26e379e629d374978b9febee686d659e33137b6d6df43f8d85fb97b93066e39f7f2d56c6c899d9ebe07ed4ffd416063ce029f33efa3919afbdbff7178b75f811edd1dab032cfff9bf933f3afa7cc603d72c699e7f5cfcd18ffa33f1ead16f3cc7346dfd88ef95fcdda7fb9f6ca453f7aca888bbaed8c57f0737361fc3959c03dbc975e659ee71bc779cad4c64bffefefe3d5daff4df05fcc2fda0b73036ff17f199e95b747f69cbcf1c9068a8bf6c224ff3572d67fba0b13fdb734feb9b2d1ba727f0ac53fb399bffffefbff030000ffff0300abf4a97b45622b00`)))
@@ -0,0 +1,150 @@
+// Package diff compares two OSCAL documents of the same type. Controls,
+// parts, parameters, alterations, implemented requirements and the other
+// objects of the documents are matched by their ids rather than by their
+// position, so that documents can be compared across formats and revisions
+// reordering their content.
+package diff
+
+import (
+	"fmt"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/types/oscal"
+)
+
+// Kind of change
+type Kind string
+
+// Kinds of changes
+const (
+	Added   Kind = "added"
+	Removed Kind = "removed"
+	Changed Kind = "changed"
+)
+
+// Types of the compared objects
+const (
+	TypeMetadata               = "metadata"
+	TypeImport                 = "import"
+	TypeGroup                  = "group"
+	TypeControl                = "control"
+	TypePart                   = "part"
+	TypeParameter              = "parameter"
+	TypeParameterSetting       = "parameter-setting"
+	TypeAlteration             = "alteration"
+	TypeComponent              = "component"
+	TypeImplementedRequirement = "implemented-requirement"
+)
+
+// FieldChange is changed value of a field. Prose is compared as plain
+// text, structured values as compact JSON.
+type FieldChange struct {
+	Name string `json:"name"`
+	Old  string `json:"old"`
+	New  string `json:"new"`
+}
+
+// Change of single object of the document
+type Change struct {
+	Kind Kind   `json:"kind"`
+	Type string `json:"type"`
+	// ID is the id of the object. Objects without id, such as parts without
+	// id, are identified by their parent and position.
+	ID string `json:"id,omitempty"`
+	// Parent is id of the control, component or other object holding the object
+	Parent string        `json:"parent,omitempty"`
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s", c.Kind, c.Type)
+	if c.ID != "" {
+		s += " " + c.ID
+	}
+	if c.Parent != "" {
+		s += " in " + c.Parent
+	}
+	return s
+}
+
+// Diff lists changes between old and new document
+type Diff struct {
+	Old     string   `json:"old"`
+	New     string   `json:"new"`
+	Changes []Change `json:"changes"`
+}
+
+// Count returns number of changes of the kind
+func (d *Diff) Count(kind Kind) int {
+	count := 0
+	for _, c := range d.Changes {
+		if c.Kind == kind {
+			count++
+		}
+	}
+	return count
+}
+
+// Empty reports whether the documents are equivalent
+func (d *Diff) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// Compare returns changes from old to new document. Removed and changed
+// objects are listed in order of the old document, followed by the added
+// objects in order of the new document.
+func Compare(old, new *oscal.OSCAL) (*Diff, error) {
+	if old.DocumentType() != new.DocumentType() {
+		return nil, fmt.Errorf("Cannot compare %s with %s", old.DocumentType(), new.DocumentType())
+	}
+	if old.DocumentType() == constants.UnknownDocument {
+		return nil, fmt.Errorf("Cannot compare unknown documents")
+	}
+	oldItems, newItems := collect(old), collect(new)
+	index := map[key]*item{}
+	for _, i := range newItems {
+		index[i.key] = i
+	}
+	d := &Diff{Changes: []Change{}}
+	matched := map[key]bool{}
+	for _, o := range oldItems {
+		n, ok := index[o.key]
+		if !ok {
+			d.Changes = append(d.Changes, o.change(Removed, nil))
+			continue
+		}
+		matched[o.key] = true
+		if fields := compareFields(o.fields, n.fields); len(fields) > 0 {
+			d.Changes = append(d.Changes, n.change(Changed, fields))
+		}
+	}
+	for _, n := range newItems {
+		if !matched[n.key] {
+			d.Changes = append(d.Changes, n.change(Added, nil))
+		}
+	}
+	return d, nil
+}
+
+// compareFields returns fields that differ, in order of the new fields
+// followed by the removed ones
+func compareFields(old, new []field) []FieldChange {
+	oldValues := map[string]string{}
+	for _, f := range old {
+		oldValues[f.name] = f.value
+	}
+	var changes []FieldChange
+	seen := map[string]bool{}
+	for _, f := range new {
+		seen[f.name] = true
+		if oldValues[f.name] != f.value {
+			changes = append(changes, FieldChange{Name: f.name, Old: oldValues[f.name], New: f.value})
+		}
+	}
+	for _, f := range old {
+		if !seen[f.name] {
+			changes = append(changes, FieldChange{Name: f.name, Old: f.value})
+		}
+	}
+	return changes
+}
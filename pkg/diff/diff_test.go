@@ -0,0 +1,207 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/types/oscal"
+	"github.com/docker/oscalkit/types/oscal/catalog"
+	"github.com/docker/oscalkit/types/oscal/profile"
+	ssp "github.com/docker/oscalkit/types/oscal/system_security_plan"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+func testCatalog(statement string) *oscal.OSCAL {
+	return &oscal.OSCAL{Catalog: &catalog.Catalog{
+		Id:       "c",
+		Metadata: &validation_root.Metadata{Title: "Catalog", Version: "1.0"},
+		Groups: []catalog.Group{{
+			Id:    "ac",
+			Title: "Access Control",
+			Controls: []catalog.Control{
+				catalog.NewControl("ac-1", "Policy", &catalog.ControlOpts{
+					Params: []catalog.Param{{Id: "ac-1_prm_1", Label: "frequency"}},
+					Parts: []catalog.Part{
+						catalog.NewPart("ac-1_smt", "Statement", statement),
+						{Name: "guidance", Prose: &catalog.Prose{Raw: "<p>Guidance.</p>"}},
+					},
+				}),
+				catalog.NewControl("ac-2", "Accounts", nil),
+			},
+		}},
+	}}
+}
+
+func TestCompareCatalogs(t *testing.T) {
+	old := testCatalog("<p>Develop a policy.</p>")
+	new := testCatalog("<p>Develop and   document a policy.</p>")
+	g := &new.Catalog.Groups[0]
+	g.Controls[0].Parameters[0].Label = "period"
+	g.Controls[0].Parts[1].Prose.Raw = "<p>More guidance.</p>"
+	g.Controls = append(g.Controls[:1], catalog.NewControl("ac-3", "Enforcement", nil))
+	new.Catalog.Metadata.Version = "1.1"
+
+	d, err := Compare(old, new)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Change{
+		{Kind: Changed, Type: TypeMetadata, Fields: []FieldChange{{"version", "1.0", "1.1"}}},
+		{Kind: Changed, Type: TypeParameter, ID: "ac-1_prm_1", Parent: "ac-1", Fields: []FieldChange{{"label", "frequency", "period"}}},
+		{Kind: Changed, Type: TypePart, ID: "ac-1_smt", Parent: "ac-1", Fields: []FieldChange{{"prose", "Develop a policy.", "Develop and document a policy."}}},
+		{Kind: Changed, Type: TypePart, ID: "guidance[2]", Parent: "ac-1", Fields: []FieldChange{{"prose", "Guidance.", "More guidance."}}},
+		{Kind: Removed, Type: TypeControl, ID: "ac-2", Parent: "ac"},
+		{Kind: Added, Type: TypeControl, ID: "ac-3", Parent: "ac"},
+	}
+	if !reflect.DeepEqual(d.Changes, expected) {
+		t.Errorf("unexpected changes:\n%+v\nexpected:\n%+v", d.Changes, expected)
+	}
+}
+
+// TestCompareAcrossFormats checks that conversion between formats does not
+// change the document
+func TestCompareAcrossFormats(t *testing.T) {
+	original := testCatalog("<p>Develop a <em>policy</em>.</p>")
+	for _, format := range []string{"xml", "json", "yaml"} {
+		var b bytes.Buffer
+		var err error
+		switch format {
+		case "xml":
+			err = original.XML(&b, true)
+		case "json":
+			err = original.JSON(&b, true)
+		case "yaml":
+			err = original.YAML(&b)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := oscal.New(&b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := Compare(original, decoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !d.Empty() {
+			t.Errorf("%s: unexpected changes %+v", format, d.Changes)
+		}
+	}
+}
+
+func TestCompareProfiles(t *testing.T) {
+	testProfile := func() *oscal.OSCAL {
+		return &oscal.OSCAL{Profile: &profile.Profile{
+			Id:      "p",
+			Imports: []profile.Import{{Href: "catalog.xml", Include: &profile.Include{IdSelectors: []profile.Call{{ControlId: "ac-1"}}}}},
+			Modify: &profile.Modify{
+				ParameterSettings: []profile.SetParameter{{ParamId: "ac-1_prm_1", Value: "monthly"}},
+				Alterations:       []profile.Alter{{ControlId: "ac-1", Removals: []profile.Remove{{IdRef: "ac-1_gdn"}}}},
+			},
+		}}
+	}
+	old, new := testProfile(), testProfile()
+	new.Profile.Imports[0].Include.IdSelectors = append(new.Profile.Imports[0].Include.IdSelectors, profile.Call{ControlId: "ac-2"})
+	new.Profile.Modify.ParameterSettings[0].Value = "weekly"
+	new.Profile.Modify.Alterations = nil
+
+	d, err := Compare(old, new)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Change{
+		{Kind: Changed, Type: TypeImport, ID: "catalog.xml", Fields: []FieldChange{{
+			"include", `{"id-selectors":[{"controlId":"ac-1"}]}`, `{"id-selectors":[{"controlId":"ac-1"},{"controlId":"ac-2"}]}`,
+		}}},
+		{Kind: Changed, Type: TypeParameterSetting, ID: "ac-1_prm_1", Fields: []FieldChange{{"value", "monthly", "weekly"}}},
+		{Kind: Removed, Type: TypeAlteration, ID: "ac-1"},
+	}
+	if !reflect.DeepEqual(d.Changes, expected) {
+		t.Errorf("unexpected changes:\n%+v\nexpected:\n%+v", d.Changes, expected)
+	}
+}
+
+func TestCompareSSPs(t *testing.T) {
+	testSSP := func(description string) *oscal.OSCAL {
+		return &oscal.OSCAL{SystemSecurityPlan: &ssp.SystemSecurityPlan{
+			Id: "s",
+			ControlImplementation: &ssp.ControlImplementation{
+				ImplementedRequirements: []ssp.ImplementedRequirement{
+					{Id: "ir-1", ControlId: "ac-1", Description: validation_root.MarkupFromPlain(description)},
+					{ControlId: "ac-2"},
+				},
+			},
+		}}
+	}
+	old, new := testSSP("Implemented."), testSSP("Implemented by the platform.")
+	new.SystemSecurityPlan.ControlImplementation.ImplementedRequirements[1].ControlId = "ac-3"
+
+	d, err := Compare(old, new)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Change{
+		{Kind: Changed, Type: TypeImplementedRequirement, ID: "ir-1", Fields: []FieldChange{{"description", "Implemented.", "Implemented by the platform."}}},
+		{Kind: Removed, Type: TypeImplementedRequirement, ID: "ac-2"},
+		{Kind: Added, Type: TypeImplementedRequirement, ID: "ac-3"},
+	}
+	if !reflect.DeepEqual(d.Changes, expected) {
+		t.Errorf("unexpected changes:\n%+v\nexpected:\n%+v", d.Changes, expected)
+	}
+}
+
+func TestCompareDifferentTypes(t *testing.T) {
+	if _, err := Compare(testCatalog(""), &oscal.OSCAL{Profile: &profile.Profile{Id: "p"}}); err == nil {
+		t.Error("catalog and profile should not be compared")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	d := &Diff{Old: "old.xml", New: "new.json", Changes: []Change{
+		{Kind: Changed, Type: TypeControl, ID: "ac-1", Parent: "ac", Fields: []FieldChange{{"title", "Policy", "Policy `v2`"}}},
+		{Kind: Added, Type: TypePart, ID: "ac-1_gdn", Parent: "ac-1"},
+	}}
+	expected := map[string]string{
+		FormatText: `--- old.xml
++++ new.json
+~ control ac-1 in ac
+    title: "Policy" -> "Policy ` + "`v2`" + `"
++ part ac-1_gdn in ac-1
+2 changes: 1 added, 0 removed, 1 changed
+`,
+		FormatMarkdown: "## Changes from `old.xml` to `new.json`\n\n2 changes: 1 added, 0 removed, 1 changed\n\n" +
+			"### Controls\n\n- **changed** `ac-1` in `ac`\n  - title: `Policy` → `` Policy `v2` ``\n\n" +
+			"### Parts\n\n- **added** `ac-1_gdn` in `ac-1`\n",
+	}
+	for format, want := range expected {
+		var b bytes.Buffer
+		if err := Write(&b, format, d); err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != want {
+			t.Errorf("unexpected %s report:\n%s\nexpected:\n%s", format, b.String(), want)
+		}
+	}
+
+	var b bytes.Buffer
+	if err := Write(&b, FormatJSON, d); err != nil {
+		t.Fatal(err)
+	}
+	var report struct {
+		Changes []Change     `json:"changes"`
+		Summary map[Kind]int `json:"summary"`
+	}
+	if err := json.Unmarshal(b.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(report.Changes, d.Changes) || report.Summary[Added] != 1 || report.Summary[Changed] != 1 {
+		t.Errorf("unexpected JSON report %s", b.String())
+	}
+	if err := Write(&b, "html", d); err == nil || !strings.Contains(err.Error(), "unsupported") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
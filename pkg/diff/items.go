@@ -0,0 +1,278 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/docker/oscalkit/types/oscal"
+	"github.com/docker/oscalkit/types/oscal/catalog"
+	"github.com/docker/oscalkit/types/oscal/component_definition"
+	"github.com/docker/oscalkit/types/oscal/profile"
+	ssp "github.com/docker/oscalkit/types/oscal/system_security_plan"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+// key identifies object across the compared documents
+type key struct {
+	typ string
+	id  string
+}
+
+// field is compared value of an object, named as in JSON documents
+type field struct {
+	name  string
+	value string
+}
+
+// item is compared object of a document
+type item struct {
+	key    key
+	id     string
+	parent string
+	fields []field
+}
+
+func (i *item) change(kind Kind, fields []FieldChange) Change {
+	return Change{Kind: kind, Type: i.key.typ, ID: i.id, Parent: i.parent, Fields: fields}
+}
+
+type collector struct {
+	items []*item
+}
+
+// collect returns compared objects of the document in document order
+func collect(o *oscal.OSCAL) []*item {
+	c := &collector{}
+	switch {
+	case o.Catalog != nil:
+		c.catalog(o.Catalog)
+	case o.Profile != nil:
+		c.profile(o.Profile)
+	case o.SystemSecurityPlan != nil:
+		c.ssp(o.SystemSecurityPlan)
+	case o.Component != nil:
+		c.component(o.Component)
+	}
+	return c.items
+}
+
+// add records object identified by its id. Objects are compared by their
+// fields except for the skipped ones, which are compared as objects of
+// their own. The parent is compared as well, so that moved objects are
+// reported.
+func (c *collector) add(typ, id, parent string, v interface{}, skip ...string) {
+	fields := fieldsOf(v, skip)
+	if parent != "" {
+		fields = append([]field{{"parent", parent}}, fields...)
+	}
+	c.items = append(c.items, &item{key: key{typ, id}, id: id, parent: parent, fields: fields})
+}
+
+// addAnonymous records object without id, identified by name and position
+// within its parent. It returns the name.
+func (c *collector) addAnonymous(typ, name string, position int, parent string, v interface{}, skip ...string) string {
+	if name == "" {
+		name = typ
+	}
+	id := fmt.Sprintf("%s[%d]", name, position+1)
+	c.items = append(c.items, &item{key: key{typ, parent + "/" + id}, id: id, parent: parent, fields: fieldsOf(v, skip)})
+	return id
+}
+
+func (c *collector) metadata(m *validation_root.Metadata) {
+	if m != nil {
+		c.add(TypeMetadata, "", "", m)
+	}
+}
+
+func (c *collector) catalog(cat *catalog.Catalog) {
+	c.metadata(cat.Metadata)
+	c.parameters(cat.Parameters, "")
+	c.controls(cat.Controls, "")
+	c.groups(cat.Groups, "")
+}
+
+func (c *collector) groups(groups []catalog.Group, parent string) {
+	for i, g := range groups {
+		skip := []string{"parameters", "parts", "groups", "controls"}
+		id := g.Id
+		if id == "" {
+			id = parent + "/" + c.addAnonymous(TypeGroup, "", i, parent, g, skip...)
+		} else {
+			c.add(TypeGroup, id, parent, g, skip...)
+		}
+		c.parameters(g.Parameters, id)
+		c.parts(g.Parts, id)
+		c.controls(g.Controls, id)
+		c.groups(g.Groups, id)
+	}
+}
+
+func (c *collector) controls(controls []catalog.Control, parent string) {
+	for _, ctl := range controls {
+		c.add(TypeControl, ctl.Id, parent, ctl, "parameters", "parts", "controls")
+		c.parameters(ctl.Parameters, ctl.Id)
+		c.parts(ctl.Parts, ctl.Id)
+		c.controls(ctl.Controls, ctl.Id)
+	}
+}
+
+func (c *collector) parts(parts []catalog.Part, parent string) {
+	for i, p := range parts {
+		id := p.Id
+		if id == "" {
+			id = parent + "/" + c.addAnonymous(TypePart, p.Name, i, parent, p, "parts")
+		} else {
+			c.add(TypePart, id, parent, p, "parts")
+		}
+		c.parts(p.Parts, id)
+	}
+}
+
+func (c *collector) parameters(params []catalog.Param, parent string) {
+	for i, p := range params {
+		if p.Id == "" {
+			c.addAnonymous(TypeParameter, "", i, parent, p)
+			continue
+		}
+		c.add(TypeParameter, p.Id, parent, p)
+	}
+}
+
+func (c *collector) profile(p *profile.Profile) {
+	c.metadata(p.Metadata)
+	for _, i := range p.Imports {
+		c.add(TypeImport, i.Href, "", i)
+	}
+	if p.Modify == nil {
+		return
+	}
+	for i, s := range p.Modify.ParameterSettings {
+		if s.ParamId == "" {
+			c.addAnonymous(TypeParameterSetting, "", i, "", s)
+			continue
+		}
+		c.add(TypeParameterSetting, s.ParamId, "", s)
+	}
+	for i, a := range p.Modify.Alterations {
+		if a.ControlId == "" {
+			c.addAnonymous(TypeAlteration, "", i, "", a)
+			continue
+		}
+		c.add(TypeAlteration, a.ControlId, "", a)
+	}
+}
+
+func (c *collector) ssp(s *ssp.SystemSecurityPlan) {
+	c.metadata(s.Metadata)
+	if s.ControlImplementation == nil {
+		return
+	}
+	for i, r := range s.ControlImplementation.ImplementedRequirements {
+		id := r.Id
+		if id == "" {
+			id = r.ControlId
+		}
+		if id == "" {
+			c.addAnonymous(TypeImplementedRequirement, "", i, "", r)
+			continue
+		}
+		c.add(TypeImplementedRequirement, id, "", r)
+	}
+}
+
+func (c *collector) component(d *component_definition.ComponentDefinition) {
+	c.metadata(d.Metadata)
+	for i, comp := range d.Components {
+		id := comp.Id
+		if id == "" {
+			id = c.addAnonymous(TypeComponent, "", i, "", comp, "control-implementations")
+		} else {
+			c.add(TypeComponent, id, "", comp, "control-implementations")
+		}
+		n := 0
+		for _, ci := range comp.ControlImplementations {
+			for _, set := range ci.CanMeetRequirementSets {
+				for _, r := range set.ImplementedRequirements {
+					reqID := r.Id
+					if reqID == "" {
+						reqID = r.ControlId
+					}
+					if reqID == "" {
+						c.addAnonymous(TypeImplementedRequirement, "", n, id, r)
+					} else {
+						// requirements are identified within their component
+						c.items = append(c.items, &item{
+							key:    key{TypeImplementedRequirement, id + "/" + reqID},
+							id:     reqID,
+							parent: id,
+							fields: append(fieldsOf(r, nil), field{"source", set.Source}),
+						})
+					}
+					n++
+				}
+			}
+		}
+	}
+}
+
+var markupType = reflect.TypeOf(validation_root.Markup{})
+
+// fieldsOf returns the non-empty fields of struct, named by their JSON names
+func fieldsOf(v interface{}, skip []string) []field {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	t := value.Type()
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if f.PkgPath != "" || name == "-" || contains(skip, name) {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if s := stringify(value.Field(i)); s != "" {
+			fields = append(fields, field{name, s})
+		}
+	}
+	return fields
+}
+
+// stringify returns prose as plain text, strings trimmed and other values as
+// compact JSON. Empty values are returned as empty string.
+func stringify(v reflect.Value) string {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	switch {
+	case v.Type() == markupType:
+		return v.Interface().(validation_root.Markup).Text()
+	case v.Kind() == reflect.String:
+		return strings.TrimSpace(v.String())
+	case v.Kind() == reflect.Slice && v.Len() == 0:
+		return ""
+	}
+	b, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprintf("%v", v.Interface())
+	}
+	if s := string(b); s != "null" && s != "{}" {
+		return s
+	}
+	return ""
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Report formats
+const (
+	FormatText     = "text"
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+)
+
+// Formats lists the supported report formats
+var Formats = []string{FormatText, FormatJSON, FormatMarkdown}
+
+// Write writes the diff in given format
+func Write(w io.Writer, format string, d *Diff) error {
+	switch format {
+	case FormatText:
+		return WriteText(w, d)
+	case FormatJSON:
+		return WriteJSON(w, d)
+	case FormatMarkdown:
+		return WriteMarkdown(w, d)
+	}
+	return fmt.Errorf("unsupported report format %q, expected one of %s", format, strings.Join(Formats, ", "))
+}
+
+// markers of the kinds of changes in text reports
+var markers = map[Kind]string{Added: "+", Removed: "-", Changed: "~"}
+
+// WriteText writes change per line, followed by the changed fields
+func WriteText(w io.Writer, d *Diff) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", d.Old, d.New)
+	for _, c := range d.Changes {
+		line := fmt.Sprintf("%s %s", markers[c.Kind], c.Type)
+		if c.ID != "" {
+			line += " " + c.ID
+		}
+		if c.Parent != "" {
+			line += " in " + c.Parent
+		}
+		fmt.Fprintln(&b, line)
+		for _, f := range c.Fields {
+			fmt.Fprintf(&b, "    %s: %q -> %q\n", f.Name, f.Old, f.New)
+		}
+	}
+	fmt.Fprintln(&b, summary(d))
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteJSON writes the diff as JSON document
+func WriteJSON(w io.Writer, d *Diff) error {
+	report := struct {
+		*Diff
+		Summary map[Kind]int `json:"summary"`
+	}{d, map[Kind]int{Added: d.Count(Added), Removed: d.Count(Removed), Changed: d.Count(Changed)}}
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(report)
+}
+
+// headings of the sections of Markdown reports in order
+var headings = []struct {
+	typ     string
+	heading string
+}{
+	{TypeMetadata, "Metadata"},
+	{TypeImport, "Imports"},
+	{TypeGroup, "Groups"},
+	{TypeControl, "Controls"},
+	{TypePart, "Parts"},
+	{TypeParameter, "Parameters"},
+	{TypeParameterSetting, "Parameter settings"},
+	{TypeAlteration, "Alterations"},
+	{TypeComponent, "Components"},
+	{TypeImplementedRequirement, "Implemented requirements"},
+}
+
+// WriteMarkdown writes the changes grouped by object type, as suitable for
+// review comments
+func WriteMarkdown(w io.Writer, d *Diff) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Changes from `%s` to `%s`\n\n%s\n", d.Old, d.New, summary(d))
+	for _, h := range headings {
+		var changes []Change
+		for _, c := range d.Changes {
+			if c.Type == h.typ {
+				changes = append(changes, c)
+			}
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n### %s\n\n", h.heading)
+		for _, c := range changes {
+			fmt.Fprintf(&b, "- **%s**", c.Kind)
+			if c.ID != "" {
+				fmt.Fprintf(&b, " `%s`", c.ID)
+			}
+			if c.Parent != "" {
+				fmt.Fprintf(&b, " in `%s`", c.Parent)
+			}
+			b.WriteString("\n")
+			for _, f := range c.Fields {
+				fmt.Fprintf(&b, "  - %s: %s → %s\n", f.Name, markdownValue(f.Old), markdownValue(f.New))
+			}
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownValue quotes value as inline code
+func markdownValue(value string) string {
+	if value == "" {
+		return "_none_"
+	}
+	fence := "`"
+	for strings.Contains(value, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(value, "`") || strings.HasSuffix(value, "`") {
+		value = " " + value + " "
+	}
+	return fence + value + fence
+}
+
+func summary(d *Diff) string {
+	if d.Empty() {
+		return "No changes"
+	}
+	return fmt.Sprintf("%d changes: %d added, %d removed, %d changed", len(d.Changes), d.Count(Added), d.Count(Removed), d.Count(Changed))
+}
@@ -0,0 +1,540 @@
+// Package docx exports system security plans as Word documents in the
+// layout of the FedRAMP SSP template. The documents are written as Office
+// Open XML packages with the standard library only.
+package docx
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	ssp "github.com/docker/oscalkit/types/oscal/system_security_plan"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+// Names of the annotations and properties of the FedRAMP control summaries
+const (
+	ImplementationStatus = "implementation-status"
+	ControlOrigination   = "control-origination"
+)
+
+// implementationStatuses are the implementation statuses of the FedRAMP
+// template, in the order of the check boxes
+var implementationStatuses = []choice{
+	{"implemented", "Implemented"},
+	{"partial", "Partially implemented"},
+	{"planned", "Planned"},
+	{"alternative", "Alternative implementation"},
+	{"not-applicable", "Not applicable"},
+}
+
+// controlOriginations are the control originations of the FedRAMP template
+var controlOriginations = []choice{
+	{"sp-corporate", "Service Provider Corporate"},
+	{"sp-system", "Service Provider System Specific"},
+	{"shared", "Service Provider Hybrid (Corporate and System Specific)"},
+	{"customer-configured", "Configured by Customer (Customer System Specific)"},
+	{"customer-provided", "Provided by Customer (Customer System Specific)"},
+	{"inherited", "Inherited from pre-existing FedRAMP Authorization"},
+}
+
+const (
+	checked   = "☒ "
+	unchecked = "☐ "
+)
+
+type choice struct {
+	value string
+	label string
+}
+
+// Write writes the system security plan as DOCX document
+func Write(w io.Writer, plan *ssp.SystemSecurityPlan) error {
+	if plan == nil {
+		return errors.New("no system security plan to export")
+	}
+	e := newExporter(plan)
+	e.titlePage()
+	if c := plan.SystemCharacteristics; c != nil {
+		e.systemCharacteristics(c)
+	}
+	if i := plan.SystemImplementation; i != nil {
+		e.systemImplementation(i)
+	}
+	if c := plan.ControlImplementation; c != nil {
+		e.controlImplementation(c)
+	}
+	if e.err != nil {
+		return e.err
+	}
+	return e.doc.write(w)
+}
+
+// exporter lays out the plan in the document. The first error of the prose
+// conversions is kept in err.
+type exporter struct {
+	plan       *ssp.SystemSecurityPlan
+	doc        document
+	roles      map[string]string
+	parties    map[string]string
+	components map[string]string
+	err        error
+}
+
+func newExporter(plan *ssp.SystemSecurityPlan) *exporter {
+	e := &exporter{
+		plan:       plan,
+		roles:      map[string]string{},
+		parties:    map[string]string{},
+		components: map[string]string{},
+	}
+	if m := plan.Metadata; m != nil {
+		e.doc.title = string(m.Title)
+		e.doc.modified = string(m.LastModified)
+		for _, r := range m.Roles {
+			e.roles[r.Id] = string(r.Title)
+		}
+		for _, p := range m.Parties {
+			e.parties[p.Id] = partyName(p)
+		}
+	}
+	if i := plan.SystemImplementation; i != nil {
+		for _, c := range i.Components {
+			e.components[c.Id] = string(c.Title)
+		}
+	}
+	return e
+}
+
+func (e *exporter) prose(m *validation_root.Markup) []block {
+	blocks, err := prose(m)
+	if err != nil && e.err == nil {
+		e.err = err
+	}
+	return blocks
+}
+
+func (e *exporter) titlePage() {
+	title := e.doc.title
+	if title == "" {
+		title = e.plan.Id
+	}
+	e.doc.add(newParagraph("Title", title))
+	e.doc.add(newParagraph("Subtitle", "System Security Plan"))
+	m := e.plan.Metadata
+	if m == nil {
+		return
+	}
+	t := &table{widths: []int{35, 65}}
+	t.addRow("Version", string(m.Version))
+	t.addRow("Published", string(m.Published))
+	t.addRow("Last modified", string(m.LastModified))
+	t.addRow("OSCAL version", string(m.OscalVersion))
+	if e.plan.ImportProfile != nil {
+		t.addRow("Baseline", e.plan.ImportProfile.Href)
+	}
+	e.doc.add(t)
+}
+
+func (e *exporter) systemCharacteristics(c *ssp.SystemCharacteristics) {
+	e.doc.heading(1, "Information System Name and Title")
+	t := &table{widths: []int{35, 65}}
+	var ids []string
+	for _, id := range c.SystemIds {
+		ids = append(ids, id.Value)
+	}
+	t.addRow("Unique identifier", strings.Join(ids, "\n"))
+	t.addRow("Information system name", string(c.SystemName))
+	t.addRow("Information system abbreviation", string(c.SystemNameShort))
+	t.addRow("Date authorized", string(c.DateAuthorized))
+	e.doc.add(t)
+
+	e.doc.heading(1, "Information System Categorization")
+	if c.SecuritySensitivityLevel != "" {
+		e.doc.text("Security sensitivity level: " + impact(string(c.SecuritySensitivityLevel)))
+	}
+	if c.SystemInformation != nil && len(c.SystemInformation.InformationTypes) > 0 {
+		e.doc.heading(2, "Information Types")
+		e.informationTypes(c.SystemInformation.InformationTypes)
+	}
+	if l := c.SecurityImpactLevel; l != nil {
+		e.doc.heading(2, "Security Objectives Categorization (FIPS 199)")
+		t := &table{widths: []int{50, 50}}
+		t.addHeader("Security Objective", "Low, Moderate or High")
+		t.addRow("Confidentiality", impact(string(l.SecurityObjectiveConfidentiality)))
+		t.addRow("Integrity", impact(string(l.SecurityObjectiveIntegrity)))
+		t.addRow("Availability", impact(string(l.SecurityObjectiveAvailability)))
+		e.doc.add(t)
+	}
+
+	if len(c.ResponsibleParties) > 0 {
+		e.doc.heading(1, "Responsible Parties")
+		t := &table{widths: []int{35, 65}}
+		t.addHeader("Role", "Parties")
+		for _, p := range c.ResponsibleParties {
+			t.addRow(e.role(p.RoleId), e.partyNames(p.PartyIds))
+		}
+		e.doc.add(t)
+	}
+
+	if s := c.Status; s != nil {
+		e.doc.heading(1, "Information System Operational Status")
+		e.doc.text(statusLabel(s.State))
+		e.doc.add(e.prose(s.Remarks)...)
+	}
+
+	if len(c.LeveragedAuthorizations) > 0 {
+		e.doc.heading(1, "Leveraged FedRAMP Authorizations")
+		t := &table{widths: []int{45, 35, 20}}
+		t.addHeader("Leveraged Information System", "Service Provider Owner", "Date Granted")
+		for _, a := range c.LeveragedAuthorizations {
+			t.addRow(string(a.Title), e.partyNames([]validation_root.PartyId{a.PartyId}), string(a.DateAuthorized))
+		}
+		e.doc.add(t)
+	}
+
+	e.doc.heading(1, "General System Description")
+	e.doc.add(e.prose(c.Description)...)
+	if b := c.AuthorizationBoundary; b != nil {
+		e.doc.heading(2, "Authorization Boundary")
+		e.doc.add(e.prose(b.Description)...)
+	}
+	if n := c.NetworkArchitecture; n != nil {
+		e.doc.heading(2, "Network Architecture")
+		e.doc.add(e.prose(n.Description)...)
+	}
+	if d := c.DataFlow; d != nil {
+		e.doc.heading(2, "Data Flow")
+		e.doc.add(e.prose(d.Description)...)
+	}
+	e.doc.add(e.prose(c.Remarks)...)
+}
+
+// informationTypes adds the table of the information types with the base and
+// selected impacts on the security objectives
+func (e *exporter) informationTypes(types []ssp.InformationType) {
+	t := &table{widths: []int{28, 18, 18, 18, 18}}
+	t.addHeader("Information Type", "NIST SP 800-60 Identifier", "Confidentiality", "Integrity", "Availability")
+	for _, it := range types {
+		var ids []string
+		for _, id := range it.InformationTypeIds {
+			ids = append(ids, id.Value)
+		}
+		name := cell{blocks: []block{&paragraph{runs: []run{{text: string(it.Title), bold: true}}}}}
+		name.blocks = append(name.blocks, e.prose(it.Description)...)
+		r := row{cells: []cell{name, textCell(strings.Join(ids, "\n"))}}
+		if i := it.ConfidentialityImpact; i != nil {
+			r.cells = append(r.cells, e.impactCell(string(i.Base), string(i.Selected), i.AdjustmentJustification))
+		} else {
+			r.cells = append(r.cells, cell{})
+		}
+		if i := it.IntegrityImpact; i != nil {
+			r.cells = append(r.cells, e.impactCell(string(i.Base), string(i.Selected), i.AdjustmentJustification))
+		} else {
+			r.cells = append(r.cells, cell{})
+		}
+		if i := it.AvailabilityImpact; i != nil {
+			r.cells = append(r.cells, e.impactCell(string(i.Base), string(i.Selected), i.AdjustmentJustification))
+		} else {
+			r.cells = append(r.cells, cell{})
+		}
+		t.rows = append(t.rows, r)
+	}
+	e.doc.add(t)
+}
+
+// impactCell holds the selected impact, and the base impact and the
+// justification when the impact was adjusted
+func (e *exporter) impactCell(base, selected string, justification *ssp.AdjustmentJustification) cell {
+	if selected == "" || selected == base {
+		return textCell(impact(base))
+	}
+	c := textCell(impact(selected))
+	if base != "" {
+		c.blocks = append(c.blocks, &paragraph{runs: []run{{text: "Base: " + impact(base), italic: true}}})
+	}
+	return cell{blocks: append(c.blocks, e.prose(justification)...)}
+}
+
+func (e *exporter) systemImplementation(i *ssp.SystemImplementation) {
+	e.doc.heading(1, "System Environment")
+	if len(i.Users) > 0 {
+		e.doc.heading(2, "Users")
+		t := &table{widths: []int{25, 25, 50}}
+		t.addHeader("User", "Roles", "Authorized Privileges")
+		for _, u := range i.Users {
+			var roles, privileges []string
+			for _, id := range u.RoleIds {
+				roles = append(roles, e.role(string(id)))
+			}
+			for _, p := range u.AuthorizedPrivileges {
+				privileges = append(privileges, string(p.Title))
+			}
+			t.addRow(componentTitle(u.Title, u.Id), strings.Join(roles, "\n"), strings.Join(privileges, "\n"))
+		}
+		e.doc.add(t)
+	}
+	if len(i.Components) > 0 {
+		e.doc.heading(2, "Components")
+		t := &table{widths: []int{25, 15, 15, 45}}
+		t.addHeader("Component", "Type", "Status", "Description")
+		for _, c := range i.Components {
+			status := ""
+			if c.Status != nil {
+				status = statusLabel(c.Status.State)
+			}
+			t.rows = append(t.rows, row{cells: []cell{
+				textCell(componentTitle(c.Title, c.Id)),
+				textCell(c.ComponentType),
+				textCell(status),
+				{blocks: e.prose(c.Description)},
+			}})
+		}
+		e.doc.add(t)
+	}
+}
+
+func (e *exporter) controlImplementation(c *ssp.ControlImplementation) {
+	e.doc.heading(1, "Minimum Security Controls")
+	e.doc.add(e.prose(c.Description)...)
+	for _, r := range c.ImplementedRequirements {
+		e.implementedRequirement(r)
+	}
+}
+
+// implementedRequirement adds the control summary table and the table of the
+// implementation of the requirement, as in the FedRAMP template
+func (e *exporter) implementedRequirement(r ssp.ImplementedRequirement) {
+	control := controlLabel(r.ControlId)
+	e.doc.heading(2, control)
+
+	summary := &table{widths: []int{35, 65}}
+	summary.addTitle(control + " Control Summary Information")
+	summary.addRow("Responsible Role:", e.responsibleRoles(r.ResponsibleRoles))
+	for _, p := range r.ParameterSettings {
+		summary.addRow("Parameter "+p.ParamId+":", string(p.Value))
+	}
+	statuses := annotations(r.Annotations, r.Properties, ImplementationStatus)
+	summary.rows = append(summary.rows, row{cells: []cell{
+		textCell("Implementation Status (check all that apply):"),
+		checkBoxes(implementationStatuses, statuses),
+	}})
+	if originations := annotations(r.Annotations, r.Properties, ControlOrigination); len(originations) > 0 {
+		summary.rows = append(summary.rows, row{cells: []cell{
+			textCell("Control Origination (check all that apply):"),
+			checkBoxes(controlOriginations, originations),
+		}})
+	}
+	if r.Remarks != nil {
+		summary.rows = append(summary.rows, row{cells: []cell{textCell("Remarks:"), {blocks: e.prose(r.Remarks)}}})
+	}
+	e.doc.add(summary)
+	e.doc.add(&paragraph{})
+
+	solution := &table{widths: []int{20, 80}}
+	solution.addTitle(control + " What is the solution and how is it implemented?")
+	if r.Description != nil || len(r.ByComponents) > 0 {
+		blocks := append(e.prose(r.Description), e.byComponents(r.ByComponents)...)
+		solution.rows = append(solution.rows, row{cells: []cell{textCell(control), {blocks: blocks}}})
+	}
+	for _, s := range r.Statements {
+		blocks := append(e.prose(s.Description), e.byComponents(s.ByComponents)...)
+		if len(s.ResponsibleRoles) > 0 {
+			blocks = append(blocks, newParagraph("", "Responsible Role: "+e.responsibleRoles(s.ResponsibleRoles)))
+		}
+		blocks = append(blocks, e.prose(s.Remarks)...)
+		solution.rows = append(solution.rows, row{cells: []cell{textCell(statementLabel(s.StatementId)), {blocks: blocks}}})
+	}
+	if len(solution.rows) > 1 {
+		e.doc.add(solution)
+	}
+}
+
+// byComponents returns the descriptions of the implementation by the
+// components, each headed by the title of the component
+func (e *exporter) byComponents(components []ssp.ByComponent) []block {
+	var blocks []block
+	for _, c := range components {
+		title := e.components[c.ComponentId]
+		if title == "" {
+			title = c.ComponentId
+		}
+		blocks = append(blocks, &paragraph{runs: []run{{text: title, bold: true}}})
+		blocks = append(blocks, e.prose(c.Description)...)
+		for _, p := range c.ParameterSettings {
+			blocks = append(blocks, newParagraph("", "Parameter "+p.ParamId+": "+string(p.Value)))
+		}
+	}
+	return blocks
+}
+
+func (e *exporter) role(id string) string {
+	if title := e.roles[id]; title != "" {
+		return title
+	}
+	return id
+}
+
+// responsibleRoles returns the titles of the roles, each followed by the
+// names of the parties in the role
+func (e *exporter) responsibleRoles(roles []ssp.ResponsibleRole) string {
+	var names []string
+	for _, r := range roles {
+		name := e.role(r.RoleId)
+		if len(r.PartyIds) > 0 {
+			name += " (" + e.partyNames(r.PartyIds) + ")"
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func (e *exporter) partyNames(ids []validation_root.PartyId) string {
+	var names []string
+	for _, id := range ids {
+		if name := e.parties[string(id)]; name != "" {
+			names = append(names, name)
+		} else if id != "" {
+			names = append(names, string(id))
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func (t *table) addHeader(labels ...string) {
+	r := row{header: true}
+	for _, label := range labels {
+		r.cells = append(r.cells, cell{blocks: []block{&paragraph{runs: []run{{text: label, bold: true}}}}})
+	}
+	t.rows = append(t.rows, r)
+}
+
+// addTitle adds header row spanning all the columns
+func (t *table) addTitle(title string) {
+	t.rows = append(t.rows, row{header: true, cells: []cell{{
+		blocks: []block{&paragraph{runs: []run{{text: title, bold: true}}}},
+		span:   len(t.widths),
+	}}})
+}
+
+// addRow adds row of text cells, skipping rows of empty values
+func (t *table) addRow(label string, values ...string) {
+	empty := true
+	r := row{cells: []cell{textCell(label)}}
+	for _, v := range values {
+		empty = empty && v == ""
+		r.cells = append(r.cells, textCell(v))
+	}
+	if !empty {
+		t.rows = append(t.rows, r)
+	}
+}
+
+// checkBoxes lists the choices with the values checked. Values unknown to
+// the template are listed checked after the choices.
+func checkBoxes(choices []choice, values []string) cell {
+	selected := map[string]bool{}
+	for _, v := range values {
+		selected[v] = true
+	}
+	var c cell
+	for _, ch := range choices {
+		box := unchecked
+		if selected[ch.value] {
+			box = checked
+			delete(selected, ch.value)
+		}
+		c.blocks = append(c.blocks, newParagraph("", box+ch.label))
+	}
+	for _, v := range values {
+		if selected[v] {
+			c.blocks = append(c.blocks, newParagraph("", checked+v))
+			delete(selected, v)
+		}
+	}
+	return c
+}
+
+// annotations returns the values of the annotations and properties of the
+// name, in any namespace
+func annotations(annotations []ssp.Annotation, props []ssp.Prop, name string) []string {
+	var values []string
+	for _, a := range annotations {
+		if a.Name == name && a.Value != "" {
+			values = append(values, a.Value)
+		}
+	}
+	for _, p := range props {
+		if p.Name == name && p.Value != "" {
+			values = append(values, p.Value)
+		}
+	}
+	return values
+}
+
+// impact returns label of FIPS-199 impact level such as fips-199-moderate
+func impact(level string) string {
+	level = strings.TrimPrefix(strings.ToLower(level), "fips-199-")
+	if level == "" {
+		return ""
+	}
+	return strings.ToUpper(level[:1]) + level[1:]
+}
+
+func statusLabel(state string) string {
+	if state == "" {
+		return ""
+	}
+	state = strings.Replace(state, "-", " ", -1)
+	return strings.ToUpper(state[:1]) + state[1:]
+}
+
+// controlLabel returns control id as in the FedRAMP template, such as AC-2 (1)
+// for ac-2.1
+func controlLabel(id string) string {
+	parts := strings.Split(strings.ToUpper(id), ".")
+	label := parts[0]
+	for i, p := range parts[1:] {
+		if i == 0 {
+			label += " "
+		}
+		label += "(" + p + ")"
+	}
+	return label
+}
+
+// statementLabel returns label of the statement, such as Part a for
+// ac-1_stmt.a or ac-1_smt.a
+func statementLabel(id string) string {
+	for _, marker := range []string{"_stmt", "_smt"} {
+		i := strings.LastIndex(id, marker)
+		if i < 0 {
+			continue
+		}
+		part := strings.TrimPrefix(id[i+len(marker):], ".")
+		if part == "" {
+			return "Statement"
+		}
+		return "Part " + part
+	}
+	return id
+}
+
+func componentTitle(title validation_root.Title, id string) string {
+	if title != "" {
+		return string(title)
+	}
+	return id
+}
+
+func partyName(p validation_root.Party) string {
+	if p.Org != nil && p.Org.OrgName != "" {
+		return string(p.Org.OrgName)
+	}
+	for _, person := range p.Persons {
+		if person.PersonName != "" {
+			return string(person.PersonName)
+		}
+	}
+	return p.Id
+}
@@ -0,0 +1,207 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/types/oscal"
+)
+
+// unzip writes the plan of the test data and returns the parts of the package
+func unzip(t *testing.T, name string) map[string]string {
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	o, err := oscal.New(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := Write(&b, o.SystemSecurityPlan); err != nil {
+		t.Fatal(err)
+	}
+	z, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := map[string]string{}
+	for _, file := range z.File {
+		r, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts[file.Name] = string(content)
+	}
+	return parts
+}
+
+// paragraphs returns the text of the paragraphs of the document, line breaks
+// as new lines
+func paragraphs(t *testing.T, document string) []string {
+	var result []string
+	var text strings.Builder
+	inText := false
+	d := xml.NewDecoder(strings.NewReader(document))
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return result
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			switch token.Name.Local {
+			case "p":
+				text.Reset()
+			case "t":
+				inText = true
+			case "br":
+				text.WriteString("\n")
+			}
+		case xml.EndElement:
+			switch token.Name.Local {
+			case "p":
+				result = append(result, text.String())
+			case "t":
+				inText = false
+			}
+		case xml.CharData:
+			if inText {
+				text.Write(token)
+			}
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	parts := unzip(t, "ssp.xml")
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "docProps/core.xml", "word/_rels/document.xml.rels", "word/styles.xml", "word/document.xml"} {
+		content, ok := parts[name]
+		if !ok {
+			t.Fatalf("%s missing from package", name)
+		}
+		d := xml.NewDecoder(strings.NewReader(content))
+		for {
+			_, err := d.Token()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("%s: %s", name, err)
+			}
+		}
+	}
+	if !strings.Contains(parts["docProps/core.xml"], "<dc:title>Sample system &amp; services</dc:title>") {
+		t.Errorf("title not in core properties:\n%s", parts["docProps/core.xml"])
+	}
+
+	document := parts["word/document.xml"]
+	for _, s := range []string{
+		`<w:p><w:pPr><w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t xml:space="preserve">Minimum Security Controls</w:t></w:r></w:p>`,
+		`<w:p><w:pPr><w:pStyle w:val="Heading2"/></w:pPr><w:r><w:t xml:space="preserve">AC-2 (1)</w:t></w:r></w:p>`,
+		`<w:gridSpan w:val="2"/>`,
+		`<w:trPr><w:tblHeader/></w:trPr>`,
+		`<w:r><w:rPr><w:b/></w:rPr><w:t xml:space="preserve">sample</w:t></w:r>`,
+		`<w:r><w:rPr><w:i/></w:rPr><w:t xml:space="preserve">administrator</w:t></w:r>`,
+	} {
+		if !strings.Contains(document, s) {
+			t.Errorf("%s not found in document", s)
+		}
+	}
+
+	found := map[string]bool{}
+	for _, p := range paragraphs(t, document) {
+		found[p] = true
+	}
+	for _, p := range []string{
+		"Sample system & services",
+		"F00000000",
+		"Security sensitivity level: Moderate",
+		// information type with adjusted confidentiality impact
+		"Personnel Records",
+		"D.8.1",
+		"High",
+		"Base: Moderate",
+		"Holds social security numbers.",
+		"Low",
+		"System Owner",
+		"ACME Corp",
+		"Under development",
+		"Hosts the sample application.",
+		"• Web tier",
+		"The boundary of the system.",
+		"Operational",
+		// control summary
+		"AC-1 Control Summary Information",
+		"Administrator (ACME Corp)",
+		"Parameter ac-1_prm_1:",
+		"quarterly",
+		"☐ Implemented",
+		"☒ Partially implemented",
+		"☒ Planned",
+		"☒ Service Provider System Specific",
+		"☐ Inherited from pre-existing FedRAMP Authorization",
+		// implementation
+		"AC-1 What is the solution and how is it implemented?",
+		"Part a",
+		"Policies are reviewed by the administrator.",
+		"Web server",
+		"Configured by policy.",
+		"Part b",
+		"AC-2 (1) What is the solution and how is it implemented?",
+		"Accounts are managed automatically.",
+		"☒ Implemented",
+	} {
+		if !found[p] {
+			t.Errorf("paragraph %q not found", p)
+		}
+	}
+	if strings.Contains(document, "AC-2 (1) Control Origination") || strings.Count(document, "Control Origination") != 1 {
+		t.Error("control origination should be listed only for controls with origination")
+	}
+}
+
+func TestWriteEmpty(t *testing.T) {
+	if err := Write(ioutil.Discard, nil); err == nil {
+		t.Error("missing plan should fail")
+	}
+}
+
+func TestLabels(t *testing.T) {
+	for id, expected := range map[string]string{
+		"ac-1":        "AC-1",
+		"ac-2.1":      "AC-2 (1)",
+		"si-4.4.2":    "SI-4 (4)(2)",
+		"ac-1_stmt":   "Statement",
+		"ac-1_stmt.a": "Part a",
+		"ac-1_smt.b":  "Part b",
+		"custom":      "custom",
+	} {
+		label := controlLabel(id)
+		if strings.Contains(id, "_") || id == "custom" {
+			label = statementLabel(id)
+		}
+		if label != expected {
+			t.Errorf("label of %s is %s, expected %s", id, label, expected)
+		}
+	}
+	if impact("fips-199-moderate") != "Moderate" || impact("") != "" {
+		t.Error("unexpected impact label")
+	}
+}
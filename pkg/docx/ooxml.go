@@ -0,0 +1,279 @@
+package docx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// textWidth is the width of the text of Letter page with 1 inch margins,
+// in twentieths of a point
+const textWidth = 9360
+
+// block is a paragraph or table of the document body
+type block interface {
+	writeXML(b *strings.Builder)
+}
+
+// run is text of single formatting
+type run struct {
+	text   string
+	bold   bool
+	italic bool
+	code   bool
+	// sub and sup shift the text down or up
+	sub, sup bool
+}
+
+// paragraph of runs. Indent is left indentation in twentieths of a point.
+type paragraph struct {
+	style  string
+	indent int
+	runs   []run
+}
+
+func newParagraph(style, text string) *paragraph {
+	return &paragraph{style: style, runs: []run{{text: text}}}
+}
+
+func (p *paragraph) writeXML(b *strings.Builder) {
+	b.WriteString("<w:p>")
+	if p.style != "" || p.indent > 0 {
+		b.WriteString("<w:pPr>")
+		if p.style != "" {
+			fmt.Fprintf(b, `<w:pStyle w:val="%s"/>`, p.style)
+		}
+		if p.indent > 0 {
+			fmt.Fprintf(b, `<w:ind w:left="%d"/>`, p.indent)
+		}
+		b.WriteString("</w:pPr>")
+	}
+	for _, r := range p.runs {
+		r.writeXML(b)
+	}
+	b.WriteString("</w:p>")
+}
+
+func (r run) writeXML(b *strings.Builder) {
+	b.WriteString("<w:r>")
+	if r.bold || r.italic || r.code || r.sub || r.sup {
+		b.WriteString("<w:rPr>")
+		if r.code {
+			b.WriteString(`<w:rFonts w:ascii="Courier New" w:hAnsi="Courier New" w:cs="Courier New"/>`)
+		}
+		if r.bold {
+			b.WriteString("<w:b/>")
+		}
+		if r.italic {
+			b.WriteString("<w:i/>")
+		}
+		if r.sub {
+			b.WriteString(`<w:vertAlign w:val="subscript"/>`)
+		} else if r.sup {
+			b.WriteString(`<w:vertAlign w:val="superscript"/>`)
+		}
+		b.WriteString("</w:rPr>")
+	}
+	for i, line := range strings.Split(r.text, "\n") {
+		if i > 0 {
+			b.WriteString("<w:br/>")
+		}
+		b.WriteString(`<w:t xml:space="preserve">`)
+		xml.EscapeText(b, []byte(line))
+		b.WriteString("</w:t>")
+	}
+	b.WriteString("</w:r>")
+}
+
+// cell of table. Span is the number of columns the cell spans.
+type cell struct {
+	blocks []block
+	span   int
+}
+
+func textCell(text string) cell {
+	return cell{blocks: []block{newParagraph("", text)}}
+}
+
+// row of table. Header rows are shaded and repeated on every page.
+type row struct {
+	header bool
+	cells  []cell
+}
+
+// table with columns of the given widths, in percent of the text width
+type table struct {
+	widths []int
+	rows   []row
+}
+
+func (t *table) writeXML(b *strings.Builder) {
+	if len(t.rows) == 0 {
+		return
+	}
+	b.WriteString(`<w:tbl><w:tblPr><w:tblStyle w:val="TableGrid"/><w:tblW w:w="5000" w:type="pct"/></w:tblPr><w:tblGrid>`)
+	widths := make([]int, len(t.widths))
+	for i, w := range t.widths {
+		widths[i] = textWidth * w / 100
+		fmt.Fprintf(b, `<w:gridCol w:w="%d"/>`, widths[i])
+	}
+	b.WriteString("</w:tblGrid>")
+	for _, r := range t.rows {
+		b.WriteString("<w:tr>")
+		if r.header {
+			b.WriteString("<w:trPr><w:tblHeader/></w:trPr>")
+		}
+		column := 0
+		for _, c := range r.cells {
+			span := c.span
+			if span < 1 {
+				span = 1
+			}
+			width := 0
+			for i := column; i < column+span && i < len(widths); i++ {
+				width += widths[i]
+			}
+			column += span
+			fmt.Fprintf(b, `<w:tc><w:tcPr><w:tcW w:w="%d" w:type="dxa"/>`, width)
+			if span > 1 {
+				fmt.Fprintf(b, `<w:gridSpan w:val="%d"/>`, span)
+			}
+			if r.header {
+				b.WriteString(`<w:shd w:val="clear" w:color="auto" w:fill="DBE5F1"/>`)
+			}
+			b.WriteString("</w:tcPr>")
+			if len(c.blocks) == 0 {
+				// cells must hold a paragraph
+				c.blocks = []block{&paragraph{}}
+			}
+			for _, block := range c.blocks {
+				block.writeXML(b)
+			}
+			if _, ok := c.blocks[len(c.blocks)-1].(*table); ok {
+				// and end by a paragraph
+				b.WriteString("<w:p/>")
+			}
+			b.WriteString("</w:tc>")
+		}
+		b.WriteString("</w:tr>")
+	}
+	b.WriteString("</w:tbl>")
+}
+
+// document is a Word document of blocks
+type document struct {
+	title    string
+	modified string
+	blocks   []block
+}
+
+func (d *document) add(blocks ...block) {
+	d.blocks = append(d.blocks, blocks...)
+}
+
+// heading adds heading of level 1 to 3
+func (d *document) heading(level int, text string) {
+	d.add(newParagraph(fmt.Sprintf("Heading%d", level), text))
+}
+
+// text adds paragraph of plain text
+func (d *document) text(text string) {
+	d.add(newParagraph("", text))
+}
+
+// write writes the document as Office Open XML package
+func (d *document) write(w io.Writer) error {
+	var body strings.Builder
+	body.WriteString(xml.Header)
+	body.WriteString(`<w:document xmlns:w="` + nsWordprocessingML + `"><w:body>`)
+	for _, block := range d.blocks {
+		block.writeXML(&body)
+	}
+	body.WriteString(`<w:sectPr><w:pgSz w:w="12240" w:h="15840"/><w:pgMar w:top="1440" w:right="1440" w:bottom="1440" w:left="1440" w:header="720" w:footer="720" w:gutter="0"/></w:sectPr>`)
+	body.WriteString("</w:body></w:document>")
+
+	var core strings.Builder
+	core.WriteString(xml.Header)
+	core.WriteString(`<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:dcterms="http://purl.org/dc/terms/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><dc:title>`)
+	xml.EscapeText(&core, []byte(d.title))
+	core.WriteString("</dc:title>")
+	if d.modified != "" {
+		core.WriteString(`<dcterms:modified xsi:type="dcterms:W3CDTF">`)
+		xml.EscapeText(&core, []byte(d.modified))
+		core.WriteString("</dcterms:modified>")
+	}
+	core.WriteString("</cp:coreProperties>")
+
+	z := zip.NewWriter(w)
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypes},
+		{"_rels/.rels", packageRels},
+		{"docProps/core.xml", core.String()},
+		{"word/_rels/document.xml.rels", documentRels},
+		{"word/styles.xml", styles},
+		{"word/document.xml", body.String()},
+	}
+	for _, part := range parts {
+		f, err := z.CreateHeader(&zip.FileHeader{Name: part.name, Method: zip.Deflate, Modified: epoch})
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return err
+		}
+	}
+	return z.Close()
+}
+
+// epoch is the modification time of the parts, fixed so that the same plan
+// always gives the same package
+var epoch = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+const nsWordprocessingML = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+
+const contentTypes = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>` +
+	`<Override PartName="/word/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"/>` +
+	`<Override PartName="/docProps/core.xml" ContentType="application/vnd.openxmlformats-package.core-properties+xml"/>` +
+	`</Types>`
+
+const packageRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties" Target="docProps/core.xml"/>` +
+	`</Relationships>`
+
+const documentRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+// styles defines the paragraph styles used by the documents. Headings have
+// outline levels, so that they appear in the navigation pane and tables of
+// contents of Word.
+const styles = xml.Header + `<w:styles xmlns:w="` + nsWordprocessingML + `">` +
+	`<w:docDefaults><w:rPrDefault><w:rPr><w:rFonts w:ascii="Calibri" w:hAnsi="Calibri" w:cs="Calibri"/><w:sz w:val="22"/></w:rPr></w:rPrDefault>` +
+	`<w:pPrDefault><w:pPr><w:spacing w:after="120"/></w:pPr></w:pPrDefault></w:docDefaults>` +
+	`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Title"><w:name w:val="Title"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/>` +
+	`<w:pPr><w:spacing w:after="240"/></w:pPr><w:rPr><w:b/><w:sz w:val="48"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Subtitle"><w:name w:val="Subtitle"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/>` +
+	`<w:rPr><w:sz w:val="32"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Heading1"><w:name w:val="heading 1"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/>` +
+	`<w:pPr><w:keepNext/><w:spacing w:before="360" w:after="120"/><w:outlineLvl w:val="0"/></w:pPr><w:rPr><w:b/><w:sz w:val="32"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Heading2"><w:name w:val="heading 2"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/>` +
+	`<w:pPr><w:keepNext/><w:spacing w:before="240" w:after="120"/><w:outlineLvl w:val="1"/></w:pPr><w:rPr><w:b/><w:sz w:val="28"/></w:rPr></w:style>` +
+	`<w:style w:type="paragraph" w:styleId="Heading3"><w:name w:val="heading 3"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/>` +
+	`<w:pPr><w:keepNext/><w:spacing w:before="240" w:after="120"/><w:outlineLvl w:val="2"/></w:pPr><w:rPr><w:b/><w:sz w:val="24"/></w:rPr></w:style>` +
+	`<w:style w:type="table" w:styleId="TableGrid"><w:name w:val="Table Grid"/><w:pPr><w:spacing w:after="0"/></w:pPr>` +
+	`<w:tblPr><w:tblBorders><w:top w:val="single" w:sz="4" w:space="0" w:color="auto"/><w:left w:val="single" w:sz="4" w:space="0" w:color="auto"/>` +
+	`<w:bottom w:val="single" w:sz="4" w:space="0" w:color="auto"/><w:right w:val="single" w:sz="4" w:space="0" w:color="auto"/>` +
+	`<w:insideH w:val="single" w:sz="4" w:space="0" w:color="auto"/><w:insideV w:val="single" w:sz="4" w:space="0" w:color="auto"/></w:tblBorders>` +
+	`<w:tblCellMar><w:left w:w="108" w:type="dxa"/><w:right w:w="108" w:type="dxa"/></w:tblCellMar></w:tblPr></w:style>` +
+	`</w:styles>`
@@ -0,0 +1,160 @@
+package docx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/docker/oscalkit/types/oscal/markup"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+// indentation of list items, in twentieths of a point
+const listIndent = 360
+
+// prose converts the prose to paragraphs and tables. Parameter inserts are
+// rendered by the parameter ids, as the values are not known to the plan.
+func prose(m *validation_root.Markup) ([]block, error) {
+	if m == nil {
+		return nil, nil
+	}
+	fragment, err := m.Fragment()
+	if err != nil {
+		return nil, err
+	}
+	var blocks []block
+	var inline []*markup.Node
+	flush := func() {
+		if len(inline) > 0 {
+			blocks = append(blocks, &paragraph{runs: runs(inline, run{})})
+			inline = nil
+		}
+	}
+	for _, n := range fragment.Nodes {
+		if n.Kind == markup.ElementNode && markup.IsBlock(n.Name) {
+			flush()
+			blocks = append(blocks, proseBlocks(n, 0)...)
+			continue
+		}
+		inline = append(inline, n)
+	}
+	flush()
+	return blocks, nil
+}
+
+func proseBlocks(n *markup.Node, indent int) []block {
+	switch n.Name {
+	case "ul", "ol":
+		var blocks []block
+		number := 0
+		for _, li := range n.Children {
+			if li.Kind != markup.ElementNode {
+				continue
+			}
+			number++
+			bullet := "• "
+			if n.Name == "ol" {
+				bullet = fmt.Sprintf("%d. ", number)
+			}
+			item := &paragraph{indent: indent + listIndent, runs: []run{{text: bullet}}}
+			blocks = append(blocks, item)
+			for _, child := range li.Children {
+				if child.Kind == markup.ElementNode && (child.Name == "ul" || child.Name == "ol") {
+					blocks = append(blocks, proseBlocks(child, indent+listIndent)...)
+					continue
+				}
+				item.runs = append(item.runs, runs([]*markup.Node{child}, run{})...)
+			}
+		}
+		return blocks
+	case "table":
+		t := &table{}
+		for _, tr := range n.Children {
+			if tr.Kind != markup.ElementNode {
+				continue
+			}
+			r := row{}
+			for _, td := range tr.Children {
+				if td.Kind != markup.ElementNode {
+					continue
+				}
+				r.header = r.header || td.Name == "th"
+				r.cells = append(r.cells, cell{blocks: []block{&paragraph{runs: runs(td.Children, run{})}}})
+			}
+			if len(r.cells) > len(t.widths) {
+				t.widths = make([]int, len(r.cells))
+			}
+			t.rows = append(t.rows, r)
+		}
+		for i := range t.widths {
+			t.widths[i] = 100 / len(t.widths)
+		}
+		return []block{t}
+	case "pre":
+		return []block{&paragraph{indent: indent, runs: []run{{text: nodeText(n), code: true}}}}
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		return []block{&paragraph{indent: indent, runs: runs(n.Children, run{bold: true})}}
+	}
+	return []block{&paragraph{indent: indent, runs: runs(n.Children, run{})}}
+}
+
+// runs converts inline nodes to runs of the format
+func runs(nodes []*markup.Node, format run) []run {
+	var result []run
+	for _, n := range nodes {
+		if n.Kind == markup.TextNode {
+			r := format
+			r.text = n.Text
+			result = append(result, r)
+			continue
+		}
+		f := format
+		switch n.Name {
+		case "strong", "b":
+			f.bold = true
+		case "em", "i":
+			f.italic = true
+		case "code":
+			f.code = true
+		case "sub":
+			f.sub = true
+		case "sup":
+			f.sup = true
+		case "q":
+			result = append(result, run{text: "“"})
+			result = append(result, runs(n.Children, f)...)
+			result = append(result, run{text: "”"})
+			continue
+		case "insert":
+			f.italic = true
+			f.text = "[" + n.Attr("param-id") + "]"
+			result = append(result, f)
+			continue
+		case "img":
+			if alt := n.Attr("alt"); alt != "" {
+				f.text = "[" + alt + "]"
+				result = append(result, f)
+			}
+			continue
+		case "a":
+			result = append(result, runs(n.Children, f)...)
+			if href := n.Attr("href"); href != "" && nodeText(n) != href {
+				f.text = " (" + href + ")"
+				result = append(result, f)
+			}
+			continue
+		}
+		result = append(result, runs(n.Children, f)...)
+	}
+	return result
+}
+
+func nodeText(n *markup.Node) string {
+	if n.Kind == markup.TextNode {
+		return n.Text
+	}
+	var b strings.Builder
+	for _, child := range n.Children {
+		b.WriteString(nodeText(child))
+	}
+	return b.String()
+}
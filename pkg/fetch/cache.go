@@ -0,0 +1,160 @@
+package fetch
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Cache keeps remote documents on disk. Documents are stored by the digest
+// of their content, an index maps hrefs to the digests. Local files are
+// passed to the underlying fetcher.
+//
+// Online, each remote document is fetched once per process and the cached
+// copy is used when the fetch fails. Offline, remote documents are served
+// from the cache only.
+type Cache struct {
+	Dir     string
+	Fetcher Fetcher
+	Offline bool
+
+	// mu guards fetched and locks, locks serialise the fetches of each href
+	mu      sync.Mutex
+	fetched map[string]string
+	locks   map[string]*sync.Mutex
+}
+
+// NewCache creates cache stored in dir over fetcher
+func NewCache(dir string, f Fetcher, offline bool) *Cache {
+	return &Cache{Dir: dir, Fetcher: f, Offline: offline}
+}
+
+// DefaultCacheDir returns the cache directory in the user's cache directory
+func DefaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "oscalkit")
+}
+
+// Fetch opens the cached copy of remote document, fetching it if needed
+func (c *Cache) Fetch(href string) (io.ReadCloser, error) {
+	if !IsRemote(href) {
+		return c.Fetcher.Fetch(href)
+	}
+	name, err := c.Locate(href)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(name)
+}
+
+// Locate returns path of the cached copy of remote document
+func (c *Cache) Locate(href string) (string, error) {
+	if !IsRemote(href) {
+		return Locate(c.Fetcher, href)
+	}
+	lock := c.lock(href)
+	lock.Lock()
+	defer lock.Unlock()
+	c.mu.Lock()
+	sum, ok := c.fetched[href]
+	c.mu.Unlock()
+	if ok {
+		return c.blobPath(sum), nil
+	}
+
+	cached, cachedErr := c.lookup(href)
+	if c.Offline {
+		if cachedErr != nil {
+			return "", fmt.Errorf("cannot fetch %s: %v and it is not cached in %s", href, ErrOffline, c.Dir)
+		}
+		return cached, nil
+	}
+
+	sum, err := c.store(href)
+	if err != nil {
+		if cachedErr != nil {
+			return "", err
+		}
+		logrus.Warnf("using cached copy of %s: %v", href, err)
+		return cached, nil
+	}
+	c.mu.Lock()
+	if c.fetched == nil {
+		c.fetched = map[string]string{}
+	}
+	c.fetched[href] = sum
+	c.mu.Unlock()
+	return c.blobPath(sum), nil
+}
+
+// lock returns the lock of the href, so that documents are fetched in
+// parallel but each of them once
+func (c *Cache) lock(href string) *sync.Mutex {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.locks == nil {
+		c.locks = map[string]*sync.Mutex{}
+	}
+	if c.locks[href] == nil {
+		c.locks[href] = &sync.Mutex{}
+	}
+	return c.locks[href]
+}
+
+// store fetches the document and stores it in the cache
+func (c *Cache) store(href string) (string, error) {
+	content, err := readAll(c.Fetcher, href)
+	if err != nil {
+		return "", err
+	}
+	sum := digest(content)
+	if _, err := os.Stat(c.blobPath(sum)); err != nil {
+		if err := writeFile(c.blobPath(sum), content); err != nil {
+			return "", fmt.Errorf("cannot cache %s: %v", href, err)
+		}
+	}
+	if err := writeFile(c.indexPath(href), []byte(sum+"\n"+href+"\n")); err != nil {
+		return "", fmt.Errorf("cannot cache %s: %v", href, err)
+	}
+	logrus.Debugf("cached %s as %s", href, sum)
+	return sum, nil
+}
+
+// lookup returns path of cached copy of the document
+func (c *Cache) lookup(href string) (string, error) {
+	index, err := ioutil.ReadFile(c.indexPath(href))
+	if err != nil {
+		return "", err
+	}
+	sum := strings.SplitN(string(index), "\n", 2)[0]
+	if len(sum) != sha256.Size*2 {
+		return "", fmt.Errorf("cache index of %s is corrupted", href)
+	}
+	name := c.blobPath(sum)
+	content, err := ioutil.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+	if digest(content) != sum {
+		return "", fmt.Errorf("cached copy of %s is corrupted", href)
+	}
+	return name, nil
+}
+
+func (c *Cache) blobPath(sum string) string {
+	return filepath.Join(c.Dir, "blobs", sum[:2], sum)
+}
+
+func (c *Cache) indexPath(href string) string {
+	return filepath.Join(c.Dir, "index", digest([]byte(href)))
+}
@@ -0,0 +1,42 @@
+package fetch
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Fake serves documents from memory, for use in tests
+type Fake struct {
+	// Documents maps hrefs to contents
+	Documents map[string]string
+
+	mu       sync.Mutex
+	requests []string
+}
+
+// NewFake creates fake fetcher of the documents
+func NewFake(documents map[string]string) *Fake {
+	return &Fake{Documents: documents}
+}
+
+// Fetch returns content of the document or error wrapping os.ErrNotExist
+func (f *Fake) Fetch(href string) (io.ReadCloser, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, href)
+	content, ok := f.Documents[href]
+	if !ok {
+		return nil, &os.PathError{Op: "fetch", Path: href, Err: os.ErrNotExist}
+	}
+	return ioutil.NopCloser(strings.NewReader(content)), nil
+}
+
+// Requests returns hrefs fetched so far, in order
+func (f *Fake) Requests() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.requests...)
+}
@@ -0,0 +1,153 @@
+// Package fetch loads OSCAL documents referenced by hrefs, such as profile
+// imports. Fetchers can be stacked: Rewriter maps hrefs to local copies,
+// Cache keeps remote documents on disk for later and offline runs and HTTP
+// reads local files and http(s) resources.
+package fetch
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Fetcher opens document referenced by href, a local path or URL
+type Fetcher interface {
+	Fetch(href string) (io.ReadCloser, error)
+}
+
+// Locator is implemented by fetchers keeping fetched documents on disk
+type Locator interface {
+	// Locate fetches the document and returns its local path
+	Locate(href string) (string, error)
+}
+
+// Default is the fetcher used to load imported documents. The command line
+// tool replaces it according to its cache and offline options.
+var Default Fetcher = &HTTP{}
+
+// ErrOffline is returned for remote documents requested in offline mode
+var ErrOffline = errors.New("remote documents cannot be fetched in offline mode")
+
+// DefaultTimeout limits duration of http requests
+const DefaultTimeout = 30 * time.Second
+
+// HTTP fetches local files and http(s) resources
+type HTTP struct {
+	// Client is used for http requests, defaults to client with DefaultTimeout
+	Client *http.Client
+	// Offline makes requests of remote documents fail
+	Offline bool
+}
+
+// Fetch opens local file or requests http(s) resource
+func (h *HTTP) Fetch(href string) (io.ReadCloser, error) {
+	if !IsRemote(href) {
+		return os.Open(LocalPath(href))
+	}
+	if h.Offline {
+		return nil, fmt.Errorf("cannot fetch %s: %v", href, ErrOffline)
+	}
+	client := h.Client
+	if client == nil {
+		client = &http.Client{Timeout: DefaultTimeout}
+	}
+	resp, err := client.Get(href)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("cannot fetch %s: unexpected HTTP status %s", href, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// IsRemote reports whether href is http(s) URL
+func IsRemote(href string) bool {
+	u, err := url.Parse(href)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https")
+}
+
+// LocalPath returns file system path of local href, which may be file URL
+func LocalPath(href string) string {
+	return strings.TrimPrefix(href, "file://")
+}
+
+// Locate returns local path of the document. Local files are returned as
+// they are, remote documents are stored in the fetcher's cache, if it has
+// one, or in a temporary file named by the document's digest.
+func Locate(f Fetcher, href string) (string, error) {
+	if !IsRemote(href) {
+		return filepath.Abs(LocalPath(href))
+	}
+	if l, ok := f.(Locator); ok {
+		return l.Locate(href)
+	}
+	content, err := readAll(f, href)
+	if err != nil {
+		return "", err
+	}
+	name := filepath.Join(os.TempDir(), "oscalkit-"+digest(content)[:16]+"-"+baseName(href))
+	if err := writeFile(name, content); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func readAll(f Fetcher, href string) ([]byte, error) {
+	rc, err := f.Fetch(href)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	content, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %v", href, err)
+	}
+	return content, nil
+}
+
+// writeFile writes content through temporary file, so that concurrent
+// readers never see partially written file
+func writeFile(name string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(name), ".tmp-")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), name)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func baseName(href string) string {
+	if u, err := url.Parse(href); err == nil && u.Path != "" {
+		return path.Base(u.Path)
+	}
+	return "document"
+}
@@ -0,0 +1,211 @@
+package fetch
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const catalogHref = "https://example.com/oscal/catalog.xml"
+
+func read(t *testing.T, f Fetcher, href string) string {
+	rc, err := f.Fetch(href)
+	if err != nil {
+		t.Fatalf("cannot fetch %s: %v", href, err)
+	}
+	defer rc.Close()
+	content, err := ioutil.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(content)
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "fetch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestCache(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	fake := NewFake(map[string]string{catalogHref: "<catalog/>"})
+
+	online := NewCache(dir, fake, false)
+	for i := 0; i < 2; i++ {
+		if got := read(t, online, catalogHref); got != "<catalog/>" {
+			t.Errorf("unexpected content %q", got)
+		}
+	}
+	if n := len(fake.Requests()); n != 1 {
+		t.Errorf("expected document fetched once per process, got %d requests", n)
+	}
+
+	// another run, the document is no longer available
+	delete(fake.Documents, catalogHref)
+	if got := read(t, NewCache(dir, fake, false), catalogHref); got != "<catalog/>" {
+		t.Errorf("expected cached copy when fetch fails, got %q", got)
+	}
+	offline := NewCache(dir, fake, true)
+	if got := read(t, offline, catalogHref); got != "<catalog/>" {
+		t.Errorf("expected cached copy offline, got %q", got)
+	}
+	if n := len(fake.Requests()); n != 2 {
+		t.Errorf("expected no requests offline, got %d requests", n)
+	}
+	if _, err := offline.Fetch("https://example.com/other.xml"); err == nil || !strings.Contains(err.Error(), "offline") {
+		t.Errorf("expected offline error for uncached document, got %v", err)
+	}
+}
+
+func TestCacheContentAddressed(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	fake := NewFake(map[string]string{
+		"https://a.example.com/catalog.xml": "<catalog id='a'/>",
+		"https://b.example.com/catalog.xml": "<catalog id='b'/>",
+		"https://c.example.com/copy.xml":    "<catalog id='a'/>",
+	})
+	cache := NewCache(dir, fake, false)
+	paths := map[string]string{}
+	for href := range fake.Documents {
+		path, err := cache.Locate(href)
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths[href] = path
+	}
+	if paths["https://a.example.com/catalog.xml"] == paths["https://b.example.com/catalog.xml"] {
+		t.Error("expected documents with the same base name stored apart")
+	}
+	if paths["https://a.example.com/catalog.xml"] != paths["https://c.example.com/copy.xml"] {
+		t.Error("expected documents with the same content stored once")
+	}
+}
+
+// blockingFetcher holds the fetch of href until release is closed
+type blockingFetcher struct {
+	*Fake
+	href    string
+	started chan struct{}
+	release chan struct{}
+}
+
+func (f *blockingFetcher) Fetch(href string) (io.ReadCloser, error) {
+	if href == f.href {
+		f.started <- struct{}{}
+		<-f.release
+	}
+	return f.Fake.Fetch(href)
+}
+
+func TestCacheParallel(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	slow := "https://slow.example.com/catalog.xml"
+	fake := NewFake(map[string]string{slow: "<catalog id='slow'/>", catalogHref: "<catalog/>"})
+	f := &blockingFetcher{Fake: fake, href: slow, started: make(chan struct{}, 2), release: make(chan struct{})}
+	cache := NewCache(dir, f, false)
+
+	var wg sync.WaitGroup
+	locate := func() {
+		defer wg.Done()
+		if _, err := cache.Locate(slow); err != nil {
+			t.Error(err)
+		}
+	}
+	wg.Add(2)
+	go locate()
+	<-f.started
+	go locate()
+
+	done := make(chan struct{})
+	go func() {
+		if _, err := cache.Locate(catalogHref); err != nil {
+			t.Error(err)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("fetch of another document waits for the slow one")
+	}
+	close(f.release)
+	wg.Wait()
+
+	n := 0
+	for _, href := range fake.Requests() {
+		if href == slow {
+			n++
+		}
+	}
+	if n != 1 {
+		t.Errorf("expected slow document fetched once, got %d requests", n)
+	}
+}
+
+func TestRewriter(t *testing.T) {
+	r := &Rewriter{
+		Rules: map[string]string{
+			"https://example.com/":            "/mirror/",
+			"https://example.com/oscal/":      "/oscal/",
+			"https://example.com/profile.xml": "/profiles/local.xml",
+		},
+		Fetcher: NewFake(nil),
+	}
+	cases := map[string]string{
+		catalogHref:                       "/oscal/catalog.xml",
+		"https://example.com/a/b.xml":     "/mirror/a/b.xml",
+		"https://example.com/profile.xml": "/profiles/local.xml",
+		"https://other.org/catalog.xml":   "https://other.org/catalog.xml",
+	}
+	for href, expected := range cases {
+		if got := r.Rewrite(href); got != expected {
+			t.Errorf("expected %s rewritten to %s, got %s", href, expected, got)
+		}
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	config := filepath.Join(dir, "fetch.yaml")
+	content := "offline: true\nrewrites:\n  https://example.com/oscal/: content/\n"
+	if err := ioutil.WriteFile(config, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "content"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "content", "catalog.xml"), []byte("<catalog/>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := New(*cfg)
+	if got := read(t, f, catalogHref); got != "<catalog/>" {
+		t.Errorf("expected rewritten local copy, got %q", got)
+	}
+	if _, err := f.Fetch("https://other.org/catalog.xml"); err == nil || !strings.Contains(err.Error(), "offline") {
+		t.Errorf("expected offline error, got %v", err)
+	}
+
+	if err := ioutil.WriteFile(config, []byte("rewrite: {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfig(config); err == nil {
+		t.Error("expected error for unknown configuration key")
+	}
+}
@@ -0,0 +1,110 @@
+package fetch
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Rewriter replaces hrefs before passing them to the underlying fetcher.
+// Rules ending with slash rewrite all hrefs starting with them, other
+// rules rewrite single href. The longest matching rule wins.
+type Rewriter struct {
+	Rules   map[string]string
+	Fetcher Fetcher
+}
+
+// Fetch fetches the rewritten href
+func (r *Rewriter) Fetch(href string) (io.ReadCloser, error) {
+	return r.Fetcher.Fetch(r.Rewrite(href))
+}
+
+// Locate returns local path of the rewritten href
+func (r *Rewriter) Locate(href string) (string, error) {
+	return Locate(r.Fetcher, r.Rewrite(href))
+}
+
+// Rewrite returns href rewritten by the longest matching rule
+func (r *Rewriter) Rewrite(href string) string {
+	var prefixes []string
+	for from := range r.Rules {
+		if from == href {
+			logrus.Debugf("rewriting %s to %s", href, r.Rules[from])
+			return r.Rules[from]
+		}
+		if strings.HasSuffix(from, "/") && strings.HasPrefix(href, from) {
+			prefixes = append(prefixes, from)
+		}
+	}
+	if len(prefixes) == 0 {
+		return href
+	}
+	sort.Slice(prefixes, func(i, j int) bool { return len(prefixes[i]) > len(prefixes[j]) })
+	rewritten := r.Rules[prefixes[0]] + strings.TrimPrefix(href, prefixes[0])
+	logrus.Debugf("rewriting %s to %s", href, rewritten)
+	return rewritten
+}
+
+// Config configures the fetcher created by New
+type Config struct {
+	// CacheDir is directory of the on-disk cache, no cache is used if empty
+	CacheDir string `yaml:"cache-dir,omitempty"`
+	// Offline makes fetching of remote documents not found in cache fail
+	Offline bool `yaml:"offline,omitempty"`
+	// Rewrites maps hrefs or href prefixes to local paths or other URLs
+	Rewrites map[string]string `yaml:"rewrites,omitempty"`
+}
+
+// LoadConfig reads fetcher configuration from YAML or JSON file, such as
+//
+//	cache-dir: /var/cache/oscalkit
+//	offline: true
+//	rewrites:
+//	  https://raw.githubusercontent.com/usnistgov/OSCAL/master/content/: ./oscal-content/
+//
+// Relative local paths are resolved against the directory of the file.
+func LoadConfig(path string) (*Config, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read fetch configuration: %v", err)
+	}
+	var cfg Config
+	if err := yaml.UnmarshalStrict(content, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid fetch configuration %s: %v", path, err)
+	}
+	base := filepath.Dir(path)
+	resolve := func(p string) string {
+		if p == "" || IsRemote(p) || filepath.IsAbs(LocalPath(p)) {
+			return p
+		}
+		resolved := filepath.Join(base, LocalPath(p))
+		if strings.HasSuffix(p, "/") {
+			resolved += string(filepath.Separator)
+		}
+		return resolved
+	}
+	cfg.CacheDir = resolve(cfg.CacheDir)
+	for from, to := range cfg.Rewrites {
+		cfg.Rewrites[from] = resolve(to)
+	}
+	return &cfg, nil
+}
+
+// New creates fetcher of local files and http(s) resources according to
+// the configuration
+func New(cfg Config) Fetcher {
+	var f Fetcher = &HTTP{Offline: cfg.Offline}
+	if cfg.CacheDir != "" {
+		f = NewCache(cfg.CacheDir, f, cfg.Offline)
+	}
+	if len(cfg.Rewrites) > 0 {
+		f = &Rewriter{Rules: cfg.Rewrites, Fetcher: f}
+	}
+	return f
+}
@@ -0,0 +1,85 @@
+package html
+
+import (
+	"github.com/docker/oscalkit/types/oscal/catalog"
+)
+
+// Anchors of the sections of the pages
+const (
+	metadataAnchor              = "metadata"
+	backMatterAnchor            = "back-matter"
+	systemCharacteristicsAnchor = "system-characteristics"
+	systemImplementationAnchor  = "system-implementation"
+	controlImplementationAnchor = "control-implementation"
+	componentsAnchor            = "components"
+	capabilitiesAnchor          = "capabilities"
+)
+
+// contents returns the table of contents of the page
+func contents(page *Page) []Entry {
+	var entries []Entry
+	if page.Metadata != nil {
+		entries = append(entries, Entry{ID: metadataAnchor, Title: "Metadata"})
+	}
+	switch {
+	case page.Catalog != nil:
+		entries = append(entries, controlEntries(page.Catalog.Controls)...)
+		entries = append(entries, groupEntries(page.Catalog.Groups)...)
+	case page.SSP != nil:
+		s := page.SSP
+		if s.SystemCharacteristics != nil {
+			entries = append(entries, Entry{ID: systemCharacteristicsAnchor, Title: "System characteristics"})
+		}
+		if s.SystemImplementation != nil {
+			e := Entry{ID: systemImplementationAnchor, Title: "System implementation"}
+			for _, c := range s.SystemImplementation.Components {
+				e.Entries = append(e.Entries, Entry{ID: c.Id, Title: componentTitle(c.Title, c.Id)})
+			}
+			entries = append(entries, e)
+		}
+		if s.ControlImplementation != nil {
+			e := Entry{ID: controlImplementationAnchor, Title: "Control implementation"}
+			for _, r := range s.ControlImplementation.ImplementedRequirements {
+				e.Entries = append(e.Entries, Entry{ID: requirementID(r), Title: r.ControlId})
+			}
+			entries = append(entries, e)
+		}
+	case page.Component != nil:
+		if len(page.Component.Components) > 0 {
+			e := Entry{ID: componentsAnchor, Title: "Components"}
+			for _, c := range page.Component.Components {
+				e.Entries = append(e.Entries, Entry{ID: c.Id, Title: componentTitle(c.Title, c.Name)})
+			}
+			entries = append(entries, e)
+		}
+		if len(page.Component.Capabilities) > 0 {
+			e := Entry{ID: capabilitiesAnchor, Title: "Capabilities"}
+			for _, c := range page.Component.Capabilities {
+				e.Entries = append(e.Entries, Entry{ID: c.Id, Title: c.Name})
+			}
+			entries = append(entries, e)
+		}
+	}
+	if page.BackMatter != nil && len(page.BackMatter.Resources) > 0 {
+		entries = append(entries, Entry{ID: backMatterAnchor, Title: "Back matter"})
+	}
+	return entries
+}
+
+func groupEntries(groups []catalog.Group) []Entry {
+	var entries []Entry
+	for _, g := range groups {
+		e := Entry{ID: g.Id, Title: string(g.Title)}
+		e.Entries = append(controlEntries(g.Controls), groupEntries(g.Groups)...)
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func controlEntries(controls []catalog.Control) []Entry {
+	var entries []Entry
+	for _, ctrl := range controls {
+		entries = append(entries, Entry{ID: ctrl.Id, Title: controlTitle(ctrl), Entries: controlEntries(ctrl.Controls)})
+	}
+	return entries
+}
@@ -0,0 +1,206 @@
+package html
+
+import (
+	"html/template"
+	"strings"
+
+	"github.com/docker/oscalkit/types/oscal/catalog"
+	ssp "github.com/docker/oscalkit/types/oscal/system_security_plan"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+// funcs are the template functions of a page. They look up parameters and
+// objects of the page by their ids.
+type funcs struct {
+	params map[string]*catalog.Param
+	// titles of the objects rendered with anchors, by their ids
+	titles map[string]string
+}
+
+func newFuncs(page *Page) *funcs {
+	f := &funcs{params: map[string]*catalog.Param{}, titles: map[string]string{}}
+	if page == nil {
+		return f
+	}
+	if m := page.Metadata; m != nil {
+		for _, r := range m.Roles {
+			f.add(r.Id, string(r.Title))
+		}
+		for _, p := range m.Parties {
+			f.add(p.Id, partyName(p))
+		}
+	}
+	if c := page.Catalog; c != nil {
+		f.addParams(c.Parameters)
+		var groups func(gs []catalog.Group)
+		groups = func(gs []catalog.Group) {
+			for i := range gs {
+				f.add(gs[i].Id, string(gs[i].Title))
+				f.addParams(gs[i].Parameters)
+				f.addParts(gs[i].Parts)
+				groups(gs[i].Groups)
+			}
+		}
+		groups(c.Groups)
+		c.WalkControls(func(ctrl *catalog.Control) bool {
+			f.add(ctrl.Id, controlTitle(*ctrl))
+			f.addParams(ctrl.Parameters)
+			f.addParts(ctrl.Parts)
+			return true
+		})
+	}
+	if s := page.SSP; s != nil && s.SystemImplementation != nil {
+		for _, u := range s.SystemImplementation.Users {
+			f.add(u.Id, string(u.Title))
+		}
+		for _, c := range s.SystemImplementation.Components {
+			f.add(c.Id, string(c.Title))
+		}
+		for _, svc := range s.SystemImplementation.Services {
+			f.add(svc.Id, string(svc.Title))
+		}
+	}
+	if d := page.Component; d != nil {
+		for _, c := range d.Components {
+			f.add(c.Id, componentTitle(c.Title, c.Name))
+		}
+		for _, c := range d.Capabilities {
+			f.add(c.Id, c.Name)
+		}
+	}
+	if page.BackMatter != nil {
+		for _, r := range page.BackMatter.Resources {
+			f.add(r.Id, string(r.Title))
+		}
+	}
+	return f
+}
+
+func (f *funcs) funcMap() template.FuncMap {
+	return template.FuncMap{
+		"prose":        f.prose,
+		"param":        f.param,
+		"ref":          f.ref,
+		"title":        f.title,
+		"linkText":     f.linkText,
+		"label":        label,
+		"prop":         prop,
+		"controlTitle": controlTitle,
+		"requirement":  requirementID,
+	}
+}
+
+func (f *funcs) add(id, title string) {
+	if id == "" {
+		return
+	}
+	if _, ok := f.titles[id]; !ok {
+		f.titles[id] = strings.TrimSpace(title)
+	}
+}
+
+func (f *funcs) addParams(params []catalog.Param) {
+	for i := range params {
+		if _, ok := f.params[params[i].Id]; !ok {
+			f.params[params[i].Id] = &params[i]
+		}
+		f.add(params[i].Id, string(params[i].Label))
+	}
+}
+
+func (f *funcs) addParts(parts []catalog.Part) {
+	for i := range parts {
+		f.add(parts[i].Id, string(parts[i].Title))
+		f.addParts(parts[i].Parts)
+	}
+}
+
+// param returns text of the parameter with the id, as inserted into prose
+func (f *funcs) param(id string) string {
+	if p, ok := f.params[id]; ok {
+		return p.Text()
+	}
+	return "[Assignment: " + id + "]"
+}
+
+// title returns title of the object with the id, or the id of untitled and
+// unknown objects
+func (f *funcs) title(id string) string {
+	if title := f.titles[id]; title != "" {
+		return title
+	}
+	return id
+}
+
+// ref returns link to the object with the id, or its id when the object is
+// not on the page
+func (f *funcs) ref(id string) template.HTML {
+	if _, ok := f.titles[id]; !ok {
+		return template.HTML(template.HTMLEscapeString(id))
+	}
+	return template.HTML(`<a href="#` + template.HTMLEscapeString(id) + `">` + template.HTMLEscapeString(f.title(id)) + `</a>`)
+}
+
+// linkText returns text of the link, the title of the linked object for
+// links to objects of the page without text
+func (f *funcs) linkText(link validation_root.Link) string {
+	if text := strings.TrimSpace(link.Value); text != "" {
+		return text
+	}
+	if strings.HasPrefix(link.Href, "#") {
+		return f.title(link.Href[1:])
+	}
+	return link.Href
+}
+
+// label returns value of the label property, such as AC-1 or a.
+func label(props []validation_root.Prop) string {
+	return prop(props, "label")
+}
+
+// prop returns value of the property with the name
+func prop(props []validation_root.Prop, name string) string {
+	for _, p := range props {
+		if p.Name == name {
+			return p.Value
+		}
+	}
+	return ""
+}
+
+// controlTitle returns title of the control prefixed with its label
+func controlTitle(ctrl catalog.Control) string {
+	title := strings.TrimSpace(string(ctrl.Title))
+	if l := label(ctrl.Properties); l != "" {
+		return l + " " + title
+	}
+	return title
+}
+
+func componentTitle(title validation_root.Title, name string) string {
+	if title != "" {
+		return string(title)
+	}
+	return name
+}
+
+func partyName(p validation_root.Party) string {
+	if p.Org != nil && p.Org.OrgName != "" {
+		return string(p.Org.OrgName)
+	}
+	for _, person := range p.Persons {
+		if person.PersonName != "" {
+			return string(person.PersonName)
+		}
+	}
+	return p.Id
+}
+
+// requirementID returns anchor of the implemented requirement, its id or
+// the id of the control
+func requirementID(r ssp.ImplementedRequirement) string {
+	if r.Id != "" {
+		return r.Id
+	}
+	return r.ControlId
+}
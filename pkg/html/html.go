@@ -0,0 +1,158 @@
+// Package html renders OSCAL documents as HTML pages by html/template.
+// Catalogs, profiles, system security plans and component definitions are
+// rendered from the typed model, so documents of any format can be rendered.
+// Profiles are resolved first, so that the parameter values set by the
+// profile are inserted into the control statements.
+//
+// The pages consist of named templates, which can be replaced by templates
+// of a directory, see New.
+package html
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/resolver"
+	"github.com/docker/oscalkit/types/oscal"
+	"github.com/docker/oscalkit/types/oscal/catalog"
+	"github.com/docker/oscalkit/types/oscal/component_definition"
+	"github.com/docker/oscalkit/types/oscal/profile"
+	ssp "github.com/docker/oscalkit/types/oscal/system_security_plan"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+// Page templates, one per document type
+const (
+	CatalogTemplate   = "catalog.html"
+	ProfileTemplate   = "profile.html"
+	SSPTemplate       = "system-security-plan.html"
+	ComponentTemplate = "component-definition.html"
+)
+
+// templateExtension is the extension of template files
+const templateExtension = ".html"
+
+// Page is the data the page templates are executed with. Exactly one of the
+// documents is set, except for profiles, where Catalog holds the resolved
+// profile.
+type Page struct {
+	Title      string
+	Metadata   *validation_root.Metadata
+	Catalog    *catalog.Catalog
+	Profile    *profile.Profile
+	SSP        *ssp.SystemSecurityPlan
+	Component  *component_definition.ComponentDefinition
+	BackMatter *validation_root.BackMatter
+	// Contents is the table of contents of the page
+	Contents []Entry
+}
+
+// Entry of the table of contents, linking to the object with the id
+type Entry struct {
+	ID      string
+	Title   string
+	Entries []Entry
+}
+
+// Renderer renders OSCAL documents as HTML
+type Renderer struct {
+	templates *template.Template
+	// Resolver resolves profiles before they are rendered
+	Resolver *resolver.Resolver
+}
+
+// New creates renderer of the built-in templates. Templates of the *.html
+// files of templateDir, if given, replace the built-in templates of the same
+// name, and the templates they define by {{define}} replace the built-in
+// partial templates, such as "control" or "metadata".
+func New(templateDir string) (*Renderer, error) {
+	t := template.New("").Funcs(newFuncs(nil).funcMap())
+	for _, name := range builtinNames {
+		if _, err := t.New(name).Parse(builtin[name]); err != nil {
+			return nil, fmt.Errorf("Cannot parse built-in template %s: %v", name, err)
+		}
+	}
+	if templateDir != "" {
+		files, err := filepath.Glob(filepath.Join(templateDir, "*"+templateExtension))
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("No %s templates found in %s", templateExtension, templateDir)
+		}
+		if _, err := t.ParseFiles(files...); err != nil {
+			return nil, fmt.Errorf("Cannot parse templates: %v", err)
+		}
+	}
+	return &Renderer{templates: t, Resolver: resolver.New()}, nil
+}
+
+// Render writes the document as HTML page. The href locates the document,
+// imports of profiles are resolved against it.
+func (r *Renderer) Render(w io.Writer, o *oscal.OSCAL, href string) error {
+	page, err := r.page(o, href)
+	if err != nil {
+		return err
+	}
+	name := pageTemplate(page)
+	t, err := r.templates.Clone()
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	if err := t.Funcs(newFuncs(page).funcMap()).ExecuteTemplate(&b, name, page); err != nil {
+		return fmt.Errorf("Cannot render %s: %v", name, err)
+	}
+	_, err = b.WriteTo(w)
+	return err
+}
+
+func (r *Renderer) page(o *oscal.OSCAL, href string) (*Page, error) {
+	page := &Page{Metadata: o.Metadata()}
+	switch {
+	case o.Catalog != nil:
+		page.Catalog = o.Catalog
+		page.BackMatter = o.Catalog.BackMatter
+	case o.Profile != nil:
+		resolved, err := r.Resolver.Resolve(o.Profile, href)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot resolve profile %s: %v", href, err)
+		}
+		page.Profile = o.Profile
+		page.Catalog = resolved
+		page.Metadata = resolved.Metadata
+		page.BackMatter = resolved.BackMatter
+	case o.SystemSecurityPlan != nil:
+		page.SSP = o.SystemSecurityPlan
+		page.BackMatter = o.SystemSecurityPlan.BackMatter
+	case o.Component != nil:
+		page.Component = o.Component
+		page.BackMatter = o.Component.BackMatter
+	default:
+		return nil, fmt.Errorf("Cannot render unknown document")
+	}
+	if page.Metadata != nil {
+		page.Title = strings.TrimSpace(string(page.Metadata.Title))
+	}
+	if page.Title == "" {
+		page.Title = o.DocumentType().String()
+	}
+	page.Contents = contents(page)
+	return page, nil
+}
+
+func pageTemplate(page *Page) string {
+	switch {
+	case page.Profile != nil:
+		return ProfileTemplate
+	case page.Catalog != nil:
+		return CatalogTemplate
+	case page.SSP != nil:
+		return SSPTemplate
+	}
+	return ComponentTemplate
+}
@@ -0,0 +1,152 @@
+package html
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/types/oscal"
+)
+
+func open(t *testing.T, name string) *oscal.OSCAL {
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	o, err := oscal.New(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return o
+}
+
+func render(t *testing.T, r *Renderer, o *oscal.OSCAL, name string) string {
+	var b bytes.Buffer
+	if err := r.Render(&b, o, filepath.Join("testdata", name)); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func testRender(t *testing.T, name string, expected []string) {
+	r, err := New("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := render(t, r, open(t, name), name)
+	for _, s := range expected {
+		if !strings.Contains(page, s) {
+			t.Errorf("%s: %s not found in\n%s", name, s, page)
+		}
+	}
+}
+
+func TestRenderCatalog(t *testing.T) {
+	testRender(t, "catalog.xml", []string{
+		"<title>Sample catalog</title>",
+		`<li><a href="#ac">Access Control</a><ul>`,
+		`<li><a href="#ac-1.1">AC-1(1) Enhancement</a></li>`,
+		`<section class="control" id="ac-1">`,
+		`<p>Review the policy every <a class="param" href="#ac-1_prm_1">[Assignment: organization-defined frequency]</a>.</p>`,
+		`<span class="label">a.</span>`,
+		`<a href="https://example.com/a">reference</a> and <a>this</a>`,
+		"<h4>Guidance</h4>\n<p>Guidance <em>text</em>.</p>",
+		`<li><a href="#ac-2" rel="related">AC-2 Account Management</a></li>`,
+		`<li><a href="#ref-1" rel="reference">Policy reference</a></li>`,
+		`<div class="resource" id="ref-1">`,
+	})
+}
+
+func TestRenderProfile(t *testing.T) {
+	testRender(t, "profile.xml", []string{
+		"<title>Sample baseline</title>",
+		`<li><a href="catalog.xml">catalog.xml</a></li>`,
+		`<tr id="ac-1_prm_1"><td>ac-1_prm_1 (organization-defined frequency)</td><td>annually</td></tr>`,
+		`<a class="param" href="#ac-1_prm_1">annually</a>`,
+	})
+}
+
+func TestRenderSSP(t *testing.T) {
+	testRender(t, "ssp.json", []string{
+		`<li><a href="#system-implementation">System implementation</a><ul>`,
+		`<li><a href="#req-1">ac-1</a></li>`,
+		"<tr><th>System name</th><td>Sample system (SAMPLE)</td></tr>",
+		"<p>Hosts the <strong>sample</strong> application.</p>",
+		`<dt id="admin">Administrator</dt>`,
+		`<p>Responsible roles: <a href="#admin">Administrator</a></p>`,
+		`<h5><a href="#web">Web server</a></h5>`,
+		"<tr><td>ac-1_prm_1</td><td>quarterly</td></tr>",
+	})
+}
+
+func TestRenderComponentDefinition(t *testing.T) {
+	testRender(t, "component-definition.json", []string{
+		`<li><a href="#db">Database</a></li>`,
+		`<tr id="db-ac-1"><td>ac-1</td><td><p>Access is logged.</p></td></tr>`,
+		`<p>Components: <a href="#db">Database</a></p>`,
+	})
+}
+
+// TestRenderFormats checks that the document renders the same in all formats
+func TestRenderFormats(t *testing.T) {
+	r, err := New("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := open(t, "catalog.xml")
+	expected := render(t, r, o, "catalog.xml")
+	for _, format := range []string{"json", "yaml"} {
+		var b bytes.Buffer
+		if format == "json" {
+			err = o.JSON(&b, true)
+		} else {
+			err = o.YAML(&b)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := oscal.New(&b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page := render(t, r, decoded, "catalog."+format); page != expected {
+			t.Errorf("%s renders differently:\n%s\nexpected:\n%s", format, page, expected)
+		}
+	}
+}
+
+func TestTemplateDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := New(dir); err == nil {
+		t.Error("empty template directory should fail")
+	}
+	templates := map[string]string{
+		"control.html": `{{define "control"}}<p class="custom" id="{{.Id}}">{{controlTitle .}}</p>{{end}}`,
+		"ssp.txt":      "ignored",
+	}
+	for name, content := range templates {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r, err := New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := render(t, r, open(t, "catalog.xml"), "catalog.xml")
+	if !strings.Contains(page, `<p class="custom" id="ac-1">AC-1 Policy and Procedures</p>`) {
+		t.Errorf("control template not replaced:\n%s", page)
+	}
+	if !strings.Contains(page, `<section class="group" id="ac">`) {
+		t.Errorf("built-in group template not used:\n%s", page)
+	}
+}
@@ -0,0 +1,97 @@
+package html
+
+import (
+	"fmt"
+	"html/template"
+	"net/url"
+	"strings"
+
+	"github.com/docker/oscalkit/types/oscal/markup"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+// attributes of the prose elements kept in HTML, other attributes are dropped
+var proseAttrs = map[string][]string{
+	"a":   {"href", "title"},
+	"img": {"src", "alt", "title"},
+	"td":  {"colspan", "rowspan"},
+	"th":  {"colspan", "rowspan"},
+}
+
+// URL attributes, restricted to safeSchemes
+var urlAttrs = map[string]bool{"href": true, "src": true}
+
+var safeSchemes = map[string]bool{"": true, "http": true, "https": true, "mailto": true}
+
+// prose renders the prose as HTML. Parameter inserts are replaced by the
+// text of the parameters, linked to their definitions.
+func (f *funcs) prose(prose interface{}) (template.HTML, error) {
+	var m validation_root.Markup
+	switch p := prose.(type) {
+	case nil:
+		return "", nil
+	case *validation_root.Markup:
+		if p == nil {
+			return "", nil
+		}
+		m = *p
+	case validation_root.Markup:
+		m = p
+	default:
+		return "", fmt.Errorf("prose of unexpected type %T", prose)
+	}
+	fragment, err := m.Fragment()
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, n := range fragment.Nodes {
+		f.writeNode(&b, n)
+	}
+	return template.HTML(b.String()), nil
+}
+
+func (f *funcs) writeNode(b *strings.Builder, n *markup.Node) {
+	if n.Kind == markup.TextNode {
+		b.WriteString(template.HTMLEscapeString(n.Text))
+		return
+	}
+	if n.Name == "insert" {
+		id := n.Attr("param-id")
+		if _, ok := f.params[id]; ok {
+			fmt.Fprintf(b, `<a class="param" href="#%s">%s</a>`, template.HTMLEscapeString(id), template.HTMLEscapeString(f.param(id)))
+		} else {
+			fmt.Fprintf(b, `<span class="param">%s</span>`, template.HTMLEscapeString(f.param(id)))
+		}
+		return
+	}
+	if !markup.IsElement(n.Name) {
+		// unknown elements are rendered by their content
+		for _, child := range n.Children {
+			f.writeNode(b, child)
+		}
+		return
+	}
+	b.WriteString("<" + n.Name)
+	for _, name := range proseAttrs[n.Name] {
+		value := n.Attr(name)
+		if value == "" || urlAttrs[name] && !safeURL(value) {
+			continue
+		}
+		fmt.Fprintf(b, ` %s="%s"`, name, template.HTMLEscapeString(value))
+	}
+	b.WriteString(">")
+	if n.Name == "img" {
+		return
+	}
+	for _, child := range n.Children {
+		f.writeNode(b, child)
+	}
+	b.WriteString("</" + n.Name + ">")
+}
+
+// safeURL reports whether the URL is relative or of a safe scheme
+func safeURL(value string) bool {
+	u, err := url.Parse(strings.TrimSpace(value))
+	return err == nil && safeSchemes[strings.ToLower(u.Scheme)]
+}
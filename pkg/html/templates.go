@@ -0,0 +1,456 @@
+package html
+
+// builtinNames lists the built-in templates in order of parsing
+var builtinNames = []string{
+	"layout.html",
+	"metadata.html",
+	"control.html",
+	CatalogTemplate,
+	ProfileTemplate,
+	SSPTemplate,
+	ComponentTemplate,
+}
+
+// builtin templates by name. Pages are rendered by the page templates, the
+// other templates define the partial templates they consist of.
+var builtin = map[string]string{
+	"layout.html": `
+{{- define "header" -}}
+<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>{{template "style"}}</style>
+</head>
+<body>
+<nav class="contents">
+<h2>Contents</h2>
+{{template "contents" .Contents}}
+</nav>
+<main>
+<h1>{{.Title}}</h1>
+{{template "metadata" .Metadata}}
+{{- end}}
+
+{{define "footer"}}
+{{- template "back-matter" .BackMatter}}
+</main>
+</body>
+</html>
+{{end}}
+
+{{define "contents"}}{{with .}}<ul>
+{{- range .}}
+<li>{{if .ID}}<a href="#{{.ID}}">{{.Title}}</a>{{else}}{{.Title}}{{end}}{{template "contents" .Entries}}</li>
+{{- end}}
+</ul>{{end}}{{end}}
+
+{{define "style"}}
+body { font-family: sans-serif; margin: 0; display: flex; line-height: 1.4; }
+nav.contents { flex: 0 0 20em; height: 100vh; overflow-y: auto; position: sticky; top: 0; padding: 0 1em; border-right: 1px solid #ccc; font-size: 90%; }
+nav.contents ul { list-style: none; padding-left: 1em; }
+main { flex: 1; padding: 0 2em; max-width: 60em; }
+table { border-collapse: collapse; margin: 0.5em 0; }
+td, th { border: 1px solid #ccc; padding: 0.2em 0.5em; text-align: left; vertical-align: top; }
+section.control, section.implemented-requirement, section.component { border-top: 1px solid #ccc; }
+.part > .label { float: left; margin-right: 0.5em; }
+.label { font-weight: bold; }
+.param { font-style: italic; background: #eef; }
+{{end}}
+`,
+	"metadata.html": `
+{{- define "metadata"}}{{with .}}
+<section id="metadata" class="metadata">
+<h2>Metadata</h2>
+<table>
+{{- with .Version}}
+<tr><th>Version</th><td>{{.}}</td></tr>
+{{- end}}
+{{- with .Published}}
+<tr><th>Published</th><td>{{.}}</td></tr>
+{{- end}}
+{{- with .LastModified}}
+<tr><th>Last modified</th><td>{{.}}</td></tr>
+{{- end}}
+{{- with .OscalVersion}}
+<tr><th>OSCAL version</th><td>{{.}}</td></tr>
+{{- end}}
+{{- range .DocumentIds}}
+<tr><th>Document id{{with .Type}} ({{.}}){{end}}</th><td>{{.Value}}</td></tr>
+{{- end}}
+{{- range .Properties}}
+<tr><th>{{.Name}}</th><td>{{.Value}}</td></tr>
+{{- end}}
+</table>
+{{template "links" .Links}}
+{{- with .Roles}}
+<h3>Roles</h3>
+<dl>
+{{- range .}}
+<dt id="{{.Id}}">{{or .Title .Id}}</dt>
+{{- with .Desc}}<dd>{{.}}</dd>{{end}}
+{{- end}}
+</dl>
+{{- end}}
+{{- with .Parties}}
+<h3>Parties</h3>
+<ul>
+{{- range .}}
+<li id="{{.Id}}">{{title .Id}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- with .ResponsibleParties}}
+<h3>Responsible parties</h3>
+<table>
+{{- range .}}
+<tr><th>{{ref .RoleId}}</th><td>{{range $i, $p := .PartyIds}}{{if $i}}, {{end}}{{ref (printf "%s" $p)}}{{end}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- with .Remarks}}
+{{prose .}}
+{{- end}}
+</section>
+{{- end}}{{end}}
+
+{{define "links"}}{{with .}}
+<ul class="links">
+{{- range .}}
+<li><a href="{{.Href}}"{{with .Rel}} rel="{{.}}"{{end}}>{{linkText .}}</a></li>
+{{- end}}
+</ul>
+{{- end}}{{end}}
+
+{{define "back-matter"}}{{with .}}{{with .Resources}}
+<section id="back-matter" class="back-matter">
+<h2>Back matter</h2>
+{{- range .}}
+<div class="resource"{{with .Id}} id="{{.}}"{{end}}>
+<h3>{{or .Title .Id}}</h3>
+{{- with .Desc}}
+<p>{{.}}</p>
+{{- end}}
+{{- with .Citation}}
+<p class="citation">{{.Text}}</p>
+{{- end}}
+{{- with .Rlinks}}
+<ul>
+{{- range .}}
+<li><a href="{{.Href}}">{{.Href}}</a></li>
+{{- end}}
+</ul>
+{{- end}}
+{{- with .Remarks}}
+{{prose .}}
+{{- end}}
+</div>
+{{- end}}
+</section>
+{{- end}}{{end}}{{end}}
+`,
+	"control.html": `
+{{- define "catalog-content"}}
+{{- template "params" .Parameters}}
+{{- range .Controls}}{{template "control" .}}{{end}}
+{{- range .Groups}}{{template "group" .}}{{end}}
+{{- end}}
+
+{{define "group"}}
+<section class="group"{{with .Id}} id="{{.}}"{{end}}>
+<h2>{{with label .Properties}}<span class="label">{{.}}</span> {{end}}{{.Title}}</h2>
+{{- template "params" .Parameters}}
+{{- range .Parts}}{{template "part" .}}{{end}}
+{{- range .Controls}}{{template "control" .}}{{end}}
+{{- range .Groups}}{{template "group" .}}{{end}}
+</section>
+{{- end}}
+
+{{define "control"}}
+<section class="control" id="{{.Id}}">
+<h3>{{with label .Properties}}<span class="label">{{.}}</span> {{end}}{{.Title}}</h3>
+{{- template "params" .Parameters}}
+{{- range .Parts}}{{template "part" .}}{{end}}
+{{- template "links" .Links}}
+{{- range .Controls}}{{template "control" .}}{{end}}
+</section>
+{{- end}}
+
+{{define "part"}}
+<div class="part {{.Name}}"{{with .Id}} id="{{.}}"{{end}}>
+{{- if .Title}}
+<h4>{{.Title}}</h4>
+{{- else if eq .Name "guidance"}}
+<h4>Guidance</h4>
+{{- end}}
+{{- with label .Properties}}
+<span class="label">{{.}}</span>
+{{- end}}
+{{- with .Prose}}
+{{prose .}}
+{{- end}}
+{{- range .Parts}}{{template "part" .}}{{end}}
+</div>
+{{- end}}
+
+{{define "params"}}{{with .}}
+<table class="params">
+<tr><th>Parameter</th><th>Value</th></tr>
+{{- range .}}
+<tr id="{{.Id}}"><td>{{.Id}}{{with .Label}} ({{.}}){{end}}</td><td>{{param .Id}}</td></tr>
+{{- end}}
+</table>
+{{- end}}{{end}}
+`,
+	CatalogTemplate: `
+{{- template "header" .}}
+{{template "catalog-content" .Catalog}}
+{{template "footer" .}}`,
+	ProfileTemplate: `
+{{- template "header" .}}
+<section class="profile">
+<p>Controls selected by the profile{{with .Profile.Id}} <code>{{.}}</code>{{end}}, with its parameter settings and alterations applied. The profile imports:</p>
+<ul>
+{{- range .Profile.Imports}}
+<li><a href="{{.Href}}">{{.Href}}</a></li>
+{{- end}}
+</ul>
+</section>
+{{template "catalog-content" .Catalog}}
+{{template "footer" .}}`,
+	SSPTemplate: `
+{{- template "header" .}}
+{{- with .SSP}}
+{{- with .ImportProfile}}
+<p>Baseline: <a href="{{.Href}}">{{.Href}}</a></p>
+{{- end}}
+{{- with .SystemCharacteristics}}{{$c := .}}
+<section id="system-characteristics">
+<h2>System characteristics</h2>
+<table>
+{{- with .SystemName}}
+<tr><th>System name</th><td>{{.}}{{with $c.SystemNameShort}} ({{.}}){{end}}</td></tr>
+{{- end}}
+{{- range .SystemIds}}
+<tr><th>System id{{with .IdentifierType}} ({{.}}){{end}}</th><td>{{.Value}}</td></tr>
+{{- end}}
+{{- with .Status}}
+<tr><th>Status</th><td>{{.State}}</td></tr>
+{{- end}}
+{{- with .SecuritySensitivityLevel}}
+<tr><th>Security sensitivity level</th><td>{{.}}</td></tr>
+{{- end}}
+{{- with .SecurityImpactLevel}}
+<tr><th>Security impact level</th><td>confidentiality: {{.SecurityObjectiveConfidentiality}}, integrity: {{.SecurityObjectiveIntegrity}}, availability: {{.SecurityObjectiveAvailability}}</td></tr>
+{{- end}}
+{{- with .DateAuthorized}}
+<tr><th>Date authorized</th><td>{{.}}</td></tr>
+{{- end}}
+{{- range .Properties}}
+<tr><th>{{.Name}}</th><td>{{.Value}}</td></tr>
+{{- end}}
+</table>
+{{- with .Description}}
+{{prose .}}
+{{- end}}
+{{- with .AuthorizationBoundary}}
+<h3>Authorization boundary</h3>
+{{- with .Description}}
+{{prose .}}
+{{- end}}
+{{- end}}
+{{- with .NetworkArchitecture}}
+<h3>Network architecture</h3>
+{{- with .Description}}
+{{prose .}}
+{{- end}}
+{{- end}}
+{{- with .DataFlow}}
+<h3>Data flow</h3>
+{{- with .Description}}
+{{prose .}}
+{{- end}}
+{{- end}}
+{{- with .Remarks}}
+{{prose .}}
+{{- end}}
+</section>
+{{- end}}
+{{- with .SystemImplementation}}
+<section id="system-implementation">
+<h2>System implementation</h2>
+{{- with .Users}}
+<h3>Users</h3>
+<table>
+<tr><th>User</th><th>Roles</th><th>Description</th></tr>
+{{- range .}}
+<tr id="{{.Id}}"><td>{{.Title}}</td><td>{{range $i, $r := .RoleIds}}{{if $i}}, {{end}}{{ref (printf "%s" $r)}}{{end}}</td><td>{{prose .Description}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- range .Components}}
+<section class="component" id="{{.Id}}">
+<h3>{{or .Title .Id}}</h3>
+{{- with .ComponentType}}
+<p>Type: {{.}}</p>
+{{- end}}
+{{- with .Status}}
+<p>Status: {{.State}}</p>
+{{- end}}
+{{- with .Description}}
+{{prose .}}
+{{- end}}
+{{- template "responsible-roles" .ResponsibleRoles}}
+{{- template "links" .Links}}
+{{- with .Remarks}}
+{{prose .}}
+{{- end}}
+</section>
+{{- end}}
+{{- with .Services}}
+<h3>Services</h3>
+<table>
+<tr><th>Service</th><th>Purpose</th><th>Description</th></tr>
+{{- range .}}
+<tr{{with .Id}} id="{{.}}"{{end}}><td>{{.Title}}</td><td>{{.Purpose}}</td><td>{{prose .Description}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- with .SystemInventory}}{{with .InventoryItems}}
+<h3>Inventory</h3>
+<table>
+<tr><th>Asset</th><th>Description</th><th>Components</th></tr>
+{{- range .}}
+<tr{{with .Id}} id="{{.}}"{{end}}><td>{{.AssetId}}</td><td>{{prose .Description}}</td><td>{{range $i, $c := .ImplementedComponents}}{{if $i}}, {{end}}{{ref $c.ComponentId}}{{end}}</td></tr>
+{{- end}}
+</table>
+{{- end}}{{end}}
+</section>
+{{- end}}
+{{- with .ControlImplementation}}
+<section id="control-implementation">
+<h2>Control implementation</h2>
+{{- with .Description}}
+{{prose .}}
+{{- end}}
+{{- range .ImplementedRequirements}}{{template "implemented-requirement" .}}{{end}}
+</section>
+{{- end}}
+{{- end}}
+{{template "footer" .}}
+
+{{- define "implemented-requirement"}}
+<section class="implemented-requirement" id="{{requirement .}}">
+<h3>{{.ControlId}}</h3>
+{{- with .Description}}
+{{prose .}}
+{{- end}}
+{{- template "responsible-roles" .ResponsibleRoles}}
+{{- template "parameter-settings" .ParameterSettings}}
+{{- range .Statements}}
+<div class="statement">
+<h4>{{.StatementId}}</h4>
+{{- with .Description}}
+{{prose .}}
+{{- end}}
+{{- template "responsible-roles" .ResponsibleRoles}}
+{{- template "by-components" .ByComponents}}
+</div>
+{{- end}}
+{{- template "by-components" .ByComponents}}
+{{- with .Remarks}}
+{{prose .}}
+{{- end}}
+</section>
+{{- end}}
+
+{{define "by-components"}}{{range .}}
+<div class="by-component">
+<h5>{{ref .ComponentId}}</h5>
+{{- with .Description}}
+{{prose .}}
+{{- end}}
+{{- template "responsible-roles" .ResponsibleRoles}}
+{{- template "parameter-settings" .ParameterSettings}}
+</div>
+{{- end}}{{end}}
+
+{{define "responsible-roles"}}{{with .}}
+<p>Responsible roles: {{range $i, $r := .}}{{if $i}}, {{end}}{{ref $r.RoleId}}{{with $r.PartyIds}} ({{range $j, $p := .}}{{if $j}}, {{end}}{{ref (printf "%s" $p)}}{{end}}){{end}}{{end}}</p>
+{{- end}}{{end}}
+
+{{define "parameter-settings"}}{{with .}}
+<table class="params">
+<tr><th>Parameter</th><th>Value</th></tr>
+{{- range .}}
+<tr><td>{{.ParamId}}</td><td>{{.Value}}</td></tr>
+{{- end}}
+</table>
+{{- end}}{{end}}
+`,
+	ComponentTemplate: `
+{{- template "header" .}}
+{{- with .Component}}
+{{- with .Components}}
+<section id="components">
+<h2>Components</h2>
+{{- range .}}
+<section class="component" id="{{.Id}}">
+<h3>{{or .Title .Name}}</h3>
+{{- with .ComponentType}}
+<p>Type: {{.}}</p>
+{{- end}}
+{{- with .Description}}
+{{prose .}}
+{{- end}}
+{{- template "links" .Links}}
+{{- template "control-implementations" .ControlImplementations}}
+{{- with .Remarks}}
+{{prose .}}
+{{- end}}
+</section>
+{{- end}}
+</section>
+{{- end}}
+{{- with .Capabilities}}
+<section id="capabilities">
+<h2>Capabilities</h2>
+{{- range .}}
+<section class="capability" id="{{.Id}}">
+<h3>{{.Name}}</h3>
+{{- with .Description}}
+{{prose .}}
+{{- end}}
+{{- with .IncorporatesComponents}}
+<p>Components: {{range $i, $c := .}}{{if $i}}, {{end}}{{ref $c.ComponentId}}{{end}}</p>
+{{- end}}
+{{- template "control-implementations" .ControlImplementations}}
+</section>
+{{- end}}
+</section>
+{{- end}}
+{{- end}}
+{{template "footer" .}}
+
+{{- define "control-implementations"}}{{range .}}
+<div class="control-implementation">
+{{- with .Description}}
+{{prose .}}
+{{- end}}
+{{- range .CanMeetRequirementSets}}
+<h4>Requirements of <a href="{{.Source}}">{{.Source}}</a></h4>
+{{- with .Description}}
+{{prose .}}
+{{- end}}
+<table>
+<tr><th>Control</th><th>Description</th></tr>
+{{- range .ImplementedRequirements}}
+<tr{{with .Id}} id="{{.}}"{{end}}><td>{{.ControlId}}</td><td>{{prose .Description}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</div>
+{{- end}}{{end}}
+`,
+}
@@ -1,27 +1,295 @@
 package json_validation
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
-	"os"
+	"io/ioutil"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/docker/oscalkit/pkg/validation"
+	"github.com/docker/oscalkit/pkg/yaml_json"
 	"github.com/santhosh-tekuri/jsonschema"
 )
 
-// Validate validates JSON file against a specific JSON schema.
+// KeywordSyntax is reported for documents that are not well-formed JSON
+const KeywordSyntax = "syntax"
+
+// Error is a validation error of JSON document. Pointer is JSON pointer of
+// the offending value and Keyword the schema keyword it violates. Line and
+// Column are zero when the position is not known.
+type Error struct {
+	Pointer string
+	Line    int
+	Column  int
+	Keyword string
+	Message string
+}
+
+func (e Error) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("line %d, column %d: %s: %s", e.Line, e.Column, e.Pointer, e.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.Pointer, e.Message)
+}
+
+// Errors lists the validation errors of JSON document
+type Errors []Error
+
+func (e Errors) Error() string {
+	var lines []string
+	for _, err := range e {
+		lines = append(lines, err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Validate validates JSON file against a specific JSON schema. The
+// validation errors are returned as Errors.
 func Validate(schemaPath, inputFile string) error {
+	rawJSON, err := ioutil.ReadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("Error opening file: %s, %v", inputFile, err)
+	}
+
+	return validate(schemaPath, rawJSON, true)
+}
+
+// ValidateYAML validates YAML file against a specific JSON schema. The YAML
+// document is converted to JSON before validation, positions of the errors
+// are not reported.
+func ValidateYAML(schemaPath, inputFile string) error {
+	rawYAML, err := ioutil.ReadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("Error opening file: %s, %v", inputFile, err)
+	}
+
+	rawJSON, err := yaml_json.ToJSON(rawYAML)
+	if err != nil {
+		return Errors{{Keyword: KeywordSyntax, Message: fmt.Sprintf("Error converting YAML to JSON: %v", err)}}
+	}
+
+	return validate(schemaPath, rawJSON, false)
+}
+
+func validate(schemaPath string, rawJSON []byte, locate bool) error {
 	schema, err := jsonschema.Compile(schemaPath)
 	if err != nil {
 		return fmt.Errorf("Error compiling OSCAL schema: %v", err)
 	}
 
-	rawFile, err := os.Open(inputFile)
+	doc, err := jsonschema.DecodeJSON(bytes.NewReader(rawJSON))
 	if err != nil {
-		return fmt.Errorf("Error opening file: %s, %v", inputFile, err)
+		e := Error{Keyword: KeywordSyntax, Message: err.Error()}
+		if syntax, ok := err.(*json.SyntaxError); ok && locate {
+			e.Line, e.Column = position(rawJSON, syntax.Offset)
+		}
+		return Errors{e}
+	}
+
+	errs := collect(schema, doc, "")
+	if len(errs) == 0 {
+		return nil
+	}
+	if locate {
+		positions := locatePointers(rawJSON)
+		for i, e := range errs {
+			if offset, ok := positions[e.Pointer]; ok {
+				errs[i].Line, errs[i].Column = position(rawJSON, offset)
+			}
+		}
+		sort.SliceStable(errs, func(i, j int) bool {
+			return errs[i].Line < errs[j].Line
+		})
+	}
+	return errs
+}
+
+// collect validates the value at pointer and returns all its errors. The
+// schema validator stops at the first invalid member of an object, so
+// members and array items are validated one by one in stable order. Errors
+// of the other keywords are taken from the schema validator.
+func collect(s *jsonschema.Schema, doc interface{}, pointer string) Errors {
+	if s.ValidateInterface(doc) == nil {
+		return nil
+	}
+	for s.Ref != nil {
+		s = s.Ref
+	}
+	var errs Errors
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		if len(s.Properties) == 0 || !allowsType(s, "object") {
+			break
+		}
+		var missing []string
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				missing = append(missing, strconv.Quote(name))
+			}
+		}
+		if len(missing) > 0 {
+			errs = append(errs, Error{Pointer: pointer, Keyword: "required", Message: "missing properties: " + strings.Join(missing, ", ")})
+		}
+		var names []string
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			member := pointer + "/" + validation.EscapePointer(name)
+			if property, ok := s.Properties[name]; ok {
+				errs = append(errs, collect(property, v[name], member)...)
+				continue
+			}
+			switch additional := s.AdditionalProperties.(type) {
+			case bool:
+				if !additional {
+					errs = append(errs, Error{Pointer: member, Keyword: "additionalProperties", Message: fmt.Sprintf("additional property %q is not allowed", name)})
+				}
+			case *jsonschema.Schema:
+				errs = append(errs, collect(additional, v[name], member)...)
+			}
+		}
+	case []interface{}:
+		items, ok := s.Items.(*jsonschema.Schema)
+		if !ok || !allowsType(s, "array") {
+			break
+		}
+		if s.MinItems != -1 && len(v) < s.MinItems {
+			errs = append(errs, Error{Pointer: pointer, Keyword: "minItems", Message: fmt.Sprintf("minimum %d items allowed, but found %d items", s.MinItems, len(v))})
+		}
+		if s.MaxItems != -1 && len(v) > s.MaxItems {
+			errs = append(errs, Error{Pointer: pointer, Keyword: "maxItems", Message: fmt.Sprintf("maximum %d items allowed, but found %d items", s.MaxItems, len(v))})
+		}
+		for i, item := range v {
+			errs = append(errs, collect(items, item, fmt.Sprintf("%s/%d", pointer, i))...)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	err := s.ValidateInterface(doc)
+	ve, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return Errors{{Pointer: pointer, Message: err.Error()}}
+	}
+	for _, leaf := range leaves(ve) {
+		instance, unescapeErr := url.PathUnescape(strings.TrimPrefix(leaf.InstancePtr, "#"))
+		if unescapeErr != nil {
+			instance = strings.TrimPrefix(leaf.InstancePtr, "#")
+		}
+		errs = append(errs, Error{
+			Pointer: pointer + instance,
+			Keyword: leaf.SchemaPtr[strings.LastIndex(leaf.SchemaPtr, "/")+1:],
+			Message: leaf.Message,
+		})
+	}
+	return errs
+}
+
+func allowsType(s *jsonschema.Schema, t string) bool {
+	if len(s.Types) == 0 {
+		return true
+	}
+	for _, allowed := range s.Types {
+		if allowed == t {
+			return true
+		}
+	}
+	return false
+}
+
+// leaves returns the errors without further causes
+func leaves(ve *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(ve.Causes) == 0 {
+		return []*jsonschema.ValidationError{ve}
+	}
+	var result []*jsonschema.ValidationError
+	for _, cause := range ve.Causes {
+		result = append(result, leaves(cause)...)
 	}
-	defer rawFile.Close()
+	return result
+}
+
+// position converts byte offset to one-based line and column
+func position(data []byte, offset int64) (int, int) {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	before := data[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	return line, int(offset) - (bytes.LastIndexByte(before, '\n') + 1) + 1
+}
+
+// locatePointers returns byte offsets of the values of JSON document indexed
+// by their JSON pointers. Object members are located at their keys.
+func locatePointers(data []byte) map[string]int64 {
+	l := &locator{data: data, d: json.NewDecoder(bytes.NewReader(data)), positions: map[string]int64{}}
+	l.value("", true)
+	return l.positions
+}
+
+type locator struct {
+	data      []byte
+	d         *json.Decoder
+	positions map[string]int64
+}
+
+// next returns offset of the next token
+func (l *locator) next() int64 {
+	offset := l.d.InputOffset()
+	for offset < int64(len(l.data)) && strings.IndexByte(" \t\r\n,:", l.data[offset]) >= 0 {
+		offset++
+	}
+	return offset
+}
+
+func (l *locator) value(pointer string, record bool) bool {
+	offset := l.next()
+	token, err := l.d.Token()
+	if err != nil {
+		return false
+	}
+	if record {
+		l.positions[pointer] = offset
+	}
+	switch token {
+	case json.Delim('{'):
+		for l.d.More() {
+			offset := l.next()
+			key, err := l.d.Token()
+			if err != nil {
+				return false
+			}
+			member := pointer + "/" + validation.EscapePointer(fmt.Sprint(key))
+			l.positions[member] = offset
+			if !l.value(member, false) {
+				return false
+			}
+		}
+		_, err = l.d.Token()
+	case json.Delim('['):
+		for i := 0; l.d.More(); i++ {
+			if !l.value(fmt.Sprintf("%s/%d", pointer, i), true) {
+				return false
+			}
+		}
+		_, err = l.d.Token()
+	}
+	return err == nil
+}
 
-	if err = schema.Validate(rawFile); err != nil {
-		return err
+// Locate returns positions of the values of JSON document indexed by their
+// JSON pointers. Object members are located at their keys.
+func Locate(data []byte) map[string]validation.Position {
+	positions := map[string]validation.Position{}
+	for pointer, offset := range locatePointers(data) {
+		line, column := position(data, offset)
+		positions[pointer] = validation.Position{Line: line, Column: column}
 	}
-	return nil
+	return positions
 }
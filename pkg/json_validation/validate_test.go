@@ -0,0 +1,102 @@
+package json_validation
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const schema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "required": ["catalog"],
+  "properties": {
+    "catalog": {
+      "type": "object",
+      "required": ["id", "metadata"],
+      "additionalProperties": false,
+      "properties": {
+        "id": {"type": "string"},
+        "metadata": {"$ref": "#/definitions/metadata"},
+        "controls": {"type": "array", "items": {"$ref": "#/definitions/control"}}
+      }
+    }
+  },
+  "definitions": {
+    "metadata": {
+      "type": "object",
+      "required": ["title"],
+      "properties": {"title": {"type": "string"}}
+    },
+    "control": {
+      "type": "object",
+      "required": ["id"],
+      "properties": {"id": {"type": "string", "pattern": "^[a-z]"}}
+    }
+  }
+}`
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "json_validation")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestValidate(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"schema.json": schema,
+		"valid.json":  `{"catalog": {"id": "c", "metadata": {"title": "T"}}}`,
+		"invalid.json": `{
+  "catalog": {
+    "id": 1,
+    "metadata": {},
+    "controls": [
+      {"id": "a"},
+      {"id": "B"},
+      {}
+    ],
+    "bogus": true
+  }
+}`,
+		"invalid.yaml": "catalog:\n  id: c\n  metadata:\n    title: 1\n",
+		"broken.json":  "{\n  \"catalog\": {\n    \"id\" \"c\"\n  }\n}",
+	})
+	defer os.RemoveAll(dir)
+	schemaPath := filepath.Join(dir, "schema.json")
+
+	if err := Validate(schemaPath, filepath.Join(dir, "valid.json")); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err := Validate(schemaPath, filepath.Join(dir, "invalid.json"))
+	expected := Errors{
+		{Pointer: "/catalog/id", Line: 3, Column: 5, Keyword: "type", Message: "expected string, but got number"},
+		{Pointer: "/catalog/metadata", Line: 4, Column: 5, Keyword: "required", Message: `missing properties: "title"`},
+		{Pointer: "/catalog/controls/1/id", Line: 7, Column: 8, Keyword: "pattern", Message: `does not match pattern "^[a-z]"`},
+		{Pointer: "/catalog/controls/2", Line: 8, Column: 7, Keyword: "required", Message: `missing properties: "id"`},
+		{Pointer: "/catalog/bogus", Line: 10, Column: 5, Keyword: "additionalProperties", Message: `additional property "bogus" is not allowed`},
+	}
+	if !reflect.DeepEqual(err, expected) {
+		t.Errorf("unexpected errors:\n%v\nexpected:\n%v", err, expected)
+	}
+
+	err = ValidateYAML(schemaPath, filepath.Join(dir, "invalid.yaml"))
+	expected = Errors{{Pointer: "/catalog/metadata/title", Keyword: "type", Message: "expected string, but got number"}}
+	if !reflect.DeepEqual(err, expected) {
+		t.Errorf("unexpected errors:\n%v\nexpected:\n%v", err, expected)
+	}
+
+	err = Validate(schemaPath, filepath.Join(dir, "broken.json"))
+	if errs, ok := err.(Errors); !ok || len(errs) != 1 || errs[0].Keyword != KeywordSyntax || errs[0].Line != 3 {
+		t.Errorf("expected syntax error on line 3, got %v", err)
+	}
+}
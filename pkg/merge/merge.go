@@ -0,0 +1,308 @@
+// Package merge merges concurrent edits of OSCAL documents. The merge is a
+// three-way merge of the models: lists of controls, parts, parameters,
+// implemented requirements and other objects with ids are merged by their
+// ids, so that edits of different objects never conflict however the
+// documents are formatted or ordered. Conflicting edits of the same value
+// keep our version and are reported.
+package merge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/types/oscal"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+// Conflict is a value changed differently by both sides. Values are written
+// as JSON, prose as Markdown. Deleted values are empty.
+type Conflict struct {
+	// Path is JSON-like path of the value, list items are selected by id,
+	// such as /catalog/groups[ac]/controls[ac-1]/parts[ac-1_smt]/prose
+	Path   string `json:"path"`
+	Base   string `json:"base"`
+	Ours   string `json:"ours"`
+	Theirs string `json:"theirs"`
+}
+
+// Result of the merge. Document holds the merged document, where the
+// conflicting values are kept as in our document.
+type Result struct {
+	Document  *oscal.OSCAL
+	Conflicts []Conflict
+}
+
+// keyFields are the fields identifying items of lists, in order of preference
+var keyFields = []string{"Id", "ControlId", "ParamId", "RoleId", "PartyId", "Href"}
+
+var markupType = reflect.TypeOf(validation_root.Markup{})
+
+// Merge merges changes made by ours and theirs to the base document. The
+// documents are normalized by Canonicalize first, so that they differ only
+// in content.
+func Merge(base, ours, theirs *oscal.OSCAL) (*Result, error) {
+	docType := ours.DocumentType()
+	if base.DocumentType() != docType || theirs.DocumentType() != docType {
+		return nil, fmt.Errorf("Cannot merge %s, %s and %s", base.DocumentType(), docType, theirs.DocumentType())
+	}
+	if docType == constants.UnknownDocument {
+		return nil, fmt.Errorf("Cannot merge unknown documents")
+	}
+	for _, o := range []*oscal.OSCAL{base, ours, theirs} {
+		if err := o.Canonicalize(); err != nil {
+			return nil, err
+		}
+	}
+	m := &merger{}
+	merged := &oscal.OSCAL{}
+	root := reflect.ValueOf(merged).Elem()
+	b, o, t := reflect.ValueOf(base).Elem(), reflect.ValueOf(ours).Elem(), reflect.ValueOf(theirs).Elem()
+	for i := 0; i < root.NumField(); i++ {
+		f := root.Type().Field(i)
+		name := jsonName(f)
+		if f.PkgPath != "" || name == "-" || o.Field(i).IsNil() {
+			continue
+		}
+		root.Field(i).Set(m.merge("/"+name, b.Field(i), o.Field(i), t.Field(i)))
+	}
+	return &Result{Document: merged, Conflicts: m.conflicts}, nil
+}
+
+type merger struct {
+	conflicts []Conflict
+}
+
+func (m *merger) merge(path string, base, ours, theirs reflect.Value) reflect.Value {
+	switch {
+	case equal(ours, theirs), equal(base, theirs):
+		return ours
+	case equal(base, ours):
+		return theirs
+	}
+	switch ours.Kind() {
+	case reflect.Ptr:
+		if ours.IsNil() || theirs.IsNil() {
+			return m.conflict(path, base, ours, theirs)
+		}
+		if base.IsNil() {
+			base = reflect.New(ours.Type().Elem())
+		}
+		merged := reflect.New(ours.Type().Elem())
+		merged.Elem().Set(m.merge(path, base.Elem(), ours.Elem(), theirs.Elem()))
+		return merged
+	case reflect.Struct:
+		if ours.Type() == markupType {
+			return m.conflict(path, base, ours, theirs)
+		}
+		merged := reflect.New(ours.Type()).Elem()
+		for i := 0; i < ours.NumField(); i++ {
+			f := ours.Type().Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			if jsonName(f) == "-" {
+				// XML names differ between formats and are not merged
+				merged.Field(i).Set(ours.Field(i))
+				if ours.Field(i).IsZero() {
+					merged.Field(i).Set(theirs.Field(i))
+				}
+				continue
+			}
+			merged.Field(i).Set(m.merge(path+"/"+jsonName(f), base.Field(i), ours.Field(i), theirs.Field(i)))
+		}
+		return merged
+	case reflect.Slice:
+		if merged, ok := m.mergeList(path, base, ours, theirs); ok {
+			return merged
+		}
+	}
+	return m.conflict(path, base, ours, theirs)
+}
+
+// entry is item of a list with its key
+type entry struct {
+	key   string
+	value reflect.Value
+}
+
+// mergeList merges lists of items with unique keys. Items added by theirs
+// are placed after the item preceding them in their list. It returns false
+// when the items cannot be identified.
+func (m *merger) mergeList(path string, base, ours, theirs reflect.Value) (reflect.Value, bool) {
+	b, ok := entries(base)
+	if !ok {
+		return reflect.Value{}, false
+	}
+	o, ok := entries(ours)
+	if !ok {
+		return reflect.Value{}, false
+	}
+	t, ok := entries(theirs)
+	if !ok {
+		return reflect.Value{}, false
+	}
+	baseItems, theirItems := index(b), index(t)
+	zero := reflect.Zero(ours.Type().Elem())
+	var merged []entry
+	inOurs := map[string]bool{}
+	for _, e := range o {
+		inOurs[e.key] = true
+		itemPath := fmt.Sprintf("%s[%s]", path, e.key)
+		baseItem, inBase := baseItems[e.key]
+		theirItem, inTheirs := theirItems[e.key]
+		switch {
+		case inTheirs && inBase:
+			merged = append(merged, entry{e.key, m.merge(itemPath, baseItem, e.value, theirItem)})
+		case inTheirs:
+			// added by both sides
+			merged = append(merged, entry{e.key, m.merge(itemPath, zero, e.value, theirItem)})
+		case inBase && equal(baseItem, e.value):
+			// deleted by theirs
+		case inBase:
+			// deleted by theirs and modified by ours
+			m.conflict(itemPath, baseItem, e.value, reflect.Value{})
+			merged = append(merged, e)
+		default:
+			// added by ours
+			merged = append(merged, e)
+		}
+	}
+	for i, e := range t {
+		if inOurs[e.key] {
+			continue
+		}
+		if baseItem, inBase := baseItems[e.key]; inBase {
+			if equal(baseItem, e.value) {
+				// deleted by ours
+				continue
+			}
+			// deleted by ours and modified by theirs
+			m.conflict(fmt.Sprintf("%s[%s]", path, e.key), baseItem, reflect.Value{}, e.value)
+		}
+		position := 0
+		for j := i - 1; j >= 0 && position == 0; j-- {
+			for k := range merged {
+				if merged[k].key == t[j].key {
+					position = k + 1
+					break
+				}
+			}
+		}
+		merged = append(merged[:position], append([]entry{e}, merged[position:]...)...)
+	}
+	result := reflect.MakeSlice(ours.Type(), 0, len(merged))
+	for _, e := range merged {
+		result = reflect.Append(result, e.value)
+	}
+	if len(merged) == 0 && ours.IsNil() {
+		result = ours
+	}
+	return result, true
+}
+
+// entries returns items of the list with their keys. Items are identified by
+// the first non-empty key field, items without keys by their name and
+// position among the items of the same name. It returns false when the
+// items are not identified or the keys are not unique.
+func entries(list reflect.Value) ([]entry, bool) {
+	t := list.Type().Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == markupType {
+		return nil, false
+	}
+	var result []entry
+	seen := map[string]bool{}
+	names := map[string]int{}
+	for i := 0; i < list.Len(); i++ {
+		item := reflect.Indirect(list.Index(i))
+		key := ""
+		for _, name := range keyFields {
+			if f := item.FieldByName(name); f.IsValid() && f.Kind() == reflect.String && f.String() != "" {
+				key = f.String()
+				break
+			}
+		}
+		if key == "" {
+			f := item.FieldByName("Name")
+			if !f.IsValid() || f.Kind() != reflect.String || f.String() == "" {
+				return nil, false
+			}
+			names[f.String()]++
+			key = fmt.Sprintf("%s#%d", f.String(), names[f.String()])
+		}
+		if seen[key] {
+			return nil, false
+		}
+		seen[key] = true
+		result = append(result, entry{key, list.Index(i)})
+	}
+	return result, true
+}
+
+func index(entries []entry) map[string]reflect.Value {
+	m := map[string]reflect.Value{}
+	for _, e := range entries {
+		m[e.key] = e.value
+	}
+	return m
+}
+
+// conflict records conflicting values and returns our value
+func (m *merger) conflict(path string, base, ours, theirs reflect.Value) reflect.Value {
+	m.conflicts = append(m.conflicts, Conflict{Path: path, Base: format(base), Ours: format(ours), Theirs: format(theirs)})
+	return ours
+}
+
+func equal(a, b reflect.Value) bool {
+	if isEmpty(a) && isEmpty(b) {
+		return true
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// isEmpty reports whether the value is missing, nil or empty list
+func isEmpty(v reflect.Value) bool {
+	if !v.IsValid() {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return false
+}
+
+// format returns the value for the conflict report
+func format(v reflect.Value) string {
+	if isEmpty(v) {
+		return ""
+	}
+	if v.Kind() == reflect.String {
+		return v.String()
+	}
+	b, err := json.MarshalIndent(v.Interface(), "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%v", v.Interface())
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		// prose and other values written as single string
+		return s
+	}
+	return string(b)
+}
+
+func jsonName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
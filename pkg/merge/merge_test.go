@@ -0,0 +1,204 @@
+package merge
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/types/oscal"
+	"github.com/docker/oscalkit/types/oscal/catalog"
+	"github.com/docker/oscalkit/types/oscal/profile"
+	ssp "github.com/docker/oscalkit/types/oscal/system_security_plan"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+func testCatalog() *oscal.OSCAL {
+	return &oscal.OSCAL{Catalog: &catalog.Catalog{
+		Id:       "c",
+		Metadata: &validation_root.Metadata{Title: "Catalog", Version: "1.0"},
+		Groups: []catalog.Group{{
+			Id:    "ac",
+			Title: "Access Control",
+			Controls: []catalog.Control{
+				catalog.NewControl("ac-1", "Policy", &catalog.ControlOpts{
+					Params: []catalog.Param{{Id: "ac-1_prm_1", Label: "frequency"}},
+					Parts:  []catalog.Part{catalog.NewPart("ac-1_smt", "Statement", "<p>Develop a policy.</p>")},
+				}),
+				catalog.NewControl("ac-2", "Accounts", nil),
+			},
+		}},
+	}}
+}
+
+func controlIds(o *oscal.OSCAL) []string {
+	var ids []string
+	for _, c := range o.Catalog.Groups[0].Controls {
+		ids = append(ids, c.Id)
+	}
+	return ids
+}
+
+func TestMergeCatalogs(t *testing.T) {
+	base, ours, theirs := testCatalog(), testCatalog(), testCatalog()
+	ours.Catalog.Groups[0].Controls[0].Parameters[0].Label = "period"
+	ours.Catalog.Groups[0].Controls = append(ours.Catalog.Groups[0].Controls, catalog.NewControl("ac-4", "Flow", nil))
+	theirs.Catalog.Groups[0].Controls[0].Parts[0].Prose.Raw = "<p>Develop and document a policy.</p>"
+	theirs.Catalog.Groups[0].Controls = append(theirs.Catalog.Groups[0].Controls[:1],
+		catalog.NewControl("ac-3", "Enforcement", nil), theirs.Catalog.Groups[0].Controls[1])
+	theirs.Catalog.Metadata.Version = "1.1"
+
+	result, err := Merge(base, ours, theirs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Conflicts) > 0 {
+		t.Fatalf("unexpected conflicts %+v", result.Conflicts)
+	}
+	merged := result.Document
+	if ids := controlIds(merged); !reflect.DeepEqual(ids, []string{"ac-1", "ac-3", "ac-2", "ac-4"}) {
+		t.Errorf("unexpected controls %v", ids)
+	}
+	control := merged.Catalog.Groups[0].Controls[0]
+	if control.Parameters[0].Label != "period" {
+		t.Errorf("our change of the parameter is lost")
+	}
+	if control.Parts[0].Prose.Text() != "Develop and document a policy." {
+		t.Errorf("their change of the statement is lost")
+	}
+	if merged.Catalog.Metadata.Version != "1.1" {
+		t.Errorf("their change of the version is lost")
+	}
+}
+
+func TestMergeConflicts(t *testing.T) {
+	base, ours, theirs := testCatalog(), testCatalog(), testCatalog()
+	ours.Catalog.Groups[0].Controls[0].Parameters[0].Label = "period"
+	theirs.Catalog.Groups[0].Controls[0].Parameters[0].Label = "interval"
+	ours.Catalog.Groups[0].Controls[0].Parts[0].Prose.Raw = "<p>Develop a <em>written</em> policy.</p>"
+	theirs.Catalog.Groups[0].Controls[0].Parts[0].Prose.Raw = "<p>Document a policy.</p>"
+	// deleted by ours, modified by theirs
+	ours.Catalog.Groups[0].Controls = ours.Catalog.Groups[0].Controls[:1]
+	theirs.Catalog.Groups[0].Controls[1].Title = "Account Management"
+
+	result, err := Merge(base, ours, theirs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Conflict{
+		{Path: "/catalog/groups[ac]/controls[ac-1]/parameters[ac-1_prm_1]/label", Base: "frequency", Ours: "period", Theirs: "interval"},
+		{Path: "/catalog/groups[ac]/controls[ac-1]/parts[ac-1_smt]/prose", Base: "Develop a policy.", Ours: "Develop a *written* policy.", Theirs: "Document a policy."},
+	}
+	if len(result.Conflicts) != 3 || !reflect.DeepEqual(result.Conflicts[:2], expected) {
+		t.Fatalf("unexpected conflicts:\n%+v\nexpected:\n%+v", result.Conflicts, expected)
+	}
+	deleted := result.Conflicts[2]
+	if deleted.Path != "/catalog/groups[ac]/controls[ac-2]" || deleted.Ours != "" || !strings.Contains(deleted.Theirs, "Account Management") {
+		t.Errorf("unexpected conflict %+v", deleted)
+	}
+	// conflicting values are kept as ours, modified items are kept
+	control := result.Document.Catalog.Groups[0].Controls[0]
+	if control.Parameters[0].Label != "period" {
+		t.Errorf("unexpected label %s", control.Parameters[0].Label)
+	}
+	if ids := controlIds(result.Document); !reflect.DeepEqual(ids, []string{"ac-1", "ac-2"}) {
+		t.Errorf("unexpected controls %v", ids)
+	}
+
+	var b bytes.Buffer
+	if err := WriteConflicts(&b, result.Conflicts[:1]); err != nil {
+		t.Fatal(err)
+	}
+	report := `CONFLICT /catalog/groups[ac]/controls[ac-1]/parameters[ac-1_prm_1]/label
+<<<<<<< ours
+period
+||||||| base
+frequency
+=======
+interval
+>>>>>>> theirs
+
+1 conflicts
+`
+	if b.String() != report {
+		t.Errorf("unexpected report:\n%s\nexpected:\n%s", b.String(), report)
+	}
+}
+
+func TestMergeSSPs(t *testing.T) {
+	testSSP := func() *oscal.OSCAL {
+		return &oscal.OSCAL{SystemSecurityPlan: &ssp.SystemSecurityPlan{
+			Id: "s",
+			ControlImplementation: &ssp.ControlImplementation{
+				ImplementedRequirements: []ssp.ImplementedRequirement{
+					{ControlId: "ac-1", Description: validation_root.MarkupFromPlain("Implemented.")},
+					{ControlId: "ac-2", Description: validation_root.MarkupFromPlain("Planned.")},
+				},
+			},
+		}}
+	}
+	base, ours, theirs := testSSP(), testSSP(), testSSP()
+	ours.SystemSecurityPlan.ControlImplementation.ImplementedRequirements[0].Description = validation_root.MarkupFromPlain("Implemented by the platform.")
+	theirs.SystemSecurityPlan.ControlImplementation.ImplementedRequirements[1].Description = validation_root.MarkupFromPlain("Implemented.")
+	// added by both sides
+	both := ssp.ImplementedRequirement{ControlId: "ac-3", Description: validation_root.MarkupFromPlain("Inherited.")}
+	ours.SystemSecurityPlan.ControlImplementation.ImplementedRequirements = append(ours.SystemSecurityPlan.ControlImplementation.ImplementedRequirements, both)
+	theirs.SystemSecurityPlan.ControlImplementation.ImplementedRequirements = append(theirs.SystemSecurityPlan.ControlImplementation.ImplementedRequirements, both)
+
+	result, err := Merge(base, ours, theirs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Conflicts) > 0 {
+		t.Fatalf("unexpected conflicts %+v", result.Conflicts)
+	}
+	var descriptions []string
+	for _, r := range result.Document.SystemSecurityPlan.ControlImplementation.ImplementedRequirements {
+		descriptions = append(descriptions, r.ControlId+": "+r.Description.Text())
+	}
+	expected := []string{"ac-1: Implemented by the platform.", "ac-2: Implemented.", "ac-3: Inherited."}
+	if !reflect.DeepEqual(descriptions, expected) {
+		t.Errorf("unexpected requirements %v", descriptions)
+	}
+}
+
+// TestMergeAcrossFormats checks that documents in different formats merge
+// without conflicts
+func TestMergeAcrossFormats(t *testing.T) {
+	decode := func(o *oscal.OSCAL, format string) *oscal.OSCAL {
+		var b bytes.Buffer
+		var err error
+		switch format {
+		case "xml":
+			err = o.XML(&b, true)
+		case "json":
+			err = o.JSON(&b, true)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := oscal.New(&b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return decoded
+	}
+	ours := testCatalog()
+	ours.Catalog.Groups[0].Controls[0].Parameters[0].Label = "period"
+	result, err := Merge(decode(testCatalog(), "xml"), decode(ours, "json"), decode(testCatalog(), "xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Conflicts) > 0 {
+		t.Fatalf("unexpected conflicts %+v", result.Conflicts)
+	}
+	if label := result.Document.Catalog.Groups[0].Controls[0].Parameters[0].Label; label != "period" {
+		t.Errorf("unexpected label %s", label)
+	}
+}
+
+func TestMergeDifferentTypes(t *testing.T) {
+	if _, err := Merge(testCatalog(), testCatalog(), &oscal.OSCAL{Profile: &profile.Profile{Id: "p"}}); err == nil {
+		t.Error("catalog and profile should not be merged")
+	}
+}
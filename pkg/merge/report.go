@@ -0,0 +1,31 @@
+package merge
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteConflicts writes the conflicts with conflict markers as known from
+// git, our and their values are separated by the base value
+func WriteConflicts(w io.Writer, conflicts []Conflict) error {
+	var b strings.Builder
+	for _, c := range conflicts {
+		fmt.Fprintf(&b, "CONFLICT %s\n", c.Path)
+		fmt.Fprintf(&b, "<<<<<<< ours\n%s", block(c.Ours))
+		fmt.Fprintf(&b, "||||||| base\n%s", block(c.Base))
+		fmt.Fprintf(&b, "=======\n%s", block(c.Theirs))
+		fmt.Fprintf(&b, ">>>>>>> theirs\n\n")
+	}
+	fmt.Fprintf(&b, "%d conflicts\n", len(conflicts))
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// block returns the value terminated by new line, deleted values are marked
+func block(value string) string {
+	if value == "" {
+		return "(deleted)\n"
+	}
+	return strings.TrimRight(value, "\n") + "\n"
+}
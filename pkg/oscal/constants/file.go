@@ -10,12 +10,38 @@ const (
 	YamlFormat
 )
 
+func (f DocumentFormat) String() string {
+	switch f {
+	case XmlFormat:
+		return "XML"
+	case JsonFormat:
+		return "JSON"
+	case YamlFormat:
+		return "YAML"
+	}
+	return "unknown"
+}
+
 type DocumentType int
 
 const (
-	UnknownDocument = iota
+	UnknownDocument DocumentType = iota
 	CatalogDocument
 	ProfileDocument
 	SSPDocument
 	ComponentDocument
 )
+
+func (t DocumentType) String() string {
+	switch t {
+	case CatalogDocument:
+		return "catalog"
+	case ProfileDocument:
+		return "profile"
+	case SSPDocument:
+		return "system-security-plan"
+	case ComponentDocument:
+		return "component-definition"
+	}
+	return "unknown"
+}
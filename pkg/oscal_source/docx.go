@@ -0,0 +1,21 @@
+package oscal_source
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/docker/oscalkit/pkg/docx"
+)
+
+// DOCX exports the system security plan as Word document
+func (s *OSCALSource) DOCX() (*bytes.Buffer, error) {
+	plan := s.OSCAL().SystemSecurityPlan
+	if plan == nil {
+		return nil, errors.New("only system security plans can be exported to DOCX")
+	}
+	var b bytes.Buffer
+	if err := docx.Write(&b, plan); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
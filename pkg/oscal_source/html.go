@@ -2,21 +2,16 @@ package oscal_source
 
 import (
 	"bytes"
-	"errors"
-	"github.com/docker/oscalkit/pkg/bundled"
-	"github.com/docker/oscalkit/pkg/oscal/constants"
-	"github.com/docker/oscalkit/pkg/xslt"
+
+	"github.com/docker/oscalkit/pkg/html"
 )
 
-func (s *OSCALSource) HTML() (*bytes.Buffer, error) {
-	if s.OSCAL().DocumentType() != constants.CatalogDocument {
-		return nil, errors.New("HTML is supported only for OSCAL Catalog")
-	}
-	transformation, err := bundled.HtmlXslt()
-	if err != nil {
+// HTML renders the document as HTML page by the renderer. Profiles are
+// resolved against the location of the source.
+func (s *OSCALSource) HTML(r *html.Renderer) (*bytes.Buffer, error) {
+	var b bytes.Buffer
+	if err := r.Render(&b, s.OSCAL(), s.UserPath); err != nil {
 		return nil, err
 	}
-	defer transformation.Cleanup()
-
-	return xslt.Transform(transformation.Path, s.UserPath)
+	return &b, nil
 }
@@ -0,0 +1,387 @@
+package oscal_source
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/validation"
+	"github.com/docker/oscalkit/pkg/yaml_json"
+	"github.com/docker/oscalkit/types/oscal/markup"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+// RuleDropped is rule id of findings reporting content of the source that
+// the OSCAL model cannot hold
+const RuleDropped = "oscalkit/dropped-content"
+
+var (
+	xmlUnmarshaler  = reflect.TypeOf((*xml.Unmarshaler)(nil)).Elem()
+	textUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	jsonUnmarshaler = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	markupType      = reflect.TypeOf(validation_root.Markup{})
+)
+
+// DroppedContent reports the elements, attributes and keys of the source that
+// are not represented by the OSCAL model. Such content is lost when the source
+// is converted or otherwise re-encoded. The findings are warnings.
+func (s *OSCALSource) DroppedContent() (*validation.ValidationResult, error) {
+	content := s.content
+	if content == nil {
+		var err error
+		if content, err = ioutil.ReadFile(s.UserPath); err != nil {
+			return nil, err
+		}
+	}
+	root := reflect.ValueOf(s.OSCAL()).Elem()
+	var findings []validation.Finding
+	report := func(path, format string, args ...interface{}) {
+		f := validation.Finding{
+			File:     s.UserPath,
+			Severity: validation.SeverityWarning,
+			Rule:     RuleDropped,
+			Message:  fmt.Sprintf(format, args...),
+		}
+		if s.DocumentFormat() == constants.XmlFormat {
+			f.XPath = path
+		} else {
+			f.Pointer = path
+		}
+		findings = append(findings, f)
+	}
+	switch s.DocumentFormat() {
+	case constants.XmlFormat:
+		if err := droppedXML(xml.NewDecoder(bytes.NewReader(content)), root, report); err != nil {
+			return nil, err
+		}
+	case constants.YamlFormat:
+		var err error
+		if content, err = yaml_json.ToJSON(content); err != nil {
+			return nil, err
+		}
+		fallthrough
+	case constants.JsonFormat:
+		var doc map[string]interface{}
+		if err := json.Unmarshal(content, &doc); err != nil {
+			return nil, err
+		}
+		droppedJSON(doc, root, report)
+	}
+	if err := s.locate(findings); err != nil {
+		return nil, err
+	}
+	result := &validation.ValidationResult{}
+	result.AddFile(s.UserPath)
+	for _, f := range findings {
+		result.Add(f)
+	}
+	return result, nil
+}
+
+// droppedXML reports elements and attributes of XML document that have no
+// counterpart in the fields of the OSCAL root
+func droppedXML(d *xml.Decoder, root reflect.Value, report func(path, format string, args ...interface{})) error {
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			path := "/" + start.Name.Local
+			t, ok := rootType(root, start.Name.Local)
+			if !ok {
+				report(path, "Element '%s' is not an OSCAL document", start.Name.Local)
+				return nil
+			}
+			return droppedElement(d, start, t, path, report)
+		}
+	}
+}
+
+func droppedElement(d *xml.Decoder, start xml.StartElement, t reflect.Type, path string, report func(path, format string, args ...interface{})) error {
+	t = elementType(t)
+	// elements holding prose, such as parts, decode their fields themselves
+	// and take the prose blocks directly among the fields
+	prose := false
+	switch {
+	case reflect.PtrTo(t).Implements(xmlUnmarshaler) && t.Kind() == reflect.Struct && holdsProse(t):
+		prose = true
+	case reflect.PtrTo(t).Implements(xmlUnmarshaler) || t.Kind() == reflect.Interface:
+		return d.Skip()
+	case reflect.PtrTo(t).Implements(textUnmarshaler) || t.Kind() != reflect.Struct:
+		return droppedText(d, start, path, report)
+	}
+	fields := xmlFields(t)
+	if fields.innerXML {
+		return d.Skip()
+	}
+	for _, a := range start.Attr {
+		if a.Name.Space == "xmlns" || a.Name.Local == "xmlns" || a.Name.Space == xsiNamespace {
+			continue
+		}
+		if !fields.attrs[a.Name.Local] {
+			report(path, "Attribute '%s' of element '%s' is not supported", a.Name.Local, start.Name.Local)
+		}
+	}
+	counts := map[string]int{}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch tok := token.(type) {
+		case xml.StartElement:
+			counts[tok.Name.Local]++
+			childPath := fmt.Sprintf("%s/%s[%d]", path, tok.Name.Local, counts[tok.Name.Local])
+			childType, ok := fields.elements[tok.Name.Local]
+			if !ok && prose && markup.IsBlock(tok.Name.Local) {
+				if err := d.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+			if !ok {
+				report(childPath, "Element '%s' is not supported in '%s'", tok.Name.Local, start.Name.Local)
+				if err := d.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := droppedElement(d, tok, childType, childPath, report); err != nil {
+				return err
+			}
+		case xml.CharData:
+			if !fields.charData && len(bytes.TrimSpace(tok)) > 0 {
+				report(path, "Text of element '%s' is not supported", start.Name.Local)
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// holdsProse tells whether the struct type has prose field
+func holdsProse(t reflect.Type) bool {
+	prose, ok := xmlFields(t).elements["prose"]
+	return ok && elementType(prose) == markupType
+}
+
+// droppedText reports child elements of element holding text only
+func droppedText(d *xml.Decoder, start xml.StartElement, path string, report func(path, format string, args ...interface{})) error {
+	counts := map[string]int{}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch tok := token.(type) {
+		case xml.StartElement:
+			counts[tok.Name.Local]++
+			report(fmt.Sprintf("%s/%s[%d]", path, tok.Name.Local, counts[tok.Name.Local]), "Element '%s' is not supported in '%s'", tok.Name.Local, start.Name.Local)
+			if err := d.Skip(); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+type xmlStructFields struct {
+	elements map[string]reflect.Type
+	attrs    map[string]bool
+	charData bool
+	innerXML bool
+}
+
+// xmlFields returns names of the elements and attributes the struct type is
+// decoded from, including the fields of the embedded structs
+func xmlFields(t reflect.Type) xmlStructFields {
+	fields := xmlStructFields{elements: map[string]reflect.Type{}, attrs: map[string]bool{}}
+	var collect func(t reflect.Type)
+	collect = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("xml")
+			if f.Anonymous && tag == "" {
+				if ft := elementType(f.Type); ft.Kind() == reflect.Struct {
+					collect(ft)
+				}
+				continue
+			}
+			if f.PkgPath != "" || f.Name == "XMLName" || tag == "-" {
+				continue
+			}
+			parts := strings.Split(tag, ",")
+			name := parts[0]
+			if name == "" {
+				name = f.Name
+			}
+			if i := strings.LastIndex(name, " "); i >= 0 {
+				name = name[i+1:]
+			}
+			kind := ""
+			for _, option := range parts[1:] {
+				if option != "omitempty" {
+					kind = option
+				}
+			}
+			switch kind {
+			case "attr":
+				fields.attrs[name] = true
+			case "chardata", "cdata":
+				fields.charData = true
+			case "innerxml", "any":
+				fields.innerXML = true
+			case "":
+				if strings.Contains(name, ">") {
+					// nested paths are accepted as they are
+					fields.elements[strings.Split(name, ">")[0]] = reflect.TypeOf((*interface{})(nil)).Elem()
+				} else {
+					fields.elements[name] = f.Type
+				}
+			}
+		}
+	}
+	collect(t)
+	return fields
+}
+
+// elementType returns type of single element of pointer and slice types
+func elementType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || (t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8) {
+		t = t.Elem()
+	}
+	return t
+}
+
+// rootType returns type of the field of the OSCAL root holding document with
+// the root element or key. Root elements are named as the top-level keys.
+func rootType(root reflect.Value, name string) (reflect.Type, bool) {
+	for i := 0; i < root.NumField(); i++ {
+		f := root.Type().Field(i)
+		if strings.Split(f.Tag.Get("json"), ",")[0] == name {
+			return f.Type, true
+		}
+	}
+	return nil, false
+}
+
+// droppedJSON reports keys of JSON document that have no counterpart in the
+// fields of the OSCAL root
+func droppedJSON(doc map[string]interface{}, root reflect.Value, report func(path, format string, args ...interface{})) {
+	for _, key := range sortedKeys(doc) {
+		t, ok := rootType(root, key)
+		if !ok {
+			report("/"+validation.EscapePointer(key), "Key '%s' is not an OSCAL document", key)
+			continue
+		}
+		droppedValue(doc[key], t, "/"+validation.EscapePointer(key), report)
+	}
+}
+
+func droppedValue(value interface{}, t reflect.Type, pointer string, report func(path, format string, args ...interface{})) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(jsonUnmarshaler) {
+		return
+	}
+	switch v := value.(type) {
+	case []interface{}:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return
+		}
+		for i, item := range v {
+			droppedValue(item, t.Elem(), fmt.Sprintf("%s/%d", pointer, i), report)
+		}
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Map:
+			for _, key := range sortedKeys(v) {
+				droppedValue(v[key], t.Elem(), pointer+"/"+validation.EscapePointer(key), report)
+			}
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for _, key := range sortedKeys(v) {
+				fieldType, ok := fields.lookup(key)
+				if !ok {
+					report(pointer+"/"+validation.EscapePointer(key), "Key '%s' is not supported", key)
+					continue
+				}
+				droppedValue(v[key], fieldType, pointer+"/"+validation.EscapePointer(key), report)
+			}
+		}
+	}
+}
+
+type jsonStructFields map[string]reflect.Type
+
+// lookup finds field of the key. Like encoding/json, exact match is
+// preferred and case-insensitive match is accepted.
+func (fields jsonStructFields) lookup(key string) (reflect.Type, bool) {
+	if t, ok := fields[key]; ok {
+		return t, true
+	}
+	for name, t := range fields {
+		if strings.EqualFold(name, key) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+// jsonFields returns names of the keys the struct type is decoded from,
+// including the fields of the embedded structs
+func jsonFields(t reflect.Type) jsonStructFields {
+	fields := jsonStructFields{}
+	var collect func(t reflect.Type)
+	collect = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("json")
+			name := strings.Split(tag, ",")[0]
+			if f.Anonymous && name == "" {
+				ft := f.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct {
+					collect(ft)
+					continue
+				}
+			}
+			if f.PkgPath != "" || tag == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			fields[name] = f.Type
+		}
+	}
+	collect(t)
+	return fields
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -1,13 +1,17 @@
 package oscal_source
 
 import (
+	"bytes"
 	"fmt"
-	"github.com/docker/oscalkit/pkg/oscal/constants"
-	"github.com/docker/oscalkit/types/oscal"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/docker/oscalkit/pkg/fetch"
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/types/oscal"
 )
 
 // OSCALSource is intermediary that handles IO and low-level common operations consistently for oscalkit
@@ -15,18 +19,53 @@ type OSCALSource struct {
 	UserPath string
 	file     *os.File
 	oscal    *oscal.OSCAL
+	sniffed  *Sniffed
+	// content of sources that do not come from the file system
+	content []byte
+
+	// SchemaVersion overrides OSCAL version of the schemas the source is
+	// validated against. Empty version is selected by metadata of the source.
+	SchemaVersion string
 }
 
-// Open creates new OSCALSource and load it up
+// StdinPath is the path that denotes standard input
+const StdinPath = "-"
+
+// Open creates new OSCALSource and load it up. StdinPath reads the source from standard input,
+// http(s) URLs are fetched with the default fetcher
 func Open(path string) (*OSCALSource, error) {
+	if path == StdinPath {
+		return OpenFromReader("stdin", os.Stdin)
+	}
+	if fetch.IsRemote(path) {
+		return OpenWith(fetch.Default, path)
+	}
 	result := OSCALSource{UserPath: path}
 	return &result, result.open()
 }
 
+// OpenWith creates new OSCALSource from document fetched by the fetcher
+func OpenWith(f fetch.Fetcher, href string) (*OSCALSource, error) {
+	rc, err := f.Fetch(href)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot fetch %s: %v", href, err)
+	}
+	defer rc.Close()
+	return OpenFromReader(href, rc)
+}
+
+// OpenFromReader creates new OSCALSource from reader, such as STDIN. The name
+// is used for reporting only.
 func OpenFromReader(name string, r io.Reader) (*OSCALSource, error) {
 	s := OSCALSource{UserPath: name}
 	var err error
-	if s.oscal, err = oscal.New(r); err != nil {
+	if s.content, err = ioutil.ReadAll(r); err != nil {
+		return nil, fmt.Errorf("Cannot read %s: %v", name, err)
+	}
+	if s.sniffed, err = SniffBytes(s.content); err != nil {
+		return nil, fmt.Errorf("Cannot recognize %s: %v", name, err)
+	}
+	if s.oscal, err = oscal.New(bytes.NewReader(s.content)); err != nil {
 		return nil, fmt.Errorf("Cannot parse file: %v", err)
 	}
 	return &s, nil
@@ -46,7 +85,11 @@ func (s *OSCALSource) open() error {
 	if s.file, err = os.Open(path); err != nil {
 		return fmt.Errorf("Cannot open file %s: %v", path, err)
 	}
-	if s.oscal, err = oscal.New(s.file); err != nil {
+	var r io.Reader
+	if s.sniffed, r, err = Sniff(s.file); err != nil {
+		return fmt.Errorf("Cannot recognize file %s: %v", path, err)
+	}
+	if s.oscal, err = oscal.New(r); err != nil {
 		return fmt.Errorf("Cannot parse file: %v", err)
 	}
 	return nil
@@ -56,14 +99,26 @@ func (s *OSCALSource) OSCAL() *oscal.OSCAL {
 	return s.oscal
 }
 
+// Sniffed returns format and document type as recognized from the source contents
+func (s *OSCALSource) Sniffed() *Sniffed {
+	return s.sniffed
+}
+
+// DocumentFormat returns format of the source. The format is determined by the
+// file contents and falls back to the file extension
 func (s *OSCALSource) DocumentFormat() constants.DocumentFormat {
-	if strings.HasSuffix(s.UserPath, ".xml") {
+	if s.sniffed != nil && s.sniffed.Format != constants.UnknownFormat {
+		return s.sniffed.Format
+	}
+	switch strings.ToLower(filepath.Ext(s.UserPath)) {
+	case ".xml":
 		return constants.XmlFormat
-	} else if strings.HasSuffix(s.UserPath, ".json") {
+	case ".json":
 		return constants.JsonFormat
-	} else {
-		return constants.UnknownFormat
+	case ".yaml", ".yml":
+		return constants.YamlFormat
 	}
+	return constants.UnknownFormat
 }
 
 // Close the OSCALSource
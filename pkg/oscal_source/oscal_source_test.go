@@ -0,0 +1,334 @@
+package oscal_source
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/pkg/fetch"
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/rules"
+	"github.com/docker/oscalkit/pkg/validation"
+)
+
+func TestDocumentFormat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oscal_source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name    string
+		content string
+		format  constants.DocumentFormat
+	}{
+		{"catalog.xml", `<catalog xmlns="http://csrc.nist.gov/ns/oscal/1.0" id="c"/>`, constants.XmlFormat},
+		{"catalog.json", `{"catalog": {"id": "c"}}`, constants.JsonFormat},
+		{"profile.yaml", "profile:\n  id: p\n", constants.YamlFormat},
+		{"profile.YML", "profile:\n  id: p\n", constants.YamlFormat},
+		{"catalog.txt", `  <catalog xmlns="http://csrc.nist.gov/ns/oscal/1.0" id="c"/>`, constants.XmlFormat},
+		{"catalog", `{"catalog": {"id": "c"}}`, constants.JsonFormat},
+		{"profile.txt", "profile:\n  id: p\n", constants.YamlFormat},
+	}
+	for _, test := range tests {
+		path := filepath.Join(dir, test.name)
+		if err := ioutil.WriteFile(path, []byte(test.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		source, err := Open(path)
+		if err != nil {
+			t.Errorf("cannot open %s: %v", test.name, err)
+			continue
+		}
+		if format := source.DocumentFormat(); format != test.format {
+			t.Errorf("%s detected as format %d, expected %d", test.name, format, test.format)
+		}
+		source.Close()
+	}
+}
+
+func TestOpenRemote(t *testing.T) {
+	href := "https://example.com/oscal/catalog.json"
+	fake := fetch.NewFake(map[string]string{href: `{"catalog": {"id": "remote"}}`})
+	defer func(f fetch.Fetcher) { fetch.Default = f }(fetch.Default)
+	fetch.Default = fake
+
+	source, err := Open(href)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer source.Close()
+	if source.OSCAL().Catalog == nil || source.OSCAL().Catalog.Id != "remote" {
+		t.Errorf("expected catalog fetched from %s", href)
+	}
+	if source.DocumentFormat() != constants.JsonFormat {
+		t.Errorf("expected JSON format, got %d", source.DocumentFormat())
+	}
+	if _, err := Open("https://example.com/missing.json"); err == nil {
+		t.Error("expected error for missing remote document")
+	}
+}
+
+func TestValidationResult(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oscal_source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name    string
+		content string
+		finding validation.Finding
+	}{
+		{
+			"catalog.xml",
+			"<catalog xmlns=\"http://csrc.nist.gov/ns/oscal/1.0\">\n  <metadata/>\n</catalog>",
+			validation.Finding{XPath: "/catalog", Line: 1, Column: 1, Rule: "xsd/attribute-required"},
+		},
+		{
+			"catalog.json",
+			"{\n  \"catalog\": {\n    \"id\": \"c\"\n  }\n}",
+			validation.Finding{Pointer: "/catalog", Line: 2, Column: 3, Rule: "json-schema/required"},
+		},
+	}
+	for _, test := range tests {
+		path := filepath.Join(dir, test.name)
+		if err := ioutil.WriteFile(path, []byte(test.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		source, err := Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := source.ValidationResult()
+		source.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Valid() || len(result.Files) != 1 || len(result.Findings) == 0 {
+			t.Errorf("%s: expected findings, got %+v", test.name, result)
+			continue
+		}
+		f := result.Findings[0]
+		expected := test.finding
+		if f.File != path || f.XPath != expected.XPath || f.Pointer != expected.Pointer || f.Line != expected.Line ||
+			f.Column != expected.Column || f.Rule != expected.Rule || f.Severity != validation.SeverityError {
+			t.Errorf("%s: unexpected finding %+v", test.name, f)
+		}
+	}
+}
+
+func TestSemanticResult(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oscal_source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name    string
+		content string
+		finding validation.Finding
+	}{
+		{
+			"catalog.xml",
+			"<catalog xmlns=\"http://csrc.nist.gov/ns/oscal/1.0\" id=\"c\">\n  <metadata/>\n  <control id=\"c\"/>\n</catalog>",
+			validation.Finding{XPath: "/catalog/control[1]", Line: 3, Column: 3},
+		},
+		{
+			"catalog.json",
+			"{\n  \"catalog\": {\n    \"id\": \"c\",\n    \"controls\": [\n      {\"id\": \"c\"}\n    ]\n  }\n}",
+			validation.Finding{Pointer: "/catalog/controls/0", Line: 5, Column: 7},
+		},
+	}
+	for _, test := range tests {
+		path := filepath.Join(dir, test.name)
+		if err := ioutil.WriteFile(path, []byte(test.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		source, err := Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := source.SemanticResult(nil)
+		source.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Findings) != 1 {
+			t.Errorf("%s: expected single finding, got %+v", test.name, result.Findings)
+			continue
+		}
+		f := result.Findings[0]
+		expected := test.finding
+		if f.File != path || f.XPath != expected.XPath || f.Pointer != expected.Pointer || f.Line != expected.Line ||
+			f.Column != expected.Column || f.Rule != rules.RuleUniqueID {
+			t.Errorf("%s: unexpected finding %+v", test.name, f)
+		}
+	}
+}
+
+func TestValidationVersion(t *testing.T) {
+	tests := []struct {
+		claimed  string
+		override string
+		version  string
+		matched  bool
+	}{
+		{constants.LatestOscalVersion, "", constants.LatestOscalVersion, true},
+		{"", "", constants.LatestOscalVersion, true},
+		{"1.0.4", "", constants.LatestOscalVersion, false},
+		{"1.0.4", constants.LatestOscalVersion, constants.LatestOscalVersion, true},
+		{"1.0.4", "0.9.0", "", false},
+	}
+	for _, test := range tests {
+		content := "<catalog xmlns=\"http://csrc.nist.gov/ns/oscal/1.0\" id=\"c\"><metadata><oscal-version>" + test.claimed + "</oscal-version></metadata></catalog>"
+		source, err := OpenFromReader("catalog.xml", strings.NewReader(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+		source.SchemaVersion = test.override
+		version, matched, err := source.ValidationVersion()
+		if version != test.version || matched != test.matched || (err != nil) != (test.version == "") {
+			t.Errorf("%s/%s: unexpected version %s, %v, %v", test.claimed, test.override, version, matched, err)
+		}
+		if !test.matched || test.version == "" {
+			continue
+		}
+		result, err := source.ValidationResult()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, f := range result.Findings {
+			if f.Rule == validation.RuleVersion {
+				t.Errorf("%s/%s: unexpected finding %v", test.claimed, test.override, f)
+			}
+		}
+	}
+
+	source, err := OpenFromReader("catalog.xml", strings.NewReader("<catalog xmlns=\"http://csrc.nist.gov/ns/oscal/1.0\" id=\"c\"><metadata><oscal-version>1.0.4</oscal-version></metadata></catalog>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := source.ValidationResult()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Findings) == 0 || result.Findings[0].Rule != validation.RuleVersion || result.Findings[0].Severity != validation.SeverityWarning {
+		t.Errorf("expected version warning, got %v", result.Findings)
+	}
+}
+
+func TestValidationAutoSelect(t *testing.T) {
+	// each document is valid against the schemas of its version only
+	documents := map[string]string{
+		"1.0.0-milestone2": `<prop name="status">draft</prop></metadata><import href="catalog.xml"><include><call control-id="ac-1"/></include></import>`,
+		"1.0.0-milestone3": `<prop name="status" value="draft"/></metadata><import href="catalog.xml"><include><call control-id="ac-1"/></include></import>`,
+		"1.0.0-rc1":        `<prop name="status" value="draft"/></metadata><import href="catalog.xml"><include with-child-controls="yes"><with-id>ac-1</with-id></include></import>`,
+	}
+	for claimed, body := range documents {
+		content := `<profile xmlns="http://csrc.nist.gov/ns/oscal/1.0" id="p"><metadata><title>P</title><last-modified>2019-10-01T00:00:00Z</last-modified>` +
+			`<version>1</version><oscal-version>` + claimed + `</oscal-version>` + body + `</profile>`
+		for version := range documents {
+			source, err := OpenFromReader("profile.xml", strings.NewReader(content))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if version != claimed {
+				source.SchemaVersion = version
+			}
+			selected, _, err := source.ValidationVersion()
+			if err != nil || selected != version {
+				t.Errorf("%s: selected schemas of %s, expected %s: %v", claimed, selected, version, err)
+			}
+			result, err := source.ValidationResult()
+			source.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Valid() != (version == claimed) {
+				t.Errorf("%s validated against %s: unexpected findings %v", claimed, version, result.Findings)
+			}
+		}
+	}
+}
+
+func TestDroppedContent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oscal_source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name     string
+		content  string
+		findings []validation.Finding
+	}{
+		{
+			"catalog.xml",
+			"<catalog xmlns=\"http://csrc.nist.gov/ns/oscal/1.0\" id=\"c\">\n  <metadata color=\"red\">\n    <title>Catalog <em>one</em></title>\n    <bogus>x</bogus>\n  </metadata>\n  <control id=\"c\"><title>C</title><part name=\"p\"><p>text</p></part></control>\n</catalog>",
+			[]validation.Finding{
+				{XPath: "/catalog/metadata[1]", Line: 2, Column: 3},
+				{XPath: "/catalog/metadata[1]/title[1]/em[1]", Line: 3, Column: 20},
+				{XPath: "/catalog/metadata[1]/bogus[1]", Line: 4, Column: 5},
+			},
+		},
+		{
+			"parts.xml",
+			"<catalog xmlns=\"http://csrc.nist.gov/ns/oscal/1.0\" id=\"c\">\n  <control id=\"c\"><title>C</title>\n    <part name=\"p\" color=\"red\"><p>kept</p><bogus/>\n      <em>lost</em><ul><li>kept</li></ul>\n      <part name=\"q\">lost</part>\n    </part>\n  </control>\n</catalog>",
+			[]validation.Finding{
+				{XPath: "/catalog/control[1]/part[1]", Line: 3, Column: 5},
+				{XPath: "/catalog/control[1]/part[1]/bogus[1]", Line: 3, Column: 43},
+				{XPath: "/catalog/control[1]/part[1]/em[1]", Line: 4, Column: 7},
+				{XPath: "/catalog/control[1]/part[1]/part[1]", Line: 5, Column: 7},
+			},
+		},
+		{
+			"catalog.json",
+			"{\n  \"catalog\": {\n    \"id\": \"c\",\n    \"metadata\": {\"title\": \"Catalog\", \"bogus\": 1},\n    \"controls\": [\n      {\"id\": \"c\", \"Title\": \"C\", \"parts\": [{\"name\": \"p\", \"prose\": \"text\"}]},\n      {\"id\": \"d\", \"colour\": \"red\"}\n    ]\n  },\n  \"extra\": {}\n}",
+			[]validation.Finding{
+				{Pointer: "/catalog/controls/1/colour", Line: 7, Column: 19},
+				{Pointer: "/catalog/metadata/bogus", Line: 4, Column: 38},
+				{Pointer: "/extra", Line: 10, Column: 3},
+			},
+		},
+		{
+			"catalog.yaml",
+			"catalog:\n  id: c\n  controls:\n  - id: c\n    colour: red\n",
+			[]validation.Finding{
+				{Pointer: "/catalog/controls/0/colour"},
+			},
+		},
+	}
+	for _, test := range tests {
+		path := filepath.Join(dir, test.name)
+		if err := ioutil.WriteFile(path, []byte(test.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		source, err := Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := source.DroppedContent()
+		source.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Findings) != len(test.findings) {
+			t.Errorf("%s: expected %d findings, got %+v", test.name, len(test.findings), result.Findings)
+			continue
+		}
+		for i, f := range result.Findings {
+			expected := test.findings[i]
+			if f.File != path || f.XPath != expected.XPath || f.Pointer != expected.Pointer || f.Line != expected.Line ||
+				f.Column != expected.Column || f.Rule != RuleDropped || f.Severity != validation.SeverityWarning {
+				t.Errorf("%s: unexpected finding %+v, expected %+v", test.name, f, expected)
+			}
+		}
+	}
+}
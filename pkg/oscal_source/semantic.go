@@ -0,0 +1,72 @@
+package oscal_source
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/docker/oscalkit/pkg/fetch"
+	"github.com/docker/oscalkit/pkg/json_validation"
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/rules"
+	"github.com/docker/oscalkit/pkg/validation"
+	"github.com/docker/oscalkit/pkg/xml_validation"
+)
+
+// SemanticResult checks the source with the rules of the engine, nil engine
+// stands for rules.Default. Findings are located in the source when its
+// format allows it.
+func (s *OSCALSource) SemanticResult(e *rules.Engine) (*validation.ValidationResult, error) {
+	if e == nil {
+		e = rules.Default
+	}
+	href := s.UserPath
+	if s.content == nil && !fetch.IsRemote(href) {
+		var err error
+		if href, err = filepath.Abs(href); err != nil {
+			return nil, err
+		}
+	}
+	findings := e.Check(&rules.Document{Href: href, Format: s.DocumentFormat(), OSCAL: s.OSCAL()})
+	if err := s.locate(findings); err != nil {
+		return nil, err
+	}
+	result := &validation.ValidationResult{}
+	result.AddFile(s.UserPath)
+	for _, f := range findings {
+		f.File = s.UserPath
+		result.Add(f)
+	}
+	return result, nil
+}
+
+// locate fills in lines and columns of the findings
+func (s *OSCALSource) locate(findings []validation.Finding) error {
+	if len(findings) == 0 {
+		return nil
+	}
+	content := s.content
+	if content == nil {
+		var err error
+		if content, err = ioutil.ReadFile(s.UserPath); err != nil {
+			return err
+		}
+	}
+	switch s.DocumentFormat() {
+	case constants.XmlFormat:
+		positions, err := xml_validation.Locate(content)
+		if err != nil {
+			return nil
+		}
+		for i, f := range findings {
+			p := positions[f.XPath]
+			findings[i].Line, findings[i].Column = p.Line, p.Column
+		}
+	case constants.JsonFormat:
+		positions := json_validation.Locate(content)
+		for i, f := range findings {
+			p := positions[f.Pointer]
+			findings[i].Line, findings[i].Column = p.Line, p.Column
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package oscal_source
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+)
+
+// OSCALNamespace is XML namespace of OSCAL documents
+const OSCALNamespace = "http://csrc.nist.gov/ns/oscal/1.0"
+
+// sniffLen is the number of bytes inspected by Sniff
+const sniffLen = 64 * 1024
+
+var rootDocumentTypes = map[string]constants.DocumentType{
+	constants.CatalogDocument.String():   constants.CatalogDocument,
+	constants.ProfileDocument.String():   constants.ProfileDocument,
+	constants.SSPDocument.String():       constants.SSPDocument,
+	constants.ComponentDocument.String(): constants.ComponentDocument,
+}
+
+// Sniffed describes a document as recognized from its leading bytes
+type Sniffed struct {
+	// Format of the document
+	Format constants.DocumentFormat
+	// DocumentType is the OSCAL model denoted by the root element or key
+	DocumentType constants.DocumentType
+	// Root is the name of the root element (XML) or the top-level key (JSON, YAML)
+	Root string
+	// Namespace of the XML root element. Empty for JSON and YAML
+	Namespace string
+}
+
+// IsOSCAL returns true if the document looks like known OSCAL document
+func (s *Sniffed) IsOSCAL() bool {
+	if s.DocumentType == constants.UnknownDocument {
+		return false
+	}
+	return s.Format != constants.XmlFormat || s.Namespace == OSCALNamespace
+}
+
+func (s *Sniffed) String() string {
+	if s.Namespace != "" {
+		return fmt.Sprintf("%s %s ({%s}%s)", s.Format, s.DocumentType, s.Namespace, s.Root)
+	}
+	return fmt.Sprintf("%s %s (%s)", s.Format, s.DocumentType, s.Root)
+}
+
+// Sniff determines document format and type from the beginning of the document. The returned
+// reader yields the complete document including the inspected bytes.
+func Sniff(r io.Reader) (*Sniffed, io.Reader, error) {
+	br := bufio.NewReaderSize(r, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, br, err
+	}
+	sniffed, err := SniffBytes(head)
+	return sniffed, br, err
+}
+
+// SniffBytes determines document format and type from the document contents. The content
+// may be truncated as only the leading part of the document is inspected.
+func SniffBytes(content []byte) (*Sniffed, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	trimmed := bytes.TrimSpace(content)
+	if len(trimmed) == 0 {
+		return nil, fmt.Errorf("document is empty")
+	}
+
+	var sniffed *Sniffed
+	var err error
+	switch trimmed[0] {
+	case '<':
+		sniffed, err = sniffXML(trimmed)
+	case '{':
+		sniffed, err = sniffJSON(trimmed)
+	default:
+		sniffed, err = sniffYAML(trimmed)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sniffed.DocumentType = rootDocumentTypes[sniffed.Root]
+	return sniffed, nil
+}
+
+func sniffXML(content []byte) (*Sniffed, error) {
+	d := xml.NewDecoder(bytes.NewReader(content))
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return nil, fmt.Errorf("cannot find XML root element: %v", err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return &Sniffed{
+				Format:    constants.XmlFormat,
+				Root:      start.Name.Local,
+				Namespace: start.Name.Space,
+			}, nil
+		}
+	}
+}
+
+func sniffJSON(content []byte) (*Sniffed, error) {
+	d := json.NewDecoder(bytes.NewReader(content))
+	// opening brace
+	if _, err := d.Token(); err != nil {
+		return nil, fmt.Errorf("malformed JSON: %v", err)
+	}
+	token, err := d.Token()
+	if err != nil {
+		return nil, fmt.Errorf("malformed JSON: %v", err)
+	}
+	root, ok := token.(string)
+	if !ok {
+		return nil, fmt.Errorf("JSON document has no top-level key")
+	}
+	return &Sniffed{Format: constants.JsonFormat, Root: root}, nil
+}
+
+// sniffYAML looks for the first top-level mapping key. Full YAML parsing is avoided
+// as the content may be truncated.
+func sniffYAML(content []byte) (*Sniffed, error) {
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimRight(line, "\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "%") || trimmed == "---" {
+			continue
+		}
+		if line != strings.TrimLeft(line, " \t") {
+			return nil, fmt.Errorf("YAML document does not start with top-level key")
+		}
+		i := strings.Index(line, ":")
+		if i <= 0 || (i+1 < len(line) && line[i+1] != ' ') {
+			return nil, fmt.Errorf("document is neither XML, JSON nor YAML")
+		}
+		root := strings.Trim(line[:i], `"'`)
+		return &Sniffed{Format: constants.YamlFormat, Root: root}, nil
+	}
+	return nil, fmt.Errorf("document is neither XML, JSON nor YAML")
+}
@@ -0,0 +1,77 @@
+package oscal_source
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+)
+
+func TestSniffBytes(t *testing.T) {
+	tests := []struct {
+		content      string
+		format       constants.DocumentFormat
+		documentType constants.DocumentType
+		isOSCAL      bool
+	}{
+		{`<?xml version="1.0"?>
+<!-- comment -->
+<catalog xmlns="http://csrc.nist.gov/ns/oscal/1.0" id="c"/>`, constants.XmlFormat, constants.CatalogDocument, true},
+		{`<profile id="p"/>`, constants.XmlFormat, constants.ProfileDocument, false},
+		{`<html><body/></html>`, constants.XmlFormat, constants.UnknownDocument, false},
+		{"\xef\xbb\xbf  {\"system-security-plan\": {\"id\": \"s\"}}", constants.JsonFormat, constants.SSPDocument, true},
+		{`{"component-definition": {`, constants.JsonFormat, constants.ComponentDocument, true},
+		{"# comment\n---\nprofile:\n  id: p\n", constants.YamlFormat, constants.ProfileDocument, true},
+		{"\"catalog\": {id: c}\n", constants.YamlFormat, constants.CatalogDocument, true},
+	}
+	for _, test := range tests {
+		sniffed, err := SniffBytes([]byte(test.content))
+		if err != nil {
+			t.Errorf("cannot sniff %q: %v", test.content, err)
+			continue
+		}
+		if sniffed.Format != test.format || sniffed.DocumentType != test.documentType || sniffed.IsOSCAL() != test.isOSCAL {
+			t.Errorf("%q sniffed as %s, oscal: %v", test.content, sniffed, sniffed.IsOSCAL())
+		}
+	}
+}
+
+func TestSniffBytesInvalid(t *testing.T) {
+	for _, content := range []string{"", "   \n", "just text", "{[]}", "<unterminated"} {
+		if sniffed, err := SniffBytes([]byte(content)); err == nil {
+			t.Errorf("%q should not be recognized, got %s", content, sniffed)
+		}
+	}
+}
+
+func TestSniffKeepsContent(t *testing.T) {
+	content := `{"catalog": {"id": "c"}}`
+	sniffed, r, err := Sniff(strings.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sniffed.DocumentType != constants.CatalogDocument {
+		t.Errorf("sniffed as %s", sniffed)
+	}
+	read, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(read) != content {
+		t.Errorf("content changed by sniffing: %s", read)
+	}
+}
+
+func TestOpenFromReader(t *testing.T) {
+	source, err := OpenFromReader("stdin", strings.NewReader("profile:\n  id: p\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source.DocumentFormat() != constants.YamlFormat {
+		t.Errorf("detected as %s", source.DocumentFormat())
+	}
+	if source.OSCAL().Profile.Id != "p" {
+		t.Error("profile not parsed")
+	}
+}
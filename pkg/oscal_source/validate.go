@@ -1,30 +1,170 @@
 package oscal_source
 
 import (
-	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
 	"github.com/docker/oscalkit/pkg/bundled"
 	"github.com/docker/oscalkit/pkg/json_validation"
 	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/validation"
 	"github.com/docker/oscalkit/pkg/xml_validation"
 )
 
 type validator func(schemaPath, inputFile string) error
 
+// Validate validates the source against OSCAL schema of its document type.
+// Findings of the validation are returned as *validation.ResultError.
 func (s *OSCALSource) Validate() error {
+	result, err := s.ValidationResult()
+	if err != nil {
+		return err
+	}
+	return result.Err()
+}
+
+// ValidationResult validates the source against OSCAL schema of its document
+// type and collects all findings. Error is returned only when the validation
+// could not be performed.
+func (s *OSCALSource) ValidationResult() (*validation.ValidationResult, error) {
+	result := &validation.ValidationResult{}
+	result.AddFile(s.UserPath)
 	validate := s.relevantValidator()
-	if validate == nil {
-		return errors.New("No validator available this file type")
+	if validate == nil || s.OSCAL().DocumentType() == constants.UnknownDocument {
+		result.Add(validation.Finding{
+			File:     s.UserPath,
+			Severity: validation.SeverityError,
+			Rule:     validation.RuleType,
+			Message:  "No validator available this file type",
+		})
+		return result, nil
 	}
-	schema, err := s.relevantSchema()
+	version, matched, err := s.ValidationVersion()
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if !matched {
+		result.Add(validation.Finding{
+			File:     s.UserPath,
+			Severity: validation.SeverityWarning,
+			Rule:     validation.RuleVersion,
+			Message:  fmt.Sprintf("No schemas available for OSCAL version %s, validated against %s", s.ClaimedVersion(), version),
+		})
+	}
+	schema, err := s.relevantSchema(version)
+	if err != nil {
+		return nil, err
 	}
 	defer schema.Cleanup()
-	return validate(schema.Path, s.UserPath)
+	inputFile, cleanup, err := s.inputFile()
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+	findings, err := s.findings(validate(schema.Path, inputFile))
+	if err != nil {
+		return nil, err
+	}
+	result.Add(findings...)
+	return result, nil
 }
 
-func (s *OSCALSource) relevantSchema() (*bundled.BundledFile, error) {
-	return bundled.Schema(s.DocumentFormat(), s.OSCAL().DocumentType())
+// findings converts validation errors to findings. Other errors are returned
+// as they are.
+func (s *OSCALSource) findings(err error) ([]validation.Finding, error) {
+	var findings []validation.Finding
+	switch errs := err.(type) {
+	case nil:
+	case xml_validation.Errors:
+		for _, e := range errs {
+			rule := "xsd/" + e.Rule
+			if e.Rule == xml_validation.RuleWellFormed {
+				rule = "xml/" + e.Rule
+			}
+			findings = append(findings, validation.Finding{
+				File:     s.UserPath,
+				XPath:    e.XPath,
+				Line:     e.Line,
+				Column:   e.Column,
+				Severity: validation.SeverityError,
+				Rule:     rule,
+				Message:  e.Message,
+			})
+		}
+	case json_validation.Errors:
+		for _, e := range errs {
+			rule := "json-schema/" + e.Keyword
+			if e.Keyword == json_validation.KeywordSyntax {
+				rule = "json/" + e.Keyword
+			}
+			findings = append(findings, validation.Finding{
+				File:     s.UserPath,
+				Pointer:  e.Pointer,
+				Line:     e.Line,
+				Column:   e.Column,
+				Severity: validation.SeverityError,
+				Rule:     rule,
+				Message:  e.Message,
+			})
+		}
+	default:
+		return nil, err
+	}
+	return findings, nil
+}
+
+// inputFile returns path of the source on the file system. Sources opened from
+// reader are stored in temporary file first
+func (s *OSCALSource) inputFile() (string, func(), error) {
+	if s.content == nil {
+		return s.UserPath, func() {}, nil
+	}
+	f, err := ioutil.TempFile("", "oscal")
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+	if _, err = f.Write(s.content); err != nil {
+		os.Remove(f.Name())
+		return "", nil, err
+	}
+	return f.Name(), func() { os.Remove(f.Name()) }, nil
+}
+
+// ClaimedVersion returns OSCAL version claimed by metadata of the source
+func (s *OSCALSource) ClaimedVersion() string {
+	if m := s.OSCAL().Metadata(); m != nil {
+		return strings.TrimSpace(string(m.OscalVersion))
+	}
+	return ""
+}
+
+// ValidationVersion returns OSCAL version of the schemas the source is
+// validated against. Matched is false when no schemas match the version
+// claimed by the source and the latest version is used instead.
+func (s *OSCALSource) ValidationVersion() (version string, matched bool, err error) {
+	if s.SchemaVersion != "" {
+		if version, ok := bundled.Match(s.SchemaVersion); ok {
+			return version, true, nil
+		}
+		return "", false, fmt.Errorf("No schemas available for OSCAL version %s, expected one of %s", s.SchemaVersion, strings.Join(bundled.Versions(), ", "))
+	}
+	claimed := s.ClaimedVersion()
+	if version, ok := bundled.Match(claimed); ok {
+		return version, true, nil
+	}
+	return constants.LatestOscalVersion, claimed == "", nil
+}
+
+func (s *OSCALSource) relevantSchema(version string) (*bundled.BundledFile, error) {
+	format := s.DocumentFormat()
+	if format == constants.YamlFormat {
+		// YAML documents are validated against JSON schema
+		format = constants.JsonFormat
+	}
+	return bundled.VersionSchema(version, format, s.OSCAL().DocumentType())
 }
 
 func (s *OSCALSource) relevantValidator() validator {
@@ -33,6 +173,8 @@ func (s *OSCALSource) relevantValidator() validator {
 		return xml_validation.Validate
 	case constants.JsonFormat:
 		return json_validation.Validate
+	case constants.YamlFormat:
+		return json_validation.ValidateYAML
 	}
 	return nil
 }
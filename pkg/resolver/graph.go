@@ -0,0 +1,201 @@
+package resolver
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DefaultMaxDepth limits the length of profile import chains
+const DefaultMaxDepth = 32
+
+// document types of the import graph nodes
+const (
+	ProfileNode = "profile"
+	CatalogNode = "catalog"
+)
+
+// ImportCycleError reports profile importing itself directly or through
+// other profiles. Chain starts and ends with the same href.
+type ImportCycleError struct {
+	Chain []string
+}
+
+func (e *ImportCycleError) Error() string {
+	return fmt.Sprintf("profile import cycle: %s", strings.Join(e.Chain, " -> "))
+}
+
+// ImportDepthError reports import chain longer than the maximum depth
+type ImportDepthError struct {
+	Chain    []string
+	MaxDepth int
+}
+
+func (e *ImportDepthError) Error() string {
+	return fmt.Sprintf("profile import chain exceeds maximum depth of %d: %s", e.MaxDepth, strings.Join(e.Chain, " -> "))
+}
+
+// CheckImport returns the import chain extended by href. It fails if href is
+// already in the chain or the extended chain is longer than maxDepth. Zero
+// maxDepth stands for DefaultMaxDepth.
+func CheckImport(chain []string, href string, maxDepth int) ([]string, error) {
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxDepth
+	}
+	extended := append(chain[:len(chain):len(chain)], href)
+	for _, visited := range chain {
+		if visited == href {
+			return nil, &ImportCycleError{Chain: extended}
+		}
+	}
+	if len(extended) > maxDepth {
+		return nil, &ImportDepthError{Chain: extended, MaxDepth: maxDepth}
+	}
+	return extended, nil
+}
+
+// Graph is the import graph of a profile. Profiles imported by several
+// profiles are represented by a single node.
+type Graph struct {
+	// Root is href of the profile the graph was built from
+	Root  string
+	Nodes map[string]*GraphNode
+}
+
+// GraphNode is a catalog or profile of the import graph
+type GraphNode struct {
+	Href string
+	Type string
+	Id   string
+	// Imports holds hrefs of the imported documents in the import order
+	Imports []string
+}
+
+// Graph loads the profile from href and builds its import graph
+func (r *Resolver) Graph(href string) (*Graph, error) {
+	g := &Graph{Root: href, Nodes: map[string]*GraphNode{}}
+	if err := r.graphNode(g, href, nil); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (r *Resolver) graphNode(g *Graph, href string, chain []string) error {
+	chain, err := CheckImport(chain, href, r.MaxDepth)
+	if err != nil {
+		return err
+	}
+	if _, ok := g.Nodes[href]; ok {
+		return nil
+	}
+	o, err := r.load(href)
+	if err != nil {
+		return chainError(err, chain)
+	}
+	switch {
+	case o.Catalog != nil:
+		g.Nodes[href] = &GraphNode{Href: href, Type: CatalogNode, Id: o.Catalog.Id}
+		return nil
+	case o.Profile == nil:
+		return chainError(fmt.Errorf("%s is neither catalog nor profile", href), chain)
+	}
+
+	node := &GraphNode{Href: href, Type: ProfileNode, Id: o.Profile.Id}
+	for _, i := range o.Profile.Imports {
+		if err := i.ValidateHref(); err != nil {
+			return chainError(fmt.Errorf("invalid import in %s: %v", href, err), chain)
+		}
+		imported, err := importHref(o.Profile, i.Href, href)
+		if err != nil {
+			return chainError(err, chain)
+		}
+		node.Imports = append(node.Imports, imported)
+	}
+	// the node is added once its imports are visited, so that a cycle is
+	// reported rather than cut short by the already visited node
+	for _, imported := range node.Imports {
+		if err := r.graphNode(g, imported, chain); err != nil {
+			return err
+		}
+	}
+	g.Nodes[href] = node
+	return nil
+}
+
+// chainError adds the import chain to error of a document imported by
+// another profile
+func chainError(err error, chain []string) error {
+	if len(chain) < 2 {
+		return err
+	}
+	return fmt.Errorf("%v (import chain: %s)", err, strings.Join(chain, " -> "))
+}
+
+// WriteText writes the graph as an indented tree. Documents imported more
+// than once are expanded at the first occurrence only.
+func (g *Graph) WriteText(w io.Writer) error {
+	expanded := map[string]bool{}
+	var write func(href, indent string) error
+	write = func(href, indent string) error {
+		node := g.Nodes[href]
+		line := fmt.Sprintf("%s%s %s", indent, node.Type, href)
+		if node.Id != "" {
+			line += fmt.Sprintf(" (%s)", node.Id)
+		}
+		if expanded[href] && len(node.Imports) > 0 {
+			line += " ..."
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+		if expanded[href] {
+			return nil
+		}
+		expanded[href] = true
+		for _, imported := range node.Imports {
+			if err := write(imported, indent+"  "); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return write(g.Root, "")
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language
+func (g *Graph) WriteDOT(w io.Writer) error {
+	var lines []string
+	lines = append(lines, "digraph imports {")
+	written := map[string]bool{}
+	var write func(href string)
+	write = func(href string) {
+		if written[href] {
+			return
+		}
+		written[href] = true
+		node := g.Nodes[href]
+		shape := "box"
+		if node.Type == CatalogNode {
+			shape = "ellipse"
+		}
+		lines = append(lines, fmt.Sprintf("  %s [label=%s, shape=%s];", strconv.Quote(href), strconv.Quote(label(node)), shape))
+		for _, imported := range node.Imports {
+			lines = append(lines, fmt.Sprintf("  %s -> %s;", strconv.Quote(href), strconv.Quote(imported)))
+		}
+		for _, imported := range node.Imports {
+			write(imported)
+		}
+	}
+	write(g.Root)
+	lines = append(lines, "}")
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+func label(node *GraphNode) string {
+	if node.Id == "" {
+		return node.Href
+	}
+	return node.Id + "\n" + node.Href
+}
@@ -0,0 +1,59 @@
+package resolver
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGraph(t *testing.T) {
+	g, err := New().Graph("testdata/diamond.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var text bytes.Buffer
+	if err := g.WriteText(&text); err != nil {
+		t.Fatal(err)
+	}
+	expected := strings.Join([]string{
+		"profile testdata/diamond.xml (diamond)",
+		"  profile testdata/include-call.xml (include-call)",
+		"    catalog testdata/catalog.xml (abc-catalog)",
+		"  profile testdata/nested.xml (nested)",
+		"    profile testdata/modify.xml (modify)",
+		"      catalog testdata/catalog.xml (abc-catalog)",
+	}, "\n") + "\n"
+	if got := filepath.ToSlash(text.String()); got != expected {
+		t.Errorf("unexpected text graph\nexpected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	var dot bytes.Buffer
+	if err := g.WriteDOT(&dot); err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.ToSlash(dot.String())
+	for _, edge := range []string{
+		`"testdata/diamond.xml" -> "testdata/include-call.xml";`,
+		`"testdata/nested.xml" -> "testdata/modify.xml";`,
+		`"testdata/modify.xml" -> "testdata/catalog.xml";`,
+	} {
+		if !strings.Contains(out, edge) {
+			t.Errorf("expected edge %s in\n%s", edge, out)
+		}
+	}
+	if n := strings.Count(out, `"testdata/catalog.xml" [label=`); n != 1 {
+		t.Errorf("expected single catalog node, got %d", n)
+	}
+}
+
+func TestGraphCycle(t *testing.T) {
+	_, err := New().Graph("testdata/cycle-a.xml")
+	cycle, ok := err.(*ImportCycleError)
+	if !ok {
+		t.Fatalf("expected import cycle error, got %v", err)
+	}
+	if len(cycle.Chain) != 3 || cycle.Chain[0] != cycle.Chain[2] {
+		t.Errorf("unexpected cycle %v", cycle.Chain)
+	}
+}
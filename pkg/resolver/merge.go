@@ -0,0 +1,380 @@
+package resolver
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/docker/oscalkit/types/oscal/catalog"
+	"github.com/docker/oscalkit/types/oscal/profile"
+)
+
+// combine methods handling controls selected more than once
+const (
+	combineUseFirst = "use-first"
+	combineMerge    = "merge"
+	combineKeep     = "keep"
+)
+
+// match orders of custom structuring
+const (
+	orderKeep       = "keep"
+	orderAscending  = "ascending"
+	orderDescending = "descending"
+)
+
+// merge combines the selections into single catalog. Controls are
+// structured as in their source catalogs (as-is), per custom directive or
+// without any grouping when the profile has no merge directive.
+func merge(m *profile.Merge, selections []*selection) (*catalog.Catalog, error) {
+	method := combineUseFirst
+	if m != nil && m.Combine != nil && m.Combine.Method != "" {
+		method = m.Combine.Method
+	}
+	switch method {
+	case combineUseFirst, combineMerge, combineKeep:
+	default:
+		return nil, fmt.Errorf("unknown combine method %s", method)
+	}
+	c := newCombiner(method)
+
+	resolved := &catalog.Catalog{}
+	if m != nil && m.IsAsIs() && m.Custom == nil {
+		for _, s := range selections {
+			resolved.Parameters = append(resolved.Parameters, s.parameters...)
+			resolved.Controls = append(resolved.Controls, s.controls...)
+			resolved.Groups = mergeGroups(resolved.Groups, s.groups)
+		}
+		c.collect(resolved.Controls)
+		c.collectGroups(resolved.Groups)
+		resolved.Parameters = c.params(resolved.Parameters)
+		resolved.Controls = c.rebuild(resolved.Controls)
+		resolved.Groups = c.rebuildGroups(resolved.Groups)
+		return resolved, nil
+	}
+
+	for _, s := range selections {
+		resolved.Parameters = append(resolved.Parameters, s.parameters...)
+		resolved.Controls = append(resolved.Controls, s.controls...)
+		flattenGroups(resolved, s.groups)
+	}
+	c.collect(resolved.Controls)
+	resolved.Parameters = c.params(resolved.Parameters)
+	resolved.Controls = c.rebuild(resolved.Controls)
+
+	if m != nil && m.Custom != nil {
+		var err error
+		if resolved.Controls, resolved.Groups, err = structure(m.Custom, resolved.Controls); err != nil {
+			return nil, err
+		}
+	}
+	return resolved, nil
+}
+
+// mergeGroups appends groups to dst merging groups with the same id
+func mergeGroups(dst, groups []catalog.Group) []catalog.Group {
+	for _, g := range groups {
+		merged := false
+		for i := range dst {
+			if g.Id != "" && dst[i].Id == g.Id {
+				dst[i].Parameters = append(dst[i].Parameters, g.Parameters...)
+				dst[i].Controls = append(dst[i].Controls, g.Controls...)
+				dst[i].Groups = mergeGroups(dst[i].Groups, g.Groups)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			dst = append(dst, g)
+		}
+	}
+	return dst
+}
+
+// flattenGroups moves controls and parameters of groups to the catalog
+func flattenGroups(c *catalog.Catalog, groups []catalog.Group) {
+	for _, g := range groups {
+		c.Parameters = append(c.Parameters, g.Parameters...)
+		c.Controls = append(c.Controls, g.Controls...)
+		flattenGroups(c, g.Groups)
+	}
+}
+
+// combiner handles controls and parameters selected more than once
+type combiner struct {
+	method  string
+	merged  map[string]*catalog.Control
+	emitted map[string]bool
+}
+
+func newCombiner(method string) *combiner {
+	return &combiner{method: method, merged: map[string]*catalog.Control{}, emitted: map[string]bool{}}
+}
+
+// collect records the first occurrence of every control, merging the later
+// ones into it when requested
+func (c *combiner) collect(controls []catalog.Control) {
+	if c.method == combineKeep {
+		return
+	}
+	for _, ctrl := range controls {
+		if ctrl.Id == "" {
+			continue
+		}
+		if first, ok := c.merged[ctrl.Id]; ok {
+			if c.method == combineMerge {
+				mergeControl(first, ctrl)
+			}
+		} else {
+			first := ctrl
+			c.merged[ctrl.Id] = &first
+		}
+		c.collect(ctrl.Controls)
+	}
+}
+
+func (c *combiner) collectGroups(groups []catalog.Group) {
+	for _, g := range groups {
+		c.collect(g.Controls)
+		c.collectGroups(g.Groups)
+	}
+}
+
+// rebuild replaces the first occurrence of every control with the combined
+// control and drops the later ones
+func (c *combiner) rebuild(controls []catalog.Control) []catalog.Control {
+	if c.method == combineKeep {
+		return controls
+	}
+	var result []catalog.Control
+	for _, ctrl := range controls {
+		if ctrl.Id == "" {
+			result = append(result, ctrl)
+			continue
+		}
+		if c.emitted[ctrl.Id] {
+			continue
+		}
+		c.emitted[ctrl.Id] = true
+		combined := *c.merged[ctrl.Id]
+		combined.Controls = c.rebuild(combined.Controls)
+		result = append(result, combined)
+	}
+	return result
+}
+
+func (c *combiner) rebuildGroups(groups []catalog.Group) []catalog.Group {
+	var result []catalog.Group
+	for _, g := range groups {
+		g.Controls = c.rebuild(g.Controls)
+		g.Groups = c.rebuildGroups(g.Groups)
+		if len(g.Controls) > 0 || len(g.Groups) > 0 {
+			result = append(result, g)
+		}
+	}
+	return result
+}
+
+// params drops parameters with already seen ids unless all are kept
+func (c *combiner) params(params []catalog.Param) []catalog.Param {
+	if c.method == combineKeep {
+		return params
+	}
+	seen := map[string]bool{}
+	var result []catalog.Param
+	for _, param := range params {
+		if param.Id != "" && seen[param.Id] {
+			continue
+		}
+		seen[param.Id] = true
+		result = append(result, param)
+	}
+	return result
+}
+
+// mergeControl adds contents of control missing in dst
+func mergeControl(dst *catalog.Control, ctrl catalog.Control) {
+	if dst.Title == "" {
+		dst.Title = ctrl.Title
+	}
+	if dst.Class == "" {
+		dst.Class = ctrl.Class
+	}
+	for _, prop := range ctrl.Properties {
+		if !hasProp(dst.Properties, prop) {
+			dst.Properties = append(dst.Properties, prop)
+		}
+	}
+	for _, link := range ctrl.Links {
+		if !hasLink(dst.Links, link) {
+			dst.Links = append(dst.Links, link)
+		}
+	}
+	for _, param := range ctrl.Parameters {
+		if !hasParam(dst.Parameters, param.Id) {
+			dst.Parameters = append(dst.Parameters, param)
+		}
+	}
+	dst.Annotations = append(dst.Annotations, ctrl.Annotations...)
+	dst.Parts = mergeParts(dst.Parts, ctrl.Parts)
+	dst.Controls = append(dst.Controls, ctrl.Controls...)
+}
+
+func mergeParts(dst, parts []catalog.Part) []catalog.Part {
+	for _, part := range parts {
+		merged := false
+		for i := range dst {
+			if part.Id != "" && dst[i].Id == part.Id {
+				dst[i].Parts = mergeParts(dst[i].Parts, part.Parts)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			dst = append(dst, part)
+		}
+	}
+	return dst
+}
+
+func hasProp(props []catalog.Prop, prop catalog.Prop) bool {
+	for _, p := range props {
+		if p == prop {
+			return true
+		}
+	}
+	return false
+}
+
+func hasLink(links []catalog.Link, link catalog.Link) bool {
+	for _, l := range links {
+		if l == link {
+			return true
+		}
+	}
+	return false
+}
+
+func hasParam(params []catalog.Param, id string) bool {
+	for _, p := range params {
+		if p.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// structure places the controls per custom merge directive. Controls not
+// called nor matched by the directive are left out.
+func structure(custom *profile.Custom, controls []catalog.Control) ([]catalog.Control, []catalog.Group, error) {
+	s := &structurer{placed: map[string]bool{}}
+	var index func(controls []catalog.Control)
+	index = func(controls []catalog.Control) {
+		for _, ctrl := range controls {
+			s.controls = append(s.controls, ctrl)
+			index(ctrl.Controls)
+		}
+	}
+	index(controls)
+
+	root, err := s.pull(custom.IdSelectors, custom.PatternSelectors)
+	if err != nil {
+		return nil, nil, err
+	}
+	groups, err := s.groups(custom.Groups)
+	if err != nil {
+		return nil, nil, err
+	}
+	return root, groups, nil
+}
+
+type structurer struct {
+	// all controls including nested ones, in document order
+	controls []catalog.Control
+	placed   map[string]bool
+}
+
+func (s *structurer) groups(groups []profile.Group) ([]catalog.Group, error) {
+	var result []catalog.Group
+	for _, g := range groups {
+		controls, err := s.pull(g.IdSelectors, g.PatternSelectors)
+		if err != nil {
+			return nil, err
+		}
+		subgroups, err := s.groups(g.Groups)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, catalog.Group{
+			Id:         g.Id,
+			Class:      g.Class,
+			Title:      g.Title,
+			Properties: g.Properties,
+			Parameters: g.Parameters,
+			Parts:      g.Parts,
+			Groups:     subgroups,
+			Controls:   controls,
+		})
+	}
+	return result, nil
+}
+
+// pull takes the called and matched controls not placed yet
+func (s *structurer) pull(calls []profile.Call, matches []profile.Match) ([]catalog.Control, error) {
+	var result []catalog.Control
+	for _, call := range calls {
+		ctrl, ok := s.find(call.ControlId)
+		if !ok {
+			return nil, fmt.Errorf("custom merge calls control %s which is not selected", call.ControlId)
+		}
+		if !s.placed[ctrl.Id] {
+			s.place(ctrl)
+			result = append(result, ctrl)
+		}
+	}
+	for _, match := range matches {
+		pattern, err := regexp.Compile(match.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid match pattern %s: %v", match.Pattern, err)
+		}
+		var matched []catalog.Control
+		for _, ctrl := range s.controls {
+			if pattern.MatchString(ctrl.Id) && !s.placed[ctrl.Id] {
+				matched = append(matched, ctrl)
+			}
+		}
+		switch match.Order {
+		case "", orderKeep:
+		case orderAscending:
+			sort.SliceStable(matched, func(i, j int) bool { return matched[i].Id < matched[j].Id })
+		case orderDescending:
+			sort.SliceStable(matched, func(i, j int) bool { return matched[i].Id > matched[j].Id })
+		default:
+			return nil, fmt.Errorf("unknown match order %s", match.Order)
+		}
+		for _, ctrl := range matched {
+			if !s.placed[ctrl.Id] {
+				s.place(ctrl)
+				result = append(result, ctrl)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (s *structurer) find(id string) (catalog.Control, bool) {
+	for _, ctrl := range s.controls {
+		if ctrl.Id == id {
+			return ctrl, true
+		}
+	}
+	return catalog.Control{}, false
+}
+
+// place marks control and its nested controls as placed
+func (s *structurer) place(ctrl catalog.Control) {
+	s.placed[ctrl.Id] = true
+	for _, child := range ctrl.Controls {
+		s.place(child)
+	}
+}
@@ -0,0 +1,30 @@
+package resolver
+
+import (
+	"github.com/docker/oscalkit/types/oscal/catalog"
+	"github.com/docker/oscalkit/types/oscal/profile"
+)
+
+// modify applies parameter settings and alterations of the profile to the
+// resolved catalog. Settings and alterations of parameters and controls
+// which were not selected are ignored.
+func modify(c *catalog.Catalog, m *profile.Modify) error {
+	if m == nil {
+		return nil
+	}
+	for _, sp := range m.ParameterSettings {
+		if param := c.FindParam(sp.ParamId); param != nil {
+			sp.Apply(param)
+		}
+	}
+	for _, alt := range m.Alterations {
+		ctrl := c.FindControl(alt.ControlId)
+		if ctrl == nil {
+			continue
+		}
+		if err := alt.Apply(ctrl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
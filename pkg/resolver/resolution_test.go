@@ -0,0 +1,61 @@
+package resolver
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/types/oscal"
+)
+
+// TestResolution resolves the profiles of testdata/resolution, laid out as
+// the profile resolution examples of the OSCAL repository, and compares the
+// resolved catalogs with output-expected/<name>_RESOLVED.xml. The expected
+// catalogs are written by hand, see testdata/resolution/README.md, and are
+// compared in canonical form.
+func TestResolution(t *testing.T) {
+	profiles, err := filepath.Glob(filepath.Join("testdata", "resolution", "*_profile.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profiles) == 0 {
+		t.Fatal("no resolution tests found")
+	}
+	for _, path := range profiles {
+		name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		t.Run(name, func(t *testing.T) {
+			r := New()
+			r.Now = func() time.Time { return time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC) }
+			c, err := r.ResolveHref(filepath.ToSlash(path))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var actual bytes.Buffer
+			if err := (&oscal.OSCAL{Catalog: c}).Canonical(&actual, constants.XmlFormat); err != nil {
+				t.Fatal(err)
+			}
+
+			expectedPath := filepath.Join("testdata", "resolution", "output-expected", name+"_RESOLVED.xml")
+			f, err := os.Open(expectedPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			o, err := oscal.New(f)
+			if err != nil {
+				t.Fatalf("cannot decode %s: %v", expectedPath, err)
+			}
+			var expected bytes.Buffer
+			if err := o.Canonical(&expected, constants.XmlFormat); err != nil {
+				t.Fatal(err)
+			}
+			if actual.String() != expected.String() {
+				t.Errorf("%s resolves differently from %s:\n%s\nexpected:\n%s", path, expectedPath, actual.String(), expected.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+// Package resolver implements OSCAL profile resolution. A profile is resolved
+// into a single catalog in three steps: import selects controls from the
+// imported catalogs and profiles, merge combines and structures them and
+// modify applies parameter settings and alterations.
+package resolver
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/docker/oscalkit/pkg/fetch"
+	"github.com/docker/oscalkit/types/oscal"
+	"github.com/docker/oscalkit/types/oscal/catalog"
+	"github.com/docker/oscalkit/types/oscal/profile"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+const (
+	// ToolName is recorded in the metadata of resolved catalogs
+	ToolName = "oscalkit"
+
+	// link relations recording provenance of resolved catalog
+	relSourceProfile = "source-profile"
+	relImported      = "imported"
+)
+
+// Opener opens OSCAL document referenced by href
+type Opener func(href string) (io.ReadCloser, error)
+
+// Resolver resolves OSCAL profiles into catalogs
+type Resolver struct {
+	// Open is used to load imported documents. Hrefs are already resolved
+	// against the location of the importing profile.
+	Open Opener
+	// Now returns the time recorded as the resolution time
+	Now func() time.Time
+	// MaxDepth limits the length of import chains, zero stands for
+	// DefaultMaxDepth
+	MaxDepth int
+}
+
+// New creates Resolver loading local files and http(s) resources
+func New() *Resolver {
+	return &Resolver{Open: OpenHref, Now: time.Now, MaxDepth: DefaultMaxDepth}
+}
+
+// ResolveFile resolves profile stored at the given path or URL
+func ResolveFile(href string) (*catalog.Catalog, error) {
+	return New().ResolveHref(href)
+}
+
+// ResolveHref loads profile from href and resolves it
+func (r *Resolver) ResolveHref(href string) (*catalog.Catalog, error) {
+	o, err := r.load(href)
+	if err != nil {
+		return nil, err
+	}
+	if o.Profile == nil {
+		return nil, fmt.Errorf("%s is not an OSCAL profile", href)
+	}
+	return r.Resolve(o.Profile, href)
+}
+
+// Resolve resolves profile into catalog. The href is location of the
+// profile, imports are resolved relative to it.
+func (r *Resolver) Resolve(p *profile.Profile, href string) (*catalog.Catalog, error) {
+	return r.resolve(p, href, nil)
+}
+
+func (r *Resolver) resolve(p *profile.Profile, href string, chain []string) (*catalog.Catalog, error) {
+	chain, err := CheckImport(chain, href, r.MaxDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	var selections []*selection
+	for _, i := range p.Imports {
+		s, err := r.importSelection(p, i, href, chain)
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, s)
+	}
+
+	resolved, err := merge(p.Merge, selections)
+	if err != nil {
+		return nil, fmt.Errorf("cannot merge profile %s: %v", href, err)
+	}
+
+	if err := modify(resolved, p.Modify); err != nil {
+		return nil, fmt.Errorf("cannot modify profile %s: %v", href, err)
+	}
+
+	resolved.Id = p.Id
+	resolved.Metadata = r.provenance(p, href, selections)
+	resolved.BackMatter = backMatter(p, selections)
+	return resolved, nil
+}
+
+// importSelection loads the imported resource and selects its controls
+func (r *Resolver) importSelection(p *profile.Profile, i profile.Import, base string, chain []string) (*selection, error) {
+	if err := i.ValidateHref(); err != nil {
+		return nil, fmt.Errorf("invalid import in %s: %v", base, err)
+	}
+	href, err := importHref(p, i.Href, base)
+	if err != nil {
+		return nil, err
+	}
+	include, exclude, err := selectors(i)
+	if err != nil {
+		return nil, fmt.Errorf("cannot select controls from %s: %v", href, err)
+	}
+	source, o, err := r.loadImport(href, include.included)
+	if err != nil {
+		return nil, chainError(err, append(chain[:len(chain):len(chain)], href))
+	}
+
+	switch {
+	case source != nil:
+	case o.Catalog != nil:
+		source = o.Catalog
+	case o.Profile != nil:
+		if source, err = r.resolve(o.Profile, href, chain); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, chainError(fmt.Errorf("%s is neither catalog nor profile", href), append(chain[:len(chain):len(chain)], href))
+	}
+
+	s := selectControls(source, include, exclude)
+	s.href = href
+	return s, nil
+}
+
+// loadImport loads the imported resource. XML and JSON catalogs are streamed
+// keeping only the controls accepted by keep and returned as catalog, other
+// resources are loaded whole.
+func (r *Resolver) loadImport(href string, keep func(c *catalog.Control) bool) (*catalog.Catalog, *oscal.OSCAL, error) {
+	rc, err := r.Open(href)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot open %s: %v", href, err)
+	}
+	defer rc.Close()
+	br := bufio.NewReader(rc)
+	if isCatalog(br) {
+		c, err := oscal.FilterCatalog(br, keep)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot parse %s: %v", href, err)
+		}
+		return c, nil, nil
+	}
+	o, err := oscal.New(br)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot parse %s: %v", href, err)
+	}
+	return nil, o, nil
+}
+
+// isCatalog peeks at the buffered beginning of the document and reports
+// whether it is XML or JSON catalog. Documents whose root is not found in the
+// buffer are not recognized.
+func isCatalog(br *bufio.Reader) bool {
+	head, _ := br.Peek(br.Size())
+	d := xml.NewDecoder(bytes.NewReader(head))
+	for {
+		token, err := d.Token()
+		if err != nil {
+			break
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name.Local == "catalog"
+		}
+	}
+	j := json.NewDecoder(bytes.NewReader(head))
+	if token, err := j.Token(); err != nil || token != json.Delim('{') {
+		return false
+	}
+	key, err := j.Token()
+	return err == nil && key == "catalog"
+}
+
+func (r *Resolver) load(href string) (*oscal.OSCAL, error) {
+	rc, err := r.Open(href)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open %s: %v", href, err)
+	}
+	defer rc.Close()
+	o, err := oscal.New(rc)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %v", href, err)
+	}
+	return o, nil
+}
+
+// provenance creates metadata of the resolved catalog from the profile metadata
+func (r *Resolver) provenance(p *profile.Profile, href string, selections []*selection) *catalog.Metadata {
+	metadata := catalog.Metadata{}
+	if p.Metadata != nil {
+		metadata = *p.Metadata
+	}
+	now := time.Now
+	if r.Now != nil {
+		now = r.Now
+	}
+	metadata.LastModified = validation_root.LastModified(now().Format(time.RFC3339))
+	metadata.Properties = append(metadata.Properties[:len(metadata.Properties):len(metadata.Properties)],
+		catalog.Prop{Name: "resolution-tool", Value: ToolName},
+	)
+	metadata.Links = append(metadata.Links[:len(metadata.Links):len(metadata.Links)],
+		catalog.Link{Href: href, Rel: relSourceProfile, Value: p.Id},
+	)
+	for _, s := range selections {
+		metadata.Links = append(metadata.Links, catalog.Link{Href: s.href, Rel: relImported})
+	}
+	return &metadata
+}
+
+// backMatter collects back matter resources of the profile and imported documents
+func backMatter(p *profile.Profile, selections []*selection) *catalog.BackMatter {
+	seen := map[string]bool{}
+	var resources []validation_root.Resource
+	add := func(bm *catalog.BackMatter) {
+		if bm == nil {
+			return
+		}
+		for _, resource := range bm.Resources {
+			if resource.Id != "" && seen[resource.Id] {
+				continue
+			}
+			seen[resource.Id] = true
+			resources = append(resources, resource)
+		}
+	}
+	add(p.BackMatter)
+	for _, s := range selections {
+		add(s.backMatter)
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+	return &catalog.BackMatter{Resources: resources}
+}
+
+// importHref resolves href of an import against location of the importing
+// profile. Fragment hrefs point to back matter resources of the profile.
+func importHref(p *profile.Profile, href, base string) (string, error) {
+	if strings.HasPrefix(href, "#") {
+		id := strings.TrimPrefix(href, "#")
+		if p.BackMatter != nil {
+			for _, resource := range p.BackMatter.Resources {
+				if resource.Id == id && len(resource.Rlinks) > 0 {
+					return importHref(p, resource.Rlinks[0].Href, base)
+				}
+			}
+		}
+		return "", fmt.Errorf("import %s in %s does not refer to back matter resource with rlink", href, base)
+	}
+
+	ref, err := url.Parse(href)
+	if err != nil {
+		return "", fmt.Errorf("invalid import href %s in %s: %v", href, base, err)
+	}
+	if ref.IsAbs() || filepath.IsAbs(href) {
+		return href, nil
+	}
+	baseURL, err := url.Parse(base)
+	if err == nil && isHTTP(baseURL) {
+		return baseURL.ResolveReference(ref).String(), nil
+	}
+	return filepath.Join(filepath.Dir(base), filepath.FromSlash(ref.Path)), nil
+}
+
+// OpenHref opens local file or http(s) resource with the default fetcher
+func OpenHref(href string) (io.ReadCloser, error) {
+	return fetch.Default.Fetch(href)
+}
+
+func isHTTP(u *url.URL) bool {
+	return u.Scheme == "http" || u.Scheme == "https"
+}
@@ -0,0 +1,309 @@
+package resolver
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/oscalkit/pkg/fetch"
+	"github.com/docker/oscalkit/types/oscal/catalog"
+)
+
+func testResolve(t *testing.T, name string) *catalog.Catalog {
+	r := New()
+	r.Now = func() time.Time { return time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC) }
+	c, err := r.ResolveHref(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("cannot resolve %s: %v", name, err)
+	}
+	return c
+}
+
+// outline prints structure of the catalog one item per line
+func outline(c *catalog.Catalog) string {
+	var lines []string
+	var controls func(ctrls []catalog.Control, indent string)
+	controls = func(ctrls []catalog.Control, indent string) {
+		for _, ctrl := range ctrls {
+			lines = append(lines, indent+"control "+ctrl.Id)
+			controls(ctrl.Controls, indent+"  ")
+		}
+	}
+	var groups func(gs []catalog.Group, indent string)
+	groups = func(gs []catalog.Group, indent string) {
+		for _, g := range gs {
+			lines = append(lines, indent+"group "+g.Id)
+			controls(g.Controls, indent+"  ")
+			groups(g.Groups, indent+"  ")
+		}
+	}
+	controls(c.Controls, "")
+	groups(c.Groups, "")
+	return strings.Join(lines, "\n")
+}
+
+func TestResolveStructure(t *testing.T) {
+	cases := []struct {
+		profile  string
+		expected []string
+	}{
+		{"include-all.xml", []string{
+			"control a1", "  control a1.1", "  control a1.2", "control a2", "control b1", "control b2", "control b3",
+		}},
+		{"include-all-parents.xml", []string{
+			"control a1", "control a2", "control b1", "control b2", "control b3",
+		}},
+		{"include-all-as-is.xml", []string{
+			"group a", "  control a1", "    control a1.1", "    control a1.2", "  control a2",
+			"group b", "  control b1", "  control b2", "  group bb", "    control b3",
+		}},
+		{"include-call.xml", []string{
+			"control a1", "  control a1.2", "control b1",
+		}},
+		{"include-call-children.xml", []string{
+			"control a1", "  control a1.1", "  control a1.2",
+		}},
+		{"include-match.xml", []string{
+			"group b", "  control b1", "  control b2", "  group bb", "    control b3",
+		}},
+		{"exclude.xml", []string{
+			"control a1.1", "control a1.2", "control a2", "control b3",
+		}},
+		{"exclude-children.xml", []string{
+			"control a2", "control b3",
+		}},
+		{"merge-custom.xml", []string{
+			"control a2",
+			"group x", "  control b3", "  control b2", "  control b1", "  group y", "    control a1",
+			"      control a1.1", "      control a1.2",
+		}},
+		{"combine-use-first.xml", []string{
+			"control a1", "control a2",
+		}},
+		{"combine-keep.xml", []string{
+			"control a1", "control a1", "control a2",
+		}},
+		{"nested.xml", []string{
+			"control a1", "  control a1.1", "  control a1.2",
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.profile, func(t *testing.T) {
+			got := outline(testResolve(t, tc.profile))
+			if expected := strings.Join(tc.expected, "\n"); got != expected {
+				t.Errorf("unexpected structure\nexpected:\n%s\ngot:\n%s", expected, got)
+			}
+		})
+	}
+}
+
+func TestResolveParameters(t *testing.T) {
+	flat := testResolve(t, "include-all.xml")
+	var ids []string
+	for _, param := range flat.Parameters {
+		ids = append(ids, param.Id)
+	}
+	if got := strings.Join(ids, ","); got != "cat_prm,a_prm" {
+		t.Errorf("expected catalog and group parameters in flat catalog, got %s", got)
+	}
+
+	asIs := testResolve(t, "include-all-as-is.xml")
+	if len(asIs.Parameters) != 1 || len(asIs.Groups[0].Parameters) != 1 {
+		t.Errorf("expected parameters to stay in place in as-is catalog")
+	}
+}
+
+func TestResolveCombine(t *testing.T) {
+	props := func(c *catalog.Catalog) int {
+		return len(c.FindControl("a1").Properties)
+	}
+	if n := props(testResolve(t, "combine-use-first.xml")); n != 0 {
+		t.Errorf("use-first: expected first a1 without properties, got %d", n)
+	}
+	merged := testResolve(t, "combine-merge.xml")
+	if n := props(merged); n != 1 {
+		t.Errorf("merge: expected a1 with property of the second import, got %d", n)
+	}
+	if outline(merged) != "control a1\ncontrol a2" {
+		t.Errorf("merge: unexpected structure %s", outline(merged))
+	}
+}
+
+func TestResolveModify(t *testing.T) {
+	c := testResolve(t, "modify.xml")
+	if param := c.FindParam("a1_prm"); param.Value != "monthly" || param.Label != "control parameter" {
+		t.Errorf("set-parameter: unexpected a1_prm %+v", param)
+	}
+	if param := c.FindParam("a_prm"); param.Label != "overridden label" {
+		t.Errorf("set-parameter: unexpected a_prm %+v", param)
+	}
+	a1 := c.FindControl("a1")
+	if len(a1.Properties) != 1 || a1.Properties[0].Value != "tailored" {
+		t.Errorf("alter: expected added property, got %v", a1.Properties)
+	}
+	if len(a1.Parts) != 2 || a1.Parts[1].Id != "a1_odp" {
+		t.Errorf("alter: expected part added at the end, got %v", a1.Parts)
+	}
+	if statement := a1.Parts[0].Parts; len(statement) != 2 || statement[0].Id != "a1_smt.0" {
+		t.Errorf("alter: expected item added before a1_smt.a, got %v", statement)
+	}
+	if b1 := c.FindControl("b1"); len(b1.Parts) != 0 {
+		t.Errorf("alter: expected guidance removed, got %v", b1.Parts)
+	}
+}
+
+func TestResolveProvenance(t *testing.T) {
+	c := testResolve(t, "nested.xml")
+	if c.Id != "nested" {
+		t.Errorf("expected resolved catalog to take profile id, got %s", c.Id)
+	}
+	if c.Metadata.Title != "nested profile" {
+		t.Errorf("expected profile title, got %s", c.Metadata.Title)
+	}
+	if c.Metadata.LastModified != "2019-10-01T12:00:00Z" {
+		t.Errorf("expected resolution time, got %s", c.Metadata.LastModified)
+	}
+	var links []string
+	for _, link := range c.Metadata.Links {
+		links = append(links, fmt.Sprintf("%s %s", link.Rel, filepath.ToSlash(link.Href)))
+	}
+	expected := "source-profile testdata/nested.xml,imported testdata/modify.xml"
+	if got := strings.Join(links, ","); got != expected {
+		t.Errorf("expected links %s, got %s", expected, got)
+	}
+	if c.BackMatter == nil || len(c.BackMatter.Resources) != 2 {
+		t.Errorf("expected back matter of the profile and the catalog, got %+v", c.BackMatter)
+	}
+}
+
+func TestResolveCycle(t *testing.T) {
+	_, err := ResolveFile(filepath.Join("testdata", "cycle-a.xml"))
+	if err == nil {
+		t.Fatal("expected import cycle error")
+	}
+	expected := "profile import cycle: testdata/cycle-a.xml -> testdata/cycle-b.xml -> testdata/cycle-a.xml"
+	if !strings.Contains(filepath.ToSlash(err.Error()), expected) {
+		t.Errorf("expected %q, got %q", expected, err)
+	}
+}
+
+func TestResolveMaxDepth(t *testing.T) {
+	r := New()
+	r.MaxDepth = 1
+	_, err := r.ResolveHref(filepath.Join("testdata", "nested.xml"))
+	depthErr, ok := err.(*ImportDepthError)
+	if !ok {
+		t.Fatalf("expected import depth error, got %v", err)
+	}
+	expected := "testdata/nested.xml -> testdata/modify.xml"
+	if got := filepath.ToSlash(strings.Join(depthErr.Chain, " -> ")); got != expected {
+		t.Errorf("expected chain %s, got %s", expected, got)
+	}
+}
+
+func TestResolveMissingImport(t *testing.T) {
+	r := New()
+	r.Open = func(href string) (io.ReadCloser, error) {
+		if strings.HasSuffix(filepath.ToSlash(href), "testdata/catalog.xml") {
+			return nil, os.ErrNotExist
+		}
+		return OpenHref(href)
+	}
+	_, err := r.ResolveHref(filepath.Join("testdata", "nested.xml"))
+	if err == nil {
+		t.Fatal("expected error for missing catalog")
+	}
+	expected := "import chain: testdata/nested.xml -> testdata/modify.xml -> testdata/catalog.xml"
+	if !strings.Contains(filepath.ToSlash(err.Error()), expected) {
+		t.Errorf("expected %q, got %q", expected, err)
+	}
+}
+
+func TestResolveRemote(t *testing.T) {
+	read := func(name string) string {
+		content, err := ioutil.ReadFile(filepath.Join("testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(content)
+	}
+	fake := fetch.NewFake(map[string]string{
+		"https://example.com/profiles/modify.xml":  read("modify.xml"),
+		"https://example.com/profiles/catalog.xml": read("catalog.xml"),
+	})
+	r := New()
+	r.Open = fake.Fetch
+	c, err := r.ResolveHref("https://example.com/profiles/modify.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.FindControl("a1") == nil {
+		t.Error("expected a1 imported from remote catalog")
+	}
+	expected := "https://example.com/profiles/modify.xml,https://example.com/profiles/catalog.xml"
+	if got := strings.Join(fake.Requests(), ","); got != expected {
+		t.Errorf("expected requests %s, got %s", expected, got)
+	}
+}
+
+func TestSelectStreamed(t *testing.T) {
+	profiles, err := filepath.Glob(filepath.Join("testdata", "*.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := New()
+	filtered := false
+	for _, href := range profiles {
+		o, err := r.load(href)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if o.Profile == nil {
+			continue
+		}
+		for _, i := range o.Profile.Imports {
+			importHref, err := importHref(o.Profile, i.Href, href)
+			if err != nil {
+				t.Fatal(err)
+			}
+			whole, err := r.load(importHref)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if whole.Catalog == nil {
+				continue
+			}
+			include, exclude, err := selectors(i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			streamed, _, err := r.loadImport(importHref, include.included)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if streamed == nil {
+				t.Fatalf("%s: catalog %s should be streamed", href, importHref)
+			}
+			if len(outline(streamed)) < len(outline(whole.Catalog)) {
+				filtered = true
+			}
+			// controls are selected the same from the streamed catalog
+			// as from the whole one
+			expected := selectControls(whole.Catalog, include, exclude)
+			got := selectControls(streamed, include, exclude)
+			expectedOutline := outline(&catalog.Catalog{Controls: expected.controls, Groups: expected.groups})
+			gotOutline := outline(&catalog.Catalog{Controls: got.controls, Groups: got.groups})
+			if gotOutline != expectedOutline {
+				t.Errorf("%s: expected selection\n%s\ngot\n%s", href, expectedOutline, gotOutline)
+			}
+		}
+	}
+	if !filtered {
+		t.Error("controls not selected should be dropped while streaming")
+	}
+}
@@ -0,0 +1,174 @@
+package resolver
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/docker/oscalkit/types/oscal/catalog"
+	"github.com/docker/oscalkit/types/oscal/profile"
+)
+
+const withChildControls = "yes"
+
+// selection holds controls selected from single import, structured as in the
+// source catalog
+type selection struct {
+	href       string
+	parameters []catalog.Param
+	controls   []catalog.Control
+	groups     []catalog.Group
+	backMatter *catalog.BackMatter
+}
+
+// selector matches control ids
+type selector struct {
+	ids      map[string]bool
+	children map[string]bool
+	patterns []*regexp.Regexp
+	// patterns selecting child controls as well
+	childPatterns []*regexp.Regexp
+	// all selects the controls that are not child controls, allChildren
+	// selects their child controls as well
+	all         bool
+	allChildren bool
+}
+
+func newSelector(calls []profile.Call, matches []profile.Match) (*selector, error) {
+	s := &selector{ids: map[string]bool{}, children: map[string]bool{}}
+	for _, call := range calls {
+		s.ids[call.ControlId] = true
+		if call.WithChildControls == withChildControls {
+			s.children[call.ControlId] = true
+		}
+	}
+	for _, match := range matches {
+		pattern, err := regexp.Compile(match.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid match pattern %s: %v", match.Pattern, err)
+		}
+		s.patterns = append(s.patterns, pattern)
+		if match.WithChildControls == withChildControls {
+			s.childPatterns = append(s.childPatterns, pattern)
+		}
+	}
+	return s, nil
+}
+
+// matches reports whether control is selected and whether its child
+// controls are selected as well. Nested tells child controls.
+func (s *selector) matches(id string, nested bool) (selected, children bool) {
+	if s.all {
+		return !nested, s.allChildren
+	}
+	if s.ids[id] {
+		selected = true
+		children = s.children[id]
+	}
+	for _, pattern := range s.patterns {
+		if pattern.MatchString(id) {
+			selected = true
+		}
+	}
+	for _, pattern := range s.childPatterns {
+		if pattern.MatchString(id) {
+			children = true
+		}
+	}
+	return selected, children
+}
+
+// selectors returns selectors of the import include and exclude directives
+func selectors(i profile.Import) (include, exclude *selector, err error) {
+	include = &selector{all: true, allChildren: true}
+	if i.Include != nil {
+		if i.Include.All != nil {
+			include.allChildren = i.Include.All.WithChildControls == withChildControls
+		} else if include, err = newSelector(i.Include.IdSelectors, i.Include.PatternSelectors); err != nil {
+			return nil, nil, err
+		}
+	}
+	exclude = &selector{}
+	if i.Exclude != nil {
+		if exclude, err = newSelector(i.Exclude.IdSelectors, i.Exclude.PatternSelectors); err != nil {
+			return nil, nil, err
+		}
+	}
+	return include, exclude, nil
+}
+
+// included reports whether the selector selects the control or any of its
+// descendants. Selection of a control depends on its ancestors only, so
+// controls not included can be dropped before the selection.
+func (s *selector) included(c *catalog.Control) bool {
+	return len(mark(&catalog.Catalog{Controls: []catalog.Control{*c}}, s)) > 0
+}
+
+// selectControls selects controls of the source catalog per import include and exclude selectors
+func selectControls(source *catalog.Catalog, include, exclude *selector) *selection {
+	selected := mark(source, include)
+	for id := range mark(source, exclude) {
+		delete(selected, id)
+	}
+
+	return &selection{
+		parameters: source.Parameters,
+		controls:   pruneControls(source.Controls, selected),
+		groups:     pruneGroups(source.Groups, selected),
+		backMatter: source.BackMatter,
+	}
+}
+
+// mark returns ids of the controls of the catalog matched by the selector,
+// with their descendants when the selector selects child controls as well
+func mark(source *catalog.Catalog, s *selector) map[string]bool {
+	marked := map[string]bool{}
+	var controls func(ctrls []catalog.Control, nested, inherited bool)
+	controls = func(ctrls []catalog.Control, nested, inherited bool) {
+		for _, ctrl := range ctrls {
+			isSelected, children := s.matches(ctrl.Id, nested)
+			if isSelected || inherited {
+				marked[ctrl.Id] = true
+			}
+			controls(ctrl.Controls, true, inherited || (isSelected && children))
+		}
+	}
+	var groups func(gs []catalog.Group)
+	groups = func(gs []catalog.Group) {
+		for _, g := range gs {
+			controls(g.Controls, false, false)
+			groups(g.Groups)
+		}
+	}
+	controls(source.Controls, false, false)
+	groups(source.Groups)
+	return marked
+}
+
+// pruneControls keeps selected controls only. Selected child controls of
+// unselected controls take place of their parent.
+func pruneControls(controls []catalog.Control, selected map[string]bool) []catalog.Control {
+	var result []catalog.Control
+	for _, ctrl := range controls {
+		children := pruneControls(ctrl.Controls, selected)
+		if !selected[ctrl.Id] {
+			result = append(result, children...)
+			continue
+		}
+		ctrl.Controls = children
+		result = append(result, ctrl)
+	}
+	return result
+}
+
+// pruneGroups keeps groups containing selected controls
+func pruneGroups(groups []catalog.Group, selected map[string]bool) []catalog.Group {
+	var result []catalog.Group
+	for _, g := range groups {
+		g.Controls = pruneControls(g.Controls, selected)
+		g.Groups = pruneGroups(g.Groups, selected)
+		if len(g.Controls) > 0 || len(g.Groups) > 0 {
+			result = append(result, g)
+		}
+	}
+	return result
+}
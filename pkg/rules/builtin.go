@@ -0,0 +1,299 @@
+package rules
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/validation"
+	"github.com/docker/oscalkit/types/oscal/profile"
+	ssp "github.com/docker/oscalkit/types/oscal/system_security_plan"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+// Ids of the built-in rules
+const (
+	RuleUniqueID               = "oscal/unique-id"
+	RulePartyReference         = "oscal/party-reference"
+	RuleRoleReference          = "oscal/role-reference"
+	RuleLocationReference      = "oscal/location-reference"
+	RuleInternalReference      = "oscal/internal-reference"
+	RuleUnusedCitation         = "oscal/unused-citation"
+	RuleImport                 = "oscal/import"
+	RuleSetParameter           = "oscal/set-parameter"
+	RuleAlterControl           = "oscal/alter-control"
+	RuleImplementedRequirement = "oscal/implemented-requirement"
+	RuleComponentReference     = "oscal/component-reference"
+)
+
+// Builtin returns the built-in OSCAL ruleset
+func Builtin() []Rule {
+	return []Rule{
+		{
+			ID:          RuleUniqueID,
+			Description: "ids are unique within the document",
+			Severity:    validation.SeverityError,
+			Check:       checkUniqueIDs,
+		},
+		{
+			ID:          RulePartyReference,
+			Description: "party-id references party defined in metadata",
+			Severity:    validation.SeverityError,
+			Check:       checkMetadataReferences("party-id", "party", func(m *validation_root.Metadata) []string { return partyIDs(m.Parties) }),
+		},
+		{
+			ID:          RuleRoleReference,
+			Description: "role-id references role defined in metadata",
+			Severity:    validation.SeverityError,
+			Check:       checkMetadataReferences("role-id", "role", func(m *validation_root.Metadata) []string { return roleIDs(m.Roles) }),
+		},
+		{
+			ID:          RuleLocationReference,
+			Description: "location-id references location defined in metadata",
+			Severity:    validation.SeverityError,
+			Check:       checkMetadataReferences("location-id", "location", func(m *validation_root.Metadata) []string { return locationIDs(m.Locations) }),
+		},
+		{
+			ID:          RuleInternalReference,
+			Description: "fragment hrefs such as #ref-1 reference element of the document",
+			Severity:    validation.SeverityError,
+			Check:       checkInternalReferences,
+		},
+		{
+			ID:          RuleUnusedCitation,
+			Description: "citations of back-matter are referenced from the document",
+			Severity:    validation.SeverityWarning,
+			Check:       checkUnusedCitations,
+		},
+		{
+			ID:          RuleImport,
+			Description: "imported catalogs and profiles can be resolved",
+			Severity:    validation.SeverityError,
+			Check:       checkImports,
+		},
+		{
+			ID:          RuleSetParameter,
+			Description: "set-parameter references parameter of the imported controls",
+			Severity:    validation.SeverityError,
+			Check:       checkSetParameters,
+		},
+		{
+			ID:          RuleAlterControl,
+			Description: "alter references imported control",
+			Severity:    validation.SeverityError,
+			Check:       checkAlterations,
+		},
+		{
+			ID:          RuleImplementedRequirement,
+			Description: "implemented-requirement references control of the imported profile",
+			Severity:    validation.SeverityError,
+			Check:       checkImplementedRequirements,
+		},
+		{
+			ID:          RuleComponentReference,
+			Description: "component-id references component of the system implementation",
+			Severity:    validation.SeverityError,
+			Check:       checkComponentReferences,
+		},
+	}
+}
+
+func checkUniqueIDs(c *Context) {
+	seen := map[string]Location{}
+	Walk(c.Document.OSCAL, func(n *Node) {
+		id := n.Attr("id")
+		if id == "" {
+			return
+		}
+		if first, ok := seen[id]; ok {
+			c.Report(n.Location, "Duplicate id '%s', already used by %s", id, c.Document.Path(first))
+			return
+		}
+		seen[id] = n.Location
+	})
+}
+
+// checkMetadataReferences checks that the attributes and child elements
+// named name reference ids defined in metadata
+func checkMetadataReferences(name, kind string, defined func(m *validation_root.Metadata) []string) func(c *Context) {
+	return func(c *Context) {
+		ids := map[string]bool{}
+		if m := c.Document.OSCAL.Metadata(); m != nil {
+			for _, id := range defined(m) {
+				ids[id] = true
+			}
+		}
+		Walk(c.Document.OSCAL, func(n *Node) {
+			if id := n.Attr(name); id != "" && !ids[id] {
+				c.Report(n.Location, "%s '%s' is not defined in metadata", strings.Title(kind), id)
+			}
+			values, locations := n.Elements(name)
+			for i, id := range values {
+				if !ids[id] {
+					c.Report(locations[i], "%s '%s' is not defined in metadata", strings.Title(kind), id)
+				}
+			}
+		})
+	}
+}
+
+func partyIDs(parties []validation_root.Party) []string {
+	var ids []string
+	for _, p := range parties {
+		ids = append(ids, p.Id)
+	}
+	return ids
+}
+
+func roleIDs(roles []validation_root.Role) []string {
+	var ids []string
+	for _, r := range roles {
+		ids = append(ids, r.Id)
+	}
+	return ids
+}
+
+func locationIDs(locations []validation_root.Location) []string {
+	var ids []string
+	for _, l := range locations {
+		ids = append(ids, l.Id)
+	}
+	return ids
+}
+
+// reference is a fragment href within the document
+type reference struct {
+	id       string
+	location Location
+}
+
+var markupHref = regexp.MustCompile(`href="#([^"]*)"`)
+
+// internalReferences returns fragment hrefs of the document, including
+// hrefs of the links embedded in prose
+func internalReferences(c *Context) []reference {
+	var refs []reference
+	Walk(c.Document.OSCAL, func(n *Node) {
+		if href := n.Attr("href"); strings.HasPrefix(href, "#") {
+			refs = append(refs, reference{strings.TrimPrefix(href, "#"), n.Location})
+		}
+		for _, raw := range markup(n) {
+			for _, m := range markupHref.FindAllStringSubmatch(raw, -1) {
+				refs = append(refs, reference{m[1], n.Location})
+			}
+		}
+	})
+	return refs
+}
+
+// markup returns raw prose of the markup fields of the node
+func markup(n *Node) []string {
+	var raw []string
+	v := reflect.ValueOf(n.Value).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		switch m := v.Field(i).Interface().(type) {
+		case *validation_root.Markup:
+			if m != nil {
+				raw = append(raw, m.Raw)
+			}
+		case validation_root.Markup:
+			raw = append(raw, m.Raw)
+		}
+	}
+	return raw
+}
+
+func checkInternalReferences(c *Context) {
+	ids := map[string]bool{}
+	Walk(c.Document.OSCAL, func(n *Node) {
+		if id := n.Attr("id"); id != "" {
+			ids[id] = true
+		}
+	})
+	for _, ref := range internalReferences(c) {
+		if !ids[ref.id] {
+			c.Report(ref.location, "Reference '#%s' does not match id of any element", ref.id)
+		}
+	}
+}
+
+func checkUnusedCitations(c *Context) {
+	referenced := map[string]bool{}
+	for _, ref := range internalReferences(c) {
+		referenced[ref.id] = true
+	}
+	Walk(c.Document.OSCAL, func(n *Node) {
+		if r, ok := n.Value.(*validation_root.Resource); ok && r.Citation != nil && !referenced[r.Id] {
+			c.Report(n.Location, "Citation '%s' is never referenced", r.Id)
+		}
+	})
+}
+
+func checkImports(c *Context) {
+	if _, err := c.Document.Imported(); err != nil {
+		c.Report(rootNode(c.Document.OSCAL).Location, "Cannot resolve imports: %v", err)
+	}
+}
+
+func checkSetParameters(c *Context) {
+	imported, err := c.Document.Imported()
+	if err != nil || imported == nil {
+		return
+	}
+	Walk(c.Document.OSCAL, func(n *Node) {
+		var id string
+		switch sp := n.Value.(type) {
+		case *profile.SetParameter:
+			id = sp.ParamId
+		case *ssp.SetParameter:
+			id = sp.ParamId
+		default:
+			return
+		}
+		if imported.FindParam(id) == nil {
+			c.Report(n.Location, "Parameter '%s' is not defined by the imported controls", id)
+		}
+	})
+}
+
+func checkAlterations(c *Context) {
+	imported, err := c.Document.Imported()
+	if err != nil || imported == nil {
+		return
+	}
+	Walk(c.Document.OSCAL, func(n *Node) {
+		if alt, ok := n.Value.(*profile.Alter); ok && imported.FindControl(alt.ControlId) == nil {
+			c.Report(n.Location, "Control '%s' is not imported", alt.ControlId)
+		}
+	})
+}
+
+func checkImplementedRequirements(c *Context) {
+	imported, err := c.Document.Imported()
+	if err != nil || imported == nil {
+		return
+	}
+	Walk(c.Document.OSCAL, func(n *Node) {
+		if req, ok := n.Value.(*ssp.ImplementedRequirement); ok && imported.FindControl(req.ControlId) == nil {
+			c.Report(n.Location, "Control '%s' is not defined by the imported profile", req.ControlId)
+		}
+	})
+}
+
+func checkComponentReferences(c *Context) {
+	plan := c.Document.OSCAL.SystemSecurityPlan
+	if plan == nil {
+		return
+	}
+	components := map[string]bool{}
+	if plan.SystemImplementation != nil {
+		for _, component := range plan.SystemImplementation.Components {
+			components[component.Id] = true
+		}
+	}
+	Walk(c.Document.OSCAL, func(n *Node) {
+		if id := n.Attr("component-id"); id != "" && !components[id] {
+			c.Report(n.Location, "Component '%s' is not defined in system implementation", id)
+		}
+	})
+}
@@ -0,0 +1,202 @@
+// Package rules implements semantic validation of OSCAL documents. Rules
+// check the constraints that cannot be expressed by the XML and JSON
+// schemas, such as references between elements of the document and to the
+// imported catalogs and profiles.
+//
+// The built-in ruleset is registered with the Default engine. Custom rules
+// are Go functions registered with Register:
+//
+//	rules.Register(rules.Rule{
+//		ID:       "example/control-title",
+//		Severity: validation.SeverityWarning,
+//		Check: func(c *rules.Context) {
+//			rules.Walk(c.Document.OSCAL, func(n *rules.Node) {
+//				if ctrl, ok := n.Value.(*catalog.Control); ok && ctrl.Title == "" {
+//					c.Report(n.Location, "control %s has no title", ctrl.Id)
+//				}
+//			})
+//		},
+//	})
+package rules
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+
+	"github.com/docker/oscalkit/pkg/fetch"
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/resolver"
+	"github.com/docker/oscalkit/pkg/validation"
+	"github.com/docker/oscalkit/types/oscal"
+	"github.com/docker/oscalkit/types/oscal/catalog"
+)
+
+// Rule is a semantic check of OSCAL documents
+type Rule struct {
+	// ID identifies the rule in the findings
+	ID          string
+	Description string
+	// Severity of the findings reported by the rule
+	Severity validation.Severity
+	// Check reports the findings through the context
+	Check func(c *Context)
+}
+
+// Document is OSCAL document checked by the rules
+type Document struct {
+	// Href is location of the document. Imports are resolved relative to it.
+	Href   string
+	Format constants.DocumentFormat
+	OSCAL  *oscal.OSCAL
+	// Resolver resolves imported profiles, nil stands for resolver.New()
+	Resolver *resolver.Resolver
+
+	imported    *catalog.Catalog
+	importErr   error
+	importsDone bool
+}
+
+// Imported returns catalog of the controls imported by profile or system
+// security plan. Parameter settings and alterations of profile are not
+// applied to its own imports. Nil catalog is returned for other documents.
+func (d *Document) Imported() (*catalog.Catalog, error) {
+	if !d.importsDone {
+		d.importsDone = true
+		d.imported, d.importErr = d.resolveImports()
+	}
+	return d.imported, d.importErr
+}
+
+func (d *Document) resolveImports() (*catalog.Catalog, error) {
+	r := d.Resolver
+	if r == nil {
+		r = resolver.New()
+	}
+	switch {
+	case d.OSCAL.Profile != nil:
+		p := *d.OSCAL.Profile
+		p.Modify = nil
+		return r.Resolve(&p, d.Href)
+	case d.OSCAL.SystemSecurityPlan != nil:
+		ip := d.OSCAL.SystemSecurityPlan.ImportProfile
+		if ip == nil || ip.Href == "" {
+			return nil, nil
+		}
+		href, err := relativeTo(d.Href, ip.Href)
+		if err != nil {
+			return nil, err
+		}
+		return r.ResolveHref(href)
+	}
+	return nil, nil
+}
+
+// relativeTo resolves href relative to location of the base document
+func relativeTo(base, href string) (string, error) {
+	u, err := url.Parse(href)
+	if err != nil {
+		return "", fmt.Errorf("invalid href %s: %v", href, err)
+	}
+	if u.IsAbs() || filepath.IsAbs(href) {
+		return href, nil
+	}
+	if fetch.IsRemote(base) {
+		b, err := url.Parse(base)
+		if err != nil {
+			return "", err
+		}
+		return b.ResolveReference(u).String(), nil
+	}
+	return filepath.Join(filepath.Dir(base), filepath.FromSlash(u.Path)), nil
+}
+
+// Context is passed to the checked rule
+type Context struct {
+	Document *Document
+	rule     *Rule
+	findings []validation.Finding
+}
+
+// Report records finding of the rule at the location
+func (c *Context) Report(l Location, format string, args ...interface{}) {
+	f := validation.Finding{
+		File:     c.Document.Href,
+		Severity: c.rule.Severity,
+		Rule:     c.rule.ID,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	if c.Document.Format == constants.XmlFormat {
+		f.XPath = l.XPath
+	} else {
+		f.Pointer = l.Pointer
+	}
+	c.findings = append(c.findings, f)
+}
+
+// Path returns XPath or JSON pointer of the location depending on format
+// of the document
+func (d *Document) Path(l Location) string {
+	if d.Format == constants.XmlFormat {
+		return l.XPath
+	}
+	return l.Pointer
+}
+
+// Engine checks documents with set of rules
+type Engine struct {
+	rules []Rule
+}
+
+// NewEngine creates engine checking the rules
+func NewEngine(rules ...Rule) (*Engine, error) {
+	e := &Engine{}
+	for _, r := range rules {
+		if err := e.Register(r); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// Default engine checks the built-in rules and the rules registered with
+// Register
+var Default = &Engine{rules: Builtin()}
+
+// Register adds rule to the Default engine
+func Register(r Rule) error {
+	return Default.Register(r)
+}
+
+// Register adds rule to the engine. Rule ids must be unique.
+func (e *Engine) Register(r Rule) error {
+	if r.ID == "" || r.Check == nil {
+		return fmt.Errorf("rule must have id and check")
+	}
+	for _, existing := range e.rules {
+		if existing.ID == r.ID {
+			return fmt.Errorf("rule %s is already registered", r.ID)
+		}
+	}
+	if r.Severity == "" {
+		r.Severity = validation.SeverityError
+	}
+	e.rules = append(e.rules, r)
+	return nil
+}
+
+// Rules returns the rules of the engine in order of registration
+func (e *Engine) Rules() []Rule {
+	return append([]Rule(nil), e.rules...)
+}
+
+// Check checks the document with all rules of the engine
+func (e *Engine) Check(d *Document) []validation.Finding {
+	var findings []validation.Finding
+	for i := range e.rules {
+		c := &Context{Document: d, rule: &e.rules[i]}
+		e.rules[i].Check(c)
+		findings = append(findings, c.findings...)
+	}
+	return findings
+}
@@ -0,0 +1,122 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/validation"
+	"github.com/docker/oscalkit/types/oscal"
+	"github.com/docker/oscalkit/types/oscal/catalog"
+)
+
+func testDocument(t *testing.T, name string) *Document {
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	o, err := oscal.New(f)
+	if err != nil {
+		t.Fatalf("cannot parse %s: %v", name, err)
+	}
+	return &Document{Href: filepath.Join("testdata", name), Format: constants.XmlFormat, OSCAL: o}
+}
+
+// summary prints findings one per line as rule, XPath and message
+func summary(findings []validation.Finding) string {
+	var lines []string
+	for _, f := range findings {
+		lines = append(lines, f.Rule+" "+f.XPath+" "+f.Message)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestBuiltin(t *testing.T) {
+	for _, name := range []string{"catalog.xml", "baseline.xml"} {
+		if findings := Default.Check(testDocument(t, name)); len(findings) > 0 {
+			t.Errorf("unexpected findings of %s:\n%s", name, summary(findings))
+		}
+	}
+}
+
+func TestBuiltinFindings(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected []string
+	}{
+		{"profile.xml", []string{
+			"oscal/unique-id /profile/modify[1]/alter[1]/add[1]/part[1] Duplicate id 'acme', already used by /profile/metadata[1]/party[1]",
+			"oscal/party-reference /profile/metadata[1]/responsible-party[1]/party-id[2] Party 'nobody' is not defined in metadata",
+			"oscal/role-reference /profile/metadata[1]/responsible-party[1] Role 'approver' is not defined in metadata",
+			"oscal/internal-reference /profile/modify[1]/alter[1]/add[1]/part[1] Reference '#ref-2' does not match id of any element",
+			"oscal/unused-citation /profile/back-matter[1]/resource[1] Citation 'ref-1' is never referenced",
+			"oscal/set-parameter /profile/modify[1]/set-parameter[2] Parameter 'zz_prm' is not defined by the imported controls",
+			"oscal/alter-control /profile/modify[1]/alter[1] Control 'b2' is not imported",
+		}},
+		{"ssp.xml", []string{
+			"oscal/role-reference /system-security-plan/control-implementation[1]/implemented-requirement[2]/responsible-role[1] Role 'operator' is not defined in metadata",
+			"oscal/set-parameter /system-security-plan/control-implementation[1]/implemented-requirement[2]/set-parameter[1] Parameter 'b2_prm' is not defined by the imported controls",
+			"oscal/implemented-requirement /system-security-plan/control-implementation[1]/implemented-requirement[2] Control 'b2' is not defined by the imported profile",
+			"oscal/component-reference /system-security-plan/control-implementation[1]/implemented-requirement[2]/by-component[1] Component 'db' is not defined in system implementation",
+		}},
+	}
+	for _, c := range cases {
+		actual := summary(Default.Check(testDocument(t, c.name)))
+		if expected := strings.Join(c.expected, "\n"); actual != expected {
+			t.Errorf("unexpected findings of %s:\n%s\nexpected:\n%s", c.name, actual, expected)
+		}
+	}
+}
+
+func TestJSONLocation(t *testing.T) {
+	d := testDocument(t, "profile.xml")
+	d.Format = constants.JsonFormat
+	for _, f := range Default.Check(d) {
+		if f.Rule == RuleSetParameter {
+			if f.XPath != "" || f.Pointer != "/profile/modify/parameter-settings/1" {
+				t.Errorf("unexpected location of %v", f)
+			}
+			return
+		}
+	}
+	t.Error("set-parameter finding not reported")
+}
+
+func TestImportFailure(t *testing.T) {
+	d := testDocument(t, "profile.xml")
+	d.Href = filepath.Join("testdata", "missing", "profile.xml")
+	findings := Default.Check(d)
+	if len(findings) == 0 || findings[len(findings)-1].Rule != RuleImport || findings[len(findings)-1].XPath != "/profile" {
+		t.Errorf("expected import finding, got:\n%s", summary(findings))
+	}
+}
+
+func TestCustomRule(t *testing.T) {
+	e, err := NewEngine(Rule{
+		ID: "test/control-title",
+		Check: func(c *Context) {
+			Walk(c.Document.OSCAL, func(n *Node) {
+				if ctrl, ok := n.Value.(*catalog.Control); ok && ctrl.Title == "Control B2" {
+					c.Report(n.Location, "control %s has placeholder title", ctrl.Id)
+				}
+			})
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	findings := e.Check(testDocument(t, "catalog.xml"))
+	expected := "test/control-title /catalog/group[2]/control[2] control b2 has placeholder title"
+	if actual := summary(findings); actual != expected || findings[0].Severity != validation.SeverityError {
+		t.Errorf("unexpected findings:\n%s", actual)
+	}
+	if err := e.Register(Rule{ID: "test/control-title", Check: func(*Context) {}}); err == nil {
+		t.Error("expected error for duplicate rule id")
+	}
+	if len(e.Rules()) != 1 {
+		t.Errorf("expected single rule, got %d", len(e.Rules()))
+	}
+}
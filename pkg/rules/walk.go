@@ -0,0 +1,167 @@
+package rules
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/docker/oscalkit/types/oscal"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+// Location identifies node of the document both by XPath of its XML
+// representation and by JSON pointer of its JSON representation
+type Location struct {
+	XPath   string
+	Pointer string
+}
+
+// Child returns location of index-th child element. The index is zero
+// based, negative index denotes element that is not repeated.
+func (l Location) Child(xmlName, jsonName string, index int) Location {
+	child := Location{
+		XPath:   fmt.Sprintf("%s/%s[%d]", l.XPath, xmlName, index+1),
+		Pointer: l.Pointer + "/" + jsonName,
+	}
+	if index < 0 {
+		child.XPath = fmt.Sprintf("%s/%s[1]", l.XPath, xmlName)
+	} else {
+		child.Pointer = fmt.Sprintf("%s/%d", child.Pointer, index)
+	}
+	return child
+}
+
+// Node is an element of the document model
+type Node struct {
+	// Name is name of the XML element
+	Name     string
+	Location Location
+	// Value is pointer to the model struct, such as *catalog.Control
+	Value  interface{}
+	Parent *Node
+}
+
+// Attr returns value of the XML attribute of the node
+func (n *Node) Attr(name string) string {
+	v := reflect.ValueOf(n.Value).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if tag := v.Type().Field(i).Tag.Get("xml"); tag == name+",attr" || tag == name+",attr,omitempty" {
+			if v.Field(i).Kind() == reflect.String {
+				return v.Field(i).String()
+			}
+		}
+	}
+	return ""
+}
+
+// Elements returns values of child elements of string type, such as
+// party-id, with their locations
+func (n *Node) Elements(name string) ([]string, []Location) {
+	v := reflect.ValueOf(n.Value).Elem()
+	var values []string
+	var locations []Location
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		xmlName, jsonName, ok := fieldNames(f)
+		if !ok || xmlName != name {
+			continue
+		}
+		fv := v.Field(i)
+		switch {
+		case fv.Kind() == reflect.String && fv.String() != "":
+			values = append(values, fv.String())
+			locations = append(locations, n.Location.Child(xmlName, jsonName, -1))
+		case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.String:
+			for j := 0; j < fv.Len(); j++ {
+				values = append(values, fv.Index(j).String())
+				locations = append(locations, n.Location.Child(xmlName, jsonName, j))
+			}
+		}
+	}
+	return values, locations
+}
+
+var markupType = reflect.TypeOf(validation_root.Markup{})
+
+// Walk calls fn for the root element of the document and all its
+// descendants in document order
+func Walk(o *oscal.OSCAL, fn func(n *Node)) {
+	if root := rootNode(o); root != nil {
+		walk(root, fn)
+	}
+}
+
+func rootNode(o *oscal.OSCAL) *Node {
+	var root *Node
+	switch {
+	case o.Catalog != nil:
+		root = &Node{Name: "catalog", Value: o.Catalog}
+	case o.Profile != nil:
+		root = &Node{Name: "profile", Value: o.Profile}
+	case o.SystemSecurityPlan != nil:
+		root = &Node{Name: "system-security-plan", Value: o.SystemSecurityPlan}
+	case o.Component != nil:
+		root = &Node{Name: "component-definition", Value: o.Component}
+	default:
+		return nil
+	}
+	root.Location = Location{XPath: "/" + root.Name, Pointer: "/" + root.Name}
+	return root
+}
+
+func walk(n *Node, fn func(n *Node)) {
+	fn(n)
+	v := reflect.ValueOf(n.Value).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		xmlName, jsonName, ok := fieldNames(v.Type().Field(i))
+		if !ok {
+			continue
+		}
+		fv := v.Field(i)
+		switch fv.Kind() {
+		case reflect.Ptr:
+			if !fv.IsNil() && isElement(fv.Type().Elem()) {
+				walk(&Node{Name: xmlName, Location: n.Location.Child(xmlName, jsonName, -1), Value: fv.Interface(), Parent: n}, fn)
+			}
+		case reflect.Struct:
+			if isElement(fv.Type()) {
+				walk(&Node{Name: xmlName, Location: n.Location.Child(xmlName, jsonName, -1), Value: fv.Addr().Interface(), Parent: n}, fn)
+			}
+		case reflect.Slice:
+			if !isElement(fv.Type().Elem()) {
+				continue
+			}
+			for j := 0; j < fv.Len(); j++ {
+				walk(&Node{Name: xmlName, Location: n.Location.Child(xmlName, jsonName, j), Value: fv.Index(j).Addr().Interface(), Parent: n}, fn)
+			}
+		}
+	}
+}
+
+// isElement reports whether values of the type are model elements. Markup
+// holds prose rather than model elements.
+func isElement(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t != markupType
+}
+
+// fieldNames returns element names of the struct field in XML and JSON. Ok
+// is false for attributes, character data and ignored fields.
+func fieldNames(f reflect.StructField) (xmlName, jsonName string, ok bool) {
+	if f.Name == "XMLName" || f.PkgPath != "" {
+		return "", "", false
+	}
+	xmlTag := strings.Split(f.Tag.Get("xml"), ",")
+	if xmlTag[0] == "" || xmlTag[0] == "-" {
+		return "", "", false
+	}
+	for _, option := range xmlTag[1:] {
+		if option != "omitempty" {
+			return "", "", false
+		}
+	}
+	jsonName = strings.Split(f.Tag.Get("json"), ",")[0]
+	if jsonName == "" || jsonName == "-" {
+		jsonName = xmlTag[0]
+	}
+	return xmlTag[0], jsonName, true
+}
@@ -0,0 +1,121 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	// hashes of the algorithms
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+
+	xed25519 "golang.org/x/crypto/ed25519"
+)
+
+// hashes of the JWS algorithms, Ed25519 hashes the data itself
+var hashes = map[string]crypto.Hash{
+	"RS256": crypto.SHA256, "RS384": crypto.SHA384, "RS512": crypto.SHA512,
+	"PS256": crypto.SHA256, "PS384": crypto.SHA384, "PS512": crypto.SHA512,
+	"ES256": crypto.SHA256, "ES384": crypto.SHA384, "ES512": crypto.SHA512,
+	"EdDSA": crypto.SHA256,
+}
+
+// curves of the ECDSA algorithms by the key size in bits
+var curveBits = map[string]int{"ES256": 256, "ES384": 384, "ES512": 521}
+
+// signData signs the data by the algorithm as JWS does, ECDSA signatures are
+// concatenated r and s
+func signData(key interface{}, alg string, data []byte) ([]byte, error) {
+	hash, ok := hashes[alg]
+	if !ok {
+		return nil, fmt.Errorf("unsupported algorithm %s", alg)
+	}
+	digest := digestOf(hash, data)
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		switch alg[:2] {
+		case "RS":
+			return rsa.SignPKCS1v15(rand.Reader, k, hash, digest)
+		case "PS":
+			return rsa.SignPSS(rand.Reader, k, hash, digest, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		}
+	case *ecdsa.PrivateKey:
+		if curveBits[alg] != k.Curve.Params().BitSize {
+			break
+		}
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest)
+		if err != nil {
+			return nil, err
+		}
+		size := (k.Curve.Params().BitSize + 7) / 8
+		signature := make([]byte, 2*size)
+		rBytes, sBytes := r.Bytes(), s.Bytes()
+		copy(signature[size-len(rBytes):size], rBytes)
+		copy(signature[2*size-len(sBytes):], sBytes)
+		return signature, nil
+	case ed25519.PrivateKey:
+		if alg == "EdDSA" {
+			return ed25519.Sign(k, data), nil
+		}
+	case xed25519.PrivateKey:
+		if alg == "EdDSA" {
+			return xed25519.Sign(k, data), nil
+		}
+	}
+	return nil, fmt.Errorf("algorithm %s does not match %s key", alg, keyType(key))
+}
+
+// verifyData verifies signature of the data made by signData
+func verifyData(key interface{}, alg string, data, signature []byte) error {
+	hash, ok := hashes[alg]
+	if !ok {
+		return fmt.Errorf("unsupported algorithm %s", alg)
+	}
+	digest := digestOf(hash, data)
+	failed := errors.New("signature does not match")
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		switch alg[:2] {
+		case "RS":
+			if rsa.VerifyPKCS1v15(k, hash, digest, signature) != nil {
+				return failed
+			}
+			return nil
+		case "PS":
+			if rsa.VerifyPSS(k, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto}) != nil {
+				return failed
+			}
+			return nil
+		}
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if curveBits[alg] != k.Curve.Params().BitSize || len(signature) != 2*size {
+			break
+		}
+		r, s := new(big.Int).SetBytes(signature[:size]), new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			return failed
+		}
+		return nil
+	case xed25519.PublicKey:
+		if alg != "EdDSA" {
+			break
+		}
+		if !xed25519.Verify(k, data, signature) {
+			return failed
+		}
+		return nil
+	}
+	return fmt.Errorf("algorithm %s does not match %s key", alg, keyType(key))
+}
+
+func digestOf(hash crypto.Hash, data []byte) []byte {
+	h := hash.New()
+	h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,315 @@
+package signing
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// element of XML document. Names keep their prefixes, so that the element
+// can be written in canonical form.
+type element struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []interface{} // *element, xml.CharData or xml.ProcInst
+	parent   *element
+}
+
+// document is XML document without comments and document type declaration
+type document struct {
+	before, after []xml.ProcInst
+	root          *element
+}
+
+func parseXML(data []byte) (*document, error) {
+	d := xml.NewDecoder(bytes.NewReader(normalizeAttributes(data)))
+	doc := &document{}
+	var current *element
+	for {
+		token, err := d.RawToken()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if current == nil && doc.root != nil {
+				return nil, fmt.Errorf("multiple root elements")
+			}
+			e := &element{name: t.Name, attrs: append([]xml.Attr{}, t.Attr...), parent: current}
+			if current == nil {
+				doc.root = e
+			} else {
+				current.children = append(current.children, e)
+			}
+			current = e
+		case xml.EndElement:
+			if current == nil || current.name != t.Name {
+				return nil, fmt.Errorf("unexpected end element %s", qualifiedName(t.Name))
+			}
+			current = current.parent
+		case xml.CharData:
+			if current != nil {
+				current.children = append(current.children, t.Copy())
+			}
+		case xml.ProcInst:
+			switch {
+			case t.Target == "xml":
+			case current != nil:
+				current.children = append(current.children, t.Copy())
+			case doc.root == nil:
+				doc.before = append(doc.before, t.Copy())
+			default:
+				doc.after = append(doc.after, t.Copy())
+			}
+		}
+	}
+	if doc.root == nil || current != nil {
+		return nil, fmt.Errorf("incomplete XML document")
+	}
+	return doc, nil
+}
+
+// normalizeAttributes replaces literal tabs and line breaks in attribute
+// values by spaces, as XML processors normalize attribute values before
+// canonicalization while encoding/xml keeps them. Whitespace given by
+// character references is left as it is.
+func normalizeAttributes(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+	for i := 0; i < len(data); {
+		end := len(data)
+		switch rest := data[i:]; {
+		case rest[0] != '<':
+			end = i + 1
+		case bytes.HasPrefix(rest, []byte("<!--")):
+			end = skipPast(data, i, "-->")
+		case bytes.HasPrefix(rest, []byte("<![CDATA[")):
+			end = skipPast(data, i, "]]>")
+		case bytes.HasPrefix(rest, []byte("<?")):
+			end = skipPast(data, i, "?>")
+		case bytes.HasPrefix(rest, []byte("<!")):
+			// document type declaration, possibly with internal subset
+			end = skipPast(data, i, ">")
+			if j := bytes.IndexByte(rest, '['); j >= 0 && i+j < end {
+				end = skipPast(data, skipPast(data, i+j, "]"), ">")
+			}
+		default:
+			out, i = normalizeTag(out, data, i)
+			continue
+		}
+		out = append(out, data[i:end]...)
+		i = end
+	}
+	return out
+}
+
+// skipPast returns index following the first occurrence of the delimiter
+// from index i, length of the data when not found
+func skipPast(data []byte, i int, delimiter string) int {
+	if j := bytes.Index(data[i:], []byte(delimiter)); j >= 0 {
+		return i + j + len(delimiter)
+	}
+	return len(data)
+}
+
+// normalizeTag appends the tag starting at index i to out, with the
+// whitespace of its attribute values normalized, and returns index
+// following the tag
+func normalizeTag(out, data []byte, i int) ([]byte, int) {
+	var quote byte
+	for ; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case quote == 0 && c == '>':
+			return append(out, c), i + 1
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case c == quote:
+			quote = 0
+		case quote != 0 && c == '\r' && i+1 < len(data) && data[i+1] == '\n':
+			// line break of two characters is single space
+			continue
+		case quote != 0 && (c == '\r' || c == '\n' || c == '\t'):
+			c = ' '
+		}
+		out = append(out, c)
+	}
+	return out, i
+}
+
+// namespace returns the namespace URI of the prefix in scope of the element
+func (e *element) namespace(prefix string) string {
+	if prefix == "xml" {
+		return xmlNamespace
+	}
+	for el := e; el != nil; el = el.parent {
+		for _, a := range el.attrs {
+			if (prefix == "" && a.Name.Space == "" && a.Name.Local == "xmlns") || (a.Name.Space == "xmlns" && a.Name.Local == prefix) {
+				return a.Value
+			}
+		}
+	}
+	return ""
+}
+
+// child returns the first child element of the name in the namespace
+func (e *element) child(namespace, local string) *element {
+	for _, c := range e.children {
+		if el, ok := c.(*element); ok && el.name.Local == local && el.namespace(el.name.Space) == namespace {
+			return el
+		}
+	}
+	return nil
+}
+
+// text returns the character data of the element
+func (e *element) text() string {
+	var b strings.Builder
+	for _, c := range e.children {
+		if t, ok := c.(xml.CharData); ok {
+			b.Write(t)
+		}
+	}
+	return b.String()
+}
+
+func isNamespaceDeclaration(a xml.Attr) bool {
+	return a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns")
+}
+
+func qualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+// canonicalDocument writes the document in Exclusive XML Canonicalization
+// without comments, leaving out the excluded element as the enveloped
+// signature transform does
+func canonicalDocument(doc *document, exclude *element) []byte {
+	var b bytes.Buffer
+	for _, pi := range doc.before {
+		writeProcInst(&b, pi)
+		b.WriteString("\n")
+	}
+	writeCanonical(&b, doc.root, map[string]string{}, exclude)
+	for _, pi := range doc.after {
+		b.WriteString("\n")
+		writeProcInst(&b, pi)
+	}
+	return b.Bytes()
+}
+
+// canonicalElement writes the element in Exclusive XML Canonicalization
+// without comments
+func canonicalElement(e *element) []byte {
+	var b bytes.Buffer
+	writeCanonical(&b, e, map[string]string{}, nil)
+	return b.Bytes()
+}
+
+// writeCanonical writes the element with the namespace declarations it
+// visibly utilizes and that are not rendered by its output ancestors
+func writeCanonical(b *bytes.Buffer, e *element, rendered map[string]string, exclude *element) {
+	prefixes := []string{e.name.Space}
+	var attrs []xml.Attr
+	for _, a := range e.attrs {
+		if isNamespaceDeclaration(a) {
+			continue
+		}
+		attrs = append(attrs, a)
+		if a.Name.Space != "" && a.Name.Space != "xml" && !contains(prefixes, a.Name.Space) {
+			prefixes = append(prefixes, a.Name.Space)
+		}
+	}
+	sort.Strings(prefixes)
+
+	b.WriteString("<" + qualifiedName(e.name))
+	scope, copied := rendered, false
+	for _, prefix := range prefixes {
+		uri := e.namespace(prefix)
+		if previous, ok := rendered[prefix]; (ok && previous == uri) || (!ok && uri == "") {
+			continue
+		}
+		if !copied {
+			scope, copied = make(map[string]string, len(rendered)+1), true
+			for p, u := range rendered {
+				scope[p] = u
+			}
+		}
+		scope[prefix] = uri
+		if prefix == "" {
+			b.WriteString(` xmlns="` + escapeAttr(uri) + `"`)
+		} else {
+			b.WriteString(" xmlns:" + prefix + `="` + escapeAttr(uri) + `"`)
+		}
+	}
+	sort.SliceStable(attrs, func(i, j int) bool {
+		si, sj := attrNamespace(e, attrs[i]), attrNamespace(e, attrs[j])
+		if si != sj {
+			return si < sj
+		}
+		return attrs[i].Name.Local < attrs[j].Name.Local
+	})
+	for _, a := range attrs {
+		b.WriteString(" " + qualifiedName(a.Name) + `="` + escapeAttr(a.Value) + `"`)
+	}
+	b.WriteString(">")
+	for _, c := range e.children {
+		switch c := c.(type) {
+		case *element:
+			if c != exclude {
+				writeCanonical(b, c, scope, exclude)
+			}
+		case xml.CharData:
+			b.WriteString(escapeText(string(c)))
+		case xml.ProcInst:
+			writeProcInst(b, c)
+		}
+	}
+	b.WriteString("</" + qualifiedName(e.name) + ">")
+}
+
+func attrNamespace(e *element, a xml.Attr) string {
+	if a.Name.Space == "" {
+		return ""
+	}
+	return e.namespace(a.Name.Space)
+}
+
+func writeProcInst(b *bytes.Buffer, pi xml.ProcInst) {
+	b.WriteString("<?" + pi.Target)
+	if len(pi.Inst) > 0 {
+		b.WriteString(" " + string(pi.Inst))
+	}
+	b.WriteString("?>")
+}
+
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+
+var attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func escapeAttr(s string) string {
+	return attrEscaper.Replace(s)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,220 @@
+package signing
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/types/oscal"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+// ErrNoSignature is returned when the document holds no signature
+var ErrNoSignature = errors.New("no signature found")
+
+// Media types of JWS in compact and JSON serialization
+const (
+	MediaTypeJOSE     = "application/jose"
+	MediaTypeJOSEJSON = "application/jose+json"
+)
+
+// signatureResourceID is the id of the back-matter resource of signature
+const signatureResourceID = "oscalkit-signature"
+
+// Payload returns the signed content of the document for detached and
+// back-matter signatures: the canonical JSON form of the document without
+// the back-matter signature. The payload does not depend on the format and
+// formatting of the document, so that signatures remain valid when the
+// document is converted. The document is canonicalized in place.
+func Payload(o *oscal.OSCAL) ([]byte, error) {
+	if o.DocumentType() == constants.UnknownDocument {
+		return nil, errors.New("Cannot sign unknown document")
+	}
+	if err := o.Canonicalize(); err != nil {
+		return nil, err
+	}
+	backMatter := backMatterOf(o)
+	original := *backMatter
+	defer func() { *backMatter = original }()
+	if original != nil {
+		stripped := &validation_root.BackMatter{}
+		for _, r := range original.Resources {
+			if !isSignature(r) {
+				stripped.Resources = append(stripped.Resources, r)
+			}
+		}
+		*backMatter = stripped
+		if len(stripped.Resources) == 0 {
+			*backMatter = nil
+		}
+	}
+	var b bytes.Buffer
+	if err := o.Canonical(&b, constants.JsonFormat); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// SetBackMatterSignature records the detached JWS of the document as its
+// back-matter resource, replacing the previous signature
+func SetBackMatterSignature(o *oscal.OSCAL, jws string) {
+	backMatter := backMatterOf(o)
+	resources := []validation_root.Resource{}
+	if *backMatter != nil {
+		for _, r := range (*backMatter).Resources {
+			if !isSignature(r) {
+				resources = append(resources, r)
+			}
+		}
+	}
+	mediaType := MediaTypeJOSE
+	if strings.HasPrefix(jws, "{") {
+		mediaType = MediaTypeJOSEJSON
+	}
+	resources = append(resources, validation_root.Resource{
+		Id:    signatureResourceID,
+		Title: "Signature",
+		Desc:  "JSON Web Signature of the canonical JSON form of the document without this resource",
+		Attachments: []validation_root.Base64{{
+			Filename:  "signature.jws",
+			MediaType: mediaType,
+			Value:     base64.StdEncoding.EncodeToString([]byte(jws)),
+		}},
+	})
+	*backMatter = &validation_root.BackMatter{Resources: resources}
+}
+
+// BackMatterSignature returns the detached JWS recorded in the back-matter
+// of the document, ErrNoSignature when there is none
+func BackMatterSignature(o *oscal.OSCAL) (string, error) {
+	backMatter := backMatterOf(o)
+	if backMatter == nil || *backMatter == nil {
+		return "", ErrNoSignature
+	}
+	for _, r := range (*backMatter).Resources {
+		if !isSignature(r) {
+			continue
+		}
+		for _, a := range r.Attachments {
+			value := strings.Join(strings.Fields(a.Value), "")
+			jws, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return "", fmt.Errorf("Cannot decode signature of resource %s: %v", r.Id, err)
+			}
+			return string(jws), nil
+		}
+	}
+	return "", ErrNoSignature
+}
+
+// isSignature tells whether the resource is the back-matter signature.
+// Other resources, including signatures attached by other parties, are
+// signed content.
+func isSignature(r validation_root.Resource) bool {
+	return r.Id == signatureResourceID
+}
+
+func backMatterOf(o *oscal.OSCAL) **validation_root.BackMatter {
+	switch {
+	case o.Catalog != nil:
+		return &o.Catalog.BackMatter
+	case o.Profile != nil:
+		return &o.Profile.BackMatter
+	case o.SystemSecurityPlan != nil:
+		return &o.SystemSecurityPlan.BackMatter
+	case o.Component != nil:
+		return &o.Component.BackMatter
+	}
+	return nil
+}
+
+// Detach removes the payload of JWS, as detached JWS in compact
+// serialization has empty payload and in JSON serialization no payload
+func Detach(jws string) (string, error) {
+	if strings.HasPrefix(strings.TrimSpace(jws), "{") {
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(jws), &obj); err != nil {
+			return "", fmt.Errorf("Cannot parse signature: %v", err)
+		}
+		delete(obj, "payload")
+		b, err := json.Marshal(obj)
+		return string(b), err
+	}
+	parts := strings.Split(strings.TrimSpace(jws), ".")
+	if len(parts) != 3 {
+		return "", errors.New("Cannot parse signature: compact JWS must have three parts")
+	}
+	return parts[0] + ".." + parts[2], nil
+}
+
+// attach returns the detached JWS with the payload
+func attach(jws, payload []byte) ([]byte, error) {
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	jws = bytes.TrimSpace(jws)
+	if bytes.HasPrefix(jws, []byte("{")) {
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(jws, &obj); err != nil {
+			return nil, fmt.Errorf("Cannot parse signature: %v", err)
+		}
+		if _, ok := obj["payload"]; ok {
+			return nil, errors.New("Signature is not detached")
+		}
+		obj["payload"], _ = json.Marshal(encoded)
+		return json.Marshal(obj)
+	}
+	parts := strings.Split(string(jws), ".")
+	if len(parts) != 3 {
+		return nil, errors.New("Cannot parse signature: compact JWS must have three parts")
+	}
+	if parts[1] != "" {
+		return nil, errors.New("Signature is not detached")
+	}
+	return []byte(parts[0] + "." + encoded + "." + parts[2]), nil
+}
+
+// VerifyDetached verifies detached JWS of the payload
+func (v *Verifier) VerifyDetached(jws, payload []byte) (*Verified, error) {
+	attached, err := attach(jws, payload)
+	if err != nil {
+		return nil, err
+	}
+	return v.Verify(attached)
+}
+
+// VerifyDocument verifies the back-matter signature of the document
+func (v *Verifier) VerifyDocument(o *oscal.OSCAL) (*Verified, error) {
+	jws, err := BackMatterSignature(o)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := Payload(o)
+	if err != nil {
+		return nil, err
+	}
+	return v.VerifyDetached([]byte(jws), payload)
+}
+
+// IsJWS reports whether the data looks like JWS in compact or JSON
+// serialization rather than a document
+func IsJWS(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("{")) {
+		var obj map[string]json.RawMessage
+		if json.Unmarshal(data, &obj) != nil {
+			return false
+		}
+		_, signatures := obj["signatures"]
+		_, signature := obj["signature"]
+		return signatures || signature
+	}
+	for _, c := range data {
+		if !(c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '.') {
+			return false
+		}
+	}
+	return bytes.Count(data, []byte(".")) == 2
+}
@@ -0,0 +1,174 @@
+package signing
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/types/oscal"
+	"github.com/docker/oscalkit/types/oscal/catalog"
+	ssp "github.com/docker/oscalkit/types/oscal/system_security_plan"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+func testSSP() *oscal.OSCAL {
+	return &oscal.OSCAL{SystemSecurityPlan: &ssp.SystemSecurityPlan{
+		Id:       "s",
+		Metadata: &validation_root.Metadata{Title: "System", Version: "1.0"},
+		ControlImplementation: &ssp.ControlImplementation{
+			ImplementedRequirements: []ssp.ImplementedRequirement{
+				{ControlId: "ac-1", Description: validation_root.MarkupFromPlain("Implemented & <documented>.")},
+			},
+		},
+	}}
+}
+
+func testDocument() *oscal.OSCAL {
+	return &oscal.OSCAL{Catalog: &catalog.Catalog{
+		Id:       "c",
+		Metadata: &validation_root.Metadata{Title: "Catalog", Version: "1.0"},
+		Controls: []catalog.Control{catalog.NewControl("ac-1", "Policy", &catalog.ControlOpts{
+			Parts: []catalog.Part{catalog.NewPart("ac-1_smt", "Statement", "<p>Develop a <em>policy</em>.</p>")},
+		})},
+		BackMatter: &validation_root.BackMatter{Resources: []validation_root.Resource{{Id: "ref-1", Title: "Reference"}}},
+	}}
+}
+
+// reencode returns the document written in the format and read again
+func reencode(t *testing.T, o *oscal.OSCAL, format string) *oscal.OSCAL {
+	var b bytes.Buffer
+	var err error
+	switch format {
+	case "xml":
+		err = o.XML(&b, false)
+	case "json":
+		err = o.JSON(&b, true)
+	case "yaml":
+		err = o.YAML(&b)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := oscal.New(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+func TestSignDetached(t *testing.T) {
+	for _, k := range testKeys(t) {
+		jws, err := SignDetached(testSSP(), k.signer())
+		if err != nil {
+			t.Fatalf("%s: %v", k.alg, err)
+		}
+		if parts := strings.Split(jws, "."); len(parts) != 3 || parts[1] != "" {
+			t.Fatalf("%s: signature is not detached: %s", k.alg, jws)
+		}
+		v := &Verifier{Keys: parseKeys(t, k.publicPEM(t))}
+		for _, format := range []string{"xml", "json", "yaml"} {
+			payload, err := Payload(reencode(t, testSSP(), format))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := v.VerifyDetached([]byte(jws), payload); err != nil {
+				t.Errorf("%s: %s document: %v", k.alg, format, err)
+			}
+		}
+
+		tampered := testSSP()
+		tampered.SystemSecurityPlan.ControlImplementation.ImplementedRequirements[0].ControlId = "ac-2"
+		payload, err := Payload(tampered)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := v.VerifyDetached([]byte(jws), payload); err == nil {
+			t.Errorf("%s: tampered document verified", k.alg)
+		}
+	}
+}
+
+func TestSignBackMatter(t *testing.T) {
+	k := testKeys(t)[2]
+	v := &Verifier{Keys: parseKeys(t, k.publicPEM(t))}
+	for _, o := range []*oscal.OSCAL{testSSP(), testDocument()} {
+		if err := SignBackMatter(o, k.signer()); err != nil {
+			t.Fatal(err)
+		}
+		// signing again replaces the signature
+		if err := SignBackMatter(o, k.signer()); err != nil {
+			t.Fatal(err)
+		}
+		resources := backMatterOf(o)
+		signatures := 0
+		for _, r := range (*resources).Resources {
+			if isSignature(r) {
+				signatures++
+			}
+		}
+		if signatures != 1 {
+			t.Errorf("%s: unexpected signatures %d", o.DocumentType(), signatures)
+		}
+		for _, format := range []string{"xml", "json", "yaml"} {
+			if _, err := v.VerifyDocument(reencode(t, o, format)); err != nil {
+				t.Errorf("%s: %s document: %v", o.DocumentType(), format, err)
+			}
+		}
+
+		tampered := reencode(t, o, "json")
+		tampered.Metadata().Version = "2.0"
+		if _, err := v.VerifyDocument(tampered); err == nil {
+			t.Errorf("%s: tampered document verified", o.DocumentType())
+		}
+	}
+	if _, err := v.VerifyDocument(testSSP()); err != ErrNoSignature {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	// other JWS resources are signed content
+	o := testSSP()
+	other := validation_root.Resource{
+		Id:          "approval",
+		Attachments: []validation_root.Base64{{MediaType: MediaTypeJOSE, Value: "e30..c2ln"}},
+	}
+	o.SystemSecurityPlan.BackMatter = &validation_root.BackMatter{Resources: []validation_root.Resource{other}}
+	if err := SignBackMatter(o, k.signer()); err != nil {
+		t.Fatal(err)
+	}
+	if resources := o.SystemSecurityPlan.BackMatter.Resources; len(resources) != 2 || resources[0].Id != "approval" {
+		t.Fatalf("resource should be kept along the signature: %+v", resources)
+	}
+	if _, err := v.VerifyDocument(reencode(t, o, "json")); err != nil {
+		t.Error(err)
+	}
+	o.SystemSecurityPlan.BackMatter.Resources[0].Attachments[0].Value = "e30..b3RoZXI="
+	if _, err := v.VerifyDocument(o); err == nil {
+		t.Error("document with tampered resource verified")
+	}
+}
+
+func TestIsJWS(t *testing.T) {
+	k := testKeys(t)[0]
+	obj, err := Sign([]byte(payload), k.signer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	compact, err := obj.CompactSerialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	detached, err := Detach(compact)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, jws := range []string{obj.FullSerialize(), compact, detached} {
+		if !IsJWS([]byte(jws)) {
+			t.Errorf("%s is not recognized", jws)
+		}
+	}
+	for _, doc := range []string{payload, "<catalog/>", "a.b"} {
+		if IsJWS([]byte(doc)) {
+			t.Errorf("%s is recognized", doc)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+// Package signing signs OSCAL documents and verifies their signatures. The
+// documents are signed by JSON Web Signatures holding the document, detached
+// from it or recorded in its back-matter, or by enveloped XML Signatures.
+// Signatures are made by RSA, ECDSA and Ed25519 keys, the verification keys
+// are given as public keys, X.509 certificates or JSON Web Key Sets.
+package signing
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	xed25519 "golang.org/x/crypto/ed25519"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+// PublicKey is a key trusted to verify signatures
+type PublicKey struct {
+	// Key is *rsa.PublicKey, *ecdsa.PublicKey or ed25519.PublicKey
+	Key interface{}
+	// KeyID matches kid header of signatures. Keys without id verify
+	// signatures with any kid.
+	KeyID string
+	// Algorithm restricts the key to single algorithm, as alg of JWKs
+	Algorithm string
+	// Certificates is the certificate chain of the key, leaf first
+	Certificates []*x509.Certificate
+}
+
+func (k *PublicKey) String() string {
+	switch {
+	case len(k.Certificates) > 0:
+		return fmt.Sprintf("certificate %q", k.Certificates[0].Subject)
+	case k.KeyID != "":
+		return fmt.Sprintf("key %q", k.KeyID)
+	}
+	return fmt.Sprintf("%s key", keyType(k.Key))
+}
+
+// ParsePublicKeys parses public keys given as PEM or DER encoded public keys
+// or X.509 certificates, JSON Web Key or JSON Web Key Set. Certificates in
+// single PEM file are taken as certificate chain, leaf first.
+func ParsePublicKeys(data []byte) ([]*PublicKey, error) {
+	// DER keys are binary and may end with whitespace bytes, so only the
+	// text forms are trimmed
+	text := bytes.TrimSpace(data)
+	if len(text) == 0 {
+		return nil, errors.New("no keys found")
+	}
+	if text[0] == '{' {
+		return parseJWKs(text)
+	}
+	if block, _ := pem.Decode(data); block == nil {
+		key, err := parseDER(data)
+		if err != nil {
+			return nil, err
+		}
+		return []*PublicKey{key}, nil
+	}
+
+	var keys []*PublicKey
+	var chain []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		switch block.Type {
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("Cannot parse certificate: %v", err)
+			}
+			chain = append(chain, cert)
+		case "PUBLIC KEY", "RSA PUBLIC KEY":
+			key, err := parseDER(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, key)
+		default:
+			return nil, fmt.Errorf("Unsupported PEM block %s, expected public key or certificate", block.Type)
+		}
+	}
+	if len(chain) > 0 {
+		key, err := newPublicKey(chain[0].PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		key.Certificates = chain
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// ParseTrustBundle parses PEM encoded certificates of trusted authorities
+func ParseTrustBundle(data []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.New("no certificates found")
+	}
+	return pool, nil
+}
+
+func parseDER(der []byte) (*PublicKey, error) {
+	if cert, err := x509.ParseCertificate(der); err == nil {
+		key, err := newPublicKey(cert.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		key.Certificates = []*x509.Certificate{cert}
+		return key, nil
+	}
+	if key, err := x509.ParsePKIXPublicKey(der); err == nil {
+		return newPublicKey(key)
+	}
+	if key, err := x509.ParsePKCS1PublicKey(der); err == nil {
+		return newPublicKey(key)
+	}
+	return nil, errors.New("Cannot parse public key, expected PKIX or PKCS#1 public key or X.509 certificate")
+}
+
+func parseJWKs(data []byte) ([]*PublicKey, error) {
+	var set struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("Cannot parse JSON Web Key: %v", err)
+	}
+	if set.Keys == nil {
+		set.Keys = []json.RawMessage{data}
+	}
+	var keys []*PublicKey
+	for _, raw := range set.Keys {
+		var jwk jose.JSONWebKey
+		if err := json.Unmarshal(raw, &jwk); err != nil {
+			return nil, fmt.Errorf("Cannot parse JSON Web Key: %v", err)
+		}
+		if !jwk.IsPublic() {
+			jwk = jwk.Public()
+		}
+		key, err := newPublicKey(jwk.Key)
+		if err != nil {
+			return nil, fmt.Errorf("JSON Web Key %q: %v", jwk.KeyID, err)
+		}
+		key.KeyID, key.Algorithm, key.Certificates = jwk.KeyID, jwk.Algorithm, jwk.Certificates
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no keys found")
+	}
+	return keys, nil
+}
+
+func newPublicKey(key interface{}) (*PublicKey, error) {
+	switch k := key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, xed25519.PublicKey:
+		return &PublicKey{Key: k}, nil
+	case ed25519.PublicKey:
+		// the signatures are verified by golang.org/x/crypto/ed25519
+		return &PublicKey{Key: xed25519.PublicKey(k)}, nil
+	case nil:
+		return nil, errors.New("missing public key")
+	}
+	return nil, fmt.Errorf("unsupported %s key, expected RSA, ECDSA or Ed25519 public key", keyType(key))
+}
+
+func keyType(key interface{}) string {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		return "RSA"
+	case *ecdsa.PublicKey:
+		return "ECDSA"
+	case ed25519.PublicKey, xed25519.PublicKey:
+		return "Ed25519"
+	case []byte:
+		return "symmetric"
+	}
+	return fmt.Sprintf("%T", key)
+}
@@ -0,0 +1,129 @@
+package signing
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"hash"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+var (
+	oidPBES2  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+)
+
+// pseudorandom functions of PBKDF2
+var pbkdf2PRFs = []struct {
+	oid  asn1.ObjectIdentifier
+	hash func() hash.Hash
+}{
+	{asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}, sha1.New},
+	{asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}, sha256.New},
+	{asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 10}, sha512.New384},
+	{asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 11}, sha512.New},
+}
+
+// encryption schemes of PBES2 with their key sizes
+var pbes2Ciphers = []struct {
+	oid     asn1.ObjectIdentifier
+	keySize int
+	cipher  func(key []byte) (cipher.Block, error)
+}{
+	{asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}, 16, aes.NewCipher},
+	{asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}, 24, aes.NewCipher},
+	{asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}, 32, aes.NewCipher},
+	{asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 7}, 24, des.NewTripleDESCipher},
+}
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// decryptPKCS8 decrypts PKCS#8 private key encrypted by PBES2 with PBKDF2,
+// as written by openssl genpkey and openssl pkcs8 -topk8
+func decryptPKCS8(der, password []byte) ([]byte, error) {
+	var info encryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, fmt.Errorf("Cannot parse encrypted private key: %v", err)
+	}
+	if !info.Algorithm.Algorithm.Equal(oidPBES2) {
+		return nil, fmt.Errorf("unsupported encryption %s of private key, expected PBES2", info.Algorithm.Algorithm)
+	}
+	var params pbes2Params
+	if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &params); err != nil {
+		return nil, fmt.Errorf("Cannot parse encryption of private key: %v", err)
+	}
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, fmt.Errorf("unsupported key derivation %s of private key, expected PBKDF2", params.KeyDerivationFunc.Algorithm)
+	}
+	var kdf pbkdf2Params
+	if _, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdf); err != nil {
+		return nil, fmt.Errorf("Cannot parse key derivation of private key: %v", err)
+	}
+	prf := sha1.New
+	if len(kdf.PRF.Algorithm) > 0 {
+		prf = nil
+		for _, p := range pbkdf2PRFs {
+			if p.oid.Equal(kdf.PRF.Algorithm) {
+				prf = p.hash
+			}
+		}
+		if prf == nil {
+			return nil, fmt.Errorf("unsupported key derivation function %s of private key", kdf.PRF.Algorithm)
+		}
+	}
+
+	for _, c := range pbes2Ciphers {
+		if !c.oid.Equal(params.EncryptionScheme.Algorithm) {
+			continue
+		}
+		var iv []byte
+		if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+			return nil, fmt.Errorf("Cannot parse encryption of private key: %v", err)
+		}
+		block, err := c.cipher(pbkdf2.Key(password, kdf.Salt, kdf.IterationCount, c.keySize, prf))
+		if err != nil {
+			return nil, err
+		}
+		data := info.EncryptedData
+		if len(iv) != block.BlockSize() || len(data) == 0 || len(data)%block.BlockSize() != 0 {
+			return nil, errors.New("Cannot decrypt private key: invalid encrypted data")
+		}
+		plain := make([]byte, len(data))
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, data)
+		// PKCS#7 padding, wrong password gives invalid padding in most cases
+		padding := int(plain[len(plain)-1])
+		if padding == 0 || padding > block.BlockSize() {
+			return nil, errIncorrectPassword
+		}
+		for _, b := range plain[len(plain)-padding:] {
+			if int(b) != padding {
+				return nil, errIncorrectPassword
+			}
+		}
+		return plain[:len(plain)-padding], nil
+	}
+	return nil, fmt.Errorf("unsupported cipher %s of private key", params.EncryptionScheme.Algorithm)
+}
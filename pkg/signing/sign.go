@@ -0,0 +1,120 @@
+package signing
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+
+	"github.com/docker/oscalkit/types/oscal"
+	xed25519 "golang.org/x/crypto/ed25519"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+// Sign signs the payload by the signers. Each signature carries the kid and
+// x5c headers of its signer. JWS of multiple signers can be serialized in
+// general JSON serialization only.
+func Sign(payload []byte, signers ...*Signer) (*jose.JSONWebSignature, error) {
+	if len(signers) == 0 {
+		return nil, errors.New("no signers")
+	}
+	var result *jose.JSONWebSignature
+	for _, s := range signers {
+		if err := s.Validate(); err != nil {
+			return nil, err
+		}
+		key := s.Key
+		if k, ok := key.(ed25519.PrivateKey); ok {
+			// go-jose signs by golang.org/x/crypto/ed25519
+			key = xed25519.PrivateKey(k)
+		}
+		opts := &jose.SignerOptions{}
+		if len(s.Certificates) > 0 {
+			opts.WithHeader("x5c", s.x5c())
+		}
+		switch _, symmetric := key.([]byte); {
+		case s.KeyID != "":
+			key = jose.JSONWebKey{Key: key, KeyID: s.KeyID}
+		case !symmetric:
+			// go-jose writes kid header, even empty, for the private keys
+			key = opaqueKey{key: key, alg: s.Algorithm}
+		}
+		signer, err := jose.NewSigner(jose.SigningKey{
+			Algorithm: jose.SignatureAlgorithm(s.Algorithm),
+			Key:       key,
+		}, opts)
+		if err != nil {
+			return nil, err
+		}
+		obj, err := signer.Sign(payload)
+		if err != nil {
+			return nil, err
+		}
+		// signatures of the same payload are independent of each other
+		if result == nil {
+			result = obj
+		} else {
+			result.Signatures = append(result.Signatures, obj.Signatures...)
+		}
+	}
+	return result, nil
+}
+
+// opaqueKey signs by the private key without revealing its public key to
+// go-jose, so that no kid header is written
+type opaqueKey struct {
+	key interface{}
+	alg string
+}
+
+func (k opaqueKey) Public() *jose.JSONWebKey {
+	return nil
+}
+
+func (k opaqueKey) Algs() []jose.SignatureAlgorithm {
+	return []jose.SignatureAlgorithm{jose.SignatureAlgorithm(k.alg)}
+}
+
+func (k opaqueKey) SignPayload(payload []byte, alg jose.SignatureAlgorithm) ([]byte, error) {
+	return signData(k.key, string(alg), payload)
+}
+
+// x5c returns the certificate chain as the value of x5c header
+func (s *Signer) x5c() []string {
+	var chain []string
+	for _, cert := range s.Certificates {
+		chain = append(chain, base64.StdEncoding.EncodeToString(cert.Raw))
+	}
+	return chain
+}
+
+// SignDetached returns detached JWS of the document, in compact
+// serialization when signed by single signer. The signed payload is the
+// canonical JSON form of the document as returned by Payload.
+func SignDetached(o *oscal.OSCAL, signers ...*Signer) (string, error) {
+	payload, err := Payload(o)
+	if err != nil {
+		return "", err
+	}
+	obj, err := Sign(payload, signers...)
+	if err != nil {
+		return "", err
+	}
+	jws := obj.FullSerialize()
+	if len(obj.Signatures) == 1 {
+		if jws, err = obj.CompactSerialize(); err != nil {
+			return "", err
+		}
+	}
+	return Detach(jws)
+}
+
+// SignBackMatter signs the document by detached JWS recorded as resource of
+// its back-matter, so that the signed document remains valid OSCAL
+func SignBackMatter(o *oscal.OSCAL, signers ...*Signer) error {
+	jws, err := SignDetached(o, signers...)
+	if err != nil {
+		return err
+	}
+	SetBackMatterSignature(o, jws)
+	return nil
+}
@@ -0,0 +1,247 @@
+package signing
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	xed25519 "golang.org/x/crypto/ed25519"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+// ErrPasswordRequired is returned when the private key is encrypted and no
+// password is given
+var ErrPasswordRequired = errors.New("private key is encrypted, password required")
+
+var errIncorrectPassword = errors.New("Cannot decrypt private key: incorrect password")
+
+// Signer is a private key signing documents
+type Signer struct {
+	// Key is *rsa.PrivateKey, *ecdsa.PrivateKey, ed25519.PrivateKey or HMAC
+	// secret as []byte
+	Key interface{}
+	// Algorithm is the JWS algorithm of the signatures
+	Algorithm string
+	// KeyID is written to kid header of the signatures
+	KeyID string
+	// Certificates is the certificate chain of the key, leaf first, written
+	// to x5c header of the signatures
+	Certificates []*x509.Certificate
+}
+
+// NewSigner returns signer of the key using the default algorithm of the key
+func NewSigner(key interface{}) (*Signer, error) {
+	if k, ok := key.(xed25519.PrivateKey); ok {
+		key = ed25519.PrivateKey(k)
+	}
+	alg, err := DefaultAlgorithm(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{Key: key, Algorithm: alg}, nil
+}
+
+// DefaultAlgorithm returns the algorithm used with the key by default:
+// RS256 for RSA keys, ES256, ES384 or ES512 for ECDSA keys by their curve,
+// EdDSA for Ed25519 keys and HS256 for HMAC secrets
+func DefaultAlgorithm(key interface{}) (string, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return "RS256", nil
+	case *ecdsa.PrivateKey:
+		for alg, bits := range curveBits {
+			if k.Curve.Params().BitSize == bits {
+				return alg, nil
+			}
+		}
+		return "", fmt.Errorf("unsupported ECDSA curve %s", k.Curve.Params().Name)
+	case ed25519.PrivateKey, xed25519.PrivateKey:
+		return "EdDSA", nil
+	case []byte:
+		if len(k) == 0 {
+			return "", errors.New("empty HMAC secret")
+		}
+		return "HS256", nil
+	}
+	return "", fmt.Errorf("unsupported %T key, expected RSA, ECDSA or Ed25519 private key or HMAC secret", key)
+}
+
+// Validate checks that the algorithm is usable with the key and that the
+// certificate is of the key
+func (s *Signer) Validate() error {
+	alg := s.Algorithm
+	valid := false
+	switch k := s.Key.(type) {
+	case *rsa.PrivateKey:
+		valid = strings.HasPrefix(alg, "RS") || strings.HasPrefix(alg, "PS")
+	case *ecdsa.PrivateKey:
+		valid = curveBits[alg] == k.Curve.Params().BitSize
+	case ed25519.PrivateKey:
+		valid = alg == "EdDSA"
+	case []byte:
+		valid = strings.HasPrefix(alg, "HS")
+	default:
+		_, err := DefaultAlgorithm(s.Key)
+		return err
+	}
+	if !valid || (hashes[alg] == 0 && !strings.HasPrefix(alg, "HS")) {
+		return fmt.Errorf("algorithm %s cannot be used with %s key", alg, privateKeyType(s.Key))
+	}
+	if len(s.Certificates) > 0 {
+		if _, ok := s.Key.([]byte); ok {
+			return errors.New("HMAC secret cannot have certificates")
+		}
+		certificateKey, err := x509.MarshalPKIXPublicKey(s.Certificates[0].PublicKey)
+		if err != nil {
+			return err
+		}
+		publicKey, err := x509.MarshalPKIXPublicKey(s.Key.(interface{ Public() crypto.PublicKey }).Public())
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(certificateKey, publicKey) {
+			return fmt.Errorf("certificate %q is not of the key", s.Certificates[0].Subject)
+		}
+	}
+	return nil
+}
+
+// ParseSigner parses private key given as PEM or DER encoded PKCS#1, PKCS#8
+// or SEC 1 key, or JSON Web Key. PEM keys may be encrypted, by PKCS#8 PBES2
+// or legacy PEM encryption, the password is then asked for by the password
+// function. The PEM key may be followed by its certificate chain, leaf
+// first. HMAC secrets are given as JSON Web Keys of type oct.
+func ParseSigner(data []byte, password func() ([]byte, error)) (*Signer, error) {
+	// DER keys are binary and may end with whitespace bytes, so only the
+	// text forms are trimmed
+	text := bytes.TrimSpace(data)
+	if len(text) == 0 {
+		return nil, errors.New("no private key found")
+	}
+	if text[0] == '{' {
+		var jwk jose.JSONWebKey
+		if err := json.Unmarshal(text, &jwk); err != nil {
+			return nil, fmt.Errorf("Cannot parse JSON Web Key: %v", err)
+		}
+		if jwk.IsPublic() {
+			return nil, errors.New("JSON Web Key is public, expected private key")
+		}
+		s, err := NewSigner(jwk.Key)
+		if err != nil {
+			return nil, err
+		}
+		if jwk.Algorithm != "" {
+			s.Algorithm = jwk.Algorithm
+		}
+		s.KeyID, s.Certificates = jwk.KeyID, jwk.Certificates
+		return s, nil
+	}
+	if block, _ := pem.Decode(data); block == nil {
+		key, err := parsePrivateKey("", data)
+		if err != nil {
+			return nil, err
+		}
+		return NewSigner(key)
+	}
+
+	var key interface{}
+	var certificates []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		switch block.Type {
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("Cannot parse certificate: %v", err)
+			}
+			certificates = append(certificates, cert)
+			continue
+		case "EC PARAMETERS":
+			// written by openssl ecparam -genkey
+			continue
+		}
+		if key != nil {
+			return nil, errors.New("multiple private keys found")
+		}
+		der := block.Bytes
+		blockType := block.Type
+		if block.Type == "ENCRYPTED PRIVATE KEY" || x509.IsEncryptedPEMBlock(block) {
+			if password == nil {
+				return nil, ErrPasswordRequired
+			}
+			secret, err := password()
+			if err != nil {
+				return nil, err
+			}
+			if block.Type == "ENCRYPTED PRIVATE KEY" {
+				der, err = decryptPKCS8(block.Bytes, secret)
+				blockType = "PRIVATE KEY"
+			} else {
+				der, err = x509.DecryptPEMBlock(block, secret)
+				if err == x509.IncorrectPasswordError {
+					err = errIncorrectPassword
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if key, err = parsePrivateKey(blockType, der); err != nil {
+			return nil, err
+		}
+	}
+	if key == nil {
+		return nil, errors.New("no private key found")
+	}
+	s, err := NewSigner(key)
+	if err != nil {
+		return nil, err
+	}
+	s.Certificates = certificates
+	return s, nil
+}
+
+// parsePrivateKey parses DER encoded key of the PEM block type, trying all
+// encodings when the type is not known
+func parsePrivateKey(blockType string, der []byte) (interface{}, error) {
+	switch blockType {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(der)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(der)
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(der)
+	case "":
+		if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+			return key, nil
+		}
+		if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+			return key, nil
+		}
+		if key, err := x509.ParseECPrivateKey(der); err == nil {
+			return key, nil
+		}
+		return nil, errors.New("Cannot parse private key, expected PKCS#1, PKCS#8 or SEC 1 private key")
+	}
+	return nil, fmt.Errorf("Unsupported PEM block %s, expected private key or certificate", blockType)
+}
+
+func privateKeyType(key interface{}) string {
+	if k, ok := key.(interface{ Public() crypto.PublicKey }); ok {
+		return keyType(k.Public())
+	}
+	return keyType(key)
+}
@@ -0,0 +1,297 @@
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+func password(secret string) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		return []byte(secret), nil
+	}
+}
+
+func TestParseSigner(t *testing.T) {
+	keys := testKeys(t)
+	for i, k := range []testKey{keys[0], keys[2], keys[3]} {
+		der, err := x509.MarshalPKCS8PrivateKey(k.private)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, data := range [][]byte{der, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})} {
+			s, err := ParseSigner(data, nil)
+			if err != nil {
+				t.Fatalf("%d: %v", i, err)
+			}
+			if expected := []string{"RS256", "ES256", "EdDSA"}[i]; s.Algorithm != expected {
+				t.Errorf("unexpected default algorithm %s, expected %s", s.Algorithm, expected)
+			}
+		}
+	}
+
+	rsaKey := keys[0].private.(*rsa.PrivateKey)
+	s, err := ParseSigner(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}), nil)
+	if err != nil || s.Algorithm != "RS256" {
+		t.Errorf("PKCS#1 key: %v", err)
+	}
+	ecDER, err := x509.MarshalECPrivateKey(keys[2].private.(*ecdsa.PrivateKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecPEM := append([]byte("-----BEGIN EC PARAMETERS-----\nBggqhkjOPQMBBw==\n-----END EC PARAMETERS-----\n"),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecDER})...)
+	if s, err = ParseSigner(ecPEM, nil); err != nil || s.Algorithm != "ES256" {
+		t.Errorf("SEC 1 key: %v", err)
+	}
+
+	for _, data := range []string{"", "junk", "-----BEGIN CERTIFICATE REQUEST-----\nAAAA\n-----END CERTIFICATE REQUEST-----\n", string(keys[0].publicPEM(t))} {
+		if _, err := ParseSigner([]byte(data), nil); err == nil {
+			t.Errorf("%q parsed", data)
+		}
+	}
+}
+
+// TestParseDER parses DER keys ending with whitespace bytes
+func TestParseDER(t *testing.T) {
+	seed := make([]byte, ed25519.SeedSize)
+	seed[len(seed)-1] = ' '
+	private := ed25519.NewKeyFromSeed(seed)
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseSigner(der, nil); err != nil {
+		t.Errorf("private key: %v", err)
+	}
+	for i := byte(0); ; i++ {
+		seed[0] = i
+		public := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
+		if c := public[len(public)-1]; c != ' ' && c != '\n' && c != '\t' && c != '\r' {
+			continue
+		}
+		der, err := x509.MarshalPKIXPublicKey(public)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ParsePublicKeys(der); err != nil {
+			t.Errorf("public key: %v", err)
+		}
+		return
+	}
+}
+
+func TestParseEncryptedSigner(t *testing.T) {
+	for file, alg := range map[string]string{
+		"ec-p384-encrypted.pem":    "ES384",
+		"ed25519-encrypted.pem":    "EdDSA",
+		"rsa-legacy-encrypted.pem": "RS256",
+	} {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ParseSigner(data, nil); err != ErrPasswordRequired {
+			t.Errorf("%s: unexpected error %v", file, err)
+		}
+		if _, err := ParseSigner(data, password("wrong")); err == nil {
+			t.Errorf("%s: decrypted by wrong password", file)
+		}
+		failure := errors.New("no terminal")
+		if _, err := ParseSigner(data, func() ([]byte, error) { return nil, failure }); err != failure {
+			t.Errorf("%s: unexpected error %v", file, err)
+		}
+		s, err := ParseSigner(data, password("secret"))
+		if err != nil {
+			t.Errorf("%s: %v", file, err)
+			continue
+		}
+		if s.Algorithm != alg {
+			t.Errorf("%s: unexpected algorithm %s", file, s.Algorithm)
+		}
+		if _, err := Sign([]byte(payload), s); err != nil {
+			t.Errorf("%s: %v", file, err)
+		}
+	}
+}
+
+func TestParseSignerJWK(t *testing.T) {
+	k := testKeys(t)[0]
+	jwk, err := json.Marshal(jose.JSONWebKey{Key: testKeys(t)[2].private, KeyID: "ec-1", Algorithm: "ES256"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := ParseSigner(jwk, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.KeyID != "ec-1" || s.Algorithm != "ES256" {
+		t.Errorf("unexpected signer %+v", s)
+	}
+
+	secret := []byte(`{"kty":"oct","kid":"hmac","k":"c2VjcmV0LXNlY3JldC1zZWNyZXQtc2VjcmV0LXNlY3JldA"}`)
+	if s, err = ParseSigner(secret, nil); err != nil {
+		t.Fatal(err)
+	}
+	if s.Algorithm != "HS256" || s.KeyID != "hmac" {
+		t.Errorf("unexpected signer %+v", s)
+	}
+	obj, err := Sign([]byte(payload), s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := obj.Verify([]byte("secret-secret-secret-secret-secret")); err != nil {
+		t.Errorf("HMAC signature: %v", err)
+	}
+
+	public, err := json.Marshal(jose.JSONWebKey{Key: k.private.Public()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseSigner(public, nil); err == nil {
+		t.Error("public key parsed as signer")
+	}
+}
+
+func TestSignerValidate(t *testing.T) {
+	keys := testKeys(t)
+	invalid := []*Signer{
+		{Key: keys[0].private, Algorithm: "ES256"},
+		{Key: keys[0].private, Algorithm: "RS999"},
+		{Key: keys[2].private, Algorithm: "ES384"},
+		{Key: keys[3].private, Algorithm: "RS256"},
+		{Key: []byte("secret"), Algorithm: "EdDSA"},
+		{Key: keys[0].private.Public(), Algorithm: "RS256"},
+		{Key: keys[2].private, Algorithm: "ES256", Certificates: []*x509.Certificate{certificate(t, keys[3].private, "Other", nil, nil)}},
+	}
+	for _, s := range invalid {
+		if err := s.Validate(); err == nil {
+			t.Errorf("%T %s is valid", s.Key, s.Algorithm)
+		}
+	}
+	if err := (&Signer{Key: keys[3].private.(ed25519.PrivateKey), Algorithm: "EdDSA"}).Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestSignHeaders checks that kid and x5c headers are written only when set
+func TestSignHeaders(t *testing.T) {
+	hmac, err := NewSigner([]byte("secret-secret-secret-secret-secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signers := []*Signer{hmac}
+	for _, k := range testKeys(t) {
+		signers = append(signers, k.signer())
+	}
+	for _, s := range signers {
+		obj, err := Sign([]byte(payload), s)
+		if err != nil {
+			t.Fatalf("%s: %v", s.Algorithm, err)
+		}
+		compact, err := obj.CompactSerialize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		protected, err := base64.RawURLEncoding.DecodeString(strings.Split(compact, ".")[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var header map[string]interface{}
+		if err := json.Unmarshal(protected, &header); err != nil {
+			t.Fatal(err)
+		}
+		if len(header) != 1 || header["alg"] != s.Algorithm {
+			t.Errorf("%s: unexpected header %s", s.Algorithm, protected)
+		}
+	}
+}
+
+func TestSignMultipleSigners(t *testing.T) {
+	keys := testKeys(t)
+	ca := certificate(t, keys[2].private, "CA", nil, nil)
+	leaf := certificate(t, keys[3].private, "Signer", ca, keys[2].private)
+	signers := []*Signer{
+		{Key: keys[0].private, Algorithm: "PS256", KeyID: "rsa-1"},
+		{Key: keys[3].private, Algorithm: "EdDSA", KeyID: "ed-1", Certificates: []*x509.Certificate{leaf, ca}},
+	}
+	obj, err := Sign([]byte(payload), signers...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jws := obj.FullSerialize()
+	var general struct {
+		Signatures []json.RawMessage `json:"signatures"`
+	}
+	if err := json.Unmarshal([]byte(jws), &general); err != nil || len(general.Signatures) != 2 {
+		t.Fatalf("unexpected serialization %s", jws)
+	}
+
+	parsed, err := jose.ParseSigned(jws)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Signatures[0].Header.KeyID != "rsa-1" || parsed.Signatures[1].Header.KeyID != "ed-1" {
+		t.Errorf("unexpected kid headers %s", jws)
+	}
+
+	// each signer verifies on its own, the second one by x5c certificate
+	verified, err := (&Verifier{Keys: parseKeys(t, keys[0].publicPEM(t))}).Verify([]byte(jws))
+	if err != nil || verified.Algorithm != "PS256" {
+		t.Errorf("first signature: %v", err)
+	}
+	roots, err := ParseTrustBundle(certificatePEM(ca))
+	if err != nil {
+		t.Fatal(err)
+	}
+	verified, err = (&Verifier{Roots: roots}).Verify([]byte(jws))
+	if err != nil || verified.Key.Certificates[0].Subject.CommonName != "Signer" {
+		t.Errorf("second signature: %v", err)
+	}
+
+	// kid of the key selects the signature
+	rsaKeys := parseKeys(t, keys[0].publicPEM(t))
+	rsaKeys[0].KeyID = "rsa-2"
+	if _, err := (&Verifier{Keys: rsaKeys}).Verify([]byte(jws)); err == nil {
+		t.Error("signature verified by key of other id")
+	}
+
+	detached, err := SignDetached(testSSP(), signers...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(detached, "{") || strings.Contains(detached, `"payload"`) {
+		t.Errorf("unexpected detached signature %s", detached)
+	}
+	ssp := testSSP()
+	if err := SignBackMatter(ssp, signers...); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&Verifier{Roots: roots}).VerifyDocument(ssp); err != nil {
+		t.Errorf("back-matter signature: %v", err)
+	}
+
+	signed, err := SignXML(testDocument(), signers[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"<KeyName>ed-1</KeyName>", "<X509Certificate>"} {
+		if !strings.Contains(string(signed), expected) {
+			t.Errorf("missing %s in %s", expected, signed)
+		}
+	}
+	if _, err := (&Verifier{Roots: roots}).VerifyXML(signed); err != nil {
+		t.Errorf("XML signature: %v", err)
+	}
+}
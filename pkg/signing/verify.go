@@ -0,0 +1,158 @@
+package signing
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+// DefaultAlgorithms are the signature algorithms allowed by default. HMAC is
+// not allowed as the verification keys are public.
+var DefaultAlgorithms = []string{
+	string(jose.RS256), string(jose.RS384), string(jose.RS512),
+	string(jose.PS256), string(jose.PS384), string(jose.PS512),
+	string(jose.ES256), string(jose.ES384), string(jose.ES512),
+	string(jose.EdDSA),
+}
+
+// Verifier verifies JSON Web Signatures
+type Verifier struct {
+	// Keys are the trusted keys
+	Keys []*PublicKey
+	// Algorithms are the allowed algorithms, DefaultAlgorithms when empty
+	Algorithms []string
+	// Roots are the trusted certificate authorities. When set, keys with
+	// certificates are trusted only when the certificates chain to the
+	// roots, and signatures are verified by the certificates of their x5c
+	// headers as well.
+	Roots *x509.CertPool
+}
+
+// Verified is the result of a successful verification
+type Verified struct {
+	// Payload is the signed content
+	Payload []byte
+	// Algorithm of the verified signature
+	Algorithm string
+	// Key verifying the signature
+	Key *PublicKey
+}
+
+// Verify verifies JWS in compact or full serialization and returns the
+// payload. Verification succeeds when a signature of allowed algorithm is
+// verified by a trusted key.
+func (v *Verifier) Verify(jws []byte) (*Verified, error) {
+	obj, err := jose.ParseSigned(strings.TrimSpace(string(jws)))
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse signature: %v", err)
+	}
+	keys, errs := v.trustedKeys()
+	for i, sig := range obj.Signatures {
+		prefix := fmt.Sprintf("signature %d: ", i+1)
+		if !v.allowed(sig.Header.Algorithm) {
+			errs = append(errs, prefix+fmt.Sprintf("algorithm %s is not allowed", sig.Header.Algorithm))
+			continue
+		}
+		candidates := keys
+		if key, err := v.certificateKey(sig); err != nil {
+			errs = append(errs, prefix+err.Error())
+		} else if key != nil {
+			candidates = append([]*PublicKey{key}, keys...)
+		}
+		// signatures are verified one by one, so that the verified one is
+		// known to be of allowed algorithm
+		single := *obj
+		single.Signatures = []jose.Signature{sig}
+		for _, key := range candidates {
+			if !key.matches(sig.Header) {
+				continue
+			}
+			payload, err := single.Verify(key.Key)
+			if err == nil {
+				return &Verified{Payload: payload, Algorithm: sig.Header.Algorithm, Key: key}, nil
+			}
+		}
+		errs = append(errs, prefix+"not verified by any trusted key")
+	}
+	if len(obj.Signatures) == 0 {
+		errs = append(errs, "no signatures found")
+	}
+	return nil, errors.New("Verification failed: " + strings.Join(errs, "; "))
+}
+
+// trustedKeys returns the keys whose certificates are trusted by the roots
+func (v *Verifier) trustedKeys() ([]*PublicKey, []string) {
+	if v.Roots == nil {
+		return v.Keys, nil
+	}
+	var keys []*PublicKey
+	var errs []string
+	for _, key := range v.Keys {
+		if len(key.Certificates) > 0 {
+			if err := v.verifyChain(key.Certificates); err != nil {
+				errs = append(errs, fmt.Sprintf("%s is not trusted: %v", key, err))
+				continue
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys, errs
+}
+
+// certificateKey returns key of the certificate of x5c header, when the
+// certificate is trusted by the roots
+func (v *Verifier) certificateKey(sig jose.Signature) (*PublicKey, error) {
+	if v.Roots == nil {
+		return nil, nil
+	}
+	chains, err := sig.Header.Certificates(x509.VerifyOptions{Roots: v.Roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}})
+	if err != nil {
+		if strings.Contains(err.Error(), "no x5c header") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("x5c certificate is not trusted: %v", err)
+	}
+	key, err := newPublicKey(chains[0][0].PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	key.Certificates = chains[0]
+	return key, nil
+}
+
+func (v *Verifier) verifyChain(chain []*x509.Certificate) error {
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         v.Roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	return err
+}
+
+func (v *Verifier) allowed(alg string) bool {
+	algorithms := v.Algorithms
+	if len(algorithms) == 0 {
+		algorithms = DefaultAlgorithms
+	}
+	for _, a := range algorithms {
+		if a == alg {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether the key may verify signature with the header
+func (k *PublicKey) matches(h jose.Header) bool {
+	if k.KeyID != "" && h.KeyID != "" && k.KeyID != h.KeyID {
+		return false
+	}
+	return k.Algorithm == "" || k.Algorithm == h.Algorithm
+}
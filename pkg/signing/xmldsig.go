@@ -0,0 +1,292 @@
+package signing
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/types/oscal"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+// Algorithms of XML Signature
+const (
+	dsigNamespace      = "http://www.w3.org/2000/09/xmldsig#"
+	exclusiveC14N      = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	envelopedSignature = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+)
+
+// xmlSignatureMethods are the XML Signature algorithms of the JWS algorithms
+var xmlSignatureMethods = map[string]string{
+	"RS256": "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256",
+	"RS384": "http://www.w3.org/2001/04/xmldsig-more#rsa-sha384",
+	"RS512": "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512",
+	"PS256": "http://www.w3.org/2007/05/xmldsig-more#sha256-rsa-MGF1",
+	"PS384": "http://www.w3.org/2007/05/xmldsig-more#sha384-rsa-MGF1",
+	"PS512": "http://www.w3.org/2007/05/xmldsig-more#sha512-rsa-MGF1",
+	"ES256": "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256",
+	"ES384": "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha384",
+	"ES512": "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha512",
+	"EdDSA": "http://www.w3.org/2021/04/xmldsig-more#eddsa-ed25519",
+}
+
+// xmlDigestMethods are the XML Signature digest algorithms
+var xmlDigestMethods = map[crypto.Hash]string{
+	crypto.SHA256: "http://www.w3.org/2001/04/xmlenc#sha256",
+	crypto.SHA384: "http://www.w3.org/2001/04/xmldsig-more#sha384",
+	crypto.SHA512: "http://www.w3.org/2001/04/xmlenc#sha512",
+}
+
+// signatureTemplate is the enveloped signature, indented as child of the
+// root element
+const signatureTemplate = `  <Signature xmlns="` + dsigNamespace + `">
+    <SignedInfo>
+      <CanonicalizationMethod Algorithm="` + exclusiveC14N + `"></CanonicalizationMethod>
+      <SignatureMethod Algorithm="%s"></SignatureMethod>
+      <Reference URI="">
+        <Transforms>
+          <Transform Algorithm="` + envelopedSignature + `"></Transform>
+          <Transform Algorithm="` + exclusiveC14N + `"></Transform>
+        </Transforms>
+        <DigestMethod Algorithm="%s"></DigestMethod>
+        <DigestValue>%s</DigestValue>
+      </Reference>
+    </SignedInfo>
+    <SignatureValue>%s</SignatureValue>%s
+  </Signature>
+`
+
+// SignXML returns the document in canonical XML form signed by enveloped XML
+// Signature. The signature is the last child of the root element and
+// references the whole document, canonicalized by Exclusive XML
+// Canonicalization. The key id and certificates of the signer are written
+// to KeyInfo of the signature.
+func SignXML(o *oscal.OSCAL, signer *Signer) ([]byte, error) {
+	if err := signer.Validate(); err != nil {
+		return nil, err
+	}
+	alg := signer.Algorithm
+	method, ok := xmlSignatureMethods[alg]
+	if !ok {
+		return nil, fmt.Errorf("Algorithm %s is not supported by XML Signature", alg)
+	}
+	hash := hashes[alg]
+	keyInfo := signer.keyInfo()
+	var b bytes.Buffer
+	if err := o.Canonical(&b, constants.XmlFormat); err != nil {
+		return nil, err
+	}
+	content := b.Bytes()
+	end := bytes.LastIndex(content, []byte("</"))
+	if end < 0 {
+		return nil, errors.New("Cannot sign empty document")
+	}
+	withSignature := func(signature string) []byte {
+		var doc bytes.Buffer
+		doc.Write(content[:end])
+		doc.WriteString(signature)
+		doc.Write(content[end:])
+		return doc.Bytes()
+	}
+
+	// the digest is computed without the signature but with its indentation
+	doc, err := parseXML(withSignature(fmt.Sprintf(signatureTemplate, method, xmlDigestMethods[hash], "", "", keyInfo)))
+	if err != nil {
+		return nil, err
+	}
+	signature := doc.root.child(dsigNamespace, "Signature")
+	digest := base64.StdEncoding.EncodeToString(digestOf(hash, canonicalDocument(doc, signature)))
+
+	doc, err = parseXML(withSignature(fmt.Sprintf(signatureTemplate, method, xmlDigestMethods[hash], digest, "", keyInfo)))
+	if err != nil {
+		return nil, err
+	}
+	signedInfo := doc.root.child(dsigNamespace, "Signature").child(dsigNamespace, "SignedInfo")
+	value, err := signData(signer.Key, alg, canonicalElement(signedInfo))
+	if err != nil {
+		return nil, err
+	}
+	return withSignature(fmt.Sprintf(signatureTemplate, method, xmlDigestMethods[hash], digest, base64.StdEncoding.EncodeToString(value), keyInfo)), nil
+}
+
+// keyInfo returns KeyInfo element of the signer, indented as child of the
+// signature
+func (s *Signer) keyInfo() string {
+	if s.KeyID == "" && len(s.Certificates) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("\n    <KeyInfo>")
+	if s.KeyID != "" {
+		b.WriteString("\n      <KeyName>" + escapeText(s.KeyID) + "</KeyName>")
+	}
+	if len(s.Certificates) > 0 {
+		b.WriteString("\n      <X509Data>")
+		for _, cert := range s.x5c() {
+			b.WriteString("\n        <X509Certificate>" + cert + "</X509Certificate>")
+		}
+		b.WriteString("\n      </X509Data>")
+	}
+	b.WriteString("\n    </KeyInfo>")
+	return b.String()
+}
+
+type xmlAlgorithm struct {
+	Algorithm string `xml:"Algorithm,attr"`
+}
+
+type xmlSignedInfo struct {
+	CanonicalizationMethod xmlAlgorithm
+	SignatureMethod        xmlAlgorithm
+	References             []struct {
+		URI          string         `xml:"URI,attr"`
+		Transforms   []xmlAlgorithm `xml:"Transforms>Transform"`
+		DigestMethod xmlAlgorithm
+		DigestValue  string
+	} `xml:"Reference"`
+}
+
+// VerifyXML verifies enveloped XML Signature of the document. Only
+// signatures of the whole document as made by SignXML are accepted: the
+// signature must be child of the root element, have single reference to the
+// document, transformed by the enveloped signature transform and Exclusive
+// XML Canonicalization. The payload of the result is the canonicalized
+// document without the signature.
+func (v *Verifier) VerifyXML(data []byte) (*Verified, error) {
+	doc, err := parseXML(data)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse XML: %v", err)
+	}
+	var signature *element
+	for _, c := range doc.root.children {
+		if e, ok := c.(*element); ok && e.name.Local == "Signature" && e.namespace(e.name.Space) == dsigNamespace {
+			if signature != nil {
+				return nil, errors.New("Verification failed: multiple XML signatures")
+			}
+			signature = e
+		}
+	}
+	if signature == nil {
+		return nil, ErrNoSignature
+	}
+	signedInfoElement := signature.child(dsigNamespace, "SignedInfo")
+	valueElement := signature.child(dsigNamespace, "SignatureValue")
+	if signedInfoElement == nil || valueElement == nil {
+		return nil, errors.New("Verification failed: incomplete XML signature")
+	}
+	signedInfo := canonicalElement(signedInfoElement)
+	var info xmlSignedInfo
+	if err := xml.Unmarshal(signedInfo, &info); err != nil {
+		return nil, fmt.Errorf("Cannot parse XML signature: %v", err)
+	}
+	if info.CanonicalizationMethod.Algorithm != exclusiveC14N {
+		return nil, fmt.Errorf("Verification failed: unsupported canonicalization %s", info.CanonicalizationMethod.Algorithm)
+	}
+	if len(info.References) != 1 || info.References[0].URI != "" {
+		return nil, errors.New("Verification failed: signature must reference the whole document")
+	}
+	ref := info.References[0]
+	if len(ref.Transforms) != 2 || ref.Transforms[0].Algorithm != envelopedSignature || ref.Transforms[1].Algorithm != exclusiveC14N {
+		return nil, errors.New("Verification failed: unsupported transforms, expected enveloped signature and exclusive canonicalization")
+	}
+	alg := ""
+	for a, method := range xmlSignatureMethods {
+		if method == info.SignatureMethod.Algorithm {
+			alg = a
+		}
+	}
+	if alg == "" {
+		return nil, fmt.Errorf("Verification failed: unsupported signature method %s", info.SignatureMethod.Algorithm)
+	}
+	if !v.allowed(alg) {
+		return nil, fmt.Errorf("Verification failed: algorithm %s is not allowed", alg)
+	}
+
+	var hash crypto.Hash
+	for h, method := range xmlDigestMethods {
+		if method == ref.DigestMethod.Algorithm {
+			hash = h
+		}
+	}
+	if hash == 0 {
+		return nil, fmt.Errorf("Verification failed: unsupported digest method %s", ref.DigestMethod.Algorithm)
+	}
+	payload := canonicalDocument(doc, signature)
+	digest, err := decodeBase64(ref.DigestValue)
+	if err != nil || !bytes.Equal(digest, digestOf(hash, payload)) {
+		return nil, errors.New("Verification failed: digest of the document does not match")
+	}
+
+	value, err := decodeBase64(valueElement.text())
+	if err != nil {
+		return nil, fmt.Errorf("Cannot decode signature value: %v", err)
+	}
+	keys, errs := v.trustedKeys()
+	keyName := ""
+	if keyInfo := signature.child(dsigNamespace, "KeyInfo"); keyInfo != nil {
+		if name := keyInfo.child(dsigNamespace, "KeyName"); name != nil {
+			keyName = strings.TrimSpace(name.text())
+		}
+		key, err := v.keyInfoCertificate(keyInfo)
+		if err != nil {
+			errs = append(errs, err.Error())
+		} else if key != nil {
+			keys = append([]*PublicKey{key}, keys...)
+		}
+	}
+	for _, key := range keys {
+		if !key.matches(jose.Header{KeyID: keyName, Algorithm: alg}) {
+			continue
+		}
+		if verifyData(key.Key, alg, signedInfo, value) == nil {
+			return &Verified{Payload: payload, Algorithm: alg, Key: key}, nil
+		}
+	}
+	errs = append(errs, "XML signature not verified by any trusted key")
+	return nil, errors.New("Verification failed: " + strings.Join(errs, "; "))
+}
+
+// keyInfoCertificate returns key of the certificates of KeyInfo, when the
+// certificates are trusted by the roots
+func (v *Verifier) keyInfoCertificate(keyInfo *element) (*PublicKey, error) {
+	data := keyInfo.child(dsigNamespace, "X509Data")
+	if v.Roots == nil || data == nil {
+		return nil, nil
+	}
+	var chain []*x509.Certificate
+	for _, c := range data.children {
+		if e, ok := c.(*element); ok && e.name.Local == "X509Certificate" && e.namespace(e.name.Space) == dsigNamespace {
+			der, err := decodeBase64(e.text())
+			if err != nil {
+				return nil, fmt.Errorf("Cannot decode certificate: %v", err)
+			}
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				return nil, fmt.Errorf("Cannot parse certificate: %v", err)
+			}
+			chain = append(chain, cert)
+		}
+	}
+	if len(chain) == 0 {
+		return nil, nil
+	}
+	if err := v.verifyChain(chain); err != nil {
+		return nil, fmt.Errorf("KeyInfo certificate is not trusted: %v", err)
+	}
+	key, err := newPublicKey(chain[0].PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	key.Certificates = chain
+	return key, nil
+}
+
+func decodeBase64(value string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value), ""))
+}
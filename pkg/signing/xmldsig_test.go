@@ -0,0 +1,183 @@
+package signing
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/types/oscal"
+)
+
+func TestCanonicalXML(t *testing.T) {
+	input := `<?xml version="1.0" encoding="UTF-8"?>
+<!-- comment -->
+<?xml-stylesheet href="doc.xsl"?>
+<doc xmlns="http://example.com/default" xmlns:a="http://example.com/a" xmlns:unused="http://example.com/unused">
+  <e1 b="2" a:c="3" a="1 &amp; &quot;x&quot;"/>
+  <a:e2><e3 xmlns="">text &lt;&gt; <![CDATA[<cdata>]]></e3><!-- comment --></a:e2>
+  <e4 xmlns="http://example.com/default" xmlns:a="http://example.com/a2" a:d="4"/>
+</doc>`
+	expected := `<?xml-stylesheet href="doc.xsl"?>
+<doc xmlns="http://example.com/default">
+  <e1 xmlns:a="http://example.com/a" a="1 &amp; &quot;x&quot;" b="2" a:c="3"></e1>
+  <a:e2 xmlns:a="http://example.com/a"><e3 xmlns="">text &lt;&gt; &lt;cdata&gt;</e3></a:e2>
+  <e4 xmlns:a="http://example.com/a2" a:d="4"></e4>
+</doc>`
+	doc, err := parseXML([]byte(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := string(canonicalDocument(doc, nil)); c != expected {
+		t.Errorf("unexpected canonical form:\n%s\nexpected:\n%s", c, expected)
+	}
+}
+
+func TestNormalizeAttributes(t *testing.T) {
+	for input, expected := range map[string]string{
+		"<a b=\"1\n2\t3\r\n4\" c='\"\n'>\n</a>":               "<a b=\"1 2 3 4\" c='\" '>\n</a>",
+		"<a b=\"&#10;\"/>":                                    "<a b=\"&#10;\"/>",
+		"<!DOCTYPE a [\n<!ENTITY e \"x\n\">\n]>\n<a b='\n'/>": "<!DOCTYPE a [\n<!ENTITY e \"x\n\">\n]>\n<a b=' '/>",
+		"<a><!-- \"\n --><![CDATA[\"\n]]><?pi \"\n?>\"\n</a>": "<a><!-- \"\n --><![CDATA[\"\n]]><?pi \"\n?>\"\n</a>",
+	} {
+		if normalized := string(normalizeAttributes([]byte(input))); normalized != expected {
+			t.Errorf("%q normalized to %q, expected %q", input, normalized, expected)
+		}
+	}
+}
+
+func TestSignXML(t *testing.T) {
+	for _, k := range testKeys(t) {
+		signed, err := SignXML(testDocument(), k.signer())
+		if err != nil {
+			t.Fatalf("%s: %v", k.alg, err)
+		}
+		// the signed document remains readable
+		o, err := oscal.New(bytes.NewReader(signed))
+		if err != nil || o.Catalog == nil || o.Catalog.Id != "c" {
+			t.Fatalf("%s: signed document cannot be read: %v", k.alg, err)
+		}
+
+		v := &Verifier{Keys: parseKeys(t, k.publicPEM(t))}
+		verified, err := v.VerifyXML(signed)
+		if err != nil {
+			t.Fatalf("%s: %v", k.alg, err)
+		}
+		if verified.Algorithm != string(k.alg) || bytes.Contains(verified.Payload, []byte("Signature")) {
+			t.Errorf("%s: unexpected result %s %s", k.alg, verified.Algorithm, verified.Payload)
+		}
+
+		// prefixed namespaces and attribute order do not matter
+		reformatted := strings.Replace(string(signed), `<catalog xmlns="http://csrc.nist.gov/ns/oscal/1.0" id="c">`, `<catalog id="c" xmlns="http://csrc.nist.gov/ns/oscal/1.0" xmlns:unused="urn:x">`, 1)
+		if reformatted == string(signed) {
+			t.Fatalf("unexpected root element %s", signed[:200])
+		}
+		if _, err := v.VerifyXML([]byte(reformatted)); err != nil {
+			t.Errorf("%s: reformatted document: %v", k.alg, err)
+		}
+
+		tampered := bytes.Replace(signed, []byte("Develop a"), []byte("Develop no"), 1)
+		if _, err := v.VerifyXML(tampered); err == nil || !strings.Contains(err.Error(), "digest") {
+			t.Errorf("%s: tampered document verified: %v", k.alg, err)
+		}
+		tampered = bytes.Replace(signed, []byte(`URI=""`), []byte(`URI="#c"`), 1)
+		if _, err := v.VerifyXML(tampered); err == nil {
+			t.Errorf("%s: tampered signature verified", k.alg)
+		}
+		if _, err := (&Verifier{Keys: parseKeys(t, testKeys(t)[3].publicPEM(t))}).VerifyXML(signed); err == nil {
+			t.Errorf("%s: verified by other key", k.alg)
+		}
+	}
+
+	var b bytes.Buffer
+	if err := testDocument().XML(&b, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&Verifier{}).VerifyXML(b.Bytes()); err != ErrNoSignature {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+// TestVerifyXMLReference verifies the fixture signed by reference tools: the
+// document and SignedInfo canonicalized by libxml2, as used by xmlsec1, and
+// signed by OpenSSL. See testdata/xmldsig/README.md.
+func TestVerifyXMLReference(t *testing.T) {
+	read := func(name string) []byte {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", "xmldsig", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	v := &Verifier{Keys: parseKeys(t, read("public.pem"))}
+	verified, err := v.VerifyXML(read("signed.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := read("canonical.xml"); !bytes.Equal(verified.Payload, expected) {
+		t.Errorf("canonical form differs from libxml2:\n%s\nexpected:\n%s", verified.Payload, expected)
+	}
+}
+
+// TestSignXMLInterop verifies the signatures of SignXML by xmlsec1 and
+// compares the canonical form with xmllint, when they are installed
+func TestSignXMLInterop(t *testing.T) {
+	xmlsec, xmlsecErr := exec.LookPath("xmlsec1")
+	xmllint, xmllintErr := exec.LookPath("xmllint")
+	if xmlsecErr != nil && xmllintErr != nil {
+		t.Skip("xmlsec1 and xmllint are not installed")
+	}
+	dir, err := ioutil.TempDir("", "xmldsig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// RSASSA-PSS and Ed25519 are not supported by all xmlsec1 versions
+	keys := testKeys(t)
+	for _, k := range []testKey{keys[0], keys[2]} {
+		// xmlsec1 1.3 finds the key by its name only
+		signer := k.signer()
+		signer.KeyID = "oscalkit"
+		signed, err := SignXML(testDocument(), signer)
+		if err != nil {
+			t.Fatalf("%s: %v", k.alg, err)
+		}
+		signedFile := filepath.Join(dir, "signed.xml")
+		keyFile := filepath.Join(dir, "public.pem")
+		if err := ioutil.WriteFile(signedFile, signed, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(keyFile, k.publicPEM(t), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if xmlsecErr == nil {
+			if out, err := exec.Command(xmlsec, "--verify", "--pubkey-pem:oscalkit", keyFile, signedFile).CombinedOutput(); err != nil {
+				t.Errorf("%s: xmlsec1 does not verify the signature: %v\n%s", k.alg, err, out)
+			}
+		}
+		if xmllintErr == nil {
+			// the enveloped signature transform removes the signature only
+			start := bytes.Index(signed, []byte("<Signature "))
+			end := bytes.Index(signed, []byte("</Signature>")) + len("</Signature>")
+			unsigned := append(append([]byte{}, signed[:start]...), signed[end:]...)
+			if err := ioutil.WriteFile(signedFile, unsigned, 0644); err != nil {
+				t.Fatal(err)
+			}
+			expected, err := exec.Command(xmllint, "--exc-c14n", signedFile).Output()
+			if err != nil {
+				t.Fatalf("xmllint: %v", err)
+			}
+			verified, err := (&Verifier{Keys: parseKeys(t, k.publicPEM(t))}).VerifyXML(signed)
+			if err != nil {
+				t.Fatalf("%s: %v", k.alg, err)
+			}
+			if !bytes.Equal(verified.Payload, expected) {
+				t.Errorf("%s: canonical form differs from xmllint:\n%s\nexpected:\n%s", k.alg, verified.Payload, expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package upgrade
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/docker/oscalkit/pkg/validation"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Object is JSON object that keeps order of its members. Values of the
+// members are *Object, []interface{}, string, json.Number, bool or nil.
+type Object struct {
+	Members []Member
+}
+
+// Member of JSON object
+type Member struct {
+	Key   string
+	Value interface{}
+}
+
+// Get returns value of the member
+func (o *Object) Get(key string) (interface{}, bool) {
+	for _, m := range o.Members {
+		if m.Key == key {
+			return m.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Set sets value of the member, new members are appended
+func (o *Object) Set(key string, value interface{}) {
+	for i, m := range o.Members {
+		if m.Key == key {
+			o.Members[i].Value = value
+			return
+		}
+	}
+	o.Members = append(o.Members, Member{key, value})
+}
+
+// Delete removes the member
+func (o *Object) Delete(key string) {
+	for i, m := range o.Members {
+		if m.Key == key {
+			o.Members = append(o.Members[:i], o.Members[i+1:]...)
+			return
+		}
+	}
+}
+
+// Rename renames the member keeping its position
+func (o *Object) Rename(key, newKey string) {
+	for i, m := range o.Members {
+		if m.Key == key {
+			o.Members[i].Key = newKey
+			return
+		}
+	}
+}
+
+// WalkObjects calls fn for the objects of the JSON value with their JSON
+// pointers. Descendants of an object are visited after fn returns, so fn may
+// change them.
+func WalkObjects(value interface{}, pointer string, fn func(o *Object, pointer string)) {
+	switch v := value.(type) {
+	case *Object:
+		fn(v, pointer)
+		for _, m := range v.Members {
+			WalkObjects(m.Value, pointer+"/"+validation.EscapePointer(m.Key), fn)
+		}
+	case []interface{}:
+		for i, item := range v {
+			WalkObjects(item, fmt.Sprintf("%s/%d", pointer, i), fn)
+		}
+	}
+}
+
+func parseJSON(data []byte) (*Object, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	value, err := decodeJSON(d)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after top-level object")
+	}
+	o, ok := value.(*Object)
+	if !ok {
+		return nil, fmt.Errorf("top-level value is not an object")
+	}
+	return o, nil
+}
+
+func decodeJSON(d *json.Decoder) (interface{}, error) {
+	token, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case json.Delim('{'):
+		o := &Object{}
+		for d.More() {
+			key, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeJSON(d)
+			if err != nil {
+				return nil, err
+			}
+			o.Members = append(o.Members, Member{key.(string), value})
+		}
+		_, err = d.Token()
+		return o, err
+	case json.Delim('['):
+		a := []interface{}{}
+		for d.More() {
+			item, err := decodeJSON(d)
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, item)
+		}
+		_, err = d.Token()
+		return a, err
+	}
+	return token, nil
+}
+
+// parseYAML parses YAML document into JSON values keeping order of the keys
+func parseYAML(data []byte) (*Object, error) {
+	var doc yaml.MapSlice
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	value, err := fromYAML(doc)
+	if err != nil {
+		return nil, err
+	}
+	return value.(*Object), nil
+}
+
+func fromYAML(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case yaml.MapSlice:
+		o := &Object{}
+		for _, item := range v {
+			key, ok := item.Key.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported key %v", item.Key)
+			}
+			member, err := fromYAML(item.Value)
+			if err != nil {
+				return nil, err
+			}
+			o.Members = append(o.Members, Member{key, member})
+		}
+		return o, nil
+	case []interface{}:
+		a := make([]interface{}, len(v))
+		for i, item := range v {
+			var err error
+			if a[i], err = fromYAML(item); err != nil {
+				return nil, err
+			}
+		}
+		return a, nil
+	case int, int64, uint64, float64:
+		return json.Number(fmt.Sprint(v)), nil
+	}
+	return value, nil
+}
+
+// writeJSON writes the value indented by two spaces
+func writeJSON(w *bytes.Buffer, value interface{}, indent string) error {
+	switch v := value.(type) {
+	case *Object:
+		if len(v.Members) == 0 {
+			w.WriteString("{}")
+			return nil
+		}
+		w.WriteString("{")
+		for i, m := range v.Members {
+			if i > 0 {
+				w.WriteString(",")
+			}
+			w.WriteString("\n" + indent + "  ")
+			if err := writeJSONScalar(w, m.Key); err != nil {
+				return err
+			}
+			w.WriteString(": ")
+			if err := writeJSON(w, m.Value, indent+"  "); err != nil {
+				return err
+			}
+		}
+		w.WriteString("\n" + indent + "}")
+	case []interface{}:
+		if len(v) == 0 {
+			w.WriteString("[]")
+			return nil
+		}
+		w.WriteString("[")
+		for i, item := range v {
+			if i > 0 {
+				w.WriteString(",")
+			}
+			w.WriteString("\n" + indent + "  ")
+			if err := writeJSON(w, item, indent+"  "); err != nil {
+				return err
+			}
+		}
+		w.WriteString("\n" + indent + "]")
+	default:
+		return writeJSONScalar(w, v)
+	}
+	return nil
+}
+
+func writeJSONScalar(w *bytes.Buffer, value interface{}) error {
+	var b bytes.Buffer
+	e := json.NewEncoder(&b)
+	e.SetEscapeHTML(false)
+	if err := e.Encode(value); err != nil {
+		return err
+	}
+	w.Write(bytes.TrimRight(b.Bytes(), "\n"))
+	return nil
+}
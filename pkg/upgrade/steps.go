@@ -0,0 +1,160 @@
+package upgrade
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// steps are the registered steps, starting with the built-in ones
+var steps = []Step{
+	{
+		From:        "1.0.0-milestone2",
+		To:          "1.0.0-milestone3",
+		Description: "annotations become properties and properties carry their value in the value attribute",
+		XML:         propertiesXML,
+		JSON:        propertiesJSON,
+	},
+	{
+		From:        "1.0.0-milestone3",
+		To:          "1.0.0-rc1",
+		Description: "controls are selected by with-id (XML) and with-ids (JSON) instead of call and id-selectors",
+		XML:         withIDsXML,
+		JSON:        withIDsJSON,
+	},
+}
+
+func propertiesXML(c *Context, root *Element) {
+	root.Walk("/"+root.Name.Local, func(e *Element, path string) {
+		switch e.Name.Local {
+		case "annotation":
+			e.Name.Local = "prop"
+			c.Changed(path, "Converted annotation to prop")
+		case "prop":
+			if _, ok := e.GetAttr("value"); ok || e.HasElements() {
+				return
+			}
+			e.SetAttr("value", strings.TrimSpace(e.Text()))
+			e.Children = nil
+			c.Changed(path, "Moved value of prop to value attribute")
+		}
+	})
+}
+
+func propertiesJSON(c *Context, root *Object) {
+	WalkObjects(root, "", func(o *Object, pointer string) {
+		value, ok := o.Get("annotations")
+		if !ok {
+			return
+		}
+		annotations, ok := value.([]interface{})
+		if !ok {
+			c.Skipped(pointer+"/annotations", "Annotations are not an array")
+			return
+		}
+		var properties []interface{}
+		if value, ok := o.Get("properties"); ok {
+			if properties, ok = value.([]interface{}); !ok {
+				c.Skipped(pointer+"/properties", "Properties are not an array")
+				return
+			}
+		}
+		o.Set("properties", append(properties, annotations...))
+		o.Delete("annotations")
+		c.Changed(pointer+"/annotations", "Moved %d annotations to properties", len(annotations))
+	})
+}
+
+// selections are the elements selecting controls by call
+var selections = map[string]bool{"include": true, "exclude": true}
+
+func withIDsXML(c *Context, root *Element) {
+	root.Walk("/"+root.Name.Local, func(e *Element, path string) {
+		if !selections[e.Name.Local] {
+			return
+		}
+		calls := e.Elements("call")
+		if len(calls) == 0 {
+			return
+		}
+		var values []string
+		for _, call := range calls {
+			value, _ := call.GetAttr("with-child-controls")
+			values = append(values, value)
+		}
+		withChildControls, ok := uniform(values)
+		if !ok {
+			c.Skipped(path, "Calls with different with-child-controls cannot be converted to with-id")
+			return
+		}
+		for _, call := range calls {
+			id, _ := call.GetAttr("control-id")
+			call.Name = xml.Name{Space: call.Name.Space, Local: "with-id"}
+			call.Attr = nil
+			call.SetText(id)
+		}
+		if withChildControls != "" {
+			e.SetAttr("with-child-controls", withChildControls)
+		}
+		c.Changed(path, "Converted %d calls to with-id", len(calls))
+	})
+}
+
+func withIDsJSON(c *Context, root *Object) {
+	WalkObjects(root, "", func(o *Object, pointer string) {
+		for _, m := range o.Members {
+			selection, ok := m.Value.(*Object)
+			if !ok || !selections[m.Key] {
+				continue
+			}
+			path := pointer + "/" + m.Key
+			value, ok := selection.Get("id-selectors")
+			if !ok {
+				continue
+			}
+			calls, ok := value.([]interface{})
+			if !ok {
+				c.Skipped(path+"/id-selectors", "Id selectors are not an array")
+				continue
+			}
+			var ids []interface{}
+			var values []string
+			for _, item := range calls {
+				call, ok := item.(*Object)
+				if !ok {
+					c.Skipped(path+"/id-selectors", "Id selector is not an object")
+					return
+				}
+				id, _ := call.Get("controlId")
+				value, _ := call.Get("withChildControls")
+				ids = append(ids, id)
+				values = append(values, stringValue(value))
+			}
+			withChildControls, ok := uniform(values)
+			if !ok {
+				c.Skipped(path, "Id selectors with different withChildControls cannot be converted to with-ids")
+				continue
+			}
+			selection.Rename("id-selectors", "with-ids")
+			selection.Set("with-ids", ids)
+			if withChildControls != "" {
+				selection.Set("withChildControls", withChildControls)
+			}
+			c.Changed(path, "Converted %d id-selectors to with-ids", len(calls))
+		}
+	})
+}
+
+// uniform returns the value when all values are equal
+func uniform(values []string) (string, bool) {
+	for _, v := range values[1:] {
+		if v != values[0] {
+			return "", false
+		}
+	}
+	return values[0], true
+}
+
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
@@ -0,0 +1,285 @@
+// Package upgrade migrates OSCAL documents between versions of the OSCAL
+// models. Each step migrates documents from one version to the next one and
+// steps are chained to migrate across several versions. Documents are
+// migrated as generic XML and JSON trees, so that elements unknown to the
+// step are kept as they are.
+//
+// JSON and YAML documents are in the JSON form of the oscalkit models: flags
+// are keyed in lower camel case, such as controlId and oscalVersion, and
+// repeated members are arrays. The OSCAL JSON schemas key flags by their XML
+// names and write single members as objects, so upgraded JSON and YAML
+// documents are not valid against the JSON schemas.
+package upgrade
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/validation"
+	"github.com/docker/oscalkit/pkg/yaml_json"
+)
+
+// Change is a single modification of the document made by a step
+type Change struct {
+	// Step is the version transition, such as 1.0.0-milestone2 -> 1.0.0-milestone3
+	Step string `json:"step"`
+	// Path is XPath (XML) or JSON pointer (JSON and YAML) of the modified element
+	Path    string `json:"path"`
+	Message string `json:"message"`
+	// Skipped is true when the step could not migrate the element
+	Skipped bool `json:"skipped,omitempty"`
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s: %s", c.Path, c.Message)
+	if c.Skipped {
+		s = "skipped " + s
+	}
+	return s
+}
+
+// Step migrates documents from version From to version To
+type Step struct {
+	From        string
+	To          string
+	Description string
+	// XML migrates root element of XML document
+	XML func(c *Context, root *Element)
+	// JSON migrates top-level object of JSON and YAML document
+	JSON func(c *Context, root *Object)
+}
+
+func (s *Step) String() string {
+	return s.From + " -> " + s.To
+}
+
+// Context is passed to the migrating step
+type Context struct {
+	step    *Step
+	changes []Change
+}
+
+// Changed records modification of the element
+func (c *Context) Changed(path, format string, args ...interface{}) {
+	c.changes = append(c.changes, Change{Step: c.step.String(), Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// Skipped records element the step cannot migrate
+func (c *Context) Skipped(path, format string, args ...interface{}) {
+	c.changes = append(c.changes, Change{Step: c.step.String(), Path: path, Message: fmt.Sprintf(format, args...), Skipped: true})
+}
+
+// Register adds migration step. Steps must form a single chain of versions.
+func Register(s Step) error {
+	if s.From == "" || s.To == "" || s.XML == nil || s.JSON == nil {
+		return fmt.Errorf("step must have versions and migrate both XML and JSON")
+	}
+	for _, existing := range steps {
+		if existing.From == s.From {
+			return fmt.Errorf("step from %s is already registered", s.From)
+		}
+	}
+	steps = append(steps, s)
+	return nil
+}
+
+// Steps returns the registered steps in order of registration
+func Steps() []Step {
+	return append([]Step(nil), steps...)
+}
+
+// Versions returns the versions known to the steps from the oldest
+func Versions() []string {
+	from, to := map[string]bool{}, map[string]string{}
+	for _, s := range steps {
+		from[s.To] = true
+		to[s.From] = s.To
+	}
+	var versions []string
+	for _, s := range steps {
+		if !from[s.From] {
+			for v := s.From; v != ""; v = to[v] {
+				versions = append(versions, v)
+			}
+		}
+	}
+	return versions
+}
+
+// Latest returns the newest version documents can be upgraded to
+func Latest() string {
+	versions := Versions()
+	if len(versions) == 0 {
+		return ""
+	}
+	return versions[len(versions)-1]
+}
+
+// Plan returns chain of steps migrating documents from version to version
+func Plan(from, to string) ([]Step, error) {
+	var plan []Step
+	for v := from; v != to; {
+		if len(plan) > len(steps) {
+			return nil, fmt.Errorf("Steps from OSCAL version %s form a cycle", from)
+		}
+		next := -1
+		for i := range steps {
+			if steps[i].From == v {
+				next = i
+			}
+		}
+		if next < 0 {
+			return nil, fmt.Errorf("Cannot upgrade from OSCAL version %s to %s, known versions are %s", from, to, strings.Join(Versions(), ", "))
+		}
+		plan = append(plan, steps[next])
+		v = steps[next].To
+	}
+	return plan, nil
+}
+
+// Document is OSCAL document being migrated
+type Document struct {
+	Format constants.DocumentFormat
+	xml    *xmlDocument
+	json   *Object
+}
+
+// Parse parses XML, JSON or YAML document
+func Parse(data []byte) (*Document, error) {
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		doc, err := parseXML(data)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot parse XML: %v", err)
+		}
+		return &Document{Format: constants.XmlFormat, xml: doc}, nil
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		o, err := parseJSON(data)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot parse JSON: %v", err)
+		}
+		return &Document{Format: constants.JsonFormat, json: o}, nil
+	}
+	o, err := parseYAML(data)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse YAML: %v", err)
+	}
+	return &Document{Format: constants.YamlFormat, json: o}, nil
+}
+
+// Bytes returns the document in its format
+func (d *Document) Bytes() ([]byte, error) {
+	var b bytes.Buffer
+	if d.xml != nil {
+		d.xml.write(&b)
+		return b.Bytes(), nil
+	}
+	if err := writeJSON(&b, d.json, ""); err != nil {
+		return nil, err
+	}
+	b.WriteString("\n")
+	if d.Format == constants.YamlFormat {
+		return yaml_json.FromJSON(b.Bytes())
+	}
+	return b.Bytes(), nil
+}
+
+// Version returns OSCAL version declared in metadata of the document
+func (d *Document) Version() string {
+	if e := d.xmlVersion(); e != nil {
+		return strings.TrimSpace(e.Text())
+	}
+	if m := d.jsonMetadata(); m != nil {
+		if v, ok := m.Get("oscalVersion"); ok {
+			if s, ok := v.(string); ok {
+				return strings.TrimSpace(s)
+			}
+		}
+	}
+	return ""
+}
+
+// SetVersion sets OSCAL version in metadata of the document. It returns
+// false when the document has no metadata.
+func (d *Document) SetVersion(version string) bool {
+	if d.xml != nil {
+		if e := d.xmlVersion(); e != nil {
+			e.SetText(version)
+			return true
+		}
+		for _, m := range d.xml.root.Elements("metadata") {
+			m.Children = append(m.Children, &Element{Name: xml.Name{Space: m.Name.Space, Local: "oscal-version"}, Children: []interface{}{xml.CharData(version)}})
+			return true
+		}
+		return false
+	}
+	if m := d.jsonMetadata(); m != nil {
+		m.Set("oscalVersion", version)
+		return true
+	}
+	return false
+}
+
+func (d *Document) xmlVersion() *Element {
+	if d.xml == nil {
+		return nil
+	}
+	for _, m := range d.xml.root.Elements("metadata") {
+		for _, v := range m.Elements("oscal-version") {
+			return v
+		}
+	}
+	return nil
+}
+
+func (d *Document) jsonMetadata() *Object {
+	if d.json == nil || len(d.json.Members) != 1 {
+		return nil
+	}
+	root, ok := d.json.Members[0].Value.(*Object)
+	if !ok {
+		return nil
+	}
+	m, _ := root.Get("metadata")
+	metadata, _ := m.(*Object)
+	return metadata
+}
+
+// versionPath returns path of the OSCAL version in the document
+func (d *Document) versionPath() string {
+	if d.xml != nil {
+		return "/" + d.xml.root.Name.Local + "/metadata[1]/oscal-version[1]"
+	}
+	return "/" + validation.EscapePointer(d.json.Members[0].Key) + "/metadata/oscalVersion"
+}
+
+// Upgrade migrates the document to the version and returns the changes.
+// The document is migrated from the version declared in its metadata.
+func Upgrade(d *Document, to string) ([]Change, error) {
+	from := d.Version()
+	if from == "" {
+		return nil, fmt.Errorf("Document does not declare its OSCAL version")
+	}
+	plan, err := Plan(from, to)
+	if err != nil {
+		return nil, err
+	}
+	var changes []Change
+	for i := range plan {
+		step := &plan[i]
+		c := &Context{step: step}
+		if d.xml != nil {
+			step.XML(c, d.xml.root)
+		} else {
+			step.JSON(c, d.json)
+		}
+		d.SetVersion(step.To)
+		c.Changed(d.versionPath(), "Changed OSCAL version to %s", step.To)
+		changes = append(changes, c.changes...)
+	}
+	return changes, nil
+}
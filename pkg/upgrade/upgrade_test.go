@@ -0,0 +1,167 @@
+package upgrade
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/pkg/bundled"
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/xml_validation"
+)
+
+var update = flag.Bool("update", false, "update golden files of the steps")
+
+// testUpgrade upgrades the document from testdata/<from> and compares it
+// with the golden file in testdata/<to>
+func testUpgrade(t *testing.T, from, to, name string) []Change {
+	input, err := ioutil.ReadFile(filepath.Join("testdata", from, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := Parse(input)
+	if err != nil {
+		t.Fatalf("cannot parse %s/%s: %v", from, name, err)
+	}
+	changes, err := Upgrade(d, to)
+	if err != nil {
+		t.Fatalf("cannot upgrade %s/%s: %v", from, name, err)
+	}
+	actual, err := d.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := filepath.Join("testdata", to, name)
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(golden, actual, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(actual, expected) {
+		t.Errorf("%s upgraded from %s to %s differs from golden file:\n%s", name, from, to, actual)
+	}
+	// JSON and YAML documents follow the JSON model of oscalkit, which the
+	// JSON schemas do not describe, so only XML documents are validated
+	if filepath.Ext(name) == ".xml" {
+		validate(t, to, name, actual, changes)
+	}
+	return changes
+}
+
+// documentTypes are the document types by their root element
+var documentTypes = map[string]constants.DocumentType{
+	"catalog":              constants.CatalogDocument,
+	"profile":              constants.ProfileDocument,
+	"system-security-plan": constants.SSPDocument,
+	"component-definition": constants.ComponentDocument,
+}
+
+// validate validates the upgraded XML document against the bundled schemas
+// of the version. Only elements the upgrade skipped may be invalid.
+func validate(t *testing.T, version, name string, document []byte, changes []Change) {
+	var root xml.StartElement
+	d := xml.NewDecoder(bytes.NewReader(document))
+	for root.Name.Local == "" {
+		token, err := d.Token()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			root = start
+		}
+	}
+	schema, err := bundled.VersionSchema(version, constants.XmlFormat, documentTypes[root.Name.Local])
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	defer schema.Cleanup()
+	s, err := xml_validation.Compile(schema.Path)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	for _, e := range s.Validate(document) {
+		if !skipped(e.XPath, changes) {
+			t.Errorf("%s upgraded to %s is invalid: %v", name, version, e)
+		}
+	}
+}
+
+// skipped reports whether the element is within element the upgrade skipped
+func skipped(xpath string, changes []Change) bool {
+	for _, c := range changes {
+		if c.Skipped && (xpath == c.Path || strings.HasPrefix(xpath, c.Path+"/")) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSteps(t *testing.T) {
+	for _, step := range Steps() {
+		inputs, err := filepath.Glob(filepath.Join("testdata", step.From, "*"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(inputs) == 0 {
+			t.Errorf("step %s has no golden files", step.String())
+		}
+		for _, input := range inputs {
+			testUpgrade(t, step.From, step.To, filepath.Base(input))
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	from, to := Versions()[0], Latest()
+	for _, name := range []string{"profile.xml", "catalog.json", "profile.yaml"} {
+		changes := testUpgrade(t, from, to, name)
+		if len(changes) == 0 || changes[len(changes)-1].Message != "Changed OSCAL version to "+to {
+			t.Errorf("%s: unexpected changes %v", name, changes)
+		}
+	}
+}
+
+func TestSkipped(t *testing.T) {
+	changes := testUpgrade(t, "1.0.0-milestone3", "1.0.0-rc1", "profile.xml")
+	var skipped []Change
+	for _, c := range changes {
+		if c.Skipped {
+			skipped = append(skipped, c)
+		}
+	}
+	if len(skipped) != 1 || skipped[0].Path != "/profile/import[2]/include[1]" || skipped[0].Step != "1.0.0-milestone3 -> 1.0.0-rc1" {
+		t.Errorf("unexpected skipped changes %v", skipped)
+	}
+}
+
+func TestPlan(t *testing.T) {
+	if plan, err := Plan("1.0.0-milestone2", "1.0.0-rc1"); err != nil || len(plan) != 2 {
+		t.Errorf("unexpected plan %v: %v", plan, err)
+	}
+	if plan, err := Plan("1.0.0-rc1", "1.0.0-rc1"); err != nil || len(plan) != 0 {
+		t.Errorf("unexpected plan %v: %v", plan, err)
+	}
+	if _, err := Plan("1.0.0-rc1", "1.0.0-milestone2"); err == nil {
+		t.Error("expected error for downgrade")
+	}
+
+	d, err := Parse([]byte(`{"catalog": {"id": "c"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Upgrade(d, Latest()); err == nil {
+		t.Error("expected error for document without version")
+	}
+}
@@ -0,0 +1,213 @@
+package upgrade
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Element is an element of XML document. Name.Space holds the namespace
+// prefix as written in the document. Children are *Element, xml.CharData,
+// xml.Comment, xml.ProcInst and xml.Directive.
+type Element struct {
+	Name     xml.Name
+	Attr     []xml.Attr
+	Children []interface{}
+}
+
+// GetAttr returns value of the unqualified attribute
+func (e *Element) GetAttr(name string) (string, bool) {
+	for _, a := range e.Attr {
+		if a.Name.Space == "" && a.Name.Local == name {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// SetAttr sets value of the unqualified attribute, new attributes are
+// appended
+func (e *Element) SetAttr(name, value string) {
+	for i, a := range e.Attr {
+		if a.Name.Space == "" && a.Name.Local == name {
+			e.Attr[i].Value = value
+			return
+		}
+	}
+	e.Attr = append(e.Attr, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+}
+
+// RemoveAttr removes the unqualified attribute
+func (e *Element) RemoveAttr(name string) {
+	for i, a := range e.Attr {
+		if a.Name.Space == "" && a.Name.Local == name {
+			e.Attr = append(e.Attr[:i], e.Attr[i+1:]...)
+			return
+		}
+	}
+}
+
+// Elements returns child elements of the local name
+func (e *Element) Elements(name string) []*Element {
+	var elements []*Element
+	for _, c := range e.Children {
+		if child, ok := c.(*Element); ok && child.Name.Local == name {
+			elements = append(elements, child)
+		}
+	}
+	return elements
+}
+
+// Text returns character data of the element without its descendants
+func (e *Element) Text() string {
+	var b strings.Builder
+	for _, c := range e.Children {
+		if text, ok := c.(xml.CharData); ok {
+			b.Write(text)
+		}
+	}
+	return b.String()
+}
+
+// SetText replaces all children of the element with the text
+func (e *Element) SetText(text string) {
+	e.Children = []interface{}{xml.CharData(text)}
+}
+
+// HasElements reports whether the element has child elements
+func (e *Element) HasElements() bool {
+	for _, c := range e.Children {
+		if _, ok := c.(*Element); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Walk calls fn for the element and its descendants with their XPath, such
+// as /profile/import[1]/include[1]. Descendants of the element are visited
+// after fn returns, so fn may change them.
+func (e *Element) Walk(path string, fn func(e *Element, path string)) {
+	fn(e, path)
+	counts := map[string]int{}
+	for _, c := range e.Children {
+		if child, ok := c.(*Element); ok {
+			counts[child.Name.Local]++
+			child.Walk(fmt.Sprintf("%s/%s[%d]", path, child.Name.Local, counts[child.Name.Local]), fn)
+		}
+	}
+}
+
+// xmlDocument is XML document with the tokens surrounding its root element
+type xmlDocument struct {
+	prolog []interface{}
+	root   *Element
+	epilog []interface{}
+}
+
+func parseXML(data []byte) (*xmlDocument, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	doc := &xmlDocument{}
+	var stack []*Element
+	for {
+		token, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		token = xml.CopyToken(token)
+		switch t := token.(type) {
+		case xml.StartElement:
+			e := &Element{Name: t.Name, Attr: t.Attr}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, e)
+			} else if doc.root == nil {
+				doc.root = e
+			} else {
+				return nil, fmt.Errorf("multiple root elements")
+			}
+			stack = append(stack, e)
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("unexpected end element %s", t.Name.Local)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			switch {
+			case len(stack) > 0:
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, token)
+			case doc.root == nil:
+				doc.prolog = append(doc.prolog, token)
+			default:
+				doc.epilog = append(doc.epilog, token)
+			}
+		}
+	}
+	if doc.root == nil {
+		return nil, fmt.Errorf("document has no root element")
+	}
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("element %s is not closed", stack[len(stack)-1].Name.Local)
+	}
+	return doc, nil
+}
+
+func (doc *xmlDocument) write(w *bytes.Buffer) {
+	for _, t := range doc.prolog {
+		writeXMLNode(w, t)
+	}
+	writeXMLNode(w, doc.root)
+	for _, t := range doc.epilog {
+		writeXMLNode(w, t)
+	}
+}
+
+func writeXMLNode(w *bytes.Buffer, node interface{}) {
+	switch n := node.(type) {
+	case *Element:
+		w.WriteString("<" + qualifiedName(n.Name))
+		for _, a := range n.Attr {
+			w.WriteString(" " + qualifiedName(a.Name) + `="`)
+			w.WriteString(attrEscaper.Replace(a.Value))
+			w.WriteString(`"`)
+		}
+		if len(n.Children) == 0 {
+			w.WriteString("/>")
+			return
+		}
+		w.WriteString(">")
+		for _, c := range n.Children {
+			writeXMLNode(w, c)
+		}
+		w.WriteString("</" + qualifiedName(n.Name) + ">")
+	case xml.CharData:
+		w.WriteString(textEscaper.Replace(string(n)))
+	case xml.Comment:
+		w.WriteString("<!--" + string(n) + "-->")
+	case xml.ProcInst:
+		w.WriteString("<?" + n.Target)
+		if len(n.Inst) > 0 {
+			w.WriteString(" " + string(n.Inst))
+		}
+		w.WriteString("?>")
+	case xml.Directive:
+		w.WriteString("<!" + string(n) + ">")
+	}
+}
+
+func qualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+var attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;", "\n", "&#xA;", "\t", "&#x9;")
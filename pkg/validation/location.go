@@ -0,0 +1,15 @@
+package validation
+
+import "strings"
+
+// Position is one-based line and column in the document
+type Position struct {
+	Line   int
+	Column int
+}
+
+// EscapePointer escapes the reference token of JSON pointer as specified by
+// RFC 6901
+func EscapePointer(token string) string {
+	return strings.Replace(strings.Replace(token, "~", "~0", -1), "/", "~1", -1)
+}
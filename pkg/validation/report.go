@@ -0,0 +1,249 @@
+package validation
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Report formats
+const (
+	FormatText  = "text"
+	FormatJSON  = "json"
+	FormatJUnit = "junit"
+	FormatSARIF = "sarif"
+)
+
+// Formats lists the supported report formats
+var Formats = []string{FormatText, FormatJSON, FormatJUnit, FormatSARIF}
+
+// Write writes the result in given format. Version is reported as version
+// of the tool where the format supports it.
+func Write(w io.Writer, format string, r *ValidationResult, version string) error {
+	switch format {
+	case FormatText:
+		return WriteText(w, r)
+	case FormatJSON:
+		return WriteJSON(w, r)
+	case FormatJUnit:
+		return WriteJUnit(w, r)
+	case FormatSARIF:
+		return WriteSARIF(w, r, version)
+	}
+	return fmt.Errorf("unsupported report format %q, expected one of %s", format, strings.Join(Formats, ", "))
+}
+
+// WriteText writes findings one per line
+func WriteText(w io.Writer, r *ValidationResult) error {
+	for _, f := range r.Findings {
+		if _, err := fmt.Fprintln(w, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the result as JSON document
+func WriteJSON(w io.Writer, r *ValidationResult) error {
+	report := struct {
+		Valid    bool      `json:"valid"`
+		Files    []string  `json:"files"`
+		Findings []Finding `json:"findings"`
+	}{r.Valid(), r.Files, r.Findings}
+	// empty lists are written as arrays rather than null
+	if report.Files == nil {
+		report.Files = []string{}
+	}
+	if report.Findings == nil {
+		report.Findings = []Finding{}
+	}
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(report)
+}
+
+type junitSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the result as JUnit XML report with test case per file.
+// Files with findings of error severity are reported as failed, findings of
+// lower severity are written to the standard output of the test case.
+func WriteJUnit(w io.Writer, r *ValidationResult) error {
+	suite := junitSuite{Name: "oscalkit validate", Tests: len(r.Files)}
+	for _, file := range r.Files {
+		c := junitCase{Name: file, ClassName: "oscalkit.validate"}
+		var errors, others []string
+		for _, f := range r.FileFindings(file) {
+			if f.Severity == SeverityError {
+				errors = append(errors, f.String())
+			} else {
+				others = append(others, f.String())
+			}
+		}
+		if len(errors) > 0 {
+			suite.Failures++
+			c.Failure = &junitFailure{
+				Message: fmt.Sprintf("%d validation error(s)", len(errors)),
+				Type:    "validation",
+				Text:    strings.Join(errors, "\n"),
+			}
+		}
+		c.SystemOut = strings.Join(others, "\n")
+		suite.Cases = append(suite.Cases, c)
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+	if err := e.Encode(junitSuites{Suites: []junitSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	toolURI      = "https://github.com/docker/oscalkit"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// WriteSARIF writes the result as SARIF 2.1.0 log
+func WriteSARIF(w io.Writer, r *ValidationResult, version string) error {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "oscalkit",
+			Version:        version,
+			InformationURI: toolURI,
+			Rules:          []sarifRule{},
+		}},
+		Results: []sarifResult{},
+	}
+	rules := map[string]bool{}
+	for _, f := range r.Findings {
+		if !rules[f.Rule] {
+			rules[f.Rule] = true
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: f.Rule})
+		}
+		location := sarifLocation{
+			PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: sarifURI(f.File)},
+			},
+		}
+		if f.Line > 0 {
+			location.PhysicalLocation.Region = &sarifRegion{StartLine: f.Line, StartColumn: f.Column}
+		}
+		if l := f.Location(); l != "" {
+			location.LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: l}}
+		}
+		run.Results = append(run.Results, sarifResult{
+			RuleID:    f.Rule,
+			Level:     sarifLevel(f.Severity),
+			Message:   sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{location},
+		})
+	}
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(sarifLog{Schema: sarifSchema, Version: sarifVersion, Runs: []sarifRun{run}})
+}
+
+func sarifLevel(s Severity) string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+	return "note"
+}
+
+// sarifURI returns file path as relative URI reference
+func sarifURI(file string) string {
+	return strings.Replace(file, "\\", "/", -1)
+}
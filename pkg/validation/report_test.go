@@ -0,0 +1,141 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func sampleResult() *ValidationResult {
+	r := &ValidationResult{}
+	r.AddFile("valid.xml")
+	r.Add(
+		Finding{File: "catalog.xml", XPath: "/catalog/control[2]", Line: 18, Column: 3, Severity: SeverityError, Rule: "xsd/attribute-required", Message: "Element 'control': The attribute 'id' is required but missing."},
+		Finding{File: "catalog.json", Pointer: "/catalog/metadata", Line: 4, Column: 5, Severity: SeverityError, Rule: "json-schema/required", Message: `missing properties: "version"`},
+		Finding{File: "profile.yaml", Severity: SeverityWarning, Rule: "oscalkit/example", Message: "not recommended"},
+	)
+	return r
+}
+
+func TestResult(t *testing.T) {
+	r := sampleResult()
+	if r.Valid() {
+		t.Error("result with errors reported as valid")
+	}
+	if len(r.Files) != 4 {
+		t.Errorf("expected 4 files, got %v", r.Files)
+	}
+	if r.Count(SeverityError) != 2 || r.Count(SeverityWarning) != 1 {
+		t.Errorf("unexpected counts of findings: %v", r.Findings)
+	}
+	err := r.Err()
+	if err == nil || strings.Contains(err.Error(), "not recommended") {
+		t.Errorf("expected errors only, got %v", err)
+	}
+
+	warnings := &ValidationResult{}
+	warnings.Add(r.FileFindings("profile.yaml")...)
+	if !warnings.Valid() || warnings.Err() != nil {
+		t.Error("result with warnings only reported as invalid")
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, FormatText, sampleResult(), ""); err != nil {
+		t.Fatal(err)
+	}
+	expected := `catalog.xml:18:3: error: Element 'control': The attribute 'id' is required but missing. [xsd/attribute-required] at /catalog/control[2]
+catalog.json:4:5: error: missing properties: "version" [json-schema/required] at /catalog/metadata
+profile.yaml: warning: not recommended [oscalkit/example]
+`
+	if buf.String() != expected {
+		t.Errorf("unexpected text report:\n%s", buf.String())
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, FormatJSON, &ValidationResult{}, ""); err != nil {
+		t.Fatal(err)
+	}
+	var report struct {
+		Valid    bool
+		Files    []string
+		Findings []Finding
+	}
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if !report.Valid || report.Files == nil || report.Findings == nil {
+		t.Errorf("unexpected report of empty result: %s", buf.String())
+	}
+
+	buf.Reset()
+	if err := WriteJSON(&buf, sampleResult()); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Valid || len(report.Findings) != 3 || report.Findings[1].Pointer != "/catalog/metadata" {
+		t.Errorf("unexpected report: %s", buf.String())
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJUnit(&buf, sampleResult()); err != nil {
+		t.Fatal(err)
+	}
+	var report junitSuites
+	if err := xml.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	suite := report.Suites[0]
+	if suite.Tests != 4 || suite.Failures != 2 {
+		t.Errorf("expected 4 tests and 2 failures, got %d and %d", suite.Tests, suite.Failures)
+	}
+	for _, c := range suite.Cases {
+		failed := c.Failure != nil
+		if failed != (c.Name == "catalog.xml" || c.Name == "catalog.json") {
+			t.Errorf("unexpected status of %s: %v", c.Name, c.Failure)
+		}
+		if c.Name == "profile.yaml" && !strings.Contains(c.SystemOut, "not recommended") {
+			t.Errorf("warning not reported in output of %s", c.Name)
+		}
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, FormatSARIF, sampleResult(), "1.0.0"); err != nil {
+		t.Fatal(err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatal(err)
+	}
+	run := log.Runs[0]
+	if log.Version != "2.1.0" || run.Tool.Driver.Version != "1.0.0" || len(run.Tool.Driver.Rules) != 3 {
+		t.Errorf("unexpected SARIF log: %s", buf.String())
+	}
+	first := run.Results[0]
+	location := first.Locations[0]
+	if first.Level != "error" || location.PhysicalLocation.Region.StartLine != 18 ||
+		location.LogicalLocations[0].FullyQualifiedName != "/catalog/control[2]" {
+		t.Errorf("unexpected SARIF result: %+v", first)
+	}
+	last := run.Results[2]
+	if last.Level != "warning" || last.Locations[0].PhysicalLocation.Region != nil {
+		t.Errorf("unexpected SARIF result: %+v", last)
+	}
+}
+
+func TestWriteUnsupported(t *testing.T) {
+	if err := Write(&bytes.Buffer{}, "html", sampleResult(), ""); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
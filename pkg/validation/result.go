@@ -0,0 +1,148 @@
+// Package validation collects findings of OSCAL document validation and
+// reports them in human and machine readable formats.
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity of a finding
+type Severity string
+
+// Severities of findings
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// Rule ids of findings not tied to particular schema rule
+const (
+	RuleOpen = "oscalkit/open"
+	RuleType = "oscalkit/document-type"
+	// RuleVersion reports documents validated against schemas of other
+	// OSCAL version than they claim
+	RuleVersion = "oscalkit/oscal-version"
+)
+
+// Finding is a single problem found in a document. Pointer holds JSON
+// pointer of the offending value in JSON and YAML documents, XPath the path
+// of the offending element in XML documents. Line and Column are zero when
+// the position is not known.
+type Finding struct {
+	File     string   `json:"file"`
+	Pointer  string   `json:"pointer,omitempty"`
+	XPath    string   `json:"xpath,omitempty"`
+	Line     int      `json:"line,omitempty"`
+	Column   int      `json:"column,omitempty"`
+	Severity Severity `json:"severity"`
+	Rule     string   `json:"rule"`
+	Message  string   `json:"message"`
+}
+
+// Location returns JSON pointer or XPath of the finding
+func (f Finding) Location() string {
+	if f.XPath != "" {
+		return f.XPath
+	}
+	return f.Pointer
+}
+
+func (f Finding) String() string {
+	position := f.File
+	if f.Line > 0 {
+		position = fmt.Sprintf("%s:%d", position, f.Line)
+		if f.Column > 0 {
+			position = fmt.Sprintf("%s:%d", position, f.Column)
+		}
+	}
+	s := fmt.Sprintf("%s: %s: %s [%s]", position, f.Severity, f.Message, f.Rule)
+	if location := f.Location(); location != "" {
+		s += " at " + location
+	}
+	return s
+}
+
+// ValidationResult collects findings of validated files
+type ValidationResult struct {
+	Files    []string  `json:"files"`
+	Findings []Finding `json:"findings"`
+}
+
+// AddFile records that file was validated
+func (r *ValidationResult) AddFile(file string) {
+	for _, f := range r.Files {
+		if f == file {
+			return
+		}
+	}
+	r.Files = append(r.Files, file)
+}
+
+// Add records findings. Files of the findings are recorded as validated.
+func (r *ValidationResult) Add(findings ...Finding) {
+	for _, f := range findings {
+		r.AddFile(f.File)
+		r.Findings = append(r.Findings, f)
+	}
+}
+
+// Merge adds files and findings of other result
+func (r *ValidationResult) Merge(other *ValidationResult) {
+	for _, file := range other.Files {
+		r.AddFile(file)
+	}
+	r.Add(other.Findings...)
+}
+
+// Valid reports whether there are no findings of error severity
+func (r *ValidationResult) Valid() bool {
+	return r.Count(SeverityError) == 0
+}
+
+// Count returns number of findings of given severity
+func (r *ValidationResult) Count(severity Severity) int {
+	count := 0
+	for _, f := range r.Findings {
+		if f.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+// FileFindings returns findings of given file
+func (r *ValidationResult) FileFindings(file string) []Finding {
+	var findings []Finding
+	for _, f := range r.Findings {
+		if f.File == file {
+			findings = append(findings, f)
+		}
+	}
+	return findings
+}
+
+// Err returns error listing the findings of error severity or nil when the
+// result is valid
+func (r *ValidationResult) Err() error {
+	if r.Valid() {
+		return nil
+	}
+	return &ResultError{r}
+}
+
+// ResultError is returned by Err of invalid result
+type ResultError struct {
+	Result *ValidationResult
+}
+
+func (e *ResultError) Error() string {
+	var lines []string
+	for _, f := range e.Result.Findings {
+		if f.Severity == SeverityError {
+			lines = append(lines, f.String())
+		}
+	}
+	return strings.Join(lines, "\n")
+}
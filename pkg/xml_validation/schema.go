@@ -0,0 +1,468 @@
+package xml_validation
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// xsdNamespace is the namespace of XML Schema definitions
+const xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+
+// Schema is compiled XML Schema. Only the subset of XML Schema used by the
+// OSCAL schemas is supported: global and local element declarations,
+// sequences, choices and groups, mixed and simple content, attributes and
+// attribute groups, simple types restricted by enumerations, patterns and
+// white space facets, unions and unique identity constraints.
+type Schema struct {
+	TargetNamespace string
+
+	elements        map[string]*elementDecl
+	groups          map[string]*particle
+	attributeGroups map[string][]*attributeDecl
+	simpleTypes     map[string]*simpleType
+	complexTypes    map[string]*complexType
+
+	// raw definitions, compiled on first reference
+	defs map[string]map[string]*xsdNode
+}
+
+type elementDecl struct {
+	name    string
+	complex *complexType
+	simple  *simpleType
+	uniques []*uniqueConstraint
+}
+
+type complexType struct {
+	mixed bool
+	// content is nil for empty and simple content
+	content *particle
+	// simple is type of simple content
+	simple     *simpleType
+	attributes []*attributeDecl
+	// elements holds declarations of the elements of the content model
+	elements map[string]*elementDecl
+}
+
+type particleKind int
+
+const (
+	elementParticle particleKind = iota
+	sequenceParticle
+	choiceParticle
+)
+
+// unbounded stands for maxOccurs="unbounded"
+const unbounded = -1
+
+type particle struct {
+	kind     particleKind
+	min, max int
+	element  *elementDecl
+	children []*particle
+}
+
+type attributeDecl struct {
+	name     string
+	required bool
+	typ      *simpleType
+}
+
+type uniqueConstraint struct {
+	name     string
+	selector [][]string
+	fields   []string
+}
+
+// xsdNode is element of the schema document
+type xsdNode struct {
+	name     string
+	attrs    map[string]string
+	children []*xsdNode
+	// namespaces maps prefixes in scope to namespaces
+	namespaces map[string]string
+}
+
+func (n *xsdNode) attr(name string) string {
+	return n.attrs[name]
+}
+
+// Compile reads XML Schema from the file
+func Compile(path string) (*Schema, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open schema %s: %v", path, err)
+	}
+	defer f.Close()
+	return CompileReader(f)
+}
+
+// CompileReader reads XML Schema from reader
+func CompileReader(r io.Reader) (*Schema, error) {
+	root, err := parseXSD(r)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse schema: %v", err)
+	}
+	if root.name != "schema" {
+		return nil, fmt.Errorf("cannot parse schema: root element is %s", root.name)
+	}
+	s := &Schema{
+		TargetNamespace: root.attr("targetNamespace"),
+		elements:        map[string]*elementDecl{},
+		groups:          map[string]*particle{},
+		attributeGroups: map[string][]*attributeDecl{},
+		simpleTypes:     map[string]*simpleType{},
+		complexTypes:    map[string]*complexType{},
+		defs:            map[string]map[string]*xsdNode{},
+	}
+	for _, def := range root.children {
+		if s.defs[def.name] == nil {
+			s.defs[def.name] = map[string]*xsdNode{}
+		}
+		s.defs[def.name][def.attr("name")] = def
+	}
+	for name := range s.defs["element"] {
+		if _, err := s.element(name); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func parseXSD(r io.Reader) (*xsdNode, error) {
+	d := xml.NewDecoder(r)
+	var stack []*xsdNode
+	var root *xsdNode
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return root, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			n := &xsdNode{name: t.Name.Local, attrs: map[string]string{}, namespaces: map[string]string{}}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				for prefix, ns := range parent.namespaces {
+					n.namespaces[prefix] = ns
+				}
+			}
+			for _, a := range t.Attr {
+				if a.Name.Space == "xmlns" {
+					n.namespaces[a.Name.Local] = a.Value
+					continue
+				}
+				n.attrs[a.Name.Local] = a.Value
+			}
+			if t.Name.Space != xsdNamespace {
+				// annotations and other foreign elements are skipped
+				if err := d.Skip(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				if n.name != "annotation" {
+					parent.children = append(parent.children, n)
+				}
+			} else {
+				root = n
+			}
+			if n.name == "annotation" {
+				if err := d.Skip(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+// qname splits qualified name of a referenced definition into local name and
+// reports whether it refers to the built-in XML Schema types
+func qname(n *xsdNode, value string) (string, bool) {
+	prefix, local := "", value
+	if i := strings.IndexByte(value, ':'); i >= 0 {
+		prefix, local = value[:i], value[i+1:]
+	}
+	return local, n.namespaces[prefix] == xsdNamespace
+}
+
+func (s *Schema) element(name string) (*elementDecl, error) {
+	if decl, ok := s.elements[name]; ok {
+		return decl, nil
+	}
+	def, ok := s.defs["element"][name]
+	if !ok {
+		return nil, fmt.Errorf("element %s is not declared", name)
+	}
+	decl := &elementDecl{name: name}
+	// registered before compiling its type, which may refer to the element
+	s.elements[name] = decl
+	return decl, s.compileElement(decl, def)
+}
+
+func (s *Schema) compileElement(decl *elementDecl, def *xsdNode) error {
+	if typ := def.attr("type"); typ != "" {
+		name, builtin := qname(def, typ)
+		if builtin {
+			st, err := builtinType(name)
+			decl.simple = st
+			return err
+		}
+		if _, ok := s.defs["complexType"][name]; ok {
+			ct, err := s.namedComplexType(name)
+			decl.complex = ct
+			return err
+		}
+		st, err := s.namedSimpleType(name)
+		decl.simple = st
+		return err
+	}
+	decl.simple = anySimpleType
+	for _, child := range def.children {
+		var err error
+		switch child.name {
+		case "complexType":
+			decl.simple = nil
+			decl.complex, err = s.compileComplexType(child)
+		case "simpleType":
+			decl.simple, err = s.compileSimpleType(child)
+		case "unique":
+			var u *uniqueConstraint
+			if u, err = compileUnique(child); err == nil {
+				decl.uniques = append(decl.uniques, u)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("element %s: %v", decl.name, err)
+		}
+	}
+	return nil
+}
+
+func (s *Schema) namedComplexType(name string) (*complexType, error) {
+	if ct, ok := s.complexTypes[name]; ok {
+		return ct, nil
+	}
+	ct := &complexType{}
+	s.complexTypes[name] = ct
+	compiled, err := s.compileComplexType(s.defs["complexType"][name])
+	if err != nil {
+		return nil, err
+	}
+	*ct = *compiled
+	return ct, nil
+}
+
+func (s *Schema) compileComplexType(def *xsdNode) (*complexType, error) {
+	ct := &complexType{mixed: def.attr("mixed") == "true", elements: map[string]*elementDecl{}}
+	for _, child := range def.children {
+		switch child.name {
+		case "sequence", "choice", "group":
+			p, err := s.compileParticle(ct, child)
+			if err != nil {
+				return nil, err
+			}
+			ct.content = p
+		case "attribute", "attributeGroup":
+			attrs, err := s.compileAttributes(child)
+			if err != nil {
+				return nil, err
+			}
+			ct.attributes = append(ct.attributes, attrs...)
+		case "simpleContent":
+			if err := s.compileSimpleContent(ct, child); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unsupported schema construct %s in complex type", child.name)
+		}
+	}
+	return ct, nil
+}
+
+func (s *Schema) compileSimpleContent(ct *complexType, def *xsdNode) error {
+	for _, derivation := range def.children {
+		if derivation.name != "extension" && derivation.name != "restriction" {
+			return fmt.Errorf("unsupported schema construct %s in simple content", derivation.name)
+		}
+		st, err := s.typeRef(derivation, derivation.attr("base"))
+		if err != nil {
+			return err
+		}
+		ct.simple = st
+		for _, child := range derivation.children {
+			switch child.name {
+			case "attribute", "attributeGroup":
+				attrs, err := s.compileAttributes(child)
+				if err != nil {
+					return err
+				}
+				ct.attributes = append(ct.attributes, attrs...)
+			default:
+				if derivation.name == "extension" {
+					return fmt.Errorf("unsupported schema construct %s in simple content extension", child.name)
+				}
+				restricted, err := s.compileRestriction(derivation)
+				if err != nil {
+					return err
+				}
+				ct.simple = restricted
+			}
+		}
+	}
+	return nil
+}
+
+// compileParticle compiles content model. Declarations of the elements are
+// collected in ct.
+func (s *Schema) compileParticle(ct *complexType, def *xsdNode) (*particle, error) {
+	p := &particle{min: 1, max: 1}
+	if v := def.attr("minOccurs"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid minOccurs %s", v)
+		}
+		p.min = n
+	}
+	if v := def.attr("maxOccurs"); v != "" {
+		if v == "unbounded" {
+			p.max = unbounded
+		} else {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid maxOccurs %s", v)
+			}
+			p.max = n
+		}
+	}
+
+	switch def.name {
+	case "element":
+		p.kind = elementParticle
+		if ref := def.attr("ref"); ref != "" {
+			name, _ := qname(def, ref)
+			decl, err := s.element(name)
+			if err != nil {
+				return nil, err
+			}
+			p.element = decl
+		} else {
+			decl := &elementDecl{name: def.attr("name")}
+			if err := s.compileElement(decl, def); err != nil {
+				return nil, err
+			}
+			p.element = decl
+		}
+		if _, ok := ct.elements[p.element.name]; !ok {
+			ct.elements[p.element.name] = p.element
+		}
+	case "sequence", "choice":
+		p.kind = sequenceParticle
+		if def.name == "choice" {
+			p.kind = choiceParticle
+		}
+		for _, child := range def.children {
+			c, err := s.compileParticle(ct, child)
+			if err != nil {
+				return nil, err
+			}
+			p.children = append(p.children, c)
+		}
+	case "group":
+		name, _ := qname(def, def.attr("ref"))
+		groupDef, ok := s.defs["group"][name]
+		if !ok {
+			return nil, fmt.Errorf("group %s is not defined", name)
+		}
+		p.kind = sequenceParticle
+		for _, child := range groupDef.children {
+			c, err := s.compileParticle(ct, child)
+			if err != nil {
+				return nil, err
+			}
+			p.children = append(p.children, c)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported schema construct %s in content model", def.name)
+	}
+	return p, nil
+}
+
+func (s *Schema) compileAttributes(def *xsdNode) ([]*attributeDecl, error) {
+	if def.name == "attributeGroup" {
+		name, _ := qname(def, def.attr("ref"))
+		if attrs, ok := s.attributeGroups[name]; ok {
+			return attrs, nil
+		}
+		groupDef, ok := s.defs["attributeGroup"][name]
+		if !ok {
+			return nil, fmt.Errorf("attribute group %s is not defined", name)
+		}
+		var attrs []*attributeDecl
+		for _, child := range groupDef.children {
+			a, err := s.compileAttributes(child)
+			if err != nil {
+				return nil, err
+			}
+			attrs = append(attrs, a...)
+		}
+		s.attributeGroups[name] = attrs
+		return attrs, nil
+	}
+
+	a := &attributeDecl{name: def.attr("name"), required: def.attr("use") == "required", typ: anySimpleType}
+	if typ := def.attr("type"); typ != "" {
+		st, err := s.typeRef(def, typ)
+		if err != nil {
+			return nil, err
+		}
+		a.typ = st
+	}
+	for _, child := range def.children {
+		if child.name == "simpleType" {
+			st, err := s.compileSimpleType(child)
+			if err != nil {
+				return nil, err
+			}
+			a.typ = st
+		}
+	}
+	return []*attributeDecl{a}, nil
+}
+
+func compileUnique(def *xsdNode) (*uniqueConstraint, error) {
+	u := &uniqueConstraint{name: def.attr("name")}
+	for _, child := range def.children {
+		switch child.name {
+		case "selector":
+			for _, path := range strings.Split(child.attr("xpath"), "|") {
+				var steps []string
+				for _, step := range strings.Split(strings.TrimSpace(path), "/") {
+					name, _ := qname(child, step)
+					steps = append(steps, name)
+				}
+				u.selector = append(u.selector, steps)
+			}
+		case "field":
+			xpath := child.attr("xpath")
+			if !strings.HasPrefix(xpath, "@") {
+				return nil, fmt.Errorf("unsupported identity constraint field %s", xpath)
+			}
+			u.fields = append(u.fields, strings.TrimPrefix(xpath, "@"))
+		}
+	}
+	return u, nil
+}
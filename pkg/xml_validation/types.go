@@ -0,0 +1,244 @@
+package xml_validation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// simpleType validates character data of attributes and simple content
+type simpleType struct {
+	name string
+	// builtin validates the lexical space of built-in types
+	builtin    func(value string) bool
+	base       *simpleType
+	collapse   bool
+	enumerated []string
+	patterns   []*pattern
+	union      []*simpleType
+}
+
+type pattern struct {
+	source string
+	re     *regexp.Regexp
+}
+
+var (
+	ncNamePattern   = regexp.MustCompile(`^[\p{L}_][\p{L}\p{N}\p{M}_.\-]*$`)
+	integerPattern  = regexp.MustCompile(`^\+?[0-9]+$`)
+	dateTimePattern = regexp.MustCompile(`^-?([0-9]{4,})-([0-9]{2})-([0-9]{2})T([0-9]{2}):([0-9]{2}):([0-9]{2})(\.[0-9]+)?(Z|[+-][0-9]{2}:[0-9]{2})?$`)
+	datePattern     = regexp.MustCompile(`^-?([0-9]{4,})-([0-9]{2})-([0-9]{2})(Z|[+-][0-9]{2}:[0-9]{2})?$`)
+)
+
+// anySimpleType accepts any value
+var anySimpleType = &simpleType{name: "xs:anySimpleType", builtin: func(string) bool { return true }}
+
+var builtinTypes = map[string]*simpleType{
+	"string":             {name: "xs:string", builtin: func(string) bool { return true }},
+	"normalizedString":   {name: "xs:normalizedString", builtin: func(string) bool { return true }},
+	"token":              {name: "xs:token", collapse: true, builtin: func(string) bool { return true }},
+	"anySimpleType":      anySimpleType,
+	"anyURI":             {name: "xs:anyURI", collapse: true, builtin: func(string) bool { return true }},
+	"NCName":             {name: "xs:NCName", collapse: true, builtin: ncNamePattern.MatchString},
+	"ID":                 {name: "xs:ID", collapse: true, builtin: ncNamePattern.MatchString},
+	"nonNegativeInteger": {name: "xs:nonNegativeInteger", collapse: true, builtin: integerPattern.MatchString},
+	"positiveInteger": {name: "xs:positiveInteger", collapse: true, builtin: func(v string) bool {
+		n, err := strconv.ParseUint(strings.TrimPrefix(v, "+"), 10, 64)
+		return err == nil && n > 0
+	}},
+	"integer": {name: "xs:integer", collapse: true, builtin: func(v string) bool {
+		return integerPattern.MatchString(strings.TrimPrefix(v, "-"))
+	}},
+	"boolean": {name: "xs:boolean", collapse: true, builtin: func(v string) bool {
+		return v == "true" || v == "false" || v == "1" || v == "0"
+	}},
+	"dateTime": {name: "xs:dateTime", collapse: true, builtin: func(v string) bool {
+		m := dateTimePattern.FindStringSubmatch(v)
+		return m != nil && validDate(m[2], m[3]) && validTime(m[4], m[5], m[6])
+	}},
+	"date": {name: "xs:date", collapse: true, builtin: func(v string) bool {
+		m := datePattern.FindStringSubmatch(v)
+		return m != nil && validDate(m[2], m[3])
+	}},
+	"base64Binary": {name: "xs:base64Binary", collapse: true, builtin: func(v string) bool {
+		_, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(v), ""))
+		return err == nil
+	}},
+}
+
+func validDate(month, day string) bool {
+	m, _ := strconv.Atoi(month)
+	d, _ := strconv.Atoi(day)
+	return m >= 1 && m <= 12 && d >= 1 && d <= 31
+}
+
+func validTime(hour, minute, second string) bool {
+	h, _ := strconv.Atoi(hour)
+	m, _ := strconv.Atoi(minute)
+	s, _ := strconv.Atoi(second)
+	return (h < 24 && m < 60 && s < 60) || (h == 24 && m == 0 && s == 0)
+}
+
+func builtinType(name string) (*simpleType, error) {
+	st, ok := builtinTypes[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported built-in type xs:%s", name)
+	}
+	return st, nil
+}
+
+// typeRef resolves reference to built-in or named simple type
+func (s *Schema) typeRef(n *xsdNode, ref string) (*simpleType, error) {
+	name, builtin := qname(n, ref)
+	if builtin {
+		return builtinType(name)
+	}
+	return s.namedSimpleType(name)
+}
+
+func (s *Schema) namedSimpleType(name string) (*simpleType, error) {
+	if st, ok := s.simpleTypes[name]; ok {
+		return st, nil
+	}
+	def, ok := s.defs["simpleType"][name]
+	if !ok {
+		return nil, fmt.Errorf("simple type %s is not defined", name)
+	}
+	st, err := s.compileSimpleType(def)
+	if err != nil {
+		return nil, err
+	}
+	s.simpleTypes[name] = st
+	return st, nil
+}
+
+func (s *Schema) compileSimpleType(def *xsdNode) (*simpleType, error) {
+	for _, child := range def.children {
+		var st *simpleType
+		var err error
+		switch child.name {
+		case "restriction":
+			st, err = s.compileRestriction(child)
+		case "union":
+			st, err = s.compileUnion(child)
+		default:
+			err = fmt.Errorf("unsupported schema construct %s in simple type", child.name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		st.name = def.attr("name")
+		return st, nil
+	}
+	return nil, fmt.Errorf("empty simple type %s", def.attr("name"))
+}
+
+func (s *Schema) compileRestriction(def *xsdNode) (*simpleType, error) {
+	st := &simpleType{}
+	if base := def.attr("base"); base != "" {
+		var err error
+		if st.base, err = s.typeRef(def, base); err != nil {
+			return nil, err
+		}
+		st.collapse = st.base.collapse
+	}
+	for _, facet := range def.children {
+		switch facet.name {
+		case "simpleType":
+			base, err := s.compileSimpleType(facet)
+			if err != nil {
+				return nil, err
+			}
+			st.base = base
+		case "enumeration":
+			st.enumerated = append(st.enumerated, facet.attr("value"))
+		case "pattern":
+			// XML Schema patterns match the whole value
+			re, err := regexp.Compile(`^(?:` + facet.attr("value") + `)$`)
+			if err != nil {
+				return nil, fmt.Errorf("unsupported pattern %s: %v", facet.attr("value"), err)
+			}
+			st.patterns = append(st.patterns, &pattern{source: facet.attr("value"), re: re})
+		case "whiteSpace":
+			st.collapse = facet.attr("value") == "collapse"
+		default:
+			return nil, fmt.Errorf("unsupported facet %s", facet.name)
+		}
+	}
+	return st, nil
+}
+
+func (s *Schema) compileUnion(def *xsdNode) (*simpleType, error) {
+	st := &simpleType{}
+	for _, member := range strings.Fields(def.attr("memberTypes")) {
+		m, err := s.typeRef(def, member)
+		if err != nil {
+			return nil, err
+		}
+		st.union = append(st.union, m)
+	}
+	for _, child := range def.children {
+		m, err := s.compileSimpleType(child)
+		if err != nil {
+			return nil, err
+		}
+		st.union = append(st.union, m)
+	}
+	return st, nil
+}
+
+// validate checks the value and returns description of the problem
+func (st *simpleType) validate(value string) string {
+	if st.collapse {
+		value = strings.Join(strings.Fields(value), " ")
+	}
+	switch {
+	case st.builtin != nil:
+		if !st.builtin(value) {
+			return fmt.Sprintf("'%s' is not a valid value of the atomic type '%s'.", value, st.name)
+		}
+	case st.union != nil:
+		for _, member := range st.union {
+			if member.validate(value) == "" {
+				return ""
+			}
+		}
+		return fmt.Sprintf("'%s' is not a valid value of the union type '%s'.", value, st.displayName())
+	default:
+		if st.base != nil {
+			if problem := st.base.validate(value); problem != "" {
+				if st.base.builtin != nil && st.name != "" {
+					return fmt.Sprintf("'%s' is not a valid value of the atomic type '%s'.", value, st.name)
+				}
+				return problem
+			}
+		}
+		if len(st.enumerated) > 0 && !contains(st.enumerated, value) {
+			return fmt.Sprintf("[facet 'enumeration'] The value '%s' is not an element of the set {'%s'}.", value, strings.Join(st.enumerated, "', '"))
+		}
+		for _, p := range st.patterns {
+			if !p.re.MatchString(value) {
+				return fmt.Sprintf("[facet 'pattern'] The value '%s' is not accepted by the pattern '%s'.", value, p.source)
+			}
+		}
+	}
+	return ""
+}
+
+func (st *simpleType) displayName() string {
+	if st.name == "" {
+		return "local union type"
+	}
+	return st.name
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
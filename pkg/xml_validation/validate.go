@@ -2,25 +2,524 @@ package xml_validation
 
 import (
 	"bytes"
-	"errors"
-	"os/exec"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/docker/oscalkit/pkg/validation"
+)
+
+// Rules violated by the document
+const (
+	RuleWellFormed = "well-formed"
+	RuleRoot       = "root-declaration"
+	RuleAttribute  = "attribute-not-allowed"
+	RuleRequired   = "attribute-required"
+	RuleValue      = "value"
+	RuleContent    = "content"
+	RuleUnexpected = "unexpected-element"
+	RuleMissing    = "missing-element"
+	RuleUnique     = "unique"
 )
 
-// Validate validates xml file against given schema
+// Error is a schema validity error of XML document
+type Error struct {
+	Line    int
+	Column  int
+	XPath   string
+	Rule    string
+	Message string
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s: %s", e.Line, e.Column, e.XPath, e.Message)
+}
+
+// Errors lists the validity errors of XML document in document order
+type Errors []Error
+
+func (e Errors) Error() string {
+	var lines []string
+	for _, err := range e {
+		lines = append(lines, err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Validate validates xml file against given schema. The validity errors are
+// returned as Errors.
 func Validate(schemaPath, inputFile string) error {
-	xmllintCmd := exec.Command("xmllint", "--schema", schemaPath, inputFile, "--noout")
+	schema, err := Compile(schemaPath)
+	if err != nil {
+		return err
+	}
+	content, err := ioutil.ReadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("Error opening file: %s, %v", inputFile, err)
+	}
+	if errs := schema.Validate(content); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
 
-	xmllintCmdOutput := &bytes.Buffer{}
-	xmllintCmdErr := &bytes.Buffer{}
-	xmllintCmd.Stdout = xmllintCmdOutput
-	xmllintCmd.Stderr = xmllintCmdErr
+// instance is element of the validated document
+type instance struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []*instance
+	text     bytes.Buffer
+	// hasText records character data other than white space and textAt
+	// the number of child elements preceding it
+	hasText bool
+	textAt  int
+	line    int
+	column  int
+	xpath   string
+}
 
-	if err := xmllintCmd.Run(); err != nil {
-		stderr := xmllintCmdErr.String()
-		if stderr == "" {
-			return err
+func (n *instance) attr(name string) (string, bool) {
+	for _, a := range n.attrs {
+		if a.Name.Space == "" && a.Name.Local == name {
+			return a.Value, true
 		}
-		return errors.New(xmllintCmdErr.String())
 	}
-	return nil
+	return "", false
+}
+
+// Validate validates the document and returns all validity errors. Well
+// formedness errors are returned as single error.
+func (s *Schema) Validate(document []byte) Errors {
+	root, err := parseInstance(document)
+	if err != nil {
+		return Errors{*err}
+	}
+	v := &validator{schema: s}
+	decl, ok := s.elements[root.name.Local]
+	if !ok || root.name.Space != s.TargetNamespace {
+		v.report(root, RuleRoot, "No matching global declaration available for the validation root.")
+		return v.errors
+	}
+	v.validate(root, decl)
+	return v.errors
+}
+
+func parseInstance(document []byte) (*instance, *Error) {
+	lines := lineOffsets(document)
+	d := xml.NewDecoder(bytes.NewReader(document))
+	var stack []*instance
+	var root *instance
+	for {
+		offset := d.InputOffset()
+		token, err := d.Token()
+		if err == io.EOF {
+			if root == nil {
+				return nil, &Error{Rule: RuleWellFormed, Line: 1, Column: 1, XPath: "/", Message: "document has no root element"}
+			}
+			return root, nil
+		}
+		if err != nil {
+			line, column := position(lines, d.InputOffset())
+			if syntax, ok := err.(*xml.SyntaxError); ok {
+				line, column = syntax.Line, 0
+			}
+			return nil, &Error{Rule: RuleWellFormed, Line: line, Column: column, XPath: xpathOf(stack), Message: fmt.Sprintf("not well-formed: %v", err)}
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			n := &instance{name: t.Name, attrs: t.Attr}
+			n.line, n.column = position(lines, offset)
+			if len(stack) == 0 {
+				n.xpath = "/" + t.Name.Local
+				root = n
+			} else {
+				parent := stack[len(stack)-1]
+				index := 1
+				for _, sibling := range parent.children {
+					if sibling.name == t.Name {
+						index++
+					}
+				}
+				n.xpath = fmt.Sprintf("%s/%s[%d]", parent.xpath, t.Name.Local, index)
+				parent.children = append(parent.children, n)
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				n := stack[len(stack)-1]
+				n.text.Write(t)
+				if !n.hasText && len(bytes.TrimSpace(t)) > 0 {
+					n.hasText = true
+					n.textAt = len(n.children)
+				}
+			}
+		}
+	}
+}
+
+func xpathOf(stack []*instance) string {
+	if len(stack) == 0 {
+		return "/"
+	}
+	return stack[len(stack)-1].xpath
+}
+
+// lineOffsets returns offsets of the line starts
+func lineOffsets(document []byte) []int64 {
+	offsets := []int64{0}
+	for i, c := range document {
+		if c == '\n' {
+			offsets = append(offsets, int64(i+1))
+		}
+	}
+	return offsets
+}
+
+// position converts offset to one-based line and column. Leading white
+// space before the token is skipped.
+func position(lines []int64, offset int64) (int, int) {
+	line := sort.Search(len(lines), func(i int) bool { return lines[i] > offset })
+	return line, int(offset-lines[line-1]) + 1
+}
+
+type validator struct {
+	schema *Schema
+	errors Errors
+}
+
+func (v *validator) report(n *instance, rule, format string, args ...interface{}) {
+	v.errors = append(v.errors, Error{
+		Line:    n.line,
+		Column:  n.column,
+		XPath:   n.xpath,
+		Rule:    rule,
+		Message: fmt.Sprintf("Element '%s': ", v.displayName(n)) + fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) reportAttr(n *instance, attr, rule, format string, args ...interface{}) {
+	v.errors = append(v.errors, Error{
+		Line:    n.line,
+		Column:  n.column,
+		XPath:   n.xpath + "/@" + attr,
+		Rule:    rule,
+		Message: fmt.Sprintf("Element '%s', attribute '%s': ", v.displayName(n), attr) + fmt.Sprintf(format, args...),
+	})
+}
+
+// displayName returns the element name as xmllint prints it, qualified by
+// namespace outside the target namespace
+func (v *validator) displayName(n *instance) string {
+	if n.name.Space == "" || n.name.Space == v.schema.TargetNamespace {
+		return n.name.Local
+	}
+	return "{" + n.name.Space + "}" + n.name.Local
+}
+
+func (v *validator) validate(n *instance, decl *elementDecl) {
+	if decl.simple != nil {
+		v.validateSimple(n, decl.simple)
+	} else {
+		v.validateComplex(n, decl.complex)
+	}
+	for _, u := range decl.uniques {
+		v.validateUnique(n, u)
+	}
+}
+
+// validateSimple validates element of simple type
+func (v *validator) validateSimple(n *instance, st *simpleType) {
+	for _, a := range n.attrs {
+		if !isNamespaceDecl(a) {
+			v.reportAttr(n, a.Name.Local, RuleAttribute, "The attribute '%s' is not allowed.", a.Name.Local)
+		}
+	}
+	if len(n.children) > 0 {
+		v.report(n, RuleContent, "Element content is not allowed, because the type definition is simple.")
+		return
+	}
+	if problem := st.validate(n.text.String()); problem != "" {
+		v.report(n, RuleValue, "%s", problem)
+	}
+}
+
+func (v *validator) validateComplex(n *instance, ct *complexType) {
+	v.validateAttributes(n, ct.attributes)
+
+	switch {
+	case ct.simple != nil:
+		if len(n.children) > 0 {
+			v.report(n, RuleContent, "Element content is not allowed, because the content type is a simple type definition.")
+			return
+		}
+		if problem := ct.simple.validate(n.text.String()); problem != "" {
+			v.report(n, RuleValue, "%s", problem)
+		}
+		return
+	case ct.content == nil:
+		if n.hasText && !ct.mixed {
+			v.report(n, RuleContent, "Character content is not allowed, because the content type is empty.")
+		}
+		if len(n.children) > 0 {
+			v.report(n.children[0], RuleUnexpected, "This element is not expected.")
+		}
+		return
+	}
+
+	m := &matcher{children: n.children, namespace: v.schema.TargetNamespace, expected: map[int][]string{}}
+	ends := m.match(ct.content, 0)
+	valid := false
+	for _, end := range ends {
+		valid = valid || end == len(n.children)
+	}
+	// content following unexpected element is not examined
+	if n.hasText && !ct.mixed && (valid || n.textAt <= m.reach) {
+		v.report(n, RuleContent, "Character content other than whitespace is not allowed because the content type is 'element-only'.")
+	}
+	// children following the first unexpected one are not validated
+	validated := len(n.children)
+	if !valid && m.reach < len(n.children) {
+		validated = m.reach
+	}
+	for _, child := range n.children[:validated] {
+		if decl, ok := ct.elements[child.name.Local]; ok && child.name.Space == v.schema.TargetNamespace {
+			v.validate(child, decl)
+		}
+	}
+	if valid {
+		return
+	}
+	expected := expectation(m.expected[m.reach])
+	if m.reach < len(n.children) {
+		v.report(n.children[m.reach], RuleUnexpected, "This element is not expected.%s", expected)
+		return
+	}
+	v.report(n, RuleMissing, "Missing child element(s).%s", expected)
+}
+
+// expectation formats the elements expected at a position
+func expectation(names []string) string {
+	switch len(names) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf(" Expected is ( %s ).", names[0])
+	default:
+		return fmt.Sprintf(" Expected is one of ( %s ).", strings.Join(names, ", "))
+	}
+}
+
+func (v *validator) validateAttributes(n *instance, decls []*attributeDecl) {
+	seen := map[string]bool{}
+	for _, a := range n.attrs {
+		if isNamespaceDecl(a) {
+			continue
+		}
+		var decl *attributeDecl
+		if a.Name.Space == "" {
+			for _, d := range decls {
+				if d.name == a.Name.Local {
+					decl = d
+					break
+				}
+			}
+		}
+		if decl == nil {
+			v.reportAttr(n, a.Name.Local, RuleAttribute, "The attribute '%s' is not allowed.", a.Name.Local)
+			continue
+		}
+		seen[decl.name] = true
+		if problem := decl.typ.validate(a.Value); problem != "" {
+			v.reportAttr(n, a.Name.Local, RuleValue, "%s", problem)
+		}
+	}
+	for _, d := range decls {
+		if d.required && !seen[d.name] {
+			v.report(n, RuleRequired, "The attribute '%s' is required but missing.", d.name)
+		}
+	}
+}
+
+// validateUnique checks that no two elements selected by the constraint
+// have the same fields
+func (v *validator) validateUnique(n *instance, u *uniqueConstraint) {
+	seen := map[string]bool{}
+	for _, selected := range selectElements(n, v.schema.TargetNamespace, u.selector) {
+		var key []string
+		complete := true
+		for _, field := range u.fields {
+			value, ok := selected.attr(field)
+			if !ok {
+				complete = false
+				break
+			}
+			key = append(key, value)
+		}
+		if !complete {
+			continue
+		}
+		k := strings.Join(key, "\x00")
+		if seen[k] {
+			v.report(selected, RuleUnique, "Duplicate key-sequence ['%s'] in unique identity-constraint '%s'.", strings.Join(key, "', '"), u.name)
+			continue
+		}
+		seen[k] = true
+	}
+}
+
+// selectElements returns elements at the child paths in document order,
+// named steps match elements of the namespace
+func selectElements(n *instance, namespace string, paths [][]string) []*instance {
+	var selected []*instance
+	var walk func(n *instance, depth int)
+	walk = func(n *instance, depth int) {
+		for _, child := range n.children {
+			for _, path := range paths {
+				if depth < len(path) && (path[depth] == "*" || child.name == (xml.Name{Space: namespace, Local: path[depth]})) {
+					if depth == len(path)-1 {
+						selected = append(selected, child)
+					} else {
+						walk(child, depth+1)
+					}
+					break
+				}
+			}
+		}
+	}
+	walk(n, 0)
+	return selected
+}
+
+func isNamespaceDecl(a xml.Attr) bool {
+	return a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns")
+}
+
+// matcher matches children of an element against content model. It tracks
+// the furthest child reached and the elements expected at each position for
+// error reporting.
+type matcher struct {
+	children  []*instance
+	namespace string
+	reach     int
+	expected  map[int][]string
+}
+
+// match returns positions following the children matched by the particle
+// starting at pos
+func (m *matcher) match(p *particle, pos int) []int {
+	seen := map[int]bool{}
+	var result []int
+	add := func(positions []int) {
+		for _, q := range positions {
+			if !seen[q] {
+				seen[q] = true
+				result = append(result, q)
+			}
+		}
+	}
+	if p.min == 0 {
+		add([]int{pos})
+	}
+	current := []int{pos}
+	visited := map[int]bool{pos: true}
+	for i := 1; p.max == unbounded || i <= p.max; i++ {
+		var next []int
+		for _, q := range current {
+			next = append(next, m.matchOnce(p, q)...)
+		}
+		if len(next) == 0 {
+			break
+		}
+		if i >= p.min {
+			add(next)
+		}
+		var fresh []int
+		for _, q := range next {
+			if !visited[q] {
+				visited[q] = true
+				fresh = append(fresh, q)
+			}
+		}
+		if i >= p.min && len(fresh) == 0 {
+			break
+		}
+		if i < p.min {
+			current = dedupe(next)
+		} else {
+			current = fresh
+		}
+	}
+	sort.Ints(result)
+	return result
+}
+
+func (m *matcher) matchOnce(p *particle, pos int) []int {
+	switch p.kind {
+	case elementParticle:
+		if pos < len(m.children) && m.children[pos].name == (xml.Name{Space: m.namespace, Local: p.element.name}) {
+			if pos+1 > m.reach {
+				m.reach = pos + 1
+			}
+			return []int{pos + 1}
+		}
+		if !contains(m.expected[pos], p.element.name) {
+			m.expected[pos] = append(m.expected[pos], p.element.name)
+		}
+		return nil
+	case sequenceParticle:
+		positions := []int{pos}
+		for _, child := range p.children {
+			var next []int
+			for _, q := range positions {
+				next = append(next, m.match(child, q)...)
+			}
+			positions = dedupe(next)
+			if len(positions) == 0 {
+				return nil
+			}
+		}
+		return positions
+	default:
+		var positions []int
+		for _, child := range p.children {
+			positions = append(positions, m.match(child, pos)...)
+		}
+		return dedupe(positions)
+	}
+}
+
+func dedupe(positions []int) []int {
+	seen := map[int]bool{}
+	var result []int
+	for _, q := range positions {
+		if !seen[q] {
+			seen[q] = true
+			result = append(result, q)
+		}
+	}
+	return result
+}
+
+// Locate returns positions of start tags of the document elements indexed
+// by their XPath, such as /catalog/group[1]/control[2]
+func Locate(document []byte) (map[string]validation.Position, error) {
+	root, err := parseInstance(document)
+	if err != nil {
+		return nil, *err
+	}
+	positions := map[string]validation.Position{}
+	var locate func(n *instance)
+	locate = func(n *instance) {
+		positions[n.xpath] = validation.Position{Line: n.line, Column: n.column}
+		for _, child := range n.children {
+			locate(child)
+		}
+	}
+	locate(root)
+	return positions, nil
 }
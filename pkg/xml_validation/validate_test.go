@@ -0,0 +1,165 @@
+package xml_validation
+
+import (
+	"bytes"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/docker/oscalkit/pkg/bundled"
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+)
+
+var fixtures = []struct {
+	file    string
+	docType constants.DocumentType
+	valid   bool
+}{
+	{"catalog.xml", constants.CatalogDocument, true},
+	{"profile.xml", constants.ProfileDocument, true},
+	{"invalid-catalog.xml", constants.CatalogDocument, false},
+	{"invalid-profile.xml", constants.ProfileDocument, false},
+	{"foreign-namespace-catalog.xml", constants.CatalogDocument, false},
+}
+
+func compile(t *testing.T, docType constants.DocumentType) *Schema {
+	schemaFile, err := bundled.Schema(constants.XmlFormat, docType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer schemaFile.Cleanup()
+	schema, err := Compile(schemaFile.Path)
+	if err != nil {
+		t.Fatalf("cannot compile %s schema: %v", docType, err)
+	}
+	return schema
+}
+
+func TestValidate(t *testing.T) {
+	for _, f := range fixtures {
+		schemaFile, err := bundled.Schema(constants.XmlFormat, f.docType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = Validate(schemaFile.Path, filepath.Join("testdata", f.file))
+		schemaFile.Cleanup()
+		if f.valid && err != nil {
+			t.Errorf("%s: unexpected errors:\n%v", f.file, err)
+		}
+		if !f.valid {
+			if _, ok := err.(Errors); !ok {
+				t.Errorf("%s: expected validity errors, got %v", f.file, err)
+			}
+		}
+	}
+}
+
+func TestValidateErrors(t *testing.T) {
+	schema := compile(t, constants.CatalogDocument)
+	document := []byte(`<catalog xmlns="http://csrc.nist.gov/ns/oscal/1.0" id="c 1">
+  <metadata>
+    <title>T</title>
+    <last-modified>2019-01-01</last-modified>
+    <version>1</version>
+    <oscal-version>1.0</oscal-version>
+  </metadata>
+  <control id="a">
+    <title>A</title>
+    <unknown/>
+  </control>
+  <control>
+    <title>B</title>
+  </control>
+</catalog>`)
+	expected := Errors{
+		{Line: 1, Column: 1, XPath: "/catalog/@id", Rule: RuleValue, Message: "Element 'catalog', attribute 'id': 'c 1' is not a valid value of the atomic type 'xs:NCName'."},
+		{Line: 4, Column: 5, XPath: "/catalog/metadata[1]/last-modified[1]", Rule: RuleValue, Message: "Element 'last-modified': '2019-01-01' is not a valid value of the atomic type 'dateTime-with-timezone'."},
+		{Line: 10, Column: 5, XPath: "/catalog/control[1]/unknown[1]", Rule: RuleUnexpected, Message: "Element 'unknown': This element is not expected. Expected is one of ( param, prop, annotation, link, part, control )."},
+		{Line: 12, Column: 3, XPath: "/catalog/control[2]", Rule: RuleRequired, Message: "Element 'control': The attribute 'id' is required but missing."},
+	}
+	if errs := schema.Validate(document); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("unexpected errors:\n%v\nexpected:\n%v", errs, expected)
+	}
+}
+
+func TestValidateForeignNamespace(t *testing.T) {
+	schema := compile(t, constants.CatalogDocument)
+	document := []byte(`<catalog xmlns="http://csrc.nist.gov/ns/oscal/1.0" xmlns:x="urn:other" id="c">
+  <metadata>
+    <x:title>T</x:title>
+  </metadata>
+</catalog>`)
+	expected := Errors{
+		{Line: 3, Column: 5, XPath: "/catalog/metadata[1]/title[1]", Rule: RuleUnexpected, Message: "Element '{urn:other}title': This element is not expected. Expected is ( title )."},
+	}
+	if errs := schema.Validate(document); !reflect.DeepEqual(errs, expected) {
+		t.Errorf("unexpected errors:\n%v\nexpected:\n%v", errs, expected)
+	}
+}
+
+func TestValidateMalformed(t *testing.T) {
+	schema := compile(t, constants.CatalogDocument)
+	errs := schema.Validate([]byte("<catalog xmlns=\"http://csrc.nist.gov/ns/oscal/1.0\">\n  <metadata>\n</catalog>"))
+	if len(errs) != 1 || errs[0].Line != 3 {
+		t.Errorf("expected single syntax error on line 3, got %v", errs)
+	}
+	errs = schema.Validate([]byte(`<catalog id="c"/>`))
+	if len(errs) != 1 || errs[0].Message != "Element 'catalog': No matching global declaration available for the validation root." {
+		t.Errorf("expected root declaration error, got %v", errs)
+	}
+}
+
+var xmllintLine = regexp.MustCompile(`(?m)^[^\n]*:(\d+): Schemas validity error`)
+
+// TestXmllintParity checks that documents are reported at the same lines as
+// by xmllint
+func TestXmllintParity(t *testing.T) {
+	xmllint, err := exec.LookPath("xmllint")
+	if err != nil {
+		t.Skip("xmllint is not available")
+	}
+	for _, f := range fixtures {
+		schemaFile, err := bundled.Schema(constants.XmlFormat, f.docType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join("testdata", f.file)
+
+		var stderr bytes.Buffer
+		cmd := exec.Command(xmllint, "--noout", "--schema", schemaFile.Path, path)
+		cmd.Stderr = &stderr
+		xmllintValid := cmd.Run() == nil
+		var expected []int
+		for _, m := range xmllintLine.FindAllStringSubmatch(stderr.String(), -1) {
+			line, _ := strconv.Atoi(m[1])
+			expected = appendLine(expected, line)
+		}
+
+		err = Validate(schemaFile.Path, path)
+		schemaFile.Cleanup()
+		var lines []int
+		if errs, ok := err.(Errors); ok {
+			for _, e := range errs {
+				lines = appendLine(lines, e.Line)
+			}
+		}
+		sort.Ints(lines)
+		sort.Ints(expected)
+		if (err == nil) != xmllintValid || !reflect.DeepEqual(lines, expected) {
+			t.Errorf("%s: errors reported at lines %v, xmllint reports %v", f.file, lines, expected)
+		}
+	}
+}
+
+func appendLine(lines []int, line int) []int {
+	for _, l := range lines {
+		if l == line {
+			return lines
+		}
+	}
+	return append(lines, line)
+}
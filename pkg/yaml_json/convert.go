@@ -0,0 +1,62 @@
+package yaml_json
+
+import (
+	"encoding/json"
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ToJSON converts YAML document into its JSON equivalent. OSCAL YAML mirrors
+// the JSON model, so the result can be decoded with the JSON struct tags
+func ToJSON(yamlBytes []byte) ([]byte, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal(yamlBytes, &doc); err != nil {
+		return nil, err
+	}
+	doc, err := normalize(doc)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+}
+
+// FromJSON converts JSON document into YAML, preserving the order of the keys
+func FromJSON(jsonBytes []byte) ([]byte, error) {
+	var doc yaml.MapSlice
+	if err := yaml.Unmarshal(jsonBytes, &doc); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(doc)
+}
+
+// normalize turns map[interface{}]interface{} produced by yaml.v2 into
+// map[string]interface{} that encoding/json can handle
+func normalize(in interface{}) (interface{}, error) {
+	switch v := in.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			k, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported YAML key %v, keys must be strings", key)
+			}
+			n, err := normalize(value)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = n
+		}
+		return out, nil
+	case []interface{}:
+		for i, value := range v {
+			n, err := normalize(value)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = n
+		}
+		return v, nil
+	}
+	return in, nil
+}
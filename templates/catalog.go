@@ -1,10 +1,23 @@
 package templates
 
-import "html/template"
+import (
+	"html/template"
+	"strconv"
+
+	"github.com/docker/oscalkit/types/oscal/catalog"
+)
 
 //GetCatalogTemplate GetCatalogTemplate
 func GetCatalogTemplate() (*template.Template, error) {
-	return template.New("").Parse(catalogTemplate)
+	return template.New("").Funcs(template.FuncMap{"prose": proseLiteral}).Parse(catalogTemplate)
+}
+
+// proseLiteral returns Go expression of the prose
+func proseLiteral(prose *catalog.Prose) template.HTML {
+	if prose == nil {
+		return "nil"
+	}
+	return template.HTML("&catalog.Prose{Raw: " + strconv.Quote(prose.Raw) + "}")
 }
 
 const catalogTemplate = `
@@ -35,6 +48,7 @@ var ApplicableControls = []catalog.Catalog{
 											Id:  "{{.Id}}",
 											Class: "{{.Class}}",
 											Title: "{{.Title}}",
+											Prose: {{prose .Prose}},
 											},
 									{{end}}
 								},
@@ -49,6 +63,7 @@ var ApplicableControls = []catalog.Catalog{
 												catalog.Part{
 													Id:  "{{.Id}}",
 													Class: "{{.Class}}",
+													Prose: {{prose .Prose}},
 													},
 											{{end}}
 										},
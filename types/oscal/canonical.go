@@ -0,0 +1,161 @@
+package oscal
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/yaml_json"
+	ssp "github.com/docker/oscalkit/types/oscal/system_security_plan"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+var markupType = reflect.TypeOf(validation_root.Markup{})
+
+// dateTimeTypes hold dateTime-with-timezone values, dateTypes hold dates
+var (
+	dateTimeTypes = map[reflect.Type]bool{
+		reflect.TypeOf(validation_root.Published("")):    true,
+		reflect.TypeOf(validation_root.LastModified("")): true,
+	}
+	dateTypes = map[reflect.Type]bool{
+		reflect.TypeOf(ssp.DateAuthorized("")): true,
+	}
+)
+
+// dateTimeLayouts are the accepted representations of dateTime values
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02 15:04:05Z07:00",
+}
+
+// dateLayouts are the accepted representations of date values
+var dateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02Z07:00",
+}
+
+// Canonicalize normalizes the document in place. Whitespace of the prose is
+// normalized as in HTML, dateTime values are written in RFC 3339 in UTC and
+// dates without time. Values that cannot be parsed are only trimmed.
+func (o *OSCAL) Canonicalize() error {
+	return canonicalize(reflect.ValueOf(o))
+}
+
+// Canonical normalizes the document with Canonicalize and writes it in the
+// canonical form of the format. The canonical form depends only on the
+// content of the document, so that equal documents are written byte for
+// byte equal:
+//
+// - elements and keys are ordered as in the metaschema, which is the order
+// the XML schema requires, map keys are sorted
+// - XML starts with the XML declaration and the OSCAL namespace is the
+// default namespace of the root element, without prefixes
+// - XML and JSON are indented by two spaces and end with a new line
+// - JSON strings are not HTML-escaped
+func (o *OSCAL) Canonical(w io.Writer, format constants.DocumentFormat) error {
+	if err := o.Canonicalize(); err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	switch format {
+	case constants.XmlFormat:
+		b.WriteString(xml.Header)
+		e := xml.NewEncoder(&b)
+		e.Indent("", "  ")
+		if err := e.Encode(o); err != nil {
+			return err
+		}
+		b.WriteString("\n")
+	case constants.JsonFormat, constants.YamlFormat:
+		e := json.NewEncoder(&b)
+		e.SetEscapeHTML(false)
+		e.SetIndent("", "  ")
+		if err := e.Encode(o); err != nil {
+			return err
+		}
+		if format == constants.YamlFormat {
+			yamlBytes, err := yaml_json.FromJSON(b.Bytes())
+			if err != nil {
+				return err
+			}
+			b.Reset()
+			b.Write(yamlBytes)
+		}
+	default:
+		return fmt.Errorf("Canonical form of %s format is not supported", format)
+	}
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+func canonicalize(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			return canonicalize(v.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := canonicalize(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		if v.Type() == markupType {
+			return canonicalMarkup(v.Addr().Interface().(*validation_root.Markup))
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			if err := canonicalize(v.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.String:
+		switch {
+		case dateTimeTypes[v.Type()]:
+			v.SetString(canonicalTime(v.String(), dateTimeLayouts, func(t time.Time, layout string) string {
+				return t.UTC().Format(time.RFC3339Nano)
+			}))
+		case dateTypes[v.Type()]:
+			// dates keep their time zone, if any
+			v.SetString(canonicalTime(v.String(), dateLayouts, time.Time.Format))
+		}
+	}
+	return nil
+}
+
+// canonicalMarkup normalizes whitespace of the prose and drops namespace
+// prefixes of its elements
+func canonicalMarkup(m *validation_root.Markup) error {
+	if strings.TrimSpace(m.Raw) == "" {
+		m.Raw = ""
+		return nil
+	}
+	f, err := m.Fragment()
+	if err != nil {
+		return fmt.Errorf("cannot normalize prose: %v", err)
+	}
+	m.Raw = f.XML()
+	return nil
+}
+
+// canonicalTime formats value parsed with one of the layouts
+func canonicalTime(value string, layouts []string, format func(t time.Time, layout string) string) string {
+	value = strings.TrimSpace(value)
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return format(t, layout)
+		}
+	}
+	return value
+}
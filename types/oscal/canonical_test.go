@@ -0,0 +1,176 @@
+package oscal
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/pkg/bundled"
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/xml_validation"
+	ssp "github.com/docker/oscalkit/types/oscal/system_security_plan"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+func TestCanonicalIsStable(t *testing.T) {
+	formats := []constants.DocumentFormat{constants.XmlFormat, constants.JsonFormat, constants.YamlFormat}
+	for docType, original := range testDocuments() {
+		for _, format := range formats {
+			var first bytes.Buffer
+			if err := original.Canonical(&first, format); err != nil {
+				t.Fatalf("cannot encode document type %d as canonical %s: %v", docType, format, err)
+			}
+			// the canonical form must survive decoding and encoding in any format
+			for _, via := range formats {
+				var intermediate bytes.Buffer
+				if err := original.Canonical(&intermediate, via); err != nil {
+					t.Fatal(err)
+				}
+				decoded, err := New(&intermediate)
+				if err != nil {
+					t.Fatalf("cannot decode canonical %s: %v", via, err)
+				}
+				var second bytes.Buffer
+				if err := decoded.Canonical(&second, format); err != nil {
+					t.Fatal(err)
+				}
+				if first.String() != second.String() {
+					t.Errorf("document type %d: canonical %s differs after decoding from %s:\n%s\n%s", docType, format, via, first.String(), second.String())
+				}
+			}
+		}
+	}
+}
+
+func TestCanonicalXML(t *testing.T) {
+	source := `<o:catalog xmlns:o="http://csrc.nist.gov/ns/oscal/1.0" id="c">
+  <o:metadata>
+    <o:title>Catalog</o:title>
+    <o:last-modified> 2019-10-01T02:00:00.500+02:00 </o:last-modified>
+  </o:metadata>
+  <o:control id="c-1">
+    <o:title>Control</o:title>
+    <o:part name="statement">
+      <o:p>
+        Develop   a <o:em>policy</o:em>.
+      </o:p>
+    </o:part>
+  </o:control>
+</o:catalog>`
+	o, err := New(strings.NewReader(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := o.Canonical(&b, constants.XmlFormat); err != nil {
+		t.Fatal(err)
+	}
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<catalog xmlns="http://csrc.nist.gov/ns/oscal/1.0" id="c">
+  <metadata>
+    <title>Catalog</title>
+    <last-modified>2019-10-01T00:00:00.5Z</last-modified>
+  </metadata>
+  <control id="c-1">
+    <title>Control</title>
+    <part name="statement"><p>Develop a <em>policy</em>.</p></part>
+  </control>
+</catalog>
+`
+	if b.String() != expected {
+		t.Errorf("unexpected canonical XML:\n%s\nexpected:\n%s", b.String(), expected)
+	}
+}
+
+// TestCanonicalValid checks that canonical XML of the valid XML fixtures of
+// the packages is valid, also after conversion from the canonical JSON
+func TestCanonicalValid(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "pkg", "*", "testdata", "*.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemas := map[constants.DocumentType]*xml_validation.Schema{}
+	schema := func(docType constants.DocumentType) *xml_validation.Schema {
+		if schemas[docType] == nil {
+			schemaFile, err := bundled.Schema(constants.XmlFormat, docType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer schemaFile.Cleanup()
+			if schemas[docType], err = xml_validation.Compile(schemaFile.Path); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return schemas[docType]
+	}
+	validated := 0
+	for _, file := range files {
+		source, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		o, err := New(bytes.NewReader(source))
+		if err != nil {
+			continue
+		}
+		s := schema(o.DocumentType())
+		if errs := s.Validate(source); len(errs) > 0 {
+			continue
+		}
+		validated++
+
+		var canonicalJSON, canonical, converted bytes.Buffer
+		if err := o.Canonical(&canonicalJSON, constants.JsonFormat); err != nil {
+			t.Fatal(err)
+		}
+		if err := o.Canonical(&canonical, constants.XmlFormat); err != nil {
+			t.Fatal(err)
+		}
+		if errs := s.Validate(canonical.Bytes()); len(errs) > 0 {
+			t.Errorf("canonical XML of %s is not valid: %v", file, errs)
+		}
+		fromJSON, err := New(&canonicalJSON)
+		if err != nil {
+			t.Fatalf("cannot decode canonical JSON of %s: %v", file, err)
+		}
+		if err := fromJSON.Canonical(&converted, constants.XmlFormat); err != nil {
+			t.Fatal(err)
+		}
+		if errs := s.Validate(converted.Bytes()); len(errs) > 0 {
+			t.Errorf("canonical XML of %s converted from canonical JSON is not valid: %v", file, errs)
+		}
+	}
+	if validated == 0 {
+		t.Error("no valid fixtures found")
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	o := &OSCAL{SystemSecurityPlan: &ssp.SystemSecurityPlan{
+		Id: "s",
+		Metadata: &validation_root.Metadata{
+			Published:    "2019-10-01T00:00:00Z",
+			LastModified: "not a date",
+		},
+		SystemCharacteristics: &ssp.SystemCharacteristics{
+			Description:    &validation_root.Markup{Raw: "\n  <p>A   system</p>\n"},
+			DateAuthorized: " 2019-10-01+02:00 ",
+		},
+	}}
+	if err := o.Canonicalize(); err != nil {
+		t.Fatal(err)
+	}
+	m := o.SystemSecurityPlan.Metadata
+	if m.Published != "2019-10-01T00:00:00Z" || m.LastModified != "not a date" {
+		t.Errorf("unexpected dates %q, %q", m.Published, m.LastModified)
+	}
+	c := o.SystemSecurityPlan.SystemCharacteristics
+	if c.DateAuthorized != "2019-10-01+02:00" {
+		t.Errorf("unexpected date %q", c.DateAuthorized)
+	}
+	if c.Description.Raw != "<p>A system</p>" {
+		t.Errorf("unexpected prose %q", c.Description.Raw)
+	}
+}
@@ -19,10 +19,10 @@ type Catalog struct {
 	Metadata *Metadata `xml:"metadata,omitempty" json:"metadata,omitempty"`
 	// Parameters provide a mechanism for the dynamic assignment of value(s) in a control.
 	Parameters []Param `xml:"param,omitempty" json:"parameters,omitempty"`
-	// A structured information object representing a security or privacy control. Each security or privacy control within the Catalog is defined by a distinct control instance.
-	Controls []Control `xml:"control,omitempty" json:"controls,omitempty"`
 	// A group of controls, or of groups of controls.
 	Groups []Group `xml:"group,omitempty" json:"groups,omitempty"`
+	// A structured information object representing a security or privacy control. Each security or privacy control within the Catalog is defined by a distinct control instance.
+	Controls []Control `xml:"control,omitempty" json:"controls,omitempty"`
 	// Back matter including references and resources.
 	BackMatter *BackMatter `xml:"back-matter,omitempty" json:"backMatter,omitempty"`
 }
@@ -37,10 +37,10 @@ type Group struct {
 
 	// A title for display and navigation
 	Title Title `xml:"title,omitempty" json:"title,omitempty"`
-	// A value with a name, attributed to the containing control, part, or group.
-	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
 	// Parameters provide a mechanism for the dynamic assignment of value(s) in a control.
 	Parameters []Param `xml:"param,omitempty" json:"parameters,omitempty"`
+	// A value with a name, attributed to the containing control, part, or group.
+	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
 	// A partition or component of a control or part
 	Parts []Part `xml:"part,omitempty" json:"parts,omitempty"`
 	// A group of controls, or of groups of controls.
@@ -59,14 +59,14 @@ type Control struct {
 
 	// A title for display and navigation
 	Title Title `xml:"title,omitempty" json:"title,omitempty"`
-	// A value with a name, attributed to the containing control, part, or group.
-	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
-	// A reference to a local or remote resource
-	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
 	// Parameters provide a mechanism for the dynamic assignment of value(s) in a control.
 	Parameters []Param `xml:"param,omitempty" json:"parameters,omitempty"`
+	// A value with a name, attributed to the containing control, part, or group.
+	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
 	// A name/value pair with optional explanatory remarks.
 	Annotations []Annotation `xml:"annotation,omitempty" json:"annotations,omitempty"`
+	// A reference to a local or remote resource
+	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
 	// A partition or component of a control or part
 	Parts []Part `xml:"part,omitempty" json:"parts,omitempty"`
 	// A structured information object representing a security or privacy control. Each security or privacy control within the Catalog is defined by a distinct control instance.
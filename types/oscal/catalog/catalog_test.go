@@ -2,6 +2,7 @@ package catalog
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -13,18 +14,10 @@ func TestModifyParts(t *testing.T) {
 	shouldNotChange := fmt.Sprintf(`this should not change <insert param-id="%s">`, parameterIDNotToChange)
 	afterChange := fmt.Sprintf(`this should change. %s`, parameterVal)
 	prose := Prose{
-		P: []P{
-			P{
-				Raw: shouldChange,
-			},
-		},
+		Raw: shouldChange,
 	}
 	nestedProse := Prose{
-		P: []P{
-			P{
-				Raw: shouldChange,
-			},
-		},
+		Raw: shouldChange,
 	}
 	c := Catalog{
 		Groups: []Group{
@@ -37,11 +30,7 @@ func TestModifyParts(t *testing.T) {
 								Parts: []Part{
 									Part{
 										Prose: &Prose{
-											P: []P{
-												P{
-													Raw: shouldNotChange,
-												},
-											},
+											Raw: shouldNotChange,
 										},
 										Parts: []Part{
 											Part{
@@ -60,14 +49,55 @@ func TestModifyParts(t *testing.T) {
 
 	c.Groups[0].Controls[0].Parts[0].ModifyProse(parameterIDToChange, parameterVal)
 
-	if c.Groups[0].Controls[0].Parts[0].Prose.P[0].Raw != afterChange {
+	if c.Groups[0].Controls[0].Parts[0].Prose.Raw != afterChange {
 		t.Error("part not modified")
 	}
 
-	if c.Groups[0].Controls[0].Parts[0].Parts[0].Prose.P[0].Raw != shouldNotChange {
+	if c.Groups[0].Controls[0].Parts[0].Parts[0].Prose.Raw != shouldNotChange {
 		t.Error("part got modified which shouldnt")
 	}
-	if c.Groups[0].Controls[0].Parts[0].Parts[0].Parts[0].Prose.P[0].Raw != afterChange {
+	if c.Groups[0].Controls[0].Parts[0].Parts[0].Parts[0].Prose.Raw != afterChange {
 		t.Error("part not modified")
 	}
 }
+
+func TestRenderControl(t *testing.T) {
+	c := Catalog{
+		Parameters: []Param{{Id: "cat_prm", Label: "organization-defined roles"}},
+		Groups: []Group{
+			{
+				Controls: []Control{
+					{
+						Id:         "ac-1",
+						Parameters: []Param{{Id: "ac-1_prm_1", Value: "monthly"}},
+						Parts: []Part{
+							{
+								Name:       "statement",
+								Properties: []Prop{{Name: "label", Value: "a."}},
+								Prose:      &Prose{Raw: `<p>Disseminate to <insert param-id="cat_prm"/> every <insert param-id="ac-1_prm_1"/>.</p>`},
+								Parts: []Part{
+									{
+										Properties: []Prop{{Name: "label", Value: "1."}},
+										Prose:      &Prose{Raw: `<p>Keep <insert param-id="unknown"/> &amp; more</p>`},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	ctrl := c.FindControl("ac-1")
+	rendered := c.RenderControl(*ctrl)
+	expected := "a. Disseminate to [Assignment: organization-defined roles] every monthly.\n  1. Keep & more"
+	if got := rendered.Statement().Text(); got != expected {
+		t.Errorf("expected rendered statement\n%s\ngot\n%s", expected, got)
+	}
+	if !strings.Contains(rendered.Parts[0].Parts[0].Prose.Raw, `<insert param-id="unknown"/>`) {
+		t.Error("insert of unknown parameter should be kept")
+	}
+	if !strings.Contains(ctrl.Parts[0].Prose.Raw, "<insert") {
+		t.Error("rendering should not modify the catalog")
+	}
+}
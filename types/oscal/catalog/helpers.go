@@ -29,3 +29,80 @@ func NewControl(id, title string, opts *ControlOpts) Control {
 	}
 	return ctrl
 }
+
+// WalkControls calls fn for every control of the catalog, including
+// controls of groups and nested controls, until fn returns false
+func (c *Catalog) WalkControls(fn func(ctrl *Control) bool) {
+	var controls func(ctrls []Control) bool
+	controls = func(ctrls []Control) bool {
+		for i := range ctrls {
+			if !fn(&ctrls[i]) || !controls(ctrls[i].Controls) {
+				return false
+			}
+		}
+		return true
+	}
+	var groups func(gs []Group) bool
+	groups = func(gs []Group) bool {
+		for i := range gs {
+			if !controls(gs[i].Controls) || !groups(gs[i].Groups) {
+				return false
+			}
+		}
+		return true
+	}
+	if controls(c.Controls) {
+		groups(c.Groups)
+	}
+}
+
+// FindControl finds control with id among controls of the catalog, its
+// groups and nested controls
+func (c *Catalog) FindControl(id string) *Control {
+	var found *Control
+	c.WalkControls(func(ctrl *Control) bool {
+		if ctrl.Id == id {
+			found = ctrl
+		}
+		return found == nil
+	})
+	return found
+}
+
+// FindParam finds parameter with id among parameters of the catalog, its
+// groups and controls
+func (c *Catalog) FindParam(id string) *Param {
+	if param := paramIn(c.Parameters, id); param != nil {
+		return param
+	}
+	var find func(groups []Group) *Param
+	find = func(groups []Group) *Param {
+		for i := range groups {
+			if param := paramIn(groups[i].Parameters, id); param != nil {
+				return param
+			}
+			if param := find(groups[i].Groups); param != nil {
+				return param
+			}
+		}
+		return nil
+	}
+	if param := find(c.Groups); param != nil {
+		return param
+	}
+	var found *Param
+	c.WalkControls(func(ctrl *Control) bool {
+		found = paramIn(ctrl.Parameters, id)
+		return found == nil
+	})
+	return found
+}
+
+func paramIn(params []Param, id string) *Param {
+	for i := range params {
+		if params[i].Id == id {
+			return &params[i]
+		}
+	}
+	return nil
+}
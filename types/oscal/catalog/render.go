@@ -0,0 +1,34 @@
+package catalog
+
+// RenderControl returns copy of the control with parameter inserts in its
+// parts replaced by the text of the parameters of the catalog. Inserts of
+// parameters missing in the catalog are kept. Nested controls are left out.
+func (c *Catalog) RenderControl(ctrl Control) Control {
+	value := func(id string) (string, bool) {
+		param := paramIn(ctrl.Parameters, id)
+		if param == nil {
+			param = c.FindParam(id)
+		}
+		if param == nil {
+			return "", false
+		}
+		return param.Text(), true
+	}
+	parts := make([]Part, len(ctrl.Parts))
+	for i := range ctrl.Parts {
+		parts[i] = ctrl.Parts[i].InsertParams(value)
+	}
+	ctrl.Parts = parts
+	ctrl.Controls = nil
+	return ctrl
+}
+
+// Statement returns the statement part of the control
+func (ctrl *Control) Statement() *Part {
+	for i := range ctrl.Parts {
+		if ctrl.Parts[i].Name == "statement" {
+			return &ctrl.Parts[i]
+		}
+	}
+	return nil
+}
@@ -10,10 +10,10 @@ import (
 // TBD
 type ComponentDefinition struct {
 
-	// Loads a component definition from another resource.
-	ImportComponentDefinitions []ImportComponentDefinition `xml:"import-component-definition,omitempty" json:"import-component-definitions,omitempty"`
 	// Provides information about the publication and availability of the containing document.
 	Metadata *Metadata `xml:"metadata,omitempty" json:"metadata,omitempty"`
+	// Loads a component definition from another resource.
+	ImportComponentDefinitions []ImportComponentDefinition `xml:"import-component-definition,omitempty" json:"import-component-definitions,omitempty"`
 	// A defined component that can be part of an implemented system.
 	Components []Component `xml:"component,omitempty" json:"components,omitempty"`
 	// A grouping of other components and/or capabilities.
@@ -40,12 +40,12 @@ type Component struct {
 	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
 	// A reference to a local or remote resource
 	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
-	// Additional commentary on the parent item.
-	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
 	// Defines a role associated with a party or parties that has responsibility for the component.
 	ResponsibleParties []ResponsibleParty `xml:"responsible-party,omitempty" json:"responsible-parties,omitempty"`
 	// Defines how the component or capability supports a set of controls.
 	ControlImplementations []ControlImplementation `xml:"control-implementation,omitempty" json:"control-implementations,omitempty"`
+	// Additional commentary on the parent item.
+	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
 }
 
 // A grouping of other components and/or capabilities.
@@ -62,14 +62,14 @@ type Capability struct {
 	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
 	// A reference to a local or remote resource
 	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
-	// Additional commentary on the parent item.
-	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
 	// TBD
 	IncorporatesCapabilities []IncorporatesCapability `xml:"incorporates-capability,omitempty" json:"incorporates-capabilities,omitempty"`
 	// TBD
 	IncorporatesComponents []IncorporatesComponent `xml:"incorporates-component,omitempty" json:"incorporates-components,omitempty"`
 	// Defines how the component or capability supports a set of controls.
 	ControlImplementations []ControlImplementation `xml:"control-implementation,omitempty" json:"control-implementations,omitempty"`
+	// Additional commentary on the parent item.
+	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
 }
 
 // Defines how the component or capability supports a set of controls.
@@ -93,10 +93,10 @@ type CanMeetRequirementSet struct {
 	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
 	// A reference to a local or remote resource
 	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
-	// Additional commentary on the parent item.
-	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
 	// TBD
 	ImplementedRequirements []ImplementedRequirement `xml:"implemented-requirement,omitempty" json:"implemented-requirements,omitempty"`
+	// Additional commentary on the parent item.
+	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
 }
 
 // TBD
@@ -109,6 +109,8 @@ type ImplementedRequirement struct {
 	// A reference to a control identifier.
 	ControlId string `xml:"control-id,attr,omitempty" json:"controlId,omitempty"`
 
+	// Describes which specific statements are addressed by a requirement, by pointing to a specific requirement statement within a control.
+	OnlyStatements []OnlyStatement `xml:"only-statement,omitempty" json:"only-statements,omitempty"`
 	// A description supporting the parent item.
 	Description *Description `xml:"description,omitempty" json:"description,omitempty"`
 	// A value with a name, attributed to the containing control, part, or group.
@@ -117,8 +119,6 @@ type ImplementedRequirement struct {
 	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
 	// Additional commentary on the parent item.
 	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
-	// Describes which specific statements are addressed by a requirement, by pointing to a specific requirement statement within a control.
-	OnlyStatements []OnlyStatement `xml:"only-statement,omitempty" json:"only-statements,omitempty"`
 }
 
 // Loads a component definition from another resource.
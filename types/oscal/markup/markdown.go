@@ -0,0 +1,286 @@
+package markup
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Markdown returns the Markdown representation of the fragment, as used in
+// JSON documents. Inline elements without Markdown syntax of their own are
+// written as follows, so that they survive the conversion back to XML:
+//
+//	<i>       _text_
+//	<b>       __text__
+//	<q>       "text"
+//	<sub>     ~text~
+//	<sup>     ^text^
+//	<insert>  {{ insert: param, id }}
+//
+// Markup without Markdown syntax, such as class attributes, tables without
+// header or elements outside of the OSCAL prose, is written as inline HTML.
+func (f *Fragment) Markdown() string {
+	var blocks []string
+	for _, n := range f.Nodes {
+		blocks = append(blocks, markdownBlock(n))
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+func markdownBlock(n *Node) string {
+	if n.Kind == TextNode {
+		return escapeBlockStart(markdownInline([]*Node{n}))
+	}
+	if !hasMarkdown(n) {
+		return markdownHTML(n)
+	}
+	switch n.Name {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level := int(n.Name[1] - '0')
+		return strings.Repeat("#", level) + " " + markdownInline(n.Children)
+	case "ul", "ol":
+		var lines []string
+		markdownList(n, "", &lines)
+		return strings.Join(lines, "\n")
+	case "pre":
+		return "```\n" + nodeText(n) + "\n```"
+	case "table":
+		return markdownTable(n)
+	default:
+		return escapeBlockStart(markdownInline(n.Children))
+	}
+}
+
+// markdownList writes list items one per line. Nested lists are indented by
+// the width of the item marker.
+func markdownList(list *Node, indent string, lines *[]string) {
+	number := 0
+	for _, li := range list.Children {
+		if li.Kind != ElementNode {
+			continue
+		}
+		marker := "- "
+		if list.Name == "ol" {
+			number++
+			marker = fmt.Sprintf("%d. ", number)
+		}
+		var inline, nested []*Node
+		for _, child := range li.Children {
+			if child.Kind == ElementNode && (child.Name == "ul" || child.Name == "ol") {
+				nested = append(nested, child)
+				continue
+			}
+			inline = append(inline, child)
+		}
+		*lines = append(*lines, strings.TrimRight(indent+marker+escapeBlockStart(markdownInline(inline)), " "))
+		for _, sub := range nested {
+			markdownList(sub, indent+strings.Repeat(" ", len(marker)), lines)
+		}
+	}
+}
+
+// markdownTable writes table with its first row as the header
+func markdownTable(table *Node) string {
+	var lines []string
+	for i, tr := range table.Children {
+		var cells []string
+		for _, cell := range tr.Children {
+			if cell.Kind == ElementNode {
+				cells = append(cells, markdownInline(cell.Children))
+			}
+		}
+		lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+		if i == 0 {
+			separators := make([]string, len(cells))
+			for j := range separators {
+				separators[j] = "---"
+			}
+			lines = append(lines, "| "+strings.Join(separators, " | ")+" |")
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func markdownInline(nodes []*Node) string {
+	var b strings.Builder
+	for _, n := range nodes {
+		if n.Kind == TextNode {
+			b.WriteString(escapeMarkdown(n.Text))
+			continue
+		}
+		if !IsInline(n.Name) || !hasMarkdown(n) {
+			b.WriteString(markdownHTML(n))
+			continue
+		}
+		switch n.Name {
+		case "em":
+			b.WriteString("*" + markdownInline(n.Children) + "*")
+		case "i":
+			b.WriteString("_" + markdownInline(n.Children) + "_")
+		case "strong":
+			b.WriteString("**" + markdownInline(n.Children) + "**")
+		case "b":
+			b.WriteString("__" + markdownInline(n.Children) + "__")
+		case "q":
+			b.WriteString(`"` + markdownInline(n.Children) + `"`)
+		case "sub":
+			b.WriteString("~" + markdownInline(n.Children) + "~")
+		case "sup":
+			b.WriteString("^" + markdownInline(n.Children) + "^")
+		case "code":
+			b.WriteString(codeSpan(nodeText(n)))
+		case "a":
+			b.WriteString("[" + markdownInline(n.Children) + "](" + destination(n.Attr("href")) + ")")
+		case "img":
+			b.WriteString("![" + escapeMarkdown(n.Attr("alt")) + "](" + destination(n.Attr("src")) + ")")
+		case "insert":
+			b.WriteString("{{ insert: param, " + n.Attr("param-id") + " }}")
+		}
+	}
+	return b.String()
+}
+
+// markdownAttrs lists the attributes with Markdown syntax
+var markdownAttrs = map[string]map[string]bool{
+	"a":      {"href": true},
+	"img":    {"alt": true, "src": true},
+	"insert": {"param-id": true},
+}
+
+// hasMarkdown reports whether the element has Markdown syntax. Inline
+// content of the element is checked as it is written.
+func hasMarkdown(n *Node) bool {
+	if !IsElement(n.Name) {
+		return false
+	}
+	for _, a := range n.Attrs {
+		if !markdownAttrs[n.Name][a.Name] {
+			return false
+		}
+	}
+	switch n.Name {
+	case "p":
+		// paragraph of single block written as HTML would be taken for the block
+		if len(n.Children) == 1 && n.Children[0].Kind == ElementNode && (IsBlock(n.Children[0].Name) || !IsElement(n.Children[0].Name)) {
+			return false
+		}
+	case "pre", "code":
+		for _, child := range n.Children {
+			if child.Kind != TextNode {
+				return false
+			}
+		}
+	case "ul", "ol":
+		for _, li := range n.Children {
+			if li.Kind != ElementNode || li.Name != "li" || !hasMarkdown(li) {
+				return false
+			}
+		}
+	case "li":
+		// nested lists are written after the inline content of the item
+		nested := false
+		for _, child := range n.Children {
+			if child.Kind == ElementNode && (child.Name == "ul" || child.Name == "ol") {
+				if !hasMarkdown(child) {
+					return false
+				}
+				nested = true
+			} else if nested {
+				return false
+			}
+		}
+	case "table":
+		if len(n.Children) == 0 {
+			return false
+		}
+		for i, tr := range n.Children {
+			if tr.Kind != ElementNode || tr.Name != "tr" || len(tr.Attrs) > 0 || len(tr.Children) == 0 {
+				return false
+			}
+			// the first row is the header
+			cell := "td"
+			if i == 0 {
+				cell = "th"
+			}
+			for _, c := range tr.Children {
+				if c.Kind != ElementNode || c.Name != cell || len(c.Attrs) > 0 {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// markdownHTML writes the element as inline HTML. New lines are written as
+// character references, so that the HTML stays on single line.
+func markdownHTML(n *Node) string {
+	var b strings.Builder
+	n.WriteXML(&b)
+	return strings.Replace(b.String(), "\n", "&#10;", -1)
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "~", `\~`, "^", `\^`, `"`, `\"`,
+	"[", `\[`, "]", `\]`, "{", `\{`, "}", `\}`, "|", `\|`,
+)
+
+// tagStart matches less-than signs which would start inline HTML
+var tagStart = regexp.MustCompile(`<([A-Za-z/])`)
+
+func escapeMarkdown(s string) string {
+	return tagStart.ReplaceAllString(markdownEscaper.Replace(s), `\<$1`)
+}
+
+// escapeBlockStart escapes characters which would start another kind of
+// block at the beginning of paragraph
+func escapeBlockStart(s string) string {
+	if s == "" {
+		return s
+	}
+	switch s[0] {
+	case '#', '-', '+', '>':
+		return `\` + s
+	}
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i > 0 && i < len(s) && s[i] == '.' {
+		return s[:i] + `\` + s[i:]
+	}
+	return s
+}
+
+// codeSpan encloses code in backticks not occurring in it
+func codeSpan(code string) string {
+	fence := "`"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(code, "`") || strings.HasSuffix(code, "`") || (strings.HasPrefix(code, " ") && strings.HasSuffix(code, " ") && strings.TrimSpace(code) != "") {
+		return fence + " " + code + " " + fence
+	}
+	return fence + code + fence
+}
+
+// destination encloses link destinations with spaces or parentheses in
+// angle brackets
+func destination(href string) string {
+	if strings.ContainsAny(href, " ()<>") {
+		return "<" + strings.NewReplacer("<", `\<`, ">", `\>`).Replace(href) + ">"
+	}
+	return href
+}
+
+// nodeText returns the text content of the node
+func nodeText(n *Node) string {
+	if n.Kind == TextNode {
+		return n.Text
+	}
+	var b strings.Builder
+	for _, child := range n.Children {
+		b.WriteString(nodeText(child))
+	}
+	return b.String()
+}
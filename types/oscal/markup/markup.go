@@ -0,0 +1,367 @@
+// Package markup implements the typed model of OSCAL prose. Prose is a
+// subset of HTML, represented as XHTML in XML documents and as Markdown in
+// JSON and YAML documents. The model converts between both representations.
+package markup
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Kind distinguishes element and text nodes
+type Kind int
+
+const (
+	// TextNode holds character data
+	TextNode Kind = iota
+	// ElementNode holds markup element with its attributes and children
+	ElementNode
+)
+
+// Attr is an attribute of element
+type Attr struct {
+	Name  string
+	Value string
+}
+
+// Node is an element or text of the markup
+type Node struct {
+	Kind     Kind
+	Name     string
+	Attrs    []Attr
+	Text     string
+	Children []*Node
+}
+
+// Fragment is a sequence of markup nodes, usually block elements
+type Fragment struct {
+	Nodes []*Node
+}
+
+// block elements of the OSCAL prose
+var blockElements = map[string]bool{
+	"p": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"ul": true, "ol": true, "li": true, "pre": true, "table": true, "tr": true, "th": true, "td": true,
+}
+
+// inline elements of the OSCAL prose
+var inlineElements = map[string]bool{
+	"q": true, "code": true, "em": true, "i": true, "strong": true, "b": true,
+	"sub": true, "sup": true, "img": true, "a": true, "insert": true,
+}
+
+// elements holding blocks rather than inline content
+var containerElements = map[string]bool{
+	"ul": true, "ol": true, "table": true, "tr": true,
+}
+
+// IsElement reports whether name is an element of the OSCAL prose
+func IsElement(name string) bool {
+	return blockElements[name] || inlineElements[name]
+}
+
+// IsInline reports whether name is an inline element of the OSCAL prose
+func IsInline(name string) bool {
+	return inlineElements[name]
+}
+
+// IsBlock reports whether name is a top level block element of the OSCAL
+// prose, one that may appear directly in a part
+func IsBlock(name string) bool {
+	return blockElements[name] && name != "li" && name != "tr" && name != "th" && name != "td"
+}
+
+// Element creates element node
+func Element(name string, children ...*Node) *Node {
+	return &Node{Kind: ElementNode, Name: name, Children: children}
+}
+
+// Text creates text node
+func Text(text string) *Node {
+	return &Node{Kind: TextNode, Text: text}
+}
+
+// Attr returns value of the attribute
+func (n *Node) Attr(name string) string {
+	for _, a := range n.Attrs {
+		if a.Name == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// SetAttr sets value of the attribute
+func (n *Node) SetAttr(name, value string) {
+	for i := range n.Attrs {
+		if n.Attrs[i].Name == name {
+			n.Attrs[i].Value = value
+			return
+		}
+	}
+	n.Attrs = append(n.Attrs, Attr{Name: name, Value: value})
+}
+
+// ParseXML parses XHTML markup, as found in XML documents. Whitespace is
+// normalized as in HTML, except in pre elements. Inline content outside
+// of blocks is wrapped in paragraphs. Elements outside of the OSCAL prose
+// are kept, so that they survive the conversions.
+func ParseXML(raw string) (*Fragment, error) {
+	d := xml.NewDecoder(strings.NewReader("<markup>" + raw + "</markup>"))
+	d.Entity = xml.HTMLEntity
+	if _, err := d.Token(); err != nil {
+		return nil, fmt.Errorf("invalid markup: %v", err)
+	}
+	root := Element("markup")
+	if err := decodeChildren(d, root); err != nil {
+		return nil, fmt.Errorf("invalid markup: %v", err)
+	}
+	normalize(root, false)
+	return &Fragment{Nodes: wrapInline(root.Children)}, nil
+}
+
+// DecodeElement decodes single markup element from decoder. It is used to
+// collect prose interleaved with other elements of OSCAL models.
+func DecodeElement(d *xml.Decoder, start xml.StartElement) (*Node, error) {
+	n := element(start)
+	if err := decodeChildren(d, n); err != nil {
+		return nil, err
+	}
+	normalize(n, false)
+	return n, nil
+}
+
+func element(start xml.StartElement) *Node {
+	n := Element(start.Name.Local)
+	for _, a := range start.Attr {
+		if a.Name.Space == "xmlns" || a.Name.Local == "xmlns" {
+			continue
+		}
+		n.Attrs = append(n.Attrs, Attr{Name: a.Name.Local, Value: a.Value})
+	}
+	return n
+}
+
+func decodeChildren(d *xml.Decoder, parent *Node) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			child := element(t)
+			if err := decodeChildren(d, child); err != nil {
+				return err
+			}
+			parent.Children = append(parent.Children, child)
+		case xml.CharData:
+			parent.Children = append(parent.Children, Text(string(t)))
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+var whitespace = regexp.MustCompile(`\s+`)
+
+// normalize collapses whitespace, drops whitespace between blocks and trims
+// whitespace at the start and the end of blocks
+func normalize(n *Node, pre bool) {
+	pre = pre || n.Name == "pre"
+	var children []*Node
+	for _, child := range n.Children {
+		if child.Kind == TextNode {
+			if !pre {
+				child.Text = whitespace.ReplaceAllString(child.Text, " ")
+			}
+			if child.Text == "" {
+				continue
+			}
+			if last := len(children) - 1; last >= 0 && children[last].Kind == TextNode {
+				children[last].Text += child.Text
+				continue
+			}
+		} else {
+			normalize(child, pre)
+		}
+		children = append(children, child)
+	}
+	n.Children = children
+	if pre {
+		return
+	}
+
+	if containerElements[n.Name] || n.Name == "markup" {
+		var blocks []*Node
+		for _, child := range n.Children {
+			if child.Kind == TextNode && strings.TrimSpace(child.Text) == "" {
+				continue
+			}
+			blocks = append(blocks, child)
+		}
+		n.Children = blocks
+		if n.Name != "markup" {
+			for _, child := range n.Children {
+				if child.Kind == TextNode {
+					child.Text = strings.TrimSpace(child.Text)
+				}
+			}
+		}
+		return
+	}
+	if blockElements[n.Name] {
+		trimStart(n)
+		trimEnd(n)
+	}
+}
+
+// trimStart removes leading whitespace of the first text in the block
+func trimStart(n *Node) {
+	for len(n.Children) > 0 {
+		first := n.Children[0]
+		if first.Kind == ElementNode {
+			if !containerElements[first.Name] {
+				trimStart(first)
+			}
+			return
+		}
+		first.Text = strings.TrimLeft(first.Text, " ")
+		if first.Text != "" {
+			return
+		}
+		n.Children = n.Children[1:]
+	}
+}
+
+// trimEnd removes trailing whitespace of the last text in the block and
+// before the nested lists of list items
+func trimEnd(n *Node) {
+	for end := len(n.Children); end > 0; end-- {
+		last := n.Children[end-1]
+		if last.Kind == ElementNode {
+			if containerElements[last.Name] {
+				continue
+			}
+			trimEnd(last)
+			return
+		}
+		last.Text = strings.TrimRight(last.Text, " ")
+		if last.Text != "" {
+			return
+		}
+		n.Children = append(n.Children[:end-1], n.Children[end:]...)
+	}
+}
+
+// wrapInline wraps inline content among blocks into paragraphs. Elements
+// outside of the OSCAL prose are taken for blocks unless they are next to
+// inline content.
+func wrapInline(nodes []*Node) []*Node {
+	inline := func(i int) bool {
+		return i >= 0 && i < len(nodes) && (nodes[i].Kind == TextNode || IsElement(nodes[i].Name) && !IsBlock(nodes[i].Name))
+	}
+	var result []*Node
+	var p *Node
+	for i, n := range nodes {
+		if n.Kind == ElementNode && (IsBlock(n.Name) || !IsElement(n.Name) && !inline(i-1) && !inline(i+1)) {
+			p = nil
+			result = append(result, n)
+			continue
+		}
+		if p == nil {
+			p = Element("p")
+			result = append(result, p)
+		}
+		p.Children = append(p.Children, n)
+	}
+	for _, n := range result {
+		if n.Name == "p" {
+			trimStart(n)
+			trimEnd(n)
+		}
+	}
+	return result
+}
+
+// XML returns the XHTML representation of the fragment
+func (f *Fragment) XML() string {
+	var b strings.Builder
+	for _, n := range f.Nodes {
+		n.WriteXML(&b)
+	}
+	return b.String()
+}
+
+// WriteXML writes XHTML representation of the node
+func (n *Node) WriteXML(w io.Writer) {
+	if n.Kind == TextNode {
+		io.WriteString(w, escapeText(n.Text))
+		return
+	}
+	io.WriteString(w, "<"+n.Name)
+	for _, a := range n.Attrs {
+		io.WriteString(w, fmt.Sprintf(` %s="%s"`, a.Name, escapeAttr(a.Value)))
+	}
+	if len(n.Children) == 0 && (n.Name == "insert" || n.Name == "img") {
+		io.WriteString(w, "/>")
+		return
+	}
+	io.WriteString(w, ">")
+	for _, child := range n.Children {
+		child.WriteXML(w)
+	}
+	io.WriteString(w, "</"+n.Name+">")
+}
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func escapeAttr(s string) string {
+	return attrEscaper.Replace(s)
+}
+
+// Text returns the fragment as plain text with whitespace collapsed
+func (f *Fragment) Text() string {
+	var text []string
+	var walk func(n *Node)
+	walk = func(n *Node) {
+		if n.Kind == TextNode {
+			text = append(text, n.Text)
+			return
+		}
+		if blockElements[n.Name] {
+			text = append(text, " ")
+		}
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	for _, n := range f.Nodes {
+		walk(n)
+	}
+	return strings.Join(strings.Fields(strings.Join(text, "")), " ")
+}
+
+// Walk calls fn for every node of the fragment in document order
+func (f *Fragment) Walk(fn func(n *Node)) {
+	var walk func(n *Node)
+	walk = func(n *Node) {
+		fn(n)
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	for _, n := range f.Nodes {
+		walk(n)
+	}
+}
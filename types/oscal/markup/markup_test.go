@@ -0,0 +1,124 @@
+package markup
+
+import (
+	"testing"
+)
+
+func TestParseXML(t *testing.T) {
+	cases := []struct {
+		raw      string
+		expected string
+	}{
+		{"<p>plain</p>", "<p>plain</p>"},
+		{"\n  <p>\n    spaced\n    text </p>\n  <p>next</p>\n", "<p>spaced text</p><p>next</p>"},
+		{"stray <em>inline</em>", "<p>stray <em>inline</em></p>"},
+		{"<pre>  keep\n  this </pre>", "<pre>  keep\n  this </pre>"},
+		{"<p>a &amp; b &lt; c &gt; d&nbsp;e</p>", "<p>a &amp; b &lt; c &gt; d e</p>"},
+		{`<p>set <insert param-id="ac-1_prm_1" /></p>`, `<p>set <insert param-id="ac-1_prm_1"/></p>`},
+		{"<ul>\n <li>one\n  <ul><li>nested</li></ul>\n </li>\n</ul>", "<ul><li>one<ul><li>nested</li></ul></li></ul>"},
+		{`<p class="x">kept <span>unknown</span></p>`, `<p class="x">kept <span>unknown</span></p>`},
+		{"<div>block</div> <p>next</p>", "<div>block</div><p>next</p>"},
+		{"text <span>next to</span> text", "<p>text <span>next to</span> text</p>"},
+	}
+	for _, tc := range cases {
+		f, err := ParseXML(tc.raw)
+		if err != nil {
+			t.Errorf("cannot parse %q: %v", tc.raw, err)
+			continue
+		}
+		if got := f.XML(); got != tc.expected {
+			t.Errorf("parsing %q\nexpected: %q\ngot:      %q", tc.raw, tc.expected, got)
+		}
+	}
+}
+
+func TestParseXMLInvalid(t *testing.T) {
+	for _, raw := range []string{"<p>unclosed", "<p><em>x</p></em>"} {
+		if _, err := ParseXML(raw); err == nil {
+			t.Errorf("expected error parsing %q", raw)
+		}
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	cases := []struct {
+		xml      string
+		markdown string
+	}{
+		{"<p>plain</p><p>second</p>", "plain\n\nsecond"},
+		{"<h2>Title</h2>", "## Title"},
+		{"<p><em>a</em> <strong>b</strong> <i>c</i> <b>d</b></p>", "*a* **b** _c_ __d__"},
+		{"<p><q>quoted</q> H<sub>2</sub>O x<sup>2</sup></p>", `"quoted" H~2~O x^2^`},
+		{"<p>run <code>go test</code></p>", "run `go test`"},
+		{`<p><a href="https://example.com">link</a></p>`, "[link](https://example.com)"},
+		{`<p><a href="a b.html">spaced</a></p>`, "[spaced](<a b.html>)"},
+		{`<p>set <insert param-id="ac-1_prm_1"/></p>`, "set {{ insert: param, ac-1_prm_1 }}"},
+		{"<ul><li>one<ol><li>first</li><li>second</li></ol></li><li>two</li></ul>", "- one\n  1. first\n  2. second\n- two"},
+		{"<pre>a *b*\n  c</pre>", "```\na *b*\n  c\n```"},
+		{"<table><tr><th>h1</th><th>h2</th></tr><tr><td>a|b</td><td>c</td></tr></table>", "| h1 | h2 |\n| --- | --- |\n| a\\|b | c |"},
+		{"<p>escaped *stars* and [brackets] and_underscores_</p>", `escaped \*stars\* and \[brackets\] and\_underscores\_`},
+		{"<p># not a heading</p><p>1. not a list</p>", `\# not a heading` + "\n\n" + `1\. not a list`},
+		{"<p>a &lt;b&gt; c</p>", `a \<b> c`},
+		// markup without markdown syntax
+		{`<p class="lead">intro</p><p>next</p>`, `<p class="lead">intro</p>` + "\n\n" + "next"},
+		{`<p>see <span class="term">this</span> and <em>that <sub class="x">2</sub></em></p>`, `see <span class="term">this</span> and *that <sub class="x">2</sub>*`},
+		{"<div><p>nested</p></div>", "<div><p>nested</p></div>"},
+		{"<p><span>only</span></p>", "<p><span>only</span></p>"},
+		{`<pre class="go">a *b*` + "\n\n" + `c</pre>`, `<pre class="go">a *b*&#10;&#10;c</pre>`},
+		{"<table><tr><td>no header</td></tr></table>", "<table><tr><td>no header</td></tr></table>"},
+		{`<table><tr><th>h</th></tr><tr><td><span class="x">a|b</span></td></tr></table>`, "| h |\n| --- |\n" + `| <span class="x">a|b</span> |`},
+		{`<ul><li class="done">one</li></ul>`, `<ul><li class="done">one</li></ul>`},
+		{`<p>set <insert param-id="p1" class="x"/></p>`, `set <insert param-id="p1" class="x"/>`},
+	}
+	for _, tc := range cases {
+		f, err := ParseXML(tc.xml)
+		if err != nil {
+			t.Fatalf("cannot parse %q: %v", tc.xml, err)
+		}
+		if got := f.Markdown(); got != tc.markdown {
+			t.Errorf("converting %q\nexpected: %q\ngot:      %q", tc.xml, tc.markdown, got)
+		}
+		back, err := ParseMarkdown(tc.markdown)
+		if err != nil {
+			t.Fatalf("cannot parse %q: %v", tc.markdown, err)
+		}
+		if got := back.XML(); got != tc.xml {
+			t.Errorf("round trip of %q\nexpected: %q\ngot:      %q", tc.markdown, tc.xml, got)
+		}
+	}
+}
+
+func TestParseMarkdown(t *testing.T) {
+	cases := []struct {
+		markdown string
+		expected string
+	}{
+		{"one\nparagraph\n\nanother", "<p>one paragraph</p><p>another</p>"},
+		{"intro\n* a\n* b", "<p>intro</p><ul><li>a</li><li>b</li></ul>"},
+		{"- item\n  continued", "<ul><li>item continued</li></ul>"},
+		{"set {{ ac-1_prm_1 }} yearly", `<p>set <insert param-id="ac-1_prm_1"/> yearly</p>`},
+		{"snake_case_name", "<p>snake_case_name</p>"},
+		{"![diagram](img.png)", `<p><img alt="diagram" src="img.png"/></p>`},
+		{"unclosed *emphasis", "<p>unclosed *emphasis</p>"},
+		{"a < b & c", "<p>a &lt; b &amp; c</p>"},
+	}
+	for _, tc := range cases {
+		f, err := ParseMarkdown(tc.markdown)
+		if err != nil {
+			t.Fatalf("cannot parse %q: %v", tc.markdown, err)
+		}
+		if got := f.XML(); got != tc.expected {
+			t.Errorf("parsing %q\nexpected: %q\ngot:      %q", tc.markdown, tc.expected, got)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	f, err := ParseXML("<p>first <em>line</em></p><ul><li>item</li></ul>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.Text(); got != "first line item" {
+		t.Errorf("unexpected text %q", got)
+	}
+}
@@ -0,0 +1,458 @@
+package markup
+
+import (
+	"encoding/xml"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	headingLine   = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*$`)
+	listItemLine  = regexp.MustCompile(`^( *)([-*+]|\d+\.)(?: +(.*)|$)`)
+	tableSepLine  = regexp.MustCompile(`^\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+	fenceLine     = regexp.MustCompile("^(```+|~~~+)")
+	insertPattern = regexp.MustCompile(`^\{\{\s*(?:insert\s*:\s*param\s*,\s*)?([^\s{}]+)\s*\}\}`)
+)
+
+// ParseMarkdown parses the Markdown representation of markup, as found in
+// JSON documents. It understands the Markdown written by Fragment.Markdown
+// as well as the common block and inline syntax of hand written prose.
+func ParseMarkdown(md string) (*Fragment, error) {
+	lines := strings.Split(strings.Replace(md, "\r\n", "\n", -1), "\n")
+	f := &Fragment{}
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			i++
+		case fenceLine.MatchString(trimmed):
+			fence := fenceLine.FindString(trimmed)
+			var code []string
+			i++
+			for i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), fence) {
+				code = append(code, lines[i])
+				i++
+			}
+			i++
+			f.Nodes = append(f.Nodes, Element("pre", Text(strings.Join(code, "\n"))))
+		case headingLine.MatchString(trimmed):
+			m := headingLine.FindStringSubmatch(trimmed)
+			f.Nodes = append(f.Nodes, Element("h"+string('0'+rune(len(m[1]))), parseInline(m[2])...))
+			i++
+		case listItemLine.MatchString(line):
+			start := i
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "" {
+				i++
+			}
+			f.Nodes = append(f.Nodes, parseLists(lines[start:i])...)
+		case strings.HasPrefix(trimmed, "|"):
+			var rows []string
+			for i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), "|") {
+				rows = append(rows, strings.TrimSpace(lines[i]))
+				i++
+			}
+			f.Nodes = append(f.Nodes, parseTable(rows))
+		default:
+			var text []string
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "" && !startsBlock(lines[i]) {
+				text = append(text, strings.TrimSpace(lines[i]))
+				i++
+			}
+			if len(text) == 0 {
+				text = append(text, trimmed)
+				i++
+			}
+			inline := parseInline(strings.Join(text, " "))
+			if len(inline) == 1 && inline[0].Kind == ElementNode && (IsBlock(inline[0].Name) || !IsElement(inline[0].Name)) {
+				// block written as HTML
+				f.Nodes = append(f.Nodes, inline[0])
+				continue
+			}
+			f.Nodes = append(f.Nodes, Element("p", inline...))
+		}
+	}
+	root := Element("markup", f.Nodes...)
+	normalize(root, false)
+	f.Nodes = root.Children
+	return f, nil
+}
+
+// startsBlock reports whether line interrupts paragraph
+func startsBlock(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return headingLine.MatchString(trimmed) || fenceLine.MatchString(trimmed) ||
+		listItemLine.MatchString(line) || strings.HasPrefix(trimmed, "|")
+}
+
+// parseLists builds lists from consecutive list item lines. Items indented
+// more than the preceding item form nested lists, other lines continue the
+// preceding item.
+func parseLists(lines []string) []*Node {
+	type level struct {
+		indent int
+		list   *Node
+	}
+	type item struct {
+		node *Node
+		text []string
+	}
+	var roots []*Node
+	var stack []level
+	var items []*item
+	var last *item
+	for _, line := range lines {
+		m := listItemLine.FindStringSubmatch(line)
+		if m == nil {
+			if last != nil {
+				last.text = append(last.text, strings.TrimSpace(line))
+			}
+			continue
+		}
+		indent := len(m[1])
+		name := "ul"
+		if strings.HasSuffix(m[2], ".") {
+			name = "ol"
+		}
+		for len(stack) > 0 && stack[len(stack)-1].indent > indent {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) > 0 && stack[len(stack)-1].indent == indent && stack[len(stack)-1].list.Name != name {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 || stack[len(stack)-1].indent < indent {
+			list := Element(name)
+			if len(stack) == 0 || last == nil {
+				roots = append(roots, list)
+			} else {
+				last.node.Children = append(last.node.Children, list)
+			}
+			stack = append(stack, level{indent: indent, list: list})
+		}
+		last = &item{node: Element("li"), text: []string{m[3]}}
+		items = append(items, last)
+		top := stack[len(stack)-1].list
+		top.Children = append(top.Children, last.node)
+	}
+	for _, it := range items {
+		inline := parseInline(strings.TrimSpace(strings.Join(it.text, " ")))
+		it.node.Children = append(inline, it.node.Children...)
+	}
+	return roots
+}
+
+// parseTable builds table from rows, the first one being the header
+func parseTable(rows []string) *Node {
+	table := Element("table")
+	for i, row := range rows {
+		if i == 1 && tableSepLine.MatchString(row) {
+			continue
+		}
+		cell := "td"
+		if i == 0 {
+			cell = "th"
+		}
+		tr := Element("tr")
+		for _, text := range splitCells(row) {
+			tr.Children = append(tr.Children, Element(cell, parseInline(strings.TrimSpace(text))...))
+		}
+		table.Children = append(table.Children, tr)
+	}
+	return table
+}
+
+// splitCells splits table row on the unescaped pipes
+func splitCells(row string) []string {
+	row = strings.TrimPrefix(row, "|")
+	if strings.HasSuffix(row, "|") && !strings.HasSuffix(row, `\|`) {
+		row = row[:len(row)-1]
+	}
+	var cells []string
+	start := 0
+	for i := 0; i < len(row); i++ {
+		switch row[i] {
+		case '\\':
+			i++
+		case '`':
+			if end := closingBackticks(row, i); end > 0 {
+				i = end - 1
+			}
+		case '<':
+			if n, length := parseHTML(row[i:]); n != nil {
+				i += length - 1
+			}
+		case '|':
+			cells = append(cells, row[start:i])
+			start = i + 1
+		}
+	}
+	return append(cells, row[start:])
+}
+
+// inline delimiters and the elements they enclose, longest first
+var delimiters = []struct {
+	delim, element string
+}{
+	{"**", "strong"},
+	{"__", "b"},
+	{"*", "em"},
+	{"_", "i"},
+	{`"`, "q"},
+	{"~", "sub"},
+	{"^", "sup"},
+}
+
+// parseInline parses inline Markdown into nodes
+func parseInline(s string) []*Node {
+	var nodes []*Node
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, Text(text.String()))
+			text.Reset()
+		}
+	}
+	add := func(n *Node) {
+		flush()
+		nodes = append(nodes, n)
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && isPunct(s[i+1]):
+			text.WriteByte(s[i+1])
+			i += 2
+			continue
+		case c == '`':
+			if end := closingBackticks(s, i); end > 0 {
+				add(Element("code", Text(codeContent(s[i:end]))))
+				i = end
+				continue
+			}
+		case c == '{' && strings.HasPrefix(s[i:], "{{"):
+			if m := insertPattern.FindStringSubmatch(s[i:]); m != nil {
+				insert := Element("insert")
+				insert.SetAttr("param-id", m[1])
+				add(insert)
+				i += len(m[0])
+				continue
+			}
+		case c == '<':
+			if n, length := parseHTML(s[i:]); n != nil {
+				add(n)
+				i += length
+				continue
+			}
+		case c == '!' && strings.HasPrefix(s[i:], "!["):
+			if label, href, end, ok := parseLink(s, i+1); ok {
+				img := Element("img")
+				img.SetAttr("alt", unescapeMarkdown(label))
+				img.SetAttr("src", href)
+				add(img)
+				i = end
+				continue
+			}
+		case c == '[':
+			if label, href, end, ok := parseLink(s, i); ok {
+				a := Element("a", parseInline(label)...)
+				a.SetAttr("href", href)
+				add(a)
+				i = end
+				continue
+			}
+		}
+		if n, end := parseDelimited(s, i); n != nil {
+			add(n)
+			i = end
+			continue
+		}
+		text.WriteByte(c)
+		i++
+	}
+	flush()
+	return nodes
+}
+
+// parseDelimited parses emphasis like span starting at i
+func parseDelimited(s string, i int) (*Node, int) {
+	for _, d := range delimiters {
+		if !strings.HasPrefix(s[i:], d.delim) {
+			continue
+		}
+		if d.delim[0] == '_' && i > 0 && isWordChar(s, i-1) {
+			return nil, 0
+		}
+		start := i + len(d.delim)
+		end := findClosing(s, start, d.delim)
+		if end <= start {
+			continue
+		}
+		return Element(d.element, parseInline(s[start:end])...), end + len(d.delim)
+	}
+	return nil, 0
+}
+
+// findClosing finds the closing delimiter skipping escapes, code spans and
+// nested spans of the longer delimiters
+func findClosing(s string, start int, delim string) int {
+	for j := start; j < len(s); j++ {
+		switch {
+		case s[j] == '\\':
+			j++
+			continue
+		case s[j] == '`':
+			if end := closingBackticks(s, j); end > 0 {
+				j = end - 1
+				continue
+			}
+		case s[j] == '<':
+			if n, length := parseHTML(s[j:]); n != nil {
+				j += length - 1
+				continue
+			}
+		}
+		if len(delim) == 1 && (delim == "*" || delim == "_") && strings.HasPrefix(s[j:], delim+delim) {
+			if end := findClosing(s, j+2, delim+delim); end > 0 {
+				j = end + 1
+				continue
+			}
+		}
+		if strings.HasPrefix(s[j:], delim) {
+			if delim[0] == '_' && j+len(delim) < len(s) && isWordChar(s, j+len(delim)) {
+				continue
+			}
+			return j
+		}
+	}
+	return -1
+}
+
+// parseLink parses [label](destination) starting at i
+func parseLink(s string, i int) (label, href string, end int, ok bool) {
+	depth := 0
+	j := i
+	for ; j < len(s); j++ {
+		switch s[j] {
+		case '\\':
+			j++
+			continue
+		case '[':
+			depth++
+		case ']':
+			depth--
+		}
+		if depth == 0 {
+			break
+		}
+	}
+	if j >= len(s) || j+1 >= len(s) || s[j+1] != '(' {
+		return "", "", 0, false
+	}
+	label = s[i+1 : j]
+	k := j + 2
+	if k < len(s) && s[k] == '<' {
+		close := strings.Index(s[k:], ">")
+		for close > 0 && s[k+close-1] == '\\' {
+			next := strings.Index(s[k+close+1:], ">")
+			if next < 0 {
+				close = -1
+				break
+			}
+			close += next + 1
+		}
+		if close < 0 || k+close+1 >= len(s) || s[k+close+1] != ')' {
+			return "", "", 0, false
+		}
+		href = strings.NewReplacer(`\<`, "<", `\>`, ">").Replace(s[k+1 : k+close])
+		return label, href, k + close + 2, true
+	}
+	close := strings.IndexByte(s[k:], ')')
+	if close < 0 {
+		return "", "", 0, false
+	}
+	return label, strings.TrimSpace(s[k : k+close]), k + close + 1, true
+}
+
+// parseHTML parses element written as inline HTML at the start of s. It
+// returns nil if s does not start with well-formed element.
+func parseHTML(s string) (*Node, int) {
+	if len(s) < 2 || !unicode.IsLetter(rune(s[1])) {
+		return nil, 0
+	}
+	d := xml.NewDecoder(strings.NewReader(s))
+	d.Entity = xml.HTMLEntity
+	token, err := d.Token()
+	start, ok := token.(xml.StartElement)
+	if err != nil || !ok {
+		return nil, 0
+	}
+	n := element(start)
+	if err := decodeChildren(d, n); err != nil {
+		return nil, 0
+	}
+	return n, int(d.InputOffset())
+}
+
+// closingBackticks returns end of code span starting at i or -1
+func closingBackticks(s string, i int) int {
+	n := 0
+	for i+n < len(s) && s[i+n] == '`' {
+		n++
+	}
+	fence := strings.Repeat("`", n)
+	for j := i + n; j < len(s); {
+		k := strings.Index(s[j:], fence)
+		if k < 0 {
+			return -1
+		}
+		k += j
+		m := 0
+		for k+m < len(s) && s[k+m] == '`' {
+			m++
+		}
+		if m == n {
+			return k + n
+		}
+		j = k + m
+	}
+	return -1
+}
+
+// codeContent strips the backticks and the padding spaces of code span
+func codeContent(span string) string {
+	n := 0
+	for n < len(span) && span[n] == '`' {
+		n++
+	}
+	code := span[n : len(span)-n]
+	if len(code) >= 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+		code = code[1 : len(code)-1]
+	}
+	return code
+}
+
+func unescapeMarkdown(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && isPunct(s[i+1]) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+func isPunct(c byte) bool {
+	return c < utf8.RuneSelf && unicode.IsPunct(rune(c)) || c == '^' || c == '`' || c == '|' || c == '~' || c == '+' || c == '<' || c == '>'
+}
+
+func isWordChar(s string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	if r == utf8.RuneError && i > 0 {
+		r, _ = utf8.DecodeLastRuneInString(s[:i+1])
+	}
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,41 @@
+package nominal_catalog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// how-many of selection allowing multiple choices
+const oneOrMore = "one-or-more"
+
+// Text returns the text inserted into prose in place of the parameter. The
+// value takes precedence, followed by the constraints. Otherwise the
+// parameter is rendered as a selection of its choices or an assignment of
+// its label, in the style of NIST SP 800-53.
+func (p *Param) Text() string {
+	if p.Value != "" {
+		return string(p.Value)
+	}
+	if len(p.Constraints) > 0 {
+		var constraints []string
+		for _, c := range p.Constraints {
+			constraints = append(constraints, strings.TrimSpace(c.Value))
+		}
+		return strings.Join(constraints, "; ")
+	}
+	if p.Select != nil && len(p.Select.Alternatives) > 0 {
+		var choices []string
+		for _, choice := range p.Select.Alternatives {
+			choices = append(choices, strings.TrimSpace(string(choice)))
+		}
+		kind := "Selection"
+		if p.Select.HowMany == oneOrMore {
+			kind = "Selection (one or more)"
+		}
+		return fmt.Sprintf("[%s: %s]", kind, strings.Join(choices, "; "))
+	}
+	if p.Label != "" {
+		return fmt.Sprintf("[Assignment: %s]", p.Label)
+	}
+	return fmt.Sprintf("[Assignment: %s]", p.Id)
+}
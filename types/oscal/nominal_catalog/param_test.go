@@ -0,0 +1,40 @@
+package nominal_catalog
+
+import "testing"
+
+func TestParamText(t *testing.T) {
+	cases := []struct {
+		param    Param
+		expected string
+	}{
+		{Param{Id: "p", Label: "frequency", Value: "monthly"}, "monthly"},
+		{Param{Id: "p", Label: "frequency", Constraints: []Constraint{{Value: " at least annually "}}}, "at least annually"},
+		{Param{Id: "p", Select: &Select{Alternatives: []Choice{"organization", "system"}}}, "[Selection: organization; system]"},
+		{Param{Id: "p", Select: &Select{HowMany: "one-or-more", Alternatives: []Choice{"a", "b"}}}, "[Selection (one or more): a; b]"},
+		{Param{Id: "p", Label: "organization-defined frequency"}, "[Assignment: organization-defined frequency]"},
+		{Param{Id: "p"}, "[Assignment: p]"},
+	}
+	for _, tc := range cases {
+		if got := tc.param.Text(); got != tc.expected {
+			t.Errorf("expected %q, got %q", tc.expected, got)
+		}
+	}
+}
+
+func TestInsertParams(t *testing.T) {
+	part := Part{
+		Prose: &Prose{Raw: `<p>Review <insert param-id="p1"/> and <insert param-id='p2'></insert> or <insert param-id="p3">.</p>`},
+	}
+	values := map[string]string{"p1": "a < b", "p2": "two"}
+	rendered := part.InsertParams(func(id string) (string, bool) {
+		v, ok := values[id]
+		return v, ok
+	})
+	expected := `<p>Review a &lt; b and two or <insert param-id="p3">.</p>`
+	if rendered.Prose.Raw != expected {
+		t.Errorf("expected %s, got %s", expected, rendered.Prose.Raw)
+	}
+	if part.Prose.Raw == expected {
+		t.Error("original part should be left intact")
+	}
+}
@@ -1,6 +1,68 @@
 package nominal_catalog
 
-// ModifyProse modifies prose insert parameter template
+import (
+	"strings"
+)
+
+// ModifyProse replaces inserts of the parameter in the prose of the part and
+// its nested parts with the value
 func (part *Part) ModifyProse(parameterID, parameterVal string) {
-	panic("Part.ModifyProse() needs to be re-implemented")
+	*part = part.InsertParams(func(id string) (string, bool) {
+		return parameterVal, id == parameterID
+	})
+}
+
+// InsertParams returns copy of the part with parameter inserts in its prose
+// and the prose of its nested parts replaced by values. The part itself is
+// left intact.
+func (part Part) InsertParams(value func(paramID string) (string, bool)) Part {
+	if part.Prose != nil {
+		prose := part.Prose.InsertParams(value)
+		part.Prose = &prose
+	}
+	if part.Parts != nil {
+		parts := make([]Part, len(part.Parts))
+		for i := range part.Parts {
+			parts[i] = part.Parts[i].InsertParams(value)
+		}
+		part.Parts = parts
+	}
+	return part
+}
+
+// Label returns value of the label property of the part
+func (part *Part) Label() string {
+	for _, prop := range part.Properties {
+		if prop.Name == "label" {
+			return prop.Value
+		}
+	}
+	return ""
+}
+
+// Text returns the part as plain text, one line per part prefixed with its
+// label. Nested parts are indented.
+func (part *Part) Text() string {
+	var lines []string
+	var text func(p *Part, indent string)
+	text = func(p *Part, indent string) {
+		var line []string
+		if label := p.Label(); label != "" {
+			line = append(line, label)
+		}
+		if p.Prose != nil {
+			if prose := p.Prose.Text(); prose != "" {
+				line = append(line, prose)
+			}
+		}
+		if len(line) > 0 {
+			lines = append(lines, indent+strings.Join(line, " "))
+			indent += "  "
+		}
+		for i := range p.Parts {
+			text(&p.Parts[i], indent)
+		}
+	}
+	text(part, "")
+	return strings.Join(lines, "\n")
 }
@@ -0,0 +1,156 @@
+package nominal_catalog
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"github.com/docker/oscalkit/types/oscal/markup"
+)
+
+// partXML is the XML layout of part, with the prose blocks placed directly
+// in the part between the properties and the nested parts
+type partXML struct {
+	Id         string `xml:"id,attr,omitempty"`
+	Name       string `xml:"name,attr,omitempty"`
+	Ns         string `xml:"ns,attr,omitempty"`
+	Class      string `xml:"class,attr,omitempty"`
+	Title      Title  `xml:"title,omitempty"`
+	Properties []Prop `xml:"prop,omitempty"`
+	Prose      string `xml:",innerxml"`
+	Parts      []Part `xml:"part,omitempty"`
+	Links      []Link `xml:"link,omitempty"`
+}
+
+// guidelineXML is the XML layout of guideline holding the prose blocks only
+type guidelineXML struct {
+	Prose string `xml:",innerxml"`
+}
+
+// MarshalXML writes the prose of the part as its direct children
+func (part Part) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(partXML{
+		Id:         part.Id,
+		Name:       part.Name,
+		Ns:         part.Ns,
+		Class:      part.Class,
+		Title:      part.Title,
+		Properties: part.Properties,
+		Prose:      proseXML(part.Prose),
+		Parts:      part.Parts,
+		Links:      part.Links,
+	}, start)
+}
+
+// UnmarshalXML collects the prose blocks of the part. Prose wrapped in the
+// prose element, as written by the earlier versions, is accepted as well.
+func (part *Part) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*part = Part{}
+	for _, attr := range start.Attr {
+		switch attr.Name.Local {
+		case "id":
+			part.Id = attr.Value
+		case "name":
+			part.Name = attr.Value
+		case "ns":
+			part.Ns = attr.Value
+		case "class":
+			part.Class = attr.Value
+		}
+	}
+	var prose strings.Builder
+	err := decodeChildren(d, &prose, func(child xml.StartElement) error {
+		switch child.Name.Local {
+		case "title":
+			return d.DecodeElement(&part.Title, &child)
+		case "prop":
+			var prop Prop
+			if err := d.DecodeElement(&prop, &child); err != nil {
+				return err
+			}
+			part.Properties = append(part.Properties, prop)
+		case "part":
+			var p Part
+			if err := d.DecodeElement(&p, &child); err != nil {
+				return err
+			}
+			part.Parts = append(part.Parts, p)
+		case "link":
+			var link Link
+			if err := d.DecodeElement(&link, &child); err != nil {
+				return err
+			}
+			part.Links = append(part.Links, link)
+		default:
+			return d.Skip()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	part.Prose = proseOf(prose.String())
+	return nil
+}
+
+// MarshalXML writes the prose of the guideline as its direct children
+func (g Guideline) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(guidelineXML{Prose: proseXML(g.Prose)}, start)
+}
+
+// UnmarshalXML collects the prose blocks of the guideline
+func (g *Guideline) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var prose strings.Builder
+	if err := decodeChildren(d, &prose, func(xml.StartElement) error { return d.Skip() }); err != nil {
+		return err
+	}
+	g.Prose = proseOf(prose.String())
+	return nil
+}
+
+// decodeChildren writes the prose blocks among the children of the current
+// element to prose and passes other elements to decode
+func decodeChildren(d *xml.Decoder, prose *strings.Builder, decode func(xml.StartElement) error) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch {
+			case t.Name.Local == "prose":
+				var legacy Prose
+				if err := d.DecodeElement(&legacy, &t); err != nil {
+					return err
+				}
+				prose.WriteString(legacy.Raw)
+			case markup.IsBlock(t.Name.Local):
+				block, err := markup.DecodeElement(d, t)
+				if err != nil {
+					return err
+				}
+				block.WriteXML(prose)
+			default:
+				if err := decode(t); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+func proseXML(prose *Prose) string {
+	if prose == nil {
+		return ""
+	}
+	return prose.Raw
+}
+
+func proseOf(raw string) *Prose {
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+	return &Prose{Raw: raw}
+}
@@ -5,15 +5,16 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 
 	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/pkg/yaml_json"
 	"github.com/docker/oscalkit/types/oscal/catalog"
 	"github.com/docker/oscalkit/types/oscal/component_definition"
 	"github.com/docker/oscalkit/types/oscal/profile"
 	ssp "github.com/docker/oscalkit/types/oscal/system_security_plan"
-	yaml "gopkg.in/yaml.v2"
 )
 
 const (
@@ -21,6 +22,8 @@ const (
 	profileRootElement = "profile"
 	sspRootElement     = "system-security-plan"
 	componentElement   = "component-definition"
+
+	oscalNamespace = "http://csrc.nist.gov/ns/oscal/1.0"
 )
 
 // OSCAL contains specific OSCAL components
@@ -29,8 +32,8 @@ type OSCAL struct {
 	Catalog *catalog.Catalog `json:"catalog,omitempty" yaml:"catalog,omitempty"`
 	// Declarations *Declarations `json:"declarations,omitempty" yaml:"declarations,omitempty"`
 	Profile                 *profile.Profile `json:"profile,omitempty" yaml:"profile,omitempty"`
-	*ssp.SystemSecurityPlan `xml:"system-security-plan"`
-	Component               *component_definition.ComponentDefinition
+	*ssp.SystemSecurityPlan `xml:"system-security-plan" json:"system-security-plan,omitempty" yaml:"system-security-plan,omitempty"`
+	Component               *component_definition.ComponentDefinition `json:"component-definition,omitempty" yaml:"component-definition,omitempty"`
 	documentType            constants.DocumentType
 }
 
@@ -48,6 +51,21 @@ func (o *OSCAL) DocumentType() constants.DocumentType {
 	}
 }
 
+// Metadata returns metadata of the document, nil for unknown documents
+func (o *OSCAL) Metadata() *catalog.Metadata {
+	switch {
+	case o.Catalog != nil:
+		return o.Catalog.Metadata
+	case o.Profile != nil:
+		return o.Profile.Metadata
+	case o.SystemSecurityPlan != nil:
+		return o.SystemSecurityPlan.Metadata
+	case o.Component != nil:
+		return o.Component.Metadata
+	}
+	return nil
+}
+
 // MarshalXML marshals either a catalog or a profile
 func (o *OSCAL) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if o.Catalog != nil {
@@ -65,6 +83,12 @@ func (o *OSCAL) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 		if err := e.Encode(o.SystemSecurityPlan); err != nil {
 			return err
 		}
+	} else if o.Component != nil {
+		// generated component definition carries no XMLName
+		o.XMLName = xml.Name{Space: oscalNamespace, Local: componentElement}
+		if err := e.EncodeElement(o.Component, xml.StartElement{Name: o.XMLName}); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -115,28 +139,65 @@ func New(r io.Reader) (*OSCAL, error) {
 		}
 	}
 
+	if o, err := newFromJSON(oscalBytes); err == nil {
+		return o, nil
+	} else if err != errUnknownRoot {
+		return nil, err
+	}
+
+	jsonBytes, err := yaml_json.ToJSON(oscalBytes)
+	if err == nil {
+		if o, err := newFromJSON(jsonBytes); err == nil {
+			return o, nil
+		} else if err != errUnknownRoot {
+			return nil, err
+		}
+	}
+
+	return nil, errors.New("Malformed OSCAL. Must be XML, JSON or YAML")
+}
+
+var errUnknownRoot = errors.New("no known OSCAL root element")
+
+// newFromJSON decodes OSCAL document from JSON. YAML documents are decoded
+// through here as well once converted to JSON
+func newFromJSON(oscalBytes []byte) (*OSCAL, error) {
 	var oscalT map[string]json.RawMessage
-	if err := json.Unmarshal(oscalBytes, &oscalT); err == nil {
-		for k, v := range oscalT {
-			switch k {
-			case catalogRootElement:
-				var catalog catalog.Catalog
-				if err := json.Unmarshal(v, &catalog); err != nil {
-					return nil, err
-				}
-				return &OSCAL{Catalog: &catalog}, nil
+	if err := json.Unmarshal(oscalBytes, &oscalT); err != nil {
+		return nil, errUnknownRoot
+	}
+	for k, v := range oscalT {
+		switch k {
+		case catalogRootElement:
+			var catalog catalog.Catalog
+			if err := json.Unmarshal(v, &catalog); err != nil {
+				return nil, fmt.Errorf("cannot decode %s: %v", k, err)
+			}
+			return &OSCAL{Catalog: &catalog}, nil
 
-			case profileRootElement:
-				var profile profile.Profile
-				if err := json.Unmarshal(v, &profile); err != nil {
-					return nil, err
-				}
-				return &OSCAL{Profile: &profile}, nil
+		case profileRootElement:
+			var profile profile.Profile
+			if err := json.Unmarshal(v, &profile); err != nil {
+				return nil, fmt.Errorf("cannot decode %s: %v", k, err)
 			}
+			return &OSCAL{Profile: &profile}, nil
+
+		case sspRootElement:
+			var ssp ssp.SystemSecurityPlan
+			if err := json.Unmarshal(v, &ssp); err != nil {
+				return nil, fmt.Errorf("cannot decode %s: %v", k, err)
+			}
+			return &OSCAL{SystemSecurityPlan: &ssp}, nil
+
+		case componentElement:
+			var component component_definition.ComponentDefinition
+			if err := json.Unmarshal(v, &component); err != nil {
+				return nil, fmt.Errorf("cannot decode %s: %v", k, err)
+			}
+			return &OSCAL{Component: &component}, nil
 		}
 	}
-
-	return nil, errors.New("Malformed OSCAL. Must be XML or JSON")
+	return nil, errUnknownRoot
 }
 
 // XML writes the OSCAL object as XML to the given writer
@@ -179,7 +240,18 @@ func (o *OSCAL) encode(options encodeOptions) error {
 		return e.Encode(o)
 
 	case "yaml":
-		return yaml.NewEncoder(options.writer).Encode(o)
+		// YAML mirrors the JSON model, so it is produced from JSON to keep
+		// the key names identical and readable by New
+		jsonBytes, err := json.Marshal(o)
+		if err != nil {
+			return err
+		}
+		yamlBytes, err := yaml_json.FromJSON(jsonBytes)
+		if err != nil {
+			return err
+		}
+		_, err = options.writer.Write(yamlBytes)
+		return err
 	}
 
 	return errors.New("Incorrect format specified")
@@ -0,0 +1,185 @@
+package oscal
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/pkg/oscal/constants"
+	"github.com/docker/oscalkit/types/oscal/catalog"
+	"github.com/docker/oscalkit/types/oscal/component_definition"
+	"github.com/docker/oscalkit/types/oscal/profile"
+	ssp "github.com/docker/oscalkit/types/oscal/system_security_plan"
+	"github.com/docker/oscalkit/types/oscal/validation_root"
+)
+
+func testMetadata() *validation_root.Metadata {
+	return &validation_root.Metadata{
+		Title:        "Test Document",
+		Version:      "1.0",
+		OscalVersion: constants.LatestOscalVersion,
+		Roles: []validation_root.Role{
+			{Id: "admin", Title: "Administrator"},
+		},
+	}
+}
+
+func testDocuments() map[constants.DocumentType]*OSCAL {
+	return map[constants.DocumentType]*OSCAL{
+		constants.CatalogDocument: {Catalog: &catalog.Catalog{
+			Id:       "test-catalog",
+			Metadata: testMetadata(),
+			Groups: []catalog.Group{{
+				Id:    "ac",
+				Title: "Access Control",
+				Controls: []catalog.Control{
+					catalog.NewControl("ac-1", "Policy and Procedures", &catalog.ControlOpts{
+						Parts: []catalog.Part{catalog.NewPart("ac-1_smt", "Statement", "<p>Develop a policy.</p>")},
+					}),
+				},
+			}},
+		}},
+		constants.ProfileDocument: {Profile: &profile.Profile{
+			Id:       "test-profile",
+			Metadata: testMetadata(),
+			Imports: []profile.Import{{
+				Href: "catalog.xml",
+				Include: &profile.Include{
+					IdSelectors: []profile.Call{{ControlId: "ac-1"}},
+				},
+			}},
+			Modify: &profile.Modify{
+				ParameterSettings: []profile.SetParameter{{ParamId: "ac-1_prm_1", Value: "quarterly"}},
+			},
+		}},
+		constants.SSPDocument: {SystemSecurityPlan: &ssp.SystemSecurityPlan{
+			Id:            "test-ssp",
+			Metadata:      testMetadata(),
+			ImportProfile: &ssp.ImportProfile{Href: "profile.xml"},
+			SystemCharacteristics: &ssp.SystemCharacteristics{
+				SystemName:  "Test System",
+				Description: validation_root.MarkupFromPlain("A system under test"),
+			},
+			ControlImplementation: &ssp.ControlImplementation{
+				ImplementedRequirements: []ssp.ImplementedRequirement{{
+					Id:               "ir-ac-1",
+					ControlId:        "ac-1",
+					ResponsibleRoles: []ssp.ResponsibleRole{{RoleId: "admin"}},
+				}},
+			},
+		}},
+		constants.ComponentDocument: {Component: &component_definition.ComponentDefinition{
+			Metadata: testMetadata(),
+			Components: []component_definition.Component{{
+				Id:            "component-1",
+				Name:          "Test Component",
+				ComponentType: "software",
+				ControlImplementations: []component_definition.ControlImplementation{{
+					CanMeetRequirementSets: []component_definition.CanMeetRequirementSet{{
+						Source: "profile.xml",
+						ImplementedRequirements: []component_definition.ImplementedRequirement{{
+							Id:        "req-1",
+							ControlId: "ac-1",
+						}},
+					}},
+				}},
+			}},
+		}},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	encoders := map[string]func(*OSCAL, *bytes.Buffer) error{
+		"xml":  func(o *OSCAL, b *bytes.Buffer) error { return o.XML(b, true) },
+		"json": func(o *OSCAL, b *bytes.Buffer) error { return o.JSON(b, true) },
+		"yaml": func(o *OSCAL, b *bytes.Buffer) error { return o.YAML(b) },
+	}
+	for docType, original := range testDocuments() {
+		for format, encode := range encoders {
+			var buf bytes.Buffer
+			if err := encode(original, &buf); err != nil {
+				t.Fatalf("cannot encode document type %d as %s: %v", docType, format, err)
+			}
+			decoded, err := New(&buf)
+			if err != nil {
+				t.Fatalf("cannot decode document type %d from %s: %v", docType, format, err)
+			}
+			if decoded.DocumentType() != docType {
+				t.Errorf("document type %d from %s decoded as %d", docType, format, decoded.DocumentType())
+				continue
+			}
+			assertSameDocument(t, format, original, decoded)
+		}
+	}
+}
+
+func assertSameDocument(t *testing.T, format string, expected, actual *OSCAL) {
+	var want, got interface{}
+	switch expected.DocumentType() {
+	case constants.CatalogDocument:
+		actual.Catalog.XMLName = expected.Catalog.XMLName
+		want, got = expected.Catalog, actual.Catalog
+	case constants.ProfileDocument:
+		actual.Profile.XMLName = expected.Profile.XMLName
+		want, got = expected.Profile, actual.Profile
+	case constants.SSPDocument:
+		actual.SystemSecurityPlan.XMLName = expected.SystemSecurityPlan.XMLName
+		want, got = expected.SystemSecurityPlan, actual.SystemSecurityPlan
+	case constants.ComponentDocument:
+		want, got = expected.Component, actual.Component
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s round trip mismatch:\nwant %+v\n got %+v", format, want, got)
+	}
+}
+
+func TestNewFromJSON(t *testing.T) {
+	tests := map[string]constants.DocumentType{
+		`{"catalog": {"id": "c"}}`: constants.CatalogDocument,
+		`{"profile": {"id": "p"}}`: constants.ProfileDocument,
+		`{"system-security-plan": {"id": "s", "metadata": {"title": "t"}}}`: constants.SSPDocument,
+		`{"component-definition": {"components": [{"id": "c1"}]}}`:          constants.ComponentDocument,
+	}
+	for doc, docType := range tests {
+		o, err := New(strings.NewReader(doc))
+		if err != nil {
+			t.Errorf("cannot parse %s: %v", doc, err)
+			continue
+		}
+		if o.DocumentType() != docType {
+			t.Errorf("%s parsed as document type %d, expected %d", doc, o.DocumentType(), docType)
+		}
+	}
+}
+
+func TestNewFromYAML(t *testing.T) {
+	doc := `
+system-security-plan:
+  id: ssp-1
+  metadata:
+    title: YAML SSP
+  controlImplementation:
+    implemented-requirements:
+      - id: ir-1
+        controlId: ac-1
+`
+	o, err := New(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.DocumentType() != constants.SSPDocument {
+		t.Fatalf("parsed as document type %d", o.DocumentType())
+	}
+	if o.SystemSecurityPlan.ControlImplementation.ImplementedRequirements[0].ControlId != "ac-1" {
+		t.Error("implemented requirement not decoded")
+	}
+}
+
+func TestNewMalformed(t *testing.T) {
+	for _, doc := range []string{`{"unknown": {}}`, `just some text`, `<unknown/>`} {
+		if _, err := New(strings.NewReader(doc)); err == nil {
+			t.Errorf("%s should not parse", doc)
+		}
+	}
+}
@@ -0,0 +1,321 @@
+package profile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/docker/oscalkit/types/oscal/catalog"
+)
+
+// Positions of additions relative to their target
+const (
+	PositionBefore   = "before"
+	PositionAfter    = "after"
+	PositionStarting = "starting"
+	PositionEnding   = "ending"
+)
+
+// item names selectable by removals
+const (
+	itemProp       = "prop"
+	itemLink       = "link"
+	itemParam      = "param"
+	itemPart       = "part"
+	itemAnnotation = "annotation"
+)
+
+// Apply applies the alteration to the control. Removals are applied first,
+// then additions in document order. An error is returned when a removal
+// matches nothing or an addition refers to an item missing in the control.
+func (a *Alter) Apply(ctrl *catalog.Control) error {
+	if a.ControlId != "" && a.ControlId != ctrl.Id {
+		return fmt.Errorf("alteration of control %s cannot be applied to control %s", a.ControlId, ctrl.Id)
+	}
+	for _, r := range a.Removals {
+		if err := r.Apply(ctrl); err != nil {
+			return fmt.Errorf("cannot alter control %s: %v", ctrl.Id, err)
+		}
+	}
+	for _, add := range a.Additions {
+		if err := add.Apply(ctrl); err != nil {
+			return fmt.Errorf("cannot alter control %s: %v", ctrl.Id, err)
+		}
+	}
+	return nil
+}
+
+// String describes the items selected by removal
+func (r *Remove) String() string {
+	var criteria []string
+	for _, c := range []struct{ attr, value string }{
+		{"name-ref", r.NameRef},
+		{"class-ref", r.ClassRef},
+		{"id-ref", r.IdRef},
+		{"item-name", r.ItemName},
+	} {
+		if c.value != "" {
+			criteria = append(criteria, fmt.Sprintf("%s=%q", c.attr, c.value))
+		}
+	}
+	return "remove " + strings.Join(criteria, " ")
+}
+
+// Apply removes the matching properties, links, parameters, annotations and
+// parts from the control and its nested parts. Child controls are left
+// intact.
+func (r *Remove) Apply(ctrl *catalog.Control) error {
+	if r.NameRef == "" && r.ClassRef == "" && r.IdRef == "" && r.ItemName == "" {
+		return fmt.Errorf("removal selects no items")
+	}
+	removed := 0
+	var params []catalog.Param
+	for _, param := range ctrl.Parameters {
+		if r.matches(itemParam, "", param.Class, param.Id) {
+			removed++
+			continue
+		}
+		params = append(params, param)
+	}
+	ctrl.Parameters = params
+	var annotations []catalog.Annotation
+	for _, annotation := range ctrl.Annotations {
+		if r.matches(itemAnnotation, annotation.Name, "", annotation.Id) {
+			removed++
+			continue
+		}
+		annotations = append(annotations, annotation)
+	}
+	ctrl.Annotations = annotations
+	ctrl.Properties = r.props(ctrl.Properties, &removed)
+	ctrl.Links = r.links(ctrl.Links, &removed)
+	ctrl.Parts = r.parts(ctrl.Parts, &removed)
+	if removed == 0 {
+		return fmt.Errorf("%s matches nothing", r)
+	}
+	return nil
+}
+
+func (r *Remove) matches(item, name, class, id string) bool {
+	return (r.ItemName == "" || r.ItemName == item) &&
+		(r.NameRef == "" || r.NameRef == name) &&
+		(r.ClassRef == "" || r.ClassRef == class) &&
+		(r.IdRef == "" || r.IdRef == id)
+}
+
+func (r *Remove) props(props []catalog.Prop, removed *int) []catalog.Prop {
+	var result []catalog.Prop
+	for _, prop := range props {
+		if r.matches(itemProp, prop.Name, prop.Class, prop.Id) {
+			*removed++
+			continue
+		}
+		result = append(result, prop)
+	}
+	return result
+}
+
+func (r *Remove) links(links []catalog.Link, removed *int) []catalog.Link {
+	var result []catalog.Link
+	for _, link := range links {
+		if r.matches(itemLink, "", "", "") {
+			*removed++
+			continue
+		}
+		result = append(result, link)
+	}
+	return result
+}
+
+func (r *Remove) parts(parts []catalog.Part, removed *int) []catalog.Part {
+	var result []catalog.Part
+	for _, part := range parts {
+		if r.matches(itemPart, part.Name, part.Class, part.Id) {
+			*removed++
+			continue
+		}
+		part.Properties = r.props(part.Properties, removed)
+		part.Links = r.links(part.Links, removed)
+		part.Parts = r.parts(part.Parts, removed)
+		result = append(result, part)
+	}
+	return result
+}
+
+// Apply adds the contents of the addition to the control. Without id-ref
+// the control itself is the target, otherwise the part, parameter or
+// property with the id anywhere in the control.
+func (a *Add) Apply(ctrl *catalog.Control) error {
+	position := a.Position
+	if position == "" {
+		position = PositionEnding
+	}
+	switch position {
+	case PositionBefore, PositionAfter, PositionStarting, PositionEnding:
+	default:
+		return fmt.Errorf("unknown position %s", a.Position)
+	}
+
+	if a.IdRef == "" || a.IdRef == ctrl.Id {
+		if position == PositionBefore || position == PositionAfter {
+			return fmt.Errorf("position %s requires id-ref of an item within the control", position)
+		}
+		return a.addInto(controlContainer(ctrl), position)
+	}
+
+	t := findTarget(controlContainer(ctrl), a.IdRef)
+	if t == nil {
+		return fmt.Errorf("id-ref %s not found", a.IdRef)
+	}
+	if position == PositionStarting || position == PositionEnding {
+		if t.part == nil {
+			return fmt.Errorf("cannot add into %s %s, only parts can be targeted with position %s", t.item, a.IdRef, position)
+		}
+		return a.addInto(partContainer(t.part), position)
+	}
+	return a.addBeside(t, position)
+}
+
+// container points to lists of a control or a part that additions modify.
+// Parts have no parameters and annotations.
+type container struct {
+	name        string
+	title       *catalog.Title
+	props       *[]catalog.Prop
+	links       *[]catalog.Link
+	params      *[]catalog.Param
+	annotations *[]catalog.Annotation
+	parts       *[]catalog.Part
+}
+
+func controlContainer(ctrl *catalog.Control) container {
+	return container{
+		name:        "control " + ctrl.Id,
+		title:       &ctrl.Title,
+		props:       &ctrl.Properties,
+		links:       &ctrl.Links,
+		params:      &ctrl.Parameters,
+		annotations: &ctrl.Annotations,
+		parts:       &ctrl.Parts,
+	}
+}
+
+func partContainer(part *catalog.Part) container {
+	return container{
+		name:  "part " + part.Id,
+		title: &part.Title,
+		props: &part.Properties,
+		links: &part.Links,
+		parts: &part.Parts,
+	}
+}
+
+// target is an item of a container referenced by id
+type target struct {
+	parent container
+	item   string
+	index  int
+	// part is set when the target is a part
+	part *catalog.Part
+}
+
+// findTarget finds part, parameter or property with the id within the
+// container and its nested parts
+func findTarget(c container, id string) *target {
+	if c.params != nil {
+		for i, param := range *c.params {
+			if param.Id == id {
+				return &target{parent: c, item: itemParam, index: i}
+			}
+		}
+	}
+	for i, prop := range *c.props {
+		if prop.Id == id {
+			return &target{parent: c, item: itemProp, index: i}
+		}
+	}
+	parts := *c.parts
+	for i := range parts {
+		if parts[i].Id == id {
+			return &target{parent: c, item: itemPart, index: i, part: &parts[i]}
+		}
+		if t := findTarget(partContainer(&parts[i]), id); t != nil {
+			return t
+		}
+	}
+	return nil
+}
+
+func (a *Add) check(c container) error {
+	if c.params == nil && len(a.Parameters) > 0 {
+		return fmt.Errorf("cannot add parameters to %s", c.name)
+	}
+	if c.annotations == nil && len(a.Annotations) > 0 {
+		return fmt.Errorf("cannot add annotations to %s", c.name)
+	}
+	return nil
+}
+
+// addInto adds the contents at the start or the end of the container
+func (a *Add) addInto(c container, position string) error {
+	if err := a.check(c); err != nil {
+		return err
+	}
+	if a.Title != "" {
+		*c.title = a.Title
+	}
+	if position == PositionStarting {
+		*c.props = append(a.Properties[:len(a.Properties):len(a.Properties)], *c.props...)
+		*c.links = append(a.Links[:len(a.Links):len(a.Links)], *c.links...)
+		*c.parts = append(a.Parts[:len(a.Parts):len(a.Parts)], *c.parts...)
+		if c.params != nil {
+			*c.params = append(a.Parameters[:len(a.Parameters):len(a.Parameters)], *c.params...)
+			*c.annotations = append(a.Annotations[:len(a.Annotations):len(a.Annotations)], *c.annotations...)
+		}
+		return nil
+	}
+	*c.props = append(*c.props, a.Properties...)
+	*c.links = append(*c.links, a.Links...)
+	*c.parts = append(*c.parts, a.Parts...)
+	if c.params != nil {
+		*c.params = append(*c.params, a.Parameters...)
+		*c.annotations = append(*c.annotations, a.Annotations...)
+	}
+	return nil
+}
+
+// addBeside inserts the items of the same kind as the target right before
+// or after it. Other items are added at the end of the target's parent.
+func (a *Add) addBeside(t *target, position string) error {
+	if a.Title != "" {
+		return fmt.Errorf("title cannot be added %s %s %s", position, t.item, a.IdRef)
+	}
+	if err := a.check(t.parent); err != nil {
+		return err
+	}
+	at := t.index
+	if position == PositionAfter {
+		at++
+	}
+	c := t.parent
+
+	if t.item == itemProp {
+		*c.props = append((*c.props)[:at:at], append(a.Properties[:len(a.Properties):len(a.Properties)], (*c.props)[at:]...)...)
+	} else {
+		*c.props = append(*c.props, a.Properties...)
+	}
+	if t.item == itemPart {
+		*c.parts = append((*c.parts)[:at:at], append(a.Parts[:len(a.Parts):len(a.Parts)], (*c.parts)[at:]...)...)
+	} else {
+		*c.parts = append(*c.parts, a.Parts...)
+	}
+	if c.params != nil {
+		if t.item == itemParam {
+			*c.params = append((*c.params)[:at:at], append(a.Parameters[:len(a.Parameters):len(a.Parameters)], (*c.params)[at:]...)...)
+		} else {
+			*c.params = append(*c.params, a.Parameters...)
+		}
+		*c.annotations = append(*c.annotations, a.Annotations...)
+	}
+	*c.links = append(*c.links, a.Links...)
+	return nil
+}
@@ -0,0 +1,186 @@
+package profile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/docker/oscalkit/types/oscal/catalog"
+)
+
+func testControl() catalog.Control {
+	return catalog.Control{
+		Id:    "ac-1",
+		Title: "Policy and Procedures",
+		Properties: []catalog.Prop{
+			{Name: "label", Value: "AC-1"},
+			{Name: "status", Id: "ac-1_status", Value: "draft"},
+		},
+		Links:      []catalog.Link{{Href: "#ref-1", Rel: "reference"}},
+		Parameters: []catalog.Param{{Id: "ac-1_prm_1"}, {Id: "ac-1_prm_2", Class: "odp"}},
+		Parts: []catalog.Part{
+			{Id: "ac-1_smt", Name: "statement", Parts: []catalog.Part{
+				{Id: "ac-1_smt.a", Name: "item"},
+				{Id: "ac-1_smt.b", Name: "item", Class: "nist"},
+			}},
+			{Id: "ac-1_gdn", Name: "guidance", Class: "nist"},
+		},
+	}
+}
+
+func partIds(parts []catalog.Part) string {
+	var ids []string
+	for _, part := range parts {
+		ids = append(ids, part.Id)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestRemove(t *testing.T) {
+	cases := []struct {
+		name   string
+		remove Remove
+		check  func(ctrl catalog.Control) bool
+	}{
+		{"name-ref", Remove{NameRef: "guidance"}, func(ctrl catalog.Control) bool {
+			return partIds(ctrl.Parts) == "ac-1_smt"
+		}},
+		{"class-ref in nested parts", Remove{ClassRef: "nist"}, func(ctrl catalog.Control) bool {
+			return partIds(ctrl.Parts) == "ac-1_smt" && partIds(ctrl.Parts[0].Parts) == "ac-1_smt.a"
+		}},
+		{"class-ref of parameter", Remove{ClassRef: "odp", ItemName: "param"}, func(ctrl catalog.Control) bool {
+			return len(ctrl.Parameters) == 1 && len(ctrl.Parts) == 2
+		}},
+		{"id-ref", Remove{IdRef: "ac-1_smt.a"}, func(ctrl catalog.Control) bool {
+			return partIds(ctrl.Parts[0].Parts) == "ac-1_smt.b"
+		}},
+		{"item-name", Remove{ItemName: "link"}, func(ctrl catalog.Control) bool {
+			return len(ctrl.Links) == 0 && len(ctrl.Properties) == 2
+		}},
+		{"name-ref and item-name", Remove{NameRef: "status", ItemName: "prop"}, func(ctrl catalog.Control) bool {
+			return len(ctrl.Properties) == 1 && ctrl.Properties[0].Name == "label"
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := testControl()
+			if err := tc.remove.Apply(&ctrl); err != nil {
+				t.Fatal(err)
+			}
+			if !tc.check(ctrl) {
+				t.Errorf("unexpected control after %s: %+v", tc.remove.String(), ctrl)
+			}
+		})
+	}
+}
+
+func TestRemoveUnresolved(t *testing.T) {
+	for _, r := range []Remove{{NameRef: "assessment"}, {}} {
+		ctrl := testControl()
+		if err := r.Apply(&ctrl); err == nil {
+			t.Errorf("expected error for %s", r.String())
+		}
+	}
+}
+
+func TestAdd(t *testing.T) {
+	added := []catalog.Part{{Id: "new"}}
+	cases := []struct {
+		name     string
+		add      Add
+		parts    func(ctrl catalog.Control) []catalog.Part
+		expected string
+	}{
+		{"ending by default", Add{Parts: added}, func(ctrl catalog.Control) []catalog.Part {
+			return ctrl.Parts
+		}, "ac-1_smt,ac-1_gdn,new"},
+		{"starting", Add{Position: PositionStarting, Parts: added}, func(ctrl catalog.Control) []catalog.Part {
+			return ctrl.Parts
+		}, "new,ac-1_smt,ac-1_gdn"},
+		{"before nested part", Add{Position: PositionBefore, IdRef: "ac-1_smt.b", Parts: added}, func(ctrl catalog.Control) []catalog.Part {
+			return ctrl.Parts[0].Parts
+		}, "ac-1_smt.a,new,ac-1_smt.b"},
+		{"after part", Add{Position: PositionAfter, IdRef: "ac-1_smt", Parts: added}, func(ctrl catalog.Control) []catalog.Part {
+			return ctrl.Parts
+		}, "ac-1_smt,new,ac-1_gdn"},
+		{"starting part", Add{Position: PositionStarting, IdRef: "ac-1_smt", Parts: added}, func(ctrl catalog.Control) []catalog.Part {
+			return ctrl.Parts[0].Parts
+		}, "new,ac-1_smt.a,ac-1_smt.b"},
+		{"ending part", Add{Position: PositionEnding, IdRef: "ac-1_gdn", Parts: added}, func(ctrl catalog.Control) []catalog.Part {
+			return ctrl.Parts[1].Parts
+		}, "new"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := testControl()
+			if err := tc.add.Apply(&ctrl); err != nil {
+				t.Fatal(err)
+			}
+			if got := partIds(tc.parts(ctrl)); got != tc.expected {
+				t.Errorf("expected parts %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestAddItems(t *testing.T) {
+	ctrl := testControl()
+	add := Add{
+		Position:   PositionBefore,
+		IdRef:      "ac-1_prm_2",
+		Properties: []catalog.Prop{{Name: "added"}},
+		Parameters: []catalog.Param{{Id: "ac-1_prm_new"}},
+	}
+	if err := add.Apply(&ctrl); err != nil {
+		t.Fatal(err)
+	}
+	if len(ctrl.Parameters) != 3 || ctrl.Parameters[1].Id != "ac-1_prm_new" {
+		t.Errorf("expected parameter inserted before ac-1_prm_2, got %v", ctrl.Parameters)
+	}
+	if len(ctrl.Properties) != 3 || ctrl.Properties[2].Name != "added" {
+		t.Errorf("expected property added at the end, got %v", ctrl.Properties)
+	}
+
+	title := Add{Title: "Tailored title", Links: []catalog.Link{{Href: "#ref-2"}}}
+	if err := title.Apply(&ctrl); err != nil {
+		t.Fatal(err)
+	}
+	if ctrl.Title != "Tailored title" || len(ctrl.Links) != 2 {
+		t.Errorf("expected title replaced and link added, got %s %v", ctrl.Title, ctrl.Links)
+	}
+}
+
+func TestAddUnresolved(t *testing.T) {
+	cases := []Add{
+		{IdRef: "missing", Position: PositionAfter},
+		{Position: PositionBefore},
+		{Position: "middle"},
+		{IdRef: "ac-1_prm_1", Position: PositionEnding},
+		{IdRef: "ac-1_smt", Parameters: []catalog.Param{{Id: "p"}}},
+	}
+	for _, add := range cases {
+		ctrl := testControl()
+		if err := add.Apply(&ctrl); err == nil {
+			t.Errorf("expected error for addition %+v", add)
+		}
+	}
+}
+
+func TestAlter(t *testing.T) {
+	ctrl := testControl()
+	alter := Alter{
+		ControlId: "ac-1",
+		Removals:  []Remove{{NameRef: "guidance"}},
+		Additions: []Add{{Parts: []catalog.Part{{Id: "ac-1_fedramp", Name: "guidance"}}}},
+	}
+	if err := alter.Apply(&ctrl); err != nil {
+		t.Fatal(err)
+	}
+	if got := partIds(ctrl.Parts); got != "ac-1_smt,ac-1_fedramp" {
+		t.Errorf("expected guidance replaced, got %s", got)
+	}
+
+	other := catalog.Control{Id: "ac-2"}
+	if err := alter.Apply(&other); err == nil {
+		t.Error("expected error applying alteration to another control")
+	}
+}
@@ -26,3 +26,12 @@ func (i *Import) ValidateHref() error {
 	}
 	return nil
 }
+
+// IsAsIs returns true if merge requests controls to be structured as they are in their source catalogs
+func (m *Merge) IsAsIs() bool {
+	switch strings.TrimSpace(string(m.AsIs)) {
+	case "true", "1":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package profile
+
+import (
+	"github.com/docker/oscalkit/types/oscal/catalog"
+)
+
+// Apply overrides the fields of the parameter given by the setting
+func (sp *SetParameter) Apply(param *catalog.Param) {
+	if sp.Class != "" {
+		param.Class = sp.Class
+	}
+	if sp.DependsOn != "" {
+		param.DependsOn = sp.DependsOn
+	}
+	if sp.Label != "" {
+		param.Label = sp.Label
+	}
+	if len(sp.Descriptions) > 0 {
+		param.Descriptions = sp.Descriptions
+	}
+	if len(sp.Constraints) > 0 {
+		param.Constraints = sp.Constraints
+	}
+	if len(sp.Links) > 0 {
+		param.Links = sp.Links
+	}
+	if len(sp.Guidance) > 0 {
+		param.Guidance = sp.Guidance
+	}
+	if sp.Value != "" {
+		param.Value = sp.Value
+	}
+	if sp.Select != nil {
+		param.Select = sp.Select
+	}
+}
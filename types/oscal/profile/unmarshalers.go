@@ -1,6 +1,31 @@
 package profile
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// UnmarshalJSON reads as-is given as JSON boolean or string
 func (a *AsIs) UnmarshalJSON(b []byte) error {
-	*a = AsIs(string(b))
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return err
+	}
+	*a = ""
+	if value != nil {
+		*a = AsIs(fmt.Sprint(value))
+	}
 	return nil
 }
+
+// MarshalJSON writes as-is of xs:boolean value as JSON boolean
+func (a AsIs) MarshalJSON() ([]byte, error) {
+	switch strings.TrimSpace(string(a)) {
+	case "true", "1":
+		return []byte("true"), nil
+	case "false", "0":
+		return []byte("false"), nil
+	}
+	return json.Marshal(string(a))
+}
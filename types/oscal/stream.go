@@ -0,0 +1,416 @@
+package oscal
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/docker/oscalkit/types/oscal/catalog"
+)
+
+// ErrStopStream may be returned by CatalogHandler callbacks to stop streaming
+// the catalog early. StreamCatalog then returns nil.
+var ErrStopStream = errors.New("catalog streaming stopped")
+
+// CatalogHandler receives groups and controls of a catalog one at a time as
+// the catalog is being decoded by StreamCatalog. All callbacks are optional.
+type CatalogHandler struct {
+	// Group is called when group is entered. Only the fields preceding the
+	// nested groups and controls are populated, Groups and Controls are always
+	// empty. The path holds the enclosing groups, outermost first.
+	Group func(path []*catalog.Group, g *catalog.Group) error
+	// Control is called for every control directly under the catalog or a
+	// group. Nested controls (enhancements) are decoded as part of the control.
+	Control func(path []*catalog.Group, c *catalog.Control) error
+	// Done is called at the end of the catalog with the catalog holding
+	// everything but its groups and controls.
+	Done func(c *catalog.Catalog) error
+}
+
+func (h *CatalogHandler) group(path []*catalog.Group, g *catalog.Group) error {
+	if h.Group == nil {
+		return nil
+	}
+	return h.Group(path, g)
+}
+
+func (h *CatalogHandler) control(path []*catalog.Group, c *catalog.Control) error {
+	if h.Control == nil {
+		return nil
+	}
+	return h.Control(path, c)
+}
+
+func (h *CatalogHandler) done(c *catalog.Catalog) error {
+	if h.Done == nil {
+		return nil
+	}
+	return h.Done(c)
+}
+
+// StreamCatalog decodes catalog from XML or JSON reader and passes its groups
+// and controls to the handler one at a time, without holding the whole
+// catalog in memory.
+func StreamCatalog(r io.Reader, h CatalogHandler) error {
+	br := bufio.NewReader(r)
+	first, err := firstNonSpace(br)
+	if err != nil {
+		return err
+	}
+	switch first {
+	case '<':
+		err = streamCatalogXML(xml.NewDecoder(br), h)
+	case '{':
+		err = streamCatalogJSON(json.NewDecoder(br), h)
+	default:
+		return errors.New("catalog streaming supports XML and JSON only")
+	}
+	if err == ErrStopStream {
+		return nil
+	}
+	return err
+}
+
+// FilterCatalog decodes catalog from XML or JSON reader keeping only the
+// controls accepted by keep. Groups left without any controls are dropped.
+func FilterCatalog(r io.Reader, keep func(c *catalog.Control) bool) (*catalog.Catalog, error) {
+	type node struct {
+		group    catalog.Group
+		children []*node
+	}
+	root := &node{}
+	nodes := map[*catalog.Group]*node{}
+	parent := func(path []*catalog.Group) *node {
+		if len(path) == 0 {
+			return root
+		}
+		return nodes[path[len(path)-1]]
+	}
+
+	var result *catalog.Catalog
+	err := StreamCatalog(r, CatalogHandler{
+		Group: func(path []*catalog.Group, g *catalog.Group) error {
+			n := &node{group: *g}
+			nodes[g] = n
+			p := parent(path)
+			p.children = append(p.children, n)
+			return nil
+		},
+		Control: func(path []*catalog.Group, c *catalog.Control) error {
+			if keep(c) {
+				p := parent(path)
+				p.group.Controls = append(p.group.Controls, *c)
+			}
+			return nil
+		},
+		Done: func(c *catalog.Catalog) error {
+			result = c
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var build func(n *node) []catalog.Group
+	build = func(n *node) []catalog.Group {
+		var groups []catalog.Group
+		for _, child := range n.children {
+			child.group.Groups = build(child)
+			if len(child.group.Controls) > 0 || len(child.group.Groups) > 0 {
+				groups = append(groups, child.group)
+			}
+		}
+		return groups
+	}
+	result.Controls = root.group.Controls
+	result.Groups = build(root)
+	return result, nil
+}
+
+func firstNonSpace(br *bufio.Reader) (byte, error) {
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return 0, fmt.Errorf("cannot read catalog: %v", err)
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n', 0xef, 0xbb, 0xbf:
+			continue
+		}
+		return b, br.UnreadByte()
+	}
+}
+
+func streamCatalogXML(d *xml.Decoder, h CatalogHandler) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return fmt.Errorf("cannot find catalog element: %v", err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local != catalogRootElement {
+			return fmt.Errorf("expected catalog, found %s", start.Name.Local)
+		}
+		c := &catalog.Catalog{XMLName: start.Name}
+		for _, attr := range start.Attr {
+			if attr.Name.Local == "id" {
+				c.Id = attr.Value
+			}
+		}
+		if err := streamXMLChildren(d, nil, nil, c, h); err != nil {
+			return err
+		}
+		return h.done(c)
+	}
+}
+
+// streamXMLChildren processes children of catalog (when g is nil) or group
+// up to the closing element
+func streamXMLChildren(d *xml.Decoder, path []*catalog.Group, g *catalog.Group, c *catalog.Catalog, h CatalogHandler) error {
+	entered := g == nil
+	enter := func() error {
+		if entered {
+			return nil
+		}
+		entered = true
+		return h.group(path, g)
+	}
+	groupPath := path
+	if g != nil {
+		groupPath = append(path[:len(path):len(path)], g)
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.EndElement:
+			return enter()
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "control":
+				if err := enter(); err != nil {
+					return err
+				}
+				var ctrl catalog.Control
+				if err := d.DecodeElement(&ctrl, &t); err != nil {
+					return err
+				}
+				if err := h.control(groupPath, &ctrl); err != nil {
+					return err
+				}
+			case "group":
+				if err := enter(); err != nil {
+					return err
+				}
+				sub := &catalog.Group{}
+				for _, attr := range t.Attr {
+					switch attr.Name.Local {
+					case "id":
+						sub.Id = attr.Value
+					case "class":
+						sub.Class = attr.Value
+					}
+				}
+				if err := streamXMLChildren(d, groupPath, sub, c, h); err != nil {
+					return err
+				}
+			case "param":
+				var param catalog.Param
+				if err := d.DecodeElement(&param, &t); err != nil {
+					return err
+				}
+				if g != nil {
+					g.Parameters = append(g.Parameters, param)
+				} else {
+					c.Parameters = append(c.Parameters, param)
+				}
+			case "title":
+				var title catalog.Title
+				if err := d.DecodeElement(&title, &t); err != nil {
+					return err
+				}
+				if g != nil {
+					g.Title = title
+				}
+			case "prop":
+				var prop catalog.Prop
+				if err := d.DecodeElement(&prop, &t); err != nil {
+					return err
+				}
+				if g != nil {
+					g.Properties = append(g.Properties, prop)
+				}
+			case "part":
+				var part catalog.Part
+				if err := d.DecodeElement(&part, &t); err != nil {
+					return err
+				}
+				if g != nil {
+					g.Parts = append(g.Parts, part)
+				}
+			case "metadata":
+				var metadata catalog.Metadata
+				if err := d.DecodeElement(&metadata, &t); err != nil {
+					return err
+				}
+				c.Metadata = &metadata
+			case "back-matter":
+				var backMatter catalog.BackMatter
+				if err := d.DecodeElement(&backMatter, &t); err != nil {
+					return err
+				}
+				c.BackMatter = &backMatter
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func streamCatalogJSON(d *json.Decoder, h CatalogHandler) error {
+	if err := expectDelim(d, '{'); err != nil {
+		return err
+	}
+	for d.More() {
+		key, err := jsonKey(d)
+		if err != nil {
+			return err
+		}
+		if key != catalogRootElement {
+			var skipped json.RawMessage
+			if err := d.Decode(&skipped); err != nil {
+				return err
+			}
+			continue
+		}
+		c := &catalog.Catalog{}
+		if err := streamJSONObject(d, nil, nil, c, h); err != nil {
+			return err
+		}
+		return h.done(c)
+	}
+	return errors.New("expected catalog, found none")
+}
+
+// streamJSONObject processes catalog (when g is nil) or group object
+func streamJSONObject(d *json.Decoder, path []*catalog.Group, g *catalog.Group, c *catalog.Catalog, h CatalogHandler) error {
+	entered := g == nil
+	enter := func() error {
+		if entered {
+			return nil
+		}
+		entered = true
+		return h.group(path, g)
+	}
+	groupPath := path
+	if g != nil {
+		groupPath = append(path[:len(path):len(path)], g)
+	}
+
+	if err := expectDelim(d, '{'); err != nil {
+		return err
+	}
+	for d.More() {
+		key, err := jsonKey(d)
+		if err != nil {
+			return err
+		}
+		switch {
+		case key == "controls":
+			if err := enter(); err != nil {
+				return err
+			}
+			err = streamJSONArray(d, func() error {
+				var ctrl catalog.Control
+				if err := d.Decode(&ctrl); err != nil {
+					return err
+				}
+				return h.control(groupPath, &ctrl)
+			})
+		case key == "groups":
+			if err := enter(); err != nil {
+				return err
+			}
+			err = streamJSONArray(d, func() error {
+				return streamJSONObject(d, groupPath, &catalog.Group{}, c, h)
+			})
+		case key == "parameters" && g == nil:
+			err = d.Decode(&c.Parameters)
+		case key == "id" && g == nil:
+			err = d.Decode(&c.Id)
+		case key == "metadata" && g == nil:
+			err = d.Decode(&c.Metadata)
+		case key == "backMatter" && g == nil:
+			err = d.Decode(&c.BackMatter)
+		case key == "parameters":
+			err = d.Decode(&g.Parameters)
+		case key == "id":
+			err = d.Decode(&g.Id)
+		case key == "class":
+			err = d.Decode(&g.Class)
+		case key == "title":
+			err = d.Decode(&g.Title)
+		case key == "properties":
+			err = d.Decode(&g.Properties)
+		case key == "parts":
+			err = d.Decode(&g.Parts)
+		default:
+			var skipped json.RawMessage
+			err = d.Decode(&skipped)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := enter(); err != nil {
+		return err
+	}
+	return expectDelim(d, '}')
+}
+
+func streamJSONArray(d *json.Decoder, item func() error) error {
+	if err := expectDelim(d, '['); err != nil {
+		return err
+	}
+	for d.More() {
+		if err := item(); err != nil {
+			return err
+		}
+	}
+	return expectDelim(d, ']')
+}
+
+func jsonKey(d *json.Decoder) (string, error) {
+	token, err := d.Token()
+	if err != nil {
+		return "", err
+	}
+	key, ok := token.(string)
+	if !ok {
+		return "", fmt.Errorf("expected object key, found %v", token)
+	}
+	return key, nil
+}
+
+func expectDelim(d *json.Decoder, delim json.Delim) error {
+	token, err := d.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %s, found %v", delim, token)
+	}
+	return nil
+}
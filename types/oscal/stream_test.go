@@ -0,0 +1,168 @@
+package oscal
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/docker/oscalkit/types/oscal/catalog"
+)
+
+func largeCatalog(groups, controls int) *catalog.Catalog {
+	c := &catalog.Catalog{Id: "large", Metadata: testMetadata()}
+	for i := 0; i < groups; i++ {
+		g := catalog.Group{Id: fmt.Sprintf("g%d", i), Title: catalog.Title(fmt.Sprintf("Group %d", i))}
+		for j := 0; j < controls; j++ {
+			id := fmt.Sprintf("g%d-%d", i, j)
+			g.Controls = append(g.Controls, catalog.NewControl(id, "Control "+id, &catalog.ControlOpts{
+				Parts: []catalog.Part{
+					catalog.NewPart(id+"_smt", "Statement", "<p>The organization shall do things.</p>"),
+					catalog.NewPart(id+"_gdn", "Guidance", "<p>Some supplemental guidance that is fairly long.</p>"),
+				},
+				Controls: []catalog.Control{
+					catalog.NewControl(id+".1", "Enhancement "+id, nil),
+				},
+			}))
+		}
+		c.Groups = append(c.Groups, g)
+	}
+	return c
+}
+
+func encodedCatalog(t testing.TB, c *catalog.Catalog, format string) []byte {
+	var buf bytes.Buffer
+	o := &OSCAL{Catalog: c}
+	var err error
+	if format == "xml" {
+		err = o.XML(&buf, false)
+	} else {
+		err = o.JSON(&buf, false)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestStreamCatalog(t *testing.T) {
+	c := largeCatalog(2, 3)
+	c.Groups[1].Groups = []catalog.Group{{Id: "nested", Title: "Nested", Controls: []catalog.Control{catalog.NewControl("n-1", "Nested", nil)}}}
+	for _, format := range []string{"xml", "json"} {
+		var groups, controls []string
+		var done *catalog.Catalog
+		err := StreamCatalog(bytes.NewReader(encodedCatalog(t, c, format)), CatalogHandler{
+			Group: func(path []*catalog.Group, g *catalog.Group) error {
+				groups = append(groups, fmt.Sprintf("%d:%s:%s", len(path), g.Id, g.Title))
+				return nil
+			},
+			Control: func(path []*catalog.Group, ctrl *catalog.Control) error {
+				controls = append(controls, fmt.Sprintf("%s/%s/%d", path[len(path)-1].Id, ctrl.Id, len(ctrl.Controls)))
+				return nil
+			},
+			Done: func(c *catalog.Catalog) error {
+				done = c
+				return nil
+			},
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		expectedGroups := []string{"0:g0:Group 0", "0:g1:Group 1", "1:nested:Nested"}
+		if !reflect.DeepEqual(groups, expectedGroups) {
+			t.Errorf("%s: unexpected groups %v", format, groups)
+		}
+		expectedControls := []string{"g0/g0-0/1", "g0/g0-1/1", "g0/g0-2/1", "nested/n-1/0", "g1/g1-0/1", "g1/g1-1/1", "g1/g1-2/1"}
+		if !reflect.DeepEqual(controls, expectedControls) {
+			t.Errorf("%s: unexpected controls %v", format, controls)
+		}
+		if done == nil || done.Id != "large" || done.Metadata == nil || done.Metadata.Title != "Test Document" {
+			t.Errorf("%s: catalog header not decoded: %+v", format, done)
+		}
+	}
+}
+
+func TestStreamCatalogStop(t *testing.T) {
+	count := 0
+	err := StreamCatalog(bytes.NewReader(encodedCatalog(t, largeCatalog(3, 3), "json")), CatalogHandler{
+		Control: func(path []*catalog.Group, c *catalog.Control) error {
+			count++
+			if c.Id == "g0-1" {
+				return ErrStopStream
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("streaming did not stop, %d controls seen", count)
+	}
+}
+
+func TestStreamCatalogRejectsOtherDocuments(t *testing.T) {
+	for _, doc := range []string{`<profile id="p"/>`, `{"profile": {"id": "p"}}`, `profile: {}`} {
+		if err := StreamCatalog(bytes.NewReader([]byte(doc)), CatalogHandler{}); err == nil {
+			t.Errorf("%s should not stream as catalog", doc)
+		}
+	}
+}
+
+func TestFilterCatalog(t *testing.T) {
+	c := largeCatalog(3, 3)
+	keep := map[string]bool{"g0-1": true, "g2-0": true, "g2-2": true}
+	for _, format := range []string{"xml", "json"} {
+		filtered, err := FilterCatalog(bytes.NewReader(encodedCatalog(t, c, format)), func(ctrl *catalog.Control) bool {
+			return keep[ctrl.Id]
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		if len(filtered.Groups) != 2 || filtered.Groups[0].Id != "g0" || filtered.Groups[1].Id != "g2" {
+			t.Fatalf("%s: unexpected groups %+v", format, filtered.Groups)
+		}
+		if len(filtered.Groups[1].Controls) != 2 || filtered.Groups[1].Controls[1].Id != "g2-2" {
+			t.Errorf("%s: unexpected controls %+v", format, filtered.Groups[1].Controls)
+		}
+		if !reflect.DeepEqual(filtered.Groups[0].Controls[0], c.Groups[0].Controls[1]) {
+			t.Errorf("%s: control not decoded completely", format)
+		}
+	}
+}
+
+func benchmarkCatalog(b *testing.B, format string, decode func(data []byte) error) {
+	data := encodedCatalog(b, largeCatalog(20, 50), format)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := decode(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func newCatalog(data []byte) error {
+	_, err := New(bytes.NewReader(data))
+	return err
+}
+
+func streamCatalog(data []byte) error {
+	return StreamCatalog(bytes.NewReader(data), CatalogHandler{})
+}
+
+func filterCatalog(data []byte) error {
+	_, err := FilterCatalog(bytes.NewReader(data), func(c *catalog.Control) bool {
+		return c.Id == "g10-10"
+	})
+	return err
+}
+
+func BenchmarkNewXML(b *testing.B)           { benchmarkCatalog(b, "xml", newCatalog) }
+func BenchmarkStreamCatalogXML(b *testing.B) { benchmarkCatalog(b, "xml", streamCatalog) }
+func BenchmarkFilterCatalogXML(b *testing.B) { benchmarkCatalog(b, "xml", filterCatalog) }
+
+func BenchmarkNewJSON(b *testing.B)           { benchmarkCatalog(b, "json", newCatalog) }
+func BenchmarkStreamCatalogJSON(b *testing.B) { benchmarkCatalog(b, "json", streamCatalog) }
+func BenchmarkFilterCatalogJSON(b *testing.B) { benchmarkCatalog(b, "json", filterCatalog) }
@@ -1,19 +1,134 @@
 package validation_root
 
 import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"regexp"
 	"strings"
+
+	"github.com/docker/oscalkit/types/oscal/markup"
 )
 
-// Markup ...
+// Markup is OSCAL prose. Raw holds the XHTML representation used in XML
+// documents, JSON and YAML documents represent the prose as Markdown.
 type Markup struct {
 	Raw string `xml:",innerxml" json:"raw,omitempty" yaml:"raw,omitempty"`
 }
 
+// MarkupFromPlain creates markup of single paragraph holding plain text
 func MarkupFromPlain(plain string) *Markup {
-	plain = strings.ReplaceAll(plain, "&", "&amp;")
-	plain = strings.ReplaceAll(plain, "<", "&lt;")
-	plain = strings.ReplaceAll(plain, "<", "&gt;")
+	var escaped bytes.Buffer
+	xml.EscapeText(&escaped, []byte(plain))
 	return &Markup{
-		Raw: "<p>" + plain + "</p>",
+		Raw: "<p>" + escaped.String() + "</p>",
+	}
+}
+
+// MarkupFromMarkdown creates markup from its Markdown representation
+func MarkupFromMarkdown(md string) (*Markup, error) {
+	f, err := markup.ParseMarkdown(md)
+	if err != nil {
+		return nil, err
+	}
+	return &Markup{Raw: f.XML()}, nil
+}
+
+// Fragment parses the markup into the typed model
+func (m Markup) Fragment() (*markup.Fragment, error) {
+	return markup.ParseXML(m.Raw)
+}
+
+// Markdown returns the Markdown representation of the markup
+func (m Markup) Markdown() (string, error) {
+	f, err := m.Fragment()
+	if err != nil {
+		return "", err
+	}
+	return f.Markdown(), nil
+}
+
+// MarshalJSON writes the markup as Markdown string
+func (m Markup) MarshalJSON() ([]byte, error) {
+	md, err := m.Markdown()
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert prose to markdown: %v", err)
 	}
+	return json.Marshal(md)
+}
+
+// UnmarshalJSON reads the markup from Markdown string. The object with raw
+// XHTML written by the earlier versions is accepted as well.
+func (m *Markup) UnmarshalJSON(data []byte) error {
+	var md string
+	if err := json.Unmarshal(data, &md); err != nil {
+		var raw struct {
+			Raw string `json:"raw"`
+		}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return fmt.Errorf("prose must be markdown string: %v", err)
+		}
+		m.Raw = raw.Raw
+		return nil
+	}
+	parsed, err := MarkupFromMarkdown(md)
+	if err != nil {
+		return err
+	}
+	*m = *parsed
+	return nil
+}
+
+// insertPattern matches parameter insert elements, including the unclosed ones
+var insertPattern = regexp.MustCompile(`<insert\s[^>]*?param-id\s*=\s*["']([^"']*)["'][^>]*?(/>|>\s*</insert>|>)`)
+
+// InsertParams returns copy of the markup with the parameter inserts replaced
+// by values. Inserts for which value reports false are kept.
+func (m Markup) InsertParams(value func(paramID string) (string, bool)) Markup {
+	m.Raw = insertPattern.ReplaceAllStringFunc(m.Raw, func(insert string) string {
+		v, ok := value(insertPattern.FindStringSubmatch(insert)[1])
+		if !ok {
+			return insert
+		}
+		var escaped bytes.Buffer
+		xml.EscapeText(&escaped, []byte(v))
+		return escaped.String()
+	})
+	return m
+}
+
+// Text returns the markup as plain text with the whitespace collapsed. Text
+// of inline elements joins the surrounding text, block elements are
+// separated by space.
+func (m Markup) Text() string {
+	var text strings.Builder
+	d := xml.NewDecoder(strings.NewReader("<markup>" + m.Raw + "</markup>"))
+	d.Strict = false
+	d.Entity = xml.HTMLEntity
+	for {
+		token, err := d.Token()
+		if err != nil {
+			break
+		}
+		switch t := token.(type) {
+		case xml.CharData:
+			text.Write(t)
+		case xml.StartElement:
+			if separates(t.Name.Local) {
+				text.WriteString(" ")
+			}
+		case xml.EndElement:
+			if separates(t.Name.Local) {
+				text.WriteString(" ")
+			}
+		}
+	}
+	return strings.Join(strings.Fields(text.String()), " ")
+}
+
+// separates reports whether the element separates text, as block elements
+// such as paragraphs, list items and table cells do
+func separates(name string) bool {
+	return markup.IsElement(name) && !markup.IsInline(name)
 }
@@ -0,0 +1,46 @@
+package validation_root
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarkupText(t *testing.T) {
+	cases := map[string]string{
+		"<p><em>foo</em>bar</p>":                       "foobar",
+		"<p>a <strong>b</strong> c</p>":                "a b c",
+		"<p>first</p><p>second</p>":                    "first second",
+		"<ul><li>one</li><li>two</li></ul>":            "one two",
+		"<p>x&amp;y\n   z</p>":                         "x&y z",
+		`<p>every <insert param-id="p1"/>days</p>`:     "every days",
+		"<table><tr><td>a</td><td>b</td></tr></table>": "a b",
+		"plain <code>text</code>s":                     "plain texts",
+	}
+	for raw, expected := range cases {
+		if text := (Markup{Raw: raw}).Text(); text != expected {
+			t.Errorf("text of %q is %q, expected %q", raw, text, expected)
+		}
+	}
+}
+
+func TestMarkupJSON(t *testing.T) {
+	for _, raw := range []string{
+		"<p>plain <em>prose</em></p>",
+		`<p class="lead">intro</p><div><p>unsupported</p></div>`,
+		`<p>with <span class="term">class</span></p>`,
+	} {
+		data, err := json.Marshal(Markup{Raw: raw})
+		if err != nil {
+			t.Errorf("cannot marshal %q: %v", raw, err)
+			continue
+		}
+		var m Markup
+		if err := json.Unmarshal(data, &m); err != nil {
+			t.Errorf("cannot unmarshal %s: %v", data, err)
+			continue
+		}
+		if m.Raw != raw {
+			t.Errorf("round trip of %q through %s gives %q", raw, data, m.Raw)
+		}
+	}
+}
@@ -35,16 +35,14 @@ type Metadata struct {
 	Version Version `xml:"version,omitempty" json:"version,omitempty"`
 	// OSCAL model version.
 	OscalVersion OscalVersion `xml:"oscal-version,omitempty" json:"oscalVersion,omitempty"`
+	// An entry in a sequential list of revisions to the containing document in reverse chronological order (i.e., most recent previous revision first).
+	RevisionHistory []Revision `xml:"revision,omitempty" json:"revision-history,omitempty"`
 	// A document identifier qualified by an identifier .
 	DocumentIds []DocId `xml:"doc-id,omitempty" json:"document-ids,omitempty"`
 	// A value with a name, attributed to the containing control, part, or group.
 	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
 	// A reference to a local or remote resource
 	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
-	// Additional commentary on the parent item.
-	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
-	// An entry in a sequential list of revisions to the containing document in reverse chronological order (i.e., most recent previous revision first).
-	RevisionHistory []Revision `xml:"revision,omitempty" json:"revision-history,omitempty"`
 	// Defining a role to be assigned to a party
 	Roles []Role `xml:"role,omitempty" json:"roles,omitempty"`
 	// A location, with associated metadata that can be referenced.
@@ -53,6 +51,8 @@ type Metadata struct {
 	Parties []Party `xml:"party,omitempty" json:"parties,omitempty"`
 	// A reference to a set of organizations or persons that have responsibility for performing a referenced role relative to the parent context.
 	ResponsibleParties []ResponsibleParty `xml:"responsible-party,omitempty" json:"responsible-parties,omitempty"`
+	// Additional commentary on the parent item.
+	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
 }
 
 // A collection of citations and resource references.
@@ -105,6 +105,8 @@ type Location struct {
 	// Unique identifier of the containing object
 	Id string `xml:"id,attr,omitempty" json:"id,omitempty"`
 
+	// A postal address.
+	Address *Address `xml:"address,omitempty" json:"address,omitempty"`
 	// Email address
 	EmailAddresses []Email `xml:"email,omitempty" json:"email-addresses,omitempty"`
 	// Contact number by telephone
@@ -113,14 +115,12 @@ type Location struct {
 	URLs []Url `xml:"url,omitempty" json:"URLs,omitempty"`
 	// A value with a name, attributed to the containing control, part, or group.
 	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
+	// A name/value pair with optional explanatory remarks.
+	Annotations []Annotation `xml:"annotation,omitempty" json:"annotations,omitempty"`
 	// A reference to a local or remote resource
 	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
 	// Additional commentary on the parent item.
 	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
-	// A postal address.
-	Address *Address `xml:"address,omitempty" json:"address,omitempty"`
-	// A name/value pair with optional explanatory remarks.
-	Annotations []Annotation `xml:"annotation,omitempty" json:"annotations,omitempty"`
 }
 
 // A responsible entity, either singular (an organization or person) or collective (multiple persons)
@@ -129,18 +129,18 @@ type Party struct {
 	// Unique identifier of the containing object
 	Id string `xml:"id,attr,omitempty" json:"id,omitempty"`
 
-	// A value with a name, attributed to the containing control, part, or group.
-	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
-	// A reference to a local or remote resource
-	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
-	// Additional commentary on the parent item.
-	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
 	// A person, with contact information
 	Persons []Person `xml:"person,omitempty" json:"persons,omitempty"`
 	// An organization or legal entity (not a person), with contact information
 	Org *Org `xml:"org,omitempty" json:"org,omitempty"`
+	// A value with a name, attributed to the containing control, part, or group.
+	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
 	// A name/value pair with optional explanatory remarks.
 	Annotations []Annotation `xml:"annotation,omitempty" json:"annotations,omitempty"`
+	// A reference to a local or remote resource
+	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
+	// Additional commentary on the parent item.
+	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
 }
 
 // A person, with contact information
@@ -156,6 +156,8 @@ type Person struct {
 	PersonIds []PersonId `xml:"person-id,omitempty" json:"person-ids,omitempty"`
 	// An identifier for an organization using a designated scheme.
 	OrganizationIds []OrgId `xml:"org-id,omitempty" json:"organization-ids,omitempty"`
+	// A postal address.
+	Addresses []Address `xml:"address,omitempty" json:"addresses,omitempty"`
 	// References a  defined in .
 	LocationIds []LocationId `xml:"location-id,omitempty" json:"location-ids,omitempty"`
 	// Email address
@@ -166,14 +168,12 @@ type Person struct {
 	URLs []Url `xml:"url,omitempty" json:"URLs,omitempty"`
 	// A value with a name, attributed to the containing control, part, or group.
 	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
+	// A name/value pair with optional explanatory remarks.
+	Annotations []Annotation `xml:"annotation,omitempty" json:"annotations,omitempty"`
 	// A reference to a local or remote resource
 	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
 	// Additional commentary on the parent item.
 	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
-	// A postal address.
-	Addresses []Address `xml:"address,omitempty" json:"addresses,omitempty"`
-	// A name/value pair with optional explanatory remarks.
-	Annotations []Annotation `xml:"annotation,omitempty" json:"annotations,omitempty"`
 }
 
 // An organization or legal entity (not a person), with contact information
@@ -185,6 +185,8 @@ type Org struct {
 	ShortName ShortName `xml:"short-name,omitempty" json:"shortName,omitempty"`
 	// An identifier for an organization using a designated scheme.
 	OrganizationIds []OrgId `xml:"org-id,omitempty" json:"organization-ids,omitempty"`
+	// A postal address.
+	Addresses []Address `xml:"address,omitempty" json:"addresses,omitempty"`
 	// References a  defined in .
 	LocationIds []LocationId `xml:"location-id,omitempty" json:"location-ids,omitempty"`
 	// Email address
@@ -195,14 +197,12 @@ type Org struct {
 	URLs []Url `xml:"url,omitempty" json:"URLs,omitempty"`
 	// A value with a name, attributed to the containing control, part, or group.
 	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
+	// A name/value pair with optional explanatory remarks.
+	Annotations []Annotation `xml:"annotation,omitempty" json:"annotations,omitempty"`
 	// A reference to a local or remote resource
 	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
 	// Additional commentary on the parent item.
 	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
-	// A postal address.
-	Addresses []Address `xml:"address,omitempty" json:"addresses,omitempty"`
-	// A name/value pair with optional explanatory remarks.
-	Annotations []Annotation `xml:"annotation,omitempty" json:"annotations,omitempty"`
 }
 
 // A pointer to an external copy of a document with optional hash for verification
@@ -253,14 +253,14 @@ type Resource struct {
 	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
 	// A document identifier qualified by an identifier .
 	DocumentIds []DocId `xml:"doc-id,omitempty" json:"document-ids,omitempty"`
-	//
-	Attachments []Base64 `xml:"base64,omitempty" json:"attachments,omitempty"`
-	// Additional commentary on the parent item.
-	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
 	// A citation consisting of end note text and optional structured bibliographic data.
 	Citation *Citation `xml:"citation,omitempty" json:"citation,omitempty"`
 	// A pointer to an external copy of a document with optional hash for verification
 	Rlinks []Rlink `xml:"rlink,omitempty" json:"rlinks,omitempty"`
+	//
+	Attachments []Base64 `xml:"base64,omitempty" json:"attachments,omitempty"`
+	// Additional commentary on the parent item.
+	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
 }
 
 // A citation consisting of end note text and optional structured bibliographic data.
@@ -288,12 +288,12 @@ type Role struct {
 	Desc Desc `xml:"desc,omitempty" json:"desc,omitempty"`
 	// A value with a name, attributed to the containing control, part, or group.
 	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
+	// A name/value pair with optional explanatory remarks.
+	Annotations []Annotation `xml:"annotation,omitempty" json:"annotations,omitempty"`
 	// A reference to a local or remote resource
 	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
 	// Additional commentary on the parent item.
 	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
-	// A name/value pair with optional explanatory remarks.
-	Annotations []Annotation `xml:"annotation,omitempty" json:"annotations,omitempty"`
 }
 
 // A reference to a set of organizations or persons that have responsibility for performing a referenced role relative to the parent context.
@@ -306,12 +306,12 @@ type ResponsibleParty struct {
 	PartyIds []PartyId `xml:"party-id,omitempty" json:"party-ids,omitempty"`
 	// A value with a name, attributed to the containing control, part, or group.
 	Properties []Prop `xml:"prop,omitempty" json:"properties,omitempty"`
+	// A name/value pair with optional explanatory remarks.
+	Annotations []Annotation `xml:"annotation,omitempty" json:"annotations,omitempty"`
 	// A reference to a local or remote resource
 	Links []Link `xml:"link,omitempty" json:"links,omitempty"`
 	// Additional commentary on the parent item.
 	Remarks *Remarks `xml:"remarks,omitempty" json:"remarks,omitempty"`
-	// A name/value pair with optional explanatory remarks.
-	Annotations []Annotation `xml:"annotation,omitempty" json:"annotations,omitempty"`
 }
 
 // A reference to a local or remote resource